@@ -198,6 +198,10 @@ type Client struct {
 	// see RestoreCommonConfig.WithSysTable
 	withSysTable bool
 
+	// tableMapping maps the databases and tables to other names, the bindings of
+	// the mapped tables are restored with the target names.
+	tableMapping *TableMapping
+
 	// the successfully preallocated table IDs.
 	preallocedTableIDs *tidalloc.PreallocIDs
 
@@ -3698,6 +3702,11 @@ func (rc *Client) SetWithSysTable(withSysTable bool) {
 	rc.withSysTable = withSysTable
 }
 
+// SetTableMapping sets the mapping of databases and tables to restore.
+func (rc *Client) SetTableMapping(mapping *TableMapping) {
+	rc.tableMapping = mapping
+}
+
 func (rc *Client) ResetTiFlashReplicas(ctx context.Context, g glue.Glue, storage kv.Storage) error {
 	dom, err := g.GetDomain(storage)
 	if err != nil {
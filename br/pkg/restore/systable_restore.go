@@ -13,6 +13,7 @@ import (
 	"github.com/pingcap/tidb/br/pkg/logutil"
 	"github.com/pingcap/tidb/br/pkg/utils"
 	"github.com/pingcap/tidb/pkg/bindinfo"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	filter "github.com/pingcap/tidb/pkg/util/table-filter"
@@ -21,7 +22,8 @@ import (
 )
 
 const (
-	sysUserTableName = "user"
+	sysUserTableName     = "user"
+	sysBindInfoTableName = "bind_info"
 )
 
 var statsTables = map[string]map[string]struct{}{
@@ -180,7 +182,7 @@ func (rc *Client) afterSystemTablesReplaced(ctx context.Context, db string, tabl
 			} else {
 				log.Info("privilege system table restored, please reconnect to make it effective")
 			}
-		} else if table == "bind_info" {
+		} else if table == sysBindInfoTableName {
 			if serr := rc.db.se.Execute(ctx, bindinfo.StmtRemoveDuplicatedPseudoBinding); serr != nil {
 				log.Warn("failed to delete duplicated pseudo binding", zap.Error(serr))
 				err = multierr.Append(err,
@@ -232,6 +234,12 @@ func (rc *Client) replaceTemporaryTableToSystable(ctx context.Context, ti *model
 		return nil
 	}
 
+	// The bindings refer to the tables by the names in the backup, only the
+	// bindings of the mapped tables are restored with the target names.
+	if rc.tableMapping != nil && dbName == mysql.SystemDB && tableName == sysBindInfoTableName {
+		return rc.restoreMappedBindings(ctx, db)
+	}
+
 	// Currently, we don't support restore resource group metadata, so we need to
 	// remove the resource group related metadata in mysql.user.
 	// TODO: this function should be removed when we support backup and restore
@@ -272,6 +280,41 @@ func (rc *Client) replaceTemporaryTableToSystable(ctx context.Context, ti *model
 	return execSQL(renameSQL)
 }
 
+// restoreMappedBindings creates the global bindings of the mapped tables from
+// the bindings in the temporary bind_info table.
+func (rc *Client) restoreMappedBindings(ctx context.Context, db *database) error {
+	exec := rc.db.se.GetSessionCtx().GetRestrictedSQLExecutor()
+	rows, _, err := exec.ExecRestrictedSQL(kv.WithInternalSourceType(ctx, kv.InternalTxnBR), nil,
+		"SELECT bind_sql, default_db FROM %n.%n WHERE status IN (%?, %?) AND source != %?",
+		db.TemporaryName.L, sysBindInfoTableName, bindinfo.Enabled, bindinfo.Using, bindinfo.Builtin)
+	if err != nil {
+		return errors.Annotate(err, "failed to read the bindings in backup")
+	}
+	restored := 0
+	for _, row := range rows {
+		bindSQL, defaultDB := row.GetString(0), row.GetString(1)
+		sql, mapped, err := rc.tableMapping.rewriteSQL(bindSQL, defaultDB, false)
+		if err != nil {
+			log.Warn("skip the binding which cannot be rewritten",
+				logutil.Redact(zap.String("sql", bindSQL)), logutil.ShortError(err))
+			continue
+		}
+		if !mapped {
+			continue
+		}
+		// A binding may be invalid for the target cluster, e.g. its hints refer to
+		// an index which doesn't exist, it shouldn't fail the whole restore.
+		if err = rc.db.se.Execute(ctx, "CREATE GLOBAL BINDING USING "+sql); err != nil {
+			log.Warn("skip the binding which cannot be created",
+				logutil.Redact(zap.String("sql", sql)), logutil.ShortError(err))
+			continue
+		}
+		restored++
+	}
+	log.Info("restore bindings of mapped tables", zap.Int("restored", restored), zap.Int("total", len(rows)))
+	return nil
+}
+
 func (rc *Client) cleanTemporaryDatabase(ctx context.Context, originDB string) {
 	database := utils.TemporaryDBName(originDB)
 	log.Debug("dropping temporary database", zap.Stringer("database", database))
@@ -0,0 +1,313 @@
+// Copyright 2024 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/log"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/br/pkg/utils"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/format"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"go.uber.org/zap"
+)
+
+// TableMapping maps the databases and tables in the backup to other names in
+// the restored cluster, e.g. restore `app` into `app_staging`. The mapping of
+// a table takes precedence over the mapping of its database.
+type TableMapping struct {
+	// lower source database name -> target database name.
+	dbs map[string]string
+	// lower source table name -> target table name.
+	tables map[UniqueTableName]UniqueTableName
+}
+
+// ParseTableMapping parses the mapping rules, each rule is in the form of
+// `src-db:dst-db` or `src-db.src-table:dst-db.dst-table`. It returns nil if
+// there is no rule.
+func ParseTableMapping(rules []string) (*TableMapping, error) {
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	m := &TableMapping{
+		dbs:    make(map[string]string),
+		tables: make(map[UniqueTableName]UniqueTableName),
+	}
+	dbTargets := make(map[string]struct{})
+	tableTargets := make(map[UniqueTableName]struct{})
+	for _, rule := range rules {
+		src, dst, ok := strings.Cut(rule, ":")
+		if !ok {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+				"invalid table mapping %q, it should be `src:dst`", rule)
+		}
+		srcNames, dstNames := strings.Split(src, "."), strings.Split(dst, ".")
+		if len(srcNames) != len(dstNames) || len(srcNames) > 2 ||
+			slices.Contains(srcNames, "") || slices.Contains(dstNames, "") {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+				"invalid table mapping %q, it should be `src-db:dst-db` or `src-db.src-table:dst-db.dst-table`", rule)
+		}
+		if utils.IsSysDB(strings.ToLower(srcNames[0])) || utils.IsSysDB(strings.ToLower(dstNames[0])) {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument,
+				"invalid table mapping %q, system databases cannot be mapped", rule)
+		}
+		if len(srcNames) == 1 {
+			srcDB, dstDB := strings.ToLower(src), strings.ToLower(dst)
+			if _, ok := m.dbs[srcDB]; ok {
+				return nil, errors.Annotatef(berrors.ErrInvalidArgument, "database %s is mapped more than once", src)
+			}
+			if _, ok := dbTargets[dstDB]; ok {
+				return nil, errors.Annotatef(berrors.ErrInvalidArgument, "more than one database is mapped to %s", dst)
+			}
+			m.dbs[srcDB] = dst
+			dbTargets[dstDB] = struct{}{}
+			continue
+		}
+		srcTable := UniqueTableName{DB: strings.ToLower(srcNames[0]), Table: strings.ToLower(srcNames[1])}
+		dstTable := UniqueTableName{DB: strings.ToLower(dstNames[0]), Table: strings.ToLower(dstNames[1])}
+		if _, ok := m.tables[srcTable]; ok {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument, "table %s is mapped more than once", src)
+		}
+		if _, ok := tableTargets[dstTable]; ok {
+			return nil, errors.Annotatef(berrors.ErrInvalidArgument, "more than one table is mapped to %s", dst)
+		}
+		m.tables[srcTable] = UniqueTableName{DB: dstNames[0], Table: dstNames[1]}
+		tableTargets[dstTable] = struct{}{}
+	}
+	return m, nil
+}
+
+// mapDB returns the target name of the database, ok is false if the database
+// isn't mapped.
+func (m *TableMapping) mapDB(db string) (string, bool) {
+	dst, ok := m.dbs[strings.ToLower(db)]
+	return dst, ok
+}
+
+// mapTable returns the target name of the table, ok is false if neither the
+// table nor its database is mapped.
+func (m *TableMapping) mapTable(db, table string) (dstDB, dstTable string, ok bool) {
+	if dst, ok := m.tables[UniqueTableName{DB: strings.ToLower(db), Table: strings.ToLower(table)}]; ok {
+		return dst.DB, dst.Table, true
+	}
+	if dst, ok := m.mapDB(db); ok {
+		return dst, table, true
+	}
+	return db, table, false
+}
+
+// RewriteSchemas returns the databases and tables to restore after mapping,
+// the mapped ones are copied with the target names, so the tables are created
+// in or reuse the target databases and tables, and their statistics are loaded
+// into the target tables. A source database is skipped if all of its tables
+// are mapped into other databases.
+func (m *TableMapping) RewriteSchemas(
+	dbs []*metautil.Database,
+	tables []*metautil.Table,
+) ([]*metautil.Database, []*metautil.Table, error) {
+	// the number of tables which are left in, or moved out from, the source database.
+	leftTables, movedTables := make(map[string]int), make(map[string]int)
+	for _, table := range tables {
+		if _, ok := m.tables[UniqueTableName{DB: table.DB.Name.L, Table: table.Info.Name.L}]; ok {
+			movedTables[table.DB.Name.L]++
+		} else {
+			leftTables[table.DB.Name.L]++
+		}
+	}
+
+	newDBs := make([]*metautil.Database, 0, len(dbs))
+	dbByName := make(map[string]*metautil.Database, len(dbs))
+	getDB := func(name string, src *model.DBInfo) *metautil.Database {
+		if db, ok := dbByName[strings.ToLower(name)]; ok {
+			return db
+		}
+		info := src
+		if src.Name.O != name {
+			info = src.Clone()
+			info.Name = model.NewCIStr(name)
+		}
+		db := &metautil.Database{Info: info}
+		dbByName[info.Name.L] = db
+		newDBs = append(newDBs, db)
+		return db
+	}
+	for _, db := range dbs {
+		if _, ok := m.mapDB(db.Info.Name.O); ok {
+			continue
+		}
+		if leftTables[db.Info.Name.L] > 0 || movedTables[db.Info.Name.L] == 0 {
+			dbByName[db.Info.Name.L] = db
+			newDBs = append(newDBs, db)
+		}
+	}
+	for _, db := range dbs {
+		if dst, ok := m.mapDB(db.Info.Name.O); ok && (leftTables[db.Info.Name.L] > 0 || movedTables[db.Info.Name.L] == 0) {
+			getDB(dst, db.Info)
+		}
+	}
+
+	newTables := make([]*metautil.Table, 0, len(tables))
+	targets := make(map[UniqueTableName]struct{}, len(tables))
+	for _, table := range tables {
+		dstDB, dstTable, ok := m.mapTable(table.DB.Name.O, table.Info.Name.O)
+		target := UniqueTableName{DB: strings.ToLower(dstDB), Table: strings.ToLower(dstTable)}
+		if _, dup := targets[target]; dup {
+			return nil, nil, errors.Annotatef(berrors.ErrInvalidArgument,
+				"more than one table is restored to %s", utils.EncloseDBAndTable(dstDB, dstTable))
+		}
+		targets[target] = struct{}{}
+		if !ok {
+			newTables = append(newTables, table)
+			continue
+		}
+
+		newTable := *table
+		newTable.DB = getDB(dstDB, table.DB).Info
+		newTable.Info = table.Info.Clone()
+		newTable.Info.Name = model.NewCIStr(dstTable)
+		if table.Stats != nil {
+			// the statistics are loaded into the table with the same name.
+			stats := *table.Stats
+			stats.DatabaseName, stats.TableName = newTable.DB.Name.O, dstTable
+			newTable.Stats = &stats
+		}
+		newTables = append(newTables, &newTable)
+		log.Info("map table",
+			zap.String("from", utils.EncloseDBAndTable(table.DB.Name.O, table.Info.Name.O)),
+			zap.String("to", utils.EncloseDBAndTable(newTable.DB.Name.O, dstTable)))
+	}
+	return newDBs, newTables, nil
+}
+
+// RewriteDDLJobs returns the ddl jobs with the mapped database and table names
+// rewritten, the original jobs are not changed.
+func (m *TableMapping) RewriteDDLJobs(jobs []*model.Job) ([]*model.Job, error) {
+	newJobs := make([]*model.Job, 0, len(jobs))
+	for _, job := range jobs {
+		newJob := job.Clone()
+		if newJob == nil {
+			return nil, errors.Errorf("failed to clone ddl job %d", job.ID)
+		}
+		if tableInfo := newJob.BinlogInfo.TableInfo; tableInfo != nil {
+			if dstDB, dstTable, ok := m.mapTable(job.SchemaName, tableInfo.Name.O); ok {
+				newJob.SchemaName = dstDB
+				tableInfo.Name = model.NewCIStr(dstTable)
+			}
+		} else if dbInfo := newJob.BinlogInfo.DBInfo; dbInfo != nil {
+			if dstDB, ok := m.mapDB(dbInfo.Name.O); ok {
+				newJob.SchemaName = dstDB
+				dbInfo.Name = model.NewCIStr(dstDB)
+			}
+		}
+		if job.Query != "" {
+			// the query is executed in the target database, so the tables which
+			// are not mapped are qualified with the source database.
+			query, rewritten, err := m.rewriteSQL(job.Query, job.SchemaName, true)
+			if err != nil {
+				return nil, errors.Annotatef(err, "failed to rewrite ddl job %d", job.ID)
+			}
+			if rewritten {
+				newJob.Query = query
+			}
+		}
+		newJobs = append(newJobs, newJob)
+	}
+	return newJobs, nil
+}
+
+// rewriteSQL rewrites the mapped database and table names in sql, rewritten
+// is false if sql doesn't refer to any of them. If qualify is true, the tables
+// not mapped are also qualified with defaultDB.
+func (m *TableMapping) rewriteSQL(sql, defaultDB string, qualify bool) (_ string, rewritten bool, _ error) {
+	stmt, err := parser.New().ParseOneStmt(sql, "", "")
+	if err != nil {
+		return "", false, errors.Trace(err)
+	}
+	v := &tableNameRewriter{mapping: m, defaultDB: defaultDB, qualify: qualify}
+	stmt.Accept(v)
+	if !v.rewritten {
+		return sql, false, nil
+	}
+	var sb strings.Builder
+	if err = stmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)); err != nil {
+		return "", false, errors.Trace(err)
+	}
+	return sb.String(), true, nil
+}
+
+// tableNameRewriter rewrites the mapped database and table names of the
+// tables, columns and table hints.
+type tableNameRewriter struct {
+	mapping   *TableMapping
+	defaultDB string
+	qualify   bool
+	rewritten bool
+}
+
+func (v *tableNameRewriter) dbOrDefault(db model.CIStr) string {
+	if db.O == "" {
+		return v.defaultDB
+	}
+	return db.O
+}
+
+// Enter implements ast.Visitor interface.
+func (v *tableNameRewriter) Enter(in ast.Node) (ast.Node, bool) {
+	switch n := in.(type) {
+	case *ast.TableName:
+		db := v.dbOrDefault(n.Schema)
+		if dstDB, dstTable, ok := v.mapping.mapTable(db, n.Name.O); ok {
+			n.Schema, n.Name = model.NewCIStr(dstDB), model.NewCIStr(dstTable)
+			v.rewritten = true
+		} else if v.qualify && db != "" {
+			n.Schema = model.NewCIStr(db)
+		}
+	case *ast.ColumnName:
+		if n.Table.O == "" {
+			break
+		}
+		if dstDB, dstTable, ok := v.mapping.mapTable(v.dbOrDefault(n.Schema), n.Table.O); ok {
+			if n.Schema.O != "" {
+				n.Schema = model.NewCIStr(dstDB)
+			}
+			n.Table = model.NewCIStr(dstTable)
+			v.rewritten = true
+		}
+	case *ast.TableOptimizerHint:
+		for i := range n.Tables {
+			ht := &n.Tables[i]
+			if dstDB, dstTable, ok := v.mapping.mapTable(v.dbOrDefault(ht.DBName), ht.TableName.O); ok {
+				if ht.DBName.O != "" {
+					ht.DBName = model.NewCIStr(dstDB)
+				}
+				ht.TableName = model.NewCIStr(dstTable)
+				v.rewritten = true
+			}
+		}
+	case *ast.CreateDatabaseStmt:
+		v.rewriteDBName(&n.Name)
+	case *ast.AlterDatabaseStmt:
+		v.rewriteDBName(&n.Name)
+	case *ast.DropDatabaseStmt:
+		v.rewriteDBName(&n.Name)
+	}
+	return in, false
+}
+
+func (v *tableNameRewriter) rewriteDBName(name *model.CIStr) {
+	if dst, ok := v.mapping.mapDB(name.O); ok {
+		*name = model.NewCIStr(dst)
+		v.rewritten = true
+	}
+}
+
+// Leave implements ast.Visitor interface.
+func (*tableNameRewriter) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
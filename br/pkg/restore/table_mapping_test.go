@@ -0,0 +1,214 @@
+// Copyright 2024 PingCAP, Inc. Licensed under Apache-2.0.
+
+package restore
+
+import (
+	"testing"
+
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
+	"github.com/pingcap/tidb/br/pkg/metautil"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/statistics/handle/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTableMapping(t *testing.T) {
+	m, err := ParseTableMapping(nil)
+	require.NoError(t, err)
+	require.Nil(t, m)
+
+	m, err = ParseTableMapping([]string{"App:app_staging", "app.Orders:archive.orders_2024"})
+	require.NoError(t, err)
+	db, ok := m.mapDB("app")
+	require.True(t, ok)
+	require.Equal(t, "app_staging", db)
+	_, ok = m.mapDB("archive")
+	require.False(t, ok)
+	dstDB, dstTable, ok := m.mapTable("APP", "orders")
+	require.True(t, ok)
+	require.Equal(t, "archive", dstDB)
+	require.Equal(t, "orders_2024", dstTable)
+	dstDB, dstTable, ok = m.mapTable("app", "users")
+	require.True(t, ok)
+	require.Equal(t, "app_staging", dstDB)
+	require.Equal(t, "users", dstTable)
+	dstDB, dstTable, ok = m.mapTable("other", "users")
+	require.False(t, ok)
+	require.Equal(t, "other", dstDB)
+	require.Equal(t, "users", dstTable)
+
+	for _, rules := range [][]string{
+		{"app"},
+		{"app:"},
+		{"app.t:app2"},
+		{"app.t.x:app2.t.x"},
+		{"app.:app2.t"},
+		{"mysql:mysql2"},
+		{"app:sys"},
+		{"app:a", "APP:b"},
+		{"a:app", "b:APP"},
+		{"app.t:a.t", "app.T:b.t"},
+		{"a.t:app.t", "b.t:app.T"},
+	} {
+		_, err = ParseTableMapping(rules)
+		require.ErrorIs(t, err, berrors.ErrInvalidArgument, "%v", rules)
+	}
+}
+
+func TestTableMappingRewriteSchemas(t *testing.T) {
+	newDB := func(id int64, name string) *metautil.Database {
+		return &metautil.Database{Info: &model.DBInfo{ID: id, Name: model.NewCIStr(name)}}
+	}
+	newTable := func(db *metautil.Database, id int64, name string) *metautil.Table {
+		table := &metautil.Table{
+			DB:    db.Info,
+			Info:  &model.TableInfo{ID: id, Name: model.NewCIStr(name)},
+			Stats: &util.JSONTable{DatabaseName: db.Info.Name.O, TableName: name},
+		}
+		db.Tables = append(db.Tables, table)
+		return table
+	}
+	app, logs, hist, empty := newDB(1, "app"), newDB(2, "logs"), newDB(3, "hist"), newDB(4, "empty")
+	tables := []*metautil.Table{
+		newTable(app, 11, "users"),
+		newTable(app, 12, "orders"),
+		newTable(logs, 21, "access"),
+		newTable(hist, 31, "events"),
+	}
+	dbs := []*metautil.Database{app, logs, hist, empty}
+
+	m, err := ParseTableMapping([]string{"app:app_staging", "app.orders:logs.orders", "hist.events:archive.events_old"})
+	require.NoError(t, err)
+	newDBs, newTables, err := m.RewriteSchemas(dbs, tables)
+	require.NoError(t, err)
+
+	dbNames := make([]string, 0, len(newDBs))
+	for _, db := range newDBs {
+		dbNames = append(dbNames, db.Info.Name.O)
+	}
+	// hist is skipped because its only table is moved out.
+	require.Equal(t, []string{"logs", "empty", "app_staging", "archive"}, dbNames)
+	require.Equal(t, app.Info.ID, newDBs[2].Info.ID)
+	require.Equal(t, hist.Info.ID, newDBs[3].Info.ID)
+
+	tableNames := make([]string, 0, len(newTables))
+	for i, table := range newTables {
+		tableNames = append(tableNames, table.DB.Name.O+"."+table.Info.Name.O)
+		require.Equal(t, tables[i].Info.ID, table.Info.ID)
+		require.Equal(t, table.DB.Name.O, table.Stats.DatabaseName)
+		require.Equal(t, table.Info.Name.O, table.Stats.TableName)
+	}
+	require.Equal(t, []string{"app_staging.users", "logs.orders", "logs.access", "archive.events_old"}, tableNames)
+	// the tables of the mapped databases reuse the target databases.
+	require.Same(t, newDBs[0].Info, newTables[1].DB)
+	require.Same(t, logs.Info, newTables[1].DB)
+	// the backup schemas are not changed.
+	require.Equal(t, "app", app.Info.Name.O)
+	require.Equal(t, "orders", tables[1].Info.Name.O)
+	require.Equal(t, "app", tables[1].Stats.DatabaseName)
+
+	m, err = ParseTableMapping([]string{"app.users:logs.access"})
+	require.NoError(t, err)
+	_, _, err = m.RewriteSchemas(dbs, tables)
+	require.ErrorIs(t, err, berrors.ErrInvalidArgument)
+}
+
+func TestTableMappingRewriteDDLJobs(t *testing.T) {
+	m, err := ParseTableMapping([]string{"app:app_staging", "shop.orders:archive.orders_old"})
+	require.NoError(t, err)
+	jobs := []*model.Job{
+		{
+			ID:         1,
+			Type:       model.ActionCreateSchema,
+			SchemaName: "app",
+			BinlogInfo: &model.HistoryInfo{DBInfo: &model.DBInfo{Name: model.NewCIStr("app")}},
+			Query:      "CREATE DATABASE app",
+		},
+		{
+			ID:         2,
+			Type:       model.ActionAddColumn,
+			SchemaName: "app",
+			BinlogInfo: &model.HistoryInfo{TableInfo: &model.TableInfo{Name: model.NewCIStr("users")}},
+			Query:      "alter table users add column c int",
+		},
+		{
+			ID:         3,
+			Type:       model.ActionRenameTable,
+			SchemaName: "shop",
+			BinlogInfo: &model.HistoryInfo{TableInfo: &model.TableInfo{Name: model.NewCIStr("orders")}},
+			Query:      "rename table orders_tmp to orders",
+		},
+		{
+			ID:         4,
+			Type:       model.ActionAddIndex,
+			SchemaName: "shop",
+			BinlogInfo: &model.HistoryInfo{TableInfo: &model.TableInfo{Name: model.NewCIStr("items")}},
+			Query:      "alter table items add index idx(c)",
+		},
+	}
+	newJobs, err := m.RewriteDDLJobs(jobs)
+	require.NoError(t, err)
+	require.Len(t, newJobs, len(jobs))
+
+	require.Equal(t, "app_staging", newJobs[0].SchemaName)
+	require.Equal(t, "app_staging", newJobs[0].BinlogInfo.DBInfo.Name.O)
+	require.Equal(t, "CREATE DATABASE `app_staging`", newJobs[0].Query)
+
+	require.Equal(t, "app_staging", newJobs[1].SchemaName)
+	require.Equal(t, "users", newJobs[1].BinlogInfo.TableInfo.Name.O)
+	require.Equal(t, "ALTER TABLE `app_staging`.`users` ADD COLUMN `c` INT", newJobs[1].Query)
+
+	// the table not mapped is qualified with the source database.
+	require.Equal(t, "archive", newJobs[2].SchemaName)
+	require.Equal(t, "orders_old", newJobs[2].BinlogInfo.TableInfo.Name.O)
+	require.Equal(t, "RENAME TABLE `shop`.`orders_tmp` TO `archive`.`orders_old`", newJobs[2].Query)
+
+	// the job of the table not mapped is not changed.
+	require.Equal(t, "shop", newJobs[3].SchemaName)
+	require.Equal(t, jobs[3].Query, newJobs[3].Query)
+
+	// the original jobs are not changed.
+	require.Equal(t, "app", jobs[1].SchemaName)
+	require.Equal(t, "orders", jobs[2].BinlogInfo.TableInfo.Name.O)
+	require.Equal(t, "rename table orders_tmp to orders", jobs[2].Query)
+
+	jobs[3].Query = "alter table"
+	_, err = m.RewriteDDLJobs(jobs)
+	require.ErrorContains(t, err, "failed to rewrite ddl job 4")
+}
+
+func TestTableMappingRewriteBindSQL(t *testing.T) {
+	m, err := ParseTableMapping([]string{"app:app_staging", "shop.orders:archive.orders_old"})
+	require.NoError(t, err)
+	cases := []struct {
+		sql      string
+		expected string
+	}{
+		{
+			sql:      "SELECT /*+ use_index(@`sel_1` `app`.`users` `idx`)*/ * FROM `app`.`users` WHERE `app`.`users`.`id` = 1",
+			expected: "SELECT /*+ USE_INDEX(@`sel_1` `app_staging`.`users` `idx`)*/ * FROM `app_staging`.`users` WHERE `app_staging`.`users`.`id`=1",
+		},
+		{
+			sql:      "SELECT /*+ hash_join(`o`, `c`)*/ * FROM `shop`.`orders` AS `o` JOIN `shop`.`customers` AS `c` ON `o`.`cid` = `c`.`id`",
+			expected: "SELECT /*+ HASH_JOIN(`o`, `c`)*/ * FROM `archive`.`orders_old` AS `o` JOIN `shop`.`customers` AS `c` ON `o`.`cid`=`c`.`id`",
+		},
+		{
+			sql:      "SELECT /*+ use_index(`orders` `idx`)*/ `orders`.`id` FROM `orders`",
+			expected: "SELECT /*+ USE_INDEX(`orders_old` `idx`)*/ `orders_old`.`id` FROM `archive`.`orders_old`",
+		},
+		{
+			sql: "SELECT * FROM `shop`.`customers`",
+		},
+	}
+	for _, c := range cases {
+		sql, rewritten, err := m.rewriteSQL(c.sql, "shop", false)
+		require.NoError(t, err)
+		if c.expected == "" {
+			require.False(t, rewritten, c.sql)
+			require.Equal(t, c.sql, sql)
+		} else {
+			require.True(t, rewritten, c.sql)
+			require.Equal(t, c.expected, sql)
+		}
+	}
+}
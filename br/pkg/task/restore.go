@@ -31,9 +31,11 @@ import (
 	"github.com/pingcap/tidb/br/pkg/utils"
 	"github.com/pingcap/tidb/br/pkg/version"
 	"github.com/pingcap/tidb/pkg/config"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/mathutil"
+	filter "github.com/pingcap/tidb/pkg/util/table-filter"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/tikv/client-go/v2/tikv"
@@ -82,6 +84,9 @@ const (
 
 	FlagResetSysUsers = "reset-sys-users"
 
+	// FlagTableMapping maps the databases and tables in the backup to other names.
+	FlagTableMapping = "table-mapping"
+
 	defaultPiTRBatchCount     = 8
 	defaultPiTRBatchSize      = 16 * 1024 * 1024
 	defaultRestoreConcurrency = 128
@@ -245,6 +250,10 @@ type RestoreConfig struct {
 	checkpointTaskInfoClusterID       uint64 `json:"-" toml:"-"`
 	WaitTiflashReady                  bool   `json:"wait-tiflash-ready" toml:"wait-tiflash-ready"`
 
+	// TableMapping is the rules to restore the databases and tables with other
+	// names, see restore.ParseTableMapping.
+	TableMapping []string `json:"table-mapping" toml:"table-mapping"`
+
 	// for ebs-based restore
 	FullBackupType      FullBackupType        `json:"full-backup-type" toml:"full-backup-type"`
 	Prepare             bool                  `json:"prepare" toml:"prepare"`
@@ -274,6 +283,10 @@ func DefineRestoreFlags(flags *pflag.FlagSet) {
 
 	flags.Bool(FlagWaitTiFlashReady, false, "whether wait tiflash replica ready if tiflash exists")
 
+	flags.StringArray(FlagTableMapping, nil, "restore the databases or tables with other names, "+
+		"in the form of 'src-db:dst-db' or 'src-db.src-table:dst-db.dst-table'. "+
+		"The bindings of the mapped tables are restored if mysql.bind_info is in the backup")
+
 	DefineRestoreCommonFlags(flags)
 }
 
@@ -389,6 +402,17 @@ func (cfg *RestoreConfig) ParseFromFlags(flags *pflag.FlagSet) error {
 		return errors.Annotatef(err, "failed to get flag %s", FlagWaitTiFlashReady)
 	}
 
+	tableMapping, err := flags.GetStringArray(FlagTableMapping)
+	if err != nil {
+		return errors.Annotatef(err, "failed to get flag %s", FlagTableMapping)
+	}
+	if len(tableMapping) > 0 {
+		if _, err = restore.ParseTableMapping(tableMapping); err != nil {
+			return errors.Trace(err)
+		}
+		cfg.TableMapping = tableMapping
+	}
+
 	if flags.Lookup(flagFullBackupType) != nil {
 		// for restore full only
 		fullBackupType, err := flags.GetString(flagFullBackupType)
@@ -667,6 +691,9 @@ func RunRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 
 	var restoreError error
 	if IsStreamRestore(cmdName) {
+		if len(cfg.TableMapping) > 0 {
+			return errors.Annotatef(berrors.ErrInvalidArgument, "%s is not supported by point restore", FlagTableMapping)
+		}
 		restoreError = RunStreamRestore(c, g, cmdName, cfg)
 	} else {
 		restoreError = runRestore(c, g, cmdName, cfg)
@@ -713,6 +740,15 @@ func runRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 		ctx = opentracing.ContextWithSpan(ctx, span1)
 	}
 
+	tableMapping, err := restore.ParseTableMapping(cfg.TableMapping)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if tableMapping != nil {
+		// the bindings of the mapped tables are restored from mysql.bind_info.
+		cfg.TableFilter = withBindInfoFilter{Filter: cfg.TableFilter}
+	}
+
 	// Restore needs domain to do DDL.
 	needDomain := true
 	keepaliveCfg := GetKeepalive(&cfg.Config)
@@ -743,6 +779,7 @@ func runRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	if err != nil {
 		return errors.Trace(err)
 	}
+	client.SetTableMapping(tableMapping)
 	// Init DB connection sessions
 	err = client.Init(g, mgr.GetStorage())
 	defer client.Close()
@@ -904,6 +941,16 @@ func runRestore(c context.Context, g glue.Glue, cmdName string, cfg *RestoreConf
 	}
 	ddlJobs := restore.FilterDDLJobs(client.GetDDLJobs(), tables)
 	ddlJobs = restore.FilterDDLJobByRules(ddlJobs, restore.DDLJobBlockListRule)
+	if tableMapping != nil {
+		// the ddl jobs are filtered by the names in the backup, so rewrite them
+		// after filtering.
+		if dbs, tables, err = tableMapping.RewriteSchemas(dbs, tables); err != nil {
+			return errors.Trace(err)
+		}
+		if ddlJobs, err = tableMapping.RewriteDDLJobs(ddlJobs); err != nil {
+			return errors.Trace(err)
+		}
+	}
 
 	err = client.PreCheckTableTiFlashReplica(ctx, tables, cfg.tiflashRecorder)
 	if err != nil {
@@ -1214,6 +1261,20 @@ func filterRestoreFiles(
 	return
 }
 
+// withBindInfoFilter makes the filter match mysql.bind_info too.
+type withBindInfoFilter struct {
+	filter.Filter
+}
+
+func (f withBindInfoFilter) MatchTable(schema string, table string) bool {
+	return (strings.EqualFold(schema, mysql.SystemDB) && strings.EqualFold(table, "bind_info")) ||
+		f.Filter.MatchTable(schema, table)
+}
+
+func (f withBindInfoFilter) MatchSchema(schema string) bool {
+	return strings.EqualFold(schema, mysql.SystemDB) || f.Filter.MatchSchema(schema)
+}
+
 // restorePreWork executes some prepare work before restore.
 // TODO make this function returns a restore post work.
 func restorePreWork(ctx context.Context, client *restore.Client, mgr *conn.Mgr, switchToImport bool) (pdutil.UndoFunc, *pdutil.ClusterConfig, error) {
@@ -13,6 +13,7 @@ import (
 	"github.com/pingcap/kvproto/pkg/encryptionpb"
 	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/tidb/br/pkg/conn"
+	berrors "github.com/pingcap/tidb/br/pkg/errors"
 	"github.com/pingcap/tidb/br/pkg/metautil"
 	"github.com/pingcap/tidb/br/pkg/restore"
 	"github.com/pingcap/tidb/br/pkg/storage"
@@ -20,6 +21,8 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/statistics/handle/util"
 	"github.com/pingcap/tidb/pkg/tablecodec"
+	filter "github.com/pingcap/tidb/pkg/util/table-filter"
+	"github.com/spf13/pflag"
 	"github.com/stretchr/testify/require"
 	pd "github.com/tikv/pd/client"
 	"google.golang.org/grpc/keepalive"
@@ -309,3 +312,28 @@ func TestMapTableToFiles(t *testing.T) {
 	require.Equal(t, filesOfTable1, result[1])
 	require.Equal(t, filesOfTable2, result[2])
 }
+
+func TestRestoreTableMapping(t *testing.T) {
+	flags := &pflag.FlagSet{}
+	DefineCommonFlags(flags)
+	DefineRestoreFlags(flags)
+	cfg := &RestoreConfig{}
+	require.NoError(t, cfg.ParseFromFlags(flags))
+	require.Nil(t, cfg.TableMapping)
+
+	require.NoError(t, flags.Set(FlagTableMapping, "app:app_staging"))
+	require.NoError(t, flags.Set(FlagTableMapping, "shop.orders:archive.orders"))
+	require.NoError(t, cfg.ParseFromFlags(flags))
+	require.Equal(t, []string{"app:app_staging", "shop.orders:archive.orders"}, cfg.TableMapping)
+
+	require.NoError(t, flags.Set(FlagTableMapping, "mysql:mysql2"))
+	require.ErrorIs(t, cfg.ParseFromFlags(flags), berrors.ErrInvalidArgument)
+
+	f := withBindInfoFilter{Filter: filter.NewSchemasFilter("app")}
+	require.True(t, f.MatchSchema("app"))
+	require.True(t, f.MatchSchema("mysql"))
+	require.False(t, f.MatchSchema("shop"))
+	require.True(t, f.MatchTable("app", "t"))
+	require.True(t, f.MatchTable("mysql", "bind_info"))
+	require.False(t, f.MatchTable("mysql", "user"))
+}
@@ -48,6 +48,8 @@ const (
 	Builtin = "builtin"
 	// History indicate the binding is created from statement summary by plan digest
 	History = "history"
+	// Regression indicates the binding is created for the old plan of a plan regression automatically.
+	Regression = "regression"
 )
 
 // Binding stores the basic bind hint info.
@@ -94,6 +94,27 @@ func matchSQLBinding(sctx sessionctx.Context, stmtNode ast.StmtNode, info *Bindi
 	return
 }
 
+// MatchDigestHints returns the hints set by `ADMIN SET HINTS FOR DIGEST` for this statement.
+func MatchDigestHints(sctx sessionctx.Context) (hints []*ast.TableOptimizerHint, matched bool) {
+	vars := sctx.GetSessionVars()
+	if !vars.UsePlanBaselines || vars.InRestrictedSQL {
+		return
+	}
+	// When the domain is initializing, the bind will be nil.
+	if sctx.Value(SessionBindInfoKeyType) == nil {
+		return
+	}
+	globalHandle := GetGlobalBindingHandle(sctx)
+	if globalHandle == nil {
+		return
+	}
+	_, digest := vars.StmtCtx.SQLDigest()
+	if digest == nil {
+		return
+	}
+	return globalHandle.MatchGlobalDigestHints(digest.String(), vars.AppName)
+}
+
 func fuzzyMatchBindingTableName(currentDB string, stmtTableNames, bindingTableNames []*ast.TableName) (numWildcards int, matched bool) {
 	if len(stmtTableNames) != len(bindingTableNames) {
 		return 0, false
@@ -24,6 +24,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
 	utilparser "github.com/pingcap/tidb/pkg/util/parser"
+	"github.com/pingcap/tidb/pkg/util/planregression"
 	stmtsummaryv2 "github.com/pingcap/tidb/pkg/util/stmtsummary/v2"
 	tablefilter "github.com/pingcap/tidb/pkg/util/table-filter"
 	"go.uber.org/zap"
@@ -200,3 +201,50 @@ func (h *globalBindingHandle) CaptureBaselines() {
 		}
 	}
 }
+
+// CreateShadowBindings creates the disabled bindings for the old plans of the detected plan regressions,
+// so that the old plans can be pinned by enabling the bindings.
+func (h *globalBindingHandle) CreateShadowBindings() {
+	tasks := planregression.GlobalDetector.TakeShadowBindingTasks()
+	if len(tasks) == 0 {
+		return
+	}
+	p := parser.New()
+	for _, task := range tasks {
+		task.Finish(h.createShadowBinding(p, task))
+	}
+}
+
+func (h *globalBindingHandle) createShadowBinding(p *parser.Parser, task *planregression.ShadowBindingTask) string {
+	stmt, err := p.ParseOneStmt(task.OriginalSQL, task.Charset, task.Collation)
+	if err != nil {
+		logutil.BindLogger().Debug("parse SQL failed in creating shadow binding", zap.String("SQL", task.OriginalSQL), zap.Error(err))
+		return planregression.ShadowBindingFailed
+	}
+	dbName := utilparser.GetDefaultDB(stmt, task.Schema)
+	normalizedSQL, digest := parser.NormalizeDigestForBinding(utilparser.RestoreWithDefaultDB(stmt, dbName, task.OriginalSQL))
+	// Creating a binding replaces the existing ones of the statement, so don't touch the statements with bindings.
+	if r := h.getCache().GetBinding(digest.String()); len(r) > 0 {
+		return planregression.ShadowBindingSkipped
+	}
+	bindSQL := GenerateBindingSQL(stmt, task.PlanHint, true, dbName)
+	if bindSQL == "" {
+		return planregression.ShadowBindingFailed
+	}
+	binding := Binding{
+		OriginalSQL: normalizedSQL,
+		Db:          dbName,
+		BindSQL:     bindSQL,
+		Status:      Disabled,
+		Charset:     task.Charset,
+		Collation:   task.Collation,
+		Source:      Regression,
+		SQLDigest:   digest.String(),
+	}
+	// We don't need to pass the `sctx` because the BindSQL is generated from a valid plan.
+	if err = h.CreateGlobalBinding(nil, binding); err != nil {
+		logutil.BindLogger().Warn("create shadow binding failed", zap.String("SQL", task.OriginalSQL), zap.Error(err))
+		return planregression.ShadowBindingFailed
+	}
+	return planregression.ShadowBindingCreated
+}
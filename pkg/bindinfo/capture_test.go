@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/testkit"
 	utilparser "github.com/pingcap/tidb/pkg/util/parser"
+	"github.com/pingcap/tidb/pkg/util/planregression"
 	"github.com/pingcap/tidb/pkg/util/stmtsummary"
 	"github.com/stretchr/testify/require"
 	"go.opencensus.io/stats/view"
@@ -992,3 +993,63 @@ func TestCaptureHints(t *testing.T) {
 		require.Equal(t, res[0][9], sqlDigestWithDB.String())
 	}
 }
+
+func TestCreateShadowBindings(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, key(a))")
+
+	planregression.GlobalDetector.Clear()
+	defer planregression.GlobalDetector.Clear()
+	tk.MustExec("set global tidb_plan_regression_min_exec_count = 1")
+	tk.MustExec("set global tidb_enable_plan_regression_shadow_binding = on")
+	defer func() {
+		tk.MustExec("set global tidb_plan_regression_min_exec_count = default")
+		tk.MustExec("set global tidb_enable_plan_regression_shadow_binding = default")
+	}()
+	addPlan := func(sqlDigest, sql, planDigest, planHint string, latency time.Duration) {
+		planregression.GlobalDetector.Add(&planregression.StmtExecInfo{
+			SQLDigest:     sqlDigest,
+			NormalizedSQL: sql,
+			PlanDigest:    planDigest,
+			OriginalSQL:   sql,
+			Schema:        "test",
+			Charset:       "utf8mb4",
+			Collation:     "utf8mb4_bin",
+			PlanHintGen:   func() string { return planHint },
+			Latency:       latency,
+		})
+	}
+	addPlan("d1", "select * from t where a > 1", "p1", "use_index(@`sel_1` `test`.`t` `a`)", time.Millisecond)
+	addPlan("d1", "select * from t where a > 1", "p2", "use_index(@`sel_1` `test`.`t` )", time.Second)
+	tk.MustQuery("select old_plan_digest, new_plan_digest, old_exec_count, new_exec_count, shadow_binding from information_schema.plan_regression_events").
+		Check(testkit.Rows("p1 p2 1 1 pending"))
+
+	dom.BindHandle().CreateShadowBindings()
+	tk.MustQuery("select original_sql, bind_sql, status, source from mysql.bind_info where source = 'regression'").Check(testkit.Rows(
+		"select * from `test` . `t` where `a` > ? SELECT /*+ use_index(@`sel_1` `test`.`t` `a`)*/ * FROM `test`.`t` WHERE `a` > 1 disabled regression"))
+	tk.MustQuery("select shadow_binding from information_schema.plan_regression_events").Check(testkit.Rows("created"))
+	// The shadow binding doesn't affect the statement until it's enabled.
+	tk.MustExec("select * from t where a > 1")
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("0"))
+
+	// The statements with bindings are skipped, since creating a binding replaces the existing ones.
+	tk.MustExec("create global binding for select * from t where b > 1 using select /*+ use_index(t) */ * from t where b > 1")
+	addPlan("d2", "select * from t where b > 1", "p3", "use_index(@`sel_1` `test`.`t` )", time.Millisecond)
+	addPlan("d2", "select * from t where b > 1", "p4", "use_index(@`sel_1` `test`.`t` `a`)", time.Second)
+	tk.MustQuery("select new_plan_digest, shadow_binding from information_schema.plan_regression_events").Sort().
+		Check(testkit.Rows("p2 created", "p4 pending"))
+	dom.BindHandle().CreateShadowBindings()
+	tk.MustQuery("select new_plan_digest, shadow_binding from information_schema.plan_regression_events").Sort().
+		Check(testkit.Rows("p2 created", "p4 skipped"))
+	tk.MustQuery("select count(*) from mysql.bind_info where source = 'regression'").Check(testkit.Rows("1"))
+
+	// The user without the PROCESS privilege can only read the events of its own statements.
+	tk.MustExec("create user 'u1'@'%'")
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustQuery("select count(*) from information_schema.plan_regression_events").Check(testkit.Rows("0"))
+	tk.MustExec("grant process on *.* to 'u1'@'%'")
+	tk1.MustQuery("select count(*) from information_schema.plan_regression_events").Check(testkit.Rows("2"))
+}
@@ -0,0 +1,186 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bindinfo
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/bindinfo/internal/logutil"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/util/hint"
+	"go.uber.org/zap"
+)
+
+// digestHints is the hints set for a SQL digest by `ADMIN SET HINTS FOR DIGEST`.
+type digestHints struct {
+	hints []*ast.TableOptimizerHint
+	// expireTime is zero if the hints never expire.
+	expireTime time.Time
+}
+
+func (dh *digestHints) expired(now time.Time) bool {
+	return !dh.expireTime.IsZero() && !now.Before(dh.expireTime)
+}
+
+// ParseDigestHints parses the hints set by `ADMIN SET HINTS FOR DIGEST`.
+// The hints can be either wrapped by `/*+ */` or not.
+func ParseDigestHints(hintsStr string) ([]*ast.TableOptimizerHint, error) {
+	return hint.ParseHintsStr(hintsStr)
+}
+
+func normalizeDigest(digest string) string {
+	return strings.ToLower(strings.TrimSpace(digest))
+}
+
+// digestHintsKey identifies the hints set for a SQL digest.
+// The appName is empty if the hints are applied to all the applications.
+type digestHintsKey struct {
+	appName string
+	digest  string
+}
+
+func (h *globalBindingHandle) getDigestHints() map[digestHintsKey]*digestHints {
+	return *h.digestHints.Load()
+}
+
+// SetGlobalDigestHints sets the hints for the statements of the digest to the storage and the cache.
+// The hints are only applied to the connections whose `program_name` attribute is appName if it's not empty.
+// The hints never expire if the ttl is 0.
+func (h *globalBindingHandle) SetGlobalDigestHints(digest, appName, hintsStr string, ttl time.Duration) (err error) {
+	digest = normalizeDigest(digest)
+	if digest == "" {
+		return errors.New("sql digest is empty")
+	}
+	hints, err := ParseDigestHints(hintsStr)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err == nil {
+			err = h.LoadDigestHintsFromStorage()
+		}
+	}()
+
+	return h.callWithSCtx(false, func(sctx sessionctx.Context) error {
+		hintsStr := hint.RestoreOptimizerHints(hints)
+		switch {
+		case appName != "" && ttl > 0:
+			_, err = exec(sctx, `REPLACE INTO mysql.app_digest_hints (app_name, digest, hints, expire_time)
+				VALUES (%?, %?, %?, DATE_ADD(NOW(6), INTERVAL %? MICROSECOND))`,
+				appName, digest, hintsStr, ttl.Microseconds())
+		case appName != "":
+			_, err = exec(sctx, `REPLACE INTO mysql.app_digest_hints (app_name, digest, hints) VALUES (%?, %?, %?)`,
+				appName, digest, hintsStr)
+		case ttl > 0:
+			_, err = exec(sctx, `REPLACE INTO mysql.digest_hints (digest, hints, expire_time)
+				VALUES (%?, %?, DATE_ADD(NOW(6), INTERVAL %? MICROSECOND))`,
+				digest, hintsStr, ttl.Microseconds())
+		default:
+			_, err = exec(sctx, `REPLACE INTO mysql.digest_hints (digest, hints) VALUES (%?, %?)`,
+				digest, hintsStr)
+		}
+		return err
+	})
+}
+
+// DropGlobalDigestHints drops the hints for the statements of the digest from the storage and the cache.
+// Only the hints set for appName are dropped if it's not empty.
+func (h *globalBindingHandle) DropGlobalDigestHints(digest, appName string) (deletedRows uint64, err error) {
+	digest = normalizeDigest(digest)
+	if digest == "" {
+		return 0, errors.New("sql digest is empty")
+	}
+	defer func() {
+		if err == nil {
+			err = h.LoadDigestHintsFromStorage()
+		}
+	}()
+
+	err = h.callWithSCtx(false, func(sctx sessionctx.Context) error {
+		if appName != "" {
+			_, err = exec(sctx, `DELETE FROM mysql.app_digest_hints WHERE app_name = %? AND digest = %?`, appName, digest)
+		} else {
+			_, err = exec(sctx, `DELETE FROM mysql.digest_hints WHERE digest = %?`, digest)
+		}
+		if err != nil {
+			return err
+		}
+		deletedRows = sctx.GetSessionVars().StmtCtx.AffectedRows()
+		return nil
+	})
+	return
+}
+
+// MatchGlobalDigestHints returns the unexpired hints set for the statements of the digest.
+// The hints set for appName take precedence over the ones set for all the applications.
+func (h *globalBindingHandle) MatchGlobalDigestHints(digest, appName string) (hints []*ast.TableOptimizerHint, matched bool) {
+	cache, now := h.getDigestHints(), time.Now()
+	if appName != "" {
+		if dh, ok := cache[digestHintsKey{appName: appName, digest: digest}]; ok && !dh.expired(now) {
+			return dh.hints, true
+		}
+	}
+	dh, ok := cache[digestHintsKey{digest: digest}]
+	if !ok || dh.expired(now) {
+		return nil, false
+	}
+	return dh.hints, true
+}
+
+// LoadDigestHintsFromStorage loads all the unexpired digest hints from the storage into the cache.
+func (h *globalBindingHandle) LoadDigestHintsFromStorage() error {
+	return h.callWithSCtx(false, func(sctx sessionctx.Context) error {
+		// The remaining time is used instead of the expire time to avoid the time zone conversions.
+		rows, _, err := execRows(sctx, `SELECT '', digest, hints, TIMESTAMPDIFF(MICROSECOND, NOW(6), expire_time)
+			FROM mysql.digest_hints WHERE expire_time IS NULL OR expire_time > NOW(6)
+			UNION ALL
+			SELECT app_name, digest, hints, TIMESTAMPDIFF(MICROSECOND, NOW(6), expire_time)
+			FROM mysql.app_digest_hints WHERE expire_time IS NULL OR expire_time > NOW(6)`)
+		if err != nil {
+			return err
+		}
+		now := time.Now()
+		newCache := make(map[digestHintsKey]*digestHints, len(rows))
+		for _, row := range rows {
+			key := digestHintsKey{appName: row.GetString(0), digest: row.GetString(1)}
+			hintsStr := row.GetString(2)
+			hints, err := ParseDigestHints(hintsStr)
+			if err != nil {
+				logutil.BindLogger().Warn("failed to parse digest hints", zap.String("digest", key.digest),
+					zap.String("appName", key.appName), zap.String("hints", hintsStr), zap.Error(err))
+				continue
+			}
+			dh := &digestHints{hints: hints}
+			if !row.IsNull(3) {
+				dh.expireTime = now.Add(time.Duration(row.GetInt64(3)) * time.Microsecond)
+			}
+			newCache[key] = dh
+		}
+		h.digestHints.Store(&newCache)
+		return nil
+	})
+}
+
+// gcDigestHints physically removes the expired hints in mysql.digest_hints and mysql.app_digest_hints.
+func gcDigestHints(sctx sessionctx.Context) error {
+	if _, err := exec(sctx, `DELETE FROM mysql.digest_hints WHERE expire_time <= NOW(6)`); err != nil {
+		return err
+	}
+	_, err := exec(sctx, `DELETE FROM mysql.app_digest_hints WHERE expire_time <= NOW(6)`)
+	return err
+}
@@ -0,0 +1,149 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bindinfo_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/bindinfo"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseDigestHints(t *testing.T) {
+	hints, err := bindinfo.ParseDigestHints("/*+ use_index(t, ia), hash_join(t1) */")
+	require.NoError(t, err)
+	require.Len(t, hints, 2)
+	hints, err = bindinfo.ParseDigestHints(" use_index(t, ia) ")
+	require.NoError(t, err)
+	require.Len(t, hints, 1)
+	_, err = bindinfo.ParseDigestHints("use_index(t, ia")
+	require.Error(t, err)
+	_, err = bindinfo.ParseDigestHints("")
+	require.Error(t, err)
+}
+
+func TestDigestHints(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ia(a), index ib(b))")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+
+	_, digest := parser.NormalizeDigest("select * from t where a = 1 and b = 1")
+	checkIndex := func(sql, index string) {
+		tk.MustQuery(sql)
+		require.Equal(t, []string{index}, tk.Session().GetSessionVars().StmtCtx.IndexNames)
+	}
+
+	tk.MustExec(fmt.Sprintf("admin set hints for digest '%s' '/*+ use_index(t, ib) */'", digest))
+	tk.MustQuery("select digest, hints, expire_time from mysql.digest_hints").Check(testkit.Rows(digest.String() + " use_index(`t` `ib`) <nil>"))
+	checkIndex("select * from t where a = 1 and b = 1", "t:ib")
+	// the statements with the same digest share the hints.
+	checkIndex("select * from t where a = 2 and b = 3", "t:ib")
+
+	// the hints are replaced.
+	tk.MustExec(fmt.Sprintf("admin set hints for digest '%s' 'use_index(t, ia)'", digest))
+	checkIndex("select * from t where a = 1 and b = 1", "t:ia")
+
+	// the binding takes precedence over the hints set for the digest.
+	tk.MustExec("create global binding for select * from t where a = 1 and b = 1 using select /*+ use_index(t, ib) */ * from t where a = 1 and b = 1")
+	checkIndex("select * from t where a = 1 and b = 1", "t:ib")
+	tk.MustExec("drop global binding for select * from t where a = 1 and b = 1")
+	checkIndex("select * from t where a = 1 and b = 1", "t:ia")
+
+	// the hints are ignored if the plan baselines are disabled.
+	tk.MustExec("set @@tidb_use_plan_baselines = 0")
+	tk.MustQuery("select * from t where a = 1 and b = 1")
+	require.Len(t, tk.Session().GetSessionVars().StmtCtx.IndexNames, 1)
+	tk.MustExec("set @@tidb_use_plan_baselines = 1")
+
+	tk.MustGetErrMsg(fmt.Sprintf("admin set hints for digest '%s' 'use_index(t, ia'", digest),
+		"[parser:1064]Optimizer hint syntax error at line 1 column 22 near \"\" ")
+	tk.MustGetErrMsg(fmt.Sprintf("admin set hints for digest '%s' 'use_index(t, ia)' ttl interval 1 month", digest),
+		"MONTH is not a constant time interval and cannot be used here")
+	tk.MustGetErrMsg("admin set hints for digest '' 'use_index(t, ia)'", "sql digest is empty")
+
+	// the expired hints are not used and are removed by the GC.
+	tk.MustExec(fmt.Sprintf("admin set hints for digest '%s' 'use_index(t, ib)' ttl = interval 1 hour", digest))
+	tk.MustQuery("select count(*) from mysql.digest_hints where expire_time > now()").Check(testkit.Rows("1"))
+	checkIndex("select * from t where a = 1 and b = 1", "t:ib")
+	tk.MustExec("update mysql.digest_hints set expire_time = now(6) - interval 1 second")
+	require.NoError(t, dom.BindHandle().LoadDigestHintsFromStorage())
+	_, matched := dom.BindHandle().MatchGlobalDigestHints(digest.String(), "")
+	require.False(t, matched)
+	require.NoError(t, dom.BindHandle().GCGlobalBinding())
+	tk.MustQuery("select count(*) from mysql.digest_hints").Check(testkit.Rows("0"))
+
+	tk.MustExec(fmt.Sprintf("admin set hints for digest '%s' 'use_index(t, ib)'", digest))
+	tk.MustExec(fmt.Sprintf("admin unset hints for digest '%s'", digest))
+	require.Equal(t, uint64(1), tk.Session().GetSessionVars().StmtCtx.AffectedRows())
+	_, matched = dom.BindHandle().MatchGlobalDigestHints(digest.String(), "")
+	require.False(t, matched)
+	tk.MustExec(fmt.Sprintf("admin unset hints for digest '%s'", digest))
+	require.Equal(t, uint64(0), tk.Session().GetSessionVars().StmtCtx.AffectedRows())
+}
+
+func TestAppDigestHints(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ia(a), index ib(b))")
+
+	_, digest := parser.NormalizeDigest("select * from t where a = 1 and b = 1")
+	checkIndex := func(appName, index string) {
+		tk.Session().GetSessionVars().AppName = appName
+		tk.MustQuery("select * from t where a = 1 and b = 1")
+		require.Equal(t, []string{index}, tk.Session().GetSessionVars().StmtCtx.IndexNames)
+	}
+
+	tk.MustExec(fmt.Sprintf("admin set hints for digest '%s' 'use_index(t, ib)' for application 'app1'", digest))
+	tk.MustQuery("select app_name, digest, hints from mysql.app_digest_hints").Check(testkit.Rows("app1 " + digest.String() + " use_index(`t` `ib`)"))
+	tk.MustQuery("select count(*) from mysql.digest_hints").Check(testkit.Rows("0"))
+	checkIndex("app1", "t:ib")
+	hints, matched := dom.BindHandle().MatchGlobalDigestHints(digest.String(), "app2")
+	require.False(t, matched)
+	require.Nil(t, hints)
+
+	// the hints set for the application take precedence over the ones set for all the applications.
+	tk.MustExec(fmt.Sprintf("admin set hints for digest '%s' 'use_index(t, ia)'", digest))
+	checkIndex("app1", "t:ib")
+	checkIndex("app2", "t:ia")
+	checkIndex("", "t:ia")
+
+	// the hints of the other applications are kept when the hints of an application are unset.
+	tk.MustExec(fmt.Sprintf("admin set hints for digest '%s' 'use_index(t, ib)' for application 'app2'", digest))
+	tk.MustExec(fmt.Sprintf("admin unset hints for digest '%s' for application 'app1'", digest))
+	require.Equal(t, uint64(1), tk.Session().GetSessionVars().StmtCtx.AffectedRows())
+	checkIndex("app1", "t:ia")
+	checkIndex("app2", "t:ib")
+	tk.MustExec(fmt.Sprintf("admin unset hints for digest '%s'", digest))
+	checkIndex("app1", "t:ia")
+	_, matched = dom.BindHandle().MatchGlobalDigestHints(digest.String(), "app1")
+	require.False(t, matched)
+	_, matched = dom.BindHandle().MatchGlobalDigestHints(digest.String(), "app2")
+	require.True(t, matched)
+
+	// the expired hints of the applications are removed by the GC.
+	tk.MustExec(fmt.Sprintf("admin set hints for digest '%s' 'use_index(t, ib)' for application 'app1' ttl = interval 1 hour", digest))
+	tk.MustExec("update mysql.app_digest_hints set expire_time = now(6) - interval 1 second where app_name = 'app1'")
+	require.NoError(t, dom.BindHandle().LoadDigestHintsFromStorage())
+	_, matched = dom.BindHandle().MatchGlobalDigestHints(digest.String(), "app1")
+	require.False(t, matched)
+	require.NoError(t, dom.BindHandle().GCGlobalBinding())
+	tk.MustQuery("select app_name from mysql.app_digest_hints").Check(testkit.Rows("app2"))
+}
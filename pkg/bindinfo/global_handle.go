@@ -62,12 +62,32 @@ type GlobalBindingHandle interface {
 	// SetGlobalBindingStatus set a Bindings's status to the storage and bind cache.
 	SetGlobalBindingStatus(newStatus, sqlDigest string) (ok bool, err error)
 
+	// RenameIndexInGlobalBindings rewrites the hints of the global bindings which reference the renamed index.
+	RenameIndexInGlobalBindings(schema, table, oldIndex, newIndex string) (updatedRows int, err error)
+
 	// AddInvalidGlobalBinding adds Bindings which needs to be deleted into invalidBindingCache.
 	AddInvalidGlobalBinding(invalidBinding Binding)
 
 	// DropInvalidGlobalBinding executes the drop Bindings tasks.
 	DropInvalidGlobalBinding()
 
+	// Methods for the hints set by `ADMIN SET HINTS FOR DIGEST`.
+
+	// SetGlobalDigestHints sets the hints for the statements of the digest to the storage and the cache.
+	// The hints are only applied to the application appName if it's not empty. The hints never expire if the ttl is 0.
+	SetGlobalDigestHints(digest, appName, hints string, ttl time.Duration) (err error)
+
+	// DropGlobalDigestHints drops the hints for the statements of the digest and the application appName
+	// from the storage and the cache.
+	DropGlobalDigestHints(digest, appName string) (deletedRows uint64, err error)
+
+	// MatchGlobalDigestHints returns the unexpired hints set for the statements of the digest,
+	// the hints set for the application appName take precedence.
+	MatchGlobalDigestHints(digest, appName string) (hints []*ast.TableOptimizerHint, matched bool)
+
+	// LoadDigestHintsFromStorage loads all the unexpired digest hints from the storage into the cache.
+	LoadDigestHintsFromStorage() (err error)
+
 	// Methods for load and clear global sql bindings.
 
 	// Reset is to reset the BindHandle and clean old info.
@@ -76,7 +96,8 @@ type GlobalBindingHandle interface {
 	// LoadFromStorageToCache loads global bindings from storage to the memory cache.
 	LoadFromStorageToCache(fullLoad bool) (err error)
 
-	// GCGlobalBinding physically removes the deleted bind records in mysql.bind_info.
+	// GCGlobalBinding physically removes the deleted bind records in mysql.bind_info
+	// and the expired hints in mysql.digest_hints.
 	GCGlobalBinding() (err error)
 
 	// Methods for memory control.
@@ -104,6 +125,9 @@ type GlobalBindingHandle interface {
 	// CaptureBaselines is used to automatically capture plan baselines.
 	CaptureBaselines()
 
+	// CreateShadowBindings creates the disabled bindings for the old plans of the detected plan regressions.
+	CreateShadowBindings()
+
 	variable.Statistics
 }
 
@@ -123,6 +147,9 @@ type globalBindingHandle struct {
 
 	// syncBindingSingleflight is used to synchronize the execution of `LoadFromStorageToCache` method.
 	syncBindingSingleflight singleflight.Group
+
+	// digestHints caches the hints set by `ADMIN SET HINTS FOR DIGEST`, the key is the application name and the sql digest.
+	digestHints atomic.Pointer[map[digestHintsKey]*digestHints]
 }
 
 // Lease influences the duration of loading bind info and handling invalid bind.
@@ -169,6 +196,7 @@ func (h *globalBindingHandle) Reset() {
 	h.lastUpdateTime.Store(types.ZeroTimestamp)
 	h.invalidBindings = newInvalidBindingCache()
 	h.setCache(newFuzzyBindingCache(h.LoadBindingsFromStorage))
+	h.digestHints.Store(&map[digestHintsKey]*digestHints{})
 	variable.RegisterStatistics(h)
 }
 
@@ -377,7 +405,8 @@ func (h *globalBindingHandle) SetGlobalBindingStatus(newStatus, sqlDigest string
 	return
 }
 
-// GCGlobalBinding physically removes the deleted bind records in mysql.bind_info.
+// GCGlobalBinding physically removes the deleted bind records in mysql.bind_info
+// and the expired hints in mysql.digest_hints and mysql.app_digest_hints.
 func (h *globalBindingHandle) GCGlobalBinding() (err error) {
 	return h.callWithSCtx(true, func(sctx sessionctx.Context) error {
 		// Lock mysql.bind_info to synchronize with CreateBinding / AddBinding / DropBinding on other tidb instances.
@@ -390,7 +419,10 @@ func (h *globalBindingHandle) GCGlobalBinding() (err error) {
 		updateTime := time.Now().Add(-(10 * Lease))
 		updateTimeStr := types.NewTime(types.FromGoTime(updateTime), mysql.TypeTimestamp, 3).String()
 		_, err = exec(sctx, `DELETE FROM mysql.bind_info WHERE status = 'deleted' and update_time < %?`, updateTimeStr)
-		return err
+		if err != nil {
+			return err
+		}
+		return gcDigestHints(sctx)
 	})
 }
 
@@ -637,6 +669,7 @@ func (h *globalBindingHandle) Clear() {
 	h.setCache(newFuzzyBindingCache(h.LoadBindingsFromStorage))
 	h.setLastUpdateTime(types.ZeroTimestamp)
 	h.invalidBindings.reset()
+	h.digestHints.Store(&map[digestHintsKey]*digestHints{})
 }
 
 // FlushGlobalBindings flushes the Bindings in temp maps to storage and loads them into cache.
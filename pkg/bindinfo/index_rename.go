@@ -0,0 +1,171 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bindinfo
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/bindinfo/internal/logutil"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/hint"
+	utilparser "github.com/pingcap/tidb/pkg/util/parser"
+	"go.uber.org/zap"
+)
+
+// RenameIndexInGlobalBindings rewrites the index hints and the index related optimizer hints of the global bindings
+// which reference the renamed index, so that the bindings keep taking effect after `ALTER TABLE ... RENAME INDEX`.
+func (h *globalBindingHandle) RenameIndexInGlobalBindings(schema, table, oldIndex, newIndex string) (updatedRows int, err error) {
+	defer func() {
+		if err == nil && updatedRows > 0 {
+			err = h.LoadFromStorageToCache(false)
+		}
+	}()
+
+	err = h.callWithSCtx(true, func(sctx sessionctx.Context) error {
+		// Lock mysql.bind_info to synchronize with CreateBinding / AddBinding / DropBinding on other tidb instances.
+		if err = lockBindInfoTable(sctx); err != nil {
+			return err
+		}
+		// The LIKE condition is only a rough filter, the bindings are checked precisely after being parsed.
+		var rows []chunk.Row
+		rows, _, err = execRows(sctx, `SELECT original_sql, bind_sql, default_db, charset, collation FROM mysql.bind_info
+			WHERE status != %? AND source != %? AND LOWER(bind_sql) LIKE %?`, deleted, Builtin, "%"+strings.ToLower(oldIndex)+"%")
+		if err != nil {
+			return err
+		}
+		updateTs := types.NewTime(types.FromGoTime(time.Now()), mysql.TypeTimestamp, 3).String()
+		p := parser.New()
+		for _, row := range rows {
+			originalSQL, bindSQL, db := row.GetString(0), row.GetString(1), strings.ToLower(row.GetString(2))
+			stmt, err := p.ParseOneStmt(bindSQL, row.GetString(3), row.GetString(4))
+			if err != nil {
+				logutil.BindLogger().Warn("parse binding SQL failed when renaming index", zap.String("SQL", bindSQL), zap.Error(err))
+				continue
+			}
+			if !renameIndexInStmt(stmt, db, schema, table, oldIndex, newIndex) {
+				continue
+			}
+			newBindSQL := utilparser.RestoreWithDefaultDB(stmt, db, "")
+			if newBindSQL == "" {
+				continue
+			}
+			// The binding is replaced instead of updated in place, since the bindings are identified by their hints, and
+			// the cache of each tidb instance is updated by the bindings changed since the last load.
+			_, err = exec(sctx, `INSERT INTO mysql.bind_info (original_sql, bind_sql, default_db, status, create_time, update_time, charset, collation, source, sql_digest, plan_digest)
+				SELECT original_sql, %?, default_db, status, create_time, %?, charset, collation, source, sql_digest, plan_digest FROM mysql.bind_info
+				WHERE original_sql = %? AND bind_sql = %? AND status != %?`, newBindSQL, updateTs, originalSQL, bindSQL, deleted)
+			if err != nil {
+				return err
+			}
+			_, err = exec(sctx, `UPDATE mysql.bind_info SET status = %?, update_time = %? WHERE original_sql = %? AND bind_sql = %? AND status != %?`,
+				deleted, updateTs, originalSQL, bindSQL, deleted)
+			if err != nil {
+				return err
+			}
+			updatedRows++
+		}
+		return nil
+	})
+	return
+}
+
+// renameIndexInStmt renames the index in the index hints and the optimizer hints of the statement which reference
+// the table `schema`.`table`. It returns whether the statement is changed.
+func renameIndexInStmt(stmt ast.StmtNode, defaultDB, schema, table, oldIndex, newIndex string) bool {
+	r := &indexRenamer{
+		defaultDB: defaultDB,
+		schema:    strings.ToLower(schema),
+		table:     strings.ToLower(table),
+		oldIndex:  strings.ToLower(oldIndex),
+		newIndex:  model.NewCIStr(newIndex),
+		names:     map[string]struct{}{strings.ToLower(table): {}},
+	}
+	// The optimizer hints may reference the table by its alias, so collect the aliases first.
+	r.collectAliases = true
+	stmt.Accept(r)
+	r.collectAliases = false
+	stmt.Accept(r)
+	return r.changed
+}
+
+type indexRenamer struct {
+	defaultDB string
+	schema    string
+	table     string
+	oldIndex  string
+	newIndex  model.CIStr
+	// names is the table name and the aliases of the table in the statement.
+	names          map[string]struct{}
+	collectAliases bool
+	changed        bool
+}
+
+func (r *indexRenamer) matchSchema(schema string) bool {
+	if schema == "" {
+		schema = r.defaultDB
+	}
+	return strings.ToLower(schema) == r.schema
+}
+
+func (r *indexRenamer) renameIndexes(indexes []model.CIStr) {
+	for i := range indexes {
+		if indexes[i].L == r.oldIndex {
+			indexes[i] = r.newIndex
+			r.changed = true
+		}
+	}
+}
+
+// Enter implements Visitor interface.
+func (r *indexRenamer) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.TableSource:
+		tn, ok := x.Source.(*ast.TableName)
+		if r.collectAliases && ok && x.AsName.L != "" && tn.Name.L == r.table && r.matchSchema(tn.Schema.O) {
+			r.names[x.AsName.L] = struct{}{}
+		}
+	case *ast.TableName:
+		if !r.collectAliases && x.Name.L == r.table && r.matchSchema(x.Schema.O) {
+			for _, indexHint := range x.IndexHints {
+				r.renameIndexes(indexHint.IndexNames)
+			}
+		}
+	case *ast.TableOptimizerHint:
+		if r.collectAliases || len(x.Tables) == 0 {
+			break
+		}
+		switch x.HintName.L {
+		case hint.HintUseIndex, hint.HintIgnoreIndex, hint.HintForceIndex, hint.HintOrderIndex, hint.HintNoOrderIndex, hint.HintIndexMerge,
+			hint.HintIndex, hint.HintNoIndex, hint.HintGroupIndex, hint.HintNoGroupIndex, hint.HintJoinIndex, hint.HintNoJoinIndex,
+			hint.HintLooseIndexScan:
+			if _, ok := r.names[x.Tables[0].TableName.L]; ok && r.matchSchema(x.Tables[0].DBName.O) {
+				r.renameIndexes(x.Indexes)
+			}
+		}
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (*indexRenamer) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
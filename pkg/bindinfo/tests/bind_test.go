@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/tidb/pkg/bindinfo"
@@ -861,6 +862,24 @@ func TestJoinOrderHintWithBinding(t *testing.T) {
 	tk.MustExec("drop global binding for select * from t1 join t2 on t1.a=t2.a join t3 on t2.b=t3.b")
 }
 
+func TestQueryBlockNamesInBinding(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int, key(a));")
+	tk.MustExec("create table t2(a int, b int, key(a));")
+
+	// The query block names defined by qb_name are replaced by the default ones, so the hints still refer to the right
+	// query blocks after they are bound to the statement without the qb_name hints.
+	tk.MustExec("create global binding for select * from t1, (select * from t2) t2 where t1.a = t2.a using " +
+		"select /*+ merge_join(t1, t2@qb) */ * from t1, (select /*+ qb_name(qb) */ * from t2) t2 where t1.a = t2.a")
+	tk.MustHavePlan("select * from t1, (select * from t2) t2 where t1.a = t2.a", "MergeJoin")
+	tk.MustExec("select * from t1, (select * from t2) t2 where t1.a = t2.a")
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+}
+
 func TestNormalizeStmtForBinding(t *testing.T) {
 	tests := []struct {
 		sql        string
@@ -1112,3 +1131,36 @@ func TestFuzzyBindingHintsWithSourceReturning(t *testing.T) {
 		}
 	}
 }
+
+func TestRenameIndexInBindings(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ia(a), index ib(b))")
+	tk.MustExec("create table t2(a int, b int, index ib(b))")
+	tk.MustExec("create global binding for select * from t where a = 1 and b = 1 using select * from t use index(ib) where a = 1 and b = 1")
+	tk.MustExec("create global binding for select * from t t1 where b > 1 using select /*+ use_index(t1, ib) */ * from t t1 where b > 1")
+	tk.MustExec("create global binding for select * from t2 where b = 1 using select * from t2 use index(ib) where b = 1")
+
+	tk.MustExec("alter table t rename index ib to ib_new")
+	rows := tk.MustQuery("show global bindings").Rows()
+	require.Len(t, rows, 3)
+	for _, row := range rows {
+		bindSQL := row[1].(string)
+		if strings.Contains(bindSQL, "`t2`") {
+			// The bindings of the other tables are not touched.
+			require.Contains(t, bindSQL, "`ib`")
+		} else {
+			require.Contains(t, bindSQL, "`ib_new`")
+			require.NotContains(t, bindSQL, "`ib`")
+		}
+	}
+
+	// The rewritten bindings still take effect.
+	tk.MustQuery("select * from t where a = 1 and b = 1")
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+	require.True(t, tk.MustUseIndex("select * from t where a = 1 and b = 1", "ib_new(b)"))
+	tk.MustQuery("select * from t t1 where b > 1")
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+	require.True(t, tk.MustUseIndex("select * from t t1 where b > 1", "ib_new(b)"))
+}
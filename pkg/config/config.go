@@ -487,6 +487,8 @@ type Log struct {
 	File logutil.FileLogConfig `toml:"file" json:"file"`
 
 	SlowQueryFile string `toml:"slow-query-file" json:"slow-query-file"`
+	// SlowQueryFormat is the format of the slow query log, one of text or json.
+	SlowQueryFormat string `toml:"slow-query-format" json:"slow-query-format"`
 	// ExpensiveThreshold is deprecated.
 	ExpensiveThreshold uint `toml:"expensive-threshold" json:"expensive-threshold"`
 
@@ -941,6 +943,7 @@ var defaultConf = Config{
 		Format:              "text",
 		File:                logutil.NewFileLogConfig(logutil.DefaultLogMaxSize),
 		SlowQueryFile:       "tidb-slow.log",
+		SlowQueryFormat:     logutil.SlowLogFormatText,
 		SlowThreshold:       logutil.DefaultSlowThreshold,
 		ExpensiveThreshold:  10000, // ExpensiveThreshold is deprecated.
 		DisableErrorStack:   nbUnset,
@@ -1348,6 +1351,9 @@ func (c *Config) Valid() error {
 	if c.IndexLimit < DefIndexLimit || c.IndexLimit > DefMaxOfIndexLimit {
 		return fmt.Errorf("index-limit should be [%d, %d]", DefIndexLimit, DefMaxOfIndexLimit)
 	}
+	if c.Log.SlowQueryFormat != logutil.SlowLogFormatText && c.Log.SlowQueryFormat != logutil.SlowLogFormatJSON {
+		return fmt.Errorf("invalid slow-query-format=%s, valid formats=[%s, %s]", c.Log.SlowQueryFormat, logutil.SlowLogFormatText, logutil.SlowLogFormatJSON)
+	}
 	if c.Log.File.MaxSize > MaxLogFileSize {
 		return fmt.Errorf("invalid max log file size=%v which is larger than max=%v", c.Log.File.MaxSize, MaxLogFileSize)
 	}
@@ -1450,10 +1456,12 @@ var TableLockDelayClean = func() uint64 {
 
 // ToLogConfig converts *Log to *logutil.LogConfig.
 func (l *Log) ToLogConfig() *logutil.LogConfig {
-	return logutil.NewLogConfig(l.Level, l.Format, l.SlowQueryFile, l.GeneralLogFile, l.File, l.getDisableTimestamp(),
+	cfg := logutil.NewLogConfig(l.Level, l.Format, l.SlowQueryFile, l.GeneralLogFile, l.File, l.getDisableTimestamp(),
 		func(config *zaplog.Config) { config.DisableErrorVerbose = l.getDisableErrorStack() },
 		func(config *zaplog.Config) { config.Timeout = l.Timeout },
 	)
+	cfg.SlowQueryFormat = l.SlowQueryFormat
+	return cfg
 }
 
 // ToTracingConfig converts *OpenTracing to *tracing.Configuration.
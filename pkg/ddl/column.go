@@ -80,7 +80,9 @@ func checkAddColumn(t *meta.Meta, job *model.Job) (*model.TableInfo, *model.Colu
 	pos := &ast.ColumnPosition{}
 	offset := 0
 	ifNotExists := false
-	err = job.DecodeArgs(col, pos, &offset, &ifNotExists)
+	// The check constraints are only decoded to be kept in the job args, see addColumnCheckConstraints.
+	var constraints []*model.ConstraintInfo
+	err = job.DecodeArgs(col, pos, &offset, &ifNotExists, &constraints)
 	if err != nil {
 		job.State = model.JobStateCancelled
 		return nil, nil, nil, nil, false, errors.Trace(err)
@@ -104,7 +106,35 @@ func checkAddColumn(t *meta.Meta, job *model.Job) (*model.TableInfo, *model.Colu
 	return tblInfo, columnInfo, col, pos, false, nil
 }
 
-func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+// addColumnCheckConstraints returns the check constraints defined on the column added by the job, which are the fifth
+// argument of the job.
+func addColumnCheckConstraints(job *model.Job) []*model.ConstraintInfo {
+	if len(job.Args) < 5 {
+		return nil
+	}
+	constraints, ok := job.Args[4].(*[]*model.ConstraintInfo)
+	if !ok {
+		return nil
+	}
+	return *constraints
+}
+
+// checkAddColumnCheckConstraints checks the names of the check constraints defined on the added column don't exist,
+// since they may be added by other jobs after the job is submitted.
+func checkAddColumnCheckConstraints(t *meta.Meta, job *model.Job, tblInfo *model.TableInfo) error {
+	constraints := addColumnCheckConstraints(job)
+	if len(constraints) == 0 {
+		return nil
+	}
+	for _, constr := range constraints {
+		if tblInfo.FindConstraintInfoByName(constr.Name.L) != nil {
+			return infoschema.ErrCheckConstraintDupName.GenWithStackByArgs(constr.Name.L)
+		}
+	}
+	return checkConstraintNamesNotExists(t, job.SchemaID, constraints)
+}
+
+func onAddColumn(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
 	// Handle the rolling back job.
 	if job.IsRollingback() {
 		ver, err = onDropColumn(d, t, job)
@@ -137,6 +167,10 @@ func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error)
 			job.State = model.JobStateCancelled
 			return ver, errors.Trace(err)
 		}
+		if err = checkAddColumnCheckConstraints(t, job, tblInfo); err != nil {
+			job.State = model.JobStateCancelled
+			return ver, errors.Trace(err)
+		}
 	}
 
 	originalState := columnInfo.State
@@ -167,8 +201,16 @@ func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error)
 		}
 		// Update the job state when all affairs done.
 		job.SchemaState = model.StateWriteReorganization
+		// Initialize SnapshotVer to 0 for later reorganization check.
+		job.SnapshotVer = 0
 		job.MarkNonRevertible()
 	case model.StateWriteReorganization:
+		if isPerRowDefaultExpr(columnInfo) {
+			done, ver, err := doReorgWorkForAddColumn(w, d, t, job, tblInfo, columnInfo)
+			if !done {
+				return ver, err
+			}
+		}
 		// reorganization -> public
 		// Adjust table column offset.
 		offset, err := LocateOffsetToMove(columnInfo.Offset, pos, tblInfo)
@@ -177,6 +219,15 @@ func onAddColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error)
 		}
 		tblInfo.MoveColumnInfo(columnInfo.Offset, offset)
 		columnInfo.State = model.StatePublic
+		// The check constraints of the column become public together with the column. The existing rows are
+		// filled with the default value, which has been verified before running the job, and the new rows can't
+		// write the column until now, so the constraints are satisfied by all the rows.
+		for _, constr := range addColumnCheckConstraints(job) {
+			constr.ID = allocateConstraintID(tblInfo)
+			constr.State = model.StatePublic
+			constr.Validated = constr.Enforced
+			tblInfo.Constraints = append(tblInfo.Constraints, constr)
+		}
 		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, originalState != columnInfo.State)
 		if err != nil {
 			return ver, errors.Trace(err)
@@ -352,6 +403,12 @@ func checkDropColumn(d *ddlCtx, t *meta.Meta, job *model.Job) (*model.TableInfo,
 	if err = checkDropColumnWithTTLConfig(tblInfo, colName.L); err != nil {
 		return nil, nil, nil, false, errors.Trace(err)
 	}
+	if err = checkDropColumnWithRowPolicy(tblInfo, colName.L); err != nil {
+		return nil, nil, nil, false, errors.Trace(err)
+	}
+	if err = checkDropColumnWithMaskingPolicy(tblInfo, colName.L); err != nil {
+		return nil, nil, nil, false, errors.Trace(err)
+	}
 	idxInfos := listIndicesWithColumn(colName.L, tblInfo.Indices)
 	return tblInfo, colInfo, idxInfos, false, nil
 }
@@ -882,6 +939,69 @@ func doReorgWorkForModifyColumn(w *worker, d *ddlCtx, t *meta.Meta, job *model.J
 	return true, ver, nil
 }
 
+// doReorgWorkForAddColumn backfills the existing rows with the values of the per-row default expression of the
+// added column. The rows written after the column becomes write-only have their own values and are skipped.
+func doReorgWorkForAddColumn(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job,
+	tblInfo *model.TableInfo, columnInfo *model.ColumnInfo) (done bool, ver int64, err error) {
+	if job.ReorgMeta == nil {
+		job.ReorgMeta = &model.DDLReorgMeta{
+			SQLMode:       job.SQLMode,
+			Warnings:      make(map[errors.ErrorID]*terror.Error),
+			WarningsCount: make(map[errors.ErrorID]int64),
+			Location:      &model.TimeZoneLocation{Name: time.UTC.String(), Offset: 0},
+			Version:       model.CurrentReorgMetaVersion,
+		}
+	}
+	job.ReorgMeta.ReorgTp = model.ReorgTypeTxn
+	sctx, err1 := w.sessPool.Get()
+	if err1 != nil {
+		return false, ver, errors.Trace(err1)
+	}
+	defer w.sessPool.Put(sctx)
+	rh := newReorgHandler(sess.NewSession(sctx))
+	dbInfo, err := t.GetDatabase(job.SchemaID)
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	tbl, err := getTable((*asAutoIDRequirement)(d), dbInfo.ID, tblInfo)
+	if err != nil {
+		return false, ver, errors.Trace(err)
+	}
+	reorgInfo, err := getReorgInfo(d.jobContext(job.ID, job.ReorgMeta),
+		d, rh, job, dbInfo, tbl, BuildElements(columnInfo, nil), false)
+	if err != nil || reorgInfo == nil || reorgInfo.first {
+		// If we run reorg firstly, we should update the job snapshot version
+		// and then run the reorg next time.
+		return false, ver, errors.Trace(err)
+	}
+	err = w.runReorgJob(reorgInfo, tbl.Meta(), d.lease, func() (addColumnErr error) {
+		defer util.Recover(metrics.LabelDDL, "onAddColumn",
+			func() {
+				addColumnErr = dbterror.ErrCancelledDDLJob.GenWithStack("add table `%v` column `%v` panic", tbl.Meta().Name, columnInfo.Name)
+			}, false)
+		return w.updatePhysicalTableRow(tbl, reorgInfo)
+	})
+	if err != nil {
+		if dbterror.ErrPausedDDLJob.Equal(err) || dbterror.ErrWaitReorgTimeout.Equal(err) {
+			return false, ver, nil
+		}
+		if kv.IsTxnRetryableError(err) || dbterror.ErrNotOwner.Equal(err) {
+			return false, ver, errors.Trace(err)
+		}
+		if err1 := rh.RemoveDDLReorgHandle(job, reorgInfo.elements); err1 != nil {
+			logutil.DDLLogger().Warn("run add column job failed, RemoveDDLReorgHandle failed, can't convert job to rollback",
+				zap.String("job", job.String()), zap.Error(err1))
+		}
+		logutil.DDLLogger().Warn("run add column job failed, convert job to rollback", zap.Stringer("job", job), zap.Error(err))
+		ver, err1 := rollingbackAddColumn(d, t, job)
+		if err1 != nil && !dbterror.ErrCancelledDDLJob.Equal(err1) {
+			return false, ver, errors.Trace(err1)
+		}
+		return false, ver, errors.Trace(err)
+	}
+	return true, ver, nil
+}
+
 func adjustTableInfoAfterModifyColumnWithData(tblInfo *model.TableInfo, pos *ast.ColumnPosition,
 	oldCol, changingCol *model.ColumnInfo, newName model.CIStr, changingIdxs []*model.IndexInfo) (err error) {
 	if pos != nil && pos.RelativeColumn != nil && oldCol.Name.L == pos.RelativeColumn.Name.L {
@@ -898,6 +1018,7 @@ func adjustTableInfoAfterModifyColumnWithData(tblInfo *model.TableInfo, pos *ast
 	if tblInfo.TTLInfo != nil {
 		updateTTLInfoWhenModifyColumn(tblInfo, oldCol.Name, changingCol.Name)
 	}
+	updateMaskingPoliciesWhenModifyColumn(tblInfo, oldCol.Name, changingCol.Name)
 	// Move the new column to a correct offset.
 	destOffset, err := LocateOffsetToMove(changingCol.Offset, pos, tblInfo)
 	if err != nil {
@@ -980,6 +1101,10 @@ func updateTTLInfoWhenModifyColumn(tblInfo *model.TableInfo, oldCol, newCol mode
 		if tblInfo.TTLInfo.ColumnName.L == oldCol.L {
 			tblInfo.TTLInfo.ColumnName = newCol
 		}
+		if ttlConditionReferColumn(tblInfo.TTLInfo, oldCol.L) {
+			// The condition has been validated when it's set, so it should always be parsed successfully.
+			terror.Log(renameColumnInTTLCondition(tblInfo.TTLInfo, oldCol, newCol))
+		}
 	}
 }
 
@@ -1087,6 +1212,8 @@ func (w *worker) updatePhysicalTableRow(t table.Table, reorgInfo *reorgInfo) err
 				model.ActionRemovePartitioning,
 				model.ActionAlterTablePartitioning:
 				// Expected
+			case model.ActionAddColumn:
+				workType = typeUpdateColumnWorker
 			default:
 				// workType = typeUpdateColumnWorker
 				// TODO: Support Modify Column on partitioned table
@@ -1219,7 +1346,10 @@ func newUpdateColumnWorker(sessCtx sessionctx.Context, id int, t table.PhysicalT
 	for _, col := range t.WritableCols() {
 		if col.ID == reorgInfo.currElement.ID {
 			newCol = col.ColumnInfo
-			oldCol = table.FindCol(t.Cols(), getChangingColumnOriginName(newCol)).ColumnInfo
+			// The added column is filled with its default value instead of the value of an old column.
+			if reorgInfo.Job.Type != model.ActionAddColumn {
+				oldCol = table.FindCol(t.Cols(), getChangingColumnOriginName(newCol)).ColumnInfo
+			}
 			break
 		}
 	}
@@ -1232,7 +1362,7 @@ func newUpdateColumnWorker(sessCtx sessionctx.Context, id int, t table.PhysicalT
 	})
 	// We use global `EnableRowLevelChecksum` to detect whether checksum is enabled in ddl backfill worker because
 	// `SessionVars.IsRowLevelChecksumEnabled` will filter out internal sessions.
-	if variable.EnableRowLevelChecksum.Load() {
+	if variable.EnableRowLevelChecksum.Load() && oldCol != nil {
 		if numNonPubCols := len(t.DeletableCols()) - len(t.Cols()); numNonPubCols > 1 {
 			cols := make([]*model.ColumnInfo, len(t.DeletableCols()))
 			for i, col := range t.DeletableCols() {
@@ -1332,7 +1462,18 @@ func (w *updateColumnWorker) getRowRecord(handle kv.Handle, recordKey []byte, ra
 		return errors.Trace(dbterror.ErrCantDecodeRecord.GenWithStackByArgs("column", err))
 	}
 
-	if _, ok := w.rowMap[w.newColInfo.ID]; ok {
+	if w.oldColInfo == nil {
+		// The decoder fills the origin default value of the added column in the rows without the column, so the
+		// raw row is checked to skip the rows written after the column becomes writable.
+		vals, err := tablecodec.DecodeRowToDatumMap(rawRow, map[int64]*types.FieldType{w.newColInfo.ID: &w.newColInfo.FieldType}, sysTZ)
+		if err != nil {
+			return errors.Trace(dbterror.ErrCantDecodeRecord.GenWithStackByArgs("column", err))
+		}
+		if _, ok := vals[w.newColInfo.ID]; ok {
+			w.cleanRowMap()
+			return nil
+		}
+	} else if _, ok := w.rowMap[w.newColInfo.ID]; ok {
 		// The column is already added by update or insert statement, skip it.
 		w.cleanRowMap()
 		return nil
@@ -1347,17 +1488,26 @@ func (w *updateColumnWorker) getRowRecord(handle kv.Handle, recordKey []byte, ra
 		oldWarn = oldWarn[:0]
 	}
 	w.sessCtx.GetSessionVars().StmtCtx.SetWarnings(oldWarn)
-	val := w.rowMap[w.oldColInfo.ID]
-	col := w.newColInfo
-	if val.Kind() == types.KindNull && col.FieldType.GetType() == mysql.TypeTimestamp && mysql.HasNotNullFlag(col.GetFlag()) {
-		if v, err := expression.GetTimeCurrentTimestamp(w.sessCtx.GetExprCtx().GetEvalCtx(), col.GetType(), col.GetDecimal()); err == nil {
-			// convert null value to timestamp should be substituted with current timestamp if NOT_NULL flag is set.
-			w.rowMap[w.oldColInfo.ID] = v
+	var newColVal types.Datum
+	if w.oldColInfo == nil {
+		// The default expression of the added column is evaluated for each row.
+		newColVal, err = table.GetColDefaultValue(w.sessCtx.GetExprCtx(), w.newColInfo)
+		if err != nil {
+			return errors.Trace(err)
+		}
+	} else {
+		val := w.rowMap[w.oldColInfo.ID]
+		col := w.newColInfo
+		if val.Kind() == types.KindNull && col.FieldType.GetType() == mysql.TypeTimestamp && mysql.HasNotNullFlag(col.GetFlag()) {
+			if v, err := expression.GetTimeCurrentTimestamp(w.sessCtx.GetExprCtx().GetEvalCtx(), col.GetType(), col.GetDecimal()); err == nil {
+				// convert null value to timestamp should be substituted with current timestamp if NOT_NULL flag is set.
+				w.rowMap[w.oldColInfo.ID] = v
+			}
+		}
+		newColVal, err = table.CastValue(w.sessCtx, w.rowMap[w.oldColInfo.ID], w.newColInfo, false, false)
+		if err != nil {
+			return w.reformatErrors(err)
 		}
-	}
-	newColVal, err := table.CastValue(w.sessCtx, w.rowMap[w.oldColInfo.ID], w.newColInfo, false, false)
-	if err != nil {
-		return w.reformatErrors(err)
 	}
 	warn := w.sessCtx.GetSessionVars().StmtCtx.GetWarnings()
 	if len(warn) != 0 {
@@ -1432,6 +1582,9 @@ func (w *updateColumnWorker) calcChecksums() []uint32 {
 
 // reformatErrors casted error because `convertTo` function couldn't package column name and datum value for some errors.
 func (w *updateColumnWorker) reformatErrors(err error) error {
+	if w.oldColInfo == nil {
+		return err
+	}
 	// Since row count is not precious in concurrent reorganization, here we substitute row count with datum value.
 	if types.ErrTruncated.Equal(err) || types.ErrDataTooLong.Equal(err) {
 		dStr := datumToStringNoErr(w.rowMap[w.oldColInfo.ID])
@@ -1609,6 +1762,7 @@ func adjustTableInfoAfterModifyColumn(
 	updateNewIdxColsNameOffset(tblInfo.Indices, oldCol.Name, newCol)
 	updateFKInfoWhenModifyColumn(tblInfo, oldCol.Name, newCol.Name)
 	updateTTLInfoWhenModifyColumn(tblInfo, oldCol.Name, newCol.Name)
+	updateMaskingPoliciesWhenModifyColumn(tblInfo, oldCol.Name, newCol.Name)
 	return nil
 }
 
@@ -1655,18 +1809,47 @@ func adjustForeignKeyChildTableInfoAfterModifyColumn(d *ddlCtx, t *meta.Meta, jo
 }
 
 func checkAndApplyAutoRandomBits(d *ddlCtx, m *meta.Meta, dbInfo *model.DBInfo, tblInfo *model.TableInfo,
-	oldCol *model.ColumnInfo, newCol *model.ColumnInfo, newAutoRandBits uint64) error {
+	oldCol *model.ColumnInfo, newCol *model.ColumnInfo, encodedAutoRandBits uint64) error {
+	newAutoRandBits, newRangeBits := decodeAutoRandomBits(encodedAutoRandBits)
 	if newAutoRandBits == 0 {
 		return nil
 	}
+	if newRangeBits == 0 {
+		newRangeBits = tblInfo.AutoRandomRangeBits
+	}
 	idAcc := m.GetAutoIDAccessors(dbInfo.ID, tblInfo.ID)
-	err := checkNewAutoRandomBits(idAcc, oldCol, newCol, newAutoRandBits, tblInfo.AutoRandomRangeBits, tblInfo.SepAutoInc())
+	if tblInfo.AutoRandomBits > 0 && rangeBitsIsChanged(tblInfo.AutoRandomRangeBits, newRangeBits) {
+		// The range bits is changed, move the incremental ID after the ID space of the old layout,
+		// see checkAutoRandom.
+		oldFmt := autoid.NewShardIDFormat(&oldCol.FieldType, tblInfo.AutoRandomBits, tblInfo.AutoRandomRangeBits)
+		if err := rebaseAutoRandomOutOfIDSpace(idAcc.RandomID(), oldFmt.IncrementalBits+oldFmt.ShardBits); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	err := checkNewAutoRandomBits(idAcc, oldCol, newCol, newAutoRandBits, newRangeBits, tblInfo.SepAutoInc())
 	if err != nil {
 		return err
 	}
+	tblInfo.AutoRandomRangeBits = newRangeBits
 	return applyNewAutoRandomBits(d, m, dbInfo, tblInfo, oldCol, newAutoRandBits)
 }
 
+// rebaseAutoRandomOutOfIDSpace rebases the incremental ID of auto_random to the max ID of the given bits, so the IDs
+// allocated later never conflict with the existing IDs.
+func rebaseAutoRandomOutOfIDSpace(idAcc meta.AutoIDAccessor, idBits uint64) error {
+	maxID := int64(1<<idBits - 1)
+	// Generate a new auto ID first to prevent concurrent update in DML.
+	currentID, err := idAcc.Inc(1)
+	if err != nil {
+		return err
+	}
+	if currentID >= maxID {
+		return nil
+	}
+	_, err = idAcc.Inc(maxID - currentID)
+	return err
+}
+
 // checkNewAutoRandomBits checks whether the new auto_random bits number can cause overflow.
 func checkNewAutoRandomBits(idAccessors meta.AutoIDAccessors, oldCol *model.ColumnInfo,
 	newCol *model.ColumnInfo, newShardBits, newRangeBits uint64, sepAutoInc bool) error {
@@ -1987,18 +2170,14 @@ func generateOriginDefaultValue(col *model.ColumnInfo, ctx sessionctx.Context) (
 		return odValue, nil
 	}
 
+	// It's for add column. The default expression is evaluated once, and the result is used as the value of all
+	// the existing rows, which is filled in when the rows are read, so the existing rows don't need to be rewritten.
+	// If the expression returns a different value for each row, e.g. rand() and uuid(), the existing rows are
+	// backfilled with their own values before the column becomes public, see isPerRowDefaultExpr.
 	if col.DefaultIsExpr && ctx != nil {
-		valStr, ok := odValue.(string)
-		if !ok {
+		if _, ok := odValue.(string); !ok {
 			return nil, dbterror.ErrDefValGeneratedNamedFunctionIsNotAllowed.GenWithStackByArgs(col.Name.String())
 		}
-		oldValue := strings.ToLower(valStr)
-		// It's checked in getFuncCallDefaultValue.
-		if !strings.Contains(oldValue, fmt.Sprintf("%s(%s(),", ast.DateFormat, ast.Now)) &&
-			!strings.Contains(oldValue, ast.StrToDate) {
-			return nil, errors.Trace(dbterror.ErrBinlogUnsafeSystemFunction)
-		}
-
 		defVal, err := table.GetColDefaultValue(ctx.GetExprCtx(), col)
 		if err != nil {
 			return nil, errors.Trace(err)
@@ -2011,6 +2190,26 @@ func generateOriginDefaultValue(col *model.ColumnInfo, ctx sessionctx.Context) (
 	return odValue, nil
 }
 
+// isPerRowDefaultExpr returns whether the default expression of the column returns a different value for each row.
+// The existing rows can't share a single origin default value of such a column, so they are backfilled one by one
+// when the column is added.
+func isPerRowDefaultExpr(col *model.ColumnInfo) bool {
+	if !col.DefaultIsExpr {
+		return false
+	}
+	valStr, ok := col.GetDefaultValue().(string)
+	if !ok {
+		return false
+	}
+	valStr = strings.ToLower(valStr)
+	for _, fn := range []string{ast.Rand, ast.UUID, ast.UUIDToBin} {
+		if strings.Contains(valStr, fn+"(") {
+			return true
+		}
+	}
+	return false
+}
+
 func indexInfoContains(idxID int64, idxInfos []*model.IndexInfo) bool {
 	for _, idxInfo := range idxInfos {
 		if idxID == idxInfo.ID {
@@ -108,6 +108,7 @@ func (w *worker) onAddCheckConstraint(d *ddlCtx, t *meta.Meta, job *model.Job) (
 			return ver, errors.Trace(err)
 		}
 		constraintInfoInMeta.State = model.StatePublic
+		constraintInfoInMeta.Validated = true
 		ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
 		if err != nil {
 			return ver, errors.Trace(err)
@@ -238,6 +239,8 @@ func (w *worker) onAlterCheckConstraint(d *ddlCtx, t *meta.Meta, job *model.Job)
 			job.SchemaState = model.StateWriteReorganization
 			constraintInfo.State = model.StateWriteReorganization
 			constraintInfo.Enforced = enforced
+			// The rows written when the constraint isn't enforced haven't been verified yet.
+			constraintInfo.Validated = false
 			ver, err = updateVersionAndTableInfoWithCheck(d, t, job, tblInfo, true)
 		case model.StateWriteReorganization:
 			job.SchemaState = model.StateWriteOnly
@@ -252,6 +255,7 @@ func (w *worker) onAlterCheckConstraint(d *ddlCtx, t *meta.Meta, job *model.Job)
 				return ver, errors.Trace(err)
 			}
 			constraintInfo.State = model.StatePublic
+			constraintInfo.Validated = true
 			ver, err = updateVersionAndTableInfoWithCheck(d, t, job, tblInfo, true)
 			if err != nil {
 				return ver, errors.Trace(err)
@@ -260,6 +264,7 @@ func (w *worker) onAlterCheckConstraint(d *ddlCtx, t *meta.Meta, job *model.Job)
 		}
 	} else {
 		constraintInfo.Enforced = enforced
+		constraintInfo.Validated = false
 		ver, err = updateVersionAndTableInfoWithCheck(d, t, job, tblInfo, true)
 		if err != nil {
 			// update version and tableInfo error will cause retry.
@@ -336,6 +341,14 @@ func buildConstraintInfo(tblInfo *model.TableInfo, dependedCols []model.CIStr, c
 	return constraintInfo, nil
 }
 
+// invalidateCheckConstraints marks the check constraints of the table not validated, it's used when some rows are
+// written into the table without being verified against the constraints.
+func invalidateCheckConstraints(tblInfo *model.TableInfo) {
+	for _, constr := range tblInfo.Constraints {
+		constr.Validated = false
+	}
+}
+
 func checkTooLongConstraint(constr model.CIStr) error {
 	if len(constr.L) > mysql.MaxConstraintIdentifierLen {
 		return dbterror.ErrTooLongIdent.GenWithStackByArgs(constr)
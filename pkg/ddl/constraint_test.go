@@ -21,6 +21,7 @@ import (
 
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/ddl/util/callback"
+	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/table/tables"
@@ -279,3 +280,37 @@ func TestAlterEnforcedConstraintStateChange(t *testing.T) {
 	tk.MustExec("alter table t alter constraint c1 enforced")
 	tk.MustQuery("select * from t").Check(testkit.Rows("12"))
 }
+
+func TestAddColumnWithCheckConstraint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@global.tidb_enable_check_constraint = 1")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("insert into t values (1), (2)")
+
+	// The column check constraint is added together with the column.
+	tk.MustExec("alter table t add column b int default 5 check (b > 0)")
+	tk.MustQuery("show create table t").Check(testkit.Rows("t CREATE TABLE `t` (\n" +
+		"  `a` int(11) DEFAULT NULL,\n" +
+		"  `b` int(11) DEFAULT '5',\n" +
+		"CONSTRAINT `t_chk_1` CHECK ((`b` > 0))\n" +
+		") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
+	tk.MustGetErrMsg("insert into t values (3, -1)", "[table:3819]Check constraint 't_chk_1' is violated.")
+	tk.MustGetErrMsg("update t set b = 0 where a = 1", "[table:3819]Check constraint 't_chk_1' is violated.")
+	tk.MustExec("insert into t values (3, 1)")
+
+	// The default value, which is used by the existing rows, violates the check constraint.
+	tk.MustGetErrMsg("alter table t add column c int default 0 constraint c_chk check (c > 0)", "[ddl:3819]Check constraint 'c_chk' is violated.")
+	tk.MustGetErrCode("alter table t add column c int check (c > a)", errno.ErrColumnCheckConstraintReferencesOtherColumn)
+	tk.MustQuery("select count(*) from information_schema.columns where table_schema = 'test' and table_name = 't' and column_name = 'c'").Check(testkit.Rows("0"))
+	tk.MustExec("alter table t add column c int check (c > 0) not enforced")
+	tk.MustExec("insert into t values (4, 1, -1)")
+
+	tk.MustGetErrCode("alter table t add column d int check (d > 0), add column e int", errno.ErrUnsupportedDDLOperation)
+
+	// The column isn't added if the check constraint can't be added.
+	tk.MustGetErrCode("alter table t add column d int constraint t_chk_1 check (d > 0)", errno.ErrCheckConstraintDupName)
+	tk.MustGetErrCode("alter table t add column d int check (d + 1)", errno.ErrNonBooleanExprForCheckConstraint)
+	tk.MustQuery("select count(*) from information_schema.columns where table_schema = 'test' and table_name = 't' and column_name = 'd'").Check(testkit.Rows("0"))
+}
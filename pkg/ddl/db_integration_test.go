@@ -1558,18 +1558,13 @@ func TestDefaultColumnWithRand(t *testing.T) {
 	store := testkit.CreateMockStoreWithSchemaLease(t, testLease)
 	tk := testkit.NewTestKit(t, store)
 	tk.MustExec("use test")
-	tk.MustExec("drop table if exists t, t1, t2")
+	tk.MustExec("drop table if exists t, t1, t2, t3")
 
 	// create table
 	tk.MustExec("create table t (c int(10), c1 int default (rand()))")
 	tk.MustExec("create table t1 (c int, c1 double default (rand()))")
 	tk.MustExec("create table t2 (c int, c1 double default (rand(1)))")
 
-	// add column with default rand() for table t is forbidden in MySQL 8.0
-	tk.MustGetErrCode("alter table t add column c2 double default (rand(2))", errno.ErrBinlogUnsafeSystemFunction)
-	tk.MustGetErrCode("alter table t add column c3 int default ((rand()))", errno.ErrBinlogUnsafeSystemFunction)
-	tk.MustGetErrCode("alter table t add column c4 int default (((rand(3))))", errno.ErrBinlogUnsafeSystemFunction)
-
 	// insert records
 	tk.MustExec("insert into t(c) values (1),(2),(3)")
 	tk.MustExec("insert into t1(c) values (1),(2),(3)")
@@ -1606,8 +1601,32 @@ func TestDefaultColumnWithRand(t *testing.T) {
 			"  `c1` double DEFAULT (rand(1))\n" +
 			") ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin"))
 
+	// add column with default rand() is forbidden in MySQL 8.0, but TiDB backfills the existing rows
+	// with their own values of the default expression.
+	tk.MustExec("alter table t1 add column c2 double default (rand())")
+	tk.MustExec("alter table t1 add column c3 int default ((rand()))")
+	tk.MustExec("alter table t1 add column c4 double default (((rand(3))))")
+	tk.MustQuery("select count(distinct c2), count(distinct c4), count(*) from t1").Check(testkit.Rows("3 1 3"))
+	tk.MustQuery("select count(*) from t1 where c3 in (0, 1)").Check(testkit.Rows("3"))
+	tk.MustExec("insert into t1(c) values (4),(5),(6)")
+	tk.MustQuery("select count(distinct c2) from t1").Check(testkit.Rows("6"))
+	r := tk.MustQuery("select c2 from t1").Rows()
+	for _, row := range r {
+		d, err := strconv.ParseFloat(row[0].(string), 64)
+		require.NoError(t, err)
+		require.True(t, 0.0 <= d && d < 1.0, "rand() return a random floating-point value in the range 0 <= v < 1.0.")
+	}
+	tk.MustExec("create table t3 (c int) partition by hash(c) partitions 3")
+	tk.MustExec("insert into t3 values (1),(2),(3),(4),(5),(6)")
+	tk.MustExec("alter table t3 add column c1 varchar(36) default (uuid())")
+	tk.MustQuery("select count(distinct c1), sum(is_uuid(c1)) from t3").Check(testkit.Rows("6 6"))
+	tk.MustGetErrCode("alter table t1 add column c5 double default (rand()), add column c6 int", errno.ErrUnsupportedDDLOperation)
+	tk.MustExec("set @@global.tidb_enable_check_constraint = 1")
+	tk.MustGetErrCode("alter table t1 add column c5 double default (rand()) check (c5 < 1)", errno.ErrUnsupportedDDLOperation)
+	tk.MustExec("set @@global.tidb_enable_check_constraint = 0")
+
 	// use a non-existent function name
-	tk.MustGetErrCode("CREATE TABLE t3 (c int, c1 int default a_function_not_supported_yet());", errno.ErrDefValGeneratedNamedFunctionIsNotAllowed)
+	tk.MustGetErrCode("CREATE TABLE t4 (c int, c1 int default a_function_not_supported_yet());", errno.ErrDefValGeneratedNamedFunctionIsNotAllowed)
 }
 
 // TestDefaultValueAsExpressions is used for tests that are inconvenient to place in the pkg/tests directory.
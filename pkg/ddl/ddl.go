@@ -196,6 +196,10 @@ type DDL interface {
 	AddResourceGroup(ctx sessionctx.Context, stmt *ast.CreateResourceGroupStmt) error
 	AlterResourceGroup(ctx sessionctx.Context, stmt *ast.AlterResourceGroupStmt) error
 	DropResourceGroup(ctx sessionctx.Context, stmt *ast.DropResourceGroupStmt) error
+	CreateRowPolicy(ctx sessionctx.Context, stmt *ast.CreateRowPolicyStmt) error
+	DropRowPolicy(ctx sessionctx.Context, stmt *ast.DropRowPolicyStmt) error
+	CreateMaskingPolicy(ctx sessionctx.Context, stmt *ast.CreateMaskingPolicyStmt) error
+	DropMaskingPolicy(ctx sessionctx.Context, stmt *ast.DropMaskingPolicyStmt) error
 	FlashbackCluster(ctx sessionctx.Context, flashbackTS uint64) error
 
 	// CreateSchemaWithInfo creates a database (schema) given its database info.
@@ -68,6 +68,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/pkg/util/domainutil"
 	"github.com/pingcap/tidb/pkg/util/hack"
+	"github.com/pingcap/tidb/pkg/util/hint"
 	"github.com/pingcap/tidb/pkg/util/mathutil"
 	"github.com/pingcap/tidb/pkg/util/mock"
 	"github.com/pingcap/tidb/pkg/util/set"
@@ -2112,6 +2113,18 @@ func BuildTableInfo(
 	}
 	foreignKeyID := tbInfo.MaxForeignKeyID
 	for _, constr := range constraints {
+		if constr.Tp == ast.ConstraintFulltext {
+			// The constraint is copied to keep the AST of the statement unchanged.
+			ftConstr := *constr
+			if ftConstr.Keys, err = buildFullTextIndexPart(ctx, tbInfo, constr.Keys); err != nil {
+				return nil, err
+			}
+			if ftConstr.Keys == nil {
+				continue
+			}
+			ftConstr.Option = buildFullTextIndexOption(constr.Option)
+			constr = &ftConstr
+		}
 		// Build hidden columns if necessary.
 		hiddenCols, err := buildHiddenColumnInfoWithCheck(ctx, constr.Keys, model.NewCIStr(constr.Name), tbInfo, tblColumns)
 		if err != nil {
@@ -2174,11 +2187,6 @@ func BuildTableInfo(
 			}
 		}
 
-		if constr.Tp == ast.ConstraintFulltext {
-			ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrTableCantHandleFt.FastGenByArgs())
-			continue
-		}
-
 		var (
 			indexName       = constr.Name
 			primary, unique bool
@@ -2252,6 +2260,8 @@ func BuildTableInfo(
 			if err := table.IfCheckConstraintExprBoolType(constraintInfo, tbInfo); err != nil {
 				return nil, err
 			}
+			// The table is empty, so the enforced constraint is satisfied by all the rows.
+			constraintInfo.Validated = constraintInfo.Enforced
 			constraintInfo.ID = allocateConstraintID(tbInfo)
 			tbInfo.Constraints = append(tbInfo.Constraints, constraintInfo)
 			continue
@@ -2446,6 +2456,11 @@ func checkTableInfoValidWithStmt(ctx sessionctx.Context, tbInfo *model.TableInfo
 			return errors.Trace(err)
 		}
 	}
+	if tbInfo.ExternalInfo != nil {
+		if err := checkExternalTableValid(tbInfo); err != nil {
+			return errors.Trace(err)
+		}
+	}
 
 	return nil
 }
@@ -2630,6 +2645,9 @@ func BuildTableInfoWithStmt(ctx sessionctx.Context, s *ast.CreateTableStmt, dbCh
 	if err = handleTableOptions(s.Options, tbInfo); err != nil {
 		return nil, errors.Trace(err)
 	}
+	if tbInfo.ExternalInfo != nil {
+		stripExternalLocationCredentials(ctx, tbInfo.ExternalInfo)
+	}
 
 	sessionVars := ctx.GetSessionVars()
 	if _, err = validateCommentLength(sessionVars.StmtCtx.ErrCtx(), sessionVars.SQLMode, tbInfo.Name.L, &tbInfo.Comment, dbterror.ErrTooLongTableComment); err != nil {
@@ -2671,6 +2689,13 @@ func (d *ddl) assignPartitionIDs(defs []model.PartitionDefinition) error {
 }
 
 func (d *ddl) CreateTable(ctx sessionctx.Context, s *ast.CreateTableStmt) (err error) {
+	// The credentials in the EXTERNAL_LOCATION must not be kept in the query of the DDL job.
+	if secureText := s.SecureText(); secureText != s.Text() {
+		if query, ok := ctx.Value(sessionctx.QueryString).(string); ok {
+			defer ctx.SetValue(sessionctx.QueryString, query)
+			ctx.SetValue(sessionctx.QueryString, secureText)
+		}
+	}
 	ident := ast.Ident{Schema: s.Table.Schema, Name: s.Table.Name}
 	is := d.GetInfoSchemaWithInterceptor(ctx)
 	schema, ok := is.SchemaByName(ident.Schema)
@@ -3258,8 +3283,17 @@ func BuildViewInfo(_ sessionctx.Context, s *ast.CreateViewStmt) (*model.ViewInfo
 		return nil, err
 	}
 
+	var hintsStr string
+	if s.Hints != "" {
+		hints, err := hint.ParseHintsStr(s.Hints)
+		if err != nil {
+			return nil, err
+		}
+		hintsStr = hint.RestoreOptimizerHints(hints)
+	}
+
 	return &model.ViewInfo{Definer: s.Definer, Algorithm: s.Algorithm,
-		Security: s.Security, SelectStmt: sb.String(), CheckOption: s.CheckOption, Cols: nil}, nil
+		Security: s.Security, SelectStmt: sb.String(), CheckOption: s.CheckOption, Cols: nil, Hints: hintsStr}, nil
 }
 
 func checkPartitionByHash(ctx sessionctx.Context, tbInfo *model.TableInfo) error {
@@ -3513,6 +3547,11 @@ func SetDirectResourceGroupSettings(groupInfo *model.ResourceGroupInfo, opt *ast
 				return err
 			}
 		}
+	case ast.ResourceGroupOptimizerVars:
+		resourceGroupSettings.OptimizerVars = nil
+		for _, v := range opt.OptimizerVars {
+			resourceGroupSettings.OptimizerVars = append(resourceGroupSettings.OptimizerVars, model.ResourceGroupOptimizerVar{Name: v.Name, Value: v.Value})
+		}
 	default:
 		return errors.Trace(errors.New("unknown resource unit type"))
 	}
@@ -3629,12 +3668,13 @@ func handleTableOptions(options []*ast.TableOption, tbInfo *model.TableInfo) err
 			tbInfo.PlacementPolicyRef = &model.PolicyRefInfo{
 				Name: model.NewCIStr(op.StrValue),
 			}
-		case ast.TableOptionTTL, ast.TableOptionTTLEnable, ast.TableOptionTTLJobInterval:
+		case ast.TableOptionTTL, ast.TableOptionTTLEnable, ast.TableOptionTTLJobInterval, ast.TableOptionTTLCondition,
+			ast.TableOptionTTLJobWindowStart, ast.TableOptionTTLJobWindowEnd, ast.TableOptionTTLDeleteRateLimit, ast.TableOptionTTLResourceGroup:
 			if ttlOptionsHandled {
 				continue
 			}
 
-			ttlInfo, ttlEnable, ttlJobInterval, err := getTTLInfoInOptions(options)
+			ttlInfo, ttlEnable, ttlJobInterval, ttlCondition, ttlScheduleOpts, err := getTTLInfoInOptions(options)
 			if err != nil {
 				return err
 			}
@@ -3647,10 +3687,25 @@ func handleTableOptions(options []*ast.TableOption, tbInfo *model.TableInfo) err
 				if ttlJobInterval != nil {
 					return errors.Trace(dbterror.ErrSetTTLOptionForNonTTLTable.FastGenByArgs("TTL_JOB_INTERVAL"))
 				}
+				if ttlCondition != nil {
+					return errors.Trace(dbterror.ErrSetTTLOptionForNonTTLTable.FastGenByArgs("TTL_CONDITION"))
+				}
+				if ttlScheduleOpts != nil {
+					return errors.Trace(ttlScheduleOpts.errForNonTTLTable())
+				}
 			}
 
 			tbInfo.TTLInfo = ttlInfo
 			ttlOptionsHandled = true
+		case ast.TableOptionExternalLocation, ast.TableOptionExternalFormat:
+			if tbInfo.ExternalInfo == nil {
+				tbInfo.ExternalInfo = &model.ExternalTableInfo{Format: model.ExternalTableFormatCSV}
+			}
+			if op.Tp == ast.TableOptionExternalLocation {
+				tbInfo.ExternalInfo.Location = op.StrValue
+			} else {
+				tbInfo.ExternalInfo.Format = strings.ToLower(op.StrValue)
+			}
 		}
 	}
 	shardingBits := shardingBits(tbInfo)
@@ -3847,6 +3902,11 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 	if tb.Meta().IsView() || tb.Meta().IsSequence() {
 		return dbterror.ErrWrongObject.GenWithStackByArgs(ident.Schema, ident.Name, "BASE TABLE")
 	}
+	if tb.Meta().IsExternal() {
+		if err = checkAlterExternalTable(tb.Meta(), validSpecs); err != nil {
+			return errors.Trace(err)
+		}
+	}
 	if tb.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 		if len(validSpecs) != 1 {
 			return dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Alter Table")
@@ -3880,7 +3940,7 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 			err = d.AddTablePartitions(sctx, ident, spec)
 		case ast.AlterTableCoalescePartitions:
 			err = d.CoalescePartitions(sctx, ident, spec)
-		case ast.AlterTableReorganizePartition:
+		case ast.AlterTableReorganizePartition, ast.AlterTableSplitPartition, ast.AlterTableMergePartitions:
 			err = d.ReorganizePartitions(sctx, ident, spec)
 		case ast.AlterTableReorganizeFirstPartition:
 			err = dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("MERGE FIRST PARTITION")
@@ -3944,7 +4004,8 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 			case ast.ConstraintPrimaryKey:
 				err = d.CreatePrimaryKey(sctx, ident, model.NewCIStr(constr.Name), spec.Constraint.Keys, constr.Option)
 			case ast.ConstraintFulltext:
-				sctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrTableCantHandleFt)
+				err = d.createIndex(sctx, ident, ast.IndexKeyTypeFullText, model.NewCIStr(constr.Name),
+					spec.Constraint.Keys, constr.Option, constr.IfNotExists)
 			case ast.ConstraintCheck:
 				if !variable.EnableCheckConstraint.Load() {
 					sctx.GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackError("the switch of check constraint is off"))
@@ -4013,19 +4074,22 @@ func (d *ddl) AlterTable(ctx context.Context, sctx sessionctx.Context, stmt *ast
 					}
 				case ast.TableOptionEngine:
 				case ast.TableOptionRowFormat:
-				case ast.TableOptionTTL, ast.TableOptionTTLEnable, ast.TableOptionTTLJobInterval:
+				case ast.TableOptionTTL, ast.TableOptionTTLEnable, ast.TableOptionTTLJobInterval, ast.TableOptionTTLCondition,
+					ast.TableOptionTTLJobWindowStart, ast.TableOptionTTLJobWindowEnd, ast.TableOptionTTLDeleteRateLimit, ast.TableOptionTTLResourceGroup:
 					var ttlInfo *model.TTLInfo
 					var ttlEnable *bool
 					var ttlJobInterval *string
+					var ttlCondition *string
+					var ttlScheduleOpts *ttlScheduleOptions
 
 					if ttlOptionsHandled {
 						continue
 					}
-					ttlInfo, ttlEnable, ttlJobInterval, err = getTTLInfoInOptions(spec.Options)
+					ttlInfo, ttlEnable, ttlJobInterval, ttlCondition, ttlScheduleOpts, err = getTTLInfoInOptions(spec.Options)
 					if err != nil {
 						return err
 					}
-					err = d.AlterTableTTLInfoOrEnable(sctx, ident, ttlInfo, ttlEnable, ttlJobInterval)
+					err = d.AlterTableTTLInfoOrEnable(sctx, ident, ttlInfo, ttlEnable, ttlJobInterval, ttlCondition, ttlScheduleOpts)
 
 					ttlOptionsHandled = true
 				default:
@@ -4299,6 +4363,9 @@ func CreateNewColumn(ctx sessionctx.Context, schema *model.DBInfo, spec *ast.Alt
 			}
 			cols := t.Cols()
 
+			if err := checkGeneratedColumnWithMaskingPolicy(t.Meta(), specNewColumn.Name.Name.O, dependColNames); err != nil {
+				return nil, errors.Trace(err)
+			}
 			if err := checkDependedColExist(dependColNames, cols); err != nil {
 				return nil, errors.Trace(err)
 			}
@@ -4319,8 +4386,12 @@ func CreateNewColumn(ctx sessionctx.Context, schema *model.DBInfo, spec *ast.Alt
 						return nil, errors.Trace(err)
 					}
 					return nil, errors.Trace(dbterror.ErrAddColumnWithSequenceAsDefault.GenWithStackByArgs(specNewColumn.Name.Name.O))
-				case ast.Rand, ast.UUID, ast.UUIDToBin, ast.Replace, ast.Upper:
-					return nil, errors.Trace(dbterror.ErrBinlogUnsafeSystemFunction.GenWithStackByArgs())
+				case ast.Rand, ast.UUID, ast.UUIDToBin, ast.Replace:
+					// These functions return a different value for each row, so the existing rows are backfilled
+					// one by one when the column is added, which isn't supported in multi-schema change.
+					if ctx.GetSessionVars().StmtCtx.MultiSchemaInfo != nil {
+						return nil, dbterror.ErrUnsupportedAddColumn.GenWithStack("unsupported add column '%s' with default value %s() in multi-schema change", specNewColumn.Name.Name.O, f.FnName.L)
+					}
 				}
 			}
 		}
@@ -4379,6 +4450,10 @@ func (d *ddl) AddColumn(ctx sessionctx.Context, ti ast.Ident, spec *ast.AlterTab
 	if err != nil {
 		return errors.Trace(err)
 	}
+	checkConstraints, err := buildNewColumnCheckConstraints(ctx, tbInfo, col, specNewColumn)
+	if err != nil {
+		return errors.Trace(err)
+	}
 
 	txn, err := ctx.Txn(true)
 	if err != nil {
@@ -4399,16 +4474,115 @@ func (d *ddl) AddColumn(ctx sessionctx.Context, ti ast.Ident, spec *ast.AlterTab
 		TableName:      tbInfo.Name.L,
 		Type:           model.ActionAddColumn,
 		BinlogInfo:     &model.HistoryInfo{},
-		Args:           []any{col, spec.Position, 0, spec.IfNotExists},
+		Args:           []any{col, spec.Position, 0, spec.IfNotExists, checkConstraints},
 		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
 		SQLMode:        ctx.GetSessionVars().SQLMode,
 	}
+	// The existing rows are backfilled with the values of the per-row default expression.
+	if isPerRowDefaultExpr(col.ColumnInfo) {
+		job.ReorgMeta = NewDDLReorgMeta(ctx)
+		job.CtxVars = []any{true}
+	}
 
 	err = d.DoDDLJob(ctx, job)
 	err = d.callHookOnChanged(job, err)
 	return errors.Trace(err)
 }
 
+// buildNewColumnCheckConstraints builds the check constraints defined on the column added by ALTER TABLE ADD COLUMN,
+// they are added in the same job as the column when it becomes public.
+// The default value of the column, which is filled in for the existing rows, is checked against the constraints in
+// advance, so that the column isn't added if the existing rows are going to violate them.
+func buildNewColumnCheckConstraints(ctx sessionctx.Context, tblInfo *model.TableInfo, col *table.Column, colDef *ast.ColumnDef) ([]*model.ConstraintInfo, error) {
+	var constraints []*ast.Constraint
+	for _, option := range colDef.Options {
+		if option.Tp != ast.ColumnOptionCheck || !variable.EnableCheckConstraint.Load() {
+			continue
+		}
+		constraints = append(constraints, &ast.Constraint{
+			Tp:           ast.ConstraintCheck,
+			Expr:         option.Expr,
+			Enforced:     option.Enforced,
+			Name:         option.ConstraintName,
+			InColumn:     true,
+			InColumnName: colDef.Name.Name.O,
+		})
+	}
+	if len(constraints) == 0 {
+		return nil, nil
+	}
+	if ctx.GetSessionVars().StmtCtx.MultiSchemaInfo != nil {
+		return nil, dbterror.ErrUnsupportedAddColumn.GenWithStack("unsupported add column '%s' constraint CHECK in multi-schema change", colDef.Name)
+	}
+	// The constraints are only checked against the default value, which is different for each existing row.
+	if isPerRowDefaultExpr(col.ColumnInfo) {
+		return nil, dbterror.ErrUnsupportedAddColumn.GenWithStack("unsupported add column '%s' constraint CHECK with a per-row default value", colDef.Name)
+	}
+	constrNames := make(map[string]bool, len(tblInfo.Constraints))
+	for _, constr := range tblInfo.Constraints {
+		constrNames[constr.Name.L] = true
+	}
+	for _, constr := range constraints {
+		if constr.Name != "" && constrNames[strings.ToLower(constr.Name)] {
+			return nil, infoschema.ErrCheckConstraintDupName.GenWithStackByArgs(strings.ToLower(constr.Name))
+		}
+	}
+	setEmptyCheckConstraintName(tblInfo.Name.L, constrNames, constraints)
+
+	// Build the row of the existing records, only the new column is set since the constraints can't refer to
+	// other columns.
+	tmpTblInfo := tblInfo.Clone()
+	colInfo := col.ToInfo().Clone()
+	colInfo.State = model.StatePublic
+	colInfo.Offset = len(tmpTblInfo.Columns)
+	tmpTblInfo.Columns = append(tmpTblInfo.Columns, colInfo)
+	defVal, err := table.GetColOriginDefaultValue(ctx.GetExprCtx(), colInfo)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	row := make([]types.Datum, len(tmpTblInfo.Columns))
+	row[colInfo.Offset] = defVal
+	constraintInfos := make([]*model.ConstraintInfo, 0, len(constraints))
+	for _, constr := range constraints {
+		if ok, err := table.IsSupportedExpr(constr); !ok {
+			return nil, err
+		}
+		dependedCols := findDependentColsInExpr(constr.Expr)
+		if len(dependedCols) > 1 {
+			return nil, dbterror.ErrColumnCheckConstraintReferOther.GenWithStackByArgs(constr.Name)
+		}
+		var constraintCols []model.CIStr
+		if _, ok := dependedCols[colInfo.Name.L]; ok {
+			constraintCols = []model.CIStr{colInfo.Name}
+		} else if len(dependedCols) > 0 {
+			return nil, dbterror.ErrColumnCheckConstraintReferOther.GenWithStackByArgs(constr.Name)
+		}
+		constraintInfo, err := buildConstraintInfo(tmpTblInfo, constraintCols, constr, model.StateNone)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if err := table.IfCheckConstraintExprBoolType(constraintInfo, tmpTblInfo); err != nil {
+			return nil, err
+		}
+		constraintInfos = append(constraintInfos, constraintInfo)
+		if !constraintInfo.Enforced {
+			continue
+		}
+		c, err := table.ToConstraint(constraintInfo, tmpTblInfo)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		ok, isNull, err := c.ConstraintExpr.EvalInt(ctx.GetExprCtx().GetEvalCtx(), chunk.MutRowFromDatums(row).ToRow())
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		if ok == 0 && !isNull {
+			return nil, dbterror.ErrCheckConstraintIsViolated.GenWithStackByArgs(constr.Name)
+		}
+	}
+	return constraintInfos, nil
+}
+
 // AddTablePartitions will add a new partition to the table.
 func (d *ddl) AddTablePartitions(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
 	is := d.infoCache.GetLatest()
@@ -4720,7 +4894,24 @@ func (d *ddl) ReorganizePartitions(ctx sessionctx.Context, ident ast.Ident, spec
 	if err != nil {
 		return errors.Trace(err)
 	}
-	partInfo, err := BuildAddedPartitionInfo(ctx.GetExprCtx(), meta, spec)
+	var partInfo *model.PartitionInfo
+	switch spec.Tp {
+	case ast.AlterTableSplitPartition:
+		if pi.Type != model.PartitionTypeRange {
+			return errors.Trace(dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("SPLIT PARTITION of LIST partitioned table"))
+		}
+		if len(spec.PartDefinitions) < 2 {
+			return errors.Trace(dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("SPLIT PARTITION into less than two partitions"))
+		}
+		partInfo, err = BuildAddedPartitionInfo(ctx.GetExprCtx(), meta, spec)
+	case ast.AlterTableMergePartitions:
+		if len(partNames) < 2 {
+			return errors.Trace(dbterror.ErrGeneralUnsupportedDDL.GenWithStackByArgs("MERGE PARTITIONS of less than two partitions"))
+		}
+		partInfo = buildMergedPartitionInfo(pi, spec.PartDefinitions[0].Name, firstPartIdx, lastPartIdx, idMap)
+	default:
+		partInfo, err = BuildAddedPartitionInfo(ctx.GetExprCtx(), meta, spec)
+	}
 	if err != nil {
 		return errors.Trace(err)
 	}
@@ -4733,6 +4924,9 @@ func (d *ddl) ReorganizePartitions(ctx sessionctx.Context, ident ast.Ident, spec
 	if err = handlePartitionPlacement(ctx, partInfo); err != nil {
 		return errors.Trace(err)
 	}
+	// The count of the replaced partitions is carried over to the new partitions only if the rows of the new
+	// partitions all come from the replaced partitions in a known way.
+	carryOverStats := spec.Tp == ast.AlterTableSplitPartition || spec.Tp == ast.AlterTableMergePartitions
 
 	job := &model.Job{
 		SchemaID:       schema.ID,
@@ -4741,7 +4935,7 @@ func (d *ddl) ReorganizePartitions(ctx sessionctx.Context, ident ast.Ident, spec
 		TableName:      t.Meta().Name.L,
 		Type:           model.ActionReorganizePartition,
 		BinlogInfo:     &model.HistoryInfo{},
-		Args:           []any{partNames, partInfo},
+		Args:           []any{partNames, partInfo, carryOverStats},
 		ReorgMeta:      NewDDLReorgMeta(ctx),
 		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
 		SQLMode:        ctx.GetSessionVars().SQLMode,
@@ -4756,6 +4950,31 @@ func (d *ddl) ReorganizePartitions(ctx sessionctx.Context, ident ast.Ident, spec
 	return errors.Trace(err)
 }
 
+// buildMergedPartitionInfo builds the partition info of the single partition that the replaced partitions are merged
+// into. For RANGE partitioning it takes over the upper bound of the last replaced partition, and for LIST partitioning
+// it takes over the values of all the replaced partitions.
+func buildMergedPartitionInfo(pi *model.PartitionInfo, name model.CIStr, firstPartIdx, lastPartIdx int, idMap map[int]struct{}) *model.PartitionInfo {
+	def := model.PartitionDefinition{Name: name}
+	switch pi.Type {
+	case model.PartitionTypeRange:
+		def.LessThan = slices.Clone(pi.Definitions[lastPartIdx].LessThan)
+	case model.PartitionTypeList:
+		for i := firstPartIdx; i <= lastPartIdx; i++ {
+			if _, ok := idMap[i]; ok {
+				def.InValues = append(def.InValues, pi.Definitions[i].InValues...)
+			}
+		}
+	}
+	return &model.PartitionInfo{
+		Type:        pi.Type,
+		Expr:        pi.Expr,
+		Columns:     pi.Columns,
+		Enable:      pi.Enable,
+		Definitions: []model.PartitionDefinition{def},
+		Num:         1,
+	}
+}
+
 // RemovePartitioning removes partitioning from a table.
 func (d *ddl) RemovePartitioning(ctx sessionctx.Context, ident ast.Ident, spec *ast.AlterTableSpec) error {
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ident)
@@ -5410,6 +5629,15 @@ func checkIsDroppableColumn(ctx sessionctx.Context, is infoschema.InfoSchema, sc
 	if err != nil {
 		return false, errors.Trace(err)
 	}
+	// Check the column with row policies and masking policies
+	err = checkDropColumnWithRowPolicy(tblInfo, colName.L)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	err = checkDropColumnWithMaskingPolicy(tblInfo, colName.L)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
 	// We don't support dropping column with PK handle covered now.
 	if col.IsPKHandleColumn(tblInfo) {
 		return false, dbterror.ErrUnsupportedPKHandle
@@ -5794,6 +6022,9 @@ func GetModifiableColumnJob(
 		if errG != nil {
 			return nil, errors.Trace(errG)
 		}
+		if err := checkDropColumnWithRowPolicy(t.Meta(), originalColName.L); err != nil {
+			return nil, errors.Trace(err)
+		}
 	}
 
 	// Constraints in the new column means adding new constraints. Errors should thrown,
@@ -5992,8 +6223,8 @@ func GetModifiableColumnJob(
 		}
 	}
 
-	var newAutoRandBits uint64
-	if newAutoRandBits, err = checkAutoRandom(t.Meta(), col, specNewColumn); err != nil {
+	newAutoRandBits, newAutoRandRangeBits, err := checkAutoRandom(t.Meta(), col, specNewColumn)
+	if err != nil {
 		return nil, errors.Trace(err)
 	}
 
@@ -6019,7 +6250,7 @@ func GetModifiableColumnJob(
 		BinlogInfo:     &model.HistoryInfo{},
 		ReorgMeta:      NewDDLReorgMeta(sctx),
 		CtxVars:        []any{needChangeColData},
-		Args:           []any{&newCol.ColumnInfo, originalColName, spec.Position, modifyColumnTp, newAutoRandBits},
+		Args:           []any{&newCol.ColumnInfo, originalColName, spec.Position, modifyColumnTp, encodeAutoRandomBits(newAutoRandBits, newAutoRandRangeBits)},
 		CDCWriteSource: sctx.GetSessionVars().CDCWriteSource,
 		SQLMode:        sctx.GetSessionVars().SQLMode,
 	}
@@ -6125,7 +6356,9 @@ func isClusteredPKColumn(col *table.Column, tblInfo *model.TableInfo) bool {
 	}
 }
 
-func checkAutoRandom(tableInfo *model.TableInfo, originCol *table.Column, specNewColumn *ast.ColumnDef) (uint64, error) {
+// checkAutoRandom checks the auto_random bits of the modified column, and returns the new shard bits and the new
+// range bits, the range bits is 0 if it's not changed.
+func checkAutoRandom(tableInfo *model.TableInfo, originCol *table.Column, specNewColumn *ast.ColumnDef) (shardBits, rangeBits uint64, _ error) {
 	var oldShardBits, oldRangeBits uint64
 	if isClusteredPKColumn(originCol, tableInfo) {
 		oldShardBits = tableInfo.AutoRandomBits
@@ -6133,8 +6366,9 @@ func checkAutoRandom(tableInfo *model.TableInfo, originCol *table.Column, specNe
 	}
 	newShardBits, newRangeBits, err := extractAutoRandomBitsFromColDef(specNewColumn)
 	if err != nil {
-		return 0, errors.Trace(err)
+		return 0, 0, errors.Trace(err)
 	}
+	rangeChanged := rangeBitsIsChanged(oldRangeBits, newRangeBits)
 	switch {
 	case oldShardBits == newShardBits:
 	case oldShardBits < newShardBits:
@@ -6142,44 +6376,68 @@ func checkAutoRandom(tableInfo *model.TableInfo, originCol *table.Column, specNe
 		if addingAutoRandom {
 			convFromAutoInc := mysql.HasAutoIncrementFlag(originCol.GetFlag()) && originCol.IsPKHandleColumn(tableInfo)
 			if !convFromAutoInc {
-				return 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomAlterChangeFromAutoInc)
+				return 0, 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomAlterChangeFromAutoInc)
 			}
 		}
 		if autoid.AutoRandomShardBitsMax < newShardBits {
 			errMsg := fmt.Sprintf(autoid.AutoRandomOverflowErrMsg,
 				autoid.AutoRandomShardBitsMax, newShardBits, specNewColumn.Name.Name.O)
-			return 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(errMsg)
+			return 0, 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(errMsg)
 		}
 		// increasing auto_random shard bits is allowed.
 	case oldShardBits > newShardBits:
 		if newShardBits == 0 {
-			return 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomAlterErrMsg)
+			return 0, 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomAlterErrMsg)
+		}
+		// decreasing auto_random shard bits is only allowed along with increasing the range bits, see below.
+		if !rangeChanged {
+			return 0, 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomDecreaseBitErrMsg)
 		}
-		return 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomDecreaseBitErrMsg)
 	}
 
 	modifyingAutoRandCol := oldShardBits > 0 || newShardBits > 0
 	if modifyingAutoRandCol {
 		// Disallow changing the column field type.
 		if originCol.GetType() != specNewColumn.Tp.GetType() {
-			return 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomModifyColTypeErrMsg)
+			return 0, 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomModifyColTypeErrMsg)
 		}
 		if originCol.GetType() != mysql.TypeLonglong {
-			return 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(fmt.Sprintf(autoid.AutoRandomOnNonBigIntColumn, types.TypeStr(originCol.GetType())))
+			return 0, 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(fmt.Sprintf(autoid.AutoRandomOnNonBigIntColumn, types.TypeStr(originCol.GetType())))
 		}
 		// Disallow changing from auto_random to auto_increment column.
 		if containsColumnOption(specNewColumn, ast.ColumnOptionAutoIncrement) {
-			return 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomIncompatibleWithAutoIncErrMsg)
+			return 0, 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomIncompatibleWithAutoIncErrMsg)
 		}
 		// Disallow specifying a default value on auto_random column.
 		if containsColumnOption(specNewColumn, ast.ColumnOptionDefaultValue) {
-			return 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomIncompatibleWithDefaultValueErrMsg)
+			return 0, 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(autoid.AutoRandomIncompatibleWithDefaultValueErrMsg)
 		}
 	}
-	if rangeBitsIsChanged(oldRangeBits, newRangeBits) {
-		return 0, dbterror.ErrInvalidAutoRandom.FastGenByArgs(autoid.AutoRandomUnsupportedAlterRangeBits)
+	if !rangeChanged {
+		return newShardBits, 0, nil
 	}
-	return newShardBits, nil
+	if oldShardBits > 0 {
+		// The existing IDs are regarded as the incremental part of the new layout, and the new IDs are allocated
+		// after all of them, so the incremental bits of the new layout must cover the whole ID space of the old one.
+		oldFmt := autoid.NewShardIDFormat(&originCol.FieldType, oldShardBits, oldRangeBits)
+		newFmt := autoid.NewShardIDFormat(&originCol.FieldType, newShardBits, newRangeBits)
+		oldIDBits := oldFmt.IncrementalBits + oldFmt.ShardBits
+		if newFmt.IncrementalBits <= oldIDBits {
+			errMsg := fmt.Sprintf(autoid.AutoRandomAlterIDSpaceOverflow, oldIDBits, newFmt.IncrementalBits)
+			return 0, 0, dbterror.ErrInvalidAutoRandom.GenWithStackByArgs(errMsg)
+		}
+	}
+	return newShardBits, newRangeBits, nil
+}
+
+// encodeAutoRandomBits encodes the new auto_random bits into the job args of modify column, the range bits is put
+// in the high 32 bits, so the args are compatible with the jobs which only change the shard bits.
+func encodeAutoRandomBits(shardBits, rangeBits uint64) uint64 {
+	return shardBits | rangeBits<<32
+}
+
+func decodeAutoRandomBits(bits uint64) (shardBits, rangeBits uint64) {
+	return bits & math.MaxUint32, bits >> 32
 }
 
 func rangeBitsIsChanged(oldBits, newBits uint64) bool {
@@ -6271,6 +6529,10 @@ func (d *ddl) RenameColumn(ctx sessionctx.Context, ident ast.Ident, spec *ast.Al
 	if err != nil {
 		return errors.Trace(err)
 	}
+	err = checkDropColumnWithRowPolicy(tbl.Meta(), oldColName.L)
+	if err != nil {
+		return errors.Trace(err)
+	}
 
 	newCol := oldCol.Clone()
 	newCol.Name = newColName
@@ -6576,8 +6838,13 @@ func (d *ddl) AlterTableSetTiFlashReplica(ctx sessionctx.Context, ident ast.Iden
 // `.Enable`. If the `.TTLInfo` in the table info is empty, this function will return an error.
 // When `ttlInfo` is nil, and `ttlCronJobSchedule` is not, it will use the original `.TTLInfo` in the table info and modify the
 // `.JobInterval`. If the `.TTLInfo` in the table info is empty, this function will return an error.
+// When `ttlInfo` is nil, and `ttlCondition` is not, it will use the original `.TTLInfo` in the table info and modify the
+// `.ConditionExprStr`. If the `.TTLInfo` in the table info is empty, this function will return an error.
+// When `ttlInfo` is nil, and `scheduleOpts` is not, it will use the original `.TTLInfo` in the table info and modify the
+// job windows, delete rate limit and resource group. If the `.TTLInfo` in the table info is empty, this function will
+// return an error.
 // When `ttlInfo` is not nil, it simply submits the job with the `ttlInfo` and ignore the `ttlEnable`.
-func (d *ddl) AlterTableTTLInfoOrEnable(ctx sessionctx.Context, ident ast.Ident, ttlInfo *model.TTLInfo, ttlEnable *bool, ttlCronJobSchedule *string) error {
+func (d *ddl) AlterTableTTLInfoOrEnable(ctx sessionctx.Context, ident ast.Ident, ttlInfo *model.TTLInfo, ttlEnable *bool, ttlCronJobSchedule *string, ttlCondition *string, scheduleOpts *ttlScheduleOptions) error {
 	is := d.infoCache.GetLatest()
 	schema, ok := is.SchemaByName(ident.Schema)
 	if !ok {
@@ -6600,6 +6867,9 @@ func (d *ddl) AlterTableTTLInfoOrEnable(ctx sessionctx.Context, ident ast.Ident,
 		if err != nil {
 			return err
 		}
+		if ttlCondition != nil {
+			ttlCondition = &ttlInfo.ConditionExprStr
+		}
 	} else {
 		if tblInfo.TTLInfo == nil {
 			if ttlEnable != nil {
@@ -6608,6 +6878,26 @@ func (d *ddl) AlterTableTTLInfoOrEnable(ctx sessionctx.Context, ident ast.Ident,
 			if ttlCronJobSchedule != nil {
 				return errors.Trace(dbterror.ErrSetTTLOptionForNonTTLTable.FastGenByArgs("TTL_JOB_INTERVAL"))
 			}
+			if ttlCondition != nil {
+				return errors.Trace(dbterror.ErrSetTTLOptionForNonTTLTable.FastGenByArgs("TTL_CONDITION"))
+			}
+			if scheduleOpts != nil {
+				return errors.Trace(scheduleOpts.errForNonTTLTable())
+			}
+		} else {
+			if ttlCondition != nil {
+				tblInfo.TTLInfo.ConditionExprStr = *ttlCondition
+				if err = checkTTLConditionExpr(ctx, tblInfo); err != nil {
+					return err
+				}
+				ttlCondition = &tblInfo.TTLInfo.ConditionExprStr
+			}
+			if scheduleOpts != nil {
+				scheduleOpts.apply(tblInfo.TTLInfo)
+				if err = checkTTLResourceGroup(ctx, tblInfo.TTLInfo); err != nil {
+					return err
+				}
+			}
 		}
 	}
 
@@ -6618,7 +6908,7 @@ func (d *ddl) AlterTableTTLInfoOrEnable(ctx sessionctx.Context, ident ast.Ident,
 		TableName:      tableName,
 		Type:           model.ActionAlterTTLInfo,
 		BinlogInfo:     &model.HistoryInfo{},
-		Args:           []any{ttlInfo, ttlEnable, ttlCronJobSchedule},
+		Args:           []any{ttlInfo, ttlEnable, ttlCronJobSchedule, ttlCondition, scheduleOpts},
 		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
 		SQLMode:        ctx.GetSessionVars().SQLMode,
 	}
@@ -6664,6 +6954,162 @@ func (d *ddl) AlterTableRemoveTTL(ctx sessionctx.Context, ident ast.Ident) error
 	return nil
 }
 
+// CreateRowPolicy creates a row-level security policy on the table.
+func (d *ddl) CreateRowPolicy(ctx sessionctx.Context, stmt *ast.CreateRowPolicyStmt) error {
+	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
+	schema, tb, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := tb.Meta()
+	if err = checkPolicyTableSuitable(schema.Name, tblInfo, dbterror.ErrUnsupportedRowPolicy, stmt.PolicyName); err != nil {
+		return errors.Trace(err)
+	}
+	if tblInfo.FindRowPolicyByName(stmt.PolicyName.L) != nil {
+		err = dbterror.ErrRowPolicyExists.GenWithStackByArgs(stmt.PolicyName.O, tblInfo.Name.O)
+		if stmt.IfNotExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	exprStr, err := buildRowPolicyExprString(ctx, tblInfo, stmt.PolicyName, stmt.Expr)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	policy := &model.RowPolicyInfo{
+		Name:       stmt.PolicyName,
+		Roles:      stmt.Roles,
+		ExprString: exprStr,
+	}
+
+	job := &model.Job{
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionCreateRowPolicy,
+		BinlogInfo:     &model.HistoryInfo{},
+		Args:           []any{policy},
+		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
+		SQLMode:        ctx.GetSessionVars().SQLMode,
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// DropRowPolicy drops a row-level security policy from the table.
+func (d *ddl) DropRowPolicy(ctx sessionctx.Context, stmt *ast.DropRowPolicyStmt) error {
+	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
+	schema, tb, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := tb.Meta()
+	if tblInfo.FindRowPolicyByName(stmt.PolicyName.L) == nil {
+		err = dbterror.ErrRowPolicyNotExists.GenWithStackByArgs(stmt.PolicyName.O, tblInfo.Name.O)
+		if stmt.IfExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	job := &model.Job{
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionDropRowPolicy,
+		BinlogInfo:     &model.HistoryInfo{},
+		Args:           []any{stmt.PolicyName},
+		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
+		SQLMode:        ctx.GetSessionVars().SQLMode,
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// CreateMaskingPolicy creates a data masking policy on a column of the table.
+func (d *ddl) CreateMaskingPolicy(ctx sessionctx.Context, stmt *ast.CreateMaskingPolicyStmt) error {
+	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
+	schema, tb, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := tb.Meta()
+	if err = checkPolicyTableSuitable(schema.Name, tblInfo, dbterror.ErrUnsupportedMaskingPolicy, stmt.PolicyName); err != nil {
+		return errors.Trace(err)
+	}
+
+	policy := &model.MaskingPolicyInfo{
+		Name:          stmt.PolicyName,
+		ColumnName:    stmt.Column,
+		Roles:         stmt.Roles,
+		Type:          stmt.MaskingType,
+		PartialPrefix: int(stmt.PartialPrefix),
+		PartialSuffix: int(stmt.PartialSuffix),
+	}
+	if err = checkMaskingPolicy(tblInfo, policy); err != nil {
+		if stmt.IfNotExists && dbterror.ErrMaskingPolicyExists.Equal(err) {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return errors.Trace(err)
+	}
+
+	job := &model.Job{
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionCreateMaskingPolicy,
+		BinlogInfo:     &model.HistoryInfo{},
+		Args:           []any{policy},
+		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
+		SQLMode:        ctx.GetSessionVars().SQLMode,
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
+// DropMaskingPolicy drops a data masking policy from the table.
+func (d *ddl) DropMaskingPolicy(ctx sessionctx.Context, stmt *ast.DropMaskingPolicyStmt) error {
+	ident := ast.Ident{Schema: stmt.Table.Schema, Name: stmt.Table.Name}
+	schema, tb, err := d.getSchemaAndTableByIdent(ctx, ident)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo := tb.Meta()
+	if tblInfo.FindMaskingPolicyByName(stmt.PolicyName.L) == nil {
+		err = dbterror.ErrMaskingPolicyNotExists.GenWithStackByArgs(stmt.PolicyName.O, tblInfo.Name.O)
+		if stmt.IfExists {
+			ctx.GetSessionVars().StmtCtx.AppendNote(err)
+			return nil
+		}
+		return err
+	}
+
+	job := &model.Job{
+		SchemaID:       schema.ID,
+		TableID:        tblInfo.ID,
+		SchemaName:     schema.Name.L,
+		TableName:      tblInfo.Name.L,
+		Type:           model.ActionDropMaskingPolicy,
+		BinlogInfo:     &model.HistoryInfo{},
+		Args:           []any{stmt.PolicyName},
+		CDCWriteSource: ctx.GetSessionVars().CDCWriteSource,
+		SQLMode:        ctx.GetSessionVars().SQLMode,
+	}
+	err = d.DoDDLJob(ctx, job)
+	err = d.callHookOnChanged(job, err)
+	return errors.Trace(err)
+}
+
 func isTableTiFlashSupported(dbName model.CIStr, tbl *model.TableInfo) error {
 	// Memory tables and system tables are not supported by TiFlash
 	if util.IsMemOrSysDB(dbName.L) {
@@ -7098,6 +7544,9 @@ func (d *ddl) TruncateTable(ctx sessionctx.Context, ti ast.Ident) error {
 	if tb.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 		return dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Truncate Table")
 	}
+	if tb.Meta().IsExternal() {
+		return dbterror.ErrUnsupportedExternalTable.GenWithStackByArgs(tb.Meta().Name.O, "TRUNCATE TABLE is not supported")
+	}
 	fkCheck := ctx.GetSessionVars().ForeignKeyChecks
 	referredFK := checkTableHasForeignKeyReferred(d.GetInfoSchemaWithInterceptor(ctx), ti.Schema.L, ti.Name.L, []ast.Ident{{Name: ti.Name, Schema: ti.Schema}}, fkCheck)
 	if referredFK != nil {
@@ -7492,6 +7941,62 @@ func precheckBuildHiddenColumnInfo(
 	return nil
 }
 
+// buildFullTextIndexPart converts the key parts of a FULLTEXT index to an expression key part on the tokens of the
+// columns, like `CAST(tidb_fulltext_tokens(a, b) AS CHAR(64) ARRAY)`, so the FULLTEXT index is stored as a
+// multi-valued index, which is the inverted index from the tokens to the rows.
+// Like the previous versions, the FULLTEXT index on the columns which are not non-binary strings is ignored with a
+// warning, and nil is returned.
+func buildFullTextIndexPart(ctx sessionctx.Context, tblInfo *model.TableInfo, indexPartSpecifications []*ast.IndexPartSpecification) ([]*ast.IndexPartSpecification, error) {
+	ftCols := make(map[string]struct{}, len(indexPartSpecifications))
+	for _, idxPart := range indexPartSpecifications {
+		if idxPart.Expr != nil {
+			return nil, dbterror.ErrUnsupportedIndexType.GenWithStack("FULLTEXT index on expression is not supported")
+		}
+		col := model.FindColumnInfo(tblInfo.Columns, idxPart.Column.Name.L)
+		if col == nil {
+			return nil, dbterror.ErrKeyColumnDoesNotExits.GenWithStackByArgs(idxPart.Column.Name)
+		}
+		if col.Hidden {
+			return nil, dbterror.ErrBadFtColumn.GenWithStackByArgs(idxPart.Column.Name)
+		}
+		if !types.IsNonBinaryStr(&col.FieldType) {
+			ctx.GetSessionVars().StmtCtx.AppendWarning(dbterror.ErrTableCantHandleFt.FastGenByArgs())
+			return nil, nil
+		}
+		ftCols[col.Name.L] = struct{}{}
+	}
+	// The columns are ordered by the offsets, so the FULLTEXT indexes on the same columns are the same.
+	args := make([]ast.ExprNode, 0, len(ftCols))
+	for _, col := range tblInfo.Columns {
+		if _, ok := ftCols[col.Name.L]; ok {
+			args = append(args, &ast.ColumnNameExpr{Name: &ast.ColumnName{Name: col.Name}})
+		}
+	}
+	tp := types.NewFieldType(mysql.TypeVarString)
+	tp.SetFlen(expression.FullTextTokenMaxLen)
+	tp.SetCharset(charset.CharsetUTF8MB4)
+	tp.SetCollate(charset.CollationUTF8MB4)
+	tp.SetArray(true)
+	return []*ast.IndexPartSpecification{{
+		Expr: &ast.FuncCastExpr{
+			Expr:         &ast.FuncCallExpr{FnName: model.NewCIStr(ast.TiDBFullTextTokens), Args: args},
+			Tp:           tp,
+			FunctionType: ast.CastFunction,
+		},
+		Length: types.UnspecifiedLength,
+	}}, nil
+}
+
+// buildFullTextIndexOption returns the index option of a FULLTEXT index, it doesn't modify the original one.
+func buildFullTextIndexOption(indexOption *ast.IndexOption) *ast.IndexOption {
+	ftIndexOption := &ast.IndexOption{}
+	if indexOption != nil {
+		*ftIndexOption = *indexOption
+	}
+	ftIndexOption.Tp = model.IndexTypeFullText
+	return ftIndexOption
+}
+
 func buildHiddenColumnInfoWithCheck(ctx sessionctx.Context, indexPartSpecifications []*ast.IndexPartSpecification, indexName model.CIStr, tblInfo *model.TableInfo, existCols []*table.Column) ([]*model.ColumnInfo, error) {
 	if err := precheckBuildHiddenColumnInfo(indexPartSpecifications, indexName); err != nil {
 		return nil, err
@@ -7609,9 +8114,9 @@ func (*ddl) addHypoIndexIntoCtx(ctx sessionctx.Context, schemaName, tableName mo
 
 func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.IndexKeyType, indexName model.CIStr,
 	indexPartSpecifications []*ast.IndexPartSpecification, indexOption *ast.IndexOption, ifNotExists bool) error {
-	// not support Spatial and FullText index
-	if keyType == ast.IndexKeyTypeFullText || keyType == ast.IndexKeyTypeSpatial {
-		return dbterror.ErrUnsupportedIndexType.GenWithStack("FULLTEXT and SPATIAL index is not supported")
+	// not support Spatial index
+	if keyType == ast.IndexKeyTypeSpatial {
+		return dbterror.ErrUnsupportedIndexType.GenWithStack("SPATIAL index is not supported")
 	}
 	unique := keyType == ast.IndexKeyTypeUnique
 	schema, t, err := d.getSchemaAndTableByIdent(ctx, ti)
@@ -7622,6 +8127,9 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 	if t.Meta().TableCacheStatusType != model.TableCacheStatusDisable {
 		return errors.Trace(dbterror.ErrOptOnCacheTable.GenWithStackByArgs("Create Index"))
 	}
+	if t.Meta().IsExternal() {
+		return errors.Trace(dbterror.ErrUnsupportedExternalTable.GenWithStackByArgs(t.Meta().Name.O, "indexes are not supported"))
+	}
 	// Deal with anonymous index.
 	if len(indexName.L) == 0 {
 		colName := model.NewCIStr("expression_index")
@@ -7631,6 +8139,17 @@ func (d *ddl) createIndex(ctx sessionctx.Context, ti ast.Ident, keyType ast.Inde
 		indexName = GetName4AnonymousIndex(t, colName, model.NewCIStr(""))
 	}
 
+	if keyType == ast.IndexKeyTypeFullText {
+		indexPartSpecifications, err = buildFullTextIndexPart(ctx, t.Meta(), indexPartSpecifications)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		if indexPartSpecifications == nil {
+			return nil
+		}
+		indexOption = buildFullTextIndexOption(indexOption)
+	}
+
 	if indexInfo := t.Meta().FindIndexByName(indexName.L); indexInfo != nil {
 		if indexInfo.State != model.StatePublic {
 			// NOTE: explicit error message. See issue #18363.
@@ -8955,6 +9474,9 @@ func (d *ddl) AddResourceGroup(ctx sessionctx.Context, stmt *ast.CreateResourceG
 	if err := d.checkResourceGroupValidation(groupInfo); err != nil {
 		return err
 	}
+	if err := checkResourceGroupOptimizerVars(ctx, groupInfo.ResourceGroupSettings); err != nil {
+		return err
+	}
 
 	logutil.DDLLogger().Debug("create resource group", zap.String("name", groupName.O), zap.Stringer("resource group settings", groupInfo.ResourceGroupSettings))
 	groupIDs, err := d.genGlobalIDs(1)
@@ -8985,6 +9507,26 @@ func (*ddl) checkResourceGroupValidation(groupInfo *model.ResourceGroupInfo) err
 	return err
 }
 
+// checkResourceGroupOptimizerVars checks the optimizer variables of the resource group and normalizes their values.
+// Only the variables which can be set by the SET_VAR hint are allowed.
+func checkResourceGroupOptimizerVars(ctx sessionctx.Context, settings *model.ResourceGroupSettings) error {
+	for i, v := range settings.OptimizerVars {
+		sysVar := variable.GetSysVar(v.Name)
+		if sysVar == nil {
+			return variable.ErrUnknownSystemVar.GenWithStackByArgs(v.Name)
+		}
+		if !sysVar.IsHintUpdatableVerified {
+			return errors.Errorf("variable '%s' can't be overridden by resource group", v.Name)
+		}
+		value, err := sysVar.Validate(ctx.GetSessionVars(), v.Value, variable.ScopeSession)
+		if err != nil {
+			return err
+		}
+		settings.OptimizerVars[i].Value = value
+	}
+	return nil
+}
+
 // DropResourceGroup implements the DDL interface.
 func (d *ddl) DropResourceGroup(ctx sessionctx.Context, stmt *ast.DropResourceGroupStmt) (err error) {
 	groupName := stmt.ResourceGroupName
@@ -9035,7 +9577,7 @@ func (d *ddl) DropResourceGroup(ctx sessionctx.Context, stmt *ast.DropResourceGr
 func buildResourceGroup(oldGroup *model.ResourceGroupInfo, options []*ast.ResourceGroupOption) (*model.ResourceGroupInfo, error) {
 	groupInfo := &model.ResourceGroupInfo{Name: oldGroup.Name, ID: oldGroup.ID, ResourceGroupSettings: model.NewResourceGroupSettings()}
 	if oldGroup.ResourceGroupSettings != nil {
-		*groupInfo.ResourceGroupSettings = *oldGroup.ResourceGroupSettings
+		groupInfo.ResourceGroupSettings = oldGroup.ResourceGroupSettings.Clone()
 	}
 	for _, opt := range options {
 		err := SetDirectResourceGroupSettings(groupInfo, opt)
@@ -9069,6 +9611,9 @@ func (d *ddl) AlterResourceGroup(ctx sessionctx.Context, stmt *ast.AlterResource
 	if err := d.checkResourceGroupValidation(newGroupInfo); err != nil {
 		return err
 	}
+	if err := checkResourceGroupOptimizerVars(ctx, newGroupInfo.ResourceGroupSettings); err != nil {
+		return err
+	}
 
 	logutil.DDLLogger().Debug("alter resource group", zap.String("name", groupName.L), zap.Stringer("new resource group settings", newGroupInfo.ResourceGroupSettings))
 
@@ -1250,7 +1250,7 @@ func (w *worker) runDDLJob(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64,
 	case model.ActionExchangeTablePartition:
 		ver, err = w.onExchangeTablePartition(d, t, job)
 	case model.ActionAddColumn:
-		ver, err = onAddColumn(d, t, job)
+		ver, err = onAddColumn(w, d, t, job)
 	case model.ActionDropColumn:
 		ver, err = onDropColumn(d, t, job)
 	case model.ActionModifyColumn:
@@ -1340,6 +1340,14 @@ func (w *worker) runDDLJob(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64,
 		ver, err = onTTLInfoChange(d, t, job)
 	case model.ActionAlterTTLRemove:
 		ver, err = onTTLInfoRemove(d, t, job)
+	case model.ActionCreateRowPolicy:
+		ver, err = onCreateRowPolicy(d, t, job)
+	case model.ActionDropRowPolicy:
+		ver, err = onDropRowPolicy(d, t, job)
+	case model.ActionCreateMaskingPolicy:
+		ver, err = onCreateMaskingPolicy(d, t, job)
+	case model.ActionDropMaskingPolicy:
+		ver, err = onDropMaskingPolicy(d, t, job)
 	case model.ActionAddCheckConstraint:
 		ver, err = w.onAddCheckConstraint(d, t, job)
 	case model.ActionDropCheckConstraint:
@@ -0,0 +1,149 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/util/dbterror"
+	"github.com/pingcap/tidb/pkg/util/stringutil"
+)
+
+// checkExternalTableValid checks the definition of an external table. The rows of the external table are read from
+// the data files directly, so the definitions relying on the data stored in TiKV are not supported.
+func checkExternalTableValid(tblInfo *model.TableInfo) error {
+	info := tblInfo.ExternalInfo
+	if info.Location == "" {
+		return dbterror.ErrUnsupportedExternalTable.GenWithStackByArgs(tblInfo.Name.O, "EXTERNAL_LOCATION is required")
+	}
+	switch info.Format {
+	case model.ExternalTableFormatCSV, model.ExternalTableFormatParquet:
+	default:
+		return dbterror.ErrUnsupportedExternalTable.GenWithStackByArgs(tblInfo.Name.O, fmt.Sprintf("unknown EXTERNAL_FORMAT '%s'", info.Format))
+	}
+	u, err := storage.ParseRawURL(info.Location)
+	if err != nil {
+		return dbterror.ErrUnsupportedExternalTable.GenWithStackByArgs(tblInfo.Name.O, err.Error())
+	}
+	// reading the files on the disk of TiDB server is not allowed, same as LOAD DATA.
+	if storage.IsLocal(u) {
+		return dbterror.ErrUnsupportedExternalTable.GenWithStackByArgs(tblInfo.Name.O, "EXTERNAL_LOCATION must be in the external storage")
+	}
+	if _, err = filepath.Match(stringutil.EscapeGlobQuestionMark(strings.Trim(u.Path, "/")), ""); err != nil {
+		return dbterror.ErrUnsupportedExternalTable.GenWithStackByArgs(tblInfo.Name.O, "glob pattern error: "+err.Error())
+	}
+
+	var reason string
+	switch {
+	case len(tblInfo.Indices) > 0 || tblInfo.PKIsHandle:
+		reason = "indexes are not supported"
+	case len(tblInfo.ForeignKeys) > 0:
+		reason = "foreign keys are not supported"
+	case tblInfo.Partition != nil:
+		reason = "partitions are not supported"
+	case tblInfo.TTLInfo != nil:
+		reason = "TTL is not supported"
+	case tblInfo.TempTableType != model.TempTableNone:
+		reason = "temporary tables are not supported"
+	}
+	for _, col := range tblInfo.Columns {
+		if reason != "" {
+			break
+		}
+		if col.IsGenerated() {
+			reason = "generated columns are not supported"
+		} else if mysql.HasAutoIncrementFlag(col.GetFlag()) {
+			reason = "AUTO_INCREMENT columns are not supported"
+		}
+	}
+	if reason != "" {
+		return dbterror.ErrUnsupportedExternalTable.GenWithStackByArgs(tblInfo.Name.O, reason)
+	}
+	return nil
+}
+
+// externalLocationCredentials are the query parameters of the storage URL which carry the credentials, the keys
+// are normalized in the same way as br/pkg/storage.
+var externalLocationCredentials = map[string]struct{}{
+	"access-key":        {},
+	"secret-access-key": {},
+	"session-token":     {},
+	"credentials-file":  {},
+	"account-key":       {},
+	"sas-token":         {},
+}
+
+// stripExternalLocationCredentials removes the credentials from the location of the external table, since the
+// location is visible in the table definition. The files of external tables are read with the credentials of the
+// TiDB server.
+func stripExternalLocationCredentials(ctx sessionctx.Context, info *model.ExternalTableInfo) {
+	base, rawQuery, ok := strings.Cut(info.Location, "?")
+	if !ok || rawQuery == "" {
+		return
+	}
+	params := strings.Split(rawQuery, "&")
+	kept := params[:0]
+	for _, param := range params {
+		key, _, _ := strings.Cut(param, "=")
+		if unescaped, err := url.QueryUnescape(key); err == nil {
+			key = unescaped
+		}
+		if _, ok := externalLocationCredentials[strings.ToLower(strings.ReplaceAll(key, "_", "-"))]; ok {
+			continue
+		}
+		kept = append(kept, param)
+	}
+	if len(kept) == len(params) {
+		return
+	}
+	info.Location = base
+	if len(kept) > 0 {
+		info.Location += "?" + strings.Join(kept, "&")
+	}
+	ctx.GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackError(
+		"the credentials in EXTERNAL_LOCATION are not stored, the data files are read with the credentials of TiDB server"))
+}
+
+// checkAlterExternalTable checks the ALTER TABLE statement on an external table. The schema of the external table
+// is bound to the data files, so only renaming the table and changing the comment are supported.
+func checkAlterExternalTable(tblInfo *model.TableInfo, specs []*ast.AlterTableSpec) error {
+	for _, spec := range specs {
+		switch spec.Tp {
+		case ast.AlterTableRenameTable:
+			continue
+		case ast.AlterTableOption:
+			onlyComment := true
+			for _, opt := range spec.Options {
+				if opt.Tp != ast.TableOptionComment {
+					onlyComment = false
+				}
+			}
+			if onlyComment {
+				continue
+			}
+		}
+		return dbterror.ErrUnsupportedExternalTable.GenWithStackByArgs(tblInfo.Name.O, "only RENAME and COMMENT are supported by ALTER TABLE")
+	}
+	return nil
+}
@@ -266,6 +266,9 @@ func checkModifyGeneratedColumn(sctx sessionctx.Context, schemaName model.CIStr,
 				return errors.Trace(err)
 			}
 		}
+		if err := checkGeneratedColumnWithMaskingPolicy(tbl.Meta(), newColDef.Name.Name.O, dependColNames); err != nil {
+			return errors.Trace(err)
+		}
 
 		// rule 5.
 		if err := checkIndexOrStored(tbl, oldCol, newCol); err != nil {
@@ -0,0 +1,145 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"fmt"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/meta"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/dbterror"
+)
+
+func onCreateMaskingPolicy(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	policy := &model.MaskingPolicyInfo{}
+	if err := job.DecodeArgs(policy); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if err = checkMaskingPolicy(tblInfo, policy); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	tblInfo.MaskingPolicies = append(tblInfo.MaskingPolicies, policy)
+	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
+
+func onDropMaskingPolicy(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	var policyName model.CIStr
+	if err := job.DecodeArgs(&policyName); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	policies := make([]*model.MaskingPolicyInfo, 0, len(tblInfo.MaskingPolicies))
+	for _, p := range tblInfo.MaskingPolicies {
+		if p.Name.L != policyName.L {
+			policies = append(policies, p)
+		}
+	}
+	if len(policies) == len(tblInfo.MaskingPolicies) {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrMaskingPolicyNotExists.GenWithStackByArgs(policyName.O, tblInfo.Name.O)
+	}
+	if len(policies) == 0 {
+		policies = nil
+	}
+
+	tblInfo.MaskingPolicies = policies
+	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
+
+// checkMaskingPolicy checks the masking policy can be created on the table.
+// Every column can be masked by at most one policy.
+func checkMaskingPolicy(tblInfo *model.TableInfo, policy *model.MaskingPolicyInfo) error {
+	if tblInfo.FindMaskingPolicyByName(policy.Name.L) != nil {
+		return dbterror.ErrMaskingPolicyExists.GenWithStackByArgs(policy.Name.O, tblInfo.Name.O)
+	}
+	colInfo := model.FindColumnInfo(tblInfo.Columns, policy.ColumnName.L)
+	if colInfo == nil || colInfo.Hidden || colInfo.State != model.StatePublic {
+		return dbterror.ErrBadField.GenWithStackByArgs(policy.ColumnName.O, "masking policy")
+	}
+	if p := tblInfo.FindMaskingPolicyByColumn(colInfo.Name.L); p != nil {
+		return dbterror.ErrUnsupportedMaskingPolicy.GenWithStackByArgs(policy.Name.O,
+			fmt.Sprintf("column '%s' is already masked by policy '%s'", colInfo.Name.O, p.Name.O))
+	}
+	// The handle columns are required to locate the rows, so they are never masked.
+	if mysql.HasPriKeyFlag(colInfo.GetFlag()) && (tblInfo.PKIsHandle || tblInfo.IsCommonHandle) {
+		return dbterror.ErrUnsupportedMaskingPolicy.GenWithStackByArgs(policy.Name.O, "columns of the clustered primary key are not supported")
+	}
+	// The generated columns are computed from the original values, so the masked values could be bypassed by them.
+	for _, col := range tblInfo.Columns {
+		if _, ok := col.Dependences[colInfo.Name.L]; ok && !col.Hidden {
+			return dbterror.ErrUnsupportedMaskingPolicy.GenWithStackByArgs(policy.Name.O,
+				fmt.Sprintf("column '%s' is used by generated column '%s'", colInfo.Name.O, col.Name.O))
+		}
+	}
+	if policy.Type == model.MaskingTypePartial && !types.IsString(colInfo.GetType()) {
+		return dbterror.ErrUnsupportedMaskingPolicy.GenWithStackByArgs(policy.Name.O, "PARTIAL masking is only supported on string columns")
+	}
+	policy.ColumnName = colInfo.Name
+	return nil
+}
+
+// checkGeneratedColumnWithMaskingPolicy checks the generated column doesn't depend on the masked columns.
+func checkGeneratedColumnWithMaskingPolicy(tblInfo *model.TableInfo, colName string, dependences map[string]struct{}) error {
+	for _, p := range tblInfo.MaskingPolicies {
+		if _, ok := dependences[p.ColumnName.L]; ok {
+			return dbterror.ErrUnsupportedMaskingPolicy.GenWithStackByArgs(p.Name.O,
+				fmt.Sprintf("column '%s' is used by generated column '%s'", p.ColumnName.O, colName))
+		}
+	}
+	return nil
+}
+
+func checkDropColumnWithMaskingPolicy(tblInfo *model.TableInfo, colName string) error {
+	if p := tblInfo.FindMaskingPolicyByColumn(colName); p != nil {
+		return dbterror.ErrMaskedColumnCannotDrop.GenWithStackByArgs(colName, p.Name.O)
+	}
+	return nil
+}
+
+// updateMaskingPoliciesWhenModifyColumn keeps the masking policy attached to the column when it's renamed.
+func updateMaskingPoliciesWhenModifyColumn(tblInfo *model.TableInfo, oldCol, newCol model.CIStr) {
+	if oldCol.L == newCol.L {
+		return
+	}
+	if p := tblInfo.FindMaskingPolicyByColumn(oldCol.L); p != nil {
+		p.ColumnName = newCol
+	}
+}
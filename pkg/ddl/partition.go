@@ -46,7 +46,6 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/opcode"
 	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/sessionctx"
-	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	statsutil "github.com/pingcap/tidb/pkg/statistics/handle/util"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/table/tables"
@@ -2652,6 +2651,12 @@ func (w *worker) onExchangeTablePartition(d *ddlCtx, t *meta.Meta, job *model.Jo
 		return ver, errors.Trace(err)
 	}
 
+	if !withValidation {
+		// The exchanged rows aren't verified against the check constraints.
+		invalidateCheckConstraints(pt)
+		invalidateCheckConstraints(nt)
+	}
+
 	// exchange table meta id
 	pt.ExchangePartitionInfo = nil
 	// Used below to update the partitioned table's stats meta.
@@ -2778,7 +2783,9 @@ func getReorgPartitionInfo(t *meta.Meta, job *model.Job) (*model.TableInfo, []st
 	}
 	partInfo := &model.PartitionInfo{}
 	var partNames []string
-	err = job.DecodeArgs(&partNames, &partInfo)
+	// carryOverStats is only decoded to be kept in the job args, see reorgPartitionCarriesOverStats.
+	var carryOverStats bool
+	err = job.DecodeArgs(&partNames, &partInfo, &carryOverStats)
 	if err != nil {
 		job.State = model.JobStateCancelled
 		return nil, nil, nil, nil, nil, errors.Trace(err)
@@ -3125,6 +3132,7 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 		event, err := newStatsDDLEventForJob(
 			job.SchemaID,
 			job.Type, oldTblID, tblInfo, statisticsPartInfo, droppedPartInfo,
+			reorgPartitionCarriesOverStats(job),
 		)
 		if err != nil {
 			return ver, errors.Trace(err)
@@ -3140,6 +3148,16 @@ func (w *worker) onReorganizePartition(d *ddlCtx, t *meta.Meta, job *model.Job)
 	return ver, errors.Trace(err)
 }
 
+// reorgPartitionCarriesOverStats returns whether the count of the dropped partitions is carried over to the added
+// partitions. It's the third argument of the job, which is only true for SPLIT PARTITION and MERGE PARTITIONS.
+func reorgPartitionCarriesOverStats(job *model.Job) bool {
+	if len(job.Args) < 3 {
+		return false
+	}
+	carryOverStats, ok := job.Args[2].(*bool)
+	return ok && *carryOverStats
+}
+
 // newStatsDDLEventForJob creates a statsutil.DDLEvent for a job.
 // It is used for reorganize partition, add partitioning and remove partitioning.
 func newStatsDDLEventForJob(
@@ -3149,6 +3167,7 @@ func newStatsDDLEventForJob(
 	tblInfo *model.TableInfo,
 	addedPartInfo *model.PartitionInfo,
 	droppedPartInfo *model.PartitionInfo,
+	carryOverStats bool,
 ) (*statsutil.DDLEvent, error) {
 	var event *statsutil.DDLEvent
 	switch jobType {
@@ -3158,6 +3177,7 @@ func newStatsDDLEventForJob(
 			tblInfo,
 			addedPartInfo,
 			droppedPartInfo,
+			carryOverStats,
 		)
 	case model.ActionAlterTablePartitioning:
 		event = statsutil.NewAddPartitioningEvent(
@@ -3652,20 +3672,20 @@ func checkExchangePartitionRecordValidation(w *worker, ptbl, ntbl table.Table, p
 		return dbterror.ErrUnsupportedPartitionType.GenWithStackByArgs(pt.Name.O)
 	}
 
-	if variable.EnableCheckConstraint.Load() {
-		pcc, ok := ptbl.(CheckConstraintTable)
-		if !ok {
-			return errors.Errorf("exchange partition process assert table partition failed")
-		}
-		pCons := pcc.WritableConstraint()
-		if len(pCons) > 0 {
-			if !checkNt {
-				checkNt = true
-			} else {
-				buf.WriteString(" or ")
-			}
-			buf.WriteString(genConstraintCondition(pCons))
+	// The check constraints are verified even if tidb_enable_check_constraint is off, since they are enforced on
+	// the other writes and the validated constraints are used by the optimizer.
+	pcc, ok := ptbl.(CheckConstraintTable)
+	if !ok {
+		return errors.Errorf("exchange partition process assert table partition failed")
+	}
+	pCons := pcc.WritableConstraint()
+	if len(pCons) > 0 {
+		if !checkNt {
+			checkNt = true
+		} else {
+			buf.WriteString(" or ")
 		}
+		buf.WriteString(genConstraintCondition(pCons))
 	}
 	// Check non-partition table records.
 	if checkNt {
@@ -3677,21 +3697,19 @@ func checkExchangePartitionRecordValidation(w *worker, ptbl, ntbl table.Table, p
 	}
 
 	// Check partition table records.
-	if variable.EnableCheckConstraint.Load() {
-		ncc, ok := ntbl.(CheckConstraintTable)
-		if !ok {
-			return errors.Errorf("exchange partition process assert table partition failed")
-		}
-		nCons := ncc.WritableConstraint()
-		if len(nCons) > 0 {
-			buf.Reset()
-			buf.WriteString("select 1 from %n.%n partition(%n) where ")
-			buf.WriteString(genConstraintCondition(nCons))
-			buf.WriteString(" limit 1")
-			err = verifyFunc(buf.String(), pschemaName, pt.Name.L, partitionName)
-			if err != nil {
-				return errors.Trace(err)
-			}
+	ncc, ok := ntbl.(CheckConstraintTable)
+	if !ok {
+		return errors.Errorf("exchange partition process assert table partition failed")
+	}
+	nCons := ncc.WritableConstraint()
+	if len(nCons) > 0 {
+		buf.Reset()
+		buf.WriteString("select 1 from %n.%n partition(%n) where ")
+		buf.WriteString(genConstraintCondition(nCons))
+		buf.WriteString(" limit 1")
+		err = verifyFunc(buf.String(), pschemaName, pt.Name.L, partitionName)
+		if err != nil {
+			return errors.Trace(err)
 		}
 	}
 	return nil
@@ -510,7 +510,9 @@ func convertJob2RollbackJob(w *worker, d *ddlCtx, t *meta.Meta, job *model.Job)
 		model.ActionModifyTableCharsetAndCollate,
 		model.ActionModifySchemaCharsetAndCollate, model.ActionRepairTable,
 		model.ActionModifyTableAutoIdCache, model.ActionAlterIndexVisibility,
-		model.ActionModifySchemaDefaultPlacement, model.ActionRecoverSchema:
+		model.ActionModifySchemaDefaultPlacement, model.ActionRecoverSchema,
+		model.ActionCreateRowPolicy, model.ActionDropRowPolicy,
+		model.ActionCreateMaskingPolicy, model.ActionDropMaskingPolicy:
 		ver, err = cancelOnlyNotHandledJob(job, model.StateNone)
 	case model.ActionMultiSchemaChange:
 		err = rollingBackMultiSchemaChange(job)
@@ -0,0 +1,156 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ddl
+
+import (
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/meta"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/dbterror"
+)
+
+func onCreateRowPolicy(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	policy := &model.RowPolicyInfo{}
+	if err := job.DecodeArgs(policy); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	if tblInfo.FindRowPolicyByName(policy.Name.L) != nil {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrRowPolicyExists.GenWithStackByArgs(policy.Name.O, tblInfo.Name.O)
+	}
+
+	tblInfo.RowPolicies = append(tblInfo.RowPolicies, policy)
+	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
+
+func onDropRowPolicy(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err error) {
+	var policyName model.CIStr
+	if err := job.DecodeArgs(&policyName); err != nil {
+		job.State = model.JobStateCancelled
+		return ver, errors.Trace(err)
+	}
+
+	tblInfo, err := GetTableInfoAndCancelFaultJob(t, job, job.SchemaID)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	policies := make([]*model.RowPolicyInfo, 0, len(tblInfo.RowPolicies))
+	for _, p := range tblInfo.RowPolicies {
+		if p.Name.L != policyName.L {
+			policies = append(policies, p)
+		}
+	}
+	if len(policies) == len(tblInfo.RowPolicies) {
+		job.State = model.JobStateCancelled
+		return ver, dbterror.ErrRowPolicyNotExists.GenWithStackByArgs(policyName.O, tblInfo.Name.O)
+	}
+	if len(policies) == 0 {
+		policies = nil
+	}
+
+	tblInfo.RowPolicies = policies
+	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
+	if err != nil {
+		return ver, errors.Trace(err)
+	}
+	job.FinishTableJob(model.JobStateDone, model.StatePublic, ver, tblInfo)
+	return ver, nil
+}
+
+// checkPolicyTableSuitable returns whether row policies or masking policies can be defined on the table.
+// `errUnsupported` is the error returned for the unsupported tables.
+func checkPolicyTableSuitable(schema model.CIStr, tblInfo *model.TableInfo, errUnsupported *terror.Error, policyName model.CIStr) error {
+	if util.IsMemOrSysDB(schema.L) {
+		return errUnsupported.GenWithStackByArgs(policyName.O, "system tables are not supported")
+	}
+	if tblInfo.IsView() || tblInfo.IsSequence() {
+		return dbterror.ErrWrongObject.GenWithStackByArgs(schema.O, tblInfo.Name.O, "BASE TABLE")
+	}
+	if tblInfo.TempTableType != model.TempTableNone {
+		return errUnsupported.GenWithStackByArgs(policyName.O, "temporary tables are not supported")
+	}
+	if tblInfo.IsExternal() {
+		return errUnsupported.GenWithStackByArgs(policyName.O, "external tables are not supported")
+	}
+	return nil
+}
+
+// buildRowPolicyExprString checks the predicate of a row policy is a deterministic expression of the table
+// columns and returns its normalized form. The predicate is injected into every query reading the table, so it
+// must be able to be built with only the columns of the table.
+func buildRowPolicyExprString(ctx sessionctx.Context, tblInfo *model.TableInfo, name model.CIStr, node ast.ExprNode) (string, error) {
+	var c illegalFunctionChecker
+	node.Accept(&c)
+	if c.otherErr != nil {
+		return "", errors.Trace(c.otherErr)
+	}
+	if c.hasIllegalFunc || c.hasRowVal {
+		return "", dbterror.ErrUnsupportedRowPolicy.GenWithStackByArgs(name.O, "only deterministic functions of the table columns are allowed")
+	}
+	if c.hasAggFunc || c.hasWindowFunc {
+		return "", dbterror.ErrUnsupportedRowPolicy.GenWithStackByArgs(name.O, "aggregate and window functions are not allowed")
+	}
+
+	expr, err := expression.BuildSimpleExpr(ctx.GetExprCtx(), node, expression.WithTableInfo("", tblInfo))
+	if err != nil {
+		return "", errors.Trace(err)
+	}
+	if expression.CheckNonDeterministic(expr) || expression.IsMutableEffectsExpr(expr) {
+		return "", dbterror.ErrUnsupportedRowPolicy.GenWithStackByArgs(name.O, "only deterministic functions of the table columns are allowed")
+	}
+
+	exprStr, err := restoreTTLCondition(node)
+	return exprStr, errors.Trace(err)
+}
+
+// rowPolicyReferColumn returns the first row policy referring to the column, or nil if there is none.
+func rowPolicyReferColumn(tblInfo *model.TableInfo, colName string) *model.RowPolicyInfo {
+	for _, p := range tblInfo.RowPolicies {
+		// The predicate has been validated when the policy is created, so it should always be parsed successfully.
+		node, err := parseTTLCondition(p.ExprString)
+		if err != nil {
+			continue
+		}
+		for _, col := range FindColumnNamesInExpr(node) {
+			if col.Name.L == colName {
+				return p
+			}
+		}
+	}
+	return nil
+}
+
+func checkDropColumnWithRowPolicy(tblInfo *model.TableInfo, colName string) error {
+	if p := rowPolicyReferColumn(tblInfo, colName); p != nil {
+		return dbterror.ErrRowPolicyColumnCannotDrop.GenWithStackByArgs(colName, p.Name.O)
+	}
+	return nil
+}
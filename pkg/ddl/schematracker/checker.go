@@ -447,6 +447,26 @@ func (*Checker) AlterResourceGroup(_ sessionctx.Context, _ *ast.AlterResourceGro
 	return nil
 }
 
+// CreateRowPolicy implements the DDL interface.
+func (d *Checker) CreateRowPolicy(ctx sessionctx.Context, stmt *ast.CreateRowPolicyStmt) error {
+	return d.realDDL.CreateRowPolicy(ctx, stmt)
+}
+
+// DropRowPolicy implements the DDL interface.
+func (d *Checker) DropRowPolicy(ctx sessionctx.Context, stmt *ast.DropRowPolicyStmt) error {
+	return d.realDDL.DropRowPolicy(ctx, stmt)
+}
+
+// CreateMaskingPolicy implements the DDL interface.
+func (d *Checker) CreateMaskingPolicy(ctx sessionctx.Context, stmt *ast.CreateMaskingPolicyStmt) error {
+	return d.realDDL.CreateMaskingPolicy(ctx, stmt)
+}
+
+// DropMaskingPolicy implements the DDL interface.
+func (d *Checker) DropMaskingPolicy(ctx sessionctx.Context, stmt *ast.DropMaskingPolicyStmt) error {
+	return d.realDDL.DropMaskingPolicy(ctx, stmt)
+}
+
 // CreateSchemaWithInfo implements the DDL interface.
 func (d *Checker) CreateSchemaWithInfo(ctx sessionctx.Context, info *model.DBInfo, onExist ddl.OnExist) error {
 	err := d.realDDL.CreateSchemaWithInfo(ctx, info, onExist)
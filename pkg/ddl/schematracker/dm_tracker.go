@@ -1033,6 +1033,8 @@ func (d SchemaTracker) AlterTable(ctx context.Context, sctx sessionctx.Context,
 			ast.AlterTableDropForeignKey,
 			ast.AlterTableCoalescePartitions,
 			ast.AlterTableReorganizePartition,
+			ast.AlterTableSplitPartition,
+			ast.AlterTableMergePartitions,
 			ast.AlterTableCheckPartitions,
 			ast.AlterTableRebuildPartition,
 			ast.AlterTableOptimizePartition,
@@ -1186,6 +1188,26 @@ func (SchemaTracker) AlterResourceGroup(_ sessionctx.Context, _ *ast.AlterResour
 	return nil
 }
 
+// CreateRowPolicy implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) CreateRowPolicy(_ sessionctx.Context, _ *ast.CreateRowPolicyStmt) error {
+	return nil
+}
+
+// DropRowPolicy implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) DropRowPolicy(_ sessionctx.Context, _ *ast.DropRowPolicyStmt) error {
+	return nil
+}
+
+// CreateMaskingPolicy implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) CreateMaskingPolicy(_ sessionctx.Context, _ *ast.CreateMaskingPolicyStmt) error {
+	return nil
+}
+
+// DropMaskingPolicy implements the DDL interface, it's no-op in DM's case.
+func (SchemaTracker) DropMaskingPolicy(_ sessionctx.Context, _ *ast.DropMaskingPolicyStmt) error {
+	return nil
+}
+
 // BatchCreateTableWithInfo implements the DDL interface, it will call CreateTableWithInfo for each table.
 func (d SchemaTracker) BatchCreateTableWithInfo(ctx sessionctx.Context, schema model.CIStr, info []*model.TableInfo, cs ...ddl.CreateTableWithInfoConfigurier) error {
 	for _, tableInfo := range info {
@@ -15,6 +15,7 @@
 package ddl_test
 
 import (
+	"fmt"
 	"testing"
 	"time"
 
@@ -545,3 +546,48 @@ func BenchmarkInsertCacheDefaultExpr(b *testing.B) {
 		tk.MustExec(sql)
 	}
 }
+
+func TestSequenceSessionCache(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	var refills []string
+	tables.RegisterSequenceCacheExhaustedFunc(func(dbName, seqName string, base, end int64) {
+		if seqName == "seq_cache" {
+			refills = append(refills, fmt.Sprintf("%s.%s %d %d", dbName, seqName, base, end))
+		}
+	})
+	tk.MustExec("drop sequence if exists seq_cache")
+	tk.MustExec("create sequence seq_cache cache 10")
+	tk.MustExec("set @@tidb_sequence_session_cache_size = 3")
+	tk.MustQuery("select nextval(seq_cache)").Check(testkit.Rows("1"))
+	require.Equal(t, []string{"test.seq_cache 0 10"}, refills)
+	// The session takes 2 more values from the instance cache.
+	cacheQuery := "select scope, next_value, last_value, remaining from information_schema.tidb_sequence_cache where sequence_schema = 'test' and sequence_name = 'seq_cache'"
+	tk.MustQuery(cacheQuery).Check(testkit.Rows("INSTANCE 4 10 7", "SESSION 2 3 2"))
+
+	// The other session without the session cache takes the values from the instance cache.
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustExec("use test")
+	tk2.MustQuery("select nextval(seq_cache)").Check(testkit.Rows("4"))
+	tk2.MustQuery(cacheQuery).Check(testkit.Rows("INSTANCE 5 10 6"))
+
+	tk.MustQuery("select nextval(seq_cache), nextval(seq_cache)").Check(testkit.Rows("2 3"))
+	tk.MustQuery(cacheQuery).Check(testkit.Rows("INSTANCE 5 10 6"))
+	tk.MustQuery("select nextval(seq_cache)").Check(testkit.Rows("5"))
+	tk.MustQuery("select lastval(seq_cache)").Check(testkit.Rows("5"))
+	tk.MustQuery(cacheQuery).Check(testkit.Rows("INSTANCE 8 10 3", "SESSION 6 7 2"))
+
+	// setval drops the values taken by the session.
+	tk.MustQuery("select setval(seq_cache, 20)").Check(testkit.Rows("20"))
+	tk.MustQuery(cacheQuery).Check(testkit.Rows("INSTANCE <nil> <nil> 0"))
+	tk.MustQuery("select nextval(seq_cache)").Check(testkit.Rows("21"))
+	require.Equal(t, []string{"test.seq_cache 0 10", "test.seq_cache 20 30"}, refills)
+	tk.MustQuery(cacheQuery).Check(testkit.Rows("INSTANCE 24 30 7", "SESSION 22 23 2"))
+
+	// The values taken from the altered sequence are stale.
+	tk.MustExec("alter sequence seq_cache restart with 100")
+	tk.MustQuery("select nextval(seq_cache)").Check(testkit.Rows("100"))
+	tk.MustQuery("select nextval(seq_cache)").Check(testkit.Rows("101"))
+	tk.MustExec("drop sequence seq_cache")
+}
@@ -97,9 +97,29 @@ func preSplitPhysicalTableByShardRowID(ctx context.Context, store kv.SplittableS
 		ft = types.NewFieldType(mysql.TypeLonglong)
 	}
 	shardFmt := autoid.NewShardIDFormat(ft, shardingBits(tbInfo), tbInfo.AutoRandomRangeBits)
-	step := int64(1 << (shardFmt.ShardBits - tbInfo.PreSplitRegions))
+	regionIDs, err := splitRecordRegionByShards(ctx, store, tbInfo, physicalID, shardFmt, tbInfo.PreSplitRegions, scatter)
+	if err != nil {
+		logutil.DDLLogger().Warn("pre split some table regions failed",
+			zap.Stringer("table", tbInfo.Name), zap.Int("successful region count", len(regionIDs)), zap.Error(err))
+	}
+	regionIDs = append(regionIDs, splitIndexRegion(store, tbInfo, scatter)...)
+	return regionIDs
+}
+
+// SplitRecordRegionByShardRowID splits the record region of the physical table into 2^shardBits regions by the
+// shards of the implicit row IDs. It's used by the shard_row_id hint, which scatters the row IDs into more shards
+// than the SHARD_ROW_ID_BITS of the table.
+func SplitRecordRegionByShardRowID(ctx context.Context, store kv.SplittableStore, tbInfo *model.TableInfo, physicalID int64, shardBits uint64, scatter bool) ([]uint64, error) {
+	shardFmt := autoid.NewShardIDFormat(types.NewFieldType(mysql.TypeLonglong), shardBits, autoid.RowIDBitLength)
+	return splitRecordRegionByShards(ctx, store, tbInfo, physicalID, shardFmt, shardBits, scatter)
+}
+
+// splitRecordRegionByShards splits the record region of the physical table into 2^splitBits regions, the split keys
+// are the starts of the shards in shardFmt.
+func splitRecordRegionByShards(ctx context.Context, store kv.SplittableStore, tbInfo *model.TableInfo, physicalID int64, shardFmt autoid.ShardIDFormat, splitBits uint64, scatter bool) ([]uint64, error) {
+	step := int64(1 << (shardFmt.ShardBits - splitBits))
 	max := int64(1 << shardFmt.ShardBits)
-	splitTableKeys := make([][]byte, 0, 1<<(tbInfo.PreSplitRegions))
+	splitTableKeys := make([][]byte, 0, 1<<splitBits)
 	splitTableKeys = append(splitTableKeys, tablecodec.GenTablePrefix(physicalID))
 	for p := step; p < max; p += step {
 		recordID := p << shardFmt.IncrementalBits
@@ -107,14 +127,7 @@ func preSplitPhysicalTableByShardRowID(ctx context.Context, store kv.SplittableS
 		key := tablecodec.EncodeRecordKey(recordPrefix, kv.IntHandle(recordID))
 		splitTableKeys = append(splitTableKeys, key)
 	}
-	var err error
-	regionIDs, err := store.SplitRegions(ctx, splitTableKeys, scatter, &tbInfo.ID)
-	if err != nil {
-		logutil.DDLLogger().Warn("pre split some table regions failed",
-			zap.Stringer("table", tbInfo.Name), zap.Int("successful region count", len(regionIDs)), zap.Error(err))
-	}
-	regionIDs = append(regionIDs, splitIndexRegion(store, tbInfo, scatter)...)
-	return regionIDs
+	return store.SplitRegions(ctx, splitTableKeys, scatter, &tbInfo.ID)
 }
 
 // SplitRecordRegion is to split region in store by table prefix.
@@ -3461,3 +3461,94 @@ func TestExchangeValidateHandleNullValue(t *testing.T) {
 	tk.MustExec(`alter table t5 EXCHANGE PARTITION p0 WITH TABLE t6`)
 	// TODO: add "partition by range columns(a, b, c)" test cases.
 }
+
+func TestSplitAndMergePartition(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	h := dom.StatsHandle()
+	tk.MustExec("create database SplitAndMergePartition")
+	tk.MustExec("use SplitAndMergePartition")
+	tk.MustExec(`create table t (a int, b int, key (b)) partition by range (a) (
+		partition p0 values less than (10),
+		partition p1 values less than (20),
+		partition p2 values less than (30),
+		partition p3 values less than (maxvalue))`)
+	require.NoError(t, h.HandleDDLEvent(<-h.DDLEventCh()))
+	for i := 0; i < 40; i++ {
+		tk.MustExec(fmt.Sprintf(`insert into t values (%d, %d)`, i, i))
+	}
+	tk.MustExec(`analyze table t`)
+
+	tk.MustContainErrMsg(`alter table t split partition p1 into (partition p1 values less than (20))`,
+		"[ddl:8200]Unsupported SPLIT PARTITION into less than two partitions")
+	tk.MustContainErrMsg(`alter table t split partition p1 into (partition p10 values less than (15), partition p11 values less than (25))`,
+		"[ddl:1493]VALUES LESS THAN value must be strictly increasing for each partition")
+	tk.MustContainErrMsg(`alter table t merge partitions p0 into partition p01`,
+		"[ddl:8200]Unsupported MERGE PARTITIONS of less than two partitions")
+	tk.MustContainErrMsg(`alter table t merge partitions p0, p2 into partition p02`,
+		"[ddl:8200]Unsupported REORGANIZE PARTITION of RANGE; not adjacent partitions")
+
+	tk.MustExec(`alter table t split partition p1 into (partition p10 values less than (15), partition p11 values less than (20))`)
+	tk.MustQuery(`select partition_name, partition_description from information_schema.partitions where table_schema = 'SplitAndMergePartition' and table_name = 't' order by partition_ordinal_position`).Check(testkit.Rows(
+		"p0 10",
+		"p10 15",
+		"p11 20",
+		"p2 30",
+		"p3 MAXVALUE"))
+	tk.MustQuery(`select count(*) from t partition (p10)`).Check(testkit.Rows("5"))
+	tk.MustQuery(`select count(*) from t partition (p11)`).Check(testkit.Rows("5"))
+	// The count of the split partition is spread evenly over the new partitions.
+	require.NoError(t, h.HandleDDLEvent(<-h.DDLEventCh()))
+	h.Clear()
+	require.NoError(t, h.Update(dom.InfoSchema()))
+	tk.MustQuery(`show stats_meta where db_name = 'SplitAndMergePartition' and table_name = 't'`).Sort().CheckAt([]int{2, 5}, [][]any{
+		{"global", "40"},
+		{"p0", "10"},
+		{"p10", "5"},
+		{"p11", "5"},
+		{"p2", "10"},
+		{"p3", "10"}})
+
+	tk.MustExec(`alter table t merge partitions p11, p2, p3 into partition p2`)
+	tk.MustQuery(`select partition_name, partition_description from information_schema.partitions where table_schema = 'SplitAndMergePartition' and table_name = 't' order by partition_ordinal_position`).Check(testkit.Rows(
+		"p0 10",
+		"p10 15",
+		"p2 MAXVALUE"))
+	tk.MustQuery(`select count(*) from t partition (p2)`).Check(testkit.Rows("25"))
+	tk.MustQuery(`select count(*) from t`).Check(testkit.Rows("40"))
+	require.NoError(t, h.HandleDDLEvent(<-h.DDLEventCh()))
+	h.Clear()
+	require.NoError(t, h.Update(dom.InfoSchema()))
+	tk.MustQuery(`show stats_meta where db_name = 'SplitAndMergePartition' and table_name = 't'`).Sort().CheckAt([]int{2, 5}, [][]any{
+		{"global", "40"},
+		{"p0", "10"},
+		{"p10", "5"},
+		{"p2", "25"}})
+	// The count is not carried over by a general REORGANIZE PARTITION.
+	tk.MustExec(`alter table t reorganize partition p10 into (partition p1 values less than (15))`)
+	require.NoError(t, h.HandleDDLEvent(<-h.DDLEventCh()))
+	h.Clear()
+	require.NoError(t, h.Update(dom.InfoSchema()))
+	tk.MustQuery(`show stats_meta where db_name = 'SplitAndMergePartition' and table_name = 't'`).Sort().CheckAt([]int{2, 5}, [][]any{
+		{"global", "40"},
+		{"p0", "10"},
+		{"p1", "0"},
+		{"p2", "25"}})
+
+	tk.MustExec(`create table tl (a int) partition by list (a) (
+		partition p0 values in (1, 2),
+		partition p1 values in (3, 4),
+		partition p2 values in (5, 6))`)
+	tk.MustExec(`insert into tl values (1), (2), (3), (4), (5), (6)`)
+	tk.MustContainErrMsg(`alter table tl split partition p0 into (partition p00 values in (1), partition p01 values in (2))`,
+		"[ddl:8200]Unsupported SPLIT PARTITION of LIST partitioned table")
+	tk.MustExec(`alter table tl merge partitions p0, p2 into partition p02`)
+	tk.MustQuery(`select partition_name, partition_description from information_schema.partitions where table_schema = 'SplitAndMergePartition' and table_name = 'tl' order by partition_ordinal_position`).Check(testkit.Rows(
+		"p02 1,2,5,6",
+		"p1 3,4"))
+	tk.MustQuery(`select a from tl partition (p02) order by a`).Check(testkit.Rows("1", "2", "5", "6"))
+
+	tk.MustExec(`create table th (a int) partition by hash (a) partitions 2`)
+	tk.MustContainErrMsg(`alter table th merge partitions p0, p1 into partition p01`,
+		"[ddl:8200]Unsupported reorganize partition")
+}
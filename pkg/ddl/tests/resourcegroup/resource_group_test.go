@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/domain/infosync"
 	mysql "github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/sessionctx"
@@ -363,6 +364,43 @@ func TestResourceGroupRunaway(t *testing.T) {
 	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/store/copr/sleepCoprAfterReq"))
 }
 
+func TestResourceGroupRunawayCoolingHints(t *testing.T) {
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/domain/FastRunawayGC", `return(true)`))
+	defer func() {
+		require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/domain/FastRunawayGC"))
+	}()
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "localhost"}, nil, nil, nil))
+
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int)")
+	tk.MustExec("insert into t values(1)")
+	tk.MustGetErrCode("set global tidb_runaway_cooling_hints = 'max_execution_time('", mysql.ErrWrongValueForVar)
+	tk.MustExec("set global tidb_runaway_cooling_hints = 'max_execution_time(1000) set_var(tidb_allow_mpp=off)'")
+	tk.MustExec("set global tidb_runaway_cooling_duration = '1h'")
+	defer func() {
+		tk.MustExec("set global tidb_runaway_cooling_hints = default")
+		tk.MustExec("set global tidb_runaway_cooling_duration = default")
+	}()
+
+	tk.MustExec("set global tidb_enable_resource_control='on'")
+	tk.MustExec("create resource group rg1 RU_PER_SEC=1000 QUERY_LIMIT=(EXEC_ELAPSED='50ms' ACTION=KILL)")
+	require.Eventually(t, func() bool {
+		return dom.RunawayManager().IsSyncerInitialized()
+	}, 20*time.Second, 300*time.Millisecond)
+
+	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/store/copr/sleepCoprRequest", fmt.Sprintf("return(%d)", 60)))
+	err := tk.QueryToErr("select /*+ resource_group(rg1) */ * from t")
+	require.ErrorContains(t, err, "Query execution was interrupted, identified as runaway query")
+	require.NoError(t, failpoint.Disable("github.com/pingcap/tidb/pkg/store/copr/sleepCoprRequest"))
+
+	// the cooling hints are attached to the digest of the runaway query for a while.
+	_, digest := parser.NormalizeDigest("select /*+ resource_group(rg1) */ * from t")
+	tk.EventuallyMustQueryAndCheck("select SQL_NO_CACHE lower(hints), expire_time is not null from mysql.digest_hints where digest = ?",
+		[]any{digest.String()}, testkit.Rows("max_execution_time(1000), set_var(tidb_allow_mpp = 'off') 1"), 5*time.Second, 100*time.Millisecond)
+}
+
 func TestAlreadyExistsDefaultResourceGroup(t *testing.T) {
 	require.NoError(t, failpoint.Enable("github.com/pingcap/tidb/pkg/domain/infosync/managerAlreadyCreateSomeGroups", `return(true)`))
 	defer func() {
@@ -518,3 +556,62 @@ func TestBindHints(t *testing.T) {
 	re.Equal("default", tk.Session().GetSessionVars().StmtCtx.ResourceGroupName)
 	re.Equal("default", tk.Session().GetSessionVars().ResourceGroupName)
 }
+
+func TestResourceGroupOptimizerVars(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("set global tidb_enable_resource_control = 'on'")
+
+	tk.MustGetErrCode("create resource group rg1 ru_per_sec=1000 optimizer_vars=(no_such_var='1')", mysql.ErrUnknownSystemVariable)
+	tk.MustContainErrMsg("create resource group rg1 ru_per_sec=1000 optimizer_vars=(tidb_enable_resource_control='off')", "variable 'tidb_enable_resource_control' can't be overridden by resource group")
+	tk.MustGetErrCode("create resource group rg1 ru_per_sec=1000 optimizer_vars=(tidb_partition_prune_mode='unknown')", mysql.ErrWrongValueForVar)
+
+	tk.MustExec("create resource group rg1 ru_per_sec=1000 optimizer_vars=(tidb_opt_cpu_factor='5', tidb_enforce_mpp='on')")
+	tk.MustQuery("show create resource group rg1").Check(testkit.Rows("rg1 CREATE RESOURCE GROUP `rg1` RU_PER_SEC=1000, PRIORITY=MEDIUM, OPTIMIZER_VARS=(tidb_opt_cpu_factor='5', tidb_enforce_mpp='ON')"))
+
+	tk.MustQuery("select @@tidb_opt_cpu_factor, @@tidb_enforce_mpp").Check(testkit.Rows("3 0"))
+	tk.MustQuery("select /*+ resource_group(rg1) */ @@tidb_opt_cpu_factor, @@tidb_enforce_mpp").Check(testkit.Rows("5 1"))
+	tk.MustExec("set resource group rg1")
+	tk.MustQuery("select @@tidb_opt_cpu_factor, @@tidb_enforce_mpp").Check(testkit.Rows("5 1"))
+	// The SET_VAR hints take precedence over the resource group.
+	tk.MustQuery("select /*+ set_var(tidb_opt_cpu_factor=7) */ @@tidb_opt_cpu_factor, @@tidb_enforce_mpp").Check(testkit.Rows("7 1"))
+	tk.MustExec("set resource group default")
+	tk.MustQuery("select @@tidb_opt_cpu_factor, @@tidb_enforce_mpp").Check(testkit.Rows("3 0"))
+
+	tk.MustExec("alter resource group rg1 optimizer_vars=(tidb_opt_cpu_factor='4')")
+	tk.MustQuery("select /*+ resource_group(rg1) */ @@tidb_opt_cpu_factor, @@tidb_enforce_mpp").Check(testkit.Rows("4 0"))
+	tk.MustExec("alter resource group rg1 priority=high")
+	tk.MustQuery("show create resource group rg1").Check(testkit.Rows("rg1 CREATE RESOURCE GROUP `rg1` RU_PER_SEC=1000, PRIORITY=HIGH, OPTIMIZER_VARS=(tidb_opt_cpu_factor='4')"))
+	tk.MustExec("alter resource group rg1 optimizer_vars=NULL")
+	tk.MustQuery("show create resource group rg1").Check(testkit.Rows("rg1 CREATE RESOURCE GROUP `rg1` RU_PER_SEC=1000, PRIORITY=HIGH"))
+	tk.MustQuery("select /*+ resource_group(rg1) */ @@tidb_opt_cpu_factor").Check(testkit.Rows("3"))
+
+	// The prepared statements use the variables of the resource group too, and their cached plans are not shared
+	// across the resource groups.
+	tk.MustExec("alter resource group rg1 optimizer_vars=(tidb_opt_cpu_factor='5')")
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, key(a))")
+	tk.MustExec("prepare s1 from 'select @@tidb_opt_cpu_factor'")
+	tk.MustExec("prepare s2 from 'select a from t where a = ?'")
+	tk.MustExec("set @a = 1")
+	tk.MustQuery("execute s1").Check(testkit.Rows("3"))
+	tk.MustExec("execute s2 using @a")
+	tk.MustExec("execute s2 using @a")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk.MustExec("set resource group rg1")
+	tk.MustQuery("execute s1").Check(testkit.Rows("5"))
+	tk.MustExec("execute s2 using @a")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
+	tk.MustExec("execute s2 using @a")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk.MustExec("set resource group default")
+	tk.MustQuery("execute s1").Check(testkit.Rows("3"))
+
+	// The values are escaped, so the output of SHOW CREATE RESOURCE GROUP can be executed.
+	tk.MustExec(`create resource group rg2 ru_per_sec=1000 optimizer_vars=(tidb_session_alias='a\\'', b')`)
+	rows := tk.MustQuery("show create resource group rg2").Rows()
+	require.Equal(t, `CREATE RESOURCE GROUP `+"`rg2`"+` RU_PER_SEC=1000, PRIORITY=MEDIUM, OPTIMIZER_VARS=(tidb_session_alias='a\\'', b')`, rows[0][1])
+	tk.MustExec("drop resource group rg2")
+	tk.MustExec(rows[0][1].(string))
+	tk.MustQuery("select /*+ resource_group(rg2) */ @@tidb_session_alias").Check(testkit.Rows(`a\', b`))
+}
@@ -16,6 +16,7 @@ package serial
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math"
 	"strings"
@@ -30,6 +31,7 @@ import (
 	"github.com/pingcap/tidb/pkg/ddl/util"
 	"github.com/pingcap/tidb/pkg/ddl/util/callback"
 	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/domain/infosync"
 	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/infoschema"
 	"github.com/pingcap/tidb/pkg/kv"
@@ -529,6 +531,32 @@ func TestRecoverTableWithTTL(t *testing.T) {
 	tk.MustQuery("show create table test_recover2.t2").Check(testkit.Rows("t2 CREATE TABLE `t2` (\n  `t` timestamp NULL DEFAULT NULL\n) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin /*T![ttl] TTL=`t` + INTERVAL 1 DAY */ /*T![ttl] TTL_ENABLE='OFF' */ /*T![ttl] TTL_JOB_INTERVAL='1h' */"))
 }
 
+func TestTTLCondition(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, created_at datetime, status int, v int as (status + 1) virtual) " +
+		"TTL = `created_at` + INTERVAL 5 DAY")
+	tk.MustGetErrMsg("alter table t TTL_CONDITION = 'v = 2'",
+		"[ddl:8178]Unsupported TTL condition 'v = 2': virtual generated columns are not allowed")
+	tk.MustExec("alter table t TTL_CONDITION = 'status = 2'")
+
+	// the TTL_CONDITION can't be set until all the servers in the cluster are upgraded.
+	serverInfos, err := json.Marshal(map[string]*infosync.ServerInfo{
+		"s1": {ID: "s1", IP: "127.0.0.1", Port: 4000, ServerVersionInfo: infosync.ServerVersionInfo{Version: mysql.ServerVersion}},
+		"s2": {ID: "s2", IP: "127.0.0.2", Port: 4000, ServerVersionInfo: infosync.ServerVersionInfo{Version: "8.0.11-TiDB-v7.5.0"}},
+	})
+	require.NoError(t, err)
+	fpName := "github.com/pingcap/tidb/pkg/domain/infosync/mockGetAllServerInfo"
+	require.NoError(t, failpoint.Enable(fpName, fmt.Sprintf("return(`%s`)", serverInfos)))
+	defer func() {
+		require.NoError(t, failpoint.Disable(fpName))
+	}()
+	tk.MustGetErrMsg("alter table t TTL_CONDITION = 'status = 3'", "[ddl:8178]Unsupported TTL condition 'status = 3': "+
+		"TiDB server 127.0.0.2:4000 is of version 8.0.11-TiDB-v7.5.0, please retry after the cluster is upgraded")
+	tk.MustExec("alter table t TTL_CONDITION = ''")
+}
+
 func TestRecoverTableByJobID(t *testing.T) {
 	store, _ := createMockStoreAndDomain(t)
 	tk := testkit.NewTestKit(t, store)
@@ -15,11 +15,19 @@
 package ddl
 
 import (
+	"context"
+	"math"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/domain/infosync"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/meta"
+	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/format"
 	"github.com/pingcap/tidb/pkg/parser/model"
@@ -51,8 +59,10 @@ func onTTLInfoChange(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err er
 	var ttlInfo *model.TTLInfo
 	var ttlInfoEnable *bool
 	var ttlInfoJobInterval *string
+	var ttlInfoCondition *string
+	var ttlScheduleOpts *ttlScheduleOptions
 
-	if err := job.DecodeArgs(&ttlInfo, &ttlInfoEnable, &ttlInfoJobInterval); err != nil {
+	if err := job.DecodeArgs(&ttlInfo, &ttlInfoEnable, &ttlInfoJobInterval, &ttlInfoCondition, &ttlScheduleOpts); err != nil {
 		job.State = model.JobStateCancelled
 		return ver, errors.Trace(err)
 	}
@@ -70,6 +80,15 @@ func onTTLInfoChange(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err er
 		if ttlInfoJobInterval == nil && tblInfo.TTLInfo != nil {
 			ttlInfo.JobInterval = tblInfo.TTLInfo.JobInterval
 		}
+		if ttlInfoCondition == nil && tblInfo.TTLInfo != nil {
+			ttlInfo.ConditionExprStr = tblInfo.TTLInfo.ConditionExprStr
+		}
+		if tblInfo.TTLInfo != nil {
+			ttlInfo.JobWindowStart = tblInfo.TTLInfo.JobWindowStart
+			ttlInfo.JobWindowEnd = tblInfo.TTLInfo.JobWindowEnd
+			ttlInfo.DeleteRateLimit = tblInfo.TTLInfo.DeleteRateLimit
+			ttlInfo.ResourceGroup = tblInfo.TTLInfo.ResourceGroup
+		}
 		tblInfo.TTLInfo = ttlInfo
 	}
 	if ttlInfoEnable != nil {
@@ -86,6 +105,20 @@ func onTTLInfoChange(d *ddlCtx, t *meta.Meta, job *model.Job) (ver int64, err er
 
 		tblInfo.TTLInfo.JobInterval = *ttlInfoJobInterval
 	}
+	if ttlInfoCondition != nil {
+		if tblInfo.TTLInfo == nil {
+			return ver, errors.Trace(dbterror.ErrSetTTLOptionForNonTTLTable.FastGenByArgs("TTL_CONDITION"))
+		}
+
+		tblInfo.TTLInfo.ConditionExprStr = *ttlInfoCondition
+	}
+	if ttlScheduleOpts != nil {
+		if tblInfo.TTLInfo == nil {
+			return ver, errors.Trace(ttlScheduleOpts.errForNonTTLTable())
+		}
+
+		ttlScheduleOpts.apply(tblInfo.TTLInfo)
+	}
 
 	ver, err = updateVersionAndTableInfo(d, t, job, tblInfo, true)
 	if err != nil {
@@ -104,7 +137,28 @@ func checkTTLInfoValid(ctx sessionctx.Context, schema model.CIStr, tblInfo *mode
 		return err
 	}
 
-	return checkTTLInfoColumnType(tblInfo)
+	if err := checkTTLInfoColumnType(tblInfo); err != nil {
+		return err
+	}
+
+	if err := checkTTLResourceGroup(ctx, tblInfo.TTLInfo); err != nil {
+		return err
+	}
+
+	return checkTTLConditionExpr(ctx, tblInfo)
+}
+
+// checkTTLResourceGroup checks the resource group in TTL_RESOURCE_GROUP exists.
+func checkTTLResourceGroup(ctx sessionctx.Context, ttlInfo *model.TTLInfo) error {
+	if len(ttlInfo.ResourceGroup) == 0 {
+		return nil
+	}
+
+	is := sessiontxn.GetTxnManager(ctx).GetTxnInfoSchema()
+	if _, ok := is.ResourceGroupByName(model.NewCIStr(ttlInfo.ResourceGroup)); !ok {
+		return infoschema.ErrResourceGroupNotExists.GenWithStackByArgs(ttlInfo.ResourceGroup)
+	}
+	return nil
 }
 
 func checkTTLIntervalExpr(ttlInfo *model.TTLInfo) error {
@@ -124,6 +178,151 @@ func checkTTLInfoColumnType(tblInfo *model.TableInfo) error {
 	return nil
 }
 
+func parseTTLCondition(cond string) (ast.ExprNode, error) {
+	stmts, _, err := parser.New().ParseSQL("select " + cond)
+	if err != nil {
+		return nil, err
+	}
+	// the condition is spliced into the SQL of the TTL jobs, so it must be exactly one expression
+	sel, ok := stmts[0].(*ast.SelectStmt)
+	if len(stmts) != 1 || !ok || len(sel.Fields.Fields) != 1 || sel.Fields.Fields[0].Expr == nil ||
+		sel.From != nil || sel.Where != nil || sel.GroupBy != nil || sel.Having != nil || sel.WindowSpecs != nil ||
+		sel.OrderBy != nil || sel.Limit != nil || sel.LockInfo != nil || sel.Distinct {
+		return nil, errors.New("it should be a single expression")
+	}
+	return sel.Fields.Fields[0].Expr, nil
+}
+
+func restoreTTLCondition(node ast.ExprNode) (string, error) {
+	var sb strings.Builder
+	restoreFlags := format.RestoreStringSingleQuotes | format.RestoreKeyWordLowercase | format.RestoreNameBackQuotes |
+		format.RestoreSpacesAroundBinaryOperation | format.RestoreWithoutSchemaName | format.RestoreWithoutTableName
+	if err := node.Restore(format.NewRestoreCtx(restoreFlags, &sb)); err != nil {
+		return "", err
+	}
+	return sb.String(), nil
+}
+
+// checkTTLConditionExpr checks the TTL_CONDITION is a deterministic predicate on the columns of the table.
+// The TTL scan reads the table by ranges of the primary key, so the condition must also be able to be pushed
+// down to TiKV, otherwise every row in the range has to be sent back to TiDB to evaluate the condition.
+// If the condition is valid, `ConditionExprStr` is replaced with its normalized form.
+func checkTTLConditionExpr(ctx sessionctx.Context, tblInfo *model.TableInfo) error {
+	cond := tblInfo.TTLInfo.ConditionExprStr
+	if len(cond) == 0 {
+		return nil
+	}
+
+	// The TTL workers of the old versions don't know the condition and would delete every expired row of the table.
+	if err := checkAllServersSupportTTLCondition(); err != nil {
+		return dbterror.ErrUnsupportedTTLCondition.GenWithStackByArgs(cond, err.Error())
+	}
+
+	node, err := parseTTLCondition(cond)
+	if err != nil {
+		return dbterror.ErrUnsupportedTTLCondition.GenWithStackByArgs(cond, err.Error())
+	}
+
+	for _, colName := range FindColumnNamesInExpr(node) {
+		col := model.FindColumnInfo(tblInfo.Columns, colName.Name.L)
+		// The virtual generated columns are not stored in TiKV, so the condition on them can't be evaluated in the
+		// table scan of the TTL jobs.
+		if col != nil && col.IsGenerated() && !col.GeneratedStored {
+			return dbterror.ErrUnsupportedTTLCondition.GenWithStackByArgs(cond, "virtual generated columns are not allowed")
+		}
+	}
+
+	var c illegalFunctionChecker
+	node.Accept(&c)
+	if c.otherErr != nil {
+		return errors.Trace(c.otherErr)
+	}
+	if c.hasIllegalFunc || c.hasRowVal {
+		return dbterror.ErrUnsupportedTTLCondition.GenWithStackByArgs(cond, "only deterministic functions of the table columns are allowed")
+	}
+	if c.hasAggFunc || c.hasWindowFunc {
+		return dbterror.ErrUnsupportedTTLCondition.GenWithStackByArgs(cond, "aggregate and window functions are not allowed")
+	}
+
+	exprCtx := ctx.GetExprCtx()
+	expr, err := expression.BuildSimpleExpr(exprCtx, node, expression.WithTableInfo("", tblInfo))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if expression.CheckNonDeterministic(expr) || expression.IsMutableEffectsExpr(expr) {
+		return dbterror.ErrUnsupportedTTLCondition.GenWithStackByArgs(cond, "only deterministic functions of the table columns are allowed")
+	}
+
+	pushDownCtx := expression.NewPushDownContext(exprCtx.GetEvalCtx(), ctx.GetClient(), false, nil, nil,
+		ctx.GetSessionVars().GroupConcatMaxLen)
+	if !expression.CanExprsPushDown(pushDownCtx, []expression.Expression{expr}, kv.TiKV) {
+		return dbterror.ErrUnsupportedTTLCondition.GenWithStackByArgs(cond, "it cannot be pushed down to the table scan in TiKV")
+	}
+
+	normalized, err := restoreTTLCondition(node)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	tblInfo.TTLInfo.ConditionExprStr = normalized
+	return nil
+}
+
+// checkAllServersSupportTTLCondition checks all the TiDB servers in the cluster are of the current version, so every
+// TTL worker which may run the jobs of the table knows the TTL_CONDITION.
+func checkAllServersSupportTTLCondition() error {
+	serverInfos, err := infosync.GetAllServerInfo(context.Background())
+	if err != nil {
+		return err
+	}
+	for _, info := range serverInfos {
+		if info.Version != mysql.ServerVersion {
+			return errors.Errorf("TiDB server %s:%d is of version %s, please retry after the cluster is upgraded",
+				info.IP, info.Port, info.Version)
+		}
+	}
+	return nil
+}
+
+// ttlConditionReferColumn returns whether the TTL_CONDITION refers to the column.
+func ttlConditionReferColumn(ttlInfo *model.TTLInfo, colName string) bool {
+	if len(ttlInfo.ConditionExprStr) == 0 {
+		return false
+	}
+
+	node, err := parseTTLCondition(ttlInfo.ConditionExprStr)
+	if err != nil {
+		return false
+	}
+
+	for _, col := range FindColumnNamesInExpr(node) {
+		if col.Name.L == colName {
+			return true
+		}
+	}
+	return false
+}
+
+// renameColumnInTTLCondition rewrites the references of the column in the TTL_CONDITION to the new name.
+func renameColumnInTTLCondition(ttlInfo *model.TTLInfo, oldCol, newCol model.CIStr) error {
+	node, err := parseTTLCondition(ttlInfo.ConditionExprStr)
+	if err != nil {
+		return err
+	}
+
+	for _, col := range FindColumnNamesInExpr(node) {
+		if col.Name.L == oldCol.L {
+			col.Name = newCol
+		}
+	}
+
+	cond, err := restoreTTLCondition(node)
+	if err != nil {
+		return err
+	}
+	ttlInfo.ConditionExprStr = cond
+	return nil
+}
+
 // checkTTLTableSuitable returns whether this table is suitable to be a TTL table
 // A temporary table or a parent table referenced by a foreign key cannot be TTL table
 func checkTTLTableSuitable(ctx sessionctx.Context, schema model.CIStr, tblInfo *model.TableInfo) error {
@@ -146,7 +345,7 @@ func checkTTLTableSuitable(ctx sessionctx.Context, schema model.CIStr, tblInfo *
 
 func checkDropColumnWithTTLConfig(tblInfo *model.TableInfo, colName string) error {
 	if tblInfo.TTLInfo != nil {
-		if tblInfo.TTLInfo.ColumnName.L == colName {
+		if tblInfo.TTLInfo.ColumnName.L == colName || ttlConditionReferColumn(tblInfo.TTLInfo, colName) {
 			return dbterror.ErrTTLColumnCannotDrop.GenWithStackByArgs(colName)
 		}
 	}
@@ -179,11 +378,59 @@ func checkPrimaryKeyForTTLTable(tblInfo *model.TableInfo) error {
 	return nil
 }
 
+// ttlScheduleOptions is the TTL_JOB_WINDOW_START, TTL_JOB_WINDOW_END, TTL_DELETE_RATE_LIMIT and TTL_RESOURCE_GROUP
+// options in a statement. A nil field means the option is not set.
+type ttlScheduleOptions struct {
+	JobWindowStart  *string `json:"job_window_start"`
+	JobWindowEnd    *string `json:"job_window_end"`
+	DeleteRateLimit *int64  `json:"delete_rate_limit"`
+	ResourceGroup   *string `json:"resource_group"`
+}
+
+func (o *ttlScheduleOptions) apply(ttlInfo *model.TTLInfo) {
+	if o.JobWindowStart != nil {
+		ttlInfo.JobWindowStart = *o.JobWindowStart
+	}
+	if o.JobWindowEnd != nil {
+		ttlInfo.JobWindowEnd = *o.JobWindowEnd
+	}
+	if o.DeleteRateLimit != nil {
+		ttlInfo.DeleteRateLimit = *o.DeleteRateLimit
+	}
+	if o.ResourceGroup != nil {
+		ttlInfo.ResourceGroup = *o.ResourceGroup
+	}
+}
+
+// errForNonTTLTable returns the error for setting these options on a table without TTL.
+func (o *ttlScheduleOptions) errForNonTTLTable() error {
+	option := "TTL_RESOURCE_GROUP"
+	switch {
+	case o.JobWindowStart != nil:
+		option = "TTL_JOB_WINDOW_START"
+	case o.JobWindowEnd != nil:
+		option = "TTL_JOB_WINDOW_END"
+	case o.DeleteRateLimit != nil:
+		option = "TTL_DELETE_RATE_LIMIT"
+	}
+	return dbterror.ErrSetTTLOptionForNonTTLTable.FastGenByArgs(option)
+}
+
 // getTTLInfoInOptions returns the aggregated ttlInfo, the ttlEnable, or an error.
-// if TTL, TTL_ENABLE or TTL_JOB_INTERVAL is not set in the config, the corresponding return value will be nil.
+// if TTL, TTL_ENABLE, TTL_JOB_INTERVAL or TTL_CONDITION is not set in the config, the corresponding return value will be nil.
+// if none of TTL_JOB_WINDOW_START, TTL_JOB_WINDOW_END, TTL_DELETE_RATE_LIMIT and TTL_RESOURCE_GROUP is set, `scheduleOpts`
+// will be nil.
 // if both of TTL and TTL_ENABLE are set, the `ttlInfo.Enable` will be equal with `ttlEnable`.
 // if both of TTL and TTL_JOB_INTERVAL are set, the `ttlInfo.JobInterval` will be equal with `ttlCronJobSchedule`.
-func getTTLInfoInOptions(options []*ast.TableOption) (ttlInfo *model.TTLInfo, ttlEnable *bool, ttlCronJobSchedule *string, err error) {
+// if both of TTL and TTL_CONDITION are set, the `ttlInfo.ConditionExprStr` will be equal with `ttlCondition`.
+// if both of TTL and the schedule options are set, they are also applied to the `ttlInfo`.
+func getTTLInfoInOptions(options []*ast.TableOption) (ttlInfo *model.TTLInfo, ttlEnable *bool, ttlCronJobSchedule *string, ttlCondition *string, scheduleOpts *ttlScheduleOptions, err error) {
+	getScheduleOpts := func() *ttlScheduleOptions {
+		if scheduleOpts == nil {
+			scheduleOpts = &ttlScheduleOptions{}
+		}
+		return scheduleOpts
+	}
 	for _, op := range options {
 		switch op.Tp {
 		case ast.TableOptionTTL:
@@ -192,7 +439,7 @@ func getTTLInfoInOptions(options []*ast.TableOption) (ttlInfo *model.TTLInfo, tt
 			restoreCtx := format.NewRestoreCtx(restoreFlags, &sb)
 			err := op.Value.Restore(restoreCtx)
 			if err != nil {
-				return nil, nil, nil, err
+				return nil, nil, nil, nil, nil, err
 			}
 
 			intervalExpr := sb.String()
@@ -207,6 +454,20 @@ func getTTLInfoInOptions(options []*ast.TableOption) (ttlInfo *model.TTLInfo, tt
 			ttlEnable = &op.BoolValue
 		case ast.TableOptionTTLJobInterval:
 			ttlCronJobSchedule = &op.StrValue
+		case ast.TableOptionTTLCondition:
+			ttlCondition = &op.StrValue
+		case ast.TableOptionTTLJobWindowStart:
+			getScheduleOpts().JobWindowStart = &op.StrValue
+		case ast.TableOptionTTLJobWindowEnd:
+			getScheduleOpts().JobWindowEnd = &op.StrValue
+		case ast.TableOptionTTLDeleteRateLimit:
+			if op.UintValue > math.MaxInt64 {
+				return nil, nil, nil, nil, nil, types.ErrWrongValue.GenWithStackByArgs("TTL_DELETE_RATE_LIMIT", strconv.FormatUint(op.UintValue, 10))
+			}
+			limit := int64(op.UintValue)
+			getScheduleOpts().DeleteRateLimit = &limit
+		case ast.TableOptionTTLResourceGroup:
+			getScheduleOpts().ResourceGroup = &op.StrValue
 		}
 	}
 
@@ -217,6 +478,12 @@ func getTTLInfoInOptions(options []*ast.TableOption) (ttlInfo *model.TTLInfo, tt
 		if ttlCronJobSchedule != nil {
 			ttlInfo.JobInterval = *ttlCronJobSchedule
 		}
+		if ttlCondition != nil {
+			ttlInfo.ConditionExprStr = *ttlCondition
+		}
+		if scheduleOpts != nil {
+			scheduleOpts.apply(ttlInfo)
+		}
 	}
-	return ttlInfo, ttlEnable, ttlCronJobSchedule, nil
+	return ttlInfo, ttlEnable, ttlCronJobSchedule, ttlCondition, scheduleOpts, nil
 }
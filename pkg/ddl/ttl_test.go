@@ -26,12 +26,19 @@ func Test_getTTLInfoInOptions(t *testing.T) {
 	falseValue := false
 	trueValue := true
 	twentyFourHours := "24h"
+	statusCondition := "status = 2"
+	windowStart := "01:00 +0000"
+	windowEnd := "05:00 +0000"
+	deleteRateLimit := int64(100)
+	resourceGroup := "rg1"
 
 	cases := []struct {
 		options            []*ast.TableOption
 		ttlInfo            *model.TTLInfo
 		ttlEnable          *bool
 		ttlCronJobSchedule *string
+		ttlCondition       *string
+		scheduleOpts       *ttlScheduleOptions
 		err                error
 	}{
 		{
@@ -40,6 +47,8 @@ func Test_getTTLInfoInOptions(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
 		},
 		{
 			[]*ast.TableOption{
@@ -60,6 +69,8 @@ func Test_getTTLInfoInOptions(t *testing.T) {
 			nil,
 			nil,
 			nil,
+			nil,
+			nil,
 		},
 		{
 			[]*ast.TableOption{
@@ -84,6 +95,8 @@ func Test_getTTLInfoInOptions(t *testing.T) {
 			&falseValue,
 			nil,
 			nil,
+			nil,
+			nil,
 		},
 		{
 			[]*ast.TableOption{
@@ -112,6 +125,8 @@ func Test_getTTLInfoInOptions(t *testing.T) {
 			&trueValue,
 			nil,
 			nil,
+			nil,
+			nil,
 		},
 		{
 			[]*ast.TableOption{
@@ -136,15 +151,103 @@ func Test_getTTLInfoInOptions(t *testing.T) {
 			nil,
 			&twentyFourHours,
 			nil,
+			nil,
+			nil,
+		},
+		{
+			[]*ast.TableOption{
+				{
+					Tp:       ast.TableOptionTTLCondition,
+					StrValue: "status = 2",
+				},
+				{
+					Tp:            ast.TableOptionTTL,
+					ColumnName:    &ast.ColumnName{Name: model.NewCIStr("test_column")},
+					Value:         ast.NewValueExpr(5, "", ""),
+					TimeUnitValue: &ast.TimeUnitExpr{Unit: ast.TimeUnitYear},
+				},
+			},
+			&model.TTLInfo{
+				ColumnName:       model.NewCIStr("test_column"),
+				IntervalExprStr:  "5",
+				IntervalTimeUnit: int(ast.TimeUnitYear),
+				Enable:           true,
+				JobInterval:      "1h",
+				ConditionExprStr: "status = 2",
+			},
+			nil,
+			nil,
+			&statusCondition,
+			nil,
+			nil,
+		},
+		{
+			[]*ast.TableOption{
+				{
+					Tp:       ast.TableOptionTTLJobWindowStart,
+					StrValue: "01:00 +0000",
+				},
+				{
+					Tp:       ast.TableOptionTTLJobWindowEnd,
+					StrValue: "05:00 +0000",
+				},
+				{
+					Tp:            ast.TableOptionTTL,
+					ColumnName:    &ast.ColumnName{Name: model.NewCIStr("test_column")},
+					Value:         ast.NewValueExpr(5, "", ""),
+					TimeUnitValue: &ast.TimeUnitExpr{Unit: ast.TimeUnitYear},
+				},
+				{
+					Tp:        ast.TableOptionTTLDeleteRateLimit,
+					UintValue: 100,
+				},
+			},
+			&model.TTLInfo{
+				ColumnName:       model.NewCIStr("test_column"),
+				IntervalExprStr:  "5",
+				IntervalTimeUnit: int(ast.TimeUnitYear),
+				Enable:           true,
+				JobInterval:      "1h",
+				JobWindowStart:   "01:00 +0000",
+				JobWindowEnd:     "05:00 +0000",
+				DeleteRateLimit:  100,
+			},
+			nil,
+			nil,
+			nil,
+			&ttlScheduleOptions{
+				JobWindowStart:  &windowStart,
+				JobWindowEnd:    &windowEnd,
+				DeleteRateLimit: &deleteRateLimit,
+			},
+			nil,
+		},
+		{
+			[]*ast.TableOption{
+				{
+					Tp:       ast.TableOptionTTLResourceGroup,
+					StrValue: "rg1",
+				},
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			&ttlScheduleOptions{
+				ResourceGroup: &resourceGroup,
+			},
+			nil,
 		},
 	}
 
 	for _, c := range cases {
-		ttlInfo, ttlEnable, ttlCronJobSchedule, err := getTTLInfoInOptions(c.options)
+		ttlInfo, ttlEnable, ttlCronJobSchedule, ttlCondition, scheduleOpts, err := getTTLInfoInOptions(c.options)
 
 		assert.Equal(t, c.ttlInfo, ttlInfo)
 		assert.Equal(t, c.ttlEnable, ttlEnable)
 		assert.Equal(t, c.ttlCronJobSchedule, ttlCronJobSchedule)
+		assert.Equal(t, c.ttlCondition, ttlCondition)
+		assert.Equal(t, c.scheduleOpts, scheduleOpts)
 		assert.Equal(t, c.err, err)
 	}
 }
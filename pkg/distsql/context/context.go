@@ -17,6 +17,7 @@ package context
 import (
 	"time"
 
+	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/tidb/pkg/domain/resourcegroup"
 	"github.com/pingcap/tidb/pkg/errctx"
 	"github.com/pingcap/tidb/pkg/kv"
@@ -29,6 +30,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/topsql/stmtstats"
 	tikvstore "github.com/tikv/client-go/v2/kv"
 	"github.com/tikv/client-go/v2/tikvrpc"
+	"go.uber.org/atomic"
 )
 
 // DistSQLContext provides all information needed by using functions in `distsql`
@@ -65,6 +67,7 @@ type DistSQLContext struct {
 
 	DistSQLConcurrency            int
 	ReplicaReadType               kv.ReplicaReadType
+	MatchStoreLabels              []*metapb.StoreLabel
 	WeakConsistency               bool
 	RCCheckTS                     bool
 	NotFillCache                  bool
@@ -77,14 +80,18 @@ type DistSQLContext struct {
 	RequestSourceType             string
 	ExplicitRequestSourceType     string
 	StoreBatchSize                int
+	AdaptiveDistSQLConcurrency    bool
 	ResourceGroupName             string
 	LoadBasedReplicaReadThreshold time.Duration
 	RunawayChecker                *resourcegroup.RunawayChecker
 	TiKVClientReadTimeout         uint64
+	RegionErrorMaxRetries         int
+	RegionErrorRetries            *atomic.Int64
 
 	ReplicaClosestReadThreshold int64
 	ConnectionID                uint64
 	SessionAlias                string
+	ExplainAnalyzeTopRegions    int
 
 	ExecDetails *execdetails.SyncExecDetails
 }
@@ -300,6 +300,9 @@ func (builder *RequestBuilder) SetFromSessionVars(dctx *distsqlctx.DistSQLContex
 	builder.Request.TaskID = dctx.TaskID
 	builder.Request.Priority = builder.getKVPriority(dctx)
 	builder.Request.ReplicaRead = replicaReadType
+	if len(dctx.MatchStoreLabels) > 0 && replicaReadType.IsFollowerRead() {
+		builder.Request.MatchStoreLabels = dctx.MatchStoreLabels
+	}
 	builder.SetResourceGroupTagger(dctx.ResourceGroupTagger)
 	{
 		builder.SetPaging(dctx.EnablePaging)
@@ -310,10 +313,13 @@ func (builder *RequestBuilder) SetFromSessionVars(dctx *distsqlctx.DistSQLContex
 	builder.RequestSource.RequestSourceType = dctx.RequestSourceType
 	builder.RequestSource.ExplicitRequestSourceType = dctx.ExplicitRequestSourceType
 	builder.StoreBatchSize = dctx.StoreBatchSize
+	builder.Request.AdaptiveConcurrency = dctx.AdaptiveDistSQLConcurrency
 	builder.Request.ResourceGroupName = dctx.ResourceGroupName
 	builder.Request.StoreBusyThreshold = dctx.LoadBasedReplicaReadThreshold
 	builder.Request.RunawayChecker = dctx.RunawayChecker
 	builder.Request.TiKVClientReadTimeout = dctx.TiKVClientReadTimeout
+	builder.Request.MaxRegionErrorRetries = dctx.RegionErrorMaxRetries
+	builder.Request.RegionErrorRetries = dctx.RegionErrorRetries
 	return builder
 }
 
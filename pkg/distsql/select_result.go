@@ -16,9 +16,11 @@ package distsql
 
 import (
 	"bytes"
+	"cmp"
 	"container/heap"
 	"context"
 	"fmt"
+	"slices"
 	"strconv"
 	"sync/atomic"
 	"time"
@@ -364,7 +366,7 @@ func (r *selectResult) fetchResp(ctx context.Context) error {
 		if ok {
 			copStats := hasStats.GetCopRuntimeStats()
 			if copStats != nil {
-				if err := r.updateCopRuntimeStats(ctx, copStats, resultSubset.RespTime()); err != nil {
+				if err := r.updateCopRuntimeStats(ctx, copStats, resultSubset.RespTime(), copTaskProducedRows(r.selectResp.GetExecutionSummaries())); err != nil {
 					return err
 				}
 				copStats.CopTime = duration
@@ -496,7 +498,8 @@ func recordExecutionSummariesForTiFlashTasks(runtimeStatsColl *execdetails.Runti
 	FillDummySummariesForTiFlashTasks(runtimeStatsColl, callee, storeTypeName, allPlanIDs, recordedPlanIDs)
 }
 
-func (r *selectResult) updateCopRuntimeStats(ctx context.Context, copStats *copr.CopRuntimeStats, respTime time.Duration) (err error) {
+// updateCopRuntimeStats merges the runtime stats of a cop task, rows is the number of rows returned by the task.
+func (r *selectResult) updateCopRuntimeStats(ctx context.Context, copStats *copr.CopRuntimeStats, respTime time.Duration, rows int64) (err error) {
 	callee := copStats.CalleeAddress
 	if r.rootPlanID <= 0 || r.ctx.RuntimeStatsColl == nil || (callee == "" && (copStats.ReqStats == nil || len(copStats.ReqStats.RPCStats) == 0)) {
 		return
@@ -514,6 +517,7 @@ func (r *selectResult) updateCopRuntimeStats(ctx context.Context, copStats *copr
 			backoffSleep:       make(map[string]time.Duration),
 			reqStat:            tikv.NewRegionRequestRuntimeStats(),
 			distSQLConcurrency: r.distSQLConcurrency,
+			topRegions:         r.ctx.ExplainAnalyzeTopRegions,
 		}
 		if ci, ok := r.resp.(copr.CopInfo); ok {
 			conc, extraConc := ci.GetConcurrency()
@@ -521,7 +525,7 @@ func (r *selectResult) updateCopRuntimeStats(ctx context.Context, copStats *copr
 			r.stats.extraConcurrency = extraConc
 		}
 	}
-	r.stats.mergeCopRuntimeStats(copStats, respTime)
+	r.stats.mergeCopRuntimeStats(copStats, respTime, rows)
 
 	if copStats.ScanDetail != nil && len(r.copPlanIDs) > 0 {
 		r.ctx.RuntimeStatsColl.RecordScanDetail(r.copPlanIDs[len(r.copPlanIDs)-1], r.storeType.Name(), copStats.ScanDetail)
@@ -606,7 +610,9 @@ func (r *selectResult) Close() error {
 		if unconsumed, ok := r.resp.(copr.HasUnconsumedCopRuntimeStats); ok && unconsumed != nil {
 			unconsumedCopStats := unconsumed.CollectUnconsumedCopRuntimeStats()
 			for _, copStats := range unconsumedCopStats {
-				_ = r.updateCopRuntimeStats(context.Background(), copStats, time.Duration(0))
+				// The responses of the unconsumed cop tasks are not read, so the returned rows are unknown. r.selectResp
+				// is the last read response which belongs to another task.
+				_ = r.updateCopRuntimeStats(context.Background(), copStats, time.Duration(0), 0)
 				r.ctx.ExecDetails.MergeExecDetails(&copStats.ExecDetails, nil)
 			}
 		}
@@ -645,9 +651,92 @@ type selectResultRuntimeStats struct {
 	storeBatchedNum         uint64
 	storeBatchedFallbackNum uint64
 	buildTaskDuration       time.Duration
+
+	// topRegions is the number of slowest regions to report, the per-store and per-region
+	// breakdown is only collected when it is greater than 0.
+	topRegions    int
+	storeDetails  map[string]*copTaskDetail
+	regionDetails map[uint64]*copTaskDetail
+}
+
+// copTaskDetail is the aggregated cop task information of a store or a region.
+type copTaskDetail struct {
+	store    string
+	num      int
+	respTime time.Duration
+	procKeys int64
+	rows     int64
+}
+
+func (d *copTaskDetail) merge(other *copTaskDetail) {
+	d.num += other.num
+	d.respTime += other.respTime
+	d.procKeys += other.procKeys
+	d.rows += other.rows
+}
+
+func (d *copTaskDetail) String() string {
+	return fmt.Sprintf("num: %d, time: %v, proc_keys: %d, rows: %d", d.num, execdetails.FormatDuration(d.respTime), d.procKeys, d.rows)
+}
+
+func mergeCopTaskDetails[K comparable](dst map[K]*copTaskDetail, src map[K]*copTaskDetail) map[K]*copTaskDetail {
+	if len(src) == 0 {
+		return dst
+	}
+	if dst == nil {
+		dst = make(map[K]*copTaskDetail, len(src))
+	}
+	for k, v := range src {
+		if d, ok := dst[k]; ok {
+			d.merge(v)
+		} else {
+			detail := *v
+			dst[k] = &detail
+		}
+	}
+	return dst
+}
+
+// copTaskProducedRows returns the number of rows returned by a cop task, which are produced by the
+// last executor of the task, or 0 if the task has no execution summaries.
+func copTaskProducedRows(summaries []*tipb.ExecutorExecutionSummary) int64 {
+	if len(summaries) == 0 {
+		return 0
+	}
+	if last := summaries[len(summaries)-1]; last != nil && last.NumProducedRows != nil {
+		return int64(*last.NumProducedRows)
+	}
+	return 0
+}
+
+func (s *selectResultRuntimeStats) recordTaskDetail(copStats *copr.CopRuntimeStats, respTime time.Duration, rows int64) {
+	detail := copTaskDetail{store: copStats.CalleeAddress, num: 1, respTime: respTime, rows: rows}
+	if copStats.ScanDetail != nil {
+		detail.procKeys = copStats.ScanDetail.ProcessedKeys
+	}
+	if s.storeDetails == nil {
+		s.storeDetails = make(map[string]*copTaskDetail)
+	}
+	if d, ok := s.storeDetails[detail.store]; ok {
+		d.merge(&detail)
+	} else {
+		storeDetail := detail
+		s.storeDetails[detail.store] = &storeDetail
+	}
+	if copStats.RegionID == 0 {
+		return
+	}
+	if s.regionDetails == nil {
+		s.regionDetails = make(map[uint64]*copTaskDetail)
+	}
+	if d, ok := s.regionDetails[copStats.RegionID]; ok {
+		d.merge(&detail)
+	} else {
+		s.regionDetails[copStats.RegionID] = &detail
+	}
 }
 
-func (s *selectResultRuntimeStats) mergeCopRuntimeStats(copStats *copr.CopRuntimeStats, respTime time.Duration) {
+func (s *selectResultRuntimeStats) mergeCopRuntimeStats(copStats *copr.CopRuntimeStats, respTime time.Duration, rows int64) {
 	s.copRespTime.Add(execdetails.Duration(respTime))
 	if copStats.ScanDetail != nil {
 		s.procKeys.Add(execdetails.Int64(copStats.ScanDetail.ProcessedKeys))
@@ -661,6 +750,9 @@ func (s *selectResultRuntimeStats) mergeCopRuntimeStats(copStats *copr.CopRuntim
 	if copStats.CoprCacheHit {
 		s.CoprCacheHitNum++
 	}
+	if s.topRegions > 0 {
+		s.recordTaskDetail(copStats, respTime, rows)
+	}
 }
 
 func (s *selectResultRuntimeStats) Clone() execdetails.RuntimeStats {
@@ -675,8 +767,11 @@ func (s *selectResultRuntimeStats) Clone() execdetails.RuntimeStats {
 		storeBatchedNum:         s.storeBatchedNum,
 		storeBatchedFallbackNum: s.storeBatchedFallbackNum,
 		buildTaskDuration:       s.buildTaskDuration,
+		topRegions:              s.topRegions,
 	}
 	newRs.copRespTime.MergePercentile(&s.copRespTime)
+	newRs.storeDetails = mergeCopTaskDetails(nil, s.storeDetails)
+	newRs.regionDetails = mergeCopTaskDetails(nil, s.regionDetails)
 	newRs.procKeys.MergePercentile(&s.procKeys)
 	for k, v := range s.backoffSleep {
 		newRs.backoffSleep[k] += v
@@ -711,6 +806,11 @@ func (s *selectResultRuntimeStats) Merge(rs execdetails.RuntimeStats) {
 	s.storeBatchedNum += other.storeBatchedNum
 	s.storeBatchedFallbackNum += other.storeBatchedFallbackNum
 	s.buildTaskDuration += other.buildTaskDuration
+	if other.topRegions > s.topRegions {
+		s.topRegions = other.topRegions
+	}
+	s.storeDetails = mergeCopTaskDetails(s.storeDetails, other.storeDetails)
+	s.regionDetails = mergeCopTaskDetails(s.regionDetails, other.regionDetails)
 }
 
 func (s *selectResultRuntimeStats) String() string {
@@ -775,6 +875,8 @@ func (s *selectResultRuntimeStats) String() string {
 		buf.WriteString("}")
 	}
 
+	s.writeTaskDetails(buf)
+
 	rpcStatsStr := reqStat.String()
 	if len(rpcStatsStr) > 0 {
 		buf.WriteString(", rpc_info:{")
@@ -797,6 +899,52 @@ func (s *selectResultRuntimeStats) String() string {
 	return buf.String()
 }
 
+// writeTaskDetails writes the per-store breakdown and the slowest regions of the cop tasks.
+func (s *selectResultRuntimeStats) writeTaskDetails(buf *bytes.Buffer) {
+	if s.topRegions <= 0 || len(s.storeDetails) == 0 {
+		return
+	}
+	stores := make([]string, 0, len(s.storeDetails))
+	for store := range s.storeDetails {
+		stores = append(stores, store)
+	}
+	slices.Sort(stores)
+	buf.WriteString(", store_detail: {")
+	for i, store := range stores {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		fmt.Fprintf(buf, "%s: {%s}", store, s.storeDetails[store])
+	}
+	buf.WriteString("}")
+
+	if len(s.regionDetails) == 0 {
+		return
+	}
+	regions := make([]uint64, 0, len(s.regionDetails))
+	for id := range s.regionDetails {
+		regions = append(regions, id)
+	}
+	slices.SortFunc(regions, func(a, b uint64) int {
+		if c := cmp.Compare(s.regionDetails[b].respTime, s.regionDetails[a].respTime); c != 0 {
+			return c
+		}
+		return cmp.Compare(a, b)
+	})
+	if len(regions) > s.topRegions {
+		regions = regions[:s.topRegions]
+	}
+	buf.WriteString(", top_regions: {")
+	for i, id := range regions {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		detail := s.regionDetails[id]
+		fmt.Fprintf(buf, "%d: {store: %s, %s}", id, detail.store, detail)
+	}
+	buf.WriteString("}")
+}
+
 // Tp implements the RuntimeStats interface.
 func (*selectResultRuntimeStats) Tp() int {
 	return execdetails.TpSelectResultRuntimeStats
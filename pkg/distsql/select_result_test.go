@@ -17,6 +17,7 @@ package distsql
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
@@ -25,6 +26,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/mock"
 	"github.com/pingcap/tipb/go-tipb"
 	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/tikv"
 )
 
 func TestUpdateCopRuntimeStats(t *testing.T) {
@@ -34,7 +36,7 @@ func TestUpdateCopRuntimeStats(t *testing.T) {
 	require.Nil(t, ctx.GetSessionVars().StmtCtx.RuntimeStatsColl)
 
 	sr.rootPlanID = 1234
-	sr.updateCopRuntimeStats(context.Background(), &copr.CopRuntimeStats{ExecDetails: execdetails.ExecDetails{DetailsNeedP90: execdetails.DetailsNeedP90{CalleeAddress: "a"}}}, 0)
+	sr.updateCopRuntimeStats(context.Background(), &copr.CopRuntimeStats{ExecDetails: execdetails.ExecDetails{DetailsNeedP90: execdetails.DetailsNeedP90{CalleeAddress: "a"}}}, 0, 0)
 
 	ctx.GetSessionVars().StmtCtx.RuntimeStatsColl = execdetails.NewRuntimeStatsColl(nil)
 	// refresh the ctx after assigning `RuntimeStatsColl`.
@@ -48,13 +50,56 @@ func TestUpdateCopRuntimeStats(t *testing.T) {
 
 	require.NotEqual(t, len(sr.copPlanIDs), len(sr.selectResp.GetExecutionSummaries()))
 
-	sr.updateCopRuntimeStats(context.Background(), &copr.CopRuntimeStats{ExecDetails: execdetails.ExecDetails{DetailsNeedP90: execdetails.DetailsNeedP90{CalleeAddress: "callee"}}}, 0)
+	sr.updateCopRuntimeStats(context.Background(), &copr.CopRuntimeStats{ExecDetails: execdetails.ExecDetails{DetailsNeedP90: execdetails.DetailsNeedP90{CalleeAddress: "callee"}}}, 0, 0)
 	require.False(t, ctx.GetSessionVars().StmtCtx.RuntimeStatsColl.ExistsCopStats(1234))
 
 	sr.copPlanIDs = []int{sr.rootPlanID}
 	require.NotNil(t, ctx.GetSessionVars().StmtCtx.RuntimeStatsColl)
 	require.Equal(t, len(sr.copPlanIDs), len(sr.selectResp.GetExecutionSummaries()))
 
-	sr.updateCopRuntimeStats(context.Background(), &copr.CopRuntimeStats{ExecDetails: execdetails.ExecDetails{DetailsNeedP90: execdetails.DetailsNeedP90{CalleeAddress: "callee"}}}, 0)
+	sr.updateCopRuntimeStats(context.Background(), &copr.CopRuntimeStats{ExecDetails: execdetails.ExecDetails{DetailsNeedP90: execdetails.DetailsNeedP90{CalleeAddress: "callee"}}}, 0, 0)
 	require.Equal(t, "tikv_task:{time:1ns, loops:1}", ctx.GetSessionVars().StmtCtx.RuntimeStatsColl.GetOrCreateCopStats(1234, "tikv").String())
 }
+
+func TestSelectResultRuntimeStatsTopRegions(t *testing.T) {
+	newCopStats := func(store string, regionID uint64) *copr.CopRuntimeStats {
+		return &copr.CopRuntimeStats{
+			ExecDetails: execdetails.ExecDetails{DetailsNeedP90: execdetails.DetailsNeedP90{CalleeAddress: store}},
+			RegionID:    regionID,
+		}
+	}
+	s := &selectResultRuntimeStats{
+		backoffSleep: make(map[string]time.Duration),
+		reqStat:      tikv.NewRegionRequestRuntimeStats(),
+		topRegions:   2,
+	}
+	s.mergeCopRuntimeStats(newCopStats("store1", 1), time.Millisecond, 10)
+	s.mergeCopRuntimeStats(newCopStats("store1", 2), 3*time.Millisecond, 20)
+	s.mergeCopRuntimeStats(newCopStats("store2", 3), 5*time.Millisecond, 30)
+	s.mergeCopRuntimeStats(newCopStats("store1", 1), 3*time.Millisecond, 40)
+	require.Contains(t, s.String(), "store_detail: {store1: {num: 3, time: 7ms, proc_keys: 0, rows: 70}, store2: {num: 1, time: 5ms, proc_keys: 0, rows: 30}}")
+	require.Contains(t, s.String(), "top_regions: {3: {store: store2, num: 1, time: 5ms, proc_keys: 0, rows: 30}, 1: {store: store1, num: 2, time: 4ms, proc_keys: 0, rows: 50}}")
+
+	cloned := s.Clone().(*selectResultRuntimeStats)
+	cloned.Merge(s)
+	require.Contains(t, cloned.String(), "store_detail: {store1: {num: 6, time: 14ms, proc_keys: 0, rows: 140}, store2: {num: 2, time: 10ms, proc_keys: 0, rows: 60}}")
+	// merging into the clone must not change the original stats.
+	require.Contains(t, s.String(), "store_detail: {store1: {num: 3, time: 7ms, proc_keys: 0, rows: 70}")
+
+	// the breakdown is not collected when it is disabled.
+	s = &selectResultRuntimeStats{
+		backoffSleep: make(map[string]time.Duration),
+		reqStat:      tikv.NewRegionRequestRuntimeStats(),
+	}
+	s.mergeCopRuntimeStats(newCopStats("store1", 1), time.Millisecond, 10)
+	require.NotContains(t, s.String(), "store_detail")
+	require.NotContains(t, s.String(), "top_regions")
+}
+
+func TestCopTaskProducedRows(t *testing.T) {
+	require.Equal(t, int64(0), copTaskProducedRows(nil))
+	scanRows, selRows := uint64(100), uint64(10)
+	summaries := []*tipb.ExecutorExecutionSummary{{NumProducedRows: &scanRows}, {NumProducedRows: &selRows}}
+	require.Equal(t, int64(10), copTaskProducedRows(summaries))
+	require.Equal(t, int64(0), copTaskProducedRows([]*tipb.ExecutorExecutionSummary{{}}))
+}
@@ -21,8 +21,11 @@ import (
 
 	"github.com/pingcap/tidb/pkg/disttask/framework/proto"
 	"github.com/pingcap/tidb/pkg/disttask/framework/scheduler"
+	"github.com/pingcap/tidb/pkg/disttask/framework/storage"
+	"github.com/pingcap/tidb/pkg/executor/importer"
 	"github.com/pingcap/tidb/pkg/lightning/backend/external"
 	"github.com/pingcap/tidb/pkg/lightning/log"
+	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"go.uber.org/zap"
 )
@@ -47,11 +50,18 @@ func (*ImportCleanUpS3) CleanUp(ctx context.Context, task *proto.Task) error {
 		return err
 	}
 	defer redactSensitiveInfo(task, taskMeta)
+	logger := logutil.BgLogger().With(zap.Int64("task-id", task.ID))
+	if taskMeta.Plan.ConflictStrategy != "" {
+		// the expired conflicting rows of all jobs are deleted here, it's not
+		// critical, so only log the error.
+		if err = deleteExpiredConflictRows(ctx); err != nil {
+			logger.Warn("failed to delete expired conflicting rows", zap.Error(err))
+		}
+	}
 	// Not use cloud storage, no need to cleanUp.
 	if taskMeta.Plan.CloudStorageURI == "" {
 		return nil
 	}
-	logger := logutil.BgLogger().With(zap.Int64("task-id", task.ID))
 	callLog := log.BeginTask(logger, "cleanup global sorted data")
 	defer callLog.End(zap.InfoLevel, nil)
 
@@ -72,6 +82,16 @@ func (*ImportCleanUpS3) CleanUp(ctx context.Context, task *proto.Task) error {
 	return nil
 }
 
+func deleteExpiredConflictRows(ctx context.Context) error {
+	taskManager, err := storage.GetTaskManager()
+	if err != nil {
+		return err
+	}
+	return taskManager.WithNewSession(func(se sessionctx.Context) error {
+		return importer.DeleteExpiredConflictRows(ctx, se, importer.ConflictRowsRetention)
+	})
+}
+
 func init() {
 	scheduler.RegisterSchedulerCleanUpFactory(proto.ImportInto, newImportCleanUpS3)
 }
@@ -37,6 +37,7 @@ import (
 	"github.com/pingcap/tidb/pkg/meta/autoid"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/table/tables"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/tikv/client-go/v2/oracle"
 	"go.uber.org/zap"
@@ -288,6 +289,11 @@ func generateImportSpecs(pCtx planner.PlanCtx, p *LogicalPlan) ([]planner.Pipeli
 		}
 		importSpecs = append(importSpecs, importSpec)
 	}
+	// conflicts are only detected inside the local duplicate db of a subtask,
+	// the rows conflicting across subtasks can't be resolved.
+	if p.Plan.ConflictStrategy != "" && len(importSpecs) > 1 {
+		return nil, exeerrors.ErrLoadDataUnsupportedOption.FastGenByArgs("conflict_strategy", "import with more than one subtask")
+	}
 	return importSpecs, nil
 }
 
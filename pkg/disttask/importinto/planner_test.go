@@ -28,6 +28,7 @@ import (
 	"github.com/pingcap/tidb/pkg/lightning/backend/external"
 	"github.com/pingcap/tidb/pkg/meta/autoid"
 	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
 	"github.com/stretchr/testify/require"
 )
 
@@ -160,6 +161,27 @@ func genEncodeStepMetas(t *testing.T, cnt int) [][]byte {
 	return stepMetaBytes
 }
 
+func TestGenerateImportSpecsWithConflictStrategy(t *testing.T) {
+	logicalPlan := &LogicalPlan{
+		JobID: 1,
+		Plan: importer.Plan{
+			ConflictStrategy: importer.ConflictStrategySkip,
+		},
+		ChunkMap: map[int32][]Chunk{
+			-1: {},
+			1:  {{Path: "gs://test-load/1.csv"}},
+		},
+	}
+	specs, err := generateImportSpecs(planner.PlanCtx{}, logicalPlan)
+	require.NoError(t, err)
+	require.Len(t, specs, 1)
+
+	// conflicts across subtasks can't be resolved.
+	logicalPlan.ChunkMap[2] = []Chunk{{Path: "gs://test-load/2.csv"}}
+	_, err = generateImportSpecs(planner.PlanCtx{}, logicalPlan)
+	require.ErrorIs(t, err, exeerrors.ErrLoadDataUnsupportedOption)
+}
+
 func TestGenerateMergeSortSpecs(t *testing.T) {
 	stepBak := external.MergeSortFileCountStep
 	external.MergeSortFileCountStep = 2
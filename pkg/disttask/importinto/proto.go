@@ -173,6 +173,7 @@ type Checksum struct {
 // Result records the metrics information.
 // This portion of the code may be implemented uniformly in the framework in the future.
 type Result struct {
-	LoadedRowCnt uint64
-	ColSizeMap   map[int64]int64
+	LoadedRowCnt   uint64
+	ConflictRowCnt uint64
+	ColSizeMap     map[int64]int64
 }
@@ -580,6 +580,7 @@ func updateResult(handle storage.TaskHandle, task *proto.Task, taskMeta *TaskMet
 	columnSizeMap := make(map[int64]int64)
 	for _, subtaskMeta := range subtaskMetas {
 		taskMeta.Result.LoadedRowCnt += subtaskMeta.Result.LoadedRowCnt
+		taskMeta.Result.ConflictRowCnt += subtaskMeta.Result.ConflictRowCnt
 		for key, val := range subtaskMeta.Result.ColSizeMap {
 			columnSizeMap[key] += val
 		}
@@ -660,7 +661,10 @@ func (sch *ImportSchedulerExt) finishJob(ctx context.Context, logger *zap.Logger
 	taskHandle storage.TaskHandle, task *proto.Task, taskMeta *TaskMeta) error {
 	// we have already switch import-mode when switch to post-process step.
 	sch.unregisterTask(ctx, task)
-	summary := &importer.JobSummary{ImportedRows: taskMeta.Result.LoadedRowCnt}
+	summary := &importer.JobSummary{
+		ImportedRows: taskMeta.Result.LoadedRowCnt,
+		ConflictRows: taskMeta.Result.ConflictRowCnt,
+	}
 	// retry for 3+6+12+24+(30-4)*30 ~= 825s ~= 14 minutes
 	backoffer := backoff.NewExponential(scheduler.RetrySQLInterval, 2, scheduler.RetrySQLMaxInterval)
 	return handle.RunWithRetry(ctx, scheduler.RetrySQLTimes, backoffer, logger,
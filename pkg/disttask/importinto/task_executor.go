@@ -26,6 +26,7 @@ import (
 	"github.com/pingcap/failpoint"
 	brlogutil "github.com/pingcap/tidb/br/pkg/logutil"
 	"github.com/pingcap/tidb/pkg/disttask/framework/proto"
+	"github.com/pingcap/tidb/pkg/disttask/framework/storage"
 	"github.com/pingcap/tidb/pkg/disttask/framework/taskexecutor"
 	"github.com/pingcap/tidb/pkg/disttask/framework/taskexecutor/execute"
 	"github.com/pingcap/tidb/pkg/disttask/operator"
@@ -40,7 +41,9 @@ import (
 	"github.com/pingcap/tidb/pkg/lightning/metric"
 	"github.com/pingcap/tidb/pkg/lightning/verification"
 	"github.com/pingcap/tidb/pkg/meta/autoid"
+	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/table/tables"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
@@ -92,6 +95,29 @@ func getTableImporter(
 	return importer.NewTableImporter(ctx, controller, strconv.FormatInt(taskID, 10), store)
 }
 
+// NewImportStepExecutorForTest creates a new import step executor with the table
+// importer and the shared variables of the subtasks, it's only used in test.
+func NewImportStepExecutorForTest(
+	taskID int64,
+	store tidbkv.Storage,
+	taskMeta *TaskMeta,
+	tableImporter *importer.TableImporter,
+	sharedVars map[int32]*SharedVars,
+	logger *zap.Logger,
+) execute.StepExecutor {
+	s := &importStepExecutor{
+		taskID:        taskID,
+		taskMeta:      taskMeta,
+		tableImporter: tableImporter,
+		store:         store,
+		logger:        logger,
+	}
+	for id, vars := range sharedVars {
+		s.sharedVars.Store(id, vars)
+	}
+	return s
+}
+
 func (s *importStepExecutor) Init(ctx context.Context) error {
 	s.logger.Info("init subtask env")
 	tableImporter, err := getTableImporter(ctx, s.taskID, s.taskMeta, s.store)
@@ -207,14 +233,37 @@ func (s *importStepExecutor) OnFinished(ctx context.Context, subtask *proto.Subt
 		return errors.Errorf("sharedVars %d not found", subtaskMeta.ID)
 	}
 
-	var dataKVCount uint64
+	var dataKVCount, conflictRowCnt uint64
 	if s.tableImporter.IsLocalSort() {
 		// TODO: we should close and cleanup engine in all case, since there's no checkpoint.
-		s.logger.Info("import data engine", zap.Int32("engine-id", subtaskMeta.ID))
 		closedDataEngine, err := sharedVars.DataEngine.Close(ctx)
 		if err != nil {
 			return err
 		}
+		closedIndexEngine, err := sharedVars.IndexEngine.Close(ctx)
+		if err != nil {
+			return err
+		}
+
+		// conflicts are checked before importing with the 'error' strategy,
+		// so no conflicting data is ingested when the job fails.
+		if s.taskMeta.Plan.ConflictStrategy == importer.ConflictStrategyError {
+			sharedVars.mu.Lock()
+			conflictResult, err := s.tableImporter.CheckConflicts(ctx, s.store, sharedVars.Checksum,
+				closedDataEngine.GetUUID(), closedIndexEngine.GetUUID())
+			sharedVars.mu.Unlock()
+			if err != nil {
+				return err
+			}
+			if err = s.recordConflicts(ctx, conflictResult); err != nil {
+				return err
+			}
+			if conflictResult.ConflictRows > 0 {
+				return exeerrors.ErrLoadDataConflictFound.GenWithStackByArgs(conflictResult.ConflictRows, s.tableImporter.FullTableName())
+			}
+		}
+
+		s.logger.Info("import data engine", zap.Int32("engine-id", subtaskMeta.ID))
 		dataKVCount2, err := s.tableImporter.ImportAndCleanup(ctx, closedDataEngine)
 		if err != nil {
 			return err
@@ -222,11 +271,23 @@ func (s *importStepExecutor) OnFinished(ctx context.Context, subtask *proto.Subt
 		dataKVCount = uint64(dataKVCount2)
 
 		s.logger.Info("import index engine", zap.Int32("engine-id", subtaskMeta.ID))
-		if closedEngine, err := sharedVars.IndexEngine.Close(ctx); err != nil {
+		if _, err := s.tableImporter.ImportAndCleanup(ctx, closedIndexEngine); err != nil {
 			return err
-		} else if _, err := s.tableImporter.ImportAndCleanup(ctx, closedEngine); err != nil {
+		}
+
+		// other conflicts can only be resolved after both data and index
+		// engines are imported.
+		sharedVars.mu.Lock()
+		conflictResult, err := s.tableImporter.ResolveConflicts(ctx, s.store, sharedVars.Checksum)
+		sharedVars.mu.Unlock()
+		if err != nil {
+			return err
+		}
+		if err = s.recordConflicts(ctx, conflictResult); err != nil {
 			return err
 		}
+		dataKVCount -= uint64(conflictResult.DeletedRows)
+		conflictRowCnt = uint64(conflictResult.ConflictRows)
 	}
 	// there's no imported dataKVCount on this stage when using global sort.
 
@@ -241,8 +302,9 @@ func (s *importStepExecutor) OnFinished(ctx context.Context, subtask *proto.Subt
 		}
 	}
 	subtaskMeta.Result = Result{
-		LoadedRowCnt: dataKVCount,
-		ColSizeMap:   sharedVars.Progress.GetColSize(),
+		LoadedRowCnt:   dataKVCount,
+		ConflictRowCnt: conflictRowCnt,
+		ColSizeMap:     sharedVars.Progress.GetColSize(),
 	}
 	allocators := sharedVars.TableImporter.Allocators()
 	subtaskMeta.MaxIDs = map[autoid.AllocatorType]int64{
@@ -261,6 +323,29 @@ func (s *importStepExecutor) OnFinished(ctx context.Context, subtask *proto.Subt
 	return nil
 }
 
+func (s *importStepExecutor) recordConflicts(ctx context.Context, result *importer.ConflictResult) error {
+	if result.ConflictRows == 0 {
+		return nil
+	}
+	s.logger.Info("found conflicting rows",
+		zap.String("strategy", s.taskMeta.Plan.ConflictStrategy),
+		zap.Int64("conflict-rows", result.ConflictRows),
+		zap.Int64("deleted-rows", result.DeletedRows),
+		zap.Int("samples", len(result.Samples)))
+	if len(result.Samples) == 0 {
+		return nil
+	}
+	taskManager, err := storage.GetTaskManager()
+	if err != nil {
+		return err
+	}
+	plan := &s.taskMeta.Plan
+	return taskManager.WithNewSession(func(se sessionctx.Context) error {
+		return importer.RecordConflictRows(ctx, se.GetSQLExecutor(), s.taskMeta.JobID,
+			plan.DBName, plan.TableInfo.Name.O, plan.ConflictStrategy, result.Samples)
+	})
+}
+
 func (s *importStepExecutor) Cleanup(_ context.Context) (err error) {
 	s.logger.Info("cleanup subtask env")
 	s.importCancel()
@@ -17,17 +17,34 @@ package importinto_test
 import (
 	"context"
 	"encoding/json"
+	"os"
+	"path"
 	"strconv"
 	"testing"
 
+	tidb "github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/disttask/framework/proto"
 	"github.com/pingcap/tidb/pkg/disttask/importinto"
 	"github.com/pingcap/tidb/pkg/executor/importer"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/lightning/backend"
+	"github.com/pingcap/tidb/pkg/lightning/backend/encode"
+	lkv "github.com/pingcap/tidb/pkg/lightning/backend/kv"
+	"github.com/pingcap/tidb/pkg/lightning/common"
 	"github.com/pingcap/tidb/pkg/lightning/config"
+	"github.com/pingcap/tidb/pkg/lightning/log"
+	"github.com/pingcap/tidb/pkg/lightning/verification"
+	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/model"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
 	"github.com/pingcap/tidb/pkg/session"
+	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
 	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/tikv"
 	"go.uber.org/zap"
 )
 
@@ -93,3 +110,105 @@ func TestPostProcessStepExecutor(t *testing.T) {
 	err = executor.RunSubtask(context.Background(), &proto.Subtask{Meta: bytes})
 	require.NoError(t, err)
 }
+
+type storeHelper struct {
+	kvStore kv.Storage
+}
+
+func (*storeHelper) GetTS(_ context.Context) (physical, logical int64, err error) {
+	return 0, 0, nil
+}
+
+func (s *storeHelper) GetTiKVCodec() tikv.Codec {
+	return s.kvStore.GetCodec()
+}
+
+func TestImportStepExecutorConflictError(t *testing.T) {
+	ctx := context.Background()
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tidbCfg := tidb.GetGlobalConfig()
+	tidbCfg.TempDir = t.TempDir()
+	fileName := path.Join(tidbCfg.TempDir, "test.csv")
+	require.NoError(t, os.WriteFile(fileName, []byte("1,10\n"), 0o644))
+
+	tk.MustExec("create table test.t(id int primary key, v int, unique key uk(v))")
+	dom, err := session.GetDomain(store)
+	require.NoError(t, err)
+	dbInfo, ok := dom.InfoSchema().SchemaByName(model.NewCIStr("test"))
+	require.True(t, ok)
+	table, err := dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	plan, err := importer.NewImportPlan(ctx, tk.Session(), &plannercore.ImportInto{
+		Path:  fileName,
+		Table: &ast.TableName{Name: table.Meta().Name, DBInfo: dbInfo},
+		Options: []*plannercore.LoadDataOpt{
+			{Name: "conflict_strategy", Value: expression.NewStrConst(importer.ConflictStrategyError)},
+		},
+	}, table)
+	require.NoError(t, err)
+	controller, err := importer.NewLoadDataController(plan, table, &importer.ASTArgs{})
+	require.NoError(t, err)
+	require.NoError(t, controller.InitDataStore(ctx))
+	ti, err := importer.NewTableImporterForTest(ctx, controller, "1", &storeHelper{kvStore: store})
+	require.NoError(t, err)
+	defer ti.Backend().CloseEngineMgr()
+
+	// (1, 20) conflicts with (1, 10) on primary key.
+	encoder, err := lkv.NewBaseKVEncoder(&encode.EncodingConfig{
+		Table: table,
+		SessionOptions: encode.SessionOptions{
+			SQLMode: ti.SQLMode,
+			SysVars: ti.ImportantSysVars,
+		},
+		Logger: log.Logger{Logger: zap.NewExample()},
+	})
+	require.NoError(t, err)
+	var dataPairs, indexPairs []common.KvPair
+	for i, row := range [][]types.Datum{types.MakeDatums(1, 10), types.MakeDatums(1, 20)} {
+		_, err = encoder.Table.AddRecord(encoder.SessionCtx.GetTableCtx(), row)
+		require.NoError(t, err)
+		for _, pair := range encoder.SessionCtx.TakeKvPairs().Pairs {
+			pair.RowID = common.EncodeIntRowID(int64(i + 1))
+			if tablecodec.IsRecordKey(pair.Key) {
+				dataPairs = append(dataPairs, pair)
+			} else {
+				indexPairs = append(indexPairs, pair)
+			}
+		}
+	}
+	writeEngine := func(engine *backend.OpenedEngine, pairs []common.KvPair) {
+		w, err := engine.LocalWriter(ctx, &backend.LocalWriterConfig{})
+		require.NoError(t, err)
+		require.NoError(t, w.AppendRows(ctx, nil, lkv.MakeRowsFromKvPairs(pairs)))
+		_, err = w.Close(ctx)
+		require.NoError(t, err)
+	}
+	dataEngine, err := ti.OpenDataEngine(ctx, 1)
+	require.NoError(t, err)
+	writeEngine(dataEngine, dataPairs)
+	indexEngine, err := ti.OpenIndexEngine(ctx, common.IndexEngineID-1)
+	require.NoError(t, err)
+	writeEngine(indexEngine, indexPairs)
+
+	taskMeta := &importinto.TaskMeta{JobID: 1, Plan: *plan}
+	sharedVars := map[int32]*importinto.SharedVars{
+		1: {
+			TableImporter: ti,
+			DataEngine:    dataEngine,
+			IndexEngine:   indexEngine,
+			Progress:      importer.NewProgress(),
+			Checksum:      verification.NewKVGroupChecksumWithKeyspace(ti.GetKeySpace()),
+		},
+	}
+	executor := importinto.NewImportStepExecutorForTest(1, store, taskMeta, ti, sharedVars, zap.NewExample())
+	bytes, err := json.Marshal(&importinto.ImportStepMeta{ID: 1})
+	require.NoError(t, err)
+	err = executor.OnFinished(ctx, &proto.Subtask{Meta: bytes})
+	require.ErrorIs(t, err, exeerrors.ErrLoadDataConflictFound)
+
+	// the job fails before any data is ingested, and the conflicting rows are recorded.
+	tk.MustQuery("select * from test.t").Check(testkit.Rows())
+	tk.MustQuery("select job_id, index_name, strategy, row_data from mysql.tidb_import_conflicts order by row_data").
+		Check(testkit.Rows("1 PRIMARY error (1, 10)", "1 PRIMARY error (1, 20)"))
+}
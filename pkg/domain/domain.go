@@ -174,6 +174,9 @@ type Domain struct {
 
 	logBackupAdvancer        *daemon.OwnerDaemon
 	historicalStatsWorker    *HistoricalStatsWorker
+	tableCacheAdvisor        *TableCacheAdvisor
+	splitAdvisor             *SplitAdvisor
+	sqlFirewall              *SQLFirewall
 	ttlJobManager            atomic.Pointer[ttlworker.JobManager]
 	runawayManager           *resourcegroup.RunawayManager
 	runawaySyncer            *runawaySyncer
@@ -713,6 +716,64 @@ func (do *Domain) topNSlowQueryLoop() {
 	}
 }
 
+func (do *Domain) tableCacheAdvisorLoop() {
+	defer util.Recover(metrics.LabelDomain, "tableCacheAdvisorLoop", nil, false)
+	ticker := time.NewTicker(tableCacheAdvisorInterval)
+	defer func() {
+		ticker.Stop()
+		logutil.BgLogger().Info("tableCacheAdvisorLoop exited.")
+	}()
+	for {
+		select {
+		case <-do.exit:
+			return
+		case <-ticker.C:
+			// Only the DDL owner makes the advices since the statements summary of the whole cluster is used.
+			if !do.DDL().OwnerManager().IsOwner() {
+				continue
+			}
+			if err := do.tableCacheAdvisor.Run(do.InfoSchema()); err != nil {
+				logutil.BgLogger().Warn("table cache advisor failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// TableCacheAdvisor returns the table cache advisor.
+func (do *Domain) TableCacheAdvisor() *TableCacheAdvisor {
+	return do.tableCacheAdvisor
+}
+
+func (do *Domain) splitAdvisorLoop() {
+	defer util.Recover(metrics.LabelDomain, "splitAdvisorLoop", nil, false)
+	ticker := time.NewTicker(splitAdvisorInterval)
+	defer func() {
+		ticker.Stop()
+		logutil.BgLogger().Info("splitAdvisorLoop exited.")
+	}()
+	for {
+		select {
+		case <-do.exit:
+			return
+		case <-ticker.C:
+			// Only the DDL owner makes the advices since the hot regions of the whole cluster are used.
+			if !do.DDL().OwnerManager().IsOwner() {
+				continue
+			}
+			ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+			if err := do.splitAdvisor.Run(ctx, do.InfoSchema()); err != nil {
+				logutil.BgLogger().Warn("hot region split advisor failed", zap.Error(err))
+			}
+			cancel()
+		}
+	}
+}
+
+// SplitAdvisor returns the hot region split advisor.
+func (do *Domain) SplitAdvisor() *SplitAdvisor {
+	return do.splitAdvisor
+}
+
 func (do *Domain) infoSyncerKeeper() {
 	defer func() {
 		logutil.BgLogger().Info("infoSyncerKeeper exited.")
@@ -1109,6 +1170,9 @@ func NewDomain(store kv.Storage, ddlLease time.Duration, statsLease time.Duratio
 	do.memoryUsageAlarmHandle = memoryusagealarm.NewMemoryUsageAlarmHandle(do.exit)
 	do.serverMemoryLimitHandle = servermemorylimit.NewServerMemoryLimitHandle(do.exit)
 	do.sysProcesses = SysProcesses{mu: &sync.RWMutex{}, procMap: make(map[uint64]sysproctrack.TrackProc)}
+	do.tableCacheAdvisor = newTableCacheAdvisor(do.sysSessionPool)
+	do.splitAdvisor = newSplitAdvisor(do.store, do.sysSessionPool)
+	do.sqlFirewall = newSQLFirewall(do.sysSessionPool)
 	do.initDomainSysVars()
 	do.expiredTimeStamp4PC.expiredTimeStamp = types.NewTime(types.ZeroCoreTime, mysql.TypeTimestamp, types.DefaultFsp)
 	return do
@@ -1300,6 +1364,10 @@ func (do *Domain) Init(
 	do.wg.Run(do.globalConfigSyncerKeeper, "globalConfigSyncerKeeper")
 	do.wg.Run(do.runawayStartLoop, "runawayStartLoop")
 	do.wg.Run(do.requestUnitsWriterLoop, "requestUnitsWriterLoop")
+	do.wg.Run(do.tableCacheAdvisorLoop, "tableCacheAdvisorLoop")
+	do.wg.Run(do.splitAdvisorLoop, "splitAdvisorLoop")
+	do.wg.Run(do.tableAccessStatsLoop, "tableAccessStatsLoop")
+	do.wg.Run(do.sqlFirewallLoop, "sqlFirewallLoop")
 	if !skipRegisterToDashboard {
 		do.wg.Run(do.topologySyncerKeeper, "topologySyncerKeeper")
 	}
@@ -1881,9 +1949,15 @@ func (do *Domain) LoadBindInfoLoop(ctxForHandle sessionctx.Context, ctxForEvolve
 	}
 
 	err := do.BindHandle().LoadFromStorageToCache(true)
-	if err != nil || bindinfo.Lease == 0 {
+	if err != nil {
 		return err
 	}
+	if err := do.BindHandle().LoadDigestHintsFromStorage(); err != nil {
+		logutil.BgLogger().Error("load digest hints failed", zap.Error(err))
+	}
+	if bindinfo.Lease == 0 {
+		return nil
+	}
 
 	owner := do.newOwnerManager(bindinfo.Prompt, bindinfo.OwnerKey)
 	do.globalBindHandleWorkerLoop(owner)
@@ -1915,11 +1989,18 @@ func (do *Domain) globalBindHandleWorkerLoop(owner owner.Manager) {
 					logutil.BgLogger().Error("update bindinfo failed", zap.Error(err))
 				}
 				bindHandle.DropInvalidGlobalBinding()
+				// Reload the digest hints to sync the ones set on the other tidb instances.
+				if err := bindHandle.LoadDigestHintsFromStorage(); err != nil {
+					logutil.BgLogger().Error("update digest hints failed", zap.Error(err))
+				}
 				// Get Global
 				optVal, err := do.GetGlobalVar(variable.TiDBCapturePlanBaseline)
 				if err == nil && variable.TiDBOptOn(optVal) {
 					bindHandle.CaptureBaselines()
 				}
+				if variable.EnablePlanRegressionShadowBinding.Load() {
+					bindHandle.CreateShadowBindings()
+				}
 			case <-gcBindTicker.C:
 				if !owner.IsOwner() {
 					continue
@@ -223,12 +223,19 @@ type planReplayerHandle struct {
 
 // SendTask send dumpTask in background task handler
 func (h *planReplayerHandle) SendTask(task *PlanReplayerDumpTask) bool {
+	if !task.IsContinuesCapture {
+		task.CaptureLimited = h.planReplayerTaskCollectorHandle.isLimitedTask(task.CaptureTaskKey)
+	}
 	select {
 	case h.planReplayerTaskDumpHandle.taskCH <- task:
 		// we directly remove the task key if we put task in channel successfully, if the task was failed to dump,
 		// the task handle will re-add the task in next loop
 		if !task.IsContinuesCapture {
-			h.planReplayerTaskCollectorHandle.removeTask(task.PlanReplayerTaskKey)
+			if task.CaptureLimited {
+				h.planReplayerTaskCollectorHandle.consumeLimitedTask(task.CaptureTaskKey)
+			} else {
+				h.planReplayerTaskCollectorHandle.removeTask(task.PlanReplayerTaskKey)
+			}
 		}
 		domain_metrics.PlanReplayerCaptureTaskSendCounter.Inc()
 		return true
@@ -244,7 +251,9 @@ func (h *planReplayerHandle) SendTask(task *PlanReplayerDumpTask) bool {
 type planReplayerTaskCollectorHandle struct {
 	taskMu struct {
 		sync.RWMutex
-		tasks map[replayer.PlanReplayerTaskKey]struct{}
+		// tasks records the remaining capture times of each task, 0 means the task isn't limited by
+		// capture times and it dumps each plan digest once.
+		tasks map[replayer.PlanReplayerTaskKey]uint64
 	}
 	ctx  context.Context
 	sctx sessionctx.Context
@@ -252,12 +261,20 @@ type planReplayerTaskCollectorHandle struct {
 
 // CollectPlanReplayerTask collects all unhandled plan replayer task
 func (h *planReplayerTaskCollectorHandle) CollectPlanReplayerTask() error {
-	allKeys, err := h.collectAllPlanReplayerTask(h.ctx)
+	allTasks, err := h.collectAllPlanReplayerTask(h.ctx)
 	if err != nil {
 		return err
 	}
-	tasks := make([]replayer.PlanReplayerTaskKey, 0)
-	for _, key := range allKeys {
+	tasks := make(map[replayer.PlanReplayerTaskKey]uint64)
+	for _, task := range allTasks {
+		key := task.PlanReplayerTaskKey
+		if task.captureLimit > 0 {
+			// a limited task dumps the matched executions until it has been captured for captureLimit times.
+			if task.capturedCount < task.captureLimit {
+				tasks[key] = task.captureLimit - task.capturedCount
+			}
+			continue
+		}
 		unhandled, err := checkUnHandledReplayerTask(h.ctx, h.sctx, key)
 		if err != nil {
 			logutil.BgLogger().Warn("collect plan replayer task failed", zap.String("category", "plan-replayer-task"), zap.Error(err))
@@ -267,7 +284,7 @@ func (h *planReplayerTaskCollectorHandle) CollectPlanReplayerTask() error {
 			logutil.BgLogger().Debug("collect plan replayer task success", zap.String("category", "plan-replayer-task"),
 				zap.String("sql-digest", key.SQLDigest),
 				zap.String("plan-digest", key.PlanDigest))
-			tasks = append(tasks, key)
+			tasks[key] = 0
 		}
 	}
 	h.setupTasks(tasks)
@@ -286,14 +303,32 @@ func (h *planReplayerTaskCollectorHandle) GetTasks() []replayer.PlanReplayerTask
 	return tasks
 }
 
-func (h *planReplayerTaskCollectorHandle) setupTasks(tasks []replayer.PlanReplayerTaskKey) {
-	r := make(map[replayer.PlanReplayerTaskKey]struct{})
-	for _, task := range tasks {
-		r[task] = struct{}{}
-	}
+func (h *planReplayerTaskCollectorHandle) setupTasks(tasks map[replayer.PlanReplayerTaskKey]uint64) {
 	h.taskMu.Lock()
 	defer h.taskMu.Unlock()
-	h.taskMu.tasks = r
+	h.taskMu.tasks = tasks
+}
+
+func (h *planReplayerTaskCollectorHandle) isLimitedTask(taskKey replayer.PlanReplayerTaskKey) bool {
+	h.taskMu.RLock()
+	defer h.taskMu.RUnlock()
+	return h.taskMu.tasks[taskKey] > 0
+}
+
+// consumeLimitedTask decreases the remaining capture times of the task, and removes the task if it has
+// been used up. The remaining times will be corrected by the captured count in the next collecting loop.
+func (h *planReplayerTaskCollectorHandle) consumeLimitedTask(taskKey replayer.PlanReplayerTaskKey) {
+	h.taskMu.Lock()
+	defer h.taskMu.Unlock()
+	remaining, ok := h.taskMu.tasks[taskKey]
+	if !ok || remaining == 0 {
+		return
+	}
+	if remaining == 1 {
+		delete(h.taskMu.tasks, taskKey)
+		return
+	}
+	h.taskMu.tasks[taskKey] = remaining - 1
 }
 
 func (h *planReplayerTaskCollectorHandle) removeTask(taskKey replayer.PlanReplayerTaskKey) {
@@ -302,9 +337,16 @@ func (h *planReplayerTaskCollectorHandle) removeTask(taskKey replayer.PlanReplay
 	delete(h.taskMu.tasks, taskKey)
 }
 
-func (h *planReplayerTaskCollectorHandle) collectAllPlanReplayerTask(ctx context.Context) ([]replayer.PlanReplayerTaskKey, error) {
+// planReplayerTaskRecord indicates record in mysql.plan_replayer_task
+type planReplayerTaskRecord struct {
+	replayer.PlanReplayerTaskKey
+	captureLimit  uint64
+	capturedCount uint64
+}
+
+func (h *planReplayerTaskCollectorHandle) collectAllPlanReplayerTask(ctx context.Context) ([]planReplayerTaskRecord, error) {
 	exec := h.sctx.GetSQLExecutor()
-	rs, err := exec.ExecuteInternal(ctx, "select sql_digest, plan_digest from mysql.plan_replayer_task")
+	rs, err := exec.ExecuteInternal(ctx, "select sql_digest, plan_digest, capture_limit, captured_count from mysql.plan_replayer_task")
 	if err != nil {
 		return nil, err
 	}
@@ -316,15 +358,19 @@ func (h *planReplayerTaskCollectorHandle) collectAllPlanReplayerTask(ctx context
 	if rows, err = sqlexec.DrainRecordSet(ctx, rs, 8); err != nil {
 		return nil, errors.Trace(err)
 	}
-	allKeys := make([]replayer.PlanReplayerTaskKey, 0, len(rows))
+	allTasks := make([]planReplayerTaskRecord, 0, len(rows))
 	for _, row := range rows {
 		sqlDigest, planDigest := row.GetString(0), row.GetString(1)
-		allKeys = append(allKeys, replayer.PlanReplayerTaskKey{
-			SQLDigest:  sqlDigest,
-			PlanDigest: planDigest,
+		allTasks = append(allTasks, planReplayerTaskRecord{
+			PlanReplayerTaskKey: replayer.PlanReplayerTaskKey{
+				SQLDigest:  sqlDigest,
+				PlanDigest: planDigest,
+			},
+			captureLimit:  row.GetUint64(2),
+			capturedCount: row.GetUint64(3),
 		})
 	}
-	return allKeys, nil
+	return allTasks, nil
 }
 
 type planReplayerDumpTaskStatus struct {
@@ -451,17 +497,20 @@ func (w *planReplayerTaskDumpWorker) HandleTask(task *PlanReplayerDumpTask) (suc
 		}
 	}()
 	taskKey := task.PlanReplayerTaskKey
-	unhandled, err := checkUnHandledReplayerTask(w.ctx, w.sctx, taskKey)
-	if err != nil {
-		logutil.BgLogger().Warn("check task failed", zap.String("category", "plan-replayer-capture"),
-			zap.String("sqlDigest", taskKey.SQLDigest),
-			zap.String("planDigest", taskKey.PlanDigest),
-			zap.Error(err))
-		return false
-	}
-	// the task is processed, thus we directly skip it.
-	if !unhandled {
-		return true
+	// the limited task dumps every matched execution, so there is no need to check whether it has been processed.
+	if !task.CaptureLimited {
+		unhandled, err := checkUnHandledReplayerTask(w.ctx, w.sctx, taskKey)
+		if err != nil {
+			logutil.BgLogger().Warn("check task failed", zap.String("category", "plan-replayer-capture"),
+				zap.String("sqlDigest", taskKey.SQLDigest),
+				zap.String("planDigest", taskKey.PlanDigest),
+				zap.Error(err))
+			return false
+		}
+		// the task is processed, thus we directly skip it.
+		if !unhandled {
+			return true
+		}
 	}
 
 	file, fileName, err := replayer.GeneratePlanReplayerFile(task.IsCapture, task.IsContinuesCapture, variable.EnableHistoricalStatsForCapture.Load())
@@ -482,9 +531,27 @@ func (w *planReplayerTaskDumpWorker) HandleTask(task *PlanReplayerDumpTask) (suc
 			zap.Error(err))
 		return false
 	}
+	if task.CaptureLimited {
+		increasePlanReplayerCapturedCount(w.ctx, w.sctx, task.CaptureTaskKey)
+	}
 	return true
 }
 
+// increasePlanReplayerCapturedCount increases the captured count of the limited task in mysql.plan_replayer_task
+func increasePlanReplayerCapturedCount(ctx context.Context, sctx sessionctx.Context, taskKey replayer.PlanReplayerTaskKey) {
+	ctx1 := kv.WithInternalSourceType(ctx, kv.InternalTxnStats)
+	exec := sctx.GetRestrictedSQLExecutor()
+	_, _, err := exec.ExecRestrictedSQL(ctx1, nil,
+		"update mysql.plan_replayer_task set captured_count = captured_count + 1 where sql_digest = %? and plan_digest = %?",
+		taskKey.SQLDigest, taskKey.PlanDigest)
+	if err != nil {
+		logutil.BgLogger().Warn("update mysql.plan_replayer_task captured count failed", zap.String("category", "plan-replayer-capture"),
+			zap.String("sqlDigest", taskKey.SQLDigest),
+			zap.String("planDigest", taskKey.PlanDigest),
+			zap.Error(err))
+	}
+}
+
 type planReplayerTaskDumpHandle struct {
 	taskCH  chan *PlanReplayerDumpTask
 	status  *planReplayerDumpTaskStatus
@@ -586,4 +653,8 @@ type PlanReplayerDumpTask struct {
 	IsCapture bool
 	// IsContinuesCapture indicates whether the task is from continues capture
 	IsContinuesCapture bool
+	// CaptureTaskKey is the key of the registered capture task matched by the statement, its plan digest may be '*'.
+	CaptureTaskKey replayer.PlanReplayerTaskKey
+	// CaptureLimited indicates whether the matched capture task is limited by capture times
+	CaptureLimited bool
 }
@@ -125,6 +125,52 @@ func TestPlanReplayerHandleDumpTask(t *testing.T) {
 	require.Len(t, prHandle.GetTasks(), 1)
 }
 
+func TestPlanReplayerHandleLimitedTask(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	prHandle := dom.GetPlanReplayerHandle()
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int)")
+	tk.MustQuery("select * from t;")
+	_, d := tk.Session().GetSessionVars().StmtCtx.SQLDigest()
+	_, pd := tk.Session().GetSessionVars().StmtCtx.GetPlanDigest()
+	sqlDigest := d.String()
+	planDigest := pd.String()
+
+	// register a task which captures the next 2 executions
+	tk.MustExec("delete from mysql.plan_replayer_task")
+	tk.MustExec("delete from mysql.plan_replayer_status")
+	tk.MustExec(fmt.Sprintf("plan replayer capture '%v' '%v' limit 2", sqlDigest, planDigest))
+	require.Len(t, prHandle.GetTasks(), 1)
+	tk.MustExec("SET @@tidb_enable_plan_replayer_capture = ON;")
+	defer os.RemoveAll(replayer.GetPlanReplayerDirName())
+
+	worker := prHandle.GetWorker()
+	for i := 0; i < 2; i++ {
+		tk.MustQuery("select * from t;")
+		task := prHandle.DrainTask()
+		require.NotNil(t, task)
+		require.True(t, task.CaptureLimited)
+		require.True(t, worker.HandleTask(task))
+	}
+	// assert the task is used up in memory and in storage
+	require.Len(t, prHandle.GetTasks(), 0)
+	tk.MustQuery("select captured_count from mysql.plan_replayer_task").Check(testkit.Rows("2"))
+	tk.MustQuery(fmt.Sprintf("select count(*) from mysql.plan_replayer_status where sql_digest = '%v' and plan_digest = '%v'",
+		sqlDigest, planDigest)).Check(testkit.Rows("2"))
+	require.NoError(t, prHandle.CollectPlanReplayerTask())
+	require.Len(t, prHandle.GetTasks(), 0)
+
+	// raise the limit and the task is collected again with the remaining times
+	tk.MustExec("update mysql.plan_replayer_task set capture_limit = 3")
+	require.NoError(t, prHandle.CollectPlanReplayerTask())
+	require.Len(t, prHandle.GetTasks(), 1)
+	tk.MustQuery("select * from t;")
+	task := prHandle.DrainTask()
+	require.True(t, worker.HandleTask(task))
+	require.Len(t, prHandle.GetTasks(), 0)
+}
+
 func TestPlanReplayerGC(t *testing.T) {
 	store, dom := testkit.CreateMockStoreAndDomain(t)
 	tk := testkit.NewTestKit(t, store)
@@ -201,6 +201,9 @@ type RunawayManager struct {
 	serverID           string
 	runawayQueriesChan chan *RunawayRecord
 	quarantineChan     chan *QuarantineRecord
+	// coolingDigestChan is used to send the SQL digests of the queries identified as runaway by the rules, which
+	// the cooling hints are attached to.
+	coolingDigestChan chan string
 	// staleQuarantineRecord is used to clean outdated record. There are three scenarios:
 	// 1. Record is expired in watch list.
 	// 2. The record that will be added is itself out of date.
@@ -228,6 +231,7 @@ func NewRunawayManager(resourceGroupCtl *rmclient.ResourceGroupsController, serv
 		serverID:              serverAddr,
 		runawayQueriesChan:    make(chan *RunawayRecord, maxWatchRecordChannelSize),
 		quarantineChan:        make(chan *QuarantineRecord, maxWatchRecordChannelSize),
+		coolingDigestChan:     make(chan string, maxWatchRecordChannelSize),
 		staleQuarantineRecord: staleQuarantineChan,
 		activeGroup:           make(map[string]int64),
 		metricsMap:            generic.NewSyncMap[string, prometheus.Counter](8),
@@ -421,6 +425,16 @@ func (rm *RunawayManager) markRunaway(resourceGroupName, originalSQL, planDigest
 	}
 }
 
+func (rm *RunawayManager) markCooling(sqlDigest string) {
+	if sqlDigest == "" || !rm.syncerInitialized.Load() {
+		return
+	}
+	select {
+	case rm.coolingDigestChan <- sqlDigest:
+	default:
+	}
+}
+
 // FlushThreshold specifies the threshold for the number of records in trigger flush
 func (*RunawayManager) FlushThreshold() int {
 	return maxWatchRecordChannelSize / 2
@@ -436,6 +450,11 @@ func (rm *RunawayManager) QuarantineRecordChan() <-chan *QuarantineRecord {
 	return rm.quarantineChan
 }
 
+// CoolingDigestChan returns the channel of the SQL digests to attach the cooling hints.
+func (rm *RunawayManager) CoolingDigestChan() <-chan string {
+	return rm.coolingDigestChan
+}
+
 // StaleQuarantineRecordChan returns the channel of staleQuarantineRecord
 func (rm *RunawayManager) StaleQuarantineRecordChan() <-chan *QuarantineRecord {
 	return rm.staleQuarantineRecord
@@ -552,6 +571,7 @@ func (r *RunawayChecker) BeforeCopRequest(req *tikvrpc.Request) error {
 			if !r.markedByWatch {
 				r.markQuarantine(&now)
 			}
+			r.manager.markCooling(r.sqlDigest)
 		}
 	}
 	switch r.setting.Action {
@@ -580,6 +600,7 @@ func (r *RunawayChecker) CheckCopRespError(err error) error {
 				if !r.markedByWatch {
 					r.markQuarantine(&now)
 				}
+				r.manager.markCooling(r.sqlDigest)
 				return exeerrors.ErrResourceGroupQueryRunawayInterrupted
 			}
 		}
@@ -623,5 +644,11 @@ func (r *RunawayChecker) getSettingConvictIdentifier() string {
 }
 
 func (r *RunawayChecker) getConvictIdentifiers() []string {
-	return []string{r.originalSQL, r.sqlDigest, r.planDigest}
+	return []string{r.originalSQL, r.sqlDigest, r.planDigest, StatementPlanWatchText(r.sqlDigest, r.planDigest)}
+}
+
+// StatementPlanWatchText returns the watch text which only matches the given plan of the given statement,
+// so that the other plans of the same statement are not affected.
+func StatementPlanWatchText(sqlDigest, planDigest string) string {
+	return sqlDigest + "/" + planDigest
 }
@@ -32,6 +32,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/ttl/cache"
 	"github.com/pingcap/tidb/pkg/ttl/sqlbuilder"
 	"github.com/pingcap/tidb/pkg/types"
@@ -255,6 +256,7 @@ func (do *Domain) runawayRecordFlushLoop() {
 	recordCh := do.RunawayManager().RunawayRecordChan()
 	quarantineRecordCh := do.RunawayManager().QuarantineRecordChan()
 	staleQuarantineRecordCh := do.RunawayManager().StaleQuarantineRecordChan()
+	coolingDigestCh := do.RunawayManager().CoolingDigestChan()
 	flushThrehold := do.runawayManager.FlushThreshold()
 	records := make([]*resourcegroup.RunawayRecord, 0, flushThrehold)
 
@@ -308,10 +310,31 @@ func (do *Domain) runawayRecordFlushLoop() {
 					time.Sleep(time.Second)
 				}
 			}()
+		case digest := <-coolingDigestCh:
+			go do.attachRunawayCoolingHints(digest)
 		}
 	}
 }
 
+// attachRunawayCoolingHints attaches the hints of tidb_runaway_cooling_hints to the SQL digest of a runaway query
+// for tidb_runaway_cooling_duration, so that the following executions of the statement are cooled down.
+func (do *Domain) attachRunawayCoolingHints(sqlDigest string) {
+	hints := variable.RunawayCoolingHints.Load()
+	bindHandle := do.BindHandle()
+	if hints == "" || bindHandle == nil {
+		return
+	}
+	// Don't override the hints set manually or by the previous runaway queries.
+	if _, matched := bindHandle.MatchGlobalDigestHints(sqlDigest, ""); matched {
+		return
+	}
+	if err := bindHandle.SetGlobalDigestHints(sqlDigest, "", hints, variable.RunawayCoolingDuration.Load()); err != nil {
+		logutil.BgLogger().Warn("attach cooling hints to runaway query failed", zap.String("sql-digest", sqlDigest), zap.Error(err))
+		return
+	}
+	logutil.BgLogger().Info("attach cooling hints to runaway query", zap.String("sql-digest", sqlDigest), zap.String("hints", hints))
+}
+
 // AddRunawayWatch is used to add runaway watch item manually.
 func (do *Domain) AddRunawayWatch(record *resourcegroup.QuarantineRecord) (uint64, error) {
 	se, err := do.sysSessionPool.Get()
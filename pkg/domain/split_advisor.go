@@ -0,0 +1,447 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/store/helper"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/sqlescape"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
+	"github.com/tikv/client-go/v2/tikv"
+	"go.uber.org/zap"
+)
+
+const (
+	splitAdvisorInterval = 10 * time.Minute
+	// splitMinRegions and splitMaxRegions are the bounds of the number of regions a hot region is split into.
+	splitMinRegions = 2
+	splitMaxRegions = 16
+	// splitMinRegionStep is the min step of the int handles between the split keys, it's the same as the one checked
+	// by SPLIT TABLE.
+	splitMinRegionStep = 1000
+	// splitMaxSQLDigests is the max number of the write statements linked to an advice.
+	splitMaxSQLDigests = 3
+	// maxSplitAdvices is the max number of the recent advices kept in memory.
+	maxSplitAdvices = 100
+)
+
+// The status of the advices of the hot region split advisor.
+const (
+	// SplitAdviceAdvised means the advice is not applied since tidb_auto_split_hot_regions is ADVISE.
+	SplitAdviceAdvised = "advised"
+	// SplitAdviceApplied means the split statement of the advice has been executed.
+	SplitAdviceApplied = "applied"
+	// SplitAdviceFailed means the split statement of the advice fails to be executed.
+	SplitAdviceFailed = "failed"
+	// SplitAdviceNotApplicable means the hot region can't be pre-split, the reason is in the note of the advice.
+	SplitAdviceNotApplicable = "not applicable"
+)
+
+// SplitAdvice is an advice to split a write hotspot made by the hot region split advisor.
+type SplitAdvice struct {
+	Time      time.Time
+	Schema    string
+	Table     string
+	Partition string
+	Index     string
+	RegionID  uint64
+	FlowBytes uint64
+	// Regions is the number of regions the hot region is suggested to be split into.
+	Regions int
+	// SplitSQL is the statement to split and scatter the hot region, it's empty if the region can't be pre-split.
+	SplitSQL string
+	Note     string
+	// SQLDigests are the digests of the most frequent write statements on the table.
+	SQLDigests []string
+	Status     string
+}
+
+// hotWriteRegion is a hot write region with its key range.
+type hotWriteRegion struct {
+	helper.HotTableIndex
+	StartKey []byte
+	EndKey   []byte
+}
+
+// SplitAdvisor identifies the write hotspots by the hot regions of PD, and suggests or executes `SPLIT TABLE` to
+// split and scatter them. The advices are linked to the most frequent write statements on the tables in the
+// statements summary, which are likely to cause the hotspots.
+type SplitAdvisor struct {
+	store    kv.Storage
+	sessPool *sessionPool
+
+	mu      sync.Mutex
+	advices []SplitAdvice
+}
+
+func newSplitAdvisor(store kv.Storage, sessPool *sessionPool) *SplitAdvisor {
+	return &SplitAdvisor{store: store, sessPool: sessPool}
+}
+
+// Advices returns the recent advices made by the advisor.
+func (a *SplitAdvisor) Advices() []SplitAdvice {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]SplitAdvice(nil), a.advices...)
+}
+
+func (a *SplitAdvisor) addAdvices(advices []SplitAdvice) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.advices = append(a.advices, advices...)
+	if len(a.advices) > maxSplitAdvices {
+		a.advices = a.advices[len(a.advices)-maxSplitAdvices:]
+	}
+}
+
+// Run makes the advices by the current hot write regions, and executes them if tidb_auto_split_hot_regions is ON.
+func (a *SplitAdvisor) Run(ctx context.Context, is infoschema.InfoSchema) error {
+	mode := variable.AutoSplitHotRegionsMode.Load()
+	if mode == variable.Off {
+		return nil
+	}
+	regions, err := a.fetchHotWriteRegions(ctx, is)
+	if err != nil {
+		return err
+	}
+	if len(regions) == 0 {
+		return nil
+	}
+	digests, err := a.loadWriteDigests()
+	if err != nil {
+		return err
+	}
+	res, err := a.sessPool.Get()
+	if err != nil {
+		return errors.Annotate(err, "get session failed")
+	}
+	defer a.sessPool.Put(res)
+	se := res.(sessionctx.Context)
+	// the temporal split values are formatted in the time zone of the session executing SPLIT TABLE.
+	advices := adviseSplitRegions(is, regions, digests, se.GetSessionVars().Location())
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	for i := range advices {
+		advice := &advices[i]
+		switch {
+		case advice.SplitSQL == "":
+			advice.Status = SplitAdviceNotApplicable
+		case mode == variable.On:
+			advice.Status = SplitAdviceApplied
+			if _, _, err := se.GetRestrictedSQLExecutor().ExecRestrictedSQL(ctx,
+				[]sqlexec.OptionFuncAlias{sqlexec.ExecOptionUseCurSession}, advice.SplitSQL); err != nil {
+				advice.Status = SplitAdviceFailed
+				logutil.BgLogger().Warn("failed to apply the split advice", zap.String("sql", advice.SplitSQL), zap.Error(err))
+				continue
+			}
+		default:
+			advice.Status = SplitAdviceAdvised
+		}
+		logutil.BgLogger().Info("hot region split advice", zap.String("schema", advice.Schema), zap.String("table", advice.Table),
+			zap.String("index", advice.Index), zap.Uint64("region", advice.RegionID), zap.Uint64("flow", advice.FlowBytes),
+			zap.String("sql", advice.SplitSQL), zap.String("note", advice.Note), zap.String("status", advice.Status),
+			zap.Strings("digests", advice.SQLDigests))
+	}
+	a.addAdvices(advices)
+	return nil
+}
+
+// fetchHotWriteRegions fetches the hot write regions of the user tables from PD.
+func (a *SplitAdvisor) fetchHotWriteRegions(ctx context.Context, is infoschema.InfoSchema) ([]hotWriteRegion, error) {
+	tikvStore, ok := a.store.(helper.Storage)
+	if !ok {
+		return nil, nil
+	}
+	tikvHelper := &helper.Helper{
+		Store:       tikvStore,
+		RegionCache: tikvStore.GetRegionCache(),
+	}
+	hotTables, err := tikvHelper.ScrapeHotInfo(ctx, helper.HotWrite, tikvHelper.FilterMemDBs(is.AllSchemas()))
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	regions := make([]hotWriteRegion, 0, len(hotTables))
+	for _, hot := range hotTables {
+		if hot.TableName == "" || util.IsMemOrSysDB(strings.ToLower(hot.DbName)) {
+			continue
+		}
+		loc, err := tikvHelper.RegionCache.LocateRegionByID(tikv.NewBackofferWithVars(ctx, 20000, nil), hot.RegionID)
+		if err != nil {
+			logutil.BgLogger().Warn("failed to locate the hot region", zap.Uint64("region", hot.RegionID), zap.Error(err))
+			continue
+		}
+		regions = append(regions, hotWriteRegion{HotTableIndex: hot, StartKey: loc.StartKey, EndKey: loc.EndKey})
+	}
+	return regions, nil
+}
+
+// loadWriteDigests loads the digests of the most frequent write statements of each table from the statements summary
+// of the cluster, the keys of the result are in the form of "schema.table" in lower case.
+func (a *SplitAdvisor) loadWriteDigests() (map[string][]string, error) {
+	rows, err := execRestrictedSQL(a.sessPool, "SELECT TABLE_NAMES, DIGEST, CAST(SUM(EXEC_COUNT) AS SIGNED) AS exec_count "+
+		"FROM information_schema.cluster_statements_summary WHERE STMT_TYPE IN ('Insert', 'Replace', 'Update', 'Delete') "+
+		"AND TABLE_NAMES != '' GROUP BY TABLE_NAMES, DIGEST ORDER BY exec_count DESC", nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	digests := make(map[string][]string)
+	for _, row := range rows {
+		digest := row.GetString(1)
+		for _, name := range strings.Split(strings.ToLower(row.GetString(0)), ",") {
+			if name != "" && len(digests[name]) < splitMaxSQLDigests {
+				digests[name] = append(digests[name], digest)
+			}
+		}
+	}
+	return digests, nil
+}
+
+// adviseSplitRegions makes the advices for the hot write regions, the hotter regions are split into more regions.
+// The region is split between its start key and end key, so the hot regions at the tail of the tables or indexes,
+// which are usually caused by the increasing keys, can't be pre-split and only notes are given. The temporal values
+// in the keys are formatted in loc.
+func adviseSplitRegions(is infoschema.InfoSchema, regions []hotWriteRegion, digests map[string][]string, loc *time.Location) []SplitAdvice {
+	if len(regions) == 0 {
+		return nil
+	}
+	var totalFlow uint64
+	for _, region := range regions {
+		totalFlow += region.RegionMetric.FlowBytes
+	}
+	avgFlow := max(totalFlow/uint64(len(regions)), 1)
+	sort.SliceStable(regions, func(i, j int) bool {
+		return regions[i].RegionMetric.FlowBytes > regions[j].RegionMetric.FlowBytes
+	})
+
+	now := time.Now()
+	var advices []SplitAdvice
+	for _, region := range regions {
+		tbl, err := is.TableByName(model.NewCIStr(region.DbName), model.NewCIStr(region.TableName))
+		if err != nil {
+			continue
+		}
+		tblInfo := tbl.Meta()
+		var idxInfo *model.IndexInfo
+		if region.IndexName != "" {
+			idxInfo = tblInfo.FindIndexByName(strings.ToLower(region.IndexName))
+			if idxInfo == nil {
+				continue
+			}
+		}
+		advice := SplitAdvice{
+			Time:       now,
+			Schema:     region.DbName,
+			Table:      tblInfo.Name.O,
+			RegionID:   region.RegionID,
+			FlowBytes:  region.RegionMetric.FlowBytes,
+			SQLDigests: digests[strings.ToLower(region.DbName+"."+tblInfo.Name.O)],
+		}
+		if idxInfo != nil {
+			advice.Index = idxInfo.Name.O
+		}
+		if pi := tblInfo.GetPartitionInfo(); pi != nil && (idxInfo == nil || !idxInfo.Global) {
+			for _, def := range pi.Definitions {
+				if def.ID == region.TableID {
+					advice.Partition = def.Name.O
+				}
+			}
+		}
+
+		lower, lowerOK := decodeSplitBound(tblInfo, idxInfo, region.TableID, region.StartKey, loc)
+		upper, upperOK := decodeSplitBound(tblInfo, idxInfo, region.TableID, region.EndKey, loc)
+		switch {
+		case !splitValuesDecodable(tblInfo, idxInfo):
+			advice.Note = "the keys hold the sort keys of the new collations or the prefixes of the columns, which can't be used by SPLIT TABLE"
+		case !upperOK && idxInfo == nil:
+			advice.Note = "the hot region is at the tail of the table, consider AUTO_RANDOM or SHARD_ROW_ID_BITS to scatter the increasing handles"
+		case !upperOK:
+			advice.Note = "the hot region is at the tail of the index, the increasing index values can't be scattered by splitting regions"
+		case !lowerOK:
+			advice.Note = "the hot region is at the head of the table or index, it can't be split by SPLIT TABLE"
+		}
+		if advice.Note != "" {
+			advices = append(advices, advice)
+			continue
+		}
+
+		regionNum := int((region.RegionMetric.FlowBytes + avgFlow - 1) / avgFlow)
+		regionNum = min(max(regionNum, splitMinRegions), splitMaxRegions)
+		if width, ok := handleRangeWidth(lower, upper); ok {
+			maxRegionNum := int(min(width/splitMinRegionStep, splitMaxRegions))
+			if maxRegionNum < splitMinRegions {
+				advice.Note = "the hot region is too small to be split by SPLIT TABLE"
+				advices = append(advices, advice)
+				continue
+			}
+			regionNum = min(regionNum, maxRegionNum)
+		}
+		advice.Regions = regionNum
+		advice.SplitSQL = buildSplitSQL(&advice, lower, upper)
+		advices = append(advices, advice)
+	}
+	return advices
+}
+
+// handleRangeWidth returns the number of the int handles between the bounds, it returns false if the bounds aren't
+// int handles.
+func handleRangeWidth(lower, upper []any) (uint64, bool) {
+	switch lo := lower[0].(type) {
+	case int64:
+		return uint64(upper[0].(int64)) - uint64(lo), true
+	case uint64:
+		return upper[0].(uint64) - lo, true
+	}
+	return 0, false
+}
+
+// buildSplitSQL builds the statement to split the region between the lower and upper bounds.
+func buildSplitSQL(advice *SplitAdvice, lower, upper []any) string {
+	var sb strings.Builder
+	sqlescape.MustFormatSQL(&sb, "SPLIT TABLE %n.%n", advice.Schema, advice.Table)
+	if advice.Partition != "" {
+		sqlescape.MustFormatSQL(&sb, " PARTITION (%n)", advice.Partition)
+	}
+	if advice.Index != "" {
+		sqlescape.MustFormatSQL(&sb, " INDEX %n", advice.Index)
+	}
+	sb.WriteString(" BETWEEN (")
+	for i, v := range lower {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sqlescape.MustFormatSQL(&sb, "%?", v)
+	}
+	sb.WriteString(") AND (")
+	for i, v := range upper {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sqlescape.MustFormatSQL(&sb, "%?", v)
+	}
+	sqlescape.MustFormatSQL(&sb, ") REGIONS %?", advice.Regions)
+	return sb.String()
+}
+
+// decodeSplitBound decodes the values of the handle or the index columns from the region boundary key, which can be
+// used as the bound of SPLIT TABLE. It returns false if the key isn't in the table or index, for example, the start
+// key of the first region of a table.
+func decodeSplitBound(tblInfo *model.TableInfo, idxInfo *model.IndexInfo, physicalID int64, key []byte, loc *time.Location) ([]any, bool) {
+	if idxInfo == nil {
+		if !tablecodec.IsRecordKey(key) {
+			return nil, false
+		}
+		tableID, handle, err := tablecodec.DecodeRecordKey(key)
+		if err != nil || tableID != physicalID {
+			return nil, false
+		}
+		if handle.IsInt() {
+			if tblInfo.IsCommonHandle {
+				return nil, false
+			}
+			if pkCol := tblInfo.GetPkColInfo(); pkCol != nil && mysql.HasUnsignedFlag(pkCol.GetFlag()) {
+				return []any{uint64(handle.IntValue())}, true
+			}
+			return []any{handle.IntValue()}, true
+		}
+		pk := tblInfo.GetPrimaryKey()
+		if !tblInfo.IsCommonHandle || pk == nil {
+			return nil, false
+		}
+		return decodeSplitValues(tblInfo, pk, handle.Encoded(), loc)
+	}
+
+	if !tablecodec.IsIndexKey(key) {
+		return nil, false
+	}
+	tableID, indexID, _, err := tablecodec.DecodeKeyHead(key)
+	if err != nil || indexID != idxInfo.ID || (tableID != physicalID && tableID != tblInfo.ID) {
+		return nil, false
+	}
+	return decodeSplitValues(tblInfo, idxInfo, key[len(tablecodec.EncodeTableIndexPrefix(tableID, indexID)):], loc)
+}
+
+// decodeSplitValues decodes the values of the index columns, the values of the handle appended to the non-unique
+// index keys are ignored.
+func decodeSplitValues(tblInfo *model.TableInfo, idxInfo *model.IndexInfo, b []byte, loc *time.Location) ([]any, bool) {
+	if len(b) == 0 {
+		return nil, false
+	}
+	datums, err := codec.Decode(b, len(idxInfo.Columns))
+	if err != nil {
+		return nil, false
+	}
+	if len(datums) > len(idxInfo.Columns) {
+		datums = datums[:len(idxInfo.Columns)]
+	}
+	values := make([]any, 0, len(datums))
+	for i, d := range datums {
+		if d.IsNull() {
+			return nil, false
+		}
+		col := tblInfo.Columns[idxInfo.Columns[i].Offset]
+		d, err = tablecodec.Unflatten(d, &col.FieldType, loc)
+		if err != nil {
+			return nil, false
+		}
+		s, err := d.ToString()
+		if err != nil {
+			return nil, false
+		}
+		values = append(values, s)
+	}
+	return values, true
+}
+
+// splitValuesDecodable returns whether the values of the index or the clustered primary key can be decoded from the
+// keys. The keys of the string columns with the new collations hold the sort keys, and the keys of the prefix columns
+// hold the prefixes, so they can't be decoded.
+func splitValuesDecodable(tblInfo *model.TableInfo, idxInfo *model.IndexInfo) bool {
+	if idxInfo == nil {
+		if !tblInfo.IsCommonHandle {
+			return true
+		}
+		idxInfo = tblInfo.GetPrimaryKey()
+		if idxInfo == nil {
+			return true
+		}
+	}
+	for _, idxCol := range idxInfo.Columns {
+		if idxCol.Length != types.UnspecifiedLength {
+			return false
+		}
+		col := tblInfo.Columns[idxCol.Offset]
+		if types.IsString(col.GetType()) && collate.NewCollationEnabled() && !collate.IsBinCollation(col.GetCollate()) {
+			return false
+		}
+	}
+	return true
+}
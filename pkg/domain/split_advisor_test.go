@@ -0,0 +1,134 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/store/helper"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/codec"
+	"github.com/pingcap/tidb/pkg/util/collate"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdviseSplitRegions(t *testing.T) {
+	idCol := &model.ColumnInfo{ID: 1, Name: model.NewCIStr("id"), Offset: 0, State: model.StatePublic, FieldType: *types.NewFieldType(mysql.TypeLonglong)}
+	cCol := &model.ColumnInfo{ID: 2, Name: model.NewCIStr("c"), Offset: 1, State: model.StatePublic, FieldType: *types.NewFieldType(mysql.TypeVarchar)}
+	cCol.SetCollate(mysql.DefaultCollationName)
+	ciCol := &model.ColumnInfo{ID: 3, Name: model.NewCIStr("ci"), Offset: 2, State: model.StatePublic, FieldType: *types.NewFieldType(mysql.TypeVarchar)}
+	ciCol.SetCollate("utf8mb4_general_ci")
+	tsCol := &model.ColumnInfo{ID: 4, Name: model.NewCIStr("ts"), Offset: 3, State: model.StatePublic, FieldType: *types.NewFieldType(mysql.TypeTimestamp)}
+	idx := &model.IndexInfo{ID: 1, Name: model.NewCIStr("idx"), Columns: []*model.IndexColumn{{Name: cCol.Name, Offset: 1, Length: types.UnspecifiedLength}}, State: model.StatePublic}
+	ciIdx := &model.IndexInfo{ID: 2, Name: model.NewCIStr("ci_idx"), Columns: []*model.IndexColumn{{Name: ciCol.Name, Offset: 2, Length: types.UnspecifiedLength}}, State: model.StatePublic}
+	prefixIdx := &model.IndexInfo{ID: 3, Name: model.NewCIStr("prefix_idx"), Columns: []*model.IndexColumn{{Name: cCol.Name, Offset: 1, Length: 2}}, State: model.StatePublic}
+	tsIdx := &model.IndexInfo{ID: 4, Name: model.NewCIStr("ts_idx"), Columns: []*model.IndexColumn{{Name: tsCol.Name, Offset: 3, Length: types.UnspecifiedLength}}, State: model.StatePublic}
+	tblInfo := &model.TableInfo{ID: 1, Name: model.NewCIStr("t"), Columns: []*model.ColumnInfo{idCol, cCol, ciCol, tsCol},
+		Indices: []*model.IndexInfo{idx, ciIdx, prefixIdx, tsIdx}, State: model.StatePublic}
+	is := infoschema.MockInfoSchema([]*model.TableInfo{tblInfo})
+
+	rowKey := func(handle int64) []byte {
+		return tablecodec.EncodeRowKeyWithHandle(tblInfo.ID, kv.IntHandle(handle))
+	}
+	idxKey := func(val string) []byte {
+		encoded, err := codec.EncodeKey(time.UTC, nil, types.NewStringDatum(val), types.NewIntDatum(1))
+		require.NoError(t, err)
+		return tablecodec.EncodeIndexSeekKey(tblInfo.ID, idx.ID, encoded)
+	}
+	newRegion := func(id, flow uint64, index string, start, end []byte) hotWriteRegion {
+		return hotWriteRegion{
+			HotTableIndex: helper.HotTableIndex{
+				RegionID:     id,
+				RegionMetric: &helper.RegionMetric{FlowBytes: flow},
+				DbName:       "test",
+				TableName:    "t",
+				TableID:      tblInfo.ID,
+				IndexName:    index,
+				IndexID:      idx.ID,
+			},
+			StartKey: start,
+			EndKey:   end,
+		}
+	}
+	notExist := newRegion(6, 100, "", rowKey(0), rowKey(100000))
+	notExist.TableName = "not_exist"
+	regions := []hotWriteRegion{
+		newRegion(1, 100, "", rowKey(100), rowKey(1100)),
+		newRegion(2, 1000, "", rowKey(0), rowKey(100000)),
+		newRegion(3, 500, "", rowKey(100000), tablecodec.EncodeTablePrefix(tblInfo.ID+1)),
+		newRegion(4, 200, "idx", idxKey("a"), idxKey("m")),
+		newRegion(5, 100, "idx", idxKey("m"), rowKey(0)),
+		notExist,
+	}
+	digests := map[string][]string{"test.t": {"digest1", "digest2"}}
+
+	advices := adviseSplitRegions(is, regions, digests, time.UTC)
+	require.Len(t, advices, 5)
+	// the hottest region is split into more regions.
+	require.Equal(t, uint64(2), advices[0].RegionID)
+	require.Equal(t, 4, advices[0].Regions)
+	require.Equal(t, "SPLIT TABLE `test`.`t` BETWEEN (0) AND (100000) REGIONS 4", advices[0].SplitSQL)
+	require.Equal(t, []string{"digest1", "digest2"}, advices[0].SQLDigests)
+	// the tail of the table can't be pre-split.
+	require.Equal(t, uint64(3), advices[1].RegionID)
+	require.Empty(t, advices[1].SplitSQL)
+	require.Contains(t, advices[1].Note, "AUTO_RANDOM")
+	require.Equal(t, uint64(4), advices[2].RegionID)
+	require.Equal(t, "idx", advices[2].Index)
+	require.Equal(t, "SPLIT TABLE `test`.`t` INDEX `idx` BETWEEN ('a') AND ('m') REGIONS 2", advices[2].SplitSQL)
+	// the region is too small to be split.
+	require.Equal(t, uint64(1), advices[3].RegionID)
+	require.Empty(t, advices[3].SplitSQL)
+	require.Contains(t, advices[3].Note, "too small")
+	require.Equal(t, uint64(5), advices[4].RegionID)
+	require.Contains(t, advices[4].Note, "tail of the index")
+
+	// the sort keys of the new collations and the prefixes of the columns can't be used as the split values.
+	indexKey := func(idxInfo *model.IndexInfo, val types.Datum) []byte {
+		encoded, err := codec.EncodeKey(time.UTC, nil, val, types.NewIntDatum(1))
+		require.NoError(t, err)
+		return tablecodec.EncodeIndexSeekKey(tblInfo.ID, idxInfo.ID, encoded)
+	}
+	collate.SetNewCollationEnabledForTest(true)
+	defer collate.SetNewCollationEnabledForTest(false)
+	for _, idxInfo := range []*model.IndexInfo{ciIdx, prefixIdx} {
+		region := newRegion(7, 100, idxInfo.Name.O, indexKey(idxInfo, types.NewStringDatum("a")), indexKey(idxInfo, types.NewStringDatum("m")))
+		region.IndexID = idxInfo.ID
+		advices := adviseSplitRegions(is, []hotWriteRegion{region}, digests, time.UTC)
+		require.Len(t, advices, 1)
+		require.Empty(t, advices[0].SplitSQL)
+		require.Contains(t, advices[0].Note, "sort keys of the new collations")
+	}
+	// the timestamps are stored in UTC, and formatted in the given time zone.
+	lowerTS := types.NewTime(types.FromDate(2024, 1, 1, 0, 0, 0, 0), mysql.TypeTimestamp, 0)
+	upperTS := types.NewTime(types.FromDate(2024, 1, 2, 0, 0, 0, 0), mysql.TypeTimestamp, 0)
+	region := newRegion(8, 100, "ts_idx", indexKey(tsIdx, types.NewTimeDatum(lowerTS)), indexKey(tsIdx, types.NewTimeDatum(upperTS)))
+	region.IndexID = tsIdx.ID
+	advices = adviseSplitRegions(is, []hotWriteRegion{region}, digests, time.FixedZone("UTC+8", 8*3600))
+	require.Len(t, advices, 1)
+	require.Equal(t, "SPLIT TABLE `test`.`t` INDEX `ts_idx` BETWEEN ('2024-01-01 08:00:00') AND ('2024-01-02 08:00:00') REGIONS 2", advices[0].SplitSQL)
+
+	advisor := newSplitAdvisor(nil, nil)
+	for i := 0; i < maxSplitAdvices; i++ {
+		advisor.addAdvices(advices)
+	}
+	require.Len(t, advisor.Advices(), maxSplitAdvices)
+}
@@ -0,0 +1,287 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/metrics"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/intest"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/stringutil"
+	"go.uber.org/zap"
+)
+
+const (
+	// sqlFirewallReloadInterval is the interval to reload the rules of the SQL firewall, so the rules changed by any
+	// instance take effect in the whole cluster.
+	sqlFirewallReloadInterval = 3 * time.Second
+	// maxSQLFirewallLearnedDigests is the max number of the digests learned but not persisted yet.
+	maxSQLFirewallLearnedDigests = 10000
+	// sqlFirewallFlushBatchSize is the max number of the learned rules written by a statement.
+	sqlFirewallFlushBatchSize = 256
+)
+
+// The actions of the SQL firewall rules.
+const (
+	// SQLFirewallActionAllow means the matched statements are allowed in the ALLOW mode.
+	SQLFirewallActionAllow = "ALLOW"
+	// SQLFirewallActionDeny means the matched statements are blocked.
+	SQLFirewallActionDeny = "DENY"
+	// SQLFirewallActionRewrite means the matched statements are replaced by the replacement of the rule.
+	SQLFirewallActionRewrite = "REWRITE"
+)
+
+// SQLFirewallRule is a rule of the SQL firewall in mysql.tidb_sql_firewall_rules.
+type SQLFirewallRule struct {
+	ID          int64
+	Digest      string
+	Pattern     string
+	Action      string
+	Replacement string
+
+	patWeights []rune
+	patTypes   []byte
+}
+
+// sqlFirewallRules is a snapshot of the rules of the SQL firewall.
+type sqlFirewallRules struct {
+	byDigest map[string]*SQLFirewallRule
+	// patterns are the rules matching by the patterns, ordered by the IDs.
+	patterns []*SQLFirewallRule
+}
+
+func newSQLFirewallRules(rules []*SQLFirewallRule) *sqlFirewallRules {
+	r := &sqlFirewallRules{byDigest: make(map[string]*SQLFirewallRule)}
+	for _, rule := range rules {
+		if rule.Digest != "" {
+			r.byDigest[rule.Digest] = rule
+			continue
+		}
+		if rule.Pattern != "" {
+			rule.patWeights, rule.patTypes = stringutil.CompilePattern(strings.ToLower(rule.Pattern), '\\')
+			r.patterns = append(r.patterns, rule)
+		}
+	}
+	return r
+}
+
+// match returns the rule matching the statement. A DENY rule is always preferred, and then the rule of the digest
+// since it's more specific than the patterns.
+func (r *sqlFirewallRules) match(normalizedSQL, digest string) *SQLFirewallRule {
+	digestRule := r.byDigest[digest]
+	if digestRule != nil && digestRule.Action == SQLFirewallActionDeny {
+		return digestRule
+	}
+	var matched *SQLFirewallRule
+	for _, rule := range r.patterns {
+		if !stringutil.DoMatch(normalizedSQL, rule.patWeights, rule.patTypes) {
+			continue
+		}
+		if rule.Action == SQLFirewallActionDeny {
+			return rule
+		}
+		if matched == nil {
+			matched = rule
+		}
+	}
+	if digestRule != nil {
+		return digestRule
+	}
+	return matched
+}
+
+// SQLFirewallDecision is the decision of the SQL firewall on a statement.
+type SQLFirewallDecision struct {
+	// Blocked means the statement is rejected.
+	Blocked bool
+	// Replacement is the statement executed instead, it's empty if the statement isn't rewritten.
+	Replacement string
+	// RuleID is the ID of the matched rule, it's 0 if no rule matches the statement.
+	RuleID int64
+}
+
+// decideSQLFirewall decides what to do with a statement matching the rule in the mode. The rule is nil if no rule
+// matches the statement. It also returns whether the statement is learned in the LEARNING mode.
+func decideSQLFirewall(mode string, rule *SQLFirewallRule) (decision SQLFirewallDecision, learn bool) {
+	if rule != nil {
+		decision.RuleID = rule.ID
+	}
+	switch mode {
+	case variable.SQLFirewallLearning:
+		return decision, rule == nil
+	case variable.SQLFirewallDeny, variable.SQLFirewallAllow:
+		switch {
+		case rule == nil:
+			decision.Blocked = mode == variable.SQLFirewallAllow
+		case rule.Action == SQLFirewallActionDeny:
+			decision.Blocked = true
+		case rule.Action == SQLFirewallActionRewrite:
+			decision.Replacement = rule.Replacement
+		}
+	}
+	return decision, false
+}
+
+// SQLFirewall blocks or rewrites the statements by the rules in mysql.tidb_sql_firewall_rules. In the LEARNING mode,
+// it blocks nothing but records the digests not matching any rule as ALLOW rules, which are reviewed before switching
+// to the ALLOW mode, and logs the statements which would be blocked or rewritten.
+type SQLFirewall struct {
+	sessPool *sessionPool
+	rules    atomic.Pointer[sqlFirewallRules]
+
+	mu sync.Mutex
+	// learned is the normalized SQL of the digests learned but not persisted yet.
+	learned map[string]string
+	// audited is the digests logged in the LEARNING mode since the last reload, so each of them is logged once.
+	audited map[string]struct{}
+}
+
+func newSQLFirewall(sessPool *sessionPool) *SQLFirewall {
+	f := &SQLFirewall{
+		sessPool: sessPool,
+		learned:  make(map[string]string),
+		audited:  make(map[string]struct{}),
+	}
+	f.rules.Store(newSQLFirewallRules(nil))
+	return f
+}
+
+// Check decides what to do with the statement by the rules and the mode of the firewall.
+func (f *SQLFirewall) Check(normalizedSQL, digest string) SQLFirewallDecision {
+	mode := variable.SQLFirewallMode.Load()
+	if mode == variable.Off {
+		return SQLFirewallDecision{}
+	}
+	rule := f.rules.Load().match(normalizedSQL, digest)
+	decision, learn := decideSQLFirewall(mode, rule)
+	if mode != variable.SQLFirewallLearning {
+		return decision
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if learn {
+		if len(f.learned) < maxSQLFirewallLearnedDigests {
+			f.learned[digest] = normalizedSQL
+		}
+	} else if rule.Action != SQLFirewallActionAllow {
+		if _, ok := f.audited[digest]; !ok {
+			f.audited[digest] = struct{}{}
+			logutil.BgLogger().Info("the statement matches a SQL firewall rule in the learning mode",
+				zap.String("sqlDigest", digest), zap.Int64("ruleID", rule.ID), zap.String("action", rule.Action))
+		}
+	}
+	return decision
+}
+
+// Reload loads the rules from mysql.tidb_sql_firewall_rules.
+func (f *SQLFirewall) Reload() error {
+	rows, err := execRestrictedSQL(f.sessPool, "SELECT id, sql_digest, sql_pattern, action, replacement FROM mysql.tidb_sql_firewall_rules ORDER BY id", nil)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	rules := make([]*SQLFirewallRule, 0, len(rows))
+	for _, row := range rows {
+		rules = append(rules, &SQLFirewallRule{
+			ID:          row.GetInt64(0),
+			Digest:      row.GetString(1),
+			Pattern:     row.GetString(2),
+			Action:      row.GetEnum(3).String(),
+			Replacement: row.GetString(4),
+		})
+	}
+	f.rules.Store(newSQLFirewallRules(rules))
+	f.mu.Lock()
+	f.audited = make(map[string]struct{})
+	f.mu.Unlock()
+	return nil
+}
+
+// FlushLearned persists the learned digests as ALLOW rules. The digests which fail to be written are learned again.
+func (f *SQLFirewall) FlushLearned() error {
+	f.mu.Lock()
+	learned := f.learned
+	f.learned = make(map[string]string)
+	f.mu.Unlock()
+	digests := make([]string, 0, len(learned))
+	for digest := range learned {
+		digests = append(digests, digest)
+	}
+	for len(digests) > 0 {
+		batch := digests[:min(len(digests), sqlFirewallFlushBatchSize)]
+		var sb strings.Builder
+		sb.WriteString("INSERT IGNORE INTO mysql.tidb_sql_firewall_rules (sql_digest, action, source, normalized_sql) VALUES ")
+		params := make([]any, 0, len(batch)*2)
+		for i, digest := range batch {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("(%?, 'ALLOW', 'LEARNED', %?)")
+			params = append(params, digest, learned[digest])
+		}
+		if _, err := execRestrictedSQL(f.sessPool, sb.String(), params); err != nil {
+			f.mu.Lock()
+			for _, digest := range digests {
+				if len(f.learned) < maxSQLFirewallLearnedDigests {
+					f.learned[digest] = learned[digest]
+				}
+			}
+			f.mu.Unlock()
+			return errors.Trace(err)
+		}
+		digests = digests[len(batch):]
+	}
+	return nil
+}
+
+func (do *Domain) sqlFirewallLoop() {
+	// do not start reload loop in unit test, the rules are reloaded by the tests explicitly.
+	if intest.InTest {
+		return
+	}
+	defer util.Recover(metrics.LabelDomain, "sqlFirewallLoop", nil, false)
+	ticker := time.NewTicker(sqlFirewallReloadInterval)
+	defer func() {
+		ticker.Stop()
+		logutil.BgLogger().Info("sqlFirewallLoop exited.")
+	}()
+	for {
+		select {
+		case <-do.exit:
+			return
+		case <-ticker.C:
+			if variable.SQLFirewallMode.Load() == variable.Off {
+				continue
+			}
+			if err := do.sqlFirewall.FlushLearned(); err != nil {
+				logutil.BgLogger().Warn("flush the digests learned by the SQL firewall failed", zap.Error(err))
+			}
+			if err := do.sqlFirewall.Reload(); err != nil {
+				logutil.BgLogger().Warn("reload the SQL firewall rules failed", zap.Error(err))
+			}
+		}
+	}
+}
+
+// SQLFirewall returns the SQL firewall.
+func (do *Domain) SQLFirewall() *SQLFirewall {
+	return do.sqlFirewall
+}
@@ -0,0 +1,64 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSQLFirewallRules(t *testing.T) {
+	rules := newSQLFirewallRules([]*SQLFirewallRule{
+		{ID: 1, Digest: "d1", Action: SQLFirewallActionAllow},
+		{ID: 2, Pattern: "select * from `t` where %", Action: SQLFirewallActionRewrite, Replacement: "select 1"},
+		{ID: 3, Pattern: "SELECT * FROM `t` WHERE `a` LIKE %", Action: SQLFirewallActionDeny},
+		{ID: 4, Action: SQLFirewallActionDeny},
+	})
+	// The DENY rule is always preferred.
+	require.Equal(t, int64(3), rules.match("select * from `t` where `a` like ?", "d1").ID)
+	require.Equal(t, int64(3), rules.match("select * from `t` where `a` like ?", "d2").ID)
+	// The rule of the digest is preferred to the other patterns.
+	require.Equal(t, int64(1), rules.match("select * from `t` where `a` = ?", "d1").ID)
+	require.Equal(t, int64(2), rules.match("select * from `t` where `a` = ?", "d2").ID)
+	require.Nil(t, rules.match("select * from `s`", "d3"))
+
+	allow := &SQLFirewallRule{ID: 1, Action: SQLFirewallActionAllow}
+	deny := &SQLFirewallRule{ID: 2, Action: SQLFirewallActionDeny}
+	rewrite := &SQLFirewallRule{ID: 3, Action: SQLFirewallActionRewrite, Replacement: "select 1"}
+	for _, tc := range []struct {
+		mode     string
+		rule     *SQLFirewallRule
+		decision SQLFirewallDecision
+		learn    bool
+	}{
+		{variable.SQLFirewallLearning, nil, SQLFirewallDecision{}, true},
+		{variable.SQLFirewallLearning, deny, SQLFirewallDecision{RuleID: 2}, false},
+		{variable.SQLFirewallLearning, rewrite, SQLFirewallDecision{RuleID: 3}, false},
+		{variable.SQLFirewallDeny, nil, SQLFirewallDecision{}, false},
+		{variable.SQLFirewallDeny, allow, SQLFirewallDecision{RuleID: 1}, false},
+		{variable.SQLFirewallDeny, deny, SQLFirewallDecision{Blocked: true, RuleID: 2}, false},
+		{variable.SQLFirewallDeny, rewrite, SQLFirewallDecision{Replacement: "select 1", RuleID: 3}, false},
+		{variable.SQLFirewallAllow, nil, SQLFirewallDecision{Blocked: true}, false},
+		{variable.SQLFirewallAllow, allow, SQLFirewallDecision{RuleID: 1}, false},
+		{variable.SQLFirewallAllow, deny, SQLFirewallDecision{Blocked: true, RuleID: 2}, false},
+		{variable.SQLFirewallAllow, rewrite, SQLFirewallDecision{Replacement: "select 1", RuleID: 3}, false},
+	} {
+		decision, learn := decideSQLFirewall(tc.mode, tc.rule)
+		require.Equal(t, tc.decision, decision, "%s %v", tc.mode, tc.rule)
+		require.Equal(t, tc.learn, learn, "%s %v", tc.mode, tc.rule)
+	}
+}
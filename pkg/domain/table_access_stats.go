@@ -0,0 +1,72 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/metrics"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/intest"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/tableaccess"
+	"go.uber.org/zap"
+)
+
+// tableAccessStatsFlushInterval is the interval to persist the table and index accesses collected by the instance
+// into mysql.tidb_table_access_stats.
+const tableAccessStatsFlushInterval = time.Minute
+
+func (do *Domain) tableAccessStatsLoop() {
+	// do not start flush loop in unit test, the accesses are flushed by the tests explicitly.
+	if intest.InTest {
+		return
+	}
+	defer util.Recover(metrics.LabelDomain, "tableAccessStatsLoop", nil, false)
+	ticker := time.NewTicker(tableAccessStatsFlushInterval)
+	defer func() {
+		ticker.Stop()
+		logutil.BgLogger().Info("tableAccessStatsLoop exited.")
+	}()
+	for {
+		select {
+		case <-do.exit:
+			// Flush the accesses collected since the last flush before the instance exits.
+			do.flushTableAccessStats()
+			return
+		case <-ticker.C:
+			do.flushTableAccessStats()
+		}
+	}
+}
+
+// flushTableAccessStats persists the table and index accesses collected by the instance.
+func (do *Domain) flushTableAccessStats() {
+	se, err := do.sysSessionPool.Get()
+	if err != nil {
+		logutil.BgLogger().Warn("get session failed when flushing the table access stats", zap.Error(err))
+		return
+	}
+	defer do.sysSessionPool.Put(se)
+	exec := se.(sessionctx.Context).GetRestrictedSQLExecutor()
+	ctx, cancel := context.WithTimeout(kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats), 30*time.Second)
+	defer cancel()
+	if err := tableaccess.GlobalCollector.Flush(ctx, exec); err != nil {
+		logutil.BgLogger().Warn("flush the table access stats failed", zap.Error(err))
+	}
+}
@@ -0,0 +1,267 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"go.uber.org/zap"
+)
+
+const (
+	tableCacheAdvisorInterval = 10 * time.Minute
+	// tableCacheMinReadCount is the minimum read count of a table in the statements summary to be cached.
+	tableCacheMinReadCount = 1000
+	// tableCacheMaxWriteRatio is the max ratio of the write count to the read count of a table to be cached.
+	tableCacheMaxWriteRatio = 0.01
+	// tableCacheEvictWriteRatio is the ratio of the write count to the read count, beyond which a cached table is
+	// evicted, since every write of a cached table has to wait for the lease of the cache to expire.
+	tableCacheEvictWriteRatio = 0.1
+	// tableCacheMaxSize is the max size of a table to be cached, it's the same as the size limit of the cached table.
+	tableCacheMaxSize = 64 * (1 << 20)
+	// maxTableCacheAdvices is the max number of the recent advices kept in memory.
+	maxTableCacheAdvices = 100
+)
+
+// The advices of the table cache advisor.
+const (
+	// TableCacheAdviceCache means the table is suggested to be cached.
+	TableCacheAdviceCache = "CACHE"
+	// TableCacheAdviceNoCache means the cached table is suggested to be evicted.
+	TableCacheAdviceNoCache = "NOCACHE"
+)
+
+// The status of the advices of the table cache advisor.
+const (
+	// TableCacheAdviceAdvised means the advice is not applied since tidb_auto_table_cache is ADVISE.
+	TableCacheAdviceAdvised = "advised"
+	// TableCacheAdviceApplied means the advice has been applied.
+	TableCacheAdviceApplied = "applied"
+	// TableCacheAdviceFailed means the advice fails to be applied.
+	TableCacheAdviceFailed = "failed"
+)
+
+// TableCacheAdvice is an advice to cache or evict a table made by the table cache advisor.
+type TableCacheAdvice struct {
+	Time       time.Time
+	Schema     string
+	Table      string
+	ReadCount  int64
+	WriteCount int64
+	Size       int64
+	Advice     string
+	Status     string
+}
+
+// tableAccessCount is the read and write count of a table in the statements summary.
+type tableAccessCount struct {
+	reads  int64
+	writes int64
+}
+
+// TableCacheAdvisor identifies the small, hot and read-mostly tables from the statements summary, and suggests or
+// applies `ALTER TABLE ... CACHE` for them. The cached tables whose write rates rise are evicted in the same way.
+type TableCacheAdvisor struct {
+	sessPool *sessionPool
+
+	mu      sync.Mutex
+	advices []TableCacheAdvice
+}
+
+func newTableCacheAdvisor(sessPool *sessionPool) *TableCacheAdvisor {
+	return &TableCacheAdvisor{sessPool: sessPool}
+}
+
+// Advices returns the recent advices made by the advisor.
+func (a *TableCacheAdvisor) Advices() []TableCacheAdvice {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return append([]TableCacheAdvice(nil), a.advices...)
+}
+
+// isAdvised returns whether the same advice for the table has been made and not applied yet.
+func (a *TableCacheAdvisor) isAdvised(advice *TableCacheAdvice) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i := range a.advices {
+		old := &a.advices[i]
+		if old.Schema == advice.Schema && old.Table == advice.Table {
+			return old.Advice == advice.Advice && old.Status == TableCacheAdviceAdvised
+		}
+	}
+	return false
+}
+
+// addAdvices adds the advices, the previous advice for the same table is replaced so that a table is advised only
+// once.
+func (a *TableCacheAdvisor) addAdvices(advices []TableCacheAdvice) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i := range advices {
+		advice := &advices[i]
+		a.advices = slices.DeleteFunc(a.advices, func(old TableCacheAdvice) bool {
+			return old.Schema == advice.Schema && old.Table == advice.Table
+		})
+		a.advices = append(a.advices, *advice)
+	}
+	if len(a.advices) > maxTableCacheAdvices {
+		a.advices = a.advices[len(a.advices)-maxTableCacheAdvices:]
+	}
+}
+
+// Run makes the advices by the current statements summary, and applies them if tidb_auto_table_cache is ON.
+func (a *TableCacheAdvisor) Run(is infoschema.InfoSchema) error {
+	mode := variable.AutoTableCacheMode.Load()
+	if mode == variable.Off {
+		return nil
+	}
+	counts, err := a.loadTableAccessCounts()
+	if err != nil {
+		return err
+	}
+	advices := adviseTableCache(is, counts, a.getTableSize)
+	for i := range advices {
+		advice := &advices[i]
+		advice.Status = TableCacheAdviceAdvised
+		if mode != variable.On && a.isAdvised(advice) {
+			continue
+		}
+		if mode == variable.On {
+			advice.Status = TableCacheAdviceApplied
+			if _, err := execRestrictedSQL(a.sessPool, "ALTER TABLE %n.%n "+advice.Advice, []any{advice.Schema, advice.Table}); err != nil {
+				advice.Status = TableCacheAdviceFailed
+				logutil.BgLogger().Warn("failed to apply the table cache advice", zap.String("schema", advice.Schema),
+					zap.String("table", advice.Table), zap.String("advice", advice.Advice), zap.Error(err))
+				continue
+			}
+		}
+		logutil.BgLogger().Info("table cache advice", zap.String("schema", advice.Schema), zap.String("table", advice.Table),
+			zap.String("advice", advice.Advice), zap.String("status", advice.Status),
+			zap.Int64("reads", advice.ReadCount), zap.Int64("writes", advice.WriteCount), zap.Int64("size", advice.Size))
+	}
+	a.addAdvices(advices)
+	return nil
+}
+
+// loadTableAccessCounts loads the read and write count of each table from the statements summary of the cluster.
+func (a *TableCacheAdvisor) loadTableAccessCounts() (map[string]*tableAccessCount, error) {
+	rows, err := execRestrictedSQL(a.sessPool, "SELECT TABLE_NAMES, STMT_TYPE, CAST(SUM(EXEC_COUNT) AS SIGNED) FROM information_schema.cluster_statements_summary "+
+		"WHERE TABLE_NAMES != '' GROUP BY TABLE_NAMES, STMT_TYPE", nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	counts := make(map[string]*tableAccessCount)
+	for _, row := range rows {
+		stmtType := strings.ToLower(row.GetString(1))
+		execCount := row.GetInt64(2)
+		for _, name := range strings.Split(row.GetString(0), ",") {
+			if name == "" {
+				continue
+			}
+			count, ok := counts[name]
+			if !ok {
+				count = &tableAccessCount{}
+				counts[name] = count
+			}
+			switch stmtType {
+			case "select":
+				count.reads += execCount
+			case "insert", "replace", "update", "delete", "loaddata":
+				count.writes += execCount
+			}
+		}
+	}
+	return counts, nil
+}
+
+// getTableSize returns the estimated data and index size of the table, ok is false if the size is unknown, e.g. the
+// statistics of the table have not been collected.
+func (a *TableCacheAdvisor) getTableSize(schema, table string) (size int64, ok bool, err error) {
+	rows, err := execRestrictedSQL(a.sessPool, "SELECT DATA_LENGTH + INDEX_LENGTH FROM information_schema.tables WHERE TABLE_SCHEMA = %? AND TABLE_NAME = %?",
+		[]any{schema, table})
+	if err != nil {
+		return 0, false, errors.Trace(err)
+	}
+	if len(rows) == 0 || rows[0].IsNull(0) {
+		return 0, false, nil
+	}
+	size = rows[0].GetInt64(0)
+	return size, size > 0, nil
+}
+
+// adviseTableCache makes the advices by the read and write count of the tables, the keys of counts are in the form of
+// "schema.table" as the TABLE_NAMES in the statements summary.
+func adviseTableCache(is infoschema.InfoSchema, counts map[string]*tableAccessCount, getTableSize func(schema, table string) (int64, bool, error)) []TableCacheAdvice {
+	names := make([]string, 0, len(counts))
+	for name := range counts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	now := time.Now()
+	var advices []TableCacheAdvice
+	for _, name := range names {
+		count := counts[name]
+		schema, table, ok := strings.Cut(name, ".")
+		if !ok || util.IsMemOrSysDB(schema) {
+			continue
+		}
+		tbl, err := is.TableByName(model.NewCIStr(schema), model.NewCIStr(table))
+		if err != nil {
+			continue
+		}
+		tblInfo := tbl.Meta()
+		if tblInfo.IsView() || tblInfo.IsSequence() || tblInfo.TempTableType != model.TempTableNone || tblInfo.Partition != nil {
+			continue
+		}
+		advice := TableCacheAdvice{
+			Time:       now,
+			Schema:     schema,
+			Table:      tblInfo.Name.O,
+			ReadCount:  count.reads,
+			WriteCount: count.writes,
+		}
+		if tblInfo.TableCacheStatusType == model.TableCacheStatusEnable {
+			if float64(count.writes) > float64(count.reads)*tableCacheEvictWriteRatio {
+				advice.Advice = TableCacheAdviceNoCache
+				advices = append(advices, advice)
+			}
+			continue
+		}
+		if tblInfo.TableCacheStatusType != model.TableCacheStatusDisable || count.reads < tableCacheMinReadCount ||
+			float64(count.writes) > float64(count.reads)*tableCacheMaxWriteRatio {
+			continue
+		}
+		// The table whose size is unknown is not advised, since it may be too large to be cached. The zero size means
+		// the statistics of the table are not collected either.
+		size, ok, err := getTableSize(schema, table)
+		if err != nil || !ok || size <= 0 || size > tableCacheMaxSize {
+			continue
+		}
+		advice.Size = size
+		advice.Advice = TableCacheAdviceCache
+		advices = append(advices, advice)
+	}
+	return advices
+}
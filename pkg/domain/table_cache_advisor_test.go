@@ -0,0 +1,96 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package domain
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdviseTableCache(t *testing.T) {
+	newTable := func(id int64, name string) *model.TableInfo {
+		return &model.TableInfo{ID: id, Name: model.NewCIStr(name), State: model.StatePublic}
+	}
+	hot, large, written, cold := newTable(1, "hot"), newTable(2, "large"), newTable(3, "written"), newTable(4, "cold")
+	unknown, empty := newTable(8, "unknown"), newTable(9, "empty")
+	cached, cachedWritten := newTable(5, "cached"), newTable(6, "cached_written")
+	cached.TableCacheStatusType = model.TableCacheStatusEnable
+	cachedWritten.TableCacheStatusType = model.TableCacheStatusEnable
+	tmp := newTable(7, "tmp")
+	tmp.TempTableType = model.TempTableGlobal
+	is := infoschema.MockInfoSchema([]*model.TableInfo{hot, large, written, cold, cached, cachedWritten, tmp, unknown, empty})
+
+	counts := map[string]*tableAccessCount{
+		"test.hot":            {reads: 10000, writes: 10},
+		"test.large":          {reads: 10000},
+		"test.written":        {reads: 10000, writes: 1000},
+		"test.cold":           {reads: 10},
+		"test.cached":         {reads: 10000, writes: 100},
+		"test.cached_written": {reads: 10000, writes: 5000},
+		"test.tmp":            {reads: 10000},
+		"test.unknown":        {reads: 10000},
+		"test.empty":          {reads: 10000},
+		"test.not_exist":      {reads: 10000},
+		"mysql.user":          {reads: 10000},
+	}
+	getTableSize := func(_, table string) (int64, bool, error) {
+		switch table {
+		case "large":
+			return tableCacheMaxSize + 1, true, nil
+		case "unknown":
+			return 0, false, nil
+		case "empty":
+			return 0, true, nil
+		}
+		return 1024, true, nil
+	}
+	advices := adviseTableCache(is, counts, getTableSize)
+	require.Len(t, advices, 2)
+	require.Equal(t, "cached_written", advices[0].Table)
+	require.Equal(t, TableCacheAdviceNoCache, advices[0].Advice)
+	require.Equal(t, int64(5000), advices[0].WriteCount)
+	require.Equal(t, "hot", advices[1].Table)
+	require.Equal(t, "test", advices[1].Schema)
+	require.Equal(t, TableCacheAdviceCache, advices[1].Advice)
+	require.Equal(t, int64(1024), advices[1].Size)
+
+	advisor := newTableCacheAdvisor(nil)
+	for i := range advices {
+		advices[i].Status = TableCacheAdviceAdvised
+	}
+	advisor.addAdvices(advices)
+	require.True(t, advisor.isAdvised(&advices[1]))
+	// The repeated advices of the same tables are not duplicated.
+	advisor.addAdvices(advices)
+	require.Len(t, advisor.Advices(), 2)
+	// The advice of a table replaces the previous one.
+	evict := advices[1]
+	evict.Advice = TableCacheAdviceNoCache
+	require.False(t, advisor.isAdvised(&evict))
+	advisor.addAdvices([]TableCacheAdvice{evict})
+	require.Len(t, advisor.Advices(), 2)
+	require.Equal(t, TableCacheAdviceNoCache, advisor.Advices()[1].Advice)
+
+	many := make([]TableCacheAdvice, 0, maxTableCacheAdvices+1)
+	for i := 0; i <= maxTableCacheAdvices; i++ {
+		many = append(many, TableCacheAdvice{Schema: "test", Table: fmt.Sprintf("t%d", i)})
+	}
+	advisor.addAdvices(many)
+	require.Len(t, advisor.Advices(), maxTableCacheAdvices)
+}
@@ -896,6 +896,8 @@ const (
 	ErrCTEMaxRecursionDepth                                  = 3636
 	ErrNotHintUpdatable                                      = 3637
 	ErrExistsInHistoryPassword                               = 3638
+	ErrMissingJSONTableValue                                 = 3665
+	ErrWrongJSONTableValue                                   = 3666
 	ErrInvalidDefaultUTF8MB4Collation                        = 3721
 	ErrForeignKeyCannotDropParent                            = 3730
 	ErrForeignKeyCannotUseVirtualColumn                      = 3733
@@ -1008,6 +1010,11 @@ const (
 	ErrWarnOptimizerHintWrongPos           = 8066
 	ErrUnsupportedSecondArgumentType       = 8067
 	ErrColumnNotMatched                    = 8068
+	ErrWarnHintUnmatchedTable              = 8069
+	ErrWarnHintInapplicableIndex           = 8070
+	ErrWarnHintInapplicablePartition       = 8071
+	ErrWarnHintUnknownQueryBlock           = 8072
+	ErrWarnHintInapplicable                = 8073
 	ErrInvalidPluginID                     = 8101
 	ErrInvalidPluginManifest               = 8102
 	ErrInvalidPluginName                   = 8103
@@ -1080,6 +1087,22 @@ const (
 	ErrBRJobNotFound                       = 8174
 	ErrMemoryExceedForQuery                = 8175
 	ErrMemoryExceedForInstance             = 8176
+	ErrCTEMaxRecursionRows                 = 8177
+	ErrUnsupportedTTLCondition             = 8178
+	ErrRowPolicyExists                     = 8179
+	ErrRowPolicyNotExists                  = 8180
+	ErrUnsupportedRowPolicy                = 8181
+	ErrRowPolicyColumnCannotDrop           = 8182
+	ErrMaskingPolicyExists                 = 8183
+	ErrMaskingPolicyNotExists              = 8184
+	ErrUnsupportedMaskingPolicy            = 8185
+	ErrMaskedColumnCannotDrop              = 8186
+	ErrMaskedColumnInDML                   = 8187
+	ErrLoadDataConflictFound               = 8188
+	ErrUnsupportedExternalTable            = 8189
+	ErrNoMatchingReplica                   = 8190
+	ErrSQLFirewallBlocked                  = 8191
+	ErrTiFlashReplicaUnavailable           = 8192
 
 	// Error codes used by TiDB ddl package
 	ErrUnsupportedDDLOperation            = 8200
@@ -935,7 +935,9 @@ var MySQLErrName = map[uint16]*mysql.ErrMessage{
 	ErrCTERecursiveForbidsAggregation:                        mysql.Message("Recursive Common Table Expression '%s' can contain neither aggregation nor window functions in recursive query block", nil),
 	ErrCTERecursiveForbiddenJoinOrder:                        mysql.Message("In recursive query block of Recursive Common Table Expression '%s', the recursive table must neither be in the right argument of a LEFT JOIN, nor be forced to be non-first with join order hints", nil),
 	ErrInvalidRequiresSingleReference:                        mysql.Message("In recursive query block of Recursive Common Table Expression '%s', the recursive table must be referenced only once, and not in any subquery", nil),
-	ErrCTEMaxRecursionDepth:                                  mysql.Message("Recursive query aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value", nil),
+	ErrCTEMaxRecursionDepth:                                  mysql.Message("Recursive query `%s` aborted after %d iterations. Try increasing @@cte_max_recursion_depth to a larger value", nil),
+	ErrMissingJSONTableValue:                                 mysql.Message("Missing value for JSON_TABLE column '%s'", nil),
+	ErrWrongJSONTableValue:                                   mysql.Message("Can't store an array or an object in the scalar column '%s' of JSON_TABLE '%s'.", nil),
 	ErrTableWithoutPrimaryKey:                                mysql.Message("Unable to create or change a table without a primary key, when the system variable 'sql_require_primary_key' is set. Add a primary key to the table or unset this variable to avoid this message. Note that tables without a primary key can cause performance problems in row-based replication, so please consult your DBA before changing this setting.", nil),
 	ErrConstraintNotFound:                                    mysql.Message("Constraint '%s' does not exist.", nil),
 	ErrDependentByCheckConstraint:                            mysql.Message("Check constraint '%s' uses column '%s', hence column cannot be dropped or renamed.", nil),
@@ -1073,6 +1075,22 @@ var MySQLErrName = map[uint16]*mysql.ErrMessage{
 	ErrLoadDataPreCheckFailed:           mysql.Message("PreCheck failed: %s", nil),
 	ErrMemoryExceedForQuery:             mysql.Message("Your query has been cancelled due to exceeding the allowed memory limit for a single SQL query. Please try narrowing your query scope or increase the tidb_mem_quota_query limit and try again.[conn=%d]", nil),
 	ErrMemoryExceedForInstance:          mysql.Message("Your query has been cancelled due to exceeding the allowed memory limit for the tidb-server instance and this query is currently using the most memory. Please try narrowing your query scope or increase the tidb_server_memory_limit and try again.[conn=%d]", nil),
+	ErrCTEMaxRecursionRows:              mysql.Message("Recursive query `%s` aborted after materializing %d rows. Try increasing @@tidb_cte_max_recursion_rows to a larger value", nil),
+	ErrUnsupportedTTLCondition:          mysql.Message("Unsupported TTL condition '%-.192s': %s", nil),
+	ErrRowPolicyExists:                  mysql.Message("Row policy '%-.192s' already exists on table '%-.192s'", nil),
+	ErrRowPolicyNotExists:               mysql.Message("Row policy '%-.192s' doesn't exist on table '%-.192s'", nil),
+	ErrUnsupportedRowPolicy:             mysql.Message("Unsupported row policy '%-.192s': %s", nil),
+	ErrRowPolicyColumnCannotDrop:        mysql.Message("Cannot drop or rename column '%-.192s': needed in row policy '%-.192s'", nil),
+	ErrMaskingPolicyExists:              mysql.Message("Masking policy '%-.192s' already exists on table '%-.192s'", nil),
+	ErrMaskingPolicyNotExists:           mysql.Message("Masking policy '%-.192s' doesn't exist on table '%-.192s'", nil),
+	ErrUnsupportedMaskingPolicy:         mysql.Message("Unsupported masking policy '%-.192s': %s", nil),
+	ErrMaskedColumnCannotDrop:           mysql.Message("Cannot drop column '%-.192s': needed in masking policy '%-.192s'", nil),
+	ErrMaskedColumnInDML:                mysql.Message("Column '%-.192s' is masked for the current user and can't be read in %s statements", nil),
+	ErrLoadDataConflictFound:            mysql.Message("Found %d rows conflicting on primary key or unique index when importing into table %s", nil),
+	ErrUnsupportedExternalTable:         mysql.Message("Unsupported external table '%-.192s': %s", nil),
+	ErrNoMatchingReplica:                mysql.Message("No store matches the labels '%s' of the READ_LOCALITY hint", nil),
+	ErrSQLFirewallBlocked:               mysql.Message("The statement with SQL digest '%s' is blocked by the SQL firewall", nil),
+	ErrTiFlashReplicaUnavailable:        mysql.Message("No available path for table %s.%s with the store type tiflash of the hint /*+ read_from_storage */, please check the status of the table replica and variable value of tidb_isolation_read_engines(%v)", nil),
 	ErrHTTPServiceError:                 mysql.Message("HTTP request failed with status %s", nil),
 
 	ErrWarnOptimizerHintInvalidInteger:  mysql.Message("integer value is out of range in '%s'", nil),
@@ -1081,6 +1099,11 @@ var MySQLErrName = map[uint16]*mysql.ErrMessage{
 	ErrWarnMemoryQuotaOverflow:          mysql.Message("Max value of MEMORY_QUOTA is %d bytes, ignore this invalid limit", nil),
 	ErrWarnOptimizerHintParseError:      mysql.Message("Optimizer hint syntax error at %v", nil),
 	ErrWarnOptimizerHintWrongPos:        mysql.Message("Optimizer hint can only be followed by certain keywords like SELECT, INSERT, etc.", nil),
+	ErrWarnHintUnmatchedTable:           mysql.Message("There are no matching table names for (%s) in optimizer hint %s. Maybe you can use the table alias name", nil),
+	ErrWarnHintInapplicableIndex:        mysql.Message("%s is inapplicable, check whether the table(%s.%s) exists", nil),
+	ErrWarnHintInapplicablePartition:    mysql.Message("Optimizer Hint %s is inapplicable on specified partitions", nil),
+	ErrWarnHintUnknownQueryBlock:        mysql.Message("Hint %s is ignored due to unknown query block name", nil),
+	ErrWarnHintInapplicable:             mysql.Message("Optimizer Hint %s is inapplicable", nil),
 
 	ErrSequenceUnsupportedTableOption:      mysql.Message("Unsupported sequence table-option %s", nil),
 	ErrColumnTypeUnsupportedNextValue:      mysql.Message("Unsupported sequence default value for column type '%s'", nil),
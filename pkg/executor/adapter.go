@@ -65,6 +65,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/hint"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/plancodec"
+	"github.com/pingcap/tidb/pkg/util/planregression"
 	"github.com/pingcap/tidb/pkg/util/redact"
 	"github.com/pingcap/tidb/pkg/util/replayer"
 	"github.com/pingcap/tidb/pkg/util/sqlexec"
@@ -96,6 +97,8 @@ type recordSet struct {
 	lastErrs   []error
 	txnStartTS uint64
 	once       sync.Once
+	// rowsReturned indicates whether some rows have been returned, then the statement can't be re-optimized.
+	rowsReturned bool
 }
 
 func (a *recordSet) Fields() []*ast.ResultField {
@@ -158,6 +161,16 @@ func (a *recordSet) Next(ctx context.Context, req *chunk.Chunk) (err error) {
 	}()
 
 	err = a.stmt.next(ctx, a.executor, req)
+	if !a.rowsReturned && a.stmt != nil {
+		// The statement can only be re-optimized before the first rows are returned to the client.
+		reoptimize := err != nil && errors.ErrorEqual(err, errReoptimize)
+		if err == nil && req.NumRows() > 0 {
+			reoptimize = a.stmt.Ctx.GetSessionVars().StmtCtx.Reoptimize.Disable()
+		}
+		if reoptimize {
+			err = a.reoptimize(ctx, req)
+		}
+	}
 	if err != nil {
 		a.lastErrs = append(a.lastErrs, err)
 		return err
@@ -171,10 +184,32 @@ func (a *recordSet) Next(ctx context.Context, req *chunk.Chunk) (err error) {
 	}
 	if a.stmt != nil {
 		a.stmt.Ctx.GetSessionVars().StmtCtx.AddFoundRows(uint64(numRows))
+		a.rowsReturned = true
 	}
 	return nil
 }
 
+// reoptimize closes the current executor, re-optimizes the statement with the row counts observed in the
+// execution and fetches the first chunk from the executor of the new plan.
+func (a *recordSet) reoptimize(ctx context.Context, req *chunk.Chunk) error {
+	err := exec.Close(a.executor)
+	// The executor is closed anyway, don't close it again in Finish.
+	a.executor = nil
+	if err != nil {
+		return err
+	}
+	if err = resetCTEStorageMap(a.stmt.Ctx); err != nil {
+		return err
+	}
+	e, err := a.stmt.reoptimize(ctx)
+	if err != nil {
+		return err
+	}
+	a.executor = e
+	req.Reset()
+	return a.stmt.next(ctx, a.executor, req)
+}
+
 // NewChunk create a chunk base on top-level executor's exec.NewFirstChunk().
 func (a *recordSet) NewChunk(alloc chunk.Allocator) *chunk.Chunk {
 	if alloc == nil {
@@ -187,7 +222,9 @@ func (a *recordSet) NewChunk(alloc chunk.Allocator) *chunk.Chunk {
 func (a *recordSet) Finish() error {
 	var err error
 	a.once.Do(func() {
-		err = exec.Close(a.executor)
+		if a.executor != nil {
+			err = exec.Close(a.executor)
+		}
 		cteErr := resetCTEStorageMap(a.stmt.Ctx)
 		if cteErr != nil {
 			logutil.BgLogger().Error("got error when reset cte storage, should check if the spill disk file deleted or not", zap.Error(cteErr))
@@ -280,6 +317,9 @@ func (a *ExecStmt) PointGet(ctx context.Context) (*recordSet, error) {
 		return nil, err
 	}
 	a.Ctx.GetSessionVars().StmtCtx.Priority = kv.PriorityHigh
+	if err := applyReadLocality(a.Ctx); err != nil {
+		return nil, err
+	}
 
 	var executor exec.Executor
 	useMaxTS := startTs == math.MaxUint64
@@ -505,6 +545,7 @@ func (a *ExecStmt) Exec(ctx context.Context) (_ sqlexec.RecordSet, err error) {
 	if sctx.GetSessionVars().StmtCtx.HasMemQuotaHint {
 		sctx.GetSessionVars().MemTracker.SetBytesLimit(sctx.GetSessionVars().StmtCtx.MemQuotaQuery)
 	}
+	a.applyCommitHints()
 
 	// must set plan according to the `Execute` plan before getting planDigest
 	a.inheritContextFromExecuteStmt()
@@ -519,6 +560,9 @@ func (a *ExecStmt) Exec(ctx context.Context) (_ sqlexec.RecordSet, err error) {
 	}
 	ctx = a.observeStmtBeginForTopSQL(ctx)
 
+	if a.canReoptimize() {
+		sctx.GetSessionVars().StmtCtx.Reoptimize.Enable()
+	}
 	e, err := a.buildExecutor()
 	if err != nil {
 		return nil, err
@@ -576,6 +620,28 @@ func (a *ExecStmt) Exec(ctx context.Context) (_ sqlexec.RecordSet, err error) {
 	}, nil
 }
 
+// applyCommitHints records the ASYNC_COMMIT and ONE_PC hints of the DML statement in the transaction context, so
+// that they take effect when the transaction is committed.
+func (a *ExecStmt) applyCommitHints() {
+	sessVars := a.Ctx.GetSessionVars()
+	stmtCtx := sessVars.StmtCtx
+	if !stmtCtx.HasAsyncCommitHint && !stmtCtx.HasOnePCHint {
+		return
+	}
+	if a.IsReadOnly(sessVars) {
+		stmtCtx.AppendWarning(errors.NewNoStackError("ASYNC_COMMIT() and ONE_PC() are only applicable to DML statements"))
+		return
+	}
+	if stmtCtx.HasAsyncCommitHint {
+		asyncCommit := stmtCtx.AsyncCommit
+		sessVars.TxnCtx.AsyncCommitHint = &asyncCommit
+	}
+	if stmtCtx.HasOnePCHint {
+		onePC := stmtCtx.OnePC
+		sessVars.TxnCtx.OnePCHint = &onePC
+	}
+}
+
 func (a *ExecStmt) inheritContextFromExecuteStmt() {
 	if executePlan, ok := a.Plan.(*plannercore.Execute); ok {
 		a.Ctx.SetValue(sessionctx.QueryString, executePlan.Stmt.Text())
@@ -593,13 +659,24 @@ func (a *ExecStmt) getSQLForProcessInfo() string {
 			// Use SecureText to avoid leak password information.
 			sql = ss.SecureText()
 		}
-	} else if sn, ok2 := a.StmtNode.(ast.SensitiveStmtNode); ok2 {
+	} else if secureText, ok2 := secureStmtText(a.StmtNode); ok2 {
 		// such as import into statement
-		sql = sn.SecureText()
+		sql = secureText
 	}
 	return sql
 }
 
+// secureStmtText returns the redacted text of the sensitive statement. It returns false if
+// nothing is redacted, such as the CREATE TABLE statement of a table which isn't external.
+func secureStmtText(stmt ast.StmtNode) (string, bool) {
+	sn, ok := stmt.(ast.SensitiveStmtNode)
+	if !ok {
+		return "", false
+	}
+	secureText := sn.SecureText()
+	return secureText, secureText != stmt.Text()
+}
+
 func (a *ExecStmt) handleStmtForeignKeyTrigger(ctx context.Context, e exec.Executor) error {
 	stmtCtx := a.Ctx.GetSessionVars().StmtCtx
 	if stmtCtx.ForeignKeyTriggerCtx.HasFKCascades {
@@ -1107,10 +1184,23 @@ func (a *ExecStmt) handlePessimisticLockError(ctx context.Context, lockErr error
 		return nil, lockErr
 	}
 
-	if a.retryCount >= config.GetGlobalConfig().PessimisticTxn.MaxRetryCount {
+	sessVars := a.Ctx.GetSessionVars()
+	maxRetryCount := config.GetGlobalConfig().PessimisticTxn.MaxRetryCount
+	if sessVars.WriteConflictMaxRetries >= 0 {
+		maxRetryCount = uint(sessVars.WriteConflictMaxRetries)
+	}
+	if a.retryCount >= maxRetryCount {
 		return nil, errors.New("pessimistic lock retry limit reached")
 	}
 	a.retryCount++
+	sessVars.StmtCtx.WriteConflictRetries.Inc()
+	if backoff := writeConflictRetryBackoff(sessVars.WriteConflictRetryBackoff, a.retryCount); backoff > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(backoff):
+		}
+	}
 	a.retryStartTime = time.Now()
 
 	err = txnManager.OnStmtRetry(ctx)
@@ -1148,6 +1238,24 @@ func (a *ExecStmt) handlePessimisticLockError(ctx context.Context, lockErr error
 	return e, nil
 }
 
+// maxWriteConflictRetryBackoff is the max backoff between the retries on the write conflicts, unless the backoff
+// before the first retry is larger.
+const maxWriteConflictRetryBackoff = time.Second
+
+// writeConflictRetryBackoff returns the backoff before the nth retry on the write conflicts, which doubles on each
+// retry.
+func writeConflictRetryBackoff(base time.Duration, retryCount uint) time.Duration {
+	if base <= 0 || retryCount == 0 {
+		return 0
+	}
+	limit := max(base, maxWriteConflictRetryBackoff)
+	backoff := base
+	for i := uint(1); i < retryCount && backoff < limit; i++ {
+		backoff *= 2
+	}
+	return min(backoff, limit)
+}
+
 type pessimisticTxn interface {
 	kv.Transaction
 	// KeysNeedToLock returns the keys need to be locked.
@@ -1167,6 +1275,9 @@ func (a *ExecStmt) buildExecutor() (exec.Executor, error) {
 	if _, ok := a.Plan.(*plannercore.Analyze); ok && ctx.GetSessionVars().InRestrictedSQL {
 		ctx.GetSessionVars().StmtCtx.Priority = kv.PriorityLow
 	}
+	if err := applyReadLocality(ctx); err != nil {
+		return nil, err
+	}
 
 	b := newExecutorBuilder(ctx, a.InfoSchema)
 	e := b.build(a.Plan)
@@ -1238,6 +1349,7 @@ func (a *ExecStmt) logAudit() {
 		if audit.OnGeneralEvent != nil {
 			cmd := mysql.Command2Str[byte(atomic.LoadUint32(&a.Ctx.GetSessionVars().CommandValue))]
 			ctx := context.WithValue(context.Background(), plugin.ExecStartTimeCtxKey, a.Ctx.GetSessionVars().StartTime)
+			ctx = context.WithValue(ctx, plugin.ExecPlanInfoCtxKey, plugin.PlanInfoFunc(a.getPlanInfo))
 			audit.OnGeneralEvent(ctx, sessVars, plugin.Completed, cmd)
 		}
 		return nil
@@ -1247,6 +1359,19 @@ func (a *ExecStmt) logAudit() {
 	}
 }
 
+// getPlanInfo returns the plan digest and the optimizer hints of the stmt.
+func (a *ExecStmt) getPlanInfo() (planDigest string, hints string) {
+	stmtCtx := a.Ctx.GetSessionVars().StmtCtx
+	if stmtCtx.GetPlan() == nil {
+		return "", ""
+	}
+	if _, digest := GetPlanDigest(stmtCtx); digest != nil {
+		planDigest = digest.String()
+	}
+	_, hints = getEncodedPlan(stmtCtx, true)
+	return planDigest, hints
+}
+
 // FormatSQL is used to format the original SQL, e.g. truncating long SQL, appending prepared arguments.
 func FormatSQL(sql string) stringutil.StringerFunc {
 	return func() string {
@@ -1341,6 +1466,9 @@ func (a *ExecStmt) FinishExecuteStmt(txnTS uint64, err error, hasMoreResults boo
 		}
 		sessVars.StmtCtx.RuntimeStatsColl.RegisterStats(a.Plan.ID(), statsWithCommit)
 	}
+	if a.Plan != nil {
+		registerRetryRuntimeStats(sessVars.StmtCtx, a.Plan.ID())
+	}
 	// Record related SLI metrics.
 	if execDetail.CommitDetail != nil && execDetail.CommitDetail.WriteSize > 0 {
 		a.Ctx.GetTxnWriteThroughputSLI().AddTxnWriteSize(execDetail.CommitDetail.WriteSize, execDetail.CommitDetail.WriteKeys)
@@ -1361,6 +1489,8 @@ func (a *ExecStmt) FinishExecuteStmt(txnTS uint64, err error, hasMoreResults boo
 	// `LowSlowQuery` and `SummaryStmt` must be called before recording `PrevStmt`.
 	a.LogSlowQuery(txnTS, succ, hasMoreResults)
 	a.SummaryStmt(succ)
+	a.observePlanRegression(succ)
+	a.recordTableAccess()
 	a.observeStmtFinishedForTopSQL()
 	if sessVars.StmtCtx.IsTiFlash.Load() {
 		if succ {
@@ -1402,6 +1532,9 @@ func (a *ExecStmt) FinishExecuteStmt(txnTS uint64, err error, hasMoreResults boo
 			if execDetail.LockKeysDetail.LockedWithConflictCount > 0 || execDetail.LockKeysDetail.AggressiveLockDerivedCount > 0 {
 				executor_metrics.FairLockingStmtEffectiveCount.Inc()
 			}
+			if execDetail.LockKeysDetail.LockedWithConflictCount > 0 {
+				metrics.FairLockingConflictKeysCount.Observe(float64(execDetail.LockKeysDetail.LockedWithConflictCount))
+			}
 		}
 	}
 	// If the transaction is committed, update fair locking related counters by txn
@@ -1626,6 +1759,8 @@ func (a *ExecStmt) LogSlowQuery(txnTS uint64, succ bool, hasMoreResults bool) {
 		RRU:               ruDetails.RRU(),
 		WRU:               ruDetails.WRU(),
 		WaitRUDuration:    ruDetails.RUWaitDuration(),
+		AppliedHints:      a.appliedHints(),
+		BindSQL:           stmtCtx.BindSQL,
 	}
 	failpoint.Inject("assertSyncStatsFailed", func(val failpoint.Value) {
 		if val.(bool) {
@@ -1640,7 +1775,12 @@ func (a *ExecStmt) LogSlowQuery(txnTS uint64, succ bool, hasMoreResults bool) {
 	if _, ok := a.StmtNode.(*ast.CommitStmt); ok && sessVars.PrevStmt != nil {
 		slowItems.PrevStmt = sessVars.PrevStmt.String()
 	}
-	slowLog := sessVars.SlowLogFormat(slowItems)
+	var slowLog string
+	if cfg.Log.SlowQueryFormat == logutil.SlowLogFormatJSON {
+		slowLog = sessVars.SlowLogJSONFormat(slowItems)
+	} else {
+		slowLog = sessVars.SlowLogFormat(slowItems)
+	}
 	if trace.IsEnabled() {
 		trace.Log(a.GoCtx, "details", slowLog)
 	}
@@ -1809,7 +1949,7 @@ func getEncodedPlan(stmtCtx *stmtctx.StatementContext, genHint bool) (encodedPla
 			switch tableHint.HintName.L {
 			case hint.HintMemoryQuota, hint.HintUseToja, hint.HintNoIndexMerge,
 				hint.HintMaxExecutionTime, hint.HintIgnoreIndex, hint.HintReadFromStorage,
-				hint.HintMerge, hint.HintSemiJoinRewrite, hint.HintNoDecorrelate:
+				hint.HintMerge, hint.HintSemiJoinRewrite, hint.HintNoDecorrelate, hint.HintUseInvisibleIndexes:
 				hints = append(hints, tableHint)
 			}
 		}
@@ -1966,8 +2106,11 @@ func (a *ExecStmt) SummaryStmt(succ bool) {
 		KeyspaceID:          keyspaceID,
 		RUDetail:            ruDetail,
 		ResourceGroupName:   sessVars.StmtCtx.ResourceGroupName,
+		AppName:             sessVars.AppName,
 
 		PlanCacheUnqualified: sessVars.StmtCtx.PlanCacheUnqualified(),
+		BindingSQL:           stmtCtx.BindSQL,
+		AppliedHints:         a.appliedHints(),
 	}
 	if a.retryCount > 0 {
 		stmtExecInfo.ExecRetryTime = costTime - sessVars.DurationParse - sessVars.DurationCompile - time.Since(a.retryStartTime)
@@ -1975,6 +2118,57 @@ func (a *ExecStmt) SummaryStmt(succ bool) {
 	stmtsummaryv2.Add(stmtExecInfo)
 }
 
+// observePlanRegression records the execution of the query or DML statement for the plan regression detection.
+func (a *ExecStmt) observePlanRegression(succ bool) {
+	sessVars := a.Ctx.GetSessionVars()
+	stmtCtx := sessVars.StmtCtx
+	if !succ || sessVars.InRestrictedSQL || !planregression.Enabled() || a.Plan == nil || stmtCtx.InExplainStmt {
+		return
+	}
+	if !stmtCtx.InSelectStmt && !stmtCtx.InInsertStmt && !stmtCtx.InUpdateStmt && !stmtCtx.InDeleteStmt {
+		return
+	}
+	normalizedSQL, digest := stmtCtx.SQLDigest()
+	_, planDigest := GetPlanDigest(stmtCtx)
+	if digest == nil || planDigest == nil {
+		return
+	}
+	charset, collation := sessVars.GetCharsetInfo()
+	info := &planregression.StmtExecInfo{
+		SQLDigest:     digest.String(),
+		NormalizedSQL: normalizedSQL,
+		PlanDigest:    planDigest.String(),
+		OriginalSQL:   stmtCtx.OriginalSQL,
+		Schema:        strings.ToLower(sessVars.CurrentDB),
+		Charset:       charset,
+		Collation:     collation,
+		Latency:       time.Since(sessVars.StartTime) + sessVars.DurationParse,
+	}
+	if sessVars.User != nil {
+		info.User = sessVars.User.Username
+	}
+	// The text of the prepared statements contains the parameter markers, so the shadow bindings can't be created
+	// from them.
+	if !a.isPreparedStmt {
+		info.PlanHintGen = func() string {
+			_, planHint := getEncodedPlan(stmtCtx, true)
+			return planHint
+		}
+	}
+	planregression.GlobalDetector.Add(info)
+}
+
+// appliedHints returns the canonical hint set applied to the statement, which comes from
+// the binding if the binding is used, or from the statement itself. The hints are restored
+// once by the optimizer and kept with the cached plans, so it's cheap to call for every statement.
+func (a *ExecStmt) appliedHints() string {
+	stmtCtx := a.Ctx.GetSessionVars().StmtCtx
+	if stmtCtx.BindSQL != "" || stmtCtx.BindHints != "" {
+		return stmtCtx.BindHints
+	}
+	return stmtCtx.OriginalHints
+}
+
 // GetTextToLog return the query text to log.
 func (a *ExecStmt) GetTextToLog(keepHint bool) string {
 	var sql string
@@ -1986,8 +2180,8 @@ func (a *ExecStmt) GetTextToLog(keepHint bool) string {
 		} else {
 			sql, _ = sessVars.StmtCtx.SQLDigest()
 		}
-	} else if sensitiveStmt, ok := a.StmtNode.(ast.SensitiveStmtNode); ok {
-		sql = sensitiveStmt.SecureText()
+	} else if secureText, ok := secureStmtText(a.StmtNode); ok {
+		sql = secureText
 	} else {
 		sql = redact.String(rmode, sessVars.StmtCtx.OriginalSQL+sessVars.PlanCacheParams.String())
 	}
@@ -2034,7 +2228,7 @@ func (a *ExecStmt) observeStmtBeginForTopSQL(ctx context.Context) context.Contex
 	if len(normalizedPlan) == 0 {
 		return ctx
 	}
-	topsql.RegisterPlan(normalizedPlan, planDigest)
+	topsql.RegisterPlan(normalizedPlan, planDigest, vars.FoundInBinding)
 	return topsql.AttachSQLAndPlanInfo(ctx, sqlDigest, planDigest)
 }
 
@@ -2097,7 +2291,7 @@ func checkPlanReplayerCaptureTask(sctx sessionctx.Context, stmtNode ast.StmtNode
 	for _, task := range tasks {
 		if task.SQLDigest == sqlDigest.String() {
 			if task.PlanDigest == "*" || task.PlanDigest == planDigest.String() {
-				sendPlanReplayerDumpTask(key, sctx, stmtNode, startTS, false)
+				sendPlanReplayerDumpTask(key, task, sctx, stmtNode, startTS, false)
 				return
 			}
 		}
@@ -2123,11 +2317,11 @@ func checkPlanReplayerContinuesCapture(sctx sessionctx.Context, stmtNode ast.Stm
 	if existed {
 		return
 	}
-	sendPlanReplayerDumpTask(key, sctx, stmtNode, startTS, true)
+	sendPlanReplayerDumpTask(key, key, sctx, stmtNode, startTS, true)
 	sctx.GetSessionVars().AddPlanReplayerFinishedTaskKey(key)
 }
 
-func sendPlanReplayerDumpTask(key replayer.PlanReplayerTaskKey, sctx sessionctx.Context, stmtNode ast.StmtNode,
+func sendPlanReplayerDumpTask(key, captureTaskKey replayer.PlanReplayerTaskKey, sctx sessionctx.Context, stmtNode ast.StmtNode,
 	startTS uint64, isContinuesCapture bool) {
 	stmtCtx := sctx.GetSessionVars().StmtCtx
 	handle := sctx.Value(bindinfo.SessionBindInfoKeyType).(bindinfo.SessionBindingHandle)
@@ -2143,6 +2337,7 @@ func sendPlanReplayerDumpTask(key replayer.PlanReplayerTaskKey, sctx sessionctx.
 		Analyze:             false,
 		IsCapture:           true,
 		IsContinuesCapture:  isContinuesCapture,
+		CaptureTaskKey:      captureTaskKey,
 	}
 	dumpTask.EncodedPlan, _ = GetEncodedPlan(stmtCtx, false)
 	if execStmtAst, ok := stmtNode.(*ast.ExecuteStmt); ok {
@@ -280,7 +280,9 @@ func (e *HashAggExec) initForUnparallelExec() {
 		e.diskTracker = disk.NewTracker(e.ID(), -1)
 		e.diskTracker.AttachTo(vars.StmtCtx.DiskTracker)
 		e.dataInDisk.GetDiskTracker().AttachTo(e.diskTracker)
-		vars.MemTracker.FallbackOldAndSetNewActionForSoftLimit(e.ActionSpill())
+		spillAction := e.ActionSpill()
+		vars.MemTracker.FallbackOldAndSetNewActionForSoftLimit(spillAction)
+		memory.SetOperatorQuota(e.memTracker, vars.MemQuotaOperator, spillAction)
 	}
 }
 
@@ -402,7 +404,9 @@ func (e *HashAggExec) initForParallelExec(ctx sessionctx.Context) error {
 		e.diskTracker = disk.NewTracker(e.ID(), -1)
 		e.diskTracker.AttachTo(sessionVars.StmtCtx.DiskTracker)
 		e.spillHelper.diskTracker = e.diskTracker
-		sessionVars.MemTracker.FallbackOldAndSetNewActionForSoftLimit(e.ActionSpill())
+		spillAction := e.ActionSpill()
+		sessionVars.MemTracker.FallbackOldAndSetNewActionForSoftLimit(spillAction)
+		memory.SetOperatorQuota(e.memTracker, sessionVars.MemQuotaOperator, spillAction)
 	}
 
 	e.partialWorkers = make([]HashAggPartialWorker, partialConcurrency)
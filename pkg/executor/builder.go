@@ -204,6 +204,8 @@ func (b *executorBuilder) build(p base.Plan) exec.Executor {
 		return b.buildLoadData(v)
 	case *plannercore.LoadStats:
 		return b.buildLoadStats(v)
+	case *plannercore.ExportTable:
+		return b.buildExportTable(v)
 	case *plannercore.LockStats:
 		return b.buildLockStats(v)
 	case *plannercore.UnlockStats:
@@ -278,6 +280,10 @@ func (b *executorBuilder) build(p base.Plan) exec.Executor {
 		return b.buildMemTable(v)
 	case *plannercore.PhysicalTableDual:
 		return b.buildTableDual(v)
+	case *plannercore.PhysicalJSONTable:
+		return b.buildJSONTable(v)
+	case *plannercore.PhysicalLooseIndexScan:
+		return b.buildLooseIndexScan(v)
 	case *plannercore.PhysicalApply:
 		return b.buildApply(v)
 	case *plannercore.PhysicalMaxOneRow:
@@ -314,6 +320,12 @@ func (b *executorBuilder) build(p base.Plan) exec.Executor {
 		return b.buildCompactTable(v)
 	case *plannercore.AdminShowBDRRole:
 		return b.buildAdminShowBDRRole(v)
+	case *plannercore.AdminRecommendHints:
+		return b.buildAdminRecommendHints(v)
+	case *plannercore.AdminRecommendDropIndex:
+		return b.buildAdminRecommendDropIndex(v)
+	case *plannercore.AdminShowDiagnostics:
+		return b.buildAdminShowDiagnostics(v)
 	default:
 		if mp, ok := p.(testutil.MockPhysicalPlan); ok {
 			return mp.GetExecutor()
@@ -1056,6 +1068,24 @@ func (b *executorBuilder) buildLoadData(v *plannercore.LoadData) exec.Executor {
 	}
 }
 
+func (b *executorBuilder) buildExportTable(v *plannercore.ExportTable) exec.Executor {
+	// all the chunks are read at the same snapshot to keep the exported data consistent.
+	snapshotTS, err := b.getSnapshotTS()
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	return &ExportTableExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		dbName:       v.Table.Schema.O,
+		tableInfo:    v.Table.TableInfo,
+		path:         v.Path,
+		format:       v.Format,
+		options:      v.Options,
+		snapshotTS:   snapshotTS,
+	}
+}
+
 func (b *executorBuilder) buildLoadStats(v *plannercore.LoadStats) exec.Executor {
 	e := &LoadStatsExec{
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, nil, v.ID()),
@@ -1107,8 +1137,9 @@ func (b *executorBuilder) buildPlanReplayer(v *plannercore.PlanReplayer) exec.Ex
 		e := &PlanReplayerExec{
 			BaseExecutor: exec.NewBaseExecutor(b.ctx, nil, v.ID()),
 			CaptureInfo: &PlanReplayerCaptureInfo{
-				SQLDigest:  v.SQLDigest,
-				PlanDigest: v.PlanDigest,
+				SQLDigest:    v.SQLDigest,
+				PlanDigest:   v.PlanDigest,
+				CaptureLimit: v.CaptureLimit,
 			},
 		}
 		return e
@@ -1235,9 +1266,15 @@ func (b *executorBuilder) buildSelectInto(v *plannercore.SelectInto) exec.Execut
 	if b.err != nil {
 		return nil
 	}
+	columnNames := make([]string, 0, len(v.TargetNames))
+	for _, name := range v.TargetNames {
+		columnNames = append(columnNames, name.ColName.O)
+	}
 	return &SelectIntoExec{
 		BaseExecutor:   exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID(), child),
 		intoOpt:        v.IntoOpt,
+		format:         v.Format,
+		columnNames:    columnNames,
 		LineFieldsInfo: v.LineFieldsInfo,
 	}
 }
@@ -1812,6 +1849,45 @@ func (b *executorBuilder) buildTableDual(v *plannercore.PhysicalTableDual) exec.
 	return e
 }
 
+func (b *executorBuilder) buildJSONTable(v *plannercore.PhysicalJSONTable) exec.Executor {
+	e := &JSONTableExec{
+		BaseExecutor:    exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		expr:            v.Expr,
+		root:            v.Root,
+		alias:           v.Alias,
+		pushedDownConds: v.PushedDownConds,
+		colIdx:          make(map[*plannercore.JSONTableColumn]int, v.Schema().Len()),
+	}
+	var collect func(path *plannercore.JSONTablePath)
+	collect = func(path *plannercore.JSONTablePath) {
+		for _, col := range path.Columns {
+			if idx := v.Schema().ColumnIndex(col.Col); idx != -1 {
+				e.colIdx[col] = idx
+			}
+		}
+		for _, nested := range path.Nested {
+			collect(nested)
+		}
+	}
+	collect(v.Root)
+	return e
+}
+
+func (b *executorBuilder) buildLooseIndexScan(v *plannercore.PhysicalLooseIndexScan) exec.Executor {
+	snapshot, err := b.getSnapshot()
+	if err != nil {
+		b.err = err
+		return nil
+	}
+	return &LooseIndexScanExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		snapshot:     snapshot,
+		table:        v.IndexScan.Table,
+		index:        v.IndexScan.Index,
+		plan:         v,
+	}
+}
+
 // `getSnapshotTS` returns for-update-ts if in insert/update/delete/lock statement otherwise the isolation read ts
 // Please notice that in RC isolation, the above two ts are the same
 func (b *executorBuilder) getSnapshotTS() (ts uint64, err error) {
@@ -1858,11 +1934,28 @@ func (b *executorBuilder) getSnapshot() (kv.Snapshot, error) {
 			},
 		})
 	}
+	// the snapshot of an explicit transaction is shared by the statements, so the labels of the read_locality hint
+	// are only passed to the coprocessor requests in it.
+	if labels := sessVars.StmtCtx.ReadLocalityLabels; len(labels) > 0 && !sessVars.InTxn() {
+		snapshot.SetOption(kv.MatchStoreLabels, labels)
+	}
 
 	return snapshot, nil
 }
 
 func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) exec.Executor {
+	if v.Table.IsExternal() {
+		return &MemTableReaderExec{
+			BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+			table:        v.Table,
+			retriever: &externalTableRetriever{
+				table:      v.Table,
+				outputCols: v.Columns,
+				schema:     v.Schema(),
+				extractor:  v.Extractor.(*plannercore.ExternalTableExtractor),
+			},
+		}
+	}
 	switch v.DBName.L {
 	case util.MetricSchemaName.L:
 		return &MemTableReaderExec{
@@ -1988,6 +2081,8 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) exec.Ex
 			strings.ToLower(infoschema.TableTables),
 			strings.ToLower(infoschema.TableReferConst),
 			strings.ToLower(infoschema.TableSequences),
+			strings.ToLower(infoschema.TableTiDBSequenceCache),
+			strings.ToLower(infoschema.TableTiDBTTLTableBacklog),
 			strings.ToLower(infoschema.TablePartitions),
 			strings.ToLower(infoschema.TableEngines),
 			strings.ToLower(infoschema.TableCollations),
@@ -2027,7 +2122,16 @@ func (b *executorBuilder) buildMemTable(v *plannercore.PhysicalMemTable) exec.Ex
 			strings.ToLower(infoschema.TableTiDBCheckConstraints),
 			strings.ToLower(infoschema.TableKeywords),
 			strings.ToLower(infoschema.TableTiDBIndexUsage),
-			strings.ToLower(infoschema.ClusterTableTiDBIndexUsage):
+			strings.ToLower(infoschema.ClusterTableTiDBIndexUsage),
+			strings.ToLower(infoschema.TablePlanRegressionEvents),
+			strings.ToLower(infoschema.ClusterTablePlanRegressionEvents),
+			strings.ToLower(infoschema.TableTiDBTableCacheAdvice),
+			strings.ToLower(infoschema.ClusterTableTiDBTableCacheAdvice),
+			strings.ToLower(infoschema.TableTiDBHotRegionSplitAdvice),
+			strings.ToLower(infoschema.ClusterTableTiDBHotRegionSplitAdvice),
+			strings.ToLower(infoschema.TableTableAccessStats),
+			strings.ToLower(infoschema.TableTiDBDropIndexAdvice),
+			strings.ToLower(infoschema.TableOptimizerHints):
 			memTracker := memory.NewTracker(v.ID(), -1)
 			memTracker.AttachTo(b.ctx.GetSessionVars().StmtCtx.MemTracker)
 			return &MemTableReaderExec{
@@ -3048,6 +3152,7 @@ func (b *executorBuilder) buildIndexLookUpJoin(v *plannercore.PhysicalIndexJoin)
 		IndexRanges:   v.Ranges,
 		KeyOff2IdxOff: v.KeyOff2IdxOff,
 		LastColHelper: v.CompareFilters,
+		PrefetchTasks: v.PrefetchTasks,
 		Finished:      &atomic.Value{},
 	}
 	colsFromChildren := v.Schema().Columns
@@ -3290,6 +3395,8 @@ func (b *executorBuilder) buildMPPGather(v *plannercore.PhysicalTableReader) exe
 		mppQueryID:   kv.MPPQueryID{QueryTs: getMPPQueryTS(b.ctx), LocalQueryID: getMPPQueryID(b.ctx), ServerID: domain.GetDomain(b.ctx).ServerID()},
 		memTracker:   memory.NewTracker(v.ID(), -1),
 
+		waitTiFlashReplica: b.newTiFlashReplicaWaiter(v),
+
 		columns:                    []*model.ColumnInfo{},
 		virtualColumnIndex:         []int{},
 		virtualColumnRetFieldTypes: []*types.FieldType{},
@@ -3344,6 +3451,44 @@ func (b *executorBuilder) buildMPPGather(v *plannercore.PhysicalTableReader) exe
 	return gather
 }
 
+// newTiFlashReplicaWaiter returns a function that waits for the tiflash replicas read by the table reader to become
+// available when tidb_read_from_storage_fallback_policy is WAIT, and fails with ErrTiFlashReplicaUnavailable after
+// the timeout. It returns nil if there is nothing to wait for.
+func (b *executorBuilder) newTiFlashReplicaWaiter(v *plannercore.PhysicalTableReader) func(ctx context.Context) error {
+	sessVars := b.ctx.GetSessionVars()
+	if v.StoreType != kv.TiFlash || sessVars.ReadFromStorageFallbackPolicy != variable.ReadFromStorageFallbackWait {
+		return nil
+	}
+	dom := domain.GetDomain(b.ctx)
+	tableScans := v.GetTableScans()
+	return func(ctx context.Context) error {
+		const checkInterval = 100 * time.Millisecond
+		deadline := time.Now().Add(sessVars.ReadFromStorageFallbackWaitTimeout)
+		for _, ts := range tableScans {
+			for {
+				// The availability of the tiflash replica is updated in the latest schema rather than the one of the statement.
+				tbl, ok := dom.InfoSchema().TableByID(ts.Table.ID)
+				if ok && tbl.Meta().TiFlashReplica != nil && tbl.Meta().TiFlashReplica.Available {
+					break
+				}
+				if !ok || tbl.Meta().TiFlashReplica == nil || time.Now().Add(checkInterval).After(deadline) {
+					return plannererrors.ErrTiFlashReplicaUnavailable.GenWithStackByArgs(
+						ts.DBName.O, ts.Table.Name.O, sessVars.GetIsolationReadEngines())
+				}
+				if err := sessVars.SQLKiller.HandleSignal(); err != nil {
+					return err
+				}
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(checkInterval):
+				}
+			}
+		}
+		return nil
+	}
+}
+
 // buildTableReader builds a table reader executor. It first build a no range table reader,
 // and then update it ranges from table scan plan.
 func (b *executorBuilder) buildTableReader(v *plannercore.PhysicalTableReader) exec.Executor {
@@ -3386,6 +3531,8 @@ func (b *executorBuilder) buildTableReader(v *plannercore.PhysicalTableReader) e
 		b.err = err
 		return nil
 	}
+	ret.reoptChecker = b.newReoptimizeChecker(v, v.TablePlans)
+	ret.waitTiFlashReplica = b.newTiFlashReplicaWaiter(v)
 
 	if ret.table.Meta().TempTableType != model.TempTableNone {
 		ret.dummy = true
@@ -3619,6 +3766,7 @@ func (b *executorBuilder) buildIndexReader(v *plannercore.PhysicalIndexReader) e
 		b.err = err
 		return nil
 	}
+	ret.reoptChecker = b.newReoptimizeChecker(v, v.IndexPlans)
 
 	if ret.table.Meta().TempTableType != model.TempTableNone {
 		ret.dummy = true
@@ -3804,6 +3952,9 @@ func (b *executorBuilder) buildIndexLookUpReader(v *plannercore.PhysicalIndexLoo
 		b.err = err
 		return nil
 	}
+	if v.PushedLimit == nil {
+		ret.reoptChecker = b.newReoptimizeChecker(v, append(slices.Clone(v.IndexPlans), v.TablePlans...))
+	}
 
 	if ret.table.Meta().TempTableType != model.TempTableNone {
 		ret.dummy = true
@@ -4709,7 +4860,8 @@ func (b *executorBuilder) buildWindow(v *plannercore.PhysicalWindow) exec.Execut
 	}
 
 	var err error
-	if b.ctx.GetSessionVars().EnablePipelinedWindowExec {
+	// The pipelined window executor always slides the frame, it can't exclude the rows from the frame.
+	if b.ctx.GetSessionVars().EnablePipelinedWindowExec && (v.Frame == nil || v.Frame.Exclusion == ast.ExcludeNoOthers) {
 		exec := &PipelinedWindowExec{
 			BaseExecutor:   base,
 			groupChecker:   vecgroupchecker.NewVecGroupChecker(b.ctx.GetExprCtx().GetEvalCtx(), b.ctx.GetSessionVars().EnableVectorizedExpression, groupByItems),
@@ -4762,6 +4914,7 @@ func (b *executorBuilder) buildWindow(v *plannercore.PhysicalWindow) exec.Execut
 			partialResults: partialResults,
 			start:          v.Frame.Start,
 			end:            v.Frame.End,
+			excluder:       newFrameExcluder(v.Frame.Exclusion, orderByCols),
 		}
 	} else {
 		cmpResult := int64(-1)
@@ -4775,6 +4928,7 @@ func (b *executorBuilder) buildWindow(v *plannercore.PhysicalWindow) exec.Execut
 			end:               v.Frame.End,
 			orderByCols:       orderByCols,
 			expectedCmpResult: cmpResult,
+			excluder:          newFrameExcluder(v.Frame.Exclusion, orderByCols),
 		}
 
 		err = tmpProcessor.start.UpdateCompareCols(b.ctx, orderByCols)
@@ -5158,6 +5312,10 @@ func (*emptySampler) finished() bool {
 	return true
 }
 
+func (*emptySampler) close() error {
+	return nil
+}
+
 func (b *executorBuilder) buildTableSample(v *plannercore.PhysicalTableSample) *TableSampleExecutor {
 	startTS, err := b.getSnapshotTS()
 	if err != nil {
@@ -5181,6 +5339,22 @@ func (b *executorBuilder) buildTableSample(v *plannercore.PhysicalTableSample) *
 		e.sampler = newTableRegionSampler(
 			b.ctx, v.TableInfo, startTS, v.PhysicalTableID, v.TableSampleInfo.Partitions, v.Schema(),
 			v.TableSampleInfo.FullSchema, e.RetFieldTypes(), v.Desc)
+	} else if rate, ok := v.TableSampleInfo.SampleRate(); ok && v.Index != nil {
+		e.sampler = &indexSystemSampler{
+			tableRegionSampler: newTableRegionSampler(
+				b.ctx, v.TableInfo, startTS, v.PhysicalTableID, v.TableSampleInfo.Partitions, v.Schema(),
+				v.TableSampleInfo.FullSchema, e.RetFieldTypes(), v.Desc),
+			rate:   rate,
+			index:  v.Index,
+			ranges: v.Ranges,
+		}
+	} else if rate, ok := v.TableSampleInfo.SampleRate(); ok {
+		e.sampler = &tableSystemSampler{
+			tableRegionSampler: newTableRegionSampler(
+				b.ctx, v.TableInfo, startTS, v.PhysicalTableID, v.TableSampleInfo.Partitions, v.Schema(),
+				v.TableSampleInfo.FullSchema, e.RetFieldTypes(), v.Desc),
+			rate: rate,
+		}
 	}
 
 	return e
@@ -5255,6 +5429,7 @@ func (b *executorBuilder) buildCTE(v *plannercore.PhysicalCTE) exec.Executor {
 
 		producer = &cteProducer{
 			ctx:             b.ctx,
+			name:            v.CTE.Name.O,
 			seedExec:        seedExec,
 			recursiveExec:   recursiveExec,
 			resTbl:          resTbl,
@@ -5272,6 +5447,7 @@ func (b *executorBuilder) buildCTE(v *plannercore.PhysicalCTE) exec.Executor {
 
 	return &CTEExec{
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		resIter:      cteutil.NewStorageIter(producer.resTbl),
 		producer:     producer,
 	}
 }
@@ -5290,7 +5466,7 @@ func (b *executorBuilder) buildCTETableReader(v *plannercore.PhysicalCTETable) e
 	return &CTETableReaderExec{
 		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
 		iterInTbl:    storages.IterInTbl,
-		chkIdx:       0,
+		iter:         cteutil.NewStorageIter(storages.IterInTbl),
 	}
 }
 func (b *executorBuilder) validCanReadTemporaryOrCacheTable(tbl *model.TableInfo) error {
@@ -5401,6 +5577,30 @@ func (b *executorBuilder) buildCompactTable(v *plannercore.CompactTable) exec.Ex
 	}
 }
 
+func (b *executorBuilder) buildAdminRecommendHints(v *plannercore.AdminRecommendHints) exec.Executor {
+	return &AdminRecommendHintsExec{
+		BaseExecutor:  exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		hints:         v.Hints,
+		createBinding: v.CreateBinding,
+	}
+}
+
+func (b *executorBuilder) buildAdminRecommendDropIndex(v *plannercore.AdminRecommendDropIndex) exec.Executor {
+	return &AdminRecommendDropIndexExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		is:           b.is,
+		tables:       v.Tables,
+	}
+}
+
+func (b *executorBuilder) buildAdminShowDiagnostics(v *plannercore.AdminShowDiagnostics) exec.Executor {
+	return &AdminShowDiagnosticsExec{
+		BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID()),
+		sql:          v.SQL,
+		digest:       v.Digest,
+	}
+}
+
 func (b *executorBuilder) buildAdminShowBDRRole(v *plannercore.AdminShowBDRRole) exec.Executor {
 	return &AdminShowBDRRoleExec{BaseExecutor: exec.NewBaseExecutor(b.ctx, v.Schema(), v.ID())}
 }
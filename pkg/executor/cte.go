@@ -70,7 +70,7 @@ var _ exec.Executor = &CTEExec{}
 type CTEExec struct {
 	exec.BaseExecutor
 
-	chkIdx   int
+	resIter  *cteutil.StorageIter
 	producer *cteProducer
 
 	// limit in recursive CTE.
@@ -154,7 +154,7 @@ func (e *CTEExec) Close() (firstErr error) {
 }
 
 func (e *CTEExec) reset() {
-	e.chkIdx = 0
+	e.resIter.Reset()
 	e.cursor = 0
 	e.meetFirstBatch = false
 }
@@ -172,6 +172,9 @@ type cteProducer struct {
 
 	ctx sessionctx.Context
 
+	// name of the CTE, used to identify it in errors.
+	name string
+
 	seedExec      exec.Executor
 	recursiveExec exec.Executor
 
@@ -280,28 +283,23 @@ func (p *cteProducer) getChunk(cteExec *CTEExec, req *chunk.Chunk) (err error) {
 	if p.hasLimit {
 		return p.nextChunkLimit(cteExec, req)
 	}
-	if cteExec.chkIdx < p.resTbl.NumChunks() {
-		res, err := p.resTbl.GetChunk(cteExec.chkIdx)
-		if err != nil {
-			return err
-		}
-		// Need to copy chunk to make sure upper operator will not change chunk in resTbl.
-		// Also we ignore copying rows not selected, because some operators like Projection
-		// doesn't support swap column if chunk.sel is no nil.
-		req.SwapColumns(res.CopyConstructSel())
-		cteExec.chkIdx++
+	// Read resTbl page by page, so only the chunk being returned is loaded
+	// into memory when resTbl has been spilled to disk.
+	res, err := cteExec.resIter.Next()
+	if err != nil || res == nil {
+		return err
 	}
+	req.SwapColumns(res)
 	return nil
 }
 
 func (p *cteProducer) nextChunkLimit(cteExec *CTEExec, req *chunk.Chunk) error {
 	if !cteExec.meetFirstBatch {
-		for cteExec.chkIdx < p.resTbl.NumChunks() {
-			res, err := p.resTbl.GetChunk(cteExec.chkIdx)
-			if err != nil {
+		for {
+			res, err := cteExec.resIter.Next()
+			if err != nil || res == nil {
 				return err
 			}
-			cteExec.chkIdx++
 			numRows := uint64(res.NumRows())
 			if newCursor := cteExec.cursor + numRows; newCursor >= p.limitBeg {
 				cteExec.meetFirstBatch = true
@@ -313,25 +311,23 @@ func (p *cteProducer) nextChunkLimit(cteExec *CTEExec, req *chunk.Chunk) error {
 				if begInChk == endInChk {
 					break
 				}
-				tmpChk := res.CopyConstructSel()
-				req.Append(tmpChk, int(begInChk), int(endInChk))
+				req.Append(res, int(begInChk), int(endInChk))
 				return nil
 			}
 			cteExec.cursor += numRows
 		}
 	}
-	if cteExec.chkIdx < p.resTbl.NumChunks() && cteExec.cursor < p.limitEnd {
-		res, err := p.resTbl.GetChunk(cteExec.chkIdx)
-		if err != nil {
+	if cteExec.cursor < p.limitEnd {
+		res, err := cteExec.resIter.Next()
+		if err != nil || res == nil {
 			return err
 		}
-		cteExec.chkIdx++
 		numRows := uint64(res.NumRows())
 		if cteExec.cursor+numRows > p.limitEnd {
 			numRows = p.limitEnd - cteExec.cursor
-			req.Append(res.CopyConstructSel(), 0, int(numRows))
+			req.Append(res, 0, int(numRows))
 		} else {
-			req.SwapColumns(res.CopyConstructSel())
+			req.SwapColumns(res)
 		}
 		cteExec.cursor += numRows
 	}
@@ -422,7 +418,7 @@ func (p *cteProducer) computeRecursivePart(ctx context.Context) (err error) {
 	}
 
 	if p.curIter > p.ctx.GetSessionVars().CTEMaxRecursionDepth {
-		return exeerrors.ErrCTEMaxRecursionDepth.GenWithStackByArgs(p.curIter)
+		return exeerrors.ErrCTEMaxRecursionDepth.GenWithStackByArgs(p.name, p.curIter)
 	}
 
 	if p.limitDone(p.resTbl) {
@@ -441,6 +437,9 @@ func (p *cteProducer) computeRecursivePart(ctx context.Context) (err error) {
 			if p.limitDone(p.resTbl) {
 				break
 			}
+			if maxRows := p.ctx.GetSessionVars().CTEMaxRecursionRows; maxRows > 0 && int64(p.resTbl.NumRows()) > maxRows {
+				return exeerrors.ErrCTEMaxRecursionRows.GenWithStackByArgs(p.name, p.resTbl.NumRows())
+			}
 			if p.iterInTbl.NumChunks() == 0 {
 				break
 			}
@@ -448,7 +447,7 @@ func (p *cteProducer) computeRecursivePart(ctx context.Context) (err error) {
 			p.curIter++
 			p.iterInTbl.SetIter(p.curIter)
 			if p.curIter > p.ctx.GetSessionVars().CTEMaxRecursionDepth {
-				return exeerrors.ErrCTEMaxRecursionDepth.GenWithStackByArgs(p.curIter)
+				return exeerrors.ErrCTEMaxRecursionDepth.GenWithStackByArgs(p.name, p.curIter)
 			}
 			// Make sure iterInTbl is setup before Close/Open,
 			// because some executors will read iterInTbl in Open() (like IndexLookupJoin).
@@ -564,6 +563,7 @@ func setupCTEStorageTracker(tbl cteutil.Storage, ctx sessionctx.Context, parentM
 			}
 		})
 		ctx.GetSessionVars().MemTracker.FallbackOldAndSetNewAction(actionSpill)
+		memory.SetOperatorQuota(memTracker, ctx.GetSessionVars().MemQuotaOperator, actionSpill)
 	}
 	return actionSpill
 }
@@ -28,7 +28,7 @@ type CTETableReaderExec struct {
 	exec.BaseExecutor
 
 	iterInTbl cteutil.Storage
-	chkIdx    int
+	iter      *cteutil.StorageIter
 	curIter   int
 }
 
@@ -43,25 +43,21 @@ func (e *CTETableReaderExec) Next(_ context.Context, req *chunk.Chunk) (err erro
 	req.Reset()
 
 	// We should read `iterInTbl` from the beginning when the next iteration starts.
-	// Can not directly judge whether to start the next iteration based on e.chkIdx,
+	// Can not directly judge whether to start the next iteration based on e.iter,
 	// because some operators(Selection) may use for loop to read all data in `iterInTbl`.
 	if e.curIter != e.iterInTbl.GetIter() {
 		if e.curIter > e.iterInTbl.GetIter() {
 			return errors.Errorf("invalid iteration for CTETableReaderExec (e.curIter: %d, e.iterInTbl.GetIter(): %d)",
 				e.curIter, e.iterInTbl.GetIter())
 		}
-		e.chkIdx = 0
+		e.iter.Reset()
 		e.curIter = e.iterInTbl.GetIter()
 	}
-	if e.chkIdx < e.iterInTbl.NumChunks() {
-		res, err := e.iterInTbl.GetChunk(e.chkIdx)
-		if err != nil {
-			return err
-		}
-		// Need to copy chunk to make sure upper operators will not change chunk in iterInTbl.
-		req.SwapColumns(res.CopyConstructSel())
-		e.chkIdx++
+	res, err := e.iter.Next()
+	if err != nil || res == nil {
+		return err
 	}
+	req.SwapColumns(res)
 	return nil
 }
 
@@ -72,6 +68,6 @@ func (e *CTETableReaderExec) Close() (err error) {
 }
 
 func (e *CTETableReaderExec) reset() {
-	e.chkIdx = 0
+	e.iter.Reset()
 	e.curIter = 0
 }
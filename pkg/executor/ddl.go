@@ -215,6 +215,14 @@ func (e *DDLExec) Next(ctx context.Context, _ *chunk.Chunk) (err error) {
 		err = e.executeDropResourceGroup(x)
 	case *ast.AlterResourceGroupStmt:
 		err = e.executeAlterResourceGroup(x)
+	case *ast.CreateRowPolicyStmt:
+		err = e.executeCreateRowPolicy(x)
+	case *ast.DropRowPolicyStmt:
+		err = e.executeDropRowPolicy(x)
+	case *ast.CreateMaskingPolicyStmt:
+		err = e.executeCreateMaskingPolicy(x)
+	case *ast.DropMaskingPolicyStmt:
+		err = e.executeDropMaskingPolicy(x)
 	}
 	if err != nil {
 		// If the owner return ErrTableNotExists error when running this DDL, it may be caused by schema changed,
@@ -385,7 +393,34 @@ func (e *DDLExec) executeAlterTable(ctx context.Context, s *ast.AlterTableStmt)
 		return dbterror.ErrUnsupportedLocalTempTableDDL.GenWithStackByArgs("ALTER TABLE")
 	}
 
-	return domain.GetDomain(e.Ctx()).DDL().AlterTable(ctx, e.Ctx(), s)
+	dom := domain.GetDomain(e.Ctx())
+	if err := dom.DDL().AlterTable(ctx, e.Ctx(), s); err != nil {
+		return err
+	}
+	e.renameIndexInBindings(dom, s)
+	return nil
+}
+
+// renameIndexInBindings rewrites the global bindings which reference the renamed indexes, otherwise the hints of the
+// bindings can't find the indexes any more and the bindings silently become useless.
+func (e *DDLExec) renameIndexInBindings(dom *domain.Domain, s *ast.AlterTableStmt) {
+	bindHandle := dom.BindHandle()
+	if bindHandle == nil {
+		return
+	}
+	schema := s.Table.Schema.O
+	if schema == "" {
+		schema = e.Ctx().GetSessionVars().CurrentDB
+	}
+	for _, spec := range s.Specs {
+		if spec.Tp != ast.AlterTableRenameIndex || spec.FromKey.L == spec.ToKey.L {
+			continue
+		}
+		if _, err := bindHandle.RenameIndexInGlobalBindings(schema, s.Table.Name.O, spec.FromKey.O, spec.ToKey.O); err != nil {
+			logutil.BgLogger().Warn("rename index in global bindings failed", zap.String("schema", schema),
+				zap.String("table", s.Table.Name.O), zap.String("index", spec.FromKey.O), zap.Error(err))
+		}
+	}
 }
 
 // executeRecoverTable represents a recover table executor.
@@ -774,3 +809,19 @@ func (e *DDLExec) executeDropResourceGroup(s *ast.DropResourceGroupStmt) error {
 	}
 	return domain.GetDomain(e.Ctx()).DDL().DropResourceGroup(e.Ctx(), s)
 }
+
+func (e *DDLExec) executeCreateRowPolicy(s *ast.CreateRowPolicyStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().CreateRowPolicy(e.Ctx(), s)
+}
+
+func (e *DDLExec) executeDropRowPolicy(s *ast.DropRowPolicyStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().DropRowPolicy(e.Ctx(), s)
+}
+
+func (e *DDLExec) executeCreateMaskingPolicy(s *ast.CreateMaskingPolicyStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().CreateMaskingPolicy(e.Ctx(), s)
+}
+
+func (e *DDLExec) executeDropMaskingPolicy(s *ast.DropMaskingPolicyStmt) error {
+	return domain.GetDomain(e.Ctx()).DDL().DropMaskingPolicy(e.Ctx(), s)
+}
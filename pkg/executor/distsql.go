@@ -214,6 +214,8 @@ type IndexReaderExecutor struct {
 	// If dummy flag is set, this is not a real IndexReader, it just provides the KV ranges for UnionScan.
 	// Used by the temporary table, cached table.
 	dummy bool
+
+	reoptChecker *reoptimizeChecker
 }
 
 // Table implements the dataSourceExecutor interface.
@@ -249,7 +251,14 @@ func (e *IndexReaderExecutor) Next(ctx context.Context, req *chunk.Chunk) error
 		return nil
 	}
 
-	return e.result.Next(ctx, req)
+	if e.RuntimeStats() != nil {
+		start := time.Now()
+		defer func() { e.RuntimeStats().RecordNetworkWait(time.Since(start)) }()
+	}
+	if err := e.result.Next(ctx, req); err != nil {
+		return err
+	}
+	return e.reoptChecker.check(req.NumRows())
 }
 
 // TODO: cleanup this method.
@@ -268,6 +277,7 @@ func (e *IndexReaderExecutor) buildKeyRanges(dctx *distsqlctx.DistSQLContext, ra
 
 // Open implements the Executor Open interface.
 func (e *IndexReaderExecutor) Open(ctx context.Context) error {
+	e.reoptChecker.reset()
 	var err error
 	if e.corColInAccess {
 		e.ranges, err = rebuildIndexRanges(e.Ctx(), e.plans[0].(*plannercore.PhysicalIndexScan), e.idxCols, e.colLens)
@@ -453,6 +463,8 @@ type IndexLookUpExecutor struct {
 	// If dummy flag is set, this is not a real IndexLookUpReader, it just provides the KV ranges for UnionScan.
 	// Used by the temporary table, cached table.
 	dummy bool
+
+	reoptChecker *reoptimizeChecker
 }
 
 type getHandleType int8
@@ -479,6 +491,7 @@ func (e *IndexLookUpExecutor) setDummy() {
 
 // Open implements the Executor Open interface.
 func (e *IndexLookUpExecutor) Open(ctx context.Context) error {
+	e.reoptChecker.reset()
 	var err error
 	if e.corColInAccess {
 		e.ranges, err = rebuildIndexRanges(e.Ctx(), e.idxPlans[0].(*plannercore.PhysicalIndexScan), e.idxCols, e.colLens)
@@ -568,9 +581,11 @@ func (e *IndexLookUpExecutor) open(_ context.Context) error {
 func (e *IndexLookUpExecutor) startWorkers(ctx context.Context, initBatchSize int) error {
 	// indexWorker will write to workCh and tableWorker will read from workCh,
 	// so fetching index and getting table data can run concurrently.
+	// The extra capacity of workCh allows indexWorker to prefetch some tasks ahead of tableWorker,
+	// and indexWorker is blocked once both workCh and resultCh are full.
 	ctx, cancel := context.WithCancel(ctx)
 	e.cancelFunc = cancel
-	workCh := make(chan *lookupTableTask, 1)
+	workCh := make(chan *lookupTableTask, 1+e.Ctx().GetSessionVars().IndexLookupPrefetchTasks)
 	if err := e.startIndexWorker(ctx, workCh, initBatchSize); err != nil {
 		return err
 	}
@@ -849,14 +864,14 @@ func (e *IndexLookUpExecutor) Next(ctx context.Context, req *chunk.Chunk) error
 			return err
 		}
 		if resultTask == nil {
-			return nil
+			return e.reoptChecker.check(req.NumRows())
 		}
 		if resultTask.cursor < len(resultTask.rows) {
 			numToAppend := min(len(resultTask.rows)-resultTask.cursor, req.RequiredRows()-req.NumRows())
 			req.AppendRows(resultTask.rows[resultTask.cursor : resultTask.cursor+numToAppend])
 			resultTask.cursor += numToAppend
 			if req.IsFull() {
-				return nil
+				return e.reoptChecker.check(req.NumRows())
 			}
 		}
 	}
@@ -906,6 +921,7 @@ func (e *IndexLookUpExecutor) initRuntimeStats() {
 		e.stats = &IndexLookUpRunTimeStats{
 			indexScanBasicStats: &execdetails.BasicRuntimeStats{},
 			Concurrency:         e.Ctx().GetSessionVars().IndexLookupConcurrency(),
+			PrefetchTasks:       e.Ctx().GetSessionVars().IndexLookupPrefetchTasks,
 		}
 	}
 }
@@ -1228,6 +1244,7 @@ type IndexLookUpRunTimeStats struct {
 	TableRowScan        int64
 	TableTaskNum        int64
 	Concurrency         int
+	PrefetchTasks       int
 	// Record the `Next` call affected wait duration details.
 	NextWaitIndexScan        time.Duration
 	NextWaitTableLookUpBuild time.Duration
@@ -1253,7 +1270,11 @@ func (e *IndexLookUpRunTimeStats) String() string {
 		if buf.Len() > 0 {
 			buf.WriteByte(',')
 		}
-		buf.WriteString(fmt.Sprintf(" table_task: {total_time: %v, num: %d, concurrency: %d}", execdetails.FormatDuration(time.Duration(tableScan)), tableTaskNum, concurrency))
+		buf.WriteString(fmt.Sprintf(" table_task: {total_time: %v, num: %d, concurrency: %d", execdetails.FormatDuration(time.Duration(tableScan)), tableTaskNum, concurrency))
+		if e.PrefetchTasks > 0 {
+			buf.WriteString(fmt.Sprintf(", prefetch: %d", e.PrefetchTasks))
+		}
+		buf.WriteByte('}')
 	}
 	if e.NextWaitIndexScan > 0 || e.NextWaitTableLookUpBuild > 0 || e.NextWaitTableLookUpResp > 0 {
 		if buf.Len() > 0 {
@@ -27,12 +27,15 @@ import (
 	"time"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/kvproto/pkg/metapb"
 	"github.com/pingcap/tidb/pkg/executor"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/metrics"
 	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/pkg/store/copr"
 	"github.com/pingcap/tidb/pkg/store/mockstore"
+	"github.com/pingcap/tidb/pkg/store/mockstore/unistore"
 	"github.com/pingcap/tidb/pkg/table/tables"
 	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/testkit"
@@ -260,6 +263,80 @@ func TestIndexLookUpStats(t *testing.T) {
 	require.Equal(t, "index_task: {total_time: 10s, fetch_handle: 4s, build: 2s, wait: 4s}"+
 		", table_task: {total_time: 4s, num: 4, concurrency: 1}"+
 		", next: {wait_index: 2s, wait_table_lookup_build: 4s, wait_table_lookup_resp: 6s}", stats.String())
+
+	stats.PrefetchTasks = 4
+	require.Equal(t, "index_task: {total_time: 10s, fetch_handle: 4s, build: 2s, wait: 4s}"+
+		", table_task: {total_time: 4s, num: 4, concurrency: 1, prefetch: 4}"+
+		", next: {wait_index: 2s, wait_table_lookup_build: 4s, wait_table_lookup_resp: 6s}", stats.String())
+}
+
+func TestIndexLookUpPrefetch(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int, b int, key(a))")
+	tk.MustExec("create table t2 (a int, b int, key(a))")
+	values := make([]string, 0, 200)
+	for i := 0; i < 200; i++ {
+		values = append(values, fmt.Sprintf("(%d, %d)", i, i))
+	}
+	tk.MustExec("insert into t1 values " + strings.Join(values, ","))
+	tk.MustExec("insert into t2 values " + strings.Join(values, ","))
+	tk.MustExec("set @@tidb_index_lookup_size = 10")
+	tk.MustExec("set @@tidb_index_join_batch_size = 10")
+
+	lookup := "select /*+ use_index(t1, a) */ * from t1 where a > 50"
+	join := "select /*+ inl_join(t2) */ t1.a, t2.b from t1, t2 where t1.a = t2.a and t1.b < 100"
+	hashJoin := "select /*+ inl_hash_join(t2) */ t1.a, t2.b from t1, t2 where t1.a = t2.a and t1.b < 100"
+	expected := make([][][]any, 0, 3)
+	for _, sql := range []string{lookup, join, hashJoin} {
+		expected = append(expected, tk.MustQuery(sql).Sort().Rows())
+	}
+	tk.MustExec("set @@tidb_index_lookup_prefetch_tasks = 4")
+	for i, sql := range []string{lookup, join, hashJoin} {
+		tk.MustQuery(sql).Sort().Check(expected[i])
+	}
+	rows := tk.MustQuery("explain analyze " + lookup).Rows()
+	require.Contains(t, rows[0][5], "prefetch: 4")
+	rows = tk.MustQuery("explain analyze " + join).Rows()
+	require.Contains(t, rows[0][5], "prefetch:4")
+}
+
+func TestIndexJoinPrefetchHint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1 (a int, b int, key(a))")
+	tk.MustExec("create table t2 (a int, b int, key(a))")
+	values := make([]string, 0, 200)
+	for i := 0; i < 200; i++ {
+		values = append(values, fmt.Sprintf("(%d, %d)", i, i))
+	}
+	tk.MustExec("insert into t1 values " + strings.Join(values, ","))
+	tk.MustExec("insert into t2 values " + strings.Join(values, ","))
+	tk.MustExec("set @@tidb_index_join_batch_size = 10")
+
+	join := "select /*+ inl_join(t2) %s */ t1.a, t2.b from t1, t2 where t1.a = t2.a and t1.b < 100"
+	hashJoin := "select /*+ inl_hash_join(t2) %s */ t1.a, t2.b from t1, t2 where t1.a = t2.a and t1.b < 100"
+	for _, sql := range []string{join, hashJoin} {
+		expected := tk.MustQuery(fmt.Sprintf(sql, "")).Sort().Rows()
+		hinted := fmt.Sprintf(sql, "inl_join_prefetch(t2, 8)")
+		tk.MustQuery(hinted).Sort().Check(expected)
+		require.Empty(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings())
+		rows := tk.MustQuery("explain analyze " + hinted).Rows()
+		require.Contains(t, rows[0][5], "prefetch:8, prefetch_hit:")
+
+		// The hint takes precedence over the variable.
+		tk.MustExec("set @@tidb_index_lookup_prefetch_tasks = 4")
+		rows = tk.MustQuery("explain analyze " + hinted).Rows()
+		require.Contains(t, rows[0][5], "prefetch:8, prefetch_hit:")
+		tk.MustExec("set @@tidb_index_lookup_prefetch_tasks = default")
+	}
+
+	tk.MustQuery(fmt.Sprintf(join, "inl_join_prefetch(t3, 8)"))
+	tk.MustQuery("show warnings").CheckContain("There are no matching table names for (t3) in optimizer hint /*+ INL_JOIN_PREFETCH(t3, 8) */")
+	tk.MustQuery(fmt.Sprintf(join, "inl_join_prefetch(t2, 0)"))
+	tk.MustQuery("show warnings").CheckContain("The number of tasks 0 of the INL_JOIN_PREFETCH hint is out of range, it should be in [1, 256]")
 }
 
 func TestPartitionTableIndexJoinIndexLookUp(t *testing.T) {
@@ -452,6 +529,53 @@ func TestAdaptiveClosestRead(t *testing.T) {
 	checkMetrics("select/* +USE_INDEX_MERGE(t) */ id from t use index(`idx_v_s1`) use index(idx_s2) where (s1 < 3 and v > 0) or s2 = 3;", 3, 1)
 }
 
+func TestReadLocalityHint(t *testing.T) {
+	store := testkit.CreateMockStore(t, mockstore.WithClusterInspector(func(c testutils.Cluster) {
+		storeID, _, _ := mockstore.BootstrapWithSingleStore(c)
+		c.(*unistore.Cluster).AddStore(storeID, fmt.Sprintf("store%d", storeID),
+			&metapb.StoreLabel{Key: "region", Value: "eu"}, &metapb.StoreLabel{Key: "zone", Value: "z1"})
+	}))
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, v int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+
+	sc := func() *stmtctx.StatementContext {
+		return tk.Session().GetSessionVars().StmtCtx
+	}
+	// the replicas on the matched stores are preferred.
+	tk.MustQuery("select /*+ read_locality('region=eu, zone=z1') */ v from t order by id").Check(testkit.Rows("1", "2"))
+	require.Equal(t, []*metapb.StoreLabel{{Key: "region", Value: "eu"}, {Key: "zone", Value: "z1"}}, sc().ReadLocalityLabels)
+	require.Equal(t, kv.ReplicaReadMixed, tk.Session().GetSessionVars().GetReplicaRead())
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	tk.MustQuery("select /*+ read_locality('region=eu') read_consistent_replica() */ v from t where id = 1").Check(testkit.Rows("1"))
+	require.Equal(t, kv.ReplicaReadFollower, tk.Session().GetSessionVars().GetReplicaRead())
+
+	// no store matches the labels.
+	tk.MustQuery("select /*+ read_locality('region=us') */ v from t order by id").Check(testkit.Rows("1", "2"))
+	require.Empty(t, sc().ReadLocalityLabels)
+	require.Equal(t, kv.ReplicaReadLeader, tk.Session().GetSessionVars().GetReplicaRead())
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 8073 No store matches the labels 'region=us' of READ_LOCALITY(), read from any replica"))
+	tk.MustExec("set @@tidb_replica_read = 'follower'")
+	tk.MustQuery("select /*+ read_locality('region=eu, zone=z2', leader) */ v from t order by id").Check(testkit.Rows("1", "2"))
+	require.Equal(t, kv.ReplicaReadLeader, tk.Session().GetSessionVars().GetReplicaRead())
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 8073 No store matches the labels 'region=eu,zone=z2' of READ_LOCALITY(), read from the leader"))
+	tk.MustExec("set @@tidb_replica_read = 'leader'")
+	tk.MustGetErrMsg("select /*+ read_locality('region=us', error) */ v from t order by id",
+		"[executor:8190]No store matches the labels 'region=us' of the READ_LOCALITY hint")
+	tk.MustGetErrMsg("select /*+ read_locality('region=us', error) */ v from t where id = 1",
+		"[executor:8190]No store matches the labels 'region=us' of the READ_LOCALITY hint")
+
+	// invalid or conflicting hints are ignored.
+	tk.MustQuery("select /*+ read_locality('region') */ v from t where id = 1").Check(testkit.Rows("1"))
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 8073 The use of READ_LOCALITY hint is invalid, the label 'region' is not in the form of key=value. Valid usage: READ_LOCALITY('region=eu, zone=z1', ANY | LEADER | ERROR)"))
+	tk.MustQuery("select /*+ read_locality('region=eu', closest) */ v from t where id = 1").Check(testkit.Rows("1"))
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 8073 The use of READ_LOCALITY hint is invalid, the fallback policy 'closest' is unknown. Valid usage: READ_LOCALITY('region=eu, zone=z1', ANY | LEADER | ERROR)"))
+	tk.MustQuery("select /*+ read_locality('region=eu') hedged_read() */ v from t where id = 1").Check(testkit.Rows("1"))
+	require.Empty(t, sc().ReadLocalityLabels)
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 3126 READ_LOCALITY() is conflict with HEDGED_READ(), READ_LOCALITY() is ignored"))
+}
+
 func TestCoprocessorPagingReqKeyRangeSorted(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -0,0 +1,447 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/infoschema"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/privilege"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/sqlescape"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
+	"github.com/pingcap/tidb/pkg/util/tableaccess"
+)
+
+const (
+	// maxEvaluatedStmts is the max number of the affected statements evaluated for a DROP INDEX candidate.
+	maxEvaluatedStmts = 10
+
+	// dropIndexReasonUnused means the index is never accessed according to the table access stats.
+	dropIndexReasonUnused = "UNUSED"
+	// dropIndexReasonRedundant means the columns of the index are the leading columns of another index.
+	dropIndexReasonRedundant = "REDUNDANT"
+)
+
+// dropIndexAdvice is a DROP INDEX candidate made by the drop index advisor.
+type dropIndexAdvice struct {
+	Schema string
+	Table  string
+	Index  string
+	// Reasons are dropIndexReasonUnused, dropIndexReasonRedundant or both.
+	Reasons []string
+	// CoveringIndex is the index which makes the index redundant.
+	CoveringIndex  string
+	Scans          uint64
+	PointGets      uint64
+	LastAccessTime time.Time
+	// Digests are the statements in the statement summary whose plans use the index.
+	Digests []string
+	// CostIncrease is the max ratio of the estimated cost of the affected statements without the index to the one
+	// with the index, it's 0 if no statement is evaluated.
+	CostIncrease float64
+	// Unevaluated is the number of the affected statements which can't be evaluated.
+	Unevaluated int
+	// LoseUniqueness means the uniqueness constraint is removed with the index.
+	LoseUniqueness bool
+	RiskScore      int
+	DropSQL        string
+	Notes          []string
+}
+
+// adviseDropIndexes makes the DROP INDEX candidates of the tables, or all the tables the user has privileges on if
+// tables is empty. The candidates are the indexes never accessed and the indexes whose columns are the leading
+// columns of other indexes. The statements using the candidates are evaluated by comparing their estimated costs
+// with and without the index, and the risks of dropping the candidates are scored from them.
+func adviseDropIndexes(ctx context.Context, sctx sessionctx.Context, is infoschema.InfoSchema, tables []*ast.TableName) ([]*dropIndexAdvice, error) {
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	accessStats, err := tableaccess.GlobalCollector.Load(ctx, sctx.GetRestrictedSQLExecutor())
+	if err != nil {
+		return nil, err
+	}
+	var advices []*dropIndexAdvice
+	addTable := func(schema model.CIStr, tbl *model.TableInfo) {
+		referredFKs := is.GetTableReferredForeignKeys(schema.L, tbl.Name.L)
+		advices = append(advices, findDropIndexCandidates(schema, tbl, accessStats, referredFKs)...)
+	}
+	if len(tables) > 0 {
+		for _, tn := range tables {
+			tbl, err := is.TableByName(tn.Schema, tn.Name)
+			if err != nil {
+				return nil, err
+			}
+			addTable(tn.Schema, tbl.Meta())
+		}
+	} else {
+		checker := privilege.GetPrivilegeManager(sctx)
+		for _, schema := range is.AllSchemaNames() {
+			if util.IsMemOrSysDB(schema.L) {
+				continue
+			}
+			for _, tbl := range is.SchemaTables(schema) {
+				tblInfo := tbl.Meta()
+				if tblInfo.IsView() || tblInfo.IsSequence() || tblInfo.TempTableType == model.TempTableLocal {
+					continue
+				}
+				if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, schema.L, tblInfo.Name.L, "", mysql.AllPrivMask) {
+					continue
+				}
+				addTable(schema, tblInfo)
+			}
+		}
+	}
+	if len(advices) == 0 {
+		return nil, nil
+	}
+
+	// The statements are explained in a system session, so the variables of the current session are not changed.
+	pool := domain.GetDomain(sctx).SysSessionPool()
+	res, err := pool.Get()
+	if err != nil {
+		return nil, err
+	}
+	defer pool.Put(res)
+	for _, advice := range advices {
+		if err := evaluateDropIndex(ctx, sctx.GetRestrictedSQLExecutor(), res.(sessionctx.Context), advice); err != nil {
+			return nil, err
+		}
+		scoreDropIndex(advice)
+	}
+	slices.SortFunc(advices, func(a, b *dropIndexAdvice) int {
+		if a.RiskScore != b.RiskScore {
+			return cmp.Compare(a.RiskScore, b.RiskScore)
+		}
+		if a.Schema != b.Schema {
+			return cmp.Compare(a.Schema, b.Schema)
+		}
+		if a.Table != b.Table {
+			return cmp.Compare(a.Table, b.Table)
+		}
+		return cmp.Compare(a.Index, b.Index)
+	})
+	return advices, nil
+}
+
+// findDropIndexCandidates finds the unused and the redundant indexes of the table. The indexes needed by the
+// foreign keys are skipped since they can't be dropped.
+func findDropIndexCandidates(schema model.CIStr, tbl *model.TableInfo, accessStats map[tableaccess.Key]tableaccess.Stats,
+	referredFKs []*model.ReferredFKInfo) []*dropIndexAdvice {
+	var advices []*dropIndexAdvice
+	for _, idx := range tbl.Indices {
+		if idx.Primary || idx.State != model.StatePublic || idx.MVIndex {
+			continue
+		}
+		stats := accessStats[tableaccess.Key{TableID: tbl.ID, IndexID: idx.ID}]
+		advice := &dropIndexAdvice{
+			Schema:         schema.O,
+			Table:          tbl.Name.O,
+			Index:          idx.Name.O,
+			Scans:          stats.Scans,
+			PointGets:      stats.PointGets,
+			LastAccessTime: stats.LastAccessTime,
+			DropSQL:        sqlescape.MustEscapeSQL("ALTER TABLE %n.%n DROP INDEX %n", schema.O, tbl.Name.O, idx.Name.O),
+		}
+		if stats.Scans+stats.PointGets == 0 {
+			advice.Reasons = append(advice.Reasons, dropIndexReasonUnused)
+		}
+		advice.CoveringIndex = findCoveringIndex(tbl, idx)
+		if advice.CoveringIndex != "" {
+			advice.Reasons = append(advice.Reasons, dropIndexReasonRedundant)
+		} else if isIndexNeededByForeignKey(tbl, idx, referredFKs) {
+			continue
+		}
+		if len(advice.Reasons) == 0 {
+			continue
+		}
+		if idx.Unique && advice.CoveringIndex == "" {
+			advice.LoseUniqueness = true
+			advice.Notes = append(advice.Notes, "the uniqueness constraint is removed with the index")
+		}
+		if idx.Invisible {
+			advice.Notes = append(advice.Notes, "the index is invisible")
+		}
+		advices = append(advices, advice)
+	}
+	return advices
+}
+
+// findCoveringIndex returns the name of the index whose leading columns are the columns of idx, so idx is redundant.
+// A unique index is only covered by the unique index with the same columns, and only the last one of the indexes with
+// the same columns is regarded as redundant.
+func findCoveringIndex(tbl *model.TableInfo, idx *model.IndexInfo) string {
+	if tbl.PKIsHandle && len(idx.Columns) == 1 {
+		if pkCol := tbl.GetPkColInfo(); pkCol != nil && pkCol.Name.L == idx.Columns[0].Name.L && idx.Columns[0].Length == types.UnspecifiedLength {
+			return mysql.PrimaryKeyName
+		}
+	}
+	for _, other := range tbl.Indices {
+		if other.ID == idx.ID || other.State != model.StatePublic || other.Invisible || other.MVIndex || !isIndexPrefixOf(idx, other) {
+			continue
+		}
+		sameColumns := len(idx.Columns) == len(other.Columns)
+		if idx.Unique && !(other.Unique && sameColumns) {
+			continue
+		}
+		if sameColumns && !other.Primary && other.Unique == idx.Unique && other.ID > idx.ID {
+			continue
+		}
+		return other.Name.O
+	}
+	return ""
+}
+
+// isIndexPrefixOf returns whether the columns of idx are the leading columns of other.
+func isIndexPrefixOf(idx, other *model.IndexInfo) bool {
+	if len(idx.Columns) > len(other.Columns) {
+		return false
+	}
+	for i, col := range idx.Columns {
+		otherCol := other.Columns[i]
+		if col.Name.L != otherCol.Name.L {
+			return false
+		}
+		// The prefix of the column in other must be at least as long as the one in idx.
+		if otherCol.Length != types.UnspecifiedLength && (col.Length == types.UnspecifiedLength || col.Length > otherCol.Length) {
+			return false
+		}
+	}
+	return true
+}
+
+// isIndexNeededByForeignKey returns whether the index is the only index which can be used by a foreign key of the
+// table or a foreign key referring to the table.
+func isIndexNeededByForeignKey(tbl *model.TableInfo, idx *model.IndexInfo, referredFKs []*model.ReferredFKInfo) bool {
+	fkCols := make([][]model.CIStr, 0, len(tbl.ForeignKeys)+len(referredFKs))
+	for _, fk := range tbl.ForeignKeys {
+		fkCols = append(fkCols, fk.Cols)
+	}
+	for _, fk := range referredFKs {
+		fkCols = append(fkCols, fk.Cols)
+	}
+	hasLeadingCols := func(idx *model.IndexInfo, cols []model.CIStr) bool {
+		if len(cols) > len(idx.Columns) {
+			return false
+		}
+		for i, col := range cols {
+			if idx.Columns[i].Name.L != col.L {
+				return false
+			}
+		}
+		return true
+	}
+	for _, cols := range fkCols {
+		if !hasLeadingCols(idx, cols) {
+			continue
+		}
+		needed := true
+		for _, other := range tbl.Indices {
+			if other.ID != idx.ID && other.State == model.StatePublic && hasLeadingCols(other, cols) {
+				needed = false
+				break
+			}
+		}
+		if needed {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluateDropIndex finds the statements in the statement summary whose plans use the index, and compares their
+// estimated costs with the index and with the index ignored by tidb_opt_ignored_indexes in the session se.
+func evaluateDropIndex(ctx context.Context, sqlExec sqlexec.RestrictedSQLExecutor, se sessionctx.Context, advice *dropIndexAdvice) error {
+	rows, _, err := sqlExec.ExecRestrictedSQL(ctx, nil, `SELECT digest, schema_name, query_sample_text FROM information_schema.statements_summary
+		WHERE FIND_IN_SET(%?, table_names) AND FIND_IN_SET(%?, index_names) ORDER BY exec_count DESC`,
+		strings.ToLower(advice.Schema+"."+advice.Table), advice.Table+":"+advice.Index)
+	if err != nil {
+		return err
+	}
+	type stmt struct {
+		schema string
+		sql    string
+	}
+	stmts := make([]stmt, 0, len(rows))
+	for _, row := range rows {
+		digest := row.GetString(0)
+		if slices.Contains(advice.Digests, digest) {
+			continue
+		}
+		advice.Digests = append(advice.Digests, digest)
+		stmts = append(stmts, stmt{schema: row.GetString(1), sql: row.GetString(2)})
+	}
+	if len(stmts) > maxEvaluatedStmts {
+		advice.Unevaluated += len(stmts) - maxEvaluatedStmts
+		stmts = stmts[:maxEvaluatedStmts]
+	}
+	ignoredIndex := strings.ToLower(strings.Join([]string{advice.Schema, advice.Table, advice.Index}, "."))
+	for _, s := range stmts {
+		costWithIndex, err := explainCost(ctx, se, s.schema, s.sql, "")
+		if err != nil {
+			advice.Unevaluated++
+			continue
+		}
+		costWithoutIndex, err := explainCost(ctx, se, s.schema, s.sql, ignoredIndex)
+		if err != nil {
+			advice.Unevaluated++
+			continue
+		}
+		if costWithIndex > 0 {
+			advice.CostIncrease = max(advice.CostIncrease, costWithoutIndex/costWithIndex)
+		}
+	}
+	if advice.Unevaluated > 0 {
+		advice.Notes = append(advice.Notes, fmt.Sprintf("%d of the affected statements can't be evaluated", advice.Unevaluated))
+	}
+	return nil
+}
+
+// explainCost returns the estimated cost of the plan of the statement, the ignoredIndex is set to
+// tidb_opt_ignored_indexes to evaluate the plan as if the index is dropped.
+func explainCost(ctx context.Context, se sessionctx.Context, schema, sql, ignoredIndex string) (float64, error) {
+	// The sample text of the prepared statements contains the arguments, which can't be parsed.
+	stmt, err := parser.New().ParseOneStmt(sql, "", "")
+	if err != nil {
+		return 0, err
+	}
+	switch stmt.(type) {
+	case *ast.SelectStmt, *ast.SetOprStmt, *ast.UpdateStmt, *ast.DeleteStmt, *ast.InsertStmt:
+	default:
+		return 0, errors.Errorf("can't explain %T", stmt)
+	}
+	sessVars := se.GetSessionVars()
+	if err := sessVars.SetSystemVar(variable.TiDBOptIgnoredIndexes, ignoredIndex); err != nil {
+		return 0, err
+	}
+	sessVars.CurrentDB = schema
+	defer func() {
+		sessVars.CurrentDB = ""
+		_ = sessVars.SetSystemVar(variable.TiDBOptIgnoredIndexes, "")
+	}()
+	rows, _, err := se.GetRestrictedSQLExecutor().ExecRestrictedSQL(ctx, []sqlexec.OptionFuncAlias{sqlexec.ExecOptionUseCurSession},
+		"EXPLAIN FORMAT = 'verbose' "+sql)
+	if err != nil {
+		return 0, err
+	}
+	if len(rows) == 0 {
+		return 0, errors.New("empty plan")
+	}
+	// The columns are id, estRows, estCost, task, access object and operator info.
+	return strconv.ParseFloat(rows[0].GetString(2), 64)
+}
+
+// scoreDropIndex scores the risk of dropping the index from 0 to 100.
+func scoreDropIndex(advice *dropIndexAdvice) {
+	score := 0
+	if advice.LoseUniqueness {
+		score += 40
+	}
+	if advice.Scans+advice.PointGets > 0 {
+		score += 20
+	}
+	if advice.Unevaluated > 0 {
+		score += 20
+	}
+	if advice.CostIncrease > 1 {
+		score += int(min(40, (advice.CostIncrease-1)*40))
+	}
+	advice.RiskScore = min(score, 100)
+}
+
+// dropIndexAdviceRows converts the advices to the rows of ADMIN RECOMMEND DROP INDEX and TIDB_DROP_INDEX_ADVICE.
+func dropIndexAdviceRows(advices []*dropIndexAdvice) [][]types.Datum {
+	rows := make([][]types.Datum, 0, len(advices))
+	for _, advice := range advices {
+		row := types.MakeDatums(
+			advice.Schema,
+			advice.Table,
+			advice.Index,
+			strings.Join(advice.Reasons, ","),
+			nil, // COVERING_INDEX
+			advice.Scans,
+			advice.PointGets,
+			nil, // LAST_ACCESS_TIME
+			nil, // AFFECTED_DIGESTS
+			nil, // COST_INCREASE
+			advice.RiskScore,
+			advice.DropSQL,
+			nil, // NOTE
+		)
+		if advice.CoveringIndex != "" {
+			row[4].SetString(advice.CoveringIndex, mysql.DefaultCollationName)
+		}
+		if !advice.LastAccessTime.IsZero() {
+			row[7].SetMysqlTime(types.NewTime(types.FromGoTime(advice.LastAccessTime), mysql.TypeTimestamp, types.MaxFsp))
+		}
+		if len(advice.Digests) > 0 {
+			row[8].SetString(strings.Join(advice.Digests, ","), mysql.DefaultCollationName)
+		}
+		if advice.CostIncrease > 0 {
+			row[9].SetFloat64(advice.CostIncrease)
+		}
+		if len(advice.Notes) > 0 {
+			row[12].SetString(strings.Join(advice.Notes, "; "), mysql.DefaultCollationName)
+		}
+		rows = append(rows, row)
+	}
+	return rows
+}
+
+// AdminRecommendDropIndexExec represents an `ADMIN RECOMMEND DROP INDEX` executor.
+type AdminRecommendDropIndexExec struct {
+	exec.BaseExecutor
+
+	is     infoschema.InfoSchema
+	tables []*ast.TableName
+	rows   [][]types.Datum
+	cursor int
+	done   bool
+}
+
+// Next implements the Executor Next interface.
+func (e *AdminRecommendDropIndexExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if !e.done {
+		advices, err := adviseDropIndexes(ctx, e.Ctx(), e.is, e.tables)
+		if err != nil {
+			return err
+		}
+		e.rows = dropIndexAdviceRows(advices)
+		e.done = true
+	}
+	for ; e.cursor < len(e.rows) && !req.IsFull(); e.cursor++ {
+		for i := range e.rows[e.cursor] {
+			req.AppendDatum(i, &e.rows[e.cursor][i])
+		}
+	}
+	return nil
+}
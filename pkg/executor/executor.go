@@ -115,6 +115,9 @@ var (
 	_ exec.Executor = &sortexec.TopNExec{}
 	_ exec.Executor = &FastCheckTableExec{}
 	_ exec.Executor = &AdminShowBDRRoleExec{}
+	_ exec.Executor = &AdminRecommendHintsExec{}
+	_ exec.Executor = &AdminRecommendDropIndexExec{}
+	_ exec.Executor = &AdminShowDiagnosticsExec{}
 
 	// GlobalMemoryUsageTracker is the ancestor of all the Executors' memory tracker and GlobalMemory Tracker
 	GlobalMemoryUsageTracker *memory.Tracker
@@ -1849,6 +1852,7 @@ func ResetContextOfStmt(ctx sessionctx.Context, s ast.StmtNode) (err error) {
 	}
 	sc.MemTracker.SessionID.Store(vars.ConnectionID)
 	sc.MemTracker.AttachTo(vars.MemTracker)
+	vars.RowChanges.AttachMemTracker(sc.MemTracker)
 	sc.InitDiskTracker(memory.LabelForSQLText, -1)
 	globalConfig := config.GetGlobalConfig()
 	if variable.EnableTmpStorageOnOOM.Load() && sc.DiskTracker != nil {
@@ -2046,7 +2050,6 @@ func ResetContextOfStmt(ctx sessionctx.Context, s ast.StmtNode) (err error) {
 		sc.IndexUsageCollector = nil
 	}
 
-	sc.SetForcePlanCache(fixcontrol.GetBoolWithDefault(vars.OptimizerFixControl, fixcontrol.Fix49736, false))
 	sc.SetAlwaysWarnSkipCache(sc.InExplainStmt && sc.ExplainFormat == "plan_cache")
 	sc.TblInfo2UnionScan = make(map[*model.TableInfo]bool)
 	errCount, warnCount := vars.StmtCtx.NumErrorWarnings()
@@ -2054,6 +2057,8 @@ func ResetContextOfStmt(ctx sessionctx.Context, s ast.StmtNode) (err error) {
 	vars.SysWarningCount = warnCount
 	vars.ExchangeChunkStatus()
 	vars.StmtCtx = sc
+	// The fix_control hint is applied by the planner, see planner.Optimize.
+	sc.SetForcePlanCache(fixcontrol.GetBoolWithDefault(vars.GetOptimizerFixControlMap(), fixcontrol.Fix49736, false))
 	vars.PrevFoundInPlanCache = vars.FoundInPlanCache
 	vars.FoundInPlanCache = false
 	vars.PrevFoundInBinding = vars.FoundInBinding
@@ -2605,6 +2610,27 @@ func escapeName(name string) string {
 	return strings.ReplaceAll(name, "`", "``")
 }
 
+// AdminRecommendHintsExec represents an `ADMIN RECOMMEND HINTS` executor.
+type AdminRecommendHintsExec struct {
+	exec.BaseExecutor
+
+	hints         string
+	createBinding string
+	done          bool
+}
+
+// Next implements the Executor Next interface.
+func (e *AdminRecommendHintsExec) Next(_ context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.done {
+		return nil
+	}
+	req.AppendString(0, e.hints)
+	req.AppendString(1, e.createBinding)
+	e.done = true
+	return nil
+}
+
 // AdminShowBDRRoleExec represents a show BDR role executor.
 type AdminShowBDRRoleExec struct {
 	exec.BaseExecutor
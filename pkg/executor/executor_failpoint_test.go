@@ -110,6 +110,51 @@ func TestTiDBLastTxnInfoCommitMode(t *testing.T) {
 	require.Equal(t, "true", rows[0][2])
 }
 
+func TestCommitModeHints(t *testing.T) {
+	defer config.RestoreFunc()()
+	config.UpdateGlobal(func(conf *config.Config) {
+		conf.TiKVClient.AsyncCommit.SafeWindow = time.Second
+	})
+
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int primary key, v int)")
+	tk.MustExec("insert into t values (1, 1)")
+	commitMode := func() string {
+		return tk.MustQuery("select json_extract(@@tidb_last_txn_info, '$.txn_commit_mode')").Rows()[0][0].(string)
+	}
+
+	// The hints override the session variables.
+	tk.MustExec("set @@tidb_enable_async_commit = 0")
+	tk.MustExec("set @@tidb_enable_1pc = 0")
+	tk.MustExec("update /*+ async_commit(true) */ t set v = v + 1 where a = 1")
+	require.Equal(t, `"async_commit"`, commitMode())
+	tk.MustExec("update /*+ one_pc(true) */ t set v = v + 1 where a = 1")
+	require.Equal(t, `"1pc"`, commitMode())
+	tk.MustExec("update t set v = v + 1 where a = 1")
+	require.Equal(t, `"2pc"`, commitMode())
+
+	tk.MustExec("set @@tidb_enable_async_commit = 1")
+	tk.MustExec("set @@tidb_enable_1pc = 1")
+	tk.MustExec("update /*+ async_commit(false) one_pc(false) */ t set v = v + 1 where a = 1")
+	require.Equal(t, `"2pc"`, commitMode())
+	tk.MustExec("update /*+ one_pc(false) */ t set v = v + 1 where a = 1")
+	require.Equal(t, `"async_commit"`, commitMode())
+
+	// The hint in an explicit transaction takes effect on the commit of the transaction.
+	tk.MustExec("begin")
+	tk.MustExec("update /*+ async_commit(false) one_pc(false) */ t set v = v + 1 where a = 1")
+	tk.MustExec("insert into t values (2, 2)")
+	tk.MustExec("commit")
+	require.Equal(t, `"2pc"`, commitMode())
+	tk.MustQuery("select * from t").Check(testkit.Rows("1 7", "2 2"))
+
+	// The hints are ignored by the read-only statements.
+	tk.MustQuery("select /*+ one_pc(false) */ * from t where a = 2").Check(testkit.Rows("2 2"))
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1105 ASYNC_COMMIT() and ONE_PC() are only applicable to DML statements"))
+}
 func TestPointGetRepeatableRead(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 
@@ -502,6 +547,7 @@ func TestDeadlocksTable(t *testing.T) {
 			{
 				TryLockTxn:     101,
 				SQLDigest:      "aabbccdd",
+				PlanDigest:     "11223344",
 				Key:            []byte("k1"),
 				AllSQLDigests:  nil,
 				TxnHoldingLock: 102,
@@ -555,11 +601,11 @@ func TestDeadlocksTable(t *testing.T) {
 	tk := testkit.NewTestKit(t, store)
 	tk.MustQuery("select * from information_schema.deadlocks").Check(
 		testkit.RowsWithSep("/",
-			id1+"/2021-05-10 01:02:03.456789/0/101/aabbccdd/<nil>/6B31/<nil>/102",
-			id1+"/2021-05-10 01:02:03.456789/0/102/ddccbbaa/<nil>/6B32/<nil>/101",
-			id2+"/2022-06-11 02:03:04.987654/1/201/<nil>/<nil>/<nil>/<nil>/202",
-			id2+"/2022-06-11 02:03:04.987654/1/202/<nil>/<nil>/<nil>/<nil>/203",
-			id2+"/2022-06-11 02:03:04.987654/1/203/<nil>/<nil>/<nil>/<nil>/201",
+			id1+"/2021-05-10 01:02:03.456789/0/101/aabbccdd/<nil>/6B31/<nil>/102/11223344/<nil>/<nil>",
+			id1+"/2021-05-10 01:02:03.456789/0/102/ddccbbaa/<nil>/6B32/<nil>/101/<nil>/<nil>/<nil>",
+			id2+"/2022-06-11 02:03:04.987654/1/201/<nil>/<nil>/<nil>/<nil>/202/<nil>/<nil>/<nil>",
+			id2+"/2022-06-11 02:03:04.987654/1/202/<nil>/<nil>/<nil>/<nil>/203/<nil>/<nil>/<nil>",
+			id2+"/2022-06-11 02:03:04.987654/1/203/<nil>/<nil>/<nil>/<nil>/201/<nil>/<nil>/<nil>",
 		))
 }
 
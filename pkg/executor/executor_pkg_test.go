@@ -491,3 +491,15 @@ func TestErrLevelsForResetStmtContext(t *testing.T) {
 		}
 	}
 }
+
+func TestWriteConflictRetryBackoff(t *testing.T) {
+	require.Equal(t, time.Duration(0), writeConflictRetryBackoff(0, 1))
+	require.Equal(t, time.Duration(0), writeConflictRetryBackoff(10*time.Millisecond, 0))
+	// the backoff doubles on each retry.
+	require.Equal(t, 10*time.Millisecond, writeConflictRetryBackoff(10*time.Millisecond, 1))
+	require.Equal(t, 20*time.Millisecond, writeConflictRetryBackoff(10*time.Millisecond, 2))
+	require.Equal(t, 80*time.Millisecond, writeConflictRetryBackoff(10*time.Millisecond, 4))
+	// the backoff is bounded.
+	require.Equal(t, maxWriteConflictRetryBackoff, writeConflictRetryBackoff(10*time.Millisecond, 100))
+	require.Equal(t, 3*time.Second, writeConflictRetryBackoff(3*time.Second, 5))
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/executor/internal/exec"
 	"github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/execdetails"
 	"github.com/pingcap/tidb/pkg/util/logutil"
@@ -133,12 +134,15 @@ func (e *ExplainExec) executeAnalyzeExec(ctx context.Context) (err error) {
 			}
 		}
 	}
-	// Register the RU runtime stats to the runtime stats collection after the analyze executor has been executed.
+	// Register the RU and retry runtime stats to the runtime stats collection after the analyze executor has been executed.
 	if e.explain.Analyze && e.analyzeExec != nil && e.executed {
-		ruDetailsRaw := ctx.Value(clientutil.RUDetailsCtxKey)
-		if coll := e.Ctx().GetSessionVars().StmtCtx.RuntimeStatsColl; coll != nil && ruDetailsRaw != nil {
-			ruDetails := ruDetailsRaw.(*clientutil.RUDetails)
-			coll.RegisterStats(e.explain.TargetPlan.ID(), &ruRuntimeStats{ruDetails})
+		sc := e.Ctx().GetSessionVars().StmtCtx
+		if coll := sc.RuntimeStatsColl; coll != nil {
+			if ruDetailsRaw := ctx.Value(clientutil.RUDetailsCtxKey); ruDetailsRaw != nil {
+				ruDetails := ruDetailsRaw.(*clientutil.RUDetails)
+				coll.RegisterStats(e.explain.TargetPlan.ID(), &ruRuntimeStats{ruDetails})
+			}
+			registerRetryRuntimeStats(sc, e.explain.TargetPlan.ID())
 		}
 	}
 	return err
@@ -353,3 +357,44 @@ func (e *ruRuntimeStats) Merge(other execdetails.RuntimeStats) {
 func (*ruRuntimeStats) Tp() int {
 	return execdetails.TpRURuntimeStats
 }
+
+// retryRuntimeStats records the retries of the statement on the write conflicts and the region errors.
+type retryRuntimeStats struct {
+	writeConflict int64
+	regionError   int64
+}
+
+// registerRetryRuntimeStats registers the retries of the statement to the runtime stats of the plan if any.
+func registerRetryRuntimeStats(sc *stmtctx.StatementContext, planID int) {
+	retryStats := &retryRuntimeStats{
+		writeConflict: sc.WriteConflictRetries.Load(),
+		regionError:   sc.RegionErrorRetries.Load(),
+	}
+	if sc.RuntimeStatsColl != nil && (retryStats.writeConflict > 0 || retryStats.regionError > 0) {
+		sc.RuntimeStatsColl.RegisterStats(planID, retryStats)
+	}
+}
+
+// String implements the RuntimeStats interface.
+func (e *retryRuntimeStats) String() string {
+	return fmt.Sprintf("retry:{write_conflict:%d, region_error:%d}", e.writeConflict, e.regionError)
+}
+
+// Clone implements the RuntimeStats interface.
+func (e *retryRuntimeStats) Clone() execdetails.RuntimeStats {
+	newRs := *e
+	return &newRs
+}
+
+// Merge implements the RuntimeStats interface.
+func (e *retryRuntimeStats) Merge(other execdetails.RuntimeStats) {
+	if tmp, ok := other.(*retryRuntimeStats); ok {
+		e.writeConflict += tmp.writeConflict
+		e.regionError += tmp.regionError
+	}
+}
+
+// Tp implements the RuntimeStats interface.
+func (*retryRuntimeStats) Tp() int {
+	return execdetails.TpRetryRuntimeStats
+}
@@ -160,6 +160,20 @@ func TestExplainAnalyzeExecutionInfo(t *testing.T) {
 	tk.MustExec("drop table if exists lineitem")
 }
 
+func TestExplainAnalyzeWaitTime(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (v int, k int, key(k))")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+
+	// The self and wait time is only shown in the verbose format.
+	rows := tk.MustQuery("explain analyze select * from t").Rows()
+	require.NotContains(t, rows[len(rows)-2][5].(string), "wait:{")
+	rows = tk.MustQuery("explain analyze format='verbose' select * from t").Rows()
+	require.Contains(t, rows[len(rows)-2][6].(string), "wait:{network:")
+}
+
 func checkExecutionInfo(t *testing.T, tk *testkit.TestKit, sql string) {
 	executionInfoCol := 4
 	rows := tk.MustQuery(sql).Rows()
@@ -284,6 +298,31 @@ func TestExplainAnalyzeCTEMemoryAndDiskInfo(t *testing.T) {
 	require.NotEqual(t, "N/A", rows[4][8].(string))
 }
 
+func TestExplainAnalyzeOperatorMemQuota(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("insert into t with recursive cte(a) as (select 1 union select a + 1 from cte where a < 1000) select * from cte;")
+
+	sql := "explain analyze with recursive cte(a) as (select 1 union select a + 1 from cte where a < 1000)" +
+		" select * from cte, t;"
+	rows := tk.MustQuery(sql).Rows()
+	require.Equal(t, "0 Bytes", rows[4][8].(string))
+
+	// The CTE spills when it exceeds the operator quota, even if the query quota is not exceeded.
+	tk.MustExec("set @@tidb_mem_quota_operator=10240;")
+	rows = tk.MustQuery(sql).Rows()
+	require.NotEqual(t, "N/A", rows[4][7].(string))
+	require.NotEqual(t, "0 Bytes", rows[4][8].(string))
+
+	tk.MustExec("set @@tidb_max_chunk_size=32;")
+	rows = tk.MustQuery("explain analyze select * from t order by a;").Rows()
+	require.Contains(t, rows[0][0], "Sort")
+	require.NotEqual(t, "0 Bytes", rows[0][8].(string))
+}
+
 func TestIssue35296AndIssue43024(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -434,6 +473,69 @@ func TestExplainJSON(t *testing.T) {
 	}
 }
 
+func TestExplainMySQLJSON(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(id int, v int, key(id))")
+	tk.MustExec("create table t2(id int, key(id))")
+
+	queryBlock := func(sql string) map[string]any {
+		rows := tk.MustQuery(sql).Rows()
+		require.Len(t, rows, 1)
+		res := make(map[string]any)
+		require.NoError(t, json.Unmarshal([]byte(rows[0][0].(string)), &res))
+		block, ok := res["query_block"].(map[string]any)
+		require.True(t, ok)
+		require.Equal(t, float64(1), block["select_id"])
+		require.Contains(t, block["cost_info"], "query_cost")
+		return block
+	}
+
+	block := queryBlock("explain format = 'json' select * from t1 where v > 1")
+	table := block["table"].(map[string]any)
+	require.Equal(t, "t1", table["table_name"])
+	require.Equal(t, "ALL", table["access_type"])
+	require.Equal(t, []any{"id", "v"}, table["used_columns"])
+	require.Contains(t, table["attached_condition"], "gt(test.t1.v, 1)")
+	require.Contains(t, table["cost_info"], "prefix_cost")
+	require.NotEmpty(t, table["tidb"])
+
+	block = queryBlock("explain format = json select id from t1 where id > 10")
+	table = block["table"].(map[string]any)
+	require.Equal(t, "range", table["access_type"])
+	require.Equal(t, "id", table["key"])
+
+	block = queryBlock("explain format = 'JSON' select * from t1, t2 where t1.id = t2.id")
+	require.Len(t, block["nested_loop"], 2)
+
+	block = queryBlock("explain format = 'json' select /*+ inl_join(t2) */ * from t1, t2 where t1.id = t2.id")
+	loop := block["nested_loop"].([]any)
+	require.Len(t, loop, 2)
+	require.Equal(t, "ref", loop[1].(map[string]any)["table"].(map[string]any)["access_type"])
+
+	block = queryBlock("explain format = 'json' select v, count(*) from t1 group by v order by v")
+	ordering := block["ordering_operation"].(map[string]any)
+	require.Equal(t, true, ordering["using_filesort"])
+	require.Contains(t, ordering, "grouping_operation")
+
+	block = queryBlock("explain format = 'json' select id from t1 union all select id from t2")
+	union := block["union_result"].(map[string]any)
+	require.Len(t, union["query_specifications"], 2)
+
+	block = queryBlock("explain format = 'json' select 1")
+	require.Equal(t, "No tables used", block["message"])
+
+	block = queryBlock("explain format = 'json' with cte as (select * from t1 order by id limit 10) select * from cte, t2 where cte.id = t2.id")
+	require.Len(t, block["nested_loop"], 2)
+
+	block = queryBlock("explain analyze format = 'json' select * from t1")
+	info := block["table"].(map[string]any)["tidb"].([]any)[0].(map[string]any)
+	require.Equal(t, "0", info["actRows"])
+	require.NotEmpty(t, info["executeInfo"])
+}
+
 func TestExplainFormatInCtx(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -451,6 +553,7 @@ func TestExplainFormatInCtx(t *testing.T) {
 		types.ExplainFormatTraditional,
 		types.ExplainFormatBinary,
 		types.ExplainFormatTiDBJSON,
+		types.ExplainFormatJSON,
 		types.ExplainFormatCostTrace,
 		types.ExplainFormatPlanCache,
 	}
@@ -485,3 +588,177 @@ func TestExplainImportFromSelect(t *testing.T) {
 	require.Contains(t, rs[1][0], "TableReader")
 	require.Contains(t, rs[2][0], "TableFullScan")
 }
+
+func TestExplainHintsInUse(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(id int, v int, key(id))")
+	tk.MustExec("create table t2(id int, key(id))")
+
+	sql := "explain format = 'brief' select /*+ hash_join(t1, t2), use_index(t1, id), merge_join(t3) */ * from t1, t2 where t1.id = t2.id"
+	rows := tk.MustQuery(sql).Rows()
+	for _, row := range rows {
+		require.NotEqual(t, "Hints In Use:", row[0])
+	}
+	// EXPLAIN FORMAT='json' always reports the hints in use.
+	rows = tk.MustQuery("explain format = 'json' select /*+ hash_join(t1, t2) */ * from t1, t2 where t1.id = t2.id").Rows()
+	require.Len(t, rows, 1)
+	jsonRes := make(map[string]any)
+	require.NoError(t, json.Unmarshal([]byte(rows[0][0].(string)), &jsonRes))
+	require.Equal(t, []any{map[string]any{"hint": "hash_join(t1, t2)", "source": "comment", "matched": true, "applied": true}}, jsonRes["hints_in_use"])
+	rows = tk.MustQuery("explain format = 'tidb_json' select /*+ hash_join(t1, t2) */ * from t1, t2 where t1.id = t2.id").Rows()
+	require.True(t, strings.HasPrefix(rows[0][0].(string), "["))
+
+	tk.MustExec("set @@tidb_explain_show_hints_in_use = on")
+	rows = tk.MustQuery(sql).Rows()
+	require.Greater(t, len(rows), 4)
+	section := rows[len(rows)-4:]
+	require.Equal(t, []any{"Hints In Use:", "", "", "", ""}, section[0])
+	require.Equal(t, []any{"use_index(test.t1, id)", "", "", "", "source:comment, matched:true, applied:true"}, section[1])
+	require.Equal(t, []any{"merge_join(t3)", "", "", "", "source:comment, matched:false, applied:false"}, section[2])
+	require.Equal(t, []any{"hash_join(t1, t2)", "", "", "", "source:comment, matched:true, applied:true"}, section[3])
+
+	// the matched hints may be ignored by the optimizer, such as the conflicting join hints.
+	rows = tk.MustQuery("explain format = 'brief' select /*+ inl_join(t1), merge_join(t1, t2) */ * from t1, t2 where t1.id = t2.id").Rows()
+	require.Equal(t, "Hints In Use:", rows[len(rows)-3][0])
+	require.Equal(t, []any{"inl_join(t1)", "", "", "", "source:comment, matched:true, applied:false"}, rows[len(rows)-2])
+	require.Equal(t, []any{"merge_join(t1, t2)", "", "", "", "source:comment, matched:true, applied:false"}, rows[len(rows)-1])
+	rows = tk.MustQuery("explain format = 'brief' select /*+ inl_join(t1) */ * from t1, t2 where t1.id = t2.id").Rows()
+	require.Equal(t, []any{"inl_join(t1)", "", "", "", "source:comment, matched:true, applied:true"}, rows[len(rows)-1])
+	rows = tk.MustQuery("explain format = 'brief' select /*+ ignore_index(t1, id) */ * from t1 where id = 1").Rows()
+	require.Equal(t, []any{"ignore_index(test.t1, id)", "", "", "", "source:comment, matched:true, applied:true"}, rows[len(rows)-1])
+
+	// the statement-level hints are listed too, the overridden ones are unmatched.
+	rows = tk.MustQuery("explain format = 'brief' select /*+ max_execution_time(100), max_execution_time(200), set_var(tidb_opt_enable_hash_join=off) */ * from t1").Rows()
+	section = rows[len(rows)-4:]
+	require.Equal(t, "Hints In Use:", section[0][0])
+	require.Equal(t, []any{"max_execution_time(100)", "", "", "", "source:comment, matched:false, applied:false"}, section[1])
+	require.Equal(t, []any{"max_execution_time(200)", "", "", "", "source:comment, matched:true, applied:true"}, section[2])
+	require.Equal(t, []any{"set_var(tidb_opt_enable_hash_join = 'off')", "", "", "", "source:comment, matched:true, applied:true"}, section[3])
+
+	rows = tk.MustQuery("explain format = 'hint' select /*+ hash_join(t1, t2) */ * from t1, t2 where t1.id = t2.id").Rows()
+	require.Equal(t, "Hints In Use:", rows[len(rows)-2][0])
+	require.Equal(t, "hash_join(t1, t2) source:comment, matched:true, applied:true", rows[len(rows)-1][0])
+
+	// the JSON formats put the hints into the plan document, so there's still a single row.
+	rows = tk.MustQuery("explain format = 'json' select /*+ hash_join(t1, t2) */ * from t1, t2 where t1.id = t2.id").Rows()
+	require.Len(t, rows, 1)
+	res := make(map[string]any)
+	require.NoError(t, json.Unmarshal([]byte(rows[0][0].(string)), &res))
+	require.Contains(t, res, "query_block")
+	require.Equal(t, []any{map[string]any{"hint": "hash_join(t1, t2)", "source": "comment", "matched": true, "applied": true}}, res["hints_in_use"])
+	rows = tk.MustQuery("explain format = 'tidb_json' select /*+ hash_join(t1, t2) */ * from t1, t2 where t1.id = t2.id").Rows()
+	require.Len(t, rows, 1)
+	res = make(map[string]any)
+	require.NoError(t, json.Unmarshal([]byte(rows[0][0].(string)), &res))
+	require.NotEmpty(t, res["plan"])
+	require.Len(t, res["hints_in_use"], 1)
+
+	// hints in the binding replace the hints in the comment.
+	tk.MustExec("create session binding for select * from t1, t2 where t1.id = t2.id using select /*+ merge_join(t1, t2) */ * from t1, t2 where t1.id = t2.id")
+	rows = tk.MustQuery("explain format = 'brief' select /*+ hash_join(t1, t2) */ * from t1, t2 where t1.id = t2.id").Rows()
+	require.Equal(t, []any{"merge_join(t1, t2)", "", "", "", "source:binding, matched:true, applied:true"}, rows[len(rows)-1])
+	require.Equal(t, "Hints In Use:", rows[len(rows)-2][0])
+
+	// statements without hints have no such section, except TIDB_JSON which always reports the hints in use.
+	rows = tk.MustQuery("explain format = 'brief' select * from t1").Rows()
+	require.NotEqual(t, "Hints In Use:", rows[len(rows)-1][0])
+	rows = tk.MustQuery("explain format = 'tidb_json' select * from t1").Rows()
+	require.Len(t, rows, 1)
+	res = make(map[string]any)
+	require.NoError(t, json.Unmarshal([]byte(rows[0][0].(string)), &res))
+	require.NotEmpty(t, res["plan"])
+	require.Equal(t, []any{}, res["hints_in_use"])
+}
+
+func TestExplainCostDetails(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(id int, v int, key(id))")
+	tk.MustExec("create table t2(id int, key(id))")
+	tk.MustExec("set @@tidb_cost_model_version = 2")
+
+	sql := "explain format = 'verbose' select /*+ merge_join(t1, t2) */ * from t1, t2 where t1.id = t2.id"
+	rows := tk.MustQuery(sql).Rows()
+	for _, row := range rows {
+		require.NotEqual(t, "Cost Details:", row[0])
+	}
+
+	tk.MustExec("set @@tidb_explain_show_cost_details = on")
+	tk.MustExec("set @@tidb_opt_enable_hash_join = off")
+	rows = tk.MustQuery(sql).Rows()
+	planRows, details, constraints := -1, -1, -1
+	for i, row := range rows {
+		switch row[0] {
+		case "Cost Details:":
+			planRows, details = i, i
+		case "Plan Constraints:":
+			constraints = i
+		}
+	}
+	require.Greater(t, details, 0)
+	require.Greater(t, constraints, details)
+	// every operator has a row in the "Cost Details" section.
+	require.Equal(t, planRows, constraints-details-1)
+	for i := 0; i < planRows; i++ {
+		detail := rows[details+1+i]
+		require.Contains(t, rows[i][0].(string), detail[0].(string))
+		require.True(t, strings.HasPrefix(detail[5].(string), "rows:"+rows[i][1].(string)))
+	}
+	require.Contains(t, rows[constraints-1][5].(string), "scan:")
+	require.Equal(t, []any{"merge_join(t1, t2)", "", "", "", "", "hint, matched:true"}, rows[constraints+1])
+	require.Equal(t, "tidb_opt_enable_hash_join=OFF", rows[constraints+2][0])
+	require.True(t, strings.HasPrefix(rows[constraints+2][5].(string), "variable, default:ON"))
+	require.Len(t, rows, constraints+3)
+
+	// other formats have no such sections.
+	rows = tk.MustQuery("explain format = 'brief' select * from t1").Rows()
+	require.NotEqual(t, "Plan Constraints:", rows[len(rows)-1][0])
+}
+
+func TestExplainWithVars(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(id int, v int, key(id))")
+	tk.MustExec("create table t2(id int, key(id))")
+
+	// the HASH_JOIN hint conflicts with tidb_opt_enable_hash_join = off.
+	hashJoinDisabled := func(sql string) bool {
+		tk.MustQuery(sql)
+		for _, row := range tk.MustQuery("show warnings").Rows() {
+			if strings.Contains(row[2].(string), "the HASH_JOIN hint will take precedence") {
+				return true
+			}
+		}
+		return false
+	}
+	sql := "select /*+ hash_join(t1, t2) */ * from t1, t2 where t1.id = t2.id"
+	require.False(t, hashJoinDisabled("explain format = 'brief' "+sql))
+	require.True(t, hashJoinDisabled("explain format = 'brief' with (tidb_opt_enable_hash_join = off) "+sql))
+	// the variables are restored after the statement.
+	tk.MustQuery("select @@tidb_opt_enable_hash_join").Check(testkit.Rows("1"))
+	require.False(t, hashJoinDisabled("explain format = 'brief' "+sql))
+
+	// the SET_VAR hints of the statement take precedence.
+	require.False(t, hashJoinDisabled("explain format = 'brief' with (tidb_opt_enable_hash_join = 0) "+
+		"select /*+ hash_join(t1, t2), set_var(tidb_opt_enable_hash_join = on) */ * from t1, t2 where t1.id = t2.id"))
+	tk.MustQuery("select @@tidb_opt_enable_hash_join").Check(testkit.Rows("1"))
+
+	tk.MustExec("set @@tidb_opt_enable_hash_join = off")
+	require.True(t, hashJoinDisabled("explain format = 'brief' "+sql))
+	require.False(t, hashJoinDisabled("explain analyze format = 'brief' with (tidb_opt_enable_hash_join = default) "+sql))
+	tk.MustQuery("select @@tidb_opt_enable_hash_join").Check(testkit.Rows("0"))
+
+	tk.MustGetErrMsg("explain with (tidb_no_such_var = 1) select 1", "[variable:1193]Unknown system variable 'tidb_no_such_var'")
+	tk.MustGetErrMsg("explain with (tidb_snapshot = '2020-01-01 00:00:00') select 1", "variable 'tidb_snapshot' can't be overridden by EXPLAIN")
+	tk.MustGetErrMsg("explain with (autocommit = 0) select 1", "variable 'autocommit' can't be overridden by EXPLAIN")
+	tk.MustQuery("select @@tidb_snapshot, @@autocommit").Check(testkit.Rows(" 1"))
+	tk.MustGetErrMsg("explain with (tidb_opt_enable_hash_join = 'x') select 1", "[variable:1231]Variable 'tidb_opt_enable_hash_join' can't be set to the value of 'x'")
+	tk.MustQuery("select @@tidb_opt_enable_hash_join").Check(testkit.Rows("0"))
+}
@@ -0,0 +1,627 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/ngaut/pools"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/executor/importer"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/meta/autoid"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/parser/terror"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/table/tables"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/memory"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+const (
+	exportFormatCSV     = "csv"
+	exportFormatParquet = "parquet"
+
+	exportThreadOption             = "thread"
+	exportHeaderOption             = "header"
+	exportFieldsTerminatedByOption = "fields_terminated_by"
+	exportFieldsEnclosedByOption   = "fields_enclosed_by"
+
+	defaultExportThread = 4
+	maxExportThread     = 64
+	// exportNullValue is the same as the default NULL value of dumpling and
+	// IMPORT INTO, so the exported files can be imported back as they are.
+	exportNullValue = `\N`
+)
+
+// exportOptions maps the supported options to whether the option needs a value.
+var exportOptions = map[string]bool{
+	exportThreadOption:             true,
+	exportHeaderOption:             false,
+	exportFieldsTerminatedByOption: true,
+	exportFieldsEnclosedByOption:   true,
+}
+
+var exportCSVOnlyOptions = map[string]struct{}{
+	exportHeaderOption:             {},
+	exportFieldsTerminatedByOption: {},
+	exportFieldsEnclosedByOption:   {},
+}
+
+var _ exec.Executor = &ExportTableExec{}
+
+// ExportTableExec exports the data of a table to the external storage.
+// The table is split into chunks by the region boundaries of its records,
+// and the chunks are read concurrently at the same snapshot, each chunk is
+// written to its own file named in the way of dumpling.
+type ExportTableExec struct {
+	exec.BaseExecutor
+
+	dbName     string
+	tableInfo  *model.TableInfo
+	path       string
+	format     string
+	options    []*plannercore.LoadDataOpt
+	snapshotTS uint64
+
+	thread         int
+	header         bool
+	fieldsTerminal string
+	fieldsEnclosed string
+
+	// memTracker tracks the memory of the rows read but not written yet.
+	memTracker *memory.Tracker
+	done       bool
+}
+
+// exportChunk is a part of a physical table to be exported to one file.
+type exportChunk struct {
+	// partition is the partition name, it's empty for non-partitioned table.
+	partition string
+	// lower and upper are the values of the handle columns bounding the
+	// chunk [lower, upper), nil means unbounded.
+	lower, upper []any
+	seq          int
+}
+
+// Open implements the Executor Open interface.
+func (e *ExportTableExec) Open(context.Context) error {
+	e.memTracker = memory.NewTracker(e.ID(), -1)
+	e.memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
+	return e.initOptions()
+}
+
+// Next implements the Executor Next interface.
+func (e *ExportTableExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.GrowAndReset(e.MaxChunkSize())
+	if e.done {
+		return nil
+	}
+	e.done = true
+
+	b, err := storage.ParseBackend(e.path, nil)
+	if err != nil {
+		return exeerrors.ErrLoadDataInvalidURI.GenWithStackByArgs(plannercore.ExportTableDestination, importer.GetMsgFromBRError(err))
+	}
+	store, err := storage.NewWithDefaultOpt(ctx, b)
+	if err != nil {
+		return exeerrors.ErrLoadDataCantAccess.GenWithStackByArgs(plannercore.ExportTableDestination, importer.GetMsgFromBRError(err))
+	}
+	defer store.Close()
+
+	if err = e.writeSchema(ctx, store); err != nil {
+		return err
+	}
+	chunks, err := e.splitChunks(ctx)
+	if err != nil {
+		return err
+	}
+
+	var fileCnt, rowCnt atomic.Int64
+	eg, egCtx := util.NewErrorGroupWithRecoverWithCtx(ctx)
+	eg.SetLimit(e.thread)
+	for _, c := range chunks {
+		c := c
+		eg.Go(func() error {
+			rows, err2 := e.exportChunk(egCtx, store, c)
+			if err2 != nil {
+				return err2
+			}
+			if rows > 0 {
+				fileCnt.Add(1)
+				rowCnt.Add(rows)
+			}
+			return nil
+		})
+	}
+	if err = eg.Wait(); err != nil {
+		return err
+	}
+
+	req.AppendUint64(0, e.snapshotTS)
+	req.AppendString(1, ast.RedactURL(e.path))
+	req.AppendInt64(2, fileCnt.Load())
+	req.AppendInt64(3, rowCnt.Load())
+	return nil
+}
+
+func (e *ExportTableExec) initOptions() error {
+	if e.format != exportFormatCSV && e.format != exportFormatParquet {
+		return exeerrors.ErrLoadDataUnsupportedFormat.GenWithStackByArgs(e.format)
+	}
+	e.thread = defaultExportThread
+	e.fieldsTerminal = ","
+	e.fieldsEnclosed = `"`
+
+	specifiedOptions := make(map[string]*plannercore.LoadDataOpt, len(e.options))
+	for _, opt := range e.options {
+		hasValue, ok := exportOptions[opt.Name]
+		if !ok {
+			return exeerrors.ErrUnknownOption.FastGenByArgs(opt.Name)
+		}
+		if hasValue && opt.Value == nil || !hasValue && opt.Value != nil {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		if _, ok = specifiedOptions[opt.Name]; ok {
+			return exeerrors.ErrDuplicateOption.FastGenByArgs(opt.Name)
+		}
+		if _, ok = exportCSVOnlyOptions[opt.Name]; ok && e.format != exportFormatCSV {
+			return exeerrors.ErrLoadDataUnsupportedOption.FastGenByArgs(opt.Name, "non-CSV format")
+		}
+		specifiedOptions[opt.Name] = opt
+	}
+
+	evalCtx := e.Ctx().GetExprCtx().GetEvalCtx()
+	if opt, ok := specifiedOptions[exportThreadOption]; ok {
+		if opt.Value.GetType().GetType() != mysql.TypeLonglong || mysql.HasIsBooleanFlag(opt.Value.GetType().GetFlag()) {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		v, isNull, err := opt.Value.EvalInt(evalCtx, chunk.Row{})
+		if err != nil || isNull || v <= 0 || v > maxExportThread {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		e.thread = int(v)
+	}
+	_, e.header = specifiedOptions[exportHeaderOption]
+	for name, target := range map[string]*string{
+		exportFieldsTerminatedByOption: &e.fieldsTerminal,
+		exportFieldsEnclosedByOption:   &e.fieldsEnclosed,
+	} {
+		opt, ok := specifiedOptions[name]
+		if !ok {
+			continue
+		}
+		if opt.Value.GetType().GetType() != mysql.TypeVarString {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		v, isNull, err := opt.Value.EvalString(evalCtx, chunk.Row{})
+		if err != nil || isNull {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		*target = v
+	}
+	if e.fieldsTerminal == "" || len(e.fieldsEnclosed) > 1 || e.fieldsEnclosed == `\` ||
+		strings.Contains(e.fieldsTerminal, `\`) || e.fieldsEnclosed != "" && strings.Contains(e.fieldsTerminal, e.fieldsEnclosed) {
+		return exeerrors.ErrLoadDataWrongFormatConfig.GenWithStackByArgs("invalid fields_terminated_by or fields_enclosed_by")
+	}
+	return nil
+}
+
+// writeSchema writes the create table statement in the way of dumpling, so
+// the exported files can be imported by lightning directly.
+func (e *ExportTableExec) writeSchema(ctx context.Context, store storage.ExternalStorage) error {
+	var buf bytes.Buffer
+	if err := ConstructResultOfShowCreateTable(e.Ctx(), e.tableInfo, autoid.Allocators{}, &buf); err != nil {
+		return err
+	}
+	buf.WriteString(";\n")
+	name := fmt.Sprintf("%s.%s-schema.sql", e.dbName, e.tableInfo.Name.O)
+	return errors.Trace(store.WriteFile(ctx, name, buf.Bytes()))
+}
+
+// splitChunks splits the table into chunks by the regions of the records.
+// The first row of each region is sampled in the way of dumpling, and the
+// chunks are bounded by the values of the handle columns of the samples, so
+// the tables with any kind of handle are split in the same way.
+func (e *ExportTableExec) splitChunks(ctx context.Context) ([]exportChunk, error) {
+	partitions := []string{""}
+	if pi := e.tableInfo.GetPartitionInfo(); pi != nil {
+		partitions = partitions[:0]
+		for _, def := range pi.Definitions {
+			partitions = append(partitions, def.Name.O)
+		}
+	}
+
+	chunks := make([]exportChunk, 0, len(partitions))
+	for _, partition := range partitions {
+		boundaries, err := e.sampleRegionBoundaries(ctx, partition)
+		if err != nil {
+			return nil, err
+		}
+		var lower []any
+		for _, upper := range boundaries {
+			chunks = append(chunks, exportChunk{partition: partition, lower: lower, upper: upper, seq: len(chunks)})
+			lower = upper
+		}
+		chunks = append(chunks, exportChunk{partition: partition, lower: lower, seq: len(chunks)})
+	}
+	return chunks, nil
+}
+
+// handleColumns returns the columns which the records are ordered by in TiKV.
+func (e *ExportTableExec) handleColumns() []string {
+	if pkInfo := e.tableInfo.GetPkColInfo(); e.tableInfo.PKIsHandle && pkInfo != nil {
+		return []string{pkInfo.Name.O}
+	}
+	if e.tableInfo.IsCommonHandle {
+		pkIdx := tables.FindPrimaryIndex(e.tableInfo)
+		cols := make([]string, 0, len(pkIdx.Columns))
+		for _, col := range pkIdx.Columns {
+			cols = append(cols, col.Name.O)
+		}
+		return cols
+	}
+	return []string{model.ExtraHandleName.O}
+}
+
+// sampleRegionBoundaries returns the sorted values of the handle columns of
+// the first rows of the regions of the physical table. The first sample is
+// the first row of the table, which doesn't split the table, so it's skipped.
+func (e *ExportTableExec) sampleRegionBoundaries(ctx context.Context, partition string) ([][]any, error) {
+	cols := e.handleColumns()
+	var sb strings.Builder
+	args := make([]any, 0, 2*len(cols)+3)
+	sb.WriteString("SELECT ")
+	args = writeExportColumnList(&sb, args, cols)
+	sb.WriteString(" FROM %n.%n")
+	args = append(args, e.dbName, e.tableInfo.Name.O)
+	if partition != "" {
+		sb.WriteString(" PARTITION(%n)")
+		args = append(args, partition)
+	}
+	sb.WriteString(" TABLESAMPLE REGIONS() ORDER BY ")
+	args = writeExportColumnList(&sb, args, cols)
+
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnTools)
+	exec := e.Ctx().GetRestrictedSQLExecutor()
+	rows, fields, err := exec.ExecRestrictedSQL(ctx, []sqlexec.OptionFuncAlias{sqlexec.ExecOptionWithSnapshot(e.snapshotTS)}, sb.String(), args...)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) <= 1 {
+		return nil, nil
+	}
+	boundaries := make([][]any, 0, len(rows)-1)
+	for _, row := range rows[1:] {
+		values := make([]any, 0, len(fields))
+		for i, f := range fields {
+			v, err := exportBoundaryValue(row.GetDatum(i, &f.Column.FieldType))
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, v)
+		}
+		boundaries = append(boundaries, values)
+	}
+	return boundaries, nil
+}
+
+// exportBoundaryValue converts the datum to the value used as the argument of
+// the SQL, the values of other types are compared as strings.
+func exportBoundaryValue(d types.Datum) (any, error) {
+	switch d.Kind() {
+	case types.KindInt64:
+		return d.GetInt64(), nil
+	case types.KindUint64:
+		return d.GetUint64(), nil
+	case types.KindBytes:
+		return d.GetBytes(), nil
+	case types.KindString:
+		return d.GetString(), nil
+	default:
+		return d.ToString()
+	}
+}
+
+func writeExportColumnList(sb *strings.Builder, args []any, cols []string) []any {
+	for i, col := range cols {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("%n")
+		args = append(args, col)
+	}
+	return args
+}
+
+// writeExportBoundCondition writes the condition that the handle columns are
+// greater than or equal to the bound if lower is true, or less than the bound
+// otherwise, in the order of the columns.
+func writeExportBoundCondition(sb *strings.Builder, args []any, cols []string, bound []any, lower bool) []any {
+	sb.WriteString("(")
+	for i := range cols {
+		if i > 0 {
+			sb.WriteString(" OR (")
+		}
+		for j := 0; j < i; j++ {
+			sb.WriteString("%n = %? AND ")
+			args = append(args, cols[j], bound[j])
+		}
+		switch {
+		case !lower:
+			sb.WriteString("%n < %?")
+		case i == len(cols)-1:
+			sb.WriteString("%n >= %?")
+		default:
+			sb.WriteString("%n > %?")
+		}
+		args = append(args, cols[i], bound[i])
+		if i > 0 {
+			sb.WriteString(")")
+		}
+	}
+	sb.WriteString(")")
+	return args
+}
+
+// exportChunk reads the chunk at the snapshot and writes it to a file, it
+// returns the number of exported rows. No file is written for empty chunk.
+// The rows are read by a system session and written chunk by chunk, so only
+// the chunk being written is held in memory.
+func (e *ExportTableExec) exportChunk(ctx context.Context, store storage.ExternalStorage, c exportChunk) (rowCnt int64, err error) {
+	var sb strings.Builder
+	args := []any{e.dbName, e.tableInfo.Name.O}
+	sb.WriteString("SELECT * FROM %n.%n")
+	if c.partition != "" {
+		sb.WriteString(" PARTITION(%n)")
+		args = append(args, c.partition)
+	}
+	cols := e.handleColumns()
+	if c.lower != nil {
+		sb.WriteString(" WHERE ")
+		args = writeExportBoundCondition(&sb, args, cols, c.lower, true)
+	}
+	if c.upper != nil {
+		if c.lower != nil {
+			sb.WriteString(" AND ")
+		} else {
+			sb.WriteString(" WHERE ")
+		}
+		args = writeExportBoundCondition(&sb, args, cols, c.upper, false)
+	}
+
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnTools)
+	se, err := e.GetSysSession()
+	if err != nil {
+		return 0, err
+	}
+	// the snapshot is set directly as the restricted SQL executor does, it's
+	// the start ts of the statement, so it needn't be checked against the GC
+	// safe point.
+	seVars := se.GetSessionVars()
+	defer func() {
+		if err2 := seVars.SetSystemVar(variable.TiDBSnapshot, ""); err2 != nil {
+			se.(pools.Resource).Close()
+			return
+		}
+		seVars.SnapshotInfoschema = nil
+		seVars.SnapshotTS = 0
+		e.ReleaseSysSession(ctx, se)
+	}()
+	if err = seVars.SetSystemVar(variable.TiDBSnapshot, strconv.FormatUint(e.snapshotTS, 10)); err != nil {
+		return 0, err
+	}
+	if seVars.SnapshotInfoschema, err = domain.GetDomain(se).GetSnapshotInfoSchema(e.snapshotTS); err != nil {
+		return 0, err
+	}
+
+	sqlExec := se.GetSQLExecutor()
+	rs, err := sqlExec.ExecuteInternal(ctx, sb.String(), args...)
+	if err != nil {
+		return 0, err
+	}
+	defer terror.Call(rs.Close)
+
+	var w exportFileWriter
+	defer func() {
+		if w != nil && err != nil {
+			_ = w.close(ctx)
+		}
+	}()
+	chk := rs.NewChunk(nil)
+	for {
+		if err = rs.Next(ctx, chk); err != nil {
+			return 0, err
+		}
+		if chk.NumRows() == 0 {
+			break
+		}
+		memUsage := chk.MemoryUsage()
+		e.memTracker.Consume(memUsage)
+		if err = e.Ctx().GetSessionVars().SQLKiller.HandleSignal(); err != nil {
+			e.memTracker.Consume(-memUsage)
+			return 0, err
+		}
+		if w == nil {
+			w, err = e.createFileWriter(ctx, store, c, rs.Fields())
+			if err != nil {
+				e.memTracker.Consume(-memUsage)
+				return 0, err
+			}
+		}
+		err = w.write(ctx, chk)
+		e.memTracker.Consume(-memUsage)
+		if err != nil {
+			return 0, err
+		}
+		rowCnt += int64(chk.NumRows())
+	}
+	if w == nil {
+		return 0, nil
+	}
+	if err = w.close(ctx); err != nil {
+		w = nil
+		return 0, err
+	}
+	return rowCnt, nil
+}
+
+// exportFileWriter writes the rows of a chunk to a file in the export format.
+type exportFileWriter interface {
+	write(ctx context.Context, chk *chunk.Chunk) error
+	close(ctx context.Context) error
+}
+
+func (e *ExportTableExec) createFileWriter(ctx context.Context, store storage.ExternalStorage, c exportChunk, fields []*ast.ResultField) (exportFileWriter, error) {
+	name := fmt.Sprintf("%s.%s.%09d.%s", e.dbName, e.tableInfo.Name.O, c.seq, e.format)
+	w, err := store.Create(ctx, name, nil)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	fts := make([]*types.FieldType, 0, len(fields))
+	names := make([]string, 0, len(fields))
+	for _, f := range fields {
+		fts = append(fts, &f.Column.FieldType)
+		names = append(names, f.Column.Name.O)
+	}
+	if e.format == exportFormatParquet {
+		pw, err := newParquetWriter(ctx, w, names, fts)
+		if err != nil {
+			_ = w.Close(ctx)
+			return nil, err
+		}
+		return &exportParquetWriter{w: w, pw: pw, fts: fts}, nil
+	}
+	cw := &exportCSVWriter{e: e, w: w, fts: fts}
+	if e.header {
+		for i, name := range names {
+			if i > 0 {
+				cw.buf.WriteString(e.fieldsTerminal)
+			}
+			e.writeCSVField(&cw.buf, name)
+		}
+		cw.buf.WriteByte('\n')
+	}
+	return cw, nil
+}
+
+type exportCSVWriter struct {
+	e   *ExportTableExec
+	w   storage.ExternalFileWriter
+	fts []*types.FieldType
+	buf bytes.Buffer
+}
+
+func (cw *exportCSVWriter) write(ctx context.Context, chk *chunk.Chunk) error {
+	e := cw.e
+	for r := 0; r < chk.NumRows(); r++ {
+		row := chk.GetRow(r)
+		for i, ft := range cw.fts {
+			if i > 0 {
+				cw.buf.WriteString(e.fieldsTerminal)
+			}
+			d := row.GetDatum(i, ft)
+			if d.IsNull() {
+				cw.buf.WriteString(exportNullValue)
+				continue
+			}
+			s, err := d.ToString()
+			if err != nil {
+				return err
+			}
+			e.writeCSVField(&cw.buf, s)
+		}
+		cw.buf.WriteByte('\n')
+	}
+	_, err := cw.w.Write(ctx, cw.buf.Bytes())
+	cw.buf.Reset()
+	return errors.Trace(err)
+}
+
+func (cw *exportCSVWriter) close(ctx context.Context) error {
+	if cw.buf.Len() > 0 {
+		if _, err := cw.w.Write(ctx, cw.buf.Bytes()); err != nil {
+			_ = cw.w.Close(ctx)
+			return errors.Trace(err)
+		}
+	}
+	return errors.Trace(cw.w.Close(ctx))
+}
+
+// writeCSVField writes the field escaped by backslash, which is the default
+// of fields_escaped_by of IMPORT INTO.
+func (e *ExportTableExec) writeCSVField(buf *bytes.Buffer, s string) {
+	buf.WriteString(e.fieldsEnclosed)
+	for i := 0; i < len(s); i++ {
+		switch {
+		case s[i] == '\\':
+			buf.WriteString(`\\`)
+		case e.fieldsEnclosed != "" && s[i] == e.fieldsEnclosed[0]:
+			buf.WriteByte('\\')
+			buf.WriteByte(s[i])
+		case e.fieldsEnclosed == "" && s[i] == '\n':
+			buf.WriteString(`\n`)
+		case e.fieldsEnclosed == "" && strings.HasPrefix(s[i:], e.fieldsTerminal):
+			buf.WriteByte('\\')
+			buf.WriteByte(s[i])
+		default:
+			buf.WriteByte(s[i])
+		}
+	}
+	buf.WriteString(e.fieldsEnclosed)
+}
+
+type exportParquetWriter struct {
+	w   storage.ExternalFileWriter
+	pw  *writer.CSVWriter
+	fts []*types.FieldType
+}
+
+func (pw *exportParquetWriter) write(_ context.Context, chk *chunk.Chunk) error {
+	for r := 0; r < chk.NumRows(); r++ {
+		rec, err := parquetRecord(chk.GetRow(r), pw.fts)
+		if err != nil {
+			return err
+		}
+		if err = pw.pw.Write(rec); err != nil {
+			return errors.Trace(err)
+		}
+	}
+	return nil
+}
+
+func (pw *exportParquetWriter) close(ctx context.Context) error {
+	if err := pw.pw.WriteStop(); err != nil {
+		_ = pw.w.Close(ctx)
+		return errors.Trace(err)
+	}
+	return errors.Trace(pw.w.Close(ctx))
+}
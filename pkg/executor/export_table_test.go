@@ -0,0 +1,180 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/stretchr/testify/require"
+)
+
+func readExportedFiles(t *testing.T, dir, suffix string) (names []string, content string) {
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	sort.Strings(names)
+	var sb strings.Builder
+	for _, name := range names {
+		if !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		require.NoError(t, err)
+		sb.Write(data)
+	}
+	return names, sb.String()
+}
+
+func TestExportTable(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, name varchar(20), score double)")
+	tk.MustExec(`insert into t values (1, 'a', 1.5), (2, 'b"\\', null), (3, null, 3), (100, 'x,y', 4)`)
+	// the table is split into chunks by the regions.
+	tk.MustQuery("split table t by (2), (50)").Check(testkit.Rows("2 1"))
+
+	dir := t.TempDir()
+	rows := tk.MustQuery(fmt.Sprintf("export table t to '%s' format csv with header", dir)).Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, dir, rows[0][1])
+	require.Equal(t, "3", rows[0][2])
+	require.Equal(t, "4", rows[0][3])
+	names, content := readExportedFiles(t, dir, ".csv")
+	require.Equal(t, []string{"test.t-schema.sql", "test.t.000000000.csv", "test.t.000000001.csv", "test.t.000000002.csv"}, names)
+	require.Equal(t, "\"id\",\"name\",\"score\"\n\"1\",\"a\",\"1.5\"\n"+
+		"\"id\",\"name\",\"score\"\n\"2\",\"b\\\"\\\\\",\\N\n\"3\",\\N,\"3\"\n"+
+		"\"id\",\"name\",\"score\"\n\"100\",\"x,y\",\"4\"\n", content)
+	schema, err := os.ReadFile(filepath.Join(dir, "test.t-schema.sql"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(schema), "CREATE TABLE `t` ("), string(schema))
+
+	// the data is exported at the snapshot of the transaction.
+	tk2 := testkit.NewTestKit(t, store)
+	tk2.MustExec("use test")
+	tk.MustExec("begin")
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("4"))
+	tk2.MustExec("insert into t values (10, 'new', 10)")
+	tk2.MustExec("delete from t where id = 100")
+	dir = t.TempDir()
+	tk.MustQuery(fmt.Sprintf("export table t to '%s' format 'CSV' with fields_terminated_by='|', fields_enclosed_by='', thread=1", dir)).
+		CheckAt([]int{2, 3}, testkit.Rows("3 4"))
+	tk.MustExec("commit")
+	_, content = readExportedFiles(t, dir, ".csv")
+	require.Equal(t, "1|a|1.5\n2|b\"\\\\|\\N\n3|\\N|3\n100|x,y|4\n", content)
+
+	// parquet and partitioned table with _tidb_rowid as handle.
+	tk.MustExec("create table tp (a int, b varchar(10)) partition by hash(a) partitions 2")
+	tk.MustExec("insert into tp values (1, 'a'), (2, 'b'), (3, null)")
+	dir = t.TempDir()
+	tk.MustQuery(fmt.Sprintf("export table tp to '%s' format parquet", dir)).CheckAt([]int{2, 3}, testkit.Rows("2 3"))
+	names, _ = readExportedFiles(t, dir, ".parquet")
+	require.Equal(t, []string{"test.tp-schema.sql", "test.tp.000000000.parquet", "test.tp.000000001.parquet"}, names)
+
+	// empty table exports the schema only.
+	tk.MustExec("create table empty_t (a int)")
+	dir = t.TempDir()
+	tk.MustQuery(fmt.Sprintf("export table empty_t to '%s' format csv", dir)).CheckAt([]int{2, 3}, testkit.Rows("0 0"))
+	names, _ = readExportedFiles(t, dir, ".csv")
+	require.Equal(t, []string{"test.empty_t-schema.sql"}, names)
+
+	dir = t.TempDir()
+	tk.MustGetErrMsg(fmt.Sprintf("export table t to '%s' format 'sql'", dir), "[executor:8157]The FORMAT 'sql' is not supported")
+	tk.MustGetErrMsg(fmt.Sprintf("export table t to '%s' format csv with xx=1", dir), "[executor:8163]Unknown option xx")
+	tk.MustGetErrMsg(fmt.Sprintf("export table t to '%s' format csv with thread=0", dir), "[executor:8164]Invalid option value for thread")
+	tk.MustGetErrMsg(fmt.Sprintf("export table t to '%s' format parquet with header", dir), "[executor:8166]Unsupported option header for non-CSV format")
+	tk.MustExec("create view v as select * from t")
+	tk.MustGetErrMsg(fmt.Sprintf("export table v to '%s' format csv", dir), "[planner:1235]This version of TiDB doesn't yet support 'EXPORT TABLE of view, sequence or temporary table'")
+}
+
+func TestExportTableSplitByHandle(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+
+	// clustered index with multiple columns.
+	tk.MustExec("create table tc (a varchar(10), b int, c int, primary key (a, b) clustered)")
+	tk.MustExec("insert into tc values ('a', 1, 1), ('b', 1, 2), ('b', 2, 3), ('b', 3, 4), ('c', 1, 5)")
+	tk.MustQuery("split table tc by ('b', 2), ('c', 0)").Check(testkit.Rows("2 1"))
+	dir := t.TempDir()
+	tk.MustQuery(fmt.Sprintf("export table tc to '%s' format csv with fields_enclosed_by=''", dir)).CheckAt([]int{2, 3}, testkit.Rows("3 5"))
+	names, content := readExportedFiles(t, dir, ".csv")
+	require.Equal(t, []string{"test.tc-schema.sql", "test.tc.000000000.csv", "test.tc.000000001.csv", "test.tc.000000002.csv"}, names)
+	require.Equal(t, "a,1,1\nb,1,2\nb,2,3\nb,3,4\nc,1,5\n", content)
+	data, err := os.ReadFile(filepath.Join(dir, "test.tc.000000001.csv"))
+	require.NoError(t, err)
+	require.Equal(t, "b,2,3\nb,3,4\n", string(data))
+
+	// unsigned integer as the handle.
+	tk.MustExec("create table tu (id bigint unsigned primary key, v int)")
+	tk.MustExec("insert into tu values (1, 1), (100, 2), (9223372036854775808, 3)")
+	// the values greater than the max int64 are stored before the others.
+	tk.MustQuery("split table tu by (50), (9223372036854775809)").Check(testkit.Rows("2 1"))
+	dir = t.TempDir()
+	tk.MustQuery(fmt.Sprintf("export table tu to '%s' format csv with fields_enclosed_by=''", dir)).CheckAt([]int{2, 3}, testkit.Rows("3 3"))
+	names, content = readExportedFiles(t, dir, ".csv")
+	require.Equal(t, []string{"test.tu-schema.sql", "test.tu.000000000.csv", "test.tu.000000001.csv", "test.tu.000000002.csv"}, names)
+	require.Equal(t, "1,1\n100,2\n9223372036854775808,3\n", content)
+
+	// the rows read are tracked by the memory tracker of the statement.
+	tk.MustExec("set global tidb_mem_oom_action = 'CANCEL'")
+	defer tk.MustExec("set global tidb_mem_oom_action = default")
+	tk.MustExec("set @@tidb_mem_quota_query = 128")
+	dir = t.TempDir()
+	err = tk.QueryToErr(fmt.Sprintf("export table tu to '%s' format csv", dir))
+	require.True(t, exeerrors.ErrMemoryExceedForQuery.Equal(err), "%v", err)
+}
+
+func TestExportTablePrivilege(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("create user 'export_user'@'%'")
+	tk.MustExec("grant select on test.t to 'export_user'@'%'")
+
+	userTk := testkit.NewTestKit(t, store)
+	require.NoError(t, userTk.Session().Auth(&auth.UserIdentity{Username: "export_user", Hostname: "%"}, nil, nil, nil))
+	dir := t.TempDir()
+	userTk.MustGetErrMsg(fmt.Sprintf("export table test.t to '%s' format csv", dir), "[planner:1227]Access denied; you need (at least one of) the FILE privilege(s) for this operation")
+	tk.MustExec("grant file on *.* to 'export_user'@'%'")
+	userTk.MustQuery(fmt.Sprintf("export table test.t to '%s' format csv", dir)).CheckAt([]int{2, 3}, testkit.Rows("0 0"))
+
+	// the table is exported without the policies, so they must be bypassed.
+	tk.MustExec("create policy p1 on t to 'export_user' using (a = 1)")
+	userTk.MustGetErrMsg(fmt.Sprintf("export table test.t to '%s' format csv", dir), "[planner:1227]Access denied; you need (at least one of) the SUPER or BYPASS_ROW_POLICY privilege(s) for this operation")
+	tk.MustExec("grant bypass_row_policy on *.* to 'export_user'@'%'")
+	userTk.MustQuery(fmt.Sprintf("export table test.t to '%s' format csv", dir)).CheckAt([]int{2, 3}, testkit.Rows("0 0"))
+	tk.MustExec("create masking policy m1 on t (a) to 'root' using full")
+	userTk.MustQuery(fmt.Sprintf("export table test.t to '%s' format csv", dir)).CheckAt([]int{2, 3}, testkit.Rows("0 0"))
+	tk.MustExec("drop masking policy m1 on t")
+	tk.MustExec("create masking policy m2 on t (a) to 'export_user' using full")
+	userTk.MustGetErrMsg(fmt.Sprintf("export table test.t to '%s' format csv", dir), "[planner:1227]Access denied; you need (at least one of) the SUPER or BYPASS_DATA_MASKING privilege(s) for this operation")
+	tk.MustExec("grant bypass_data_masking on *.* to 'export_user'@'%'")
+	userTk.MustQuery(fmt.Sprintf("export table test.t to '%s' format csv", dir)).CheckAt([]int{2, 3}, testkit.Rows("0 0"))
+
+	tk.MustExec("revoke select on test.t from 'export_user'@'%'")
+	userTk.MustGetErrMsg(fmt.Sprintf("export table test.t to '%s' format csv", dir), "[planner:1142]SELECT command denied to user 'export_user'@'%' for table 't'")
+}
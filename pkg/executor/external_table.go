@@ -0,0 +1,284 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"io"
+	"path/filepath"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/pkg/executor/importer"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/lightning/config"
+	"github.com/pingcap/tidb/pkg/lightning/mydump"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/parser/terror"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/table"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
+	"github.com/pingcap/tidb/pkg/util/execdetails"
+	"github.com/pingcap/tidb/pkg/util/sem"
+	"github.com/pingcap/tidb/pkg/util/stringutil"
+)
+
+// externalTableRetriever reads the rows of an external table from the data files in the external storage. The files
+// are read one by one, and at most one chunk of rows are kept in memory.
+type externalTableRetriever struct {
+	table      *model.TableInfo
+	outputCols []*model.ColumnInfo
+	schema     *expression.Schema
+	extractor  *plannercore.ExternalTableExtractor
+
+	initialized bool
+	store       storage.ExternalStorage
+	files       []*mydump.SourceFileMeta
+	filters     expression.CNFExprs
+	parser      mydump.Parser
+	// colOffsets are the offsets of the output columns in the rows read from the data file.
+	colOffsets []int
+}
+
+// retrieve implements the memTableRetriever interface
+func (e *externalTableRetriever) retrieve(ctx context.Context, sctx sessionctx.Context) ([][]types.Datum, error) {
+	if !e.initialized {
+		e.initialized = true
+		if err := e.initialize(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	exprCtx := sctx.GetExprCtx()
+	maxChunkSize := sctx.GetSessionVars().MaxChunkSize
+	rows := make([][]types.Datum, 0, maxChunkSize)
+	fieldTypes := make([]*types.FieldType, 0, len(e.outputCols))
+	for _, col := range e.outputCols {
+		fieldTypes = append(fieldTypes, &col.FieldType)
+	}
+	mutableRow := chunk.MutRowFromTypes(fieldTypes)
+	for len(rows) < maxChunkSize {
+		if e.parser == nil {
+			if len(e.files) == 0 {
+				break
+			}
+			if err := e.openFile(ctx, e.files[0]); err != nil {
+				return nil, err
+			}
+			e.files = e.files[1:]
+		}
+		err := e.parser.ReadRow()
+		if errors.Cause(err) == io.EOF {
+			err = e.parser.Close()
+			e.parser = nil
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			continue
+		}
+		if err != nil {
+			return nil, exeerrors.ErrLoadDataCantRead.GenWithStackByArgs(err.Error(), "Please check the data files match the definition of the external table")
+		}
+
+		lastRow := e.parser.LastRow()
+		row := make([]types.Datum, len(e.outputCols))
+		for i, col := range e.outputCols {
+			offset := e.colOffsets[i]
+			if offset >= len(lastRow.Row) {
+				return nil, exeerrors.ErrLoadDataCantRead.GenWithStackByArgs(
+					"the number of fields in the data file is less than the number of columns",
+					"Please check the data files match the definition of the external table")
+			}
+			d := lastRow.Row[offset]
+			if d.IsNull() {
+				if mysql.HasNotNullFlag(col.GetFlag()) {
+					return nil, table.ErrColumnCantNull.GenWithStackByArgs(col.Name.O)
+				}
+				continue
+			}
+			row[i], err = table.CastColumnValue(exprCtx, d, col, true, false)
+			if err != nil {
+				return nil, err
+			}
+		}
+		e.parser.RecycleRow(lastRow)
+
+		if len(e.filters) > 0 {
+			mutableRow.SetDatums(row...)
+			matched, _, err := expression.EvalBool(exprCtx.GetEvalCtx(), e.filters, mutableRow.ToRow())
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				continue
+			}
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
+
+func (e *externalTableRetriever) initialize(ctx context.Context) error {
+	// The tables created before SEM is enabled must not read the files with the credentials of TiDB server either.
+	if sem.IsEnabled() {
+		return plannererrors.ErrNotSupportedWithSem.GenWithStackByArgs("external table")
+	}
+	for _, filter := range e.extractor.Filters {
+		resolved, err := filter.ResolveIndices(e.schema)
+		if err != nil {
+			return err
+		}
+		e.filters = append(e.filters, resolved)
+	}
+
+	location := e.table.ExternalInfo.Location
+	u, err := storage.ParseRawURL(location)
+	if err != nil {
+		return exeerrors.ErrLoadDataInvalidURI.GenWithStackByArgs(plannercore.ExternalTableLocation, err.Error())
+	}
+	fileNameKey := strings.Trim(u.Path, "/")
+	u.Path = ""
+	b, err := storage.ParseBackendFromURL(u, nil)
+	if err != nil {
+		return exeerrors.ErrLoadDataInvalidURI.GenWithStackByArgs(plannercore.ExternalTableLocation, importer.GetMsgFromBRError(err))
+	}
+	e.store, err = storage.NewWithDefaultOpt(ctx, b)
+	if err != nil {
+		return exeerrors.ErrLoadDataCantAccess.GenWithStackByArgs(plannercore.ExternalTableLocation, importer.GetMsgFromBRError(err))
+	}
+
+	sourceType := mydump.SourceTypeCSV
+	if e.table.ExternalInfo.Format == model.ExternalTableFormatParquet {
+		sourceType = mydump.SourceTypeParquet
+	}
+	newFileMeta := func(path string, size int64) *mydump.SourceFileMeta {
+		fileMeta := &mydump.SourceFileMeta{Path: path, FileSize: size, Type: sourceType}
+		if sourceType != mydump.SourceTypeParquet {
+			fileMeta.Compression = mydump.ParseCompressionOnFileExtension(path)
+		}
+		return fileMeta
+	}
+
+	// the glob pattern is checked when creating the external table.
+	idx := strings.IndexAny(fileNameKey, "*[")
+	if idx == -1 {
+		fileReader, err := e.store.Open(ctx, fileNameKey, nil)
+		if err != nil {
+			return exeerrors.ErrLoadDataCantRead.GenWithStackByArgs(importer.GetMsgFromBRError(err), "Please check the file location is correct")
+		}
+		size, err := fileReader.GetFileSize()
+		terror.Log(fileReader.Close())
+		if err != nil {
+			return exeerrors.ErrLoadDataCantRead.GenWithStackByArgs(importer.GetMsgFromBRError(err), "failed to get the file size")
+		}
+		e.files = []*mydump.SourceFileMeta{newFileMeta(fileNameKey, size)}
+		return nil
+	}
+	escapedPath := stringutil.EscapeGlobQuestionMark(fileNameKey)
+	err = e.store.WalkDir(ctx, &storage.WalkOption{ObjPrefix: fileNameKey[:idx], SkipSubDir: true},
+		func(remotePath string, size int64) error {
+			//nolint: errcheck
+			match, _ := filepath.Match(escapedPath, remotePath)
+			if match {
+				e.files = append(e.files, newFileMeta(remotePath, size))
+			}
+			return nil
+		})
+	if err != nil {
+		return exeerrors.ErrLoadDataCantRead.GenWithStackByArgs(importer.GetMsgFromBRError(err), "failed to walk dir")
+	}
+	return nil
+}
+
+func (e *externalTableRetriever) openFile(ctx context.Context, fileMeta *mydump.SourceFileMeta) error {
+	path := fileMeta.Path
+	reader, err := mydump.OpenReader(ctx, fileMeta, e.store, storage.DecompressConfig{})
+	if err != nil {
+		return exeerrors.ErrLoadDataCantRead.GenWithStackByArgs(importer.GetMsgFromBRError(err), "Please check the file location is correct")
+	}
+
+	if fileMeta.Type == mydump.SourceTypeParquet {
+		parser, err := mydump.NewParquetParser(ctx, e.store, reader, path)
+		if err != nil {
+			terror.Log(reader.Close())
+			return exeerrors.ErrLoadDataCantRead.GenWithStackByArgs(err.Error(), "Please check the data files are in the parquet format")
+		}
+		// the columns of the parquet files are matched by name.
+		e.colOffsets = e.colOffsets[:0]
+		for _, col := range e.outputCols {
+			offset := -1
+			for i, name := range parser.Columns() {
+				if name == col.Name.L {
+					offset = i
+					break
+				}
+			}
+			if offset < 0 {
+				terror.Log(parser.Close())
+				return exeerrors.ErrLoadDataCantRead.GenWithStackByArgs(
+					"column '"+col.Name.O+"' is not found in the data file '"+path+"'",
+					"Please check the data files match the definition of the external table")
+			}
+			e.colOffsets = append(e.colOffsets, offset)
+		}
+		e.parser = parser
+		return nil
+	}
+
+	// the columns of the CSV files are matched by position, and the files are in the same format as the files
+	// exported by EXPORT TABLE and dumpling.
+	csvConfig := &config.CSVConfig{
+		Separator: ",",
+		Delimiter: `"`,
+		Null:      []string{`\N`},
+		EscapedBy: `\`,
+	}
+	parser, err := mydump.NewCSVParser(ctx, csvConfig, reader, importer.LoadDataReadBlockSize, nil, false, nil)
+	if err != nil {
+		terror.Log(reader.Close())
+		return exeerrors.ErrLoadDataCantRead.GenWithStackByArgs(err.Error(), "Please check the data files are in the CSV format")
+	}
+	e.colOffsets = e.colOffsets[:0]
+	for _, col := range e.outputCols {
+		e.colOffsets = append(e.colOffsets, col.Offset)
+	}
+	e.parser = parser
+	return nil
+}
+
+// close implements the memTableRetriever interface
+func (e *externalTableRetriever) close() error {
+	var err error
+	if e.parser != nil {
+		err = e.parser.Close()
+		e.parser = nil
+	}
+	if e.store != nil {
+		e.store.Close()
+		e.store = nil
+	}
+	return err
+}
+
+// getRuntimeStats implements the memTableRetriever interface
+func (*externalTableRetriever) getRuntimeStats() execdetails.RuntimeStats {
+	return nil
+}
@@ -100,7 +100,7 @@ func (e *ImportIntoExec) Next(ctx context.Context, req *chunk.Chunk) (err error)
 	}
 	e.controller = controller
 
-	if e.selectExec != nil {
+	if e.selectExec != nil || e.plan.SourceDSN != "" {
 		// `import from select` doesn't return rows, so no need to set dataFilled.
 		return e.importFromSelect(ctx)
 	}
@@ -225,6 +225,7 @@ func (e *ImportIntoExec) importFromSelect(ctx context.Context) error {
 	importID := uuid.New().String()
 	logutil.Logger(ctx).Info("importing data from select statement",
 		zap.String("import-id", importID), zap.Int("concurrency", e.controller.ThreadCnt),
+		zap.String("source-dsn", ast.RedactDSN(e.controller.SourceDSN)),
 		zap.String("target-table", e.controller.FullTableName()),
 		zap.Int64("target-table-id", e.controller.TableInfo.ID))
 	ti, err2 := importer.NewTableImporter(ctx, e.controller, importID, e.Ctx().GetStore())
@@ -248,6 +249,9 @@ func (e *ImportIntoExec) importFromSelect(ctx context.Context) error {
 	})
 	eg.Go(func() error {
 		defer close(selectedRowCh)
+		if e.selectExec == nil {
+			return e.controller.ReadSourceQueryRows(egCtx, selectedRowCh)
+		}
 		fields := exec.RetTypes(e.selectExec)
 		var idAllocator int64
 		for {
@@ -0,0 +1,542 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pingcap/errors"
+	tidbkv "github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/lightning/backend/encode"
+	"github.com/pingcap/tidb/pkg/lightning/backend/kv"
+	"github.com/pingcap/tidb/pkg/lightning/common"
+	"github.com/pingcap/tidb/pkg/lightning/log"
+	verify "github.com/pingcap/tidb/pkg/lightning/verification"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/table"
+	"github.com/pingcap/tidb/pkg/table/tables"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
+	"github.com/tikv/client-go/v2/util"
+	"go.uber.org/zap"
+)
+
+// conflict strategies of IMPORT INTO, they decide how to handle the rows
+// conflicting on primary key or unique index.
+const (
+	// ConflictStrategyError fails the job when any conflict is found.
+	ConflictStrategyError = "error"
+	// ConflictStrategySkip keeps the first row in the order of data files.
+	ConflictStrategySkip = "skip"
+	// ConflictStrategyReplace keeps the last row in the order of data files.
+	ConflictStrategyReplace = "replace"
+
+	primaryKeyName = "PRIMARY"
+
+	// ConflictRowsRetention is how long the sample conflicting rows are kept in
+	// mysql.tidb_import_conflicts.
+	ConflictRowsRetention = 7 * 24 * time.Hour
+	// deleteExpiredConflictRowsBatch is the max number of expired conflicting
+	// rows deleted in one statement.
+	deleteExpiredConflictRowsBatch = 1024
+)
+
+// ConflictRow is a sample row conflicting on primary key or unique index.
+type ConflictRow struct {
+	IndexName string
+	RawKey    []byte
+	RowData   string
+}
+
+// ConflictResult is the result of resolving conflicts of a subtask.
+type ConflictResult struct {
+	// ConflictRows is the number of rows dropped because of conflicts, a row
+	// conflicting on multiple keys might be counted more than once.
+	ConflictRows int64
+	// DeletedRows is the number of rows which have been ingested into TiKV and
+	// then deleted when resolving conflicts, it's used to adjust the number of
+	// loaded rows.
+	DeletedRows int64
+	// Samples contains at most ConflictMaxRecordRows conflicting rows.
+	Samples []ConflictRow
+}
+
+// ResolveConflicts resolves the rows conflicting on primary key or unique index
+// found when importing the engines of a subtask, according to the conflict
+// strategy. checksum is adjusted to match the resolved data.
+// Note: only conflicts inside the subtask are detected, so a job with conflict
+// strategy is rejected when it has more than one subtask.
+func (ti *TableImporter) ResolveConflicts(ctx context.Context, store tidbkv.Storage, checksum *verify.KVGroupChecksum) (*ConflictResult, error) {
+	if ti.ConflictStrategy == "" {
+		return &ConflictResult{}, nil
+	}
+	dupeController := ti.backend.GetDupeController(1, nil)
+	return ti.resolveConflicts(ctx, store, checksum, dupeController.IterLocalDuplicateKVs)
+}
+
+// CheckConflicts counts the rows conflicting on primary key or unique index in
+// the closed engines of a subtask before they're imported, it's used by the
+// 'error' conflict strategy to fail the job without ingesting any conflicting
+// data. Only the samples of the rows conflicting on primary key are recorded.
+func (ti *TableImporter) CheckConflicts(ctx context.Context, store tidbkv.Storage, checksum *verify.KVGroupChecksum, engineUUIDs ...uuid.UUID) (*ConflictResult, error) {
+	return ti.resolveConflicts(ctx, store, checksum, func(ctx context.Context, fn func(key, val []byte) error) error {
+		for _, engineUUID := range engineUUIDs {
+			if err := ti.backend.IterEngineDuplicateKVs(ctx, engineUUID, fn); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ResolveConflictKVsForTest resolves conflicts of the given duplicate key-value
+// pairs instead of the ones in the local duplicate db, only used in test.
+func (ti *TableImporter) ResolveConflictKVsForTest(ctx context.Context, store tidbkv.Storage, checksum *verify.KVGroupChecksum, pairs []common.KvPair) (*ConflictResult, error) {
+	return ti.resolveConflicts(ctx, store, checksum, func(ctx context.Context, fn func(key, val []byte) error) error {
+		for _, pair := range pairs {
+			if err := fn(pair.Key, pair.Val); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (ti *TableImporter) resolveConflicts(
+	ctx context.Context,
+	store tidbkv.Storage,
+	checksum *verify.KVGroupChecksum,
+	iterDupKVs func(ctx context.Context, fn func(key, val []byte) error) error,
+) (*ConflictResult, error) {
+	r, err := newConflictResolver(ti.LoadDataController, ti.encTable, store, checksum)
+	if err != nil {
+		return nil, err
+	}
+	if err = iterDupKVs(ctx, func(key, val []byte) error {
+		return r.add(ctx, key, val)
+	}); err != nil {
+		return nil, err
+	}
+	if err = r.flush(ctx); err != nil {
+		return nil, err
+	}
+	return &r.result, nil
+}
+
+// conflictResolver resolves the duplicate key-value pairs recorded when importing
+// engines. The pairs of the same key are grouped and fed in the order of data
+// files, and the first one of each group is the pair ingested into TiKV.
+type conflictResolver struct {
+	strategy   string
+	maxSamples int64
+	tbl        table.Table
+	store      tidbkv.Storage
+	encoder    *kv.BaseKVEncoder
+	decoder    *kv.TableKVDecoder
+	checksum   *verify.KVGroupChecksum
+	logger     *zap.Logger
+
+	curKey  []byte
+	curVals [][]byte
+	result  ConflictResult
+}
+
+func newConflictResolver(e *LoadDataController, tbl table.Table, store tidbkv.Storage, checksum *verify.KVGroupChecksum) (*conflictResolver, error) {
+	sessionOpts := encode.SessionOptions{
+		SQLMode: e.SQLMode,
+		SysVars: e.ImportantSysVars,
+	}
+	logger := log.Logger{Logger: e.logger}
+	encoder, err := kv.NewBaseKVEncoder(&encode.EncodingConfig{
+		Table:          tbl,
+		SessionOptions: sessionOpts,
+		Logger:         logger,
+	})
+	if err != nil {
+		return nil, err
+	}
+	decoder, err := kv.NewTableKVDecoder(tbl, e.FullTableName(), &sessionOpts, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &conflictResolver{
+		strategy:   e.ConflictStrategy,
+		maxSamples: e.ConflictMaxRecordRows,
+		tbl:        tbl,
+		store:      store,
+		encoder:    encoder,
+		decoder:    decoder,
+		checksum:   checksum,
+		logger:     e.logger,
+	}, nil
+}
+
+func (r *conflictResolver) add(ctx context.Context, key, val []byte) error {
+	if r.curKey != nil && !bytes.Equal(r.curKey, key) {
+		if err := r.flush(ctx); err != nil {
+			return err
+		}
+	}
+	r.curKey = key
+	r.curVals = append(r.curVals, val)
+	return nil
+}
+
+func (r *conflictResolver) flush(ctx context.Context) error {
+	if r.curKey == nil {
+		return nil
+	}
+	key, vals := r.curKey, r.curVals
+	r.curKey, r.curVals = nil, nil
+	if len(vals) < 2 {
+		return nil
+	}
+	return r.resolve(ctx, key, vals)
+}
+
+// conflictingRow is a row involved in a conflict, val is nil if the row is
+// not read from TiKV yet.
+type conflictingRow struct {
+	handle tidbkv.Handle
+	key    []byte
+	val    []byte
+}
+
+func (r *conflictResolver) resolve(ctx context.Context, key []byte, vals [][]byte) error {
+	indexName := primaryKeyName
+	var rows []conflictingRow
+	if tablecodec.IsRecordKey(key) {
+		handle, err := tablecodec.DecodeRowKey(key)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		for _, v := range vals {
+			rows = append(rows, conflictingRow{handle: handle, key: key, val: v})
+		}
+		// pairs other than the first one are not ingested.
+		for _, v := range vals[1:] {
+			r.checksum.RemoveOneDataKV(common.KvPair{Key: key, Val: v})
+		}
+	} else {
+		tableID, indexID, _, err := tablecodec.DecodeKeyHead(key)
+		if err != nil {
+			return errors.Trace(err)
+		}
+		var indexInfo *model.IndexInfo
+		for _, idx := range r.tbl.Meta().Indices {
+			if idx.ID == indexID {
+				indexInfo = idx
+				break
+			}
+		}
+		if indexInfo == nil {
+			return errors.Errorf("index %d not found in table %s", indexID, r.tbl.Meta().Name.O)
+		}
+		indexName = indexInfo.Name.O
+		for _, v := range vals[1:] {
+			r.checksum.RemoveOneIndexKV(indexID, common.KvPair{Key: key, Val: v})
+		}
+		seen := make(map[string]struct{}, len(vals))
+		for _, v := range vals {
+			handle, err := r.decoder.DecodeHandleFromIndex(indexInfo, key, v)
+			if err != nil {
+				return errors.Trace(err)
+			}
+			rowKey := tablecodec.EncodeRowKeyWithHandle(tableID, handle)
+			if _, ok := seen[string(rowKey)]; ok {
+				continue
+			}
+			seen[string(rowKey)] = struct{}{}
+			rows = append(rows, conflictingRow{handle: handle, key: rowKey})
+		}
+		// all the pairs come from the same row, which conflicts on its record
+		// key and is resolved there.
+		if len(rows) < 2 {
+			return nil
+		}
+	}
+
+	r.result.ConflictRows += int64(len(rows) - 1)
+	ctx = util.WithInternalSourceType(ctx, tidbkv.InternalImportInto)
+	if err := r.recordSamples(ctx, indexName, key, r.keyMasked(key), rows); err != nil {
+		return err
+	}
+	if r.strategy == ConflictStrategyError {
+		return nil
+	}
+
+	winner := 0
+	if r.strategy == ConflictStrategyReplace {
+		winner = len(rows) - 1
+	}
+	var (
+		removed, added []common.KvPair
+		deletedRows    int64
+	)
+	err := tidbkv.RunInNewTxn(ctx, r.store, true, func(ctx context.Context, txn tidbkv.Transaction) error {
+		removed, added, deletedRows = removed[:0], added[:0], 0
+		// delete the pairs of other rows which are still in TiKV.
+		for i, row := range rows {
+			if i == winner {
+				continue
+			}
+			pairs, err := r.readAndEncodeRow(ctx, txn, row)
+			if err != nil {
+				return err
+			}
+			for _, pair := range pairs {
+				cur, err := txn.Get(ctx, pair.Key)
+				if tidbkv.IsErrNotFound(err) {
+					continue
+				} else if err != nil {
+					return err
+				}
+				if !bytes.Equal(cur, pair.Val) {
+					continue
+				}
+				if err = txn.Delete(pair.Key); err != nil {
+					return err
+				}
+				removed = append(removed, pair)
+				if tablecodec.IsRecordKey(pair.Key) {
+					deletedRows++
+				}
+			}
+		}
+		// then write all pairs of the winner row.
+		pairs, err := r.readAndEncodeRow(ctx, txn, rows[winner])
+		if err != nil || pairs == nil {
+			return err
+		}
+		for _, pair := range pairs {
+			cur, err := txn.Get(ctx, pair.Key)
+			if err == nil {
+				if bytes.Equal(cur, pair.Val) {
+					continue
+				}
+				removed = append(removed, common.KvPair{Key: pair.Key, Val: cur})
+			} else if tidbkv.IsErrNotFound(err) {
+				if tablecodec.IsRecordKey(pair.Key) {
+					// the winner row might be deleted when resolving other keys.
+					deletedRows--
+				}
+			} else {
+				return err
+			}
+			if err = txn.Set(pair.Key, pair.Val); err != nil {
+				return err
+			}
+			added = append(added, pair)
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	for _, pair := range removed {
+		r.updateChecksum(pair, true)
+	}
+	for _, pair := range added {
+		r.updateChecksum(pair, false)
+	}
+	r.result.DeletedRows += deletedRows
+	return nil
+}
+
+// readAndEncodeRow encodes the row into key-value pairs, the row is read from
+// txn if it's not read yet. It returns nil if the row doesn't exist.
+func (r *conflictResolver) readAndEncodeRow(ctx context.Context, txn tidbkv.Retriever, row conflictingRow) ([]common.KvPair, error) {
+	val := row.val
+	if val == nil {
+		var err error
+		val, err = txn.Get(ctx, row.key)
+		if tidbkv.IsErrNotFound(err) {
+			return nil, nil
+		} else if err != nil {
+			return nil, err
+		}
+	}
+	data, _, err := tables.DecodeRawRowData(r.encoder.SessionCtx, r.tbl.Meta(), row.handle, r.tbl.Cols(), val)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	if !r.tbl.Meta().HasClusteredIndex() {
+		// for nonclustered PK, need to append handle to data for AddRecord
+		data = append(data, types.NewIntDatum(row.handle.IntValue()))
+	}
+	if _, err = r.encoder.Table.AddRecord(r.encoder.SessionCtx.GetTableCtx(), data); err != nil {
+		return nil, errors.Trace(err)
+	}
+	return r.encoder.SessionCtx.TakeKvPairs().Pairs, nil
+}
+
+func (r *conflictResolver) updateChecksum(pair common.KvPair, remove bool) {
+	if tablecodec.IsRecordKey(pair.Key) {
+		if remove {
+			r.checksum.RemoveOneDataKV(pair)
+		} else {
+			r.checksum.UpdateOneDataKV(pair)
+		}
+		return
+	}
+	_, indexID, _, err := tablecodec.DecodeKeyHead(pair.Key)
+	if err != nil {
+		// the key is encoded by ourselves, it should not happen.
+		r.logger.Warn("decode index key failed", zap.Error(err))
+		return
+	}
+	if remove {
+		r.checksum.RemoveOneIndexKV(indexID, pair)
+	} else {
+		r.checksum.UpdateOneIndexKV(indexID, pair)
+	}
+}
+
+// keyMasked returns whether the key holds the values of the columns with masking
+// policies.
+func (r *conflictResolver) keyMasked(key []byte) bool {
+	tblInfo := r.tbl.Meta()
+	if len(tblInfo.MaskingPolicies) == 0 {
+		return false
+	}
+	var indexInfo *model.IndexInfo
+	if tablecodec.IsRecordKey(key) {
+		if tblInfo.PKIsHandle {
+			return tblInfo.FindMaskingPolicyByColumn(tblInfo.GetPkName().L) != nil
+		}
+		if !tblInfo.IsCommonHandle {
+			return false
+		}
+		indexInfo = tables.FindPrimaryIndex(tblInfo)
+	} else {
+		_, indexID, _, err := tablecodec.DecodeKeyHead(key)
+		if err != nil {
+			return true
+		}
+		indexInfo = model.FindIndexInfoByID(tblInfo.Indices, indexID)
+	}
+	if indexInfo == nil {
+		return true
+	}
+	for _, idxCol := range indexInfo.Columns {
+		if tblInfo.FindMaskingPolicyByColumn(idxCol.Name.L) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// decodeRowAsStr decodes the row into a string. mysql.tidb_import_conflicts is
+// not protected by the masking policies of the table, so the values of the masked
+// columns are recorded as NULL.
+func (r *conflictResolver) decodeRowAsStr(handle tidbkv.Handle, val []byte) string {
+	tblInfo := r.tbl.Meta()
+	if len(tblInfo.MaskingPolicies) == 0 {
+		return r.decoder.DecodeRawRowDataAsStr(handle, val)
+	}
+	row, _, err := r.decoder.DecodeRawRowData(handle, val)
+	if err == nil {
+		for i, col := range r.tbl.Cols() {
+			if tblInfo.FindMaskingPolicyByColumn(col.Name.L) != nil {
+				row[i].SetNull()
+			}
+		}
+		var res string
+		if res, err = types.DatumsToString(row, true); err == nil {
+			return res
+		}
+	}
+	return fmt.Sprintf("/* ERROR: %s */", err)
+}
+
+// recordSamples records the conflicting rows as samples, the raw key is not
+// recorded if it holds the values of the masked columns.
+func (r *conflictResolver) recordSamples(ctx context.Context, indexName string, key []byte, keyMasked bool, rows []conflictingRow) error {
+	if int64(len(r.result.Samples)) >= r.maxSamples {
+		return nil
+	}
+	snapshot := r.store.GetSnapshot(tidbkv.MaxVersion)
+	for _, row := range rows {
+		if int64(len(r.result.Samples)) >= r.maxSamples {
+			break
+		}
+		val := row.val
+		if val == nil {
+			var err error
+			val, err = snapshot.Get(ctx, row.key)
+			if tidbkv.IsErrNotFound(err) {
+				continue
+			} else if err != nil {
+				return err
+			}
+		}
+		rawKey := key
+		if keyMasked {
+			rawKey = []byte{}
+		}
+		r.result.Samples = append(r.result.Samples, ConflictRow{
+			IndexName: indexName,
+			RawKey:    rawKey,
+			RowData:   r.decodeRowAsStr(row.handle, val),
+		})
+	}
+	return nil
+}
+
+// RecordConflictRows records the sample conflicting rows of a job into
+// mysql.tidb_import_conflicts.
+func RecordConflictRows(ctx context.Context, conn sqlexec.SQLExecutor, jobID int64, dbName, tableName, strategy string, rows []ConflictRow) error {
+	if len(rows) == 0 {
+		return nil
+	}
+	var sb strings.Builder
+	sb.WriteString(`INSERT INTO mysql.tidb_import_conflicts
+		(job_id, table_schema, table_name, index_name, strategy, raw_key, row_data) VALUES `)
+	args := make([]any, 0, len(rows)*7)
+	for i, row := range rows {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString("(%?, %?, %?, %?, %?, %?, %?)")
+		args = append(args, jobID, dbName, tableName, row.IndexName, strategy, row.RawKey, row.RowData)
+	}
+	ctx = util.WithInternalSourceType(ctx, tidbkv.InternalImportInto)
+	_, err := conn.ExecuteInternal(ctx, sb.String(), args...)
+	return err
+}
+
+// DeleteExpiredConflictRows deletes the sample conflicting rows recorded before
+// the retention from mysql.tidb_import_conflicts.
+func DeleteExpiredConflictRows(ctx context.Context, se sessionctx.Context, retention time.Duration) error {
+	ctx = util.WithInternalSourceType(ctx, tidbkv.InternalImportInto)
+	for {
+		_, err := se.GetSQLExecutor().ExecuteInternal(ctx, `DELETE FROM mysql.tidb_import_conflicts
+			WHERE create_time < DATE_SUB(CURRENT_TIMESTAMP(6), INTERVAL %? SECOND) LIMIT %?`,
+			int64(retention/time.Second), deleteExpiredConflictRowsBatch)
+		if err != nil {
+			return err
+		}
+		if se.GetSessionVars().StmtCtx.AffectedRows() < deleteExpiredConflictRowsBatch {
+			return nil
+		}
+	}
+}
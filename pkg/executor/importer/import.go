@@ -79,23 +79,28 @@ const (
 	// 0 means no limit
 	unlimitedWriteSpeed = config.ByteSize(0)
 
-	characterSetOption          = "character_set"
-	fieldsTerminatedByOption    = "fields_terminated_by"
-	fieldsEnclosedByOption      = "fields_enclosed_by"
-	fieldsEscapedByOption       = "fields_escaped_by"
-	fieldsDefinedNullByOption   = "fields_defined_null_by"
-	linesTerminatedByOption     = "lines_terminated_by"
-	skipRowsOption              = "skip_rows"
-	splitFileOption             = "split_file"
-	diskQuotaOption             = "disk_quota"
-	threadOption                = "thread"
-	maxWriteSpeedOption         = "max_write_speed"
-	checksumTableOption         = "checksum_table"
-	recordErrorsOption          = "record_errors"
-	detachedOption              = "detached"
-	disableTiKVImportModeOption = "disable_tikv_import_mode"
-	cloudStorageURIOption       = "cloud_storage_uri"
-	disablePrecheckOption       = "disable_precheck"
+	characterSetOption           = "character_set"
+	fieldsTerminatedByOption     = "fields_terminated_by"
+	fieldsEnclosedByOption       = "fields_enclosed_by"
+	fieldsEscapedByOption        = "fields_escaped_by"
+	fieldsDefinedNullByOption    = "fields_defined_null_by"
+	linesTerminatedByOption      = "lines_terminated_by"
+	skipRowsOption               = "skip_rows"
+	splitFileOption              = "split_file"
+	diskQuotaOption              = "disk_quota"
+	threadOption                 = "thread"
+	maxWriteSpeedOption          = "max_write_speed"
+	checksumTableOption          = "checksum_table"
+	recordErrorsOption           = "record_errors"
+	detachedOption               = "detached"
+	disableTiKVImportModeOption  = "disable_tikv_import_mode"
+	cloudStorageURIOption        = "cloud_storage_uri"
+	disablePrecheckOption        = "disable_precheck"
+	conflictStrategyOption       = "conflict_strategy"
+	conflictMaxRecordRowsOption  = "conflict_max_record_rows"
+	ingestConcurrencyOption      = "ingest_concurrency"
+	regionSplitConcurrencyOption = "region_split_concurrency"
+	splitColumnOption            = "split_column"
 	// used for test
 	maxEngineSizeOption = "__max_engine_size"
 	forceMergeStep      = "__force_merge_step"
@@ -105,25 +110,30 @@ var (
 	// all supported options.
 	// name -> whether the option has value
 	supportedOptions = map[string]bool{
-		characterSetOption:          true,
-		fieldsTerminatedByOption:    true,
-		fieldsEnclosedByOption:      true,
-		fieldsEscapedByOption:       true,
-		fieldsDefinedNullByOption:   true,
-		linesTerminatedByOption:     true,
-		skipRowsOption:              true,
-		splitFileOption:             false,
-		diskQuotaOption:             true,
-		threadOption:                true,
-		maxWriteSpeedOption:         true,
-		checksumTableOption:         true,
-		recordErrorsOption:          true,
-		detachedOption:              false,
-		disableTiKVImportModeOption: false,
-		maxEngineSizeOption:         true,
-		forceMergeStep:              false,
-		cloudStorageURIOption:       true,
-		disablePrecheckOption:       false,
+		characterSetOption:           true,
+		fieldsTerminatedByOption:     true,
+		fieldsEnclosedByOption:       true,
+		fieldsEscapedByOption:        true,
+		fieldsDefinedNullByOption:    true,
+		linesTerminatedByOption:      true,
+		skipRowsOption:               true,
+		splitFileOption:              false,
+		diskQuotaOption:              true,
+		threadOption:                 true,
+		maxWriteSpeedOption:          true,
+		checksumTableOption:          true,
+		recordErrorsOption:           true,
+		detachedOption:               false,
+		disableTiKVImportModeOption:  false,
+		maxEngineSizeOption:          true,
+		forceMergeStep:               false,
+		cloudStorageURIOption:        true,
+		disablePrecheckOption:        false,
+		conflictStrategyOption:       true,
+		conflictMaxRecordRowsOption:  true,
+		ingestConcurrencyOption:      true,
+		regionSplitConcurrencyOption: true,
+		splitColumnOption:            true,
 	}
 
 	csvOnlyOptions = map[string]struct{}{
@@ -140,6 +150,7 @@ var (
 	allowedOptionsOfImportFromQuery = map[string]struct{}{
 		threadOption:          {},
 		disablePrecheckOption: {},
+		splitColumnOption:     {},
 	}
 
 	// LoadDataReadBlockSize is exposed for test.
@@ -235,6 +246,22 @@ type Plan struct {
 	MaxEngineSize         config.ByteSize
 	CloudStorageURI       string
 	DisablePrecheck       bool
+	// ConflictStrategy is how rows conflicting on primary key or unique index
+	// are handled, empty means conflicts are not detected during ingest and
+	// will be reported by checksum.
+	ConflictStrategy      string
+	ConflictMaxRecordRows int64
+	// IngestConcurrency and RegionSplitConcurrency are throughput hints of the
+	// local backend, 0 means use the default value.
+	IngestConcurrency      int
+	RegionSplitConcurrency int
+	// SourceDSN and SourceQuery are set when importing from the query result
+	// of another MySQL-protocol server.
+	SourceDSN   string
+	SourceQuery string
+	// SplitColumn is the integer column used to split the source query into
+	// ranges which are read concurrently, only used with SourceDSN.
+	SplitColumn string
 
 	// used for checksum in physical mode
 	DistSQLScanConcurrency int
@@ -418,6 +445,16 @@ func NewImportPlan(ctx context.Context, userSctx sessionctx.Context, plan *plann
 		InImportInto:           true,
 		DataSourceType:         getDataSourceType(plan),
 		User:                   userSctx.GetSessionVars().User.String(),
+
+		SourceDSN:   plan.SourceDSN,
+		SourceQuery: plan.SourceQuery,
+	}
+	if p.SourceDSN != "" {
+		dsn, err := sanitizeSourceDSN(p.SourceDSN)
+		if err != nil {
+			return nil, exeerrors.ErrLoadDataInvalidURI.FastGenByArgs(plannercore.ImportIntoDataSource, err.Error())
+		}
+		p.SourceDSN = dsn
 	}
 	if err := p.initOptions(ctx, userSctx, plan.Options); err != nil {
 		return nil, err
@@ -543,6 +580,7 @@ func (p *Plan) initDefaultOptions(targetNodeCPUCnt int) {
 	p.MaxWriteSpeed = unlimitedWriteSpeed
 	p.SplitFile = false
 	p.MaxRecordedErrors = 100
+	p.ConflictMaxRecordRows = 100
 	p.Detached = false
 	p.DisableTiKVImportMode = false
 	p.MaxEngineSize = config.ByteSize(defaultMaxEngineSize)
@@ -748,6 +786,40 @@ func (p *Plan) initOptions(ctx context.Context, seCtx sessionctx.Context, option
 	if _, ok := specifiedOptions[forceMergeStep]; ok {
 		p.ForceMergeStep = true
 	}
+	if opt, ok := specifiedOptions[conflictStrategyOption]; ok {
+		v, err := optAsString(opt)
+		if err != nil {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		v = strings.ToLower(v)
+		switch v {
+		case ConflictStrategyError, ConflictStrategySkip, ConflictStrategyReplace:
+		default:
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		p.ConflictStrategy = v
+	}
+	if opt, ok := specifiedOptions[conflictMaxRecordRowsOption]; ok {
+		vInt, err := optAsInt64(opt)
+		if err != nil || vInt < 0 {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		p.ConflictMaxRecordRows = vInt
+	}
+	if opt, ok := specifiedOptions[ingestConcurrencyOption]; ok {
+		vInt, err := optAsInt64(opt)
+		if err != nil || vInt <= 0 {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		p.IngestConcurrency = int(vInt)
+	}
+	if opt, ok := specifiedOptions[regionSplitConcurrencyOption]; ok {
+		vInt, err := optAsInt64(opt)
+		if err != nil || vInt <= 0 {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		p.RegionSplitConcurrency = int(vInt)
+	}
 
 	// when split-file is set, data file will be split into chunks of 256 MiB.
 	// skip_rows should be 0 or 1, we add this restriction to simplify skip_rows
@@ -757,6 +829,26 @@ func (p *Plan) initOptions(ctx context.Context, seCtx sessionctx.Context, option
 	if p.SplitFile && p.IgnoreLines > 1 {
 		return exeerrors.ErrInvalidOptionVal.FastGenByArgs("skip_rows, should be <= 1 when split-file is enabled")
 	}
+	// conflicts are detected on the local duplicate db of each engine, which
+	// is not available when using global sort.
+	if p.CloudStorageURI != "" {
+		if _, ok := specifiedOptions[conflictStrategyOption]; ok {
+			return exeerrors.ErrLoadDataUnsupportedOption.FastGenByArgs(conflictStrategyOption, "global sort")
+		}
+	}
+	if opt, ok := specifiedOptions[splitColumnOption]; ok {
+		if p.SourceDSN == "" {
+			return exeerrors.ErrLoadDataUnsupportedOption.FastGenByArgs(opt.Name, "import without source DSN")
+		}
+		v, err := optAsString(opt)
+		if err != nil || v == "" {
+			return exeerrors.ErrInvalidOptionVal.FastGenByArgs(opt.Name)
+		}
+		p.SplitColumn = v
+	}
+	if _, ok := specifiedOptions[conflictMaxRecordRowsOption]; ok && p.ConflictStrategy == "" {
+		return exeerrors.ErrInvalidOptionVal.FastGenByArgs("conflict_max_record_rows, should be used with conflict_strategy")
+	}
 
 	p.adjustOptions(targetNodeCPUCnt)
 	return nil
@@ -1333,6 +1425,18 @@ func (e *LoadDataController) getLocalBackendCfg(pdAddr, dataDir string) local.Ba
 		DisableAutomaticCompactions: true,
 		BlockSize:                   config.DefaultBlockSize,
 	}
+	if e.IngestConcurrency > 0 {
+		backendConfig.WorkerConcurrency = e.IngestConcurrency
+	}
+	if e.RegionSplitConcurrency > 0 {
+		backendConfig.RegionSplitConcurrency = e.RegionSplitConcurrency
+	}
+	if e.ConflictStrategy != "" {
+		// duplicates are recorded into the duplicate db when importing engines,
+		// and resolved by ResolveConflicts after all engines of the subtask
+		// are imported.
+		backendConfig.DupeDetectEnabled = true
+	}
 	if e.IsRaftKV2 {
 		backendConfig.RaftKV2SwitchModeDuration = config.DefaultSwitchTiKVModeInterval
 	}
@@ -1345,7 +1449,7 @@ func (e *LoadDataController) FullTableName() string {
 }
 
 func getDataSourceType(p *plannercore.ImportInto) DataSourceType {
-	if p.SelectPlan != nil {
+	if p.SelectPlan != nil || p.SourceDSN != "" {
 		return DataSourceTypeQuery
 	}
 	return DataSourceTypeFile
@@ -142,6 +142,24 @@ func TestInitOptionsPositiveCase(t *testing.T) {
 	require.Equal(t, config.ByteSize(100<<30), plan.MaxEngineSize, sql)
 	require.Empty(t, plan.CloudStorageURI, sql)
 	require.True(t, plan.DisablePrecheck, sql)
+	require.Empty(t, plan.ConflictStrategy, sql)
+	require.Equal(t, int64(100), plan.ConflictMaxRecordRows, sql)
+
+	// conflict strategy and throughput hints
+	sql5 := fmt.Sprintf(sqlTemplate, conflictStrategyOption+"='REPLACE', "+
+		conflictMaxRecordRowsOption+"=10, "+
+		ingestConcurrencyOption+"=16, "+
+		regionSplitConcurrencyOption+"=8",
+	)
+	stmt5, err := p.ParseOneStmt(sql5, "", "")
+	require.NoError(t, err, sql5)
+	plan = &Plan{Format: DataFormatCSV}
+	err = plan.initOptions(ctx, sctx, convertOptions(stmt5.(*ast.ImportIntoStmt).Options))
+	require.NoError(t, err, sql5)
+	require.Equal(t, ConflictStrategyReplace, plan.ConflictStrategy, sql5)
+	require.Equal(t, int64(10), plan.ConflictMaxRecordRows, sql5)
+	require.Equal(t, 16, plan.IngestConcurrency, sql5)
+	require.Equal(t, 8, plan.RegionSplitConcurrency, sql5)
 
 	// set cloud storage uri
 	variable.CloudStorageURI.Store("s3://bucket/path")
@@ -306,6 +324,18 @@ func TestGetLocalBackendCfg(t *testing.T) {
 	require.Equal(t, "/tmp", cfg.LocalStoreDir)
 	require.True(t, cfg.DisableAutomaticCompactions)
 	require.Zero(t, cfg.RaftKV2SwitchModeDuration)
+	require.False(t, cfg.DupeDetectEnabled)
+	require.Equal(t, runtime.GOMAXPROCS(0), cfg.RegionSplitConcurrency)
+
+	c.Plan.ConflictStrategy = ConflictStrategySkip
+	c.Plan.ThreadCnt = 2
+	c.Plan.IngestConcurrency = 16
+	c.Plan.RegionSplitConcurrency = 8
+	cfg = c.getLocalBackendCfg("http://1.1.1.1:1234", "/tmp")
+	require.True(t, cfg.DupeDetectEnabled)
+	require.False(t, cfg.DuplicateDetectOpt.ReportErrOnDup)
+	require.Equal(t, 16, cfg.WorkerConcurrency)
+	require.Equal(t, 8, cfg.RegionSplitConcurrency)
 
 	c.Plan.IsRaftKV2 = true
 	cfg = c.getLocalBackendCfg("http://1.1.1.1:1234", "/tmp")
@@ -15,10 +15,12 @@
 package importer_test
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"os"
 	"path"
+	"slices"
 	"testing"
 	"time"
 
@@ -30,9 +32,12 @@ import (
 	"github.com/pingcap/tidb/pkg/executor/importer"
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/lightning/backend/encode"
+	lkv "github.com/pingcap/tidb/pkg/lightning/backend/kv"
 	"github.com/pingcap/tidb/pkg/lightning/checkpoints"
 	"github.com/pingcap/tidb/pkg/lightning/common"
 	"github.com/pingcap/tidb/pkg/lightning/config"
+	"github.com/pingcap/tidb/pkg/lightning/log"
 	"github.com/pingcap/tidb/pkg/lightning/mydump"
 	verify "github.com/pingcap/tidb/pkg/lightning/verification"
 	"github.com/pingcap/tidb/pkg/meta/autoid"
@@ -42,6 +47,7 @@ import (
 	"github.com/pingcap/tidb/pkg/planner/core/base"
 	"github.com/pingcap/tidb/pkg/session"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
@@ -376,3 +382,207 @@ func TestPopulateChunks(t *testing.T) {
 	require.Len(t, engines[0].Chunks, 2)
 	require.Len(t, engines[1].Chunks, 1)
 }
+
+func TestResolveConflicts(t *testing.T) {
+	ctx := context.Background()
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tidbCfg := tidb.GetGlobalConfig()
+	tidbCfg.TempDir = t.TempDir()
+	fileName := path.Join(tidbCfg.TempDir, "test.csv")
+	require.NoError(t, os.WriteFile(fileName, []byte("1,10,100\n"), 0o644))
+	keyspace := store.GetCodec().GetKeyspace()
+	do, err := session.GetDomain(store)
+	require.NoError(t, err)
+
+	updateChecksum := func(c *verify.KVGroupChecksum, pair common.KvPair) {
+		if tablecodec.IsRecordKey(pair.Key) {
+			c.UpdateOneDataKV(pair)
+			return
+		}
+		_, indexID, _, err := tablecodec.DecodeKeyHead(pair.Key)
+		require.NoError(t, err)
+		c.UpdateOneIndexKV(indexID, pair)
+	}
+
+	// rows in the order of data files, (1, 20, 200) conflicts with (1, 10, 100)
+	// on primary key, and (2, 10, 300) conflicts with (1, 10, 100) on unique key.
+	rows := [][]types.Datum{
+		types.MakeDatums(1, 10, 100),
+		types.MakeDatums(1, 20, 200),
+		types.MakeDatums(2, 10, 300),
+	}
+	cases := []struct {
+		strategy     string
+		expected     []string
+		conflictRows int64
+		deletedRows  int64
+	}{
+		{importer.ConflictStrategyError, []string{"1 10 100", "2 10 300"}, 2, 0},
+		{importer.ConflictStrategySkip, []string{"1 10 100"}, 2, 1},
+		{importer.ConflictStrategyReplace, []string{"1 20 200", "2 10 300"}, 2, 0},
+	}
+	for _, c := range cases {
+		tk.MustExec("drop table if exists test.t")
+		tk.MustExec("create table test.t(id int primary key, u int, v int, unique key uk(u))")
+		table, err := do.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+		require.NoError(t, err)
+
+		ti := getTableImporter(ctx, t, store, "t", fileName, []*plannercore.LoadDataOpt{
+			{Name: "conflict_strategy", Value: expression.NewStrConst(c.strategy)},
+			{Name: "conflict_max_record_rows", Value: expression.NewInt64Const(1)},
+		})
+
+		// encode the rows, and ingest the first pair of each key like the local
+		// backend with duplicate detection.
+		encoder, err := lkv.NewBaseKVEncoder(&encode.EncodingConfig{
+			Table: table,
+			SessionOptions: encode.SessionOptions{
+				SQLMode: ti.SQLMode,
+				SysVars: ti.ImportantSysVars,
+			},
+			Logger: log.Logger{Logger: zap.NewExample()},
+		})
+		require.NoError(t, err)
+		var pairs []common.KvPair
+		for _, row := range rows {
+			_, err = encoder.Table.AddRecord(encoder.SessionCtx.GetTableCtx(), row)
+			require.NoError(t, err)
+			pairs = append(pairs, encoder.SessionCtx.TakeKvPairs().Pairs...)
+		}
+		slices.SortStableFunc(pairs, func(a, b common.KvPair) int {
+			return bytes.Compare(a.Key, b.Key)
+		})
+		checksum := verify.NewKVGroupChecksumWithKeyspace(keyspace)
+		var dupPairs []common.KvPair
+		txn, err := store.Begin()
+		require.NoError(t, err)
+		for i, pair := range pairs {
+			updateChecksum(checksum, pair)
+			dupWithPrev := i > 0 && bytes.Equal(pairs[i-1].Key, pair.Key)
+			dupWithNext := i < len(pairs)-1 && bytes.Equal(pairs[i+1].Key, pair.Key)
+			if dupWithPrev || dupWithNext {
+				dupPairs = append(dupPairs, pair)
+			}
+			if !dupWithPrev {
+				require.NoError(t, txn.Set(pair.Key, pair.Val))
+			}
+		}
+		require.NoError(t, txn.Commit(ctx))
+
+		result, err := ti.ResolveConflictKVsForTest(ctx, store, checksum, dupPairs)
+		ti.Backend().CloseEngineMgr()
+		require.NoError(t, err, c.strategy)
+		require.Equal(t, c.conflictRows, result.ConflictRows, c.strategy)
+		require.Equal(t, c.deletedRows, result.DeletedRows, c.strategy)
+		require.Len(t, result.Samples, 1, c.strategy)
+		tk.MustQuery("select * from test.t use index() order by id").Check(testkit.Rows(c.expected...))
+		if c.strategy != importer.ConflictStrategyError {
+			tk.MustExec("admin check table test.t")
+		}
+
+		// the adjusted checksum matches the data in TiKV.
+		expected := verify.NewKVGroupChecksumWithKeyspace(keyspace)
+		txn, err = store.Begin()
+		require.NoError(t, err)
+		prefix := tablecodec.GenTablePrefix(table.Meta().ID)
+		iter, err := txn.Iter(prefix, prefix.PrefixNext())
+		require.NoError(t, err)
+		for ; iter.Valid(); require.NoError(t, iter.Next()) {
+			updateChecksum(expected, common.KvPair{Key: iter.Key().Clone(), Val: slices.Clone(iter.Value())})
+		}
+		iter.Close()
+		require.NoError(t, txn.Rollback())
+		actualMap := checksum.GetInnerChecksums()
+		for id, cksum := range expected.GetInnerChecksums() {
+			require.Equal(t, *cksum, *actualMap[id], c.strategy)
+		}
+	}
+}
+
+func TestRecordConflictRows(t *testing.T) {
+	ctx := context.Background()
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tidbCfg := tidb.GetGlobalConfig()
+	tidbCfg.TempDir = t.TempDir()
+	fileName := path.Join(tidbCfg.TempDir, "test.csv")
+	require.NoError(t, os.WriteFile(fileName, []byte("1,10,a\n"), 0o644))
+	do, err := session.GetDomain(store)
+	require.NoError(t, err)
+
+	tk.MustExec("create table test.t(id int primary key, u int, v varchar(10), unique key uk(v))")
+	tk.MustExec("create masking policy m on test.t (v) using full")
+	table, err := do.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	ti := getTableImporter(ctx, t, store, "t", fileName, []*plannercore.LoadDataOpt{
+		{Name: "conflict_strategy", Value: expression.NewStrConst(importer.ConflictStrategyError)},
+	})
+	defer ti.Backend().CloseEngineMgr()
+
+	// (1, 20, 'b') conflicts with (1, 10, 'a') on primary key, and (2, 30, 'a')
+	// conflicts with (1, 10, 'a') on unique key, the first pair of each key is
+	// ingested.
+	encoder, err := lkv.NewBaseKVEncoder(&encode.EncodingConfig{
+		Table: table,
+		SessionOptions: encode.SessionOptions{
+			SQLMode: ti.SQLMode,
+			SysVars: ti.ImportantSysVars,
+		},
+		Logger: log.Logger{Logger: zap.NewExample()},
+	})
+	require.NoError(t, err)
+	var pairs []common.KvPair
+	for _, row := range [][]types.Datum{
+		types.MakeDatums(1, 10, "a"),
+		types.MakeDatums(1, 20, "b"),
+		types.MakeDatums(2, 30, "a"),
+	} {
+		_, err = encoder.Table.AddRecord(encoder.SessionCtx.GetTableCtx(), row)
+		require.NoError(t, err)
+		pairs = append(pairs, encoder.SessionCtx.TakeKvPairs().Pairs...)
+	}
+	slices.SortStableFunc(pairs, func(a, b common.KvPair) int {
+		return bytes.Compare(a.Key, b.Key)
+	})
+	var dupPairs []common.KvPair
+	txn, err := store.Begin()
+	require.NoError(t, err)
+	for i, pair := range pairs {
+		dupWithPrev := i > 0 && bytes.Equal(pairs[i-1].Key, pair.Key)
+		dupWithNext := i < len(pairs)-1 && bytes.Equal(pairs[i+1].Key, pair.Key)
+		if dupWithPrev || dupWithNext {
+			dupPairs = append(dupPairs, pair)
+		}
+		if !dupWithPrev {
+			require.NoError(t, txn.Set(pair.Key, pair.Val))
+		}
+	}
+	require.NoError(t, txn.Commit(ctx))
+	checksum := verify.NewKVGroupChecksumWithKeyspace(store.GetCodec().GetKeyspace())
+	result, err := ti.ResolveConflictKVsForTest(ctx, store, checksum, dupPairs)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, result.ConflictRows)
+
+	// the values of the masked column are not recorded, neither is the key of
+	// the unique index on it.
+	require.NoError(t, importer.RecordConflictRows(ctx, tk.Session().GetSQLExecutor(), 1, "test", "t",
+		importer.ConflictStrategyError, result.Samples))
+	tk.MustQuery("select job_id, table_schema, table_name, index_name, strategy, length(raw_key) > 0, row_data " +
+		"from mysql.tidb_import_conflicts order by id").Check(testkit.Rows(
+		"1 test t uk error 0 (1, 10, NULL)",
+		"1 test t uk error 0 (2, 30, NULL)",
+		"1 test t PRIMARY error 1 (1, 10, NULL)",
+		"1 test t PRIMARY error 1 (1, 20, NULL)",
+	))
+	require.NoError(t, importer.RecordConflictRows(ctx, tk.Session().GetSQLExecutor(), 1, "test", "t",
+		importer.ConflictStrategyError, nil))
+	tk.MustQuery("select count(*) from mysql.tidb_import_conflicts").Check(testkit.Rows("4"))
+
+	// only the rows recorded before the retention are deleted.
+	require.NoError(t, importer.DeleteExpiredConflictRows(ctx, tk.Session(), time.Hour))
+	tk.MustQuery("select count(*) from mysql.tidb_import_conflicts").Check(testkit.Rows("4"))
+	tk.MustExec("update mysql.tidb_import_conflicts set create_time = '2000-01-01' where index_name = 'PRIMARY'")
+	require.NoError(t, importer.DeleteExpiredConflictRows(ctx, tk.Session(), time.Hour))
+	tk.MustQuery("select index_name from mysql.tidb_import_conflicts").Check(testkit.Rows("uk", "uk"))
+}
@@ -101,6 +101,9 @@ func (ip *ImportParameters) String() string {
 type JobSummary struct {
 	// ImportedRows is the number of rows imported into TiKV.
 	ImportedRows uint64 `json:"imported-rows,omitempty"`
+	// ConflictRows is the number of rows dropped because of conflicts when
+	// conflict_strategy is set.
+	ConflictRows uint64 `json:"conflict-rows,omitempty"`
 }
 
 // JobInfo is the information of import into job.
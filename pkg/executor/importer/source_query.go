@@ -0,0 +1,302 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	gomysql "github.com/go-sql-driver/mysql"
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/lightning/common"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// the source query is split into rangesPerSourceReader*ThreadCnt ranges,
+	// so the readers are balanced when the split column is skewed.
+	rangesPerSourceReader = 4
+	// max retry count of reading a range from its checkpoint.
+	maxSourceRangeRetry      = 3
+	sourceRangeRetryInterval = time.Second
+	sourceQueryAlias         = "`_import_source`"
+)
+
+// sourceRange is a range [start, end] of the split column.
+type sourceRange struct {
+	start int64
+	end   int64
+	// checkpoint is the value of split column of the last row sent to
+	// encoders, the range is resumed from it after retryable errors.
+	checkpoint    int64
+	hasCheckpoint bool
+}
+
+func (r *sourceRange) String() string {
+	return fmt.Sprintf("[%d, %d]", r.start, r.end)
+}
+
+// sourceQueryReader reads the result of the source query from another
+// MySQL-protocol server.
+type sourceQueryReader struct {
+	db          *sql.DB
+	query       string
+	splitColumn string
+	// number of fields of each row.
+	fieldCnt    int
+	concurrency int
+	logger      *zap.Logger
+
+	idAllocator atomic.Int64
+	rowCh       chan<- QueryRow
+}
+
+// ReadSourceQueryRows reads rows of the source query from SourceDSN, and sends
+// them to rowCh. If SplitColumn is set, the query is split into ranges of the
+// column which are read concurrently, and each range is read in the order of
+// the column, so it can be resumed from the last row sent after connection
+// errors, the column must be unique and not NULL in the result of the query.
+// Otherwise, the query is read by a single connection which cannot be resumed,
+// so errors are returned directly.
+// The rows are ingested when the statement finishes, so the checkpoints are
+// kept in memory only, and a failed statement is re-executed from the start.
+func (e *LoadDataController) ReadSourceQueryRows(ctx context.Context, rowCh chan<- QueryRow) error {
+	dsn, err := sanitizeSourceDSN(e.SourceDSN)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer func() {
+		if err := db.Close(); err != nil {
+			e.logger.Warn("close source db failed", zap.Error(err))
+		}
+	}()
+	db.SetMaxOpenConns(e.ThreadCnt)
+	db.SetMaxIdleConns(e.ThreadCnt)
+
+	return e.newSourceQueryReader(db, rowCh).read(ctx)
+}
+
+// sanitizeSourceDSN parses the DSN of the source cluster and disables the
+// options which are unsafe for the TiDB host, the source server is chosen by
+// the user, so it must not be able to read local files by LOCAL INFILE.
+func sanitizeSourceDSN(dsn string) (string, error) {
+	cfg, err := gomysql.ParseDSN(dsn)
+	if err != nil {
+		return "", err
+	}
+	cfg.AllowAllFiles = false
+	return cfg.FormatDSN(), nil
+}
+
+func (e *LoadDataController) newSourceQueryReader(db *sql.DB, rowCh chan<- QueryRow) *sourceQueryReader {
+	return &sourceQueryReader{
+		db:          db,
+		query:       e.SourceQuery,
+		splitColumn: e.SplitColumn,
+		fieldCnt:    len(e.FieldMappings),
+		concurrency: e.ThreadCnt,
+		logger:      e.logger,
+		rowCh:       rowCh,
+	}
+}
+
+func (r *sourceQueryReader) read(ctx context.Context) error {
+	if r.splitColumn == "" {
+		return r.readRange(ctx, nil)
+	}
+	ranges, err := r.splitRanges(ctx)
+	if err != nil {
+		return err
+	}
+	r.logger.Info("split source query", zap.String("split-column", r.splitColumn),
+		zap.Int("ranges", len(ranges)), zap.Int("concurrency", r.concurrency))
+
+	eg, egCtx := errgroup.WithContext(ctx)
+	rangeCh := make(chan *sourceRange)
+	eg.Go(func() error {
+		defer close(rangeCh)
+		for _, rg := range ranges {
+			select {
+			case rangeCh <- rg:
+			case <-egCtx.Done():
+				return egCtx.Err()
+			}
+		}
+		return nil
+	})
+	for i := 0; i < r.concurrency; i++ {
+		eg.Go(func() error {
+			for rg := range rangeCh {
+				if err := r.readRange(egCtx, rg); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	return eg.Wait()
+}
+
+// splitRanges splits the value range of the split column into ranges of equal
+// width, it returns nil if the query result is empty. The ranges are resumed
+// from the value of the last row sent, so the split column must be unique and
+// not NULL, otherwise the rows with the same value are lost after resuming.
+func (r *sourceQueryReader) splitRanges(ctx context.Context) ([]*sourceRange, error) {
+	col := common.EscapeIdentifier(r.splitColumn)
+	query := fmt.Sprintf("SELECT MIN(%s), MAX(%s), COUNT(*), COUNT(DISTINCT %s) FROM (%s) AS %s",
+		col, col, col, r.query, sourceQueryAlias)
+	var minVal, maxVal sql.NullInt64
+	var rowCnt, distinctCnt int64
+	if err := r.db.QueryRowContext(ctx, query).Scan(&minVal, &maxVal, &rowCnt, &distinctCnt); err != nil {
+		return nil, errors.Annotatef(err, "get range of split column %s", r.splitColumn)
+	}
+	// COUNT(DISTINCT) doesn't count NULL.
+	if rowCnt != distinctCnt {
+		return nil, errors.Errorf("split column %s of source query should be unique and not NULL", r.splitColumn)
+	}
+	if !minVal.Valid || !maxVal.Valid {
+		return nil, nil
+	}
+	return splitSourceRange(minVal.Int64, maxVal.Int64, r.concurrency*rangesPerSourceReader), nil
+}
+
+func splitSourceRange(minVal, maxVal int64, count int) []*sourceRange {
+	// use uint64 to avoid overflow when the range is wider than MaxInt64.
+	width := uint64(maxVal-minVal)/uint64(count) + 1
+	ranges := make([]*sourceRange, 0, count)
+	for start := minVal; ; {
+		if uint64(maxVal-start) < width {
+			ranges = append(ranges, &sourceRange{start: start, end: maxVal})
+			return ranges
+		}
+		end := start + int64(width) - 1
+		ranges = append(ranges, &sourceRange{start: start, end: end})
+		start = end + 1
+	}
+}
+
+// readRange reads the rows of rg, or the whole query if rg is nil. when rg is
+// not nil, reading is retried from the checkpoint on retryable errors.
+func (r *sourceQueryReader) readRange(ctx context.Context, rg *sourceRange) error {
+	for retry := 0; ; retry++ {
+		err := r.readRangeOnce(ctx, rg)
+		if err == nil || rg == nil || retry >= maxSourceRangeRetry || !common.IsRetryableError(err) {
+			return err
+		}
+		r.logger.Warn("read source query failed, retry from checkpoint",
+			zap.Stringer("range", rg), zap.Int64("checkpoint", rg.checkpoint),
+			zap.Bool("has-checkpoint", rg.hasCheckpoint), zap.Int("retry", retry), zap.Error(err))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(sourceRangeRetryInterval):
+		}
+	}
+}
+
+func (r *sourceQueryReader) readRangeOnce(ctx context.Context, rg *sourceRange) error {
+	query, args := r.query, []any(nil)
+	if rg != nil {
+		col := common.EscapeIdentifier(r.splitColumn)
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "SELECT * FROM (%s) AS %s WHERE ", r.query, sourceQueryAlias)
+		if rg.hasCheckpoint {
+			fmt.Fprintf(&sb, "%s > ?", col)
+			args = append(args, rg.checkpoint)
+		} else {
+			fmt.Fprintf(&sb, "%s >= ?", col)
+			args = append(args, rg.start)
+		}
+		fmt.Fprintf(&sb, " AND %s <= ? ORDER BY %s", col, col)
+		args = append(args, rg.end)
+		query = sb.String()
+	}
+	rows, err := r.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return errors.Trace(err)
+	}
+	if len(columns) != r.fieldCnt {
+		return plannererrors.ErrWrongValueCountOnRow.GenWithStackByArgs(1)
+	}
+	splitColIdx := -1
+	if rg != nil {
+		for i, name := range columns {
+			if strings.EqualFold(name, r.splitColumn) {
+				splitColIdx = i
+				break
+			}
+		}
+		if splitColIdx < 0 {
+			return errors.Errorf("split column %s not found in the result of source query", r.splitColumn)
+		}
+	}
+
+	values := make([]sql.RawBytes, len(columns))
+	dest := make([]any, len(columns))
+	for i := range values {
+		dest[i] = &values[i]
+	}
+	for rows.Next() {
+		if err = rows.Scan(dest...); err != nil {
+			return errors.Trace(err)
+		}
+		// the values are casted to the column types when encoding, same as
+		// the fields of CSV files.
+		data := make([]types.Datum, len(values))
+		for i, v := range values {
+			if v == nil {
+				data[i].SetNull()
+			} else {
+				data[i].SetString(string(v), "")
+			}
+		}
+		var splitVal int64
+		if rg != nil {
+			if values[splitColIdx] == nil {
+				return errors.Errorf("split column %s of source query should not be NULL", r.splitColumn)
+			}
+			if splitVal, err = strconv.ParseInt(string(values[splitColIdx]), 10, 64); err != nil {
+				return errors.Annotatef(err, "split column %s of source query should be integer", r.splitColumn)
+			}
+		}
+		select {
+		case r.rowCh <- QueryRow{ID: r.idAllocator.Add(1), Data: data}:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if rg != nil {
+			rg.checkpoint, rg.hasCheckpoint = splitVal, true
+		}
+	}
+	return errors.Trace(rows.Err())
+}
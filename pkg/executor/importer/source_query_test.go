@@ -0,0 +1,162 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importer
+
+import (
+	"context"
+	"math"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/go-sql-driver/mysql"
+	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+)
+
+func TestSplitSourceRange(t *testing.T) {
+	ranges := splitSourceRange(1, 8, 4)
+	require.Equal(t, []*sourceRange{{start: 1, end: 2}, {start: 3, end: 4}, {start: 5, end: 6}, {start: 7, end: 8}}, ranges)
+	ranges = splitSourceRange(1, 10, 4)
+	require.Equal(t, []*sourceRange{{start: 1, end: 3}, {start: 4, end: 6}, {start: 7, end: 9}, {start: 10, end: 10}}, ranges)
+	ranges = splitSourceRange(5, 5, 4)
+	require.Equal(t, []*sourceRange{{start: 5, end: 5}}, ranges)
+
+	ranges = splitSourceRange(math.MinInt64, math.MaxInt64, 2)
+	require.Len(t, ranges, 2)
+	require.Equal(t, int64(math.MinInt64), ranges[0].start)
+	require.Equal(t, ranges[0].end+1, ranges[1].start)
+	require.Equal(t, int64(math.MaxInt64), ranges[1].end)
+}
+
+func readAllSourceRows(t *testing.T, r *sourceQueryReader) ([][]string, error) {
+	rowCh := make(chan QueryRow, 100)
+	r.rowCh = rowCh
+	r.idAllocator.Store(0)
+	err := r.read(context.Background())
+	close(rowCh)
+	var rows [][]string
+	var lastID int64
+	for row := range rowCh {
+		require.Equal(t, lastID+1, row.ID)
+		lastID = row.ID
+		strs := make([]string, 0, len(row.Data))
+		for _, d := range row.Data {
+			if d.IsNull() {
+				strs = append(strs, "<nil>")
+			} else {
+				strs = append(strs, d.GetString())
+			}
+		}
+		rows = append(rows, strs)
+	}
+	return rows, err
+}
+
+func TestSanitizeSourceDSN(t *testing.T) {
+	dsn, err := sanitizeSourceDSN("root:pwd@tcp(127.0.0.1:3306)/test?allowAllFiles=true&charset=utf8mb4")
+	require.NoError(t, err)
+	cfg, err := mysql.ParseDSN(dsn)
+	require.NoError(t, err)
+	require.False(t, cfg.AllowAllFiles)
+	require.Equal(t, "root", cfg.User)
+	require.Equal(t, "pwd", cfg.Passwd)
+	require.Equal(t, "127.0.0.1:3306", cfg.Addr)
+	require.Equal(t, "test", cfg.DBName)
+	require.NotContains(t, dsn, "allowAllFiles")
+
+	_, err = sanitizeSourceDSN("invalid-dsn")
+	require.ErrorContains(t, err, "invalid DSN")
+}
+
+func TestSourceQueryReader(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		mock.ExpectClose()
+		require.NoError(t, db.Close())
+		require.NoError(t, mock.ExpectationsWereMet())
+	})
+	r := &sourceQueryReader{
+		db:          db,
+		query:       "SELECT `id`,`v` FROM `t`",
+		fieldCnt:    2,
+		concurrency: 1,
+		logger:      zap.NewNop(),
+	}
+
+	// without split column
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`v` FROM `t`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}).AddRow(1, "a").AddRow(2, nil))
+	rows, err := readAllSourceRows(t, r)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"1", "a"}, {"2", "<nil>"}}, rows)
+
+	// not retried without split column
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`v` FROM `t`")).WillReturnError(mysql.ErrInvalidConn)
+	_, err = readAllSourceRows(t, r)
+	require.ErrorIs(t, err, mysql.ErrInvalidConn)
+
+	// column count mismatch
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT `id`,`v` FROM `t`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	_, err = readAllSourceRows(t, r)
+	require.ErrorIs(t, err, plannererrors.ErrWrongValueCountOnRow)
+
+	// with split column, ranges are [1, 2], [3, 4], [5, 6], [7, 8]
+	r.splitColumn = "id"
+	rangeOfID := regexp.QuoteMeta("SELECT MIN(`id`), MAX(`id`), COUNT(*), COUNT(DISTINCT `id`) FROM (SELECT `id`,`v` FROM `t`) AS `_import_source`")
+	mock.ExpectQuery(rangeOfID).
+		WillReturnRows(sqlmock.NewRows([]string{"min", "max", "cnt", "distinct_cnt"}).AddRow(1, 8, 4, 4))
+	rangeQuery := regexp.QuoteMeta("SELECT * FROM (SELECT `id`,`v` FROM `t`) AS `_import_source` WHERE `id` >= ? AND `id` <= ? ORDER BY `id`")
+	resumeQuery := regexp.QuoteMeta("SELECT * FROM (SELECT `id`,`v` FROM `t`) AS `_import_source` WHERE `id` > ? AND `id` <= ? ORDER BY `id`")
+	// connection broken after the first row, resumed from it.
+	mock.ExpectQuery(rangeQuery).WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}).AddRow(1, "a").AddRow(2, "b").RowError(1, mysql.ErrInvalidConn))
+	mock.ExpectQuery(resumeQuery).WithArgs(1, 2).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}).AddRow(2, "b"))
+	mock.ExpectQuery(rangeQuery).WithArgs(3, 4).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}).AddRow(3, "c"))
+	mock.ExpectQuery(rangeQuery).WithArgs(5, 6).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "v"}))
+	mock.ExpectQuery(rangeQuery).WithArgs(7, 8).
+		WillReturnRows(sqlmock.NewRows([]string{"ID", "v"}).AddRow(8, "d"))
+	rows, err = readAllSourceRows(t, r)
+	require.NoError(t, err)
+	require.Equal(t, [][]string{{"1", "a"}, {"2", "b"}, {"3", "c"}, {"8", "d"}}, rows)
+
+	// empty result
+	mock.ExpectQuery(rangeOfID).
+		WillReturnRows(sqlmock.NewRows([]string{"min", "max", "cnt", "distinct_cnt"}).AddRow(nil, nil, 0, 0))
+	rows, err = readAllSourceRows(t, r)
+	require.NoError(t, err)
+	require.Empty(t, rows)
+
+	// the rows with the duplicated or NULL values of the split column would be lost after resuming.
+	mock.ExpectQuery(rangeOfID).
+		WillReturnRows(sqlmock.NewRows([]string{"min", "max", "cnt", "distinct_cnt"}).AddRow(1, 8, 5, 4))
+	_, err = readAllSourceRows(t, r)
+	require.ErrorContains(t, err, "split column id of source query should be unique and not NULL")
+
+	// split column not in the result
+	r.splitColumn = "x"
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT MIN(`x`), MAX(`x`), COUNT(*), COUNT(DISTINCT `x`) FROM (SELECT `id`,`v` FROM `t`) AS `_import_source`")).
+		WillReturnRows(sqlmock.NewRows([]string{"min", "max", "cnt", "distinct_cnt"}).AddRow(1, 1, 1, 1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM (SELECT `id`,`v` FROM `t`) AS `_import_source` WHERE `x` >= ? AND `x` <= ? ORDER BY `x`")).
+		WithArgs(1, 1).WillReturnRows(sqlmock.NewRows([]string{"id", "v"}).AddRow(1, "a"))
+	_, err = readAllSourceRows(t, r)
+	require.ErrorContains(t, err, "split column x not found")
+}
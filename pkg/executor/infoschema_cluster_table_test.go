@@ -397,7 +397,7 @@ func TestTableStorageStats(t *testing.T) {
 		"test 2",
 	))
 	rows := tk.MustQuery("select TABLE_NAME from information_schema.TABLE_STORAGE_STATS where TABLE_SCHEMA = 'mysql';").Rows()
-	result := 54
+	result := 59
 	require.Len(t, rows, result)
 
 	// More tests about the privileges.
@@ -60,6 +60,7 @@ import (
 	"github.com/pingcap/tidb/pkg/store/helper"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/tablecodec"
+	ttlcache "github.com/pingcap/tidb/pkg/ttl/cache"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/chunk"
@@ -73,12 +74,15 @@ import (
 	"github.com/pingcap/tidb/pkg/util/keydecoder"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/memory"
+	"github.com/pingcap/tidb/pkg/util/planregression"
 	"github.com/pingcap/tidb/pkg/util/resourcegrouptag"
 	"github.com/pingcap/tidb/pkg/util/sem"
 	"github.com/pingcap/tidb/pkg/util/servermemorylimit"
 	"github.com/pingcap/tidb/pkg/util/set"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
 	"github.com/pingcap/tidb/pkg/util/stringutil"
 	"github.com/pingcap/tidb/pkg/util/syncutil"
+	"github.com/pingcap/tidb/pkg/util/tableaccess"
 	"github.com/tikv/client-go/v2/tikv"
 	"github.com/tikv/client-go/v2/tikvrpc"
 	"github.com/tikv/client-go/v2/txnkv/txnlock"
@@ -128,6 +132,10 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			err = e.setDataFromReferConst(sctx, dbs)
 		case infoschema.TableSequences:
 			e.setDataFromSequences(sctx, dbs)
+		case infoschema.TableTiDBSequenceCache:
+			e.setDataFromSequenceCache(sctx, dbs)
+		case infoschema.TableTiDBTTLTableBacklog:
+			err = e.setDataFromTTLTableBacklog(sctx, dbs)
 		case infoschema.TablePartitions:
 			err = e.setDataFromPartitions(sctx, dbs)
 		case infoschema.TableClusterInfo:
@@ -210,6 +218,24 @@ func (e *memtableRetriever) retrieve(ctx context.Context, sctx sessionctx.Contex
 			e.setDataFromIndexUsage(sctx, dbs)
 		case infoschema.ClusterTableTiDBIndexUsage:
 			err = e.setDataForClusterIndexUsage(sctx, dbs)
+		case infoschema.TablePlanRegressionEvents:
+			err = e.setDataForPlanRegressionEvents(sctx)
+		case infoschema.ClusterTablePlanRegressionEvents:
+			err = e.setDataForClusterPlanRegressionEvents(sctx)
+		case infoschema.TableTiDBTableCacheAdvice:
+			e.setDataForTableCacheAdvice(sctx)
+		case infoschema.ClusterTableTiDBTableCacheAdvice:
+			err = e.setDataForClusterTableCacheAdvice(sctx)
+		case infoschema.TableTiDBHotRegionSplitAdvice:
+			e.setDataForHotRegionSplitAdvice(sctx)
+		case infoschema.ClusterTableTiDBHotRegionSplitAdvice:
+			err = e.setDataForClusterHotRegionSplitAdvice(sctx)
+		case infoschema.TableTableAccessStats:
+			err = e.setDataForTableAccessStats(sctx, dbs)
+		case infoschema.TableTiDBDropIndexAdvice:
+			err = e.setDataForDropIndexAdvice(ctx, sctx)
+		case infoschema.TableOptimizerHints:
+			e.setDataFromOptimizerHints()
 		}
 		if err != nil {
 			return nil, err
@@ -2470,6 +2496,212 @@ func (e *memtableRetriever) setDataFromSequences(ctx sessionctx.Context, schemas
 	e.rows = rows
 }
 
+// setDataFromSequenceCache constructs data for the values cached by the sequences in this instance and in the session.
+func (e *memtableRetriever) setDataFromSequenceCache(ctx sessionctx.Context, schemas []model.CIStr) {
+	checker := privilege.GetPrivilegeManager(ctx)
+	sessionRanges := ctx.GetSessionVars().SequenceState.GetAllRanges()
+	var rows [][]types.Datum
+	for _, schema := range schemas {
+		tables := e.is.SchemaTables(schema)
+		for _, tbl := range tables {
+			tblInfo := tbl.Meta()
+			if !tblInfo.IsSequence() {
+				continue
+			}
+			if checker != nil && !checker.RequestVerification(ctx.GetSessionVars().ActiveRoles, schema.L, tblInfo.Name.L, "", mysql.AllPrivMask) {
+				continue
+			}
+			seq, ok := tbl.(util.SequenceTable)
+			if !ok {
+				continue
+			}
+			next, last, remaining := seq.GetSequenceCacheRange()
+			rows = append(rows, sequenceCacheRow(schema.O, tblInfo.Name.O, "INSTANCE", next, last, remaining))
+			if r, ok := sessionRanges[tblInfo.ID]; ok && r.Info == tblInfo.Sequence {
+				rows = append(rows, sequenceCacheRow(schema.O, tblInfo.Name.O, "SESSION", r.Next, r.Last, r.Remaining))
+			}
+		}
+	}
+	e.rows = rows
+}
+
+func sequenceCacheRow(schema, name, scope string, next, last int64, remaining uint64) []types.Datum {
+	row := types.MakeDatums(
+		schema,    // SEQUENCE_SCHEMA
+		name,      // SEQUENCE_NAME
+		scope,     // SCOPE
+		next,      // NEXT_VALUE
+		last,      // LAST_VALUE
+		remaining, // REMAINING
+	)
+	if remaining == 0 {
+		row[3].SetNull()
+		row[4].SetNull()
+	}
+	return row
+}
+
+type ttlJobBacklog struct {
+	jobID          string
+	jobStatus      string
+	jobStartTime   any
+	lastFinishTime any
+	waitingTasks   uint64
+	runningTasks   uint64
+	scannedRows    uint64
+	deletedRows    uint64
+}
+
+// loadTTLJobBacklogs reads the current TTL job and its tasks of each physical table from the TTL system tables.
+func loadTTLJobBacklogs(sctx sessionctx.Context) (map[int64]*ttlJobBacklog, error) {
+	const statusSQL = "SELECT table_id, current_job_id, current_job_status, CONVERT_TZ(current_job_start_time, @@TIME_ZONE, '+00:00'), CONVERT_TZ(last_job_finish_time, @@TIME_ZONE, '+00:00') FROM mysql.tidb_ttl_table_status"
+	const taskSQL = "SELECT table_id, job_id, status, state FROM mysql.tidb_ttl_task"
+	exec := sctx.GetRestrictedSQLExecutor()
+	kctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnTTL)
+	toSessionTime := func(row chunk.Row, idx int) (any, error) {
+		if row.IsNull(idx) {
+			return nil, nil
+		}
+		t, err := row.GetTime(idx).GoTime(time.UTC)
+		if err != nil {
+			return nil, err
+		}
+		return types.NewTime(types.FromGoTime(t.In(sctx.GetSessionVars().TimeZone)), mysql.TypeDatetime, 0), nil
+	}
+
+	statusRows, _, err := exec.ExecRestrictedSQL(kctx, nil, statusSQL)
+	if err != nil {
+		return nil, err
+	}
+	backlogs := make(map[int64]*ttlJobBacklog, len(statusRows))
+	for _, row := range statusRows {
+		backlog := &ttlJobBacklog{}
+		if !row.IsNull(1) {
+			backlog.jobID = row.GetString(1)
+		}
+		if !row.IsNull(2) {
+			backlog.jobStatus = row.GetString(2)
+		}
+		if backlog.jobStartTime, err = toSessionTime(row, 3); err != nil {
+			return nil, err
+		}
+		if backlog.lastFinishTime, err = toSessionTime(row, 4); err != nil {
+			return nil, err
+		}
+		backlogs[row.GetInt64(0)] = backlog
+	}
+
+	taskRows, _, err := exec.ExecRestrictedSQL(kctx, nil, taskSQL)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range taskRows {
+		backlog, ok := backlogs[row.GetInt64(0)]
+		// the tasks of the finished jobs are not counted
+		if !ok || len(backlog.jobID) == 0 || backlog.jobID != row.GetString(1) {
+			continue
+		}
+		switch row.GetString(2) {
+		case "waiting":
+			backlog.waitingTasks++
+		case "running":
+			backlog.runningTasks++
+		}
+		if row.IsNull(3) {
+			continue
+		}
+		var state ttlcache.TTLTaskState
+		if err := json.Unmarshal([]byte(row.GetString(3)), &state); err != nil {
+			logutil.BgLogger().Warn("invalid TTL task state", zap.String("state", row.GetString(3)), zap.Error(err))
+			continue
+		}
+		backlog.scannedRows += state.TotalRows
+		backlog.deletedRows += state.SuccessRows
+	}
+	return backlogs, nil
+}
+
+func (e *memtableRetriever) setDataFromTTLTableBacklog(ctx sessionctx.Context, schemas []model.CIStr) error {
+	checker := privilege.GetPrivilegeManager(ctx)
+	backlogs, err := loadTTLJobBacklogs(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	globalWindowStart := variable.TTLJobScheduleWindowStartTime.Load().Format(variable.FullDayTimeFormat)
+	globalWindowEnd := variable.TTLJobScheduleWindowEndTime.Load().Format(variable.FullDayTimeFormat)
+	var rows [][]types.Datum
+	for _, schema := range schemas {
+		tables := e.is.SchemaTables(schema)
+		for _, tbl := range tables {
+			tblInfo := tbl.Meta()
+			ttlInfo := tblInfo.TTLInfo
+			if ttlInfo == nil {
+				continue
+			}
+			if checker != nil && !checker.RequestVerification(ctx.GetSessionVars().ActiveRoles, schema.L, tblInfo.Name.L, "", mysql.AllPrivMask) {
+				continue
+			}
+
+			windowStart, windowEnd := ttlInfo.JobWindowStart, ttlInfo.JobWindowEnd
+			if len(windowStart) == 0 {
+				windowStart = globalWindowStart
+			}
+			if len(windowEnd) == 0 {
+				windowEnd = globalWindowEnd
+			}
+			inJobWindow := "NO"
+			if ttlcache.InJobWindow(ttlInfo, now) {
+				inJobWindow = "YES"
+			}
+			var resourceGroup any
+			if len(ttlInfo.ResourceGroup) > 0 {
+				resourceGroup = ttlInfo.ResourceGroup
+			}
+
+			appendRow := func(partitionName any, physicalID int64) {
+				backlog, ok := backlogs[physicalID]
+				if !ok {
+					backlog = &ttlJobBacklog{}
+				}
+				var jobID, jobStatus any
+				if len(backlog.jobID) > 0 {
+					jobID, jobStatus = backlog.jobID, backlog.jobStatus
+				}
+				rows = append(rows, types.MakeDatums(
+					schema.O,                // TABLE_SCHEMA
+					tblInfo.Name.O,          // TABLE_NAME
+					partitionName,           // PARTITION_NAME
+					physicalID,              // TABLE_ID
+					windowStart,             // JOB_WINDOW_START
+					windowEnd,               // JOB_WINDOW_END
+					inJobWindow,             // IN_JOB_WINDOW
+					ttlInfo.DeleteRateLimit, // DELETE_RATE_LIMIT
+					resourceGroup,           // RESOURCE_GROUP
+					jobID,                   // CURRENT_JOB_ID
+					jobStatus,               // CURRENT_JOB_STATUS
+					backlog.jobStartTime,    // CURRENT_JOB_START_TIME
+					backlog.waitingTasks,    // WAITING_TASKS
+					backlog.runningTasks,    // RUNNING_TASKS
+					backlog.scannedRows,     // SCANNED_ROWS
+					backlog.deletedRows,     // DELETED_ROWS
+					backlog.lastFinishTime,  // LAST_JOB_FINISH_TIME
+				))
+			}
+			if tblInfo.Partition == nil {
+				appendRow(nil, tblInfo.ID)
+				continue
+			}
+			for _, def := range tblInfo.Partition.Definitions {
+				appendRow(def.Name.O, def.ID)
+			}
+		}
+	}
+	e.rows = rows
+	return nil
+}
+
 // dataForTableTiFlashReplica constructs data for table tiflash replica info.
 func (e *memtableRetriever) dataForTableTiFlashReplica(ctx sessionctx.Context, schemas []model.CIStr) {
 	var (
@@ -2675,6 +2907,164 @@ func (e *memtableRetriever) setDataForClusterMemoryUsageOpsHistory(ctx sessionct
 	return nil
 }
 
+func (e *memtableRetriever) setDataForPlanRegressionEvents(ctx sessionctx.Context) error {
+	e.rows = planregression.GlobalDetector.GetRows(ctx.GetSessionVars().User, hasPriv(ctx, mysql.ProcessPriv))
+	return nil
+}
+
+func (e *memtableRetriever) setDataForClusterPlanRegressionEvents(ctx sessionctx.Context) error {
+	err := e.setDataForPlanRegressionEvents(ctx)
+	if err != nil {
+		return err
+	}
+	rows, err := infoschema.AppendHostInfoToRows(ctx, e.rows)
+	if err != nil {
+		return err
+	}
+	e.rows = rows
+	return nil
+}
+
+func (e *memtableRetriever) setDataForTableCacheAdvice(ctx sessionctx.Context) {
+	advices := domain.GetDomain(ctx).TableCacheAdvisor().Advices()
+	checker := privilege.GetPrivilegeManager(ctx)
+	rows := make([][]types.Datum, 0, len(advices))
+	for _, advice := range advices {
+		if checker != nil && !checker.RequestVerification(ctx.GetSessionVars().ActiveRoles,
+			strings.ToLower(advice.Schema), strings.ToLower(advice.Table), "", mysql.AllPrivMask) {
+			continue
+		}
+		rows = append(rows, types.MakeDatums(
+			types.NewTime(types.FromGoTime(advice.Time), mysql.TypeTimestamp, types.MaxFsp),
+			advice.Schema,
+			advice.Table,
+			advice.ReadCount,
+			advice.WriteCount,
+			advice.Size,
+			advice.Advice,
+			advice.Status,
+		))
+	}
+	e.rows = rows
+}
+
+func (e *memtableRetriever) setDataForClusterTableCacheAdvice(ctx sessionctx.Context) error {
+	e.setDataForTableCacheAdvice(ctx)
+	rows, err := infoschema.AppendHostInfoToRows(ctx, e.rows)
+	if err != nil {
+		return err
+	}
+	e.rows = rows
+	return nil
+}
+
+func (e *memtableRetriever) setDataForHotRegionSplitAdvice(ctx sessionctx.Context) {
+	advices := domain.GetDomain(ctx).SplitAdvisor().Advices()
+	checker := privilege.GetPrivilegeManager(ctx)
+	rows := make([][]types.Datum, 0, len(advices))
+	for _, advice := range advices {
+		// the split keys of the advice contain the values of the table.
+		if checker != nil && !checker.RequestVerification(ctx.GetSessionVars().ActiveRoles,
+			strings.ToLower(advice.Schema), strings.ToLower(advice.Table), "", mysql.AllPrivMask) {
+			continue
+		}
+		row := types.MakeDatums(
+			types.NewTime(types.FromGoTime(advice.Time), mysql.TypeTimestamp, types.MaxFsp),
+			advice.Schema,
+			advice.Table,
+			nil, // PARTITION_NAME
+			nil, // INDEX_NAME
+			advice.RegionID,
+			advice.FlowBytes,
+			advice.Regions,
+			nil, // SPLIT_SQL
+			nil, // NOTE
+			strings.Join(advice.SQLDigests, ","),
+			advice.Status,
+		)
+		if advice.Partition != "" {
+			row[3].SetString(advice.Partition, mysql.DefaultCollationName)
+		}
+		if advice.Index != "" {
+			row[4].SetString(advice.Index, mysql.DefaultCollationName)
+		}
+		if advice.SplitSQL != "" {
+			row[8].SetString(advice.SplitSQL, mysql.DefaultCollationName)
+		}
+		if advice.Note != "" {
+			row[9].SetString(advice.Note, mysql.DefaultCollationName)
+		}
+		rows = append(rows, row)
+	}
+	e.rows = rows
+}
+
+func (e *memtableRetriever) setDataForClusterHotRegionSplitAdvice(ctx sessionctx.Context) error {
+	e.setDataForHotRegionSplitAdvice(ctx)
+	rows, err := infoschema.AppendHostInfoToRows(ctx, e.rows)
+	if err != nil {
+		return err
+	}
+	e.rows = rows
+	return nil
+}
+
+// setDataForTableAccessStats lists every table and index with the access counts persisted for the whole cluster and
+// the ones not flushed yet by the current instance, so the tables and the indexes never accessed are listed as well.
+func (e *memtableRetriever) setDataForTableAccessStats(sctx sessionctx.Context, schemas []model.CIStr) error {
+	kctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	accessStats, err := tableaccess.GlobalCollector.Load(kctx, sctx.GetRestrictedSQLExecutor())
+	if err != nil {
+		return err
+	}
+	checker := privilege.GetPrivilegeManager(sctx)
+	newRow := func(schema model.CIStr, tbl *model.TableInfo, idx *model.IndexInfo) []types.Datum {
+		key := tableaccess.Key{TableID: tbl.ID}
+		var idxName any
+		if idx != nil {
+			key.IndexID = idx.ID
+			idxName = idx.Name.O
+		}
+		stats := accessStats[key]
+		var lastAccessTime any
+		if !stats.LastAccessTime.IsZero() {
+			lastAccessTime = types.NewTime(types.FromGoTime(stats.LastAccessTime), mysql.TypeTimestamp, types.MaxFsp)
+		}
+		return types.MakeDatums(schema.O, tbl.Name.O, idxName, stats.Scans, stats.PointGets, lastAccessTime)
+	}
+
+	rows := make([][]types.Datum, 0, 100)
+	for _, schema := range schemas {
+		if util.IsMemDB(schema.L) {
+			continue
+		}
+		for _, tbl := range e.is.SchemaTables(schema) {
+			tbl := tbl.Meta()
+			if tbl.IsView() || tbl.IsSequence() || tbl.TempTableType == model.TempTableLocal {
+				continue
+			}
+			if checker != nil && !checker.RequestVerification(sctx.GetSessionVars().ActiveRoles, schema.L, tbl.Name.L, "", mysql.AllPrivMask) {
+				continue
+			}
+			rows = append(rows, newRow(schema, tbl, nil))
+			for _, idx := range tbl.Indices {
+				rows = append(rows, newRow(schema, tbl, idx))
+			}
+		}
+	}
+	e.rows = rows
+	return nil
+}
+
+func (e *memtableRetriever) setDataForDropIndexAdvice(ctx context.Context, sctx sessionctx.Context) error {
+	advices, err := adviseDropIndexes(ctx, sctx, e.is, nil)
+	if err != nil {
+		return err
+	}
+	e.rows = dropIndexAdviceRows(advices)
+	return nil
+}
+
 // tidbTrxTableRetriever is the memtable retriever for the TIDB_TRX and CLUSTER_TIDB_TRX table.
 type tidbTrxTableRetriever struct {
 	dummyCloser
@@ -3070,6 +3460,29 @@ func (r *deadlocksTableRetriever) retrieve(ctx context.Context, sctx sessionctx.
 				return errors.Trace(err1)
 			}
 		}
+		// Retrieve the bindings and the hints of the plans if necessary.
+		var provenances map[deadlockPlanKey]*deadlockPlanProvenance
+		for _, c := range r.columns {
+			if c.Name.O != deadlockhistory.ColCurrentBindingSQLStr && c.Name.O != deadlockhistory.ColCurrentAppliedHintsStr {
+				continue
+			}
+			provenances = make(map[deadlockPlanKey]*deadlockPlanProvenance)
+			idx, waitChainIdx := r.currentIdx, r.currentWaitChainIdx
+			for i := start; i < end; i++ {
+				if idx >= len(r.deadlocks) {
+					return errors.New("reading information_schema.(cluster_)deadlocks table meets corrupted index")
+				}
+				item := r.deadlocks[idx].WaitChain[waitChainIdx]
+				if len(item.SQLDigest) > 0 && len(item.PlanDigest) > 0 {
+					provenances[deadlockPlanKey{sqlDigest: item.SQLDigest, planDigest: item.PlanDigest}] = &deadlockPlanProvenance{}
+				}
+				idx, waitChainIdx = r.nextIndexPair(idx, waitChainIdx)
+			}
+			if err1 := retrieveDeadlockPlanProvenances(ctx, sctx.GetRestrictedSQLExecutor(), provenances); err1 != nil {
+				return errors.Trace(err1)
+			}
+			break
+		}
 
 		res = make([][]types.Datum, 0, end-start)
 
@@ -3091,6 +3504,18 @@ func (r *deadlocksTableRetriever) retrieve(ctx context.Context, sctx sessionctx.
 					} else {
 						row = append(row, types.NewDatum(nil))
 					}
+				} else if c.Name.O == deadlockhistory.ColCurrentBindingSQLStr || c.Name.O == deadlockhistory.ColCurrentAppliedHintsStr {
+					value := types.NewDatum(nil)
+					if provenance, ok := provenances[deadlockPlanKey{sqlDigest: waitChainItem.SQLDigest, planDigest: waitChainItem.PlanDigest}]; ok {
+						text := provenance.bindingSQL
+						if c.Name.O == deadlockhistory.ColCurrentAppliedHintsStr {
+							text = provenance.appliedHints
+						}
+						if len(text) > 0 {
+							value = types.NewDatum(text)
+						}
+					}
+					row = append(row, value)
 				} else if c.Name.O == deadlockhistory.ColKeyInfoStr {
 					value := types.NewDatum(nil)
 					if len(waitChainItem.Key) > 0 {
@@ -3127,6 +3552,59 @@ func (r *deadlocksTableRetriever) retrieve(ctx context.Context, sctx sessionctx.
 	return res, nil
 }
 
+// deadlockPlanKey identifies a plan of a statement involved in a deadlock.
+type deadlockPlanKey struct {
+	sqlDigest  string
+	planDigest string
+}
+
+// deadlockPlanProvenance is where a plan of a statement involved in a deadlock comes from, that is the binding and
+// the hints used to generate it.
+type deadlockPlanProvenance struct {
+	bindingSQL   string
+	appliedHints string
+}
+
+// retrieveDeadlockPlanProvenances fills the bindings and the hints of the plans in `provenances` from the statements
+// summary of this instance. The most recent information is preferred since it's the closest to the deadlock.
+func retrieveDeadlockPlanProvenances(ctx context.Context, exec sqlexec.RestrictedSQLExecutor, provenances map[deadlockPlanKey]*deadlockPlanProvenance) error {
+	if len(provenances) == 0 {
+		return nil
+	}
+	digests := make(map[string]struct{}, len(provenances))
+	for key := range provenances {
+		digests[key.sqlDigest] = struct{}{}
+	}
+	inValues := make([]any, 0, len(digests)*2)
+	for digest := range digests {
+		inValues = append(inValues, digest)
+	}
+	inValues = append(inValues, inValues...)
+	inClause := "(" + strings.Repeat("%?,", len(digests)-1) + "%?)"
+	stmt := "select digest, plan_digest, last_binding_sql, last_applied_hints from information_schema.statements_summary where digest in " + inClause +
+		" union all select digest, plan_digest, last_binding_sql, last_applied_hints from information_schema.statements_summary_history where digest in " + inClause
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil, stmt, inValues...)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	resolved := make(map[deadlockPlanKey]struct{}, len(provenances))
+	for _, row := range rows {
+		key := deadlockPlanKey{sqlDigest: row.GetString(0), planDigest: row.GetString(1)}
+		provenance, ok := provenances[key]
+		if !ok {
+			continue
+		}
+		if _, ok := resolved[key]; ok {
+			continue
+		}
+		resolved[key] = struct{}{}
+		provenance.bindingSQL = row.GetString(2)
+		provenance.appliedHints = row.GetString(3)
+	}
+	return nil
+}
+
 func adjustColumns(input [][]types.Datum, outColumns []*model.ColumnInfo, table *model.TableInfo) [][]types.Datum {
 	if len(outColumns) == len(table.Columns) {
 		return input
@@ -3575,6 +4053,20 @@ func (e *memtableRetriever) setDataFromKeywords() error {
 	return nil
 }
 
+func (e *memtableRetriever) setDataFromOptimizerHints() {
+	hints := hint.SupportedHints()
+	rows := make([][]types.Datum, 0, len(hints))
+	for _, h := range hints {
+		allowedInBinding := "NO"
+		if h.AllowedInBinding {
+			allowedInBinding = "YES"
+		}
+		row := types.MakeDatums(h.Name, h.Arguments, string(h.Scope), allowedInBinding, h.Description)
+		rows = append(rows, row)
+	}
+	e.rows = rows
+}
+
 func (e *memtableRetriever) setDataFromIndexUsage(ctx sessionctx.Context, schemas []model.CIStr) {
 	dom := domain.GetDomain(ctx)
 	rows := make([][]types.Datum, 0, 100)
@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/hint"
 	"github.com/stretchr/testify/require"
 )
 
@@ -158,3 +159,22 @@ func TestSetDataFromKeywords(t *testing.T) {
 	require.Equal(t, types.NewStringDatum("ADD"), mt.rows[0][0]) // Keyword: ADD
 	require.Equal(t, types.NewIntDatum(1), mt.rows[0][1])        // Reserved: true(1)
 }
+
+func TestSetDataFromOptimizerHints(t *testing.T) {
+	mt := memtableRetriever{}
+	mt.setDataFromOptimizerHints()
+	require.Len(t, mt.rows, len(hint.SupportedHints()))
+	var hashJoin []types.Datum
+	for i, row := range mt.rows {
+		if i > 0 {
+			require.Less(t, mt.rows[i-1][0].GetString(), row[0].GetString()) // sorted by name
+		}
+		if row[0].GetString() == hint.HintHJ {
+			hashJoin = row
+		}
+	}
+	require.NotNil(t, hashJoin)
+	require.Equal(t, types.NewStringDatum("[@query_block_name] tbl_name [, tbl_name ...]"), hashJoin[1]) // Arguments
+	require.Equal(t, types.NewStringDatum("TABLE"), hashJoin[2])                                         // Scope
+	require.Equal(t, types.NewStringDatum("YES"), hashJoin[3])                                           // Allowed in binding
+}
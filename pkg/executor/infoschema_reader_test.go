@@ -26,12 +26,15 @@ import (
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/tidb/pkg/domain/infosync"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/store/mockstore"
 	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/util/deadlockhistory"
 	"github.com/pingcap/tidb/pkg/util/stringutil"
+	"github.com/pingcap/tidb/pkg/util/tableaccess"
 	"github.com/stretchr/testify/require"
 	"github.com/tikv/client-go/v2/tikv"
 	"github.com/tikv/client-go/v2/tikvrpc"
@@ -627,3 +630,142 @@ func TestReferencedTableSchemaWithForeignKey(t *testing.T) {
 	WHERE table_name = 't2' AND table_schema = 'test2';`).Check(testkit.Rows(
 		"id id t1 test2 test"))
 }
+
+func TestTableAccessStats(t *testing.T) {
+	collector := tableaccess.GlobalCollector
+	tableaccess.GlobalCollector = tableaccess.NewCollector()
+	defer func() {
+		tableaccess.GlobalCollector = collector
+	}()
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, a int, b int, key ia(a), key ib(b))")
+	tk.MustExec("insert into t values (1, 1, 1), (2, 2, 2)")
+	tk.MustQuery("select * from t where id = 1").Check(testkit.Rows("1 1 1"))
+	tk.MustQuery("select * from t where id in (1, 2)").Sort().Check(testkit.Rows("1 1 1", "2 2 2"))
+	tk.MustQuery("select * from t use index(ia) where a = 2").Check(testkit.Rows("2 2 2"))
+	tk.MustQuery("select b from t use index(ib) where b > 1").Check(testkit.Rows("2"))
+	tk.MustQuery("select * from t ignore index(ia, ib) where a > 1").Check(testkit.Rows("2 2 2"))
+	// the statements in EXPLAIN are not executed.
+	tk.MustQuery("explain select * from t use index(ia) where a > 1")
+	const query = "select index_name, scans, point_gets, last_access_time is not null from information_schema.table_access_stats where table_schema = 'test' and table_name = 't' order by index_name"
+	tk.MustQuery(query).Check(testkit.Rows(
+		"<nil> 1 2 1",
+		"ia 1 0 1",
+		"ib 1 0 1",
+	))
+
+	// the accesses are persisted and accumulated.
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnStats)
+	require.NoError(t, tableaccess.GlobalCollector.Flush(ctx, tk.Session().GetRestrictedSQLExecutor()))
+	require.Empty(t, tableaccess.GlobalCollector.Pending())
+	tk.MustQuery("select s.index_id = 0, s.scans, s.point_gets from mysql.tidb_table_access_stats s, information_schema.tables t " +
+		"where s.table_id = t.tidb_table_id and t.table_schema = 'test' and t.table_name = 't' order by s.index_id").Check(testkit.Rows(
+		"1 1 2",
+		"0 1 0",
+		"0 1 0",
+	))
+	tk.MustQuery("select * from t where id = 2").Check(testkit.Rows("2 2 2"))
+	tk.MustQuery(query).Check(testkit.Rows(
+		"<nil> 1 3 1",
+		"ia 1 0 1",
+		"ib 1 0 1",
+	))
+	require.NoError(t, tableaccess.GlobalCollector.Flush(ctx, tk.Session().GetRestrictedSQLExecutor()))
+	tk.MustQuery(query).Check(testkit.Rows(
+		"<nil> 1 3 1",
+		"ia 1 0 1",
+		"ib 1 0 1",
+	))
+
+	// the tables and the indexes never accessed are listed as well.
+	tk.MustExec("create table t2 (a int, key ia(a))")
+	tk.MustQuery("select index_name, scans, point_gets, last_access_time from information_schema.table_access_stats where table_schema = 'test' and table_name = 't2' order by index_name").Check(testkit.Rows(
+		"<nil> 0 0 <nil>",
+		"ia 0 0 <nil>",
+	))
+}
+
+func TestDropIndexAdvice(t *testing.T) {
+	collector := tableaccess.GlobalCollector
+	tableaccess.GlobalCollector = tableaccess.NewCollector()
+	defer func() {
+		tableaccess.GlobalCollector = collector
+	}()
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, a int, b int, c int, key ia(a), key iab(a, b), key ic(c), unique key ub(b), key ib(b))")
+	tk.MustExec("insert into t values (1, 1, 1, 1), (2, 2, 2, 2), (3, 3, 3, 3)")
+	tk.MustQuery("select a, b from t use index(iab) where a = 1 and b = 1").Check(testkit.Rows("1 1"))
+	tk.MustQuery("select c from t use index(ic) where c = 1").Check(testkit.Rows("1"))
+	tk.MustQuery("select a from t use index(ia) where a = 1").Check(testkit.Rows("1"))
+
+	// ia is used by a statement, which is evaluated to be slower without it.
+	tk.MustQuery("select index_name, reason, covering_index, scans, affected_digests is not null, cost_increase > 1, risk_score > 20 " +
+		"from information_schema.tidb_drop_index_advice where table_schema = 'test' and table_name = 't' order by index_name").Check(testkit.Rows(
+		"ia REDUNDANT iab 1 1 1 1",
+		"ib UNUSED,REDUNDANT ub 0 0 <nil> 0",
+		"ub UNUSED <nil> 0 0 <nil> 1",
+	))
+	tk.MustQuery("select index_name, risk_score, drop_sql, note from information_schema.tidb_drop_index_advice where index_name in ('ib', 'ub') order by index_name").Check(testkit.Rows(
+		"ib 0 ALTER TABLE `test`.`t` DROP INDEX `ib` <nil>",
+		"ub 40 ALTER TABLE `test`.`t` DROP INDEX `ub` the uniqueness constraint is removed with the index",
+	))
+	// the candidates are ordered by the risk scores.
+	rows := tk.MustQuery("admin recommend drop index for table t").Rows()
+	require.Len(t, rows, 3)
+	require.Equal(t, "ib", rows[0][2])
+
+	// the index needed by the foreign key can't be dropped.
+	tk.MustExec("create table p (id int primary key)")
+	tk.MustExec("create table c (id int primary key, pid int, key ip(pid), foreign key fk(pid) references p(id))")
+	tk.MustQuery("admin recommend drop index for table c").Check(testkit.Rows())
+	tk.MustExec("alter table c add index ip2(pid, id)")
+	tk.MustQuery("admin recommend drop index for table c").Check(testkit.Rows(
+		"test c ip UNUSED,REDUNDANT ip2 0 0 <nil> <nil> <nil> 0 ALTER TABLE `test`.`c` DROP INDEX `ip` <nil>",
+		"test c ip2 UNUSED <nil> 0 0 <nil> <nil> <nil> 0 ALTER TABLE `test`.`c` DROP INDEX `ip2` <nil>",
+	))
+	require.Len(t, tk.MustQuery("admin recommend drop index").Rows(), 5)
+}
+
+func TestDeadlocksPlanProvenance(t *testing.T) {
+	deadlockhistory.GlobalDeadlockHistory.Clear()
+	deadlockhistory.GlobalDeadlockHistory.Resize(10)
+	defer deadlockhistory.GlobalDeadlockHistory.Clear()
+
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t (id int primary key, a int, b int, key ia(a), key ib(b))")
+	tk.MustExec("create global binding for update t set b = 1 where a = 1 and b = 1 using update /*+ use_index(t, ib) */ t set b = 1 where a = 1 and b = 1")
+	tk.MustExec("update t set b = 2 where a = 2 and b = 2")
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+	tk.MustExec("select /*+ use_index(t, ia) */ * from t where a = 3 for update")
+
+	digests := func(pattern string) (string, string) {
+		rows := tk.MustQuery("select digest, plan_digest from information_schema.statements_summary where digest_text like ?", pattern).Rows()
+		require.Len(t, rows, 1)
+		return rows[0][0].(string), rows[0][1].(string)
+	}
+	updateDigest, updatePlanDigest := digests("update `t`%")
+	selectDigest, selectPlanDigest := digests("select % for update")
+	deadlockhistory.GlobalDeadlockHistory.Push(&deadlockhistory.DeadlockRecord{
+		OccurTime: time.Now(),
+		WaitChain: []deadlockhistory.WaitChainItem{
+			{TryLockTxn: 101, SQLDigest: updateDigest, PlanDigest: updatePlanDigest, TxnHoldingLock: 102},
+			{TryLockTxn: 102, SQLDigest: selectDigest, PlanDigest: selectPlanDigest, TxnHoldingLock: 103},
+			// the plan isn't in the statements summary.
+			{TryLockTxn: 103, SQLDigest: selectDigest, PlanDigest: "aabbccdd", TxnHoldingLock: 101},
+		},
+	})
+	tk.MustQuery("select try_lock_trx_id, current_plan_digest = ?, current_binding_sql, current_applied_hints from information_schema.deadlocks order by try_lock_trx_id",
+		updatePlanDigest).Check(testkit.Rows(
+		"101 1 UPDATE /*+ use_index(`t` `ib`)*/ `test`.`t` SET `b`=1 WHERE `a` = 1 AND `b` = 1 use_index(@`upd_1` `test`.`t` `ib`)",
+		"102 0 <nil> use_index(`t` `ia`)",
+		"103 0 <nil> <nil>",
+	))
+}
@@ -334,6 +334,7 @@ func (e *InsertExec) Open(ctx context.Context) error {
 	e.memTracker = memory.NewTracker(e.ID(), -1)
 	e.memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
 
+	e.checkShardRowIDHint()
 	if e.OnDuplicate != nil {
 		e.initEvalBuffer4Dup()
 	}
@@ -19,6 +19,7 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"sync/atomic"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -38,11 +39,13 @@ import (
 	"github.com/pingcap/tidb/pkg/table/tables"
 	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util"
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/dbterror"
 	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/pkg/util/execdetails"
+	"github.com/pingcap/tidb/pkg/util/hint"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/pingcap/tidb/pkg/util/tracing"
@@ -95,6 +98,12 @@ type InsertValues struct {
 	// fkChecks contains the foreign key checkers.
 	fkChecks   []*FKCheckExec
 	fkCascades []*FKCascadeExec
+
+	// shardRowIDSplitBits is the shard bits of the shard_row_id hint which the record regions are pre-split by,
+	// it's 0 if the regions are not pre-split.
+	shardRowIDSplitBits uint64
+	// shardRowIDSplitTables is the physical tables whose record regions are pre-split by the statement.
+	shardRowIDSplitTables map[int64]struct{}
 }
 
 type defaultVal struct {
@@ -167,6 +176,83 @@ func (e *InsertValues) initInsertColumns() error {
 	return nil
 }
 
+// shardRowIDPreSplitLimit limits the number of the concurrent pre-splits by the shard_row_id hint.
+var shardRowIDPreSplitLimit = make(chan struct{}, 4)
+
+// checkShardRowIDHint checks the shard_row_id hint of the statement against the table, and enables the pre-split of
+// the record regions by the shards of the hint for a bulk insert, so the scattered row IDs are written to different
+// regions from the beginning.
+func (e *InsertValues) checkShardRowIDHint() {
+	sctx := e.Ctx()
+	stmtCtx := sctx.GetSessionVars().StmtCtx
+	if !stmtCtx.HasShardRowIDHint {
+		return
+	}
+	tblInfo := e.Table.Meta()
+	if tblInfo.HasClusteredIndex() {
+		stmtCtx.SetHintWarningFromError(hint.ErrWarnHintInapplicable.FastGen("SHARD_ROW_ID() is inapplicable for the table '%s' with a clustered primary key", tblInfo.Name.O))
+		return
+	}
+	shardBits := stmtCtx.ShardRowIDBits
+	if shardBits > tblInfo.MaxShardRowIDBits {
+		stmtCtx.SetHintWarningFromError(hint.ErrWarnHintInapplicable.FastGen("SHARD_ROW_ID(%d) exceeds the max shard_row_id_bits %d of the table '%s', the hint is ignored",
+			shardBits, tblInfo.MaxShardRowIDBits, tblInfo.Name.O))
+		// The row IDs are allocated by the shard_row_id_bits of the table.
+		stmtCtx.HasShardRowIDHint = false
+		return
+	}
+	if shardBits == 0 || (e.SelectExec == nil && len(e.Lists) <= 1) || tblInfo.TempTableType != model.TempTableNone {
+		return
+	}
+	if _, ok := sctx.GetStore().(kv.SplittableStore); !ok || atomic.LoadUint32(&ddl.EnableSplitTableRegion) == 0 {
+		return
+	}
+	e.shardRowIDSplitBits = shardBits
+	e.shardRowIDSplitTables = make(map[int64]struct{}, 1)
+}
+
+// preSplitShardRowID pre-splits the record regions of the physical table which the row is inserted into, if it's
+// not pre-split by the statement yet. So only the partitions touched by the statement are split.
+//
+// The regions are split and scattered in the background rather than blocking the statement, since the insert still
+// works without the pre-split regions. The split keys which are the starts of the regions already are skipped by the
+// store, so the regions split by the previous statements aren't split again, and the failed ones are retried by the
+// later statements. The pre-split is skipped if there are too many concurrent ones.
+func (e *InsertValues) preSplitShardRowID(row []types.Datum) {
+	physicalID := e.Table.Meta().ID
+	if pt, ok := e.Table.(table.PartitionedTable); ok {
+		p, err := pt.GetPartitionByRow(e.Ctx().GetExprCtx(), row)
+		if err != nil {
+			// The error is reported when the row is added.
+			return
+		}
+		physicalID = p.GetPhysicalID()
+	}
+	if _, ok := e.shardRowIDSplitTables[physicalID]; ok {
+		return
+	}
+	select {
+	case shardRowIDPreSplitLimit <- struct{}{}:
+	default:
+		return
+	}
+	e.shardRowIDSplitTables[physicalID] = struct{}{}
+	store := e.Ctx().GetStore().(kv.SplittableStore)
+	tblInfo, shardBits := e.Table.Meta(), e.shardRowIDSplitBits
+	timeout := e.Ctx().GetSessionVars().GetSplitRegionTimeout()
+	go util.WithRecovery(func() {
+		defer func() { <-shardRowIDPreSplitLimit }()
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		regionIDs, err := ddl.SplitRecordRegionByShardRowID(ctx, store, tblInfo, physicalID, shardBits, true)
+		if err != nil {
+			logutil.BgLogger().Warn("pre split regions by the shard_row_id hint failed",
+				zap.Stringer("table", tblInfo.Name), zap.Int64("physical table ID", physicalID),
+				zap.Int("successful region count", len(regionIDs)), zap.Error(err))
+		}
+	}, nil)
+}
+
 func (e *InsertValues) initEvalBuffer() {
 	numCols := len(e.Table.Cols())
 	if e.hasExtraHandle {
@@ -1418,6 +1504,9 @@ func (e *InsertValues) addRecordWithAutoIDHint(
 	if !vars.ConstraintCheckInPlace {
 		vars.PresumeKeyNotExists = true
 	}
+	if e.shardRowIDSplitBits > 0 {
+		e.preSplitShardRowID(row)
+	}
 	if reserveAutoIDCount > 0 {
 		_, err = e.Table.AddRecord(e.Ctx().GetTableCtx(), row, table.WithCtx(ctx), table.WithReserveAutoIDHint(reserveAutoIDCount))
 	} else {
@@ -206,10 +206,11 @@ type executorStats struct {
 	normalizedSQL          string
 	normalizedPlan         string
 	inRestrictedSQL        bool
+	planFromBinding        bool
 }
 
 // newExecutorStats creates a new `executorStats`
-func newExecutorStats(stmtCtx *stmtctx.StatementContext, id int) executorStats {
+func newExecutorStats(stmtCtx *stmtctx.StatementContext, id int, planFromBinding bool) executorStats {
 	normalizedSQL, sqlDigest := stmtCtx.SQLDigest()
 	normalizedPlan, planDigest := stmtCtx.GetPlanDigest()
 	e := executorStats{
@@ -219,6 +220,7 @@ func newExecutorStats(stmtCtx *stmtctx.StatementContext, id int) executorStats {
 		normalizedPlan:         normalizedPlan,
 		planDigest:             planDigest,
 		inRestrictedSQL:        stmtCtx.InRestrictedSQL,
+		planFromBinding:        planFromBinding,
 	}
 
 	if stmtCtx.RuntimeStatsColl != nil {
@@ -240,7 +242,7 @@ func (e *executorStats) RegisterSQLAndPlanInExecForTopSQL() {
 	if topsqlstate.TopSQLEnabled() && e.isSQLAndPlanRegistered.CompareAndSwap(false, true) {
 		topsql.RegisterSQL(e.normalizedSQL, e.sqlDigest, e.inRestrictedSQL)
 		if len(e.normalizedPlan) > 0 {
-			topsql.RegisterPlan(e.normalizedPlan, e.planDigest)
+			topsql.RegisterPlan(e.normalizedPlan, e.planDigest, e.planFromBinding)
 		}
 	}
 }
@@ -275,7 +277,7 @@ func NewBaseExecutorV2(vars *variable.SessionVars, schema *expression.Schema, id
 	executorMeta := newExecutorMeta(schema, id, children...)
 	e := BaseExecutorV2{
 		executorMeta:           executorMeta,
-		executorStats:          newExecutorStats(vars.StmtCtx, id),
+		executorStats:          newExecutorStats(vars.StmtCtx, id, vars.FoundInBinding),
 		executorChunkAllocator: newExecutorChunkAllocator(vars, executorMeta.RetFieldTypes()),
 		executorKillerHandler:  newExecutorKillerHandler(&vars.SQLKiller),
 	}
@@ -396,7 +398,16 @@ func Next(ctx context.Context, e Executor, req *chunk.Chunk) (err error) {
 	}()
 	if e.RuntimeStats() != nil {
 		start := time.Now()
-		defer func() { e.RuntimeStats().Record(time.Since(start), req.NumRows()) }()
+		childTime := childrenConsumeTime(e)
+		defer func() {
+			elapsed := time.Since(start)
+			e.RuntimeStats().Record(elapsed, req.NumRows())
+			// Children running in other goroutines may consume more time than the
+			// executor itself during the call, only the overlapped part is the wait.
+			if d := min(childrenConsumeTime(e)-childTime, elapsed); d > 0 {
+				e.RuntimeStats().RecordChildWait(d)
+			}
+		}()
 	}
 
 	if err := e.HandleSQLKillerSignal(); err != nil {
@@ -416,6 +427,17 @@ func Next(ctx context.Context, e Executor, req *chunk.Chunk) (err error) {
 	return e.HandleSQLKillerSignal()
 }
 
+// childrenConsumeTime returns the total time consumed by the Next() of e's children so far.
+func childrenConsumeTime(e Executor) time.Duration {
+	var total int64
+	for _, child := range e.AllChildren() {
+		if stats := child.RuntimeStats(); stats != nil {
+			total += stats.GetTime()
+		}
+	}
+	return time.Duration(total)
+}
+
 // Close is a wrapper function on e.Close(), it handles some common codes.
 func Close(e Executor) (err error) {
 	defer func() {
@@ -0,0 +1,48 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mpp
+
+import (
+	"fmt"
+
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/util/execdetails"
+)
+
+// exchangeCompressionRuntimeStats is the compression mode of a compressed exchange.
+// TiFlash doesn't report the bytes before and after the compression of the exchanges, so the compression ratio
+// isn't shown.
+type exchangeCompressionRuntimeStats struct {
+	mode kv.ExchangeCompressionMode
+}
+
+// String implements the RuntimeStats interface.
+func (s *exchangeCompressionRuntimeStats) String() string {
+	return fmt.Sprintf("exchange_compression: {mode: %s}", s.mode.Name())
+}
+
+// Merge implements the RuntimeStats interface.
+func (*exchangeCompressionRuntimeStats) Merge(execdetails.RuntimeStats) {}
+
+// Clone implements the RuntimeStats interface.
+func (s *exchangeCompressionRuntimeStats) Clone() execdetails.RuntimeStats {
+	newStats := *s
+	return &newStats
+}
+
+// Tp implements the RuntimeStats interface.
+func (*exchangeCompressionRuntimeStats) Tp() int {
+	return execdetails.TpMPPExchangeCompressionRuntimeStats
+}
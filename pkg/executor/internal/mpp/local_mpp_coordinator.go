@@ -97,6 +97,7 @@ func (m *mppResponse) RespTime() time.Duration {
 
 type mppRequestReport struct {
 	mppReq             *kv.MPPDispatchRequest
+	fragmentID         int // the plan id of the ExchangeSender of the fragment
 	errMsg             string
 	executionSummaries []*tipb.ExecutorExecutionSummary
 	receivedReport     bool // if received ReportStatus from mpp task
@@ -126,6 +127,7 @@ type localMppCoordinator struct {
 
 	coordinatorAddr string // empty if coordinator service not available
 	firstErrMsg     string
+	firstErr        error // the first error sent to respChan, the errors after it are replaced by it
 
 	mppReqs []*kv.MPPDispatchRequest
 
@@ -142,6 +144,7 @@ type localMppCoordinator struct {
 
 	dispatchFailed    uint32
 	allReportsHandled uint32
+	tasksCancelled    uint32
 
 	needTriggerFallback        bool
 	enableCollectExecutionInfo bool
@@ -149,6 +152,9 @@ type localMppCoordinator struct {
 
 	// Record node cnt that involved in the mpp computation.
 	nodeCnt int
+
+	// compressedSenders are the compressed ExchangeSenders whose compression mode is recorded in the runtime stats.
+	compressedSenders []*plannercore.PhysicalExchangeSender
 }
 
 // NewLocalMPPCoordinator creates a new localMppCoordinator instance
@@ -245,7 +251,7 @@ func (c *localMppCoordinator) appendMPPDispatchReq(pf *plannercore.Fragment) err
 			ConnectionID:           c.sessionCtx.GetSessionVars().ConnectionID,
 			ConnectionAlias:        c.sessionCtx.ShowProcess().SessionAlias,
 		}
-		c.reqMap[req.ID] = &mppRequestReport{mppReq: req, receivedReport: false, errMsg: "", executionSummaries: nil}
+		c.reqMap[req.ID] = &mppRequestReport{mppReq: req, fragmentID: pf.ExchangeSender.ID(), receivedReport: false, errMsg: "", executionSummaries: nil}
 		c.mppReqs = append(c.mppReqs, req)
 	}
 	return nil
@@ -381,9 +387,63 @@ func (c *localMppCoordinator) dispatchAll(ctx context.Context) {
 	close(c.respChan)
 }
 
+// sendError sends the first error of the mpp tasks to respChan. It cancels all the mpp tasks and the other dispatch
+// and receive goroutines before sending the error, so that the whole exchange tree stops promptly even if the error
+// is not consumed yet.
 func (c *localMppCoordinator) sendError(err error) {
-	c.sendToRespCh(&mppResponse{err: err})
+	c.mu.Lock()
+	if c.firstErr == nil {
+		c.firstErr = err
+	} else {
+		// The errors after the first one are mostly caused by the cancellation.
+		err = c.firstErr
+	}
+	c.mu.Unlock()
 	c.cancelMppTasks()
+	if c.cancelFunc != nil {
+		c.cancelFunc()
+	}
+	c.sendToRespCh(&mppResponse{err: err})
+}
+
+// markTaskFailed marks the task as failed unless it's caused by the cancellation, so that its state is kept when the
+// tasks are cancelled.
+func (c *localMppCoordinator) markTaskFailed(ctx context.Context, req *kv.MPPDispatchRequest) {
+	if ctx.Err() != nil {
+		return
+	}
+	c.mu.Lock()
+	req.State = kv.MppTaskFailed
+	c.mu.Unlock()
+}
+
+// nodeFailureError wraps the rpc error of the mpp task, it means that the TiFlash node may be unavailable, so the
+// query can be retried on the other nodes.
+type nodeFailureError struct {
+	addr string
+	err  error
+}
+
+func (e *nodeFailureError) Error() string {
+	return e.err.Error()
+}
+
+// Cause implements the causer interface.
+func (e *nodeFailureError) Cause() error {
+	return e.err
+}
+
+// Unwrap implements the Wrapper interface.
+func (e *nodeFailureError) Unwrap() error {
+	return e.err
+}
+
+// wrapNodeFailure wraps the rpc error of the mpp task as nodeFailureError, unless it's caused by the cancellation.
+func wrapNodeFailure(ctx context.Context, req *kv.MPPDispatchRequest, err error) error {
+	if ctx.Err() != nil {
+		return err
+	}
+	return &nodeFailureError{addr: req.Meta.GetAddress(), err: err}
 }
 
 func (c *localMppCoordinator) sendToRespCh(resp *mppResponse) (exit bool) {
@@ -437,9 +497,12 @@ func (c *localMppCoordinator) handleDispatchReq(ctx context.Context, bo *backoff
 	if err != nil {
 		logutil.BgLogger().Error("mpp dispatch meet error", zap.String("error", err.Error()), zap.Uint64("timestamp", req.StartTs), zap.Int64("task", req.ID), zap.Int64("mpp-version", req.MppVersion.ToInt64()))
 		atomic.CompareAndSwapUint32(&c.dispatchFailed, 0, 1)
+		c.markTaskFailed(ctx, req)
 		// if NeedTriggerFallback is true, we return timeout to trigger tikv's fallback
 		if c.needTriggerFallback {
 			err = derr.ErrTiFlashServerTimeout
+		} else {
+			err = wrapNodeFailure(ctx, req, err)
 		}
 		c.sendError(err)
 		return
@@ -448,6 +511,7 @@ func (c *localMppCoordinator) handleDispatchReq(ctx context.Context, bo *backoff
 	if rpcResp.Error != nil {
 		logutil.BgLogger().Error("mpp dispatch response meet error", zap.String("error", rpcResp.Error.Msg), zap.Uint64("timestamp", req.StartTs), zap.Int64("task", req.ID), zap.Int64("task-mpp-version", req.MppVersion.ToInt64()), zap.Int64("error-mpp-version", rpcResp.Error.GetMppVersion()))
 		atomic.CompareAndSwapUint32(&c.dispatchFailed, 0, 1)
+		c.markTaskFailed(ctx, req)
 		c.sendError(errors.New(rpcResp.Error.Msg))
 		return
 	}
@@ -478,19 +542,21 @@ func (c *localMppCoordinator) cancelMppTasks() {
 		return
 	}
 	usedStoreAddrs := make(map[string]bool)
-	c.mu.Lock()
 	// 1. One address will receive one cancel request, since cancel request cancels all mpp tasks within the same mpp gather
-	// 2. Cancel process will set all mpp task requests' states, thus if one request's state is Cancelled already, just return
-	if c.mppReqs[0].State == kv.MppTaskCancelled {
-		c.mu.Unlock()
+	// 2. Cancel process will set all mpp task requests' states, thus if the tasks are cancelled already, just return
+	if !atomic.CompareAndSwapUint32(&c.tasksCancelled, 0, 1) {
 		return
 	}
+	c.mu.Lock()
 	for _, task := range c.mppReqs {
 		// get the store address of running tasks,
 		if task.State == kv.MppTaskRunning && !usedStoreAddrs[task.Meta.GetAddress()] {
 			usedStoreAddrs[task.Meta.GetAddress()] = true
 		}
-		task.State = kv.MppTaskCancelled
+		// keep the state of the failed tasks for the runtime stats.
+		if task.State != kv.MppTaskFailed {
+			task.State = kv.MppTaskCancelled
+		}
 	}
 	c.mu.Unlock()
 	c.sessionCtx.GetMPPClient().CancelMPPTasks(kv.CancelMPPTasksParam{StoreAddr: usedStoreAddrs, Reqs: c.mppReqs})
@@ -499,11 +565,12 @@ func (c *localMppCoordinator) cancelMppTasks() {
 func (c *localMppCoordinator) receiveResults(req *kv.MPPDispatchRequest, taskMeta *mpp.TaskMeta, bo *backoff.Backoffer) {
 	stream, err := c.sessionCtx.GetMPPClient().EstablishMPPConns(kv.EstablishMPPConnsParam{Ctx: bo.GetCtx(), Req: req, TaskMeta: taskMeta})
 	if err != nil {
+		c.markTaskFailed(bo.GetCtx(), req)
 		// if NeedTriggerFallback is true, we return timeout to trigger tikv's fallback
 		if c.needTriggerFallback {
 			c.sendError(derr.ErrTiFlashServerTimeout)
 		} else {
-			c.sendError(err)
+			c.sendError(wrapNodeFailure(bo.GetCtx(), req, err))
 		}
 		return
 	}
@@ -517,6 +584,7 @@ func (c *localMppCoordinator) receiveResults(req *kv.MPPDispatchRequest, taskMet
 	for {
 		err := c.handleMPPStreamResponse(bo, resp, req)
 		if err != nil {
+			c.markTaskFailed(bo.GetCtx(), req)
 			c.sendError(err)
 			return
 		}
@@ -530,11 +598,12 @@ func (c *localMppCoordinator) receiveResults(req *kv.MPPDispatchRequest, taskMet
 			logutil.BgLogger().Info("mpp stream recv got error", zap.Error(err), zap.Uint64("timestamp", taskMeta.StartTs),
 				zap.Int64("task", taskMeta.TaskId), zap.Int64("mpp-version", taskMeta.MppVersion))
 
+			c.markTaskFailed(bo.GetCtx(), req)
 			// if NeedTriggerFallback is true, we return timeout to trigger tikv's fallback
 			if c.needTriggerFallback {
 				c.sendError(derr.ErrTiFlashServerTimeout)
 			} else {
-				c.sendError(err)
+				c.sendError(wrapNodeFailure(bo.GetCtx(), req, err))
 			}
 			return
 		}
@@ -567,6 +636,10 @@ func (c *localMppCoordinator) ReportStatus(info kv.ReportStatusRequest) error {
 
 	req.receivedReport = true
 	if len(errMsg) > 0 {
+		req.mppReq.State = kv.MppTaskFailed
+		// Cancel the whole exchange tree once a task fails, instead of waiting for the error to be propagated to the
+		// root task through the exchange tunnels.
+		go c.cancelMppTasks()
 		req.errMsg = errMsg
 		if len(c.firstErrMsg) == 0 {
 			c.firstErrMsg = errMsg
@@ -633,6 +706,55 @@ func (c *localMppCoordinator) closeWithoutReport() {
 	}
 	c.cancelFunc()
 	<-c.wgDoneChan
+	c.recordFragmentStats()
+	c.recordCompressionStats()
+}
+
+// recordCompressionStats records the compression mode to the runtime stats of the compressed ExchangeSenders,
+// so that it's visible in EXPLAIN ANALYZE.
+func (c *localMppCoordinator) recordCompressionStats() {
+	c.mu.Lock()
+	senders := c.compressedSenders
+	// The coordinator may be closed more than once, only record the stats once.
+	c.compressedSenders = nil
+	c.mu.Unlock()
+	runtimeStatsColl := c.sessionCtx.GetSessionVars().StmtCtx.RuntimeStatsColl
+	if runtimeStatsColl == nil {
+		return
+	}
+	for _, sender := range senders {
+		runtimeStatsColl.RegisterStats(sender.ID(), &exchangeCompressionRuntimeStats{mode: sender.CompressionMode})
+	}
+}
+
+// recordFragmentStats records the failed and cancelled tasks of each fragment to the runtime stats of its
+// ExchangeSender, so that they are visible in EXPLAIN ANALYZE. Nothing is recorded if all the tasks are successful.
+func (c *localMppCoordinator) recordFragmentStats() {
+	runtimeStatsColl := c.sessionCtx.GetSessionVars().StmtCtx.RuntimeStatsColl
+	if runtimeStatsColl == nil || atomic.LoadUint32(&c.tasksCancelled) == 0 {
+		return
+	}
+	stats := make(map[int]*fragmentRuntimeStats)
+	c.mu.Lock()
+	for _, req := range c.mppReqs {
+		fragmentID := c.reqMap[req.ID].fragmentID
+		s, ok := stats[fragmentID]
+		if !ok {
+			s = &fragmentRuntimeStats{}
+			stats[fragmentID] = s
+		}
+		s.tasks++
+		switch req.State {
+		case kv.MppTaskFailed:
+			s.failed++
+		case kv.MppTaskCancelled:
+			s.cancelled++
+		}
+	}
+	c.mu.Unlock()
+	for fragmentID, s := range stats {
+		runtimeStatsColl.RegisterStats(fragmentID, s)
+	}
 }
 
 func (c *localMppCoordinator) handleMPPStreamResponse(bo *backoff.Backoffer, response *mpp.MPPDataPacket, req *kv.MPPDispatchRequest) (err error) {
@@ -747,6 +869,11 @@ func (c *localMppCoordinator) Execute(ctx context.Context) (kv.Response, []kv.Ke
 			return nil, nil, errors.Trace(err)
 		}
 	}
+	for _, frag := range frags {
+		if !frag.IsRoot && frag.ExchangeSender.CompressionMode != kv.ExchangeCompressionModeNONE {
+			c.compressedSenders = append(c.compressedSenders, frag.ExchangeSender)
+		}
+	}
 	failpoint.Inject("checkTotalMPPTasks", func(val failpoint.Value) {
 		if val.(int) != len(c.mppReqs) {
 			failpoint.Return(nil, nil, errors.Errorf("The number of tasks is not right, expect %d tasks but actually there are %d tasks", val.(int), len(c.mppReqs)))
@@ -770,3 +897,37 @@ func (c *localMppCoordinator) Execute(ctx context.Context) (kv.Response, []kv.Ke
 func (c *localMppCoordinator) GetNodeCnt() int {
 	return c.nodeCnt
 }
+
+// fragmentRuntimeStats is the status of the mpp tasks of a fragment.
+type fragmentRuntimeStats struct {
+	tasks     int
+	failed    int
+	cancelled int
+}
+
+// String implements the RuntimeStats interface.
+func (s *fragmentRuntimeStats) String() string {
+	return fmt.Sprintf("mpp_fragment: {tasks: %d, failed: %d, cancelled: %d}", s.tasks, s.failed, s.cancelled)
+}
+
+// Merge implements the RuntimeStats interface.
+func (s *fragmentRuntimeStats) Merge(other execdetails.RuntimeStats) {
+	tmp, ok := other.(*fragmentRuntimeStats)
+	if !ok {
+		return
+	}
+	s.tasks += tmp.tasks
+	s.failed += tmp.failed
+	s.cancelled += tmp.cancelled
+}
+
+// Clone implements the RuntimeStats interface.
+func (s *fragmentRuntimeStats) Clone() execdetails.RuntimeStats {
+	newStats := *s
+	return &newStats
+}
+
+// Tp implements the RuntimeStats interface.
+func (*fragmentRuntimeStats) Tp() int {
+	return execdetails.TpMPPFragmentRuntimeStats
+}
@@ -15,11 +15,17 @@
 package mpp
 
 import (
+	"context"
 	"testing"
 
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/kv"
 	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/store/copr"
+	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/pingcap/tipb/go-tipb"
 	"github.com/stretchr/testify/require"
+	"github.com/tikv/client-go/v2/tikv"
 )
 
 func TestNeedReportExecutionSummary(t *testing.T) {
@@ -36,3 +42,42 @@ func TestNeedReportExecutionSummary(t *testing.T) {
 	passSender.SetChildren(tableScan)
 	require.False(t, needReportExecutionSummary(passSender))
 }
+
+func TestNodeFailureRecovery(t *testing.T) {
+	tracker := memory.NewTracker(1, -1)
+	// the node failure is not recovered if tidb_enable_mpp_failure_retry is off.
+	client := &mockTiKVClient{}
+	h := NewRecoveryHandler(false, false, client, 2, true, tracker)
+	nodeErr := &nodeFailureError{addr: "tiflash0", err: errors.New("rpc error: code = Unavailable")}
+	require.Error(t, h.Recovery(&RecoveryInfo{MPPErr: errors.Trace(nodeErr), NodeCnt: 2}))
+	// the node failure can't be recovered without the client to probe the failed node.
+	h = NewRecoveryHandler(false, true, nil, 2, true, tracker)
+	require.Error(t, h.Recovery(&RecoveryInfo{MPPErr: errors.Trace(nodeErr), NodeCnt: 2}))
+
+	ctx := context.Background()
+	require.True(t, copr.GlobalMPPFailedStoreProber.IsRecovery(ctx, "tiflash0", 0))
+	h = NewRecoveryHandler(false, true, client, 2, true, tracker)
+	require.NoError(t, h.Recovery(&RecoveryInfo{MPPErr: errors.Trace(nodeErr), NodeCnt: 2}))
+	require.Equal(t, uint32(1), h.RecoveryCnt())
+	// the failed node is excluded from the retry until it's detected as recovered.
+	require.False(t, copr.GlobalMPPFailedStoreProber.IsRecovery(ctx, "tiflash0", 0))
+	copr.GlobalMPPFailedStoreProber.Delete("tiflash0")
+	require.Error(t, h.Recovery(&RecoveryInfo{MPPErr: errors.New("other error for mpp stream"), NodeCnt: 2}))
+}
+
+type mockTiKVClient struct {
+	tikv.Client
+}
+
+func TestFragmentRuntimeStats(t *testing.T) {
+	stats := &fragmentRuntimeStats{tasks: 2, failed: 1, cancelled: 1}
+	require.Equal(t, "mpp_fragment: {tasks: 2, failed: 1, cancelled: 1}", stats.String())
+	stats.Merge(stats.Clone())
+	require.Equal(t, "mpp_fragment: {tasks: 4, failed: 2, cancelled: 2}", stats.String())
+}
+
+func TestExchangeCompressionRuntimeStats(t *testing.T) {
+	stats := &exchangeCompressionRuntimeStats{mode: kv.ExchangeCompressionModeFast}
+	stats.Merge(stats.Clone())
+	require.Equal(t, "exchange_compression: {mode: FAST}", stats.String())
+}
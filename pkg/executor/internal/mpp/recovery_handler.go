@@ -15,11 +15,15 @@
 package mpp
 
 import (
+	"context"
+	goerrors "errors"
 	"strings"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/store/copr"
 	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/pingcap/tidb/pkg/util/tiflashcompute"
+	"github.com/tikv/client-go/v2/tikv"
 )
 
 // RecoveryHandler tries to recovery mpp error.
@@ -44,10 +48,11 @@ const (
 )
 
 // NewRecoveryHandler returns new instance of RecoveryHandler.
-func NewRecoveryHandler(useAutoScaler bool, holderCap uint64, enable bool, parent *memory.Tracker) *RecoveryHandler {
+// tikvClient is used to probe the failed TiFlash nodes, the node failure can't be recovered if it's nil.
+func NewRecoveryHandler(useAutoScaler, retryNodeFailure bool, tikvClient tikv.Client, holderCap uint64, enable bool, parent *memory.Tracker) *RecoveryHandler {
 	return &RecoveryHandler{
 		enable:   enable,
-		handlers: []handlerImpl{newMemLimitHandlerImpl(useAutoScaler)},
+		handlers: []handlerImpl{newMemLimitHandlerImpl(useAutoScaler), newNodeFailureHandlerImpl(retryNodeFailure, tikvClient)},
 		holder:   newMPPResultHolder(holderCap, parent),
 		// Default recovery 3 time.
 		maxRecoveryCnt: 3,
@@ -157,6 +162,36 @@ func (*memLimitHandlerImpl) doRecovery(info *RecoveryInfo) error {
 	return nil
 }
 
+var _ handlerImpl = &nodeFailureHandlerImpl{}
+
+// nodeFailureHandlerImpl retries the mpp tasks when a TiFlash node fails. The failed node is added to the failed
+// store prober, so the tasks are dispatched to the other nodes in the retry until the node is detected as recovered.
+type nodeFailureHandlerImpl struct {
+	enable     bool
+	tikvClient tikv.Client
+}
+
+func newNodeFailureHandlerImpl(enable bool, tikvClient tikv.Client) *nodeFailureHandlerImpl {
+	return &nodeFailureHandlerImpl{
+		enable:     enable,
+		tikvClient: tikvClient,
+	}
+}
+
+func (h *nodeFailureHandlerImpl) chooseHandlerImpl(mppErr error) bool {
+	var nodeErr *nodeFailureError
+	return h.enable && h.tikvClient != nil && goerrors.As(mppErr, &nodeErr)
+}
+
+func (h *nodeFailureHandlerImpl) doRecovery(info *RecoveryInfo) error {
+	var nodeErr *nodeFailureError
+	if !goerrors.As(info.MPPErr, &nodeErr) || len(nodeErr.addr) == 0 {
+		return errors.New("cannot find the failed TiFlash node of the mpp err")
+	}
+	copr.GlobalMPPFailedStoreProber.Add(context.Background(), nodeErr.addr, h.tikvClient)
+	return nil
+}
+
 type mppResultHolder struct {
 	memTracker *memory.Tracker
 	resps      []*mppResponse
@@ -72,6 +72,19 @@ func setWatchOption(ctx context.Context,
 		if isNull {
 			return errors.Errorf("invalid watch text expression")
 		}
+		if !op.BoolValue && len(record.WatchText) > 0 {
+			// Both `SQL DIGEST` and `PLAN DIGEST` are specified, only the plan of the statement is watched.
+			if len(strval) != 64 {
+				return errors.Errorf("digest format error")
+			}
+			sqlDigest, planDigest := record.WatchText, strval
+			if model.RunawayWatchType(op.IntValue) == model.WatchSimilar {
+				sqlDigest, planDigest = strval, record.WatchText
+			}
+			record.Watch = rmpb.RunawayWatchType_Plan
+			record.WatchText = resourcegroup.StatementPlanWatchText(sqlDigest, planDigest)
+			return nil
+		}
 		record.Watch = rmpb.RunawayWatchType(op.IntValue)
 		if op.BoolValue {
 			p := parser.New()
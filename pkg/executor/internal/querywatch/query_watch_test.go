@@ -153,3 +153,40 @@ func TestQueryWatch(t *testing.T) {
 	time.Sleep(1 * time.Second)
 	tk.MustGetErrCode("select * from test.t1", mysql.ErrResourceGroupQueryRunawayQuarantine)
 }
+
+func TestQueryWatchSQLAndPlanDigest(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, key idx(a))")
+	tk.MustExec("insert into t values(1, 1)")
+
+	require.Eventually(t, func() bool {
+		return dom.RunawayManager().IsSyncerInitialized()
+	}, 20*time.Second, 300*time.Millisecond)
+	tk.MustExec("alter resource group default QUERY_LIMIT=(EXEC_ELAPSED='10s' ACTION=DRYRUN)")
+
+	// the two statements share the same sql digest but have different plans.
+	tk.MustQuery("select * from t where a = 1").Check(testkit.Rows("1 1"))
+	_, sqlDigest := tk.Session().GetSessionVars().StmtCtx.SQLDigest()
+	_, goodPlanDigest := tk.Session().GetSessionVars().StmtCtx.GetPlanDigest()
+	tk.MustQuery("select /*+ ignore_index(t, idx) */ * from t where a = 1").Check(testkit.Rows("1 1"))
+	_, sqlDigest2 := tk.Session().GetSessionVars().StmtCtx.SQLDigest()
+	_, badPlanDigest := tk.Session().GetSessionVars().StmtCtx.GetPlanDigest()
+	require.Equal(t, sqlDigest.String(), sqlDigest2.String())
+	require.NotEqual(t, goodPlanDigest.String(), badPlanDigest.String())
+
+	tk.MustQuery(fmt.Sprintf("query watch add action KILL SQL DIGEST '%s' PLAN DIGEST '%s'", sqlDigest, badPlanDigest)).Check(testkit.Rows("1"))
+	tk.EventuallyMustQueryAndCheck("select SQL_NO_CACHE resource_group_name, watch_text, action, watch from information_schema.runaway_watches order by id", nil,
+		testkit.Rows(fmt.Sprintf("default %s/%s Kill Plan", sqlDigest, badPlanDigest)), 5*time.Second, 200*time.Millisecond)
+
+	require.Eventually(t, func() bool {
+		return tk.QueryToErr("select /*+ ignore_index(t, idx) */ * from t where a = 1") != nil
+	}, 5*time.Second, 200*time.Millisecond)
+	tk.MustGetErrCode("select /*+ ignore_index(t, idx) */ * from t where a = 2", mysql.ErrResourceGroupQueryRunawayQuarantine)
+	// the good plan of the statement keeps running.
+	tk.MustQuery("select * from t where a = 1").Check(testkit.Rows("1 1"))
+
+	err := tk.QueryToErr(fmt.Sprintf("query watch add SQL DIGEST '%s' PLAN DIGEST 'abc'", sqlDigest))
+	require.ErrorContains(t, err, "digest format error")
+}
@@ -148,14 +148,19 @@ func (e *IndexNestedLoopHashJoin) Open(ctx context.Context) error {
 
 func (e *IndexNestedLoopHashJoin) startWorkers(ctx context.Context) {
 	concurrency := e.Ctx().GetSessionVars().IndexLookupJoinConcurrency()
+	prefetch := e.Ctx().GetSessionVars().IndexLookupPrefetchTasks
+	if e.PrefetchTasks > 0 {
+		prefetch = e.PrefetchTasks
+	}
 	if e.stats != nil {
 		e.stats.concurrency = concurrency
+		e.stats.prefetch = prefetch
 	}
 	workerCtx, cancelFunc := context.WithCancel(ctx)
 	e.ctxWithCancel, e.cancelFunc = workerCtx, cancelFunc
-	innerCh := make(chan *indexHashJoinTask, concurrency)
+	innerCh := make(chan *indexHashJoinTask, concurrency+prefetch)
 	if e.KeepOuterOrder {
-		e.taskCh = make(chan *indexHashJoinTask, concurrency)
+		e.taskCh = make(chan *indexHashJoinTask, concurrency+prefetch)
 		// When `KeepOuterOrder` is true, each task holds their own `resultCh`
 		// individually, thus we do not need a global resultCh.
 		e.resultCh = nil
@@ -496,9 +501,17 @@ func (iw *indexHashJoinInnerWorker) run(ctx context.Context, cancelFunc context.
 			task.memTracker.Detach()
 		}
 		select {
-		case <-ctx.Done():
-			return
 		case task, ok = <-iw.taskCh:
+			// The task has been built ahead by the outer worker, so the inner worker doesn't wait for it.
+			if ok && iw.stats != nil {
+				atomic.AddInt64(&iw.stats.prefetchHit, 1)
+			}
+		default:
+			select {
+			case <-ctx.Done():
+				return
+			case task, ok = <-iw.taskCh:
+			}
 		}
 		if !ok {
 			break
@@ -81,6 +81,9 @@ type IndexLookUpJoin struct {
 
 	// LastColHelper store the information for last col if there's complicated filter like col > x_col and col < x_col + 100.
 	LastColHelper *plannercore.ColWithCmpFuncManager
+	// PrefetchTasks is the number of inner side lookup tasks to prefetch set by the inl_join_prefetch hint,
+	// 0 means `tidb_index_lookup_prefetch_tasks` is used.
+	PrefetchTasks int
 
 	memTracker *memory.Tracker // track memory usage.
 
@@ -188,14 +191,20 @@ func (e *IndexLookUpJoin) Open(ctx context.Context) error {
 
 func (e *IndexLookUpJoin) startWorkers(ctx context.Context) {
 	concurrency := e.Ctx().GetSessionVars().IndexLookupJoinConcurrency()
+	prefetch := e.Ctx().GetSessionVars().IndexLookupPrefetchTasks
+	if e.PrefetchTasks > 0 {
+		prefetch = e.PrefetchTasks
+	}
 	if e.stats != nil {
 		e.stats.concurrency = concurrency
+		e.stats.prefetch = prefetch
 	}
-	resultCh := make(chan *lookUpJoinTask, concurrency)
+	// The outer worker can build at most `prefetch` tasks ahead of the inner workers and the main thread.
+	resultCh := make(chan *lookUpJoinTask, concurrency+prefetch)
 	e.resultCh = resultCh
 	workerCtx, cancelFunc := context.WithCancel(ctx)
 	e.cancelFunc = cancelFunc
-	innerCh := make(chan *lookUpJoinTask, concurrency)
+	innerCh := make(chan *lookUpJoinTask, concurrency+prefetch)
 	e.WorkerWg.Add(1)
 	go e.newOuterWorker(resultCh, innerCh).run(workerCtx, e.WorkerWg)
 	for i := 0; i < concurrency; i++ {
@@ -516,12 +525,26 @@ func (iw *innerWorker) run(ctx context.Context, wg *sync.WaitGroup) {
 	}()
 
 	for ok := true; ok; {
+		// Stop at once when the join is cancelled, even if there are tasks built ahead.
+		select {
+		case <-ctx.Done():
+			return
+		default:
+		}
 		select {
 		case task, ok = <-iw.taskCh:
-			if !ok {
+			// The task has been built ahead by the outer worker, so the inner worker doesn't wait for it.
+			if ok && iw.stats != nil {
+				atomic.AddInt64(&iw.stats.prefetchHit, 1)
+			}
+		default:
+			select {
+			case task, ok = <-iw.taskCh:
+			case <-ctx.Done():
 				return
 			}
-		case <-ctx.Done():
+		}
+		if !ok {
 			return
 		}
 
@@ -809,6 +832,7 @@ func (e *IndexLookUpJoin) Close() error {
 
 type indexLookUpJoinRuntimeStats struct {
 	concurrency int
+	prefetch    int
 	probe       int64
 	innerWorker innerWorkerRuntimeStats
 }
@@ -820,6 +844,8 @@ type innerWorkerRuntimeStats struct {
 	fetch     int64
 	build     int64
 	join      int64
+	// prefetchHit is the number of tasks which have been built before the inner workers are free to handle them.
+	prefetchHit int64
 }
 
 func (e *indexLookUpJoinRuntimeStats) String() string {
@@ -833,6 +859,12 @@ func (e *indexLookUpJoinRuntimeStats) String() string {
 		} else {
 			buf.WriteString("OFF")
 		}
+		if e.prefetch > 0 {
+			buf.WriteString(", prefetch:")
+			buf.WriteString(strconv.Itoa(e.prefetch))
+			buf.WriteString(", prefetch_hit:")
+			buf.WriteString(strconv.FormatInt(e.innerWorker.prefetchHit, 10))
+		}
 		buf.WriteString(", task:")
 		buf.WriteString(strconv.FormatInt(e.innerWorker.task, 10))
 		buf.WriteString(", construct:")
@@ -857,6 +889,7 @@ func (e *indexLookUpJoinRuntimeStats) String() string {
 func (e *indexLookUpJoinRuntimeStats) Clone() execdetails.RuntimeStats {
 	return &indexLookUpJoinRuntimeStats{
 		concurrency: e.concurrency,
+		prefetch:    e.prefetch,
 		probe:       e.probe,
 		innerWorker: e.innerWorker,
 	}
@@ -874,6 +907,7 @@ func (e *indexLookUpJoinRuntimeStats) Merge(rs execdetails.RuntimeStats) {
 	e.innerWorker.fetch += tmp.innerWorker.fetch
 	e.innerWorker.build += tmp.innerWorker.build
 	e.innerWorker.join += tmp.innerWorker.join
+	e.innerWorker.prefetchHit += tmp.innerWorker.prefetchHit
 }
 
 // Tp implements the RuntimeStats interface.
@@ -1274,6 +1274,7 @@ func (w *BuildWorker) BuildHashTableForList(buildSideResultCh <-chan *chunk.Chun
 			}
 		})
 		w.HashJoinCtx.SessCtx.GetSessionVars().MemTracker.FallbackOldAndSetNewAction(actionSpill)
+		memory.SetOperatorQuota(rowContainer.GetMemTracker(), w.HashJoinCtx.SessCtx.GetSessionVars().MemQuotaOperator, actionSpill)
 	}
 	for chk := range buildSideResultCh {
 		if w.HashJoinCtx.finished.Load() {
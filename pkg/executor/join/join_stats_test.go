@@ -44,16 +44,21 @@ func TestIndexJoinRuntimeStats(t *testing.T) {
 		concurrency: 5,
 		probe:       int64(time.Second),
 		innerWorker: innerWorkerRuntimeStats{
-			totalTime: int64(time.Second * 5),
-			task:      16,
-			construct: int64(100 * time.Millisecond),
-			fetch:     int64(300 * time.Millisecond),
-			build:     int64(250 * time.Millisecond),
-			join:      int64(150 * time.Millisecond),
+			totalTime:   int64(time.Second * 5),
+			task:        16,
+			construct:   int64(100 * time.Millisecond),
+			fetch:       int64(300 * time.Millisecond),
+			build:       int64(250 * time.Millisecond),
+			join:        int64(150 * time.Millisecond),
+			prefetchHit: 3,
 		},
 	}
 	require.Equal(t, "inner:{total:5s, concurrency:5, task:16, construct:100ms, fetch:300ms, build:250ms, join:150ms}, probe:1s", stats.String())
 	require.Equal(t, stats.Clone().String(), stats.String())
 	stats.Merge(stats.Clone())
 	require.Equal(t, "inner:{total:10s, concurrency:5, task:32, construct:200ms, fetch:600ms, build:500ms, join:300ms}, probe:2s", stats.String())
+
+	stats.prefetch = 2
+	require.Equal(t, "inner:{total:10s, concurrency:5, prefetch:2, prefetch_hit:6, task:32, construct:200ms, fetch:600ms, build:500ms, join:300ms}, probe:2s", stats.String())
+	require.Equal(t, stats.Clone().String(), stats.String())
 }
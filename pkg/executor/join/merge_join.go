@@ -109,6 +109,7 @@ func (t *MergeJoinTable) init(executor *MergeJoinExec) {
 				}
 			})
 			executor.Ctx().GetSessionVars().MemTracker.FallbackOldAndSetNewAction(actionSpill)
+			memory.SetOperatorQuota(t.rowContainer.GetMemTracker(), executor.Ctx().GetSessionVars().MemQuotaOperator, actionSpill)
 		}
 		t.memTracker = memory.NewTracker(memory.LabelForInnerTable, -1)
 	} else {
@@ -0,0 +1,215 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/memory"
+)
+
+var _ exec.Executor = &JSONTableExec{}
+
+// JSONTableExec shreds a JSON document into rows for JSON_TABLE.
+// All the rows are produced when it is opened, since the document has been read into memory anyway. The memory of
+// the rows is tracked by the statement, so a document producing too many rows is subject to tidb_mem_quota_query.
+type JSONTableExec struct {
+	exec.BaseExecutor
+
+	expr            expression.Expression
+	root            *plannercore.JSONTablePath
+	alias           model.CIStr
+	pushedDownConds []expression.Expression
+
+	// colIdx maps the columns of JSON_TABLE to their offsets in the schema, pruned columns are not in it.
+	colIdx map[*plannercore.JSONTableColumn]int
+	rows   [][]types.Datum
+	cursor int
+
+	memTracker *memory.Tracker
+}
+
+// Open implements the Executor Open interface.
+func (e *JSONTableExec) Open(context.Context) error {
+	e.rows = e.rows[:0]
+	e.cursor = 0
+	if e.memTracker != nil {
+		e.memTracker.Reset()
+	} else {
+		e.memTracker = memory.NewTracker(e.ID(), -1)
+	}
+	e.memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
+	doc, isNull, err := e.expr.EvalJSON(e.Ctx().GetExprCtx().GetEvalCtx(), chunk.Row{})
+	if err != nil || isNull {
+		return err
+	}
+	row := make([]types.Datum, e.Schema().Len())
+	_, err = e.produceRows(e.root, doc, row)
+	return err
+}
+
+// Next implements the Executor Next interface.
+func (e *JSONTableExec) Next(_ context.Context, req *chunk.Chunk) error {
+	req.GrowAndReset(e.MaxChunkSize())
+	for ; e.cursor < len(e.rows) && !req.IsFull(); e.cursor++ {
+		for i := range e.rows[e.cursor] {
+			req.AppendDatum(i, &e.rows[e.cursor][i])
+		}
+	}
+	return nil
+}
+
+// Close implements the Executor Close interface.
+func (e *JSONTableExec) Close() error {
+	e.rows = nil
+	if e.memTracker != nil {
+		e.memTracker.ReplaceBytesUsed(0)
+	}
+	return e.BaseExecutor.Close()
+}
+
+// produceRows produces the rows of every value matched by the path in the document, and returns the number of
+// the matched values. The columns of the path and its nested paths are set to NULL after that, so a nested path
+// produces NULLs for the rows of its sibling paths.
+func (e *JSONTableExec) produceRows(path *plannercore.JSONTablePath, doc types.BinaryJSON, row []types.Datum) (int, error) {
+	matches := doc.ExtractAll(path.Path)
+	for i, m := range matches {
+		for _, col := range path.Columns {
+			idx, ok := e.colIdx[col]
+			if !ok {
+				continue
+			}
+			d, err := e.columnValue(col, m, i+1)
+			if err != nil {
+				return 0, err
+			}
+			row[idx] = d
+		}
+		produced := false
+		for _, nested := range path.Nested {
+			n, err := e.produceRows(nested, m, row)
+			if err != nil {
+				return 0, err
+			}
+			produced = produced || n > 0
+		}
+		// A nested path matching nothing is like the inner side of an outer join, which produces NULLs.
+		if !produced {
+			if err := e.appendRow(row); err != nil {
+				return 0, err
+			}
+		}
+	}
+	e.resetColumns(path, row)
+	return len(matches), nil
+}
+
+func (e *JSONTableExec) resetColumns(path *plannercore.JSONTablePath, row []types.Datum) {
+	for _, col := range path.Columns {
+		if idx, ok := e.colIdx[col]; ok {
+			row[idx].SetNull()
+		}
+	}
+	for _, nested := range path.Nested {
+		e.resetColumns(nested, row)
+	}
+}
+
+func (e *JSONTableExec) appendRow(row []types.Datum) error {
+	if len(e.pushedDownConds) > 0 {
+		selected, _, err := expression.EvalBool(e.Ctx().GetExprCtx().GetEvalCtx(), e.pushedDownConds, chunk.MutRowFromDatums(row).ToRow())
+		if err != nil || !selected {
+			return err
+		}
+	}
+	e.rows = append(e.rows, append(make([]types.Datum, 0, len(row)), row...))
+	e.memTracker.Consume(types.EstimatedMemUsage(row, 1))
+	return nil
+}
+
+// columnValue calculates the value of the column for the value matched by the row path.
+func (e *JSONTableExec) columnValue(col *plannercore.JSONTableColumn, m types.BinaryJSON, ordinality int) (types.Datum, error) {
+	typeCtx := e.Ctx().GetSessionVars().StmtCtx.TypeCtx()
+	ft := col.Col.RetType
+	switch col.Tp {
+	case ast.JSONTableColumnOrdinality:
+		return types.NewUintDatum(uint64(ordinality)), nil
+	case ast.JSONTableColumnExists:
+		exists := int64(0)
+		if _, found := m.Extract([]types.JSONPathExpression{col.Path}); found {
+			exists = 1
+		}
+		d := types.NewIntDatum(exists)
+		return d.ConvertTo(typeCtx, ft)
+	}
+	matches := m.ExtractAll(col.Path)
+	if len(matches) == 0 {
+		return e.responseValue(col, col.OnEmpty, col.EmptyDefault, exeerrors.ErrMissingJSONTableValue.GenWithStackByArgs(col.Name.O))
+	}
+	var d types.Datum
+	if ft.GetType() == mysql.TypeJSON {
+		v, _ := m.Extract([]types.JSONPathExpression{col.Path})
+		d = types.NewJSONDatum(v)
+	} else {
+		if len(matches) > 1 || matches[0].TypeCode == types.JSONTypeCodeArray || matches[0].TypeCode == types.JSONTypeCodeObject {
+			return e.responseValue(col, col.OnError, col.ErrorDefault, exeerrors.ErrWrongJSONTableValue.GenWithStackByArgs(col.Name.O, e.alias.O))
+		}
+		d = jsonScalarToDatum(matches[0])
+	}
+	v, err := d.ConvertTo(typeCtx, ft)
+	if err != nil {
+		return e.responseValue(col, col.OnError, col.ErrorDefault, err)
+	}
+	return v, nil
+}
+
+// responseValue handles the ON EMPTY or ON ERROR clause of the column.
+func (e *JSONTableExec) responseValue(col *plannercore.JSONTableColumn, tp ast.JSONTableOnResponseType,
+	defaultValue types.BinaryJSON, err error) (types.Datum, error) {
+	switch tp {
+	case ast.JSONTableResponseError:
+		return types.Datum{}, err
+	case ast.JSONTableResponseDefault:
+		d := jsonScalarToDatum(defaultValue)
+		if col.Col.RetType.GetType() == mysql.TypeJSON {
+			d = types.NewJSONDatum(defaultValue)
+		}
+		return d.ConvertTo(e.Ctx().GetSessionVars().StmtCtx.TypeCtx(), col.Col.RetType)
+	default:
+		return types.Datum{}, nil
+	}
+}
+
+// jsonScalarToDatum converts a JSON scalar to a datum, a JSON string is unquoted and a JSON null is NULL.
+func jsonScalarToDatum(bj types.BinaryJSON) types.Datum {
+	switch bj.TypeCode {
+	case types.JSONTypeCodeString:
+		return types.NewStringDatum(string(bj.GetString()))
+	case types.JSONTypeCodeLiteral:
+		if bj.Value[0] == types.JSONLiteralNil {
+			return types.Datum{}
+		}
+	}
+	return types.NewJSONDatum(bj)
+}
@@ -0,0 +1,208 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/distsql"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/table/tables"
+	"github.com/pingcap/tidb/pkg/tablecodec"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/rowcodec"
+)
+
+var _ exec.Executor = &LooseIndexScanExec{}
+
+// LooseIndexScanExec executes the aggregation grouping by a prefix of the index columns by the loose index scan.
+// It seeks to the first entry of a group, reads the last entry of the group if MAX of the index column next to the
+// prefix is required, and then skips to the next group by seeking to the key after the prefix of the group.
+type LooseIndexScanExec struct {
+	exec.BaseExecutor
+
+	snapshot   kv.Snapshot
+	table      *model.TableInfo
+	index      *model.IndexInfo
+	plan       *plannercore.PhysicalLooseIndexScan
+	colInfos   []rowcodec.ColInfo
+	fieldTypes []*types.FieldType
+
+	kvRanges []kv.KeyRange
+	// rangeIdx and seekKey are the position to read the next group from.
+	rangeIdx int
+	seekKey  kv.Key
+}
+
+// Open implements the Executor Open interface.
+func (e *LooseIndexScanExec) Open(ctx context.Context) error {
+	keyRanges, err := distsql.IndexRangesToKVRanges(e.Ctx().GetDistSQLCtx(), e.table.ID, e.index.ID, e.plan.IndexScan.Ranges)
+	if err != nil {
+		return err
+	}
+	e.kvRanges = keyRanges.FirstPartitionRange()
+	e.rangeIdx = 0
+	e.seekKey = nil
+	e.colInfos = tables.BuildRowcodecColInfoForIndexColumns(e.index, e.table)
+	e.fieldTypes = tables.BuildFieldTypesForIndexColumns(e.index, e.table)
+	return e.BaseExecutor.Open(ctx)
+}
+
+// Next implements the Executor Next interface.
+func (e *LooseIndexScanExec) Next(_ context.Context, req *chunk.Chunk) error {
+	req.GrowAndReset(e.MaxChunkSize())
+	for !req.IsFull() && e.rangeIdx < len(e.kvRanges) {
+		found, err := e.nextGroup(req)
+		if err != nil {
+			return err
+		}
+		if !found {
+			e.rangeIdx++
+			e.seekKey = nil
+		}
+	}
+	return nil
+}
+
+// nextGroup appends the result of the next group in the current range to the chunk, it returns false if there are
+// no more groups in the range.
+func (e *LooseIndexScanExec) nextGroup(req *chunk.Chunk) (bool, error) {
+	r := e.kvRanges[e.rangeIdx]
+	if e.seekKey == nil {
+		e.seekKey = r.StartKey
+	}
+	if bytes.Compare(e.seekKey, r.EndKey) >= 0 {
+		return false, nil
+	}
+	first, firstValue, err := e.firstEntry(e.seekKey, r.EndKey)
+	if err != nil || first == nil {
+		return false, err
+	}
+	_, rest, err := tablecodec.CutIndexKeyNew(first, e.plan.GroupByLen)
+	if err != nil {
+		return false, errors.Trace(err)
+	}
+	prefix := first[:len(first)-len(rest)]
+	groupEnd := prefix.PrefixNext()
+	if bytes.Compare(groupEnd, r.EndKey) > 0 {
+		groupEnd = r.EndKey
+	}
+
+	firstValues, err := e.decodeEntry(first, firstValue)
+	if err != nil {
+		return false, err
+	}
+	var minValues, maxValues []types.Datum
+	for i, aggFunc := range e.plan.AggFuncs {
+		offset := e.plan.AggColOffsets[i]
+		d := firstValues[offset]
+		if offset == e.plan.GroupByLen {
+			switch aggFunc.Name {
+			case ast.AggFuncMin:
+				if minValues == nil {
+					if minValues, err = e.minEntry(firstValues, first, groupEnd); err != nil {
+						return false, err
+					}
+				}
+				d = minValues[offset]
+			case ast.AggFuncMax:
+				if maxValues == nil {
+					if maxValues, err = e.maxEntry(first, groupEnd); err != nil {
+						return false, err
+					}
+				}
+				d = maxValues[offset]
+			}
+		}
+		req.AppendDatum(i, &d)
+	}
+	e.seekKey = groupEnd
+	return true, nil
+}
+
+// minEntry returns the values of the first entry of the group whose column next to the prefix isn't NULL, since the
+// NULL values are ordered first in the index. It returns the values of the first entry if all of them are NULL.
+func (e *LooseIndexScanExec) minEntry(firstValues []types.Datum, first, groupEnd kv.Key) ([]types.Datum, error) {
+	if !firstValues[e.plan.GroupByLen].IsNull() {
+		return firstValues, nil
+	}
+	iter, err := e.snapshot.Iter(first.Next(), groupEnd)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	for iter.Valid() {
+		values, err := e.decodeEntry(iter.Key().Clone(), bytes.Clone(iter.Value()))
+		if err != nil {
+			return nil, err
+		}
+		if !values[e.plan.GroupByLen].IsNull() {
+			return values, nil
+		}
+		if err = iter.Next(); err != nil {
+			return nil, err
+		}
+	}
+	return firstValues, nil
+}
+
+// maxEntry returns the values of the last entry of the group, which starts from the first entry.
+func (e *LooseIndexScanExec) maxEntry(first, groupEnd kv.Key) ([]types.Datum, error) {
+	iter, err := e.snapshot.IterReverse(groupEnd, first)
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, errors.Errorf("the last index entry before %s is not found", groupEnd)
+	}
+	return e.decodeEntry(iter.Key().Clone(), bytes.Clone(iter.Value()))
+}
+
+func (e *LooseIndexScanExec) firstEntry(start, end kv.Key) (key kv.Key, value []byte, err error) {
+	iter, err := e.snapshot.Iter(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+	if !iter.Valid() {
+		return nil, nil, nil
+	}
+	return iter.Key().Clone(), bytes.Clone(iter.Value()), nil
+}
+
+// decodeEntry decodes the values of the index columns from the index entry.
+func (e *LooseIndexScanExec) decodeEntry(key kv.Key, value []byte) ([]types.Datum, error) {
+	colsLen := min(e.plan.GroupByLen+1, len(e.index.Columns))
+	values, err := tablecodec.DecodeIndexKV(key, value, len(e.index.Columns), tablecodec.HandleNotNeeded, e.colInfos)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	loc := e.Ctx().GetSessionVars().Location()
+	datums := make([]types.Datum, colsLen)
+	for i := range datums {
+		if datums[i], err = tablecodec.DecodeColumnValue(values[i], e.fieldTypes[i], loc); err != nil {
+			return nil, errors.Trace(err)
+		}
+	}
+	return datums, nil
+}
@@ -0,0 +1,75 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/testkit"
+)
+
+func TestLooseIndexScan(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, c varchar(10), d int, key iab(a, b), key ic(c(2), d))")
+	tk.MustExec("insert into t values (null, 1, 'x', 1), (null, null, 'x', 1), (1, null, 'ab', 2), (1, 3, 'ab', 2), (1, 2, 'abc', 3), " +
+		"(2, null, 'b', 1), (2, null, 'b', 1), (3, 5, 'c', 1), (3, 4, 'c', 2), (3, 6, 'cd', 3)")
+
+	for _, cond := range []string{"", "where a >= 2", "where a in (1, 3)", "where a is null or a > 2"} {
+		for _, fields := range []string{"a", "a, min(b)", "a, max(b)", "min(b), max(b), a", "max(a), min(b)"} {
+			sql := fmt.Sprintf("select %%s %s from t use index(iab) %s group by a order by a", fields, cond)
+			expected := tk.MustQuery(fmt.Sprintf(sql, "")).Rows()
+			hinted := fmt.Sprintf(sql, "/*+ loose_index_scan(t iab) */")
+			tk.MustHavePlan(hinted, "LooseIndexScan")
+			tk.MustQuery(hinted).Check(expected)
+			tk.MustQuery("show warnings").Check(testkit.Rows())
+		}
+	}
+	tk.MustQuery("select /*+ loose_index_scan(t iab) */ a, b from t group by a, b order by a, b").Check(testkit.Rows(
+		"<nil> <nil>", "<nil> 1", "1 <nil>", "1 2", "1 3", "2 <nil>", "3 4", "3 5", "3 6"))
+	tk.MustQuery("explain format = 'brief' select /*+ loose_index_scan(t iab) */ a, max(b) from t where a > 1 group by a").CheckAt(
+		[]int{0, 2, 3, 4}, testkit.RowsWithSep("|",
+			"Projection|root||test.t.a, Column#6",
+			"└─LooseIndexScan|root|table:t, index:iab(a, b)|group by:test.t.a, funcs:max(test.t.b)->Column#6, funcs:firstrow(test.t.a)->test.t.a, range:(1,+inf], keep order:true, stats:pseudo"))
+
+	// The hint is inapplicable for the other aggregate functions, the columns which aren't a prefix of the index and the
+	// prefix index columns.
+	for _, sql := range []string{
+		"select /*+ loose_index_scan(t iab) */ a, count(b) from t group by a",
+		"select /*+ loose_index_scan(t iab) */ b, min(a) from t group by b",
+		"select /*+ loose_index_scan(t iab) */ a, min(d) from t group by a",
+		"select /*+ loose_index_scan(t ic) */ c, min(d) from t group by c",
+	} {
+		tk.MustNotHavePlan(sql, "LooseIndexScan")
+		tk.MustQuery("show warnings").CheckContain("Optimizer Hint LOOSE_INDEX_SCAN is inapplicable")
+		tk.MustQuery("show warnings").CheckAt([]int{0, 1}, testkit.Rows("Warning 8073"))
+	}
+
+	// The uncommitted rows are read by the union scan instead.
+	tk.MustExec("begin")
+	tk.MustExec("insert into t values (4, 7, 'd', 1)")
+	tk.MustQuery("select /*+ loose_index_scan(t iab) */ a, max(b) from t where a > 2 group by a order by a").Check(testkit.Rows("3 6", "4 7"))
+	tk.MustExec("rollback")
+
+	// The ranges are rebuilt when the cached plan is reused.
+	tk.MustExec("prepare stmt from 'select /*+ loose_index_scan(t iab) */ a, min(b) from t where a >= ? group by a order by a'")
+	tk.MustExec("set @a = 2")
+	tk.MustQuery("execute stmt using @a").Check(testkit.Rows("2 <nil>", "3 4"))
+	tk.MustExec("set @a = 3")
+	tk.MustQuery("execute stmt using @a").Check(testkit.Rows("3 4"))
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+}
@@ -87,10 +87,18 @@ type MPPGather struct {
 	dummy    bool
 
 	mppExec *mpp.ExecutorWithRetry
+
+	// waitTiFlashReplica waits for the tiflash replicas to become available before dispatching the tasks.
+	waitTiFlashReplica func(ctx context.Context) error
 }
 
 // Open implements the Executor Open interface.
 func (e *MPPGather) Open(ctx context.Context) (err error) {
+	if e.waitTiFlashReplica != nil {
+		if err = e.waitTiFlashReplica(ctx); err != nil {
+			return err
+		}
+	}
 	if e.dummy {
 		sender, ok := e.originalPlan.(*plannercore.PhysicalExchangeSender)
 		if !ok {
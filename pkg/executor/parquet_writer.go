@@ -0,0 +1,121 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+const (
+	parquetTypeInt64  = "INT64"
+	parquetTypeDouble = "DOUBLE"
+	parquetTypeUTF8   = "UTF8"
+
+	// parquetRowGroupSize bounds the memory used to buffer a row group, the
+	// default 128MiB of the parquet writer is too large to run in TiDB.
+	parquetRowGroupSize = 16 * 1024 * 1024
+)
+
+// parquetColumnType returns the parquet type used to write the column, the
+// numeric columns keep their types, and other columns are written as string.
+func parquetColumnType(ft *types.FieldType) string {
+	switch ft.GetType() {
+	case mysql.TypeTiny, mysql.TypeShort, mysql.TypeInt24, mysql.TypeLong, mysql.TypeYear:
+		return parquetTypeInt64
+	case mysql.TypeLonglong:
+		if mysql.HasUnsignedFlag(ft.GetFlag()) {
+			return parquetTypeUTF8
+		}
+		return parquetTypeInt64
+	case mysql.TypeFloat, mysql.TypeDouble:
+		return parquetTypeDouble
+	default:
+		return parquetTypeUTF8
+	}
+}
+
+// fileWriterAdapter adapts storage.ExternalFileWriter to io.Writer.
+type fileWriterAdapter struct {
+	ctx context.Context
+	w   storage.ExternalFileWriter
+}
+
+// Write implements io.Writer.
+func (w *fileWriterAdapter) Write(p []byte) (int, error) {
+	return w.w.Write(w.ctx, p)
+}
+
+// newParquetWriter creates a parquet writer on w, all the columns are
+// nullable. The caller should call WriteStop to flush the data.
+func newParquetWriter(ctx context.Context, w storage.ExternalFileWriter, names []string, fts []*types.FieldType) (*writer.CSVWriter, error) {
+	metadata := make([]string, 0, len(names))
+	used := make(map[string]struct{}, len(names))
+	for i, name := range names {
+		// ',' and '=' are the separators of the metadata.
+		name = strings.NewReplacer(",", "_", "=", "_").Replace(name)
+		if _, ok := used[strings.ToLower(name)]; ok || name == "" {
+			name = fmt.Sprintf("%s_%d", name, i)
+		}
+		used[strings.ToLower(name)] = struct{}{}
+		metadata = append(metadata, fmt.Sprintf("name=%s, type=%s, repetitiontype=OPTIONAL", name, parquetColumnType(fts[i])))
+	}
+	pw, err := writer.NewCSVWriterFromWriter(metadata, &fileWriterAdapter{ctx: ctx, w: w}, 1)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	pw.RowGroupSize = parquetRowGroupSize
+	return pw, nil
+}
+
+// parquetRecord converts the row to a record of the parquet writer.
+func parquetRecord(row chunk.Row, fts []*types.FieldType) ([]any, error) {
+	rec := make([]any, len(fts))
+	for i, ft := range fts {
+		if row.IsNull(i) {
+			continue
+		}
+		switch parquetColumnType(ft) {
+		case parquetTypeInt64:
+			if mysql.HasUnsignedFlag(ft.GetFlag()) {
+				rec[i] = int64(row.GetUint64(i))
+			} else {
+				rec[i] = row.GetInt64(i)
+			}
+		case parquetTypeDouble:
+			if ft.GetType() == mysql.TypeFloat {
+				rec[i] = float64(row.GetFloat32(i))
+			} else {
+				rec[i] = row.GetFloat64(i)
+			}
+		default:
+			d := row.GetDatum(i, ft)
+			s, err := d.ToString()
+			if err != nil {
+				return nil, err
+			}
+			rec[i] = s
+		}
+	}
+	return rec, nil
+}
@@ -54,9 +54,10 @@ type PlanReplayerExec struct {
 
 // PlanReplayerCaptureInfo indicates capture info
 type PlanReplayerCaptureInfo struct {
-	SQLDigest  string
-	PlanDigest string
-	Remove     bool
+	SQLDigest    string
+	PlanDigest   string
+	Remove       bool
+	CaptureLimit uint64
 }
 
 // PlanReplayerDumpInfo indicates dump info
@@ -147,8 +148,8 @@ func (e *PlanReplayerExec) registerCaptureTask(ctx context.Context) error {
 		return errors.New("plan replayer capture task already exists")
 	}
 	exec := e.Ctx().GetRestrictedSQLExecutor()
-	_, _, err = exec.ExecRestrictedSQL(ctx1, nil, fmt.Sprintf("insert into mysql.plan_replayer_task (sql_digest, plan_digest) values ('%s','%s')",
-		e.CaptureInfo.SQLDigest, e.CaptureInfo.PlanDigest))
+	_, _, err = exec.ExecRestrictedSQL(ctx1, nil, "insert into mysql.plan_replayer_task (sql_digest, plan_digest, capture_limit) values (%?,%?,%?)",
+		e.CaptureInfo.SQLDigest, e.CaptureInfo.PlanDigest, e.CaptureInfo.CaptureLimit)
 	if err != nil {
 		logutil.BgLogger().Warn("insert mysql.plan_replayer_status record failed",
 			zap.Error(err))
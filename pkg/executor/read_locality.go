@@ -0,0 +1,94 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"strings"
+
+	"github.com/pingcap/kvproto/pkg/metapb"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/hint"
+	"github.com/tikv/client-go/v2/tikv"
+	"github.com/tikv/client-go/v2/tikvrpc"
+)
+
+// applyReadLocality decides how to read the data for the read_locality hint of the statement. If some stores match
+// the labels of the hint, the replicas on them are preferred, and the labels are kept in the statement hints to be
+// passed to the requests. Otherwise, the labels are cleared and the fallback policy of the hint takes effect.
+//
+// Matching the labels is a preference of the replica selector, so the regions which have no replica on the matched
+// stores are still read from the other replicas.
+func applyReadLocality(sctx sessionctx.Context) error {
+	sc := sctx.GetSessionVars().StmtCtx
+	if !sc.HasReadLocalityHint || len(sc.ReadLocalityLabels) == 0 {
+		return nil
+	}
+	if hasStoreMatchingLabels(sctx.GetStore(), sc.ReadLocalityLabels) {
+		// READ_CONSISTENT_REPLICA reads from the followers, otherwise both the leader and the followers can be chosen.
+		if !sc.HasReplicaReadHint {
+			sc.HasReplicaReadHint = true
+			sc.ReplicaRead = byte(kv.ReplicaReadMixed)
+		}
+		return nil
+	}
+
+	labels := formatStoreLabels(sc.ReadLocalityLabels)
+	sc.ReadLocalityLabels = nil
+	switch sc.ReadLocalityFallback {
+	case hint.ReadLocalityFallbackError:
+		return exeerrors.ErrNoMatchingReplica.FastGenByArgs(labels)
+	case hint.ReadLocalityFallbackLeader:
+		sc.HasReplicaReadHint = true
+		sc.ReplicaRead = byte(kv.ReplicaReadLeader)
+		sc.AppendWarning(hint.ErrWarnHintInapplicable.FastGen("No store matches the labels '%s' of READ_LOCALITY(), read from the leader", labels))
+	default:
+		sc.AppendWarning(hint.ErrWarnHintInapplicable.FastGen("No store matches the labels '%s' of READ_LOCALITY(), read from any replica", labels))
+	}
+	return nil
+}
+
+// hasStoreMatchingLabels checks whether any TiKV store in the region cache matches the labels. It returns true if the
+// stores are unknown, so that the labels are still passed to the requests.
+func hasStoreMatchingLabels(store kv.Storage, labels []*metapb.StoreLabel) bool {
+	tikvStore, ok := store.(tikv.Storage)
+	if !ok || tikvStore.GetRegionCache() == nil {
+		return true
+	}
+	stores := tikvStore.GetRegionCache().GetStoresByType(tikvrpc.TiKV)
+	if len(stores) == 0 {
+		return true
+	}
+	for _, s := range stores {
+		if s.IsLabelsMatch(labels) {
+			return true
+		}
+	}
+	return false
+}
+
+func formatStoreLabels(labels []*metapb.StoreLabel) string {
+	var sb strings.Builder
+	for i, label := range labels {
+		if i > 0 {
+			sb.WriteString(",")
+		}
+		sb.WriteString(label.Key)
+		sb.WriteString("=")
+		sb.WriteString(label.Value)
+	}
+	return sb.String()
+}
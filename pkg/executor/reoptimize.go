@@ -0,0 +1,218 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/planner"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"go.uber.org/zap"
+)
+
+// reoptimizeMinRows is the minimum actual row count of a reader to request the adaptive re-optimization, it avoids
+// re-optimizing the statements for the misestimations which don't matter.
+const reoptimizeMinRows = 1000
+
+// errReoptimize is returned by a reader to stop the execution when it requests to re-optimize the statement.
+var errReoptimize = errors.New("the statement is going to be re-optimized")
+
+// reoptimizeChecker checks whether the actual row count of a reader exceeds its estimation by
+// tidb_opt_reoptimization_misestimate_ratio times, and requests to re-optimize the statement if so.
+type reoptimizeChecker struct {
+	info      *stmtctx.ReoptimizeInfo
+	key       string
+	tableName string
+	estRows   float64
+	threshold float64
+	actRows   int
+}
+
+// newReoptimizeChecker returns the checker for the reader, or nil if the adaptive re-optimization is not enabled for
+// the statement. The rows returned by the reader must be the rows of its DataSource, so the readers with pushed down
+// operators other than the scans and selections are not checked.
+func (b *executorBuilder) newReoptimizeChecker(reader base.PhysicalPlan, copPlans []base.PhysicalPlan) *reoptimizeChecker {
+	info := &b.ctx.GetSessionVars().StmtCtx.Reoptimize
+	if !info.Enabled() {
+		return nil
+	}
+	var (
+		tblInfo         *model.TableInfo
+		tblAsName       *model.CIStr
+		physicalTableID int64
+	)
+	for _, p := range copPlans {
+		switch x := p.(type) {
+		case *plannercore.PhysicalTableScan:
+			if tblInfo == nil {
+				tblInfo, tblAsName, physicalTableID = x.Table, x.TableAsName, x.Table.ID
+				if ok, pid := x.IsPartition(); ok {
+					physicalTableID = pid
+				}
+			}
+		case *plannercore.PhysicalIndexScan:
+			tblInfo, tblAsName, physicalTableID = x.Table, x.TableAsName, x.Table.ID
+			if ok, pid := x.IsPartition(); ok {
+				physicalTableID = pid
+			}
+		case *plannercore.PhysicalSelection:
+		default:
+			return nil
+		}
+	}
+	if tblInfo == nil {
+		return nil
+	}
+	tableName := tblInfo.Name.L
+	if tblAsName != nil && tblAsName.L != "" {
+		tableName = tblAsName.L
+	}
+	estRows := reader.StatsCount()
+	return &reoptimizeChecker{
+		info:      info,
+		key:       stmtctx.ReoptimizeKey(physicalTableID, tableName),
+		tableName: tableName,
+		estRows:   estRows,
+		threshold: math.Max(estRows*b.ctx.GetSessionVars().ReoptimizationMisestimateRatio, reoptimizeMinRows),
+	}
+}
+
+// reset resets the actual row count when the reader is reopened.
+func (c *reoptimizeChecker) reset() {
+	if c != nil {
+		c.actRows = 0
+	}
+}
+
+// check accumulates the actual row count and returns errReoptimize if the statement is going to be re-optimized.
+func (c *reoptimizeChecker) check(rows int) error {
+	if c == nil || rows == 0 {
+		return nil
+	}
+	c.actRows += rows
+	if float64(c.actRows) < c.threshold {
+		return nil
+	}
+	reason := fmt.Sprintf("the actual row count of %s reaches %d while its estimated row count is %.2f",
+		c.tableName, c.actRows, c.estRows)
+	// Stop checking since the statement is either going to be re-optimized or unable to be re-optimized.
+	c.threshold = math.MaxFloat64
+	if !c.info.Request(c.key, float64(c.actRows), reason) {
+		return nil
+	}
+	return errReoptimize
+}
+
+// canReoptimize checks whether the statement can be re-optimized during its execution. Only the read-only SELECT
+// statements without side effects are re-optimized, since their executions can be restarted if no row has been
+// returned to the client.
+func (a *ExecStmt) canReoptimize() bool {
+	vars := a.Ctx.GetSessionVars()
+	if !vars.EnableAdaptiveReoptimization || vars.InRestrictedSQL || vars.StmtCtx.Reoptimize.Reoptimized() {
+		return false
+	}
+	sel, ok := a.StmtNode.(*ast.SelectStmt)
+	if !ok || sel.LockInfo != nil || sel.SelectIntoOpt != nil {
+		return false
+	}
+	switch a.Plan.(type) {
+	case *plannercore.PointGetPlan, *plannercore.BatchPointGetPlan:
+		return false
+	}
+	checker := sideEffectChecker{}
+	sel.Accept(&checker)
+	return !checker.hasSideEffects
+}
+
+// sideEffectFunctions are the functions whose effects are visible out of the statement,
+// so the statement can't be executed again.
+var sideEffectFunctions = map[string]struct{}{
+	ast.NextVal:         {},
+	ast.SetVal:          {},
+	ast.GetLock:         {},
+	ast.ReleaseLock:     {},
+	ast.ReleaseAllLocks: {},
+}
+
+// sideEffectChecker checks whether a statement has side effects, e.g. assigns user variables.
+type sideEffectChecker struct {
+	hasSideEffects bool
+}
+
+// Enter implements ast.Visitor interface.
+func (c *sideEffectChecker) Enter(in ast.Node) (ast.Node, bool) {
+	switch x := in.(type) {
+	case *ast.VariableExpr:
+		// @v := expr
+		c.hasSideEffects = c.hasSideEffects || (!x.IsSystem && x.Value != nil)
+	case *ast.FuncCallExpr:
+		if _, ok := sideEffectFunctions[x.FnName.L]; ok {
+			c.hasSideEffects = true
+		} else if x.FnName.L == ast.LastInsertId && len(x.Args) > 0 {
+			// last_insert_id(expr) sets the value returned by the next last_insert_id().
+			c.hasSideEffects = true
+		}
+	}
+	return in, c.hasSideEffects
+}
+
+// Leave implements ast.Visitor interface.
+func (c *sideEffectChecker) Leave(in ast.Node) (ast.Node, bool) {
+	return in, !c.hasSideEffects
+}
+
+// reoptimize re-optimizes the statement with the row counts observed in the current execution,
+// and returns the executor built for the new plan.
+func (a *ExecStmt) reoptimize(ctx context.Context) (exec.Executor, error) {
+	sc := a.Ctx.GetSessionVars().StmtCtx
+	reason := sc.Reoptimize.Start()
+	logutil.Logger(ctx).Info("re-optimize the statement", zap.String("reason", reason),
+		zap.String("sql", a.GetTextToLog(false)))
+	// Reset the warnings and other states produced by the previous optimization and execution.
+	sc.ResetForRetry()
+	a.resetPhaseDurations()
+
+	if err := plannercore.Preprocess(ctx, a.Ctx, a.StmtNode, plannercore.InTxnRetry); err != nil {
+		return nil, err
+	}
+	p, names, err := planner.Optimize(ctx, a.Ctx, a.StmtNode, a.InfoSchema)
+	if err != nil {
+		return nil, err
+	}
+	a.OutputNames = names
+	a.Plan = p
+	sc.SetPlan(p)
+	sc.AppendNote(errors.NewNoStackErrorf("the statement is re-optimized since %s", reason))
+
+	e, err := a.buildExecutor()
+	if err != nil {
+		return nil, err
+	}
+	if err = a.openExecutor(ctx, e); err != nil {
+		terror.Log(exec.Close(e))
+		return nil, err
+	}
+	return e, nil
+}
@@ -0,0 +1,89 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdaptiveReoptimization(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int, key(b))")
+	tk.MustExec("create table t2(a int, b int, key(a))")
+	tk.MustExec("insert into t1 values (1, 1)")
+	for i := 0; i < 11; i++ {
+		tk.MustExec("insert into t1 select a + (select count(*) from t1), b + (select count(*) from t1) from t1")
+	}
+	tk.MustExec("insert into t2 select * from t1")
+	tk.MustExec("analyze table t1, t2")
+	// The rows of t1.b = 1 are underestimated since the stats are outdated.
+	tk.MustExec("update t1 set b = 1")
+
+	sql := "select count(*) from t1 join t2 on t1.a = t2.a where t1.b = 1"
+	checkReoptimized := func(reoptimized bool) {
+		tk.MustQuery(sql).Check(testkit.Rows("2048"))
+		require.Equal(t, reoptimized, tk.Session().GetSessionVars().StmtCtx.Reoptimize.Reoptimized())
+		warnings := tk.Session().GetSessionVars().StmtCtx.GetWarnings()
+		found := false
+		for _, warn := range warnings {
+			if strings.Contains(warn.Err.Error(), "the statement is re-optimized since the actual row count of t1 reaches") {
+				found = true
+			}
+		}
+		require.Equal(t, reoptimized, found, "%v", warnings)
+	}
+	checkReoptimized(false)
+
+	tk.MustExec("set @@tidb_opt_enable_adaptive_reoptimization = on")
+	checkReoptimized(true)
+	// The statement isn't re-optimized if the misestimation is within the ratio.
+	tk.MustExec("set @@tidb_opt_reoptimization_misestimate_ratio = 10000")
+	checkReoptimized(false)
+	tk.MustExec("set @@tidb_opt_reoptimization_misestimate_ratio = default")
+
+	// The statement isn't re-optimized after some rows are returned.
+	tk.MustExec("set @@tidb_max_chunk_size = 32")
+	expected := tk.MustQuery("select a from t2").Sort().Rows()
+	tk.MustQuery("select a from t1 where b = 1").Sort().Check(expected)
+	require.False(t, tk.Session().GetSessionVars().StmtCtx.Reoptimize.Reoptimized())
+	tk.MustExec("set @@tidb_max_chunk_size = default")
+
+	// The statements with side effects aren't re-optimized.
+	tk.MustExec("set @@tidb_opt_enable_adaptive_reoptimization = on")
+	for _, sql := range []string{
+		"select @v := count(*) from t1 join t2 on t1.a = t2.a where t1.b = 1",
+		"select count(*), last_insert_id(10) from t1 join t2 on t1.a = t2.a where t1.b = 1",
+		"select count(*) from t1 join t2 on t1.a = t2.a where t1.b = 1 and t1.a > (select last_insert_id(1))",
+	} {
+		tk.MustQuery(sql)
+		require.False(t, tk.Session().GetSessionVars().StmtCtx.Reoptimize.Reoptimized(), sql)
+	}
+	tk.MustQuery("select @v").Check(testkit.Rows("2048"))
+	// reading the user variables is fine.
+	tk.MustQuery("select count(*), @v from t1 join t2 on t1.a = t2.a where t1.b = 1").Check(testkit.Rows("2048 2048"))
+	require.True(t, tk.Session().GetSessionVars().StmtCtx.Reoptimize.Reoptimized())
+
+	// The statements other than SELECT aren't re-optimized.
+	tk.MustExec("create table t3(a int)")
+	tk.MustExec("insert into t3 select t1.a from t1 join t2 on t1.a = t2.a where t1.b = 1")
+	require.False(t, tk.Session().GetSessionVars().StmtCtx.Reoptimize.Reoptimized())
+	tk.MustQuery("select count(*) from t3").Check(testkit.Rows("2048"))
+}
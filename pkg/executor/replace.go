@@ -54,6 +54,7 @@ func (e *ReplaceExec) Open(ctx context.Context) error {
 	e.memTracker = memory.NewTracker(e.ID(), -1)
 	e.memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
 
+	e.checkShardRowIDHint()
 	if e.SelectExec != nil {
 		return exec.Open(ctx, e.SelectExec)
 	}
@@ -16,9 +16,11 @@ package executor
 
 import (
 	"context"
+	"math/rand"
 	"slices"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/distsql"
 	"github.com/pingcap/tidb/pkg/executor/internal/exec"
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/kv"
@@ -29,6 +31,7 @@ import (
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/channel"
 	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/ranger"
 	decoder "github.com/pingcap/tidb/pkg/util/rowDecoder"
 	"github.com/pingcap/tidb/pkg/util/tracing"
 	"github.com/tikv/client-go/v2/tikv"
@@ -65,13 +68,17 @@ func (e *TableSampleExecutor) Next(_ context.Context, req *chunk.Chunk) error {
 }
 
 // Close implements the Executor Close interface.
-func (*TableSampleExecutor) Close() error {
-	return nil
+func (e *TableSampleExecutor) Close() error {
+	if e.sampler == nil {
+		return nil
+	}
+	return e.sampler.close()
 }
 
 type rowSampler interface {
 	writeChunk(req *chunk.Chunk) error
 	finished() bool
+	close() error
 }
 
 type tableRegionSampler struct {
@@ -162,31 +169,35 @@ func (s *tableRegionSampler) pickRanges(count int) ([]kv.KeyRange, error) {
 }
 
 func (s *tableRegionSampler) writeChunkFromRanges(ranges []kv.KeyRange, req *chunk.Chunk) error {
-	decLoc := s.ctx.GetSessionVars().Location()
 	cols, decColMap, err := s.buildSampleColAndDecodeColMap()
 	if err != nil {
 		return err
 	}
 	rowDecoder := decoder.NewRowDecoder(s.table, cols, decColMap)
 	err = s.scanFirstKVForEachRange(ranges, func(handle kv.Handle, value []byte) error {
-		_, err := rowDecoder.DecodeAndEvalRowWithMap(s.ctx, handle, value, decLoc, s.rowMap)
-		if err != nil {
-			return err
-		}
-		currentRow := rowDecoder.CurrentRowWithDefaultVal()
-		mutRow := chunk.MutRowFromTypes(s.retTypes)
-		for i, col := range s.schema.Columns {
-			offset := decColMap[col.ID].Col.Offset
-			target := currentRow.GetDatum(offset, s.retTypes[i])
-			mutRow.SetDatum(i, target)
-		}
-		req.AppendRow(mutRow.ToRow())
-		s.resetRowMap()
-		return nil
+		return s.appendRow(rowDecoder, decColMap, handle, value, req)
 	})
 	return err
 }
 
+func (s *tableRegionSampler) appendRow(rowDecoder *decoder.RowDecoder, decColMap map[int64]decoder.Column,
+	handle kv.Handle, value []byte, req *chunk.Chunk) error {
+	_, err := rowDecoder.DecodeAndEvalRowWithMap(s.ctx, handle, value, s.ctx.GetSessionVars().Location(), s.rowMap)
+	if err != nil {
+		return err
+	}
+	currentRow := rowDecoder.CurrentRowWithDefaultVal()
+	mutRow := chunk.MutRowFromTypes(s.retTypes)
+	for i, col := range s.schema.Columns {
+		offset := decColMap[col.ID].Col.Offset
+		target := currentRow.GetDatum(offset, s.retTypes[i])
+		mutRow.SetDatum(i, target)
+	}
+	req.AppendRow(mutRow.ToRow())
+	s.resetRowMap()
+	return nil
+}
+
 func (s *tableRegionSampler) splitTableRanges() ([]kv.KeyRange, error) {
 	partitionTable := s.table.GetPartitionedTable()
 	if partitionTable == nil {
@@ -194,14 +205,7 @@ func (s *tableRegionSampler) splitTableRanges() ([]kv.KeyRange, error) {
 		return splitIntoMultiRanges(s.ctx.GetStore(), startKey, endKey)
 	}
 
-	var partIDs []int64
-	if partitionTable.Meta().ID == s.physicalTableID {
-		for _, p := range s.partTables {
-			partIDs = append(partIDs, p.GetAllPartitionIDs()...)
-		}
-	} else {
-		partIDs = []int64{s.physicalTableID}
-	}
+	partIDs := s.partitionIDs()
 	ranges := make([]kv.KeyRange, 0, len(partIDs))
 	for _, pid := range partIDs {
 		start := tablecodec.GenTableRecordPrefix(pid)
@@ -215,6 +219,18 @@ func (s *tableRegionSampler) splitTableRanges() ([]kv.KeyRange, error) {
 	return ranges, nil
 }
 
+// partitionIDs returns the IDs of the partitions to sample if the table is partitioned.
+func (s *tableRegionSampler) partitionIDs() []int64 {
+	if s.table.GetPartitionedTable().Meta().ID != s.physicalTableID {
+		return []int64{s.physicalTableID}
+	}
+	var partIDs []int64
+	for _, p := range s.partTables {
+		partIDs = append(partIDs, p.GetAllPartitionIDs()...)
+	}
+	return partIDs
+}
+
 func splitIntoMultiRanges(store kv.Storage, startKey, endKey kv.Key) ([]kv.KeyRange, error) {
 	kvRange := kv.KeyRange{StartKey: startKey, EndKey: endKey}
 
@@ -341,6 +357,251 @@ func (s *tableRegionSampler) finished() bool {
 	return s.isFinished
 }
 
+func (*tableRegionSampler) close() error {
+	return nil
+}
+
+// tableSystemSampler implements the SYSTEM sampling method. It keeps each region of the table with the probability
+// of the sample rate and returns all the rows in the kept regions, so only the kept regions are read.
+type tableSystemSampler struct {
+	*tableRegionSampler
+	rate float64
+
+	rowDecoder *decoder.RowDecoder
+	decColMap  map[int64]decoder.Column
+	snapshot   kv.Snapshot
+	iter       kv.Iterator
+}
+
+func (s *tableSystemSampler) initRanges() error {
+	if s.restKVRanges != nil {
+		return nil
+	}
+	ranges, err := s.splitTableRanges()
+	if err != nil {
+		return err
+	}
+	sortRanges(ranges, s.isDesc)
+	s.restKVRanges = make([]kv.KeyRange, 0, len(ranges))
+	for _, r := range ranges {
+		if rand.Float64() < s.rate {
+			s.restKVRanges = append(s.restKVRanges, r)
+		}
+	}
+	cols, decColMap, err := s.buildSampleColAndDecodeColMap()
+	if err != nil {
+		return err
+	}
+	s.rowDecoder = decoder.NewRowDecoder(s.table, cols, decColMap)
+	s.decColMap = decColMap
+	s.snapshot = s.ctx.GetStore().GetSnapshot(kv.Version{Ver: s.startTS})
+	setOptionForTopSQL(s.ctx.GetSessionVars().StmtCtx, s.snapshot)
+	return nil
+}
+
+func (s *tableSystemSampler) writeChunk(req *chunk.Chunk) error {
+	if err := s.initRanges(); err != nil {
+		return err
+	}
+	for !req.IsFull() {
+		if s.iter == nil || !s.iter.Valid() {
+			if err := s.close(); err != nil {
+				return err
+			}
+			if len(s.restKVRanges) == 0 {
+				s.isFinished = true
+				return nil
+			}
+			r := s.restKVRanges[0]
+			s.restKVRanges = s.restKVRanges[1:]
+			iter, err := s.snapshot.Iter(r.StartKey, r.EndKey)
+			if err != nil {
+				return err
+			}
+			s.iter = iter
+			continue
+		}
+		if tablecodec.IsRecordKey(s.iter.Key()) {
+			handle, err := tablecodec.DecodeRowKey(s.iter.Key())
+			if err != nil {
+				return err
+			}
+			if err = s.appendRow(s.rowDecoder, s.decColMap, handle, s.iter.Value(), req); err != nil {
+				return err
+			}
+		}
+		if err := s.iter.Next(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *tableSystemSampler) close() error {
+	if s.iter != nil {
+		s.iter.Close()
+		s.iter = nil
+	}
+	return nil
+}
+
+// indexSystemSampler implements the SYSTEM sampling method by the secondary index. It keeps each region of the index
+// with the probability of the sample rate, and returns the rows pointed by the index entries in the kept regions which
+// are in the access ranges of the index.
+type indexSystemSampler struct {
+	*tableRegionSampler
+	rate   float64
+	index  *model.IndexInfo
+	ranges []*ranger.Range
+
+	rowDecoder *decoder.RowDecoder
+	decColMap  map[int64]decoder.Column
+	snapshot   kv.Snapshot
+	iter       kv.Iterator
+	// restPhysicalIDs are the physical table IDs of restKVRanges, and curPhysicalID is the one of iter.
+	restPhysicalIDs []int64
+	curPhysicalID   int64
+}
+
+func (s *indexSystemSampler) initRanges() error {
+	if s.restKVRanges != nil {
+		return nil
+	}
+	physicalIDs := []int64{s.physicalTableID}
+	if s.table.GetPartitionedTable() != nil {
+		physicalIDs = s.partitionIDs()
+	}
+	s.restKVRanges = make([]kv.KeyRange, 0)
+	for _, pid := range physicalIDs {
+		start := tablecodec.EncodeTableIndexPrefix(pid, s.index.ID)
+		ranges, err := splitIntoMultiRanges(s.ctx.GetStore(), start, start.PrefixNext())
+		if err != nil {
+			return err
+		}
+		var accessRanges []kv.KeyRange
+		if s.ranges != nil {
+			kvRanges, err := distsql.IndexRangesToKVRanges(s.ctx.GetDistSQLCtx(), pid, s.index.ID, s.ranges)
+			if err != nil {
+				return err
+			}
+			accessRanges = kvRanges.FirstPartitionRange()
+		}
+		for _, r := range ranges {
+			if rand.Float64() >= s.rate {
+				continue
+			}
+			kept := []kv.KeyRange{r}
+			if s.ranges != nil {
+				kept = intersectKeyRanges(r, accessRanges)
+			}
+			for _, kr := range kept {
+				s.restKVRanges = append(s.restKVRanges, kr)
+				s.restPhysicalIDs = append(s.restPhysicalIDs, pid)
+			}
+		}
+	}
+	cols, decColMap, err := s.buildSampleColAndDecodeColMap()
+	if err != nil {
+		return err
+	}
+	s.rowDecoder = decoder.NewRowDecoder(s.table, cols, decColMap)
+	s.decColMap = decColMap
+	s.snapshot = s.ctx.GetStore().GetSnapshot(kv.Version{Ver: s.startTS})
+	setOptionForTopSQL(s.ctx.GetSessionVars().StmtCtx, s.snapshot)
+	return nil
+}
+
+func (s *indexSystemSampler) writeChunk(req *chunk.Chunk) error {
+	if err := s.initRanges(); err != nil {
+		return err
+	}
+	for !req.IsFull() && !s.isFinished {
+		handles, rowKeys, err := s.nextHandles(req.RequiredRows() - req.NumRows())
+		if err != nil {
+			return err
+		}
+		if len(rowKeys) == 0 {
+			continue
+		}
+		values, err := s.snapshot.BatchGet(context.Background(), rowKeys)
+		if err != nil {
+			return err
+		}
+		for i, key := range rowKeys {
+			value, ok := values[string(key)]
+			if !ok {
+				return errors.Errorf("the row of the index entry %s is not found", handles[i])
+			}
+			if err := s.appendRow(s.rowDecoder, s.decColMap, handles[i], value, req); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// nextHandles reads at most count handles from the index entries in the kept regions.
+func (s *indexSystemSampler) nextHandles(count int) ([]kv.Handle, []kv.Key, error) {
+	handles := make([]kv.Handle, 0, count)
+	rowKeys := make([]kv.Key, 0, count)
+	for len(handles) < count {
+		if s.iter == nil || !s.iter.Valid() {
+			if err := s.close(); err != nil {
+				return nil, nil, err
+			}
+			if len(s.restKVRanges) == 0 {
+				s.isFinished = true
+				break
+			}
+			r := s.restKVRanges[0]
+			s.restKVRanges, s.curPhysicalID = s.restKVRanges[1:], s.restPhysicalIDs[0]
+			s.restPhysicalIDs = s.restPhysicalIDs[1:]
+			iter, err := s.snapshot.Iter(r.StartKey, r.EndKey)
+			if err != nil {
+				return nil, nil, err
+			}
+			s.iter = iter
+			continue
+		}
+		handle, err := tablecodec.DecodeIndexHandle(s.iter.Key(), s.iter.Value(), len(s.index.Columns))
+		if err != nil {
+			return nil, nil, err
+		}
+		handles = append(handles, handle)
+		rowKeys = append(rowKeys, tablecodec.EncodeRowKeyWithHandle(s.curPhysicalID, handle))
+		if err := s.iter.Next(); err != nil {
+			return nil, nil, err
+		}
+	}
+	return handles, rowKeys, nil
+}
+
+func (s *indexSystemSampler) close() error {
+	if s.iter != nil {
+		s.iter.Close()
+		s.iter = nil
+	}
+	return nil
+}
+
+// intersectKeyRanges returns the parts of r covered by the sorted and non-overlapping ranges.
+func intersectKeyRanges(r kv.KeyRange, ranges []kv.KeyRange) []kv.KeyRange {
+	var result []kv.KeyRange
+	for _, ar := range ranges {
+		start, end := r.StartKey, r.EndKey
+		if ar.StartKey.Cmp(start) > 0 {
+			start = ar.StartKey
+		}
+		if ar.EndKey.Cmp(end) < 0 {
+			end = ar.EndKey
+		}
+		if start.Cmp(end) < 0 {
+			result = append(result, kv.KeyRange{StartKey: start, EndKey: end})
+		}
+	}
+	return result
+}
+
 type sampleKV struct {
 	handle kv.Handle
 	value  []byte
@@ -16,6 +16,8 @@ package executor_test
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 	"sync/atomic"
 	"testing"
 
@@ -128,3 +130,108 @@ func TestMaxChunkSize(t *testing.T) {
 	rows := tk.MustQuery("select * from t tablesample regions();").Rows()
 	require.Len(t, rows, 4)
 }
+
+func TestTableSamplePercent(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := createSampleTestkit(t, store)
+	tk.MustExec("create table t (a int primary key, b int, c int, key idx_b(b));")
+	values := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		values = append(values, fmt.Sprintf("(%d, %d, %d)", i, i, i%10))
+	}
+	tk.MustExec("insert into t values " + strings.Join(values, ","))
+
+	tk.MustQuery("select count(*) from t tablesample bernoulli(100 percent);").Check(testkit.Rows("1000"))
+	tk.MustQuery("select count(*) from t tablesample system(0 percent);").Check(testkit.Rows("0"))
+	checkCount := func(sql string, low, high int) {
+		rows := tk.MustQuery(sql).Rows()
+		require.Len(t, rows, 1)
+		cnt, err := strconv.Atoi(rows[0][0].(string))
+		require.NoError(t, err)
+		require.Truef(t, cnt >= low && cnt <= high, "%s returns %d", sql, cnt)
+	}
+	checkCount("select count(*) from t tablesample bernoulli(50 percent);", 300, 700)
+
+	// The SYSTEM method reads all the rows of the sampled regions.
+	tk.MustQuery("split table t by (100), (200), (300), (400), (500), (600), (700), (800), (900);").Check(testkit.Rows("9 1"))
+	tk.MustHavePlan("select * from t tablesample system(50);", "TableSample")
+	tk.MustQuery("select count(*) from t tablesample system(100);").Check(testkit.Rows("1000"))
+	for i := 0; i < 5; i++ {
+		rows := tk.MustQuery("select a div 100, count(*) from t tablesample system(50) group by a div 100;").Rows()
+		for _, row := range rows {
+			require.Equal(t, "100", row[1])
+		}
+	}
+
+	// The SYSTEM method samples the regions of the secondary index if the table is accessed by the index.
+	tk.MustQuery("split table t index idx_b by (500);").Check(testkit.Rows("2 1"))
+	tk.MustQuery("explain format = 'brief' select * from t use index(idx_b) tablesample system(50);").CheckAt([]int{0, 4},
+		testkit.Rows("TableSample index:idx_b"))
+	tk.MustQuery("select count(*), sum(a), sum(c) from t use index(idx_b) tablesample system(100);").Check(testkit.Rows("1000 499500 4500"))
+	for i := 0; i < 5; i++ {
+		rows := tk.MustQuery("select b div 500, count(*) from t use index(idx_b) tablesample system(50) group by b div 500;").Rows()
+		for _, row := range rows {
+			require.Equal(t, "500", row[1])
+		}
+	}
+	tk.MustQuery("select count(*) from t use index(idx_b) tablesample system(100) where b < 100;").Check(testkit.Rows("100"))
+	// Only the access ranges of the index are read from the sampled regions, and the predicates are evaluated above.
+	sql := "select * from t use index(idx_b) tablesample system(50) where b < 100 and c = 1;"
+	tk.MustHavePlan(sql, "Selection")
+	tk.MustHavePlan(sql, "TableSample")
+	for i := 0; i < 5; i++ {
+		rows := tk.MustQuery(sql).Rows()
+		require.Contains(t, []int{0, 10}, len(rows))
+	}
+	tk.MustQuery("select count(*) from t tablesample system(100) where a >= 100 and a < 300;").Check(testkit.Rows("200"))
+
+	// The estimated row count is scaled by the sample rate.
+	tk.MustExec("analyze table t all columns;")
+	tk.MustQuery("explain format = 'brief' select * from t tablesample system(10);").CheckAt([]int{0, 1},
+		testkit.Rows("TableSample 100.00"))
+	rows := tk.MustQuery("explain format = 'brief' select * from t tablesample bernoulli(20 percent);").Rows()
+	require.True(t, strings.HasPrefix(rows[0][0].(string), "Selection"))
+	require.Equal(t, "200.00", rows[0][1])
+
+	// The predicates are still used to access the table by the index.
+	sql = "select b from t tablesample bernoulli(50 percent) where b < 100;"
+	tk.MustHavePlan(sql, "IndexRangeScan")
+	tk.MustHavePlan(sql, "Selection")
+	rows = tk.MustQuery(sql).Rows()
+	require.LessOrEqual(t, len(rows), 100)
+	for _, row := range rows {
+		b, err := strconv.Atoi(row[0].(string))
+		require.NoError(t, err)
+		require.Less(t, b, 100)
+	}
+	// The aggregation is calculated over the sampled rows.
+	for _, row := range tk.MustQuery("explain format = 'brief' select c, count(*) from t tablesample bernoulli(50 percent) group by c;").Rows() {
+		if strings.Contains(row[0].(string), "Agg") {
+			require.Equal(t, "root", row[2])
+		}
+	}
+	rows = tk.MustQuery("select c, count(*) from t tablesample bernoulli(50 percent) group by c order by c;").Rows()
+	for _, row := range rows {
+		cnt, err := strconv.Atoi(row[1].(string))
+		require.NoError(t, err)
+		require.LessOrEqual(t, cnt, 100)
+	}
+
+	// The sample hint works like the TABLESAMPLE clause and can be used in subqueries.
+	tk.MustQuery("select /*+ sample(t, 100 percent) */ count(*) from t;").Check(testkit.Rows("1000"))
+	tk.MustHavePlan("select /*+ sample(t, 1 percent) */ * from t;", "Selection")
+	checkCount("select /*+ sample(t, 50 percent) */ count(*) from t;", 300, 700)
+	checkCount("select count(*) from t t1 where t1.a in (select /*+ sample(t2, 50 percent) */ a from t t2);", 300, 700)
+	checkCount("select /*+ sample(@sel_2 t2, 50 percent) */ count(*) from t t1 where t1.a in (select a from t t2);", 300, 700)
+	tk.MustQuery("select /*+ sample(t, 0 percent) */ count(*) from t;").Check(testkit.Rows("0"))
+	tk.MustQuery("select count(*) from t tablesample bernoulli(0 percent);").Check(testkit.Rows("0"))
+	tk.MustQuery("select /*+ sample(t, 101 percent) */ count(*) from t;").Check(testkit.Rows("1000"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 8073 The percentage 101 of the SAMPLE hint is out of range, it should be in [0, 100]"))
+	tk.MustQuery("select /*+ sample(t, 1 percent) */ count(*) from t tablesample bernoulli(100 percent);").Check(testkit.Rows("1000"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 The SAMPLE hint is ignored since the table test_table_sample.t has the TABLESAMPLE clause"))
+	tk.MustQuery("select /*+ sample(t1, 1 percent) */ count(*) from t;").Check(testkit.Rows("1000"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 8069 There are no matching table names for (t1) in optimizer hint /*+ SAMPLE(t1, 1 PERCENT) */. Maybe you can use the table alias name"))
+}
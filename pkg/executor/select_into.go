@@ -15,37 +15,61 @@
 package executor
 
 import (
-	"bufio"
 	"bytes"
 	"context"
 	"math"
 	"os"
+	"path"
+	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/br/pkg/storage"
+	"github.com/pingcap/tidb/pkg/executor/importer"
 	"github.com/pingcap/tidb/pkg/executor/internal/exec"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/planner/core"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/xitongsys/parquet-go/writer"
+	"go.uber.org/zap"
 )
 
+// outfileBufferSize is the size of the buffer to write the outfile, the
+// memory usage of SELECT INTO OUTFILE is bounded by it.
+const outfileBufferSize = 5 * 1024 * 1024
+
 // SelectIntoExec represents a SelectInto executor.
 type SelectIntoExec struct {
 	exec.BaseExecutor
 	intoOpt *ast.SelectIntoOption
+	format  string
+	// columnNames are the names of the columns in the parquet outfile.
+	columnNames []string
 	core.LineFieldsInfo
 
-	lineBuf   []byte
-	realBuf   []byte
-	fieldBuf  []byte
-	escapeBuf []byte
-	enclosed  bool
-	writer    *bufio.Writer
-	dstFile   *os.File
-	chk       *chunk.Chunk
-	started   bool
+	lineBuf       []byte
+	realBuf       []byte
+	fieldBuf      []byte
+	escapeBuf     []byte
+	enclosed      bool
+	writer        storage.ExternalFileWriter
+	parquetWriter *writer.CSVWriter
+	fieldTypes    []*types.FieldType
+	// store is the external storage of the outfile, it's nil when the outfile
+	// is on the disk of TiDB server.
+	store storage.ExternalStorage
+	// outfileName is the name of the outfile in the store.
+	outfileName string
+	chk         *chunk.Chunk
+	started     bool
+	// finished is set when all the rows are written, otherwise the partial
+	// outfile is removed on Close.
+	finished bool
 }
 
 // Open implements the Executor Open interface.
@@ -55,14 +79,22 @@ func (s *SelectIntoExec) Open(ctx context.Context) error {
 		return errors.New("unsupported SelectInto type")
 	}
 
-	// MySQL-compatible behavior: allow files to be group-readable
-	f, err := os.OpenFile(s.intoOpt.FileName, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0640) // #nosec G302
+	w, err := s.createOutfile(ctx)
 	if err != nil {
-		return errors.Trace(err)
+		return err
 	}
 	s.started = true
-	s.dstFile = f
-	s.writer = bufio.NewWriter(s.dstFile)
+	s.writer = w
+	if s.format == core.SelectIntoFormatParquet {
+		cols := s.Children(0).Schema().Columns
+		s.fieldTypes = make([]*types.FieldType, 0, len(cols))
+		for _, col := range cols {
+			s.fieldTypes = append(s.fieldTypes, col.GetType())
+		}
+		if s.parquetWriter, err = newParquetWriter(ctx, s.writer, s.columnNames, s.fieldTypes); err != nil {
+			return err
+		}
+	}
 	s.chk = exec.TryNewCacheChunk(s.Children(0))
 	s.lineBuf = make([]byte, 0, 1024)
 	s.fieldBuf = make([]byte, 0, 64)
@@ -70,6 +102,87 @@ func (s *SelectIntoExec) Open(ctx context.Context) error {
 	return s.BaseExecutor.Open(ctx)
 }
 
+// createOutfile creates the outfile on the disk of TiDB server or on the
+// external storage, the CSV outfile on the external storage is compressed by
+// the extension of it.
+func (s *SelectIntoExec) createOutfile(ctx context.Context) (storage.ExternalFileWriter, error) {
+	isLocal, err := storage.IsLocalPath(s.intoOpt.FileName)
+	if err != nil {
+		return nil, exeerrors.ErrLoadDataInvalidURI.GenWithStackByArgs(core.SelectIntoOutfile, err.Error())
+	}
+	if isLocal {
+		// MySQL-compatible behavior: allow files to be group-readable
+		f, err := os.OpenFile(s.intoOpt.FileName, os.O_RDWR|os.O_CREATE|os.O_EXCL, 0640) // #nosec G302
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		return storage.NewUploaderWriter(&localOutfileWriter{f: f}, outfileBufferSize, storage.NoCompression), nil
+	}
+
+	u, err := storage.ParseRawURL(s.intoOpt.FileName)
+	if err != nil {
+		return nil, exeerrors.ErrLoadDataInvalidURI.GenWithStackByArgs(core.SelectIntoOutfile, err.Error())
+	}
+	name := path.Base(u.Path)
+	u.Path = path.Dir(u.Path)
+	b, err := storage.ParseBackendFromURL(u, nil)
+	if err != nil {
+		return nil, exeerrors.ErrLoadDataInvalidURI.GenWithStackByArgs(core.SelectIntoOutfile, importer.GetMsgFromBRError(err))
+	}
+	store, err := storage.NewWithDefaultOpt(ctx, b)
+	if err != nil {
+		return nil, exeerrors.ErrLoadDataCantAccess.GenWithStackByArgs(core.SelectIntoOutfile, importer.GetMsgFromBRError(err))
+	}
+	exists, err := store.FileExists(ctx, name)
+	if err == nil && exists {
+		err = errors.Errorf("outfile %s already exists", ast.RedactURL(s.intoOpt.FileName))
+	}
+	var w storage.ExternalFileWriter
+	if err == nil {
+		w, err = storage.WithCompression(store, s.compressType(name), storage.DecompressConfig{}).
+			Create(ctx, name, &storage.WriterOption{Concurrency: 1})
+	}
+	if err != nil {
+		store.Close()
+		return nil, errors.Trace(err)
+	}
+	s.store, s.outfileName = store, name
+	return w, nil
+}
+
+// compressType returns the compression type of the CSV outfile by the file
+// extension, the parquet outfile is always compressed internally.
+func (s *SelectIntoExec) compressType(name string) storage.CompressType {
+	if s.format != core.SelectIntoFormatCSV {
+		return storage.NoCompression
+	}
+	switch strings.ToLower(filepath.Ext(name)) {
+	case ".gz", ".gzip":
+		return storage.Gzip
+	case ".zst", ".zstd":
+		return storage.Zstd
+	case ".snappy":
+		return storage.Snappy
+	default:
+		return storage.NoCompression
+	}
+}
+
+// localOutfileWriter writes the outfile on the disk of TiDB server.
+type localOutfileWriter struct {
+	f *os.File
+}
+
+// Write implements storage.ExternalFileWriter.
+func (w *localOutfileWriter) Write(_ context.Context, p []byte) (int, error) {
+	return w.f.Write(p)
+}
+
+// Close implements storage.ExternalFileWriter.
+func (w *localOutfileWriter) Close(context.Context) error {
+	return w.f.Close()
+}
+
 // Next implements the Executor Next interface.
 func (s *SelectIntoExec) Next(ctx context.Context, _ *chunk.Chunk) error {
 	for {
@@ -77,12 +190,33 @@ func (s *SelectIntoExec) Next(ctx context.Context, _ *chunk.Chunk) error {
 			return err
 		}
 		if s.chk.NumRows() == 0 {
+			s.finished = true
 			break
 		}
-		if err := s.dumpToOutfile(); err != nil {
+		var err error
+		if s.parquetWriter != nil {
+			err = s.dumpToParquet()
+		} else {
+			err = s.dumpToOutfile(ctx)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *SelectIntoExec) dumpToParquet() error {
+	for i := 0; i < s.chk.NumRows(); i++ {
+		rec, err := parquetRecord(s.chk.GetRow(i), s.fieldTypes)
+		if err != nil {
 			return err
 		}
+		if err = s.parquetWriter.Write(rec); err != nil {
+			return errors.Trace(err)
+		}
 	}
+	s.Ctx().GetSessionVars().StmtCtx.AddAffectedRows(uint64(s.chk.NumRows()))
 	return nil
 }
 
@@ -121,7 +255,7 @@ func (s *SelectIntoExec) escapeField(f []byte) []byte {
 	return s.escapeBuf
 }
 
-func (s *SelectIntoExec) dumpToOutfile() error {
+func (s *SelectIntoExec) dumpToOutfile(ctx context.Context) error {
 	encloseFlag := false
 	var encloseByte byte
 	encloseOpt := false
@@ -203,7 +337,7 @@ func (s *SelectIntoExec) dumpToOutfile() error {
 			}
 		}
 		s.lineBuf = append(s.lineBuf, s.LinesTerminatedBy...)
-		if _, err := s.writer.Write(s.lineBuf); err != nil {
+		if _, err := s.writer.Write(ctx, s.lineBuf); err != nil {
 			return errors.Trace(err)
 		}
 	}
@@ -216,8 +350,18 @@ func (s *SelectIntoExec) Close() error {
 	if !s.started {
 		return nil
 	}
-	err1 := s.writer.Flush()
-	err2 := s.dstFile.Close()
+	var err1 error
+	if s.parquetWriter != nil && s.finished {
+		err1 = s.parquetWriter.WriteStop()
+	}
+	ctx := context.Background()
+	err2 := s.writer.Close(ctx)
+	if !s.finished {
+		s.removeOutfile(ctx)
+	}
+	if s.store != nil {
+		s.store.Close()
+	}
 	err3 := s.BaseExecutor.Close()
 	if err1 != nil {
 		return errors.Trace(err1)
@@ -227,6 +371,20 @@ func (s *SelectIntoExec) Close() error {
 	return err3
 }
 
+// removeOutfile removes the partial outfile of the failed statement.
+func (s *SelectIntoExec) removeOutfile(ctx context.Context) {
+	var err error
+	if s.store != nil {
+		err = s.store.DeleteFile(ctx, s.outfileName)
+	} else {
+		err = os.Remove(s.intoOpt.FileName)
+	}
+	if err != nil {
+		logutil.Logger(ctx).Warn("failed to remove the partial outfile of SELECT INTO",
+			zap.String("outfile", ast.RedactURL(s.intoOpt.FileName)), zap.Error(err))
+	}
+}
+
 const (
 	expFormatBig   = 1e15
 	expFormatSmall = 1e-15
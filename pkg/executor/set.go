@@ -126,7 +126,7 @@ func (e *SetExecutor) setSysVariable(ctx context.Context, name string, v *expres
 		for _, priv := range privs {
 			if !pm.RequestDynamicVerification(sessionVars.ActiveRoles, priv, false) {
 				msg := priv
-				if !semEnabled {
+				if !semEnabled && priv != "SUPER" {
 					msg = "SUPER or " + msg
 				}
 				return plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs(msg)
@@ -1132,6 +1132,8 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *model.CISt
 			buf.WriteString("  PRIMARY KEY ")
 		} else if idxInfo.Unique {
 			fmt.Fprintf(buf, "  UNIQUE KEY %s ", stringutil.Escape(idxInfo.Name.O, sqlMode))
+		} else if idxInfo.Tp == model.IndexTypeFullText {
+			fmt.Fprintf(buf, "  FULLTEXT KEY %s ", stringutil.Escape(idxInfo.Name.O, sqlMode))
 		} else {
 			fmt.Fprintf(buf, "  KEY %s ", stringutil.Escape(idxInfo.Name.O, sqlMode))
 		}
@@ -1139,6 +1141,15 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *model.CISt
 		cols := make([]string, 0, len(idxInfo.Columns))
 		var colInfo string
 		for _, c := range idxInfo.Columns {
+			if idxInfo.Tp == model.IndexTypeFullText {
+				// The FULLTEXT index is on the tokens of the columns, show the columns instead of the hidden column.
+				for _, col := range tableInfo.Columns {
+					if _, ok := tableInfo.Columns[c.Offset].Dependences[col.Name.L]; ok {
+						cols = append(cols, stringutil.Escape(col.Name.O, sqlMode))
+					}
+				}
+				continue
+			}
 			if tableInfo.Columns[c.Offset].Hidden {
 				colInfo = fmt.Sprintf("(%s)", tableInfo.Columns[c.Offset].GeneratedExprString)
 			} else {
@@ -1351,6 +1362,76 @@ func constructResultOfShowCreateTable(ctx sessionctx.Context, dbName *model.CISt
 		if err != nil {
 			return err
 		}
+
+		if len(tableInfo.TTLInfo.ConditionExprStr) > 0 {
+			restoreCtx.WritePlain(" ")
+			err = restoreCtx.WriteWithSpecialComments(tidb.FeatureIDTTL, func() error {
+				restoreCtx.WriteKeyWord("TTL_CONDITION")
+				restoreCtx.WritePlain("=")
+				restoreCtx.WriteString(tableInfo.TTLInfo.ConditionExprStr)
+				return nil
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, opt := range []struct {
+			keyword string
+			value   string
+		}{
+			{"TTL_JOB_WINDOW_START", tableInfo.TTLInfo.JobWindowStart},
+			{"TTL_JOB_WINDOW_END", tableInfo.TTLInfo.JobWindowEnd},
+		} {
+			if len(opt.value) == 0 {
+				continue
+			}
+			restoreCtx.WritePlain(" ")
+			err = restoreCtx.WriteWithSpecialComments(tidb.FeatureIDTTL, func() error {
+				restoreCtx.WriteKeyWord(opt.keyword)
+				restoreCtx.WritePlain("=")
+				restoreCtx.WriteString(opt.value)
+				return nil
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if tableInfo.TTLInfo.DeleteRateLimit > 0 {
+			restoreCtx.WritePlain(" ")
+			err = restoreCtx.WriteWithSpecialComments(tidb.FeatureIDTTL, func() error {
+				restoreCtx.WriteKeyWord("TTL_DELETE_RATE_LIMIT")
+				restoreCtx.WritePlainf("=%d", tableInfo.TTLInfo.DeleteRateLimit)
+				return nil
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(tableInfo.TTLInfo.ResourceGroup) > 0 {
+			restoreCtx.WritePlain(" ")
+			err = restoreCtx.WriteWithSpecialComments(tidb.FeatureIDTTL, func() error {
+				restoreCtx.WriteKeyWord("TTL_RESOURCE_GROUP")
+				restoreCtx.WritePlain("=")
+				restoreCtx.WriteName(tableInfo.TTLInfo.ResourceGroup)
+				return nil
+			})
+
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if tableInfo.ExternalInfo != nil {
+		// the secret tokens in the location are not shown, same as the IMPORT INTO and BACKUP statements.
+		fmt.Fprintf(buf, " /*T![%s] EXTERNAL_LOCATION='%s' EXTERNAL_FORMAT='%s' */", tidb.FeatureIDExternalTable,
+			format.OutputFormat(ast.RedactURL(tableInfo.ExternalInfo.Location)), tableInfo.ExternalInfo.Format)
 	}
 	return nil
 }
@@ -1481,7 +1562,11 @@ func fetchShowCreateTable4View(ctx sessionctx.Context, tb *model.TableInfo, buf
 			fmt.Fprintf(buf, ", ")
 		}
 	}
-	fmt.Fprintf(buf, ") AS %s", tb.View.SelectStmt)
+	fmt.Fprintf(buf, ")")
+	if tb.View.Hints != "" {
+		fmt.Fprintf(buf, " WITH HINTS '%s'", format.OutputFormat(tb.View.Hints))
+	}
+	fmt.Fprintf(buf, " AS %s", tb.View.SelectStmt)
 }
 
 // ConstructResultOfShowCreateDatabase constructs the result for show create database.
@@ -660,6 +660,7 @@ func (e *SimpleExec) executeSavepoint(s *ast.SavepointStmt) error {
 	}
 	memDBCheckpoint := txn.GetMemDBCheckpoint()
 	txnCtx.AddSavepoint(s.Name, memDBCheckpoint)
+	sessVars.RowChanges.AddSavepoint(s.Name)
 	return nil
 }
 
@@ -793,10 +794,12 @@ func (e *SimpleExec) executeRollback(s *ast.RollbackStmt) error {
 			return exeerrors.ErrSavepointNotExists.GenWithStackByArgs("SAVEPOINT", s.SavepointName)
 		}
 		txn.RollbackMemDBToCheckpoint(savepointRecord.MemDBCheckpoint)
+		sessVars.RowChanges.RollbackToSavepoint(s.SavepointName)
 		return nil
 	}
 
 	sessVars.SetInTxn(false)
+	sessVars.RowChanges.Reset()
 	if txn.Valid() {
 		duration := time.Since(sessVars.TxnCtx.CreateTime).Seconds()
 		isInternal := false
@@ -2794,6 +2797,10 @@ func (e *SimpleExec) executeAdmin(s *ast.AdminStmt) error {
 		return e.executeAdminSetBDRRole(s)
 	case ast.AdminUnsetBDRRole:
 		return e.executeAdminUnsetBDRRole()
+	case ast.AdminSetHintsForDigest:
+		return e.executeAdminSetHintsForDigest(s)
+	case ast.AdminUnsetHintsForDigest:
+		return e.executeAdminUnsetHintsForDigest(s)
 	}
 	return nil
 }
@@ -2850,6 +2857,26 @@ func (e *SimpleExec) executeAdminUnsetBDRRole() error {
 	return errors.Trace(meta.NewMeta(txn).ClearBDRRole())
 }
 
+func (e *SimpleExec) executeAdminSetHintsForDigest(s *ast.AdminStmt) error {
+	var ttl time.Duration
+	if s.HintsTTL != nil {
+		var err error
+		if ttl, err = s.HintsTTL.Duration(); err != nil {
+			return err
+		}
+		if ttl <= 0 {
+			return errors.New("the TTL of the hints should be positive")
+		}
+	}
+	return domain.GetDomain(e.Ctx()).BindHandle().SetGlobalDigestHints(s.HintsDigest, s.HintsAppName, s.Hints, ttl)
+}
+
+func (e *SimpleExec) executeAdminUnsetHintsForDigest(s *ast.AdminStmt) error {
+	affectedRows, err := domain.GetDomain(e.Ctx()).BindHandle().DropGlobalDigestHints(s.HintsDigest, s.HintsAppName)
+	e.Ctx().GetSessionVars().StmtCtx.AddAffectedRows(affectedRows)
+	return err
+}
+
 func (e *SimpleExec) executeSetResourceGroupName(s *ast.SetResourceGroupStmt) error {
 	originalResourceGroup := e.Ctx().GetSessionVars().ResourceGroupName
 	if s.Name.L != "" {
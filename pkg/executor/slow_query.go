@@ -18,6 +18,7 @@ import (
 	"bufio"
 	"compress/gzip"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -337,6 +338,10 @@ func (e *slowQueryRetriever) getBatchLog(ctx context.Context, reader *bufio.Read
 			}
 			line = string(hack.String(lineByte))
 			log = append(log, line)
+			if strings.HasPrefix(line, variable.SlowLogJSONStartPrefixStr) {
+				// A slow log in the JSON format takes a single line.
+				break
+			}
 			if strings.HasSuffix(line, variable.SlowLogSQLSuffixStr) {
 				if strings.HasPrefix(line, "use") || strings.HasPrefix(line, variable.SlowLogRowPrefixStr) {
 					continue
@@ -387,13 +392,14 @@ func (e *slowQueryRetriever) getBatchLogForReversedScan(ctx context.Context, rea
 			return nil, err
 		}
 		line = string(hack.String(lineByte))
-		if !hasStartFlag && strings.HasPrefix(line, variable.SlowLogStartPrefixStr) {
+		isJSONLog := strings.HasPrefix(line, variable.SlowLogJSONStartPrefixStr)
+		if !hasStartFlag && (isJSONLog || strings.HasPrefix(line, variable.SlowLogStartPrefixStr)) {
 			hasStartFlag = true
 		}
 		if hasStartFlag {
 			log = append(log, line)
-			if strings.HasSuffix(line, variable.SlowLogSQLSuffixStr) {
-				if strings.HasPrefix(line, "use") || strings.HasPrefix(line, variable.SlowLogRowPrefixStr) {
+			if isJSONLog || strings.HasSuffix(line, variable.SlowLogSQLSuffixStr) {
+				if !isJSONLog && (strings.HasPrefix(line, "use") || strings.HasPrefix(line, variable.SlowLogRowPrefixStr)) {
 					continue
 				}
 				logs = append(logs, log)
@@ -584,6 +590,14 @@ func (e *slowQueryRetriever) parseLog(ctx context.Context, sctx sessionctx.Conte
 			return nil, ctx.Err()
 		}
 		fileLine := getLineIndex(offset, index)
+		if strings.HasPrefix(line, variable.SlowLogJSONStartPrefixStr) {
+			if row := e.parseJSONLog(sctx, tz, line, fileLine); row != nil {
+				e.memConsume(types.EstimatedMemUsage(row, 1))
+				data = append(data, row)
+			}
+			startFlag = false
+			continue
+		}
 		if !startFlag && strings.HasPrefix(line, variable.SlowLogStartPrefixStr) {
 			row = make([]types.Datum, len(e.outputCols))
 			user = ""
@@ -660,6 +674,109 @@ func (e *slowQueryRetriever) parseLog(ctx context.Context, sctx sessionctx.Conte
 	return data, nil
 }
 
+// slowLogJSONLine is a line of the slow log in the JSON format, the "time" field is
+// written by the slow log encoder, see variable.SlowLogJSONFormat for the others.
+type slowLogJSONLine struct {
+	Time string `json:"time"`
+	variable.SlowLogJSONItem
+}
+
+// parseJSONLog parses a line of the slow log in the JSON format into a row, it returns
+// nil if the line is malformed or filtered out by the checker.
+func (e *slowQueryRetriever) parseJSONLog(sctx sessionctx.Context, tz *time.Location, line string, fileLine int) []types.Datum {
+	var item slowLogJSONLine
+	if err := json.Unmarshal(hack.Slice(line), &item); err != nil {
+		err = fmt.Errorf("Parse slow log at line %v, failed value is %v, error is %v", fileLine, line, err)
+		sctx.GetSessionVars().StmtCtx.AppendWarning(err)
+		return nil
+	}
+	if e.checker != nil && !e.checker.hasPrivilege(item.User) {
+		return nil
+	}
+	row := make([]types.Datum, len(e.outputCols))
+	if !e.setColumnValue(sctx, row, tz, variable.SlowLogTimeStr, item.Time, e.checker, fileLine) {
+		return nil
+	}
+	formatFloat := func(v float64) string { return strconv.FormatFloat(v, 'f', -1, 64) }
+	timings := &item.Timings
+	fields := []string{
+		variable.SlowLogTxnStartTSStr, strconv.FormatUint(item.TxnStartTS, 10),
+		variable.SlowLogUserStr, item.User,
+		variable.SlowLogHostStr, item.Host,
+		variable.SlowLogConnIDStr, strconv.FormatUint(item.ConnID, 10),
+		variable.SlowLogSessAliasStr, item.SessionAlias,
+		variable.SlowLogQueryTimeStr, formatFloat(item.QueryTime),
+		variable.SlowLogParseTimeStr, formatFloat(timings.Parse),
+		variable.SlowLogCompileTimeStr, formatFloat(timings.Compile),
+		variable.SlowLogRewriteTimeStr, formatFloat(timings.Rewrite),
+		variable.SlowLogPreProcSubQueryTimeStr, formatFloat(timings.PreprocSubQueries),
+		variable.SlowLogOptimizeTimeStr, formatFloat(timings.Optimize),
+		variable.SlowLogWaitTSTimeStr, formatFloat(timings.WaitTS),
+		execdetails.ProcessTimeStr, formatFloat(timings.CopProcess),
+		execdetails.WaitTimeStr, formatFloat(timings.CopWait),
+		execdetails.BackoffTimeStr, formatFloat(timings.Backoff),
+		variable.SlowLogKVTotal, formatFloat(timings.KVTotal),
+		variable.SlowLogPDTotal, formatFloat(timings.PDTotal),
+		variable.SlowLogBackoffTotal, formatFloat(timings.BackoffTotal),
+		variable.SlowLogWriteSQLRespTotal, formatFloat(timings.WriteSQLResponseTotal),
+		execdetails.PreWriteTimeStr, formatFloat(timings.Prewrite),
+		execdetails.GetCommitTSTimeStr, formatFloat(timings.GetCommitTS),
+		execdetails.CommitTimeStr, formatFloat(timings.Commit),
+		execdetails.ResolveLockTimeStr, formatFloat(timings.ResolveLock),
+		execdetails.LocalLatchWaitTimeStr, formatFloat(timings.LocalLatchWait),
+		variable.SlowLogExecRetryTime, formatFloat(timings.ExecRetry),
+		variable.SlowLogWaitRUDuration, formatFloat(timings.QueuedByRC),
+		variable.SlowLogDBStr, item.DB,
+		variable.SlowLogIndexNamesStr, item.IndexNames,
+		variable.SlowLogIsInternalStr, strconv.FormatBool(item.IsInternal),
+		variable.SlowLogDigestStr, item.Digest,
+		variable.SlowLogPlanDigest, item.PlanDigest,
+		variable.SlowLogPlanFromCache, strconv.FormatBool(item.PlanFromCache),
+		variable.SlowLogPlanFromBinding, strconv.FormatBool(item.PlanFromBinding),
+		variable.SlowLogPrepared, strconv.FormatBool(item.Prepared),
+		variable.SlowLogHasMoreResults, strconv.FormatBool(item.HasMoreResults),
+		execdetails.RequestCountStr, strconv.Itoa(item.RequestCount),
+		execdetails.ProcessKeysStr, strconv.FormatInt(item.ProcessKeys, 10),
+		execdetails.TotalKeysStr, strconv.FormatInt(item.TotalKeys, 10),
+		variable.SlowLogNumCopTasksStr, strconv.Itoa(item.NumCopTasks),
+		variable.SlowLogMemMax, strconv.FormatInt(item.MemMax, 10),
+		variable.SlowLogDiskMax, strconv.FormatInt(item.DiskMax, 10),
+		variable.SlowLogResultRows, strconv.FormatInt(item.ResultRows, 10),
+		variable.SlowLogExecRetryCount, strconv.FormatUint(uint64(item.ExecRetryCount), 10),
+		variable.SlowLogSucc, strconv.FormatBool(item.Succ),
+		variable.SlowLogIsExplicitTxn, strconv.FormatBool(item.IsExplicitTxn),
+		variable.SlowLogIsSyncStatsFailed, strconv.FormatBool(item.IsSyncStatsFailed),
+		variable.SlowLogResourceGroup, item.ResourceGroup,
+		variable.SlowLogRRU, formatFloat(item.RequestUnitRead),
+		variable.SlowLogWRU, formatFloat(item.RequestUnitWrite),
+		variable.SlowLogPlan, item.Plan,
+		variable.SlowLogBinaryPlan, item.BinaryPlan,
+		variable.SlowLogPrevStmt, item.PrevStmt,
+	}
+	if len(item.Warnings) > 0 {
+		// Keep the same value as the text format, which writes the warnings as JSON.
+		warnings, err := json.Marshal(item.Warnings)
+		if err == nil {
+			fields = append(fields, variable.SlowLogWarnings, string(warnings))
+		}
+	}
+	for i := 0; i < len(fields); i += 2 {
+		if len(fields[i+1]) == 0 {
+			continue
+		}
+		if !e.setColumnValue(sctx, row, tz, fields[i], fields[i+1], e.checker, fileLine) {
+			return nil
+		}
+	}
+	query := item.Query
+	if !strings.HasSuffix(query, variable.SlowLogSQLSuffixStr) {
+		query += variable.SlowLogSQLSuffixStr
+	}
+	_ = e.setColumnValue(sctx, row, tz, variable.SlowLogQuerySQLStr, query, e.checker, fileLine)
+	e.setDefaultValue(row)
+	return row
+}
+
 func (e *slowQueryRetriever) setColumnValue(sctx sessionctx.Context, row []types.Datum, tz *time.Location, field, value string, checker *slowLogChecker, lineNum int) bool {
 	factory := e.columnValueFactoryMap[field]
 	if factory == nil {
@@ -1016,8 +1133,8 @@ func (*slowQueryRetriever) getFileStartTime(ctx context.Context, file *os.File,
 			return t, err
 		}
 		line := string(lineByte)
-		if strings.HasPrefix(line, variable.SlowLogStartPrefixStr) {
-			return ParseTime(line[len(variable.SlowLogStartPrefixStr):])
+		if t, ok, err := parseSlowLogStartTime(line); ok {
+			return t, err
 		}
 		maxNum--
 		if maxNum <= 0 {
@@ -1030,6 +1147,26 @@ func (*slowQueryRetriever) getFileStartTime(ctx context.Context, file *os.File,
 	return t, errors.Errorf("malform slow query file %v", file.Name())
 }
 
+// parseSlowLogStartTime parses the time of a slow log from its first line, which is
+// either a "# Time: " row or a line in the JSON format. ok is false if it's neither.
+func parseSlowLogStartTime(line string) (t time.Time, ok bool, err error) {
+	if strings.HasPrefix(line, variable.SlowLogStartPrefixStr) {
+		t, err = ParseTime(line[len(variable.SlowLogStartPrefixStr):])
+		return t, true, err
+	}
+	if strings.HasPrefix(line, variable.SlowLogJSONStartPrefixStr) {
+		var item struct {
+			Time string `json:"time"`
+		}
+		if err = json.Unmarshal(hack.Slice(line), &item); err != nil {
+			return t, true, err
+		}
+		t, err = ParseTime(item.Time)
+		return t, true, err
+	}
+	return t, false, nil
+}
+
 func (e *slowQueryRetriever) getRuntimeStats() execdetails.RuntimeStats {
 	return e.stats
 }
@@ -1097,8 +1234,8 @@ func (*slowQueryRetriever) getFileEndTime(ctx context.Context, file *os.File) (t
 		}
 		endCursor -= int64(readBytes)
 		for i := len(lines) - 1; i >= 0; i-- {
-			if strings.HasPrefix(lines[i], variable.SlowLogStartPrefixStr) {
-				return ParseTime(lines[i][len(variable.SlowLogStartPrefixStr):])
+			if t, ok, err := parseSlowLogStartTime(lines[i]); ok {
+				return t, err
 			}
 		}
 		tried += len(lines)
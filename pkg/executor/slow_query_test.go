@@ -37,6 +37,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/execdetails"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/mock"
 	"github.com/stretchr/testify/require"
@@ -249,6 +250,70 @@ select * from t;
 	require.Equal(t, warnings[0].Err.Error(), "Parse slow log at line 2, failed field is Succ, failed value is abc, error is strconv.ParseBool: parsing \"abc\": invalid syntax")
 }
 
+func TestParseJSONSlowLogFile(t *testing.T) {
+	slowLog := bytes.NewBufferString(
+		`{"time":"2019-04-28T15:24:04.309074+08:00","txn_start_ts":405888132465033227,"user":"root","host":"localhost","conn_id":7,"query_time":0.216905,"timings":{"parse":0.1,"compile":0.2,"rewrite":0,"optimize":0,"wait_ts":0,"cop_process":0.021,"cop_wait":0,"backoff":0,"kv_total":0,"pd_total":0,"backoff_total":0,"write_sql_response_total":0},"db":"test","is_internal":false,"digest":"42a1c8aae6f133e934d4bf0147491709a8812ea05ff8819ec522780fe657b772","plan_from_cache":true,"plan_from_binding":false,"prepared":false,"has_more_results":false,"request_count":1,"total_keys":637,"result_rows":3,"succ":true,"is_explicit_txn":false,"is_sync_stats_failed":false,"warnings":[{"Level":"Warning","Message":"w"}],"query":"select * from t"}
+# Time: 2019-04-24-19:41:21.716221 +0800
+select * from t;
+{"time":"2019-04-28T15:24:05.309074+08:00","user":"root","query_time":0.5,"timings":{},"succ":false,"query":"select 1;"}
+`)
+	loc, err := time.LoadLocation("Asia/Shanghai")
+	require.NoError(t, err)
+	ctx := mock.NewContext()
+	ctx.ResetSessionAndStmtTimeZone(loc)
+	retriever, err := newSlowQueryRetriever()
+	require.NoError(t, err)
+	terror.Log(retriever.initialize(context.Background(), ctx))
+	rows, err := parseLog(retriever, ctx, bufio.NewReader(slowLog))
+	require.NoError(t, err)
+	require.Len(t, rows, 3)
+	require.Len(t, ctx.GetSessionVars().StmtCtx.GetWarnings(), 0)
+	column := func(row []types.Datum, name string) string {
+		for i, col := range retriever.outputCols {
+			if col.Name.O == name {
+				str, err := row[i].ToString()
+				require.NoError(t, err)
+				return str
+			}
+		}
+		require.FailNow(t, "column not found", name)
+		return ""
+	}
+	require.Equal(t, "2019-04-28 15:24:04.309074", column(rows[0], variable.SlowLogTimeStr))
+	require.Equal(t, "405888132465033227", column(rows[0], variable.SlowLogTxnStartTSStr))
+	require.Equal(t, "root", column(rows[0], variable.SlowLogUserStr))
+	require.Equal(t, "localhost", column(rows[0], variable.SlowLogHostStr))
+	require.Equal(t, "7", column(rows[0], variable.SlowLogConnIDStr))
+	require.Equal(t, "0.216905", column(rows[0], variable.SlowLogQueryTimeStr))
+	require.Equal(t, "0.2", column(rows[0], variable.SlowLogCompileTimeStr))
+	require.Equal(t, "0.021", column(rows[0], execdetails.ProcessTimeStr))
+	require.Equal(t, "637", column(rows[0], execdetails.TotalKeysStr))
+	require.Equal(t, "test", column(rows[0], variable.SlowLogDBStr))
+	require.Equal(t, "1", column(rows[0], variable.SlowLogPlanFromCache))
+	require.Equal(t, "3", column(rows[0], variable.SlowLogResultRows))
+	require.Equal(t, "1", column(rows[0], variable.SlowLogSucc))
+	require.Equal(t, `[{"Level":"Warning","Message":"w"}]`, column(rows[0], variable.SlowLogWarnings))
+	require.Equal(t, "select * from t;", column(rows[0], variable.SlowLogQuerySQLStr))
+	require.Equal(t, "2019-04-24 19:41:21.716221", column(rows[1], variable.SlowLogTimeStr))
+	require.Equal(t, "select * from t;", column(rows[1], variable.SlowLogQuerySQLStr))
+	require.Equal(t, "2019-04-28 15:24:05.309074", column(rows[2], variable.SlowLogTimeStr))
+	require.Equal(t, "0", column(rows[2], variable.SlowLogSucc))
+	require.Equal(t, "select 1;", column(rows[2], variable.SlowLogQuerySQLStr))
+
+	// A malformed JSON line is reported as a warning and skipped.
+	slowLog = bytes.NewBufferString(`{"time":"2019-04-28T15:24:04.309074+08:00","query":` + "\n")
+	rows, err = parseLog(retriever, ctx, bufio.NewReader(slowLog))
+	require.NoError(t, err)
+	require.Len(t, rows, 0)
+	require.Len(t, ctx.GetSessionVars().StmtCtx.GetWarnings(), 1)
+
+	// The start time of a file in the JSON format.
+	st, ok, err := parseSlowLogStartTime(`{"time":"2019-04-28T15:24:04.309074+08:00","query":"select 1"}`)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, "2019-04-28 15:24:04.309074", st.In(loc).Format("2006-01-02 15:04:05.000000"))
+}
+
 // It changes variable.MaxOfMaxAllowedPacket, so must be stayed in SerialSuite.
 func TestParseSlowLogFileSerial(t *testing.T) {
 	loc, err := time.LoadLocation("Asia/Shanghai")
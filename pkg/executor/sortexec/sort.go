@@ -144,6 +144,22 @@ func (e *SortExec) Close() error {
 	return exec.Close(e.Children(0))
 }
 
+// spillLimitDivisor divides the memory quota into the spill limit of a sort partition. A partition can only be
+// spilled when its size exceeds the limit, the rows are sorted in place and the spilled partition is re-read while
+// merging, so a partition of 1/10 quota leaves enough room for the other partitions, the merge buffers and the
+// memory of the other operators tracked by the same quota.
+const spillLimitDivisor = 10
+
+// getSpillLimit returns the spill limit of the sort partitions by the session-level memory quota and the
+// operator-level memory quota, the smaller positive one is used.
+func getSpillLimit(sessionQuota, operatorQuota int64) int64 {
+	spillLimit := sessionQuota / spillLimitDivisor
+	if operatorQuota > 0 && (spillLimit <= 0 || operatorQuota/spillLimitDivisor < spillLimit) {
+		spillLimit = operatorQuota / spillLimitDivisor
+	}
+	return spillLimit
+}
+
 // Open implements the Executor Open interface.
 func (e *SortExec) Open(ctx context.Context) error {
 	e.fetched = &atomic.Bool{}
@@ -155,7 +171,7 @@ func (e *SortExec) Open(ctx context.Context) error {
 	if e.memTracker == nil {
 		e.memTracker = memory.NewTracker(e.ID(), -1)
 		e.memTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.MemTracker)
-		e.spillLimit = e.Ctx().GetSessionVars().MemTracker.GetBytesLimit() / 10
+		e.spillLimit = getSpillLimit(e.Ctx().GetSessionVars().MemTracker.GetBytesLimit(), e.Ctx().GetSessionVars().MemQuotaOperator)
 		e.diskTracker = disk.NewTracker(e.ID(), -1)
 		e.diskTracker.AttachTo(e.Ctx().GetSessionVars().StmtCtx.DiskTracker)
 	}
@@ -179,6 +195,7 @@ func (e *SortExec) Open(ctx context.Context) error {
 		}
 		if e.enableTmpStorageOnOOM {
 			e.Ctx().GetSessionVars().MemTracker.FallbackOldAndSetNewAction(e.Parallel.spillAction)
+			memory.SetOperatorQuota(e.memTracker, e.Ctx().GetSessionVars().MemQuotaOperator, e.Parallel.spillAction)
 		}
 	}
 
@@ -551,6 +568,7 @@ func (e *SortExec) switchToNewSortPartition(fields []*types.FieldType, byItemsDe
 		e.curPartition.getDiskTracker().AttachTo(e.diskTracker)
 		e.curPartition.getDiskTracker().SetLabel(memory.LabelForRowChunks)
 		e.Ctx().GetSessionVars().MemTracker.FallbackOldAndSetNewAction(e.Unparallel.spillAction)
+		memory.SetOperatorQuota(e.memTracker, e.Ctx().GetSessionVars().MemQuotaOperator, e.Unparallel.spillAction)
 	}
 	return nil
 }
@@ -129,3 +129,13 @@ func TestInterruptedDuringSpilling(t *testing.T) {
 	require.Less(t, cancelDuration, 1*time.Second)
 	require.True(t, exeerrors.ErrQueryInterrupted.Equal(err))
 }
+
+func TestGetSpillLimit(t *testing.T) {
+	require.Equal(t, int64(0), getSpillLimit(-1, 0))
+	require.Equal(t, int64(100), getSpillLimit(1000, 0))
+	// The operator quota is used when it's smaller than the session quota.
+	require.Equal(t, int64(10), getSpillLimit(1000, 100))
+	require.Equal(t, int64(100), getSpillLimit(1000, 10000))
+	// The operator quota is used when the session quota is unlimited.
+	require.Equal(t, int64(10), getSpillLimit(-1, 100))
+}
@@ -0,0 +1,356 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/bindinfo"
+	"github.com/pingcap/tidb/pkg/bindinfo/norm"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/executor/internal/exec"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/privilege"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
+)
+
+// maxStatementDiagnosticsHistory is the max number of statement summary records in the bundle.
+const maxStatementDiagnosticsHistory = 10
+
+// StatementDiagnostics is the diagnostics bundle of a statement.
+type StatementDiagnostics struct {
+	Digest string `json:"digest"`
+	SQL    string `json:"sql,omitempty"`
+	DB     string `json:"db,omitempty"`
+	// Plan is the plan of the statement in the 'tidb_json' explain format.
+	Plan json.RawMessage `json:"plan,omitempty"`
+	// HintsInUse is the resolution status of the hints applied to the statement.
+	HintsInUse json.RawMessage `json:"hints_in_use,omitempty"`
+	// Warnings are the warnings generated when building the plan, e.g. the hints can't be applied.
+	Warnings []string `json:"warnings,omitempty"`
+	// Bindings are the global bindings of the statement.
+	Bindings []map[string]string `json:"bindings"`
+	// TableStats is the stats health of the tables referenced by the statement.
+	TableStats []map[string]string `json:"table_stats"`
+	// ExecStats are the recent execution stats of the statement in the statement summary.
+	ExecStats []map[string]string `json:"exec_stats"`
+	// Errors records the parts of the bundle which failed to be collected.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// AdminShowDiagnosticsExec represents an `ADMIN SHOW DIAGNOSTICS FOR [DIGEST] ...` executor.
+type AdminShowDiagnosticsExec struct {
+	exec.BaseExecutor
+
+	sql    string
+	digest string
+	done   bool
+}
+
+// Next implements the Executor Next interface.
+func (e *AdminShowDiagnosticsExec) Next(ctx context.Context, req *chunk.Chunk) error {
+	req.Reset()
+	if e.done {
+		return nil
+	}
+	e.done = true
+	sctx := e.Ctx()
+	// The bundle is collected in a system session, so the variables of the current session are not changed,
+	// and the plan is only collected if the current user has the privileges to read the tables.
+	pool := domain.GetDomain(sctx).SysSessionPool()
+	res, err := pool.Get()
+	if err != nil {
+		return err
+	}
+	defer pool.Put(res)
+	ctx = kv.WithInternalSourceType(ctx, kv.InternalTxnOthers)
+	db := sctx.GetSessionVars().CurrentDB
+	if e.sql == "" {
+		db = ""
+	}
+	bundle, err := CollectStatementDiagnostics(ctx, res.(sessionctx.Context), e.sql, e.digest, db, sctx)
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(bundle)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	bj, err := types.ParseBinaryJSONFromString(string(data))
+	if err != nil {
+		return err
+	}
+	req.AppendString(0, bundle.Digest)
+	req.AppendJSON(1, bj)
+	return nil
+}
+
+// statementDiagnosticsSensitiveFields are the fields of the exec stats and the bindings which may contain the
+// literal values of the statements or the names of the schemas.
+var statementDiagnosticsSensitiveFields = []string{"query_sample_text", "plan", "schema_name", "last_binding_sql", "bind_sql"}
+
+// CollectStatementDiagnostics collects the diagnostics bundle of the statement given by the sql text or the
+// sql digest. The plan is only collected if the user of the caller session has the privileges to read the
+// tables referenced by the statement. If the caller is nil, or the user of the caller can't read the tables
+// and has no PROCESS privilege, the parts which may contain the literal values of the statements executed by
+// other users, such as the sample text, the plans and the bind SQL in the statement summary and the bindings,
+// are removed from the bundle.
+func CollectStatementDiagnostics(ctx context.Context, se sessionctx.Context, sqlText, digest, db string, caller sessionctx.Context) (*StatementDiagnostics, error) {
+	bundle, readable, err := collectStatementDiagnostics(ctx, se, sqlText, digest, db, caller)
+	if err != nil || (caller != nil && (readable || hasPriv(caller, mysql.ProcessPriv))) {
+		return bundle, err
+	}
+	bundle.SQL, bundle.DB = sqlText, db
+	for _, rows := range [][]map[string]string{bundle.ExecStats, bundle.Bindings} {
+		for _, row := range rows {
+			for _, field := range statementDiagnosticsSensitiveFields {
+				delete(row, field)
+			}
+		}
+	}
+	return bundle, nil
+}
+
+// collectStatementDiagnostics collects the diagnostics bundle, and reports whether the user of the caller can
+// read all the tables referenced by the statement.
+func collectStatementDiagnostics(ctx context.Context, se sessionctx.Context, sqlText, digest, db string, caller sessionctx.Context) (_ *StatementDiagnostics, readable bool, _ error) {
+	bundle := &StatementDiagnostics{SQL: sqlText, DB: db, Digest: digest}
+	if sqlText != "" {
+		_, d := parser.NormalizeDigest(sqlText)
+		bundle.Digest = d.String()
+	}
+	addError := func(part string, err error) {
+		bundle.Errors = append(bundle.Errors, part+": "+err.Error())
+	}
+
+	var err error
+	bundle.ExecStats, err = execSQLToMaps(ctx, se, `select summary_begin_time, summary_end_time, stmt_type, schema_name, plan_digest,
+		exec_count, sum_errors, avg_latency, max_latency, min_latency, avg_parse_latency, avg_compile_latency,
+		avg_processed_keys, avg_total_keys, avg_mem, avg_result_rows, first_seen, last_seen,
+		plan_in_cache, plan_cache_hits, plan_in_binding, plan_binding_hits, last_binding_sql, last_applied_hints,
+		query_sample_text, plan
+		from information_schema.statements_summary_history where digest = %? order by summary_begin_time desc, last_seen desc limit %?`,
+		bundle.Digest, maxStatementDiagnosticsHistory)
+	if err != nil {
+		addError("exec_stats", err)
+	}
+	// Use the latest sample of the statement if only the digest is given.
+	if bundle.SQL == "" && len(bundle.ExecStats) > 0 {
+		bundle.SQL = bundle.ExecStats[0]["query_sample_text"]
+		if bundle.DB == "" {
+			bundle.DB = bundle.ExecStats[0]["schema_name"]
+		}
+	}
+
+	if bundle.SQL == "" {
+		return bundle, false, nil
+	}
+	stmt, err := parser.New().ParseOneStmt(bundle.SQL, "", "")
+	if err != nil {
+		addError("sql", err)
+		return bundle, false, nil
+	}
+	vars := se.GetSessionVars()
+	prevDB := vars.CurrentDB
+	vars.CurrentDB = bundle.DB
+	defer func() {
+		vars.CurrentDB = prevDB
+	}()
+	// The digest of a binding is computed from the statement normalized with the default database.
+	_, bindingDigest := norm.NormalizeStmtForBinding(stmt, norm.WithSpecifiedDB(bundle.DB))
+	bundle.Bindings, err = execSQLToMaps(ctx, se, `select original_sql, bind_sql, default_db, status, source, create_time, update_time, plan_digest
+		from mysql.bind_info where sql_digest = %? and status != 'deleted' order by update_time desc`, bindingDigest)
+	if err != nil {
+		addError("bindings", err)
+	}
+	// The statement is explained in a session which skips the privilege checks,
+	// and the plan may contain the data read by the uncorrelated subqueries.
+	if err := checkTablePrivileges(caller, stmt, bundle.DB); err != nil {
+		addError("plan", err)
+	} else {
+		readable = true
+		if err := explainStatement(ctx, se, bundle); err != nil {
+			addError("plan", err)
+		}
+	}
+	if err := collectTableStats(ctx, se, stmt, bundle); err != nil {
+		addError("table_stats", err)
+	}
+	return bundle, readable, nil
+}
+
+// checkTablePrivileges checks that the user of the caller has the SELECT privilege on all the tables referenced by the statement.
+func checkTablePrivileges(caller sessionctx.Context, stmt ast.StmtNode, defaultDB string) error {
+	if caller == nil {
+		return errors.New("the plan is only collected by ADMIN SHOW DIAGNOSTICS, which checks the privileges of the current user")
+	}
+	pm := privilege.GetPrivilegeManager(caller)
+	if pm == nil {
+		return nil
+	}
+	vars := caller.GetSessionVars()
+	cteNames := collectCTENames(stmt)
+	for _, tbl := range bindinfo.CollectTableNames(stmt) {
+		schema := tbl.Schema.L
+		if schema == "" {
+			if _, ok := cteNames[tbl.Name.L]; ok {
+				continue
+			}
+			schema = strings.ToLower(defaultDB)
+		}
+		if !pm.RequestVerification(vars.ActiveRoles, schema, tbl.Name.L, "", mysql.SelectPriv) {
+			user, host := "", ""
+			if vars.User != nil {
+				user, host = vars.User.AuthUsername, vars.User.AuthHostname
+			}
+			return plannererrors.ErrTableaccessDenied.GenWithStackByArgs("SELECT", user, host, tbl.Name.O)
+		}
+	}
+	return nil
+}
+
+// cteNameCollector collects the names of the common table expressions, which are not tables.
+type cteNameCollector struct {
+	names map[string]struct{}
+}
+
+// Enter implements Visitor interface.
+func (c *cteNameCollector) Enter(in ast.Node) (ast.Node, bool) {
+	if cte, ok := in.(*ast.CommonTableExpression); ok {
+		c.names[cte.Name.L] = struct{}{}
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (*cteNameCollector) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+func collectCTENames(stmt ast.StmtNode) map[string]struct{} {
+	collector := &cteNameCollector{names: make(map[string]struct{})}
+	stmt.Accept(collector)
+	return collector.names
+}
+
+// explainStatement fills the plan, the hints in use and the warnings of the statement into the bundle.
+func explainStatement(ctx context.Context, se sessionctx.Context, bundle *StatementDiagnostics) error {
+	vars := se.GetSessionVars()
+	vars.ExplainShowHintsInUse = true
+	defer func() {
+		vars.ExplainShowHintsInUse = false
+	}()
+	rows, err := execSQLToStrings(ctx, se, "explain format = 'tidb_json' "+bundle.SQL)
+	if err != nil {
+		return err
+	}
+	for _, warn := range vars.StmtCtx.GetWarnings() {
+		bundle.Warnings = append(bundle.Warnings, warn.Err.Error())
+	}
+	if len(rows) == 0 {
+		return nil
+	}
+	// The plan is wrapped with the hints in use since tidb_explain_show_hints_in_use is ON.
+	var planWithHints struct {
+		Plan       json.RawMessage `json:"plan"`
+		HintsInUse json.RawMessage `json:"hints_in_use"`
+	}
+	if err := json.Unmarshal([]byte(rows[0]), &planWithHints); err != nil {
+		return errors.Trace(err)
+	}
+	bundle.Plan, bundle.HintsInUse = planWithHints.Plan, planWithHints.HintsInUse
+	return nil
+}
+
+// collectTableStats fills the stats health of the tables referenced by the statement into the bundle.
+func collectTableStats(ctx context.Context, se sessionctx.Context, stmt ast.StmtNode, bundle *StatementDiagnostics) error {
+	seen := make(map[string]struct{})
+	for _, tbl := range bindinfo.CollectTableNames(stmt) {
+		schema := tbl.Schema.O
+		if schema == "" {
+			schema = bundle.DB
+		}
+		key := strings.ToLower(schema + "." + tbl.Name.O)
+		if _, ok := seen[key]; ok || schema == "" {
+			continue
+		}
+		seen[key] = struct{}{}
+		metas, err := execSQLToMaps(ctx, se, "show stats_meta where db_name = %? and table_name = %?", schema, tbl.Name.O)
+		if err != nil {
+			return err
+		}
+		healthies, err := execSQLToMaps(ctx, se, "show stats_healthy where db_name = %? and table_name = %?", schema, tbl.Name.O)
+		if err != nil {
+			return err
+		}
+		healthyByPartition := make(map[string]string, len(healthies))
+		for _, healthy := range healthies {
+			healthyByPartition[healthy["partition_name"]] = healthy["healthy"]
+		}
+		for _, meta := range metas {
+			if healthy, ok := healthyByPartition[meta["partition_name"]]; ok {
+				meta["healthy"] = healthy
+			}
+		}
+		bundle.TableStats = append(bundle.TableStats, metas...)
+	}
+	return nil
+}
+
+func execSQLToMaps(ctx context.Context, se sessionctx.Context, sql string, args ...any) ([]map[string]string, error) {
+	rows, fields, err := se.GetRestrictedSQLExecutor().ExecRestrictedSQL(ctx, []sqlexec.OptionFuncAlias{sqlexec.ExecOptionUseCurSession}, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]map[string]string, 0, len(rows))
+	for _, row := range rows {
+		m := make(map[string]string, len(fields))
+		for i, f := range fields {
+			d := row.GetDatum(i, &f.Column.FieldType)
+			if d.IsNull() {
+				continue
+			}
+			s, err := d.ToString()
+			if err != nil {
+				return nil, errors.Trace(err)
+			}
+			m[strings.ToLower(f.ColumnAsName.O)] = s
+		}
+		result = append(result, m)
+	}
+	return result, nil
+}
+
+func execSQLToStrings(ctx context.Context, se sessionctx.Context, sql string) ([]string, error) {
+	rows, _, err := se.GetRestrictedSQLExecutor().ExecRestrictedSQL(ctx, []sqlexec.OptionFuncAlias{sqlexec.ExecOptionUseCurSession}, sql)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(rows))
+	for _, row := range rows {
+		result = append(result, row.GetString(0))
+	}
+	return result, nil
+}
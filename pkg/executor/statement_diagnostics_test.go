@@ -0,0 +1,102 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/executor"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdminShowDiagnostics(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	// statements are recorded in the statement summary only for authenticated users.
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ia(a))")
+	tk.MustExec("create table t2(a int)")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+	tk.MustExec("analyze table t")
+	tk.MustExec("create global binding for select * from t where a = 1 using select /*+ use_index(t, ia) */ * from t where a = 1")
+	tk.MustQuery("select * from t where a = 1").Check(testkit.Rows("1 1"))
+	_, digest := tk.Session().GetSessionVars().StmtCtx.SQLDigest()
+
+	show := func(tk *testkit.TestKit, sql string) *executor.StatementDiagnostics {
+		rows := tk.MustQuery(sql).Rows()
+		require.Len(t, rows, 1)
+		require.Equal(t, digest.String(), rows[0][0])
+		bundle := &executor.StatementDiagnostics{}
+		require.NoError(t, json.Unmarshal([]byte(rows[0][1].(string)), bundle))
+		return bundle
+	}
+
+	bundle := show(tk, "admin show diagnostics for 'select * from t where a = 1'")
+	require.Empty(t, bundle.Errors)
+	require.Equal(t, "test", bundle.DB)
+	require.Contains(t, string(bundle.Plan), "IndexLookUp")
+	require.Contains(t, string(bundle.HintsInUse), "use_index")
+	require.Len(t, bundle.Bindings, 1)
+	require.Len(t, bundle.TableStats, 1)
+	require.Equal(t, "100", bundle.TableStats[0]["healthy"])
+	require.NotEmpty(t, bundle.ExecStats)
+	// the current database is not changed by the statement.
+	tk.MustQuery("select database()").Check(testkit.Rows("test"))
+
+	// the statement text and the schema are taken from the statement summary.
+	tk.MustExec("use mysql")
+	bundle = show(tk, "admin show diagnostics for digest '"+digest.String()+"'")
+	require.Empty(t, bundle.Errors)
+	require.Equal(t, "select * from t where a = 1", bundle.SQL)
+	require.Equal(t, "test", bundle.DB)
+	require.Contains(t, string(bundle.Plan), "IndexLookUp")
+
+	// the plan is only collected if the current user can read the tables of the statement.
+	tk.MustExec("create user u1")
+	tk.MustExec("grant select on test.t2 to u1")
+	tk1 := testkit.NewTestKit(t, store)
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "u1", Hostname: "%"}, nil, nil, nil))
+	tk1.MustGetErrCode("admin show diagnostics for digest '"+digest.String()+"'", errno.ErrSpecificAccessDenied)
+	tk.MustExec("grant sql_tuning_admin on *.* to u1")
+	tk1.MustExec("use test")
+	bundle = show(tk1, "admin show diagnostics for digest '"+digest.String()+"'")
+	require.Len(t, bundle.Errors, 1)
+	require.Contains(t, bundle.Errors[0], "plan: ")
+	require.Empty(t, bundle.Plan)
+	require.Len(t, bundle.Bindings, 1)
+	// the statements of the other users are not returned to the user who can't read the tables.
+	require.Empty(t, bundle.SQL)
+	require.NotContains(t, bundle.Bindings[0], "bind_sql")
+	require.NotEmpty(t, bundle.ExecStats)
+	require.NotContains(t, bundle.ExecStats[0], "query_sample_text")
+	require.NotContains(t, bundle.ExecStats[0], "plan")
+	tk.MustExec("grant process on *.* to u1")
+	bundle = show(tk1, "admin show diagnostics for digest '"+digest.String()+"'")
+	require.Equal(t, "select * from t where a = 1", bundle.SQL)
+	require.Contains(t, bundle.Bindings[0], "bind_sql")
+	require.Contains(t, bundle.ExecStats[0], "query_sample_text")
+	require.Empty(t, bundle.Plan)
+	tk.MustExec("revoke process on *.* from u1")
+	rows := tk1.MustQuery("admin show diagnostics for 'select * from t2 where a in (select a from t)'").Rows()
+	require.Contains(t, rows[0][1], "plan: ")
+	rows = tk1.MustQuery("admin show diagnostics for 'with c as (select a from t2) select * from c'").Rows()
+	require.NotContains(t, rows[0][1], "errors")
+	require.Contains(t, rows[0][1], "TableFullScan")
+}
@@ -0,0 +1,107 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package executor
+
+import (
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/model"
+	plannercore "github.com/pingcap/tidb/pkg/planner/core"
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/util/tableaccess"
+)
+
+// recordTableAccess records the tables and the indexes accessed by the plan of the statement into
+// tableaccess.GlobalCollector.
+func (a *ExecStmt) recordTableAccess() {
+	sessVars := a.Ctx.GetSessionVars()
+	if sessVars.InRestrictedSQL || a.Plan == nil || sessVars.StmtCtx.InExplainStmt {
+		return
+	}
+	flat := getFlatPlan(sessVars.StmtCtx)
+	if flat == nil || flat.InExplain {
+		return
+	}
+	tableaccess.GlobalCollector.Record(collectTableAccesses(flat), time.Now())
+}
+
+// collectTableAccesses returns the logical accesses to the tables and the indexes in the plan. The table side of
+// the IndexLookUp and the IndexMerge reads the rows by the handles got from the indexes, so it's not counted as a
+// scan of the table.
+func collectTableAccesses(flat *plannercore.FlatPhysicalPlan) []tableaccess.Access {
+	var accesses []tableaccess.Access
+	lookUpTableScans := make(map[base.Plan]struct{})
+	collect := func(ops plannercore.FlatPlanTree) {
+		for _, op := range ops {
+			switch p := op.Origin.(type) {
+			case *plannercore.PhysicalIndexLookUpReader:
+				for _, tablePlan := range p.TablePlans {
+					lookUpTableScans[tablePlan] = struct{}{}
+				}
+			case *plannercore.PhysicalIndexMergeReader:
+				for _, tablePlan := range p.TablePlans {
+					lookUpTableScans[tablePlan] = struct{}{}
+				}
+			case *plannercore.PhysicalTableScan:
+				if _, ok := lookUpTableScans[p]; ok || !isAccessRecorded(p.Table) {
+					continue
+				}
+				accesses = append(accesses, tableAccess(p.Table, nil, tableaccess.Scan))
+			case *plannercore.PhysicalIndexScan:
+				if !isAccessRecorded(p.Table) {
+					continue
+				}
+				accesses = append(accesses, tableAccess(p.Table, p.Index, tableaccess.Scan))
+			case *plannercore.PhysicalLooseIndexScan:
+				if !isAccessRecorded(p.IndexScan.Table) {
+					continue
+				}
+				accesses = append(accesses, tableAccess(p.IndexScan.Table, p.IndexScan.Index, tableaccess.Scan))
+			case *plannercore.PointGetPlan:
+				if !isAccessRecorded(p.TblInfo) {
+					continue
+				}
+				accesses = append(accesses, tableAccess(p.TblInfo, p.IndexInfo, tableaccess.PointGet))
+			case *plannercore.BatchPointGetPlan:
+				if !isAccessRecorded(p.TblInfo) {
+					continue
+				}
+				accesses = append(accesses, tableAccess(p.TblInfo, p.IndexInfo, tableaccess.PointGet))
+			}
+		}
+	}
+	collect(flat.Main)
+	for _, cte := range flat.CTEs {
+		collect(cte)
+	}
+	for _, subQuery := range flat.ScalarSubQueries {
+		collect(subQuery)
+	}
+	return accesses
+}
+
+// isAccessRecorded returns whether the accesses to the table are recorded. The IDs of the local temporary tables
+// are only meaningful in the session.
+func isAccessRecorded(tblInfo *model.TableInfo) bool {
+	return tblInfo != nil && tblInfo.TempTableType != model.TempTableLocal
+}
+
+func tableAccess(tblInfo *model.TableInfo, idxInfo *model.IndexInfo, kind tableaccess.Kind) tableaccess.Access {
+	key := tableaccess.Key{TableID: tblInfo.ID}
+	if idxInfo != nil {
+		key.IndexID = idxInfo.ID
+	}
+	return tableaccess.Access{Key: key, Kind: kind}
+}
@@ -163,8 +163,10 @@ type TableReaderExecutor struct {
 	// resultHandler handles the order of the result. Since (MAXInt64, MAXUint64] stores before [0, MaxInt64] physically
 	// for unsigned int.
 	resultHandler *tableResultHandler
-	plans         []base.PhysicalPlan
-	tablePlan     base.PhysicalPlan
+	// waitTiFlashReplica waits for the tiflash replica to become available before sending the requests.
+	waitTiFlashReplica func(ctx context.Context) error
+	plans              []base.PhysicalPlan
+	tablePlan          base.PhysicalPlan
 
 	memTracker       *memory.Tracker
 	selectResultHook // for testing
@@ -191,6 +193,8 @@ type TableReaderExecutor struct {
 	// If dummy flag is set, this is not a real TableReader, it just provides the KV ranges for UnionScan.
 	// Used by the temporary table, cached table.
 	dummy bool
+
+	reoptChecker *reoptimizeChecker
 }
 
 // Table implements the dataSourceExecutor interface.
@@ -220,6 +224,7 @@ func (e *TableReaderExecutor) memUsage() int64 {
 func (e *TableReaderExecutor) Open(ctx context.Context) error {
 	r, ctx := tracing.StartRegionEx(ctx, "TableReaderExecutor.Open")
 	defer r.End()
+	e.reoptChecker.reset()
 	failpoint.Inject("mockSleepInTableReaderNext", func(v failpoint.Value) {
 		ms := v.(int)
 		time.Sleep(time.Millisecond * time.Duration(ms))
@@ -233,6 +238,11 @@ func (e *TableReaderExecutor) Open(ctx context.Context) error {
 	e.memTracker.AttachTo(e.stmtMemTracker)
 
 	var err error
+	if e.waitTiFlashReplica != nil {
+		if err = e.waitTiFlashReplica(ctx); err != nil {
+			return err
+		}
+	}
 	if e.corColInFilter {
 		// If there's correlated column in filter, need to rewrite dagPB
 		if e.storeType == kv.TiFlash {
@@ -327,6 +337,10 @@ func (e *TableReaderExecutor) Next(ctx context.Context, req *chunk.Chunk) error
 		}
 		return tableName
 	}), e.ranges)
+	if e.RuntimeStats() != nil {
+		start := time.Now()
+		defer func() { e.RuntimeStats().RecordNetworkWait(time.Since(start)) }()
+	}
 	if err := e.resultHandler.nextChunk(ctx, req); err != nil {
 		return err
 	}
@@ -336,7 +350,7 @@ func (e *TableReaderExecutor) Next(ctx context.Context, req *chunk.Chunk) error
 		return err
 	}
 
-	return nil
+	return e.reoptChecker.check(req.NumRows())
 }
 
 // Close implements the Executor Close interface.
@@ -19,10 +19,12 @@ import (
 	"fmt"
 	"math"
 	"strconv"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/ddl"
 	"github.com/pingcap/tidb/pkg/ddl/schematracker"
 	ddltestutil "github.com/pingcap/tidb/pkg/ddl/testutil"
 	ddlutil "github.com/pingcap/tidb/pkg/ddl/util"
@@ -589,6 +591,58 @@ func TestShardRowIDBits(t *testing.T) {
 	tk.MustGetDBError("insert into t1 values(3)", autoid.ErrAutoincReadFailed)
 }
 
+func TestShardRowIDHint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	// The max shard_row_id_bits of the table is still 4 after the alter.
+	tk.MustExec("create table t (a int) shard_row_id_bits = 4")
+	tk.MustExec("alter table t shard_row_id_bits = 0")
+	for i := 0; i < 20; i++ {
+		tk.MustExec("insert /*+ shard_row_id(4) */ into t values (?)", i)
+	}
+	tk.MustQuery("select count(*) > 0 from t where _tidb_rowid >= 1 << 59").Check(testkit.Rows("1"))
+
+	// The hint exceeding the max shard_row_id_bits of the table is ignored.
+	tk.MustExec("truncate table t")
+	tk.MustExec("insert /*+ shard_row_id(15) */ into t values (1)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 8073 SHARD_ROW_ID(15) exceeds the max shard_row_id_bits 4 of the table 't', the hint is ignored"))
+	tk.MustExec("replace /*+ shard_row_id(5) */ into t values (2)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 8073 SHARD_ROW_ID(5) exceeds the max shard_row_id_bits 4 of the table 't', the hint is ignored"))
+	tk.MustQuery("select count(*) from t where _tidb_rowid >= 1 << 59").Check(testkit.Rows("0"))
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("2"))
+
+	// The bulk insert pre-splits and scatters the record regions by the shards of the hint in the background.
+	atomic.StoreUint32(&ddl.EnableSplitTableRegion, 1)
+	defer atomic.StoreUint32(&ddl.EnableSplitTableRegion, 0)
+	tk.MustExec("insert /*+ shard_row_id(2) */ into t values (1), (2), (3)")
+	require.Eventually(t, func() bool {
+		return len(tk.MustQuery("show table t regions").Rows()) == 4
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// Only the record regions of the partitions touched by the statement are pre-split.
+	tk.MustExec("create table tp (a int) shard_row_id_bits = 4 partition by range (a) (partition p0 values less than (10), partition p1 values less than (20))")
+	tk.MustExec("insert /*+ shard_row_id(2) */ into tp values (11), (12), (13)")
+	require.Eventually(t, func() bool {
+		return len(tk.MustQuery("show table tp partition (p1) regions").Rows()) == 4
+	}, 5*time.Second, 50*time.Millisecond)
+	require.Len(t, tk.MustQuery("show table tp partition (p0) regions").Rows(), 1)
+
+	// The hint is ignored for the table with a clustered primary key.
+	tk.MustExec("create table t2 (a int primary key clustered)")
+	tk.MustExec("insert /*+ shard_row_id(4) */ into t2 values (1)")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 8073 SHARD_ROW_ID() is inapplicable for the table 't2' with a clustered primary key"))
+
+	// The hint disables the sharding of the statement.
+	tk.MustExec("create table t1 (a int) shard_row_id_bits = 4")
+	for i := 0; i < 20; i++ {
+		tk.MustExec("insert /*+ shard_row_id(0) */ into t1 values (?)", i)
+	}
+	tk.MustQuery("select count(*) from t1 where _tidb_rowid >= 1 << 59").Check(testkit.Rows("0"))
+	tk.MustQuery("select count(*) from t1").Check(testkit.Rows("20"))
+}
+
 func TestAutoRandomBitsData(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 	tk := testkit.NewTestKit(t, store)
@@ -157,6 +157,10 @@ func TestPlanReplayerCapture(t *testing.T) {
 	tk.MustGetErrMsg("plan replayer capture '123' '123';", "plan replayer capture task already exists")
 	tk.MustExec("plan replayer capture remove '123' '123'")
 	tk.MustQuery("select count(*) from mysql.plan_replayer_task;").Check(testkit.Rows("0"))
+	tk.MustExec("plan replayer capture '123' '*' limit 3;")
+	tk.MustQuery("select sql_digest, plan_digest, capture_limit, captured_count from mysql.plan_replayer_task;").Check(testkit.Rows("123 * 3 0"))
+	tk.MustExec("plan replayer capture remove '123' '*'")
+	tk.MustQuery("select count(*) from mysql.plan_replayer_task;").Check(testkit.Rows("0"))
 	tk.MustExec("create table t(id int)")
 	tk.MustExec("prepare stmt from 'update t set id = ?  where id = ? + 1';")
 	tk.MustExec("SET @number = 5;")
@@ -0,0 +1,114 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadremotetest
+
+import (
+	"fmt"
+
+	"github.com/fsouza/fake-gcs-server/fakestorage"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/pingcap/tidb/pkg/util/sem"
+	"github.com/stretchr/testify/require"
+)
+
+func (s *mockGCSSuite) TestExternalTable() {
+	s.server.CreateBucketWithOpts(fakestorage.CreateBucketOpts{Name: "test-external-table"})
+	s.server.CreateObject(fakestorage.Object{
+		ObjectAttrs: fakestorage.ObjectAttrs{BucketName: "test-external-table", Name: "csv/t.001.csv"},
+		Content:     []byte("1,\"a\"\n2,\\N\n"),
+	})
+	s.server.CreateObject(fakestorage.Object{
+		ObjectAttrs: fakestorage.ObjectAttrs{BucketName: "test-external-table", Name: "csv/t.002.csv"},
+		Content:     []byte("3,\"c,d\"\n"),
+	})
+	s.server.CreateObject(fakestorage.Object{
+		ObjectAttrs: fakestorage.ObjectAttrs{BucketName: "test-external-table", Name: "csv/other.csv"},
+		Content:     []byte("4,\"e\"\n"),
+	})
+	s.tk.MustExec("DROP DATABASE IF EXISTS external_table;")
+	s.tk.MustExec("CREATE DATABASE external_table;")
+	s.tk.MustExec("USE external_table;")
+	s.tk.MustExec(fmt.Sprintf(`CREATE TABLE t (i INT, s VARCHAR(32))
+		EXTERNAL_LOCATION = 'gs://test-external-table/csv/t.*.csv?endpoint=%s'`, gcsEndpoint))
+	s.tk.MustQuery("SELECT * FROM t ORDER BY i").Check(testkit.Rows("1 a", "2 <nil>", "3 c,d"))
+	s.tk.MustQuery("SELECT s FROM t WHERE i > 1 ORDER BY i").Check(testkit.Rows("<nil>", "c,d"))
+	s.tk.MustQuery("EXPLAIN FORMAT = 'brief' SELECT s FROM t WHERE i > 1").CheckContain("filters:[gt(external_table.t.i, 1)]")
+	s.tk.MustQuery("SHOW CREATE TABLE t").CheckContain(
+		fmt.Sprintf("/*T![external_table] EXTERNAL_LOCATION='gs://test-external-table/csv/t.*.csv?endpoint=%s' EXTERNAL_FORMAT='csv' */", gcsEndpoint))
+
+	// join the external table with the table stored in the cluster.
+	s.tk.MustExec("CREATE TABLE n (i INT PRIMARY KEY, v INT)")
+	s.tk.MustExec("INSERT INTO n VALUES (1, 10), (3, 30), (5, 50)")
+	s.tk.MustQuery("SELECT t.s, n.v FROM t JOIN n ON t.i = n.i ORDER BY n.v").Check(testkit.Rows("a 10", "c,d 30"))
+
+	// the parquet files are matched by the column names.
+	s.tk.MustExec(fmt.Sprintf(`SELECT s, i FROM t INTO OUTFILE 'gs://test-external-table/t.parquet?endpoint=%s' FORMAT 'parquet'`, gcsEndpoint))
+	s.tk.MustExec(fmt.Sprintf(`CREATE TABLE p (i BIGINT, s VARCHAR(32))
+		EXTERNAL_LOCATION = 'gs://test-external-table/t.parquet?endpoint=%s' EXTERNAL_FORMAT = 'PARQUET'`, gcsEndpoint))
+	s.tk.MustQuery("SELECT i, s FROM p WHERE s IS NOT NULL ORDER BY i").Check(testkit.Rows("1 a", "3 c,d"))
+
+	// external tables are read-only.
+	s.tk.MustGetErrMsg("INSERT INTO t VALUES (5, 'e')", "[planner:8189]Unsupported external table 't': external tables are read-only")
+	s.tk.MustGetErrMsg("UPDATE t SET s = 'x'", "[planner:8189]Unsupported external table 't': external tables are read-only")
+	s.tk.MustGetErrMsg("DELETE FROM t", "[planner:8189]Unsupported external table 't': external tables are read-only")
+	s.tk.MustGetErrMsg("TRUNCATE TABLE t", "[ddl:8189]Unsupported external table 't': TRUNCATE TABLE is not supported")
+	s.tk.MustGetErrMsg("ALTER TABLE t ADD COLUMN c INT", "[ddl:8189]Unsupported external table 't': only RENAME and COMMENT are supported by ALTER TABLE")
+	s.tk.MustExec("ALTER TABLE t COMMENT = 'data lake'")
+	s.tk.MustExec("DROP TABLE t, p")
+
+	// the credentials are not stored in the table definition.
+	s.tk.MustExec(fmt.Sprintf(`CREATE TABLE c (i INT, s VARCHAR(32))
+		EXTERNAL_LOCATION = 'gs://test-external-table/csv/t.*.csv?credentials-file=/tmp/secret.json&endpoint=%s'`, gcsEndpoint))
+	s.tk.MustQuery("SHOW WARNINGS").CheckContain("the credentials in EXTERNAL_LOCATION are not stored")
+	s.tk.MustQuery("SHOW CREATE TABLE c").CheckContain(
+		fmt.Sprintf("EXTERNAL_LOCATION='gs://test-external-table/csv/t.*.csv?endpoint=%s'", gcsEndpoint))
+	s.tk.MustQuery("SELECT COUNT(*) FROM c").Check(testkit.Rows("3"))
+	s.tk.MustExec("DROP TABLE c")
+
+	// the FILE privilege is required to create external tables.
+	s.tk.MustExec("CREATE USER 'external_user'@'%'")
+	s.tk.MustExec("GRANT CREATE, SELECT ON external_table.* TO 'external_user'@'%'")
+	userTK := testkit.NewTestKit(s.T(), s.store)
+	require.NoError(s.T(), userTK.Session().Auth(&auth.UserIdentity{Username: "external_user", Hostname: "%"}, nil, nil, nil))
+	userTK.MustGetErrCode(fmt.Sprintf("CREATE TABLE external_table.u (i INT) EXTERNAL_LOCATION = 'gs://test-external-table/csv/t.*.csv?endpoint=%s'",
+		gcsEndpoint), mysql.ErrSpecificAccessDenied)
+	userTK.MustExec("CREATE TABLE external_table.u (i INT)")
+	s.tk.MustExec("GRANT FILE ON *.* TO 'external_user'@'%'")
+	userTK.MustExec(fmt.Sprintf("CREATE TABLE external_table.v (i INT) EXTERNAL_LOCATION = 'gs://test-external-table/csv/t.*.csv?endpoint=%s'",
+		gcsEndpoint))
+	s.tk.MustExec("DROP USER 'external_user'@'%'")
+	s.tk.MustExec("DROP TABLE u")
+
+	// external tables are read with the credentials of TiDB server, so they are not supported under SEM.
+	sem.Enable()
+	require.EqualError(s.T(), s.tk.QueryToErr("SELECT * FROM v"),
+		"[planner:8132]Feature 'external table' is not supported when security enhanced mode is enabled")
+	s.tk.MustGetErrMsg(fmt.Sprintf("CREATE TABLE w (i INT) EXTERNAL_LOCATION = 'gs://test-external-table/csv/t.*.csv?endpoint=%s'",
+		gcsEndpoint), "[planner:8132]Feature 'external table' is not supported when security enhanced mode is enabled")
+	sem.Disable()
+	s.tk.MustExec("DROP TABLE v")
+
+	// the definition of the external table is checked.
+	s.tk.MustGetErrMsg("CREATE TABLE t (i INT) EXTERNAL_LOCATION = '/tmp/t.csv'",
+		"[ddl:8189]Unsupported external table 't': EXTERNAL_LOCATION must be in the external storage")
+	s.tk.MustGetErrMsg("CREATE TABLE t (i INT) EXTERNAL_FORMAT = 'csv'",
+		"[ddl:8189]Unsupported external table 't': EXTERNAL_LOCATION is required")
+	s.tk.MustGetErrMsg("CREATE TABLE t (i INT) EXTERNAL_LOCATION = 'gs://bucket/t.json' EXTERNAL_FORMAT = 'json'",
+		"[ddl:8189]Unsupported external table 't': unknown EXTERNAL_FORMAT 'json'")
+	s.tk.MustGetErrMsg("CREATE TABLE t (i INT PRIMARY KEY) EXTERNAL_LOCATION = 'gs://bucket/t.csv'",
+		"[ddl:8189]Unsupported external table 't': indexes are not supported")
+}
@@ -0,0 +1,88 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package loadremotetest
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+
+	"github.com/fsouza/fake-gcs-server/fakestorage"
+	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+func (s *mockGCSSuite) TestSelectIntoOutfile() {
+	s.server.CreateBucketWithOpts(fakestorage.CreateBucketOpts{Name: "test-select-into"})
+	s.tk.MustExec("DROP DATABASE IF EXISTS select_into;")
+	s.tk.MustExec("CREATE DATABASE select_into;")
+	s.tk.MustExec("CREATE TABLE select_into.t (i INT, s varchar(32));")
+	s.tk.MustExec(`INSERT INTO select_into.t VALUES (1, 'a'), (2, NULL), (3, 'c,"d')`)
+
+	sql := fmt.Sprintf(`SELECT * FROM select_into.t ORDER BY i INTO OUTFILE 'gs://test-select-into/t.csv?endpoint=%s'
+		FIELDS TERMINATED BY ',' ENCLOSED BY '"'`, gcsEndpoint)
+	s.tk.MustExec(sql)
+	obj, err := s.server.GetObject("test-select-into", "t.csv")
+	s.NoError(err)
+	s.Equal("\"1\",\"a\"\n\"2\",\\N\n\"3\",\"c,\\\"d\"\n", string(obj.Content))
+	// the outfile is not overwritten.
+	s.tk.MustContainErrMsg(sql, "outfile gs://test-select-into/t.csv?endpoint=")
+
+	// the outfile is compressed by the extension.
+	s.tk.MustExec(fmt.Sprintf(`SELECT * FROM select_into.t ORDER BY i INTO OUTFILE 'gs://test-select-into/dir/t.csv.gz?endpoint=%s'`, gcsEndpoint))
+	obj, err = s.server.GetObject("test-select-into", "dir/t.csv.gz")
+	s.NoError(err)
+	r, err := gzip.NewReader(bytes.NewReader(obj.Content))
+	s.NoError(err)
+	content, err := io.ReadAll(r)
+	s.NoError(err)
+	s.Equal("1\ta\n2\t\\N\n3\tc,\"d\n", string(content))
+
+	s.tk.MustExec(fmt.Sprintf(`SELECT * FROM select_into.t ORDER BY i INTO OUTFILE 'gs://test-select-into/t.parquet?endpoint=%s' FORMAT 'parquet'`, gcsEndpoint))
+	obj, err = s.server.GetObject("test-select-into", "t.parquet")
+	s.NoError(err)
+	pf, err := buffer.NewBufferFile(obj.Content)
+	s.NoError(err)
+	pr, err := reader.NewParquetColumnReader(pf, 1)
+	s.NoError(err)
+	s.EqualValues(3, pr.GetNumRows())
+	// the first schema element is the root.
+	schemaElems := pr.Footer.Schema[1:]
+	s.Len(schemaElems, 2)
+	s.Equal("i", pr.SchemaHandler.GetExName(1))
+	s.Equal(parquet.Type_INT64, schemaElems[0].GetType())
+	s.Equal("s", pr.SchemaHandler.GetExName(2))
+	s.Equal(parquet.Type_BYTE_ARRAY, schemaElems[1].GetType())
+	s.Equal(parquet.ConvertedType_UTF8, schemaElems[1].GetConvertedType())
+	s.Equal(parquet.FieldRepetitionType_OPTIONAL, schemaElems[1].GetRepetitionType())
+	values, _, _, err := pr.ReadColumnByIndex(0, 3)
+	s.NoError(err)
+	s.Equal([]any{int64(1), int64(2), int64(3)}, values)
+	values, _, _, err = pr.ReadColumnByIndex(1, 3)
+	s.NoError(err)
+	s.Equal([]any{"a", nil, `c,"d`}, values)
+	pr.ReadStop()
+
+	// the partial outfile is removed when the statement fails.
+	s.tk.MustGetErrCode(fmt.Sprintf(`SELECT i, JSON_EXTRACT(s, '$') FROM select_into.t ORDER BY i INTO OUTFILE 'gs://test-select-into/failed.csv?endpoint=%s'`,
+		gcsEndpoint), errno.ErrInvalidJSONText)
+	_, err = s.server.GetObject("test-select-into", "failed.csv")
+	s.Error(err)
+	s.tk.MustQuery("SELECT count(*) FROM select_into.t").Check(testkit.Rows("3"))
+}
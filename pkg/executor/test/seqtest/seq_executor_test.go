@@ -1369,3 +1369,65 @@ func TestAnalyzeNextRawErrorNoLeak(t *testing.T) {
 	}()
 	tk.MustGetErrMsg("analyze table t1", "mockNextRawError")
 }
+
+func TestAutoBulkDML(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	originLimit := kv.TxnTotalSizeLimit.Load()
+	defer func() {
+		kv.TxnTotalSizeLimit.Store(originLimit)
+	}()
+	// Set the limitation to a small value, make it easier to reach the limitation.
+	kv.TxnTotalSizeLimit.Store(7000)
+
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (c int)")
+	tk.MustExec("insert into t values (1),(1),(1),(1),(1),(1),(1),(1),(1),(1)")
+	for i := 0; i < 5; i++ {
+		tk.MustExec("insert into t (c) select * from t")
+	}
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("320"))
+
+	// The standard mode meets the txn too large error.
+	tk.MustGetErrCode("insert into t (c) select * from t", errno.ErrTxnTooLarge)
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("320"))
+
+	// The auto mode retries the statement in bulk mode.
+	tk.MustExec("set @@tidb_dml_type = auto")
+	// Flush the mutations of the bulk DML in small batches, so the retry doesn't reach the small limitation either.
+	// The mutations are flushed after each batch of rows, whose size is bounded by the chunk size.
+	tk.MustExec("set @@tidb_dml_bulk_batch_size = 100")
+	tk.MustExec("set @@tidb_max_chunk_size = 32")
+	checkRetriedInBulkMode := func() {
+		// The retry doesn't fall back to the standard mode, so the only warning is the one about the retry.
+		warnings := tk.Session().GetSessionVars().StmtCtx.GetWarnings()
+		require.Len(t, warnings, 1)
+		require.Equal(t, "The statement exceeds the transaction size limit and is retried in bulk mode", warnings[0].Err.Error())
+		require.False(t, tk.Session().GetSessionVars().BulkDMLEnabled)
+	}
+	tk.MustExec("insert into t (c) select * from t")
+	checkRetriedInBulkMode()
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("640"))
+
+	// The small statements are still executed in standard mode.
+	tk.MustExec("delete from t where c = 1 limit 10")
+	require.Empty(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings())
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("630"))
+
+	// The statements in explicit transactions can't be retried.
+	tk.MustExec("begin")
+	tk.MustGetErrCode("insert into t (c) select * from t", errno.ErrTxnTooLarge)
+	tk.MustExec("rollback")
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("630"))
+
+	// The prepared statements are retried as well.
+	tk.MustExec("prepare stmt from 'insert into t (c) select * from t'")
+	tk.MustExec("execute stmt")
+	checkRetriedInBulkMode()
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("1260"))
+	// The statement with arguments is executed by the binary protocol.
+	tk.MustExec("delete from t where c > ?", 0)
+	checkRetriedInBulkMode()
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("0"))
+}
@@ -1105,6 +1105,35 @@ func TestTiflashSupportStaleRead(t *testing.T) {
 	require.NotContains(t, res, "tikv")
 }
 
+func TestTiFlashStaleReadBeforeReplicaAvailable(t *testing.T) {
+	store := testkit.CreateMockStore(t, withMockTiFlash(2))
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a bigint(20))")
+	tk.MustExec("alter table t set tiflash replica 1")
+	tk.MustExec("insert into t values (1), (2), (3)")
+	time.Sleep(time.Second)
+	readTS := tk.MustQuery("select now(3)").Rows()[0][0].(string)
+	time.Sleep(time.Second)
+	// The replica becomes available after the read ts of the stale read.
+	tb := external.GetTableByName(t, tk, "test", "t")
+	err := domain.GetDomain(tk.Session()).DDL().UpdateTableReplicaInfo(tk.Session(), tb.Meta().ID, true)
+	require.NoError(t, err)
+	tk.MustExec("insert into t values (4)")
+
+	tk.MustExec("set @@session.tidb_isolation_read_engines = 'tiflash'")
+	rows := tk.MustQuery(fmt.Sprintf("explain select avg(a) from t as of timestamp '%s'", readTS)).Rows()
+	resBuff := bytes.NewBufferString("")
+	for _, row := range rows {
+		fmt.Fprintf(resBuff, "%s\n", row)
+	}
+	res := resBuff.String()
+	require.Contains(t, res, "tiflash")
+	require.NotContains(t, res, "tikv")
+	tk.MustQuery(fmt.Sprintf("select count(*) from t as of timestamp '%s'", readTS)).Check(testkit.Rows("3"))
+}
+
 func TestForbidTiFlashIfExtraPhysTableIDIsNeeded(t *testing.T) {
 	store := testkit.CreateMockStore(t, withMockTiFlash(2))
 	tk := testkit.NewTestKit(t, store)
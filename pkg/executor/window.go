@@ -240,6 +240,8 @@ type rowFrameWindowProcessor struct {
 	start          *core.FrameBound
 	end            *core.FrameBound
 	curRowIdx      uint64
+	// excluder is nil if no rows are excluded from the frame.
+	excluder *frameExcluder
 }
 
 func (p *rowFrameWindowProcessor) getStartOffset(numRows uint64) uint64 {
@@ -315,6 +317,13 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 		end = p.getEndOffset(numRows)
 		p.curRowIdx++
 		remained--
+		if p.excluder != nil {
+			err = p.excluder.appendResult2Chunk(ctx, p.windowFuncs, p.partialResults, rows, p.curRowIdx-1, start, end, chk)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
 		shiftStart = start - lastStart
 		shiftEnd = end - lastEnd
 		if start >= end {
@@ -374,6 +383,9 @@ func (p *rowFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, row
 
 func (p *rowFrameWindowProcessor) resetPartialResult() {
 	p.curRowIdx = 0
+	if p.excluder != nil {
+		p.excluder.reset()
+	}
 }
 
 type rangeFrameWindowProcessor struct {
@@ -387,6 +399,8 @@ type rangeFrameWindowProcessor struct {
 	orderByCols     []*expression.Column
 	// expectedCmpResult is used to decide if one value is included in the frame.
 	expectedCmpResult int64
+	// excluder is nil if no rows are excluded from the frame.
+	excluder *frameExcluder
 }
 
 func (p *rangeFrameWindowProcessor) getStartOffset(ctx sessionctx.Context, rows []chunk.Row) (uint64, error) {
@@ -469,6 +483,13 @@ func (p *rangeFrameWindowProcessor) appendResult2Chunk(ctx sessionctx.Context, r
 		}
 		p.curRowIdx++
 		remained--
+		if p.excluder != nil {
+			err = p.excluder.appendResult2Chunk(ctx, p.windowFuncs, p.partialResults, rows, p.curRowIdx-1, start, end, chk)
+			if err != nil {
+				return nil, err
+			}
+			continue
+		}
 		shiftStart = start - lastStart
 		shiftEnd = end - lastEnd
 		if start >= end {
@@ -531,4 +552,110 @@ func (p *rangeFrameWindowProcessor) resetPartialResult() {
 	p.curRowIdx = 0
 	p.lastStartOffset = 0
 	p.lastEndOffset = 0
+	if p.excluder != nil {
+		p.excluder.reset()
+	}
+}
+
+// frameExcluder removes the rows specified by the EXCLUDE clause from the frame of the current row.
+// The sliding window can't be used in this case, so the window functions are evaluated on the rest rows of every frame.
+type frameExcluder struct {
+	exclusion ast.FrameExclusion
+	cmpFuncs  []chunk.CompareFunc
+	colIdx    []int
+	// [peerStart, peerEnd) are the peers of the current row, which have the same order by values with it.
+	peerStart uint64
+	peerEnd   uint64
+	ranges    [][2]uint64
+}
+
+func newFrameExcluder(exclusion ast.FrameExclusion, orderByCols []*expression.Column) *frameExcluder {
+	if exclusion == ast.ExcludeNoOthers {
+		return nil
+	}
+	e := &frameExcluder{
+		exclusion: exclusion,
+		cmpFuncs:  make([]chunk.CompareFunc, 0, len(orderByCols)),
+		colIdx:    make([]int, 0, len(orderByCols)),
+	}
+	for _, col := range orderByCols {
+		e.cmpFuncs = append(e.cmpFuncs, chunk.GetCompareFunc(col.RetType))
+		e.colIdx = append(e.colIdx, col.Index)
+	}
+	return e
+}
+
+func (e *frameExcluder) isPeer(lhs, rhs chunk.Row) bool {
+	for i, idx := range e.colIdx {
+		if e.cmpFuncs[i](lhs, idx, rhs, idx) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// updatePeers finds the peers of the current row. All the rows of the partition are peers if there is no order by.
+func (e *frameExcluder) updatePeers(rows []chunk.Row, curRowIdx uint64) {
+	if curRowIdx < e.peerEnd {
+		return
+	}
+	e.peerStart, e.peerEnd = curRowIdx, curRowIdx+1
+	for e.peerEnd < uint64(len(rows)) && e.isPeer(rows[curRowIdx], rows[e.peerEnd]) {
+		e.peerEnd++
+	}
+}
+
+// splitFrame returns the ranges of the frame [start, end) which are not excluded.
+func (e *frameExcluder) splitFrame(rows []chunk.Row, curRowIdx, start, end uint64) [][2]uint64 {
+	var excluded [2][2]uint64
+	numExcluded := 1
+	switch e.exclusion {
+	case ast.ExcludeCurrentRow:
+		excluded[0] = [2]uint64{curRowIdx, curRowIdx + 1}
+	case ast.ExcludeGroup:
+		e.updatePeers(rows, curRowIdx)
+		excluded[0] = [2]uint64{e.peerStart, e.peerEnd}
+	case ast.ExcludeTies:
+		e.updatePeers(rows, curRowIdx)
+		excluded[0] = [2]uint64{e.peerStart, curRowIdx}
+		excluded[1] = [2]uint64{curRowIdx + 1, e.peerEnd}
+		numExcluded = 2
+	}
+	e.ranges = e.ranges[:0]
+	for _, r := range excluded[:numExcluded] {
+		if rangeEnd := min(r[0], end); start < rangeEnd {
+			e.ranges = append(e.ranges, [2]uint64{start, rangeEnd})
+		}
+		start = max(start, r[1])
+	}
+	if start < end {
+		e.ranges = append(e.ranges, [2]uint64{start, end})
+	}
+	return e.ranges
+}
+
+func (e *frameExcluder) appendResult2Chunk(ctx sessionctx.Context, windowFuncs []aggfuncs.AggFunc, partialResults []aggfuncs.PartialResult,
+	rows []chunk.Row, curRowIdx, start, end uint64, chk *chunk.Chunk) error {
+	// the peers are tracked even if the frame is empty, so always split the frame.
+	ranges := e.splitFrame(rows, curRowIdx, start, end)
+	evalCtx := ctx.GetExprCtx().GetEvalCtx()
+	for i, windowFunc := range windowFuncs {
+		for _, r := range ranges {
+			if minMaxSlidingWindowAggFunc, ok := windowFunc.(aggfuncs.MaxMinSlidingWindowAggFunc); ok {
+				minMaxSlidingWindowAggFunc.SetWindowStart(r[0])
+			}
+			if _, err := windowFunc.UpdatePartialResult(evalCtx, rows[r[0]:r[1]], partialResults[i]); err != nil {
+				return err
+			}
+		}
+		if err := windowFunc.AppendFinalResult2Chunk(evalCtx, partialResults[i], chk); err != nil {
+			return err
+		}
+		windowFunc.ResetPartialResult(partialResults[i])
+	}
+	return nil
+}
+
+func (e *frameExcluder) reset() {
+	e.peerStart, e.peerEnd = 0, 0
 }
@@ -17,10 +17,12 @@ package executor_test
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
 )
 
 func TestWindowFunctions(t *testing.T) {
@@ -470,3 +472,39 @@ func TestIssue45964And46050(t *testing.T) {
 	testReturnColumnNullableAttribute(tk, "cume_dist()", false)
 	testReturnColumnNullableAttribute(tk, "percent_rank()", false)
 }
+
+func TestWindowFrameExclusion(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(p int, o int, v int)")
+	tk.MustExec("insert into t values (1, 1, 1), (1, 1, 2), (1, 2, 4), (1, 3, 8), (2, 1, 16)")
+
+	for _, enabled := range []string{"0", "1"} {
+		tk.MustExec(fmt.Sprintf("set @@tidb_enable_pipelined_window_function=%s", enabled))
+		tk.Session().GetSessionVars().MaxChunkSize = 2
+		tk.MustQuery("select p, v, sum(v) over (partition by p order by o rows between unbounded preceding and unbounded following exclude current row) from t order by p, v").
+			Check(testkit.Rows("1 1 14", "1 2 13", "1 4 11", "1 8 7", "2 16 <nil>"))
+		tk.MustQuery("select p, v, sum(v) over (partition by p order by o range between unbounded preceding and unbounded following exclude group) from t order by p, v").
+			Check(testkit.Rows("1 1 12", "1 2 12", "1 4 11", "1 8 7", "2 16 <nil>"))
+		tk.MustQuery("select p, v, sum(v) over (partition by p order by o range unbounded preceding exclude ties) from t order by p, v").
+			Check(testkit.Rows("1 1 1", "1 2 2", "1 4 7", "1 8 15", "2 16 16"))
+		tk.MustQuery("select o, count(*) over (order by o rows between 1 preceding and 1 following exclude current row) as c from t order by o, c").
+			Check(testkit.Rows("1 1", "1 2", "1 2", "2 2", "3 1"))
+		tk.MustQuery("select p, v, first_value(v) over (partition by p order by o, v rows unbounded preceding exclude current row) from t order by p, v").
+			Check(testkit.Rows("1 1 <nil>", "1 2 1", "1 4 1", "1 8 1", "2 16 <nil>"))
+		// The frame with EXCLUDE can be defined in a named window.
+		tk.MustQuery("select v, sum(v) over w from t window w as (partition by p order by o range between 1 preceding and 1 following exclude group) order by p, v").
+			Check(testkit.Rows("1 4", "2 4", "4 11", "8 4", "16 <nil>"))
+		tk.MustQuery("select v, sum(v) over w, max(v) over w from t window w as (partition by p order by o rows between unbounded preceding and unbounded following exclude no others) order by p, v").
+			Check(testkit.Rows("1 15 8", "2 15 8", "4 15 8", "8 15 8", "16 16 16"))
+	}
+	hasExclusion := false
+	for _, row := range tk.MustQuery("explain format = 'brief' select sum(v) over (order by o rows between 1 preceding and 1 following exclude ties) from t").Rows() {
+		if strings.Contains(row[4].(string), "rows between 1 preceding and 1 following exclude ties") {
+			hasExclusion = true
+		}
+	}
+	require.True(t, hasExclusion)
+}
@@ -30,7 +30,6 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/sessionctx"
-	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/types"
@@ -339,19 +338,19 @@ func checkRowForExchangePartition(sctx table.MutateContext, row []types.Datum, t
 	if err != nil {
 		return err
 	}
-	if variable.EnableCheckConstraint.Load() {
-		type CheckConstraintTable interface {
-			CheckRowConstraint(ctx table.MutateContext, rowToCheck []types.Datum) error
-		}
-		cc, ok := pt.(CheckConstraintTable)
-		if !ok {
-			return errors.Errorf("exchange partition process assert check constraint failed")
-		}
-		err := cc.CheckRowConstraint(sctx, row)
-		if err != nil {
-			// TODO: make error include ExchangePartition info.
-			return err
-		}
+	// The check constraints are always enforced like the other writes, otherwise the validated constraints of the
+	// partitioned table may be violated by the exchanged rows.
+	type CheckConstraintTable interface {
+		CheckRowConstraint(ctx table.MutateContext, rowToCheck []types.Datum) error
+	}
+	cc, ok := pt.(CheckConstraintTable)
+	if !ok {
+		return errors.Errorf("exchange partition process assert check constraint failed")
+	}
+	err = cc.CheckRowConstraint(sctx, row)
+	if err != nil {
+		// TODO: make error include ExchangePartition info.
+		return err
 	}
 	return nil
 }
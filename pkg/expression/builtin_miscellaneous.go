@@ -23,6 +23,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 	"unicode/utf8"
 
 	"github.com/google/uuid"
@@ -1616,6 +1617,79 @@ func (b *builtinTidbShardSig) evalInt(ctx EvalContext, row chunk.Row) (int64, bo
 	return int64(hashed), false, nil
 }
 
+// FullTextTokenMaxLen is the max number of characters of a token in the FULLTEXT index, longer tokens are truncated.
+const FullTextTokenMaxLen = 64
+
+// SplitFullTextTokens splits the string into the distinct lowercase tokens of the FULLTEXT index.
+// A token is a sequence of letters, digits and underscores.
+func SplitFullTextTokens(s string, tokens []string, seen map[string]struct{}) []string {
+	for _, field := range strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r) && r != '_'
+	}) {
+		token := strings.ToLower(field)
+		if utf8.RuneCountInString(token) > FullTextTokenMaxLen {
+			token = string([]rune(token)[:FullTextTokenMaxLen])
+		}
+		if _, ok := seen[token]; ok {
+			continue
+		}
+		seen[token] = struct{}{}
+		tokens = append(tokens, token)
+	}
+	return tokens
+}
+
+type tidbFullTextTokensFunctionClass struct {
+	baseFunctionClass
+}
+
+func (c *tidbFullTextTokensFunctionClass) getFunction(ctx BuildContext, args []Expression) (builtinFunc, error) {
+	if err := c.verifyArgs(args); err != nil {
+		return nil, err
+	}
+	argTps := make([]types.EvalType, 0, len(args))
+	for range args {
+		argTps = append(argTps, types.ETString)
+	}
+	bf, err := newBaseBuiltinFuncWithTp(ctx, c.funcName, args, types.ETJson, argTps...)
+	if err != nil {
+		return nil, err
+	}
+	sig := &builtinTiDBFullTextTokensSig{bf}
+	return sig, nil
+}
+
+type builtinTiDBFullTextTokensSig struct {
+	baseBuiltinFunc
+}
+
+func (b *builtinTiDBFullTextTokensSig) Clone() builtinFunc {
+	newSig := &builtinTiDBFullTextTokensSig{}
+	newSig.cloneFrom(&b.baseBuiltinFunc)
+	return newSig
+}
+
+// evalJSON evals tidb_fulltext_tokens(str, ...), it returns the JSON array of the tokens of all the non-NULL strings.
+func (b *builtinTiDBFullTextTokensSig) evalJSON(ctx EvalContext, row chunk.Row) (types.BinaryJSON, bool, error) {
+	var tokens []string
+	seen := make(map[string]struct{})
+	for _, arg := range b.args {
+		str, isNull, err := arg.EvalString(ctx, row)
+		if err != nil {
+			return types.BinaryJSON{}, true, err
+		}
+		if isNull {
+			continue
+		}
+		tokens = SplitFullTextTokens(str, tokens, seen)
+	}
+	elems := make([]any, 0, len(tokens))
+	for _, token := range tokens {
+		elems = append(elems, token)
+	}
+	return types.CreateBinaryJSON(elems), false, nil
+}
+
 type tidbRowChecksumFunctionClass struct {
 	baseFunctionClass
 }
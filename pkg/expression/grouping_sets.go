@@ -483,6 +483,38 @@ func RollupGroupingSets(rollupExprs []Expression) GroupingSets {
 	return res
 }
 
+// CubeGroupingSets computes all the combinations of the given expressions, and iterate out a slice of grouping set expressions.
+func CubeGroupingSets(cubeExprs []Expression) GroupingSets {
+	// cube expr is the power set of the exprs, and keep the order of them inside one grouping set.
+	// eg: [a,b] => {},{a},{b},{a,b}
+	res := make(GroupingSets, 0, 1<<len(cubeExprs))
+	for mask := 0; mask < 1<<len(cubeExprs); mask++ {
+		groupingExprs := GroupingExprs{}
+		for j := range cubeExprs {
+			if mask&(1<<j) != 0 {
+				groupingExprs = append(groupingExprs, cubeExprs[j])
+			}
+		}
+		res = append(res, newGroupingSet(groupingExprs))
+	}
+	return res
+}
+
+// ExplicitGroupingSets iterates out a slice of grouping set expressions from the explicitly specified grouping sets,
+// each of which is described by the offsets of its expressions in exprs.
+func ExplicitGroupingSets(exprs []Expression, offsets [][]int) GroupingSets {
+	// eg: grouping sets((a,b),(a),()) => exprs: [a,b,a], offsets: [[0,1],[2],[]] => {a,b},{a},{}
+	res := make(GroupingSets, 0, len(offsets))
+	for _, oneSetOffsets := range offsets {
+		groupingExprs := make(GroupingExprs, 0, len(oneSetOffsets))
+		for _, offset := range oneSetOffsets {
+			groupingExprs = append(groupingExprs, exprs[offset])
+		}
+		res = append(res, newGroupingSet(groupingExprs))
+	}
+	return res
+}
+
 // AdjustNullabilityFromGroupingSets adjust the nullability of the Expand schema out.
 func AdjustNullabilityFromGroupingSets(gss GroupingSets, schema *Schema) {
 	// If anyone (grouping set) of the grouping sets doesn't include one grouping-set col, meaning that
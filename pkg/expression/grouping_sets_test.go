@@ -245,6 +245,26 @@ func TestRollupGroupingSets(t *testing.T) {
 	require.Equal(t, mysql.HasNotNullFlag(expandSchema.Columns[3].RetType.GetFlag()), true)
 }
 
+func TestCubeAndExplicitGroupingSets(t *testing.T) {
+	defer view.Stop()
+	a := &Column{
+		UniqueID: 1,
+	}
+	b := &Column{
+		UniqueID: 2,
+	}
+	cubeGroupingSets := CubeGroupingSets([]Expression{a, b})
+	require.Equal(t, "[{<>},{<Column#1>},{<Column#2>},{<Column#1,Column#2>}]", cubeGroupingSets.String())
+	distinctSize, _, _ := cubeGroupingSets.DistinctSize()
+	require.Equal(t, 4, distinctSize)
+
+	// grouping sets((a,b),(a),(),(a)): the duplicated grouping set (a) shares the same gid.
+	explicitGroupingSets := ExplicitGroupingSets([]Expression{a, b, a, a}, [][]int{{0, 1}, {2}, {}, {3}})
+	require.Equal(t, "[{<Column#1,Column#2>},{<Column#1>},{<>},{<Column#1>}]", explicitGroupingSets.String())
+	distinctSize, _, _ = explicitGroupingSets.DistinctSize()
+	require.Equal(t, 3, distinctSize)
+}
+
 func TestGroupingSetsMergeUnitTest(t *testing.T) {
 	defer view.Stop()
 	a := &Column{
@@ -33,6 +33,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/rowchange"
 	"github.com/stretchr/testify/require"
 )
 
@@ -109,6 +110,106 @@ func getPreparedID(t *testing.T, sctx sessionctx.Context) uint32 {
 	return sessStates.PreparedStmtID
 }
 
+func TestExtensionRowChangeEvents(t *testing.T) {
+	defer extension.Reset()
+	extension.Reset()
+	var events []*extension.RowChangeEvent
+	require.NoError(t, extension.Register("test", extension.WithSessionHandlerFactory(func() *extension.SessionHandler {
+		return &extension.SessionHandler{
+			SubscribeRowChanges: func(db, table string) bool {
+				return db == "test" && table == "t1"
+			},
+			OnRowChangeEvent: func(event *extension.RowChangeEvent) {
+				events = append(events, event)
+			},
+		}
+	})))
+	require.NoError(t, extension.Setup())
+
+	store := testkit.CreateMockStore(t)
+	serv := server.CreateMockServer(t, store)
+	defer serv.Close()
+	conn := server.CreateMockConn(t, serv)
+	defer conn.Close()
+	mustExec := func(sql string) {
+		require.NoError(t, conn.HandleQuery(context.Background(), sql))
+	}
+	mustExec("use test")
+	mustExec("create table t1(id int primary key, v varchar(10))")
+	mustExec("create table t2(id int)")
+	require.Empty(t, events)
+
+	mustExec("insert into t1 values (1, 'a'), (2, 'b')")
+	mustExec("insert into t2 values (1)")
+	require.Len(t, events, 1)
+	event := events[0]
+	require.Equal(t, conn.Context().Session.GetSessionVars().ConnectionID, event.ConnectionID)
+	require.NotZero(t, event.StartTS)
+	require.Len(t, event.Stmts, 1)
+	stmt := event.Stmts[0]
+	require.Equal(t, "insert into `t1` values ( ... )", stmt.NormalizedSQL)
+	require.NotEmpty(t, stmt.SQLDigest)
+	require.Len(t, stmt.Rows, 2)
+	require.Equal(t, rowchange.Insert, stmt.Rows[0].Tp)
+	require.Equal(t, "test", stmt.Rows[0].Table.DB)
+	require.Equal(t, "t1", stmt.Rows[0].Table.Name)
+	require.Equal(t, []string{"id", "v"}, stmt.Rows[0].Table.Columns)
+	require.Nil(t, stmt.Rows[0].OldRow)
+	require.Equal(t, []types.Datum{types.NewIntDatum(2), types.NewStringDatum("b")}, stmt.Rows[1].NewRow)
+
+	// the row changes are emitted after the transaction is committed, and the failed statements are not included.
+	events = nil
+	mustExec("begin")
+	mustExec("update /*+ MAX_EXECUTION_TIME(1000) */ t1 set v = 'c' where id = 1")
+	mustExec("insert into t2 values (2)")
+	require.Error(t, conn.HandleQuery(context.Background(), "insert into t1 values (3, 'c'), (2, 'd')"))
+	mustExec("delete from t1 where id = 2")
+	require.Empty(t, events)
+	mustExec("commit")
+	require.Len(t, events, 1)
+	require.Len(t, events[0].Stmts, 2)
+	stmt = events[0].Stmts[0]
+	require.Equal(t, "max_execution_time(1000)", stmt.Hints)
+	require.NotEmpty(t, stmt.PlanDigest)
+	require.Equal(t, []rowchange.Row{{
+		Table:  stmt.Rows[0].Table,
+		Tp:     rowchange.Update,
+		OldRow: []types.Datum{types.NewIntDatum(1), types.NewStringDatum("a")},
+		NewRow: []types.Datum{types.NewIntDatum(1), types.NewStringDatum("c")},
+	}}, stmt.Rows)
+	stmt = events[0].Stmts[1]
+	require.Equal(t, "delete from `t1` where `id` = ?", stmt.NormalizedSQL)
+	require.Len(t, stmt.Rows, 1)
+	require.Equal(t, rowchange.Delete, stmt.Rows[0].Tp)
+	require.Nil(t, stmt.Rows[0].NewRow)
+
+	// the row changes of the rolled back transaction are discarded.
+	events = nil
+	mustExec("begin")
+	mustExec("insert into t1 values (4, 'd')")
+	mustExec("rollback")
+	require.Empty(t, events)
+	mustExec("begin")
+	mustExec("insert into t1 values (4, 'd')")
+	mustExec("savepoint s1")
+	mustExec("insert into t1 values (5, 'e')")
+	mustExec("rollback to savepoint s1")
+	mustExec("commit")
+	require.Len(t, events, 1)
+	require.Len(t, events[0].Stmts, 1)
+	require.Equal(t, types.NewIntDatum(4), events[0].Stmts[0].Rows[0].NewRow[0])
+
+	// the binding used by the statement is attached.
+	events = nil
+	mustExec("create session binding for delete from t1 where v = 'c' using delete /*+ ignore_index(t1, primary) */ from t1 where v = 'c'")
+	mustExec("delete from t1 where v = 'c'")
+	require.Len(t, events, 1)
+	stmt = events[0].Stmts[0]
+	require.Equal(t, "", stmt.Hints)
+	require.Equal(t, "DELETE /*+ ignore_index(`t1` `primary`)*/ FROM `test`.`t1` WHERE `v` = 'c'", stmt.BindSQL)
+	require.Equal(t, "ignore_index(@`del_1` `test`.`t1` `primary`)", stmt.BindHints)
+}
+
 type stmtEventCase struct {
 	sql           string
 	binaryExecute uint32
@@ -204,11 +204,21 @@ func TestSetVariablePrivilege(t *testing.T) {
 			return privs
 		},
 	}
+	// SUPER isn't a dynamic privilege, so the variable can only be set by SUPER.
+	sysVar2 := &variable.SysVar{
+		Scope: variable.ScopeGlobal,
+		Name:  "var2",
+		Value: "",
+		Type:  variable.TypeStr,
+		RequireDynamicPrivileges: func(isGlobal bool, sem bool) []string {
+			return []string{"SUPER"}
+		},
+	}
 
 	extension.Reset()
 	require.NoError(t, extension.Register(
 		"test",
-		extension.WithCustomSysVariables([]*variable.SysVar{sysVar1}),
+		extension.WithCustomSysVariables([]*variable.SysVar{sysVar1, sysVar2}),
 		extension.WithCustomDynPrivs([]string{"priv1", "priv2", "restricted_priv3"}),
 	))
 	require.NoError(t, extension.Setup())
@@ -247,6 +257,11 @@ func TestSetVariablePrivilege(t *testing.T) {
 	tk2.MustExec("set @@global.var1=18")
 	tk2.MustQuery("select @@global.var1").Check(testkit.Rows("18"))
 
+	tk1.MustExec("set @@global.var2='a'")
+	tk1.MustQuery("select @@global.var2").Check(testkit.Rows("a"))
+	require.EqualError(t, tk2.ExecToErr("set @@global.var2='b'"), "[planner:1227]Access denied; you need (at least one of) the SUPER privilege(s) for this operation")
+	tk2.MustQuery("select @@global.var2").Check(testkit.Rows("a"))
+
 	sem.Enable()
 	defer sem.Disable()
 
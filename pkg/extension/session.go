@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/rowchange"
 )
 
 // ConnEventInfo is the connection info for the event
@@ -95,10 +96,34 @@ type StmtEventInfo interface {
 	GetError() error
 }
 
+// RowChangeEvent is the row changes of the subscribed tables committed by a transaction
+type RowChangeEvent struct {
+	ConnectionID uint64
+	User         *auth.UserIdentity
+	StartTS      uint64
+	// Stmts are the row changes grouped by the statements making them, in the order of execution.
+	// The statements not changing any subscribed table are not included.
+	Stmts []*rowchange.Stmt
+	// Truncated indicates the row changes of the transaction exceed the memory limit and are discarded, Stmts is
+	// empty then. As the changed tables are unknown, the truncated event is sent to every listener.
+	Truncated bool
+}
+
 // SessionHandler is used to listen session events
 type SessionHandler struct {
 	OnConnectionEvent func(ConnEventTp, *ConnEventInfo)
 	OnStmtEvent       func(StmtEventTp, StmtEventInfo)
+	// SubscribeRowChanges returns whether the row changes of the table should be emitted to OnRowChangeEvent.
+	// Both SubscribeRowChanges and OnRowChangeEvent should be specified to listen the row changes.
+	SubscribeRowChanges func(db, table string) bool
+	// OnRowChangeEvent is called after a transaction changing the subscribed tables is committed.
+	// It's called synchronously in the session, so the handler should not block.
+	OnRowChangeEvent func(*RowChangeEvent)
+}
+
+type rowChangeListener struct {
+	subscribe func(db, table string) bool
+	onEvent   func(*RowChangeEvent)
 }
 
 func newSessionExtensions(es *Extensions) *SessionExtensions {
@@ -112,6 +137,12 @@ func newSessionExtensions(es *Extensions) *SessionExtensions {
 				if fn := handler.OnStmtEvent; fn != nil {
 					connExtensions.stmtEventFuncs = append(connExtensions.stmtEventFuncs, fn)
 				}
+				if handler.SubscribeRowChanges != nil && handler.OnRowChangeEvent != nil {
+					connExtensions.rowChangeListeners = append(connExtensions.rowChangeListeners, rowChangeListener{
+						subscribe: handler.SubscribeRowChanges,
+						onEvent:   handler.OnRowChangeEvent,
+					})
+				}
 			}
 		}
 	}
@@ -122,6 +153,7 @@ func newSessionExtensions(es *Extensions) *SessionExtensions {
 type SessionExtensions struct {
 	connectionEventFuncs []func(ConnEventTp, *ConnEventInfo)
 	stmtEventFuncs       []func(StmtEventTp, StmtEventInfo)
+	rowChangeListeners   []rowChangeListener
 }
 
 // OnConnectionEvent will be called when a connection event happens
@@ -150,3 +182,76 @@ func (es *SessionExtensions) OnStmtEvent(tp StmtEventTp, event StmtEventInfo) {
 		fn(tp, event)
 	}
 }
+
+// HasRowChangeListeners returns a bool that indicates if any row change listener exists
+func (es *SessionExtensions) HasRowChangeListeners() bool {
+	return es != nil && len(es.rowChangeListeners) > 0
+}
+
+// SubscribeRowChanges returns whether the row changes of the table are subscribed by any listener
+func (es *SessionExtensions) SubscribeRowChanges(db, table string) bool {
+	if es == nil {
+		return false
+	}
+
+	for _, l := range es.rowChangeListeners {
+		if l.subscribe(db, table) {
+			return true
+		}
+	}
+	return false
+}
+
+// OnRowChangeEvent will be called when a transaction changing the subscribed tables is committed.
+// Each listener only receives the row changes of the tables subscribed by itself.
+func (es *SessionExtensions) OnRowChangeEvent(event *RowChangeEvent) {
+	if es == nil {
+		return
+	}
+
+	for _, l := range es.rowChangeListeners {
+		if e := filterRowChangeEvent(event, l.subscribe); e != nil {
+			l.onEvent(e)
+		}
+	}
+}
+
+// filterRowChangeEvent returns the event only containing the row changes of the subscribed tables, nil is returned
+// if no table in the event is subscribed.
+func filterRowChangeEvent(event *RowChangeEvent, subscribe func(db, table string) bool) *RowChangeEvent {
+	if event.Truncated {
+		return event
+	}
+	subscribed := make(map[*rowchange.Table]bool)
+	all := true
+	for _, stmt := range event.Stmts {
+		for _, row := range stmt.Rows {
+			if _, ok := subscribed[row.Table]; !ok {
+				subscribed[row.Table] = subscribe(row.Table.DB, row.Table.Name)
+				all = all && subscribed[row.Table]
+			}
+		}
+	}
+	if all {
+		return event
+	}
+
+	filtered := *event
+	filtered.Stmts = nil
+	for _, stmt := range event.Stmts {
+		s := *stmt
+		s.Rows = nil
+		for _, row := range stmt.Rows {
+			if subscribed[row.Table] {
+				s.Rows = append(s.Rows, row)
+			}
+		}
+		if len(s.Rows) > 0 {
+			filtered.Stmts = append(filtered.Stmts, &s)
+		}
+	}
+	if len(filtered.Stmts) == 0 {
+		return nil
+	}
+	return &filtered
+}
@@ -54,6 +54,12 @@ const (
 	ClusterTableMemoryUsageOpsHistory = "CLUSTER_MEMORY_USAGE_OPS_HISTORY"
 	// ClusterTableTiDBIndexUsage is a table to show the usage stats of indexes across the whole cluster.
 	ClusterTableTiDBIndexUsage = "CLUSTER_TIDB_INDEX_USAGE"
+	// ClusterTablePlanRegressionEvents is the recent plan regressions detected across the whole cluster.
+	ClusterTablePlanRegressionEvents = "CLUSTER_PLAN_REGRESSION_EVENTS"
+	// ClusterTableTiDBTableCacheAdvice is the recent advices made by the table cache advisor across the whole cluster.
+	ClusterTableTiDBTableCacheAdvice = "CLUSTER_TIDB_TABLE_CACHE_ADVICE"
+	// ClusterTableTiDBHotRegionSplitAdvice is the recent advices made by the hot region split advisor across the whole cluster.
+	ClusterTableTiDBHotRegionSplitAdvice = "CLUSTER_TIDB_HOT_REGION_SPLIT_ADVICE"
 )
 
 // memTableToAllTiDBClusterTables means add memory table to cluster table that will send cop request to all TiDB nodes.
@@ -69,6 +75,9 @@ var memTableToAllTiDBClusterTables = map[string]string{
 	TableMemoryUsage:              ClusterTableMemoryUsage,
 	TableMemoryUsageOpsHistory:    ClusterTableMemoryUsageOpsHistory,
 	TableTiDBIndexUsage:           ClusterTableTiDBIndexUsage,
+	TablePlanRegressionEvents:     ClusterTablePlanRegressionEvents,
+	TableTiDBTableCacheAdvice:     ClusterTableTiDBTableCacheAdvice,
+	TableTiDBHotRegionSplitAdvice: ClusterTableTiDBHotRegionSplitAdvice,
 }
 
 // memTableToDDLOwnerClusterTables means add memory table to cluster table that will send cop request to DDL owner node.
@@ -215,6 +215,22 @@ const (
 	TableKeywords = "KEYWORDS"
 	// TableTiDBIndexUsage is a table to show the usage stats of indexes in the current instance.
 	TableTiDBIndexUsage = "TIDB_INDEX_USAGE"
+	// TablePlanRegressionEvents is the recent plan regressions detected in the current instance.
+	TablePlanRegressionEvents = "PLAN_REGRESSION_EVENTS"
+	// TableTiDBTableCacheAdvice is the recent advices made by the table cache advisor in the current instance.
+	TableTiDBTableCacheAdvice = "TIDB_TABLE_CACHE_ADVICE"
+	// TableOptimizerHints is the list of the optimizer hints supported by TiDB.
+	TableOptimizerHints = "OPTIMIZER_HINTS"
+	// TableTiDBSequenceCache is the values cached by the sequences in the current instance and session.
+	TableTiDBSequenceCache = "TIDB_SEQUENCE_CACHE"
+	// TableTiDBTTLTableBacklog is the schedule settings and the progress of the current TTL job of each TTL table.
+	TableTiDBTTLTableBacklog = "TIDB_TTL_TABLE_BACKLOG"
+	// TableTiDBHotRegionSplitAdvice is the recent advices made by the hot region split advisor in the current instance.
+	TableTiDBHotRegionSplitAdvice = "TIDB_HOT_REGION_SPLIT_ADVICE"
+	// TableTableAccessStats is the logical access counts of the tables and the indexes persisted for the whole cluster.
+	TableTableAccessStats = "TABLE_ACCESS_STATS"
+	// TableTiDBDropIndexAdvice is the unused and redundant indexes suggested to be dropped.
+	TableTiDBDropIndexAdvice = "TIDB_DROP_INDEX_ADVICE"
 )
 
 const (
@@ -336,6 +352,17 @@ var tableIDMap = map[string]int64{
 	TableKeywords:                        autoid.InformationSchemaDBID + 92,
 	TableTiDBIndexUsage:                  autoid.InformationSchemaDBID + 93,
 	ClusterTableTiDBIndexUsage:           autoid.InformationSchemaDBID + 94,
+	TablePlanRegressionEvents:            autoid.InformationSchemaDBID + 95,
+	ClusterTablePlanRegressionEvents:     autoid.InformationSchemaDBID + 96,
+	TableTiDBTableCacheAdvice:            autoid.InformationSchemaDBID + 97,
+	ClusterTableTiDBTableCacheAdvice:     autoid.InformationSchemaDBID + 98,
+	TableOptimizerHints:                  autoid.InformationSchemaDBID + 99,
+	TableTiDBSequenceCache:               autoid.InformationSchemaDBID + 100,
+	TableTiDBTTLTableBacklog:             autoid.InformationSchemaDBID + 101,
+	TableTiDBHotRegionSplitAdvice:        autoid.InformationSchemaDBID + 102,
+	ClusterTableTiDBHotRegionSplitAdvice: autoid.InformationSchemaDBID + 103,
+	TableTableAccessStats:                autoid.InformationSchemaDBID + 104,
+	TableTiDBDropIndexAdvice:             autoid.InformationSchemaDBID + 105,
 }
 
 // columnInfo represents the basic column information of all kinds of INFORMATION_SCHEMA tables
@@ -854,6 +881,7 @@ var tableProcesslistCols = []columnInfo{
 	{name: "TxnStart", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag, deflt: ""},
 	{name: "RESOURCE_GROUP", tp: mysql.TypeVarchar, size: resourcegroup.MaxGroupNameLength, flag: mysql.NotNullFlag, deflt: ""},
 	{name: "SESSION_ALIAS", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag, deflt: ""},
+	{name: "APP_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag, deflt: ""},
 }
 
 var tableTiDBIndexesCols = []columnInfo{
@@ -1284,6 +1312,35 @@ var tableSequencesCols = []columnInfo{
 	{name: "COMMENT", tp: mysql.TypeVarchar, size: 64},
 }
 
+var tableTiDBSequenceCacheCols = []columnInfo{
+	{name: "SEQUENCE_SCHEMA", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "SEQUENCE_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "SCOPE", tp: mysql.TypeVarchar, size: 8, flag: mysql.NotNullFlag},
+	{name: "NEXT_VALUE", tp: mysql.TypeLonglong, size: 21},
+	{name: "LAST_VALUE", tp: mysql.TypeLonglong, size: 21},
+	{name: "REMAINING", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag},
+}
+
+var tableTiDBTTLTableBacklogCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "PARTITION_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "TABLE_ID", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "JOB_WINDOW_START", tp: mysql.TypeVarchar, size: 32, flag: mysql.NotNullFlag},
+	{name: "JOB_WINDOW_END", tp: mysql.TypeVarchar, size: 32, flag: mysql.NotNullFlag},
+	{name: "IN_JOB_WINDOW", tp: mysql.TypeVarchar, size: 3, flag: mysql.NotNullFlag},
+	{name: "DELETE_RATE_LIMIT", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag},
+	{name: "RESOURCE_GROUP", tp: mysql.TypeVarchar, size: 64},
+	{name: "CURRENT_JOB_ID", tp: mysql.TypeVarchar, size: 64},
+	{name: "CURRENT_JOB_STATUS", tp: mysql.TypeVarchar, size: 64},
+	{name: "CURRENT_JOB_START_TIME", tp: mysql.TypeDatetime, size: 19},
+	{name: "WAITING_TASKS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag},
+	{name: "RUNNING_TASKS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag},
+	{name: "SCANNED_ROWS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag},
+	{name: "DELETED_ROWS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag},
+	{name: "LAST_JOB_FINISH_TIME", tp: mysql.TypeDatetime, size: 19},
+}
+
 var tableStatementsSummaryCols = []columnInfo{
 	{name: stmtsummary.SummaryBeginTimeStr, tp: mysql.TypeTimestamp, size: 26, flag: mysql.NotNullFlag, comment: "Begin time of this summary"},
 	{name: stmtsummary.SummaryEndTimeStr, tp: mysql.TypeTimestamp, size: 26, flag: mysql.NotNullFlag, comment: "End time of this summary"},
@@ -1387,8 +1444,12 @@ var tableStatementsSummaryCols = []columnInfo{
 	{name: stmtsummary.MaxQueuedRcTimeStr, tp: mysql.TypeLonglong, size: 22, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "Max time of waiting for available request-units"},
 	{name: stmtsummary.AvgQueuedRcTimeStr, tp: mysql.TypeLonglong, size: 22, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "Max time of waiting for available request-units"},
 	{name: stmtsummary.ResourceGroupName, tp: mysql.TypeVarchar, size: 64, comment: "Bind resource group name"},
+	{name: stmtsummary.AppNameStr, tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag, comment: "The program_name connection attribute of the sessions"},
 	{name: stmtsummary.PlanCacheUnqualifiedStr, tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag, comment: "The number of times that these statements are not supported by the plan cache"},
 	{name: stmtsummary.LastPlanCacheUnqualifiedStr, tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "The last reason why the statement is not supported by the plan cache"},
+	{name: stmtsummary.PlanBindingHitsStr, tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag, comment: "The number of times these statements are executed with the hints in a binding"},
+	{name: stmtsummary.LastBindingSQLStr, tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "The bind SQL of the binding used by the last statement, it's empty if no binding is used"},
+	{name: stmtsummary.LastAppliedHintsStr, tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "The hints applied to the last statement, from the binding or the statement itself"},
 }
 
 var tableStorageStatsCols = []columnInfo{
@@ -1551,6 +1612,9 @@ var tableDeadlocksCols = []columnInfo{
 	{name: deadlockhistory.ColKeyStr, tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "The key on which a transaction is waiting for another"},
 	{name: deadlockhistory.ColKeyInfoStr, tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "Information of the key"},
 	{name: deadlockhistory.ColTrxHoldingLockStr, tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "The transaction ID (start ts) of the transaction that's currently holding the lock"},
+	{name: deadlockhistory.ColCurrentPlanDigestStr, tp: mysql.TypeVarchar, size: 64, comment: "The digest of the plan of the SQL that's being blocked"},
+	{name: deadlockhistory.ColCurrentBindingSQLStr, tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "The binding used to generate the plan of the SQL that's being blocked"},
+	{name: deadlockhistory.ColCurrentAppliedHintsStr, tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "The hints applied to the plan of the SQL that's being blocked, from the binding or the SQL itself"},
 }
 
 var tableDataLockWaitsCols = []columnInfo{
@@ -1684,6 +1748,14 @@ var tableKeywords = []columnInfo{
 	{name: "RESERVED", tp: mysql.TypeLong, size: 11},
 }
 
+var tableOptimizerHintsCols = []columnInfo{
+	{name: "HINT_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "ARGUMENTS", tp: mysql.TypeVarchar, size: 256},
+	{name: "SCOPE", tp: mysql.TypeVarchar, size: 16, flag: mysql.NotNullFlag},
+	{name: "ALLOWED_IN_BINDING", tp: mysql.TypeVarchar, size: 3, flag: mysql.NotNullFlag},
+	{name: "DESCRIPTION", tp: mysql.TypeVarchar, size: 512},
+}
+
 var tableTiDBIndexUsage = []columnInfo{
 	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64},
 	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64},
@@ -1701,6 +1773,75 @@ var tableTiDBIndexUsage = []columnInfo{
 	{name: "LAST_ACCESS_TIME", tp: mysql.TypeDatetime, size: 21},
 }
 
+var tablePlanRegressionEventsCols = []columnInfo{
+	{name: "TIME", tp: mysql.TypeTimestamp, size: 26, decimal: 6, flag: mysql.NotNullFlag, comment: "The time when the plan regression is detected"},
+	{name: "DIGEST", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "DIGEST_TEXT", tp: mysql.TypeBlob, size: types.UnspecifiedLength, flag: mysql.NotNullFlag, comment: "Normalized statement"},
+	{name: "SCHEMA_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "OLD_PLAN_DIGEST", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "NEW_PLAN_DIGEST", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "OLD_EXEC_COUNT", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag | mysql.UnsignedFlag},
+	{name: "NEW_EXEC_COUNT", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag | mysql.UnsignedFlag},
+	{name: "OLD_AVG_LATENCY", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "Average latency of the old plan"},
+	{name: "NEW_AVG_LATENCY", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "Average latency of the new plan"},
+	{name: "OLD_P90_LATENCY", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "Approximate 90th percentile latency of the old plan"},
+	{name: "NEW_P90_LATENCY", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "Approximate 90th percentile latency of the new plan"},
+	{name: "OLD_MAX_LATENCY", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "Max latency of the old plan"},
+	{name: "NEW_MAX_LATENCY", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "Max latency of the new plan"},
+	{name: "SHADOW_BINDING", tp: mysql.TypeVarchar, size: 16, comment: "Status of the disabled binding created for the old plan"},
+}
+
+var tableTiDBTableCacheAdviceCols = []columnInfo{
+	{name: "TIME", tp: mysql.TypeTimestamp, size: 26, decimal: 6, flag: mysql.NotNullFlag, comment: "The time when the advice is made"},
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "READ_COUNT", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag, comment: "Read count of the table in the statements summary"},
+	{name: "WRITE_COUNT", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag, comment: "Write count of the table in the statements summary"},
+	{name: "TABLE_SIZE", tp: mysql.TypeLonglong, size: 20, flag: mysql.NotNullFlag, comment: "Estimated data and index size of the table"},
+	{name: "ADVICE", tp: mysql.TypeVarchar, size: 16, flag: mysql.NotNullFlag, comment: "CACHE or NOCACHE"},
+	{name: "STATUS", tp: mysql.TypeVarchar, size: 16, flag: mysql.NotNullFlag, comment: "Whether the advice is applied"},
+}
+
+var tableTiDBHotRegionSplitAdviceCols = []columnInfo{
+	{name: "TIME", tp: mysql.TypeTimestamp, size: 26, decimal: 6, flag: mysql.NotNullFlag, comment: "The time when the advice is made"},
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "PARTITION_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "INDEX_NAME", tp: mysql.TypeVarchar, size: 64},
+	{name: "REGION_ID", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag},
+	{name: "FLOW_BYTES", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "Written bytes per second of the hot region"},
+	{name: "SPLIT_REGIONS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag, comment: "Number of regions the hot region is suggested to be split into"},
+	{name: "SPLIT_SQL", tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "The statement to split and scatter the hot region"},
+	{name: "NOTE", tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "Why the hot region can't be pre-split"},
+	{name: "SQL_DIGESTS", tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "Digests of the most frequent write statements on the table"},
+	{name: "STATUS", tp: mysql.TypeVarchar, size: 16, flag: mysql.NotNullFlag, comment: "Whether the advice is applied"},
+}
+
+var tableTableAccessStatsCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "INDEX_NAME", tp: mysql.TypeVarchar, size: 64, comment: "NULL for the accesses to the rows of the table"},
+	{name: "SCANS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "Number of the full or range scans in the executed plans"},
+	{name: "POINT_GETS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag, comment: "Number of the point gets and batch point gets in the executed plans"},
+	{name: "LAST_ACCESS_TIME", tp: mysql.TypeTimestamp, size: 26, decimal: 6},
+}
+
+var tableTiDBDropIndexAdviceCols = []columnInfo{
+	{name: "TABLE_SCHEMA", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "TABLE_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "INDEX_NAME", tp: mysql.TypeVarchar, size: 64, flag: mysql.NotNullFlag},
+	{name: "REASON", tp: mysql.TypeVarchar, size: 32, flag: mysql.NotNullFlag, comment: "UNUSED, REDUNDANT or both"},
+	{name: "COVERING_INDEX", tp: mysql.TypeVarchar, size: 64, comment: "The index which makes the index redundant"},
+	{name: "SCANS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag},
+	{name: "POINT_GETS", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag | mysql.UnsignedFlag},
+	{name: "LAST_ACCESS_TIME", tp: mysql.TypeTimestamp, size: 26, decimal: 6},
+	{name: "AFFECTED_DIGESTS", tp: mysql.TypeBlob, size: types.UnspecifiedLength, comment: "Digests of the statements in the statement summary whose plans use the index"},
+	{name: "COST_INCREASE", tp: mysql.TypeDouble, size: 22, comment: "Max ratio of the estimated cost of the affected statements without the index to the one with the index"},
+	{name: "RISK_SCORE", tp: mysql.TypeLonglong, size: 21, flag: mysql.NotNullFlag, comment: "Risk of dropping the index, from 0 to 100"},
+	{name: "DROP_SQL", tp: mysql.TypeBlob, size: types.UnspecifiedLength, flag: mysql.NotNullFlag},
+	{name: "NOTE", tp: mysql.TypeBlob, size: types.UnspecifiedLength},
+}
+
 // GetShardingInfo returns a nil or description string for the sharding information of given TableInfo.
 // The returned description string may be:
 //   - "NOT_SHARDED": for tables that SHARD_ROW_ID_BITS is not specified.
@@ -2347,6 +2488,14 @@ var tableNameToColumns = map[string][]columnInfo{
 	TableTiDBCheckConstraints:               tableTiDBCheckConstraintsCols,
 	TableKeywords:                           tableKeywords,
 	TableTiDBIndexUsage:                     tableTiDBIndexUsage,
+	TablePlanRegressionEvents:               tablePlanRegressionEventsCols,
+	TableTiDBTableCacheAdvice:               tableTiDBTableCacheAdviceCols,
+	TableOptimizerHints:                     tableOptimizerHintsCols,
+	TableTiDBSequenceCache:                  tableTiDBSequenceCacheCols,
+	TableTiDBTTLTableBacklog:                tableTiDBTTLTableBacklogCols,
+	TableTiDBHotRegionSplitAdvice:           tableTiDBHotRegionSplitAdviceCols,
+	TableTableAccessStats:                   tableTableAccessStatsCols,
+	TableTiDBDropIndexAdvice:                tableTiDBDropIndexAdviceCols,
 }
 
 func createInfoSchemaTable(_ autoid.Allocators, meta *model.TableInfo) (table.Table, error) {
@@ -325,7 +325,7 @@ select * from t3;
 	tk.MustQuery("select count(*) from `CLUSTER_SLOW_QUERY`").Check(testkit.Rows("4"))
 	tk.MustQuery("select count(*) from `SLOW_QUERY`").Check(testkit.Rows("4"))
 	tk.MustQuery("select count(*) from `CLUSTER_PROCESSLIST`").Check(testkit.Rows("1"))
-	tk.MustQuery("select * from `CLUSTER_PROCESSLIST`").Check(testkit.Rows(fmt.Sprintf(":10080 1 root 127.0.0.1 <nil> Query 9223372036 %s <nil>  0 0   ", "")))
+	tk.MustQuery("select * from `CLUSTER_PROCESSLIST`").Check(testkit.Rows(fmt.Sprintf(":10080 1 root 127.0.0.1 <nil> Query 9223372036 %s <nil>  0 0    ", "")))
 	tk.MustExec("create user user1")
 	tk.MustExec("create user user2")
 	user1 := testkit.NewTestKit(t, s.store)
@@ -1657,6 +1657,65 @@ func testIndexUsageTable(t *testing.T, clusterTable bool) {
 		"1 20 0 0 0 0 1 0 0"))
 }
 
+func TestAdminRecommendHints(t *testing.T) {
+	s := new(clusterTablesSuite)
+	s.store, s.dom = testkit.CreateMockStoreAndDomain(t)
+	s.rpcserver, s.listenAddr = s.setUpRPCService(t, "127.0.0.1:0", nil)
+	s.httpServer, s.mockAddr = s.setUpMockPDHTTPServer()
+	s.startTime = time.Now()
+	defer s.httpServer.Close()
+	defer s.rpcserver.Stop()
+	tk := s.newTestKitWithRoot(t)
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, c int, key(a), key(b))")
+	stmtsummary.StmtSummaryByDigestMap.Clear()
+
+	badSQL := "select /*+ use_index(t, a) */ * from t where a = 1 and b = 1"
+	goodSQL := "select /*+ use_index(t, b) */ * from t where a = 2 and b = 2"
+	tk.MustExec(badSQL)
+	tk.MustExec(goodSQL)
+	tk.MustExec("select * from t where c = 1")
+	badPlanDigest := tk.MustQuery(fmt.Sprintf("select plan_digest from information_schema.statements_summary where query_sample_text = '%s'", badSQL)).Rows()[0][0].(string)
+	goodPlanDigest := tk.MustQuery(fmt.Sprintf("select plan_digest from information_schema.statements_summary where query_sample_text = '%s'", goodSQL)).Rows()[0][0].(string)
+	otherPlanDigest := tk.MustQuery("select plan_digest from information_schema.statements_summary where query_sample_text = 'select * from t where c = 1'").Rows()[0][0].(string)
+
+	rows := tk.MustQuery(fmt.Sprintf("admin recommend hints from plan digest '%s' to '%s'", badPlanDigest, goodPlanDigest)).Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, "use_index(@`sel_1` `test`.`t` `b`), no_order_index(@`sel_1` `test`.`t` `b`)", rows[0][0])
+	require.Equal(t, "CREATE GLOBAL BINDING FOR SELECT * FROM `test`.`t` WHERE `a` = 2 AND `b` = 2 USING SELECT /*+ use_index(@`sel_1` `test`.`t` `b`), no_order_index(@`sel_1` `test`.`t` `b`)*/ * FROM `test`.`t` WHERE `a` = 2 AND `b` = 2", rows[0][1])
+
+	// The recommended binding leads the statement to the good plan.
+	tk.MustExec(rows[0][1].(string))
+	tk.MustExec("select * from t where a = 3 and b = 3")
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+
+	// The hints shared by the two plans are absent in the recommended hints but kept in the binding.
+	tk.MustExec("create table t2(a int, b int, key(a))")
+	badSQL = "select /*+ hash_join(t, t2), use_index(t, a) */ * from t join t2 on t.c = t2.b where t.a = 1 and t.b = 1"
+	goodSQL = "select /*+ hash_join(t, t2), use_index(t, b) */ * from t join t2 on t.c = t2.b where t.a = 2 and t.b = 2"
+	tk.MustExec(badSQL)
+	tk.MustExec(goodSQL)
+	badPlanDigest = tk.MustQuery(fmt.Sprintf("select plan_digest from information_schema.statements_summary where query_sample_text = '%s'", badSQL)).Rows()[0][0].(string)
+	goodPlanDigest = tk.MustQuery(fmt.Sprintf("select plan_digest from information_schema.statements_summary where query_sample_text = '%s'", goodSQL)).Rows()[0][0].(string)
+	rows = tk.MustQuery(fmt.Sprintf("admin recommend hints from plan digest '%s' to '%s'", badPlanDigest, goodPlanDigest)).Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, "use_index(@`sel_1` `test`.`t` `b`), no_order_index(@`sel_1` `test`.`t` `b`)", rows[0][0])
+	require.Equal(t, "CREATE GLOBAL BINDING FOR SELECT * FROM `test`.`t` JOIN `test`.`t2` ON `t`.`c` = `t2`.`b` WHERE `t`.`a` = 2 AND `t`.`b` = 2 USING SELECT /*+ hash_join(@`sel_1` `test`.`t`), use_index(@`sel_1` `test`.`t` `b`), no_order_index(@`sel_1` `test`.`t` `b`), use_index(@`sel_1` `test`.`t2` )*/ * FROM `test`.`t` JOIN `test`.`t2` ON `t`.`c` = `t2`.`b` WHERE `t`.`a` = 2 AND `t`.`b` = 2", rows[0][1])
+	tk.MustExec(rows[0][1].(string))
+	tk.MustExec("select * from t join t2 on t.c = t2.b where t.a = 3 and t.b = 3")
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+
+	// Error cases.
+	tk.MustGetErrMsg(fmt.Sprintf("admin recommend hints from plan digest '%s' to '%s'", goodPlanDigest, goodPlanDigest),
+		fmt.Sprintf("no hint can distinguish plan digest '%s' from '%s'", goodPlanDigest, goodPlanDigest))
+	tk.MustGetErrMsg(fmt.Sprintf("admin recommend hints from plan digest '%s' to '%s'", badPlanDigest, otherPlanDigest),
+		fmt.Sprintf("plan digest '%s' and '%s' do not belong to the same statement", badPlanDigest, otherPlanDigest))
+	tk.MustGetErrMsg(fmt.Sprintf("admin recommend hints from plan digest 'not_exist' to '%s'", goodPlanDigest),
+		"can't find any plans for 'not_exist'")
+}
+
 func TestIndexUsageTable(t *testing.T) {
 	testIndexUsageTable(t, false)
 }
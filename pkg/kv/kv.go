@@ -587,6 +587,9 @@ type Request struct {
 	RequestSource util.RequestSource
 	// StoreBatchSize indicates the batch size of coprocessor in the same store.
 	StoreBatchSize int
+	// AdaptiveConcurrency indicates whether to tune the Concurrency by the regions and stores the request touches and
+	// the current load of the instance.
+	AdaptiveConcurrency bool
 	// ResourceGroupName is the name of the bind resource group.
 	ResourceGroupName string
 	// LimitSize indicates whether the request is scan and limit
@@ -595,6 +598,11 @@ type Request struct {
 	StoreBusyThreshold time.Duration
 	// TiKVClientReadTimeout is the timeout of kv read request
 	TiKVClientReadTimeout uint64
+	// MaxRegionErrorRetries is the max number of times the coprocessor requests are retried on the region errors, -1
+	// means no limit. It only takes effect when RegionErrorRetries isn't nil.
+	MaxRegionErrorRetries int
+	// RegionErrorRetries counts the retries on the region errors, it's shared by all the requests of a statement.
+	RegionErrorRetries *atomic.Int64
 
 	RunawayChecker *resourcegroup.RunawayChecker
 
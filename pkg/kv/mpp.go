@@ -140,6 +140,8 @@ const (
 	MppTaskCancelled
 	// MppTaskDone means the task is done
 	MppTaskDone
+	// MppTaskFailed means the task is failed
+	MppTaskFailed
 )
 
 // MPPDispatchRequest stands for a dispatching task.
@@ -233,6 +235,8 @@ type MppCoordinator interface {
 type MPPBuildTasksRequest struct {
 	KeyRanges []KeyRange
 	StartTS   uint64
+	// IsStaleness indicates whether the request reads the staleness data at StartTS.
+	IsStaleness bool
 
 	PartitionIDAndRanges []PartitionIDAndRanges
 }
@@ -114,6 +114,9 @@ const (
 	SizeLimits
 	// SessionID marks the connection id, for logging and tracing.
 	SessionID
+	// PipelinedFlushBatchSize sets the max number of mutations buffered by a pipelined transaction
+	// before they are flushed to TiKV. 0 means to use the default flush strategy of the storage.
+	PipelinedFlushBatchSize
 )
 
 // TxnSizeLimits is the argument type for `SizeLimits` option
@@ -140,6 +143,9 @@ const (
 	ReplicaReadLearner
 	// ReplicaReadPreferLeader stands for 'read from leader and auto-turn to followers if leader is abnormal'.
 	ReplicaReadPreferLeader
+	// ReplicaReadHedged stands for 'read from leader and send a backup request to follower if the leader doesn't respond
+	// within an adaptive threshold'. It only takes effect on coprocessor requests.
+	ReplicaReadHedged
 )
 
 // IsFollowerRead checks if follower is going to be used to read data.
@@ -22,11 +22,13 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"slices"
 	"sync"
 
 	"github.com/cockroachdb/pebble"
 	"github.com/docker/go-units"
 	"github.com/google/btree"
+	"github.com/google/uuid"
 	"github.com/pingcap/errors"
 	"github.com/pingcap/kvproto/pkg/errorpb"
 	"github.com/pingcap/kvproto/pkg/import_sstpb"
@@ -1151,6 +1153,101 @@ func (local *DupeController) ResolveDuplicateRows(ctx context.Context, tbl table
 	return errors.Trace(err)
 }
 
+// IterLocalDuplicateKVs iterates all duplicate key-value pairs recorded in the
+// local duplicate db, pairs of the same key are iterated in the order they are
+// encoded, and the first one of them is the pair ingested into TiKV. The
+// iterated pairs are removed from the duplicate db if fn succeeds on all of them.
+func (local *DupeController) IterLocalDuplicateKVs(ctx context.Context, fn func(key, val []byte) error) (err error) {
+	iter, err := local.duplicateDB.NewIter(&pebble.IterOptions{})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var firstRawKey, lastRawKey []byte
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		var key []byte
+		key, err = local.keyAdapter.Decode(nil, iter.Key())
+		if err != nil {
+			break
+		}
+		if err = fn(key, slices.Clone(iter.Value())); err != nil {
+			break
+		}
+		if firstRawKey == nil {
+			firstRawKey = slices.Clone(iter.Key())
+		}
+		lastRawKey = append(lastRawKey[:0], iter.Key()...)
+	}
+	if err == nil {
+		err = iter.Error()
+	}
+	if closeErr := iter.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil || firstRawKey == nil {
+		return errors.Trace(err)
+	}
+	return errors.Trace(local.duplicateDB.DeleteRange(firstRawKey, append(lastRawKey, 0), pebble.Sync))
+}
+
+// IterEngineDuplicateKVs iterates the key-value pairs of the closed engine whose
+// keys are duplicated, pairs of the same key are iterated in the order they are
+// encoded. The engine must be opened with duplicate detection, otherwise pairs
+// of the same key have overwritten each other.
+func (local *Backend) IterEngineDuplicateKVs(ctx context.Context, engineUUID uuid.UUID, fn func(key, val []byte) error) (err error) {
+	e := local.engineMgr.rLockEngine(engineUUID)
+	if e == nil {
+		return errors.Errorf("engine '%s' not found", engineUUID)
+	}
+	defer e.rUnlock()
+	if !e.duplicateDetection {
+		return errors.Errorf("engine '%s' is not opened with duplicate detection", engineUUID)
+	}
+	iter, err := e.getDB().NewIter(&pebble.IterOptions{
+		LowerBound: e.keyAdapter.Encode(nil, normalIterStartKey, common.MinRowID),
+	})
+	if err != nil {
+		return errors.Trace(err)
+	}
+	var (
+		prevKey, prevVal []byte
+		prevIterated     bool
+	)
+	for iter.First(); iter.Valid(); iter.Next() {
+		if err = ctx.Err(); err != nil {
+			break
+		}
+		var key []byte
+		key, err = e.keyAdapter.Decode(nil, iter.Key())
+		if err != nil {
+			break
+		}
+		val := slices.Clone(iter.Value())
+		if prevKey != nil && bytes.Equal(prevKey, key) {
+			if !prevIterated {
+				if err = fn(prevKey, prevVal); err != nil {
+					break
+				}
+				prevIterated = true
+			}
+			if err = fn(key, val); err != nil {
+				break
+			}
+			continue
+		}
+		prevKey, prevVal, prevIterated = key, val, false
+	}
+	if err == nil {
+		err = iter.Error()
+	}
+	if closeErr := iter.Close(); err == nil {
+		err = closeErr
+	}
+	return errors.Trace(err)
+}
+
 func (local *DupeController) getLatestValue(
 	ctx context.Context,
 	logger *log.Task,
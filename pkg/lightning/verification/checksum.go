@@ -66,6 +66,17 @@ func (c *KVChecksum) UpdateOne(kv common.KvPair) {
 	c.checksum ^= sum
 }
 
+// RemoveOne removes a single key-value pair, which is updated into the
+// checksum before, from the checksum.
+func (c *KVChecksum) RemoveOne(kv common.KvPair) {
+	sum := crc64.Update(c.base, ecmaTable, kv.Key)
+	sum = crc64.Update(sum, ecmaTable, kv.Val)
+
+	c.bytes -= uint64(c.prefixLen + len(kv.Key) + len(kv.Val))
+	c.kvs--
+	c.checksum ^= sum
+}
+
 // Update updates the checksum with a batch of key-value pairs.
 func (c *KVChecksum) Update(kvs []common.KvPair) {
 	var (
@@ -169,6 +180,17 @@ func (c *KVGroupChecksum) UpdateOneIndexKV(indexID int64, kv common.KvPair) {
 	cksum.UpdateOne(kv)
 }
 
+// RemoveOneDataKV removes a single data(record) key-value pair from the
+// checksum.
+func (c *KVGroupChecksum) RemoveOneDataKV(kv common.KvPair) {
+	c.m[DataKVGroupID].RemoveOne(kv)
+}
+
+// RemoveOneIndexKV removes a single index key-value pair from the checksum.
+func (c *KVGroupChecksum) RemoveOneIndexKV(indexID int64, kv common.KvPair) {
+	c.getOrCreateOneGroup(indexID).RemoveOne(kv)
+}
+
 // Add adds the checksum of another KVGroupChecksum.
 func (c *KVGroupChecksum) Add(other *KVGroupChecksum) {
 	for id, cksum := range other.m {
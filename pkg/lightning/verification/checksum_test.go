@@ -63,6 +63,14 @@ func TestChecksum(t *testing.T) {
 	require.Equal(t, kvBytes<<1, checksum.SumSize())
 	require.Equal(t, uint64(len(kvs))<<1, checksum.SumKVS())
 	require.NotEqual(t, excpectChecksum, checksum.Sum())
+
+	// remove the recomputed key-value
+	for _, kv := range kvs {
+		checksum.RemoveOne(kv)
+	}
+	require.Equal(t, kvBytes, checksum.SumSize())
+	require.Equal(t, uint64(len(kvs)), checksum.SumKVS())
+	require.Equal(t, excpectChecksum, checksum.Sum())
 }
 
 func TestChecksumJSON(t *testing.T) {
@@ -117,4 +125,13 @@ func TestGroupChecksum(t *testing.T) {
 	merged := c.MergedChecksum()
 	require.Equal(t, uint64(4), merged.SumKVS())
 	require.Equal(t, uint64(28), merged.SumSize())
+
+	c.RemoveOneDataKV(kvPair)
+	c.RemoveOneIndexKV(2, kvPair2)
+	inner = c.GetInnerChecksums()
+	require.Equal(t, uint64(0), inner[verification.DataKVGroupID].SumKVS())
+	require.Equal(t, uint64(0), inner[verification.DataKVGroupID].Sum())
+	require.Equal(t, uint64(0), inner[2].SumKVS())
+	require.Equal(t, uint64(0), inner[2].SumSize())
+	require.Equal(t, uint64(2), inner[1].SumKVS())
 }
@@ -69,6 +69,6 @@ const (
 	AutoRandomInvalidRangeBits = "auto_random range bits must be between %d and %d, but got %d"
 	// AutoRandomIncrementalBitsTooSmall is reported when the auto_random available use space is too small.
 	AutoRandomIncrementalBitsTooSmall = "auto_random ID space is too small, please decrease the shard bits or increase the range bits"
-	// AutoRandomUnsupportedAlterRangeBits is reported when the auto_random range_bits is changed.
-	AutoRandomUnsupportedAlterRangeBits = "alter the range bits of auto_random column is not supported"
+	// AutoRandomAlterIDSpaceOverflow is reported when the incremental bits of the new layout can't cover the existing IDs.
+	AutoRandomAlterIDSpaceOverflow = "the incremental bits of the new auto_random layout must be more than the %d bits of the existing IDs, but got %d"
 )
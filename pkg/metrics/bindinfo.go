@@ -23,6 +23,8 @@ var (
 	BindingCacheMemUsage    prometheus.Gauge
 	BindingCacheMemLimit    prometheus.Gauge
 	BindingCacheNumBindings prometheus.Gauge
+
+	PlanRegressionCounter *prometheus.CounterVec
 )
 
 // InitBindInfoMetrics initializes bindinfo metrics.
@@ -62,4 +64,11 @@ func InitBindInfoMetrics() {
 			Name:      "binding_cache_num_bindings",
 			Help:      "Number of bindings in binding cache.",
 		})
+	PlanRegressionCounter = NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tidb",
+			Subsystem: "server",
+			Name:      "plan_regression_total",
+			Help:      "Counter of detected plan regressions and the shadow bindings created for them.",
+		}, []string{LblType})
 }
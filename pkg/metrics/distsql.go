@@ -27,6 +27,7 @@ var (
 	DistSQLCoprCacheCounter         *prometheus.CounterVec
 	DistSQLCoprClosestReadCounter   *prometheus.CounterVec
 	DistSQLCoprRespBodySize         *prometheus.HistogramVec
+	DistSQLCoprHedgedReadCounter    *prometheus.CounterVec
 )
 
 // InitDistSQLMetrics initializes distsql metrics.
@@ -75,6 +76,14 @@ func InitDistSQLMetrics() {
 			Help:      "coprocessor cache hit, evict and miss number",
 		}, []string{LblType})
 
+	DistSQLCoprHedgedReadCounter = NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "tidb",
+			Subsystem: "distsql",
+			Name:      "copr_hedged_read",
+			Help:      "counter of copr requests in hedged replica read, type can be total/backup/backup_won.",
+		}, []string{LblType})
+
 	DistSQLCoprClosestReadCounter = NewCounterVec(
 		prometheus.CounterOpts{
 			Namespace: "tidb",
@@ -70,6 +70,7 @@ var (
 	CPUProfileCounter               prometheus.Counter
 	LoadTableCacheDurationHistogram prometheus.Histogram
 	RCCheckTSWriteConfilictCounter  *prometheus.CounterVec
+	RCReadTSReuseCounter            prometheus.Counter
 	MemoryLimit                     prometheus.Gauge
 )
 
@@ -375,6 +376,14 @@ func InitServerMetrics() {
 			Help:      "Counter of WriteConflict caused by RCCheckTS.",
 		}, []string{LblType})
 
+	RCReadTSReuseCounter = NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "tidb",
+			Subsystem: "server",
+			Name:      "rc_read_ts_reuse_total",
+			Help:      "Counter of the read-committed statements which reuse the latest fetched tso.",
+		})
+
 	MemoryLimit = prometheus.NewGauge(
 		prometheus.GaugeOpts{
 			Namespace: "tidb",
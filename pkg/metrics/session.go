@@ -39,6 +39,7 @@ var (
 	PessimisticDMLDurationByAttempt    *prometheus.HistogramVec
 	ResourceGroupQueryTotalCounter     *prometheus.CounterVec
 	FairLockingUsageCount              *prometheus.CounterVec
+	FairLockingConflictKeysCount       prometheus.Histogram
 )
 
 // InitSessionMetrics initializes session metrics.
@@ -227,6 +228,15 @@ func InitSessionMetrics() {
 			Name:      "transaction_fair_locking_usage",
 			Help:      "The counter of statements and transactions in which fair locking is used or takes effect",
 		}, []string{LblType})
+
+	FairLockingConflictKeysCount = NewHistogram(
+		prometheus.HistogramOpts{
+			Namespace: "tidb",
+			Subsystem: "session",
+			Name:      "transaction_fair_locking_conflict_keys",
+			Help:      "Keys locked with conflict for a single statement using fair locking, which reflects the depth of the lock wait queue on the hot rows",
+			Buckets:   prometheus.ExponentialBuckets(1, 2, 16), // 1 ~ 32768
+		})
 }
 
 // Label constants.
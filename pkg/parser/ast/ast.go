@@ -213,6 +213,8 @@ func GetStmtLabel(stmtNode StmtNode) string {
 			return "Replace"
 		}
 		return "Insert"
+	case *ExportTableStmt:
+		return "ExportTable"
 	case *ImportIntoStmt:
 		return "ImportInto"
 	case *LoadDataStmt:
@@ -1158,6 +1158,29 @@ func (n *CreateTableStmt) Restore(ctx *format.RestoreCtx) error {
 	return nil
 }
 
+// SecureText implements SensitiveStmtNode interface.
+// The credentials in the EXTERNAL_LOCATION of external tables are redacted.
+func (n *CreateTableStmt) SecureText() string {
+	redacted := false
+	redactedStmt := *n
+	redactedStmt.Options = make([]*TableOption, 0, len(n.Options))
+	for _, opt := range n.Options {
+		if opt.Tp == TableOptionExternalLocation {
+			redactedOpt := *opt
+			redactedOpt.StrValue = RedactURL(opt.StrValue)
+			opt = &redactedOpt
+			redacted = true
+		}
+		redactedStmt.Options = append(redactedStmt.Options, opt)
+	}
+	if !redacted {
+		return n.Text()
+	}
+	var sb strings.Builder
+	_ = redactedStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb))
+	return sb.String()
+}
+
 // Accept implements Node Accept interface.
 func (n *CreateTableStmt) Accept(v Visitor) (Node, bool) {
 	newNode, skipChildren := v.Enter(n)
@@ -1506,6 +1529,8 @@ type CreateViewStmt struct {
 	Definer     *auth.UserIdentity
 	Security    model.ViewSecurity
 	CheckOption model.ViewCheckOption
+	// Hints is the hint set of `WITH HINTS`, which is merged into the view definition when the view is expanded.
+	Hints string
 }
 
 // Restore implements Node interface.
@@ -1551,6 +1576,11 @@ func (n *CreateViewStmt) Restore(ctx *format.RestoreCtx) error {
 		}
 	}
 
+	if n.Hints != "" {
+		ctx.WriteKeyWord(" WITH HINTS ")
+		ctx.WriteString(n.Hints)
+	}
+
 	ctx.WriteKeyWord(" AS ")
 
 	if err := n.Select.Restore(ctx); err != nil {
@@ -1629,6 +1659,214 @@ func (n *CreatePlacementPolicyStmt) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// CreateRowPolicyStmt is a statement to create a row-level security policy on a table.
+// See https://www.postgresql.org/docs/current/sql-createpolicy.html
+type CreateRowPolicyStmt struct {
+	ddlNode
+
+	IfNotExists bool
+	PolicyName  model.CIStr
+	Table       *TableName
+	// Roles are the users and roles the policy applies to, an empty list means all users.
+	Roles []*auth.RoleIdentity
+	Expr  ExprNode
+}
+
+// Restore implements Node interface.
+func (n *CreateRowPolicyStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE POLICY ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	ctx.WriteName(n.PolicyName.O)
+	ctx.WriteKeyWord(" ON ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateRowPolicyStmt.Table")
+	}
+	if len(n.Roles) > 0 {
+		ctx.WriteKeyWord(" TO ")
+		for i, role := range n.Roles {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			if err := role.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore CreateRowPolicyStmt.Roles[%d]", i)
+			}
+		}
+	}
+	ctx.WriteKeyWord(" USING ")
+	ctx.WritePlain("(")
+	if err := n.Expr.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateRowPolicyStmt.Expr")
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateRowPolicyStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateRowPolicyStmt)
+	node, ok := n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	node, ok = n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	return v.Leave(n)
+}
+
+// DropRowPolicyStmt is a statement to drop a row-level security policy of a table.
+type DropRowPolicyStmt struct {
+	ddlNode
+
+	IfExists   bool
+	PolicyName model.CIStr
+	Table      *TableName
+}
+
+// Restore implements Node interface.
+func (n *DropRowPolicyStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP POLICY ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	ctx.WriteName(n.PolicyName.O)
+	ctx.WriteKeyWord(" ON ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DropRowPolicyStmt.Table")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropRowPolicyStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropRowPolicyStmt)
+	node, ok := n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	return v.Leave(n)
+}
+
+// CreateMaskingPolicyStmt is a statement to create a data masking policy on a column.
+type CreateMaskingPolicyStmt struct {
+	ddlNode
+
+	IfNotExists bool
+	PolicyName  model.CIStr
+	Table       *TableName
+	Column      model.CIStr
+	// Roles are the users and roles the policy applies to, an empty list means all users.
+	Roles         []*auth.RoleIdentity
+	MaskingType   model.MaskingType
+	PartialPrefix uint64
+	PartialSuffix uint64
+}
+
+// Restore implements Node interface.
+func (n *CreateMaskingPolicyStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("CREATE MASKING POLICY ")
+	if n.IfNotExists {
+		ctx.WriteKeyWord("IF NOT EXISTS ")
+	}
+	ctx.WriteName(n.PolicyName.O)
+	ctx.WriteKeyWord(" ON ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore CreateMaskingPolicyStmt.Table")
+	}
+	ctx.WritePlain(" (")
+	ctx.WriteName(n.Column.O)
+	ctx.WritePlain(")")
+	if len(n.Roles) > 0 {
+		ctx.WriteKeyWord(" TO ")
+		for i, role := range n.Roles {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			if err := role.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore CreateMaskingPolicyStmt.Roles[%d]", i)
+			}
+		}
+	}
+	ctx.WriteKeyWord(" USING ")
+	switch n.MaskingType {
+	case model.MaskingTypeFull, model.MaskingTypeHash:
+		ctx.WriteKeyWord(n.MaskingType.String())
+	case model.MaskingTypePartial:
+		ctx.WriteKeyWord(n.MaskingType.String())
+		ctx.WritePlainf("(%d, %d)", n.PartialPrefix, n.PartialSuffix)
+	default:
+		return errors.Errorf("invalid masking type: %d", n.MaskingType)
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *CreateMaskingPolicyStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*CreateMaskingPolicyStmt)
+	node, ok := n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	return v.Leave(n)
+}
+
+// DropMaskingPolicyStmt is a statement to drop a data masking policy of a table.
+type DropMaskingPolicyStmt struct {
+	ddlNode
+
+	IfExists   bool
+	PolicyName model.CIStr
+	Table      *TableName
+}
+
+// Restore implements Node interface.
+func (n *DropMaskingPolicyStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("DROP MASKING POLICY ")
+	if n.IfExists {
+		ctx.WriteKeyWord("IF EXISTS ")
+	}
+	ctx.WriteName(n.PolicyName.O)
+	ctx.WriteKeyWord(" ON ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore DropMaskingPolicyStmt.Table")
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *DropMaskingPolicyStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*DropMaskingPolicyStmt)
+	node, ok := n.Table.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Table = node.(*TableName)
+	return v.Leave(n)
+}
+
 // CreateResourceGroupStmt is a statement to create a policy.
 type CreateResourceGroupStmt struct {
 	ddlNode
@@ -2178,6 +2416,7 @@ type ResourceGroupOption struct {
 	BoolValue         bool
 	RunawayOptionList []*ResourceGroupRunawayOption
 	BackgroundOptions []*ResourceGroupBackgroundOption
+	OptimizerVars     []*ResourceGroupOptimizerVar
 }
 
 type ResourceUnitType int
@@ -2195,6 +2434,7 @@ const (
 	ResourceBurstableOpiton
 	ResourceGroupRunaway
 	ResourceGroupBackground
+	ResourceGroupOptimizerVars
 )
 
 func (n *ResourceGroupOption) Restore(ctx *format.RestoreCtx) error {
@@ -2257,6 +2497,21 @@ func (n *ResourceGroupOption) Restore(ctx *format.RestoreCtx) error {
 		} else {
 			ctx.WritePlain("NULL")
 		}
+	case ResourceGroupOptimizerVars:
+		ctx.WritePlain("OPTIMIZER_VARS ")
+		ctx.WritePlain("= ")
+		if len(n.OptimizerVars) > 0 {
+			ctx.WritePlain("(")
+			for i, v := range n.OptimizerVars {
+				if i > 0 {
+					ctx.WritePlain(", ")
+				}
+				v.Restore(ctx)
+			}
+			ctx.WritePlain(")")
+		} else {
+			ctx.WritePlain("NULL")
+		}
 	default:
 		return errors.Errorf("invalid ResourceGroupOption: %d", n.Tp)
 	}
@@ -2332,6 +2587,18 @@ func (n *ResourceGroupBackgroundOption) Restore(ctx *format.RestoreCtx) error {
 	return nil
 }
 
+// ResourceGroupOptimizerVar is used for parsing the optimizer variable overridden by the resource group.
+type ResourceGroupOptimizerVar struct {
+	Name  string
+	Value string
+}
+
+func (n *ResourceGroupOptimizerVar) Restore(ctx *format.RestoreCtx) {
+	ctx.WritePlain(n.Name)
+	ctx.WritePlain(" = ")
+	ctx.WriteString(n.Value)
+}
+
 type StatsOptionType int
 
 const (
@@ -2385,6 +2652,13 @@ const (
 	TableOptionTTL
 	TableOptionTTLEnable
 	TableOptionTTLJobInterval
+	TableOptionTTLCondition
+	TableOptionTTLJobWindowStart
+	TableOptionTTLJobWindowEnd
+	TableOptionTTLDeleteRateLimit
+	TableOptionTTLResourceGroup
+	TableOptionExternalLocation
+	TableOptionExternalFormat
 	TableOptionPlacementPolicy = TableOptionType(PlacementOptionPolicy)
 	TableOptionStatsBuckets    = TableOptionType(StatsOptionBuckets)
 	TableOptionStatsTopN       = TableOptionType(StatsOptionTopN)
@@ -2750,6 +3024,55 @@ func (n *TableOption) Restore(ctx *format.RestoreCtx) error {
 			ctx.WriteString(n.StrValue)
 			return nil
 		})
+	case TableOptionTTLCondition:
+		_ = ctx.WriteWithSpecialComments(tidb.FeatureIDTTL, func() error {
+			ctx.WriteKeyWord("TTL_CONDITION ")
+			ctx.WritePlain("= ")
+			ctx.WriteString(n.StrValue)
+			return nil
+		})
+	case TableOptionTTLJobWindowStart:
+		_ = ctx.WriteWithSpecialComments(tidb.FeatureIDTTL, func() error {
+			ctx.WriteKeyWord("TTL_JOB_WINDOW_START ")
+			ctx.WritePlain("= ")
+			ctx.WriteString(n.StrValue)
+			return nil
+		})
+	case TableOptionTTLJobWindowEnd:
+		_ = ctx.WriteWithSpecialComments(tidb.FeatureIDTTL, func() error {
+			ctx.WriteKeyWord("TTL_JOB_WINDOW_END ")
+			ctx.WritePlain("= ")
+			ctx.WriteString(n.StrValue)
+			return nil
+		})
+	case TableOptionTTLDeleteRateLimit:
+		_ = ctx.WriteWithSpecialComments(tidb.FeatureIDTTL, func() error {
+			ctx.WriteKeyWord("TTL_DELETE_RATE_LIMIT ")
+			ctx.WritePlain("= ")
+			ctx.WritePlainf("%d", n.UintValue)
+			return nil
+		})
+	case TableOptionTTLResourceGroup:
+		_ = ctx.WriteWithSpecialComments(tidb.FeatureIDTTL, func() error {
+			ctx.WriteKeyWord("TTL_RESOURCE_GROUP ")
+			ctx.WritePlain("= ")
+			ctx.WriteName(n.StrValue)
+			return nil
+		})
+	case TableOptionExternalLocation:
+		_ = ctx.WriteWithSpecialComments(tidb.FeatureIDExternalTable, func() error {
+			ctx.WriteKeyWord("EXTERNAL_LOCATION ")
+			ctx.WritePlain("= ")
+			ctx.WriteString(n.StrValue)
+			return nil
+		})
+	case TableOptionExternalFormat:
+		_ = ctx.WriteWithSpecialComments(tidb.FeatureIDExternalTable, func() error {
+			ctx.WriteKeyWord("EXTERNAL_FORMAT ")
+			ctx.WritePlain("= ")
+			ctx.WriteString(n.StrValue)
+			return nil
+		})
 	default:
 		return errors.Errorf("invalid TableOption: %d", n.Tp)
 	}
@@ -2969,6 +3292,8 @@ const (
 	AlterTableReorganizeLastPartition
 	AlterTableReorganizeFirstPartition
 	AlterTableRemoveTTL
+	AlterTableSplitPartition
+	AlterTableMergePartitions
 )
 
 // LockType is the type for AlterTableSpec.
@@ -3602,6 +3927,30 @@ func (n *AlterTableSpec) Restore(ctx *format.RestoreCtx) error {
 			}
 			ctx.WritePlain(")")
 		}
+	case AlterTableSplitPartition:
+		ctx.WriteKeyWord("SPLIT PARTITION ")
+		ctx.WriteName(n.PartitionNames[0].O)
+		ctx.WriteKeyWord(" INTO ")
+		ctx.WritePlain("(")
+		for i, def := range n.PartDefinitions {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			if err := def.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore AlterTableSpec.PartDefinitions[%d]", i)
+			}
+		}
+		ctx.WritePlain(")")
+	case AlterTableMergePartitions:
+		ctx.WriteKeyWord("MERGE PARTITIONS ")
+		for i, name := range n.PartitionNames {
+			if i != 0 {
+				ctx.WritePlain(",")
+			}
+			ctx.WriteName(name.O)
+		}
+		ctx.WriteKeyWord(" INTO PARTITION ")
+		ctx.WriteName(n.PartDefinitions[0].Name.O)
 	case AlterTableExchangePartition:
 		ctx.WriteKeyWord("EXCHANGE PARTITION ")
 		ctx.WriteName(n.PartitionNames[0].O)
@@ -4688,6 +5037,16 @@ func CheckBackgroundAppend(ops []*ResourceGroupBackgroundOption, newOp *Resource
 	return true
 }
 
+// CheckOptimizerVarAppend checks whether the optimizer variable is specified repeatedly.
+func CheckOptimizerVarAppend(vars []*ResourceGroupOptimizerVar, newVar *ResourceGroupOptimizerVar) bool {
+	for _, v := range vars {
+		if v.Name == newVar.Name {
+			return false
+		}
+	}
+	return true
+}
+
 // AlterResourceGroupStmt is a statement to alter placement policy option.
 type AlterResourceGroupStmt struct {
 	ddlNode
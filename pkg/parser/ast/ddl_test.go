@@ -16,6 +16,7 @@ package ast_test
 import (
 	"testing"
 
+	"github.com/pingcap/tidb/pkg/parser"
 	. "github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/format"
 	"github.com/stretchr/testify/require"
@@ -933,3 +934,32 @@ func TestTableOptionTTLRestoreWithTTLEnableOffFlag(t *testing.T) {
 		runNodeRestoreTestWithFlagsStmtChange(t, testCases, "%s", extractNodeFunc, ca.flags)
 	}
 }
+
+func TestCreateTableSecureText(t *testing.T) {
+	testCases := []struct {
+		input   string
+		secured string
+	}{
+		{
+			input:   "create table t (a int)",
+			secured: `^\Qcreate table t (a int)\E$`,
+		},
+		{
+			input:   "create table t (a int) external_location = 's3://bucket/prefix/*.csv?access-key=aaaaa&secret-access-key=bbbbb'",
+			secured: "^\\QCREATE TABLE `t` (`a` INT) EXTERNAL_LOCATION = 's3://bucket/prefix/*.csv?\\E((access-key=xxxxxx|secret-access-key=xxxxxx)(&|'$)){2}",
+		},
+		{
+			input:   "create table t (a int) external_location = 'azure://bucket/prefix/a.csv?account-key=aaaaa'",
+			secured: "^\\QCREATE TABLE `t` (`a` INT) EXTERNAL_LOCATION = 'azure://bucket/prefix/a.csv?account-key=xxxxxx'\\E$",
+		},
+	}
+
+	p := parser.New()
+	for _, tc := range testCases {
+		node, err := p.ParseOneStmt(tc.input, "", "")
+		require.NoError(t, err, tc.input)
+		n, ok := node.(SensitiveStmtNode)
+		require.True(t, ok, tc.input)
+		require.Regexp(t, tc.secured, n.SecureText(), tc.input)
+	}
+}
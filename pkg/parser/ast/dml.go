@@ -21,6 +21,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/format"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/parser/types"
 )
 
 var (
@@ -363,6 +364,7 @@ const (
 	HintForce
 	HintOrderIndex
 	HintNoOrderIndex
+	HintLooseIndexScan
 )
 
 // IndexHintScope is the type for index hint for join, order by or group by.
@@ -397,6 +399,8 @@ func (n *IndexHint) Restore(ctx *format.RestoreCtx) error {
 		indexHintType = "ORDER INDEX"
 	case HintNoOrderIndex:
 		indexHintType = "NO ORDER INDEX"
+	case HintLooseIndexScan:
+		indexHintType = "LOOSE INDEX SCAN"
 	default: // Prevent accidents
 		return errors.New("IndexHintType has an error while matching")
 	}
@@ -609,6 +613,166 @@ func (n *TableSource) Accept(v Visitor) (Node, bool) {
 	return v.Leave(n)
 }
 
+// JSONTableColumnType is the type of a column defined in JSON_TABLE.
+type JSONTableColumnType int
+
+// JSONTable column types.
+const (
+	// JSONTableColumnPath is `name type PATH path [on_empty] [on_error]`.
+	JSONTableColumnPath JSONTableColumnType = iota
+	// JSONTableColumnExists is `name type EXISTS PATH path`.
+	JSONTableColumnExists
+	// JSONTableColumnOrdinality is `name FOR ORDINALITY`.
+	JSONTableColumnOrdinality
+	// JSONTableColumnNested is `NESTED [PATH] path COLUMNS (column_list)`.
+	JSONTableColumnNested
+)
+
+// JSONTableOnResponseType is the response of a JSON_TABLE column when the value is missing or invalid.
+type JSONTableOnResponseType int
+
+// JSONTable column response types.
+const (
+	JSONTableResponseNull JSONTableOnResponseType = iota
+	JSONTableResponseError
+	JSONTableResponseDefault
+)
+
+// JSONTableOnResponse is the ON EMPTY or the ON ERROR clause of a JSON_TABLE column.
+type JSONTableOnResponse struct {
+	Tp JSONTableOnResponseType
+	// Default is the JSON string of `DEFAULT json_string`.
+	Default string
+}
+
+// Restore implements Node interface.
+func (n *JSONTableOnResponse) Restore(ctx *format.RestoreCtx) error {
+	switch n.Tp {
+	case JSONTableResponseNull:
+		ctx.WriteKeyWord("NULL")
+	case JSONTableResponseError:
+		ctx.WriteKeyWord("ERROR")
+	case JSONTableResponseDefault:
+		ctx.WriteKeyWord("DEFAULT ")
+		ctx.WriteString(n.Default)
+	default:
+		return errors.Errorf("invalid JSONTableOnResponseType: %d", n.Tp)
+	}
+	return nil
+}
+
+// JSONTableColumn is a column defined in the COLUMNS clause of JSON_TABLE.
+type JSONTableColumn struct {
+	Tp   JSONTableColumnType
+	Name model.CIStr
+	// FieldType is the type of JSONTableColumnPath and JSONTableColumnExists columns.
+	FieldType *types.FieldType
+	Path      string
+	// OnEmpty and OnError are nil if the clauses are not specified.
+	OnEmpty *JSONTableOnResponse
+	OnError *JSONTableOnResponse
+	// NestedColumns are the columns of JSONTableColumnNested.
+	NestedColumns []*JSONTableColumn
+}
+
+// Restore implements Node interface.
+func (n *JSONTableColumn) Restore(ctx *format.RestoreCtx) error {
+	if n.Tp == JSONTableColumnNested {
+		ctx.WriteKeyWord("NESTED PATH ")
+		ctx.WriteString(n.Path)
+		return restoreJSONTableColumns(ctx, n.NestedColumns)
+	}
+	ctx.WriteName(n.Name.O)
+	if n.Tp == JSONTableColumnOrdinality {
+		ctx.WriteKeyWord(" FOR ORDINALITY")
+		return nil
+	}
+	ctx.WritePlain(" ")
+	if err := n.FieldType.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore JSONTableColumn.FieldType")
+	}
+	if n.Tp == JSONTableColumnExists {
+		ctx.WriteKeyWord(" EXISTS")
+	}
+	ctx.WriteKeyWord(" PATH ")
+	ctx.WriteString(n.Path)
+	if n.OnEmpty != nil {
+		ctx.WritePlain(" ")
+		if err := n.OnEmpty.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore JSONTableColumn.OnEmpty")
+		}
+		ctx.WriteKeyWord(" ON EMPTY")
+	}
+	if n.OnError != nil {
+		ctx.WritePlain(" ")
+		if err := n.OnError.Restore(ctx); err != nil {
+			return errors.Annotate(err, "An error occurred while restore JSONTableColumn.OnError")
+		}
+		ctx.WriteKeyWord(" ON ERROR")
+	}
+	return nil
+}
+
+func restoreJSONTableColumns(ctx *format.RestoreCtx, cols []*JSONTableColumn) error {
+	ctx.WriteKeyWord(" COLUMNS")
+	ctx.WritePlain("(")
+	for i, col := range cols {
+		if i > 0 {
+			ctx.WritePlain(", ")
+		}
+		if err := col.Restore(ctx); err != nil {
+			return errors.Annotatef(err, "An error occurred while restore JSONTable.Columns[%d]", i)
+		}
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// JSONTable is the JSON_TABLE table function, which extracts data from a JSON document and returns it as a table.
+// See https://dev.mysql.com/doc/refman/8.0/en/json-table-functions.html
+type JSONTable struct {
+	node
+
+	// Expr is the JSON document.
+	Expr ExprNode
+	// Path is the path applied to Expr to get the rows.
+	Path    string
+	Columns []*JSONTableColumn
+}
+
+func (*JSONTable) resultSet() {}
+
+// Restore implements Node interface.
+func (n *JSONTable) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("JSON_TABLE")
+	ctx.WritePlain("(")
+	if err := n.Expr.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore JSONTable.Expr")
+	}
+	ctx.WritePlain(", ")
+	ctx.WriteString(n.Path)
+	if err := restoreJSONTableColumns(ctx, n.Columns); err != nil {
+		return err
+	}
+	ctx.WritePlain(")")
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *JSONTable) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*JSONTable)
+	node, ok := n.Expr.Accept(v)
+	if !ok {
+		return n, false
+	}
+	n.Expr = node.(ExprNode)
+	return v.Leave(n)
+}
+
 // SelectLockType is the lock type for SelectStmt.
 type SelectLockType int
 
@@ -870,13 +1034,49 @@ func (n *ByItem) Accept(v Visitor) (Node, bool) {
 // GroupByClause represents group by clause.
 type GroupByClause struct {
 	node
-	Items  []*ByItem
+	Items []*ByItem
+	// Rollup is true for both `GROUP BY ... WITH ROLLUP` and `GROUP BY ROLLUP(...)`.
 	Rollup bool
+	// Cube is true for `GROUP BY CUBE(...)`.
+	Cube bool
+	// GroupingSets is set for `GROUP BY GROUPING SETS(...)`. Each grouping set is
+	// represented by the offsets of its items in Items.
+	GroupingSets [][]int
+}
+
+// HasGroupingSets returns whether the clause groups the rows by more than one grouping set.
+func (n *GroupByClause) HasGroupingSets() bool {
+	return n.Rollup || n.Cube || n.GroupingSets != nil
 }
 
 // Restore implements Node interface.
 func (n *GroupByClause) Restore(ctx *format.RestoreCtx) error {
 	ctx.WriteKeyWord("GROUP BY ")
+	if n.GroupingSets != nil {
+		ctx.WriteKeyWord("GROUPING SETS")
+		ctx.WritePlain("(")
+		for i, set := range n.GroupingSets {
+			if i != 0 {
+				ctx.WritePlain(",")
+			}
+			ctx.WritePlain("(")
+			for j, offset := range set {
+				if j != 0 {
+					ctx.WritePlain(",")
+				}
+				if err := n.Items[offset].Restore(ctx); err != nil {
+					return errors.Annotatef(err, "An error occurred while restore GroupByClause.Items[%d]", offset)
+				}
+			}
+			ctx.WritePlain(")")
+		}
+		ctx.WritePlain(")")
+		return nil
+	}
+	if n.Cube {
+		ctx.WriteKeyWord("CUBE")
+		ctx.WritePlain("(")
+	}
 	for i, v := range n.Items {
 		if i != 0 {
 			ctx.WritePlain(",")
@@ -885,6 +1085,9 @@ func (n *GroupByClause) Restore(ctx *format.RestoreCtx) error {
 			return errors.Annotatef(err, "An error occurred while restore GroupByClause.Items[%d]", i)
 		}
 	}
+	if n.Cube {
+		ctx.WritePlain(")")
+	}
 	if n.Rollup {
 		ctx.WriteKeyWord(" WITH ROLLUP")
 	}
@@ -1446,6 +1649,21 @@ func (n *SelectStmt) Restore(ctx *format.RestoreCtx) error {
 	return nil
 }
 
+// SecureText implements SensitiveStmtNode interface.
+// The credentials in the URL of SELECT ... INTO OUTFILE are redacted.
+func (n *SelectStmt) SecureText() string {
+	if n.SelectIntoOpt == nil {
+		return n.Text()
+	}
+	redactedStmt := *n
+	redactedInto := *n.SelectIntoOpt
+	redactedInto.FileName = RedactURL(n.SelectIntoOpt.FileName)
+	redactedStmt.SelectIntoOpt = &redactedInto
+	var sb strings.Builder
+	_ = redactedStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb))
+	return sb.String()
+}
+
 func restoreTables(ctx *format.RestoreCtx, ts []*TableName) error {
 	for i, v := range ts {
 		if err := v.Restore(ctx); err != nil {
@@ -2135,6 +2353,9 @@ type ImportIntoStmt struct {
 	Format             *string
 	Options            []*LoadDataOpt
 	Select             ResultSetNode
+	// SourceDSN is the DSN of the MySQL-protocol server where Select runs,
+	// empty means Select runs in the current cluster.
+	SourceDSN string
 }
 
 var _ SensitiveStmtNode = &ImportIntoStmt{}
@@ -2175,6 +2396,10 @@ func (n *ImportIntoStmt) Restore(ctx *format.RestoreCtx) error {
 		if err := n.Select.Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while restore ImportIntoStmt.Select")
 		}
+		if n.SourceDSN != "" {
+			ctx.WritePlain(" @")
+			ctx.WriteString(n.SourceDSN)
+		}
 	} else {
 		ctx.WriteString(n.Path)
 		if n.Format != nil {
@@ -2238,6 +2463,91 @@ func (n *ImportIntoStmt) Accept(v Visitor) (Node, bool) {
 }
 
 func (n *ImportIntoStmt) SecureText() string {
+	redactedStmt := *n
+	redactedStmt.Path = RedactURL(n.Path)
+	redactedStmt.SourceDSN = RedactDSN(n.SourceDSN)
+	var sb strings.Builder
+	_ = redactedStmt.Restore(format.NewRestoreCtx(format.DefaultRestoreFlags, &sb))
+	return sb.String()
+}
+
+// RedactDSN redacts the password in a DSN of the form
+// [username[:password]@][protocol[(address)]]/dbname[?param1=value1&...].
+func RedactDSN(dsn string) string {
+	// the password might contain '@' or '/', so we look for the last '@'
+	// before the last '/', same as how the MySQL driver parses it.
+	slash := strings.LastIndexByte(dsn, '/')
+	if slash < 0 {
+		return dsn
+	}
+	at := strings.LastIndexByte(dsn[:slash], '@')
+	if at < 0 {
+		return dsn
+	}
+	colon := strings.IndexByte(dsn[:at], ':')
+	if colon < 0 {
+		return dsn
+	}
+	return dsn[:colon+1] + "xxxxxx" + dsn[at:]
+}
+
+// ExportTableStmt is a statement to export the data of a table to the
+// external storage, such as S3, in the format of CSV or Parquet.
+type ExportTableStmt struct {
+	stmtNode
+
+	Table   *TableName
+	Path    string
+	Format  string
+	Options []*LoadDataOpt
+}
+
+var _ SensitiveStmtNode = &ExportTableStmt{}
+
+// Restore implements Node interface.
+func (n *ExportTableStmt) Restore(ctx *format.RestoreCtx) error {
+	ctx.WriteKeyWord("EXPORT TABLE ")
+	if err := n.Table.Restore(ctx); err != nil {
+		return errors.Annotate(err, "An error occurred while restore ExportTableStmt.Table")
+	}
+	ctx.WriteKeyWord(" TO ")
+	ctx.WriteString(n.Path)
+	ctx.WriteKeyWord(" FORMAT ")
+	ctx.WriteString(n.Format)
+	if len(n.Options) > 0 {
+		ctx.WriteKeyWord(" WITH")
+		for i, option := range n.Options {
+			if i != 0 {
+				ctx.WritePlain(",")
+			}
+			ctx.WritePlain(" ")
+			if err := option.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore ExportTableStmt.Options")
+			}
+		}
+	}
+	return nil
+}
+
+// Accept implements Node Accept interface.
+func (n *ExportTableStmt) Accept(v Visitor) (Node, bool) {
+	newNode, skipChildren := v.Enter(n)
+	if skipChildren {
+		return v.Leave(newNode)
+	}
+	n = newNode.(*ExportTableStmt)
+	if n.Table != nil {
+		node, ok := n.Table.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Table = node.(*TableName)
+	}
+	return v.Leave(n)
+}
+
+// SecureText implements SensitiveStmtNode interface.
+func (n *ExportTableStmt) SecureText() string {
 	redactedStmt := *n
 	redactedStmt.Path = RedactURL(n.Path)
 	var sb strings.Builder
@@ -3620,8 +3930,10 @@ const (
 type SelectIntoOption struct {
 	node
 
-	Tp         SelectIntoType
-	FileName   string
+	Tp       SelectIntoType
+	FileName string
+	// Format is the format of the outfile, nil means the default text format.
+	Format     *string
 	FieldsInfo *FieldsClause
 	LinesInfo  *LinesClause
 }
@@ -3635,6 +3947,10 @@ func (n *SelectIntoOption) Restore(ctx *format.RestoreCtx) error {
 
 	ctx.WriteKeyWord("INTO OUTFILE ")
 	ctx.WriteString(n.FileName)
+	if n.Format != nil {
+		ctx.WriteKeyWord(" FORMAT ")
+		ctx.WriteString(*n.Format)
+	}
 	if n.FieldsInfo != nil {
 		if err := n.FieldsInfo.Restore(ctx); err != nil {
 			return errors.Annotate(err, "An error occurred while restore SelectInto.FieldsInfo")
@@ -3706,12 +4022,39 @@ const (
 	Groups
 )
 
+// FrameExclusion is the type of the EXCLUDE clause of window function frame.
+type FrameExclusion int
+
+// Window function frame exclusions.
+const (
+	ExcludeNoOthers FrameExclusion = iota
+	ExcludeCurrentRow
+	ExcludeGroup
+	ExcludeTies
+)
+
+// String implements fmt.Stringer interface.
+func (n FrameExclusion) String() string {
+	switch n {
+	case ExcludeCurrentRow:
+		return "CURRENT ROW"
+	case ExcludeGroup:
+		return "GROUP"
+	case ExcludeTies:
+		return "TIES"
+	default:
+		return "NO OTHERS"
+	}
+}
+
 // FrameClause represents frame clause.
 type FrameClause struct {
 	node
 
 	Type   FrameType
 	Extent FrameExtent
+	// Exclusion is the EXCLUDE clause which removes the rows around the current row from the frame.
+	Exclusion FrameExclusion
 }
 
 // Restore implements Node interface.
@@ -3732,6 +4075,10 @@ func (n *FrameClause) Restore(ctx *format.RestoreCtx) error {
 	if err := n.Extent.End.Restore(ctx); err != nil {
 		return errors.Annotate(err, "An error occurred while restore FrameClause.Extent.End")
 	}
+	if n.Exclusion != ExcludeNoOthers {
+		ctx.WriteKeyWord(" EXCLUDE ")
+		ctx.WriteKeyWord(n.Exclusion.String())
+	}
 
 	return nil
 }
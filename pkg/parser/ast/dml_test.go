@@ -602,6 +602,16 @@ func TestImportIntoRestore(t *testing.T) {
 			sourceSQL: "IMPORT INTO `t` from (select * from xx)",
 			expectSQL: "IMPORT INTO `t` FROM (SELECT * FROM `xx`)",
 		},
+		{
+			// SelectStmt from another cluster
+			sourceSQL: "IMPORT INTO `t` from select * from xx @'root:pwd@tcp(127.0.0.1:3306)/test'",
+			expectSQL: "IMPORT INTO `t` FROM SELECT * FROM `xx` @'root:pwd@tcp(127.0.0.1:3306)/test'",
+		},
+		{
+			// SelectStmt with where clause from another cluster
+			sourceSQL: "IMPORT INTO `t` (`a`,`b`) from select `a`,`b` from `xx` where `a`>1 @'root@tcp(127.0.0.1:3306)/test' with thread=1",
+			expectSQL: "IMPORT INTO `t` (`a`,`b`) FROM SELECT `a`,`b` FROM `xx` WHERE `a`>1 @'root@tcp(127.0.0.1:3306)/test' WITH thread=1",
+		},
 	}
 	extractNodeFunc := func(node Node) Node {
 		return node.(*ImportIntoStmt)
@@ -609,6 +619,27 @@ func TestImportIntoRestore(t *testing.T) {
 	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
 }
 
+func TestExportTableRestore(t *testing.T) {
+	testCases := []NodeRestoreTestCase{
+		{
+			sourceSQL: "EXPORT TABLE t to 's3://bucket/prefix' format 'csv'",
+			expectSQL: "EXPORT TABLE `t` TO 's3://bucket/prefix' FORMAT 'csv'",
+		},
+		{
+			sourceSQL: "EXPORT TABLE `db`.`t` to '/tmp/export' format 'parquet'",
+			expectSQL: "EXPORT TABLE `db`.`t` TO '/tmp/export' FORMAT 'parquet'",
+		},
+		{
+			sourceSQL: "EXPORT TABLE `t` to '/tmp/export' format 'csv' with header, thread=1",
+			expectSQL: "EXPORT TABLE `t` TO '/tmp/export' FORMAT 'csv' WITH header, thread=1",
+		},
+	}
+	extractNodeFunc := func(node Node) Node {
+		return node.(*ExportTableStmt)
+	}
+	runNodeRestoreTest(t, testCases, "%s", extractNodeFunc)
+}
+
 func TestFulltextSearchModifier(t *testing.T) {
 	require.False(t, FulltextSearchModifier(FulltextSearchModifierNaturalLanguageMode).IsBooleanMode())
 	require.True(t, FulltextSearchModifier(FulltextSearchModifierNaturalLanguageMode).IsNaturalLanguageMode())
@@ -628,6 +659,14 @@ func TestImportIntoSecureText(t *testing.T) {
 			input:   "import into t from 'gcs://bucket/prefix?access-key=aaaaa&secret-access-key=bbbbb'",
 			secured: "\\QIMPORT INTO `t` FROM 'gcs://bucket/prefix?access-key=aaaaa&secret-access-key=bbbbb'\\E",
 		},
+		{
+			input:   "import into t from select * from xx @'root:p@ss/word@tcp(127.0.0.1:3306)/test?charset=utf8mb4'",
+			secured: "\\QIMPORT INTO `t` FROM SELECT * FROM `xx` @'root:xxxxxx@tcp(127.0.0.1:3306)/test?charset=utf8mb4'\\E",
+		},
+		{
+			input:   "import into t from select * from xx @'root@tcp(127.0.0.1:3306)/test'",
+			secured: "\\QIMPORT INTO `t` FROM SELECT * FROM `xx` @'root@tcp(127.0.0.1:3306)/test'\\E",
+		},
 	}
 
 	p := parser.New()
@@ -641,6 +680,26 @@ func TestImportIntoSecureText(t *testing.T) {
 	}
 }
 
+func TestExportTableSecureText(t *testing.T) {
+	p := parser.New()
+	node, err := p.ParseOneStmt("export table t to 's3://bucket/prefix?access-key=aaaaa&secret-access-key=bbbbb' format csv", "", "")
+	require.NoError(t, err)
+	n, ok := node.(SensitiveStmtNode)
+	require.True(t, ok)
+	require.Regexp(t, `^EXPORT TABLE .t. TO \Q's3://bucket/prefix?\E((access-key=xxxxxx|secret-access-key=xxxxxx)(&|')){2} FORMAT 'csv'$`, n.SecureText())
+}
+
+func TestSelectIntoSecureText(t *testing.T) {
+	p := parser.New()
+	node, err := p.ParseOneStmt("select * from t", "", "")
+	require.NoError(t, err)
+	require.Equal(t, "select * from t", node.(SensitiveStmtNode).SecureText())
+	node, err = p.ParseOneStmt("select a from t into outfile 's3://bucket/t.csv?access-key=aaaaa&secret-access-key=bbbbb'", "", "")
+	require.NoError(t, err)
+	require.Regexp(t, `^SELECT .a. FROM .t. INTO OUTFILE \Q's3://bucket/t.csv?\E((access-key=xxxxxx|secret-access-key=xxxxxx)(&|')){2}$`,
+		node.(SensitiveStmtNode).SecureText())
+}
+
 func TestImportIntoFromSelectInvalidStmt(t *testing.T) {
 	p := parser.New()
 	_, err := p.ParseOneStmt("IMPORT INTO t1(a, @1) FROM select * from t2;", "", "")
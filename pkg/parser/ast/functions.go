@@ -305,6 +305,9 @@ const (
 	ReleaseLock     = "release_lock"
 	Grouping        = "grouping"
 
+	// TiDBFullTextTokens splits the strings into the tokens of the FULLTEXT index.
+	TiDBFullTextTokens = "tidb_fulltext_tokens"
+
 	// encryption and compression functions
 	AesDecrypt               = "aes_decrypt"
 	AesEncrypt               = "aes_encrypt"
@@ -19,6 +19,7 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
@@ -214,6 +215,8 @@ type ExplainStmt struct {
 	Stmt    StmtNode
 	Format  string
 	Analyze bool
+	// Vars are the variables overridden by `EXPLAIN ... WITH (var = value, ...)` while planning the statement.
+	Vars []*VariableAssignment
 }
 
 // Restore implements Node interface.
@@ -241,6 +244,21 @@ func (n *ExplainStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteString(n.Format)
 		ctx.WritePlain(" ")
 	}
+	if len(n.Vars) > 0 {
+		ctx.WriteKeyWord("WITH ")
+		ctx.WritePlain("(")
+		for i, v := range n.Vars {
+			if i > 0 {
+				ctx.WritePlain(", ")
+			}
+			ctx.WriteName(v.Name)
+			ctx.WritePlain(" = ")
+			if err := v.Value.Restore(ctx); err != nil {
+				return errors.Annotatef(err, "An error occurred while restore ExplainStmt.Vars[%d]", i)
+			}
+		}
+		ctx.WritePlain(") ")
+	}
 	if err := n.Stmt.Restore(ctx); err != nil {
 		return errors.Annotate(err, "An error occurred while restore ExplainStmt.Stmt")
 	}
@@ -254,6 +272,13 @@ func (n *ExplainStmt) Accept(v Visitor) (Node, bool) {
 		return v.Leave(newNode)
 	}
 	n = newNode.(*ExplainStmt)
+	for i, val := range n.Vars {
+		node, ok := val.Accept(v)
+		if !ok {
+			return n, false
+		}
+		n.Vars[i] = node.(*VariableAssignment)
+	}
 	node, ok := n.Stmt.Accept(v)
 	if !ok {
 		return n, false
@@ -275,6 +300,9 @@ type PlanReplayerStmt struct {
 	Capture bool
 	// Remove indicates `plan replayer capture remove <sql_digest> <plan_digest>
 	Remove bool
+	// CaptureLimit indicates 'plan replayer capture <sql_digest> <plan_digest> limit <n>', the task dumps
+	// the next n executions matching the digests. 0 means the task dumps each plan digest only once.
+	CaptureLimit uint64
 
 	SQLDigest  string
 	PlanDigest string
@@ -306,6 +334,10 @@ func (n *PlanReplayerStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteString(n.SQLDigest)
 		ctx.WriteKeyWord(" ")
 		ctx.WriteString(n.PlanDigest)
+		if n.CaptureLimit > 0 {
+			ctx.WriteKeyWord(" LIMIT ")
+			ctx.WritePlainf("%d", n.CaptureLimit)
+		}
 		return nil
 	}
 	if n.Remove {
@@ -2336,6 +2368,11 @@ const (
 	AdminSetBDRRole
 	AdminShowBDRRole
 	AdminUnsetBDRRole
+	AdminSetHintsForDigest
+	AdminUnsetHintsForDigest
+	AdminRecommendHints
+	AdminRecommendDropIndex
+	AdminShowDiagnostics
 )
 
 // HandleRange represents a range where handle value >= Begin and < End.
@@ -2475,6 +2512,35 @@ type AdminStmt struct {
 	StatementScope StatementScope
 	LimitSimple    LimitSimple
 	BDRRole        BDRRole
+
+	// HintsDigest, Hints, HintsAppName and HintsTTL are used by `ADMIN SET/UNSET HINTS FOR DIGEST`.
+	HintsDigest  string
+	Hints        string
+	HintsAppName string
+	HintsTTL     *DigestHintsTTL
+
+	// BadPlanDigest and GoodPlanDigest are used by `ADMIN RECOMMEND HINTS FROM PLAN DIGEST ... TO ...`.
+	BadPlanDigest  string
+	GoodPlanDigest string
+
+	// DiagnosticsSQL and DiagnosticsDigest are used by `ADMIN SHOW DIAGNOSTICS FOR [DIGEST] ...`.
+	DiagnosticsSQL    string
+	DiagnosticsDigest string
+}
+
+// DigestHintsTTL is the TTL of the hints set by `ADMIN SET HINTS FOR DIGEST`.
+type DigestHintsTTL struct {
+	Value uint64
+	Unit  TimeUnitType
+}
+
+// Duration returns the duration of the TTL.
+func (ttl *DigestHintsTTL) Duration() (time.Duration, error) {
+	unit, err := ttl.Unit.Duration()
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(ttl.Value) * unit, nil
 }
 
 // Restore implements Node interface.
@@ -2638,6 +2704,50 @@ func (n *AdminStmt) Restore(ctx *format.RestoreCtx) error {
 		ctx.WriteKeyWord("SHOW BDR ROLE")
 	case AdminUnsetBDRRole:
 		ctx.WriteKeyWord("UNSET BDR ROLE")
+	case AdminSetHintsForDigest:
+		ctx.WriteKeyWord("SET HINTS FOR DIGEST ")
+		ctx.WriteString(n.HintsDigest)
+		ctx.WritePlain(" ")
+		ctx.WriteString(n.Hints)
+		if n.HintsAppName != "" {
+			ctx.WriteKeyWord(" FOR APPLICATION ")
+			ctx.WriteString(n.HintsAppName)
+		}
+		if n.HintsTTL != nil {
+			ctx.WriteKeyWord(" TTL ")
+			ctx.WritePlain("= ")
+			ctx.WriteKeyWord("INTERVAL ")
+			ctx.WritePlainf("%d ", n.HintsTTL.Value)
+			ctx.WriteKeyWord(n.HintsTTL.Unit.String())
+		}
+	case AdminUnsetHintsForDigest:
+		ctx.WriteKeyWord("UNSET HINTS FOR DIGEST ")
+		ctx.WriteString(n.HintsDigest)
+		if n.HintsAppName != "" {
+			ctx.WriteKeyWord(" FOR APPLICATION ")
+			ctx.WriteString(n.HintsAppName)
+		}
+	case AdminRecommendHints:
+		ctx.WriteKeyWord("RECOMMEND HINTS FROM PLAN DIGEST ")
+		ctx.WriteString(n.BadPlanDigest)
+		ctx.WriteKeyWord(" TO ")
+		ctx.WriteString(n.GoodPlanDigest)
+	case AdminRecommendDropIndex:
+		ctx.WriteKeyWord("RECOMMEND DROP INDEX")
+		if len(n.Tables) > 0 {
+			ctx.WriteKeyWord(" FOR TABLE ")
+			if err := restoreTables(); err != nil {
+				return err
+			}
+		}
+	case AdminShowDiagnostics:
+		ctx.WriteKeyWord("SHOW DIAGNOSTICS FOR ")
+		if n.DiagnosticsDigest != "" {
+			ctx.WriteKeyWord("DIGEST ")
+			ctx.WriteString(n.DiagnosticsDigest)
+		} else {
+			ctx.WriteString(n.DiagnosticsSQL)
+		}
 	default:
 		return errors.New("Unsupported AdminStmt type")
 	}
@@ -3569,6 +3679,15 @@ func RedactURL(str string) string {
 			}
 		}
 		u.RawQuery = values.Encode()
+	case "azure", "azblob":
+		values := u.Query()
+		for k := range values {
+			normalizedKey := strings.ToLower(strings.ReplaceAll(k, "_", "-"))
+			if normalizedKey == "account-key" || normalizedKey == "sas-token" {
+				values[k] = []string{"xxxxxx"}
+			}
+		}
+		u.RawQuery = values.Encode()
 	}
 	return u.String()
 }
@@ -3666,7 +3785,12 @@ type TableOptimizerHint struct {
 	// - TIME_RANGE          => ast.HintTimeRange
 	// - READ_FROM_STORAGE   => model.CIStr
 	// - USE_TOJA            => bool
+	// - ASYNC_COMMIT        => bool
+	// - ONE_PC              => bool
+	// - GENERATED_COLUMN_SUBSTITUTE => bool
 	// - NTH_PLAN            => int64
+	// - SHARD_ROW_ID        => uint64
+	// - READ_LOCALITY       => ast.HintReadLocality
 	HintData interface{}
 	// QBName is the default effective query block of this hint.
 	QBName  model.CIStr
@@ -3686,6 +3810,20 @@ type HintSetVar struct {
 	Value   string
 }
 
+// HintReadLocality is the payload of `READ_LOCALITY` hint
+type HintReadLocality struct {
+	// Labels are the store labels in the form of 'key=value', separated by commas.
+	Labels string
+	// Fallback is the policy when no replica matches the labels, it's empty if not specified.
+	Fallback string
+}
+
+// HintFixControl is the payload of `FIX_CONTROL` hint
+type HintFixControl struct {
+	FixID uint64
+	Value string
+}
+
 // HintTable is table in the hint. It may have query block info.
 type HintTable struct {
 	DBName        model.CIStr
@@ -3735,7 +3873,9 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 	}
 	// Hints without args except query block.
 	switch n.HintName.L {
-	case "mpp_1phase_agg", "mpp_2phase_agg", "hash_agg", "stream_agg", "agg_to_cop", "read_consistent_replica", "no_index_merge", "ignore_plan_cache", "limit_to_cop", "straight_join", "merge", "no_decorrelate":
+	case "mpp_1phase_agg", "mpp_2phase_agg", "hash_agg", "stream_agg", "agg_to_cop", "read_consistent_replica", "no_index_merge", "ignore_plan_cache", "limit_to_cop", "straight_join", "merge", "no_decorrelate",
+		"distinct_agg_push_down", "no_distinct_agg_push_down", "approx_count_distinct", "no_expand", "materialize_subquery", "no_materialize_subquery", "use_invisible_indexes",
+		"use_fulltext_index", "no_fulltext_index", "hedged_read":
 		ctx.WritePlain(")")
 		return nil
 	}
@@ -3744,22 +3884,30 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 	}
 	// Hints with args except query block.
 	switch n.HintName.L {
-	case "max_execution_time":
+	case "max_execution_time", "shard_row_id":
 		ctx.WritePlainf("%d", n.HintData.(uint64))
-	case "resource_group":
+	case "resource_group", "mpp_exchange_compression":
 		ctx.WriteName(n.HintData.(string))
 	case "nth_plan":
 		ctx.WritePlainf("%d", n.HintData.(int64))
+	case "sample":
+		n.Tables[0].Restore(ctx)
+		ctx.WritePlainf(", %d ", n.HintData.(uint64))
+		ctx.WriteKeyWord("PERCENT")
+	case "inl_join_prefetch":
+		n.Tables[0].Restore(ctx)
+		ctx.WritePlainf(", %d", n.HintData.(uint64))
 	case "tidb_hj", "tidb_smj", "tidb_inlj", "hash_join", "hash_join_build", "hash_join_probe", "merge_join", "inl_join",
 		"broadcast_join", "shuffle_join", "inl_hash_join", "inl_merge_join", "leading", "no_hash_join", "no_merge_join",
-		"no_index_join", "no_index_hash_join", "no_index_merge_join":
+		"no_index_join", "no_index_hash_join", "no_index_merge_join", "full_scan", "index_only", "no_full_scan":
 		for i, table := range n.Tables {
 			if i != 0 {
 				ctx.WritePlain(", ")
 			}
 			table.Restore(ctx)
 		}
-	case "use_index", "ignore_index", "use_index_merge", "force_index", "order_index", "no_order_index":
+	case "use_index", "ignore_index", "use_index_merge", "force_index", "order_index", "no_order_index",
+		"index", "no_index", "group_index", "no_group_index", "join_index", "no_join_index", "loose_index_scan":
 		n.Tables[0].Restore(ctx)
 		ctx.WritePlain(" ")
 		for i, index := range n.Indexes {
@@ -3778,7 +3926,7 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 				table.Restore(ctx)
 			}
 		}
-	case "use_toja", "use_cascades":
+	case "use_toja", "use_cascades", "async_commit", "one_pc", "generated_column_substitute":
 		if n.HintData.(bool) {
 			ctx.WritePlain("TRUE")
 		} else {
@@ -3801,6 +3949,13 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 				ctx.WritePlain(", ")
 			}
 		}
+	case "read_locality":
+		hintData := n.HintData.(HintReadLocality)
+		ctx.WriteString(hintData.Labels)
+		if hintData.Fallback != "" {
+			ctx.WritePlain(", ")
+			ctx.WriteKeyWord(hintData.Fallback)
+		}
 	case "time_range":
 		hintData := n.HintData.(HintTimeRange)
 		ctx.WriteString(hintData.From)
@@ -3811,6 +3966,17 @@ func (n *TableOptimizerHint) Restore(ctx *format.RestoreCtx) error {
 		ctx.WritePlain(hintData.VarName)
 		ctx.WritePlain(" = ")
 		ctx.WriteString(hintData.Value)
+	case "batch_dml":
+		ctx.WriteKeyWord("SIZE")
+		ctx.WritePlainf(" = %d", n.HintData.(uint64))
+	case "fix_control":
+		for i, fix := range n.HintData.([]HintFixControl) {
+			if i != 0 {
+				ctx.WritePlain(", ")
+			}
+			ctx.WritePlainf("%d:", fix.FixID)
+			ctx.WriteString(fix.Value)
+		}
 	}
 	ctx.WritePlain(")")
 	return nil
@@ -4120,8 +4286,20 @@ func (n *QueryWatchOption) Accept(v Visitor) (Node, bool) {
 func CheckQueryWatchAppend(ops []*QueryWatchOption, newOp *QueryWatchOption) bool {
 	for _, op := range ops {
 		if op.Tp == newOp.Tp {
+			// `SQL DIGEST` and `PLAN DIGEST` can be specified together to watch a specific plan of a statement.
+			if op.Tp == QueryWatchType && isSQLAndPlanDigest(op, newOp) {
+				continue
+			}
 			return false
 		}
 	}
 	return true
 }
+
+func isSQLAndPlanDigest(op1, op2 *QueryWatchOption) bool {
+	if op1.BoolValue || op2.BoolValue {
+		return false
+	}
+	return (op1.IntValue == int32(model.WatchSimilar) && op2.IntValue == int32(model.WatchPlan)) ||
+		(op1.IntValue == int32(model.WatchPlan) && op2.IntValue == int32(model.WatchSimilar))
+}
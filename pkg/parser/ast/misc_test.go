@@ -157,6 +157,8 @@ func TestSensitiveStatement(t *testing.T) {
 		&ast.CreateUserStmt{},
 		&ast.AlterUserStmt{},
 		&ast.GrantStmt{},
+		&ast.CreateTableStmt{},
+		&ast.SelectStmt{},
 	}
 	for i, stmt := range positive {
 		_, ok := stmt.(ast.SensitiveStmtNode)
@@ -169,7 +171,6 @@ func TestSensitiveStatement(t *testing.T) {
 		&ast.AlterTableStmt{},
 		&ast.CreateDatabaseStmt{},
 		&ast.CreateIndexStmt{},
-		&ast.CreateTableStmt{},
 		&ast.DropDatabaseStmt{},
 		&ast.DropIndexStmt{},
 		&ast.DropTableStmt{},
@@ -243,6 +244,16 @@ func TestTableOptimizerHintRestore(t *testing.T) {
 		{"NO_ORDER_INDEX(t1@sel_1 c1)", "NO_ORDER_INDEX(`t1`@`sel_1` `c1`)"},
 		{"NO_ORDER_INDEX(test.t1@sel_1 c1)", "NO_ORDER_INDEX(`test`.`t1`@`sel_1` `c1`)"},
 		{"NO_ORDER_INDEX(test.t1@sel_1 partition(p0) c1)", "NO_ORDER_INDEX(`test`.`t1`@`sel_1` PARTITION(`p0`) `c1`)"},
+		{"INDEX(t1 c1, c2)", "INDEX(`t1` `c1`, `c2`)"},
+		{"NO_INDEX(@sel_1 t1 c1)", "NO_INDEX(@`sel_1` `t1` `c1`)"},
+		{"GROUP_INDEX(test.t1 c1)", "GROUP_INDEX(`test`.`t1` `c1`)"},
+		{"NO_GROUP_INDEX(t1@sel_1 c1)", "NO_GROUP_INDEX(`t1`@`sel_1` `c1`)"},
+		{"JOIN_INDEX(t1 partition(p0) c1)", "JOIN_INDEX(`t1` PARTITION(`p0`) `c1`)"},
+		{"NO_JOIN_INDEX(t1 c1)", "NO_JOIN_INDEX(`t1` `c1`)"},
+		{"LOOSE_INDEX_SCAN(t1 c1)", "LOOSE_INDEX_SCAN(`t1` `c1`)"},
+		{"LOOSE_INDEX_SCAN(@sel_1 test.t1 c1)", "LOOSE_INDEX_SCAN(@`sel_1` `test`.`t1` `c1`)"},
+		{"BATCH_DML(size=10000)", "BATCH_DML(SIZE = 10000)"},
+		{"SHARD_ROW_ID(4)", "SHARD_ROW_ID(4)"},
 		{"TIDB_SMJ(`t1`)", "TIDB_SMJ(`t1`)"},
 		{"TIDB_SMJ(t1)", "TIDB_SMJ(`t1`)"},
 		{"TIDB_SMJ(t1,t2)", "TIDB_SMJ(`t1`, `t2`)"},
@@ -282,6 +293,9 @@ func TestTableOptimizerHintRestore(t *testing.T) {
 		{"USE_CASCADES(TRUE)", "USE_CASCADES(TRUE)"},
 		{"USE_CASCADES(FALSE)", "USE_CASCADES(FALSE)"},
 		{"USE_CASCADES(@sel1 TRUE)", "USE_CASCADES(@`sel1` TRUE)"},
+		{"ASYNC_COMMIT(TRUE)", "ASYNC_COMMIT(TRUE)"},
+		{"ONE_PC(FALSE)", "ONE_PC(FALSE)"},
+		{"GENERATED_COLUMN_SUBSTITUTE(FALSE)", "GENERATED_COLUMN_SUBSTITUTE(FALSE)"},
 		{"QUERY_TYPE(OLAP)", "QUERY_TYPE(OLAP)"},
 		{"QUERY_TYPE(OLTP)", "QUERY_TYPE(OLTP)"},
 		{"QUERY_TYPE(@sel1 OLTP)", "QUERY_TYPE(@`sel1` OLTP)"},
@@ -295,6 +309,17 @@ func TestTableOptimizerHintRestore(t *testing.T) {
 		{"STREAM_AGG(@sel1)", "STREAM_AGG(@`sel1`)"},
 		{"AGG_TO_COP()", "AGG_TO_COP()"},
 		{"AGG_TO_COP(@sel_1)", "AGG_TO_COP(@`sel_1`)"},
+		{"DISTINCT_AGG_PUSH_DOWN()", "DISTINCT_AGG_PUSH_DOWN()"},
+		{"NO_DISTINCT_AGG_PUSH_DOWN(@sel1)", "NO_DISTINCT_AGG_PUSH_DOWN(@`sel1`)"},
+		{"APPROX_COUNT_DISTINCT()", "APPROX_COUNT_DISTINCT()"},
+		{"APPROX_COUNT_DISTINCT(@sel1)", "APPROX_COUNT_DISTINCT(@`sel1`)"},
+		{"NO_EXPAND()", "NO_EXPAND()"},
+		{"NO_EXPAND(@sel1)", "NO_EXPAND(@`sel1`)"},
+		{"MATERIALIZE_SUBQUERY()", "MATERIALIZE_SUBQUERY()"},
+		{"NO_MATERIALIZE_SUBQUERY(@sel_2)", "NO_MATERIALIZE_SUBQUERY(@`sel_2`)"},
+		{"USE_FULLTEXT_INDEX()", "USE_FULLTEXT_INDEX()"},
+		{"NO_FULLTEXT_INDEX(@sel_2)", "NO_FULLTEXT_INDEX(@`sel_2`)"},
+		{"USE_INVISIBLE_INDEXES()", "USE_INVISIBLE_INDEXES()"},
 		{"LIMIT_TO_COP()", "LIMIT_TO_COP()"},
 		{"MERGE()", "MERGE()"},
 		{"STRAIGHT_JOIN()", "STRAIGHT_JOIN()"},
@@ -302,11 +327,24 @@ func TestTableOptimizerHintRestore(t *testing.T) {
 		{"NO_INDEX_MERGE(@sel1)", "NO_INDEX_MERGE(@`sel1`)"},
 		{"READ_CONSISTENT_REPLICA()", "READ_CONSISTENT_REPLICA()"},
 		{"READ_CONSISTENT_REPLICA(@sel1)", "READ_CONSISTENT_REPLICA(@`sel1`)"},
+		{"HEDGED_READ()", "HEDGED_READ()"},
+		{"READ_LOCALITY('region=eu')", "READ_LOCALITY('region=eu')"},
+		{"READ_LOCALITY('region=eu,zone=z1', ERROR)", "READ_LOCALITY('region=eu,zone=z1', ERROR)"},
 		{"QB_NAME(sel1)", "QB_NAME(`sel1`)"},
 		{"READ_FROM_STORAGE(@sel TIFLASH[t1, t2])", "READ_FROM_STORAGE(@`sel` TIFLASH[`t1`, `t2`])"},
 		{"READ_FROM_STORAGE(@sel TIFLASH[t1 partition(p0)])", "READ_FROM_STORAGE(@`sel` TIFLASH[`t1` PARTITION(`p0`)])"},
 		{"TIME_RANGE('2020-02-02 10:10:10','2020-02-02 11:10:10')", "TIME_RANGE('2020-02-02 10:10:10', '2020-02-02 11:10:10')"},
 		{"RESOURCE_GROUP(rg1)", "RESOURCE_GROUP(`rg1`)"},
+		{"MPP_EXCHANGE_COMPRESSION(FAST)", "MPP_EXCHANGE_COMPRESSION(`FAST`)"},
+		{"FIX_CONTROL(44262:ON)", "FIX_CONTROL(44262:'ON')"},
+		{"FIX_CONTROL(44823:100, 45132:'OFF')", "FIX_CONTROL(44823:'100', 45132:'OFF')"},
+		{"FULL_SCAN(t1)", "FULL_SCAN(`t1`)"},
+		{"INDEX_ONLY(@sel1 t1, test.t2)", "INDEX_ONLY(@`sel1` `t1`, `test`.`t2`)"},
+		{"NO_FULL_SCAN(t1@sel1 partition(p0))", "NO_FULL_SCAN(`t1`@`sel1` PARTITION(`p0`))"},
+		{"SAMPLE(t1, 1 PERCENT)", "SAMPLE(`t1`, 1 PERCENT)"},
+		{"SAMPLE(@sel1 test.t1, 10 percent)", "SAMPLE(@`sel1` `test`.`t1`, 10 PERCENT)"},
+		{"INL_JOIN_PREFETCH(t1, 8)", "INL_JOIN_PREFETCH(`t1`, 8)"},
+		{"INL_JOIN_PREFETCH(@sel1 test.t1, 16)", "INL_JOIN_PREFETCH(@`sel1` `test`.`t1`, 16)"},
 		{"RESOURCE_GROUP(`default`)", "RESOURCE_GROUP(`default`)"},
 	}
 	extractNodeFunc := func(node ast.Node) ast.Node {
@@ -416,6 +454,8 @@ func TestRedactURL(t *testing.T) {
 		// underline
 		{args{"s3://bucket/file?access_key=123"}, "s3://bucket/file?access_key=xxxxxx"},
 		{args{"s3://bucket/file?secret_access_key=123"}, "s3://bucket/file?secret_access_key=xxxxxx"},
+		{args{"azure://bucket/file?account-key=123&account-name=abc"}, "azure://bucket/file?account-key=xxxxxx&account-name=abc"},
+		{args{"azblob://bucket/file?sas_token=123"}, "azblob://bucket/file?sas_token=xxxxxx"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.args.str, func(t *testing.T) {
@@ -767,6 +807,26 @@ func TestDeniedByBDR(t *testing.T) {
 		{ast.BDRRolePrimary, model.ActionRemovePartitioning, true},
 		{ast.BDRRoleSecondary, model.ActionRemovePartitioning, true},
 		{ast.BDRRoleNone, model.ActionRemovePartitioning, false},
+
+		// Roles for ActionCreateRowPolicy
+		{ast.BDRRolePrimary, model.ActionCreateRowPolicy, true},
+		{ast.BDRRoleSecondary, model.ActionCreateRowPolicy, true},
+		{ast.BDRRoleNone, model.ActionCreateRowPolicy, false},
+
+		// Roles for ActionDropRowPolicy
+		{ast.BDRRolePrimary, model.ActionDropRowPolicy, true},
+		{ast.BDRRoleSecondary, model.ActionDropRowPolicy, true},
+		{ast.BDRRoleNone, model.ActionDropRowPolicy, false},
+
+		// Roles for ActionCreateMaskingPolicy
+		{ast.BDRRolePrimary, model.ActionCreateMaskingPolicy, true},
+		{ast.BDRRoleSecondary, model.ActionCreateMaskingPolicy, true},
+		{ast.BDRRoleNone, model.ActionCreateMaskingPolicy, false},
+
+		// Roles for ActionDropMaskingPolicy
+		{ast.BDRRolePrimary, model.ActionDropMaskingPolicy, true},
+		{ast.BDRRoleSecondary, model.ActionDropMaskingPolicy, true},
+		{ast.BDRRoleNone, model.ActionDropMaskingPolicy, false},
 	}
 
 	for _, tc := range testCases {
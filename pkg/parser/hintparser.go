@@ -20,6 +20,7 @@ import __yyfmt__ "fmt"
 import (
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/model"
@@ -34,6 +35,8 @@ type yyhintSymType struct {
 	hints       []*ast.TableOptimizerHint
 	table       ast.HintTable
 	modelIdents []model.CIStr
+	fixControl  ast.HintFixControl
+	fixControls []ast.HintFixControl
 }
 
 type yyhintXError struct {
@@ -41,281 +44,370 @@ type yyhintXError struct {
 }
 
 const (
-	yyhintDefault             = 57433
-	yyhintEOFCode             = 57344
-	yyhintErrCode             = 57345
-	hintAggToCop              = 57379
-	hintBCJoin                = 57401
-	hintBKA                   = 57355
-	hintBNL                   = 57357
-	hintDupsWeedOut           = 57429
-	hintFalse                 = 57425
-	hintFirstMatch            = 57430
-	hintForceIndex            = 57415
-	hintGB                    = 57428
-	hintHashAgg               = 57381
-	hintHashJoin              = 57359
-	hintHashJoinBuild         = 57360
-	hintHashJoinProbe         = 57361
-	hintIdentifier            = 57347
-	hintIgnoreIndex           = 57384
-	hintIgnorePlanCache       = 57380
-	hintIndexHashJoin         = 57388
-	hintIndexJoin             = 57385
-	hintIndexMerge            = 57365
-	hintIndexMergeJoin        = 57392
-	hintInlHashJoin           = 57387
-	hintInlJoin               = 57390
-	hintInlMergeJoin          = 57391
-	hintIntLit                = 57346
-	hintInvalid               = 57348
-	hintJoinFixedOrder        = 57351
-	hintJoinOrder             = 57352
-	hintJoinPrefix            = 57353
-	hintJoinSuffix            = 57354
-	hintLeading               = 57417
-	hintLimitToCop            = 57414
-	hintLooseScan             = 57431
-	hintMB                    = 57427
-	hintMRR                   = 57367
-	hintMaterialization       = 57432
-	hintMaxExecutionTime      = 57375
-	hintMemoryQuota           = 57394
-	hintMerge                 = 57363
-	hintMpp1PhaseAgg          = 57382
-	hintMpp2PhaseAgg          = 57383
-	hintNoBKA                 = 57356
-	hintNoBNL                 = 57358
-	hintNoDecorrelate         = 57419
-	hintNoHashJoin            = 57362
-	hintNoICP                 = 57369
-	hintNoIndexHashJoin       = 57389
-	hintNoIndexJoin           = 57386
-	hintNoIndexMerge          = 57366
-	hintNoIndexMergeJoin      = 57393
-	hintNoMRR                 = 57368
-	hintNoMerge               = 57364
-	hintNoOrderIndex          = 57408
-	hintNoRangeOptimization   = 57370
-	hintNoSMJoin              = 57400
-	hintNoSemijoin            = 57374
-	hintNoSkipScan            = 57372
-	hintNoSwapJoinInputs      = 57395
-	hintNthPlan               = 57413
-	hintOLAP                  = 57420
-	hintOLTP                  = 57421
-	hintOrderIndex            = 57407
-	hintPartition             = 57422
-	hintQBName                = 57378
-	hintQueryType             = 57396
-	hintReadConsistentReplica = 57397
-	hintReadFromStorage       = 57398
-	hintResourceGroup         = 57377
-	hintSMJoin                = 57399
-	hintSemiJoinRewrite       = 57418
-	hintSemijoin              = 57373
-	hintSetVar                = 57376
-	hintShuffleJoin           = 57402
-	hintSingleAtIdentifier    = 57349
-	hintSkipScan              = 57371
-	hintStraightJoin          = 57416
-	hintStreamAgg             = 57403
-	hintStringLit             = 57350
-	hintSwapJoinInputs        = 57404
-	hintTiFlash               = 57424
-	hintTiKV                  = 57423
-	hintTimeRange             = 57411
-	hintTrue                  = 57426
-	hintUseCascades           = 57412
-	hintUseIndex              = 57406
-	hintUseIndexMerge         = 57405
-	hintUsePlanCache          = 57409
-	hintUseToja               = 57410
+	yyhintDefault              = 57464
+	yyhintEOFCode              = 57344
+	yyhintErrCode              = 57345
+	hintAggToCop               = 57385
+	hintApproxCountDistinct    = 57435
+	hintAsyncCommit            = 57442
+	hintBCJoin                 = 57407
+	hintBKA                    = 57355
+	hintBNL                    = 57357
+	hintBatchDML               = 57446
+	hintDistinctAggPushDown    = 57433
+	hintDupsWeedOut            = 57459
+	hintFalse                  = 57455
+	hintFirstMatch             = 57460
+	hintFixControl             = 57427
+	hintForceIndex             = 57422
+	hintFullScan               = 57428
+	hintGB                     = 57458
+	hintGeneratedColSubstitute = 57448
+	hintGroupIndex             = 57375
+	hintHashAgg                = 57387
+	hintHashJoin               = 57359
+	hintHashJoinBuild          = 57360
+	hintHashJoinProbe          = 57361
+	hintHedgedRead             = 57444
+	hintINLJoinPrefetch        = 57432
+	hintIdentifier             = 57347
+	hintIgnoreIndex            = 57390
+	hintIgnorePlanCache        = 57386
+	hintIndex                  = 57373
+	hintIndexHashJoin          = 57394
+	hintIndexJoin              = 57391
+	hintIndexMerge             = 57365
+	hintIndexMergeJoin         = 57398
+	hintIndexOnly              = 57429
+	hintInlHashJoin            = 57393
+	hintInlJoin                = 57396
+	hintInlMergeJoin           = 57397
+	hintIntLit                 = 57346
+	hintInvalid                = 57348
+	hintJoinFixedOrder         = 57351
+	hintJoinIndex              = 57377
+	hintJoinOrder              = 57352
+	hintJoinPrefix             = 57353
+	hintJoinSuffix             = 57354
+	hintLeading                = 57424
+	hintLimitToCop             = 57421
+	hintLooseIndexScan         = 57415
+	hintLooseScan              = 57461
+	hintMB                     = 57457
+	hintMRR                    = 57367
+	hintMaterialization        = 57462
+	hintMaterializeSubquery    = 57437
+	hintMaxExecutionTime       = 57381
+	hintMemoryQuota            = 57400
+	hintMerge                  = 57363
+	hintMpp1PhaseAgg           = 57388
+	hintMpp2PhaseAgg           = 57389
+	hintMppExchangeCompression = 57445
+	hintNoBKA                  = 57356
+	hintNoBNL                  = 57358
+	hintNoDecorrelate          = 57426
+	hintNoDistinctAggPushDown  = 57434
+	hintNoExpand               = 57436
+	hintNoFullScan             = 57430
+	hintNoFullTextIndex        = 57440
+	hintNoGroupIndex           = 57376
+	hintNoHashJoin             = 57362
+	hintNoICP                  = 57369
+	hintNoIndex                = 57374
+	hintNoIndexHashJoin        = 57395
+	hintNoIndexJoin            = 57392
+	hintNoIndexMerge           = 57366
+	hintNoIndexMergeJoin       = 57399
+	hintNoJoinIndex            = 57378
+	hintNoMRR                  = 57368
+	hintNoMaterializeSubquery  = 57438
+	hintNoMerge                = 57364
+	hintNoOrderIndex           = 57414
+	hintNoRangeOptimization    = 57370
+	hintNoSMJoin               = 57406
+	hintNoSemijoin             = 57380
+	hintNoSkipScan             = 57372
+	hintNoSwapJoinInputs       = 57401
+	hintNthPlan                = 57420
+	hintOLAP                   = 57450
+	hintOLTP                   = 57451
+	hintOnePC                  = 57443
+	hintOrderIndex             = 57413
+	hintPartition              = 57452
+	hintPercent                = 57463
+	hintQBName                 = 57384
+	hintQueryType              = 57402
+	hintReadConsistentReplica  = 57403
+	hintReadFromStorage        = 57404
+	hintReadLocality           = 57449
+	hintResourceGroup          = 57383
+	hintSMJoin                 = 57405
+	hintSample                 = 57431
+	hintSemiJoinRewrite        = 57425
+	hintSemijoin               = 57379
+	hintSetVar                 = 57382
+	hintShardRowID             = 57447
+	hintShuffleJoin            = 57408
+	hintSingleAtIdentifier     = 57349
+	hintSkipScan               = 57371
+	hintStraightJoin           = 57423
+	hintStreamAgg              = 57409
+	hintStringLit              = 57350
+	hintSwapJoinInputs         = 57410
+	hintTiFlash                = 57454
+	hintTiKV                   = 57453
+	hintTimeRange              = 57418
+	hintTrue                   = 57456
+	hintUseCascades            = 57419
+	hintUseFullTextIndex       = 57439
+	hintUseIndex               = 57412
+	hintUseIndexMerge          = 57411
+	hintUseInvisibleIndexes    = 57441
+	hintUsePlanCache           = 57416
+	hintUseToja                = 57417
 
 	yyhintMaxDepth = 200
-	yyhintTabOfs   = -217
+	yyhintTabOfs   = -282
 )
 
 var (
 	yyhintXLAT = map[int]int{
-		41:    0,   // ')' (162x)
-		57379: 1,   // hintAggToCop (151x)
-		57401: 2,   // hintBCJoin (151x)
-		57355: 3,   // hintBKA (151x)
-		57357: 4,   // hintBNL (151x)
-		57415: 5,   // hintForceIndex (151x)
-		57381: 6,   // hintHashAgg (151x)
-		57359: 7,   // hintHashJoin (151x)
-		57360: 8,   // hintHashJoinBuild (151x)
-		57361: 9,   // hintHashJoinProbe (151x)
-		57347: 10,  // hintIdentifier (151x)
-		57384: 11,  // hintIgnoreIndex (151x)
-		57380: 12,  // hintIgnorePlanCache (151x)
-		57388: 13,  // hintIndexHashJoin (151x)
-		57385: 14,  // hintIndexJoin (151x)
-		57365: 15,  // hintIndexMerge (151x)
-		57392: 16,  // hintIndexMergeJoin (151x)
-		57387: 17,  // hintInlHashJoin (151x)
-		57390: 18,  // hintInlJoin (151x)
-		57391: 19,  // hintInlMergeJoin (151x)
-		57351: 20,  // hintJoinFixedOrder (151x)
-		57352: 21,  // hintJoinOrder (151x)
-		57353: 22,  // hintJoinPrefix (151x)
-		57354: 23,  // hintJoinSuffix (151x)
-		57417: 24,  // hintLeading (151x)
-		57414: 25,  // hintLimitToCop (151x)
-		57375: 26,  // hintMaxExecutionTime (151x)
-		57394: 27,  // hintMemoryQuota (151x)
-		57363: 28,  // hintMerge (151x)
-		57382: 29,  // hintMpp1PhaseAgg (151x)
-		57383: 30,  // hintMpp2PhaseAgg (151x)
-		57367: 31,  // hintMRR (151x)
-		57356: 32,  // hintNoBKA (151x)
-		57358: 33,  // hintNoBNL (151x)
-		57419: 34,  // hintNoDecorrelate (151x)
-		57362: 35,  // hintNoHashJoin (151x)
-		57369: 36,  // hintNoICP (151x)
-		57389: 37,  // hintNoIndexHashJoin (151x)
-		57386: 38,  // hintNoIndexJoin (151x)
-		57366: 39,  // hintNoIndexMerge (151x)
-		57393: 40,  // hintNoIndexMergeJoin (151x)
-		57364: 41,  // hintNoMerge (151x)
-		57368: 42,  // hintNoMRR (151x)
-		57408: 43,  // hintNoOrderIndex (151x)
-		57370: 44,  // hintNoRangeOptimization (151x)
-		57374: 45,  // hintNoSemijoin (151x)
-		57372: 46,  // hintNoSkipScan (151x)
-		57400: 47,  // hintNoSMJoin (151x)
-		57395: 48,  // hintNoSwapJoinInputs (151x)
-		57413: 49,  // hintNthPlan (151x)
-		57407: 50,  // hintOrderIndex (151x)
-		57378: 51,  // hintQBName (151x)
-		57396: 52,  // hintQueryType (151x)
-		57397: 53,  // hintReadConsistentReplica (151x)
-		57398: 54,  // hintReadFromStorage (151x)
-		57377: 55,  // hintResourceGroup (151x)
-		57373: 56,  // hintSemijoin (151x)
-		57418: 57,  // hintSemiJoinRewrite (151x)
-		57376: 58,  // hintSetVar (151x)
-		57402: 59,  // hintShuffleJoin (151x)
-		57371: 60,  // hintSkipScan (151x)
-		57399: 61,  // hintSMJoin (151x)
-		57416: 62,  // hintStraightJoin (151x)
-		57403: 63,  // hintStreamAgg (151x)
-		57404: 64,  // hintSwapJoinInputs (151x)
-		57411: 65,  // hintTimeRange (151x)
-		57412: 66,  // hintUseCascades (151x)
-		57406: 67,  // hintUseIndex (151x)
-		57405: 68,  // hintUseIndexMerge (151x)
-		57409: 69,  // hintUsePlanCache (151x)
-		57410: 70,  // hintUseToja (151x)
-		44:    71,  // ',' (145x)
-		57429: 72,  // hintDupsWeedOut (124x)
-		57430: 73,  // hintFirstMatch (124x)
-		57431: 74,  // hintLooseScan (124x)
-		57432: 75,  // hintMaterialization (124x)
-		57424: 76,  // hintTiFlash (124x)
-		57423: 77,  // hintTiKV (124x)
-		57425: 78,  // hintFalse (123x)
-		57420: 79,  // hintOLAP (123x)
-		57421: 80,  // hintOLTP (123x)
-		57426: 81,  // hintTrue (123x)
-		57428: 82,  // hintGB (122x)
-		57427: 83,  // hintMB (122x)
-		57349: 84,  // hintSingleAtIdentifier (103x)
-		57346: 85,  // hintIntLit (100x)
-		93:    86,  // ']' (93x)
-		46:    87,  // '.' (92x)
-		57422: 88,  // hintPartition (87x)
-		61:    89,  // '=' (84x)
-		40:    90,  // '(' (79x)
-		57344: 91,  // $end (29x)
-		57453: 92,  // QueryBlockOpt (21x)
-		57445: 93,  // Identifier (18x)
-		57350: 94,  // hintStringLit (6x)
-		57435: 95,  // CommaOpt (5x)
-		57441: 96,  // HintTable (4x)
-		57442: 97,  // HintTableList (4x)
-		91:    98,  // '[' (3x)
-		43:    99,  // '+' (2x)
-		45:    100, // '-' (2x)
-		57434: 101, // BooleanHintName (2x)
-		57436: 102, // HintIndexList (2x)
-		57438: 103, // HintStorageType (2x)
-		57439: 104, // HintStorageTypeAndTable (2x)
-		57443: 105, // HintTableListOpt (2x)
-		57448: 106, // JoinOrderOptimizerHintName (2x)
-		57449: 107, // NullaryHintName (2x)
-		57451: 108, // PartitionList (2x)
-		57452: 109, // PartitionListOpt (2x)
-		57455: 110, // StorageOptimizerHintOpt (2x)
-		57456: 111, // SubqueryOptimizerHintName (2x)
-		57459: 112, // SubqueryStrategy (2x)
-		57460: 113, // SupportedIndexLevelOptimizerHintName (2x)
-		57461: 114, // SupportedTableLevelOptimizerHintName (2x)
-		57462: 115, // TableOptimizerHintOpt (2x)
-		57464: 116, // UnsupportedIndexLevelOptimizerHintName (2x)
-		57465: 117, // UnsupportedTableLevelOptimizerHintName (2x)
-		57466: 118, // Value (2x)
-		57467: 119, // ViewName (2x)
-		57437: 120, // HintQueryType (1x)
-		57440: 121, // HintStorageTypeAndTableList (1x)
-		57444: 122, // HintTrueOrFalse (1x)
-		57446: 123, // IndexNameList (1x)
-		57447: 124, // IndexNameListOpt (1x)
-		57450: 125, // OptimizerHintList (1x)
-		57454: 126, // Start (1x)
-		57457: 127, // SubqueryStrategies (1x)
-		57458: 128, // SubqueryStrategiesOpt (1x)
-		57463: 129, // UnitOfBytes (1x)
-		57468: 130, // ViewNameList (1x)
-		57433: 131, // $default (0x)
-		57345: 132, // error (0x)
-		57348: 133, // hintInvalid (0x)
+		41:    0,   // ')' (204x)
+		57385: 1,   // hintAggToCop (198x)
+		57435: 2,   // hintApproxCountDistinct (198x)
+		57442: 3,   // hintAsyncCommit (198x)
+		57446: 4,   // hintBatchDML (198x)
+		57407: 5,   // hintBCJoin (198x)
+		57355: 6,   // hintBKA (198x)
+		57357: 7,   // hintBNL (198x)
+		57433: 8,   // hintDistinctAggPushDown (198x)
+		57427: 9,   // hintFixControl (198x)
+		57422: 10,  // hintForceIndex (198x)
+		57428: 11,  // hintFullScan (198x)
+		57448: 12,  // hintGeneratedColSubstitute (198x)
+		57375: 13,  // hintGroupIndex (198x)
+		57387: 14,  // hintHashAgg (198x)
+		57359: 15,  // hintHashJoin (198x)
+		57360: 16,  // hintHashJoinBuild (198x)
+		57361: 17,  // hintHashJoinProbe (198x)
+		57444: 18,  // hintHedgedRead (198x)
+		57347: 19,  // hintIdentifier (198x)
+		57390: 20,  // hintIgnoreIndex (198x)
+		57386: 21,  // hintIgnorePlanCache (198x)
+		57373: 22,  // hintIndex (198x)
+		57394: 23,  // hintIndexHashJoin (198x)
+		57391: 24,  // hintIndexJoin (198x)
+		57365: 25,  // hintIndexMerge (198x)
+		57398: 26,  // hintIndexMergeJoin (198x)
+		57429: 27,  // hintIndexOnly (198x)
+		57393: 28,  // hintInlHashJoin (198x)
+		57396: 29,  // hintInlJoin (198x)
+		57432: 30,  // hintINLJoinPrefetch (198x)
+		57397: 31,  // hintInlMergeJoin (198x)
+		57351: 32,  // hintJoinFixedOrder (198x)
+		57377: 33,  // hintJoinIndex (198x)
+		57352: 34,  // hintJoinOrder (198x)
+		57353: 35,  // hintJoinPrefix (198x)
+		57354: 36,  // hintJoinSuffix (198x)
+		57424: 37,  // hintLeading (198x)
+		57421: 38,  // hintLimitToCop (198x)
+		57415: 39,  // hintLooseIndexScan (198x)
+		57437: 40,  // hintMaterializeSubquery (198x)
+		57381: 41,  // hintMaxExecutionTime (198x)
+		57400: 42,  // hintMemoryQuota (198x)
+		57363: 43,  // hintMerge (198x)
+		57388: 44,  // hintMpp1PhaseAgg (198x)
+		57389: 45,  // hintMpp2PhaseAgg (198x)
+		57445: 46,  // hintMppExchangeCompression (198x)
+		57367: 47,  // hintMRR (198x)
+		57356: 48,  // hintNoBKA (198x)
+		57358: 49,  // hintNoBNL (198x)
+		57426: 50,  // hintNoDecorrelate (198x)
+		57434: 51,  // hintNoDistinctAggPushDown (198x)
+		57436: 52,  // hintNoExpand (198x)
+		57430: 53,  // hintNoFullScan (198x)
+		57440: 54,  // hintNoFullTextIndex (198x)
+		57376: 55,  // hintNoGroupIndex (198x)
+		57362: 56,  // hintNoHashJoin (198x)
+		57369: 57,  // hintNoICP (198x)
+		57374: 58,  // hintNoIndex (198x)
+		57395: 59,  // hintNoIndexHashJoin (198x)
+		57392: 60,  // hintNoIndexJoin (198x)
+		57366: 61,  // hintNoIndexMerge (198x)
+		57399: 62,  // hintNoIndexMergeJoin (198x)
+		57378: 63,  // hintNoJoinIndex (198x)
+		57438: 64,  // hintNoMaterializeSubquery (198x)
+		57364: 65,  // hintNoMerge (198x)
+		57368: 66,  // hintNoMRR (198x)
+		57414: 67,  // hintNoOrderIndex (198x)
+		57370: 68,  // hintNoRangeOptimization (198x)
+		57380: 69,  // hintNoSemijoin (198x)
+		57372: 70,  // hintNoSkipScan (198x)
+		57406: 71,  // hintNoSMJoin (198x)
+		57401: 72,  // hintNoSwapJoinInputs (198x)
+		57420: 73,  // hintNthPlan (198x)
+		57443: 74,  // hintOnePC (198x)
+		57413: 75,  // hintOrderIndex (198x)
+		57384: 76,  // hintQBName (198x)
+		57402: 77,  // hintQueryType (198x)
+		57403: 78,  // hintReadConsistentReplica (198x)
+		57404: 79,  // hintReadFromStorage (198x)
+		57449: 80,  // hintReadLocality (198x)
+		57383: 81,  // hintResourceGroup (198x)
+		57431: 82,  // hintSample (198x)
+		57379: 83,  // hintSemijoin (198x)
+		57425: 84,  // hintSemiJoinRewrite (198x)
+		57382: 85,  // hintSetVar (198x)
+		57447: 86,  // hintShardRowID (198x)
+		57408: 87,  // hintShuffleJoin (198x)
+		57371: 88,  // hintSkipScan (198x)
+		57405: 89,  // hintSMJoin (198x)
+		57423: 90,  // hintStraightJoin (198x)
+		57409: 91,  // hintStreamAgg (198x)
+		57410: 92,  // hintSwapJoinInputs (198x)
+		57418: 93,  // hintTimeRange (198x)
+		57419: 94,  // hintUseCascades (198x)
+		57439: 95,  // hintUseFullTextIndex (198x)
+		57412: 96,  // hintUseIndex (198x)
+		57411: 97,  // hintUseIndexMerge (198x)
+		57441: 98,  // hintUseInvisibleIndexes (198x)
+		57416: 99,  // hintUsePlanCache (198x)
+		57417: 100, // hintUseToja (198x)
+		44:    101, // ',' (196x)
+		57459: 102, // hintDupsWeedOut (163x)
+		57460: 103, // hintFirstMatch (163x)
+		57461: 104, // hintLooseScan (163x)
+		57462: 105, // hintMaterialization (163x)
+		57454: 106, // hintTiFlash (163x)
+		57453: 107, // hintTiKV (163x)
+		57455: 108, // hintFalse (162x)
+		57450: 109, // hintOLAP (162x)
+		57451: 110, // hintOLTP (162x)
+		57456: 111, // hintTrue (162x)
+		57458: 112, // hintGB (161x)
+		57457: 113, // hintMB (161x)
+		57463: 114, // hintPercent (161x)
+		57346: 115, // hintIntLit (138x)
+		57349: 116, // hintSingleAtIdentifier (136x)
+		93:    117, // ']' (124x)
+		46:    118, // '.' (123x)
+		57452: 119, // hintPartition (118x)
+		61:    120, // '=' (116x)
+		40:    121, // '(' (109x)
+		57344: 122, // $end (37x)
+		57478: 123, // Identifier (24x)
+		57486: 124, // QueryBlockOpt (23x)
+		57350: 125, // hintStringLit (8x)
+		57474: 126, // HintTable (6x)
+		57466: 127, // CommaOpt (5x)
+		57475: 128, // HintTableList (4x)
+		43:    129, // '+' (3x)
+		45:    130, // '-' (3x)
+		91:    131, // '[' (3x)
+		57499: 132, // Value (3x)
+		57465: 133, // BooleanHintName (2x)
+		57467: 134, // FixControl (2x)
+		57469: 135, // HintIndexList (2x)
+		57471: 136, // HintStorageType (2x)
+		57472: 137, // HintStorageTypeAndTable (2x)
+		57476: 138, // HintTableListOpt (2x)
+		57481: 139, // JoinOrderOptimizerHintName (2x)
+		57482: 140, // NullaryHintName (2x)
+		57484: 141, // PartitionList (2x)
+		57485: 142, // PartitionListOpt (2x)
+		57488: 143, // StorageOptimizerHintOpt (2x)
+		57489: 144, // SubqueryOptimizerHintName (2x)
+		57492: 145, // SubqueryStrategy (2x)
+		57493: 146, // SupportedIndexLevelOptimizerHintName (2x)
+		57494: 147, // SupportedTableLevelOptimizerHintName (2x)
+		57495: 148, // TableOptimizerHintOpt (2x)
+		57497: 149, // UnsupportedIndexLevelOptimizerHintName (2x)
+		57498: 150, // UnsupportedTableLevelOptimizerHintName (2x)
+		57500: 151, // ViewName (2x)
+		58:    152, // ':' (1x)
+		57468: 153, // FixControlList (1x)
+		57470: 154, // HintQueryType (1x)
+		57473: 155, // HintStorageTypeAndTableList (1x)
+		57477: 156, // HintTrueOrFalse (1x)
+		57479: 157, // IndexNameList (1x)
+		57480: 158, // IndexNameListOpt (1x)
+		57483: 159, // OptimizerHintList (1x)
+		57487: 160, // Start (1x)
+		57490: 161, // SubqueryStrategies (1x)
+		57491: 162, // SubqueryStrategiesOpt (1x)
+		57496: 163, // UnitOfBytes (1x)
+		57501: 164, // ViewNameList (1x)
+		57464: 165, // $default (0x)
+		57345: 166, // error (0x)
+		57348: 167, // hintInvalid (0x)
 	}
 
 	yyhintSymNames = []string{
 		"')'",
 		"hintAggToCop",
+		"hintApproxCountDistinct",
+		"hintAsyncCommit",
+		"hintBatchDML",
 		"hintBCJoin",
 		"hintBKA",
 		"hintBNL",
+		"hintDistinctAggPushDown",
+		"hintFixControl",
 		"hintForceIndex",
+		"hintFullScan",
+		"hintGeneratedColSubstitute",
+		"hintGroupIndex",
 		"hintHashAgg",
 		"hintHashJoin",
 		"hintHashJoinBuild",
 		"hintHashJoinProbe",
+		"hintHedgedRead",
 		"hintIdentifier",
 		"hintIgnoreIndex",
 		"hintIgnorePlanCache",
+		"hintIndex",
 		"hintIndexHashJoin",
 		"hintIndexJoin",
 		"hintIndexMerge",
 		"hintIndexMergeJoin",
+		"hintIndexOnly",
 		"hintInlHashJoin",
 		"hintInlJoin",
+		"hintINLJoinPrefetch",
 		"hintInlMergeJoin",
 		"hintJoinFixedOrder",
+		"hintJoinIndex",
 		"hintJoinOrder",
 		"hintJoinPrefix",
 		"hintJoinSuffix",
 		"hintLeading",
 		"hintLimitToCop",
+		"hintLooseIndexScan",
+		"hintMaterializeSubquery",
 		"hintMaxExecutionTime",
 		"hintMemoryQuota",
 		"hintMerge",
 		"hintMpp1PhaseAgg",
 		"hintMpp2PhaseAgg",
+		"hintMppExchangeCompression",
 		"hintMRR",
 		"hintNoBKA",
 		"hintNoBNL",
 		"hintNoDecorrelate",
+		"hintNoDistinctAggPushDown",
+		"hintNoExpand",
+		"hintNoFullScan",
+		"hintNoFullTextIndex",
+		"hintNoGroupIndex",
 		"hintNoHashJoin",
 		"hintNoICP",
+		"hintNoIndex",
 		"hintNoIndexHashJoin",
 		"hintNoIndexJoin",
 		"hintNoIndexMerge",
 		"hintNoIndexMergeJoin",
+		"hintNoJoinIndex",
+		"hintNoMaterializeSubquery",
 		"hintNoMerge",
 		"hintNoMRR",
 		"hintNoOrderIndex",
@@ -325,15 +417,19 @@ var (
 		"hintNoSMJoin",
 		"hintNoSwapJoinInputs",
 		"hintNthPlan",
+		"hintOnePC",
 		"hintOrderIndex",
 		"hintQBName",
 		"hintQueryType",
 		"hintReadConsistentReplica",
 		"hintReadFromStorage",
+		"hintReadLocality",
 		"hintResourceGroup",
+		"hintSample",
 		"hintSemijoin",
 		"hintSemiJoinRewrite",
 		"hintSetVar",
+		"hintShardRowID",
 		"hintShuffleJoin",
 		"hintSkipScan",
 		"hintSMJoin",
@@ -342,8 +438,10 @@ var (
 		"hintSwapJoinInputs",
 		"hintTimeRange",
 		"hintUseCascades",
+		"hintUseFullTextIndex",
 		"hintUseIndex",
 		"hintUseIndexMerge",
+		"hintUseInvisibleIndexes",
 		"hintUsePlanCache",
 		"hintUseToja",
 		"','",
@@ -359,24 +457,27 @@ var (
 		"hintTrue",
 		"hintGB",
 		"hintMB",
-		"hintSingleAtIdentifier",
+		"hintPercent",
 		"hintIntLit",
+		"hintSingleAtIdentifier",
 		"']'",
 		"'.'",
 		"hintPartition",
 		"'='",
 		"'('",
 		"$end",
-		"QueryBlockOpt",
 		"Identifier",
+		"QueryBlockOpt",
 		"hintStringLit",
-		"CommaOpt",
 		"HintTable",
+		"CommaOpt",
 		"HintTableList",
-		"'['",
 		"'+'",
 		"'-'",
+		"'['",
+		"Value",
 		"BooleanHintName",
+		"FixControl",
 		"HintIndexList",
 		"HintStorageType",
 		"HintStorageTypeAndTable",
@@ -393,8 +494,9 @@ var (
 		"TableOptimizerHintOpt",
 		"UnsupportedIndexLevelOptimizerHintName",
 		"UnsupportedTableLevelOptimizerHintName",
-		"Value",
 		"ViewName",
+		"':'",
+		"FixControlList",
 		"HintQueryType",
 		"HintStorageTypeAndTableList",
 		"HintTrueOrFalse",
@@ -413,607 +515,792 @@ var (
 
 	yyhintReductions = []struct{ xsym, components int }{
 		{0, 1},
-		{126, 1},
-		{125, 1},
-		{125, 3},
-		{125, 1},
-		{125, 3},
-		{115, 4},
-		{115, 4},
-		{115, 4},
-		{115, 4},
-		{115, 4},
-		{115, 4},
-		{115, 5},
-		{115, 5},
-		{115, 5},
-		{115, 6},
-		{115, 4},
-		{115, 4},
-		{115, 6},
-		{115, 6},
-		{115, 6},
-		{115, 5},
-		{115, 4},
-		{115, 5},
-		{115, 5},
-		{115, 4},
-		{115, 6},
-		{115, 6},
-		{110, 5},
-		{121, 1},
-		{121, 3},
-		{104, 4},
-		{92, 0},
-		{92, 1},
-		{95, 0},
-		{95, 1},
-		{109, 0},
-		{109, 4},
-		{108, 1},
-		{108, 3},
-		{105, 1},
-		{105, 1},
-		{97, 2},
-		{97, 3},
-		{96, 3},
-		{96, 5},
-		{130, 3},
-		{130, 1},
-		{119, 2},
-		{119, 1},
-		{102, 4},
+		{160, 1},
+		{159, 1},
+		{159, 3},
+		{159, 1},
+		{159, 3},
+		{148, 4},
+		{148, 4},
+		{148, 4},
+		{148, 4},
+		{148, 4},
+		{148, 4},
+		{148, 5},
+		{148, 5},
+		{148, 8},
+		{148, 7},
+		{148, 5},
+		{148, 6},
+		{148, 6},
+		{148, 4},
+		{148, 4},
+		{148, 4},
+		{148, 4},
+		{148, 4},
+		{148, 6},
+		{148, 6},
+		{148, 4},
+		{148, 6},
+		{148, 6},
+		{148, 5},
+		{148, 4},
+		{148, 5},
+		{148, 5},
+		{148, 4},
+		{148, 6},
+		{148, 6},
+		{143, 5},
+		{155, 1},
+		{155, 3},
+		{137, 4},
 		{124, 0},
 		{124, 1},
-		{123, 1},
-		{123, 3},
-		{128, 0},
-		{128, 1},
+		{127, 0},
 		{127, 1},
-		{127, 3},
-		{118, 1},
-		{118, 1},
-		{118, 1},
-		{118, 2},
-		{118, 2},
-		{129, 1},
-		{129, 1},
-		{122, 1},
-		{122, 1},
-		{106, 1},
-		{106, 1},
-		{106, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{117, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{114, 1},
-		{116, 1},
-		{116, 1},
-		{116, 1},
-		{116, 1},
-		{116, 1},
-		{116, 1},
-		{116, 1},
-		{113, 1},
-		{113, 1},
-		{113, 1},
-		{113, 1},
-		{113, 1},
-		{113, 1},
-		{111, 1},
-		{111, 1},
-		{112, 1},
-		{112, 1},
-		{112, 1},
-		{112, 1},
-		{101, 1},
-		{101, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{107, 1},
-		{120, 1},
-		{120, 1},
-		{103, 1},
-		{103, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
-		{93, 1},
+		{142, 0},
+		{142, 4},
+		{141, 1},
+		{141, 3},
+		{138, 1},
+		{138, 1},
+		{128, 2},
+		{128, 3},
+		{126, 3},
+		{126, 5},
+		{164, 3},
+		{164, 1},
+		{151, 2},
+		{151, 1},
+		{135, 4},
+		{158, 0},
+		{158, 1},
+		{157, 1},
+		{157, 3},
+		{162, 0},
+		{162, 1},
+		{161, 1},
+		{161, 3},
+		{132, 1},
+		{132, 1},
+		{132, 1},
+		{132, 2},
+		{132, 2},
+		{153, 1},
+		{153, 3},
+		{134, 3},
+		{163, 1},
+		{163, 1},
+		{156, 1},
+		{156, 1},
+		{139, 1},
+		{139, 1},
+		{139, 1},
+		{150, 1},
+		{150, 1},
+		{150, 1},
+		{150, 1},
+		{150, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{147, 1},
+		{149, 1},
+		{149, 1},
+		{149, 1},
+		{149, 1},
+		{149, 1},
+		{149, 1},
+		{149, 1},
+		{146, 1},
+		{146, 1},
+		{146, 1},
+		{146, 1},
+		{146, 1},
+		{146, 1},
+		{146, 1},
+		{146, 1},
+		{146, 1},
+		{146, 1},
+		{146, 1},
+		{146, 1},
+		{146, 1},
+		{144, 1},
+		{144, 1},
+		{145, 1},
+		{145, 1},
+		{145, 1},
+		{145, 1},
+		{133, 1},
+		{133, 1},
+		{133, 1},
+		{133, 1},
+		{133, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{140, 1},
+		{154, 1},
+		{154, 1},
+		{136, 1},
+		{136, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
+		{123, 1},
 	}
 
 	yyhintXErrors = map[yyhintXError]string{}
 
-	yyhintParseTab = [316][]uint16{
+	yyhintParseTab = [416][]uint16{
 		// 0
-		{1: 292, 251, 244, 246, 280, 288, 265, 267, 268, 239, 278, 296, 258, 254, 270, 263, 257, 253, 262, 222, 241, 242, 243, 269, 293, 229, 234, 256, 289, 290, 271, 245, 247, 299, 266, 273, 259, 255, 294, 264, 248, 272, 282, 274, 284, 276, 250, 261, 230, 281, 233, 238, 295, 240, 232, 283, 298, 231, 252, 275, 249, 297, 291, 260, 235, 286, 277, 279, 287, 285, 101: 236, 106: 223, 237, 110: 221, 228, 113: 227, 225, 220, 226, 224, 125: 219, 218},
-		{91: 217},
-		{1: 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 404, 91: 216, 95: 530},
-		{1: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 91: 215},
-		{1: 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 91: 213},
+		{1: 377, 387, 369, 299, 323, 316, 318, 385, 301, 355, 342, 371, 361, 373, 337, 339, 340, 394, 311, 353, 381, 359, 330, 326, 345, 335, 343, 329, 325, 296, 334, 287, 363, 313, 314, 315, 341, 378, 358, 389, 294, 305, 328, 374, 375, 303, 346, 317, 319, 384, 386, 388, 344, 392, 362, 338, 348, 360, 331, 327, 379, 336, 364, 390, 320, 347, 357, 349, 366, 351, 322, 333, 297, 370, 356, 304, 310, 380, 312, 306, 302, 295, 365, 383, 298, 300, 324, 350, 321, 382, 376, 332, 307, 368, 391, 352, 354, 393, 372, 367, 133: 308, 139: 288, 309, 143: 286, 293, 146: 292, 290, 285, 291, 289, 159: 284, 283},
+		{122: 282},
+		{1: 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 530, 122: 281, 127: 695},
+		{1: 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 280, 122: 280},
+		{1: 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 278, 122: 278},
 		// 5
-		{90: 527},
-		{90: 524},
-		{90: 521},
-		{90: 516},
-		{90: 513},
+		{121: 692},
+		{121: 689},
+		{121: 686},
+		{121: 681},
+		{121: 678},
 		// 10
-		{90: 502},
-		{90: 490},
-		{90: 486},
-		{90: 482},
-		{90: 477},
+		{121: 667},
+		{121: 655},
+		{121: 651},
+		{121: 644},
+		{121: 638},
 		// 15
-		{90: 474},
-		{90: 462},
-		{90: 455},
-		{90: 450},
-		{90: 444},
+		{121: 634},
+		{121: 629},
+		{121: 624},
+		{121: 621},
+		{121: 612},
 		// 20
-		{90: 441},
-		{90: 435},
-		{90: 415},
-		{90: 300},
-		{90: 149},
+		{121: 609},
+		{121: 606},
+		{121: 594},
+		{121: 587},
+		{121: 581},
 		// 25
-		{90: 148},
-		{90: 147},
-		{90: 146},
-		{90: 145},
-		{90: 144},
+		{121: 576},
+		{121: 570},
+		{121: 567},
+		{121: 561},
+		{121: 541},
 		// 30
-		{90: 143},
-		{90: 142},
-		{90: 141},
-		{90: 140},
-		{90: 139},
+		{121: 395},
+		{121: 203},
+		{121: 202},
+		{121: 201},
+		{121: 200},
 		// 35
-		{90: 138},
-		{90: 137},
-		{90: 136},
-		{90: 135},
-		{90: 134},
+		{121: 199},
+		{121: 198},
+		{121: 197},
+		{121: 196},
+		{121: 195},
 		// 40
-		{90: 133},
-		{90: 132},
-		{90: 131},
-		{90: 130},
-		{90: 129},
+		{121: 194},
+		{121: 193},
+		{121: 192},
+		{121: 191},
+		{121: 190},
 		// 45
-		{90: 128},
-		{90: 127},
-		{90: 126},
-		{90: 125},
-		{90: 124},
+		{121: 189},
+		{121: 188},
+		{121: 187},
+		{121: 186},
+		{121: 185},
 		// 50
-		{90: 123},
-		{90: 122},
-		{90: 121},
-		{90: 120},
-		{90: 119},
+		{121: 184},
+		{121: 183},
+		{121: 182},
+		{121: 181},
+		{121: 180},
 		// 55
-		{90: 118},
-		{90: 117},
-		{90: 116},
-		{90: 115},
-		{90: 114},
+		{121: 179},
+		{121: 178},
+		{121: 177},
+		{121: 176},
+		{121: 175},
 		// 60
-		{90: 113},
-		{90: 112},
-		{90: 111},
-		{90: 110},
-		{90: 109},
+		{121: 174},
+		{121: 173},
+		{121: 172},
+		{121: 171},
+		{121: 170},
 		// 65
-		{90: 108},
-		{90: 107},
-		{90: 106},
-		{90: 101},
-		{90: 100},
+		{121: 169},
+		{121: 168},
+		{121: 167},
+		{121: 166},
+		{121: 165},
 		// 70
-		{90: 99},
-		{90: 98},
-		{90: 97},
-		{90: 96},
-		{90: 95},
+		{121: 164},
+		{121: 163},
+		{121: 162},
+		{121: 161},
+		{121: 160},
 		// 75
-		{90: 94},
-		{90: 93},
-		{90: 92},
-		{90: 91},
-		{90: 90},
+		{121: 159},
+		{121: 158},
+		{121: 157},
+		{121: 156},
+		{121: 155},
 		// 80
-		{90: 89},
-		{90: 88},
-		{90: 87},
-		{76: 185, 185, 84: 302, 92: 301},
-		{76: 307, 306, 103: 305, 304, 121: 303},
+		{121: 154},
+		{121: 153},
+		{121: 152},
+		{121: 151},
+		{121: 150},
 		// 85
-		{184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 85: 184, 184, 184, 184},
-		{412, 71: 413},
-		{188, 71: 188},
-		{98: 308},
-		{98: 84},
+		{121: 145},
+		{121: 144},
+		{121: 143},
+		{121: 142},
+		{121: 141},
 		// 90
-		{98: 83},
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 310, 97: 309},
-		{71: 410, 86: 409},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 312, 96: 311},
-		{175, 71: 175, 86: 175},
+		{121: 140},
+		{121: 139},
+		{121: 138},
+		{121: 137},
+		{121: 136},
 		// 95
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 86: 185, 396, 185, 92: 395},
-		{82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82},
-		{81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81},
-		{80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80},
-		{79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79},
+		{121: 135},
+		{121: 134},
+		{121: 133},
+		{121: 132},
+		{121: 131},
 		// 100
-		{78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78},
-		{77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77},
-		{76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76},
-		{75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75},
-		{74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74},
+		{121: 130},
+		{121: 129},
+		{121: 128},
+		{121: 127},
+		{121: 126},
 		// 105
-		{73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73},
-		{72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72},
-		{71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71},
-		{70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70},
-		{69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69},
+		{121: 125},
+		{121: 124},
+		{121: 123},
+		{121: 122},
+		{121: 121},
 		// 110
-		{68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68},
-		{67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67},
-		{66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66},
-		{65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65},
-		{64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64},
+		{121: 120},
+		{121: 119},
+		{121: 118},
+		{106: 242, 242, 116: 397, 124: 396},
+		{106: 402, 401, 136: 400, 399, 155: 398},
 		// 115
-		{63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63},
-		{62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62},
-		{61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61},
-		{60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60},
-		{59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
+		{241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 117: 241, 241, 241},
+		{538, 101: 539},
+		{245, 101: 245},
+		{131: 403},
+		{131: 115},
 		// 120
-		{58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58},
-		{57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57},
-		{56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
-		{55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
-		{54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
+		{131: 114},
+		{1: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 102: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 116: 397, 124: 405, 128: 404},
+		{101: 536, 117: 535},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 407, 126: 406},
+		{232, 101: 232, 117: 232},
 		// 125
-		{53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
-		{52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
-		{51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
-		{50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50},
-		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49},
+		{242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 116: 397, 242, 522, 242, 124: 521},
+		{113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113},
+		{112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112},
+		{111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111},
+		{110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110},
 		// 130
-		{48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48},
-		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47},
-		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46},
-		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
-		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44},
+		{109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109},
+		{108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108},
+		{107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107},
+		{106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106},
+		{105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105},
 		// 135
-		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43},
-		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42},
-		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41},
-		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40},
-		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39},
+		{104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104},
+		{103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103},
+		{102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102},
+		{101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101},
+		{100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100},
 		// 140
-		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38},
-		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37},
-		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36},
-		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35},
-		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34},
+		{99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99},
+		{98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98},
+		{97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97},
+		{96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96},
+		{95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95},
 		// 145
-		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33},
-		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32},
-		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31},
-		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
-		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29},
+		{94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94},
+		{93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93},
+		{92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92},
+		{91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91},
+		{90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90},
 		// 150
-		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
-		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27},
-		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26},
-		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25},
-		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24},
+		{89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89},
+		{88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88},
+		{87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87},
+		{86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86},
+		{85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85},
 		// 155
-		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23},
-		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22},
-		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21},
-		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20},
-		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19},
+		{84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84},
+		{83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83},
+		{82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82},
+		{81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81},
+		{80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80},
 		// 160
-		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18},
-		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17},
-		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16},
-		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15},
-		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14},
+		{79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79},
+		{78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78},
+		{77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77},
+		{76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76},
+		{75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75},
 		// 165
-		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13},
-		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12},
-		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11},
-		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
-		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9},
+		{74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74},
+		{73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73},
+		{72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72},
+		{71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71},
+		{70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70},
 		// 170
-		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8},
-		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7},
-		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
-		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
-		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+		{69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69},
+		{68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68},
+		{67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67},
+		{66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66},
+		{65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65},
 		// 175
-		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
-		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
-		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 86: 181, 88: 399, 109: 408},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 397},
+		{64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64},
+		{63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63},
+		{62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62},
+		{61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61},
+		{60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60},
 		// 180
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 86: 185, 88: 185, 92: 398},
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 86: 181, 88: 399, 109: 400},
-		{90: 401},
-		{172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 86: 172},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 403, 108: 402},
+		{59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
+		{58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58},
+		{57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57},
+		{56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
+		{55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
 		// 185
-		{405, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 404, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 95: 406},
-		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179},
-		{182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 72: 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 85: 182, 94: 182},
-		{180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 86: 180},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 407},
+		{54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
+		{53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
+		{52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
+		{51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
+		{50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50},
 		// 190
-		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 85: 178},
-		{173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 86: 173},
-		{186, 71: 186},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 312, 96: 411},
-		{174, 71: 174, 86: 174},
+		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49},
+		{48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48},
+		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47},
+		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46},
+		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
 		// 195
-		{1: 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 91: 189},
-		{76: 307, 306, 103: 305, 414},
-		{187, 71: 187},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 302, 185, 92: 416, 418, 108: 417},
-		{85: 433},
+		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44},
+		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43},
+		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42},
+		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41},
+		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40},
 		// 200
-		{429, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 404, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 85: 183, 95: 430},
-		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 85: 179, 89: 419},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 85: 423, 93: 422, 421, 99: 424, 425, 118: 420},
-		{428},
-		{158},
+		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39},
+		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38},
+		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37},
+		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36},
+		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35},
 		// 205
-		{157},
-		{156},
-		{85: 427},
-		{85: 426},
-		{154},
+		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34},
+		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33},
+		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32},
+		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31},
+		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30},
 		// 210
-		{155},
-		{1: 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 91: 190},
-		{1: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 91: 192},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 85: 431, 93: 407},
-		{432},
+		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29},
+		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28},
+		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27},
+		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26},
+		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25},
 		// 215
-		{1: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 91: 191},
-		{434},
-		{1: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 91: 193},
-		{79: 185, 185, 84: 302, 92: 436},
-		{79: 438, 439, 120: 437},
+		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24},
+		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23},
+		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22},
+		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21},
+		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20},
 		// 220
-		{440},
-		{86},
-		{85},
-		{1: 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 91: 194},
-		{185, 84: 302, 92: 442},
+		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19},
+		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18},
+		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17},
+		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16},
+		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15},
 		// 225
-		{443},
-		{1: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 91: 195},
-		{78: 185, 81: 185, 84: 302, 92: 445},
-		{78: 448, 81: 447, 122: 446},
-		{449},
+		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14},
+		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13},
+		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12},
+		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11},
+		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10},
 		// 230
-		{151},
-		{150},
-		{1: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 91: 196},
-		{94: 451},
-		{71: 404, 94: 183, 452},
+		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9},
+		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8},
+		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7},
+		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
+		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
 		// 235
-		{94: 453},
-		{454},
-		{1: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 91: 197},
-		{84: 302, 185, 92: 456},
-		{85: 457},
+		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
+		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		{238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 117: 238, 119: 525, 142: 534},
 		// 240
-		{82: 460, 459, 129: 458},
-		{461},
-		{153},
-		{152},
-		{1: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 91: 198},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 523},
+		{242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 116: 397, 242, 119: 242, 124: 524},
+		{238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 238, 117: 238, 119: 525, 142: 526},
+		{121: 527},
+		{229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 229, 117: 229},
 		// 245
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 463},
-		{464, 71: 465},
-		{1: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 91: 200},
-		{185, 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 302, 87: 185, 92: 469, 468, 119: 467, 130: 466},
-		{471, 87: 472},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 529, 141: 528},
+		{531, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 530, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 127: 532},
+		{236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236},
+		{239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 102: 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 239, 125: 239},
+		{237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 237, 117: 237},
 		// 250
-		{170, 87: 170},
-		{185, 84: 302, 87: 185, 92: 470},
-		{168, 87: 168},
-		{169, 87: 169},
-		{1: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 91: 199},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 533},
+		{235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235, 235},
+		{230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 230, 117: 230},
+		{243, 101: 243},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 407, 126: 537},
 		// 255
-		{185, 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 302, 87: 185, 92: 469, 468, 119: 473},
-		{171, 87: 171},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 475},
-		{476},
-		{1: 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 201, 91: 201},
+		{231, 101: 231, 117: 231},
+		{1: 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 122: 246},
+		{106: 402, 401, 136: 400, 540},
+		{244, 101: 244},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 242, 397, 123: 544, 542, 141: 543},
 		// 260
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 478},
-		{89: 479},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 85: 423, 93: 422, 421, 99: 424, 425, 118: 480},
-		{481},
-		{1: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 91: 202},
+		{115: 559},
+		{555, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 530, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 127: 556},
+		{236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 236, 120: 545},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 549, 123: 548, 125: 547, 129: 550, 551, 132: 546},
+		{554},
 		// 265
-		{84: 302, 185, 92: 483},
-		{85: 484},
-		{485},
-		{1: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 91: 203},
-		{84: 302, 185, 92: 487},
+		{215, 101: 215},
+		{214, 101: 214},
+		{213, 101: 213},
+		{115: 553},
+		{115: 552},
 		// 270
-		{85: 488},
-		{489},
-		{1: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 91: 204},
-		{185, 72: 185, 185, 185, 185, 84: 302, 92: 491},
-		{162, 72: 495, 496, 497, 498, 112: 494, 127: 493, 492},
+		{211, 101: 211},
+		{212, 101: 212},
+		{1: 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 122: 247},
+		{1: 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 249, 122: 249},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 557, 123: 533},
 		// 275
-		{501},
-		{161, 71: 499},
-		{160, 71: 160},
-		{105, 71: 105},
-		{104, 71: 104},
+		{558},
+		{1: 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 248, 122: 248},
+		{560},
+		{1: 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 250, 122: 250},
+		{109: 242, 242, 116: 397, 124: 562},
 		// 280
-		{103, 71: 103},
-		{102, 71: 102},
-		{72: 495, 496, 497, 498, 112: 500},
-		{159, 71: 159},
-		{1: 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 91: 205},
+		{109: 564, 565, 154: 563},
+		{566},
+		{117},
+		{116},
+		{1: 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 251, 122: 251},
 		// 285
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 504, 102: 503},
-		{512},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 312, 96: 505},
-		{183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 404, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 183, 95: 506},
-		{166, 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 509, 123: 508, 507},
+		{242, 116: 397, 124: 568},
+		{569},
+		{1: 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 252, 122: 252},
+		{108: 242, 111: 242, 116: 397, 124: 571},
+		{108: 574, 111: 573, 156: 572},
 		// 290
-		{167},
-		{165, 71: 510},
-		{164, 71: 164},
-		{1: 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 511},
-		{163, 71: 163},
+		{575},
+		{205},
+		{204},
+		{1: 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 122: 253},
+		{125: 577},
 		// 295
-		{1: 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 206, 91: 206},
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 504, 102: 514},
-		{515},
-		{1: 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 207, 91: 207},
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 519, 97: 518, 105: 517},
+		{101: 530, 125: 240, 127: 578},
+		{125: 579},
+		{580},
+		{1: 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 122: 254},
+		{125: 582},
 		// 300
-		{520},
-		{177, 71: 410},
-		{176, 342, 365, 318, 320, 378, 345, 322, 323, 324, 313, 348, 344, 350, 353, 328, 356, 349, 352, 355, 314, 315, 316, 317, 380, 343, 338, 358, 326, 346, 347, 330, 319, 321, 382, 325, 332, 351, 354, 329, 357, 327, 331, 372, 333, 337, 335, 364, 359, 377, 371, 341, 360, 361, 362, 340, 336, 381, 339, 366, 334, 363, 379, 367, 368, 375, 376, 370, 369, 373, 374, 72: 391, 392, 393, 394, 386, 385, 387, 383, 384, 388, 390, 389, 93: 312, 96: 311},
-		{1: 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 91: 208},
-		{185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 519, 97: 518, 105: 522},
+		{583, 101: 584},
+		{1: 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 122: 256},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 585},
+		{586},
+		{1: 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 122: 255},
 		// 305
-		{523},
-		{1: 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 91: 209},
-		{1: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 72: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 302, 92: 310, 97: 525},
-		{526, 71: 410},
-		{1: 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 210, 91: 210},
+		{115: 242, 397, 124: 588},
+		{115: 589},
+		{112: 592, 591, 163: 590},
+		{593},
+		{207},
 		// 310
-		{185, 84: 302, 92: 528},
-		{529},
-		{1: 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 91: 211},
-		{1: 292, 251, 244, 246, 280, 288, 265, 267, 268, 239, 278, 296, 258, 254, 270, 263, 257, 253, 262, 222, 241, 242, 243, 269, 293, 229, 234, 256, 289, 290, 271, 245, 247, 299, 266, 273, 259, 255, 294, 264, 248, 272, 282, 274, 284, 276, 250, 261, 230, 281, 233, 238, 295, 240, 232, 283, 298, 231, 252, 275, 249, 297, 291, 260, 235, 286, 277, 279, 287, 285, 101: 236, 106: 223, 237, 110: 532, 228, 113: 227, 225, 531, 226, 224},
-		{1: 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 91: 214},
+		{206},
+		{1: 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 122: 257},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 595},
+		{596, 101: 597},
+		{1: 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 259, 122: 259},
 		// 315
-		{1: 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 91: 212},
+		{242, 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 116: 397, 118: 242, 123: 600, 601, 151: 599, 164: 598},
+		{603, 118: 604},
+		{227, 118: 227},
+		{242, 116: 397, 118: 242, 124: 602},
+		{225, 118: 225},
+		// 320
+		{226, 118: 226},
+		{1: 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 122: 258},
+		{242, 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 116: 397, 118: 242, 123: 600, 601, 151: 605},
+		{228, 118: 228},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 607},
+		// 325
+		{608},
+		{1: 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 260, 122: 260},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 610},
+		{611},
+		{1: 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 122: 261},
+		// 330
+		{115: 615, 134: 614, 153: 613},
+		{618, 101: 619},
+		{210, 101: 210},
+		{152: 616},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 549, 123: 548, 125: 547, 129: 550, 551, 132: 617},
+		// 335
+		{208, 101: 208},
+		{1: 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 122: 262},
+		{115: 615, 134: 620},
+		{209, 101: 209},
+		{115: 622},
+		// 340
+		{623},
+		{1: 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 263, 122: 263},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 625},
+		{120: 626},
+		{115: 627},
+		// 345
+		{628},
+		{1: 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 264, 122: 264},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 630},
+		{120: 631},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 549, 123: 548, 125: 547, 129: 550, 551, 132: 632},
+		// 350
+		{633},
+		{1: 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 265, 122: 265},
+		{115: 242, 397, 124: 635},
+		{115: 636},
+		{637},
+		// 355
+		{1: 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 266, 122: 266},
+		{1: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 102: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 116: 397, 124: 639},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 407, 126: 640},
+		{101: 641},
+		{115: 642},
+		// 360
+		{643},
+		{1: 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 267, 122: 267},
+		{1: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 102: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 116: 397, 124: 645},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 407, 126: 646},
+		{101: 647},
+		// 365
+		{115: 648},
+		{114: 649},
+		{650},
+		{1: 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 268, 122: 268},
+		{115: 242, 397, 124: 652},
+		// 370
+		{115: 653},
+		{654},
+		{1: 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 269, 122: 269},
+		{242, 102: 242, 242, 242, 242, 116: 397, 124: 656},
+		{219, 102: 660, 661, 662, 663, 145: 659, 161: 658, 657},
+		// 375
+		{666},
+		{218, 101: 664},
+		{217, 101: 217},
+		{149, 101: 149},
+		{148, 101: 148},
+		// 380
+		{147, 101: 147},
+		{146, 101: 146},
+		{102: 660, 661, 662, 663, 145: 665},
+		{216, 101: 216},
+		{1: 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 270, 122: 270},
+		// 385
+		{1: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 102: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 116: 397, 124: 669, 135: 668},
+		{677},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 407, 126: 670},
+		{240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 530, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 240, 127: 671},
+		{223, 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 674, 157: 673, 672},
+		// 390
+		{224},
+		{222, 101: 675},
+		{221, 101: 221},
+		{1: 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 676},
+		{220, 101: 220},
+		// 395
+		{1: 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 271, 122: 271},
+		{1: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 102: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 116: 397, 124: 669, 135: 679},
+		{680},
+		{1: 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 272, 122: 272},
+		{242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 102: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 116: 397, 124: 684, 128: 683, 138: 682},
+		// 400
+		{685},
+		{234, 101: 536},
+		{233, 443, 493, 500, 504, 466, 413, 415, 491, 485, 480, 486, 506, 433, 446, 417, 418, 419, 502, 408, 449, 445, 431, 451, 454, 423, 457, 487, 450, 453, 490, 456, 409, 435, 410, 411, 412, 482, 444, 474, 495, 439, 459, 421, 447, 448, 503, 425, 414, 416, 484, 492, 494, 488, 498, 434, 420, 427, 432, 452, 455, 424, 458, 436, 496, 422, 426, 473, 428, 438, 430, 465, 460, 479, 501, 472, 442, 461, 462, 463, 507, 441, 489, 437, 483, 440, 505, 467, 429, 464, 481, 468, 469, 477, 478, 497, 471, 470, 499, 475, 476, 102: 516, 517, 518, 519, 511, 510, 512, 508, 509, 513, 515, 514, 520, 123: 407, 126: 406},
+		{1: 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 273, 122: 273},
+		{242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 102: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 116: 397, 124: 684, 128: 683, 138: 687},
+		// 405
+		{688},
+		{1: 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 274, 122: 274},
+		{1: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 102: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 116: 397, 124: 405, 128: 690},
+		{691, 101: 536},
+		{1: 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 275, 122: 275},
+		// 410
+		{242, 116: 397, 124: 693},
+		{694},
+		{1: 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 276, 122: 276},
+		{1: 377, 387, 369, 299, 323, 316, 318, 385, 301, 355, 342, 371, 361, 373, 337, 339, 340, 394, 311, 353, 381, 359, 330, 326, 345, 335, 343, 329, 325, 296, 334, 287, 363, 313, 314, 315, 341, 378, 358, 389, 294, 305, 328, 374, 375, 303, 346, 317, 319, 384, 386, 388, 344, 392, 362, 338, 348, 360, 331, 327, 379, 336, 364, 390, 320, 347, 357, 349, 366, 351, 322, 333, 297, 370, 356, 304, 310, 380, 312, 306, 302, 295, 365, 383, 298, 300, 324, 350, 321, 382, 376, 332, 307, 368, 391, 352, 354, 393, 372, 367, 133: 308, 139: 288, 309, 143: 697, 293, 146: 292, 290, 696, 291, 289},
+		{1: 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 279, 122: 279},
+		// 415
+		{1: 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 277, 122: 277},
 	}
 )
 
@@ -1053,7 +1340,7 @@ func yyhintlex1(yylex yyhintLexer, lval *yyhintSymType) (n int) {
 }
 
 func yyhintParse(yylex yyhintLexer, parser *hintParser) int {
-	const yyError = 132
+	const yyError = 166
 
 	yyEx, _ := yylex.(yyhintLexerEx)
 	var yyn int
@@ -1300,6 +1587,24 @@ yynewstate:
 			}
 		}
 	case 14:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: model.NewCIStr(yyS[yypt-7].ident),
+				QBName:   model.NewCIStr(yyS[yypt-5].ident),
+				Tables:   []ast.HintTable{yyS[yypt-4].table},
+				HintData: yyS[yypt-2].number,
+			}
+		}
+	case 15:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: model.NewCIStr(yyS[yypt-6].ident),
+				QBName:   model.NewCIStr(yyS[yypt-4].ident),
+				Tables:   []ast.HintTable{yyS[yypt-3].table},
+				HintData: yyS[yypt-1].number,
+			}
+		}
+	case 16:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: model.NewCIStr(yyS[yypt-4].ident),
@@ -1307,7 +1612,7 @@ yynewstate:
 				HintData: int64(yyS[yypt-1].number),
 			}
 		}
-	case 15:
+	case 17:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: model.NewCIStr(yyS[yypt-5].ident),
@@ -1317,21 +1622,55 @@ yynewstate:
 				},
 			}
 		}
-	case 16:
+	case 18:
+		{
+			if !strings.EqualFold(yyS[yypt-3].ident, "size") {
+				yylex.AppendError(ErrWarnOptimizerHintParseError.GenWithStackByArgs(yyS[yypt-3].ident))
+				parser.lastErrorAsWarn()
+				parser.yyVAL.hint = nil
+			} else {
+				parser.yyVAL.hint = &ast.TableOptimizerHint{
+					HintName: model.NewCIStr(yyS[yypt-5].ident),
+					HintData: yyS[yypt-1].number,
+				}
+			}
+		}
+	case 19:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: model.NewCIStr(yyS[yypt-3].ident),
+				HintData: yyS[yypt-1].number,
+			}
+		}
+	case 20:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: model.NewCIStr(yyS[yypt-3].ident),
+				HintData: yyS[yypt-1].fixControls,
+			}
+		}
+	case 21:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: model.NewCIStr(yyS[yypt-3].ident),
 				HintData: yyS[yypt-1].ident,
 			}
 		}
-	case 17:
+	case 22:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: model.NewCIStr(yyS[yypt-3].ident),
+				HintData: yyS[yypt-1].ident,
+			}
+		}
+	case 23:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: model.NewCIStr(yyS[yypt-3].ident),
 				QBName:   model.NewCIStr(yyS[yypt-1].ident),
 			}
 		}
-	case 18:
+	case 24:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: model.NewCIStr(yyS[yypt-5].ident),
@@ -1339,7 +1678,7 @@ yynewstate:
 				Tables:   yyS[yypt-1].hint.Tables,
 			}
 		}
-	case 19:
+	case 25:
 		{
 			maxValue := uint64(math.MaxInt64) / yyS[yypt-1].number
 			if yyS[yypt-2].number <= maxValue {
@@ -1354,7 +1693,24 @@ yynewstate:
 				parser.yyVAL.hint = nil
 			}
 		}
-	case 20:
+	case 26:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: model.NewCIStr(yyS[yypt-3].ident),
+				HintData: ast.HintReadLocality{Labels: yyS[yypt-1].ident},
+			}
+		}
+	case 27:
+		{
+			parser.yyVAL.hint = &ast.TableOptimizerHint{
+				HintName: model.NewCIStr(yyS[yypt-5].ident),
+				HintData: ast.HintReadLocality{
+					Labels:   yyS[yypt-3].ident,
+					Fallback: yyS[yypt-1].ident,
+				},
+			}
+		}
+	case 28:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: model.NewCIStr(yyS[yypt-5].ident),
@@ -1364,21 +1720,21 @@ yynewstate:
 				},
 			}
 		}
-	case 21:
+	case 29:
 		{
 			h := yyS[yypt-1].hint
 			h.HintName = model.NewCIStr(yyS[yypt-4].ident)
 			h.QBName = model.NewCIStr(yyS[yypt-2].ident)
 			parser.yyVAL.hint = h
 		}
-	case 22:
+	case 30:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: model.NewCIStr(yyS[yypt-3].ident),
 				QBName:   model.NewCIStr(yyS[yypt-1].ident),
 			}
 		}
-	case 23:
+	case 31:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				HintName: model.NewCIStr(yyS[yypt-4].ident),
@@ -1386,27 +1742,27 @@ yynewstate:
 				HintData: model.NewCIStr(yyS[yypt-1].ident),
 			}
 		}
-	case 24:
+	case 32:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-4].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 25:
+	case 33:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-3].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 26:
+	case 34:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-5].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 27:
+	case 35:
 		{
 			parser.warnUnsupportedHint(yyS[yypt-5].ident)
 			parser.yyVAL.hint = nil
 		}
-	case 28:
+	case 36:
 		{
 			hs := yyS[yypt-1].hints
 			name := model.NewCIStr(yyS[yypt-4].ident)
@@ -1417,60 +1773,60 @@ yynewstate:
 			}
 			parser.yyVAL.hints = hs
 		}
-	case 29:
+	case 37:
 		{
 			parser.yyVAL.hints = []*ast.TableOptimizerHint{yyS[yypt-0].hint}
 		}
-	case 30:
+	case 38:
 		{
 			parser.yyVAL.hints = append(yyS[yypt-2].hints, yyS[yypt-0].hint)
 		}
-	case 31:
+	case 39:
 		{
 			h := yyS[yypt-1].hint
 			h.HintData = model.NewCIStr(yyS[yypt-3].ident)
 			parser.yyVAL.hint = h
 		}
-	case 32:
+	case 40:
 		{
 			parser.yyVAL.ident = ""
 		}
-	case 36:
+	case 44:
 		{
 			parser.yyVAL.modelIdents = nil
 		}
-	case 37:
+	case 45:
 		{
 			parser.yyVAL.modelIdents = yyS[yypt-1].modelIdents
 		}
-	case 38:
+	case 46:
 		{
 			parser.yyVAL.modelIdents = []model.CIStr{model.NewCIStr(yyS[yypt-0].ident)}
 		}
-	case 39:
+	case 47:
 		{
 			parser.yyVAL.modelIdents = append(yyS[yypt-2].modelIdents, model.NewCIStr(yyS[yypt-0].ident))
 		}
-	case 41:
+	case 49:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				QBName: model.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 42:
+	case 50:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Tables: []ast.HintTable{yyS[yypt-0].table},
 				QBName: model.NewCIStr(yyS[yypt-1].ident),
 			}
 		}
-	case 43:
+	case 51:
 		{
 			h := yyS[yypt-2].hint
 			h.Tables = append(h.Tables, yyS[yypt-0].table)
 			parser.yyVAL.hint = h
 		}
-	case 44:
+	case 52:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				TableName:     model.NewCIStr(yyS[yypt-2].ident),
@@ -1478,7 +1834,7 @@ yynewstate:
 				PartitionList: yyS[yypt-0].modelIdents,
 			}
 		}
-	case 45:
+	case 53:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				DBName:        model.NewCIStr(yyS[yypt-4].ident),
@@ -1487,63 +1843,63 @@ yynewstate:
 				PartitionList: yyS[yypt-0].modelIdents,
 			}
 		}
-	case 46:
+	case 54:
 		{
 			h := yyS[yypt-2].hint
 			h.Tables = append(h.Tables, yyS[yypt-0].table)
 			parser.yyVAL.hint = h
 		}
-	case 47:
+	case 55:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Tables: []ast.HintTable{yyS[yypt-0].table},
 			}
 		}
-	case 48:
+	case 56:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				TableName: model.NewCIStr(yyS[yypt-1].ident),
 				QBName:    model.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 49:
+	case 57:
 		{
 			parser.yyVAL.table = ast.HintTable{
 				QBName: model.NewCIStr(yyS[yypt-0].ident),
 			}
 		}
-	case 50:
+	case 58:
 		{
 			h := yyS[yypt-0].hint
 			h.Tables = []ast.HintTable{yyS[yypt-2].table}
 			h.QBName = model.NewCIStr(yyS[yypt-3].ident)
 			parser.yyVAL.hint = h
 		}
-	case 51:
+	case 59:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{}
 		}
-	case 53:
+	case 61:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{
 				Indexes: []model.CIStr{model.NewCIStr(yyS[yypt-0].ident)},
 			}
 		}
-	case 54:
+	case 62:
 		{
 			h := yyS[yypt-2].hint
 			h.Indexes = append(h.Indexes, model.NewCIStr(yyS[yypt-0].ident))
 			parser.yyVAL.hint = h
 		}
-	case 61:
+	case 69:
 		{
 			parser.yyVAL.ident = strconv.FormatUint(yyS[yypt-0].number, 10)
 		}
-	case 62:
+	case 70:
 		{
 			parser.yyVAL.ident = strconv.FormatUint(yyS[yypt-0].number, 10)
 		}
-	case 63:
+	case 71:
 		{
 			if yyS[yypt-0].number > 9223372036854775808 {
 				yylex.AppendError(yylex.Errorf("the Signed Value should be at the range of [-9223372036854775808, 9223372036854775807]."))
@@ -1555,19 +1911,34 @@ yynewstate:
 				parser.yyVAL.ident = strconv.FormatInt(-int64(yyS[yypt-0].number), 10)
 			}
 		}
-	case 64:
+	case 72:
+		{
+			parser.yyVAL.fixControls = []ast.HintFixControl{yyS[yypt-0].fixControl}
+		}
+	case 73:
+		{
+			parser.yyVAL.fixControls = append(yyS[yypt-2].fixControls, yyS[yypt-0].fixControl)
+		}
+	case 74:
+		{
+			parser.yyVAL.fixControl = ast.HintFixControl{
+				FixID: yyS[yypt-2].number,
+				Value: yyS[yypt-0].ident,
+			}
+		}
+	case 75:
 		{
 			parser.yyVAL.number = 1024 * 1024
 		}
-	case 65:
+	case 76:
 		{
 			parser.yyVAL.number = 1024 * 1024 * 1024
 		}
-	case 66:
+	case 77:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{HintData: true}
 		}
-	case 67:
+	case 78:
 		{
 			parser.yyVAL.hint = &ast.TableOptimizerHint{HintData: false}
 		}
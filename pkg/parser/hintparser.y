@@ -17,6 +17,7 @@ package parser
 import (
 	"math"
 	"strconv"
+	"strings"
 
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/model"
@@ -32,6 +33,8 @@ import (
 	hints []*ast.TableOptimizerHint
 	table 	ast.HintTable
 	modelIdents []model.CIStr
+	fixControl  ast.HintFixControl
+	fixControls []ast.HintFixControl
 }
 
 %token	<number>
@@ -74,6 +77,12 @@ import (
 	hintNoRangeOptimization "NO_RANGE_OPTIMIZATION"
 	hintSkipScan            "SKIP_SCAN"
 	hintNoSkipScan          "NO_SKIP_SCAN"
+	hintIndex               "INDEX"
+	hintNoIndex             "NO_INDEX"
+	hintGroupIndex          "GROUP_INDEX"
+	hintNoGroupIndex        "NO_GROUP_INDEX"
+	hintJoinIndex           "JOIN_INDEX"
+	hintNoJoinIndex         "NO_JOIN_INDEX"
 	hintSemijoin            "SEMIJOIN"
 	hintNoSemijoin          "NO_SEMIJOIN"
 	hintMaxExecutionTime    "MAX_EXECUTION_TIME"
@@ -82,47 +91,71 @@ import (
 	hintQBName              "QB_NAME"
 
 	/* TiDB hint names */
-	hintAggToCop              "AGG_TO_COP"
-	hintIgnorePlanCache       "IGNORE_PLAN_CACHE"
-	hintHashAgg               "HASH_AGG"
-	hintMpp1PhaseAgg          "MPP_1PHASE_AGG"
-	hintMpp2PhaseAgg          "MPP_2PHASE_AGG"
-	hintIgnoreIndex           "IGNORE_INDEX"
-	hintIndexJoin             "INDEX_JOIN"
-	hintNoIndexJoin           "NO_INDEX_JOIN"
-	hintInlHashJoin           "INL_HASH_JOIN"
-	hintIndexHashJoin         "INDEX_HASH_JOIN"
-	hintNoIndexHashJoin       "NO_INDEX_HASH_JOIN"
-	hintInlJoin               "INL_JOIN"
-	hintInlMergeJoin          "INL_MERGE_JOIN"
-	hintIndexMergeJoin        "INDEX_MERGE_JOIN"
-	hintNoIndexMergeJoin      "NO_INDEX_MERGE_JOIN"
-	hintMemoryQuota           "MEMORY_QUOTA"
-	hintNoSwapJoinInputs      "NO_SWAP_JOIN_INPUTS"
-	hintQueryType             "QUERY_TYPE"
-	hintReadConsistentReplica "READ_CONSISTENT_REPLICA"
-	hintReadFromStorage       "READ_FROM_STORAGE"
-	hintSMJoin                "MERGE_JOIN"
-	hintNoSMJoin              "NO_MERGE_JOIN"
-	hintBCJoin                "BROADCAST_JOIN"
-	hintShuffleJoin           "SHUFFLE_JOIN"
-	hintStreamAgg             "STREAM_AGG"
-	hintSwapJoinInputs        "SWAP_JOIN_INPUTS"
-	hintUseIndexMerge         "USE_INDEX_MERGE"
-	hintUseIndex              "USE_INDEX"
-	hintOrderIndex            "ORDER_INDEX"
-	hintNoOrderIndex          "NO_ORDER_INDEX"
-	hintUsePlanCache          "USE_PLAN_CACHE"
-	hintUseToja               "USE_TOJA"
-	hintTimeRange             "TIME_RANGE"
-	hintUseCascades           "USE_CASCADES"
-	hintNthPlan               "NTH_PLAN"
-	hintLimitToCop            "LIMIT_TO_COP"
-	hintForceIndex            "FORCE_INDEX"
-	hintStraightJoin          "STRAIGHT_JOIN"
-	hintLeading               "LEADING"
-	hintSemiJoinRewrite       "SEMI_JOIN_REWRITE"
-	hintNoDecorrelate         "NO_DECORRELATE"
+	hintAggToCop               "AGG_TO_COP"
+	hintIgnorePlanCache        "IGNORE_PLAN_CACHE"
+	hintHashAgg                "HASH_AGG"
+	hintMpp1PhaseAgg           "MPP_1PHASE_AGG"
+	hintMpp2PhaseAgg           "MPP_2PHASE_AGG"
+	hintIgnoreIndex            "IGNORE_INDEX"
+	hintIndexJoin              "INDEX_JOIN"
+	hintNoIndexJoin            "NO_INDEX_JOIN"
+	hintInlHashJoin            "INL_HASH_JOIN"
+	hintIndexHashJoin          "INDEX_HASH_JOIN"
+	hintNoIndexHashJoin        "NO_INDEX_HASH_JOIN"
+	hintInlJoin                "INL_JOIN"
+	hintInlMergeJoin           "INL_MERGE_JOIN"
+	hintIndexMergeJoin         "INDEX_MERGE_JOIN"
+	hintNoIndexMergeJoin       "NO_INDEX_MERGE_JOIN"
+	hintMemoryQuota            "MEMORY_QUOTA"
+	hintNoSwapJoinInputs       "NO_SWAP_JOIN_INPUTS"
+	hintQueryType              "QUERY_TYPE"
+	hintReadConsistentReplica  "READ_CONSISTENT_REPLICA"
+	hintReadFromStorage        "READ_FROM_STORAGE"
+	hintSMJoin                 "MERGE_JOIN"
+	hintNoSMJoin               "NO_MERGE_JOIN"
+	hintBCJoin                 "BROADCAST_JOIN"
+	hintShuffleJoin            "SHUFFLE_JOIN"
+	hintStreamAgg              "STREAM_AGG"
+	hintSwapJoinInputs         "SWAP_JOIN_INPUTS"
+	hintUseIndexMerge          "USE_INDEX_MERGE"
+	hintUseIndex               "USE_INDEX"
+	hintOrderIndex             "ORDER_INDEX"
+	hintNoOrderIndex           "NO_ORDER_INDEX"
+	hintLooseIndexScan         "LOOSE_INDEX_SCAN"
+	hintUsePlanCache           "USE_PLAN_CACHE"
+	hintUseToja                "USE_TOJA"
+	hintTimeRange              "TIME_RANGE"
+	hintUseCascades            "USE_CASCADES"
+	hintNthPlan                "NTH_PLAN"
+	hintLimitToCop             "LIMIT_TO_COP"
+	hintForceIndex             "FORCE_INDEX"
+	hintStraightJoin           "STRAIGHT_JOIN"
+	hintLeading                "LEADING"
+	hintSemiJoinRewrite        "SEMI_JOIN_REWRITE"
+	hintNoDecorrelate          "NO_DECORRELATE"
+	hintFixControl             "FIX_CONTROL"
+	hintFullScan               "FULL_SCAN"
+	hintIndexOnly              "INDEX_ONLY"
+	hintNoFullScan             "NO_FULL_SCAN"
+	hintSample                 "SAMPLE"
+	hintINLJoinPrefetch        "INL_JOIN_PREFETCH"
+	hintDistinctAggPushDown    "DISTINCT_AGG_PUSH_DOWN"
+	hintNoDistinctAggPushDown  "NO_DISTINCT_AGG_PUSH_DOWN"
+	hintApproxCountDistinct    "APPROX_COUNT_DISTINCT"
+	hintNoExpand               "NO_EXPAND"
+	hintMaterializeSubquery    "MATERIALIZE_SUBQUERY"
+	hintNoMaterializeSubquery  "NO_MATERIALIZE_SUBQUERY"
+	hintUseFullTextIndex       "USE_FULLTEXT_INDEX"
+	hintNoFullTextIndex        "NO_FULLTEXT_INDEX"
+	hintUseInvisibleIndexes    "USE_INVISIBLE_INDEXES"
+	hintAsyncCommit            "ASYNC_COMMIT"
+	hintOnePC                  "ONE_PC"
+	hintHedgedRead             "HEDGED_READ"
+	hintMppExchangeCompression "MPP_EXCHANGE_COMPRESSION"
+	hintBatchDML               "BATCH_DML"
+	hintShardRowID             "SHARD_ROW_ID"
+	hintGeneratedColSubstitute "GENERATED_COLUMN_SUBSTITUTE"
+	hintReadLocality           "READ_LOCALITY"
 
 	/* Other keywords */
 	hintOLAP            "OLAP"
@@ -138,6 +171,7 @@ import (
 	hintFirstMatch      "FIRSTMATCH"
 	hintLooseScan       "LOOSESCAN"
 	hintMaterialization "MATERIALIZATION"
+	hintPercent         "PERCENT"
 
 %type	<ident>
 	Identifier                             "identifier (including keywords)"
@@ -181,6 +215,12 @@ import (
 	HintTable "Table in optimizer hint"
 	ViewName  "View name in optimizer hint"
 
+%type	<fixControl>
+	FixControl "fix control in optimizer hint"
+
+%type	<fixControls>
+	FixControlList "fix control list in optimizer hint"
+
 %type	<modelIdents>
 	PartitionList    "partition name list in optimizer hint"
 	PartitionListOpt "optional partition name list in optimizer hint"
@@ -266,6 +306,24 @@ TableOptimizerHintOpt:
 			HintData: $4,
 		}
 	}
+|	"SAMPLE" '(' QueryBlockOpt HintTable ',' hintIntLit "PERCENT" ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			QBName:   model.NewCIStr($3),
+			Tables:   []ast.HintTable{$4},
+			HintData: $6,
+		}
+	}
+|	"INL_JOIN_PREFETCH" '(' QueryBlockOpt HintTable ',' hintIntLit ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			QBName:   model.NewCIStr($3),
+			Tables:   []ast.HintTable{$4},
+			HintData: $6,
+		}
+	}
 |	"NTH_PLAN" '(' QueryBlockOpt hintIntLit ')'
 	{
 		$$ = &ast.TableOptimizerHint{
@@ -284,6 +342,33 @@ TableOptimizerHintOpt:
 			},
 		}
 	}
+|	"BATCH_DML" '(' Identifier '=' hintIntLit ')'
+	{
+		if !strings.EqualFold($3, "size") {
+			yylex.AppendError(ErrWarnOptimizerHintParseError.GenWithStackByArgs($3))
+			parser.lastErrorAsWarn()
+			$$ = nil
+		} else {
+			$$ = &ast.TableOptimizerHint{
+				HintName: model.NewCIStr($1),
+				HintData: $5,
+			}
+		}
+	}
+|	"SHARD_ROW_ID" '(' hintIntLit ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			HintData: $3,
+		}
+	}
+|	"FIX_CONTROL" '(' FixControlList ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			HintData: $3,
+		}
+	}
 |	"RESOURCE_GROUP" '(' Identifier ')'
 	{
 		$$ = &ast.TableOptimizerHint{
@@ -291,6 +376,13 @@ TableOptimizerHintOpt:
 			HintData: $3,
 		}
 	}
+|	"MPP_EXCHANGE_COMPRESSION" '(' Identifier ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			HintData: $3,
+		}
+	}
 |	"QB_NAME" '(' Identifier ')'
 	{
 		$$ = &ast.TableOptimizerHint{
@@ -321,6 +413,23 @@ TableOptimizerHintOpt:
 			$$ = nil
 		}
 	}
+|	"READ_LOCALITY" '(' hintStringLit ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			HintData: ast.HintReadLocality{Labels: $3},
+		}
+	}
+|	"READ_LOCALITY" '(' hintStringLit ',' Identifier ')'
+	{
+		$$ = &ast.TableOptimizerHint{
+			HintName: model.NewCIStr($1),
+			HintData: ast.HintReadLocality{
+				Labels:   $3,
+				Fallback: $5,
+			},
+		}
+	}
 |	"TIME_RANGE" '(' hintStringLit CommaOpt hintStringLit ')'
 	{
 		$$ = &ast.TableOptimizerHint{
@@ -591,6 +700,25 @@ Value:
 		}
 	}
 
+FixControlList:
+	FixControl
+	{
+		$$ = []ast.HintFixControl{$1}
+	}
+|	FixControlList ',' FixControl
+	{
+		$$ = append($1, $3)
+	}
+
+FixControl:
+	hintIntLit ':' Value
+	{
+		$$ = ast.HintFixControl{
+			FixID: $1,
+			Value: $3,
+		}
+	}
+
 UnitOfBytes:
 	"MB"
 	{
@@ -646,6 +774,9 @@ SupportedTableLevelOptimizerHintName:
 |	"HASH_JOIN_BUILD"
 |	"HASH_JOIN_PROBE"
 |	"LEADING"
+|	"FULL_SCAN"
+|	"INDEX_ONLY"
+|	"NO_FULL_SCAN"
 
 UnsupportedIndexLevelOptimizerHintName:
 	"INDEX_MERGE"
@@ -664,6 +795,14 @@ SupportedIndexLevelOptimizerHintName:
 |	"FORCE_INDEX"
 |	"ORDER_INDEX"
 |	"NO_ORDER_INDEX"
+|	"LOOSE_INDEX_SCAN"
+/* MySQL 8.0 index-level hint names */
+|	"INDEX"
+|	"NO_INDEX"
+|	"GROUP_INDEX"
+|	"NO_GROUP_INDEX"
+|	"JOIN_INDEX"
+|	"NO_JOIN_INDEX"
 
 SubqueryOptimizerHintName:
 	"SEMIJOIN"
@@ -678,6 +817,9 @@ SubqueryStrategy:
 BooleanHintName:
 	"USE_TOJA"
 |	"USE_CASCADES"
+|	"ASYNC_COMMIT"
+|	"ONE_PC"
+|	"GENERATED_COLUMN_SUBSTITUTE"
 
 NullaryHintName:
 	"USE_PLAN_CACHE"
@@ -693,6 +835,16 @@ NullaryHintName:
 |	"STRAIGHT_JOIN"
 |	"SEMI_JOIN_REWRITE"
 |	"NO_DECORRELATE"
+|	"DISTINCT_AGG_PUSH_DOWN"
+|	"NO_DISTINCT_AGG_PUSH_DOWN"
+|	"APPROX_COUNT_DISTINCT"
+|	"NO_EXPAND"
+|	"MATERIALIZE_SUBQUERY"
+|	"NO_MATERIALIZE_SUBQUERY"
+|	"USE_FULLTEXT_INDEX"
+|	"NO_FULLTEXT_INDEX"
+|	"USE_INVISIBLE_INDEXES"
+|	"HEDGED_READ"
 
 HintQueryType:
 	"OLAP"
@@ -727,6 +879,12 @@ Identifier:
 |	"NO_RANGE_OPTIMIZATION"
 |	"SKIP_SCAN"
 |	"NO_SKIP_SCAN"
+|	"INDEX"
+|	"NO_INDEX"
+|	"GROUP_INDEX"
+|	"NO_GROUP_INDEX"
+|	"JOIN_INDEX"
+|	"NO_JOIN_INDEX"
 |	"SEMIJOIN"
 |	"NO_SEMIJOIN"
 |	"MAX_EXECUTION_TIME"
@@ -765,6 +923,7 @@ Identifier:
 |	"USE_INDEX"
 |	"ORDER_INDEX"
 |	"NO_ORDER_INDEX"
+|	"LOOSE_INDEX_SCAN"
 |	"USE_PLAN_CACHE"
 |	"USE_TOJA"
 |	"TIME_RANGE"
@@ -775,6 +934,29 @@ Identifier:
 |	"LEADING"
 |	"SEMI_JOIN_REWRITE"
 |	"NO_DECORRELATE"
+|	"FIX_CONTROL"
+|	"FULL_SCAN"
+|	"INDEX_ONLY"
+|	"NO_FULL_SCAN"
+|	"SAMPLE"
+|	"INL_JOIN_PREFETCH"
+|	"DISTINCT_AGG_PUSH_DOWN"
+|	"NO_DISTINCT_AGG_PUSH_DOWN"
+|	"APPROX_COUNT_DISTINCT"
+|	"NO_EXPAND"
+|	"MATERIALIZE_SUBQUERY"
+|	"NO_MATERIALIZE_SUBQUERY"
+|	"USE_FULLTEXT_INDEX"
+|	"NO_FULLTEXT_INDEX"
+|	"USE_INVISIBLE_INDEXES"
+|	"ASYNC_COMMIT"
+|	"ONE_PC"
+|	"HEDGED_READ"
+|	"MPP_EXCHANGE_COMPRESSION"
+|	"BATCH_DML"
+|	"SHARD_ROW_ID"
+|	"GENERATED_COLUMN_SUBSTITUTE"
+|	"READ_LOCALITY"
 /* other keywords */
 |	"OLAP"
 |	"OLTP"
@@ -788,4 +970,5 @@ Identifier:
 |	"FIRSTMATCH"
 |	"LOOSESCAN"
 |	"MATERIALIZATION"
+|	"PERCENT"
 %%
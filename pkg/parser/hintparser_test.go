@@ -168,6 +168,56 @@ func TestParseHint(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: "INDEX(@qb1 tbl1 x, y) NO_INDEX(tbl2@qb2) GROUP_INDEX(tbl3 PRIMARY) NO_GROUP_INDEX(tbl3 c1) JOIN_INDEX(tbl4 c2) no_join_index(tbl4 c3)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("INDEX"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("tbl1")}},
+					QBName:   model.NewCIStr("qb1"),
+					Indexes:  []model.CIStr{model.NewCIStr("x"), model.NewCIStr("y")},
+				},
+				{
+					HintName: model.NewCIStr("NO_INDEX"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("tbl2"), QBName: model.NewCIStr("qb2")}},
+				},
+				{
+					HintName: model.NewCIStr("GROUP_INDEX"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("tbl3")}},
+					Indexes:  []model.CIStr{model.NewCIStr("PRIMARY")},
+				},
+				{
+					HintName: model.NewCIStr("NO_GROUP_INDEX"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("tbl3")}},
+					Indexes:  []model.CIStr{model.NewCIStr("c1")},
+				},
+				{
+					HintName: model.NewCIStr("JOIN_INDEX"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("tbl4")}},
+					Indexes:  []model.CIStr{model.NewCIStr("c2")},
+				},
+				{
+					HintName: model.NewCIStr("no_join_index"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("tbl4")}},
+					Indexes:  []model.CIStr{model.NewCIStr("c3")},
+				},
+			},
+		},
+		{
+			input: "LOOSE_INDEX_SCAN(tbl1 idx) loose_index_scan(@qb1 tbl2@qb1)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("LOOSE_INDEX_SCAN"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("tbl1")}},
+					Indexes:  []model.CIStr{model.NewCIStr("idx")},
+				},
+				{
+					HintName: model.NewCIStr("loose_index_scan"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("tbl2"), QBName: model.NewCIStr("qb1")}},
+					QBName:   model.NewCIStr("qb1"),
+				},
+			},
+		},
 		{
 			input: "USE_INDEX(@qb1 tbl1 partition(p0) x) USE_INDEX_MERGE(@qb2 tbl2@qb2 partition(p0, p1) x, y, z)",
 			output: []*ast.TableOptimizerHint{
@@ -232,6 +282,235 @@ func TestParseHint(t *testing.T) {
 				},
 			},
 		},
+		{
+			input: `FIX_CONTROL(44262:ON) fix_control(44823:100, 45132:'OFF')`,
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("FIX_CONTROL"),
+					HintData: []ast.HintFixControl{{FixID: 44262, Value: "ON"}},
+				},
+				{
+					HintName: model.NewCIStr("fix_control"),
+					HintData: []ast.HintFixControl{{FixID: 44823, Value: "100"}, {FixID: 45132, Value: "OFF"}},
+				},
+			},
+		},
+		{
+			input: "FULL_SCAN(t1) index_only(@qb1 t2, t3) NO_FULL_SCAN(test.t4)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("FULL_SCAN"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("t1")}},
+				},
+				{
+					HintName: model.NewCIStr("index_only"),
+					QBName:   model.NewCIStr("qb1"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("t2")}, {TableName: model.NewCIStr("t3")}},
+				},
+				{
+					HintName: model.NewCIStr("NO_FULL_SCAN"),
+					Tables:   []ast.HintTable{{DBName: model.NewCIStr("test"), TableName: model.NewCIStr("t4")}},
+				},
+			},
+		},
+		{
+			input: "SAMPLE(t1, 1 PERCENT) sample(@qb1 test.t2, 50 percent)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("SAMPLE"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("t1")}},
+					HintData: uint64(1),
+				},
+				{
+					HintName: model.NewCIStr("sample"),
+					QBName:   model.NewCIStr("qb1"),
+					Tables:   []ast.HintTable{{DBName: model.NewCIStr("test"), TableName: model.NewCIStr("t2")}},
+					HintData: uint64(50),
+				},
+			},
+		},
+		{
+			input: "SAMPLE(t1, 1)",
+			errs:  []string{`Optimizer hint syntax error at line 1 `},
+		},
+		{
+			input: "INL_JOIN_PREFETCH(t1, 8) inl_join_prefetch(@qb1 test.t2, 16)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("INL_JOIN_PREFETCH"),
+					Tables:   []ast.HintTable{{TableName: model.NewCIStr("t1")}},
+					HintData: uint64(8),
+				},
+				{
+					HintName: model.NewCIStr("inl_join_prefetch"),
+					QBName:   model.NewCIStr("qb1"),
+					Tables:   []ast.HintTable{{DBName: model.NewCIStr("test"), TableName: model.NewCIStr("t2")}},
+					HintData: uint64(16),
+				},
+			},
+		},
+		{
+			input: "INL_JOIN_PREFETCH(t1)",
+			errs:  []string{`Optimizer hint syntax error at line 1 `},
+		},
+		{
+			input: "BATCH_DML(size=10000) batch_dml(SIZE = 1)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("BATCH_DML"),
+					HintData: uint64(10000),
+				},
+				{
+					HintName: model.NewCIStr("batch_dml"),
+					HintData: uint64(1),
+				},
+			},
+		},
+		{
+			input: "BATCH_DML(rows=10000)",
+			errs:  []string{`Optimizer hint syntax error at rows`},
+		},
+		{
+			input: "BATCH_DML(10000)",
+			errs:  []string{`Optimizer hint syntax error at line 1 `},
+		},
+		{
+			input: "SHARD_ROW_ID(4) shard_row_id(0)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("SHARD_ROW_ID"),
+					HintData: uint64(4),
+				},
+				{
+					HintName: model.NewCIStr("shard_row_id"),
+					HintData: uint64(0),
+				},
+			},
+		},
+		{
+			input: "SHARD_ROW_ID()",
+			errs:  []string{`Optimizer hint syntax error at line 1 `},
+		},
+		{
+			input: "DISTINCT_AGG_PUSH_DOWN() no_distinct_agg_push_down(@qb1)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("DISTINCT_AGG_PUSH_DOWN"),
+				},
+				{
+					HintName: model.NewCIStr("no_distinct_agg_push_down"),
+					QBName:   model.NewCIStr("qb1"),
+				},
+			},
+		},
+		{
+			input: "APPROX_COUNT_DISTINCT() approx_count_distinct(@qb1)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("APPROX_COUNT_DISTINCT"),
+				},
+				{
+					HintName: model.NewCIStr("approx_count_distinct"),
+					QBName:   model.NewCIStr("qb1"),
+				},
+			},
+		},
+		{
+			input: "NO_EXPAND() no_expand(@qb1)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("NO_EXPAND"),
+				},
+				{
+					HintName: model.NewCIStr("no_expand"),
+					QBName:   model.NewCIStr("qb1"),
+				},
+			},
+		},
+		{
+			input: "USE_FULLTEXT_INDEX() no_fulltext_index(@qb1)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("USE_FULLTEXT_INDEX"),
+				},
+				{
+					HintName: model.NewCIStr("no_fulltext_index"),
+					QBName:   model.NewCIStr("qb1"),
+				},
+			},
+		},
+		{
+			input: "MATERIALIZE_SUBQUERY(@sel_2) no_materialize_subquery()",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("MATERIALIZE_SUBQUERY"),
+					QBName:   model.NewCIStr("sel_2"),
+				},
+				{
+					HintName: model.NewCIStr("no_materialize_subquery"),
+				},
+			},
+		},
+		{
+			input: "use_invisible_indexes()",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("use_invisible_indexes"),
+				},
+			},
+		},
+		{
+			input: "hedged_read()",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("hedged_read"),
+				},
+			},
+		},
+		{
+			input: "read_locality('region=eu') read_locality('region=eu,zone=z1', leader)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("read_locality"),
+					HintData: ast.HintReadLocality{Labels: "region=eu"},
+				},
+				{
+					HintName: model.NewCIStr("read_locality"),
+					HintData: ast.HintReadLocality{Labels: "region=eu,zone=z1", Fallback: "leader"},
+				},
+			},
+		},
+		{
+			input: "mpp_exchange_compression(high_compression)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("mpp_exchange_compression"),
+					HintData: "high_compression",
+				},
+			},
+		},
+		{
+			input: "async_commit(true) one_pc(false)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("async_commit"),
+					HintData: true,
+				},
+				{
+					HintName: model.NewCIStr("one_pc"),
+					HintData: false,
+				},
+			},
+		},
+		{
+			input: "generated_column_substitute(true)",
+			output: []*ast.TableOptimizerHint{
+				{
+					HintName: model.NewCIStr("generated_column_substitute"),
+					HintData: true,
+				},
+			},
+		},
 		{
 			input: "USE_TOJA(TRUE) IGNORE_PLAN_CACHE() USE_CASCADES(TRUE) QUERY_TYPE(@qb1 OLAP) QUERY_TYPE(OLTP) NO_INDEX_MERGE() RESOURCE_GROUP(rg1)",
 			output: []*ast.TableOptimizerHint{
@@ -266,6 +266,7 @@ var Keywords = []KeywordsType{
 	{"ALGORITHM", false, "unreserved"},
 	{"ALWAYS", false, "unreserved"},
 	{"ANY", false, "unreserved"},
+	{"APPLICATION", false, "unreserved"},
 	{"ASCII", false, "unreserved"},
 	{"ATTRIBUTE", false, "unreserved"},
 	{"ATTRIBUTES", false, "unreserved"},
@@ -331,6 +332,7 @@ var Keywords = []KeywordsType{
 	{"CSV_NULL", false, "unreserved"},
 	{"CSV_SEPARATOR", false, "unreserved"},
 	{"CSV_TRIM_LAST_SEPARATORS", false, "unreserved"},
+	{"CUBE", false, "unreserved"},
 	{"CURRENT", false, "unreserved"},
 	{"CYCLE", false, "unreserved"},
 	{"DATA", false, "unreserved"},
@@ -341,6 +343,7 @@ var Keywords = []KeywordsType{
 	{"DECLARE", false, "unreserved"},
 	{"DEFINER", false, "unreserved"},
 	{"DELAY_KEY_WRITE", false, "unreserved"},
+	{"DIAGNOSTICS", false, "unreserved"},
 	{"DIGEST", false, "unreserved"},
 	{"DIRECTORY", false, "unreserved"},
 	{"DISABLE", false, "unreserved"},
@@ -350,6 +353,7 @@ var Keywords = []KeywordsType{
 	{"DO", false, "unreserved"},
 	{"DUPLICATE", false, "unreserved"},
 	{"DYNAMIC", false, "unreserved"},
+	{"EMPTY", false, "unreserved"},
 	{"ENABLE", false, "unreserved"},
 	{"ENABLED", false, "unreserved"},
 	{"ENCRYPTION", false, "unreserved"},
@@ -365,11 +369,15 @@ var Keywords = []KeywordsType{
 	{"EVENTS", false, "unreserved"},
 	{"EVOLVE", false, "unreserved"},
 	{"EXCHANGE", false, "unreserved"},
+	{"EXCLUDE", false, "unreserved"},
 	{"EXCLUSIVE", false, "unreserved"},
 	{"EXECUTE", false, "unreserved"},
 	{"EXPANSION", false, "unreserved"},
 	{"EXPIRE", false, "unreserved"},
+	{"EXPORT", false, "unreserved"},
 	{"EXTENDED", false, "unreserved"},
+	{"EXTERNAL_FORMAT", false, "unreserved"},
+	{"EXTERNAL_LOCATION", false, "unreserved"},
 	{"FAILED_LOGIN_ATTEMPTS", false, "unreserved"},
 	{"FAULTS", false, "unreserved"},
 	{"FIELDS", false, "unreserved"},
@@ -385,9 +393,11 @@ var Keywords = []KeywordsType{
 	{"GENERAL", false, "unreserved"},
 	{"GLOBAL", false, "unreserved"},
 	{"GRANTS", false, "unreserved"},
+	{"GROUPING", false, "unreserved"},
 	{"HANDLER", false, "unreserved"},
 	{"HASH", false, "unreserved"},
 	{"HELP", false, "unreserved"},
+	{"HINTS", false, "unreserved"},
 	{"HISTOGRAM", false, "unreserved"},
 	{"HISTORY", false, "unreserved"},
 	{"HOSTS", false, "unreserved"},
@@ -408,6 +418,7 @@ var Keywords = []KeywordsType{
 	{"ISOLATION", false, "unreserved"},
 	{"ISSUER", false, "unreserved"},
 	{"JSON", false, "unreserved"},
+	{"JSON_TABLE", false, "unreserved"},
 	{"KEY_BLOCK_SIZE", false, "unreserved"},
 	{"LABELS", false, "unreserved"},
 	{"LANGUAGE", false, "unreserved"},
@@ -421,6 +432,7 @@ var Keywords = []KeywordsType{
 	{"LOCATION", false, "unreserved"},
 	{"LOCKED", false, "unreserved"},
 	{"LOGS", false, "unreserved"},
+	{"MASKING", false, "unreserved"},
 	{"MASTER", false, "unreserved"},
 	{"MAX_CONNECTIONS_PER_HOUR", false, "unreserved"},
 	{"MAX_IDXNUM", false, "unreserved"},
@@ -443,6 +455,7 @@ var Keywords = []KeywordsType{
 	{"NAMES", false, "unreserved"},
 	{"NATIONAL", false, "unreserved"},
 	{"NCHAR", false, "unreserved"},
+	{"NESTED", false, "unreserved"},
 	{"NEVER", false, "unreserved"},
 	{"NEXT", false, "unreserved"},
 	{"NEXTVAL", false, "unreserved"},
@@ -467,6 +480,8 @@ var Keywords = []KeywordsType{
 	{"ON_DUPLICATE", false, "unreserved"},
 	{"OPEN", false, "unreserved"},
 	{"OPTIONAL", false, "unreserved"},
+	{"ORDINALITY", false, "unreserved"},
+	{"OTHERS", false, "unreserved"},
 	{"PACK_KEYS", false, "unreserved"},
 	{"PAGE", false, "unreserved"},
 	{"PARSER", false, "unreserved"},
@@ -475,6 +490,7 @@ var Keywords = []KeywordsType{
 	{"PARTITIONS", false, "unreserved"},
 	{"PASSWORD", false, "unreserved"},
 	{"PASSWORD_LOCK_TIME", false, "unreserved"},
+	{"PATH", false, "unreserved"},
 	{"PAUSE", false, "unreserved"},
 	{"PERCENT", false, "unreserved"},
 	{"PER_DB", false, "unreserved"},
@@ -499,6 +515,7 @@ var Keywords = []KeywordsType{
 	{"QUICK", false, "unreserved"},
 	{"RATE_LIMIT", false, "unreserved"},
 	{"REBUILD", false, "unreserved"},
+	{"RECOMMEND", false, "unreserved"},
 	{"RECOVER", false, "unreserved"},
 	{"REDUNDANT", false, "unreserved"},
 	{"RELOAD", false, "unreserved"},
@@ -539,6 +556,7 @@ var Keywords = []KeywordsType{
 	{"SERIAL", false, "unreserved"},
 	{"SERIALIZABLE", false, "unreserved"},
 	{"SESSION", false, "unreserved"},
+	{"SETS", false, "unreserved"},
 	{"SETVAL", false, "unreserved"},
 	{"SHARD_ROW_ID_BITS", false, "unreserved"},
 	{"SHARE", false, "unreserved"},
@@ -590,6 +608,7 @@ var Keywords = []KeywordsType{
 	{"TEMPTABLE", false, "unreserved"},
 	{"TEXT", false, "unreserved"},
 	{"THAN", false, "unreserved"},
+	{"TIES", false, "unreserved"},
 	{"TIKV_IMPORTER", false, "unreserved"},
 	{"TIME", false, "unreserved"},
 	{"TIMESTAMP", false, "unreserved"},
@@ -603,8 +622,13 @@ var Keywords = []KeywordsType{
 	{"TRUNCATE", false, "unreserved"},
 	{"TSO", false, "unreserved"},
 	{"TTL", false, "unreserved"},
+	{"TTL_CONDITION", false, "unreserved"},
+	{"TTL_DELETE_RATE_LIMIT", false, "unreserved"},
 	{"TTL_ENABLE", false, "unreserved"},
 	{"TTL_JOB_INTERVAL", false, "unreserved"},
+	{"TTL_JOB_WINDOW_END", false, "unreserved"},
+	{"TTL_JOB_WINDOW_START", false, "unreserved"},
+	{"TTL_RESOURCE_GROUP", false, "unreserved"},
 	{"TYPE", false, "unreserved"},
 	{"UNBOUNDED", false, "unreserved"},
 	{"UNCOMMITTED", false, "unreserved"},
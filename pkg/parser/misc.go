@@ -13,6 +13,11 @@
 
 package parser
 
+import (
+	"sort"
+	"strings"
+)
+
 func isLetter(ch byte) bool {
 	return (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z')
 }
@@ -172,6 +177,7 @@ var tokenMap = map[string]int{
 	"ANALYZE":                  analyze,
 	"AND":                      and,
 	"ANY":                      any,
+	"APPLICATION":              application,
 	"APPROX_COUNT_DISTINCT":    approxCountDistinct,
 	"APPROX_PERCENTILE":        approxPercentile,
 	"ARRAY":                    array,
@@ -287,6 +293,7 @@ var tokenMap = map[string]int{
 	"CSV_SEPARATOR":            csvSeparator,
 	"CSV_TRIM_LAST_SEPARATORS": csvTrimLastSeparators,
 	"CURDATE":                  curDate,
+	"CUBE":                     cube,
 	"CURRENT_DATE":             currentDate,
 	"CURRENT_ROLE":             currentRole,
 	"CURRENT_TIME":             currentTime,
@@ -323,6 +330,7 @@ var tokenMap = map[string]int{
 	"DEPTH":                    depth,
 	"DESC":                     desc,
 	"DESCRIBE":                 describe,
+	"DIAGNOSTICS":              diagnostics,
 	"DIGEST":                   digest,
 	"DIRECTORY":                directory,
 	"DISABLE":                  disable,
@@ -346,6 +354,7 @@ var tokenMap = map[string]int{
 	"DYNAMIC":                  dynamic,
 	"ELSE":                     elseKwd,
 	"ELSEIF":                   elseIfKwd,
+	"EMPTY":                    emptyKwd,
 	"ENABLE":                   enable,
 	"ENABLED":                  enabled,
 	"ENCLOSED":                 enclosed,
@@ -367,6 +376,7 @@ var tokenMap = map[string]int{
 	"EXEC_ELAPSED":             execElapsed,
 	"EXCEPT":                   except,
 	"EXCHANGE":                 exchange,
+	"EXCLUDE":                  exclude,
 	"EXCLUSIVE":                exclusive,
 	"EXECUTE":                  execute,
 	"EXISTS":                   exists,
@@ -374,8 +384,11 @@ var tokenMap = map[string]int{
 	"EXPANSION":                expansion,
 	"EXPIRE":                   expire,
 	"EXPLAIN":                  explain,
+	"EXPORT":                   export,
 	"EXPR_PUSHDOWN_BLACKLIST":  exprPushdownBlacklist,
 	"EXTENDED":                 extended,
+	"EXTERNAL_FORMAT":          externalFormat,
+	"EXTERNAL_LOCATION":        externalLocation,
 	"EXTRACT":                  extract,
 	"FALSE":                    falseKwd,
 	"FAULTS":                   faultsSym,
@@ -412,11 +425,13 @@ var tokenMap = map[string]int{
 	"GRANTS":                   grants,
 	"GROUP_CONCAT":             groupConcat,
 	"GROUP":                    group,
+	"GROUPING":                 grouping,
 	"HASH":                     hash,
 	"HANDLER":                  handler,
 	"HAVING":                   having,
 	"HELP":                     help,
 	"HIGH_PRIORITY":            highPriority,
+	"HINTS":                    hints,
 	"HISTORY":                  history,
 	"HISTOGRAM":                histogram,
 	"HOSTS":                    hosts,
@@ -475,6 +490,7 @@ var tokenMap = map[string]int{
 	"JSON_ARRAYAGG":            jsonArrayagg,
 	"JSON_OBJECTAGG":           jsonObjectAgg,
 	"JSON":                     jsonType,
+	"JSON_TABLE":               jsonTable,
 	"KEY_BLOCK_SIZE":           keyBlockSize,
 	"KEY":                      key,
 	"KEYS":                     keys,
@@ -512,6 +528,7 @@ var tokenMap = map[string]int{
 	"LONGBLOB":                 longblobType,
 	"LONGTEXT":                 longtextType,
 	"LOW_PRIORITY":             lowPriority,
+	"MASKING":                  masking,
 	"MASTER":                   master,
 	"MATCH":                    match,
 	"MAX_CONNECTIONS_PER_HOUR": maxConnectionsPerHour,
@@ -547,6 +564,7 @@ var tokenMap = map[string]int{
 	"NATIONAL":                 national,
 	"NATURAL":                  natural,
 	"NCHAR":                    ncharType,
+	"NESTED":                   nested,
 	"NEVER":                    never,
 	"NEXT_ROW_ID":              next_row_id,
 	"NEXT":                     next,
@@ -584,11 +602,14 @@ var tokenMap = map[string]int{
 	"OPT_RULE_BLACKLIST":       optRuleBlacklist,
 	"OPTIMISTIC":               optimistic,
 	"OPTIMIZE":                 optimize,
+	"OPTIMIZER_VARS":           optimizerVars,
 	"OPTION":                   option,
 	"OPTIONAL":                 optional,
 	"OPTIONALLY":               optionally,
 	"OR":                       or,
 	"ORDER":                    order,
+	"ORDINALITY":               ordinality,
+	"OTHERS":                   others,
 	"OUT":                      out,
 	"OUTER":                    outer,
 	"OUTFILE":                  outfile,
@@ -600,6 +621,7 @@ var tokenMap = map[string]int{
 	"PARTITIONING":             partitioning,
 	"PARTITIONS":               partitions,
 	"PASSWORD":                 password,
+	"PATH":                     path,
 	"PAUSE":                    pause,
 	"PERCENT":                  percent,
 	"PER_DB":                   per_db,
@@ -640,6 +662,7 @@ var tokenMap = map[string]int{
 	"REAL":                     realType,
 	"REBUILD":                  rebuild,
 	"RECENT":                   recent,
+	"RECOMMEND":                recommend,
 	"RECOVER":                  recover,
 	"RECURSIVE":                recursive,
 	"REDUNDANT":                redundant,
@@ -711,6 +734,7 @@ var tokenMap = map[string]int{
 	"SESSION":                  session,
 	"SESSION_STATES":           sessionStates,
 	"SET":                      set,
+	"SETS":                     sets,
 	"SETVAL":                   setval,
 	"SHARD_ROW_ID_BITS":        shardRowIDBits,
 	"SHARE":                    share,
@@ -806,6 +830,7 @@ var tokenMap = map[string]int{
 	"THAN":                     than,
 	"THEN":                     then,
 	"TIDB":                     tidb,
+	"TIES":                     ties,
 	"TIDB_CURRENT_TSO":         tidbCurrentTSO,
 	"TIDB_JSON":                tidbJson,
 	"TIFLASH":                  tiFlash,
@@ -844,8 +869,13 @@ var tokenMap = map[string]int{
 	"TRUE_CARD_COST":           trueCardCost,
 	"TSO":                      tsoType,
 	"TTL":                      ttl,
+	"TTL_CONDITION":            ttlCondition,
+	"TTL_DELETE_RATE_LIMIT":    ttlDeleteRateLimit,
 	"TTL_ENABLE":               ttlEnable,
 	"TTL_JOB_INTERVAL":         ttlJobInterval,
+	"TTL_JOB_WINDOW_END":       ttlJobWindowEnd,
+	"TTL_JOB_WINDOW_START":     ttlJobWindowStart,
+	"TTL_RESOURCE_GROUP":       ttlResourceGroup,
 	"TYPE":                     tp,
 	"UNBOUNDED":                unbounded,
 	"UNCOMMITTED":              uncommitted,
@@ -1009,6 +1039,12 @@ var hintTokenMap = map[string]int{
 	"NO_RANGE_OPTIMIZATION": hintNoRangeOptimization,
 	"SKIP_SCAN":             hintSkipScan,
 	"NO_SKIP_SCAN":          hintNoSkipScan,
+	"INDEX":                 hintIndex,
+	"NO_INDEX":              hintNoIndex,
+	"GROUP_INDEX":           hintGroupIndex,
+	"NO_GROUP_INDEX":        hintNoGroupIndex,
+	"JOIN_INDEX":            hintJoinIndex,
+	"NO_JOIN_INDEX":         hintNoJoinIndex,
 	"SEMIJOIN":              hintSemijoin,
 	"NO_SEMIJOIN":           hintNoSemijoin,
 	"MAX_EXECUTION_TIME":    hintMaxExecutionTime,
@@ -1017,47 +1053,72 @@ var hintTokenMap = map[string]int{
 	"QB_NAME":               hintQBName,
 
 	// TiDB hint names
-	"AGG_TO_COP":              hintAggToCop,
-	"LIMIT_TO_COP":            hintLimitToCop,
-	"IGNORE_PLAN_CACHE":       hintIgnorePlanCache,
-	"HASH_AGG":                hintHashAgg,
-	"MPP_1PHASE_AGG":          hintMpp1PhaseAgg,
-	"MPP_2PHASE_AGG":          hintMpp2PhaseAgg,
-	"IGNORE_INDEX":            hintIgnoreIndex,
-	"INL_HASH_JOIN":           hintInlHashJoin,
-	"INDEX_HASH_JOIN":         hintIndexHashJoin,
-	"NO_INDEX_HASH_JOIN":      hintNoIndexHashJoin,
-	"INL_JOIN":                hintInlJoin,
-	"INDEX_JOIN":              hintIndexJoin,
-	"NO_INDEX_JOIN":           hintNoIndexJoin,
-	"INL_MERGE_JOIN":          hintInlMergeJoin,
-	"INDEX_MERGE_JOIN":        hintIndexMergeJoin,
-	"NO_INDEX_MERGE_JOIN":     hintNoIndexMergeJoin,
-	"MEMORY_QUOTA":            hintMemoryQuota,
-	"NO_SWAP_JOIN_INPUTS":     hintNoSwapJoinInputs,
-	"QUERY_TYPE":              hintQueryType,
-	"READ_CONSISTENT_REPLICA": hintReadConsistentReplica,
-	"READ_FROM_STORAGE":       hintReadFromStorage,
-	"BROADCAST_JOIN":          hintBCJoin,
-	"SHUFFLE_JOIN":            hintShuffleJoin,
-	"MERGE_JOIN":              hintSMJoin,
-	"NO_MERGE_JOIN":           hintNoSMJoin,
-	"STREAM_AGG":              hintStreamAgg,
-	"SWAP_JOIN_INPUTS":        hintSwapJoinInputs,
-	"USE_INDEX_MERGE":         hintUseIndexMerge,
-	"USE_INDEX":               hintUseIndex,
-	"ORDER_INDEX":             hintOrderIndex,
-	"NO_ORDER_INDEX":          hintNoOrderIndex,
-	"USE_PLAN_CACHE":          hintUsePlanCache,
-	"USE_TOJA":                hintUseToja,
-	"TIME_RANGE":              hintTimeRange,
-	"USE_CASCADES":            hintUseCascades,
-	"NTH_PLAN":                hintNthPlan,
-	"FORCE_INDEX":             hintForceIndex,
-	"STRAIGHT_JOIN":           hintStraightJoin,
-	"LEADING":                 hintLeading,
-	"SEMI_JOIN_REWRITE":       hintSemiJoinRewrite,
-	"NO_DECORRELATE":          hintNoDecorrelate,
+	"AGG_TO_COP":                hintAggToCop,
+	"LIMIT_TO_COP":              hintLimitToCop,
+	"IGNORE_PLAN_CACHE":         hintIgnorePlanCache,
+	"HASH_AGG":                  hintHashAgg,
+	"MPP_1PHASE_AGG":            hintMpp1PhaseAgg,
+	"MPP_2PHASE_AGG":            hintMpp2PhaseAgg,
+	"IGNORE_INDEX":              hintIgnoreIndex,
+	"INL_HASH_JOIN":             hintInlHashJoin,
+	"INDEX_HASH_JOIN":           hintIndexHashJoin,
+	"NO_INDEX_HASH_JOIN":        hintNoIndexHashJoin,
+	"INL_JOIN":                  hintInlJoin,
+	"INDEX_JOIN":                hintIndexJoin,
+	"NO_INDEX_JOIN":             hintNoIndexJoin,
+	"INL_MERGE_JOIN":            hintInlMergeJoin,
+	"INDEX_MERGE_JOIN":          hintIndexMergeJoin,
+	"NO_INDEX_MERGE_JOIN":       hintNoIndexMergeJoin,
+	"MEMORY_QUOTA":              hintMemoryQuota,
+	"NO_SWAP_JOIN_INPUTS":       hintNoSwapJoinInputs,
+	"QUERY_TYPE":                hintQueryType,
+	"READ_CONSISTENT_REPLICA":   hintReadConsistentReplica,
+	"READ_FROM_STORAGE":         hintReadFromStorage,
+	"BROADCAST_JOIN":            hintBCJoin,
+	"SHUFFLE_JOIN":              hintShuffleJoin,
+	"MERGE_JOIN":                hintSMJoin,
+	"NO_MERGE_JOIN":             hintNoSMJoin,
+	"STREAM_AGG":                hintStreamAgg,
+	"SWAP_JOIN_INPUTS":          hintSwapJoinInputs,
+	"USE_INDEX_MERGE":           hintUseIndexMerge,
+	"USE_INDEX":                 hintUseIndex,
+	"ORDER_INDEX":               hintOrderIndex,
+	"NO_ORDER_INDEX":            hintNoOrderIndex,
+	"LOOSE_INDEX_SCAN":          hintLooseIndexScan,
+	"USE_PLAN_CACHE":            hintUsePlanCache,
+	"USE_TOJA":                  hintUseToja,
+	"TIME_RANGE":                hintTimeRange,
+	"USE_CASCADES":              hintUseCascades,
+	"NTH_PLAN":                  hintNthPlan,
+	"FORCE_INDEX":               hintForceIndex,
+	"STRAIGHT_JOIN":             hintStraightJoin,
+	"LEADING":                   hintLeading,
+	"SEMI_JOIN_REWRITE":         hintSemiJoinRewrite,
+	"NO_DECORRELATE":            hintNoDecorrelate,
+	"FIX_CONTROL":               hintFixControl,
+	"FULL_SCAN":                 hintFullScan,
+	"INDEX_ONLY":                hintIndexOnly,
+	"NO_FULL_SCAN":              hintNoFullScan,
+	"SAMPLE":                    hintSample,
+	"INL_JOIN_PREFETCH":         hintINLJoinPrefetch,
+	"DISTINCT_AGG_PUSH_DOWN":    hintDistinctAggPushDown,
+	"NO_DISTINCT_AGG_PUSH_DOWN": hintNoDistinctAggPushDown,
+	"APPROX_COUNT_DISTINCT":     hintApproxCountDistinct,
+	"NO_EXPAND":                 hintNoExpand,
+	"MATERIALIZE_SUBQUERY":      hintMaterializeSubquery,
+	"NO_MATERIALIZE_SUBQUERY":   hintNoMaterializeSubquery,
+	"USE_FULLTEXT_INDEX":        hintUseFullTextIndex,
+	"NO_FULLTEXT_INDEX":         hintNoFullTextIndex,
+	"USE_INVISIBLE_INDEXES":     hintUseInvisibleIndexes,
+	"ASYNC_COMMIT":              hintAsyncCommit,
+	"ONE_PC":                    hintOnePC,
+	"HEDGED_READ":               hintHedgedRead,
+	"MPP_EXCHANGE_COMPRESSION":  hintMppExchangeCompression,
+	"BATCH_DML":                 hintBatchDML,
+	"SHARD_ROW_ID":              hintShardRowID,
+
+	"GENERATED_COLUMN_SUBSTITUTE": hintGeneratedColSubstitute,
+	"READ_LOCALITY":               hintReadLocality,
 
 	// TiDB hint aliases
 	"TIDB_HJ":   hintHashJoin,
@@ -1078,6 +1139,37 @@ var hintTokenMap = map[string]int{
 	"FIRSTMATCH":      hintFirstMatch,
 	"LOOSESCAN":       hintLooseScan,
 	"MATERIALIZATION": hintMaterialization,
+	"PERCENT":         hintPercent,
+}
+
+// hintArgumentKeywords are the keywords in hintTokenMap which are only used as the arguments of hints.
+var hintArgumentKeywords = map[int]struct{}{
+	hintOLAP:            {},
+	hintOLTP:            {},
+	hintTiKV:            {},
+	hintTiFlash:         {},
+	hintPartition:       {},
+	hintFalse:           {},
+	hintTrue:            {},
+	hintMB:              {},
+	hintGB:              {},
+	hintDupsWeedOut:     {},
+	hintFirstMatch:      {},
+	hintLooseScan:       {},
+	hintMaterialization: {},
+	hintPercent:         {},
+}
+
+// HintNames returns the names of all the optimizer hints recognized by the hint parser in lower case, sorted by name.
+func HintNames() []string {
+	names := make([]string, 0, len(hintTokenMap))
+	for name, tok := range hintTokenMap {
+		if _, ok := hintArgumentKeywords[tok]; !ok {
+			names = append(names, strings.ToLower(name))
+		}
+	}
+	sort.Strings(names)
+	return names
 }
 
 func (s *Scanner) isTokenIdentifier(lit string, offset int) int {
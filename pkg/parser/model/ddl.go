@@ -106,6 +106,10 @@ const (
 	ActionDropResourceGroup      ActionType = 70
 	ActionAlterTablePartitioning ActionType = 71
 	ActionRemovePartitioning     ActionType = 72
+	ActionCreateRowPolicy        ActionType = 73
+	ActionDropRowPolicy          ActionType = 74
+	ActionCreateMaskingPolicy    ActionType = 75
+	ActionDropMaskingPolicy      ActionType = 76
 )
 
 // ActionMap is the map of DDL ActionType to string.
@@ -177,6 +181,10 @@ var ActionMap = map[ActionType]string{
 	ActionDropResourceGroup:             "drop resource group",
 	ActionAlterTablePartitioning:        "alter table partition by",
 	ActionRemovePartitioning:            "alter table remove partitioning",
+	ActionCreateRowPolicy:               "create row policy",
+	ActionDropRowPolicy:                 "drop row policy",
+	ActionCreateMaskingPolicy:           "create masking policy",
+	ActionDropMaskingPolicy:             "drop masking policy",
 
 	// `ActionAlterTableAlterPartition` is removed and will never be used.
 	// Just left a tombstone here for compatibility.
@@ -266,6 +274,10 @@ var BDRActionMap = map[DDLBDRType][]ActionType{
 		ActionReorganizePartition,
 		ActionAlterTablePartitioning,
 		ActionRemovePartitioning,
+		ActionCreateRowPolicy,
+		ActionDropRowPolicy,
+		ActionCreateMaskingPolicy,
+		ActionDropMaskingPolicy,
 	},
 	UnmanagementDDL: {
 		ActionCreatePlacementPolicy,
@@ -956,7 +968,7 @@ func (job *Job) MayNeedReorg() bool {
 	case ActionAddIndex, ActionAddPrimaryKey, ActionReorganizePartition,
 		ActionRemovePartitioning, ActionAlterTablePartitioning:
 		return true
-	case ActionModifyColumn:
+	case ActionModifyColumn, ActionAddColumn:
 		if len(job.CtxVars) > 0 {
 			needReorg, ok := job.CtxVars[0].(bool)
 			return ok && needReorg
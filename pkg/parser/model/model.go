@@ -542,6 +542,15 @@ type TableInfo struct {
 
 	TTLInfo *TTLInfo `json:"ttl_info"`
 
+	// RowPolicies are the row-level security policies defined on the table.
+	RowPolicies []*RowPolicyInfo `json:"row_policies,omitempty"`
+
+	// MaskingPolicies are the data masking policies defined on the columns of the table.
+	MaskingPolicies []*MaskingPolicyInfo `json:"masking_policies,omitempty"`
+
+	// ExternalInfo is not nil when the rows of the table are read from the files in the external storage.
+	ExternalInfo *ExternalTableInfo `json:"external_info,omitempty"`
+
 	// Revision is per table schema's version, it will be increased when the schema changed.
 	Revision uint64 `json:"revision"`
 
@@ -762,6 +771,21 @@ func (t *TableInfo) Clone() *TableInfo {
 	if t.TTLInfo != nil {
 		nt.TTLInfo = t.TTLInfo.Clone()
 	}
+	if len(t.RowPolicies) > 0 {
+		nt.RowPolicies = make([]*RowPolicyInfo, len(t.RowPolicies))
+		for i := range t.RowPolicies {
+			nt.RowPolicies[i] = t.RowPolicies[i].Clone()
+		}
+	}
+	if len(t.MaskingPolicies) > 0 {
+		nt.MaskingPolicies = make([]*MaskingPolicyInfo, len(t.MaskingPolicies))
+		for i := range t.MaskingPolicies {
+			nt.MaskingPolicies[i] = t.MaskingPolicies[i].Clone()
+		}
+	}
+	if t.ExternalInfo != nil {
+		nt.ExternalInfo = t.ExternalInfo.Clone()
+	}
 
 	return &nt
 }
@@ -1028,6 +1052,11 @@ func (t *TableInfo) IsSequence() bool {
 	return t.Sequence != nil
 }
 
+// IsExternal checks if TableInfo is an external table.
+func (t *TableInfo) IsExternal() bool {
+	return t.ExternalInfo != nil
+}
+
 // IsBaseTable checks to see the table is neither a view or a sequence.
 func (t *TableInfo) IsBaseTable() bool {
 	return t.Sequence == nil && t.View == nil
@@ -1115,6 +1144,8 @@ type ViewInfo struct {
 	SelectStmt  string             `json:"view_select"`
 	CheckOption ViewCheckOption    `json:"view_checkoption"`
 	Cols        []CIStr            `json:"view_cols"`
+	// Hints is the hint set specified by `CREATE VIEW ... WITH HINTS`.
+	Hints string `json:"view_hints,omitempty"`
 }
 
 const (
@@ -1455,6 +1486,8 @@ func (t IndexType) String() string {
 		return "RTREE"
 	case IndexTypeHypo:
 		return "HYPO"
+	case IndexTypeFullText:
+		return "FULLTEXT"
 	default:
 		return ""
 	}
@@ -1467,6 +1500,8 @@ const (
 	IndexTypeHash
 	IndexTypeRtree
 	IndexTypeHypo
+	// IndexTypeFullText is the FULLTEXT index, which is stored as a multi-valued index on the tokens of the columns.
+	IndexTypeFullText
 )
 
 // IndexInfo provides meta data describing a DB index.
@@ -1552,6 +1587,9 @@ type ConstraintInfo struct {
 	InColumn       bool        `json:"in_column"` // Indicate whether the constraint is column type check.
 	ExprString     string      `json:"expr_string"`
 	State          SchemaState `json:"state"`
+	// Validated indicates all the rows of the table are verified to satisfy the constraint, it's set when the
+	// constraint is created on an empty table or enforced after verifying the existing rows.
+	Validated bool `json:"validated,omitempty"`
 }
 
 // Clone clones ConstraintInfo.
@@ -1574,6 +1612,139 @@ func (t *TableInfo) FindConstraintInfoByName(constrName string) *ConstraintInfo
 	return nil
 }
 
+// RowPolicyInfo provides meta data describing a row-level security policy.
+// Only the rows satisfying `ExprString` are visible to the users and roles in `Roles`.
+type RowPolicyInfo struct {
+	Name CIStr `json:"name"`
+	// Roles are the users or roles the policy applies to. Empty means the policy applies to everyone.
+	Roles      []*auth.RoleIdentity `json:"roles,omitempty"`
+	ExprString string               `json:"expr_string"`
+}
+
+// Clone clones RowPolicyInfo.
+func (p *RowPolicyInfo) Clone() *RowPolicyInfo {
+	np := *p
+	np.Roles = cloneRoleIdentities(p.Roles)
+	return &np
+}
+
+// AppliesTo returns whether the policy applies to the user or one of its active roles.
+func (p *RowPolicyInfo) AppliesTo(user *auth.UserIdentity, activeRoles []*auth.RoleIdentity) bool {
+	return policyAppliesTo(p.Roles, user, activeRoles)
+}
+
+func cloneRoleIdentities(roles []*auth.RoleIdentity) []*auth.RoleIdentity {
+	nroles := make([]*auth.RoleIdentity, 0, len(roles))
+	for _, r := range roles {
+		nr := *r
+		nroles = append(nroles, &nr)
+	}
+	return nroles
+}
+
+// policyAppliesTo returns whether a policy for `roles` applies to the user or one of its active roles.
+// A policy without any roles applies to everyone.
+func policyAppliesTo(roles []*auth.RoleIdentity, user *auth.UserIdentity, activeRoles []*auth.RoleIdentity) bool {
+	if len(roles) == 0 {
+		return true
+	}
+	for _, r := range roles {
+		if user != nil && r.Username == user.AuthUsername && strings.EqualFold(r.Hostname, user.AuthHostname) {
+			return true
+		}
+		for _, ar := range activeRoles {
+			if r.Username == ar.Username && strings.EqualFold(r.Hostname, ar.Hostname) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// MaskingType is the way a data masking policy masks the values of a column.
+type MaskingType byte
+
+// List of masking types.
+const (
+	// MaskingTypeFull replaces every character of a string with '*', and values of other types with NULL.
+	MaskingTypeFull MaskingType = iota + 1
+	// MaskingTypePartial only keeps the first `PartialPrefix` and the last `PartialSuffix` characters of a string.
+	MaskingTypePartial
+	// MaskingTypeHash replaces the values with their SHA-256 digests.
+	MaskingTypeHash
+)
+
+// String implements fmt.Stringer interface.
+func (t MaskingType) String() string {
+	switch t {
+	case MaskingTypeFull:
+		return "FULL"
+	case MaskingTypePartial:
+		return "PARTIAL"
+	case MaskingTypeHash:
+		return "HASH"
+	default:
+		return ""
+	}
+}
+
+// MaskingPolicyInfo provides meta data describing a data masking policy.
+// The values of `ColumnName` are masked for the users and roles in `Roles` when they are read.
+type MaskingPolicyInfo struct {
+	Name       CIStr `json:"name"`
+	ColumnName CIStr `json:"column_name"`
+	// Roles are the users or roles the policy applies to. Empty means the policy applies to everyone.
+	Roles         []*auth.RoleIdentity `json:"roles,omitempty"`
+	Type          MaskingType          `json:"type"`
+	PartialPrefix int                  `json:"partial_prefix,omitempty"`
+	PartialSuffix int                  `json:"partial_suffix,omitempty"`
+}
+
+// Clone clones MaskingPolicyInfo.
+func (p *MaskingPolicyInfo) Clone() *MaskingPolicyInfo {
+	np := *p
+	np.Roles = cloneRoleIdentities(p.Roles)
+	return &np
+}
+
+// AppliesTo returns whether the policy applies to the user or one of its active roles.
+func (p *MaskingPolicyInfo) AppliesTo(user *auth.UserIdentity, activeRoles []*auth.RoleIdentity) bool {
+	return policyAppliesTo(p.Roles, user, activeRoles)
+}
+
+// FindMaskingPolicyByName finds the masking policy by name.
+func (t *TableInfo) FindMaskingPolicyByName(name string) *MaskingPolicyInfo {
+	lowName := strings.ToLower(name)
+	for _, p := range t.MaskingPolicies {
+		if p.Name.L == lowName {
+			return p
+		}
+	}
+	return nil
+}
+
+// FindMaskingPolicyByColumn finds the masking policy of the column.
+func (t *TableInfo) FindMaskingPolicyByColumn(colName string) *MaskingPolicyInfo {
+	lowName := strings.ToLower(colName)
+	for _, p := range t.MaskingPolicies {
+		if p.ColumnName.L == lowName {
+			return p
+		}
+	}
+	return nil
+}
+
+// FindRowPolicyByName finds the row policy by name.
+func (t *TableInfo) FindRowPolicyByName(name string) *RowPolicyInfo {
+	lowName := strings.ToLower(name)
+	for _, p := range t.RowPolicies {
+		if p.Name.L == lowName {
+			return p
+		}
+	}
+	return nil
+}
+
 // FindIndexNameByID finds index name by id.
 func (t *TableInfo) FindIndexNameByID(id int64) string {
 	indexInfo := FindIndexInfoByID(t.Indices, id)
@@ -1855,7 +2026,24 @@ type TTLInfo struct {
 	// JobInterval is the interval between two TTL scan jobs.
 	// It's suggested to get a duration with `(*TTLInfo).GetJobInterval`
 	JobInterval string `json:"job_interval"`
-}
+	// ConditionExprStr is an optional deterministic predicate. A row is only expired when both the time column
+	// exceeds the interval and this predicate is true. An empty string means no extra condition.
+	ConditionExprStr string `json:"condition_expr,omitempty"`
+	// JobWindowStart and JobWindowEnd limit the time of day in which the TTL jobs of this table can run, in the
+	// format of `TTLJobWindowTimeFormat`. They narrow the global `tidb_ttl_job_schedule_window_start_time` and
+	// `tidb_ttl_job_schedule_window_end_time`, and an empty string means the global setting is used.
+	JobWindowStart string `json:"job_window_start,omitempty"`
+	JobWindowEnd   string `json:"job_window_end,omitempty"`
+	// DeleteRateLimit limits the DELETE statements per second of the TTL jobs of this table on each TiDB node,
+	// in addition to the global `tidb_ttl_delete_rate_limit`. 0 means no limit for this table.
+	DeleteRateLimit int64 `json:"delete_rate_limit,omitempty"`
+	// ResourceGroup is the resource group the TTL jobs of this table run in. An empty string means the TTL jobs
+	// run in the default way without a resource group hint.
+	ResourceGroup string `json:"resource_group,omitempty"`
+}
+
+// TTLJobWindowTimeFormat is the format of `TTLInfo.JobWindowStart` and `TTLInfo.JobWindowEnd`.
+const TTLJobWindowTimeFormat = "15:04 -0700"
 
 // Clone clones TTLInfo
 func (t *TTLInfo) Clone() *TTLInfo {
@@ -1876,6 +2064,27 @@ func (t *TTLInfo) GetJobInterval() (time.Duration, error) {
 	return duration.ParseDuration(t.JobInterval)
 }
 
+// ExternalTableInfo provides meta data describing an external table. The external table is read-only, and its rows
+// are read from the data files in the external storage every time the table is queried.
+type ExternalTableInfo struct {
+	// Location is the URI of the data files, the last part of it can be a glob pattern like `s3://bucket/dir/*.csv`.
+	Location string `json:"location"`
+	// Format is the format of the data files in lower case, see `ExternalTableFormatCSV` and `ExternalTableFormatParquet`.
+	Format string `json:"format"`
+}
+
+// The formats of the data files of external tables.
+const (
+	ExternalTableFormatCSV     = "csv"
+	ExternalTableFormatParquet = "parquet"
+)
+
+// Clone clones ExternalTableInfo.
+func (e *ExternalTableInfo) Clone() *ExternalTableInfo {
+	cloned := *e
+	return &cloned
+}
+
 func writeSettingItemToBuilder(sb *strings.Builder, item string, separatorFns ...func()) {
 	if sb.Len() != 0 {
 		for _, fn := range separatorFns {
@@ -2043,6 +2252,13 @@ type ResourceGroupSettings struct {
 	BurstLimit       int64                            `json:"burst_limit"`
 	Runaway          *ResourceGroupRunawaySettings    `json:"runaway"`
 	Background       *ResourceGroupBackgroundSettings `json:"background"`
+	OptimizerVars    []ResourceGroupOptimizerVar      `json:"optimizer_vars"`
+}
+
+// ResourceGroupOptimizerVar is the optimizer variable overridden for the statements running under the resource group.
+type ResourceGroupOptimizerVar struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
 }
 
 // NewResourceGroupSettings creates a new ResourceGroupSettings.
@@ -2078,6 +2294,8 @@ const (
 	HighPriorityValue   = 16
 )
 
+var optimizerVarValueEscaper = strings.NewReplacer(`\`, `\\`, `'`, `''`)
+
 func (p *ResourceGroupSettings) String() string {
 	sb := new(strings.Builder)
 	separatorFn := func() {
@@ -2116,6 +2334,17 @@ func (p *ResourceGroupSettings) String() string {
 	if p.Background != nil {
 		fmt.Fprintf(sb, ", BACKGROUND=(TASK_TYPES='%s')", strings.Join(p.Background.JobTypes, ","))
 	}
+	if len(p.OptimizerVars) > 0 {
+		sb.WriteString(", OPTIMIZER_VARS=(")
+		for i, v := range p.OptimizerVars {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			// The value is restored as a single-quoted string literal, which is escaped in the same way as format.RestoreCtx.
+			fmt.Fprintf(sb, "%s='%s'", v.Name, optimizerVarValueEscaper.Replace(v.Value))
+		}
+		sb.WriteString(")")
+	}
 
 	return sb.String()
 }
@@ -2131,6 +2360,9 @@ func (p *ResourceGroupSettings) Adjust() {
 // Clone clones the resource group settings.
 func (p *ResourceGroupSettings) Clone() *ResourceGroupSettings {
 	cloned := *p
+	if p.OptimizerVars != nil {
+		cloned.OptimizerVars = append([]ResourceGroupOptimizerVar(nil), p.OptimizerVars...)
+	}
 	return &cloned
 }
 
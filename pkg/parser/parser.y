@@ -321,6 +321,7 @@ import (
 	algorithm             "ALGORITHM"
 	always                "ALWAYS"
 	any                   "ANY"
+	application           "APPLICATION"
 	ascii                 "ASCII"
 	attribute             "ATTRIBUTE"
 	attributes            "ATTRIBUTES"
@@ -386,6 +387,7 @@ import (
 	csvNull               "CSV_NULL"
 	csvSeparator          "CSV_SEPARATOR"
 	csvTrimLastSeparators "CSV_TRIM_LAST_SEPARATORS"
+	cube                  "CUBE"
 	current               "CURRENT"
 	cycle                 "CYCLE"
 	data                  "DATA"
@@ -396,6 +398,7 @@ import (
 	declare               "DECLARE"
 	definer               "DEFINER"
 	delayKeyWrite         "DELAY_KEY_WRITE"
+	diagnostics           "DIAGNOSTICS"
 	digest                "DIGEST"
 	directory             "DIRECTORY"
 	disable               "DISABLE"
@@ -405,6 +408,7 @@ import (
 	do                    "DO"
 	duplicate             "DUPLICATE"
 	dynamic               "DYNAMIC"
+	emptyKwd              "EMPTY"
 	enable                "ENABLE"
 	enabled               "ENABLED"
 	encryption            "ENCRYPTION"
@@ -420,11 +424,15 @@ import (
 	events                "EVENTS"
 	evolve                "EVOLVE"
 	exchange              "EXCHANGE"
+	exclude               "EXCLUDE"
 	exclusive             "EXCLUSIVE"
 	execute               "EXECUTE"
 	expansion             "EXPANSION"
 	expire                "EXPIRE"
+	export                "EXPORT"
 	extended              "EXTENDED"
+	externalFormat        "EXTERNAL_FORMAT"
+	externalLocation      "EXTERNAL_LOCATION"
 	failedLoginAttempts   "FAILED_LOGIN_ATTEMPTS"
 	faultsSym             "FAULTS"
 	fields                "FIELDS"
@@ -440,9 +448,11 @@ import (
 	general               "GENERAL"
 	global                "GLOBAL"
 	grants                "GRANTS"
+	grouping              "GROUPING"
 	handler               "HANDLER"
 	hash                  "HASH"
 	help                  "HELP"
+	hints                 "HINTS"
 	histogram             "HISTOGRAM"
 	history               "HISTORY"
 	hosts                 "HOSTS"
@@ -463,6 +473,7 @@ import (
 	isolation             "ISOLATION"
 	issuer                "ISSUER"
 	jsonType              "JSON"
+	jsonTable             "JSON_TABLE"
 	keyBlockSize          "KEY_BLOCK_SIZE"
 	labels                "LABELS"
 	language              "LANGUAGE"
@@ -476,6 +487,7 @@ import (
 	location              "LOCATION"
 	locked                "LOCKED"
 	logs                  "LOGS"
+	masking               "MASKING"
 	master                "MASTER"
 	maxConnectionsPerHour "MAX_CONNECTIONS_PER_HOUR"
 	max_idxnum            "MAX_IDXNUM"
@@ -498,6 +510,7 @@ import (
 	names                 "NAMES"
 	national              "NATIONAL"
 	ncharType             "NCHAR"
+	nested                "NESTED"
 	never                 "NEVER"
 	next                  "NEXT"
 	nextval               "NEXTVAL"
@@ -522,6 +535,8 @@ import (
 	onDuplicate           "ON_DUPLICATE"
 	open                  "OPEN"
 	optional              "OPTIONAL"
+	ordinality            "ORDINALITY"
+	others                "OTHERS"
 	packKeys              "PACK_KEYS"
 	pageSym               "PAGE"
 	parser                "PARSER"
@@ -530,6 +545,7 @@ import (
 	partitions            "PARTITIONS"
 	password              "PASSWORD"
 	passwordLockTime      "PASSWORD_LOCK_TIME"
+	path                  "PATH"
 	pause                 "PAUSE"
 	percent               "PERCENT"
 	per_db                "PER_DB"
@@ -555,6 +571,7 @@ import (
 	quick                 "QUICK"
 	rateLimit             "RATE_LIMIT"
 	rebuild               "REBUILD"
+	recommend             "RECOMMEND"
 	recover               "RECOVER"
 	redundant             "REDUNDANT"
 	reload                "RELOAD"
@@ -595,6 +612,7 @@ import (
 	serial                "SERIAL"
 	serializable          "SERIALIZABLE"
 	session               "SESSION"
+	sets                  "SETS"
 	setval                "SETVAL"
 	shardRowIDBits        "SHARD_ROW_ID_BITS"
 	share                 "SHARE"
@@ -646,6 +664,7 @@ import (
 	temptable             "TEMPTABLE"
 	textType              "TEXT"
 	than                  "THAN"
+	ties                  "TIES"
 	tikvImporter          "TIKV_IMPORTER"
 	timeType              "TIME"
 	timestampType         "TIMESTAMP"
@@ -659,8 +678,13 @@ import (
 	truncate              "TRUNCATE"
 	tsoType               "TSO"
 	ttl                   "TTL"
+	ttlCondition          "TTL_CONDITION"
+	ttlDeleteRateLimit    "TTL_DELETE_RATE_LIMIT"
 	ttlEnable             "TTL_ENABLE"
 	ttlJobInterval        "TTL_JOB_INTERVAL"
+	ttlJobWindowEnd       "TTL_JOB_WINDOW_END"
+	ttlJobWindowStart     "TTL_JOB_WINDOW_START"
+	ttlResourceGroup      "TTL_RESOURCE_GROUP"
 	tp                    "TYPE"
 	unbounded             "UNBOUNDED"
 	uncommitted           "UNCOMMITTED"
@@ -742,6 +766,7 @@ import (
 	next_row_id           "NEXT_ROW_ID"
 	now                   "NOW"
 	optRuleBlacklist      "OPT_RULE_BLACKLIST"
+	optimizerVars         "OPTIMIZER_VARS"
 	placement             "PLACEMENT"
 	planCache             "PLAN_CACHE"
 	plan                  "PLAN"
@@ -963,6 +988,8 @@ import (
 	CreateIndexStmt            "CREATE INDEX statement"
 	CreateBindingStmt          "CREATE BINDING statement"
 	CreatePolicyStmt           "CREATE PLACEMENT POLICY statement"
+	CreateRowPolicyStmt        "CREATE POLICY statement"
+	CreateMaskingPolicyStmt    "CREATE MASKING POLICY statement"
 	CreateProcedureStmt        "CREATE PROCEDURE statement"
 	AddQueryWatchStmt          "ADD QUERY WATCH statement"
 	CreateResourceGroupStmt    "CREATE RESOURCE GROUP statement"
@@ -983,6 +1010,8 @@ import (
 	DropViewStmt               "DROP VIEW statement"
 	DropBindingStmt            "DROP BINDING  statement"
 	DropPolicyStmt             "DROP PLACEMENT POLICY statement"
+	DropRowPolicyStmt          "DROP POLICY statement"
+	DropMaskingPolicyStmt      "DROP MASKING POLICY statement"
 	DeallocateStmt             "Deallocate prepared statement"
 	DeleteFromStmt             "DELETE FROM statement"
 	DeleteWithoutUsingStmt     "Normal DELETE statement"
@@ -1001,6 +1030,7 @@ import (
 	InsertIntoStmt             "INSERT INTO statement"
 	CallStmt                   "CALL statement"
 	IndexAdviseStmt            "INDEX ADVISE statement"
+	ExportTableStmt            "EXPORT TABLE statement"
 	ImportIntoStmt             "IMPORT INTO statement"
 	ImportFromSelectStmt       "SELECT statement of IMPORT INTO"
 	KillStmt                   "Kill statement"
@@ -1135,14 +1165,19 @@ import (
 	DistinctOpt                            "Explicit distinct option"
 	DefaultFalseDistinctOpt                "Distinct option which defaults to false"
 	DefaultTrueDistinctOpt                 "Distinct option which defaults to true"
+	DigestHintsTTLOpt                      "The TTL of the hints set for a digest"
 	BuggyDefaultFalseDistinctOpt           "Distinct option which accepts DISTINCT ALL and defaults to false"
 	RequireClause                          "Encrypted connections options"
 	RequireClauseOpt                       "optional Encrypted connections options"
 	EqOpt                                  "= or empty"
 	EscapedTableRef                        "escaped table reference"
+	ExplainVar                             "variable overridden by EXPLAIN"
+	ExplainVarList                         "list of variables overridden by EXPLAIN"
+	ExplainVars                            "variables overridden by EXPLAIN"
 	ExpressionList                         "expression list"
 	ExtendedPriv                           "Extended privileges like LOAD FROM S3 or dynamic privileges"
 	MaxValueOrExpressionList               "maxvalue or expression list"
+	MaskingPolicyType                      "masking policy type"
 	DefaultOrExpressionList                "default or expression list"
 	ExpressionListOpt                      "expression list opt"
 	FetchFirstOpt                          "Fetch First/Next Option"
@@ -1165,6 +1200,8 @@ import (
 	GlobalScope                            "The scope of variable"
 	StatementScope                         "The scope of statement"
 	GroupByClause                          "GROUP BY clause"
+	GroupingSet                            "Grouping set"
+	GroupingSetList                        "Grouping set list"
 	HavingClause                           "HAVING clause"
 	AsOfClause                             "AS OF clause"
 	AsOfClauseOpt                          "AS OF clause optional"
@@ -1280,6 +1317,7 @@ import (
 	RoleSpec                               "Rolename and auth option"
 	RoleSpecList                           "Rolename and auth option list"
 	RowFormat                              "Row format option"
+	RowPolicyToOpt                         "optional user and role list of the row policy"
 	RowValue                               "Row value"
 	RowStmt                                "Row constructor"
 	SelectLockOpt                          "SELECT lock options"
@@ -1331,6 +1369,11 @@ import (
 	TableElementList                       "table definition element list"
 	TableElementListOpt                    "table definition element list optional"
 	TableFactor                            "table factor"
+	JSONTableColumn                        "column definition of JSON_TABLE"
+	JSONTableColumnList                    "column definition list of JSON_TABLE"
+	JSONTableColumnsClause                 "COLUMNS clause of JSON_TABLE"
+	JSONTableOnResponse                    "response of JSON_TABLE column on empty or error"
+	JSONTableOnResponseOpt                 "optional ON EMPTY and ON ERROR clauses of JSON_TABLE column"
 	TableLock                              "Table name and lock type"
 	TableLockList                          "Table lock list"
 	TableName                              "Table name"
@@ -1399,6 +1442,7 @@ import (
 	OptWild                                "Optional Wildcard"
 	OptWindowOrderByClause                 "Optional ORDER BY clause in WINDOW"
 	OptWindowFrameClause                   "Optional FRAME clause in WINDOW"
+	OptWindowFrameExclusion                "Optional EXCLUDE clause of FRAME in WINDOW"
 	OptWindowingClause                     "Optional OVER clause"
 	WindowingClause                        "OVER clause"
 	WindowClauseOptional                   "Optional WINDOW clause"
@@ -1470,6 +1514,8 @@ import (
 	DirectResourceGroupBackgroundOption    "Subset of direct resource group background option"
 	DirectResourceGroupRunawayOption       "Subset of anonymous or direct resource group runaway option"
 	ResourceGroupBackgroundOptionList      "Direct resource group background option list"
+	ResourceGroupOptimizerVar              "Resource group optimizer variable"
+	ResourceGroupOptimizerVarList          "Resource group optimizer variable list"
 	ResourceGroupRunawayActionOption       "Resource group runaway action option"
 	ResourceGroupRunawayWatchOption        "Resource group runaway watch option"
 	ResourceGroupRunawayOptionList         "Anomymous or direct resource group runaway option list"
@@ -1511,6 +1557,7 @@ import (
 	AsOpt             "AS or EmptyString"
 	KeyOrIndex        "{KEY|INDEX}"
 	ColumnKeywordOpt  "Column keyword or empty"
+	JSONTablePathOpt  "optional PATH keyword of NESTED PATH"
 	PrimaryOpt        "Optional primary keyword"
 	NowSym            "CURRENT_TIMESTAMP/LOCALTIME/LOCALTIMESTAMP"
 	NowSymFunc        "CURRENT_TIMESTAMP/LOCALTIME/LOCALTIMESTAMP/NOW"
@@ -1518,6 +1565,7 @@ import (
 	DefaultKwdOpt     "optional DEFAULT keyword"
 	DatabaseSym       "DATABASE or SCHEMA"
 	ExplainSym        "EXPLAIN or DESCRIBE or DESC"
+	ExportFormat      "format of EXPORT TABLE"
 	RegexpSym         "REGEXP or RLIKE"
 	IntoOpt           "INTO or EmptyString"
 	ValueSym          "Value or Values"
@@ -1548,6 +1596,7 @@ import (
 	FirstOrNext       "FIRST or NEXT"
 	RowOrRows         "ROW or ROWS"
 	Replica           "{REPLICA | SLAVE}"
+	ViewHintsOpt      "optional hints of the view"
 
 %type	<ident>
 	Identifier                      "identifier or unreserved keyword"
@@ -1567,6 +1616,7 @@ import (
 	CollationName                   "Collation name"
 	ColumnFormat                    "Column format"
 	DBName                          "Database Name"
+	DigestHintsAppOpt               "The application name of the hints set for a digest"
 	PolicyName                      "Placement Policy Name"
 	ResourceGroupName               "Resource Group Name"
 	ExplainFormatType               "explain format type"
@@ -1575,6 +1625,7 @@ import (
 	FieldTerminator                 "Field terminator"
 	FlashbackToNewName              "Flashback to new name"
 	HashString                      "Hashed string"
+	ImportSourceDSNOpt              "Source DSN of IMPORT INTO FROM SELECT"
 	LikeOrIlikeEscapeOpt            "like or ilike escape option"
 	OptCharset                      "Optional Character setting"
 	OptCollate                      "Optional Collate setting"
@@ -1894,6 +1945,38 @@ DirectResourceGroupOption:
 	{
 		$$ = &ast.ResourceGroupOption{Tp: ast.ResourceGroupBackground, BackgroundOptions: nil}
 	}
+|	"OPTIMIZER_VARS" EqOpt '(' ResourceGroupOptimizerVarList ')'
+	{
+		$$ = &ast.ResourceGroupOption{Tp: ast.ResourceGroupOptimizerVars, OptimizerVars: $4.([]*ast.ResourceGroupOptimizerVar)}
+	}
+|	"OPTIMIZER_VARS" EqOpt '(' ')'
+	{
+		$$ = &ast.ResourceGroupOption{Tp: ast.ResourceGroupOptimizerVars, OptimizerVars: nil}
+	}
+|	"OPTIMIZER_VARS" EqOpt "NULL"
+	{
+		$$ = &ast.ResourceGroupOption{Tp: ast.ResourceGroupOptimizerVars, OptimizerVars: nil}
+	}
+
+ResourceGroupOptimizerVarList:
+	ResourceGroupOptimizerVar
+	{
+		$$ = []*ast.ResourceGroupOptimizerVar{$1.(*ast.ResourceGroupOptimizerVar)}
+	}
+|	ResourceGroupOptimizerVarList ',' ResourceGroupOptimizerVar
+	{
+		if !ast.CheckOptimizerVarAppend($1.([]*ast.ResourceGroupOptimizerVar), $3.(*ast.ResourceGroupOptimizerVar)) {
+			yylex.AppendError(yylex.Errorf("Dupliated optimizer variables specified"))
+			return 1
+		}
+		$$ = append($1.([]*ast.ResourceGroupOptimizerVar), $3.(*ast.ResourceGroupOptimizerVar))
+	}
+
+ResourceGroupOptimizerVar:
+	Identifier eq stringLit
+	{
+		$$ = &ast.ResourceGroupOptimizerVar{Name: strings.ToLower($1), Value: $3}
+	}
 
 ResourceGroupBackgroundOptionList:
 	DirectResourceGroupBackgroundOption
@@ -2067,6 +2150,22 @@ AlterTableSpecSingleOpt:
 			Partition: &ast.PartitionOptions{PartitionMethod: partitionMethod},
 		}
 	}
+|	"SPLIT" "PARTITION" Identifier "INTO" '(' PartitionDefinitionList ')'
+	{
+		$$ = &ast.AlterTableSpec{
+			Tp:              ast.AlterTableSplitPartition,
+			PartitionNames:  []model.CIStr{model.NewCIStr($3)},
+			PartDefinitions: $6.([]*ast.PartitionDefinition),
+		}
+	}
+|	"MERGE" "PARTITIONS" PartitionNameList "INTO" "PARTITION" Identifier
+	{
+		$$ = &ast.AlterTableSpec{
+			Tp:              ast.AlterTableMergePartitions,
+			PartitionNames:  $3.([]model.CIStr),
+			PartDefinitions: []*ast.PartitionDefinition{{Name: model.NewCIStr($6), Clause: &ast.PartitionDefinitionClauseNone{}}},
+		}
+	}
 |	"MERGE" "FIRST" "PARTITION" "LESS" "THAN" '(' BitExpr ')'
 	{
 		partitionMethod := ast.PartitionMethod{Expr: $7}
@@ -4920,10 +5019,10 @@ LikeTableWithOrWithoutParen:
  *          as select Col1,Col2 from table WITH LOCAL CHECK OPTION
  *******************************************************************/
 CreateViewStmt:
-	"CREATE" OrReplace ViewAlgorithm ViewDefiner ViewSQLSecurity "VIEW" ViewName ViewFieldList "AS" CreateViewSelectOpt ViewCheckOption
+	"CREATE" OrReplace ViewAlgorithm ViewDefiner ViewSQLSecurity "VIEW" ViewName ViewFieldList ViewHintsOpt "AS" CreateViewSelectOpt ViewCheckOption
 	{
 		startOffset := parser.startOffset(&yyS[yypt-1])
-		selStmt := $10.(ast.StmtNode)
+		selStmt := $11.(ast.StmtNode)
 		selStmt.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:]))
 		x := &ast.CreateViewStmt{
 			OrReplace: $2.(bool),
@@ -4932,12 +5031,13 @@ CreateViewStmt:
 			Algorithm: $3.(model.ViewAlgorithm),
 			Definer:   $4.(*auth.UserIdentity),
 			Security:  $5.(model.ViewSecurity),
+			Hints:     $9,
 		}
 		if $8 != nil {
 			x.Cols = $8.([]model.CIStr)
 		}
-		if $11 != nil {
-			x.CheckOption = $11.(model.ViewCheckOption)
+		if $12 != nil {
+			x.CheckOption = $12.(model.ViewCheckOption)
 			endOffset := parser.startOffset(&yyS[yypt])
 			selStmt.SetText(parser.lexer.client, strings.TrimSpace(parser.src[startOffset:endOffset]))
 		} else {
@@ -5011,6 +5111,16 @@ ViewFieldList:
 		$$ = $2.([]model.CIStr)
 	}
 
+ViewHintsOpt:
+	/* Empty */
+	{
+		$$ = ""
+	}
+|	"WITH" "HINTS" stringLit
+	{
+		$$ = $3
+	}
+
 ColumnList:
 	Identifier
 	{
@@ -5354,6 +5464,14 @@ ExplainStmt:
 			Format: "row",
 		}
 	}
+|	ExplainSym ExplainVars ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:   $3,
+			Format: "row",
+			Vars:   $2.([]*ast.VariableAssignment),
+		}
+	}
 |	ExplainSym "FOR" "CONNECTION" NUM
 	{
 		$$ = &ast.ExplainForStmt{
@@ -5375,6 +5493,14 @@ ExplainStmt:
 			Format: $4,
 		}
 	}
+|	ExplainSym "FORMAT" "=" stringLit ExplainVars ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:   $6,
+			Format: $4,
+			Vars:   $5.([]*ast.VariableAssignment),
+		}
+	}
 |	ExplainSym "FORMAT" "=" ExplainFormatType "FOR" "CONNECTION" NUM
 	{
 		$$ = &ast.ExplainForStmt{
@@ -5389,6 +5515,14 @@ ExplainStmt:
 			Format: $4,
 		}
 	}
+|	ExplainSym "FORMAT" "=" ExplainFormatType ExplainVars ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:   $6,
+			Format: $4,
+			Vars:   $5.([]*ast.VariableAssignment),
+		}
+	}
 |	ExplainSym "ANALYZE" ExplainableStmt
 	{
 		$$ = &ast.ExplainStmt{
@@ -5397,6 +5531,15 @@ ExplainStmt:
 			Analyze: true,
 		}
 	}
+|	ExplainSym "ANALYZE" ExplainVars ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:    $4,
+			Format:  "row",
+			Analyze: true,
+			Vars:    $3.([]*ast.VariableAssignment),
+		}
+	}
 |	ExplainSym "ANALYZE" "FORMAT" "=" ExplainFormatType ExplainableStmt
 	{
 		$$ = &ast.ExplainStmt{
@@ -5405,6 +5548,15 @@ ExplainStmt:
 			Analyze: true,
 		}
 	}
+|	ExplainSym "ANALYZE" "FORMAT" "=" ExplainFormatType ExplainVars ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:    $7,
+			Format:  $5,
+			Analyze: true,
+			Vars:    $6.([]*ast.VariableAssignment),
+		}
+	}
 |	ExplainSym "ANALYZE" "FORMAT" "=" stringLit ExplainableStmt
 	{
 		$$ = &ast.ExplainStmt{
@@ -5413,6 +5565,37 @@ ExplainStmt:
 			Analyze: true,
 		}
 	}
+|	ExplainSym "ANALYZE" "FORMAT" "=" stringLit ExplainVars ExplainableStmt
+	{
+		$$ = &ast.ExplainStmt{
+			Stmt:    $7,
+			Format:  $5,
+			Analyze: true,
+			Vars:    $6.([]*ast.VariableAssignment),
+		}
+	}
+
+ExplainVars:
+	"WITH" '(' ExplainVarList ')'
+	{
+		$$ = $3
+	}
+
+ExplainVarList:
+	ExplainVar
+	{
+		$$ = []*ast.VariableAssignment{$1.(*ast.VariableAssignment)}
+	}
+|	ExplainVarList ',' ExplainVar
+	{
+		$$ = append($1.([]*ast.VariableAssignment), $3.(*ast.VariableAssignment))
+	}
+
+ExplainVar:
+	VariableName EqOrAssignmentEq SetExpr
+	{
+		$$ = &ast.VariableAssignment{Name: strings.ToLower($1), Value: $3, IsSystem: true}
+	}
 
 ExplainFormatType:
 	"TRADITIONAL"
@@ -6327,6 +6510,47 @@ GroupByClause:
 	{
 		$$ = &ast.GroupByClause{Items: $3.([]*ast.ByItem), Rollup: $4.(bool)}
 	}
+|	"GROUP" "BY" "ROLLUP" '(' ByList ')'
+	{
+		$$ = &ast.GroupByClause{Items: $5.([]*ast.ByItem), Rollup: true}
+	}
+|	"GROUP" "BY" "CUBE" '(' ByList ')'
+	{
+		$$ = &ast.GroupByClause{Items: $5.([]*ast.ByItem), Cube: true}
+	}
+|	"GROUP" "BY" "GROUPING" "SETS" '(' GroupingSetList ')'
+	{
+		groupBy := &ast.GroupByClause{}
+		for _, set := range $6.([][]*ast.ByItem) {
+			offsets := make([]int, 0, len(set))
+			for _, item := range set {
+				offsets = append(offsets, len(groupBy.Items))
+				groupBy.Items = append(groupBy.Items, item)
+			}
+			groupBy.GroupingSets = append(groupBy.GroupingSets, offsets)
+		}
+		$$ = groupBy
+	}
+
+GroupingSetList:
+	GroupingSet
+	{
+		$$ = [][]*ast.ByItem{$1.([]*ast.ByItem)}
+	}
+|	GroupingSetList ',' GroupingSet
+	{
+		$$ = append($1.([][]*ast.ByItem), $3.([]*ast.ByItem))
+	}
+
+GroupingSet:
+	'(' ')'
+	{
+		$$ = []*ast.ByItem{}
+	}
+|	'(' ByList ')'
+	{
+		$$ = $2
+	}
 
 HavingClause:
 	{
@@ -6550,6 +6774,7 @@ Identifier:
 UnReservedKeyword:
 	"ACTION"
 |	"ADVISE"
+|	"APPLICATION"
 |	"ASCII"
 |	"ATTRIBUTE"
 |	"ATTRIBUTES"
@@ -6633,6 +6858,7 @@ UnReservedKeyword:
 |	"PROXY"
 |	"QUICK"
 |	"REBUILD"
+|	"RECOMMEND"
 |	"REDUNDANT"
 |	"REORGANIZE"
 |	"RESOURCE"
@@ -6640,6 +6866,9 @@ UnReservedKeyword:
 |	"ROLE"
 |	"ROLLBACK"
 |	"ROLLUP"
+|	"CUBE"
+|	"GROUPING"
+|	"SETS"
 |	"SESSION"
 |	"SIGNED"
 |	"SHARD_ROW_ID_BITS"
@@ -6681,6 +6910,7 @@ UnReservedKeyword:
 |	"CHECKSUM"
 |	"COMPRESSION"
 |	"KEY_BLOCK_SIZE"
+|	"MASKING"
 |	"MASTER"
 |	"MAX_ROWS"
 |	"MIN_ROWS"
@@ -6771,6 +7001,7 @@ UnReservedKeyword:
 |	"X509"
 |	"NEVER"
 |	"EXPIRE"
+|	"EXPORT"
 |	"ACCOUNT"
 |	"INCREMENTAL"
 |	"CPU"
@@ -6789,6 +7020,7 @@ UnReservedKeyword:
 |	"SQL_BUFFER_RESULT"
 |	"DIRECTORY"
 |	"HISTOGRAM"
+|	"HINTS"
 |	"HISTORY"
 |	"LIST"
 |	"NODEGROUP"
@@ -6809,6 +7041,14 @@ UnReservedKeyword:
 |	"RTREE"
 |	"HYPO"
 |	"EXCHANGE"
+|	"EXCLUDE"
+|	"OTHERS"
+|	"TIES"
+|	"EMPTY"
+|	"JSON_TABLE"
+|	"NESTED"
+|	"ORDINALITY"
+|	"PATH"
 |	"COLUMN_FORMAT"
 |	"REPAIR"
 |	"IMPORT"
@@ -6901,11 +7141,19 @@ UnReservedKeyword:
 |	"PRESERVE"
 |	"TOKEN_ISSUER"
 |	"TTL"
+|	"TTL_CONDITION"
+|	"TTL_DELETE_RATE_LIMIT"
 |	"TTL_ENABLE"
 |	"TTL_JOB_INTERVAL"
+|	"TTL_JOB_WINDOW_END"
+|	"TTL_JOB_WINDOW_START"
+|	"TTL_RESOURCE_GROUP"
+|	"EXTERNAL_LOCATION"
+|	"EXTERNAL_FORMAT"
 |	"FAILED_LOGIN_ATTEMPTS"
 |	"PASSWORD_LOCK_TIME"
 |	"DIGEST"
+|	"DIAGNOSTICS"
 |	"REUSE" %prec lowerThanEq
 |	"DECLARE"
 |	"HANDLER"
@@ -7030,6 +7278,7 @@ NotKeywordToken:
 |	"NEXT_ROW_ID"
 |	"EXPR_PUSHDOWN_BLACKLIST"
 |	"OPT_RULE_BLACKLIST"
+|	"OPTIMIZER_VARS"
 |	"BOUND"
 |	"EXACT" %prec lowerThanStringLitToken
 |	"STALENESS"
@@ -7864,6 +8113,7 @@ FunctionNameConflict:
 |	"INTERVAL"
 |	"LOG"
 |	"FORMAT"
+|	"GROUPING"
 |	"LEFT"
 |	"MICROSECOND"
 |	"MINUTE"
@@ -9387,14 +9637,36 @@ OptWindowFrameClause:
 	{
 		$$ = nil
 	}
-|	WindowFrameUnits WindowFrameExtent
+|	WindowFrameUnits WindowFrameExtent OptWindowFrameExclusion
 	{
 		$$ = &ast.FrameClause{
-			Type:   $1.(ast.FrameType),
-			Extent: $2.(ast.FrameExtent),
+			Type:      $1.(ast.FrameType),
+			Extent:    $2.(ast.FrameExtent),
+			Exclusion: $3.(ast.FrameExclusion),
 		}
 	}
 
+OptWindowFrameExclusion:
+	{
+		$$ = ast.ExcludeNoOthers
+	}
+|	"EXCLUDE" "CURRENT" "ROW"
+	{
+		$$ = ast.ExcludeCurrentRow
+	}
+|	"EXCLUDE" "GROUP"
+	{
+		$$ = ast.ExcludeGroup
+	}
+|	"EXCLUDE" "TIES"
+	{
+		$$ = ast.ExcludeTies
+	}
+|	"EXCLUDE" "NO" "OTHERS"
+	{
+		$$ = ast.ExcludeNoOthers
+	}
+
 WindowFrameUnits:
 	"ROWS"
 	{
@@ -9661,6 +9933,102 @@ TableFactor:
 		j.ExplicitParens = true
 		$$ = $2
 	}
+|	"JSON_TABLE" '(' Expression ',' stringLit JSONTableColumnsClause ')' TableAsName
+	{
+		jt := &ast.JSONTable{
+			Expr:    $3,
+			Path:    $5,
+			Columns: $6.([]*ast.JSONTableColumn),
+		}
+		$$ = &ast.TableSource{Source: jt, AsName: $8.(model.CIStr)}
+	}
+
+JSONTableColumnsClause:
+	"COLUMNS" '(' JSONTableColumnList ')'
+	{
+		$$ = $3
+	}
+
+JSONTableColumnList:
+	JSONTableColumn
+	{
+		$$ = []*ast.JSONTableColumn{$1.(*ast.JSONTableColumn)}
+	}
+|	JSONTableColumnList ',' JSONTableColumn
+	{
+		$$ = append($1.([]*ast.JSONTableColumn), $3.(*ast.JSONTableColumn))
+	}
+
+JSONTableColumn:
+	Identifier "FOR" "ORDINALITY"
+	{
+		$$ = &ast.JSONTableColumn{Tp: ast.JSONTableColumnOrdinality, Name: model.NewCIStr($1)}
+	}
+|	Identifier Type "PATH" stringLit JSONTableOnResponseOpt
+	{
+		responses := $5.([]*ast.JSONTableOnResponse)
+		$$ = &ast.JSONTableColumn{
+			Tp:        ast.JSONTableColumnPath,
+			Name:      model.NewCIStr($1),
+			FieldType: $2.(*types.FieldType),
+			Path:      $4,
+			OnEmpty:   responses[0],
+			OnError:   responses[1],
+		}
+	}
+|	Identifier Type "EXISTS" "PATH" stringLit
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:        ast.JSONTableColumnExists,
+			Name:      model.NewCIStr($1),
+			FieldType: $2.(*types.FieldType),
+			Path:      $5,
+		}
+	}
+|	"NESTED" JSONTablePathOpt stringLit JSONTableColumnsClause
+	{
+		$$ = &ast.JSONTableColumn{
+			Tp:            ast.JSONTableColumnNested,
+			Path:          $3,
+			NestedColumns: $4.([]*ast.JSONTableColumn),
+		}
+	}
+
+JSONTablePathOpt:
+	/* empty */
+	{}
+|	"PATH"
+
+JSONTableOnResponseOpt:
+	{
+		$$ = []*ast.JSONTableOnResponse{nil, nil}
+	}
+|	JSONTableOnResponse "ON" "EMPTY"
+	{
+		$$ = []*ast.JSONTableOnResponse{$1.(*ast.JSONTableOnResponse), nil}
+	}
+|	JSONTableOnResponse "ON" "ERROR"
+	{
+		$$ = []*ast.JSONTableOnResponse{nil, $1.(*ast.JSONTableOnResponse)}
+	}
+|	JSONTableOnResponse "ON" "EMPTY" JSONTableOnResponse "ON" "ERROR"
+	{
+		$$ = []*ast.JSONTableOnResponse{$1.(*ast.JSONTableOnResponse), $4.(*ast.JSONTableOnResponse)}
+	}
+
+JSONTableOnResponse:
+	"NULL"
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableResponseNull}
+	}
+|	"ERROR"
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableResponseError}
+	}
+|	"DEFAULT" stringLit
+	{
+		$$ = &ast.JSONTableOnResponse{Tp: ast.JSONTableResponseDefault, Default: $2}
+	}
 
 PartitionNameListOpt:
 	/* empty */
@@ -10057,17 +10425,18 @@ SelectStmtIntoOption:
 	{
 		$$ = nil
 	}
-|	"INTO" "OUTFILE" stringLit Fields Lines
+|	"INTO" "OUTFILE" stringLit FormatOpt Fields Lines
 	{
 		x := &ast.SelectIntoOption{
 			Tp:       ast.SelectIntoOutfile,
 			FileName: $3,
-		}
-		if $4 != nil {
-			x.FieldsInfo = $4.(*ast.FieldsClause)
+			Format:   $4.(*string),
 		}
 		if $5 != nil {
-			x.LinesInfo = $5.(*ast.LinesClause)
+			x.FieldsInfo = $5.(*ast.FieldsClause)
+		}
+		if $6 != nil {
+			x.LinesInfo = $6.(*ast.LinesClause)
 		}
 
 		$$ = x
@@ -11164,12 +11533,89 @@ AdminStmt:
 			Tp: ast.AdminShowBDRRole,
 		}
 	}
+|	"ADMIN" "SHOW" "DIAGNOSTICS" "FOR" stringLit
+	{
+		$$ = &ast.AdminStmt{
+			Tp:             ast.AdminShowDiagnostics,
+			DiagnosticsSQL: $5,
+		}
+	}
+|	"ADMIN" "SHOW" "DIAGNOSTICS" "FOR" "DIGEST" stringLit
+	{
+		$$ = &ast.AdminStmt{
+			Tp:                ast.AdminShowDiagnostics,
+			DiagnosticsDigest: $6,
+		}
+	}
 |	"ADMIN" "UNSET" "BDR" "ROLE"
 	{
 		$$ = &ast.AdminStmt{
 			Tp: ast.AdminUnsetBDRRole,
 		}
 	}
+|	"ADMIN" "SET" "HINTS" "FOR" "DIGEST" stringLit stringLit DigestHintsAppOpt DigestHintsTTLOpt
+	{
+		stmt := &ast.AdminStmt{
+			Tp:           ast.AdminSetHintsForDigest,
+			HintsDigest:  $6,
+			Hints:        $7,
+			HintsAppName: $8,
+		}
+		if $9 != nil {
+			stmt.HintsTTL = $9.(*ast.DigestHintsTTL)
+		}
+		$$ = stmt
+	}
+|	"ADMIN" "UNSET" "HINTS" "FOR" "DIGEST" stringLit DigestHintsAppOpt
+	{
+		$$ = &ast.AdminStmt{
+			Tp:           ast.AdminUnsetHintsForDigest,
+			HintsDigest:  $6,
+			HintsAppName: $7,
+		}
+	}
+|	"ADMIN" "RECOMMEND" "HINTS" "FROM" "PLAN" "DIGEST" stringLit "TO" stringLit
+	{
+		$$ = &ast.AdminStmt{
+			Tp:             ast.AdminRecommendHints,
+			BadPlanDigest:  $7,
+			GoodPlanDigest: $9,
+		}
+	}
+|	"ADMIN" "RECOMMEND" "DROP" "INDEX"
+	{
+		$$ = &ast.AdminStmt{
+			Tp: ast.AdminRecommendDropIndex,
+		}
+	}
+|	"ADMIN" "RECOMMEND" "DROP" "INDEX" "FOR" "TABLE" TableNameList
+	{
+		$$ = &ast.AdminStmt{
+			Tp:     ast.AdminRecommendDropIndex,
+			Tables: $7.([]*ast.TableName),
+		}
+	}
+
+DigestHintsAppOpt:
+	{
+		$$ = ""
+	}
+|	"FOR" "APPLICATION" stringLit
+	{
+		$$ = $3
+	}
+
+DigestHintsTTLOpt:
+	{
+		$$ = nil
+	}
+|	"TTL" EqOpt "INTERVAL" LengthNum TimeUnit
+	{
+		$$ = &ast.DigestHintsTTL{
+			Value: $4.(uint64),
+			Unit:  $5.(ast.TimeUnitType),
+		}
+	}
 
 AdminShowSlow:
 	"RECENT" NUM
@@ -12012,6 +12458,8 @@ Statement:
 |	CreateRoleStmt
 |	CreateBindingStmt
 |	CreatePolicyStmt
+|	CreateRowPolicyStmt
+|	CreateMaskingPolicyStmt
 |	CreateProcedureStmt
 |	CreateResourceGroupStmt
 |	AddQueryWatchStmt
@@ -12023,6 +12471,8 @@ Statement:
 |	DropTableStmt
 |	DropProcedureStmt
 |	DropPolicyStmt
+|	DropRowPolicyStmt
+|	DropMaskingPolicyStmt
 |	DropSequenceStmt
 |	DropViewStmt
 |	DropUserStmt
@@ -12040,6 +12490,7 @@ Statement:
 |	GrantProxyStmt
 |	GrantRoleStmt
 |	CallStmt
+|	ExportTableStmt
 |	ImportIntoStmt
 |	InsertIntoStmt
 |	IndexAdviseStmt
@@ -12442,6 +12893,46 @@ TableOption:
 		}
 		$$ = &ast.TableOption{Tp: ast.TableOptionTTLJobInterval, StrValue: $3}
 	}
+|	"TTL_CONDITION" EqOpt stringLit
+	{
+		$$ = &ast.TableOption{Tp: ast.TableOptionTTLCondition, StrValue: $3}
+	}
+|	"TTL_JOB_WINDOW_START" EqOpt stringLit
+	{
+		if len($3) > 0 {
+			if _, err := time.Parse(model.TTLJobWindowTimeFormat, $3); err != nil {
+				yylex.AppendError(yylex.Errorf("The TTL_JOB_WINDOW_START option is not a valid time of day like '01:00 +0000': %s", err.Error()))
+				return 1
+			}
+		}
+		$$ = &ast.TableOption{Tp: ast.TableOptionTTLJobWindowStart, StrValue: $3}
+	}
+|	"TTL_JOB_WINDOW_END" EqOpt stringLit
+	{
+		if len($3) > 0 {
+			if _, err := time.Parse(model.TTLJobWindowTimeFormat, $3); err != nil {
+				yylex.AppendError(yylex.Errorf("The TTL_JOB_WINDOW_END option is not a valid time of day like '01:00 +0000': %s", err.Error()))
+				return 1
+			}
+		}
+		$$ = &ast.TableOption{Tp: ast.TableOptionTTLJobWindowEnd, StrValue: $3}
+	}
+|	"TTL_DELETE_RATE_LIMIT" EqOpt LengthNum
+	{
+		$$ = &ast.TableOption{Tp: ast.TableOptionTTLDeleteRateLimit, UintValue: $3.(uint64)}
+	}
+|	"TTL_RESOURCE_GROUP" EqOpt ResourceGroupName
+	{
+		$$ = &ast.TableOption{Tp: ast.TableOptionTTLResourceGroup, StrValue: $3}
+	}
+|	"EXTERNAL_LOCATION" EqOpt stringLit
+	{
+		$$ = &ast.TableOption{Tp: ast.TableOptionExternalLocation, StrValue: $3}
+	}
+|	"EXTERNAL_FORMAT" EqOpt stringLit
+	{
+		$$ = &ast.TableOption{Tp: ast.TableOptionExternalFormat, StrValue: $3}
+	}
 
 ForceOpt:
 	/* empty */
@@ -14637,14 +15128,15 @@ ImportIntoStmt:
 			Options:            $9.([]*ast.LoadDataOpt),
 		}
 	}
-|	"IMPORT" "INTO" TableName ColumnNameOrUserVarListOptWithBrackets LoadDataSetSpecOpt "FROM" ImportFromSelectStmt LoadDataOptionListOpt
+|	"IMPORT" "INTO" TableName ColumnNameOrUserVarListOptWithBrackets LoadDataSetSpecOpt "FROM" ImportFromSelectStmt ImportSourceDSNOpt LoadDataOptionListOpt
 	/* LoadDataSetSpecOpt is used to avoid shift/reduce conflict, we don't support it actually */
 	{
 		st := &ast.ImportIntoStmt{
 			Table:              $3.(*ast.TableName),
 			ColumnsAndUserVars: $4.([]*ast.ColumnNameOrUserVar),
 			Select:             $7.(ast.ResultSetNode),
-			Options:            $8.([]*ast.LoadDataOpt),
+			SourceDSN:          $8,
+			Options:            $9.([]*ast.LoadDataOpt),
 		}
 		for _, cu := range st.ColumnsAndUserVars {
 			if cu.ColumnName == nil {
@@ -14659,6 +15151,36 @@ ImportIntoStmt:
 		$$ = st
 	}
 
+ExportTableStmt:
+	"EXPORT" "TABLE" TableName "TO" stringLit "FORMAT" ExportFormat LoadDataOptionListOpt
+	{
+		$$ = &ast.ExportTableStmt{
+			Table:   $3.(*ast.TableName),
+			Path:    $5,
+			Format:  $7,
+			Options: $8.([]*ast.LoadDataOpt),
+		}
+	}
+
+ExportFormat:
+	stringLit
+	{
+		$$ = strings.ToLower($1)
+	}
+|	Identifier
+	{
+		$$ = strings.ToLower($1)
+	}
+
+ImportSourceDSNOpt:
+	{
+		$$ = ""
+	}
+|	singleAtIdentifier
+	{
+		$$ = strings.TrimPrefix($1, "@")
+	}
+
 ImportFromSelectStmt:
 	SelectStmt
 	{
@@ -14916,6 +15438,91 @@ DropPolicyStmt:
 		}
 	}
 
+/*******************************************************************
+ *
+ *  Create Row Policy Statement
+ *
+ *  Example:
+ *	CREATE POLICY tenant_isolation ON orders TO app_user USING (tenant_id = 1)
+ *******************************************************************/
+CreateRowPolicyStmt:
+	"CREATE" "POLICY" IfNotExists PolicyName "ON" TableName RowPolicyToOpt "USING" '(' Expression ')'
+	{
+		$$ = &ast.CreateRowPolicyStmt{
+			IfNotExists: $3.(bool),
+			PolicyName:  model.NewCIStr($4),
+			Table:       $6.(*ast.TableName),
+			Roles:       $7.([]*auth.RoleIdentity),
+			Expr:        $10,
+		}
+	}
+
+RowPolicyToOpt:
+	{
+		$$ = []*auth.RoleIdentity(nil)
+	}
+|	"TO" RolenameList
+	{
+		$$ = $2
+	}
+
+DropRowPolicyStmt:
+	"DROP" "POLICY" IfExists PolicyName "ON" TableName
+	{
+		$$ = &ast.DropRowPolicyStmt{
+			IfExists:   $3.(bool),
+			PolicyName: model.NewCIStr($4),
+			Table:      $6.(*ast.TableName),
+		}
+	}
+
+/*******************************************************************
+ *
+ *  Create Masking Policy Statement
+ *
+ *  Example:
+ *	CREATE MASKING POLICY mask_phone ON users (phone) TO app_user USING PARTIAL(3, 4)
+ *******************************************************************/
+CreateMaskingPolicyStmt:
+	"CREATE" "MASKING" "POLICY" IfNotExists PolicyName "ON" TableName '(' Identifier ')' RowPolicyToOpt "USING" MaskingPolicyType
+	{
+		stmt := $13.(*ast.CreateMaskingPolicyStmt)
+		stmt.IfNotExists = $4.(bool)
+		stmt.PolicyName = model.NewCIStr($5)
+		stmt.Table = $7.(*ast.TableName)
+		stmt.Column = model.NewCIStr($9)
+		stmt.Roles = $11.([]*auth.RoleIdentity)
+		$$ = stmt
+	}
+
+MaskingPolicyType:
+	"FULL"
+	{
+		$$ = &ast.CreateMaskingPolicyStmt{MaskingType: model.MaskingTypeFull}
+	}
+|	"PARTIAL" '(' LengthNum ',' LengthNum ')'
+	{
+		$$ = &ast.CreateMaskingPolicyStmt{
+			MaskingType:   model.MaskingTypePartial,
+			PartialPrefix: $3.(uint64),
+			PartialSuffix: $5.(uint64),
+		}
+	}
+|	"HASH"
+	{
+		$$ = &ast.CreateMaskingPolicyStmt{MaskingType: model.MaskingTypeHash}
+	}
+
+DropMaskingPolicyStmt:
+	"DROP" "MASKING" "POLICY" IfExists PolicyName "ON" TableName
+	{
+		$$ = &ast.DropMaskingPolicyStmt{
+			IfExists:   $4.(bool),
+			PolicyName: model.NewCIStr($5),
+			Table:      $7.(*ast.TableName),
+		}
+	}
+
 CreateResourceGroupStmt:
 	"CREATE" "RESOURCE" "GROUP" IfNotExists ResourceGroupName ResourceGroupOptionList
 	{
@@ -15412,6 +16019,22 @@ PlanReplayerStmt:
 			Limit:      nil,
 		}
 
+		$$ = x
+	}
+|	"PLAN" "REPLAYER" "CAPTURE" stringLit stringLit "LIMIT" LengthNum
+	{
+		x := &ast.PlanReplayerStmt{
+			Stmt:         nil,
+			Analyze:      false,
+			Capture:      true,
+			SQLDigest:    $4,
+			PlanDigest:   $5,
+			CaptureLimit: $7.(uint64),
+			Where:        nil,
+			OrderBy:      nil,
+			Limit:        nil,
+		}
+
 		$$ = x
 	}
 |	"PLAN" "REPLAYER" "CAPTURE" "REMOVE" stringLit stringLit
@@ -516,7 +516,27 @@ func TestAdminStmt(t *testing.T) {
 		{"admin set bdr role primary", true, "ADMIN SET BDR ROLE PRIMARY"},
 		{"admin set bdr role secondary", true, "ADMIN SET BDR ROLE SECONDARY"},
 		{"admin unset bdr role", true, "ADMIN UNSET BDR ROLE"},
+		{"admin set hints for digest 'abc' '/*+ use_index(t, ia) */'", true, "ADMIN SET HINTS FOR DIGEST 'abc' '/*+ use_index(t, ia) */'"},
+		{"admin set hints for digest 'abc' 'use_index(t, ia)' ttl interval 1 hour", true, "ADMIN SET HINTS FOR DIGEST 'abc' 'use_index(t, ia)' TTL = INTERVAL 1 HOUR"},
+		{"admin set hints for digest 'abc' 'use_index(t, ia)' ttl = interval 30 minute", true, "ADMIN SET HINTS FOR DIGEST 'abc' 'use_index(t, ia)' TTL = INTERVAL 30 MINUTE"},
+		{"admin set hints for digest 'abc'", false, ""},
+		{"admin set hints for digest 'abc' 'use_index(t, ia)' ttl 1 hour", false, ""},
+		{"admin unset hints for digest 'abc'", true, "ADMIN UNSET HINTS FOR DIGEST 'abc'"},
+		{"admin set hints for digest 'abc' 'use_index(t, ia)' for application 'app1'", true, "ADMIN SET HINTS FOR DIGEST 'abc' 'use_index(t, ia)' FOR APPLICATION 'app1'"},
+		{"admin set hints for digest 'abc' 'use_index(t, ia)' for application 'app1' ttl interval 1 hour", true, "ADMIN SET HINTS FOR DIGEST 'abc' 'use_index(t, ia)' FOR APPLICATION 'app1' TTL = INTERVAL 1 HOUR"},
+		{"admin set hints for digest 'abc' 'use_index(t, ia)' ttl interval 1 hour for application 'app1'", false, ""},
+		{"admin unset hints for digest 'abc' for application 'app1'", true, "ADMIN UNSET HINTS FOR DIGEST 'abc' FOR APPLICATION 'app1'"},
+		{"admin unset hints for digest 'abc' for application", false, ""},
+		{"admin recommend hints from plan digest 'abc' to 'def'", true, "ADMIN RECOMMEND HINTS FROM PLAN DIGEST 'abc' TO 'def'"},
+		{"admin recommend hints from plan digest 'abc'", false, ""},
+		{"admin recommend hints from digest 'abc' to 'def'", false, ""},
+		{"admin recommend drop index", true, "ADMIN RECOMMEND DROP INDEX"},
+		{"admin recommend drop index for table t1, test.t2", true, "ADMIN RECOMMEND DROP INDEX FOR TABLE `t1`, `test`.`t2`"},
+		{"admin recommend drop index for t1", false, ""},
 		{"admin show bdr role", true, "ADMIN SHOW BDR ROLE"},
+		{"admin show diagnostics for 'select * from t where a = 1'", true, "ADMIN SHOW DIAGNOSTICS FOR 'select * from t where a = 1'"},
+		{"admin show diagnostics for digest 'abc'", true, "ADMIN SHOW DIAGNOSTICS FOR DIGEST 'abc'"},
+		{"admin show diagnostics", false, ""},
 	}
 	RunTest(t, table, false)
 }
@@ -754,6 +774,18 @@ func TestDMLStmt(t *testing.T) {
 		{"import into t from '/file.csv' with detached", true, "IMPORT INTO `t` FROM '/file.csv' WITH detached"},
 		{"import into `t` from '/file.csv' with thread=1", true, "IMPORT INTO `t` FROM '/file.csv' WITH thread=1"},
 		{"import into `t` from '/file.csv' with detached, thread=1", true, "IMPORT INTO `t` FROM '/file.csv' WITH detached, thread=1"},
+		{"import into t from select * from t1 @'root@tcp(127.0.0.1:3306)/test'", true, "IMPORT INTO `t` FROM SELECT * FROM `t1` @'root@tcp(127.0.0.1:3306)/test'"},
+		{"import into `t` from select * from `t1` @'root@tcp(127.0.0.1:3306)/test' with thread=1", true, "IMPORT INTO `t` FROM SELECT * FROM `t1` @'root@tcp(127.0.0.1:3306)/test' WITH thread=1"},
+		{"import into t from select * from t1 where a = 1 for update @'root@tcp(127.0.0.1:3306)/test'", true, "IMPORT INTO `t` FROM SELECT * FROM `t1` WHERE `a`=1 FOR UPDATE @'root@tcp(127.0.0.1:3306)/test'"},
+		{"import into t from '/file.csv' @'root@tcp(127.0.0.1:3306)/test'", false, ""},
+
+		// EXPORT TABLE
+		{"export table t to 's3://bucket/prefix' format csv", true, "EXPORT TABLE `t` TO 's3://bucket/prefix' FORMAT 'csv'"},
+		{"export table db.t to 's3://bucket/prefix' format 'PARQUET'", true, "EXPORT TABLE `db`.`t` TO 's3://bucket/prefix' FORMAT 'parquet'"},
+		{"export table `t` to '/tmp/export' format 'csv' with thread=4, header", true, "EXPORT TABLE `t` TO '/tmp/export' FORMAT 'csv' WITH thread=4, header"},
+		{"export table t to '/tmp/export'", false, ""},
+		{"export table t partition (p0) to '/tmp/export' format csv", false, ""},
+		{"create table export (export int)", true, "CREATE TABLE `export` (`export` INT)"},
 
 		// select for update/share
 		{"select * from t for update", true, "SELECT * FROM `t` FOR UPDATE"},
@@ -791,6 +823,9 @@ func TestDMLStmt(t *testing.T) {
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ',' optionally enclosed BY '\"' lines starting by 'xy' terminated BY '\r'", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' OPTIONALLY ENCLOSED BY '\"' LINES STARTING BY 'xy' TERMINATED BY '\r'"},
 		{"select a,b,a+b from t into outfile '/tmp/result.txt' fields terminated BY ',' enclosed BY '\"' lines starting by 'xy' terminated BY '\r'", true, "SELECT `a`,`b`,`a`+`b` FROM `t` INTO OUTFILE '/tmp/result.txt' FIELDS TERMINATED BY ',' ENCLOSED BY '\"' LINES STARTING BY 'xy' TERMINATED BY '\r'"},
 
+		{"select a, b from t into outfile 's3://bucket/result.parquet' format 'parquet'", true, "SELECT `a`,`b` FROM `t` INTO OUTFILE 's3://bucket/result.parquet' FORMAT 'parquet'"},
+		{"select a, b from t into outfile 's3://bucket/result.csv.gz' format 'csv' fields terminated by ','", true, "SELECT `a`,`b` FROM `t` INTO OUTFILE 's3://bucket/result.csv.gz' FORMAT 'csv' FIELDS TERMINATED BY ','"},
+		{"select a, b from t into outfile '/tmp/result.txt' fields terminated by ',' format 'csv'", false, ""},
 		// from join
 		{"SELECT * from t1, t2, t3", true, "SELECT * FROM ((`t1`) JOIN `t2`) JOIN `t3`"},
 		{"select * from t1 join t2 left join t3 on t2.id = t3.id", true, "SELECT * FROM (`t1` JOIN `t2`) LEFT JOIN `t3` ON `t2`.`id`=`t3`.`id`"},
@@ -1134,6 +1169,10 @@ AAAAAAAAAAAA5gm5Mg==
 		{"query watch add SQL DIGEST 'b13858789fce00208f9a262c99621b7045f4869807cd4e6568008ae7ca19a377' ", true, "QUERY WATCH ADD SQL DIGEST _UTF8MB4'b13858789fce00208f9a262c99621b7045f4869807cd4e6568008ae7ca19a377'"},
 		{"query watch add PLAN DIGEST `5e3ddd388f6012e328233dbcdda5d48f404e0536c6c54d9618233210f3d5762a` ", true, "QUERY WATCH ADD PLAN DIGEST `5e3ddd388f6012e328233dbcdda5d48f404e0536c6c54d9618233210f3d5762a`"},
 		{"query watch add PLAN DIGEST @digest1 ", true, "QUERY WATCH ADD PLAN DIGEST @`digest1`"},
+		{"query watch add SQL DIGEST @digest1 PLAN DIGEST @digest2", true, "QUERY WATCH ADD SQL DIGEST @`digest1` PLAN DIGEST @`digest2`"},
+		{"query watch add action KILL PLAN DIGEST @digest2, SQL DIGEST @digest1", true, "QUERY WATCH ADD ACTION = KILL PLAN DIGEST @`digest2` SQL DIGEST @`digest1`"},
+		{"query watch add SQL DIGEST @digest1 SQL DIGEST @digest2", false, ""},
+		{"query watch add PLAN DIGEST @digest1 SQL TEXT SIMILAR to 'select 1'", false, ""},
 		{"query watch add SQL TEXT SIMILAR to 'select 1'", true, "QUERY WATCH ADD SQL TEXT SIMILAR TO _UTF8MB4'select 1'"},
 		{"query watch add SQL TEXT EXACT to 'select 1'", true, "QUERY WATCH ADD SQL TEXT EXACT TO _UTF8MB4'select 1'"},
 		{"query watch add SQL TEXT PLAN to 'select 1'", true, "QUERY WATCH ADD SQL TEXT PLAN TO _UTF8MB4'select 1'"},
@@ -3248,6 +3287,14 @@ func TestDDL(t *testing.T) {
 		{"alter table t reorganize partition remove partition;", false, ""},
 		{"alter table t reorganize partition no_write_to_binlog remove into (partition p0 VALUES LESS THAN (1991));", true, "ALTER TABLE `t` REORGANIZE PARTITION NO_WRITE_TO_BINLOG `remove` INTO (PARTITION `p0` VALUES LESS THAN (1991))"},
 
+		// For split / merge partition statement
+		{"ALTER TABLE members SPLIT PARTITION n0 INTO (PARTITION s0 VALUES LESS THAN (1960), PARTITION s1 VALUES LESS THAN (1970));", true, "ALTER TABLE `members` SPLIT PARTITION `n0` INTO (PARTITION `s0` VALUES LESS THAN (1960), PARTITION `s1` VALUES LESS THAN (1970))"},
+		{"ALTER TABLE members SPLIT PARTITION n0, n1 INTO (PARTITION s0 VALUES LESS THAN (1960));", false, ""},
+		{"ALTER TABLE members SPLIT PARTITION n0;", false, ""},
+		{"ALTER TABLE members MERGE PARTITIONS p1,p2,p3 INTO PARTITION s0;", true, "ALTER TABLE `members` MERGE PARTITIONS `p1`,`p2`,`p3` INTO PARTITION `s0`"},
+		{"ALTER TABLE members MERGE PARTITIONS p1 INTO PARTITION s0;", true, "ALTER TABLE `members` MERGE PARTITIONS `p1` INTO PARTITION `s0`"},
+		{"ALTER TABLE members MERGE PARTITIONS p1, p2 INTO s0;", false, ""},
+
 		// alter attributes
 		{"ALTER TABLE t ATTRIBUTES='str'", true, "ALTER TABLE `t` ATTRIBUTES='str'"},
 		{"ALTER TABLE t ATTRIBUTES='str1,str2'", true, "ALTER TABLE `t` ATTRIBUTES='str1,str2'"},
@@ -3756,6 +3803,30 @@ func TestDDL(t *testing.T) {
 		{"alter table t add primary key (`a`, `b`) clustered", true, "ALTER TABLE `t` ADD PRIMARY KEY(`a`, `b`) CLUSTERED"},
 		{"alter table t add primary key (`a`, `b`) nonclustered", true, "ALTER TABLE `t` ADD PRIMARY KEY(`a`, `b`) NONCLUSTERED"},
 
+		// for create row policy
+		{"create policy p1 on t using (tenant_id = 1)", true, "CREATE POLICY `p1` ON `t` USING (`tenant_id`=1)"},
+		{"create policy if not exists p1 on test.t to u1, 'r1'@'%', u2@localhost using (owner = current_user())", true, "CREATE POLICY IF NOT EXISTS `p1` ON `test`.`t` TO `u1`@`%`, `r1`@`%`, `u2`@`localhost` USING (`owner`=CURRENT_USER())"},
+		{"create policy p1 on t using tenant_id = 1", false, ""},
+		{"create policy p1 on t to u1", false, ""},
+		{"create policy p1 on t1, t2 using (a > 0)", false, ""},
+		// for drop row policy
+		{"drop policy p1 on t", true, "DROP POLICY `p1` ON `t`"},
+		{"drop policy if exists p1 on test.t", true, "DROP POLICY IF EXISTS `p1` ON `test`.`t`"},
+		{"drop policy p1", false, ""},
+
+		// for data masking policy
+		{"create masking policy m1 on t (phone) using full", true, "CREATE MASKING POLICY `m1` ON `t` (`phone`) USING FULL"},
+		{"create masking policy if not exists m1 on test.t (phone) to u1, 'r1'@'%' using partial(3, 4)", true, "CREATE MASKING POLICY IF NOT EXISTS `m1` ON `test`.`t` (`phone`) TO `u1`@`%`, `r1`@`%` USING PARTIAL(3, 4)"},
+		{"create masking policy m1 on t (email) using hash", true, "CREATE MASKING POLICY `m1` ON `t` (`email`) USING HASH"},
+		{"create masking policy m1 on t (phone) using partial(3)", false, ""},
+		{"create masking policy m1 on t (a, b) using full", false, ""},
+		{"create masking policy m1 on t using full", false, ""},
+		{"create masking policy m1 on t (phone) using reverse", false, ""},
+		{"drop masking policy m1 on t", true, "DROP MASKING POLICY `m1` ON `t`"},
+		{"drop masking policy if exists m1 on test.t", true, "DROP MASKING POLICY IF EXISTS `m1` ON `test`.`t`"},
+		{"drop masking policy m1", false, ""},
+		{"create table masking (a int)", true, "CREATE TABLE `masking` (`a` INT)"},
+
 		// for drop placement policy
 		{"drop placement policy x", true, "DROP PLACEMENT POLICY `x`"},
 		{"drop placement policy x, y", false, ""},
@@ -3826,6 +3897,7 @@ func TestDDL(t *testing.T) {
 		{"create resource group x ru_per_sec=1000 QUERY_LIMIT (EXEC_ELAPSED '7s' ACTION COOLDOWN WATCH EXACT DURATION UNLIMITED)", true, "CREATE RESOURCE GROUP `x` RU_PER_SEC = 1000, QUERY_LIMIT = (EXEC_ELAPSED = '7s' ACTION = COOLDOWN WATCH = EXACT DURATION = UNLIMITED)"},
 		{"create resource group x ru_per_sec=1000 QUERY_LIMIT (EXEC_ELAPSED '7s' ACTION COOLDOWN WATCH EXACT DURATION 'UNLIMITED')", true, "CREATE RESOURCE GROUP `x` RU_PER_SEC = 1000, QUERY_LIMIT = (EXEC_ELAPSED = '7s' ACTION = COOLDOWN WATCH = EXACT DURATION = UNLIMITED)"},
 		{"create resource group x ru_per_sec=1000 background = (task_types='')", true, "CREATE RESOURCE GROUP `x` RU_PER_SEC = 1000, BACKGROUND = (TASK_TYPES = '')"},
+		{"create resource group x ru_per_sec=1000 optimizer_vars = (tidb_partition_prune_mode = 'dynamic')", true, "CREATE RESOURCE GROUP `x` RU_PER_SEC = 1000, OPTIMIZER_VARS = (tidb_partition_prune_mode = 'dynamic')"},
 		{"create resource group x ru_per_sec=1000 background (task_types='br,lightning')", true, "CREATE RESOURCE GROUP `x` RU_PER_SEC = 1000, BACKGROUND = (TASK_TYPES = 'br,lightning')"},
 		{`create resource group x ru_per_sec=1000 QUERY_LIMIT (EXEC_ELAPSED "10s" ACTION COOLDOWN WATCH EXACT DURATION='10m')  background (task_types 'br,lightning')`, true, "CREATE RESOURCE GROUP `x` RU_PER_SEC = 1000, QUERY_LIMIT = (EXEC_ELAPSED = '10s' ACTION = COOLDOWN WATCH = EXACT DURATION = '10m'), BACKGROUND = (TASK_TYPES = 'br,lightning')"},
 		{`create resource group x ru_per_sec=1000 QUERY_LIMIT (EXEC_ELAPSED "10s" ACTION COOLDOWN WATCH PLAN DURATION='10m')  background (task_types 'br,lightning')`, true, "CREATE RESOURCE GROUP `x` RU_PER_SEC = 1000, QUERY_LIMIT = (EXEC_ELAPSED = '10s' ACTION = COOLDOWN WATCH = PLAN DURATION = '10m'), BACKGROUND = (TASK_TYPES = 'br,lightning')"},
@@ -3868,6 +3940,11 @@ func TestDDL(t *testing.T) {
 		{"alter resource group x ru_per_sec=1000 QUERY_LIMIT = (EXEC_ELAPSED '10s' ACTION DRYRUN WATCH SIMILAR DURATION '10m' ACTION COOLDOWN)", false, ""},
 		{"alter resource group x background=()", true, "ALTER RESOURCE GROUP `x` BACKGROUND = NULL"},
 		{"alter resource group x background NULL", true, "ALTER RESOURCE GROUP `x` BACKGROUND = NULL"},
+		{"alter resource group x optimizer_vars = (tidb_opt_cpu_factor = '2', TIDB_ENFORCE_MPP = 'on')", true, "ALTER RESOURCE GROUP `x` OPTIMIZER_VARS = (tidb_opt_cpu_factor = '2', tidb_enforce_mpp = 'on')"},
+		{"alter resource group x optimizer_vars ()", true, "ALTER RESOURCE GROUP `x` OPTIMIZER_VARS = NULL"},
+		{"alter resource group x optimizer_vars NULL", true, "ALTER RESOURCE GROUP `x` OPTIMIZER_VARS = NULL"},
+		{"alter resource group x optimizer_vars = (tidb_enforce_mpp = 'on', tidb_enforce_mpp = 'off')", false, ""},
+		{"alter resource group x optimizer_vars = (tidb_enforce_mpp 'on')", false, ""},
 		{"alter resource group default priority=low background = ( task_types \"ttl\" )", true, "ALTER RESOURCE GROUP `default` PRIORITY = LOW, BACKGROUND = (TASK_TYPES = 'ttl')"},
 		{"alter resource group default burstable background ( task_types = 'a,b,c' )", true, "ALTER RESOURCE GROUP `default` BURSTABLE = TRUE, BACKGROUND = (TASK_TYPES = 'a,b,c')"},
 
@@ -5297,6 +5374,25 @@ func TestWithRollup(t *testing.T) {
 	RunTest(t, table, false)
 }
 
+func TestGroupingSets(t *testing.T) {
+	table := []testCase{
+		{`select a, b, count(*) from t group by rollup(a, b)`, true, "SELECT `a`,`b`,COUNT(1) FROM `t` GROUP BY `a`,`b` WITH ROLLUP"},
+		{`select a, b, count(*) from t group by rollup()`, false, ""},
+		{`select a, b, count(*) from t group by rollup(a, b) with rollup`, false, ""},
+		{`select a, b, count(*) from t group by cube(a, b)`, true, "SELECT `a`,`b`,COUNT(1) FROM `t` GROUP BY CUBE(`a`,`b`)"},
+		{`select a, b, count(*) from t group by cube(a+1, b) having grouping(b) = 0`, true, "SELECT `a`,`b`,COUNT(1) FROM `t` GROUP BY CUBE(`a`+1,`b`) HAVING GROUPING(`b`)=0"},
+		{`select a, b, count(*) from t group by cube()`, false, ""},
+		{`select a, b, count(*) from t group by grouping sets((a, b), (a), ())`, true, "SELECT `a`,`b`,COUNT(1) FROM `t` GROUP BY GROUPING SETS((`a`,`b`),(`a`),())"},
+		{`select a, b, count(*) from t group by grouping sets((b), (a, b), (b))`, true, "SELECT `a`,`b`,COUNT(1) FROM `t` GROUP BY GROUPING SETS((`b`),(`a`,`b`),(`b`))"},
+		{`select a, b, count(*) from t group by grouping sets(a, b)`, false, ""},
+		{`select a, b, count(*) from t group by grouping sets()`, false, ""},
+		// ROLLUP, CUBE, GROUPING and SETS are unreserved keywords.
+		{`select grouping, sets, cube, rollup from t group by grouping, sets, cube, rollup`, true, "SELECT `grouping`,`sets`,`cube`,`rollup` FROM `t` GROUP BY `grouping`,`sets`,`cube`,`rollup`"},
+		{`select grouping(a) from t group by a with rollup`, true, "SELECT GROUPING(`a`) FROM `t` GROUP BY `a` WITH ROLLUP"},
+	}
+	RunTest(t, table, false)
+}
+
 func TestIndexHint(t *testing.T) {
 	table := []testCase{
 		{`select * from t use index (primary)`, true, "SELECT * FROM `t` USE INDEX (`primary`)"},
@@ -5436,6 +5532,15 @@ func TestExplain(t *testing.T) {
 		{"EXPLAIN FORMAT = TIDB_JSON FOR CONNECTION 1", true, "EXPLAIN FORMAT = 'TIDB_JSON' FOR CONNECTION 1"},
 		{"EXPLAIN FORMAT = tidb_json SELECT 1", true, "EXPLAIN FORMAT = 'tidb_json' SELECT 1"},
 		{"EXPLAIN ANALYZE FORMAT = tidb_json SELECT 1", true, "EXPLAIN ANALYZE FORMAT = 'tidb_json' SELECT 1"},
+		{"EXPLAIN WITH (tidb_opt_enable_hash_join = off) SELECT 1", true, "EXPLAIN FORMAT = 'row' WITH (`tidb_opt_enable_hash_join` = `off`) SELECT 1"},
+		{"EXPLAIN FORMAT = 'brief' WITH (TIDB_OPT_CPU_FACTOR = 3.5, tidb_isolation_read_engines := 'tiflash') SELECT * FROM t", true, "EXPLAIN FORMAT = 'brief' WITH (`tidb_opt_cpu_factor` = 3.5, `tidb_isolation_read_engines` = _UTF8MB4'tiflash') SELECT * FROM `t`"},
+		{"EXPLAIN FORMAT = VERBOSE WITH (tidb_enable_index_merge = default) SELECT 1", true, "EXPLAIN FORMAT = 'VERBOSE' WITH (`tidb_enable_index_merge` = DEFAULT) SELECT 1"},
+		{"EXPLAIN ANALYZE WITH (tidb_opt_enable_hash_join = on) DELETE FROM t", true, "EXPLAIN ANALYZE WITH (`tidb_opt_enable_hash_join` = _UTF8MB4'ON') DELETE FROM `t`"},
+		{"EXPLAIN ANALYZE FORMAT = 'verbose' WITH (tidb_opt_enable_hash_join = 0) SELECT 1", true, "EXPLAIN ANALYZE FORMAT = 'verbose' WITH (`tidb_opt_enable_hash_join` = 0) SELECT 1"},
+		{"EXPLAIN ANALYZE FORMAT = VERBOSE WITH (tidb_opt_enable_hash_join = 0) SELECT 1", true, "EXPLAIN ANALYZE FORMAT = 'VERBOSE' WITH (`tidb_opt_enable_hash_join` = 0) SELECT 1"},
+		{"EXPLAIN WITH cte AS (SELECT 1) SELECT * FROM cte", true, "EXPLAIN FORMAT = 'row' WITH `cte` AS (SELECT 1) SELECT * FROM `cte`"},
+		{"EXPLAIN WITH () SELECT 1", false, ""},
+		{"EXPLAIN WITH (tidb_opt_enable_hash_join) SELECT 1", false, ""},
 	}
 	RunTest(t, table, false)
 }
@@ -5645,6 +5750,12 @@ func TestView(t *testing.T) {
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t union all select * from t) with local check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t` UNION ALL SELECT * FROM `t`) WITH LOCAL CHECK OPTION"},
 		{"create or replace algorithm = merge definer = 'root' sql security invoker view v(a,b) as (select * from t union all select * from t) with cascaded check option", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = `root`@`%` SQL SECURITY INVOKER VIEW `v` (`a`,`b`) AS (SELECT * FROM `t` UNION ALL SELECT * FROM `t`)"},
 		{"create or replace algorithm = merge definer = current_user view v as select * from t union all select * from t", true, "CREATE OR REPLACE ALGORITHM = MERGE DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` AS SELECT * FROM `t` UNION ALL SELECT * FROM `t`"},
+
+		// create view with hints
+		{"create view v with hints '/*+ hash_join(t1) */' as select * from t1, t2", true, "CREATE ALGORITHM = UNDEFINED DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` WITH HINTS '/*+ hash_join(t1) */' AS SELECT * FROM (`t1`) JOIN `t2`"},
+		{"create view v(a, b) with hints 'time_range(\\'2020-02-02 12:10:00\\', \\'2020-02-02 13:00:00\\')' as select * from t with local check option", true, "CREATE ALGORITHM = UNDEFINED DEFINER = CURRENT_USER SQL SECURITY DEFINER VIEW `v` (`a`,`b`) WITH HINTS 'time_range(''2020-02-02 12:10:00'', ''2020-02-02 13:00:00'')' AS SELECT * FROM `t` WITH LOCAL CHECK OPTION"},
+		{"create view v with hints as select * from t", false, ""},
+		{"create view v as select * from t with hints 'hash_join(t)'", false, ""},
 	}
 	RunTest(t, table, false)
 
@@ -5980,6 +6091,30 @@ func TestTableSample(t *testing.T) {
 	}
 }
 
+func TestJSONTable(t *testing.T) {
+	table := []testCase{
+		// positive test cases
+		{"select * from json_table('[1,2]', '$[*]' columns(a int path '$')) as jt;", true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[1,2]', '$[*]' COLUMNS(`a` INT PATH '$')) AS `jt`"},
+		{"select * from json_table('[1,2]', '$[*]' columns(a int path '$')) jt;", true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[1,2]', '$[*]' COLUMNS(`a` INT PATH '$')) AS `jt`"},
+		{"select * from json_table('[]', '$[*]' columns(id for ordinality, b varchar(10) exists path '$.b')) as jt;", true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[]', '$[*]' COLUMNS(`id` FOR ORDINALITY, `b` VARCHAR(10) EXISTS PATH '$.b')) AS `jt`"},
+		{"select * from json_table('[]', '$[*]' columns(a int path '$.a' null on empty error on error)) as jt;", true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[]', '$[*]' COLUMNS(`a` INT PATH '$.a' NULL ON EMPTY ERROR ON ERROR)) AS `jt`"},
+		{"select * from json_table('[]', '$[*]' columns(a int path '$.a' default '0' on empty)) as jt;", true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[]', '$[*]' COLUMNS(`a` INT PATH '$.a' DEFAULT '0' ON EMPTY)) AS `jt`"},
+		{"select * from json_table('[]', '$[*]' columns(a int path '$.a' default '-1' on error)) as jt;", true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[]', '$[*]' COLUMNS(`a` INT PATH '$.a' DEFAULT '-1' ON ERROR)) AS `jt`"},
+		{"select * from json_table('[]', '$[*]' columns(a int path '$.a', nested path '$.b[*]' columns(b int path '$'), nested '$.c[*]' columns(c json path '$'))) as jt;", true, "SELECT * FROM JSON_TABLE(_UTF8MB4'[]', '$[*]' COLUMNS(`a` INT PATH '$.a', NESTED PATH '$.b[*]' COLUMNS(`b` INT PATH '$'), NESTED PATH '$.c[*]' COLUMNS(`c` JSON PATH '$'))) AS `jt`"},
+		{"select t.a, jt.* from t, json_table(t.j, '$[*]' columns(x int path '$')) as jt where jt.x > 1;", true, "SELECT `t`.`a`,`jt`.* FROM (`t`) JOIN JSON_TABLE(`t`.`j`, '$[*]' COLUMNS(`x` INT PATH '$')) AS `jt` WHERE `jt`.`x`>1"},
+		{"select * from t left join json_table(t.j, '$[*]' columns(x int path '$')) as jt on true;", true, "SELECT * FROM `t` LEFT JOIN JSON_TABLE(`t`.`j`, '$[*]' COLUMNS(`x` INT PATH '$')) AS `jt` ON TRUE"},
+		{"select json_table, path, nested, ordinality, empty from json_table;", true, "SELECT `json_table`,`path`,`nested`,`ordinality`,`empty` FROM `json_table`"},
+
+		// negative test cases
+		{"select * from json_table('[]', '$[*]' columns(a int path '$'));", false, ""},
+		{"select * from json_table('[]', '$[*]') as jt;", false, ""},
+		{"select * from json_table('[]', '$[*]' columns()) as jt;", false, ""},
+		{"select * from json_table('[]', '$[*]' columns(a int path '$' error on error null on empty)) as jt;", false, ""},
+		{"select * from json_table('[]', '$[*]' columns(a for ordinality path '$')) as jt;", false, ""},
+	}
+	RunTest(t, table, false)
+}
+
 func TestGeneratedColumn(t *testing.T) {
 	tests := []struct {
 		input string
@@ -6368,6 +6503,14 @@ func TestWindowFunctions(t *testing.T) {
 		{`SELECT AVG(val) OVER (RANGE BETWEEN INTERVAL 5 DAY PRECEDING AND INTERVAL '2:30' MINUTE_SECOND FOLLOWING) FROM t;`, true, "SELECT AVG(`val`) OVER (RANGE BETWEEN INTERVAL 5 DAY PRECEDING AND INTERVAL _UTF8MB4'2:30' MINUTE_SECOND FOLLOWING) FROM `t`"},
 		{`SELECT AVG(val) OVER (RANGE BETWEEN CURRENT ROW AND CURRENT ROW) FROM t;`, true, "SELECT AVG(`val`) OVER (RANGE BETWEEN CURRENT ROW AND CURRENT ROW) FROM `t`"},
 		{`SELECT AVG(val) OVER (RANGE CURRENT ROW) FROM t;`, true, "SELECT AVG(`val`) OVER (RANGE BETWEEN CURRENT ROW AND CURRENT ROW) FROM `t`"},
+		{`SELECT SUM(val) OVER (ORDER BY time ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE CURRENT ROW) FROM t;`, true, "SELECT SUM(`val`) OVER (ORDER BY `time` ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE CURRENT ROW) FROM `t`"},
+		{`SELECT SUM(val) OVER (ORDER BY time RANGE UNBOUNDED PRECEDING EXCLUDE GROUP) FROM t;`, true, "SELECT SUM(`val`) OVER (ORDER BY `time` RANGE BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW EXCLUDE GROUP) FROM `t`"},
+		{`SELECT SUM(val) OVER (ORDER BY time RANGE BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE TIES) FROM t;`, true, "SELECT SUM(`val`) OVER (ORDER BY `time` RANGE BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE TIES) FROM `t`"},
+		{`SELECT SUM(val) OVER (ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING EXCLUDE NO OTHERS) FROM t;`, true, "SELECT SUM(`val`) OVER (ROWS BETWEEN 1 PRECEDING AND 1 FOLLOWING) FROM `t`"},
+		{`SELECT SUM(val) OVER w FROM t WINDOW w AS (ORDER BY time ROWS 2 PRECEDING EXCLUDE TIES);`, true, "SELECT SUM(`val`) OVER `w` FROM `t` WINDOW `w` AS (ORDER BY `time` ROWS BETWEEN 2 PRECEDING AND CURRENT ROW EXCLUDE TIES)"},
+		{`SELECT SUM(val) OVER (ORDER BY time EXCLUDE CURRENT ROW) FROM t;`, false, ""},
+		{`SELECT SUM(val) OVER (ROWS CURRENT ROW EXCLUDE OTHERS) FROM t;`, false, ""},
+		{`SELECT exclude, others, ties FROM t;`, true, "SELECT `exclude`,`others`,`ties` FROM `t`"},
 
 		// For named windows.
 		// See https://dev.mysql.com/doc/refman/8.0/en/window-functions-named-windows.html
@@ -7245,6 +7388,8 @@ func TestPlanReplayer(t *testing.T) {
 		{"PLAN REPLAYER DUMP EXPLAIN 'sql.txt'", true, "PLAN REPLAYER DUMP EXPLAIN 'sql.txt'"},
 		{"PLAN REPLAYER DUMP EXPLAIN ANALYZE 'sql.txt'", true, "PLAN REPLAYER DUMP EXPLAIN ANALYZE 'sql.txt'"},
 		{"PLAN REPLAYER CAPTURE '123' '123'", true, "PLAN REPLAYER CAPTURE '123' '123'"},
+		{"PLAN REPLAYER CAPTURE '123' '*' LIMIT 5", true, "PLAN REPLAYER CAPTURE '123' '*' LIMIT 5"},
+		{"PLAN REPLAYER CAPTURE '123' '123' LIMIT -1", false, ""},
 		{"PLAN REPLAYER CAPTURE REMOVE '123' '123'", true, "PLAN REPLAYER CAPTURE REMOVE '123' '123'"},
 	}
 	RunTest(t, table, false)
@@ -7463,6 +7608,24 @@ func TestTTLTableOption(t *testing.T) {
 		{"alter table t /*T![ttl] ttl=created_at + INTERVAL 1 YEAR ttl_enable='ON' TTL_JOB_INTERVAL='8h'*/", true, "ALTER TABLE `t` TTL = `created_at` + INTERVAL 1 YEAR TTL_ENABLE = 'ON' TTL_JOB_INTERVAL = '8h'"},
 		{"alter table t /*T![ttl] ttl=created_at + INTERVAL 1 YEAR ttl_enable='ON' TTL_JOB_INTERVAL='8.645124531235h'*/", true, "ALTER TABLE `t` TTL = `created_at` + INTERVAL 1 YEAR TTL_ENABLE = 'ON' TTL_JOB_INTERVAL = '8.645124531235h'"},
 
+		// TTL_CONDITION with an extra predicate
+		{"create table t (created_at datetime, status int) TTL = created_at + INTERVAL 1 YEAR TTL_CONDITION = 'status = 2'", true, "CREATE TABLE `t` (`created_at` DATETIME,`status` INT) TTL = `created_at` + INTERVAL 1 YEAR TTL_CONDITION = 'status = 2'"},
+		{"create table t (created_at datetime, status int) /*T![ttl] ttl=created_at + INTERVAL 1 YEAR ttl_condition='status in (1, 2)'*/", true, "CREATE TABLE `t` (`created_at` DATETIME,`status` INT) TTL = `created_at` + INTERVAL 1 YEAR TTL_CONDITION = 'status in (1, 2)'"},
+		{"alter table t TTL_CONDITION 'status = 2'", true, "ALTER TABLE `t` TTL_CONDITION = 'status = 2'"},
+		{"alter table t TTL_CONDITION = ''", true, "ALTER TABLE `t` TTL_CONDITION = ''"},
+		{"alter table t /*T![ttl] TTL_CONDITION = 'name like ''tmp%''' */", true, "ALTER TABLE `t` TTL_CONDITION = 'name like ''tmp%'''"},
+		// TTL job windows, delete rate limit and resource group
+		{"create table t (created_at datetime) TTL = created_at + INTERVAL 1 YEAR TTL_JOB_WINDOW_START = '01:00 +0000' TTL_JOB_WINDOW_END = '05:00 +0000'", true, "CREATE TABLE `t` (`created_at` DATETIME) TTL = `created_at` + INTERVAL 1 YEAR TTL_JOB_WINDOW_START = '01:00 +0000' TTL_JOB_WINDOW_END = '05:00 +0000'"},
+		{"create table t (created_at datetime) /*T![ttl] ttl=created_at + INTERVAL 1 YEAR ttl_delete_rate_limit=100 ttl_resource_group=rg1*/", true, "CREATE TABLE `t` (`created_at` DATETIME) TTL = `created_at` + INTERVAL 1 YEAR TTL_DELETE_RATE_LIMIT = 100 TTL_RESOURCE_GROUP = `rg1`"},
+		{"alter table t TTL_JOB_WINDOW_START '22:00 +0800' TTL_JOB_WINDOW_END = ''", true, "ALTER TABLE `t` TTL_JOB_WINDOW_START = '22:00 +0800' TTL_JOB_WINDOW_END = ''"},
+		{"alter table t TTL_DELETE_RATE_LIMIT = 0", true, "ALTER TABLE `t` TTL_DELETE_RATE_LIMIT = 0"},
+		{"alter table t TTL_RESOURCE_GROUP = default", true, "ALTER TABLE `t` TTL_RESOURCE_GROUP = `default`"},
+		{"alter table t /*T![ttl] TTL_RESOURCE_GROUP = `rg1` */", true, "ALTER TABLE `t` TTL_RESOURCE_GROUP = `rg1`"},
+		{"alter table t TTL_DELETE_RATE_LIMIT = -1", false, ""},
+		{"alter table t TTL_RESOURCE_GROUP = 'rg1'", false, ""},
+		{"alter table t TTL_JOB_WINDOW_START = '25:00 +0000'", false, ""},
+		{"alter table t TTL_JOB_WINDOW_END = '01:00'", false, ""},
+
 		// alter table to remove ttl settings
 		{"alter table t remove ttl", true, "ALTER TABLE `t` REMOVE TTL"},
 
@@ -7480,6 +7643,19 @@ func TestTTLTableOption(t *testing.T) {
 	RunTest(t, table, false)
 }
 
+func TestExternalTableOption(t *testing.T) {
+	table := []testCase{
+		{"create table t (a int, b varchar(10)) EXTERNAL_LOCATION = 's3://bucket/dir/*.csv' EXTERNAL_FORMAT = 'csv'", true, "CREATE TABLE `t` (`a` INT,`b` VARCHAR(10)) EXTERNAL_LOCATION = 's3://bucket/dir/*.csv' EXTERNAL_FORMAT = 'csv'"},
+		{"create table t (a int) external_location 'gs://bucket/t.parquet' external_format 'parquet'", true, "CREATE TABLE `t` (`a` INT) EXTERNAL_LOCATION = 'gs://bucket/t.parquet' EXTERNAL_FORMAT = 'parquet'"},
+		{"create table t (a int) /*T![external_table] EXTERNAL_LOCATION = 's3://bucket/t.csv' */", true, "CREATE TABLE `t` (`a` INT) EXTERNAL_LOCATION = 's3://bucket/t.csv'"},
+		{"alter table t EXTERNAL_LOCATION = 's3://bucket/new/*.csv'", true, "ALTER TABLE `t` EXTERNAL_LOCATION = 's3://bucket/new/*.csv'"},
+		{"create table external_location (external_format int)", true, "CREATE TABLE `external_location` (`external_format` INT)"},
+		{"create table t (a int) EXTERNAL_LOCATION = s3", false, ""},
+	}
+
+	RunTest(t, table, false)
+}
+
 func TestIssue45898(t *testing.T) {
 	p := parser.New()
 	p.ParseSQL("a.")
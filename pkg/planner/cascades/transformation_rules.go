@@ -418,7 +418,7 @@ func (r *PushAggDownGather) Match(expr *memo.ExprIter) bool {
 	if agg.HasDistinct() {
 		// TODO: remove this logic after the cost estimation of distinct pushdown is implemented.
 		// If AllowDistinctAggPushDown is set to true, we should not consider RootTask.
-		if !agg.SCtx().GetSessionVars().AllowDistinctAggPushDown {
+		if !agg.AllowDistinctAggPushDown() {
 			return false
 		}
 	}
@@ -123,6 +123,8 @@ type IndexAccess struct {
 	Name             string
 	Cols             []string
 	IsClusteredIndex bool
+	// IsInvisible indicates the index is invisible, it's only used when the optimizer is allowed to use invisible indexes.
+	IsInvisible bool
 }
 
 // ToPB turns itself into a protobuf message.
@@ -158,6 +160,8 @@ func (s *ScanAccessObject) NormalizedString() string {
 	for _, index := range s.Indexes {
 		if index.IsClusteredIndex {
 			b.WriteString(", clustered index:")
+		} else if index.IsInvisible {
+			b.WriteString(", invisible index:")
 		} else {
 			b.WriteString(", index:")
 		}
@@ -177,6 +181,8 @@ func (s *ScanAccessObject) String() string {
 	for _, index := range s.Indexes {
 		if index.IsClusteredIndex {
 			b.WriteString(", clustered index:")
+		} else if index.IsInvisible {
+			b.WriteString(", invisible index:")
 		} else {
 			b.WriteString(", index:")
 		}
@@ -232,6 +238,11 @@ func (o OtherAccessObject) SetIntoPB(pb *tipb.ExplainOperator) {
 	}
 }
 
+// AccessObject implements dataAccesser interface.
+func (p *PhysicalLooseIndexScan) AccessObject() base.AccessObject {
+	return p.IndexScan.AccessObject()
+}
+
 // AccessObject implements dataAccesser interface.
 func (p *PhysicalIndexScan) AccessObject() base.AccessObject {
 	res := &ScanAccessObject{
@@ -251,7 +262,8 @@ func (p *PhysicalIndexScan) AccessObject() base.AccessObject {
 	}
 	if len(p.Index.Columns) > 0 {
 		index := IndexAccess{
-			Name: p.Index.Name.O,
+			Name:        p.Index.Name.O,
+			IsInvisible: p.Index.Invisible,
 		}
 		for _, idxCol := range p.Index.Columns {
 			if tblCol := p.Table.Columns[idxCol.Offset]; tblCol.Hidden {
@@ -313,6 +325,7 @@ func (p *PointGetPlan) AccessObject() base.AccessObject {
 		index := IndexAccess{
 			Name:             p.IndexInfo.Name.O,
 			IsClusteredIndex: p.IndexInfo.Primary && p.TblInfo.IsCommonHandle,
+			IsInvisible:      p.IndexInfo.Invisible,
 		}
 		for _, idxCol := range p.IndexInfo.Columns {
 			if tblCol := p.TblInfo.Columns[idxCol.Offset]; tblCol.Hidden {
@@ -350,6 +363,7 @@ func (p *BatchPointGetPlan) AccessObject() base.AccessObject {
 		index := IndexAccess{
 			Name:             p.IndexInfo.Name.O,
 			IsClusteredIndex: p.IndexInfo.Primary && p.TblInfo.IsCommonHandle,
+			IsInvisible:      p.IndexInfo.Invisible,
 		}
 		for _, idxCol := range p.IndexInfo.Columns {
 			if tblCol := p.TblInfo.Columns[idxCol.Offset]; tblCol.Hidden {
@@ -588,6 +588,38 @@ func TestMPPMultiDistinct3Stage(t *testing.T) {
 	}
 }
 
+func TestMPPMultiDistinct3StageWithGroupBy(t *testing.T) {
+	store := testkit.CreateMockStore(t, coretestsdk.WithMockTiFlash(2))
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test;")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, b int, c int, d int);")
+	tk.MustExec("alter table t set tiflash replica 1")
+	tb := external.GetTableByName(t, tk, "test", "t")
+	err := domain.GetDomain(tk.Session()).DDL().UpdateTableReplicaInfo(tk.Session(), tb.Meta().ID, true)
+	require.NoError(t, err)
+	tk.MustExec("set @@session.tidb_opt_enable_three_stage_multi_distinct_agg=1")
+	defer tk.MustExec("set @@session.tidb_opt_enable_three_stage_multi_distinct_agg=0")
+	tk.MustExec("set @@session.tidb_isolation_read_engines=\"tiflash\";")
+	tk.MustExec("set @@session.tidb_enforce_mpp=1")
+	tk.MustExec("set @@session.tidb_allow_mpp=ON;")
+	tk.MustExec("insert into t values(1, 1, 1, 1), (1, 2, 1, 1), (2, 2, 2, 1), (2, 2, 2, 1), (3, 1, 3, 2), (4, 1, null, 2), (null, 1, 4, 2)")
+
+	sql := "select /*+ mpp_2phase_agg() */ d, count(distinct a), count(distinct b), count(c), sum(c) from t group by d order by d"
+	tk.MustHavePlan(sql, "Expand")
+	tk.MustQuery(sql).Check(testkit.Rows("1 2 2 4 6", "2 2 1 2 7"))
+	sql = "select /*+ mpp_2phase_agg() */ count(distinct a), count(distinct b), count(*) from t group by d order by 1, 2"
+	tk.MustHavePlan(sql, "Expand")
+	tk.MustQuery(sql).Check(testkit.Rows("2 1 3", "2 2 4"))
+	// Fall back to the 2 phase aggregation if the group by column is also the distinct argument.
+	sql = "select /*+ mpp_2phase_agg() */ count(distinct a), count(distinct d) from t group by d order by 1"
+	tk.MustNotHavePlan(sql, "Expand")
+	tk.MustQuery(sql).Check(testkit.Rows("2 1", "2 1"))
+	tk.MustExec("set @@session.tidb_opt_enable_three_stage_multi_distinct_agg=0")
+	tk.MustNotHavePlan("select /*+ mpp_2phase_agg() */ d, count(distinct a), count(distinct b) from t group by d", "Expand")
+}
+
 // Test null-aware semi join push down for MPP mode
 func TestMPPNullAwareSemiJoinPushDown(t *testing.T) {
 	store := testkit.CreateMockStore(t, coretestsdk.WithMockTiFlash(2))
@@ -741,3 +773,47 @@ func TestRollupMPP(t *testing.T) {
 		require.Equal(t, output[i].Warn, testdata.ConvertSQLWarnToStrings(tk.Session().GetSessionVars().StmtCtx.GetWarnings()))
 	}
 }
+
+func TestGroupingSetsMPP(t *testing.T) {
+	store := testkit.CreateMockStore(t, coretestsdk.WithMockTiFlash(2))
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, b int, c int, d int)")
+	tk.MustExec("alter table t set tiflash replica 1")
+	tb := external.GetTableByName(t, tk, "test", "t")
+	err := domain.GetDomain(tk.Session()).DDL().UpdateTableReplicaInfo(tk.Session(), tb.Meta().ID, true)
+	require.NoError(t, err)
+	tk.MustExec("set @@tidb_enforce_mpp='on'")
+	tk.Session().GetSessionVars().TiFlashFineGrainedShuffleStreamCount = -1
+
+	for _, sql := range []string{
+		"select a, b, count(1) from t group by rollup(a, b)",
+		"select a, b, count(1), grouping(a, b) from t group by cube(a, b)",
+		"select a, b, sum(c) from t group by grouping sets((a, b), (a), ())",
+		"select b, sum(c) from t group by grouping sets((b), (a, b), (b)) having grouping(b) = 0",
+	} {
+		tk.MustHavePlan(sql, "Expand")
+		tk.MustQuery("show warnings").Check(testkit.Rows())
+	}
+	err = tk.ExecToErr("explain select a, count(1) from t group by cube(a, b) order by grouping(c)")
+	require.Equal(t, "[planner:3602]Argument #0 of GROUPING function is not in GROUP BY", err.Error())
+	err = tk.ExecToErr("explain select count(1) from t group by cube(a, b, c, d, a+1, b+1, c+1, d+1, a+2)")
+	require.Equal(t, "[planner:1235]This version of TiDB doesn't yet support 'CUBE with more than 8 grouping items'", err.Error())
+
+	// The grouping sets can only be computed by Expand, so NO_EXPAND is ignored.
+	tk.MustHavePlan("select /*+ no_expand() */ a, b, count(1) from t group by cube(a, b)", "Expand")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 8073 The NO_EXPAND hint is ignored since the grouping sets can only be computed by Expand"))
+
+	// NO_EXPAND falls back to the 2 phase aggregation for the multiple distinct aggregations.
+	tk.MustExec("set @@session.tidb_opt_enable_three_stage_multi_distinct_agg=1")
+	defer tk.MustExec("set @@session.tidb_opt_enable_three_stage_multi_distinct_agg=0")
+	tk.MustExec("insert into t values(1, 1, 1, 1), (1, 2, 1, 1), (2, 2, 2, 1), (3, 1, 3, 2), (null, 1, 4, 2)")
+	sql := "select /*+ mpp_2phase_agg() */ d, count(distinct a), count(distinct b) from t group by d order by d"
+	tk.MustHavePlan(sql, "Expand")
+	tk.MustQuery(sql).Check(testkit.Rows("1 2 2", "2 1 1"))
+	sql = "select /*+ mpp_2phase_agg(), no_expand() */ d, count(distinct a), count(distinct b) from t group by d order by d"
+	tk.MustNotHavePlan(sql, "Expand")
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+}
@@ -15,9 +15,14 @@
 package hint
 
 import (
+	"fmt"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/planner/util/coretestsdk"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
@@ -372,3 +377,443 @@ func TestHints(t *testing.T) {
 		tk.MustQuery("show warnings").Check(testkit.Rows(output[i].Warn...))
 	}
 }
+
+func TestScanTypeHint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, b int, c int, d int, primary key(a), index ib(b), index ibc(b, c))")
+
+	// full_scan only keeps the table paths.
+	tk.MustHavePlan("select /*+ full_scan(t) */ b, c from t where b = 1", "TableFullScan")
+	tk.MustNotHavePlan("select /*+ full_scan(t) */ b, c from t where b = 1", "IndexRangeScan")
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+
+	// index_only only keeps the covering index paths.
+	tk.MustHavePlan("select /*+ index_only(t) */ b, c from t where a > 1", "IndexFullScan")
+	tk.MustNotHavePlan("select /*+ index_only(t) */ b, c from t where a > 1", "TableRangeScan")
+	tk.MustUseIndex("select /*+ index_only(t) */ b, c from t where a > 1", "ibc")
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	tk.MustHavePlan("select /*+ index_only(t) */ d from t where b = 1", "IndexLookUp")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 No available path for table test.t satisfies the hint /*+ index_only */, the hint is ignored"))
+
+	// no_full_scan removes the paths scanning the full range.
+	tk.MustHavePlan("select b, c from t where a > 1 order by b limit 10", "IndexFullScan")
+	tk.MustHavePlan("select /*+ no_full_scan(t) */ b, c from t where a > 1 order by b limit 10", "TableRangeScan")
+	tk.MustNotHavePlan("select /*+ no_full_scan(t) */ b, c from t where a > 1 order by b limit 10", "IndexFullScan")
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	tk.MustHavePlan("select /*+ no_full_scan(t) */ * from t where d > 1", "TableFullScan")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 No available path for table test.t satisfies the hint /*+ no_full_scan */, the hint is ignored"))
+
+	// Conflicting scan type hints are all ignored.
+	tk.MustHavePlan("select /*+ full_scan(t), index_only(t) */ b from t where b = 1", "IndexRangeScan")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 Scan type hints are conflict, you can only specify one of full_scan, index_only and no_full_scan for table test.t"))
+
+	// The hints don't depend on the index names, so they work in the universal bindings.
+	tk.MustExec("set @@tidb_opt_enable_fuzzy_binding = 1")
+	tk.MustExec("create global binding using select /*+ full_scan(t) */ b, c from *.t where b = 1")
+	tk.MustHavePlan("select b, c from t where b = 1", "TableFullScan")
+	tk.MustQuery("select @@last_plan_from_binding").Check(testkit.Rows("1"))
+}
+
+func TestDistinctAggPushDownHint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, b int, c int, index ia(a), index ib(b))")
+	tk.MustExec("insert into t values (1, 1, 1), (1, 2, 1), (2, 2, 2), (3, 3, 2)")
+	copAggCount := func(sql string) int {
+		cnt := 0
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Agg") && row[2] == "cop[tikv]" {
+				cnt++
+			}
+		}
+		return cnt
+	}
+
+	tk.MustExec("set @@tidb_opt_distinct_agg_push_down = 0")
+	require.Equal(t, 0, copAggCount("select count(distinct a) from t"))
+	require.Equal(t, 1, copAggCount("select /*+ distinct_agg_push_down() */ count(distinct a) from t"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	tk.MustQuery("select /*+ distinct_agg_push_down() */ count(distinct a) from t").Check(testkit.Rows("3"))
+	tk.MustQuery("select /*+ distinct_agg_push_down() */ c, count(distinct b) from t group by c order by c").Check(testkit.Rows("1 2", "2 2"))
+
+	// The hints only take effect in the query block they belong to.
+	require.Equal(t, 1, copAggCount("select count(distinct a) from t t1 union all select /*+ distinct_agg_push_down() */ count(distinct b) from t t2"))
+	require.Equal(t, 1, copAggCount("select /*+ distinct_agg_push_down(@sel_2) */ count(distinct a) from t t1 union all select count(distinct b) from t t2"))
+
+	tk.MustExec("set @@tidb_opt_distinct_agg_push_down = 1")
+	require.Equal(t, 1, copAggCount("select count(distinct a) from t"))
+	require.Equal(t, 0, copAggCount("select /*+ no_distinct_agg_push_down() */ count(distinct a) from t"))
+	tk.MustQuery("select /*+ no_distinct_agg_push_down() */ count(distinct a) from t").Check(testkit.Rows("3"))
+
+	// Conflicting hints are both ignored.
+	require.Equal(t, 1, copAggCount("select /*+ distinct_agg_push_down(), no_distinct_agg_push_down() */ count(distinct a) from t"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 3126 DISTINCT_AGG_PUSH_DOWN and NO_DISTINCT_AGG_PUSH_DOWN hints are conflicted, both of them will be ignored"))
+}
+
+func TestApproxCountDistinctHint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, b int, c int)")
+	tk.MustExec("insert into t values (1, 1, 1), (1, 2, 1), (2, 2, null), (2, null, 2), (3, 3, 3)")
+	hasAggFunc := func(sql, aggFunc string) bool {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[4].(string), aggFunc) {
+				return true
+			}
+		}
+		return false
+	}
+
+	sql := "select /*+ approx_count_distinct() */ a, count(distinct b), count(distinct b, c), count(c) from t group by a order by a"
+	require.True(t, hasAggFunc(sql, "approx_count_distinct(test.t.b)"))
+	require.True(t, hasAggFunc(sql, "approx_count_distinct(test.t.b, test.t.c)"))
+	require.False(t, hasAggFunc(sql, "count(distinct"))
+	tk.MustQuery(sql).Check(testkit.Rows("1 2 2 2", "2 1 0 1", "3 1 1 1"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+
+	// The hint only affects the aggregations in its query block.
+	sql = "select count(distinct b), (select /*+ approx_count_distinct() */ count(distinct c) from t) from t"
+	require.True(t, hasAggFunc(sql, "count(distinct test.t.b)"))
+	tk.MustQuery(sql).Check(testkit.Rows("3 3"))
+	sql = "select /*+ approx_count_distinct(@sel_2) */ * from t where b in (select count(distinct c) from t)"
+	require.True(t, hasAggFunc(sql, "approx_count_distinct(test.t.c)"))
+	tk.MustQuery(sql + " order by a").Check(testkit.Rows("3 3 3"))
+}
+
+func TestMaterializeSubqueryHint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(a int, b int, index ia(a))")
+	tk.MustExec("create table t2(a int, b int, index ia(a))")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2), (3, 3)")
+	tk.MustExec("insert into t2 values (1, 1), (1, 1), (3, 3)")
+	hasSemiJoin := func(sql string) bool {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[4].(string), "semi join") {
+				return true
+			}
+		}
+		return false
+	}
+
+	// The IN subquery is rewritten into an inner join with aggregation by default.
+	tk.MustExec("set @@tidb_opt_insubq_to_join_and_agg = 1")
+	require.False(t, hasSemiJoin("select * from t1 where a in (select a from t2)"))
+	sql := "select * from t1 where a in (select /*+ materialize_subquery() */ a from t2)"
+	require.True(t, hasSemiJoin(sql))
+	tk.MustHavePlan(sql, "HashJoin")
+	tk.MustQuery(sql + " order by a").Check(testkit.Rows("1 1", "3 3"))
+	require.True(t, hasSemiJoin("select /*+ materialize_subquery(@sel_2) */ * from t1 where a in (select a from t2)"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+
+	tk.MustExec("set @@tidb_opt_insubq_to_join_and_agg = 0")
+	require.True(t, hasSemiJoin("select * from t1 where a in (select a from t2)"))
+	sql = "select * from t1 where a in (select /*+ no_materialize_subquery() */ a from t2)"
+	require.False(t, hasSemiJoin(sql))
+	tk.MustQuery(sql + " order by a").Check(testkit.Rows("1 1", "3 3"))
+	tk.MustQuery("select * from t1 where a not in (select /*+ no_materialize_subquery() */ a from t2)").Check(testkit.Rows("2 2"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 NO_MATERIALIZE_SUBQUERY() is inapplicable because the IN subquery can't be rewritten into an inner join."))
+
+	// The EXISTS subquery is rewritten into an inner join with aggregation like SEMI_JOIN_REWRITE().
+	require.True(t, hasSemiJoin("select * from t1 where exists (select 1 from t2 where t2.a = t1.a)"))
+	sql = "select * from t1 where exists (select /*+ no_materialize_subquery() */ 1 from t2 where t2.a = t1.a)"
+	require.False(t, hasSemiJoin(sql))
+	tk.MustQuery(sql + " order by a").Check(testkit.Rows("1 1", "3 3"))
+	sql = "select * from t1 where exists (select /*+ materialize_subquery() */ 1 from t2 where t2.a = t1.a)"
+	require.True(t, hasSemiJoin(sql))
+	tk.MustHavePlan(sql, "HashJoin")
+	tk.MustNotHavePlan(sql, "IndexJoin")
+	tk.MustQuery(sql + " order by a").Check(testkit.Rows("1 1", "3 3"))
+
+	// Inapplicable and conflicting hints.
+	tk.MustQuery("select /*+ materialize_subquery() */ * from t1 where a = 1").Check(testkit.Rows("1 1"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 8073 MATERIALIZE_SUBQUERY() is inapplicable because it's not in an IN subquery or an EXISTS subquery."))
+	tk.MustQuery("select * from t1 where a in (select /*+ materialize_subquery(), no_materialize_subquery() */ a from t2) order by a").Check(testkit.Rows("1 1", "3 3"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 MATERIALIZE_SUBQUERY() and NO_MATERIALIZE_SUBQUERY() are in conflict. Both will be ineffective."))
+	tk.MustQuery("select * from t1 where exists (select /*+ materialize_subquery(), semi_join_rewrite() */ 1 from t2 where t2.a = t1.a) order by a").Check(testkit.Rows("1 1", "3 3"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 MATERIALIZE_SUBQUERY() and SEMI_JOIN_REWRITE() are in conflict. Both will be ineffective."))
+}
+
+func TestSubqueryMaxExecutionTimeHint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t1, t2")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int)")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2)")
+	tk.MustExec("insert into t2 values (1, 10), (2, 20)")
+
+	sql := "select a, (select /*+ max_execution_time(100) */ max(b) from t2) from t1 order by a"
+	tk.MustQuery(sql).Check(testkit.Rows("1 20", "2 20"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+
+	// The subquery falls back to NULL when it runs out of its time, while the outer query still returns the rows.
+	fpName := "github.com/pingcap/tidb/pkg/planner/core/mockSlowScalarSubquery"
+	require.NoError(t, failpoint.Enable(fpName, "return"))
+	tk.MustQuery(sql).Check(testkit.Rows("1 <nil>", "2 <nil>"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 The scalar subquery exceeded MAX_EXECUTION_TIME(100), NULL is used as its result."))
+	tk.MustQuery("select a, (select /*+ max_execution_time(100) */ a, b from t2 where a = 1) = (1, 10) from t1 order by a").Check(testkit.Rows("1 <nil>", "2 <nil>"))
+	// The subquery without the hint isn't limited.
+	tk.MustQuery("select a, (select max(b) from t2) from t1 order by a").Check(testkit.Rows("1 20", "2 20"))
+	require.NoError(t, failpoint.Disable(fpName))
+
+	// Inapplicable hints.
+	tk.MustQuery("select a from t1 where exists (select /*+ max_execution_time(100) */ 1 from t2) order by a").Check(testkit.Rows("1", "2"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 MAX_EXECUTION_TIME() in the subquery is inapplicable because it's not a scalar subquery."))
+	tk.MustQuery("select a, (select /*+ max_execution_time(100) */ b from t2 where t2.a = t1.a) from t1 order by a").Check(testkit.Rows("1 10", "2 20"))
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 MAX_EXECUTION_TIME() in the subquery is inapplicable because the subquery isn't evaluated separately."))
+}
+
+func TestReadFromStorageFallbackPolicy(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ia(a))")
+
+	// Create virtual tiflash replica info which is not available.
+	dom := domain.GetDomain(tk.Session())
+	is := dom.InfoSchema()
+	tbl, err := is.TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	tbl.Meta().TiFlashReplica = &model.TiFlashReplicaInfo{
+		Count:     1,
+		Available: false,
+	}
+
+	errMsg := "No available path for table test.t with the store type tiflash of the hint /*+ read_from_storage */"
+	checkFallbackWarning := func() {
+		warnings := tk.Session().GetSessionVars().StmtCtx.GetWarnings()
+		require.Len(t, warnings, 1)
+		require.Contains(t, warnings[0].Err.Error(), errMsg)
+	}
+
+	// WARN: the hint is ignored and the table is read from TiKV.
+	tk.MustQuery("select @@tidb_read_from_storage_fallback_policy").Check(testkit.Rows("WARN"))
+	tk.MustQuery("select /*+ read_from_storage(tiflash[t]) */ * from t").Check(testkit.Rows())
+	checkFallbackWarning()
+
+	// ERROR: the statement fails.
+	tk.MustExec("set @@tidb_read_from_storage_fallback_policy = 'ERROR'")
+	tk.MustGetErrCode("select /*+ read_from_storage(tiflash[t]) */ * from t", errno.ErrTiFlashReplicaUnavailable)
+	require.ErrorContains(t, tk.ExecToErr("select /*+ read_from_storage(tiflash[t]) */ * from t"), errMsg)
+	// The policy can be set for a single statement.
+	tk.MustExec("set @@tidb_read_from_storage_fallback_policy = 'WARN'")
+	tk.MustGetErrCode("select /*+ set_var(tidb_read_from_storage_fallback_policy = 'ERROR') read_from_storage(tiflash[t]) */ * from t", errno.ErrTiFlashReplicaUnavailable)
+
+	// WAIT: the statement fails after the timeout.
+	tk.MustExec("set @@tidb_read_from_storage_fallback_policy = 'WAIT'")
+	tk.MustExec("set @@tidb_read_from_storage_fallback_wait_timeout = 1")
+	start := time.Now()
+	tk.MustGetErrCode("select /*+ read_from_storage(tiflash[t]) */ * from t", errno.ErrTiFlashReplicaUnavailable)
+	require.GreaterOrEqual(t, time.Since(start), 500*time.Millisecond)
+	// Only the execution waits, the planning does not.
+	start = time.Now()
+	tk.MustQuery("explain format = 'brief' select /*+ read_from_storage(tiflash[t]) */ * from t").CheckContain("tiflash")
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+
+	// WAIT fails fast if tiflash is not in the isolation read engines.
+	tk.MustExec("set @@tidb_isolation_read_engines = 'tikv'")
+	start = time.Now()
+	tk.MustGetErrCode("select /*+ read_from_storage(tiflash[t]) */ * from t", errno.ErrTiFlashReplicaUnavailable)
+	require.Less(t, time.Since(start), 500*time.Millisecond)
+
+	// The wait timeout is kept short.
+	tk.MustExec("set @@tidb_read_from_storage_fallback_wait_timeout = 3600")
+	tk.MustQuery("select @@tidb_read_from_storage_fallback_wait_timeout").Check(testkit.Rows("60"))
+	require.Error(t, tk.ExecToErr("set @@tidb_read_from_storage_fallback_policy = 'IGNORE'"))
+}
+
+func TestTraceHints(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int, key(a))")
+	tk.MustExec("create table t2(a int, b int, key(a))")
+
+	query := "select /*+ hash_join(t1) full_scan(test2.t2) merge_join(t2@sel_2) */ * from t1, t2 where t1.a = t2.a and t1.b in (select b from t2)"
+	tk.MustQuery(query).Check(testkit.Rows())
+	require.Empty(t, tk.Session().GetSessionVars().StmtCtx.HintMatchTraces)
+
+	tk.MustExec("set @@tidb_opt_trace_hints = 1")
+	tk.MustQuery(query).Check(testkit.Rows())
+	traces := tk.Session().GetSessionVars().StmtCtx.HintMatchTraces
+	require.Contains(t, traces, "hash_join(t1) matched test.t1 in the query block 1")
+	require.Contains(t, traces, "full_scan(t2) skipped test.t2 in the query block 1 since the database is test2")
+	require.Contains(t, traces, "merge_join(t2@sel_2) skipped test.t2 in the query block 1 since the hint is for the query block 2")
+	for _, trace := range traces {
+		// Only the tables with the same name as the hinted tables are traced.
+		require.NotContains(t, trace, "hash_join(t1) skipped test.t2")
+	}
+
+	tk.MustQuery("select /*+ leading(t2, t1) */ * from t1, t2 where t1.a = t2.a").Check(testkit.Rows())
+	require.Contains(t, tk.Session().GetSessionVars().StmtCtx.HintMatchTraces, "leading(t2) matched test.t2 in the query block 1")
+}
+
+func TestViewWithHints(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int, key(a))")
+	tk.MustExec("create table t2(a int, b int, key(a))")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2)")
+	tk.MustExec("insert into t2 values (1, 1), (3, 3)")
+
+	tk.MustExec("create definer='root'@'localhost' view v with hints '/*+ merge_join(t1, t2) */' as select t1.a, t2.b from t1 join t2 on t1.a = t2.a")
+	tk.MustQuery("show create view v").Check(testkit.Rows(
+		"v CREATE ALGORITHM=UNDEFINED DEFINER=`root`@`localhost` SQL SECURITY DEFINER VIEW `v` (`a`, `b`) WITH HINTS 'merge_join(`t1`, `t2`)' AS SELECT `t1`.`a` AS `a`,`t2`.`b` AS `b` FROM `test`.`t1` JOIN `test`.`t2` ON `t1`.`a`=`t2`.`a` utf8mb4 utf8mb4_bin"))
+	tk.MustQuery("select * from v").Check(testkit.Rows("1 1"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	tk.MustHavePlan("select * from v", "MergeJoin")
+
+	// The hints of the view are merged with the hints in the view definition.
+	tk.MustExec("create or replace definer='root'@'localhost' view v with hints 'inl_join(t2)' as select /*+ no_index_join(t1) */ t1.a, t2.b from t1 join t2 on t1.a = t2.a")
+	tk.MustQuery("select * from v").Check(testkit.Rows("1 1"))
+	tk.MustHavePlan("select * from v", "IndexJoin")
+	tk.MustExec("create or replace definer='root'@'localhost' view v with hints 'inl_join(t2)' as select t1.a, t2.b from t1 join t2 on t1.a = t2.a union all select a, b from t1")
+	tk.MustQuery("select * from v order by a").Check(testkit.Rows("1 1", "1 1", "2 2"))
+	tk.MustHavePlan("select * from v", "IndexJoin")
+
+	// The hints are validated when the view is created.
+	require.ErrorContains(t, tk.ExecToErr("create view v2 with hints 'merge_join(t1' as select * from t1"), "Optimizer hint syntax error")
+	require.ErrorContains(t, tk.ExecToErr("create view v2 with hints '/*+ */' as select * from t1"), "Optimizer hint syntax error")
+}
+
+func TestHintsForSetOprBranches(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int, key(a))")
+	tk.MustExec("create table t2(a int, b int, key(a))")
+
+	// `union_2` refers to the second branch even if the first branch has a subquery.
+	tk.MustHavePlan("select a from t1 where b in (select b from t1) union all select a from t2 where a = 1", "IndexRangeScan")
+	sql := "select /*+ ignore_index(@union_2 t2, a) */ a from t1 where b in (select b from t1) union all select a from t2 where a = 1"
+	tk.MustNotHavePlan(sql, "IndexRangeScan")
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	tk.MustHavePlan("select /*+ merge_join(@union_2 t1, t2) */ a from t1 intersect select t1.a from t1, t2 where t1.a = t2.a", "MergeJoin")
+
+	tk.MustExec("select /*+ use_index(@union_3 t2, a) */ a from t1 union select a from t2")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 8072 Hint use_index(@`union_3` `t2` `a`) is ignored due to unknown query block name"))
+}
+
+func TestReadFromStorageHintForQueryBlocks(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ia(a))")
+
+	// Create virtual tiflash replica info.
+	dom := domain.GetDomain(tk.Session())
+	tbl, err := dom.InfoSchema().TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	tbl.Meta().TiFlashReplica = &model.TiFlashReplicaInfo{
+		Count:     1,
+		Available: true,
+	}
+
+	// storesOfScans returns the stores of the table scans and index scans in the plan.
+	storesOfScans := func(sql string) []string {
+		var stores []string
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			id := row[0].(string)
+			if strings.Contains(id, "TableFullScan") || strings.Contains(id, "TableRangeScan") || strings.Contains(id, "IndexRangeScan") {
+				task := row[2].(string)
+				stores = append(stores, task[strings.Index(task, "[")+1:len(task)-1])
+			}
+		}
+		return stores
+	}
+
+	// The same table is read from TiKV in the first query block and from TiFlash in the second one.
+	for _, sql := range []string{
+		"select /*+ read_from_storage(tikv[t@sel_1], tiflash[t@sel_2]) */ * from t where a = 1 union all select * from t where b > 1",
+		"select /*+ read_from_storage(tikv[t], tiflash[t@union_2]) */ * from t where a = 1 union all select * from t where b > 1",
+		"select /*+ read_from_storage(tikv[t]) read_from_storage(@sel_2 tiflash[t]) */ * from t where a = 1 union all select * from t where b > 1",
+	} {
+		require.Equal(t, []string{"tikv", "tiflash"}, storesOfScans(sql), sql)
+		tk.MustQuery("show warnings").Check(testkit.Rows())
+	}
+	require.Equal(t, []string{"tiflash", "tikv"},
+		storesOfScans("select /*+ read_from_storage(tiflash[t@sel_1], tikv[t@sel_2]) */ * from t where a = 1 union all select * from t where b > 1"))
+
+	// The storage hints for the same table in the same query block are still conflicting.
+	tk.MustExec("explain select /*+ read_from_storage(tikv[t], tiflash[t]) */ * from t")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 1815 Storage hints are conflict, you can only specify one storage type of table test.t"))
+}
+
+func TestMySQLIndexLevelHints(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, key ia(a), key ib(b))")
+
+	tk.MustHavePlan("select /*+ index(t, ib) */ * from t where a = 1", "IndexFullScan")
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	tk.MustNotHavePlan("select /*+ no_index(t, ia) */ * from t where a = 1", "IndexRangeScan")
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+
+	// GROUP_INDEX and JOIN_INDEX are like FORCE INDEX FOR GROUP BY and FOR JOIN, which don't affect the plan.
+	for _, h := range []string{"group_index", "no_group_index", "join_index", "no_join_index"} {
+		tk.MustHavePlan(fmt.Sprintf("select /*+ %s(t, ia) */ * from t where a = 1", h), "IndexRangeScan")
+		tk.MustQuery("show warnings").Check(testkit.Rows(fmt.Sprintf(
+			"Warning 8073 %s() is accepted for compatibility with MySQL but doesn't affect the plan", strings.ToUpper(h))))
+	}
+}
+
+func TestHintWarningsOfPartitions(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int, b int, key ia(a)) partition by hash(a) partitions 32")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'static'")
+
+	// The warnings of the same hint on the same table are aggregated for all the partitions.
+	tk.MustExec("select /*+ use_index(t, nonexist) */ * from t")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1176 Key 'nonexist' doesn't exist in table 't'",
+		"Warning 1176 use_index hint is inapplicable for 32 partitions of t: Key 'nonexist' doesn't exist in table 't'"))
+	tk.MustExec("select /*+ use_index(t partition(p0, p1, p2), nonexist) */ * from t")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1176 Key 'nonexist' doesn't exist in table 't'",
+		"Warning 1176 use_index hint is inapplicable for 3 partitions of t: Key 'nonexist' doesn't exist in table 't'"))
+	tk.MustExec("select /*+ use_index_merge(t, ia) */ * from t where b = 1")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1105 use_index_merge hint is inapplicable for 32 partitions of t: IndexMerge is inapplicable"))
+	tk.MustExec("select /*+ index_only(t) */ * from t")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 1815 index_only hint is inapplicable for 32 partitions of t: " +
+			"No available path for table test.t satisfies the hint /*+ index_only */, the hint is ignored"))
+
+	// The warnings of the hints which refer to different unknown query blocks are aggregated.
+	tk.MustExec("select /*+ use_index(@sel_3 t, ia) use_index(@sel_4 t, ia) use_index(@sel_5 t, ia) */ * from t")
+	tk.MustQuery("show warnings").Check(testkit.Rows(
+		"Warning 8072 use_index hint is inapplicable for 3 query blocks of t: Hint use_index(@`sel_3` `t` `ia`) is ignored due to unknown query block name"))
+}
@@ -85,6 +85,18 @@ func TestInvisibleIndex(t *testing.T) {
 	tk.MustExec("use test")
 	tk.MustExec("CREATE TABLE t1 ( a INT, KEY( a ) INVISIBLE );")
 	tk.MustExec("INSERT INTO t1 VALUES (1), (2), (3), (4), (5), (6), (7), (8), (9), (10);")
+	tk.MustQuery(`EXPLAIN SELECT a FROM t1;`).Check(
+		testkit.Rows(
+			`TableReader_5 10000.00 root  data:TableFullScan_4`,
+			`└─TableFullScan_4 10000.00 cop[tikv] table:t1 keep order:false, stats:pseudo`))
+	tk.MustQuery(`EXPLAIN SELECT /*+ use_invisible_indexes() */ a FROM t1;`).Check(
+		testkit.Rows(
+			`IndexReader_7 10000.00 root  index:IndexFullScan_6`,
+			`└─IndexFullScan_6 10000.00 cop[tikv] table:t1, invisible index:a(a) keep order:false, stats:pseudo`))
+	tk.MustQuery(`SELECT /*+ use_invisible_indexes() */ a FROM t1 WHERE a > 8;`).Sort().Check(testkit.Rows("10", "9"))
+	tk.MustQuery(`SELECT /*+ use_invisible_indexes() use_invisible_indexes() */ a FROM t1 WHERE a = 1;`).Check(testkit.Rows("1"))
+	tk.MustQuery("SHOW WARNINGS").Check(testkit.Rows("Warning 3126 USE_INVISIBLE_INDEXES() is defined more than once, only the last definition takes effect"))
+	// The hint only takes effect for the current statement.
 	tk.MustQuery(`EXPLAIN SELECT a FROM t1;`).Check(
 		testkit.Rows(
 			`TableReader_5 10000.00 root  data:TableFullScan_4`,
@@ -93,5 +105,51 @@ func TestInvisibleIndex(t *testing.T) {
 	tk.MustQuery(`EXPLAIN SELECT a FROM t1;`).Check(
 		testkit.Rows(
 			`IndexReader_7 10000.00 root  index:IndexFullScan_6`,
-			`└─IndexFullScan_6 10000.00 cop[tikv] table:t1, index:a(a) keep order:false, stats:pseudo`))
+			`└─IndexFullScan_6 10000.00 cop[tikv] table:t1, invisible index:a(a) keep order:false, stats:pseudo`))
+
+	tk.MustExec("set session tidb_opt_use_invisible_indexes=off;")
+	tk.MustExec("CREATE TABLE t2 ( a INT, b INT, UNIQUE KEY ua( a ) INVISIBLE );")
+	tk.MustExec("INSERT INTO t2 VALUES (1, 1), (2, 2);")
+	tk.MustQuery(`EXPLAIN FORMAT = 'brief' SELECT /*+ use_invisible_indexes() */ * FROM t2 WHERE a = 1;`).Check(
+		testkit.Rows(`Point_Get 1.00 root table:t2, invisible index:ua(a) `))
+	tk.MustQuery(`SELECT /*+ use_invisible_indexes() */ * FROM t2 WHERE a = 1;`).Check(testkit.Rows("1 1"))
+	tk.MustHavePlan(`SELECT * FROM t2 WHERE a = 1;`, "TableFullScan")
+}
+
+func TestIgnoredIndexes(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int, unique key ua(a), key ib(b))")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2)")
+
+	tk.MustHavePlan("select * from t1 where a = 1", "Point_Get")
+	tk.MustHavePlan("select * from t1 where a in (1, 2)", "Batch_Point_Get")
+	tk.MustHavePlan("select * from t1 where b = 1", "IndexRangeScan")
+
+	tk.MustExec("set @@tidb_opt_ignored_indexes = 't1.ua, test.t1.ib'")
+	tk.MustHavePlan("select * from t1 where a = 1", "TableFullScan")
+	tk.MustHavePlan("select * from t1 where a in (1, 2)", "TableFullScan")
+	tk.MustHavePlan("select * from t1 where b = 1", "TableFullScan")
+	tk.MustQuery("select * from t1 where a = 1").Check(testkit.Rows("1 1"))
+	// the ignored indexes can't be used by the hints, just like the dropped ones.
+	tk.MustHavePlan("select /*+ use_index(t1, ib) */ * from t1 where b = 1", "TableFullScan")
+	tk.MustQuery("show warnings").CheckContain("ib")
+
+	// the indexes of the other databases are not affected.
+	tk.MustExec("create database db2")
+	tk.MustExec("create table db2.t1(a int, b int, unique key ua(a), key ib(b))")
+	tk.MustHavePlan("select * from db2.t1 where a = 1", "Point_Get")
+	tk.MustHavePlan("select * from db2.t1 where b = 1", "IndexRangeScan")
+
+	// the cached plans are not reused after the variable is changed.
+	tk.MustExec("set @@tidb_opt_ignored_indexes = ''")
+	tk.MustExec("prepare stmt from 'select * from t1 where b = ?'")
+	tk.MustExec("set @b = 1")
+	tk.MustQuery("execute stmt using @b").Check(testkit.Rows("1 1"))
+	tk.MustQuery("execute stmt using @b").Check(testkit.Rows("1 1"))
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	tk.MustExec("set @@tidb_opt_ignored_indexes = 't1.ib'")
+	tk.MustQuery("execute stmt using @b").Check(testkit.Rows("1 1"))
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("0"))
 }
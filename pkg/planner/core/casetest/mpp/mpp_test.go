@@ -1032,3 +1032,45 @@ func TestIssue52828(t *testing.T) {
 	tk.MustQuery("explain SELECT MAX( OUTR . col_int ) AS X FROM C AS OUTR2 INNER JOIN B AS OUTR ON ( OUTR2 . col_decimal_not_null = OUTR . col_decimal_not_null AND OUTR2 . pk = OUTR . col_int_not_null ) " +
 		"WHERE OUTR . col_decimal_not_null IN ( SELECT INNR . col_int_not_null + 1 AS Y FROM DD AS INNR WHERE INNR . pk > INNR . pk OR INNR . col_varchar_10_not_null >= INNR . col_varchar_10 ) GROUP BY OUTR . col_datetime_not_null")
 }
+
+func TestMppExchangeCompressionHint(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a bigint, b bigint)")
+	tk.MustExec("set @@tidb_allow_mpp=1; set @@tidb_enforce_mpp=1")
+	tk.MustExec("set @@tidb_isolation_read_engines = 'tiflash'")
+	tk.MustExec("set @@mpp_exchange_compression_mode = none")
+
+	// Create virtual tiflash replica info.
+	is := dom.InfoSchema()
+	tbl, err := is.TableByName(model.NewCIStr("test"), model.NewCIStr("t"))
+	require.NoError(t, err)
+	tbl.Meta().TiFlashReplica = &model.TiFlashReplicaInfo{
+		Count:     1,
+		Available: true,
+	}
+
+	hasCompression := func(sql, mode string) bool {
+		for _, row := range tk.MustQuery(sql).Rows() {
+			if strings.Contains(row[len(row)-1].(string), "Compression: "+mode) {
+				return true
+			}
+		}
+		return false
+	}
+	require.False(t, hasCompression("explain format = 'brief' select count(*) from t group by a, b", ""))
+	require.True(t, hasCompression("explain format = 'brief' select /*+ mpp_exchange_compression(high_compression) */ count(*) from t group by a, b", "HIGH_COMPRESSION"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	// the variable is restored after the statement.
+	tk.MustQuery("select @@mpp_exchange_compression_mode").Check(testkit.Rows("none"))
+
+	// the hint conflicts with SET_VAR.
+	require.True(t, hasCompression("explain format = 'brief' select /*+ set_var(mpp_exchange_compression_mode=fast) mpp_exchange_compression(high_compression) */ count(*) from t group by a, b", "FAST"))
+	tk.MustQuery("show warnings").CheckContain("mpp_exchange_compression(high_compression)")
+
+	// invalid mode is ignored with a warning.
+	require.False(t, hasCompression("explain format = 'brief' select /*+ mpp_exchange_compression(zstd) */ count(*) from t group by a, b", ""))
+	tk.MustQuery("show warnings").CheckContain("zstd")
+}
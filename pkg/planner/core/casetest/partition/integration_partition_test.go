@@ -19,6 +19,7 @@ import (
 	"testing"
 
 	"github.com/pingcap/failpoint"
+	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/testkit/testdata"
 	"github.com/stretchr/testify/require"
@@ -246,3 +247,108 @@ func TestBatchPointGetPartitionForAccessObject(t *testing.T) {
 		tk.MustQuery(tt).Check(testkit.Rows(output[i].Plan...))
 	}
 }
+
+func TestGlobalIndexAccessPaths(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@tidb_enable_global_index = 1")
+	tk.MustExec("set @@tidb_partition_prune_mode = 'dynamic'")
+	tk.MustExec("create table t(a int, b int, c int, unique key ua(a), key ib(b)) partition by hash(b) partitions 4")
+	tk.MustExec("insert into t values (1, 1, 1), (2, 2, 2), (3, 3, 3), (4, 4, 4), (5, 5, 5)")
+	tk.MustExec("create table t2(a int, b int, key ia(a))")
+	tk.MustExec("insert into t2 values (1, 1), (3, 3), (5, 5), (7, 7)")
+	tk.MustExec("analyze table t, t2")
+
+	// Point get and batch point get can use the global index across partitions.
+	tk.MustHavePlan("select * from t where a = 3", "Point_Get")
+	tk.MustQuery("select * from t where a = 3").Check(testkit.Rows("3 3 3"))
+	tk.MustHavePlan("select * from t where a in (1, 3, 6)", "Batch_Point_Get")
+	tk.MustQuery("select * from t where a in (1, 3, 6)").Sort().Check(testkit.Rows("1 1 1", "3 3 3"))
+	tk.MustHavePlan("select * from t where a in (1, 3, 5) order by a desc", "Batch_Point_Get")
+	tk.MustQuery("select * from t where a in (1, 3, 5) order by a desc").Check(testkit.Rows("5 5 5", "3 3 3", "1 1 1"))
+	tk.MustQuery("select * from t partition(p1) where a in (1, 2, 5)").Sort().Check(testkit.Rows("1 1 1", "5 5 5"))
+
+	// The global index keeps the order across partitions, so it can be the inner side of index merge join.
+	tk.MustExec("set @@tidb_enable_index_merge_join = 1")
+	sql := "select /*+ inl_merge_join(t) */ t.* from t2 join t on t2.a = t.a"
+	tk.MustHavePlan(sql, "IndexMergeJoin")
+	tk.MustQuery(sql).Sort().Check(testkit.Rows("1 1 1", "3 3 3", "5 5 5"))
+	sql = "select /*+ inl_join(t) use_index(t, ua) */ t.* from t2 join t on t2.a = t.a"
+	tk.MustHavePlan(sql, "IndexJoin")
+	tk.MustQuery(sql).Sort().Check(testkit.Rows("1 1 1", "3 3 3", "5 5 5"))
+
+	// Index merge can mix the global index and the local index.
+	sql = "select /*+ use_index_merge(t, ua, ib) */ * from t where a = 1 or b = 4"
+	tk.MustHavePlan(sql, "IndexMerge")
+	require.True(t, tk.MustUseIndex(sql, "ua"))
+	tk.MustQuery(sql).Sort().Check(testkit.Rows("1 1 1", "4 4 4"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	sql = "select /*+ use_index_merge(t, ua, ib) */ * from t where a in (1, 2, 3) or b = 4"
+	tk.MustHavePlan(sql, "IndexMerge")
+	require.True(t, tk.MustUseIndex(sql, "ua"))
+	tk.MustQuery(sql).Sort().Check(testkit.Rows("1 1 1", "2 2 2", "3 3 3", "4 4 4"))
+	// The rows read by the global index are filtered by the pruned partitions.
+	tk.MustQuery("select /*+ use_index_merge(t, ua, ib) */ * from t partition(p1) where a in (1, 2, 3) or b = 4").Check(testkit.Rows("1 1 1"))
+	sql = "select /*+ use_index_merge(t, ua, ib) */ * from t where a between 2 and 4 and b between 1 and 3"
+	tk.MustHavePlan(sql, "IndexMerge")
+	require.True(t, tk.HasKeywordInOperatorInfo(sql, "type: intersection"))
+	tk.MustQuery(sql).Sort().Check(testkit.Rows("2 2 2", "3 3 3"))
+}
+
+func TestCheckConstraintPruning(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("set @@global.tidb_enable_check_constraint = 1")
+	defer tk.MustExec("set @@global.tidb_enable_check_constraint = 0")
+	tk.MustExec("create table t(a int not null check (a >= 100), b int) partition by range(a) " +
+		"(partition p0 values less than (50), partition p1 values less than (100), partition p2 values less than (200), partition p3 values less than maxvalue)")
+	tk.MustExec("insert into t values (100, 1), (150, 2), (300, 3)")
+
+	// The predicates contradicting the check constraint are folded into a TableDual.
+	tk.MustHavePlan("select * from t where a < 50", "TableDual")
+	tk.MustQuery("select * from t where a < 50").Check(testkit.Rows())
+
+	// The partitions excluded by the check constraint are pruned even without any predicates.
+	rows := tk.MustQuery("explain format = 'brief' select * from t").Rows()
+	for _, row := range rows {
+		accessObject := row[3].(string)
+		require.NotContains(t, accessObject, "p0")
+		require.NotContains(t, accessObject, "p1")
+	}
+	tk.MustQuery("select * from t where b > 1").Sort().Check(testkit.Rows("150 2", "300 3"))
+
+	// The not enforced check constraints are ignored.
+	tk.MustExec("create table t2(a int check (a > 0) not enforced)")
+	tk.MustExec("insert into t2 values (-1)")
+	tk.MustQuery("select * from t2 where a < 0").Check(testkit.Rows("-1"))
+	tk.MustGetErrMsg("alter table t2 alter constraint t2_chk_1 enforced", "[ddl:3819]Check constraint 't2_chk_1' is violated.")
+	tk.MustQuery("select * from t2 where a < 0").Check(testkit.Rows("-1"))
+
+	// The check constraint is used after the existing rows are validated when it's enforced.
+	tk.MustExec("create table t3(a int check (a > 0) not enforced)")
+	tk.MustExec("insert into t3 values (1)")
+	tk.MustExec("alter table t3 alter constraint t3_chk_1 enforced")
+	tk.MustHavePlan("select * from t3 where a < 0", "TableDual")
+	tk.MustExec("alter table t3 alter constraint t3_chk_1 not enforced")
+	tk.MustExec("insert into t3 values (-1)")
+	tk.MustQuery("select * from t3 where a < 0").Check(testkit.Rows("-1"))
+
+	// The check constraint added with the column is used as well.
+	tk.MustExec("alter table t3 add column b int default 1 check (b > 0)")
+	tk.MustHavePlan("select * from t3 where b < 0", "TableDual")
+
+	// The rows written when tidb_enable_check_constraint is off are still checked, so the check constraint is kept
+	// validated after the variable is turned on again.
+	tk.MustExec("create table t4(a int not null check (a >= 100), b int) partition by range(a) " +
+		"(partition p0 values less than (200), partition p1 values less than maxvalue)")
+	tk.MustExec("create table nt(a int not null, b int)")
+	tk.MustExec("insert into nt values (1, 1)")
+	tk.MustExec("set @@global.tidb_enable_check_constraint = 0")
+	tk.MustGetErrCode("insert into t4 values (1, 1)", errno.ErrCheckConstraintViolated)
+	tk.MustGetErrCode("alter table t4 exchange partition p0 with table nt", errno.ErrRowDoesNotMatchPartition)
+	tk.MustExec("set @@global.tidb_enable_check_constraint = 1")
+	tk.MustHavePlan("select * from t4 where a < 100", "TableDual")
+	tk.MustQuery("select * from t4 where a < 100").Check(testkit.Rows())
+}
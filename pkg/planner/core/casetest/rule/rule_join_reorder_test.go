@@ -15,6 +15,7 @@
 package rule
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/pingcap/failpoint"
@@ -113,3 +114,40 @@ func TestJoinOrderHint4DynamicPartitionTable(t *testing.T) {
 	tk.MustExec("set @@tidb_enable_outer_join_reorder=true")
 	runJoinReorderTestData(t, tk, "TestJoinOrderHint4DynamicPartitionTable")
 }
+
+func TestLeadingHintWithSemiJoin(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int, key(a));")
+	tk.MustExec("create table t2(a int, b int, key(a));")
+	tk.MustExec("create table t3(a int, b int, key(a));")
+	tk.MustExec("insert into t1 values (1, 1), (2, 2), (3, 3), (4, null);")
+	tk.MustExec("insert into t2 values (1, 1), (2, 2), (4, 4);")
+	tk.MustExec("insert into t3 values (1, 1), (3, 3), (4, 4);")
+
+	// The tables of the unnested subquery can be specified by the leading hint.
+	tk.MustQuery("select /*+ leading(t1, t3@sel_2) */ t1.a from t1 join t2 on t1.a = t2.a where exists (select 1 from t3 where t3.a = t1.a) order by t1.a").
+		Check(testkit.Rows("1", "4"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	tk.MustQuery("select /*+ leading(t2, t1, t3@sel_2) */ t1.a from t1 join t2 on t1.a = t2.a where t1.a in (select t3.a from t3 where t3.b = t1.b) order by t1.a").
+		Check(testkit.Rows("1"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+	tk.MustQuery("select /*+ leading(t1, t3@sel_2) */ t1.a from t1 join t2 on t1.a = t2.a where not exists (select 1 from t3 where t3.a = t1.a) order by t1.a").
+		Check(testkit.Rows("2"))
+	tk.MustQuery("show warnings").Check(testkit.Rows())
+
+	// The semi join is joined before the inner join specified by the leading hint.
+	rows := tk.MustQuery("explain format = 'brief' select /*+ leading(t1, t3@sel_2) */ t1.a from t1 join t2 on t1.a = t2.a where exists (select 1 from t3 where t3.a = t1.a)").Rows()
+	var joins []string
+	for _, row := range rows {
+		if info := row[4].(string); strings.HasPrefix(info, "semi join") || strings.HasPrefix(info, "inner join") {
+			joins = append(joins, strings.Fields(info)[0])
+		}
+	}
+	require.Equal(t, []string{"inner", "semi"}, joins)
+
+	// The semi join whose conditions are related with multiple tables of its outer side is not reordered.
+	tk.MustQuery("select /*+ leading(t1, t3@sel_2) */ t1.a from t1 join t2 on t1.a = t2.a where exists (select 1 from t3 where t3.a = t1.a and t3.b = t2.b) order by t1.a").
+		Check(testkit.Rows("1", "4"))
+}
@@ -17,8 +17,11 @@ package casetest
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 	"testing"
 
+	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/planner/core"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
@@ -152,3 +155,113 @@ func TestNDVGroupCols(t *testing.T) {
 		tk.MustQuery("explain format = 'brief' " + tt).Check(testkit.Rows(output[i].Plan...))
 	}
 }
+
+func TestFKCardinalityEstimation(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists child, parent")
+	tk.MustExec("create table parent(id int primary key, v int)")
+	tk.MustExec("create table child(id int primary key, pid int, key(pid), foreign key fk_pid(pid) references parent(id))")
+	parentValues := make([]string, 0, 100)
+	childValues := make([]string, 0, 1000)
+	for i := 0; i < 100; i++ {
+		parentValues = append(parentValues, fmt.Sprintf("(%d, %d)", i, i))
+	}
+	for i := 0; i < 1000; i++ {
+		childValues = append(childValues, fmt.Sprintf("(%d, %d)", i, i%100))
+	}
+	tk.MustExec("insert into parent values " + strings.Join(parentValues, ","))
+	tk.MustExec("insert into child values " + strings.Join(childValues, ","))
+	tk.MustExec("analyze table parent, child")
+
+	joinEstRows := func(sql string) float64 {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), "Join") {
+				estRows, err := strconv.ParseFloat(row[1].(string), 64)
+				require.NoError(t, err)
+				return estRows
+			}
+		}
+		require.FailNow(t, "no join in the plan", sql)
+		return 0
+	}
+	for _, sql := range []string{
+		"select /*+ hash_join(child, parent) */ * from child join parent on child.pid = parent.id where parent.v < 10",
+		"select /*+ hash_join(child, parent) */ * from parent join child on parent.id = child.pid where parent.v < 10",
+	} {
+		tk.MustExec("set @@tidb_opt_enable_fk_cardinality_estimation = off")
+		before := joinEstRows(sql)
+		tk.MustExec("set @@tidb_opt_enable_fk_cardinality_estimation = on")
+		after := joinEstRows(sql)
+		// Each child row matches at most one parent row, and about 10% of the parent rows are kept.
+		require.LessOrEqual(t, after, before, sql)
+		require.InDelta(t, 100, after, 10, sql)
+	}
+
+	// The foreign key doesn't bound the join which is not on the foreign key columns.
+	sql := "select /*+ hash_join(child, parent) */ * from child join parent on child.id = parent.v"
+	tk.MustExec("set @@tidb_opt_enable_fk_cardinality_estimation = off")
+	before := joinEstRows(sql)
+	tk.MustExec("set @@tidb_opt_enable_fk_cardinality_estimation = on")
+	require.Equal(t, before, joinEstRows(sql))
+}
+
+func TestTemporaryTableStats(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists gtmp")
+	tk.MustExec("create temporary table ltmp(a int, b int)")
+	tk.MustExec("create global temporary table gtmp(a int, b int) on commit delete rows")
+
+	estRows := func(sql string, op string) float64 {
+		for _, row := range tk.MustQuery("explain format = 'brief' " + sql).Rows() {
+			if strings.Contains(row[0].(string), op) {
+				estRows, err := strconv.ParseFloat(row[1].(string), 64)
+				require.NoError(t, err)
+				return estRows
+			}
+		}
+		require.FailNow(t, "operator not found in the plan", "%s %s", sql, op)
+		return 0
+	}
+	values := make([]string, 0, 20)
+	for i := 0; i < 20; i++ {
+		values = append(values, fmt.Sprintf("(%d, %d)", i%4, i))
+	}
+
+	// The stats of the local temporary table are kept across transactions.
+	tk.MustExec("insert into ltmp values " + strings.Join(values, ","))
+	require.Equal(t, float64(20), estRows("select * from ltmp", "TableFullScan"))
+	require.Equal(t, float64(4), estRows("select a from ltmp group by a", "Agg"))
+	tk.MustExec("delete from ltmp where b < 10")
+	require.Equal(t, float64(10), estRows("select * from ltmp", "TableFullScan"))
+	tk.MustExec("begin")
+	tk.MustExec("insert into ltmp values (100, 100)")
+	require.Equal(t, float64(11), estRows("select * from ltmp", "TableFullScan"))
+	tk.MustExec("rollback")
+	require.Equal(t, float64(10), estRows("select * from ltmp", "TableFullScan"))
+	tk.MustExec("truncate table ltmp")
+	require.Equal(t, float64(10000), estRows("select * from ltmp", "TableFullScan"))
+
+	// The stats updated by a failed statement are rolled back.
+	tk.MustExec("create temporary table ltmp2(a int primary key)")
+	tk.MustExec("insert into ltmp2 values (1)")
+	tk.MustGetErrCode("insert into ltmp2 values (2), (3), (1)", errno.ErrDupEntry)
+	require.Equal(t, float64(1), estRows("select * from ltmp2", "TableFullScan"))
+	tk.MustExec("begin")
+	tk.MustExec("insert into ltmp2 values (2)")
+	tk.MustGetErrCode("insert into ltmp2 values (3), (4), (1)", errno.ErrDupEntry)
+	require.Equal(t, float64(2), estRows("select * from ltmp2", "TableFullScan"))
+	tk.MustExec("commit")
+	require.Equal(t, float64(2), estRows("select * from ltmp2", "TableFullScan"))
+
+	// The stats of the global temporary table are only kept in the transaction.
+	tk.MustExec("begin")
+	tk.MustExec("insert into gtmp values " + strings.Join(values, ","))
+	require.Equal(t, float64(20), estRows("select * from gtmp", "TableFullScan"))
+	require.Equal(t, float64(4), estRows("select a from gtmp group by a", "Agg"))
+	tk.MustExec("commit")
+	require.Equal(t, float64(10000), estRows("select * from gtmp", "TableFullScan"))
+}
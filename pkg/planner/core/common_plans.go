@@ -591,6 +591,20 @@ type ImportInto struct {
 	Stmt    string
 
 	SelectPlan base.PhysicalPlan
+	// SourceDSN and SourceQuery are set when importing from the query result
+	// of another MySQL-protocol server, SelectPlan is nil in this case.
+	SourceDSN   string
+	SourceQuery string
+}
+
+// ExportTable represents an export table plan.
+type ExportTable struct {
+	baseSchemaProducer
+
+	Table   *ast.TableName
+	Path    string
+	Format  string
+	Options []*LoadDataOpt
 }
 
 // LoadStats represents a load stats plan.
@@ -623,10 +637,11 @@ type PlanReplayer struct {
 	File              string
 	HistoricalStatsTS uint64
 
-	Capture    bool
-	Remove     bool
-	SQLDigest  string
-	PlanDigest string
+	Capture      bool
+	Remove       bool
+	SQLDigest    string
+	PlanDigest   string
+	CaptureLimit uint64
 }
 
 // IndexAdvise represents a index advise plan.
@@ -682,10 +697,22 @@ type SelectInto struct {
 	baseSchemaProducer
 
 	TargetPlan base.Plan
-	IntoOpt    *ast.SelectIntoOption
+	// TargetNames are the output names of the target plan, the physical plan
+	// may not keep them.
+	TargetNames types.NameSlice
+	IntoOpt     *ast.SelectIntoOption
+	// Format is the format of the outfile in lower case.
+	Format string
 	LineFieldsInfo
 }
 
+const (
+	// SelectIntoFormatCSV is the default format of SELECT INTO OUTFILE.
+	SelectIntoFormatCSV = "csv"
+	// SelectIntoFormatParquet is the parquet format of SELECT INTO OUTFILE.
+	SelectIntoFormatParquet = "parquet"
+)
+
 // LineFieldsInfo used in load-data/select-into/index-advise stmt.
 type LineFieldsInfo struct {
 	FieldsTerminatedBy string
@@ -749,6 +776,10 @@ type ExplainInfoForEncode struct {
 
 // JSONToString convert json to string
 func JSONToString(j []*ExplainInfoForEncode) (string, error) {
+	return encodeExplainJSON(j)
+}
+
+func encodeExplainJSON(j any) (string, error) {
 	byteBuffer := bytes.NewBuffer([]byte{})
 	encoder := json.NewEncoder(byteBuffer)
 	// avoid wrongly embedding
@@ -773,6 +804,8 @@ type Explain struct {
 
 	Rows        [][]string
 	ExplainRows [][]string
+
+	costDetails []explainCostDetail
 }
 
 // GetExplainRowsForPlan get explain rows for plan.
@@ -834,6 +867,8 @@ func (e *Explain) prepareSchema() error {
 		fieldNames = []string{"binary plan"}
 	case format == types.ExplainFormatTiDBJSON:
 		fieldNames = []string{"TiDB_JSON"}
+	case format == types.ExplainFormatJSON:
+		fieldNames = []string{"EXPLAIN"}
 	default:
 		return errors.Errorf("explain format '%s' is not supported now", e.Format)
 	}
@@ -907,6 +942,13 @@ func (e *Explain) RenderResult() error {
 		}
 	}
 
+	if e.showCostDetails() {
+		if err := e.traceCostDetails(); err != nil {
+			return err
+		}
+	}
+
+	renderedRows := len(e.Rows)
 	switch strings.ToLower(e.Format) {
 	case types.ExplainFormatROW, types.ExplainFormatBrief, types.ExplainFormatVerbose, types.ExplainFormatTrueCardCost, types.ExplainFormatCostTrace, types.ExplainFormatPlanCache:
 		if e.Rows == nil || e.Analyze {
@@ -943,7 +985,29 @@ func (e *Explain) RenderResult() error {
 			tracker := e.SCtx().GetSessionVars().MemTracker
 			encodeRoot.TotalMemoryConsumed = tracker.FormatBytes(tracker.MaxConsumed())
 		}
-		str, err := JSONToString(encodes)
+		var str string
+		var err error
+		if e.showHintsInUse() {
+			// The hints in use are put into the same JSON document as the plan to keep a single result row. The field
+			// is always present once the section is enabled, so the shape of the document doesn't depend on the hints.
+			hints := e.hintsInUse()
+			if hints == nil {
+				hints = []explainHintInUse{}
+			}
+			str, err = encodeExplainJSON(struct {
+				Plan       []*ExplainInfoForEncode `json:"plan"`
+				HintsInUse []explainHintInUse      `json:"hints_in_use"`
+			}{Plan: encodes, HintsInUse: hints})
+		} else {
+			str, err = JSONToString(encodes)
+		}
+		if err != nil {
+			return err
+		}
+		e.Rows = append(e.Rows, []string{str})
+	case types.ExplainFormatJSON:
+		flat := FlattenPhysicalPlan(e.TargetPlan, true)
+		str, err := e.explainFlatPlanInMySQLJSONFormat(flat, e.hintsInUse())
 		if err != nil {
 			return err
 		}
@@ -951,9 +1015,100 @@ func (e *Explain) RenderResult() error {
 	default:
 		return errors.Errorf("explain format '%s' is not supported now", e.Format)
 	}
+	if len(e.Rows) > renderedRows {
+		e.appendCostDetails()
+		e.appendHintsInUse()
+	}
 	return nil
 }
 
+// explainHintInUse is a hint listed in the "Hints In Use" section of the explain result.
+type explainHintInUse struct {
+	Hint    string `json:"hint"`
+	Source  string `json:"source"`
+	Matched bool   `json:"matched"`
+	Applied bool   `json:"applied"`
+}
+
+// hintsInUse returns each hint supplied in the statement, whether it comes from the comment or the binding, whether
+// its tables are found, and whether it takes effect in the plan.
+func (e *Explain) hintsInUse() []explainHintInUse {
+	if !e.showHintsInUse() {
+		return nil
+	}
+	vars := e.SCtx().GetSessionVars()
+	if len(vars.StmtCtx.HintUsages) == 0 {
+		return nil
+	}
+	source := "comment"
+	if vars.StmtCtx.BindSQL != "" {
+		source = "binding"
+	}
+	planHints := GenHintsFromPhysicalPlan(e.TargetPlan)
+	hints := make([]explainHintInUse, 0, len(vars.StmtCtx.HintUsages))
+	for i := range vars.StmtCtx.HintUsages {
+		usage := &vars.StmtCtx.HintUsages[i]
+		applied := usage.Matched
+		if applied {
+			// The hints which can't be checked against the plan are considered applied once they're matched.
+			if ok, checked := isHintApplied(usage, planHints); checked {
+				applied = ok
+			}
+		}
+		hints = append(hints, explainHintInUse{Hint: usage.Hint, Source: source, Matched: usage.Matched, Applied: applied})
+	}
+	return hints
+}
+
+// showHintsInUse returns whether the hints in use are reported. EXPLAIN FORMAT='json' always reports them, the other
+// formats change the shape of their results, so they only report them on demand.
+func (e *Explain) showHintsInUse() bool {
+	if e.SCtx() == nil {
+		return false
+	}
+	return e.SCtx().GetSessionVars().ExplainShowHintsInUse || strings.ToLower(e.Format) == types.ExplainFormatJSON
+}
+
+// appendHintsInUse appends the "Hints In Use" section to the result in the row formats, the JSON formats embed the
+// hints into the plan document instead.
+func (e *Explain) appendHintsInUse() {
+	if len(e.names) == 0 {
+		return
+	}
+	switch strings.ToLower(e.Format) {
+	case types.ExplainFormatTiDBJSON, types.ExplainFormatJSON:
+		return
+	}
+	hints := e.hintsInUse()
+	if len(hints) == 0 {
+		return
+	}
+	// The section is rendered as a header row followed by a row for each hint.
+	e.Rows = append(e.Rows, e.newSectionRow("Hints In Use:", ""))
+	for _, h := range hints {
+		e.Rows = append(e.Rows, e.newSectionRow(h.Hint,
+			fmt.Sprintf("source:%s, matched:%v, applied:%v", h.Source, h.Matched, h.Applied)))
+	}
+}
+
+// newSectionRow creates a row of the sections appended after the plan, such as "Hints In Use". The details
+// are put into the "operator info" column if there is one.
+func (e *Explain) newSectionRow(first, info string) []string {
+	infoIdx := 0
+	for i, name := range e.names {
+		if name.ColName.L == "operator info" {
+			infoIdx = i
+		}
+	}
+	row := make([]string, len(e.names))
+	if infoIdx == 0 {
+		row[0] = strings.TrimSpace(first + " " + info)
+	} else {
+		row[0], row[infoIdx] = first, info
+	}
+	return row
+}
+
 func (e *Explain) explainFlatPlanInRowFormat(flat *FlatPhysicalPlan) {
 	if flat == nil || len(flat.Main) == 0 || flat.InExplain {
 		return
@@ -1052,6 +1207,23 @@ func getRuntimeInfoStr(ctx base.PlanContext, p base.Plan, runtimeStatsColl *exec
 	return
 }
 
+// appendWaitTimeInfo appends the self and wait time of the operator to its execution info.
+func appendWaitTimeInfo(ctx base.PlanContext, p base.Plan, runtimeStatsColl *execdetails.RuntimeStatsColl, analyzeInfo string) string {
+	rootStats, _, _, _ := getRuntimeInfo(ctx, p, runtimeStatsColl)
+	if rootStats == nil {
+		return analyzeInfo
+	}
+	basic, _ := rootStats.MergeStats()
+	str := basic.WaitTimeString()
+	if len(str) == 0 {
+		return analyzeInfo
+	}
+	if len(analyzeInfo) > 0 {
+		analyzeInfo += ", "
+	}
+	return analyzeInfo + str
+}
+
 func getRuntimeInfo(ctx base.PlanContext, p base.Plan, runtimeStatsColl *execdetails.RuntimeStatsColl) (
 	rootStats *execdetails.RootRuntimeStats,
 	copStats *execdetails.CopRuntimeStats,
@@ -1094,6 +1266,9 @@ func (e *Explain) prepareOperatorInfo(p base.Plan, taskType, id string) {
 			row = append(row, costFormula)
 		}
 		actRows, analyzeInfo, memoryInfo, diskInfo := getRuntimeInfoStr(e.SCtx(), p, e.RuntimeStatsColl)
+		if strings.ToLower(e.Format) == types.ExplainFormatVerbose {
+			analyzeInfo = appendWaitTimeInfo(e.SCtx(), p, e.RuntimeStatsColl, analyzeInfo)
+		}
 		row = append(row, actRows, taskType, accessObject, analyzeInfo, operatorInfo, memoryInfo, diskInfo)
 	} else {
 		row = []string{id, estRows}
@@ -1107,6 +1282,9 @@ func (e *Explain) prepareOperatorInfo(p base.Plan, taskType, id string) {
 		row = append(row, taskType, accessObject, operatorInfo)
 	}
 	e.Rows = append(e.Rows, row)
+	if e.showCostDetails() {
+		e.recordCostDetail(p, estRows)
+	}
 }
 
 func (e *Explain) prepareOperatorInfoForJSONFormat(p base.Plan, taskType, id string, explainID string) *ExplainInfoForEncode {
@@ -1147,16 +1325,9 @@ func (e *Explain) getOperatorInfo(p base.Plan, id string) (estRows, estCost, cos
 	costFormula = "N/A"
 	if isPhysicalPlan {
 		estRows = strconv.FormatFloat(pp.GetEstRowCountForDisplay(), 'f', 2, 64)
-		if e.SCtx() != nil && e.SCtx().GetSessionVars().CostModelVersion == modelVer2 {
-			costVer2, _ := pp.GetPlanCostVer2(property.RootTaskType, optimizetrace.NewDefaultPlanCostOption())
-			estCost = strconv.FormatFloat(costVer2.GetCost(), 'f', 2, 64)
-			if costVer2.GetTrace() != nil {
-				costFormula = costVer2.GetTrace().GetFormula()
-			}
-		} else {
-			planCost, _ := getPlanCost(pp, property.RootTaskType, optimizetrace.NewDefaultPlanCostOption())
-			estCost = strconv.FormatFloat(planCost, 'f', 2, 64)
-		}
+		var cost float64
+		cost, costFormula = e.getEstCost(pp)
+		estCost = strconv.FormatFloat(cost, 'f', 2, 64)
 	} else if si := p.StatsInfo(); si != nil {
 		estRows = strconv.FormatFloat(si.RowCount, 'f', 2, 64)
 	}
@@ -1173,6 +1344,20 @@ func (e *Explain) getOperatorInfo(p base.Plan, id string) (estRows, estCost, cos
 	return estRows, estCost, costFormula, accessObject, operatorInfo
 }
 
+// getEstCost returns the estimated cost of the plan and its cost formula if the cost is traced.
+func (e *Explain) getEstCost(pp base.PhysicalPlan) (cost float64, costFormula string) {
+	costFormula = "N/A"
+	if e.SCtx() != nil && e.SCtx().GetSessionVars().CostModelVersion == modelVer2 {
+		costVer2, _ := pp.GetPlanCostVer2(property.RootTaskType, optimizetrace.NewDefaultPlanCostOption())
+		if costVer2.GetTrace() != nil {
+			costFormula = costVer2.GetTrace().GetFormula()
+		}
+		return costVer2.GetCost(), costFormula
+	}
+	cost, _ = getPlanCost(pp, property.RootTaskType, optimizetrace.NewDefaultPlanCostOption())
+	return cost, costFormula
+}
+
 // BinaryPlanStrFromFlatPlan generates the compressed and encoded binary plan from a FlatPhysicalPlan.
 func BinaryPlanStrFromFlatPlan(explainCtx base.PlanContext, flat *FlatPhysicalPlan) string {
 	binary := binaryDataFromFlatPlan(explainCtx, flat)
@@ -1444,3 +1629,30 @@ func IsAutoCommitTxn(vars *variable.SessionVars) bool {
 type AdminShowBDRRole struct {
 	baseSchemaProducer
 }
+
+// AdminRecommendHints represents an `ADMIN RECOMMEND HINTS FROM PLAN DIGEST ... TO ...` plan.
+type AdminRecommendHints struct {
+	baseSchemaProducer
+
+	// Hints is the minimal hint set which leads the bad plan to the good plan.
+	Hints string
+	// CreateBinding is the `CREATE BINDING` statement built from all the hints of the good plan.
+	CreateBinding string
+}
+
+// AdminRecommendDropIndex represents an `ADMIN RECOMMEND DROP INDEX [FOR TABLE ...]` plan.
+type AdminRecommendDropIndex struct {
+	baseSchemaProducer
+
+	// Tables are the tables to advise, all the tables are advised if it's empty.
+	Tables []*ast.TableName
+}
+
+// AdminShowDiagnostics represents an `ADMIN SHOW DIAGNOSTICS FOR [DIGEST] ...` plan.
+type AdminShowDiagnostics struct {
+	baseSchemaProducer
+
+	// SQL is the statement to diagnose, the latest sample in the statement summary is used if it's empty.
+	SQL    string
+	Digest string
+}
@@ -739,10 +739,22 @@ func (p *LogicalJoin) getIndexJoinByOuterIdx(prop *property.PhysicalProperty, ou
 		avgInnerRowCnt = p.equalCondOutCnt / outerChild.StatsInfo().RowCount
 	}
 	joins = p.buildIndexJoinInner2TableScan(prop, innerChildWrapper, innerJoinKeys, outerJoinKeys, outerIdx, avgInnerRowCnt)
-	if joins != nil {
-		return
+	if joins == nil {
+		joins = p.buildIndexJoinInner2IndexScan(prop, innerChildWrapper, innerJoinKeys, outerJoinKeys, outerIdx, avgInnerRowCnt)
+	}
+	if prefetchTasks := innerChildWrapper.ds.inljPrefetchTasks; prefetchTasks > 0 {
+		// The index merge join doesn't prefetch the inner side lookups, so only the index join and the index hash join
+		// are affected by the inl_join_prefetch hint.
+		for _, join := range joins {
+			switch x := join.(type) {
+			case *PhysicalIndexJoin:
+				x.PrefetchTasks = prefetchTasks
+			case *PhysicalIndexHashJoin:
+				x.PrefetchTasks = prefetchTasks
+			}
+		}
 	}
-	return p.buildIndexJoinInner2IndexScan(prop, innerChildWrapper, innerJoinKeys, outerJoinKeys, outerIdx, avgInnerRowCnt)
+	return joins
 }
 
 // indexJoinInnerChildWrapper is a wrapper for the inner child of an index join.
@@ -1102,7 +1114,7 @@ func (p *LogicalJoin) constructInnerTableScanTask(
 		keepOrder:         ts.KeepOrder,
 	}
 	copTask.physPlanPartInfo = PhysPlanPartInfo{
-		PruningConds:   ds.allConds,
+		PruningConds:   ds.partitionPruningConds(),
 		PartitionNames: ds.partitionNames,
 		Columns:        ds.TblCols,
 		ColumnNames:    ds.names,
@@ -1244,8 +1256,9 @@ func (p *LogicalJoin) constructInnerIndexScanTask(
 	ds := wrapper.ds
 	// If `ds.tableInfo.GetPartitionInfo() != nil`,
 	// it means the data source is a partition table reader.
-	// If the inner task need to keep order, the partition table reader can't satisfy it.
-	if keepOrder && ds.tableInfo.GetPartitionInfo() != nil {
+	// If the inner task need to keep order, the partition table reader can't satisfy it,
+	// unless it reads a global index, which keeps the order across all partitions.
+	if keepOrder && ds.tableInfo.GetPartitionInfo() != nil && !path.Index.Global {
 		return nil
 	}
 	is := PhysicalIndexScan{
@@ -1273,7 +1286,7 @@ func (p *LogicalJoin) constructInnerIndexScanTask(
 		keepOrder:   is.KeepOrder,
 	}
 	cop.physPlanPartInfo = PhysPlanPartInfo{
-		PruningConds:   ds.allConds,
+		PruningConds:   ds.partitionPruningConds(),
 		PartitionNames: ds.partitionNames,
 		Columns:        ds.TblCols,
 		ColumnNames:    ds.names,
@@ -1315,6 +1328,13 @@ func (p *LogicalJoin) constructInnerIndexScanTask(
 			cop.extraHandleCol, needExtraProj = ts.appendExtraHandleCol(ds)
 			cop.needExtraProj = cop.needExtraProj || needExtraProj
 		}
+		// The rows read by the global index in order are from different partitions, so the physical table IDs are
+		// needed to look them up.
+		if cop.keepOrder && path.Index.Global {
+			var succ bool
+			ts.Columns, ts.schema, succ = AddExtraPhysTblIDColumn(ts.SCtx(), ts.Columns, ts.Schema())
+			cop.needExtraProj = cop.needExtraProj || succ
+		}
 		if cop.needExtraProj {
 			cop.originSchema = ds.schema
 		}
@@ -1429,7 +1449,7 @@ func (p *LogicalJoin) constructIndexJoinInnerSideTask(dsCopTask *CopTask, ds *Da
 		if la != nil && la.HasDistinct() {
 			// TODO: remove AllowDistinctAggPushDown after the cost estimation of distinct pushdown is implemented.
 			// If AllowDistinctAggPushDown is set to true, we should not consider RootTask.
-			if !la.SCtx().GetSessionVars().AllowDistinctAggPushDown {
+			if !la.AllowDistinctAggPushDown() {
 				canPushAggToCop = false
 			}
 		}
@@ -2995,6 +3015,12 @@ func (lw *LogicalWindow) tryToGetMppWindows(prop *property.PhysicalProperty) []b
 			return nil
 		}
 
+		if lw.Frame != nil && lw.Frame.Exclusion != ast.ExcludeNoOthers {
+			lw.SCtx().GetSessionVars().RaiseWarningWhenMPPEnforced(
+				"MPP mode may be blocked because the EXCLUDE clause of window function frame is not supported now.")
+			return nil
+		}
+
 		if lw.Frame != nil && lw.Frame.Type == ast.Ranges {
 			ctx := lw.SCtx().GetExprCtx()
 			if _, err := expression.ExpressionsToPBList(ctx.GetEvalCtx(), lw.Frame.Start.CalcFuncs, lw.SCtx().GetClient()); err != nil {
@@ -3212,7 +3238,7 @@ func (la *LogicalAggregation) getEnforcedStreamAggs(prop *property.PhysicalPrope
 	if la.HasDistinct() {
 		// TODO: remove AllowDistinctAggPushDown after the cost estimation of distinct pushdown is implemented.
 		// If AllowDistinctAggPushDown is set to true, we should not consider RootTask.
-		if !la.CanPushToCop(kv.TiKV) || !la.SCtx().GetSessionVars().AllowDistinctAggPushDown {
+		if !la.CanPushToCop(kv.TiKV) || !la.AllowDistinctAggPushDown() {
 			taskTypes = []property.TaskType{property.RootTaskType}
 		}
 	} else if !la.PreferAggToCop {
@@ -3289,7 +3315,7 @@ func (la *LogicalAggregation) getStreamAggs(prop *property.PhysicalProperty) []b
 		if la.HasDistinct() {
 			// TODO: remove AllowDistinctAggPushDown after the cost estimation of distinct pushdown is implemented.
 			// If AllowDistinctAggPushDown is set to true, we should not consider RootTask.
-			if !la.SCtx().GetSessionVars().AllowDistinctAggPushDown || !la.CanPushToCop(kv.TiKV) {
+			if !la.AllowDistinctAggPushDown() || !la.CanPushToCop(kv.TiKV) {
 				// if variable doesn't allow DistinctAggPushDown, just produce root task type.
 				// if variable does allow DistinctAggPushDown, but OP itself can't be pushed down to tikv, just produce root task type.
 				taskTypes = []property.TaskType{property.RootTaskType}
@@ -3495,7 +3521,7 @@ func (la *LogicalAggregation) getHashAggs(prop *property.PhysicalProperty) []bas
 	canPushDownToMPP := canPushDownToTiFlash && la.SCtx().GetSessionVars().IsMPPAllowed() && la.checkCanPushDownToMPP()
 	if la.HasDistinct() {
 		// TODO: remove after the cost estimation of distinct pushdown is implemented.
-		if !la.SCtx().GetSessionVars().AllowDistinctAggPushDown || !la.CanPushToCop(kv.TiKV) {
+		if !la.AllowDistinctAggPushDown() || !la.CanPushToCop(kv.TiKV) {
 			// if variable doesn't allow DistinctAggPushDown, just produce root task type.
 			// if variable does allow DistinctAggPushDown, but OP itself can't be pushed down to tikv, just produce root task type.
 			taskTypes = []property.TaskType{property.RootTaskType}
@@ -350,6 +350,14 @@ func (p *PhysicalIndexMergeReader) ExplainInfo() string {
 	return str.String()
 }
 
+// ExplainInfo implements Plan interface.
+func (p *PhysicalTableSample) ExplainInfo() string {
+	if p.Index == nil {
+		return ""
+	}
+	return "index:" + p.Index.Name.O
+}
+
 // ExplainInfo implements Plan interface.
 func (p *PhysicalUnionScan) ExplainInfo() string {
 	return string(expression.SortedExplainExpressionList(p.SCtx().GetExprCtx().GetEvalCtx(), p.Conditions))
@@ -421,6 +429,61 @@ func (p *PhysicalTableDual) ExplainInfo() string {
 	return str.String()
 }
 
+// ExplainInfo implements Plan interface.
+func (p *PhysicalJSONTable) ExplainInfo() string {
+	evalCtx := p.SCtx().GetExprCtx().GetEvalCtx()
+	var str strings.Builder
+	str.WriteString("expr:")
+	str.WriteString(p.Expr.ExplainInfo(evalCtx))
+	str.WriteString(", path:")
+	str.WriteString(p.Root.Path.String())
+	if len(p.PushedDownConds) > 0 {
+		str.WriteString(", pushed filters:")
+		str.Write(expression.SortedExplainExpressionList(evalCtx, p.PushedDownConds))
+	}
+	return str.String()
+}
+
+// ExplainInfo implements Plan interface.
+func (p *PhysicalLooseIndexScan) ExplainInfo() string {
+	return p.AccessObject().String() + ", " + p.OperatorInfo(false)
+}
+
+// ExplainNormalizedInfo implements Plan interface.
+func (p *PhysicalLooseIndexScan) ExplainNormalizedInfo() string {
+	return p.AccessObject().NormalizedString() + ", " + p.OperatorInfo(true)
+}
+
+// OperatorInfo implements dataAccesser interface.
+func (p *PhysicalLooseIndexScan) OperatorInfo(normalized bool) string {
+	evalCtx := p.SCtx().GetExprCtx().GetEvalCtx()
+	var buffer strings.Builder
+	buffer.WriteString("group by:")
+	for i, col := range p.IndexScan.IdxCols[:p.GroupByLen] {
+		if i > 0 {
+			buffer.WriteString(", ")
+		}
+		if normalized {
+			buffer.WriteString(col.ExplainNormalizedInfo())
+		} else {
+			buffer.WriteString(col.ExplainInfo(evalCtx))
+		}
+	}
+	for i, aggFunc := range p.AggFuncs {
+		buffer.WriteString(", funcs:")
+		buffer.WriteString(aggregation.ExplainAggFunc(evalCtx, aggFunc, normalized))
+		buffer.WriteString("->")
+		if normalized {
+			buffer.WriteString(p.schema.Columns[i].ExplainNormalizedInfo())
+		} else {
+			buffer.WriteString(p.schema.Columns[i].ExplainInfo(evalCtx))
+		}
+	}
+	buffer.WriteString(", ")
+	buffer.WriteString(p.IndexScan.OperatorInfo(normalized))
+	return buffer.String()
+}
+
 // ExplainInfo implements Plan interface.
 func (p *PhysicalSort) ExplainInfo() string {
 	buffer := bytes.NewBufferString("")
@@ -844,6 +907,10 @@ func (p *PhysicalWindow) ExplainInfo() string {
 		p.formatFrameBound(buffer, p.Frame.Start)
 		buffer.WriteString(" and ")
 		p.formatFrameBound(buffer, p.Frame.End)
+		if p.Frame.Exclusion != ast.ExcludeNoOthers {
+			buffer.WriteString(" exclude ")
+			buffer.WriteString(strings.ToLower(p.Frame.Exclusion.String()))
+		}
 	}
 	buffer.WriteString(")")
 	if p.TiFlashFineGrainedShuffleStreamCount > 0 {
@@ -939,6 +1006,21 @@ func (p *LogicalTableDual) ExplainInfo() string {
 	return str.String()
 }
 
+// ExplainInfo implements Plan interface.
+func (p *LogicalJSONTable) ExplainInfo() string {
+	evalCtx := p.SCtx().GetExprCtx().GetEvalCtx()
+	var str strings.Builder
+	str.WriteString("expr:")
+	str.WriteString(p.Expr.ExplainInfo(evalCtx))
+	str.WriteString(", path:")
+	str.WriteString(p.Root.Path.String())
+	if len(p.PushedDownConds) > 0 {
+		str.WriteString(", pushed filters:")
+		str.Write(expression.SortedExplainExpressionList(evalCtx, p.PushedDownConds))
+	}
+	return str.String()
+}
+
 // ExplainInfo implements Plan interface.
 func (ds *DataSource) ExplainInfo() string {
 	buffer := bytes.NewBufferString("")
@@ -947,10 +1029,8 @@ func (ds *DataSource) ExplainInfo() string {
 		tblName = ds.TableAsName.O
 	}
 	fmt.Fprintf(buffer, "table:%s", tblName)
-	if ds.partitionDefIdx != nil {
-		if pi := ds.tableInfo.GetPartitionInfo(); pi != nil {
-			fmt.Fprintf(buffer, ", partition:%s", pi.Definitions[*ds.partitionDefIdx].Name.O)
-		}
+	if partitionName := ds.partitionName(); partitionName.L != "" {
+		fmt.Fprintf(buffer, ", partition:%s", partitionName.O)
 	}
 	return buffer.String()
 }
@@ -0,0 +1,149 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/property"
+	"github.com/pingcap/tidb/pkg/planner/util/costusage"
+	"github.com/pingcap/tidb/pkg/planner/util/optimizetrace"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+// costFactorCategories groups the cost factors by the keywords in their names, e.g. both `tikv_scan_factor` and
+// `tiflash_scan_factor` are grouped into `scan`. The categories are shown in this order.
+var costFactorCategories = []struct {
+	keyword  string
+	category string
+}{
+	{"scan", "scan"},
+	{"cpu", "cpu"},
+	{"mem", "memory"},
+	{"disk", "disk"},
+	{"net", "network"},
+	{"request", "request"},
+}
+
+// planConstraintVars are the variables which restrict the alternative plans the optimizer can choose from. They're
+// listed in the "Plan Constraints" section when their values are not the defaults.
+var planConstraintVars = []struct {
+	name   string
+	effect string
+}{
+	{variable.TiDBOptEnableHashJoin, "whether the hash join can be chosen"},
+	{variable.TiDBEnableIndexMerge, "whether the index merge can be chosen"},
+	{variable.TiDBEnableIndexMergeJoin, "whether the index merge join can be chosen"},
+	{variable.TiDBOptPreferRangeScan, "whether the range scan is preferred to the full scan regardless of the cost"},
+	{variable.TiDBIsolationReadEngines, "which storage engines can be read"},
+	{variable.TiDBAllowMPPExecution, "whether the MPP plans can be chosen"},
+	{variable.TiDBEnforceMPPExecution, "whether the MPP plans are preferred regardless of the cost"},
+	{variable.TiDBAllowBatchCop, "whether the batch coprocessor can be used to read TiFlash"},
+	{variable.TiDBAllowTiFlashCop, "whether the coprocessor can be used to read TiFlash"},
+	{variable.TiDBOptAggPushDown, "whether the aggregation can be pushed down across the join"},
+	{variable.TiDBOptDistinctAggPushDown, "whether the aggregation with DISTINCT can be pushed down to the coprocessor"},
+	{variable.TiDBOptForceInlineCTE, "whether the CTEs are always inlined"},
+	{variable.TiDBOptJoinReorderThreshold, "which algorithm is used to reorder the joins"},
+}
+
+// explainCostDetail is a row of the "Cost Details" section.
+type explainCostDetail struct {
+	id     string
+	detail string
+}
+
+// showCostDetails returns whether to append the "Cost Details" and "Plan Constraints" sections.
+func (e *Explain) showCostDetails() bool {
+	return e.SCtx() != nil && e.SCtx().GetSessionVars().ExplainShowCostDetails &&
+		strings.ToLower(e.Format) == types.ExplainFormatVerbose
+}
+
+// traceCostDetails recalculates the cost of the plan with the trace, so the factor costs of each operator are kept.
+func (e *Explain) traceCostDetails() error {
+	pp, ok := e.TargetPlan.(base.PhysicalPlan)
+	if !ok || e.SCtx().GetSessionVars().CostModelVersion != modelVer2 {
+		return nil
+	}
+	_, err := getPlanCost(pp, property.RootTaskType,
+		optimizetrace.NewDefaultPlanCostOption().WithCostFlag(costusage.CostFlagRecalculate|costusage.CostFlagTrace))
+	return err
+}
+
+// recordCostDetail records the estimated rows and the factor costs of the operator for the "Cost Details" section.
+func (e *Explain) recordCostDetail(p base.Plan, estRows string) {
+	pp, ok := p.(base.PhysicalPlan)
+	if !ok {
+		return
+	}
+	var sb strings.Builder
+	sb.WriteString("rows:")
+	sb.WriteString(estRows)
+	if e.SCtx().GetSessionVars().CostModelVersion == modelVer2 {
+		cost, _ := pp.GetPlanCostVer2(property.RootTaskType, optimizetrace.NewDefaultPlanCostOption())
+		if trace := cost.GetTrace(); trace != nil {
+			costs := make(map[string]float64, len(costFactorCategories))
+			for factor, factorCost := range trace.GetFactorCosts() {
+				category := "other"
+				for _, c := range costFactorCategories {
+					if strings.Contains(factor, c.keyword) {
+						category = c.category
+						break
+					}
+				}
+				costs[category] += factorCost
+			}
+			for _, c := range costFactorCategories {
+				if cost := costs[c.category]; cost > 0 {
+					fmt.Fprintf(&sb, ", %s:%.2f", c.category, cost)
+				}
+			}
+			if cost := costs["other"]; cost > 0 {
+				fmt.Fprintf(&sb, ", other:%.2f", cost)
+			}
+		}
+	}
+	e.costDetails = append(e.costDetails, explainCostDetail{id: p.ExplainID().String(), detail: sb.String()})
+}
+
+// appendCostDetails appends the "Cost Details" section, which breaks the cost of each operator into the costs of
+// the factor categories, and the "Plan Constraints" section, which lists the hints and the variables restricting
+// the alternative plans, so it's easier to tell why a hint or a variable doesn't change the plan.
+func (e *Explain) appendCostDetails() {
+	if len(e.costDetails) == 0 || len(e.names) == 0 {
+		return
+	}
+	e.Rows = append(e.Rows, e.newSectionRow("Cost Details:", ""))
+	for _, d := range e.costDetails {
+		e.Rows = append(e.Rows, e.newSectionRow(d.id, d.detail))
+	}
+
+	vars := e.SCtx().GetSessionVars()
+	e.Rows = append(e.Rows, e.newSectionRow("Plan Constraints:", ""))
+	for _, usage := range vars.StmtCtx.HintUsages {
+		e.Rows = append(e.Rows, e.newSectionRow(usage.Hint, fmt.Sprintf("hint, matched:%v", usage.Matched)))
+	}
+	for _, v := range planConstraintVars {
+		sv := variable.GetSysVar(v.name)
+		val, ok := vars.GetSystemVar(v.name)
+		if sv == nil || !ok || strings.EqualFold(val, sv.Value) {
+			continue
+		}
+		e.Rows = append(e.Rows, e.newSectionRow(fmt.Sprintf("%s=%s", v.name, val),
+			fmt.Sprintf("variable, default:%s, controls %s", sv.Value, v.effect)))
+	}
+}
@@ -0,0 +1,472 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+)
+
+// The structures below are used to encode the plan in the MySQL-compatible JSON format, which is
+// the output of `EXPLAIN FORMAT = 'json'`. They follow the layout of MySQL 8.0 so that existing tools
+// can parse them, and the TiDB specific information of each operator is kept under the "tidb" key.
+
+// explainJSONQueryBlock is the "query_block" of a SELECT.
+type explainJSONQueryBlock struct {
+	SelectID int                  `json:"select_id"`
+	CostInfo *explainJSONCostInfo `json:"cost_info,omitempty"`
+	Message  string               `json:"message,omitempty"`
+	*explainJSONOperation
+	SelectListSubqueries []*explainJSONSubquery `json:"select_list_subqueries,omitempty"`
+}
+
+// explainJSONOperation is an operation in a query block. Only one of OrderingOperation, GroupingOperation,
+// UnionResult, NestedLoop and Table is set, the other fields describe the operation itself.
+type explainJSONOperation struct {
+	UsingTemporaryTable bool                    `json:"using_temporary_table,omitempty"`
+	UsingFilesort       bool                    `json:"using_filesort,omitempty"`
+	CostInfo            *explainJSONCostInfo    `json:"cost_info,omitempty"`
+	OrderingOperation   *explainJSONOperation   `json:"ordering_operation,omitempty"`
+	GroupingOperation   *explainJSONOperation   `json:"grouping_operation,omitempty"`
+	UnionResult         *explainJSONUnionResult `json:"union_result,omitempty"`
+	NestedLoop          []*explainJSONOperation `json:"nested_loop,omitempty"`
+	Table               *explainJSONTable       `json:"table,omitempty"`
+	TiDB                []*ExplainInfoForEncode `json:"tidb,omitempty"`
+}
+
+type explainJSONCostInfo struct {
+	QueryCost       string `json:"query_cost,omitempty"`
+	SortCost        string `json:"sort_cost,omitempty"`
+	ReadCost        string `json:"read_cost,omitempty"`
+	EvalCost        string `json:"eval_cost,omitempty"`
+	PrefixCost      string `json:"prefix_cost,omitempty"`
+	DataReadPerJoin string `json:"data_read_per_join,omitempty"`
+}
+
+type explainJSONUnionResult struct {
+	UsingTemporaryTable bool                   `json:"using_temporary_table"`
+	TableName           string                 `json:"table_name"`
+	AccessType          string                 `json:"access_type"`
+	QuerySpecifications []*explainJSONSubquery `json:"query_specifications"`
+}
+
+type explainJSONSubquery struct {
+	Dependent  bool                   `json:"dependent"`
+	Cacheable  bool                   `json:"cacheable"`
+	Recursive  bool                   `json:"recursive,omitempty"`
+	QueryBlock *explainJSONQueryBlock `json:"query_block"`
+}
+
+type explainJSONTable struct {
+	TableName                string                  `json:"table_name"`
+	AccessType               string                  `json:"access_type"`
+	Key                      string                  `json:"key,omitempty"`
+	RowsExaminedPerScan      int64                   `json:"rows_examined_per_scan"`
+	RowsProducedPerJoin      int64                   `json:"rows_produced_per_join"`
+	Filtered                 string                  `json:"filtered"`
+	CostInfo                 *explainJSONCostInfo    `json:"cost_info,omitempty"`
+	UsedColumns              []string                `json:"used_columns,omitempty"`
+	AttachedCondition        string                  `json:"attached_condition,omitempty"`
+	MaterializedFromSubquery *explainJSONSubquery    `json:"materialized_from_subquery,omitempty"`
+	TiDB                     []*ExplainInfoForEncode `json:"tidb,omitempty"`
+}
+
+// explainJSONBuilder converts a FlatPhysicalPlan into the MySQL-compatible JSON format.
+type explainJSONBuilder struct {
+	e        *Explain
+	flat     *FlatPhysicalPlan
+	selectID int
+}
+
+func (e *Explain) explainFlatPlanInMySQLJSONFormat(flat *FlatPhysicalPlan, hints []explainHintInUse) (string, error) {
+	if flat == nil || len(flat.Main) == 0 || flat.InExplain {
+		return "", nil
+	}
+	b := &explainJSONBuilder{e: e, flat: flat}
+	block := b.buildQueryBlock(flat.Main, 0)
+	for _, subQ := range flat.ScalarSubQueries {
+		block.SelectListSubqueries = append(block.SelectListSubqueries, &explainJSONSubquery{
+			Cacheable:  true,
+			QueryBlock: b.buildQueryBlock(subQ, 0),
+		})
+	}
+
+	byteBuffer := bytes.NewBuffer([]byte{})
+	encoder := json.NewEncoder(byteBuffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(struct {
+		QueryBlock *explainJSONQueryBlock `json:"query_block"`
+		HintsInUse []explainHintInUse     `json:"hints_in_use,omitempty"`
+	}{QueryBlock: block, HintsInUse: hints}); err != nil {
+		return "", err
+	}
+	return strings.TrimSuffix(byteBuffer.String(), "\n"), nil
+}
+
+func (b *explainJSONBuilder) buildQueryBlock(flats FlatPlanTree, idx int) *explainJSONQueryBlock {
+	b.selectID++
+	block := &explainJSONQueryBlock{SelectID: b.selectID}
+	if pp, ok := flats[idx].Origin.(base.PhysicalPlan); ok {
+		cost, _ := b.e.getEstCost(pp)
+		block.CostInfo = &explainJSONCostInfo{QueryCost: formatJSONCost(cost)}
+	}
+	block.explainJSONOperation = b.buildOperation(flats, idx, false)
+	op := block.explainJSONOperation
+	if op.OrderingOperation == nil && op.GroupingOperation == nil && op.UnionResult == nil &&
+		len(op.NestedLoop) == 0 && op.Table == nil {
+		block.Message = "No tables used"
+	}
+	return block
+}
+
+// buildOperation builds the operation for the subtree rooted at flats[idx].
+// The returned operation only contains the field describing its kind and the TiDB operators merged into it,
+// so that the caller can attach it to an upper operation.
+func (b *explainJSONBuilder) buildOperation(flats FlatPlanTree, idx int, isIndexJoinInner bool) *explainJSONOperation {
+	flatOp := flats[idx]
+	info := b.tidbInfo(flatOp)
+	switch p := flatOp.Origin.(type) {
+	case *PhysicalTableReader, *PhysicalIndexReader, *PhysicalIndexLookUpReader, *PhysicalIndexMergeReader:
+		if table := b.buildTableForReader(flats, idx, isIndexJoinInner); table != nil {
+			return &explainJSONOperation{Table: table}
+		}
+	case *PhysicalTableScan, *PhysicalIndexScan, *PointGetPlan, *BatchPointGetPlan, *PhysicalMemTable:
+		table := b.buildTable(flats, idx, idx, isIndexJoinInner)
+		table.TiDB = []*ExplainInfoForEncode{info}
+		return &explainJSONOperation{Table: table}
+	case *PhysicalCTE:
+		return &explainJSONOperation{Table: b.buildTableForCTE(flatOp, p)}
+	case *CTEDefinition:
+		return b.buildCTEDefinition(flats, idx, p)
+	case *PhysicalSort, *PhysicalTopN:
+		if len(flatOp.ChildrenIdx) == 1 {
+			inner := b.buildOperation(flats, flatOp.ChildrenIdx[0], false)
+			inner.UsingFilesort = true
+			inner.CostInfo = &explainJSONCostInfo{SortCost: formatJSONCost(b.selfCost(flats, idx))}
+			inner.TiDB = append([]*ExplainInfoForEncode{info}, inner.TiDB...)
+			return &explainJSONOperation{OrderingOperation: inner}
+		}
+	case *PhysicalHashAgg, *PhysicalStreamAgg:
+		if len(flatOp.ChildrenIdx) == 1 {
+			inner := b.buildOperation(flats, flatOp.ChildrenIdx[0], false)
+			_, inner.UsingTemporaryTable = p.(*PhysicalHashAgg)
+			inner.TiDB = append([]*ExplainInfoForEncode{info}, inner.TiDB...)
+			return &explainJSONOperation{GroupingOperation: inner}
+		}
+	case *PhysicalUnionAll:
+		return b.buildUnion(flats, flatOp, info)
+	case *PhysicalHashJoin, *PhysicalMergeJoin, *PhysicalIndexJoin, *PhysicalIndexHashJoin, *PhysicalIndexMergeJoin, *PhysicalApply:
+		return b.buildNestedLoop(flats, flatOp, info)
+	}
+
+	// The other operators, e.g. Projection, Selection and Limit, don't have a corresponding operation in MySQL,
+	// so they are merged into the operation of their child.
+	if len(flatOp.ChildrenIdx) == 1 {
+		op := b.buildOperation(flats, flatOp.ChildrenIdx[0], false)
+		op.TiDB = append([]*ExplainInfoForEncode{info}, op.TiDB...)
+		return op
+	}
+	if len(flatOp.ChildrenIdx) > 1 {
+		return b.buildNestedLoop(flats, flatOp, info)
+	}
+	return &explainJSONOperation{TiDB: []*ExplainInfoForEncode{info}}
+}
+
+func (b *explainJSONBuilder) buildNestedLoop(flats FlatPlanTree, flatOp *FlatOperator, info *ExplainInfoForEncode) *explainJSONOperation {
+	innerPlan := indexJoinInnerPlan(flatOp.Origin)
+	op := &explainJSONOperation{TiDB: []*ExplainInfoForEncode{info}}
+	for _, childIdx := range flatOp.ChildrenIdx {
+		isIndexJoinInner := innerPlan != nil && flats[childIdx].Origin == innerPlan
+		child := b.buildOperation(flats, childIdx, isIndexJoinInner)
+		// Flatten the nested joins, since MySQL uses a single list to describe the join order.
+		if len(child.NestedLoop) > 0 && child.Table == nil && child.OrderingOperation == nil &&
+			child.GroupingOperation == nil && child.UnionResult == nil {
+			op.NestedLoop = append(op.NestedLoop, child.NestedLoop...)
+			op.TiDB = append(op.TiDB, child.TiDB...)
+			continue
+		}
+		op.NestedLoop = append(op.NestedLoop, child)
+	}
+	return op
+}
+
+func (b *explainJSONBuilder) buildUnion(flats FlatPlanTree, flatOp *FlatOperator, info *ExplainInfoForEncode) *explainJSONOperation {
+	union := &explainJSONUnionResult{AccessType: "ALL"}
+	ids := make([]string, 0, len(flatOp.ChildrenIdx))
+	for _, childIdx := range flatOp.ChildrenIdx {
+		block := b.buildQueryBlock(flats, childIdx)
+		ids = append(ids, strconv.Itoa(block.SelectID))
+		union.QuerySpecifications = append(union.QuerySpecifications, &explainJSONSubquery{
+			Cacheable:  true,
+			Recursive:  flats[childIdx].Label == RecursivePart,
+			QueryBlock: block,
+		})
+	}
+	union.TableName = "<union" + strings.Join(ids, ",") + ">"
+	return &explainJSONOperation{UnionResult: union, TiDB: []*ExplainInfoForEncode{info}}
+}
+
+func (b *explainJSONBuilder) buildCTEDefinition(flats FlatPlanTree, idx int, def *CTEDefinition) *explainJSONOperation {
+	flatOp := flats[idx]
+	info := b.tidbInfo(flatOp)
+	if def.RecurPlan == nil && len(flatOp.ChildrenIdx) == 1 {
+		op := b.buildOperation(flats, flatOp.ChildrenIdx[0], false)
+		op.TiDB = append([]*ExplainInfoForEncode{info}, op.TiDB...)
+		return op
+	}
+	return b.buildUnion(flats, flatOp, info)
+}
+
+func (b *explainJSONBuilder) buildTableForCTE(flatOp *FlatOperator, cte *PhysicalCTE) *explainJSONTable {
+	name := cte.cteAsName.O
+	if name == "" {
+		name = cte.cteName.O
+	}
+	table := &explainJSONTable{
+		TableName:           name,
+		AccessType:          "ALL",
+		RowsExaminedPerScan: int64(cte.GetEstRowCountForDisplay()),
+		RowsProducedPerJoin: int64(cte.GetEstRowCountForDisplay()),
+		Filtered:            "100.00",
+		TiDB:                []*ExplainInfoForEncode{b.tidbInfo(flatOp)},
+	}
+	for _, cteTree := range b.flat.CTEs {
+		def, ok := cteTree[0].Origin.(*CTEDefinition)
+		if !ok || def.CTE == nil || cte.CTE == nil || def.CTE.IDForStorage != cte.CTE.IDForStorage {
+			continue
+		}
+		b.selectID++
+		block := &explainJSONQueryBlock{SelectID: b.selectID}
+		block.explainJSONOperation = b.buildCTEDefinition(cteTree, 0, def)
+		table.MaterializedFromSubquery = &explainJSONSubquery{
+			Cacheable:  true,
+			QueryBlock: block,
+		}
+		break
+	}
+	return table
+}
+
+// buildTableForReader builds the table for a reader whose coprocessor tasks only read a single table.
+// It returns nil if the reader contains more than one table, e.g. an MPP plan with joins.
+func (b *explainJSONBuilder) buildTableForReader(flats FlatPlanTree, idx int, isIndexJoinInner bool) *explainJSONTable {
+	flatOp := flats[idx]
+	var scanIdx = -1
+	var tableName string
+	for i := idx + 1; i <= flatOp.ChildrenEndIdx && i < len(flats); i++ {
+		name := ""
+		switch scan := flats[i].Origin.(type) {
+		case *PhysicalTableScan:
+			name = scan.Table.Name.L
+			// The table side of IndexLookUp doesn't determine how the table is accessed.
+			if _, ok := flatOp.Origin.(*PhysicalIndexLookUpReader); !ok || scanIdx < 0 {
+				scanIdx = i
+			}
+		case *PhysicalIndexScan:
+			name = scan.Table.Name.L
+			scanIdx = i
+		default:
+			continue
+		}
+		if tableName != "" && tableName != name {
+			return nil
+		}
+		tableName = name
+	}
+	if scanIdx < 0 {
+		return nil
+	}
+	table := b.buildTable(flats, idx, scanIdx, isIndexJoinInner)
+	if _, ok := flatOp.Origin.(*PhysicalIndexMergeReader); ok {
+		table.AccessType = "index_merge"
+		table.Key = ""
+	}
+	table.TiDB = []*ExplainInfoForEncode{b.tidbInfo(flatOp)}
+	for i := idx + 1; i <= flatOp.ChildrenEndIdx && i < len(flats); i++ {
+		table.TiDB = append(table.TiDB, b.tidbInfo(flats[i]))
+		if sel, ok := flats[i].Origin.(*PhysicalSelection); ok {
+			if table.AttachedCondition != "" {
+				table.AttachedCondition += ", "
+			}
+			table.AttachedCondition += sel.ExplainInfo()
+		}
+	}
+	return table
+}
+
+// buildTable builds the table which is read by flats[idx], and flats[scanIdx] is the operator that
+// decides how the table is accessed.
+func (b *explainJSONBuilder) buildTable(flats FlatPlanTree, idx, scanIdx int, isIndexJoinInner bool) *explainJSONTable {
+	table := &explainJSONTable{}
+	var columns []*model.ColumnInfo
+	switch scan := flats[scanIdx].Origin.(type) {
+	case *PhysicalTableScan:
+		table.TableName = tableNameForJSON(scan.Table, scan.TableAsName)
+		table.AccessType = "range"
+		if scan.isFullScan() {
+			table.AccessType = "ALL"
+		} else {
+			table.Key = "PRIMARY"
+		}
+		columns = scan.Columns
+	case *PhysicalIndexScan:
+		table.TableName = tableNameForJSON(scan.Table, scan.TableAsName)
+		table.AccessType = "range"
+		if scan.isFullScan() {
+			table.AccessType = "index"
+		}
+		table.Key = scan.Index.Name.O
+		columns = scan.Columns
+	case *PointGetPlan:
+		table.TableName = tableNameForJSON(scan.TblInfo, nil)
+		table.AccessType = "const"
+		table.Key = "PRIMARY"
+		if scan.IndexInfo != nil {
+			table.Key = scan.IndexInfo.Name.O
+		}
+		columns = scan.Columns
+	case *BatchPointGetPlan:
+		table.TableName = tableNameForJSON(scan.TblInfo, nil)
+		table.AccessType = "range"
+		table.Key = "PRIMARY"
+		if scan.IndexInfo != nil {
+			table.Key = scan.IndexInfo.Name.O
+		}
+		columns = scan.Columns
+	case *PhysicalMemTable:
+		table.TableName = tableNameForJSON(scan.Table, nil)
+		table.AccessType = "ALL"
+		columns = scan.Columns
+	}
+	if isIndexJoinInner && table.AccessType != "ALL" {
+		table.AccessType = "ref"
+	}
+	for _, col := range columns {
+		if col.ID == model.ExtraHandleID || col.ID == model.ExtraPhysTblID {
+			continue
+		}
+		table.UsedColumns = append(table.UsedColumns, col.Name.O)
+	}
+
+	examined := flats[scanIdx].Origin.StatsInfo()
+	produced := flats[idx].Origin.StatsInfo()
+	table.Filtered = "100.00"
+	if pp, ok := flats[scanIdx].Origin.(base.PhysicalPlan); ok {
+		table.RowsExaminedPerScan = int64(pp.GetEstRowCountForDisplay())
+	} else if examined != nil {
+		table.RowsExaminedPerScan = int64(examined.RowCount)
+	}
+	if pp, ok := flats[idx].Origin.(base.PhysicalPlan); ok {
+		table.RowsProducedPerJoin = int64(pp.GetEstRowCountForDisplay())
+	} else if produced != nil {
+		table.RowsProducedPerJoin = int64(produced.RowCount)
+	}
+	if table.RowsExaminedPerScan > 0 && table.RowsProducedPerJoin < table.RowsExaminedPerScan {
+		table.Filtered = strconv.FormatFloat(float64(table.RowsProducedPerJoin)*100/float64(table.RowsExaminedPerScan), 'f', 2, 64)
+	}
+
+	if pp, ok := flats[idx].Origin.(base.PhysicalPlan); ok {
+		// The filters on the table are evaluated by the coprocessor, so the cost of them is a part of the cost
+		// of reading the table.
+		readCost, _ := b.e.getEstCost(pp)
+		table.CostInfo = &explainJSONCostInfo{
+			ReadCost:   formatJSONCost(readCost),
+			EvalCost:   formatJSONCost(0),
+			PrefixCost: formatJSONCost(readCost),
+		}
+		if produced != nil {
+			table.CostInfo.DataReadPerJoin = formatJSONBytes(produced.RowCount * getAvgRowSize(produced, pp.Schema().Columns))
+		}
+	}
+	return table
+}
+
+// selfCost returns the cost of flats[idx] excluding the cost of its children.
+func (b *explainJSONBuilder) selfCost(flats FlatPlanTree, idx int) float64 {
+	pp, ok := flats[idx].Origin.(base.PhysicalPlan)
+	if !ok {
+		return 0
+	}
+	cost, _ := b.e.getEstCost(pp)
+	for _, childIdx := range flats[idx].ChildrenIdx {
+		if child, ok := flats[childIdx].Origin.(base.PhysicalPlan); ok {
+			childCost, _ := b.e.getEstCost(child)
+			cost -= childCost
+		}
+	}
+	if cost < 0 {
+		return 0
+	}
+	return cost
+}
+
+func (b *explainJSONBuilder) tidbInfo(flatOp *FlatOperator) *ExplainInfoForEncode {
+	taskTp := "root"
+	if !flatOp.IsRoot {
+		taskTp = flatOp.ReqType.Name() + "[" + flatOp.StoreType.Name() + "]"
+	}
+	explainID := flatOp.Origin.ExplainID().String() + flatOp.Label.String()
+	return b.e.prepareOperatorInfoForJSONFormat(flatOp.Origin, taskTp, explainID, explainID)
+}
+
+// indexJoinInnerPlan returns the inner child of an index join, or nil if p is not an index join.
+func indexJoinInnerPlan(p base.Plan) base.PhysicalPlan {
+	var join *PhysicalIndexJoin
+	switch x := p.(type) {
+	case *PhysicalIndexJoin:
+		join = x
+	case *PhysicalIndexHashJoin:
+		join = &x.PhysicalIndexJoin
+	case *PhysicalIndexMergeJoin:
+		join = &x.PhysicalIndexJoin
+	default:
+		return nil
+	}
+	return join.Children()[join.InnerChildIdx]
+}
+
+func tableNameForJSON(tbl *model.TableInfo, asName *model.CIStr) string {
+	if asName != nil && asName.L != "" {
+		return asName.O
+	}
+	if tbl == nil {
+		return ""
+	}
+	return tbl.Name.O
+}
+
+func formatJSONCost(cost float64) string {
+	return strconv.FormatFloat(cost, 'f', 2, 64)
+}
+
+// formatJSONBytes formats the size like MySQL, e.g. "160", "1K" and "2M".
+func formatJSONBytes(size float64) string {
+	switch {
+	case size >= 1<<30:
+		return fmt.Sprintf("%.0fG", size/(1<<30))
+	case size >= 1<<20:
+		return fmt.Sprintf("%.0fM", size/(1<<20))
+	case size >= 1<<10:
+		return fmt.Sprintf("%.0fK", size/(1<<10))
+	}
+	return fmt.Sprintf("%.0f", size)
+}
@@ -24,6 +24,7 @@ import (
 	"time"
 
 	"github.com/pingcap/errors"
+	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/expression/aggregation"
 	"github.com/pingcap/tidb/pkg/infoschema"
@@ -54,6 +55,28 @@ import (
 // EvalSubqueryFirstRow evaluates incorrelated subqueries once, and get first row.
 var EvalSubqueryFirstRow func(ctx context.Context, p base.PhysicalPlan, is infoschema.InfoSchema, sctx base.PlanContext) (row []types.Datum, err error)
 
+// evalSubqueryFirstRowWithin evaluates the uncorrelated scalar subquery like EvalSubqueryFirstRow, but within the
+// max_execution_time of the subquery block in milliseconds. If the subquery runs out of its time, NULLs are used as
+// its result with a warning, so a best-effort subquery doesn't fail the whole statement.
+func evalSubqueryFirstRowWithin(ctx context.Context, p base.PhysicalPlan, is infoschema.InfoSchema, sctx base.PlanContext, maxExecutionTime uint64) ([]types.Datum, error) {
+	if maxExecutionTime == 0 {
+		return EvalSubqueryFirstRow(ctx, p, is, sctx)
+	}
+	subqueryCtx, cancel := context.WithTimeout(ctx, time.Duration(maxExecutionTime)*time.Millisecond)
+	defer cancel()
+	failpoint.Inject("mockSlowScalarSubquery", func() {
+		<-subqueryCtx.Done()
+	})
+	row, err := EvalSubqueryFirstRow(subqueryCtx, p, is, sctx)
+	// The statement itself may be canceled or killed, only the timeout of the subquery falls back to NULLs.
+	if subqueryCtx.Err() == context.DeadlineExceeded && ctx.Err() == nil {
+		sctx.GetSessionVars().StmtCtx.SetHintWarning(fmt.Sprintf(
+			"The scalar subquery exceeded MAX_EXECUTION_TIME(%d), NULL is used as its result.", maxExecutionTime))
+		return make([]types.Datum, p.Schema().Len()), nil
+	}
+	return row, err
+}
+
 // evalAstExprWithPlanCtx evaluates ast expression with plan context.
 // Different with expression.EvalSimpleAst, it uses planner context and is more powerful to build some special expressions
 // like subquery, window function, etc.
@@ -440,7 +463,8 @@ func (er *expressionRewriter) constructBinaryOpFunction(l expression.Expression,
 
 // buildSubquery translates the subquery ast to plan.
 // Subquery related hints are returned through hintFlags. Please see comments around HintFlagSemiJoinRewrite and PlanBuilder.subQueryHintFlags for details.
-func (er *expressionRewriter) buildSubquery(ctx context.Context, planCtx *exprRewriterPlanCtx, subq *ast.SubqueryExpr, subqueryCtx subQueryCtx) (np base.LogicalPlan, hintFlags uint64, err error) {
+// The max_execution_time hint in the subquery block is returned through maxExecutionTime, which is only applicable for the scalar subquery.
+func (er *expressionRewriter) buildSubquery(ctx context.Context, planCtx *exprRewriterPlanCtx, subq *ast.SubqueryExpr, subqueryCtx subQueryCtx) (np base.LogicalPlan, hintFlags, maxExecutionTime uint64, err error) {
 	intest.AssertNotNil(planCtx)
 	b := planCtx.builder
 	if er.schema != nil {
@@ -460,21 +484,30 @@ func (er *expressionRewriter) buildSubquery(ctx context.Context, planCtx *exprRe
 	b.subQueryCtx = subqueryCtx
 	oldHintFlags := b.subQueryHintFlags
 	b.subQueryHintFlags = 0
+	oldMaxExecutionTime := b.subQueryMaxExecutionTime
+	b.subQueryMaxExecutionTime = 0
 	outerWindowSpecs := b.windowSpecs
 	defer func() {
 		b.windowSpecs = outerWindowSpecs
 		b.subQueryCtx = oldSubQCtx
 		b.subQueryHintFlags = oldHintFlags
+		b.subQueryMaxExecutionTime = oldMaxExecutionTime
 	}()
 
 	np, err = b.buildResultSetNode(ctx, subq.Query, false)
 	if err != nil {
-		return nil, 0, err
+		return nil, 0, 0, err
 	}
 	hintFlags = b.subQueryHintFlags
+	maxExecutionTime = b.subQueryMaxExecutionTime
+	if maxExecutionTime > 0 && subqueryCtx != handlingScalarSubquery {
+		b.ctx.GetSessionVars().StmtCtx.SetHintWarning(
+			"MAX_EXECUTION_TIME() in the subquery is inapplicable because it's not a scalar subquery.")
+		maxExecutionTime = 0
+	}
 	// Pop the handle map generated by the subquery.
 	b.handleHelper.popMap()
-	return np, hintFlags, nil
+	return np, hintFlags, maxExecutionTime, nil
 }
 
 func (er *expressionRewriter) requirePlanCtx(inNode ast.Node) (ctx *exprRewriterPlanCtx, err error) {
@@ -694,7 +727,7 @@ func (er *expressionRewriter) handleCompareSubquery(ctx context.Context, planCtx
 		er.err = errors.Errorf("Unknown compare type %T", v.R)
 		return v, true
 	}
-	np, hintFlags, err := er.buildSubquery(ctx, planCtx, subq, handlingCompareSubquery)
+	np, hintFlags, _, err := er.buildSubquery(ctx, planCtx, subq, handlingCompareSubquery)
 	if err != nil {
 		er.err = err
 		return v, true
@@ -1010,7 +1043,7 @@ func (er *expressionRewriter) handleExistSubquery(ctx context.Context, planCtx *
 		er.err = errors.Errorf("Unknown exists type %T", v.Sel)
 		return v, true
 	}
-	np, hintFlags, err := er.buildSubquery(ctx, planCtx, subq, handlingExistsSubquery)
+	np, hintFlags, _, err := er.buildSubquery(ctx, planCtx, subq, handlingExistsSubquery)
 	if err != nil {
 		er.err = err
 		return v, true
@@ -1024,6 +1057,15 @@ func (er *expressionRewriter) handleExistSubquery(ctx context.Context, planCtx *
 		noDecorrelate = false
 	}
 	semiJoinRewrite := hintFlags&hint.HintFlagSemiJoinRewrite > 0
+	materialize, noMaterialize := getMaterializeSubqueryHints(b.ctx, hintFlags)
+	if semiJoinRewrite && materialize {
+		b.ctx.GetSessionVars().StmtCtx.SetHintWarning(
+			"MATERIALIZE_SUBQUERY() and SEMI_JOIN_REWRITE() are in conflict. Both will be ineffective.")
+		semiJoinRewrite = false
+		materialize = false
+	}
+	// NO_MATERIALIZE_SUBQUERY() rewrites the EXISTS subquery into an inner join with aggregation like SEMI_JOIN_REWRITE().
+	semiJoinRewrite = semiJoinRewrite || noMaterialize
 	if semiJoinRewrite && noDecorrelate {
 		b.ctx.GetSessionVars().StmtCtx.SetHintWarning(
 			"NO_DECORRELATE() and SEMI_JOIN_REWRITE() are in conflict. Both will be ineffective.")
@@ -1033,7 +1075,13 @@ func (er *expressionRewriter) handleExistSubquery(ctx context.Context, planCtx *
 
 	if b.disableSubQueryPreprocessing || len(coreusage.ExtractCorrelatedCols4LogicalPlan(np)) > 0 || hasCTEConsumerInSubPlan(np) {
 		planCtx.plan, er.err = b.buildSemiApply(planCtx.plan, np, nil, er.asScalar, v.Not, semiJoinRewrite, noDecorrelate)
-		if er.err != nil || !er.asScalar {
+		if er.err != nil {
+			return v, true
+		}
+		if materialize {
+			planCtx.plan.(*LogicalApply).setPreferredMaterialization()
+		}
+		if !er.asScalar {
 			return v, true
 		}
 		er.ctxStackAppend(planCtx.plan.Schema().Columns[planCtx.plan.Schema().Len()-1], planCtx.plan.OutputNames()[planCtx.plan.Schema().Len()-1])
@@ -1088,6 +1136,18 @@ func (er *expressionRewriter) handleExistSubquery(ctx context.Context, planCtx *
 	return v, true
 }
 
+// getMaterializeSubqueryHints returns whether the MATERIALIZE_SUBQUERY() or NO_MATERIALIZE_SUBQUERY() hint is set for the subquery.
+func getMaterializeSubqueryHints(sctx base.PlanContext, hintFlags uint64) (materialize, noMaterialize bool) {
+	materialize = hintFlags&hint.HintFlagMaterializeSubquery > 0
+	noMaterialize = hintFlags&hint.HintFlagNoMaterializeSubquery > 0
+	if materialize && noMaterialize {
+		sctx.GetSessionVars().StmtCtx.SetHintWarning(
+			"MATERIALIZE_SUBQUERY() and NO_MATERIALIZE_SUBQUERY() are in conflict. Both will be ineffective.")
+		return false, false
+	}
+	return materialize, noMaterialize
+}
+
 // popExistsSubPlan will remove the useless plan in exist's child.
 // See comments inside the method for more details.
 func (*expressionRewriter) popExistsSubPlan(planCtx *exprRewriterPlanCtx, p base.LogicalPlan) base.LogicalPlan {
@@ -1128,7 +1188,7 @@ func (er *expressionRewriter) handleInSubquery(ctx context.Context, planCtx *exp
 		er.err = errors.Errorf("Unknown compare type %T", v.Sel)
 		return v, true
 	}
-	np, hintFlags, err := er.buildSubquery(ctx, planCtx, subq, handlingInSubquery)
+	np, hintFlags, _, err := er.buildSubquery(ctx, planCtx, subq, handlingInSubquery)
 	if err != nil {
 		er.err = err
 		return v, true
@@ -1204,7 +1264,21 @@ func (er *expressionRewriter) handleInSubquery(ctx context.Context, planCtx *exp
 	// and has no correlated column from the current level plan(if the correlated column is from upper level,
 	// we can treat it as constant, because the upper LogicalApply cannot be eliminated since current node is a join node),
 	// and don't need to append a scalar value, we can rewrite it to inner join.
-	if planCtx.builder.ctx.GetSessionVars().GetAllowInSubqToJoinAndAgg() && !v.Not && !asScalar && len(corCols) == 0 && collFlag {
+	canRewriteToJoin := !v.Not && !asScalar && len(corCols) == 0 && collFlag
+	materialize, noMaterialize := getMaterializeSubqueryHints(planCtx.builder.ctx, hintFlags)
+	if noMaterialize && !canRewriteToJoin {
+		planCtx.builder.ctx.GetSessionVars().StmtCtx.SetHintWarning(
+			"NO_MATERIALIZE_SUBQUERY() is inapplicable because the IN subquery can't be rewritten into an inner join.")
+		noMaterialize = false
+	}
+	// MATERIALIZE_SUBQUERY() and NO_MATERIALIZE_SUBQUERY() take precedence over tidb_opt_insubq_to_join_and_agg.
+	rewriteToJoin := planCtx.builder.ctx.GetSessionVars().GetAllowInSubqToJoinAndAgg()
+	if materialize {
+		rewriteToJoin = false
+	} else if noMaterialize {
+		rewriteToJoin = true
+	}
+	if rewriteToJoin && canRewriteToJoin {
 		// We need to try to eliminate the agg and the projection produced by this operation.
 		planCtx.builder.optFlag |= flagEliminateAgg
 		planCtx.builder.optFlag |= flagEliminateProjection
@@ -1233,6 +1307,9 @@ func (er *expressionRewriter) handleInSubquery(ctx context.Context, planCtx *exp
 		if er.err != nil {
 			return v, true
 		}
+		if materialize {
+			planCtx.plan.(*LogicalApply).setPreferredMaterialization()
+		}
 	}
 
 	er.ctxStackPop(1)
@@ -1247,7 +1324,7 @@ func (er *expressionRewriter) handleScalarSubquery(ctx context.Context, planCtx
 	intest.AssertNotNil(planCtx)
 	ci := planCtx.builder.prepareCTECheckForSubQuery()
 	defer resetCTECheckForSubQuery(ci)
-	np, hintFlags, err := er.buildSubquery(ctx, planCtx, v, handlingScalarSubquery)
+	np, hintFlags, maxExecutionTime, err := er.buildSubquery(ctx, planCtx, v, handlingScalarSubquery)
 	if err != nil {
 		er.err = err
 		return v, true
@@ -1262,6 +1339,10 @@ func (er *expressionRewriter) handleScalarSubquery(ctx context.Context, planCtx
 	}
 
 	if planCtx.builder.disableSubQueryPreprocessing || len(coreusage.ExtractCorrelatedCols4LogicalPlan(np)) > 0 || hasCTEConsumerInSubPlan(np) {
+		if maxExecutionTime > 0 {
+			planCtx.builder.ctx.GetSessionVars().StmtCtx.SetHintWarning(
+				"MAX_EXECUTION_TIME() in the subquery is inapplicable because the subquery isn't evaluated separately.")
+		}
 		planCtx.plan = planCtx.builder.buildApplyWithJoinType(planCtx.plan, np, LeftOuterJoin, noDecorrelate)
 		if np.Schema().Len() > 1 {
 			newCols := make([]expression.Expression, 0, np.Schema().Len())
@@ -1290,9 +1371,10 @@ func (er *expressionRewriter) handleScalarSubquery(ctx context.Context, planCtx
 	}
 	if planCtx.builder.ctx.GetSessionVars().StmtCtx.InExplainStmt && !planCtx.builder.ctx.GetSessionVars().StmtCtx.InExplainAnalyzeStmt && planCtx.builder.ctx.GetSessionVars().ExplainNonEvaledSubQuery {
 		subqueryCtx := ScalarSubqueryEvalCtx{
-			scalarSubQuery: physicalPlan,
-			ctx:            ctx,
-			is:             planCtx.builder.is,
+			scalarSubQuery:   physicalPlan,
+			ctx:              ctx,
+			is:               planCtx.builder.is,
+			maxExecutionTime: maxExecutionTime,
 		}.Init(planCtx.builder.ctx, np.QueryBlockOffset())
 		newColIDs := make([]int64, 0, np.Schema().Len())
 		newScalarSubQueryExprs := make([]expression.Expression, 0, np.Schema().Len())
@@ -1322,7 +1404,7 @@ func (er *expressionRewriter) handleScalarSubquery(ctx context.Context, planCtx
 		}
 		return v, true
 	}
-	row, err := EvalSubqueryFirstRow(ctx, physicalPlan, planCtx.builder.is, planCtx.builder.ctx)
+	row, err := evalSubqueryFirstRowWithin(ctx, physicalPlan, planCtx.builder.is, planCtx.builder.ctx, maxExecutionTime)
 	if err != nil {
 		er.err = err
 		return v, true
@@ -1527,6 +1609,10 @@ func (er *expressionRewriter) Leave(originInNode ast.Node) (retNode ast.Node, ok
 		withPlanCtx(func(planCtx *exprRewriterPlanCtx) {
 			er.positionToScalarFunc(planCtx, v)
 		})
+	case *ast.MatchAgainst:
+		withPlanCtx(func(planCtx *exprRewriterPlanCtx) {
+			er.matchAgainstToExpression(planCtx, v)
+		})
 	case *ast.IsNullExpr:
 		er.isNullToExpression(v)
 	case *ast.IsTruthExpr:
@@ -1810,6 +1896,220 @@ func (er *expressionRewriter) isNullToExpression(v *ast.IsNullExpr) {
 	er.ctxStackAppend(function, types.EmptyName)
 }
 
+// matchAgainstToExpression rewrites MATCH ... AGAINST in natural language mode.
+// In the WHERE, ON and HAVING clauses, it's rewritten to
+// `JSON_OVERLAPS(tidb_fulltext_tokens(cols), tidb_fulltext_tokens(str))`, whose result is 1 if the columns contain any
+// token of the search string, otherwise 0. Since the FULLTEXT index is a multi-valued index on the tokens of the
+// columns, the table can be accessed by the index. If there is no FULLTEXT index on the columns or the
+// NO_FULLTEXT_INDEX hint is specified, the same expression is evaluated on every row instead, so the result is the
+// same with or without the index, and `LOWER(CONCAT_WS(' ', cols)) LIKE '%token%' OR ...` is added before it to
+// filter the rows in TiKV.
+// In the other clauses, such as the field list and ORDER BY, it's rewritten to the relevance, which is the number of
+// the tokens of the search string contained in the columns. Unlike MySQL, the relevance is not weighted by the
+// frequencies of the tokens in the rows and the table.
+func (er *expressionRewriter) matchAgainstToExpression(planCtx *exprRewriterPlanCtx, v *ast.MatchAgainst) {
+	if v.Modifier.IsBooleanMode() || v.Modifier.WithQueryExpansion() {
+		er.err = plannererrors.ErrNotSupportedYet.GenWithStackByArgs("MATCH ... AGAINST in BOOLEAN MODE or WITH QUERY EXPANSION")
+		return
+	}
+	stkLen := len(er.ctxStack)
+	colCnt := len(v.ColumnNames)
+	against, ok := er.ctxStack[stkLen-1].(*expression.Constant)
+	if !ok {
+		er.err = plannererrors.ErrWrongArguments.GenWithStackByArgs("AGAINST")
+		return
+	}
+	if expression.MaybeOverOptimized4PlanCache(er.sctx, []expression.Expression{against}) {
+		er.sctx.SetSkipPlanCache("MATCH ... AGAINST needs the search string to choose the FULLTEXT index")
+	}
+	names := er.ctxNameStk[stkLen-colCnt-1 : stkLen-1]
+	colsByName := make(map[string]*expression.Column, colCnt)
+	for i, arg := range er.ctxStack[stkLen-colCnt-1 : stkLen-1] {
+		col, ok := arg.(*expression.Column)
+		if !ok || names[i].OrigTblName.L == "" || names[i].TblName.L != names[0].TblName.L || names[i].DBName.L != names[0].DBName.L {
+			// All the columns of MATCH must be the columns of the same table.
+			er.err = plannererrors.ErrWrongArguments.GenWithStackByArgs("MATCH")
+			return
+		}
+		colsByName[names[i].OrigColName.L] = col
+	}
+	dbName := names[0].DBName
+	if dbName.L == "" {
+		dbName = model.NewCIStr(planCtx.builder.ctx.GetSessionVars().CurrentDB)
+	}
+	tbl, err := planCtx.builder.is.TableByName(dbName, names[0].OrigTblName)
+	if err != nil {
+		er.err = err
+		return
+	}
+	// The columns are ordered by the offsets like the FULLTEXT index.
+	cols := make([]expression.Expression, 0, len(colsByName))
+	for _, colInfo := range tbl.Meta().Columns {
+		if col, ok := colsByName[colInfo.Name.L]; ok {
+			cols = append(cols, col)
+		}
+	}
+
+	var preferFullText uint
+	if hints := planCtx.builder.TableHints(); hints != nil {
+		preferFullText = hints.PreferFullText
+	}
+	hasIndex := findFullTextIndex(tbl.Meta(), colsByName) != nil
+	if !hasIndex && preferFullText&hint.PreferFullTextIndex > 0 {
+		er.err = plannererrors.ErrFtMatchingKeyNotFound.GenWithStackByArgs()
+		return
+	}
+	if !hasIndex {
+		planCtx.builder.ctx.GetSessionVars().StmtCtx.AppendWarning(plannererrors.ErrFtMatchingKeyNotFound.FastGenByArgs())
+	}
+	var function expression.Expression
+	switch planCtx.builder.curClause {
+	case whereClause, onClause, havingClause:
+		function, err = er.matchAgainstByTokens(cols, against)
+		// The FULLTEXT indexes are not in the access paths if the NO_FULLTEXT_INDEX hint is specified,
+		// see getPossibleAccessPaths.
+		if err == nil && (!hasIndex || preferFullText&hint.PreferNoFullTextIndex > 0) {
+			function, err = er.matchAgainstWithLikeFilter(cols, against, function)
+		}
+	default:
+		function, err = er.matchAgainstRelevance(cols, against)
+	}
+	if err != nil {
+		er.err = err
+		return
+	}
+	er.ctxStackPop(colCnt + 1)
+	er.ctxStackAppend(function, types.EmptyName)
+}
+
+// findFullTextIndex finds the public FULLTEXT index on exactly the columns.
+func findFullTextIndex(tblInfo *model.TableInfo, cols map[string]*expression.Column) *model.IndexInfo {
+	for _, idx := range tblInfo.Indices {
+		if idx.Tp != model.IndexTypeFullText || idx.State != model.StatePublic || idx.Invisible {
+			continue
+		}
+		deps := tblInfo.Columns[idx.Columns[0].Offset].Dependences
+		if len(deps) != len(cols) {
+			continue
+		}
+		matched := true
+		for name := range cols {
+			if _, ok := deps[name]; !ok {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return idx
+		}
+	}
+	return nil
+}
+
+func (er *expressionRewriter) matchAgainstByTokens(cols []expression.Expression, against *expression.Constant) (expression.Expression, error) {
+	jsonType := types.NewFieldType(mysql.TypeJSON)
+	colTokens, err := er.newFunction(ast.TiDBFullTextTokens, jsonType, cols...)
+	if err != nil {
+		return nil, err
+	}
+	againstTokens, err := er.newFunction(ast.TiDBFullTextTokens, jsonType, against)
+	if err != nil {
+		return nil, err
+	}
+	return er.newFunction(ast.JSONOverlaps, types.NewFieldType(mysql.TypeLonglong), colTokens, againstTokens)
+}
+
+// againstTokens returns the tokens of the search string of MATCH ... AGAINST.
+func (er *expressionRewriter) againstTokens(against *expression.Constant) ([]string, error) {
+	str, isNull, err := against.EvalString(er.sctx.GetEvalCtx(), chunk.Row{})
+	if err != nil || isNull {
+		return nil, err
+	}
+	return expression.SplitFullTextTokens(str, nil, make(map[string]struct{})), nil
+}
+
+// matchAgainstWithLikeFilter adds the LIKE conditions of matchAgainstByLike before matched, since LIKE can be pushed
+// down to TiKV but tidb_fulltext_tokens can't. The LIKE conditions only filter the rows, the result is still decided
+// by matched. The binary strings are not lowercased by LOWER, so no LIKE conditions are added for them.
+func (er *expressionRewriter) matchAgainstWithLikeFilter(cols []expression.Expression, against *expression.Constant, matched expression.Expression) (expression.Expression, error) {
+	for _, col := range cols {
+		if types.IsBinaryStr(col.GetType()) {
+			return matched, nil
+		}
+	}
+	like, err := er.matchAgainstByLike(cols, against)
+	if err != nil {
+		return nil, err
+	}
+	return er.newFunction(ast.LogicAnd, types.NewFieldType(mysql.TypeLonglong), like, matched)
+}
+
+// matchAgainstByLike returns `LOWER(CONCAT_WS(' ', cols)) LIKE '%token%' OR ...` for the tokens of the search string,
+// which is true for every row whose tokens overlap the tokens of the search string.
+func (er *expressionRewriter) matchAgainstByLike(cols []expression.Expression, against *expression.Constant) (expression.Expression, error) {
+	tokens, err := er.againstTokens(against)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return expression.NewZero(), nil
+	}
+	args := make([]expression.Expression, 0, len(cols)+1)
+	args = append(args, expression.NewStrConst(" "))
+	args = append(args, cols...)
+	text, err := er.newFunction(ast.ConcatWS, types.NewFieldType(mysql.TypeVarString), args...)
+	if err != nil {
+		return nil, err
+	}
+	if text, err = er.newFunction(ast.Lower, types.NewFieldType(mysql.TypeVarString), text); err != nil {
+		return nil, err
+	}
+	likes := make([]expression.Expression, 0, len(tokens))
+	for _, token := range tokens {
+		// `_` is a wildcard of LIKE, it's escaped to match itself.
+		pattern := "%" + strings.ReplaceAll(token, "_", "\\_") + "%"
+		like, err := er.newFunction(ast.Like, types.NewFieldType(mysql.TypeLonglong), text,
+			expression.NewStrConst(pattern), expression.NewInt64Const(int64('\\')))
+		if err != nil {
+			return nil, err
+		}
+		likes = append(likes, like)
+	}
+	return expression.ComposeDNFCondition(er.sctx, likes...), nil
+}
+
+// matchAgainstRelevance returns `'token' MEMBER OF (tidb_fulltext_tokens(cols)) + ...` for the tokens of the search
+// string, which is the number of the tokens contained in the columns.
+func (er *expressionRewriter) matchAgainstRelevance(cols []expression.Expression, against *expression.Constant) (expression.Expression, error) {
+	tokens, err := er.againstTokens(against)
+	if err != nil {
+		return nil, err
+	}
+	if len(tokens) == 0 {
+		return expression.NewZero(), nil
+	}
+	colTokens, err := er.newFunction(ast.TiDBFullTextTokens, types.NewFieldType(mysql.TypeJSON), cols...)
+	if err != nil {
+		return nil, err
+	}
+	var relevance expression.Expression
+	for _, token := range tokens {
+		contained, err := er.newFunction(ast.JSONMemberOf, types.NewFieldType(mysql.TypeLonglong),
+			expression.NewStrConst(token), colTokens)
+		if err != nil {
+			return nil, err
+		}
+		if relevance == nil {
+			relevance = contained
+			continue
+		}
+		if relevance, err = er.newFunction(ast.Plus, types.NewFieldType(mysql.TypeLonglong), relevance, contained); err != nil {
+			return nil, err
+		}
+	}
+	return relevance, nil
+}
+
 func (er *expressionRewriter) positionToScalarFunc(planCtx *exprRewriterPlanCtx, v *ast.PositionExpr) {
 	intest.AssertNotNil(planCtx)
 	pos := v.N
@@ -111,6 +111,25 @@ func (p *LogicalTableDual) FindBestTask(prop *property.PhysicalProperty, planCou
 	return rt, 1, nil
 }
 
+// FindBestTask implements the LogicalPlan interface.
+func (p *LogicalJSONTable) FindBestTask(prop *property.PhysicalProperty, planCounter *base.PlanCounterTp, opt *optimizetrace.PhysicalOptimizeOp) (base.Task, int64, error) {
+	if !prop.IsSortItemEmpty() || planCounter.Empty() {
+		return invalidTask, 0, nil
+	}
+	jt := PhysicalJSONTable{
+		Expr:            p.Expr,
+		Root:            p.Root,
+		Alias:           p.Alias,
+		PushedDownConds: p.PushedDownConds,
+	}.Init(p.SCtx(), p.StatsInfo(), p.QueryBlockOffset())
+	jt.SetSchema(p.schema)
+	planCounter.Dec(1)
+	utilfuncp.AppendCandidate4PhysicalOptimizeOp(opt, p, jt, prop)
+	rt := &RootTask{}
+	rt.SetPlan(jt)
+	return rt, 1, nil
+}
+
 // FindBestTask implements the LogicalPlan interface.
 func (p *LogicalShow) FindBestTask(prop *property.PhysicalProperty, planCounter *base.PlanCounterTp, _ *optimizetrace.PhysicalOptimizeOp) (base.Task, int64, error) {
 	if !prop.IsSortItemEmpty() || planCounter.Empty() {
@@ -708,7 +727,7 @@ func compareCandidates(sctx base.PlanContext, prop *property.PhysicalProperty, l
 	if lhs.path.CountAfterAccess > 100 && rhs.path.CountAfterAccess > 100 && // to prevent some extreme cases, e.g. 0.01 : 10
 		len(lhs.path.PartialIndexPaths) == 0 && len(rhs.path.PartialIndexPaths) == 0 && // not IndexMerge since its row count estimation is not accurate enough
 		prop.ExpectedCnt == math.MaxFloat64 { // Limit may affect access row count
-		threshold := float64(fixcontrol.GetIntWithDefault(sctx.GetSessionVars().OptimizerFixControl, fixcontrol.Fix45132, 1000))
+		threshold := float64(fixcontrol.GetIntWithDefault(sctx.GetSessionVars().GetOptimizerFixControlMap(), fixcontrol.Fix45132, 1000))
 		if threshold > 0 { // set it to 0 to disable this rule
 			if lhs.path.CountAfterAccess/rhs.path.CountAfterAccess > threshold {
 				return -1
@@ -1340,6 +1359,9 @@ func (ds *DataSource) FindBestTask(prop *property.PhysicalProperty, planCounter
 	for _, candidate := range candidates {
 		path := candidate.path
 		if path.PartialIndexPaths != nil {
+			if ds.SampleInfo != nil {
+				continue
+			}
 			idxMergeTask, err := ds.convertToIndexMergeScan(prop, candidate, opt)
 			if err != nil {
 				return nil, 0, err
@@ -1378,7 +1400,7 @@ func (ds *DataSource) FindBestTask(prop *property.PhysicalProperty, planCounter
 			return t, cntPlan, nil
 		}
 
-		canConvertPointGet := len(path.Ranges) > 0 && path.StoreType == kv.TiKV && ds.isPointGetConvertableSchema()
+		canConvertPointGet := len(path.Ranges) > 0 && path.StoreType == kv.TiKV && ds.isPointGetConvertableSchema() && ds.SampleInfo == nil
 
 		if canConvertPointGet && path.Index != nil && path.Index.MVIndex {
 			canConvertPointGet = false // cannot use PointGet upon MVIndex
@@ -1397,12 +1419,15 @@ func (ds *DataSource) FindBestTask(prop *property.PhysicalProperty, planCounter
 			}
 		}
 		if canConvertPointGet && ds.table.Meta().GetPartitionInfo() != nil {
+			// A global index locates the partition of each row by the index value itself,
+			// so the limitations below for batchPointGet on partition table don't apply to it.
+			isGlobalIndex := path.Index != nil && path.Index.Global
 			// partition table with dynamic prune not support batchPointGet
 			// Due to sorting?
-			if canConvertPointGet && len(path.Ranges) > 1 && ds.SCtx().GetSessionVars().StmtCtx.UseDynamicPartitionPrune() {
+			if canConvertPointGet && !isGlobalIndex && len(path.Ranges) > 1 && ds.SCtx().GetSessionVars().StmtCtx.UseDynamicPartitionPrune() {
 				canConvertPointGet = false
 			}
-			if canConvertPointGet && len(path.Ranges) > 1 {
+			if canConvertPointGet && !isGlobalIndex && len(path.Ranges) > 1 {
 				// TODO: This is now implemented, but to decrease
 				// the impact of supporting plan cache for patitioning,
 				// this is not yet enabled.
@@ -1426,7 +1451,7 @@ func (ds *DataSource) FindBestTask(prop *property.PhysicalProperty, planCounter
 						break
 					}
 				}
-				if path != nil && path.Index != nil && path.Index.Global {
+				if isGlobalIndex {
 					// Don't convert to point get during ddl
 					// TODO: Revisit truncate partition and global index
 					if len(ds.tableInfo.GetPartitionInfo().DroppingDefinitions) > 0 ||
@@ -1515,7 +1540,16 @@ func (ds *DataSource) FindBestTask(prop *property.PhysicalProperty, planCounter
 		if ds.preferStoreType&h.PreferTiFlash != 0 {
 			continue
 		}
-		idxTask, err := ds.convertToIndexScan(prop, candidate, opt)
+		var idxTask base.Task
+		if ds.SampleInfo != nil {
+			if _, ok := ds.SampleInfo.SampleRate(); !ok || path.Index.Global || path.Index.MVIndex {
+				continue
+			}
+			// The SYSTEM sampling method can sample the regions of the secondary index instead of the table.
+			idxTask, err = ds.convertToSampleTable(prop, candidate, opt)
+		} else {
+			idxTask, err = ds.convertToIndexScan(prop, candidate, opt)
+		}
 		if err != nil {
 			return nil, 0, err
 		}
@@ -1568,7 +1602,7 @@ func (ds *DataSource) convertToIndexMergeScan(prop *property.PhysicalProperty, c
 		tblColHists:       ds.TblColHists,
 	}
 	cop.physPlanPartInfo = PhysPlanPartInfo{
-		PruningConds:   pushDownNot(ds.SCtx().GetExprCtx(), ds.allConds),
+		PruningConds:   pushDownNot(ds.SCtx().GetExprCtx(), ds.partitionPruningConds()),
 		PartitionNames: ds.partitionNames,
 		Columns:        ds.TblCols,
 		ColumnNames:    ds.names,
@@ -1989,7 +2023,7 @@ func (ds *DataSource) convertToIndexScan(prop *property.PhysicalProperty,
 		expectCnt:   uint64(prop.ExpectedCnt),
 	}
 	cop.physPlanPartInfo = PhysPlanPartInfo{
-		PruningConds:   pushDownNot(ds.SCtx().GetExprCtx(), ds.allConds),
+		PruningConds:   pushDownNot(ds.SCtx().GetExprCtx(), ds.partitionPruningConds()),
 		PartitionNames: ds.partitionNames,
 		Columns:        ds.TblCols,
 		ColumnNames:    ds.names,
@@ -2486,7 +2520,7 @@ func (ds *DataSource) convertToTableScan(prop *property.PhysicalProperty, candid
 			tblColHists: ds.TblColHists,
 		}
 		ts.PlanPartInfo = PhysPlanPartInfo{
-			PruningConds:   pushDownNot(ds.SCtx().GetExprCtx(), ds.allConds),
+			PruningConds:   pushDownNot(ds.SCtx().GetExprCtx(), ds.partitionPruningConds()),
 			PartitionNames: ds.partitionNames,
 			Columns:        ds.TblCols,
 			ColumnNames:    ds.names,
@@ -2520,7 +2554,7 @@ func (ds *DataSource) convertToTableScan(prop *property.PhysicalProperty, candid
 		tblColHists:       ds.TblColHists,
 	}
 	copTask.physPlanPartInfo = PhysPlanPartInfo{
-		PruningConds:   pushDownNot(ds.SCtx().GetExprCtx(), ds.allConds),
+		PruningConds:   pushDownNot(ds.SCtx().GetExprCtx(), ds.partitionPruningConds()),
 		PartitionNames: ds.partitionNames,
 		Columns:        ds.TblCols,
 		ColumnNames:    ds.names,
@@ -2578,6 +2612,30 @@ func (ds *DataSource) convertToSampleTable(prop *property.PhysicalProperty,
 	p.schema = ds.schema
 	rt := &RootTask{}
 	rt.SetPlan(p)
+	rate, ok := ds.SampleInfo.SampleRate()
+	if !ok {
+		return rt, nil
+	}
+	rowCount := ds.tableStats.RowCount
+	if !candidate.path.IsTablePath() {
+		// Only the regions of the index covered by the access ranges are sampled.
+		p.Index, p.Ranges = candidate.path.Index, candidate.path.Ranges
+		rowCount = candidate.path.CountAfterAccess
+		if expression.MaybeOverOptimized4PlanCache(ds.SCtx().GetExprCtx(), candidate.path.AccessConds) {
+			ds.SCtx().GetSessionVars().StmtCtx.SetSkipPlanCache("the ranges of the sampled index may be over-optimized")
+		}
+	}
+	if len(ds.pushedDownConds) == 0 {
+		// The stats of the DataSource have been scaled by the sample rate.
+		p.SetStats(ds.StatsInfo())
+		return rt, nil
+	}
+	// The sampled regions contain the rows out of the access ranges, so all the pushed down predicates are evaluated
+	// above the sampled rows.
+	p.SetStats(ds.tableStats.ScaleByExpectCnt(rowCount * rate))
+	sel := PhysicalSelection{Conditions: ds.pushedDownConds}.Init(ds.SCtx(), ds.StatsInfo(), ds.QueryBlockOffset())
+	sel.SetChildren(p)
+	rt.SetPlan(sel)
 	return rt, nil
 }
 
@@ -2841,6 +2899,7 @@ func (ds *DataSource) getOriginalPhysicalIndexScan(prop *property.PhysicalProper
 		pkIsHandleCol:    ds.getPKIsHandleCol(),
 		constColsByCond:  path.ConstCols,
 		prop:             prop,
+		looseScan:        path.ForceLooseScan,
 	}.Init(ds.SCtx(), ds.QueryBlockOffset())
 	rowCount := path.CountAfterAccess
 	is.initSchema(append(path.FullIdxCols, ds.commonHandleCols...), !isSingleScan)
@@ -2944,7 +3003,13 @@ func validateTableSamplePlan(ds *DataSource, t base.Task, err error) error {
 		return err
 	}
 	if ds.SampleInfo != nil && !t.Invalid() {
-		if _, ok := t.Plan().(*PhysicalTableSample); !ok {
+		p := t.Plan()
+		if sel, ok := p.(*PhysicalSelection); ok {
+			p = sel.Children()[0]
+		}
+		switch p.(type) {
+		case *PhysicalTableSample, *PhysicalTableDual:
+		default:
 			return expression.ErrInvalidTableSample.GenWithStackByArgs("plan not supported")
 		}
 	}
@@ -15,6 +15,8 @@
 package core
 
 import (
+	"slices"
+
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/model"
@@ -91,17 +93,36 @@ func extractTableAsName(p base.PhysicalPlan) (*model.CIStr, *model.CIStr) {
 			return &is.DBName, is.TableAsName
 		}
 		return &is.DBName, &is.Table.Name
+	case *PhysicalTableScan:
+		// The table scans of the MPP plans are not wrapped by the readers.
+		if x.TableAsName.L != "" {
+			return &x.DBName, x.TableAsName
+		}
+		return &x.DBName, &x.Table.Name
 	case *PhysicalSort, *PhysicalSelection, *PhysicalUnionScan, *PhysicalProjection:
 		return extractTableAsName(p.Children()[0])
 	}
 	return nil, nil
 }
 
+// skipMPPExchanges skips the exchange operators of the MPP plan, which don't belong to any query block.
+func skipMPPExchanges(p base.PhysicalPlan) base.PhysicalPlan {
+	for {
+		switch p.(type) {
+		case *PhysicalExchangeReceiver, *PhysicalExchangeSender:
+			p = p.Children()[0]
+		default:
+			return p
+		}
+	}
+}
+
 func getJoinHints(sctx base.PlanContext, joinType string, parentOffset int, nodeType h.NodeType, children ...base.PhysicalPlan) (res []*ast.TableOptimizerHint) {
 	if parentOffset == -1 {
 		return res
 	}
 	for _, child := range children {
+		child = skipMPPExchanges(child)
 		qbOffset := child.QueryBlockOffset()
 		if qbOffset == -1 {
 			continue
@@ -139,6 +160,16 @@ func getJoinHints(sctx base.PlanContext, joinType string, parentOffset int, node
 }
 
 func genHintsFromSingle(p base.PhysicalPlan, nodeType h.NodeType, storeType kv.StoreType, res []*ast.TableOptimizerHint) []*ast.TableOptimizerHint {
+	if sender, ok := p.(*PhysicalExchangeSender); ok {
+		// The compression mode of the exchange operators is set for the whole statement.
+		if sender.CompressionMode != kv.ExchangeCompressionModeNONE {
+			res = append(res, &ast.TableOptimizerHint{
+				HintName: model.NewCIStr(h.HintMppExchangeCompression),
+				HintData: sender.CompressionMode.Name(),
+			})
+		}
+		return res
+	}
 	qbName, err := h.GenerateQBName(nodeType, p.QueryBlockOffset())
 	if err != nil {
 		return res
@@ -182,6 +213,16 @@ func genHintsFromSingle(p base.PhysicalPlan, nodeType h.NodeType, storeType kv.S
 				})
 			}
 		}
+	case *PhysicalTableScan:
+		// The TiFlash table scans of the MPP plans, the other table scans are handled by their readers.
+		if pp.StoreType == kv.TiFlash {
+			res = append(res, &ast.TableOptimizerHint{
+				QBName:   qbName,
+				HintName: model.NewCIStr(h.HintReadFromStorage),
+				HintData: model.NewCIStr(kv.TiFlash.Name()),
+				Tables:   []ast.HintTable{{DBName: pp.DBName, TableName: getTableName(pp.Table.Name, pp.TableAsName)}},
+			})
+		}
 	case *PhysicalIndexLookUpReader:
 		index := pp.IndexPlans[0].(*PhysicalIndexScan)
 		res = append(res, &ast.TableOptimizerHint{
@@ -218,6 +259,14 @@ func genHintsFromSingle(p base.PhysicalPlan, nodeType h.NodeType, storeType kv.S
 			Tables:   []ast.HintTable{{DBName: index.DBName, TableName: getTableName(index.Table.Name, index.TableAsName)}},
 			Indexes:  []model.CIStr{index.Index.Name},
 		})
+	case *PhysicalLooseIndexScan:
+		index := pp.IndexScan
+		res = append(res, &ast.TableOptimizerHint{
+			QBName:   qbName,
+			HintName: model.NewCIStr(h.HintLooseIndexScan),
+			Tables:   []ast.HintTable{{DBName: index.DBName, TableName: getTableName(index.Table.Name, index.TableAsName)}},
+			Indexes:  []model.CIStr{index.Index.Name},
+		})
 	case *PhysicalIndexMergeReader:
 		indexs := make([]model.CIStr, 0, 2)
 		var tableName model.CIStr
@@ -249,6 +298,18 @@ func genHintsFromSingle(p base.PhysicalPlan, nodeType h.NodeType, storeType kv.S
 				HintName: model.NewCIStr(h.HintAggToCop),
 			})
 		}
+		switch pp.MppRunMode {
+		case Mpp1Phase:
+			res = append(res, &ast.TableOptimizerHint{
+				QBName:   qbName,
+				HintName: model.NewCIStr(h.HintMPP1PhaseAgg),
+			})
+		case Mpp2Phase:
+			res = append(res, &ast.TableOptimizerHint{
+				QBName:   qbName,
+				HintName: model.NewCIStr(h.HintMPP2PhaseAgg),
+			})
+		}
 	case *PhysicalStreamAgg:
 		res = append(res, &ast.TableOptimizerHint{
 			QBName:   qbName,
@@ -265,12 +326,119 @@ func genHintsFromSingle(p base.PhysicalPlan, nodeType h.NodeType, storeType kv.S
 	case *PhysicalHashJoin:
 		// TODO: support the hash_join_build and hash_join_probe hint for auto capture
 		res = append(res, getJoinHints(p.SCtx(), h.HintHJ, p.QueryBlockOffset(), nodeType, pp.children...)...)
+		if pp.storeTp == kv.TiFlash {
+			mppJoinHint := h.HintBCJ
+			if pp.mppShuffleJoin {
+				mppJoinHint = h.HintShuffleJoin
+			}
+			res = append(res, getJoinHints(p.SCtx(), mppJoinHint, p.QueryBlockOffset(), nodeType, pp.children...)...)
+		}
 	case *PhysicalIndexJoin:
-		res = append(res, getJoinHints(p.SCtx(), h.HintINLJ, p.QueryBlockOffset(), nodeType, pp.children[pp.InnerChildIdx])...)
+		joinHints := getJoinHints(p.SCtx(), h.HintINLJ, p.QueryBlockOffset(), nodeType, pp.children[pp.InnerChildIdx])
+		res = append(res, joinHints...)
+		res = append(res, getINLJPrefetchHints(joinHints, pp.PrefetchTasks)...)
 	case *PhysicalIndexMergeJoin:
 		res = append(res, getJoinHints(p.SCtx(), h.HintINLMJ, p.QueryBlockOffset(), nodeType, pp.children[pp.InnerChildIdx])...)
 	case *PhysicalIndexHashJoin:
-		res = append(res, getJoinHints(p.SCtx(), h.HintINLHJ, p.QueryBlockOffset(), nodeType, pp.children[pp.InnerChildIdx])...)
+		joinHints := getJoinHints(p.SCtx(), h.HintINLHJ, p.QueryBlockOffset(), nodeType, pp.children[pp.InnerChildIdx])
+		res = append(res, joinHints...)
+		res = append(res, getINLJPrefetchHints(joinHints, pp.PrefetchTasks)...)
+	}
+	return res
+}
+
+// getINLJPrefetchHints generates the inl_join_prefetch hints for the inner tables of the index join hints.
+func getINLJPrefetchHints(joinHints []*ast.TableOptimizerHint, prefetchTasks int) (res []*ast.TableOptimizerHint) {
+	if prefetchTasks <= 0 {
+		return nil
+	}
+	for _, joinHint := range joinHints {
+		for _, tbl := range joinHint.Tables {
+			res = append(res, &ast.TableOptimizerHint{
+				QBName:   joinHint.QBName,
+				HintName: model.NewCIStr(h.HintINLJPrefetch),
+				Tables:   []ast.HintTable{tbl},
+				HintData: uint64(prefetchTasks),
+			})
+		}
 	}
 	return res
 }
+
+// isHintApplied checks whether the hint takes effect in the plan by comparing it with the hints generated from the
+// plan, only the join, the index and the index merge hints can be checked, the second return value is false for others.
+func isHintApplied(usage *h.HintUsage, planHints []*ast.TableOptimizerHint) (applied, checked bool) {
+	switch usage.Name {
+	case h.HintHJ, h.HintSMJ, h.HintINLJ, h.HintINLHJ, h.HintINLMJ, h.HintBCJ, h.HintShuffleJoin:
+		for _, ph := range planHints {
+			if ph.HintName.L != usage.Name || len(ph.Tables) == 0 {
+				continue
+			}
+			for _, tbl := range usage.Tables {
+				if hintTableMatch(ph.Tables[0], tbl.DBName, tbl.TblName) {
+					return true, true
+				}
+			}
+		}
+		return false, true
+	case h.HintUseIndex, h.HintForceIndex, h.HintIgnoreIndex, h.HintOrderIndex, h.HintNoOrderIndex, h.HintIndexMerge:
+		if usage.Index == nil || usage.Index.IndexHint.HintScope != ast.HintForScan {
+			return false, false
+		}
+	default:
+		return false, false
+	}
+
+	idx := usage.Index
+	hinted := func(name model.CIStr) bool {
+		return slices.ContainsFunc(idx.IndexHint.IndexNames, func(n model.CIStr) bool { return n.L == name.L })
+	}
+	allHinted := func(names []model.CIStr) bool {
+		return !slices.ContainsFunc(names, func(n model.CIStr) bool { return !hinted(n) })
+	}
+	accessed := false
+	for _, ph := range planHints {
+		if len(ph.Tables) == 0 || !hintTableMatch(ph.Tables[0], idx.DBName, idx.TblName) {
+			continue
+		}
+		switch ph.HintName.L {
+		case h.HintUseIndex, h.HintIndexMerge:
+			accessed = true
+			// The table scan on the clustered index is generated as `use_index(t)`.
+			used := ph.Indexes
+			if len(used) == 0 {
+				used = []model.CIStr{model.NewCIStr("primary")}
+			}
+			switch usage.Name {
+			case h.HintIgnoreIndex:
+				if slices.ContainsFunc(used, hinted) {
+					return false, true
+				}
+			case h.HintIndexMerge:
+				if ph.HintName.L == h.HintIndexMerge && (len(idx.IndexHint.IndexNames) == 0 || allHinted(used)) {
+					return true, true
+				}
+			case h.HintUseIndex, h.HintForceIndex:
+				if ph.HintName.L == h.HintUseIndex && len(idx.IndexHint.IndexNames) == 0 && len(ph.Indexes) == 0 {
+					return true, true
+				}
+				if len(idx.IndexHint.IndexNames) > 0 && allHinted(used) {
+					return true, true
+				}
+			}
+		case h.HintOrderIndex, h.HintNoOrderIndex:
+			if ph.HintName.L == usage.Name && slices.ContainsFunc(ph.Indexes, hinted) {
+				return true, true
+			}
+		}
+	}
+	return usage.Name == h.HintIgnoreIndex && accessed, true
+}
+
+// hintTableMatch checks whether the table generated from the plan is the one specified in the hint.
+func hintTableMatch(tbl ast.HintTable, dbName, tblName model.CIStr) bool {
+	if tbl.TableName.L != tblName.L {
+		return false
+	}
+	return dbName.L == "" || tbl.DBName.L == "" || tbl.DBName.L == dbName.L
+}
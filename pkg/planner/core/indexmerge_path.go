@@ -16,6 +16,7 @@ package core
 
 import (
 	"cmp"
+	"fmt"
 	"math"
 	"slices"
 	"strings"
@@ -37,6 +38,7 @@ import (
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/chunk"
+	h "github.com/pingcap/tidb/pkg/util/hint"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/ranger"
 	"go.uber.org/zap"
@@ -54,7 +56,11 @@ func (ds *DataSource) generateIndexMergePath() error {
 	defer func() {
 		if len(ds.indexMergeHints) > 0 && warningMsg != "" {
 			ds.indexMergeHints = nil
-			stmtCtx.AppendWarning(errors.NewNoStackError(warningMsg))
+			if ds.partitionName().L != "" {
+				ds.setHintWarning(h.HintIndexMerge, errors.NewNoStackError(warningMsg))
+			} else {
+				stmtCtx.AppendWarning(errors.NewNoStackError(warningMsg))
+			}
 			logutil.BgLogger().Debug(warningMsg)
 		}
 	}()
@@ -71,11 +77,13 @@ func (ds *DataSource) generateIndexMergePath() error {
 	sessionAndStmtPermission := (ds.SCtx().GetSessionVars().GetEnableIndexMerge() || len(ds.indexMergeHints) > 0) && !stmtCtx.NoIndexMergeHint
 	if !sessionAndStmtPermission {
 		warningMsg = "IndexMerge is inapplicable or disabled. Got no_index_merge hint or tidb_enable_index_merge is off."
+		ds.warnInapplicableMVIndexHint("IndexMerge is disabled by no_index_merge hint or tidb_enable_index_merge")
 		return nil
 	}
 
 	if ds.tableInfo.TempTableType == model.TempTableLocal {
 		warningMsg = "IndexMerge is inapplicable or disabled. Cannot use IndexMerge on temporary table."
+		ds.warnInapplicableMVIndexHint("IndexMerge cannot be used on temporary table")
 		return nil
 	}
 
@@ -156,13 +164,6 @@ func (ds *DataSource) generateNormalIndexPartialPaths4DNF(
 			// the entire index merge is not valid anymore.
 			return nil, false, usedMap
 		}
-		// prune out global indexes.
-		itemPaths = slices.DeleteFunc(itemPaths, func(path *util.AccessPath) bool {
-			if path.Index != nil && path.Index.Global {
-				return true
-			}
-			return false
-		})
 		partialPath := buildIndexMergePartialPath(itemPaths)
 		if partialPath == nil {
 			// for this dnf item, we couldn't generate an index merge partial path.
@@ -889,9 +890,6 @@ func (ds *DataSource) generateIndexMerge4ComposedIndex(normalPathCnt int, indexM
 	var mvIndexPathCnt int
 	candidateAccessPaths := make([]*util.AccessPath, 0, len(ds.possibleAccessPaths))
 	for idx := 0; idx < normalPathCnt; idx++ {
-		if ds.possibleAccessPaths[idx].Index != nil && ds.possibleAccessPaths[idx].Index.Global {
-			continue
-		}
 		if (ds.possibleAccessPaths[idx].IsTablePath() &&
 			!ds.isInIndexMergeHints("primary")) ||
 			(!ds.possibleAccessPaths[idx].IsTablePath() &&
@@ -929,7 +927,7 @@ func (ds *DataSource) generateIndexMerge4ComposedIndex(normalPathCnt int, indexM
 			unfinishedIndexMergePath,
 		)
 		if finishedIndexMergePath == nil {
-			return nil
+			continue
 		}
 
 		var mvIndexPartialPathCnt, normalIndexPartialPathCnt int
@@ -943,12 +941,15 @@ func (ds *DataSource) generateIndexMerge4ComposedIndex(normalPathCnt int, indexM
 
 		// Keep the same behavior with previous implementation, we only handle the "composed" case here.
 		if mvIndexPartialPathCnt == 0 || (mvIndexPartialPathCnt == 1 && normalIndexPartialPathCnt == 0) {
-			return nil
+			continue
 		}
 		ds.possibleAccessPaths = append(ds.possibleAccessPaths, finishedIndexMergePath)
 		return nil
 	}
 	// CNF path.
+	// None of the DNF filters composes an index merge path above, so only the CNF items are used here and the DNF
+	// filters are left as the table filters, e.g. `1 member of (j->'$.path') and c = 1` is used to compose the path
+	// for `(a = 1 or b = 1) and 1 member of (j->'$.path') and c = 1`.
 
 	// after fillIndexPath, all cnf items are filled into the suitable index paths, for these normal index paths,
 	// fetch them out as partialIndexPaths of a outerScope index merge.
@@ -1425,6 +1426,21 @@ func (ds *DataSource) cleanAccessPathForMVIndexHint() {
 	}
 	if len(validMVIndexPath) > 0 {
 		ds.possibleAccessPaths = validMVIndexPath
+		return
+	}
+	ds.warnInapplicableMVIndexHint("no MEMBER OF, JSON_CONTAINS or JSON_OVERLAPS filter on its indexed JSON array can be used to access it")
+}
+
+// warnInapplicableMVIndexHint appends a warning for every multi-valued index specified by USE_INDEX or FORCE_INDEX,
+// since a multi-valued index can only be accessed by IndexMerge and the table path is used instead if it's inapplicable.
+func (ds *DataSource) warnInapplicableMVIndexHint(reason string) {
+	for _, p := range ds.possibleAccessPaths {
+		if !isMVIndexPath(p) || !p.Forced {
+			continue
+		}
+		ds.SCtx().GetSessionVars().StmtCtx.SetHintWarning(fmt.Sprintf(
+			"Optimizer Hint USE_INDEX or FORCE_INDEX on the multi-valued index %s is inapplicable because %s",
+			p.Index.Name.O, reason))
 	}
 }
 
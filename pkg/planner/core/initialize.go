@@ -190,6 +190,26 @@ func (p PhysicalTableDual) Init(ctx base.PlanContext, stats *property.StatsInfo,
 	return &p
 }
 
+// Init initializes LogicalJSONTable.
+func (p LogicalJSONTable) Init(ctx base.PlanContext, offset int) *LogicalJSONTable {
+	p.baseLogicalPlan = newBaseLogicalPlan(ctx, plancodec.TypeJSONTable, &p, offset)
+	return &p
+}
+
+// Init initializes PhysicalJSONTable.
+func (p PhysicalJSONTable) Init(ctx base.PlanContext, stats *property.StatsInfo, offset int) *PhysicalJSONTable {
+	p.basePhysicalPlan = newBasePhysicalPlan(ctx, plancodec.TypeJSONTable, &p, offset)
+	p.SetStats(stats)
+	return &p
+}
+
+// Init initializes PhysicalLooseIndexScan.
+func (p PhysicalLooseIndexScan) Init(ctx base.PlanContext, stats *property.StatsInfo, offset int) *PhysicalLooseIndexScan {
+	p.basePhysicalPlan = newBasePhysicalPlan(ctx, plancodec.TypeLooseIndexScan, &p, offset)
+	p.SetStats(stats)
+	return &p
+}
+
 // Init initializes LogicalMaxOneRow.
 func (p LogicalMaxOneRow) Init(ctx base.PlanContext, offset int) *LogicalMaxOneRow {
 	p.baseLogicalPlan = newBaseLogicalPlan(ctx, plancodec.TypeMaxOneRow, &p, offset)
@@ -501,10 +521,13 @@ func (p *PhysicalTableSample) MemoryUsage() (sum int64) {
 		return
 	}
 
-	sum = p.physicalSchemaProducer.MemoryUsage() + size.SizeOfInterface + size.SizeOfBool
+	sum = p.physicalSchemaProducer.MemoryUsage() + size.SizeOfInterface + size.SizeOfBool + size.SizeOfPointer + size.SizeOfSlice
 	if p.TableSampleInfo != nil {
 		sum += p.TableSampleInfo.MemoryUsage()
 	}
+	for _, rang := range p.Ranges {
+		sum += rang.MemUsage()
+	}
 	return
 }
 
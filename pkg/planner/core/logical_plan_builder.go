@@ -71,6 +71,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/plancodec"
 	"github.com/pingcap/tidb/pkg/util/set"
 	"github.com/pingcap/tidb/pkg/util/size"
+	"github.com/pingcap/tidb/pkg/util/stringutil"
 	"github.com/pingcap/tipb/go-tipb"
 )
 
@@ -81,6 +82,9 @@ const (
 	ErrExprInOrderBy = "ORDER BY"
 )
 
+// maxCubeGroupingItems is the max number of the grouping items of CUBE, since CUBE(a1,...,an) expands every row into 2^n rows.
+const maxCubeGroupingItems = 8
+
 // aggOrderByResolver is currently resolving expressions of order by clause
 // in aggregate function GROUP_CONCAT.
 type aggOrderByResolver struct {
@@ -127,10 +131,13 @@ func (a *aggOrderByResolver) Leave(inNode ast.Node) (ast.Node, bool) {
 	return inNode, true
 }
 
-func (b *PlanBuilder) buildExpand(p base.LogicalPlan, gbyItems []expression.Expression) (base.LogicalPlan, []expression.Expression, error) {
+func (b *PlanBuilder) buildExpand(p base.LogicalPlan, gby *ast.GroupByClause, gbyItems []expression.Expression) (base.LogicalPlan, []expression.Expression, error) {
 	b.optFlag |= flagResolveExpand
+	if hintinfo := b.TableHints(); hintinfo != nil && hintinfo.PreferAggType&h.PreferNoExpand > 0 {
+		b.ctx.GetSessionVars().StmtCtx.SetHintWarningFromError(h.ErrWarnHintInapplicable.FastGen("The NO_EXPAND hint is ignored since the grouping sets can only be computed by Expand"))
+	}
 
-	// Rollup syntax require expand OP to do the data expansion, different data replica supply the different grouping layout.
+	// Grouping sets syntax require expand OP to do the data expansion, different data replica supply the different grouping layout.
 	distinctGbyExprs, gbyExprsRefPos := expression.DeduplicateGbyExpression(gbyItems)
 	// build another projection below.
 	proj := LogicalProjection{Exprs: make([]expression.Expression, 0, p.Schema().Len()+len(distinctGbyExprs))}.Init(b.ctx, b.getSelectOffset())
@@ -174,7 +181,20 @@ func (b *PlanBuilder) buildExpand(p base.LogicalPlan, gbyItems []expression.Expr
 	newGbyItems := expression.RestoreGbyExpression(distinctGbyCols, gbyExprsRefPos)
 
 	// build expand.
-	rollupGroupingSets := expression.RollupGroupingSets(newGbyItems)
+	var rollupGroupingSets expression.GroupingSets
+	switch {
+	case gby.GroupingSets != nil:
+		// eg: grouping sets((a,b),(a),()) => {a,b},{a},{}
+		rollupGroupingSets = expression.ExplicitGroupingSets(newGbyItems, gby.GroupingSets)
+	case gby.Cube:
+		if len(newGbyItems) > maxCubeGroupingItems {
+			return nil, nil, plannererrors.ErrNotSupportedYet.GenWithStackByArgs(fmt.Sprintf("CUBE with more than %d grouping items", maxCubeGroupingItems))
+		}
+		// eg: cube(a,b) => {},{a},{b},{a,b}
+		rollupGroupingSets = expression.CubeGroupingSets(newGbyItems)
+	default:
+		rollupGroupingSets = expression.RollupGroupingSets(newGbyItems)
+	}
 	// eg: <a,b,c> with rollup => {},{a},{a,b},{a,b,c}
 	// for every grouping set above, we should individually set those not-needed grouping-set col as null value.
 	// eg: let's say base schema is <a,b,c,d>, d is unrelated col, keep it real in every grouping set projection.
@@ -285,7 +305,13 @@ func (b *PlanBuilder) buildAggregation(ctx context.Context, p base.LogicalPlan,
 			p = np
 			newArgList = append(newArgList, newArg)
 		}
-		newFunc, err := aggregation.NewAggFuncDesc(b.ctx.GetExprCtx(), aggFunc.F, newArgList, aggFunc.Distinct)
+		aggFuncName, hasDistinct := aggFunc.F, aggFunc.Distinct
+		if aggFuncName == ast.AggFuncCount && hasDistinct && plan4Agg.PreferAggType&h.PreferApproxCountDistinct > 0 {
+			// The approximate result is good enough for the exploratory queries, and it's much cheaper to compute
+			// since no distinct rows are kept.
+			aggFuncName, hasDistinct = ast.AggFuncApproxCountDistinct, false
+		}
+		newFunc, err := aggregation.NewAggFuncDesc(b.ctx.GetExprCtx(), aggFuncName, newArgList, hasDistinct)
 		if err != nil {
 			return nil, nil, err
 		}
@@ -446,6 +472,9 @@ func (b *PlanBuilder) buildResultSetNode(ctx context.Context, node ast.ResultSet
 		case *ast.TableName:
 			p, err = b.buildDataSource(ctx, v, &x.AsName)
 			isTableName = true
+		case *ast.JSONTable:
+			p, err = b.buildJSONTable(ctx, v, x.AsName)
+			isTableName = true
 		default:
 			err = plannererrors.ErrUnsupportedType.GenWithStackByArgs(v)
 		}
@@ -461,7 +490,7 @@ func (b *PlanBuilder) buildResultSetNode(ctx context.Context, node ast.ResultSet
 				name.TblName = x.AsName
 			}
 		}
-		// `TableName` is not a select block, so we do not need to handle it.
+		// `TableName` and `JSON_TABLE` are not select blocks, so we do not need to handle them.
 		var plannerSelectBlockAsName []ast.HintTable
 		if p := b.ctx.GetSessionVars().PlannerSelectBlockAsName.Load(); p != nil {
 			plannerSelectBlockAsName = *p
@@ -791,7 +820,7 @@ func (p *LogicalJoin) setPreferredJoinTypeAndOrder(hintInfo *h.PlanHints) {
 	}
 	// set the join order
 	if hintInfo.LeadingJoinOrder != nil {
-		p.preferJoinOrder = hintInfo.MatchTableName([]*h.HintedTable{lhsAlias, rhsAlias}, hintInfo.LeadingJoinOrder)
+		p.preferJoinOrder = hintInfo.IfPreferLeading(lhsAlias, rhsAlias)
 	}
 	// set hintInfo for further usage if this hint info can be used.
 	if p.preferJoinType != 0 || p.preferJoinOrder {
@@ -860,9 +889,9 @@ func (p *LogicalJoin) setPreferredJoinType() {
 	}
 }
 
-func (ds *DataSource) setPreferredStoreType(hintInfo *h.PlanHints) {
+func (ds *DataSource) setPreferredStoreType(hintInfo *h.PlanHints) error {
 	if hintInfo == nil {
-		return
+		return nil
 	}
 
 	var alias *h.HintedTable
@@ -896,22 +925,535 @@ func (ds *DataSource) setPreferredStoreType(hintInfo *h.PlanHints) {
 				fmt.Sprintf("Storage hints are conflict, you can only specify one storage type of table %s.%s",
 					alias.DBName.L, alias.TblName.L))
 			ds.preferStoreType = 0
-			return
+			return nil
 		}
+		hasTiFlashPath := false
 		for _, path := range ds.possibleAccessPaths {
 			if path.StoreType == kv.TiFlash {
-				ds.preferStoreType |= h.PreferTiFlash
-				ds.preferPartitions[h.PreferTiFlash] = hintTbl.Partitions
+				hasTiFlashPath = true
 				break
 			}
 		}
-		if ds.preferStoreType&h.PreferTiFlash == 0 {
+		if !hasTiFlashPath {
+			switch ds.SCtx().GetSessionVars().ReadFromStorageFallbackPolicy {
+			case variable.ReadFromStorageFallbackError:
+				return plannererrors.ErrTiFlashReplicaUnavailable.GenWithStackByArgs(
+					ds.DBName.O, ds.table.Meta().Name.O, ds.SCtx().GetSessionVars().GetIsolationReadEngines())
+			case variable.ReadFromStorageFallbackWait:
+				// The executor waits for the replica to become available, so only fail fast here if the
+				// replica never becomes available.
+				sessVars := ds.SCtx().GetSessionVars()
+				if _, ok := sessVars.GetIsolationReadEngines()[kv.TiFlash]; !ok || ds.tableInfo.TiFlashReplica == nil {
+					return plannererrors.ErrTiFlashReplicaUnavailable.GenWithStackByArgs(
+						ds.DBName.O, ds.table.Meta().Name.O, sessVars.GetIsolationReadEngines())
+				}
+				ds.possibleAccessPaths = append(ds.possibleAccessPaths, genTiFlashPath(ds.tableInfo))
+				hasTiFlashPath = true
+			}
+		}
+		if hasTiFlashPath {
+			ds.preferStoreType |= h.PreferTiFlash
+			ds.preferPartitions[h.PreferTiFlash] = hintTbl.Partitions
+		} else {
 			errMsg := fmt.Sprintf("No available path for table %s.%s with the store type %s of the hint /*+ read_from_storage */, "+
 				"please check the status of the table replica and variable value of tidb_isolation_read_engines(%v)",
 				ds.DBName.O, ds.table.Meta().Name.O, kv.TiFlash.Name(), ds.SCtx().GetSessionVars().GetIsolationReadEngines())
 			ds.SCtx().GetSessionVars().StmtCtx.SetHintWarning(errMsg)
 		}
 	}
+	return nil
+}
+
+func (ds *DataSource) setPreferredScanType(hintInfo *h.PlanHints) {
+	if hintInfo == nil {
+		return
+	}
+
+	var alias *h.HintedTable
+	if len(ds.TableAsName.L) != 0 {
+		alias = &h.HintedTable{DBName: ds.DBName, TblName: *ds.TableAsName, SelectOffset: ds.QueryBlockOffset()}
+	} else {
+		alias = &h.HintedTable{DBName: ds.DBName, TblName: ds.tableInfo.Name, SelectOffset: ds.QueryBlockOffset()}
+	}
+	preferScanType := hintInfo.PreferScanType(alias)
+	if preferScanType&(preferScanType-1) != 0 {
+		ds.SCtx().GetSessionVars().StmtCtx.SetHintWarning(
+			fmt.Sprintf("Scan type hints are conflict, you can only specify one of full_scan, index_only and no_full_scan for table %s.%s",
+				alias.DBName.L, alias.TblName.L))
+		return
+	}
+	ds.preferScanType = preferScanType
+}
+
+func (ds *DataSource) setINLJPrefetchTasks(hintInfo *h.PlanHints) {
+	if hintInfo == nil {
+		return
+	}
+
+	var alias *h.HintedTable
+	if len(ds.TableAsName.L) != 0 {
+		alias = &h.HintedTable{DBName: ds.DBName, TblName: *ds.TableAsName, SelectOffset: ds.QueryBlockOffset()}
+	} else {
+		alias = &h.HintedTable{DBName: ds.DBName, TblName: ds.tableInfo.Name, SelectOffset: ds.QueryBlockOffset()}
+	}
+	if hintTbl := hintInfo.IfINLJPrefetch(alias); hintTbl != nil {
+		ds.inljPrefetchTasks = int(hintTbl.Tasks)
+	}
+}
+
+// getSamplePercent returns the percentage of the rows to sample by the SYSTEM or BERNOULLI TABLESAMPLE clause
+// or the sample hint. The returned bool is false if the rows of the DataSource are not sampled in this way.
+func (ds *DataSource) getSamplePercent(hintInfo *h.PlanHints, tableSample *ast.TableSample) (float64, bool, error) {
+	var hintTbl *h.SampledTable
+	if hintInfo != nil {
+		var alias *h.HintedTable
+		if len(ds.TableAsName.L) != 0 {
+			alias = &h.HintedTable{DBName: ds.DBName, TblName: *ds.TableAsName, SelectOffset: ds.QueryBlockOffset()}
+		} else {
+			alias = &h.HintedTable{DBName: ds.DBName, TblName: ds.tableInfo.Name, SelectOffset: ds.QueryBlockOffset()}
+		}
+		hintTbl = hintInfo.IfSample(alias)
+	}
+	if tableSample != nil {
+		if hintTbl != nil {
+			ds.SCtx().GetSessionVars().StmtCtx.SetHintWarning(
+				fmt.Sprintf("The SAMPLE hint is ignored since the table %s.%s has the TABLESAMPLE clause",
+					hintTbl.DBName.L, hintTbl.TblName.L))
+		}
+		if tableSample.SampleMethod == ast.SampleMethodTypeTiDBRegion {
+			return 0, false, nil
+		}
+		percent, err := tablesampler.GetSamplePercent(tableSample)
+		return percent, err == nil, err
+	}
+	if hintTbl != nil {
+		return float64(hintTbl.Percent), true, nil
+	}
+	return 0, false, nil
+}
+
+// buildSampleSelection builds a Selection which keeps each row of its child with the probability of the percentage.
+// Since the rows are sampled independently, the Selection doesn't block the predicates from being pushed down to the
+// DataSource and the access paths of the DataSource, while the operators above it like aggregation and limit won't be
+// pushed below it.
+// The sampling is row-level only: RAND() can't be pushed down to the storage, so all the rows matching the pushed down
+// predicates are still read and the sampling only reduces the rows processed by TiDB.
+func (b *PlanBuilder) buildSampleSelection(p base.LogicalPlan, percent float64) (base.LogicalPlan, error) {
+	if percent >= 100 {
+		return p, nil
+	}
+	if percent <= 0 {
+		// No row is sampled, the Selection is turned into a TableDual by the predicate push down.
+		sel := LogicalSelection{Conditions: []expression.Expression{expression.NewZero()}}.Init(b.ctx, b.getSelectOffset())
+		sel.SetChildren(p)
+		return sel, nil
+	}
+	exprCtx := b.ctx.GetExprCtx()
+	randFunc, err := expression.NewFunction(exprCtx, ast.Rand, types.NewFieldType(mysql.TypeDouble))
+	if err != nil {
+		return nil, err
+	}
+	rate := &expression.Constant{Value: types.NewFloat64Datum(percent / 100), RetType: types.NewFieldType(mysql.TypeDouble)}
+	cond, err := expression.NewFunction(exprCtx, ast.LT, types.NewFieldType(mysql.TypeTiny), randFunc, rate)
+	if err != nil {
+		return nil, err
+	}
+	sel := LogicalSelection{Conditions: []expression.Expression{cond}, sampleRate: percent / 100}.Init(b.ctx, b.getSelectOffset())
+	sel.SetChildren(p)
+	return sel, nil
+}
+
+// buildRowPolicySelection injects the predicates of the row policies of the table which apply to the current user.
+// The predicates of the applied policies are combined with OR, and no row is visible if the table has row policies
+// but none of them applies. Users with the BYPASS_ROW_POLICY privilege and internal sessions are not restricted.
+func (b *PlanBuilder) buildRowPolicySelection(p base.LogicalPlan, ds *DataSource, tableInfo *model.TableInfo, tblName model.CIStr) (base.LogicalPlan, error) {
+	if len(tableInfo.RowPolicies) == 0 {
+		return p, nil
+	}
+	sessionVars := b.ctx.GetSessionVars()
+	// The injected predicates depend on the current user, so the plan can't be shared.
+	sessionVars.StmtCtx.SetSkipPlanCache("the table has row policies")
+	pm := privilege.GetPrivilegeManager(b.ctx)
+	if sessionVars.User == nil || pm == nil || pm.RequestDynamicVerification(sessionVars.ActiveRoles, "BYPASS_ROW_POLICY", false) {
+		return p, nil
+	}
+
+	exprCtx := b.ctx.GetExprCtx()
+	conds := make([]expression.Expression, 0, len(tableInfo.RowPolicies))
+	applied := make([]string, 0, len(tableInfo.RowPolicies))
+	for _, policy := range tableInfo.RowPolicies {
+		if !policy.AppliesTo(sessionVars.User, sessionVars.ActiveRoles) {
+			continue
+		}
+		cond, err := expression.ParseSimpleExpr(exprCtx, policy.ExprString, expression.WithInputSchemaAndNames(ds.schema, ds.names, tableInfo))
+		if err != nil {
+			return nil, err
+		}
+		conds = append(conds, cond)
+		applied = append(applied, policy.Name.O)
+	}
+
+	var cond expression.Expression
+	if len(conds) == 0 {
+		cond = expression.NewZero()
+	} else {
+		cond = expression.ComposeDNFCondition(exprCtx, conds...)
+	}
+	if sessionVars.StmtCtx.InExplainStmt {
+		var note string
+		if len(applied) == 0 {
+			note = fmt.Sprintf("No row policy of table '%s' applies to the current user, all rows are filtered out", tblName.O)
+		} else {
+			note = fmt.Sprintf("Row policy '%s' is applied on table '%s'", strings.Join(applied, "', '"), tblName.O)
+		}
+		sessionVars.StmtCtx.AppendNote(errors.NewNoStackError(note))
+	}
+	sel := LogicalSelection{Conditions: []expression.Expression{cond}}.Init(b.ctx, b.getSelectOffset())
+	sel.SetChildren(p)
+	return sel, nil
+}
+
+// buildMaskingProjection masks the columns of the table by the masking policies which apply to the current user.
+// The masked values are computed by a projection right above the table, so the predicates, the sort keys and the
+// error messages of the statement can only see the masked values. The tables modified by UPDATE and DELETE
+// statements are not masked because the original values are written back, their masked columns are recorded and
+// reading them in these statements is rejected when the statements are built.
+// Users with the BYPASS_DATA_MASKING privilege and internal sessions are not restricted.
+func (b *PlanBuilder) buildMaskingProjection(p base.LogicalPlan, ds *DataSource, tn *ast.TableName, tableInfo *model.TableInfo, tblName model.CIStr) (base.LogicalPlan, error) {
+	policies := b.applicableMaskingPolicies(tableInfo)
+	if len(policies) == 0 {
+		return p, nil
+	}
+
+	// The columns which are not masked are passed through as they are, so the handle columns recorded by the
+	// DataSource are still valid above the projection.
+	sessionVars := b.ctx.GetSessionVars()
+	exprs := expression.Column2Exprs(ds.schema.Columns)
+	cols := make([]*expression.Column, len(ds.schema.Columns))
+	copy(cols, ds.schema.Columns)
+	_, isTarget := b.dmlTargetTables[tn]
+	masked := false
+	for _, policy := range policies {
+		idx := -1
+		for i, name := range ds.names {
+			if name.ColName.L == policy.ColumnName.L {
+				idx = i
+				break
+			}
+		}
+		if idx < 0 {
+			continue
+		}
+		if isTarget {
+			b.maskedColumns = append(b.maskedColumns, &maskedColumn{
+				col:     ds.schema.Columns[idx],
+				colName: ds.names[idx].ColName,
+				source:  p,
+			})
+			continue
+		}
+		expr, err := b.buildMaskingExpr(policy, ds.schema.Columns[idx], ds, tableInfo)
+		if err != nil {
+			return nil, err
+		}
+		exprs[idx] = expr
+		col := cols[idx].Clone().(*expression.Column)
+		col.UniqueID = sessionVars.AllocPlanColumnID()
+		col.RetType = expr.GetType().Clone()
+		col.RetType.DelFlag(mysql.NotNullFlag)
+		cols[idx] = col
+		masked = true
+		if sessionVars.StmtCtx.InExplainStmt {
+			sessionVars.StmtCtx.AppendNote(errors.NewNoStackError(fmt.Sprintf(
+				"Column '%s' of table '%s' is masked by masking policy '%s'", ds.names[idx].ColName.O, tblName.O, policy.Name.O)))
+		}
+	}
+	if !masked {
+		return p, nil
+	}
+	proj := LogicalProjection{Exprs: exprs}.Init(b.ctx, b.getSelectOffset())
+	proj.SetChildren(p)
+	proj.SetSchema(expression.NewSchema(cols...))
+	proj.names = ds.names
+	return proj, nil
+}
+
+// applicableMaskingPolicies returns the masking policies of the table which apply to the current user.
+func (b *PlanBuilder) applicableMaskingPolicies(tableInfo *model.TableInfo) []*model.MaskingPolicyInfo {
+	if len(tableInfo.MaskingPolicies) == 0 {
+		return nil
+	}
+	sessionVars := b.ctx.GetSessionVars()
+	// The masked columns depend on the current user, so the plan can't be shared.
+	sessionVars.StmtCtx.SetSkipPlanCache("the table has masking policies")
+	pm := privilege.GetPrivilegeManager(b.ctx)
+	if sessionVars.User == nil || pm == nil || pm.RequestDynamicVerification(sessionVars.ActiveRoles, "BYPASS_DATA_MASKING", false) {
+		return nil
+	}
+	var policies []*model.MaskingPolicyInfo
+	for _, policy := range tableInfo.MaskingPolicies {
+		if policy.AppliesTo(sessionVars.User, sessionVars.ActiveRoles) {
+			policies = append(policies, policy)
+		}
+	}
+	return policies
+}
+
+// buildMaskingExpr builds the expression computing the masked value of the column.
+func (b *PlanBuilder) buildMaskingExpr(policy *model.MaskingPolicyInfo, col *expression.Column, ds *DataSource, tableInfo *model.TableInfo) (expression.Expression, error) {
+	colName := stringutil.Escape(policy.ColumnName.O, mysql.ModeNone)
+	var exprStr string
+	switch policy.Type {
+	case model.MaskingTypeFull:
+		if !types.IsString(col.RetType.GetType()) {
+			retType := col.RetType.Clone()
+			retType.DelFlag(mysql.NotNullFlag)
+			return &expression.Constant{Value: types.NewDatum(nil), RetType: retType}, nil
+		}
+		exprStr = fmt.Sprintf("repeat('*', char_length(%s))", colName)
+	case model.MaskingTypePartial:
+		keep := policy.PartialPrefix + policy.PartialSuffix
+		exprStr = fmt.Sprintf("if(char_length(%[1]s) <= %[2]d, repeat('*', char_length(%[1]s)), "+
+			"concat(left(%[1]s, %[3]d), repeat('*', char_length(%[1]s) - %[2]d), right(%[1]s, %[4]d)))",
+			colName, keep, policy.PartialPrefix, policy.PartialSuffix)
+	case model.MaskingTypeHash:
+		exprStr = fmt.Sprintf("sha2(%s, 256)", colName)
+	default:
+		return nil, errors.Errorf("unknown masking type %d of masking policy '%s'", policy.Type, policy.Name.O)
+	}
+	return expression.ParseSimpleExpr(b.ctx.GetExprCtx(), exprStr, expression.WithInputSchemaAndNames(ds.schema, ds.names, tableInfo))
+}
+
+// collectDMLTargetTables returns the tables modified by the UPDATE or DELETE statement. Only the tables in the
+// FROM clause can be modified, the tables in derived tables, subqueries and views are only read.
+func collectDMLTargetTables(sctx base.PlanContext, node ast.StmtNode) map[*ast.TableName]struct{} {
+	var sources []*ast.TableSource
+	var refs []*ast.ColumnName
+	switch x := node.(type) {
+	case *ast.UpdateStmt:
+		sources = extractTableSources(x.TableRefs.TableRefs, sources)
+		for _, assign := range x.List {
+			refs = append(refs, assign.Column)
+		}
+	case *ast.DeleteStmt:
+		sources = extractTableSources(x.TableRefs.TableRefs, sources)
+		// The single table DELETE statement modifies the only table in the FROM clause.
+		if x.IsMultiTable {
+			for _, tn := range x.Tables.Tables {
+				refs = append(refs, &ast.ColumnName{Schema: tn.Schema, Table: tn.Name})
+			}
+		}
+	}
+	currentDB := sctx.GetSessionVars().CurrentDB
+	targets := make(map[*ast.TableName]struct{}, len(sources))
+	for _, ts := range sources {
+		tn := ts.Source.(*ast.TableName)
+		if refs == nil {
+			targets[tn] = struct{}{}
+			continue
+		}
+		for _, ref := range refs {
+			if ref.Table.L == "" {
+				if tn.TableInfo != nil && model.FindColumnInfo(tn.TableInfo.Columns, ref.Name.L) != nil {
+					targets[tn] = struct{}{}
+				}
+				continue
+			}
+			if ts.AsName.L != "" {
+				if ref.Schema.L == "" && ref.Table.L == ts.AsName.L {
+					targets[tn] = struct{}{}
+				}
+				continue
+			}
+			dbName := tn.Schema.L
+			if dbName == "" {
+				dbName = currentDB
+			}
+			if ref.Table.L == tn.Name.L && (ref.Schema.L == "" || ref.Schema.L == dbName) {
+				targets[tn] = struct{}{}
+			}
+		}
+	}
+	return targets
+}
+
+// extractTableSources appends the table sources of the tables joined by the join tree to result.
+func extractTableSources(node ast.ResultSetNode, result []*ast.TableSource) []*ast.TableSource {
+	switch x := node.(type) {
+	case *ast.Join:
+		result = extractTableSources(x.Left, result)
+		if x.Right != nil {
+			result = extractTableSources(x.Right, result)
+		}
+	case *ast.TableSource:
+		if _, ok := x.Source.(*ast.TableName); ok {
+			result = append(result, x)
+		}
+	}
+	return result
+}
+
+// checkMaskedColumnsInDML returns an error if the UPDATE or DELETE statement reads a masked column of the
+// modified tables. Their original values are only written back, the assigned columns are not read, so they can
+// still be updated.
+func (b *PlanBuilder) checkMaskedColumnsInDML(p base.LogicalPlan, assignments []*expression.Assignment, stmtType string) error {
+	if len(b.maskedColumns) == 0 {
+		return nil
+	}
+	c := &maskedColumnChecker{
+		masked:   make(map[int64]model.CIStr, len(b.maskedColumns)),
+		sources:  make(map[base.LogicalPlan]struct{}, len(b.maskedColumns)),
+		stmtType: stmtType,
+	}
+	for _, m := range b.maskedColumns {
+		c.masked[m.col.UniqueID] = m.colName
+		c.sources[m.source] = struct{}{}
+	}
+	if err := c.check(p); err != nil {
+		return err
+	}
+	for _, assign := range assignments {
+		if err := c.checkExprs(assign.Expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkMaskedColumnsInOnDuplicate returns an error if the ON DUPLICATE KEY UPDATE assignments of the INSERT
+// statement read the masked columns of the existing rows, which could be copied to the columns not masked.
+func (b *PlanBuilder) checkMaskedColumnsInOnDuplicate(insert *Insert, tableInfo *model.TableInfo) error {
+	if len(insert.OnDuplicate) == 0 {
+		return nil
+	}
+	policies := b.applicableMaskingPolicies(tableInfo)
+	if len(policies) == 0 {
+		return nil
+	}
+	c := &maskedColumnChecker{
+		masked:   make(map[int64]model.CIStr, len(policies)),
+		stmtType: "INSERT",
+	}
+	for _, policy := range policies {
+		for i, name := range insert.tableColNames {
+			if name.ColName.L == policy.ColumnName.L {
+				c.masked[insert.tableSchema.Columns[i].UniqueID] = name.ColName
+			}
+		}
+	}
+	for _, assign := range insert.OnDuplicate {
+		if err := c.checkExprs(assign.Expr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// maskedColumn is a masked column of the table modified by the UPDATE or DELETE statement, source is the plan
+// which reads the table, above which the column would be masked.
+type maskedColumn struct {
+	col     *expression.Column
+	colName model.CIStr
+	source  base.LogicalPlan
+}
+
+// maskedColumnChecker finds the expressions reading the masked columns in the logical plan. The columns
+// projected as they are by projections are tracked by the UniqueID of the projected columns.
+type maskedColumnChecker struct {
+	masked   map[int64]model.CIStr
+	sources  map[base.LogicalPlan]struct{}
+	stmtType string
+}
+
+func (c *maskedColumnChecker) check(p base.LogicalPlan) error {
+	if _, ok := c.sources[p]; ok {
+		return nil
+	}
+	for _, child := range p.Children() {
+		if err := c.check(child); err != nil {
+			return err
+		}
+	}
+	switch x := p.(type) {
+	case *LogicalProjection:
+		for i, expr := range x.Exprs {
+			if col, ok := expr.(*expression.Column); ok {
+				if name, ok := c.masked[col.UniqueID]; ok {
+					c.masked[x.schema.Columns[i].UniqueID] = name
+				}
+				continue
+			}
+			if err := c.checkExprs(expr); err != nil {
+				return err
+			}
+		}
+	case *LogicalSelection:
+		return c.checkExprs(x.Conditions...)
+	case *LogicalApply:
+		return c.checkJoin(&x.LogicalJoin)
+	case *LogicalJoin:
+		return c.checkJoin(x)
+	case *LogicalSort:
+		for _, item := range x.ByItems {
+			if err := c.checkExprs(item.Expr); err != nil {
+				return err
+			}
+		}
+	case *LogicalTopN:
+		for _, item := range x.ByItems {
+			if err := c.checkExprs(item.Expr); err != nil {
+				return err
+			}
+		}
+	case *LogicalAggregation:
+		for _, agg := range x.AggFuncs {
+			if err := c.checkExprs(agg.Args...); err != nil {
+				return err
+			}
+		}
+		return c.checkExprs(x.GroupByItems...)
+	case *LogicalWindow:
+		for _, fun := range x.WindowFuncDescs {
+			if err := c.checkExprs(fun.Args...); err != nil {
+				return err
+			}
+		}
+		for _, item := range append(x.PartitionBy, x.OrderBy...) {
+			if err := c.checkExprs(item.Col); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *maskedColumnChecker) checkJoin(p *LogicalJoin) error {
+	for _, cond := range p.EqualConditions {
+		if err := c.checkExprs(cond); err != nil {
+			return err
+		}
+	}
+	for _, cond := range p.NAEQConditions {
+		if err := c.checkExprs(cond); err != nil {
+			return err
+		}
+	}
+	if err := c.checkExprs(p.LeftConditions...); err != nil {
+		return err
+	}
+	if err := c.checkExprs(p.RightConditions...); err != nil {
+		return err
+	}
+	return c.checkExprs(p.OtherConditions...)
+}
+
+func (c *maskedColumnChecker) checkExprs(exprs ...expression.Expression) error {
+	for _, col := range expression.ExtractColumnsAndCorColumnsFromExpressions(nil, exprs) {
+		if name, ok := c.masked[col.UniqueID]; ok {
+			return plannererrors.ErrMaskedColumnInDML.GenWithStackByArgs(name.O, c.stmtType)
+		}
+	}
+	return nil
 }
 
 func resetNotNullFlag(schema *expression.Schema, start, end int) {
@@ -942,7 +1484,20 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (base.L
 		return nil, err
 	}
 
+	// JSON_TABLE may reference the columns of the tables before it, except for the right side of a RIGHT JOIN.
+	isJSONTable := false
+	if ts, ok := joinNode.Right.(*ast.TableSource); ok {
+		_, isJSONTable = ts.Source.(*ast.JSONTable)
+	}
+	if isJSONTable && joinNode.Tp != ast.RightJoin {
+		b.outerSchemas = append(b.outerSchemas, leftPlan.Schema())
+		b.outerNames = append(b.outerNames, leftPlan.OutputNames())
+	}
 	rightPlan, err := b.buildResultSetNode(ctx, joinNode.Right, false)
+	if isJSONTable && joinNode.Tp != ast.RightJoin {
+		b.outerSchemas = b.outerSchemas[0 : len(b.outerSchemas)-1]
+		b.outerNames = b.outerNames[0 : len(b.outerNames)-1]
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -957,6 +1512,14 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (base.L
 	b.handleHelper.mergeAndPush(handleMap1, handleMap2)
 
 	joinPlan := LogicalJoin{StraightJoin: joinNode.StraightJoin || b.inStraightJoin}.Init(b.ctx, b.getSelectOffset())
+	// A JSON_TABLE referencing the left side is evaluated for every row of the left side by a LogicalApply.
+	if isJSONTable && len(coreusage.ExtractCorColumnsBySchema4LogicalPlan(rightPlan, leftPlan.Schema())) > 0 {
+		b.optFlag = b.optFlag | flagBuildKeyInfo | flagDecorrelate
+		ap := &LogicalApply{LogicalJoin: *joinPlan}
+		ap.SetTP(plancodec.TypeApply)
+		ap.self = ap
+		joinPlan = &ap.LogicalJoin
+	}
 	joinPlan.SetChildren(leftPlan, rightPlan)
 	joinPlan.SetSchema(expression.MergeSchema(leftPlan.Schema(), rightPlan.Schema()))
 	joinPlan.names = make([]*types.FieldName, leftPlan.Schema().Len()+rightPlan.Schema().Len())
@@ -1056,7 +1619,7 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (base.L
 		// possible decorrelate optimizations. The ON clause is actually treated as a WHERE clause now.
 		if joinPlan.JoinType == InnerJoin {
 			sel := LogicalSelection{Conditions: onCondition}.Init(b.ctx, b.getSelectOffset())
-			sel.SetChildren(joinPlan)
+			sel.SetChildren(joinPlan.self)
 			return sel, nil
 		}
 		joinPlan.AttachOnConds(onCondition)
@@ -1066,7 +1629,7 @@ func (b *PlanBuilder) buildJoin(ctx context.Context, joinNode *ast.Join) (base.L
 		joinPlan.cartesianJoin = true
 	}
 
-	return joinPlan, nil
+	return joinPlan.self, nil
 }
 
 // buildUsingClause eliminate the redundant columns and ordering columns based
@@ -3649,7 +4212,7 @@ func (*PlanBuilder) checkOnlyFullGroupByWithGroupClause(p base.LogicalPlan, sel
 		}
 		switch errExprLoc.Loc {
 		case ErrExprInSelect:
-			if sel.GroupBy.Rollup {
+			if sel.GroupBy.HasGroupingSets() {
 				return plannererrors.ErrFieldInGroupingNotGroupBy.GenWithStackByArgs(strconv.Itoa(errExprLoc.Offset + 1))
 			}
 			return plannererrors.ErrFieldNotInGroupBy.GenWithStackByArgs(errExprLoc.Offset+1, errExprLoc.Loc, name.DBName.O+"."+name.TblName.O+"."+name.OrigColName.O)
@@ -3848,7 +4411,7 @@ func (b *PlanBuilder) resolveGbyExprs(ctx context.Context, p base.LogicalPlan, g
 		exprs = append(exprs, expr)
 		p = np
 	}
-	return p, exprs, gby.Rollup, nil
+	return p, exprs, gby.HasGroupingSets(), nil
 }
 
 func (*PlanBuilder) unfoldWildStar(p base.LogicalPlan, selectFields []*ast.SelectField) (resultList []*ast.SelectField, err error) {
@@ -3982,8 +4545,8 @@ func (b *PlanBuilder) pushHintWithoutTableWarning(hint *ast.TableOptimizerHint)
 	if err := hint.Restore(ctx); err != nil {
 		return
 	}
-	b.ctx.GetSessionVars().StmtCtx.SetHintWarning(
-		fmt.Sprintf("Hint %s is inapplicable. Please specify the table names in the arguments.", sb.String()))
+	b.ctx.GetSessionVars().StmtCtx.SetHintWarningFromError(
+		h.ErrWarnHintInapplicable.FastGen("Hint %s is inapplicable. Please specify the table names in the arguments.", sb.String()))
 }
 
 func (b *PlanBuilder) pushTableHints(hints []*ast.TableOptimizerHint, currentLevel int) {
@@ -3992,12 +4555,20 @@ func (b *PlanBuilder) pushTableHints(hints []*ast.TableOptimizerHint, currentLev
 	warnHandler := b.ctx.GetSessionVars().StmtCtx
 	planHints, subQueryHintFlags, err := h.ParsePlanHints(hints, currentLevel, currentDB,
 		b.hintProcessor, b.ctx.GetSessionVars().StmtCtx.StraightJoinOrder,
-		b.subQueryCtx == handlingExistsSubquery, b.subQueryCtx == notHandlingSubquery, warnHandler)
+		b.subQueryCtx == handlingExistsSubquery, b.subQueryCtx == handlingInSubquery, b.subQueryCtx == notHandlingSubquery, warnHandler)
 	if err != nil {
 		return
 	}
+	if stmtCtx := b.ctx.GetSessionVars().StmtCtx; b.ctx.GetSessionVars().TraceHints {
+		planHints.MatchTracer = func(decision string) {
+			stmtCtx.HintMatchTraces = append(stmtCtx.HintMatchTraces, decision)
+		}
+	}
 	b.tableHintInfo = append(b.tableHintInfo, planHints)
 	b.subQueryHintFlags |= subQueryHintFlags
+	if planHints.MaxExecutionTime > 0 {
+		b.subQueryMaxExecutionTime = planHints.MaxExecutionTime
+	}
 }
 
 func (b *PlanBuilder) popVisitInfo() {
@@ -4009,8 +4580,18 @@ func (b *PlanBuilder) popVisitInfo() {
 
 func (b *PlanBuilder) popTableHints() {
 	hintInfo := b.tableHintInfo[len(b.tableHintInfo)-1]
-	for _, warning := range h.CollectUnmatchedHintWarnings(hintInfo) {
-		b.ctx.GetSessionVars().StmtCtx.SetHintWarning(warning)
+	sessVars := b.ctx.GetSessionVars()
+	if sessVars.ShouldCollectHintUsages() {
+		for _, usage := range h.CollectHintUsages(hintInfo) {
+			if !usage.Matched {
+				sessVars.StmtCtx.SetHintWarningFromError(usage.Warning)
+			}
+			sessVars.StmtCtx.HintUsages = append(sessVars.StmtCtx.HintUsages, usage)
+		}
+	} else {
+		for _, warning := range h.CollectUnmatchedHintWarnings(hintInfo) {
+			sessVars.StmtCtx.SetHintWarningFromError(warning)
+		}
 	}
 	b.tableHintInfo = b.tableHintInfo[:len(b.tableHintInfo)-1]
 }
@@ -4227,9 +4808,9 @@ func (b *PlanBuilder) buildSelect(ctx context.Context, sel *ast.SelectStmt) (p b
 		}
 	}
 	if needBuildAgg {
-		// if rollup syntax is specified, Expand OP is required to replicate the data to feed different grouping layout.
+		// if grouping sets syntax is specified, Expand OP is required to replicate the data to feed different grouping layout.
 		if rollup {
-			p, gbyCols, err = b.buildExpand(p, gbyCols)
+			p, gbyCols, err = b.buildExpand(p, sel.GroupBy, gbyCols)
 			if err != nil {
 				return nil, err
 			}
@@ -4452,6 +5033,14 @@ func getStatsTable(ctx base.PlanContext, tblInfo *model.TableInfo, pid int64) *s
 		return statistics.PseudoTable(tblInfo, false, true)
 	}
 
+	// Temporary tables can't be analyzed, use the stats collected in the session instead.
+	if tblInfo.TempTableType != model.TempTableNone {
+		if tempStats := getTempTableStats(ctx, tblInfo); tempStats != nil {
+			statsTbl = tempStats.StatsTable(tblInfo)
+			return statsTbl
+		}
+	}
+
 	if pid == tblInfo.ID || ctx.GetSessionVars().StmtCtx.UseDynamicPartitionPrune() {
 		statsTbl = statsHandle.GetTableStats(tblInfo)
 	} else {
@@ -4508,6 +5097,26 @@ func getStatsTable(ctx base.PlanContext, tblInfo *model.TableInfo, pid int64) *s
 	return statsTbl
 }
 
+// getTempTableStats returns the in-session stats of the temporary table, which is collected when the rows are written.
+// The stats of the current transaction is preferred, and the committed stats in the session is used for local
+// temporary tables since their data is kept across transactions.
+func getTempTableStats(ctx base.PlanContext, tblInfo *model.TableInfo) *statistics.TempTableStats {
+	sessVars := ctx.GetSessionVars()
+	if sessVars.TxnCtx != nil {
+		if tempTable, ok := sessVars.TxnCtx.TemporaryTables[tblInfo.ID]; ok {
+			if tempStats, ok := tempTable.GetStats().(*statistics.TempTableStats); ok {
+				return tempStats
+			}
+		}
+	}
+	if tblInfo.TempTableType == model.TempTableLocal && sessVars.TemporaryTableData != nil {
+		if tempStats, ok := sessVars.TemporaryTableData.GetTableStats(tblInfo.ID).(*statistics.TempTableStats); ok {
+			return tempStats
+		}
+	}
+	return nil
+}
+
 // getLatestVersionFromStatsTable gets statistics information for a table specified by "tableID", and get the max
 // LastUpdateVersion among all Columns and Indices in it.
 // Its overall logic is quite similar to getStatsTable(). During plan cache matching, only the latest version is needed.
@@ -4593,6 +5202,7 @@ func (b *PlanBuilder) tryBuildCTE(ctx context.Context, tn *ast.TableName, asName
 
 			if cte.cteClass == nil {
 				cte.cteClass = &CTEClass{
+					Name:                     tn.Name,
 					IsDistinct:               cte.isDistinct,
 					seedPartLogicalPlan:      cte.seedLP,
 					recursivePartLogicalPlan: cte.recurLP,
@@ -4707,6 +5317,125 @@ func (b *PlanBuilder) buildDataSourceFromCTEMerge(ctx context.Context, cte *ast.
 	return p, nil
 }
 
+// buildJSONTable builds the plan of JSON_TABLE. The expression of JSON_TABLE may reference the columns of
+// the tables before it in the FROM clause, they are resolved as correlated columns by the outer schemas.
+func (b *PlanBuilder) buildJSONTable(ctx context.Context, jt *ast.JSONTable, asName model.CIStr) (base.LogicalPlan, error) {
+	dual := LogicalTableDual{RowCount: 1}.Init(b.ctx, b.getSelectOffset())
+	dual.SetSchema(expression.NewSchema())
+	expr, np, err := b.rewrite(ctx, jt.Expr, dual, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	if np != dual {
+		return nil, plannererrors.ErrNotSupportedYet.GenWithStackByArgs("subqueries in the expression of JSON_TABLE")
+	}
+	p := LogicalJSONTable{
+		Expr:  expression.WrapWithCastAsJSON(b.ctx.GetExprCtx(), expr),
+		Alias: asName,
+	}.Init(b.ctx, b.getSelectOffset())
+	schema := expression.NewSchema()
+	names := make(types.NameSlice, 0, len(jt.Columns))
+	p.Root, err = b.buildJSONTablePath(jt.Path, jt.Columns, asName, schema, &names)
+	if err != nil {
+		return nil, err
+	}
+	p.setSchemaAndNames(schema, names)
+	// JSON_TABLE has no handle, but a map is pushed like the other data sources to be popped by the select.
+	b.handleHelper.pushMap(nil)
+	return p, nil
+}
+
+// buildJSONTablePath builds the row path and its nested paths of JSON_TABLE, and appends their columns
+// to the schema and names in the order of the column list.
+func (b *PlanBuilder) buildJSONTablePath(path string, cols []*ast.JSONTableColumn, asName model.CIStr,
+	schema *expression.Schema, names *types.NameSlice) (*JSONTablePath, error) {
+	pathExpr, err := types.ParseJSONPathExpr(path)
+	if err != nil {
+		return nil, err
+	}
+	jp := &JSONTablePath{Path: pathExpr}
+	for _, col := range cols {
+		if col.Tp == ast.JSONTableColumnNested {
+			nested, err := b.buildJSONTablePath(col.Path, col.NestedColumns, asName, schema, names)
+			if err != nil {
+				return nil, err
+			}
+			jp.Nested = append(jp.Nested, nested)
+			continue
+		}
+		c := &JSONTableColumn{Tp: col.Tp, Name: col.Name}
+		var ft *types.FieldType
+		if col.Tp == ast.JSONTableColumnOrdinality {
+			ft = types.NewFieldType(mysql.TypeLonglong)
+			ft.AddFlag(mysql.UnsignedFlag | mysql.NotNullFlag)
+			ft.SetFlen(mysql.MaxIntWidth)
+			ft.SetDecimal(0)
+			ft.SetCharset(charset.CharsetBin)
+			ft.SetCollate(charset.CollationBin)
+		} else {
+			ft = b.jsonTableColumnType(col.FieldType)
+			if c.Path, err = types.ParseJSONPathExpr(col.Path); err != nil {
+				return nil, err
+			}
+			if col.OnEmpty != nil {
+				c.OnEmpty = col.OnEmpty.Tp
+				if c.OnEmpty == ast.JSONTableResponseDefault {
+					if c.EmptyDefault, err = types.ParseBinaryJSONFromString(col.OnEmpty.Default); err != nil {
+						return nil, err
+					}
+				}
+			}
+			if col.OnError != nil {
+				c.OnError = col.OnError.Tp
+				if c.OnError == ast.JSONTableResponseDefault {
+					if c.ErrorDefault, err = types.ParseBinaryJSONFromString(col.OnError.Default); err != nil {
+						return nil, err
+					}
+				}
+			}
+		}
+		name := &types.FieldName{
+			TblName:     asName,
+			OrigTblName: asName,
+			ColName:     col.Name,
+			OrigColName: col.Name,
+		}
+		c.Col = &expression.Column{
+			RetType:  ft,
+			UniqueID: b.ctx.GetSessionVars().AllocPlanColumnID(),
+			OrigName: name.String(),
+		}
+		schema.Append(c.Col)
+		*names = append(*names, name)
+		jp.Columns = append(jp.Columns, c)
+	}
+	return jp, nil
+}
+
+// jsonTableColumnType fills the length, charset and collation of the type of a JSON_TABLE column
+// if they are not specified.
+func (b *PlanBuilder) jsonTableColumnType(tp *types.FieldType) *types.FieldType {
+	ft := tp.Clone()
+	if ft.GetCharset() == "" {
+		var chs, coll string
+		if ft.EvalType() == types.ETString && !mysql.HasBinaryFlag(ft.GetFlag()) {
+			chs, coll = b.ctx.GetSessionVars().GetCharsetInfo()
+		} else {
+			chs, coll = types.DefaultCharsetForType(ft.GetType())
+		}
+		ft.SetCharset(chs)
+		ft.SetCollate(coll)
+	}
+	defaultFlen, defaultDecimal := mysql.GetDefaultFieldLengthAndDecimal(ft.GetType())
+	if ft.GetFlen() == types.UnspecifiedLength {
+		ft.SetFlen(defaultFlen)
+	}
+	if ft.GetDecimal() == types.UnspecifiedLength {
+		ft.SetDecimal(defaultDecimal)
+	}
+	return ft
+}
+
 func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, asName *model.CIStr) (base.LogicalPlan, error) {
 	b.optFlag |= flagPredicateSimplification
 	dbName := tn.Schema
@@ -4757,6 +5486,18 @@ func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, as
 		return b.buildMemTable(ctx, dbName, tableInfo)
 	}
 
+	// The rows of the external table are read from the data files in the external storage, which is the same as
+	// reading the memory tables.
+	if tableInfo.IsExternal() {
+		if tn.TableSample != nil {
+			return nil, expression.ErrInvalidTableSample.GenWithStackByArgs("Unsupported TABLESAMPLE in external tables")
+		}
+		if len(tn.PartitionNames) != 0 {
+			return nil, plannererrors.ErrPartitionClauseOnNonpartitioned
+		}
+		return b.buildMemTable(ctx, dbName, tableInfo)
+	}
+
 	tblName := *asName
 	if tblName.L == "" {
 		tblName = tn.Name
@@ -4818,7 +5559,7 @@ func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, as
 		return nil, plannererrors.ErrPartitionClauseOnNonpartitioned
 	}
 
-	possiblePaths, err := getPossibleAccessPaths(b.ctx, b.TableHints(), tn.IndexHints, tbl, dbName, tblName, b.isForUpdateRead, b.optFlag&flagPartitionProcessor > 0)
+	possiblePaths, err := getPossibleAccessPaths(b.ctx, b.TableHints(), tn.IndexHints, tbl, dbName, tblName, model.CIStr{}, b.isForUpdateRead, b.optFlag&flagPartitionProcessor > 0)
 	if err != nil {
 		return nil, err
 	}
@@ -5018,9 +5759,26 @@ func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, as
 	b.handleHelper.pushMap(handleMap)
 	ds.SetSchema(schema)
 	ds.names = names
-	ds.setPreferredStoreType(b.TableHints())
-	ds.SampleInfo = tablesampler.NewTableSampleInfo(tn.TableSample, schema, b.partitionedTable)
-	b.isSampling = ds.SampleInfo != nil
+	if err = ds.setPreferredStoreType(b.TableHints()); err != nil {
+		return nil, err
+	}
+	ds.setPreferredScanType(b.TableHints())
+	ds.setINLJPrefetchTasks(b.TableHints())
+	samplePercent, sampled, err := ds.getSamplePercent(b.TableHints(), tn.TableSample)
+	if err != nil {
+		return nil, err
+	}
+	if tn.TableSample != nil && tn.TableSample.SampleMethod != ast.SampleMethodTypeBernoulli {
+		// The REGIONS and SYSTEM sampling methods pick the regions to read, so they're executed by PhysicalTableSample.
+		ds.SampleInfo = tablesampler.NewTableSampleInfo(tn.TableSample, schema, b.partitionedTable)
+		ds.SampleInfo.Percent = samplePercent
+		sampled = false
+	}
+	if ds.SampleInfo != nil && tn.TableSample.SampleMethod == ast.SampleMethodTypeTiDBRegion {
+		// The REGIONS sampling method returns one row for each region, so the operators can't be pushed down to it.
+		// The SYSTEM sampling method evaluates the pushed down predicates above the sampled rows.
+		b.isSampling = true
+	}
 
 	for i, colExpr := range ds.Schema().Columns {
 		var expr expression.Expression
@@ -5039,6 +5797,10 @@ func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, as
 		}
 	}
 
+	if variable.EnableCheckConstraint.Load() && len(tableInfo.Constraints) > 0 {
+		ds.checkConstraintConds = buildCheckConstraintConds(ds)
+	}
+
 	// Init commonHandleCols and commonHandleLens for data source.
 	if tableInfo.IsCommonHandle {
 		ds.commonHandleCols, ds.commonHandleLens = expression.IndexInfo2Cols(ds.Columns, ds.schema.Columns, tables.FindPrimaryIndex(tableInfo))
@@ -5078,6 +5840,20 @@ func (b *PlanBuilder) buildDataSource(ctx context.Context, tn *ast.TableName, as
 		}
 		result = us
 	}
+	if sampled {
+		result, err = b.buildSampleSelection(result, samplePercent)
+		if err != nil {
+			return nil, err
+		}
+	}
+	result, err = b.buildRowPolicySelection(result, ds, tableInfo, tblName)
+	if err != nil {
+		return nil, err
+	}
+	result, err = b.buildMaskingProjection(result, ds, tn, tableInfo, tblName)
+	if err != nil {
+		return nil, err
+	}
 
 	// Adding ExtraPhysTblIDCol for SelectLock (SELECT FOR UPDATE) is done when building SelectLock
 
@@ -5213,12 +5989,21 @@ func (ds *DataSource) ExtractFD() *fd.FDSet {
 
 func (b *PlanBuilder) timeRangeForSummaryTable() util.QueryTimeRange {
 	const defaultSummaryDuration = 30 * time.Minute
-	hints := b.TableHints()
+	if tr, ok := b.timeRangeFromHint(defaultSummaryDuration); ok {
+		return tr
+	}
 	// User doesn't use TIME_RANGE hint
+	to := time.Now()
+	from := to.Add(-defaultSummaryDuration)
+	return util.QueryTimeRange{From: from, To: to}
+}
+
+// timeRangeFromHint returns the time range specified by the TIME_RANGE hint. If only one side of the range is valid,
+// the other side is calculated by the defaultDuration. It returns false if the hint is not used or both sides are invalid.
+func (b *PlanBuilder) timeRangeFromHint(defaultDuration time.Duration) (util.QueryTimeRange, bool) {
+	hints := b.TableHints()
 	if hints == nil || (hints.TimeRangeHint.From == "" && hints.TimeRangeHint.To == "") {
-		to := time.Now()
-		from := to.Add(-defaultSummaryDuration)
-		return util.QueryTimeRange{From: from, To: to}
+		return util.QueryTimeRange{}, false
 	}
 
 	// Parse time specified by user via TIM_RANGE hint
@@ -5233,15 +6018,14 @@ func (b *PlanBuilder) timeRangeForSummaryTable() util.QueryTimeRange {
 	to, toValid := parse(hints.TimeRangeHint.To)
 	switch {
 	case !fromValid && !toValid:
-		to = time.Now()
-		from = to.Add(-defaultSummaryDuration)
+		return util.QueryTimeRange{}, false
 	case fromValid && !toValid:
-		to = from.Add(defaultSummaryDuration)
+		to = from.Add(defaultDuration)
 	case !fromValid && toValid:
-		from = to.Add(-defaultSummaryDuration)
+		from = to.Add(-defaultDuration)
 	}
 
-	return util.QueryTimeRange{From: from, To: to}
+	return util.QueryTimeRange{From: from, To: to}, true
 }
 
 func (b *PlanBuilder) buildMemTable(_ context.Context, dbName model.CIStr, tableInfo *model.TableInfo) (base.LogicalPlan, error) {
@@ -5264,6 +6048,10 @@ func (b *PlanBuilder) buildMemTable(_ context.Context, dbName model.CIStr, table
 			ID:       col.ID,
 			RetType:  &col.FieldType,
 		}
+		if tableInfo.IsExternal() {
+			// show the column names of the filters pushed down to the external table in the explain result.
+			newCol.OrigName = names[len(names)-1].String()
+		}
 		if tableInfo.PKIsHandle && mysql.HasPriKeyFlag(col.GetFlag()) {
 			handleCols = util.NewIntHandleCols(newCol)
 		}
@@ -5332,6 +6120,17 @@ func (b *PlanBuilder) buildMemTable(_ context.Context, dbName model.CIStr, table
 			p.Extractor = &TiKVRegionStatusExtractor{tablesID: make([]int64, 0)}
 		}
 	}
+	if tableInfo.IsExternal() {
+		p.Extractor = &ExternalTableExtractor{}
+	}
+	// Push the time range of the TIME_RANGE hint down to the memory tables whose rows are indexed by time, such as
+	// cluster_log, slow_query, statements_summary_history and the metrics tables.
+	if e, ok := p.Extractor.(timeRangeHintExtractor); ok {
+		const defaultTimeRangeDuration = 30 * time.Minute
+		if tr, ok := b.timeRangeFromHint(defaultTimeRangeDuration); ok {
+			e.setTimeRangeHint(tr)
+		}
+	}
 	return p, nil
 }
 
@@ -5354,6 +6153,20 @@ func (b *PlanBuilder) checkRecursiveView(dbName model.CIStr, tableName model.CIS
 	return func() { delete(b.buildingViewStack, viewFullName) }, nil
 }
 
+// appendViewHints merges the hints of `CREATE VIEW ... WITH HINTS` into the hints of the view definition. For the set
+// operations, the hints are merged into the first SELECT, the hints which specify other query blocks still take effect.
+func appendViewHints(node ast.Node, hints []*ast.TableOptimizerHint) {
+	switch x := node.(type) {
+	case *ast.SelectStmt:
+		x.TableHints = append(x.TableHints, hints...)
+	case *ast.SetOprStmt:
+		if x.SelectList == nil || len(x.SelectList.Selects) == 0 {
+			return
+		}
+		appendViewHints(x.SelectList.Selects[0], hints)
+	}
+}
+
 // BuildDataSourceFromView is used to build base.LogicalPlan from view
 // qbNameMap4View and viewHints are used for the view's hint.
 // qbNameMap4View maps the query block name to the view table lists.
@@ -5374,6 +6187,13 @@ func (b *PlanBuilder) BuildDataSourceFromView(ctx context.Context, dbName model.
 	if err != nil {
 		return nil, err
 	}
+	if tableInfo.View.Hints != "" {
+		hints, err := h.ParseHintsStr(tableInfo.View.Hints)
+		if err != nil {
+			return nil, err
+		}
+		appendViewHints(selectNode, hints)
+	}
 	originalVisitInfo := b.visitInfo
 	b.visitInfo = make([]visitInfo, 0)
 
@@ -5635,6 +6455,14 @@ func (b *PlanBuilder) buildSemiJoin(outerPlan, innerPlan base.LogicalPlan, onCon
 	return joinPlan, nil
 }
 
+// setPreferredMaterialization makes the semi join built from the subquery prefer the hash join, which materializes
+// the result of the subquery into a hash table, unless the join method is already specified by other hints.
+func (p *LogicalJoin) setPreferredMaterialization() {
+	if p.preferJoinType == 0 {
+		p.preferJoinType = h.PreferHashJoin
+	}
+}
+
 func getTableOffset(names []*types.FieldName, handleName *types.FieldName) (int, error) {
 	for i, name := range names {
 		if name.DBName.L == handleName.DBName.L && name.TblName.L == handleName.TblName.L {
@@ -5738,6 +6566,7 @@ func (b *PlanBuilder) buildUpdate(ctx context.Context, update *ast.UpdateStmt) (
 
 	b.inUpdateStmt = true
 	b.isForUpdateRead = true
+	b.dmlTargetTables = collectDMLTargetTables(b.ctx, update)
 
 	if update.With != nil {
 		l := len(b.outerCTEs)
@@ -5814,6 +6643,11 @@ func (b *PlanBuilder) buildUpdate(ctx context.Context, update *ast.UpdateStmt) (
 		return nil, err
 	}
 	p = np
+	// The assignments of the generated columns are appended after the assignments in the SET list, they are
+	// computed from the original values and not read by the user.
+	if err = b.checkMaskedColumnsInDML(p, orderedList[:min(len(orderedList), len(update.List))], "UPDATE"); err != nil {
+		return nil, err
+	}
 
 	updt := Update{
 		OrderedList:               orderedList,
@@ -5957,6 +6791,9 @@ func (b *PlanBuilder) buildUpdateLists(ctx context.Context, tableList []*ast.Tab
 				if isCTE(tl) || tl.TableInfo.IsView() || tl.TableInfo.IsSequence() {
 					return nil, nil, false, plannererrors.ErrNonUpdatableTable.GenWithStackByArgs(name.TblName.O, "UPDATE")
 				}
+				if tl.TableInfo.IsExternal() {
+					return nil, nil, false, plannererrors.ErrUnsupportedExternalTable.GenWithStackByArgs(name.TblName.O, "external tables are read-only")
+				}
 				foundListItem = true
 			}
 		}
@@ -6144,6 +6981,7 @@ func (b *PlanBuilder) buildDelete(ctx context.Context, ds *ast.DeleteStmt) (base
 
 	b.inDeleteStmt = true
 	b.isForUpdateRead = true
+	b.dmlTargetTables = collectDMLTargetTables(b.ctx, ds)
 
 	if ds.With != nil {
 		l := len(b.outerCTEs)
@@ -6194,6 +7032,9 @@ func (b *PlanBuilder) buildDelete(ctx context.Context, ds *ast.DeleteStmt) (base
 			return nil, err
 		}
 	}
+	if err = b.checkMaskedColumnsInDML(p, nil, "DELETE"); err != nil {
+		return nil, err
+	}
 
 	// If the delete is non-qualified it does not require Select Priv
 	if ds.Where == nil && ds.Order == nil {
@@ -6276,6 +7117,9 @@ func (b *PlanBuilder) buildDelete(ctx context.Context, ds *ast.DeleteStmt) (base
 			if tn.TableInfo.IsSequence() {
 				return nil, errors.Errorf("delete sequence %s is not supported now", tn.Name.O)
 			}
+			if tn.TableInfo.IsExternal() {
+				return nil, plannererrors.ErrUnsupportedExternalTable.GenWithStackByArgs(tn.Name.O, "external tables are read-only")
+			}
 			if sessionVars.User != nil {
 				authErr = plannererrors.ErrTableaccessDenied.FastGenByArgs("DELETE", sessionVars.User.AuthUsername, sessionVars.User.AuthHostname, tb.Name.L)
 			}
@@ -6294,6 +7138,9 @@ func (b *PlanBuilder) buildDelete(ctx context.Context, ds *ast.DeleteStmt) (base
 			if v.TableInfo.IsSequence() {
 				return nil, errors.Errorf("delete sequence %s is not supported now", v.Name.O)
 			}
+			if v.TableInfo.IsExternal() {
+				return nil, plannererrors.ErrUnsupportedExternalTable.GenWithStackByArgs(v.Name.O, "external tables are read-only")
+			}
 			dbName := v.Schema.L
 			if dbName == "" {
 				dbName = b.ctx.GetSessionVars().CurrentDB
@@ -6629,7 +7476,7 @@ func (b *PlanBuilder) buildWindowFunctionFrame(ctx context.Context, spec *ast.Wi
 	if frameClause == nil {
 		return nil, nil
 	}
-	frame := &WindowFrame{Type: frameClause.Type}
+	frame := &WindowFrame{Type: frameClause.Type, Exclusion: frameClause.Exclusion}
 	var err error
 	frame.Start, err = b.buildWindowFunctionFrameBound(ctx, spec, orderByItems, &frameClause.Extent.Start)
 	if err != nil {
@@ -6915,8 +7762,9 @@ func (b *PlanBuilder) handleDefaultFrame(spec *ast.WindowSpec, windowFuncName st
 		}
 		return &newSpec, true
 	}
-	// "RANGE/ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING" is equivalent to empty frame.
-	if needFrame && spec.Frame != nil &&
+	// "RANGE/ROWS BETWEEN UNBOUNDED PRECEDING AND UNBOUNDED FOLLOWING" is equivalent to empty frame,
+	// unless some rows are excluded from it.
+	if needFrame && spec.Frame != nil && spec.Frame.Exclusion == ast.ExcludeNoOthers &&
 		spec.Frame.Extent.Start.UnBounded && spec.Frame.Extent.End.UnBounded {
 		newSpec := *spec
 		newSpec.Frame = nil
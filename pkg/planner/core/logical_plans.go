@@ -58,6 +58,7 @@ var (
 	_ base.LogicalPlan = &LogicalApply{}
 	_ base.LogicalPlan = &LogicalMaxOneRow{}
 	_ base.LogicalPlan = &LogicalTableDual{}
+	_ base.LogicalPlan = &LogicalJSONTable{}
 	_ base.LogicalPlan = &DataSource{}
 	_ base.LogicalPlan = &TiKVSingleGather{}
 	_ base.LogicalPlan = &LogicalTableScan{}
@@ -943,6 +944,18 @@ func (la *LogicalAggregation) HasDistinct() bool {
 	return false
 }
 
+// AllowDistinctAggPushDown shows whether the aggregation with distinct is allowed to be pushed down to coprocessor.
+// The DISTINCT_AGG_PUSH_DOWN and NO_DISTINCT_AGG_PUSH_DOWN hints take precedence over tidb_opt_distinct_agg_push_down.
+func (la *LogicalAggregation) AllowDistinctAggPushDown() bool {
+	if la.PreferAggType&h.PreferNoDistinctAggPushDown > 0 {
+		return false
+	}
+	if la.PreferAggType&h.PreferDistinctAggPushDown > 0 {
+		return true
+	}
+	return la.SCtx().GetSessionVars().AllowDistinctAggPushDown
+}
+
 // HasOrderBy shows whether LogicalAggregation has functions with order-by items.
 func (la *LogicalAggregation) HasOrderBy() bool {
 	for _, aggFunc := range la.AggFuncs {
@@ -1166,6 +1179,10 @@ type LogicalSelection struct {
 	// but after we converted to CNF(Conjunctive normal form), it can be
 	// split into a list of AND conditions.
 	Conditions []expression.Expression
+
+	// sampleRate is the probability to keep each row if the Selection is built by the BERNOULLI TABLESAMPLE clause
+	// or the sample hint, it's 0 otherwise.
+	sampleRate float64
 }
 
 func extractNotNullFromConds(conditions []expression.Expression, p base.LogicalPlan) intset.FastIntSet {
@@ -1423,6 +1440,33 @@ func (ds *DataSource) GetAllConds() []expression.Expression {
 	return ds.allConds
 }
 
+// partitionName returns the name of the partition which the DataSource reads in the static prune mode, it's empty
+// if the DataSource reads the whole table.
+func (ds *DataSource) partitionName() model.CIStr {
+	if ds.partitionDefIdx != nil {
+		if pi := ds.tableInfo.GetPartitionInfo(); pi != nil {
+			return pi.Definitions[*ds.partitionDefIdx].Name
+		}
+	}
+	return model.CIStr{}
+}
+
+// setHintWarning sets the hint warning of the DataSource, the warnings of all the partitions in the static prune mode
+// are aggregated into one.
+func (ds *DataSource) setHintWarning(hintName string, err error) {
+	stmtCtx := ds.SCtx().GetSessionVars().StmtCtx
+	if partitionName := ds.partitionName(); partitionName.L != "" {
+		stmtCtx.SetHintWarningForScope(err, h.WarningScope{
+			Hint:  hintName,
+			Table: ds.tableInfo.Name.O,
+			Kind:  h.WarningScopePartition,
+			Name:  partitionName.O,
+		})
+		return
+	}
+	stmtCtx.SetHintWarningFromError(err)
+}
+
 // DataSource represents a tableScan without condition push down.
 type DataSource struct {
 	logicalSchemaProducer
@@ -1442,6 +1486,9 @@ type DataSource struct {
 	// allConds contains all the filters on this table. For now it's maintained
 	// in predicate push down and used in partition pruning/index merge.
 	allConds []expression.Expression
+	// checkConstraintConds are derived from the enforced check constraints of the table, they are satisfied by all the
+	// rows of the table, and used to detect the contradictory predicates and prune partitions.
+	checkConstraintConds []expression.Expression
 
 	statisticTable *statistics.Table
 	tableStats     *property.StatsInfo
@@ -1472,8 +1519,13 @@ type DataSource struct {
 	preferStoreType int
 	// preferPartitions store the map, the key represents store type, the value represents the partition name list.
 	preferPartitions map[int][]model.CIStr
-	SampleInfo       *tablesampler.TableSampleInfo
-	is               infoschema.InfoSchema
+	// preferScanType means the DataSource is enforced to which scan type by the full_scan, index_only or no_full_scan hint.
+	preferScanType uint
+	// inljPrefetchTasks is the number of lookup tasks to prefetch set by the inl_join_prefetch hint when the
+	// DataSource is the inner side of an index join.
+	inljPrefetchTasks int
+	SampleInfo        *tablesampler.TableSampleInfo
+	is                infoschema.InfoSchema
 	// isForUpdateRead should be true in either of the following situations
 	// 1. use `inside insert`, `update`, `delete` or `select for update` statement
 	// 2. isolation level is RC
@@ -1988,6 +2040,8 @@ type WindowFrame struct {
 	Type  ast.FrameType
 	Start *FrameBound
 	End   *FrameBound
+	// Exclusion is the EXCLUDE clause of the frame, the excluded rows are decided by the peers of the current row.
+	Exclusion ast.FrameExclusion
 }
 
 // Clone copies a window frame totally.
@@ -2139,6 +2193,7 @@ func (p *LogicalWindow) EqualFrame(ctx expression.EvalContext, newWindow *Logica
 		return true
 	}
 	if p.Frame.Type != newWindow.Frame.Type ||
+		p.Frame.Exclusion != newWindow.Frame.Exclusion ||
 		p.Frame.Start.Type != newWindow.Frame.Start.Type ||
 		p.Frame.Start.UnBounded != newWindow.Frame.Start.UnBounded ||
 		p.Frame.Start.Num != newWindow.Frame.Start.Num ||
@@ -2243,6 +2298,8 @@ type LogicalShowDDLJobs struct {
 // CTEClass holds the information and plan for a CTE. Most of the fields in this struct are the same as cteInfo.
 // But the cteInfo is used when building the plan, and CTEClass is used also for building the executor.
 type CTEClass struct {
+	// Name is the name of this CTE, used to identify it in errors.
+	Name model.CIStr
 	// The union between seed part and recursive part is DISTINCT or DISTINCT ALL.
 	IsDistinct bool
 	// seedPartLogicalPlan and recursivePartLogicalPlan are the logical plans for the seed part and recursive part of this CTE.
@@ -2323,6 +2380,51 @@ func (p *LogicalCTE) ExtractCorrelatedCols() []*expression.CorrelatedColumn {
 	return corCols
 }
 
+// JSONTableColumn is a column of JSON_TABLE, which is a PATH column, an EXISTS PATH column or an ordinality column.
+type JSONTableColumn struct {
+	Tp   ast.JSONTableColumnType
+	Col  *expression.Column
+	Name model.CIStr
+	Path types.JSONPathExpression
+	// OnEmpty and OnError decide the value of a PATH column when the value is missing or invalid.
+	OnEmpty ast.JSONTableOnResponseType
+	OnError ast.JSONTableOnResponseType
+	// EmptyDefault and ErrorDefault are the values of `DEFAULT ... ON EMPTY` and `DEFAULT ... ON ERROR`.
+	EmptyDefault types.BinaryJSON
+	ErrorDefault types.BinaryJSON
+}
+
+// JSONTablePath is a row path of JSON_TABLE, i.e. the root path or a NESTED PATH.
+// Every value matched by Path produces the values of Columns, together with the rows of the Nested paths under it.
+type JSONTablePath struct {
+	Path    types.JSONPathExpression
+	Columns []*JSONTableColumn
+	Nested  []*JSONTablePath
+}
+
+// LogicalJSONTable is the data source of JSON_TABLE, which shreds a JSON document into rows.
+type LogicalJSONTable struct {
+	logicalSchemaProducer
+
+	// Expr is the JSON document. It may reference the tables before JSON_TABLE in the FROM clause,
+	// and then the JSON_TABLE is the inner child of a LogicalApply.
+	Expr  expression.Expression
+	Root  *JSONTablePath
+	Alias model.CIStr
+
+	// PushedDownConds are the filters on the columns of JSON_TABLE, they are evaluated when the rows are produced.
+	PushedDownConds []expression.Expression
+}
+
+// ExtractCorrelatedCols implements LogicalPlan interface.
+func (p *LogicalJSONTable) ExtractCorrelatedCols() []*expression.CorrelatedColumn {
+	corCols := expression.ExtractCorColumns(p.Expr)
+	for _, cond := range p.PushedDownConds {
+		corCols = append(corCols, expression.ExtractCorColumns(cond)...)
+	}
+	return corCols
+}
+
 // LogicalSequence is used to mark the CTE producer in the main query tree.
 // Its last child is main query. The previous children are cte producers.
 // And there might be dependencies between the CTE producers:
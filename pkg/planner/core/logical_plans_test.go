@@ -1426,6 +1426,18 @@ func TestVisitInfo(t *testing.T) {
 				{mysql.ExtendedPriv, "", "", "", plannererrors.ErrSpecificAccessDenied, false, "PLACEMENT_ADMIN", false},
 			},
 		},
+		{
+			sql: "create global binding for select * from test.t using select /*+ use_index(t, c_d_e) */ * from test.t",
+			ans: []visitInfo{
+				{mysql.ExtendedPriv, "", "", "", plannererrors.ErrSpecificAccessDenied, false, "SQL_TUNING_ADMIN", false},
+			},
+		},
+		{
+			sql: "drop session binding for select * from test.t",
+			ans: []visitInfo{
+				{mysql.ExtendedPriv, "", "", "", plannererrors.ErrSpecificAccessDenied, false, "SQL_TUNING_ADMIN", false},
+			},
+		},
 		{
 			sql: "BACKUP DATABASE test TO 'local:///tmp/a'",
 			ans: []visitInfo{
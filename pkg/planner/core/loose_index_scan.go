@@ -0,0 +1,217 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"github.com/pingcap/tidb/pkg/expression"
+	"github.com/pingcap/tidb/pkg/expression/aggregation"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/hint"
+)
+
+// convertToLooseIndexScan replaces the aggregations on the indexes hinted by LOOSE_INDEX_SCAN with the loose index
+// scans, and warns about the hints which can't be applied.
+func convertToLooseIndexScan(sctx base.PlanContext, plan base.PhysicalPlan) base.PhysicalPlan {
+	hinted := false
+	iteratePhysicalPlan(plan, func(p base.PhysicalPlan) bool {
+		hinted = hinted || looseScanHintedIndexScan(p) != nil
+		return !hinted
+	})
+	if !hinted {
+		return plan
+	}
+	plan = transformPhysicalPlan(plan, func(p base.PhysicalPlan) base.PhysicalPlan {
+		if looseScan := tryLooseIndexScan(p); looseScan != nil {
+			return looseScan
+		}
+		return p
+	})
+	iteratePhysicalPlan(plan, func(p base.PhysicalPlan) bool {
+		if is := looseScanHintedIndexScan(p); is != nil {
+			sctx.GetSessionVars().StmtCtx.SetHintWarningFromError(hint.ErrWarnHintInapplicable.FastGen(
+				"Optimizer Hint LOOSE_INDEX_SCAN is inapplicable for the index %s of the table %s, only the aggregation grouping by a prefix of the index "+
+					"with FIRSTROW, MIN or MAX on the index columns can be executed by the loose index scan", is.Index.Name.O, is.Table.Name.O))
+		}
+		return true
+	})
+	return plan
+}
+
+// looseScanHintedIndexScan returns the index scan of the reader if it's hinted by LOOSE_INDEX_SCAN.
+func looseScanHintedIndexScan(p base.PhysicalPlan) *PhysicalIndexScan {
+	var indexPlans []base.PhysicalPlan
+	switch x := p.(type) {
+	case *PhysicalIndexReader:
+		indexPlans = x.IndexPlans
+	case *PhysicalIndexLookUpReader:
+		indexPlans = x.IndexPlans
+	}
+	for _, indexPlan := range indexPlans {
+		if is, ok := indexPlan.(*PhysicalIndexScan); ok && is.looseScan {
+			return is
+		}
+	}
+	return nil
+}
+
+// tryLooseIndexScan converts the aggregation to the loose index scan if it groups by a prefix of the index read by
+// its child, and each of its functions is FIRSTROW, MIN or MAX of a column grouped by, or MIN or MAX of the index
+// column next to the prefix. Such an aggregation only needs the first or the last entry of each group.
+func tryLooseIndexScan(p base.PhysicalPlan) *PhysicalLooseIndexScan {
+	var agg *basePhysicalAgg
+	switch x := p.(type) {
+	case *PhysicalHashAgg:
+		agg = x.getPointer()
+	case *PhysicalStreamAgg:
+		agg = x.getPointer()
+	default:
+		return nil
+	}
+	if len(agg.children) != 1 {
+		return nil
+	}
+	reader, ok := agg.children[0].(*PhysicalIndexReader)
+	if !ok {
+		return nil
+	}
+	groupByItems, aggFuncs := agg.GroupByItems, agg.AggFuncs
+	var is *PhysicalIndexScan
+	var partialAgg *basePhysicalAgg
+	switch x := reader.indexPlan.(type) {
+	case *PhysicalIndexScan:
+		is = x
+	case *PhysicalHashAgg:
+		partialAgg = x.getPointer()
+	case *PhysicalStreamAgg:
+		partialAgg = x.getPointer()
+	default:
+		return nil
+	}
+	if partialAgg == nil {
+		for _, aggFunc := range aggFuncs {
+			if aggFunc.Mode != aggregation.CompleteMode {
+				return nil
+			}
+		}
+	} else {
+		// The partial aggregation is pushed down. The final FIRSTROW, MIN and MAX are the same as the partial ones, and
+		// the final FIRSTROW of a column grouped by reads the column from the partial aggregation directly.
+		if is, ok = partialAgg.children[0].(*PhysicalIndexScan); !ok {
+			return nil
+		}
+		aggFuncs = make([]*aggregation.AggFuncDesc, 0, len(agg.AggFuncs))
+		for _, aggFunc := range agg.AggFuncs {
+			if aggFunc.Mode != aggregation.FinalMode || len(aggFunc.Args) != 1 {
+				return nil
+			}
+			col, ok := aggFunc.Args[0].(*expression.Column)
+			if !ok {
+				return nil
+			}
+			idx := partialAgg.schema.ColumnIndex(col)
+			if idx < 0 {
+				return nil
+			}
+			var arg expression.Expression = col
+			if idx < len(partialAgg.AggFuncs) {
+				partialFunc := partialAgg.AggFuncs[idx]
+				if partialFunc.Name != aggFunc.Name || len(partialFunc.Args) != 1 {
+					return nil
+				}
+				arg = partialFunc.Args[0]
+			}
+			completeFunc := aggFunc.Clone()
+			completeFunc.Args = []expression.Expression{arg}
+			completeFunc.Mode = aggregation.CompleteMode
+			aggFuncs = append(aggFuncs, completeFunc)
+		}
+		groupByItems = partialAgg.GroupByItems
+	}
+	if !is.looseScan || is.Desc || is.haveCorCol() || len(is.rangeInfo) > 0 || is.Index.MVIndex || is.Index.Global ||
+		is.Table.GetPartitionInfo() != nil || is.Table.TempTableType != model.TempTableNone {
+		return nil
+	}
+
+	groupByLen := len(groupByItems)
+	if groupByLen == 0 || groupByLen > len(is.IdxCols) {
+		return nil
+	}
+	grouped := make([]bool, groupByLen)
+	for _, item := range groupByItems {
+		col, ok := item.(*expression.Column)
+		if !ok {
+			return nil
+		}
+		offset := looseScanColumnOffset(is, col)
+		if offset < 0 || offset >= groupByLen || grouped[offset] {
+			return nil
+		}
+		grouped[offset] = true
+	}
+	offsets := make([]int, 0, len(aggFuncs))
+	for _, aggFunc := range aggFuncs {
+		if len(aggFunc.Args) != 1 {
+			return nil
+		}
+		col, ok := aggFunc.Args[0].(*expression.Column)
+		if !ok || aggFunc.RetTp.GetType() != col.RetType.GetType() {
+			return nil
+		}
+		offset := looseScanColumnOffset(is, col)
+		isMinMax := aggFunc.Name == ast.AggFuncMin || aggFunc.Name == ast.AggFuncMax
+		switch {
+		case offset >= 0 && offset < groupByLen && (isMinMax || aggFunc.Name == ast.AggFuncFirstRow):
+		case offset == groupByLen && isMinMax:
+			// The ENUM and SET values are compared by the names, which isn't the order in the index.
+			if tp := col.RetType.GetType(); tp == mysql.TypeEnum || tp == mysql.TypeSet {
+				return nil
+			}
+		default:
+			return nil
+		}
+		offsets = append(offsets, offset)
+	}
+
+	is.KeepOrder = true
+	looseScan := PhysicalLooseIndexScan{
+		IndexScan:     is,
+		GroupByLen:    groupByLen,
+		AggFuncs:      aggFuncs,
+		AggColOffsets: offsets,
+	}.Init(agg.SCtx(), agg.StatsInfo(), agg.QueryBlockOffset())
+	looseScan.SetSchema(agg.Schema())
+	return looseScan
+}
+
+// looseScanColumnOffset returns the offset of the column in the index columns, or -1 if the column isn't an index
+// column, or only the prefix of the column is indexed. The handle columns appended to the index columns are ignored.
+func looseScanColumnOffset(is *PhysicalIndexScan, col *expression.Column) int {
+	for i, idxCol := range is.IdxCols {
+		if i >= len(is.Index.Columns) {
+			break
+		}
+		if idxCol.EqualColumn(col) {
+			if is.IdxColLens[i] != types.UnspecifiedLength {
+				return -1
+			}
+			return i
+		}
+	}
+	return -1
+}
@@ -55,6 +55,35 @@ type extractHelper struct {
 	isLower map[string]bool
 }
 
+// timeRangeHintExtractor is implemented by the extractors of the memory tables whose rows are indexed by time, the
+// time range specified by the TIME_RANGE hint is pushed down to them to reduce the rows to be retrieved.
+type timeRangeHintExtractor interface {
+	setTimeRangeHint(tr util.QueryTimeRange)
+}
+
+// timeRangeHint is embedded by the extractors which implement timeRangeHintExtractor.
+type timeRangeHint struct {
+	// hintTimeRange is the time range specified by the TIME_RANGE hint, it's nil if the hint is not used.
+	hintTimeRange *util.QueryTimeRange
+}
+
+func (h *timeRangeHint) setTimeRangeHint(tr util.QueryTimeRange) {
+	h.hintTimeRange = &tr
+}
+
+// intersectTimeRangeHint narrows the time range extracted from the predicates by the TIME_RANGE hint. The time is the
+// unix timestamp in nanoseconds, and 0 means that side of the range is unbounded.
+func (h *timeRangeHint) intersectTimeRangeHint(startTime, endTime int64) (int64, int64) {
+	if h.hintTimeRange == nil {
+		return startTime, endTime
+	}
+	startTime = max(startTime, h.hintTimeRange.From.UnixNano())
+	if to := h.hintTimeRange.To.UnixNano(); endTime == 0 || to < endTime {
+		endTime = to
+	}
+	return startTime, endTime
+}
+
 func (extractHelper) extractColInConsExpr(extractCols map[int64]*types.FieldName, expr *expression.ScalarFunction) (string, []types.Datum) {
 	args := expr.GetArgs()
 	col, isCol := args[0].(*expression.Column)
@@ -737,6 +766,7 @@ func (e *ClusterTableExtractor) ExplainInfo(_ base.PhysicalPlan) string {
 // ClusterLogTableExtractor is used to extract some predicates of `cluster_config`
 type ClusterLogTableExtractor struct {
 	extractHelper
+	timeRangeHint
 
 	// SkipRequest means the where clause always false, we don't need to request any component
 	SkipRequest bool
@@ -786,6 +816,7 @@ func (e *ClusterLogTableExtractor) Extract(ctx base.PlanContext,
 	}
 
 	remained, startTime, endTime := e.extractTimeRange(ctx, schema, names, remained, "time", time.Local)
+	startTime, endTime = e.intersectTimeRangeHint(startTime, endTime)
 	// The time unit for search log is millisecond.
 	startTime = startTime / int64(time.Millisecond)
 	endTime = endTime / int64(time.Millisecond)
@@ -848,6 +879,7 @@ const (
 // HotRegionsHistoryTableExtractor is used to extract some predicates of `tidb_hot_regions_history`
 type HotRegionsHistoryTableExtractor struct {
 	extractHelper
+	timeRangeHint
 
 	// SkipRequest means the where clause always false, we don't need to request any pd server.
 	SkipRequest bool
@@ -925,6 +957,7 @@ func (e *HotRegionsHistoryTableExtractor) Extract(ctx base.PlanContext,
 	}
 
 	remained, startTime, endTime := e.extractTimeRange(ctx, schema, names, remained, "update_time", ctx.GetSessionVars().StmtCtx.TimeZone())
+	startTime, endTime = e.intersectTimeRangeHint(startTime, endTime)
 	// The time unit for search hot regions is millisecond
 	startTime = startTime / int64(time.Millisecond)
 	endTime = endTime / int64(time.Millisecond)
@@ -985,6 +1018,7 @@ func (e *HotRegionsHistoryTableExtractor) ExplainInfo(pp base.PhysicalPlan) stri
 // MetricTableExtractor is used to extract some predicates of metrics_schema tables.
 type MetricTableExtractor struct {
 	extractHelper
+	timeRangeHint
 	// SkipRequest means the where clause always false, we don't need to request any component
 	SkipRequest bool
 	// StartTime represents the beginning time of metric data.
@@ -1018,6 +1052,7 @@ func (e *MetricTableExtractor) Extract(ctx base.PlanContext,
 
 	// Extract the `time` columns
 	remained, startTime, endTime := e.extractTimeRange(ctx, schema, names, remained, "time", ctx.GetSessionVars().StmtCtx.TimeZone())
+	startTime, endTime = e.intersectTimeRangeHint(startTime, endTime)
 	e.StartTime, e.EndTime = e.getTimeRange(startTime, endTime)
 	e.SkipRequest = e.StartTime.After(e.EndTime)
 	if e.SkipRequest {
@@ -1263,6 +1298,7 @@ func (e *InspectionRuleTableExtractor) ExplainInfo(_ base.PhysicalPlan) string {
 // SlowQueryExtractor is used to extract some predicates of `slow_query`
 type SlowQueryExtractor struct {
 	extractHelper
+	timeRangeHint
 
 	SkipRequest bool
 	TimeRanges  []*TimeRange
@@ -1286,6 +1322,7 @@ func (e *SlowQueryExtractor) Extract(ctx base.PlanContext,
 	predicates []expression.Expression,
 ) []expression.Expression {
 	remained, startTime, endTime := e.extractTimeRange(ctx, schema, names, predicates, "time", ctx.GetSessionVars().StmtCtx.TimeZone())
+	startTime, endTime = e.intersectTimeRangeHint(startTime, endTime)
 	e.setTimeRange(startTime, endTime)
 	e.SkipRequest = e.Enable && e.TimeRanges[0].StartTime.After(e.TimeRanges[0].EndTime)
 	if e.SkipRequest {
@@ -1503,6 +1540,7 @@ func (e *TiFlashSystemTableExtractor) ExplainInfo(_ base.PhysicalPlan) string {
 // StatementsSummaryExtractor is used to extract some predicates of statements summary table.
 type StatementsSummaryExtractor struct {
 	extractHelper
+	timeRangeHint
 
 	// SkipRequest means the where clause always false, we don't need to request any component
 	SkipRequest bool
@@ -1582,6 +1620,7 @@ func (e *StatementsSummaryExtractor) findCoarseTimeRange(
 	tz := sctx.GetSessionVars().StmtCtx.TimeZone()
 	_, _, endTime := e.extractTimeRange(sctx, schema, names, predicates, "summary_begin_time", tz)
 	_, startTime, _ := e.extractTimeRange(sctx, schema, names, predicates, "summary_end_time", tz)
+	startTime, endTime = e.intersectTimeRangeHint(startTime, endTime)
 	return e.buildTimeRange(startTime, endTime)
 }
 
@@ -1877,3 +1916,36 @@ func (e *InfoSchemaTablesExtractor) Filter(colName string, val string) bool {
 	// No need to filter records since no predicate for the column exists.
 	return false
 }
+
+// ExternalTableExtractor is used to push the predicates down to the reader of the external table, the rows which
+// don't satisfy the predicates are filtered out when reading the data files.
+type ExternalTableExtractor struct {
+	// Filters are the predicates which are evaluated by the reader of the external table.
+	Filters []expression.Expression
+}
+
+// Extract implements the MemTablePredicateExtractor Extract interface
+func (e *ExternalTableExtractor) Extract(_ base.PlanContext,
+	_ *expression.Schema,
+	_ []*types.FieldName,
+	predicates []expression.Expression,
+) (remained []expression.Expression) {
+	for _, expr := range predicates {
+		// the correlated columns are only known when the outer row is fetched, and the mutable functions must be
+		// evaluated in the same way as they are not pushed down.
+		if len(expression.ExtractCorColumns(expr)) > 0 || expression.IsMutableEffectsExpr(expr) {
+			remained = append(remained, expr)
+			continue
+		}
+		e.Filters = append(e.Filters, expr)
+	}
+	return remained
+}
+
+// ExplainInfo implements base.MemTablePredicateExtractor interface.
+func (e *ExternalTableExtractor) ExplainInfo(p base.PhysicalPlan) string {
+	if len(e.Filters) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("filters:[%s]", expression.SortedExplainExpressionList(p.SCtx().GetExprCtx().GetEvalCtx(), e.Filters))
+}
@@ -516,6 +516,26 @@ func TestClusterLogTableExtractor(t *testing.T) {
 			instances: set.NewStringSet(),
 			level:     set.NewStringSet("debug", "error"),
 		},
+		{
+			sql:       "select /*+ time_range('2019-10-10 10:10:10', '2019-10-11 10:10:10') */ * from information_schema.cluster_log",
+			nodeTypes: set.NewStringSet(),
+			instances: set.NewStringSet(),
+			startTime: timestamp(t, "2019-10-10 10:10:10"),
+			endTime:   timestamp(t, "2019-10-11 10:10:10"),
+		},
+		{
+			sql:       "select /*+ time_range('2019-10-10 10:10:10', '2019-10-11 10:10:10') */ * from information_schema.cluster_log where time>='2019-10-10 12:00:00'",
+			nodeTypes: set.NewStringSet(),
+			instances: set.NewStringSet(),
+			startTime: timestamp(t, "2019-10-10 12:00:00"),
+			endTime:   timestamp(t, "2019-10-11 10:10:10"),
+		},
+		{
+			sql:         "select /*+ time_range('2019-10-10 10:10:10', '2019-10-11 10:10:10') */ * from information_schema.cluster_log where time>='2019-10-12 10:10:10'",
+			nodeTypes:   set.NewStringSet(),
+			instances:   set.NewStringSet(),
+			skipRequest: true,
+		},
 	}
 	for _, ca := range cases {
 		logicalMemTable := getLogicalMemTable(t, dom, se, parser, ca.sql)
@@ -635,6 +655,18 @@ func TestMetricTableExtractor(t *testing.T) {
 			promQL:    "histogram_quantile(0, sum(rate(tidb_server_handle_query_duration_seconds_bucket{}[60s])) by (le,sql_type,instance))",
 			quantiles: []float64{0},
 		},
+		{
+			sql:       "select /*+ time_range('2019-10-10 10:10:10', '2019-10-10 12:10:10') */ * from metrics_schema.tidb_query_duration",
+			promQL:    `histogram_quantile(0.9, sum(rate(tidb_server_handle_query_duration_seconds_bucket{}[60s])) by (le,sql_type,instance))`,
+			startTime: parseTime(t, "2019-10-10 10:10:10"),
+			endTime:   parseTime(t, "2019-10-10 12:10:10"),
+		},
+		{
+			sql:       "select /*+ time_range('2019-10-10 10:10:10', '2019-10-10 12:10:10') */ * from metrics_schema.tidb_query_duration where time<='2019-10-10 11:10:10'",
+			promQL:    `histogram_quantile(0.9, sum(rate(tidb_server_handle_query_duration_seconds_bucket{}[60s])) by (le,sql_type,instance))`,
+			startTime: parseTime(t, "2019-10-10 10:10:10"),
+			endTime:   parseTime(t, "2019-10-10 11:10:10"),
+		},
 	}
 	se.GetSessionVars().TimeZone = time.Local
 	se.GetSessionVars().StmtCtx.SetTimeZone(time.Local)
@@ -664,6 +696,49 @@ func TestMetricTableExtractor(t *testing.T) {
 	}
 }
 
+func TestTimeRangeHintForMemTables(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+
+	se, err := session.CreateSession4Test(store)
+	require.NoError(t, err)
+	se.GetSessionVars().TimeZone = time.Local
+	se.GetSessionVars().StmtCtx.SetTimeZone(time.Local)
+
+	parseTime := func(s string) time.Time {
+		tt, err := time.ParseInLocation(util.MetricTableTimeFormat, s, time.Local)
+		require.NoError(t, err)
+		return tt
+	}
+	parser := parser.New()
+
+	// The slow log files in the time range are parsed.
+	logicalMemTable := getLogicalMemTable(t, dom, se, parser,
+		"select /*+ time_range('2019-10-10 10:10:10', '2019-10-11 10:10:10') */ * from information_schema.slow_query where time<='2019-10-11 00:00:00'")
+	slowQueryExtractor := logicalMemTable.Extractor.(*plannercore.SlowQueryExtractor)
+	require.True(t, slowQueryExtractor.Enable)
+	require.False(t, slowQueryExtractor.SkipRequest)
+	require.Len(t, slowQueryExtractor.TimeRanges, 1)
+	require.Equal(t, parseTime("2019-10-10 10:10:10"), slowQueryExtractor.TimeRanges[0].StartTime)
+	require.Equal(t, parseTime("2019-10-11 00:00:00"), slowQueryExtractor.TimeRanges[0].EndTime)
+
+	logicalMemTable = getLogicalMemTable(t, dom, se, parser,
+		"select /*+ time_range('2019-10-10 10:10:10', '2019-10-11 10:10:10') */ * from information_schema.slow_query where time>'2019-10-12 00:00:00'")
+	require.True(t, logicalMemTable.Extractor.(*plannercore.SlowQueryExtractor).SkipRequest)
+
+	// Without the hint, only the current slow log file is parsed.
+	logicalMemTable = getLogicalMemTable(t, dom, se, parser, "select * from information_schema.slow_query")
+	require.False(t, logicalMemTable.Extractor.(*plannercore.SlowQueryExtractor).Enable)
+
+	// The history windows which overlap the time range are read.
+	logicalMemTable = getLogicalMemTable(t, dom, se, parser,
+		"select /*+ time_range('2019-10-10 10:10:10', '2019-10-11 10:10:10') */ * from information_schema.statements_summary_history")
+	statementsSummaryExtractor := logicalMemTable.Extractor.(*plannercore.StatementsSummaryExtractor)
+	require.False(t, statementsSummaryExtractor.SkipRequest)
+	require.NotNil(t, statementsSummaryExtractor.CoarseTimeRange)
+	require.Equal(t, parseTime("2019-10-10 10:10:10"), statementsSummaryExtractor.CoarseTimeRange.StartTime)
+	require.Equal(t, parseTime("2019-10-11 10:10:10"), statementsSummaryExtractor.CoarseTimeRange.EndTime)
+}
+
 func TestMetricsSummaryTableExtractor(t *testing.T) {
 	store, dom := testkit.CreateMockStoreAndDomain(t)
 
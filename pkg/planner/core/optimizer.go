@@ -412,6 +412,7 @@ func postOptimize(ctx context.Context, sctx base.PlanContext, plan base.Physical
 	// some cases from update optimize will require avoiding projection elimination.
 	// see comments ahead of call of DoOptimize in function of buildUpdate().
 	plan = eliminatePhysicalProjection(plan)
+	plan = convertToLooseIndexScan(sctx, plan)
 	plan = InjectExtraProjection(plan)
 	mergeContinuousSelections(plan)
 	plan = eliminateUnionScanAndLock(sctx, plan)
@@ -16,6 +16,7 @@ package core
 
 import (
 	"fmt"
+	"slices"
 	"strconv"
 	"strings"
 	"unsafe"
@@ -41,6 +42,7 @@ import (
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/table/tables"
 	"github.com/pingcap/tidb/pkg/types"
+	h "github.com/pingcap/tidb/pkg/util/hint"
 	"github.com/pingcap/tidb/pkg/util/plancodec"
 	"github.com/pingcap/tidb/pkg/util/ranger"
 	"github.com/pingcap/tidb/pkg/util/size"
@@ -55,6 +57,8 @@ var (
 	_ base.PhysicalPlan = &PhysicalTopN{}
 	_ base.PhysicalPlan = &PhysicalMaxOneRow{}
 	_ base.PhysicalPlan = &PhysicalTableDual{}
+	_ base.PhysicalPlan = &PhysicalJSONTable{}
+	_ base.PhysicalPlan = &PhysicalLooseIndexScan{}
 	_ base.PhysicalPlan = &PhysicalUnionAll{}
 	_ base.PhysicalPlan = &PhysicalSort{}
 	_ base.PhysicalPlan = &NominalSort{}
@@ -738,6 +742,9 @@ type PhysicalIndexScan struct {
 	// usedStatsInfo records stats status of this physical table.
 	// It's for printing stats related information when display execution plan.
 	usedStatsInfo *stmtctx.UsedStatsInfoForTable
+
+	// looseScan means the LOOSE_INDEX_SCAN hint is specified for the index, see convertToLooseIndexScan.
+	looseScan bool
 }
 
 // Clone implements op.PhysicalPlan interface.
@@ -1488,6 +1495,9 @@ type PhysicalIndexJoin struct {
 	// InnerHashKeys indicates the inner keys used to build hash table during
 	// execution. InnerJoinKeys is the prefix of InnerHashKeys.
 	InnerHashKeys []*expression.Column
+	// PrefetchTasks is the number of inner side lookup tasks to prefetch, which is set by the inl_join_prefetch hint.
+	// 0 means `tidb_index_lookup_prefetch_tasks` is used.
+	PrefetchTasks int
 }
 
 // MemoryUsage return the memory usage of PhysicalIndexJoin
@@ -1497,7 +1507,7 @@ func (p *PhysicalIndexJoin) MemoryUsage() (sum int64) {
 	}
 
 	sum = p.basePhysicalJoin.MemoryUsage() + size.SizeOfInterface*2 + size.SizeOfSlice*4 +
-		int64(cap(p.KeyOff2IdxOff)+cap(p.IdxColLens))*size.SizeOfInt + size.SizeOfPointer
+		int64(cap(p.KeyOff2IdxOff)+cap(p.IdxColLens))*size.SizeOfInt + size.SizeOfPointer + size.SizeOfInt
 	if p.innerTask != nil {
 		sum += p.innerTask.MemoryUsage()
 	}
@@ -1879,6 +1889,8 @@ type basePhysicalAgg struct {
 	GroupByItems     []expression.Expression
 	MppRunMode       AggMppRunMode
 	MppPartitionCols []*property.MPPPartitionColumn
+	// noExpand is set by the NO_EXPAND hint, it forbids expanding the rows by Expand for the multiple distinct aggregations.
+	noExpand bool
 }
 
 func (p *basePhysicalAgg) IsFinalAgg() bool {
@@ -1901,6 +1913,7 @@ func (p *basePhysicalAgg) cloneWithSelf(newSelf base.PhysicalPlan) (*basePhysica
 		cloned.AggFuncs = append(cloned.AggFuncs, aggDesc.Clone())
 	}
 	cloned.GroupByItems = util.CloneExprs(p.GroupByItems)
+	cloned.noExpand = p.noExpand
 	return cloned, nil
 }
 
@@ -2014,6 +2027,7 @@ func NewPhysicalHashAgg(la *LogicalAggregation, newStats *property.StatsInfo, pr
 	agg := basePhysicalAgg{
 		GroupByItems: newGbyItems,
 		AggFuncs:     newAggFuncs,
+		noExpand:     la.PreferAggType&h.PreferNoExpand > 0,
 	}.initForHash(la.SCtx(), newStats, la.QueryBlockOffset(), prop)
 	return agg
 }
@@ -2280,6 +2294,95 @@ func (p *PhysicalTableDual) MemoryUsage() (sum int64) {
 	return
 }
 
+// PhysicalJSONTable is the physical operator of JSON_TABLE.
+type PhysicalJSONTable struct {
+	physicalSchemaProducer
+
+	Expr            expression.Expression
+	Root            *JSONTablePath
+	Alias           model.CIStr
+	PushedDownConds []expression.Expression
+}
+
+// ExtractCorrelatedCols implements op.PhysicalPlan interface.
+func (p *PhysicalJSONTable) ExtractCorrelatedCols() []*expression.CorrelatedColumn {
+	corCols := expression.ExtractCorColumns(p.Expr)
+	for _, cond := range p.PushedDownConds {
+		corCols = append(corCols, expression.ExtractCorColumns(cond)...)
+	}
+	return corCols
+}
+
+// MemoryUsage return the memory usage of PhysicalJSONTable
+func (p *PhysicalJSONTable) MemoryUsage() (sum int64) {
+	if p == nil {
+		return
+	}
+
+	sum = p.physicalSchemaProducer.MemoryUsage() + size.SizeOfInterface + size.SizeOfPointer + p.Alias.MemoryUsage() +
+		size.SizeOfSlice + int64(cap(p.PushedDownConds))*size.SizeOfInterface
+	if p.Expr != nil {
+		sum += p.Expr.MemoryUsage()
+	}
+	for _, cond := range p.PushedDownConds {
+		sum += cond.MemoryUsage()
+	}
+	return
+}
+
+// PhysicalLooseIndexScan is the aggregation grouping by a prefix of the index columns executed by the loose index
+// scan, which reads an entry of each group and then skips to the next group instead of scanning all the entries.
+type PhysicalLooseIndexScan struct {
+	physicalSchemaProducer
+
+	// IndexScan is the scan of the index, whose ranges are read by the loose index scan.
+	IndexScan *PhysicalIndexScan
+	// GroupByLen is the number of the prefix index columns grouped by.
+	GroupByLen int
+	// AggFuncs are the aggregate functions, each of them is FIRSTROW, MIN or MAX, and produces a column of the schema.
+	AggFuncs []*aggregation.AggFuncDesc
+	// AggColOffsets are the offsets in the index columns of the arguments of AggFuncs. The offset of a MIN or MAX can be
+	// GroupByLen, the others are the offsets of the columns grouped by.
+	AggColOffsets []int
+}
+
+// Clone implements op.PhysicalPlan interface.
+func (p *PhysicalLooseIndexScan) Clone() (base.PhysicalPlan, error) {
+	cloned := new(PhysicalLooseIndexScan)
+	*cloned = *p
+	base, err := p.physicalSchemaProducer.cloneWithSelf(cloned)
+	if err != nil {
+		return nil, err
+	}
+	cloned.physicalSchemaProducer = *base
+	is, err := p.IndexScan.Clone()
+	if err != nil {
+		return nil, err
+	}
+	cloned.IndexScan = is.(*PhysicalIndexScan)
+	cloned.AggFuncs = make([]*aggregation.AggFuncDesc, 0, len(p.AggFuncs))
+	for _, aggDesc := range p.AggFuncs {
+		cloned.AggFuncs = append(cloned.AggFuncs, aggDesc.Clone())
+	}
+	cloned.AggColOffsets = slices.Clone(p.AggColOffsets)
+	return cloned, nil
+}
+
+// MemoryUsage return the memory usage of PhysicalLooseIndexScan
+func (p *PhysicalLooseIndexScan) MemoryUsage() (sum int64) {
+	if p == nil {
+		return
+	}
+
+	sum = p.physicalSchemaProducer.MemoryUsage() + size.SizeOfPointer + size.SizeOfInt +
+		size.SizeOfSlice*2 + int64(cap(p.AggFuncs))*size.SizeOfPointer + int64(cap(p.AggColOffsets))*size.SizeOfInt
+	sum += p.IndexScan.MemoryUsage()
+	for _, agg := range p.AggFuncs {
+		sum += agg.MemoryUsage()
+	}
+	return
+}
+
 // PhysicalWindow is the physical operator of window function.
 type PhysicalWindow struct {
 	physicalSchemaProducer
@@ -2531,6 +2634,11 @@ type PhysicalTableSample struct {
 	TableInfo       table.Table
 	PhysicalTableID int64
 	Desc            bool
+	// Index is the secondary index whose regions are sampled by the SYSTEM sampling method,
+	// the regions of the table records are sampled if it's nil.
+	Index *model.IndexInfo
+	// Ranges are the access ranges of Index, only the parts of the sampled regions in the ranges are read.
+	Ranges []*ranger.Range
 }
 
 // PhysicalCTE is for CTE.
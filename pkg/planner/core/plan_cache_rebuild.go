@@ -124,9 +124,14 @@ func rebuildRange(p base.Plan) error {
 		if err != nil {
 			return err
 		}
+	case *PhysicalLooseIndexScan:
+		err = rebuildRange(x.IndexScan)
+		if err != nil {
+			return err
+		}
 	case *PointGetPlan:
 		if x.TblInfo.GetPartitionInfo() != nil {
-			if fixcontrol.GetBoolWithDefault(sctx.GetSessionVars().OptimizerFixControl, fixcontrol.Fix33031, false) {
+			if fixcontrol.GetBoolWithDefault(sctx.GetSessionVars().GetOptimizerFixControlMap(), fixcontrol.Fix33031, false) {
 				return errors.NewNoStackError("Fix33031 fix-control set and partitioned table in cached Point Get plan")
 			}
 		}
@@ -193,7 +198,7 @@ func rebuildRange(p base.Plan) error {
 		}
 		return nil
 	case *BatchPointGetPlan:
-		if x.TblInfo.GetPartitionInfo() != nil && fixcontrol.GetBoolWithDefault(sctx.GetSessionVars().OptimizerFixControl, fixcontrol.Fix33031, false) {
+		if x.TblInfo.GetPartitionInfo() != nil && fixcontrol.GetBoolWithDefault(sctx.GetSessionVars().GetOptimizerFixControlMap(), fixcontrol.Fix33031, false) {
 			return errors.NewNoStackError("Fix33031 fix-control set and partitioned table in cached Batch Point Get plan")
 		}
 		// if access condition is not nil, which means it's a point get generated by cbo.
@@ -94,7 +94,7 @@ func GeneratePlanCacheStmtWithAST(ctx context.Context, sctx sessionctx.Context,
 	}
 
 	switch stmt := paramStmt.(type) {
-	case *ast.ImportIntoStmt, *ast.LoadDataStmt, *ast.PrepareStmt, *ast.ExecuteStmt, *ast.DeallocateStmt, *ast.NonTransactionalDMLStmt:
+	case *ast.ImportIntoStmt, *ast.ExportTableStmt, *ast.LoadDataStmt, *ast.PrepareStmt, *ast.ExecuteStmt, *ast.DeallocateStmt, *ast.NonTransactionalDMLStmt:
 		return nil, nil, 0, plannererrors.ErrUnsupportedPs
 	case *ast.SelectStmt:
 		if stmt.SelectIntoOpt != nil {
@@ -146,7 +146,7 @@ func GeneratePlanCacheStmtWithAST(ctx context.Context, sctx sessionctx.Context,
 			cacheable = true // it is already checked here
 		}
 
-		if !cacheable && fixcontrol.GetBoolWithDefault(vars.OptimizerFixControl, fixcontrol.Fix49736, false) {
+		if !cacheable && fixcontrol.GetBoolWithDefault(vars.GetOptimizerFixControlMap(), fixcontrol.Fix49736, false) {
 			sctx.GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackErrorf("force plan-cache: may use risky cached plan: %s", reason))
 			cacheable = true
 			reason = ""
@@ -256,6 +256,9 @@ type planCacheKey struct {
 	restrictedReadOnly       bool
 	TiDBSuperReadOnly        bool
 	exprBlacklistTS          int64 // expr-pushdown-blacklist can affect query optimization, so we need to consider it in plan cache.
+	ignoredIndexes           string
+	// resourceGroup is the resource group of the statement, whose optimizer variables can affect the plan.
+	resourceGroup string
 
 	memoryUsage int64 // Do not include in hash
 	hash        []byte
@@ -308,6 +311,8 @@ func (key *planCacheKey) Hash() []byte {
 		key.hash = append(key.hash, hack.Slice(strconv.FormatBool(key.restrictedReadOnly))...)
 		key.hash = append(key.hash, hack.Slice(strconv.FormatBool(key.TiDBSuperReadOnly))...)
 		key.hash = codec.EncodeInt(key.hash, key.exprBlacklistTS)
+		key.hash = append(key.hash, hack.Slice(key.ignoredIndexes)...)
+		key.hash = append(key.hash, hack.Slice(key.resourceGroup)...)
 	}
 	return key.hash
 }
@@ -323,7 +328,7 @@ func (key *planCacheKey) MemoryUsage() (sum int64) {
 	if key.memoryUsage > 0 {
 		return key.memoryUsage
 	}
-	sum = emptyPlanCacheKeySize + int64(len(key.database)+len(key.stmtText)+len(key.bindSQL)+len(key.connCollation)) +
+	sum = emptyPlanCacheKeySize + int64(len(key.database)+len(key.stmtText)+len(key.bindSQL)+len(key.connCollation)+len(key.ignoredIndexes)+len(key.resourceGroup)) +
 		int64(len(key.isolationReadEngines))*size.SizeOfUint8 + int64(cap(key.hash))
 	key.memoryUsage = sum
 	return
@@ -381,6 +386,13 @@ func NewPlanCacheKey(sessionVars *variable.SessionVars, stmtText, stmtDB string,
 		restrictedReadOnly:       variable.RestrictedReadOnly.Load(),
 		TiDBSuperReadOnly:        variable.VarTiDBSuperReadOnly.Load(),
 		exprBlacklistTS:          exprBlacklistTS,
+		ignoredIndexes:           sessionVars.OptimizerIgnoredIndexes,
+	}
+	if variable.EnableResourceControl.Load() {
+		key.resourceGroup = sessionVars.StmtCtx.ResourceGroupName
+		if sessionVars.StmtCtx.StmtHints.HasResourceGroup {
+			key.resourceGroup = sessionVars.StmtCtx.StmtHints.ResourceGroup
+		}
 	}
 	for k, v := range sessionVars.IsolationReadEngines {
 		key.isolationReadEngines[k] = v
@@ -402,6 +414,10 @@ type PlanCacheValue struct {
 	matchOpts *utilpc.PlanCacheMatchOpts
 	// stmtHints stores the hints which set session variables, because the hints won't be processed using cached plan.
 	stmtHints *hint.StmtHints
+	// originalHints and bindHints are the restored hints of the statement and the binding, they're kept to report
+	// the hints applied to the statement without restoring them again.
+	originalHints string
+	bindHints     string
 }
 
 // unKnownMemoryUsage represent the memory usage of uncounted structure, maybe need implement later
@@ -431,7 +447,8 @@ func (v *PlanCacheValue) MemoryUsage() (sum int64) {
 	}
 
 	sum += size.SizeOfInterface + size.SizeOfSlice*2 + int64(cap(v.OutputColumns))*size.SizeOfPointer +
-		size.SizeOfMap + int64(len(v.TblInfo2UnionScan))*(size.SizeOfPointer+size.SizeOfBool) + size.SizeOfInt64*2
+		size.SizeOfMap + int64(len(v.TblInfo2UnionScan))*(size.SizeOfPointer+size.SizeOfBool) + size.SizeOfInt64*2 +
+		size.SizeOfString*2 + int64(len(v.originalHints)+len(v.bindHints))
 	if v.matchOpts != nil {
 		sum += int64(cap(v.matchOpts.ParamTypes)) * size.SizeOfPointer
 		for _, ft := range v.matchOpts.ParamTypes {
@@ -646,7 +663,7 @@ func isSafePointGetPath4PlanCache(sctx base.PlanContext, path *util.AccessPath)
 	}
 
 	// TODO: enable this fix control switch by default after more test cases are added.
-	if sctx != nil && sctx.GetSessionVars() != nil && sctx.GetSessionVars().OptimizerFixControl != nil {
+	if sctx != nil && sctx.GetSessionVars() != nil && sctx.GetSessionVars().GetOptimizerFixControlMap() != nil {
 		fixControlOK := fixcontrol.GetBoolWithDefault(sctx.GetSessionVars().GetOptimizerFixControlMap(), fixcontrol.Fix44830, false)
 		if fixControlOK && (isSafePointGetPath4PlanCacheScenario2(path) || isSafePointGetPath4PlanCacheScenario3(path)) {
 			return true
@@ -607,7 +607,7 @@ func isPhysicalPlanCacheable(sctx base.PlanContext, p base.PhysicalPlan, paramNu
 // getMaxParamLimit returns the maximum number of parameters for a query that can be cached in the Plan Cache.
 func getMaxParamLimit(sctx base.PlanContext) int {
 	v := 200
-	if sctx == nil || sctx.GetSessionVars() == nil || sctx.GetSessionVars().OptimizerFixControl == nil {
+	if sctx == nil || sctx.GetSessionVars() == nil || sctx.GetSessionVars().GetOptimizerFixControlMap() == nil {
 		return v
 	}
 	n := fixcontrol.GetIntWithDefault(sctx.GetSessionVars().GetOptimizerFixControlMap(), fixcontrol.Fix44823, int64(v))
@@ -654,7 +654,7 @@ func checkTableCacheable(ctx context.Context, sctx base.PlanContext, schema info
 		if sctx == nil || !sctx.GetSessionVars().IsDynamicPartitionPruneEnabled() {
 			return false, "query accesses partitioned tables is un-cacheable if tidb_partition_pruning_mode = 'static'"
 		}
-		if fixcontrol.GetBoolWithDefault(sctx.GetSessionVars().OptimizerFixControl, fixcontrol.Fix33031, false) {
+		if fixcontrol.GetBoolWithDefault(sctx.GetSessionVars().GetOptimizerFixControlMap(), fixcontrol.Fix33031, false) {
 			return false, "Fix33031 fix-control set and partitioned table"
 		}
 	}
@@ -669,6 +669,9 @@ func checkTableCacheable(ctx context.Context, sctx base.PlanContext, schema info
 	if tb.Meta().TempTableType != model.TempTableNone {
 		return false, "query accesses temporary tables is un-cacheable"
 	}
+	if tb.Meta().IsExternal() {
+		return false, "query accesses external tables is un-cacheable"
+	}
 
 	if isNonPrep { // non-prep plan cache is stricter
 		if tb.Meta().IsView() {
@@ -392,6 +392,51 @@ func TestExplainFormatHintRecoverableForTiFlashReplica(t *testing.T) {
 	require.Equal(t, rows[len(rows)-1][2], "mpp[tiflash]")
 }
 
+func TestExplainFormatHintForMPPPlans(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t1(a int, b int)")
+	tk.MustExec("create table t2(a int, b int)")
+	// Create virtual `tiflash` replica info.
+	is := dom.InfoSchema()
+	db, exists := is.SchemaByName(model.NewCIStr("test"))
+	require.True(t, exists)
+	for _, tbl := range is.SchemaTables(db.Name) {
+		tbl.Meta().TiFlashReplica = &model.TiFlashReplicaInfo{
+			Count:     1,
+			Available: true,
+		}
+	}
+	tk.MustExec("set @@session.tidb_isolation_read_engines = 'tiflash'")
+	tk.MustExec("set @@session.tidb_enforce_mpp = 1")
+	tk.MustExec("set @@session.mpp_exchange_compression_mode = 'HIGH_COMPRESSION'")
+
+	checkHints := func(sql string, expected ...string) {
+		hints := tk.MustQuery("explain format='hint' " + sql).Rows()[0][0].(string)
+		for _, hint := range expected {
+			require.Contains(t, hints, hint, sql)
+		}
+		// The plan can be recovered by the hints.
+		plan := tk.MustQuery("explain format='brief' " + sql).Rows()
+		recovered := tk.MustQuery(fmt.Sprintf("explain format='brief' select /*+ %s */ %s", hints, sql[len("select "):])).Rows()
+		require.Equal(t, plan, recovered, sql)
+	}
+	checkHints("select /*+ shuffle_join(t1, t2) */ * from t1, t2 where t1.a = t2.a",
+		"read_from_storage(@`sel_1` tiflash[`test`.`t1`])",
+		"read_from_storage(@`sel_1` tiflash[`test`.`t2`])",
+		"hash_join(@`sel_1` `test`.`t1`)",
+		"shuffle_join(@`sel_1` `test`.`t1`)",
+		"mpp_exchange_compression(`HIGH_COMPRESSION`)")
+	checkHints("select /*+ broadcast_join(t1, t2) */ * from t1, t2 where t1.a = t2.a",
+		"broadcast_join(@`sel_1` `test`.`t1`)")
+	checkHints("select /*+ mpp_1phase_agg() */ a, count(*) from t1 group by a",
+		"hash_agg(@`sel_1`)",
+		"mpp_1phase_agg(@`sel_1`)")
+	checkHints("select /*+ mpp_2phase_agg() */ a, count(*) from t1 group by a",
+		"mpp_2phase_agg(@`sel_1`)")
+}
+
 func BenchmarkDecodePlan(b *testing.B) {
 	store := testkit.CreateMockStore(b)
 	tk := testkit.NewTestKit(b, store)
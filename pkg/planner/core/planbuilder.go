@@ -35,6 +35,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/auth"
 	"github.com/pingcap/tidb/pkg/parser/charset"
+	"github.com/pingcap/tidb/pkg/parser/format"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/opcode"
@@ -205,6 +206,10 @@ type PlanBuilder struct {
 	windowSpecs  map[string]*ast.WindowSpec
 	inUpdateStmt bool
 	inDeleteStmt bool
+	// dmlTargetTables records the tables modified by UPDATE and DELETE statements, which are not masked.
+	dmlTargetTables map[*ast.TableName]struct{}
+	// maskedColumns records the masked columns of dmlTargetTables, which can't be read by the statements.
+	maskedColumns []*maskedColumn
 	// inStraightJoin represents whether the current "SELECT" statement has
 	// "STRAIGHT_JOIN" option.
 	inStraightJoin bool
@@ -268,6 +273,9 @@ type PlanBuilder struct {
 	// subQueryHintFlags stores subquery related hints that are set and applicable in the query block.
 	// It's for returning information to buildSubquery().
 	subQueryHintFlags uint64 // TODO: move this field to hint.PlanHints
+	// subQueryMaxExecutionTime stores the max_execution_time hint in the subquery block, which limits the execution
+	// time of the uncorrelated scalar subquery. It's for returning information to buildSubquery() as well.
+	subQueryMaxExecutionTime uint64
 
 	// disableSubQueryPreprocessing indicates whether to pre-process uncorrelated sub-queries in rewriting stage.
 	disableSubQueryPreprocessing bool
@@ -494,6 +502,8 @@ func (b *PlanBuilder) Build(ctx context.Context, node ast.Node) (base.Plan, erro
 		return b.buildInsert(ctx, x)
 	case *ast.ImportIntoStmt:
 		return b.buildImportInto(ctx, x)
+	case *ast.ExportTableStmt:
+		return b.buildExportTable(ctx, x)
 	case *ast.LoadDataStmt:
 		return b.buildLoadData(ctx, x)
 	case *ast.LoadStatsStmt:
@@ -731,7 +741,8 @@ func (b *PlanBuilder) buildDropBindPlan(v *ast.DropBindingStmt) (base.Plan, erro
 			SQLDigest: v.SQLDigest,
 		}
 	}
-	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "", "", "", nil)
+	authErr := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or SQL_TUNING_ADMIN")
+	b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "SQL_TUNING_ADMIN", false, authErr)
 	return p, nil
 }
 
@@ -760,7 +771,8 @@ func (b *PlanBuilder) buildSetBindingStatusPlan(v *ast.SetBindingStmt) (base.Pla
 	if v.HintedNode != nil {
 		p.BindSQL = utilparser.RestoreWithDefaultDB(v.HintedNode, b.ctx.GetSessionVars().CurrentDB, v.HintedNode.Text())
 	}
-	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "", "", "", nil)
+	authErr := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or SQL_TUNING_ADMIN")
+	b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "SQL_TUNING_ADMIN", false, authErr)
 	return p, nil
 }
 
@@ -807,17 +819,10 @@ func fetchRecordFromClusterStmtSummary(sctx base.PlanContext, planDigest string)
 }
 
 func (b *PlanBuilder) buildCreateBindPlanFromPlanDigest(v *ast.CreateBindingStmt) (base.Plan, error) {
-	if v.PlanDigest == "" {
-		return nil, errors.New("plan digest is empty")
-	}
-	rows, err := fetchRecordFromClusterStmtSummary(b.ctx, v.PlanDigest)
+	bindableStmt, err := fetchBindableStmtByPlanDigest(b.ctx, v.PlanDigest)
 	if err != nil {
 		return nil, err
 	}
-	bindableStmt := stmtsummary.GetBindableStmtFromCluster(rows)
-	if bindableStmt == nil {
-		return nil, errors.New("can't find any plans for '" + v.PlanDigest + "'")
-	}
 
 	parser4binding := parser.New()
 	originNode, err := parser4binding.ParseOneStmt(bindableStmt.Query, bindableStmt.Charset, bindableStmt.Collation)
@@ -852,10 +857,87 @@ func (b *PlanBuilder) buildCreateBindPlanFromPlanDigest(v *ast.CreateBindingStmt
 		SQLDigest:    sqlDigestWithDB.String(),
 		PlanDigest:   v.PlanDigest,
 	}
-	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "", "", "", nil)
+	authErr := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or SQL_TUNING_ADMIN")
+	b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "SQL_TUNING_ADMIN", false, authErr)
 	return p, nil
 }
 
+// fetchBindableStmtByPlanDigest fetches the statement of the plan digest from the cluster statement summary.
+func fetchBindableStmtByPlanDigest(sctx base.PlanContext, planDigest string) (*stmtsummary.BindableStmt, error) {
+	if planDigest == "" {
+		return nil, errors.New("plan digest is empty")
+	}
+	rows, err := fetchRecordFromClusterStmtSummary(sctx, planDigest)
+	if err != nil {
+		return nil, err
+	}
+	bindableStmt := stmtsummary.GetBindableStmtFromCluster(rows)
+	if bindableStmt == nil {
+		return nil, errors.New("can't find any plans for '" + planDigest + "'")
+	}
+	return bindableStmt, nil
+}
+
+// buildAdminRecommendHints compares the hints generated from the bad plan and the good plan of the same statement,
+// and recommends the hints of the good plan which are absent in the bad plan, along with a binding built from all
+// the hints of the good plan.
+func (b *PlanBuilder) buildAdminRecommendHints(as *ast.AdminStmt) (base.Plan, error) {
+	badStmt, err := fetchBindableStmtByPlanDigest(b.ctx, as.BadPlanDigest)
+	if err != nil {
+		return nil, err
+	}
+	goodStmt, err := fetchBindableStmtByPlanDigest(b.ctx, as.GoodPlanDigest)
+	if err != nil {
+		return nil, err
+	}
+
+	p := parser.New()
+	badNode, err := p.ParseOneStmt(badStmt.Query, badStmt.Charset, badStmt.Collation)
+	if err != nil {
+		return nil, errors.Errorf("recommend hints failed: %v", err)
+	}
+	goodNode, err := p.ParseOneStmt(goodStmt.Query, goodStmt.Charset, goodStmt.Collation)
+	if err != nil {
+		return nil, errors.Errorf("recommend hints failed: %v", err)
+	}
+	restoredSQL := utilparser.RestoreWithDefaultDB(goodNode, goodStmt.Schema, goodStmt.Query)
+	_, goodDigest := parser.NormalizeDigestForBinding(restoredSQL)
+	_, badDigest := parser.NormalizeDigestForBinding(utilparser.RestoreWithDefaultDB(badNode, badStmt.Schema, badStmt.Query))
+	if goodDigest.String() != badDigest.String() {
+		return nil, errors.Errorf("plan digest '%s' and '%s' do not belong to the same statement", as.BadPlanDigest, as.GoodPlanDigest)
+	}
+
+	goodHints, err := bindinfo.ParseDigestHints(goodStmt.PlanHint)
+	if err != nil {
+		return nil, errors.Errorf("recommend hints failed: %v", err)
+	}
+	var badHints []*ast.TableOptimizerHint
+	if badStmt.PlanHint != "" {
+		if badHints, err = bindinfo.ParseDigestHints(badStmt.PlanHint); err != nil {
+			return nil, errors.Errorf("recommend hints failed: %v", err)
+		}
+	}
+	diff := hint.DiffHints(goodHints, badHints)
+	if len(diff) == 0 {
+		return nil, errors.Errorf("no hint can distinguish plan digest '%s' from '%s'", as.GoodPlanDigest, as.BadPlanDigest)
+	}
+	if complete, reason := hint.CheckBindingFromHistoryComplete(goodNode, goodStmt.PlanHint); !complete {
+		b.ctx.GetSessionVars().StmtCtx.AppendWarning(errors.NewNoStackError(reason))
+	}
+
+	// The binding carries all the hints of the good plan, because the hints shared with the bad plan
+	// are only the optimizer's choice for now and may change once the diff hints are applied.
+	bindSQL := bindinfo.GenerateBindingSQL(goodNode, hint.RestoreOptimizerHints(goodHints), true, goodStmt.Schema)
+	// The hints of goodNode have been removed by GenerateBindingSQL.
+	originSQL := utilparser.RestoreWithDefaultDB(goodNode, goodStmt.Schema, "")
+	plan := &AdminRecommendHints{
+		Hints:         hint.RestoreOptimizerHints(diff),
+		CreateBinding: fmt.Sprintf("CREATE GLOBAL BINDING FOR %s USING %s", originSQL, bindSQL),
+	}
+	plan.setSchemaAndNames(buildAdminRecommendHintsFields())
+	return plan, nil
+}
+
 func (b *PlanBuilder) buildCreateBindPlan(v *ast.CreateBindingStmt) (base.Plan, error) {
 	if v.OriginNode == nil {
 		return b.buildCreateBindPlanFromPlanDigest(v)
@@ -887,7 +969,8 @@ func (b *PlanBuilder) buildCreateBindPlan(v *ast.CreateBindingStmt) (base.Plan,
 		Source:       bindinfo.Manual,
 		SQLDigest:    sqlDigestWithDB.String(),
 	}
-	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "", "", "", nil)
+	authErr := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or SQL_TUNING_ADMIN")
+	b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "SQL_TUNING_ADMIN", false, authErr)
 	return p, nil
 }
 
@@ -1032,7 +1115,40 @@ func getLatestIndexInfo(ctx base.PlanContext, id int64, startVer int64) (map[int
 	return latestIndexes, true, nil
 }
 
-func getPossibleAccessPaths(ctx base.PlanContext, tableHints *hint.PlanHints, indexHints []*ast.IndexHint, tbl table.Table, dbName, tblName model.CIStr, check bool, hasFlagPartitionProcessor bool) ([]*util.AccessPath, error) {
+// tiflashReplicaForStaleRead returns the TiFlash replica info of the table in the latest schema. The schema used by a
+// stale read may be older than the TiFlash replica, while the replica keeps the history versions of the data, so it
+// can serve the stale read as long as the columns and partitions read by the stale read still exist.
+func tiflashReplicaForStaleRead(ctx base.PlanContext, tblInfo *model.TableInfo) *model.TiFlashReplicaInfo {
+	latestIS, ok := ctx.GetDomainInfoSchema().(infoschema.InfoSchema)
+	if !ok {
+		return tblInfo.TiFlashReplica
+	}
+	latestTbl, ok := latestIS.TableByID(tblInfo.ID)
+	if !ok {
+		return tblInfo.TiFlashReplica
+	}
+	replica := latestTbl.Meta().TiFlashReplica
+	if replica == nil || !replica.Available {
+		return tblInfo.TiFlashReplica
+	}
+	for _, col := range tblInfo.Columns {
+		if model.FindColumnInfoByID(latestTbl.Meta().Columns, col.ID) == nil {
+			return tblInfo.TiFlashReplica
+		}
+	}
+	if pi := tblInfo.GetPartitionInfo(); pi != nil {
+		for _, def := range pi.Definitions {
+			if !replica.IsPartitionAvailable(def.ID) {
+				return tblInfo.TiFlashReplica
+			}
+		}
+	}
+	return replica
+}
+
+// partitionName is the name of the partition if the access paths are for a partition in the static prune mode, the
+// warnings for the unmatched index hints of the partitions are aggregated into one.
+func getPossibleAccessPaths(ctx base.PlanContext, tableHints *hint.PlanHints, indexHints []*ast.IndexHint, tbl table.Table, dbName, tblName, partitionName model.CIStr, check bool, hasFlagPartitionProcessor bool) ([]*util.AccessPath, error) {
 	tblInfo := tbl.Meta()
 	publicPaths := make([]*util.AccessPath, 0, len(tblInfo.Indices)+2)
 	tp := kv.TiKV
@@ -1043,9 +1159,13 @@ func getPossibleAccessPaths(ctx base.PlanContext, tableHints *hint.PlanHints, in
 	fillContentForTablePath(tablePath, tblInfo)
 	publicPaths = append(publicPaths, tablePath)
 
-	if tblInfo.TiFlashReplica == nil {
+	tiflashReplica := tblInfo.TiFlashReplica
+	if ctx.GetSessionVars().StmtCtx.IsStaleness && (tiflashReplica == nil || !tiflashReplica.Available) {
+		tiflashReplica = tiflashReplicaForStaleRead(ctx, tblInfo)
+	}
+	if tiflashReplica == nil {
 		ctx.GetSessionVars().RaiseWarningWhenMPPEnforced("MPP mode may be blocked because there aren't tiflash replicas of table `" + tblInfo.Name.O + "`.")
-	} else if !tblInfo.TiFlashReplica.Available {
+	} else if !tiflashReplica.Available {
 		ctx.GetSessionVars().RaiseWarningWhenMPPEnforced("MPP mode may be blocked because tiflash replicas of table `" + tblInfo.Name.O + "` not ready.")
 	} else {
 		publicPaths = append(publicPaths, genTiFlashPath(tblInfo))
@@ -1062,7 +1182,7 @@ func getPossibleAccessPaths(ctx base.PlanContext, tableHints *hint.PlanHints, in
 		}
 	}
 
-	optimizerUseInvisibleIndexes := ctx.GetSessionVars().OptimizerUseInvisibleIndexes
+	optimizerUseInvisibleIndexes := ctx.GetSessionVars().GetOptimizerUseInvisibleIndexes()
 
 	check = check || ctx.GetSessionVars().IsIsolation(ast.ReadCommitted)
 	check = check && ctx.GetSessionVars().ConnectionID > 0
@@ -1075,9 +1195,17 @@ func getPossibleAccessPaths(ctx base.PlanContext, tableHints *hint.PlanHints, in
 			if !optimizerUseInvisibleIndexes && index.Invisible {
 				continue
 			}
+			// Filter out the indexes which the optimizer pretends don't exist by tidb_opt_ignored_indexes
+			if ctx.GetSessionVars().IsIndexIgnored(dbName.L, tblInfo.Name.L, index.Name.L) {
+				continue
+			}
 			if tblInfo.IsCommonHandle && index.Primary {
 				continue
 			}
+			// The NO_FULLTEXT_INDEX hint evaluates MATCH ... AGAINST on every row without the FULLTEXT index.
+			if index.Tp == model.IndexTypeFullText && tableHints != nil && tableHints.PreferFullText&hint.PreferNoFullTextIndex > 0 {
+				continue
+			}
 			if check && latestIndexes == nil {
 				latestIndexes, check, err = getLatestIndexInfo(ctx, tblInfo.ID, 0)
 				if err != nil {
@@ -1156,6 +1284,10 @@ func getPossibleAccessPaths(ctx base.PlanContext, tableHints *hint.PlanHints, in
 				if i < indexHintsLen {
 					return nil, err
 				}
+				if partitionName.L != "" {
+					warnUnmatchedIndexHintForPartition(ctx, hint, tblInfo, partitionName, err)
+					continue
+				}
 				ctx.GetSessionVars().StmtCtx.AppendWarning(err)
 				continue
 			}
@@ -1174,6 +1306,9 @@ func getPossibleAccessPaths(ctx base.PlanContext, tableHints *hint.PlanHints, in
 			if hint.HintType == ast.HintNoOrderIndex {
 				path.ForceNoKeepOrder = true
 			}
+			if hint.HintType == ast.HintLooseIndexScan {
+				path.ForceLooseScan = true
+			}
 			available = append(available, path)
 		}
 	}
@@ -1211,6 +1346,17 @@ func getPossibleAccessPaths(ctx base.PlanContext, tableHints *hint.PlanHints, in
 	return available, nil
 }
 
+// warnUnmatchedIndexHintForPartition sets the warning for the index hint which is unmatched on the partition, the
+// warnings of all the partitions are aggregated into one.
+func warnUnmatchedIndexHintForPartition(ctx base.PlanContext, indexHint *ast.IndexHint, tblInfo *model.TableInfo, partitionName model.CIStr, err error) {
+	ctx.GetSessionVars().StmtCtx.SetHintWarningForScope(err, hint.WarningScope{
+		Hint:  (&hint.HintedIndex{IndexHint: indexHint}).HintTypeString(),
+		Table: tblInfo.Name.O,
+		Kind:  hint.WarningScopePartition,
+		Name:  partitionName.O,
+	})
+}
+
 func filterPathByIsolationRead(ctx base.PlanContext, paths []*util.AccessPath, tblName model.CIStr, dbName model.CIStr) ([]*util.AccessPath, error) {
 	// TODO: filter paths with isolation read locations.
 	if util2.IsSysDB(dbName.L) {
@@ -1442,16 +1588,39 @@ func (b *PlanBuilder) buildAdmin(ctx context.Context, as *ast.AdminStmt) (base.P
 		return &Simple{Statement: as}, nil
 	case ast.AdminSetBDRRole, ast.AdminUnsetBDRRole:
 		ret = &Simple{Statement: as}
+	case ast.AdminSetHintsForDigest, ast.AdminUnsetHintsForDigest:
+		ret = &Simple{Statement: as}
 	case ast.AdminShowBDRRole:
 		p := &AdminShowBDRRole{}
 		p.setSchemaAndNames(buildAdminShowBDRRoleFields())
 		ret = p
+	case ast.AdminRecommendHints:
+		p, err := b.buildAdminRecommendHints(as)
+		if err != nil {
+			return nil, err
+		}
+		ret = p
+	case ast.AdminRecommendDropIndex:
+		p := &AdminRecommendDropIndex{Tables: as.Tables}
+		p.setSchemaAndNames(buildAdminRecommendDropIndexFields())
+		ret = p
+	case ast.AdminShowDiagnostics:
+		p := &AdminShowDiagnostics{SQL: as.DiagnosticsSQL, Digest: as.DiagnosticsDigest}
+		p.setSchemaAndNames(buildAdminShowDiagnosticsFields())
+		ret = p
 	default:
 		return nil, plannererrors.ErrUnsupportedType.GenWithStack("Unsupported ast.AdminStmt(%T) for buildAdmin", as)
 	}
 
-	// Admin command can only be executed by administrator.
-	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "", "", "", nil)
+	switch as.Tp {
+	case ast.AdminSetHintsForDigest, ast.AdminUnsetHintsForDigest, ast.AdminRecommendHints, ast.AdminShowDiagnostics:
+		// The hints can also be managed by the users with SQL_TUNING_ADMIN, who are not administrators.
+		err := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or SQL_TUNING_ADMIN")
+		b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "SQL_TUNING_ADMIN", false, err)
+	default:
+		// Admin command can only be executed by administrator.
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SuperPriv, "", "", "", nil)
+	}
 	return ret, nil
 }
 
@@ -2468,6 +2637,9 @@ func (b *PlanBuilder) buildAnalyzeTable(as *ast.AnalyzeTableStmt, opts map[ast.A
 		if tbl.TableInfo.IsSequence() {
 			return nil, errors.Errorf("analyze sequence %s is not supported now", tbl.Name.O)
 		}
+		if tbl.TableInfo.IsExternal() {
+			return nil, plannererrors.ErrUnsupportedExternalTable.GenWithStackByArgs(tbl.Name.O, "ANALYZE is not supported")
+		}
 
 		idxInfo, colInfo := getColsInfo(tbl)
 		physicalIDs, partitionNames, err := GetPhysicalIDsAndPartitionNames(tbl.TableInfo, as.PartitionNames)
@@ -2968,6 +3140,35 @@ func buildAdminShowBDRRoleFields() (*expression.Schema, types.NameSlice) {
 	return schema.col2Schema(), schema.names
 }
 
+func buildAdminRecommendHintsFields() (*expression.Schema, types.NameSlice) {
+	schema := newColumnsWithNames(2)
+	schema.Append(buildColumnWithName("", "HINTS", mysql.TypeVarchar, 4096))
+	schema.Append(buildColumnWithName("", "CREATE_BINDING", mysql.TypeVarchar, 4096))
+	return schema.col2Schema(), schema.names
+}
+
+func buildAdminRecommendDropIndexFields() (*expression.Schema, types.NameSlice) {
+	schema := newColumnsWithNames(13)
+	schema.Append(buildColumnWithName("", "TABLE_SCHEMA", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "TABLE_NAME", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "INDEX_NAME", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "REASON", mysql.TypeVarchar, 32))
+	schema.Append(buildColumnWithName("", "COVERING_INDEX", mysql.TypeVarchar, 64))
+	schema.Append(buildColumnWithName("", "SCANS", mysql.TypeLonglong, 21))
+	schema.Append(buildColumnWithName("", "POINT_GETS", mysql.TypeLonglong, 21))
+	schema.Append(buildColumnWithName("", "LAST_ACCESS_TIME", mysql.TypeDatetime, 26))
+	schema.Append(buildColumnWithName("", "AFFECTED_DIGESTS", mysql.TypeVarchar, 4096))
+	schema.Append(buildColumnWithName("", "COST_INCREASE", mysql.TypeDouble, 22))
+	schema.Append(buildColumnWithName("", "RISK_SCORE", mysql.TypeLonglong, 21))
+	schema.Append(buildColumnWithName("", "DROP_SQL", mysql.TypeVarchar, 4096))
+	schema.Append(buildColumnWithName("", "NOTE", mysql.TypeVarchar, 4096))
+	return schema.col2Schema(), schema.names
+}
+
+func buildAdminShowDiagnosticsFields() (*expression.Schema, types.NameSlice) {
+	return convert2OutputSchemasAndNames([]string{"DIGEST", "DIAGNOSTICS"}, []byte{mysql.TypeVarchar, mysql.TypeJSON})
+}
+
 func buildShowBackupMetaSchema() (*expression.Schema, types.NameSlice) {
 	names := []string{"Database", "Table", "Total_kvs", "Total_bytes", "Time_range_start", "Time_range_end"}
 	ftypes := []byte{mysql.TypeVarchar, mysql.TypeVarchar, mysql.TypeLonglong, mysql.TypeLonglong, mysql.TypeDatetime, mysql.TypeDatetime}
@@ -3607,6 +3808,9 @@ func (b *PlanBuilder) buildInsert(ctx context.Context, insert *ast.InsertStmt) (
 		}
 		return nil, err
 	}
+	if tableInfo.IsExternal() {
+		return nil, plannererrors.ErrUnsupportedExternalTable.GenWithStackByArgs(tableInfo.Name.O, "external tables are read-only")
+	}
 	// Build Schema with DBName otherwise ColumnRef with DBName cannot match any Column in Schema.
 	schema, names, err := expression.TableInfo2SchemaAndNames(b.ctx.GetExprCtx(), tn.Schema, tableInfo)
 	if err != nil {
@@ -3705,6 +3909,9 @@ func (b *PlanBuilder) buildInsert(ctx context.Context, insert *ast.InsertStmt) (
 	if err != nil {
 		return nil, err
 	}
+	if err = b.checkMaskedColumnsInOnDuplicate(insertPlan, tableInfo); err != nil {
+		return nil, err
+	}
 
 	// Calculate generated columns.
 	mockTablePlan.schema = insertPlan.tableSchema
@@ -4054,6 +4261,9 @@ func (b *PlanBuilder) buildSelectPlanOfInsert(ctx context.Context, insert *ast.I
 }
 
 func (b *PlanBuilder) buildLoadData(ctx context.Context, ld *ast.LoadDataStmt) (base.Plan, error) {
+	if ld.Table.TableInfo != nil && ld.Table.TableInfo.IsExternal() {
+		return nil, plannererrors.ErrUnsupportedExternalTable.GenWithStackByArgs(ld.Table.Name.O, "external tables are read-only")
+	}
 	mockTablePlan := LogicalTableDual{}.Init(b.ctx, b.getSelectOffset())
 	var (
 		err     error
@@ -4121,6 +4331,16 @@ var (
 
 	// ImportIntoDataSource used inplannererrors.ErrLoadDataInvalidURI.
 	ImportIntoDataSource = "data source"
+
+	exportTableSchemaNames  = []string{"Snapshot_TS", "Destination", "File_Count", "Exported_Rows"}
+	exportTableSchemaFTypes = []byte{mysql.TypeLonglong, mysql.TypeString, mysql.TypeLonglong, mysql.TypeLonglong}
+
+	// ExportTableDestination used in exeerrors.ErrLoadDataInvalidURI.
+	ExportTableDestination = "export destination"
+	// SelectIntoOutfile used in exeerrors.ErrLoadDataInvalidURI.
+	SelectIntoOutfile = "outfile"
+	// ExternalTableLocation used in exeerrors.ErrLoadDataInvalidURI.
+	ExternalTableLocation = "external table location"
 )
 
 func (b *PlanBuilder) buildImportInto(ctx context.Context, ld *ast.ImportIntoStmt) (base.Plan, error) {
@@ -4141,6 +4361,12 @@ func (b *PlanBuilder) buildImportInto(ctx context.Context, ld *ast.ImportIntoStm
 	if importFromServer && sem.IsEnabled() {
 		return nil, plannererrors.ErrNotSupportedWithSem.GenWithStackByArgs("IMPORT INTO from server disk")
 	}
+	if ld.SourceDSN != "" && sem.IsEnabled() {
+		return nil, plannererrors.ErrNotSupportedWithSem.GenWithStackByArgs("IMPORT INTO from another cluster")
+	}
+	if ld.Table.TableInfo != nil && ld.Table.TableInfo.IsExternal() {
+		return nil, plannererrors.ErrUnsupportedExternalTable.GenWithStackByArgs(ld.Table.Name.O, "external tables are read-only")
+	}
 
 	for _, opt := range ld.Options {
 		loadDataOpt := LoadDataOpt{Name: opt.Name}
@@ -4180,6 +4406,12 @@ func (b *PlanBuilder) buildImportInto(ctx context.Context, ld *ast.ImportIntoStm
 	if importFromServer {
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.FilePriv, "", "", "", plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("FILE"))
 	}
+	if ld.SourceDSN != "" {
+		// TiDB connects to the host and port in the DSN on behalf of the user,
+		// which is only allowed for administrators.
+		authErr := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or REMOTE_IMPORT_ADMIN")
+		b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "REMOTE_IMPORT_ADMIN", false, authErr)
+	}
 	tableInfo := p.Table.TableInfo
 	// we use the latest IS to support IMPORT INTO dst FROM SELECT * FROM src AS OF TIMESTAMP '2020-01-01 00:00:00'
 	// Note: we need to get p.Table when preprocessing, at that time, IS of session
@@ -4212,7 +4444,17 @@ func (b *PlanBuilder) buildImportInto(ctx context.Context, ld *ast.ImportIntoStm
 		return nil, err
 	}
 
-	if ld.Select != nil {
+	if ld.SourceDSN != "" {
+		// the select is sent to the source cluster as it is, the number of
+		// columns is checked when reading the result.
+		var sb strings.Builder
+		restoreCtx := format.NewRestoreCtx(format.DefaultRestoreFlags, &sb)
+		if err = ld.Select.Restore(restoreCtx); err != nil {
+			return nil, err
+		}
+		p.SourceDSN = ld.SourceDSN
+		p.SourceQuery = sb.String()
+	} else if ld.Select != nil {
 		// privilege of tables in select will be checked here
 		selectPlan, err2 := b.Build(ctx, ld.Select)
 		if err2 != nil {
@@ -4235,6 +4477,75 @@ func (b *PlanBuilder) buildImportInto(ctx context.Context, ld *ast.ImportIntoStm
 	return p, nil
 }
 
+func (b *PlanBuilder) buildExportTable(ctx context.Context, et *ast.ExportTableStmt) (base.Plan, error) {
+	exportToServer, err := storage.IsLocalPath(et.Path)
+	if err != nil {
+		return nil, exeerrors.ErrLoadDataInvalidURI.FastGenByArgs(ExportTableDestination, err.Error())
+	}
+	if exportToServer && sem.IsEnabled() {
+		return nil, plannererrors.ErrNotSupportedWithSem.GenWithStackByArgs("EXPORT TABLE to server disk")
+	}
+	tableInfo := et.Table.TableInfo
+	if tableInfo.IsView() || tableInfo.IsSequence() || tableInfo.TempTableType != model.TempTableNone {
+		return nil, plannererrors.ErrNotSupportedYet.GenWithStackByArgs("EXPORT TABLE of view, sequence or temporary table")
+	}
+	if tableInfo.IsExternal() {
+		return nil, plannererrors.ErrUnsupportedExternalTable.GenWithStackByArgs(tableInfo.Name.O, "EXPORT TABLE is not supported, use SELECT INTO OUTFILE instead")
+	}
+
+	mockTablePlan := LogicalTableDual{}.Init(b.ctx, b.getSelectOffset())
+	options := make([]*LoadDataOpt, 0, len(et.Options))
+	for _, opt := range et.Options {
+		exportOpt := LoadDataOpt{Name: opt.Name}
+		if opt.Value != nil {
+			exportOpt.Value, _, err = b.rewrite(ctx, opt.Value, mockTablePlan, nil, true)
+			if err != nil {
+				return nil, err
+			}
+		}
+		options = append(options, &exportOpt)
+	}
+	p := &ExportTable{
+		Table:   et.Table,
+		Path:    et.Path,
+		Format:  et.Format,
+		Options: options,
+	}
+	var selectErr error
+	if user := b.ctx.GetSessionVars().User; user != nil {
+		selectErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("SELECT", user.AuthUsername, user.AuthHostname, p.Table.Name.O)
+	}
+	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.SelectPriv, p.Table.Schema.O, p.Table.Name.O, "", selectErr)
+	if exportToServer {
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.FilePriv, "", "", "", plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("FILE"))
+	}
+	b.checkExportTablePolicies(tableInfo)
+	outputSchema, outputFields := convert2OutputSchemasAndNames(exportTableSchemaNames, exportTableSchemaFTypes)
+	p.setSchemaAndNames(outputSchema, outputFields)
+	return p, nil
+}
+
+// checkExportTablePolicies requires the privileges to bypass the row policies and the masking policies which
+// restrict the current user, since the table is exported by internal sessions without them.
+func (b *PlanBuilder) checkExportTablePolicies(tableInfo *model.TableInfo) {
+	sessionVars := b.ctx.GetSessionVars()
+	if sessionVars.User == nil {
+		return
+	}
+	// All the rows are filtered out if none of the row policies applies to the user.
+	if len(tableInfo.RowPolicies) > 0 {
+		authErr := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or BYPASS_ROW_POLICY")
+		b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "BYPASS_ROW_POLICY", false, authErr)
+	}
+	for _, policy := range tableInfo.MaskingPolicies {
+		if policy.AppliesTo(sessionVars.User, sessionVars.ActiveRoles) {
+			authErr := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("SUPER or BYPASS_DATA_MASKING")
+			b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "BYPASS_DATA_MASKING", false, authErr)
+			break
+		}
+	}
+}
+
 func (*PlanBuilder) buildLoadStats(ld *ast.LoadStatsStmt) base.Plan {
 	p := &LoadStats{Path: ld.Path}
 	return p
@@ -4290,6 +4601,9 @@ func (b *PlanBuilder) buildSplitRegion(node *ast.SplitRegionStmt) (base.Plan, er
 	if node.Table.TableInfo.TempTableType != model.TempTableNone {
 		return nil, plannererrors.ErrOptOnTemporaryTable.GenWithStackByArgs("split table")
 	}
+	if node.Table.TableInfo.IsExternal() {
+		return nil, plannererrors.ErrUnsupportedExternalTable.GenWithStackByArgs(node.Table.Name.O, "SPLIT TABLE is not supported")
+	}
 	if node.SplitSyntaxOpt != nil && node.SplitSyntaxOpt.HasPartition && node.Table.TableInfo.Partition == nil {
 		return nil, plannererrors.ErrPartitionClauseOnNonpartitioned
 	}
@@ -4661,6 +4975,17 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (base.Plan
 		}
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.CreatePriv, v.Table.Schema.L,
 			v.Table.Name.L, "", authErr)
+		for _, opt := range v.Options {
+			if opt.Tp != ast.TableOptionExternalLocation {
+				continue
+			}
+			// The files of external tables are read with the credentials of TiDB server.
+			if sem.IsEnabled() {
+				return nil, plannererrors.ErrNotSupportedWithSem.GenWithStackByArgs("external table")
+			}
+			b.visitInfo = appendVisitInfo(b.visitInfo, mysql.FilePriv, "", "", "",
+				plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("FILE"))
+		}
 		if v.ReferTable != nil {
 			if b.ctx.GetSessionVars().User != nil {
 				authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("CREATE", b.ctx.GetSessionVars().User.AuthUsername,
@@ -4720,6 +5045,34 @@ func (b *PlanBuilder) buildDDL(ctx context.Context, node ast.DDLNode) (base.Plan
 		}
 		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.CreatePriv, v.Name.Schema.L,
 			v.Name.Name.L, "", authErr)
+	case *ast.CreateRowPolicyStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("ALTER", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
+	case *ast.DropRowPolicyStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("ALTER", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
+	case *ast.CreateMaskingPolicyStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("ALTER", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
+	case *ast.DropMaskingPolicyStmt:
+		if b.ctx.GetSessionVars().User != nil {
+			authErr = plannererrors.ErrTableaccessDenied.GenWithStackByArgs("ALTER", b.ctx.GetSessionVars().User.AuthUsername,
+				b.ctx.GetSessionVars().User.AuthHostname, v.Table.Name.L)
+		}
+		b.visitInfo = appendVisitInfo(b.visitInfo, mysql.AlterPriv, v.Table.Schema.L,
+			v.Table.Name.L, "", authErr)
 	case *ast.DropDatabaseStmt:
 		if b.ctx.GetSessionVars().User != nil {
 			authErr = plannererrors.ErrDBaccessDenied.GenWithStackByArgs(b.ctx.GetSessionVars().User.AuthUsername,
@@ -4980,6 +5333,9 @@ func (b *PlanBuilder) buildExplain(ctx context.Context, explain *ast.ExplainStmt
 		return nil, err
 	}
 
+	if err := b.overrideExplainVars(explain.Vars); err != nil {
+		return nil, err
+	}
 	targetPlan, _, err := OptimizeAstNode(ctx, sctx, explain.Stmt, b.is)
 	if err != nil {
 		return nil, err
@@ -4988,24 +5344,96 @@ func (b *PlanBuilder) buildExplain(ctx context.Context, explain *ast.ExplainStmt
 	return b.buildExplainPlan(targetPlan, explain.Format, nil, explain.Analyze, explain.Stmt, nil)
 }
 
+// overrideExplainVars sets the variables of `EXPLAIN ... WITH (var = value, ...)` before planning the statement.
+// Only the variables which can be set by the SET_VAR hints are allowed. Like the SET_VAR hints, they're restored to the old values after the statement, and the SET_VAR hints of the
+// statement take precedence over them.
+func (b *PlanBuilder) overrideExplainVars(vars []*ast.VariableAssignment) error {
+	if len(vars) == 0 {
+		return nil
+	}
+	sessVars := b.ctx.GetSessionVars()
+	for _, v := range vars {
+		sysVar := variable.GetSysVar(v.Name)
+		if sysVar == nil {
+			return variable.ErrUnknownSystemVar.GenWithStackByArgs(v.Name)
+		}
+		// Only the variables which are verified to work for the SET_VAR hint take effect for a single statement.
+		if !sysVar.IsHintUpdatableVerified {
+			return errors.Errorf("variable '%s' can't be overridden by EXPLAIN", v.Name)
+		}
+		if sem.IsEnabled() && sem.IsInvisibleSysVar(v.Name) {
+			err := plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("RESTRICTED_VARIABLES_ADMIN")
+			b.visitInfo = appendDynamicVisitInfo(b.visitInfo, "RESTRICTED_VARIABLES_ADMIN", false, err)
+		}
+		var val string
+		switch x := v.Value.(type) {
+		case *ast.DefaultExpr:
+			val = sysVar.Value
+		case *ast.ColumnNameExpr:
+			val = x.Name.Name.O
+		default:
+			d, err := evalAstExprWithPlanCtx(b.ctx, x)
+			if err != nil {
+				return err
+			}
+			if val, err = d.ToString(); err != nil {
+				return err
+			}
+		}
+		oldV, err := sessVars.SetSystemVarWithOldValAsRet(sysVar.Name, val)
+		if err != nil {
+			return err
+		}
+		if _, ok := sessVars.StmtCtx.SetVarHintRestore[sysVar.Name]; !ok {
+			sessVars.StmtCtx.AddSetVarHintRestore(sysVar.Name, oldV)
+		}
+	}
+	sessVars.StmtCtx.SetSkipPlanCache("EXPLAIN overrides the variables")
+	return nil
+}
+
 func (b *PlanBuilder) buildSelectInto(ctx context.Context, sel *ast.SelectStmt) (base.Plan, error) {
+	selectIntoInfo := sel.SelectIntoOpt
+	if _, err := storage.IsLocalPath(selectIntoInfo.FileName); err != nil {
+		return nil, exeerrors.ErrLoadDataInvalidURI.FastGenByArgs(SelectIntoOutfile, err.Error())
+	}
+	// the outfile on the external storage is written with the credentials of TiDB server,
+	// so it's not supported under SEM and needs the FILE privilege too.
 	if sem.IsEnabled() {
 		return nil, plannererrors.ErrNotSupportedWithSem.GenWithStackByArgs("SELECT INTO")
 	}
-	selectIntoInfo := sel.SelectIntoOpt
+	format := SelectIntoFormatCSV
+	if selectIntoInfo.Format != nil {
+		format = strings.ToLower(*selectIntoInfo.Format)
+	}
+	switch format {
+	case SelectIntoFormatCSV:
+	case SelectIntoFormatParquet:
+		if selectIntoInfo.FieldsInfo != nil || selectIntoInfo.LinesInfo != nil {
+			return nil, exeerrors.ErrLoadDataUnsupportedOption.FastGenByArgs("FIELDS or LINES", "parquet format")
+		}
+	default:
+		return nil, exeerrors.ErrLoadDataUnsupportedFormat.FastGenByArgs(*selectIntoInfo.Format)
+	}
+	// the select-into option is kept in the AST for the redaction of the statement text.
 	sel.SelectIntoOpt = nil
+	defer func() {
+		sel.SelectIntoOpt = selectIntoInfo
+	}()
 	sctx, err := AsSctx(b.ctx)
 	if err != nil {
 		return nil, err
 	}
-	targetPlan, _, err := OptimizeAstNode(ctx, sctx, sel, b.is)
+	targetPlan, names, err := OptimizeAstNode(ctx, sctx, sel, b.is)
 	if err != nil {
 		return nil, err
 	}
 	b.visitInfo = appendVisitInfo(b.visitInfo, mysql.FilePriv, "", "", "", plannererrors.ErrSpecificAccessDenied.GenWithStackByArgs("FILE"))
 	return &SelectInto{
 		TargetPlan:     targetPlan,
+		TargetNames:    names,
 		IntoOpt:        selectIntoInfo,
+		Format:         format,
 		LineFieldsInfo: NewLineFieldsInfo(selectIntoInfo.FieldsInfo, selectIntoInfo.LinesInfo),
 	}, nil
 }
@@ -5289,7 +5717,7 @@ func convert2OutputSchemasAndNames(names []string, ftypes []byte) (schema *expre
 
 func (b *PlanBuilder) buildPlanReplayer(pc *ast.PlanReplayerStmt) base.Plan {
 	p := &PlanReplayer{ExecStmt: pc.Stmt, Analyze: pc.Analyze, Load: pc.Load, File: pc.File,
-		Capture: pc.Capture, Remove: pc.Remove, SQLDigest: pc.SQLDigest, PlanDigest: pc.PlanDigest}
+		Capture: pc.Capture, Remove: pc.Remove, SQLDigest: pc.SQLDigest, PlanDigest: pc.PlanDigest, CaptureLimit: pc.CaptureLimit}
 
 	if pc.HistoricalStatsInfo != nil {
 		p.HistoricalStatsTS = calcTSForPlanReplayer(b.ctx, pc.HistoricalStatsInfo.TsExpr)
@@ -961,7 +961,7 @@ func getLockWaitTime(ctx base.PlanContext, lockInfo *ast.SelectLockInfo) (lock b
 
 func newBatchPointGetPlan(
 	ctx base.PlanContext, patternInExpr *ast.PatternInExpr,
-	handleCol *model.ColumnInfo, tbl *model.TableInfo, schema *expression.Schema,
+	handleCol *model.ColumnInfo, dbName string, tbl *model.TableInfo, schema *expression.Schema,
 	names []*types.FieldName, whereColNames []string, indexHints []*ast.IndexHint,
 ) *BatchPointGetPlan {
 	stmtCtx := ctx.GetSessionVars().StmtCtx
@@ -1052,10 +1052,17 @@ func newBatchPointGetPlan(
 		}
 	}
 	for _, idxInfo := range tbl.Indices {
-		if !idxInfo.Unique || idxInfo.State != model.StatePublic || (idxInfo.Invisible && !ctx.GetSessionVars().OptimizerUseInvisibleIndexes) || idxInfo.MVIndex ||
-			!indexIsAvailableByHints(idxInfo, indexHints) {
+		if !idxInfo.Unique || idxInfo.State != model.StatePublic || (idxInfo.Invisible && !ctx.GetSessionVars().GetOptimizerUseInvisibleIndexes()) || idxInfo.MVIndex ||
+			ctx.GetSessionVars().IsIndexIgnored(dbName, tbl.Name.L, idxInfo.Name.L) || !indexIsAvailableByHints(idxInfo, indexHints) {
 			continue
 		}
+		if idxInfo.Global {
+			// Don't use the global index during ddl.
+			if len(tbl.GetPartitionInfo().AddingDefinitions) > 0 ||
+				len(tbl.GetPartitionInfo().DroppingDefinitions) > 0 {
+				continue
+			}
+		}
 		if len(idxInfo.Columns) != len(whereColNames) || idxInfo.HasPrefixIndex() {
 			continue
 		}
@@ -1219,6 +1226,10 @@ func tryWhereIn2BatchPointGet(ctx base.PlanContext, selStmt *ast.SelectStmt) *Ba
 	if tbl == nil {
 		return nil
 	}
+	// The predicates of row policies and the masked columns are injected when building the DataSource.
+	if len(tbl.RowPolicies) > 0 || len(tbl.MaskingPolicies) > 0 {
+		return nil
+	}
 	// Skip the optimization with partition selection.
 	// TODO: Add test and remove this!
 	if len(tblName.PartitionNames) > 0 {
@@ -1281,7 +1292,11 @@ func tryWhereIn2BatchPointGet(ctx base.PlanContext, selStmt *ast.SelectStmt) *Ba
 		return nil
 	}
 
-	p := newBatchPointGetPlan(ctx, in, handleCol, tbl, schema, names, whereColNames, tblName.IndexHints)
+	dbName := tblName.Schema.L
+	if dbName == "" {
+		dbName = ctx.GetSessionVars().CurrentDB
+	}
+	p := newBatchPointGetPlan(ctx, in, handleCol, dbName, tbl, schema, names, whereColNames, tblName.IndexHints)
 	if p == nil {
 		return nil
 	}
@@ -1316,6 +1331,10 @@ func tryPointGetPlan(ctx base.PlanContext, selStmt *ast.SelectStmt, check bool)
 	if tbl == nil {
 		return nil
 	}
+	// The predicates of row policies and the masked columns are injected when building the DataSource.
+	if len(tbl.RowPolicies) > 0 || len(tbl.MaskingPolicies) > 0 {
+		return nil
+	}
 
 	var pkColOffset int
 	for i, col := range tbl.Columns {
@@ -1382,8 +1401,8 @@ func checkTblIndexForPointPlan(ctx base.PlanContext, tblName *ast.TableName, sch
 		dbName = ctx.GetSessionVars().CurrentDB
 	}
 	for _, idxInfo := range tbl.Indices {
-		if !idxInfo.Unique || idxInfo.State != model.StatePublic || (idxInfo.Invisible && !ctx.GetSessionVars().OptimizerUseInvisibleIndexes) || idxInfo.MVIndex ||
-			!indexIsAvailableByHints(idxInfo, tblName.IndexHints) {
+		if !idxInfo.Unique || idxInfo.State != model.StatePublic || (idxInfo.Invisible && !ctx.GetSessionVars().GetOptimizerUseInvisibleIndexes()) || idxInfo.MVIndex ||
+			ctx.GetSessionVars().IsIndexIgnored(dbName, tbl.Name.L, idxInfo.Name.L) || !indexIsAvailableByHints(idxInfo, tblName.IndexHints) {
 			continue
 		}
 		if idxInfo.Global {
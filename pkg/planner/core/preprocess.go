@@ -35,6 +35,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/terror"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
+	"github.com/pingcap/tidb/pkg/planner/util/tablesampler"
 	"github.com/pingcap/tidb/pkg/privilege"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
@@ -347,6 +348,12 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 	case *ast.ImportIntoStmt:
 		p.stmtTp = TypeImportInto
 		p.flag |= inImportInto
+		if node.SourceDSN != "" {
+			// the select runs on the source cluster, only the target table is
+			// resolved here.
+			node.Table.Accept(p)
+			return in, true
+		}
 	case *ast.CreateSequenceStmt:
 		p.stmtTp = TypeCreate
 		p.flag |= inCreateOrDropTable
@@ -377,8 +384,12 @@ func (p *preprocessor) Enter(in ast.Node) (out ast.Node, skipChildren bool) {
 		if v, ok := node.Source.(*ast.TableName); ok && v.TableSample != nil {
 			switch v.TableSample.SampleMethod {
 			case ast.SampleMethodTypeTiDBRegion:
+			case ast.SampleMethodTypeSystem, ast.SampleMethodTypeBernoulli:
+				if _, err := tablesampler.GetSamplePercent(v.TableSample); err != nil {
+					p.err = err
+				}
 			default:
-				p.err = expression.ErrInvalidTableSample.GenWithStackByArgs("Only supports REGIONS sampling method")
+				p.err = expression.ErrInvalidTableSample.GenWithStackByArgs("Only supports REGIONS, SYSTEM and BERNOULLI sampling methods")
 			}
 		}
 	case *ast.GroupByClause:
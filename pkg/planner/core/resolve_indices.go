@@ -482,6 +482,21 @@ func (p *PhysicalIndexMergeReader) ResolveIndices() (err error) {
 	return nil
 }
 
+// ResolveIndices implements Plan interface.
+func (p *PhysicalJSONTable) ResolveIndices() (err error) {
+	err = p.basePhysicalPlan.ResolveIndices()
+	if err != nil {
+		return err
+	}
+	for i, expr := range p.PushedDownConds {
+		p.PushedDownConds[i], err = expr.ResolveIndices(p.schema)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ResolveIndices implements Plan interface.
 func (p *PhysicalSelection) ResolveIndices() (err error) {
 	err = p.basePhysicalPlan.ResolveIndices()
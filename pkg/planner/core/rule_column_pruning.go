@@ -385,6 +385,10 @@ func (ds *DataSource) PruneColumns(parentUsedCols []*expression.Column, opt *opt
 			ds.colsRequiringFullLen = append(ds.colsRequiringFullLen, col)
 		}
 	}
+	if ds.SampleInfo != nil {
+		// The samplers decode the rows by the offsets of all the columns.
+		return ds, nil
+	}
 
 	for i := len(used) - 1; i >= 0; i-- {
 		if !used[i] && !exprUsed[i] {
@@ -436,6 +440,13 @@ func (ds *DataSource) PruneColumns(parentUsedCols []*expression.Column, opt *opt
 
 // PruneColumns implements base.LogicalPlan interface.
 func (p *LogicalMemTable) PruneColumns(parentUsedCols []*expression.Column, opt *optimizetrace.LogicalOptimizeOp) (base.LogicalPlan, error) {
+	if p.TableInfo.IsExternal() {
+		// the columns used by the filters pushed down are still read from the data files.
+		if e, ok := p.Extractor.(*ExternalTableExtractor); ok {
+			parentUsedCols = expression.ExtractColumnsFromExpressions(parentUsedCols, e.Filters, nil)
+		}
+		return p.pruneColumns(parentUsedCols, opt)
+	}
 	switch p.TableInfo.Name.O {
 	case infoschema.TableStatementsSummary,
 		infoschema.TableStatementsSummaryHistory,
@@ -452,6 +463,10 @@ func (p *LogicalMemTable) PruneColumns(parentUsedCols []*expression.Column, opt
 	default:
 		return p, nil
 	}
+	return p.pruneColumns(parentUsedCols, opt)
+}
+
+func (p *LogicalMemTable) pruneColumns(parentUsedCols []*expression.Column, opt *optimizetrace.LogicalOptimizeOp) (base.LogicalPlan, error) {
 	prunedColumns := make([]*expression.Column, 0)
 	used := expression.GetUsedList(p.SCtx().GetExprCtx().GetEvalCtx(), parentUsedCols, p.schema)
 	for i := len(used) - 1; i >= 0; i-- {
@@ -480,6 +495,22 @@ func (p *LogicalTableDual) PruneColumns(parentUsedCols []*expression.Column, opt
 	return p, nil
 }
 
+// PruneColumns implements base.LogicalPlan interface.
+func (p *LogicalJSONTable) PruneColumns(parentUsedCols []*expression.Column, opt *optimizetrace.LogicalOptimizeOp) (base.LogicalPlan, error) {
+	parentUsedCols = expression.ExtractColumnsFromExpressions(parentUsedCols, p.PushedDownConds, nil)
+	used := expression.GetUsedList(p.SCtx().GetExprCtx().GetEvalCtx(), parentUsedCols, p.schema)
+	prunedColumns := make([]*expression.Column, 0)
+	for i := len(used) - 1; i >= 0; i-- {
+		if !used[i] && p.schema.Len() > 1 {
+			prunedColumns = append(prunedColumns, p.schema.Columns[i])
+			p.schema.Columns = append(p.schema.Columns[:i], p.schema.Columns[i+1:]...)
+			p.names = append(p.names[:i], p.names[i+1:]...)
+		}
+	}
+	appendColumnPruneTraceStep(p, prunedColumns, opt)
+	return p, nil
+}
+
 func (p *LogicalJoin) extractUsedCols(parentUsedCols []*expression.Column) (leftCols []*expression.Column, rightCols []*expression.Column) {
 	for _, eqCond := range p.EqualConditions {
 		parentUsedCols = append(parentUsedCols, expression.ExtractColumns(eqCond)...)
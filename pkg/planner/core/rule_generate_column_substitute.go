@@ -51,11 +51,18 @@ func (gc *gcSubstituter) optimize(ctx context.Context, lp base.LogicalPlan, opt
 
 // collectGenerateColumn collect the generate column and save them to a map from their expressions to themselves.
 // For the sake of simplicity, we don't collect the stored generate column because we can't get their expressions directly.
+// Nothing is collected if the substitution is disabled by the generated_column_substitute(false) hint, and
+// generated_column_substitute(true) collects the columns which are skipped by default.
 // TODO: support stored generate column.
 func collectGenerateColumn(lp base.LogicalPlan, exprToColumn ExprColumnMap) {
 	if _, ok := lp.(*LogicalCTE); ok {
 		return
 	}
+	stmtCtx := lp.SCtx().GetSessionVars().StmtCtx
+	if stmtCtx.HasGeneratedColumnSubstitute && !stmtCtx.GeneratedColumnSubstitute {
+		return
+	}
+	forceSubstitute := stmtCtx.HasGeneratedColumnSubstitute && stmtCtx.GeneratedColumnSubstitute
 	for _, child := range lp.Children() {
 		collectGenerateColumn(child, exprToColumn)
 	}
@@ -66,7 +73,7 @@ func collectGenerateColumn(lp base.LogicalPlan, exprToColumn ExprColumnMap) {
 	// detect the read_from_storage(tiflash) hints, since virtual column will
 	// block the mpp task spreading (only supporting MPP table scan), causing
 	// mpp plan fail the cost comparison with tikv index plan.
-	if ds.preferStoreType&h.PreferTiFlash != 0 {
+	if ds.preferStoreType&h.PreferTiFlash != 0 && !forceSubstitute {
 		return
 	}
 	for _, p := range ds.possibleAccessPaths {
@@ -78,7 +85,7 @@ func collectGenerateColumn(lp base.LogicalPlan, exprToColumn ExprColumnMap) {
 			if colInfo.IsGenerated() && !colInfo.GeneratedStored {
 				s := ds.schema.Columns
 				col := expression.ColInfo2Col(s, colInfo)
-				if col != nil && col.GetType().PartialEqual(col.VirtualExpr.GetType(), lp.SCtx().GetSessionVars().EnableUnsafeSubstitute) {
+				if col != nil && col.GetType().PartialEqual(col.VirtualExpr.GetType(), lp.SCtx().GetSessionVars().EnableUnsafeSubstitute || forceSubstitute) {
 					exprToColumn[col.VirtualExpr] = col
 				}
 			}
@@ -135,7 +142,7 @@ func substituteExpression(cond expression.Expression, lp base.LogicalPlan, exprT
 	var expr *expression.Expression
 	var tp types.EvalType
 	switch sf.FuncName.L {
-	case ast.EQ, ast.LT, ast.LE, ast.GT, ast.GE:
+	case ast.EQ, ast.NE, ast.NullEQ, ast.LT, ast.LE, ast.GT, ast.GE:
 		for candidateExpr, column := range exprToColumn {
 			collectChanged(tryToSubstituteExpr(&sf.GetArgs()[1], lp, candidateExpr, sf.GetArgs()[0].GetType().EvalType(), schema, column, opt))
 		}
@@ -159,6 +166,12 @@ func substituteExpression(cond expression.Expression, lp base.LogicalPlan, exprT
 				collectChanged(tryToSubstituteExpr(expr, lp, candidateExpr, tp, schema, column, opt))
 			}
 		}
+	case ast.IsNull:
+		expr = &sf.GetArgs()[0]
+		tp = sf.GetArgs()[0].GetType().EvalType()
+		for candidateExpr, column := range exprToColumn {
+			collectChanged(tryToSubstituteExpr(expr, lp, candidateExpr, tp, schema, column, opt))
+		}
 	case ast.Like:
 		expr = &sf.GetArgs()[0]
 		tp = sf.GetArgs()[1].GetType().EvalType()
@@ -181,6 +194,14 @@ func (gc *gcSubstituter) substitute(ctx context.Context, lp base.LogicalPlan, ex
 		for _, cond := range x.Conditions {
 			substituteExpression(cond, lp, exprToColumn, x.Schema(), opt)
 		}
+	case *LogicalJoin:
+		// The conditions in the ON clause are kept in the join since this rule runs before the predicate push down.
+		schema := expression.MergeSchema(x.children[0].Schema(), x.children[1].Schema())
+		for _, conds := range [][]expression.Expression{x.LeftConditions, x.RightConditions, x.OtherConditions} {
+			for _, cond := range conds {
+				substituteExpression(cond, lp, exprToColumn, schema, opt)
+			}
+		}
 	case *LogicalProjection:
 		for i := range x.Exprs {
 			tp = x.Exprs[i].GetType().EvalType()
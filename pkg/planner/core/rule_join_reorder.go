@@ -54,7 +54,7 @@ func extractJoinGroup(p base.LogicalPlan) *joinGroupResult {
 	}
 	// If the variable `tidb_opt_advanced_join_hint` is false and the join node has the join method hint, we will not split the current join node to join reorder process.
 	if !isJoin || (join.preferJoinType > uint(0) && !p.SCtx().GetSessionVars().EnableAdvancedJoinHint) || join.StraightJoin ||
-		(join.JoinType != InnerJoin && join.JoinType != LeftOuterJoin && join.JoinType != RightOuterJoin) ||
+		(join.JoinType != InnerJoin && join.JoinType != LeftOuterJoin && join.JoinType != RightOuterJoin && !join.isLeadingHintedSemiJoin()) ||
 		((join.JoinType == LeftOuterJoin || join.JoinType == RightOuterJoin) && join.EqualConditions == nil) {
 		if joinOrderHintInfo != nil {
 			// The leading hint can not work for some reasons. So clear it in the join node.
@@ -96,7 +96,8 @@ func extractJoinGroup(p base.LogicalPlan) *joinGroupResult {
 		lhsGroup, lhsEqualConds, lhsOtherConds, lhsJoinTypes, lhsJoinOrderHintInfo, lhsJoinMethodHintInfo, lhsHasOuterJoin := lhsJoinGroupResult.group, lhsJoinGroupResult.eqEdges, lhsJoinGroupResult.otherConds, lhsJoinGroupResult.joinTypes, lhsJoinGroupResult.joinOrderHintInfo, lhsJoinGroupResult.joinMethodHintInfo, lhsJoinGroupResult.hasOuterJoin
 		noExpand := false
 		// If the filters of the outer join is related with multiple leaves of the outer join side. We don't reorder it for now.
-		if join.JoinType == LeftOuterJoin {
+		// It's the same for the semi join, whose conditions can't be split to the different leaves of its outer side.
+		if join.JoinType == LeftOuterJoin || join.JoinType == SemiJoin || join.JoinType == AntiSemiJoin {
 			extractedCols := make([]*expression.Column, 0, 8)
 			extractedCols = expression.ExtractColumnsFromExpressions(extractedCols, join.OtherConditions, nil)
 			extractedCols = expression.ExtractColumnsFromExpressions(extractedCols, join.LeftConditions, nil)
@@ -135,7 +136,8 @@ func extractJoinGroup(p base.LogicalPlan) *joinGroupResult {
 	}
 
 	// You can see the comments in the upside part which we try to split the left child part. It's the same here.
-	if join.JoinType != LeftOuterJoin && !rightHasHint {
+	// The inner side of the semi join is never split, it's joined as a whole through the semi join edges.
+	if join.JoinType != LeftOuterJoin && join.JoinType != SemiJoin && join.JoinType != AntiSemiJoin && !rightHasHint {
 		rhsJoinGroupResult := extractJoinGroup(join.children[1])
 		rhsGroup, rhsEqualConds, rhsOtherConds, rhsJoinTypes, rhsJoinOrderHintInfo, rhsJoinMethodHintInfo, rhsHasOuterJoin := rhsJoinGroupResult.group, rhsJoinGroupResult.eqEdges, rhsJoinGroupResult.otherConds, rhsJoinGroupResult.joinTypes, rhsJoinGroupResult.joinOrderHintInfo, rhsJoinGroupResult.joinMethodHintInfo, rhsJoinGroupResult.hasOuterJoin
 		noExpand := false
@@ -183,7 +185,8 @@ func extractJoinGroup(p base.LogicalPlan) *joinGroupResult {
 	tmpOtherConds = append(tmpOtherConds, join.OtherConditions...)
 	tmpOtherConds = append(tmpOtherConds, join.LeftConditions...)
 	tmpOtherConds = append(tmpOtherConds, join.RightConditions...)
-	if join.JoinType == LeftOuterJoin || join.JoinType == RightOuterJoin || join.JoinType == LeftOuterSemiJoin || join.JoinType == AntiLeftOuterSemiJoin {
+	if join.JoinType == LeftOuterJoin || join.JoinType == RightOuterJoin || join.JoinType == LeftOuterSemiJoin || join.JoinType == AntiLeftOuterSemiJoin ||
+		join.JoinType == SemiJoin || join.JoinType == AntiSemiJoin {
 		for range join.EqualConditions {
 			abType := &joinTypeWithExtMsg{JoinType: join.JoinType}
 			// outer join's other condition should be bound with the connecting edge.
@@ -211,6 +214,15 @@ func extractJoinGroup(p base.LogicalPlan) *joinGroupResult {
 	}
 }
 
+// isLeadingHintedSemiJoin checks whether the join is a semi join which is unnested from the IN/EXISTS subquery and
+// specified by the leading hint. Such a semi join takes part in the join reorder, so the tables of the subquery can be
+// joined in the order of the leading hint. The inner side of the semi join is kept as a whole node of the join group.
+// The correlated subquery which is not decorrelated is still an Apply and can't be reordered.
+func (p *LogicalJoin) isLeadingHintedSemiJoin() bool {
+	return p.preferJoinOrder && (p.JoinType == SemiJoin || p.JoinType == AntiSemiJoin) &&
+		len(p.EqualConditions) > 0 && !p.isNAAJ()
+}
+
 type joinReOrderSolver struct {
 }
 
@@ -272,8 +284,8 @@ func (s *joinReOrderSolver) optimizeRecursive(ctx base.PlanContext, p base.Logic
 
 		leadingHintInfo, hasDiffLeadingHint := checkAndGenerateLeadingHint(joinOrderHintInfo)
 		if hasDiffLeadingHint {
-			ctx.GetSessionVars().StmtCtx.SetHintWarning(
-				"We can only use one leading hint at most, when multiple leading hints are used, all leading hints will be invalid")
+			ctx.GetSessionVars().StmtCtx.SetHintWarningFromError(h.ErrWarnConflictingHint.FastGen(
+				"We can only use one leading hint at most, when multiple leading hints are used, all leading hints will be invalid"))
 		}
 
 		if leadingHintInfo != nil && leadingHintInfo.LeadingJoinOrder != nil {
@@ -573,7 +585,8 @@ func (s *baseSingleGroupJoinOrderSolver) makeJoin(leftPlan, rightPlan base.Logic
 		return expression.ExprFromSchema(expr, mergedSchema)
 	})
 
-	if joinType.JoinType == LeftOuterJoin || joinType.JoinType == RightOuterJoin || joinType.JoinType == LeftOuterSemiJoin || joinType.JoinType == AntiLeftOuterSemiJoin {
+	if joinType.JoinType == LeftOuterJoin || joinType.JoinType == RightOuterJoin || joinType.JoinType == LeftOuterSemiJoin || joinType.JoinType == AntiLeftOuterSemiJoin ||
+		joinType.JoinType == SemiJoin || joinType.JoinType == AntiSemiJoin {
 		// the original outer join's other conditions has been bound to the outer join Edge,
 		// these remained other condition here shouldn't be appended to it because on-mismatch
 		// logic will produce more append-null rows which is banned in original semantic.
@@ -661,6 +674,10 @@ func (s *baseSingleGroupJoinOrderSolver) newJoinWithEdges(lChild, rChild base.Lo
 	newJoin.LeftConditions = leftConds
 	newJoin.RightConditions = rightConds
 	newJoin.JoinType = joinType
+	if joinType == SemiJoin || joinType == AntiSemiJoin {
+		// The semi join only outputs the columns of its outer side.
+		newJoin.SetSchema(buildLogicalJoinSchema(joinType, newJoin))
+	}
 	return newJoin
 }
 
@@ -509,7 +509,7 @@ func (s *partitionProcessor) processHashOrKeyPartition(ds *DataSource, pi *model
 		return nil, err
 	}
 
-	used, err := s.pruneHashOrKeyPartition(ds.SCtx(), ds.table, ds.partitionNames, ds.allConds, ds.TblCols, names)
+	used, err := s.pruneHashOrKeyPartition(ds.SCtx(), ds.table, ds.partitionNames, ds.partitionPruningConds(), ds.TblCols, names)
 	if err != nil {
 		return nil, err
 	}
@@ -827,7 +827,8 @@ func (s *partitionProcessor) pruneListPartition(ctx base.PlanContext, tbl table.
 
 func (s *partitionProcessor) prune(ds *DataSource, opt *optimizetrace.LogicalOptimizeOp) (base.LogicalPlan, error) {
 	pi := ds.tableInfo.GetPartitionInfo()
-	if pi == nil {
+	if pi == nil || ds.SampleInfo != nil {
+		// The sampled table reads the partitions by PhysicalTableSample.
 		return ds, nil
 	}
 	// PushDownNot here can convert condition 'not (a != 1)' to 'a = 1'. When we build range from ds.allConds, the condition
@@ -1039,7 +1040,7 @@ func (s *partitionProcessor) pruneRangePartition(ctx base.PlanContext, pi *model
 }
 
 func (s *partitionProcessor) processRangePartition(ds *DataSource, pi *model.PartitionInfo, opt *optimizetrace.LogicalOptimizeOp) (base.LogicalPlan, error) {
-	used, err := s.pruneRangePartition(ds.SCtx(), pi, ds.table.(table.PartitionedTable), ds.allConds, ds.TblCols, ds.names)
+	used, err := s.pruneRangePartition(ds.SCtx(), pi, ds.table.(table.PartitionedTable), ds.partitionPruningConds(), ds.TblCols, ds.names)
 	if err != nil {
 		return nil, err
 	}
@@ -1047,7 +1048,7 @@ func (s *partitionProcessor) processRangePartition(ds *DataSource, pi *model.Par
 }
 
 func (s *partitionProcessor) processListPartition(ds *DataSource, pi *model.PartitionInfo, opt *optimizetrace.LogicalOptimizeOp) (base.LogicalPlan, error) {
-	used, err := s.pruneListPartition(ds.SCtx(), ds.table, ds.partitionNames, ds.allConds, ds.TblCols)
+	used, err := s.pruneListPartition(ds.SCtx(), ds.table, ds.partitionNames, ds.partitionPruningConds(), ds.TblCols)
 	if err != nil {
 		return nil, err
 	}
@@ -1656,7 +1657,7 @@ func pruneUseBinarySearch(lessThan lessThanDataInt, data dataForPrune) (start in
 func (*partitionProcessor) resolveAccessPaths(ds *DataSource) error {
 	possiblePaths, err := getPossibleAccessPaths(
 		ds.SCtx(), &h.PlanHints{IndexMergeHintList: ds.indexMergeHints, IndexHintList: ds.IndexHints},
-		ds.astIndexHints, ds.table, ds.DBName, ds.tableInfo.Name, ds.isForUpdateRead, true)
+		ds.astIndexHints, ds.table, ds.DBName, ds.tableInfo.Name, ds.partitionName(), ds.isForUpdateRead, true)
 	if err != nil {
 		return err
 	}
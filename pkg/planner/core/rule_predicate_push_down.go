@@ -22,6 +22,7 @@ import (
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
 	"github.com/pingcap/tidb/pkg/planner/util"
@@ -141,16 +142,95 @@ func (ds *DataSource) PredicatePushDown(predicates []expression.Expression, opt
 	// TODO: remove it to the place building logical plan
 	predicates = ds.AddPrefix4ShardIndexes(ds.SCtx(), predicates)
 	ds.allConds = predicates
+	if ds.contradictsCheckConstraints(predicates) {
+		dual := LogicalTableDual{}.Init(ds.SCtx(), ds.QueryBlockOffset())
+		dual.SetSchema(ds.Schema())
+		appendTableDualTraceStep(ds, dual, predicates, opt)
+		return nil, dual
+	}
 	ds.pushedDownConds, predicates = expression.PushDownExprs(GetPushDownCtx(ds.SCtx()), predicates, kv.UnSpecified)
 	appendDataSourcePredicatePushDownTraceStep(ds, opt)
 	return predicates, ds
 }
 
+// buildCheckConstraintConds builds the conditions from the enforced and validated check constraints of the table,
+// the rows of a not validated constraint may violate it, e.g. the rows written before it's enforced. Only the
+// constraints which refer to a single column and can be fully converted to the ranges of the column are used, and
+// `OR col IS NULL` is appended for a nullable column since a check constraint is satisfied if it evaluates to NULL.
+func buildCheckConstraintConds(ds *DataSource) []expression.Expression {
+	var conds []expression.Expression
+	exprCtx := ds.SCtx().GetExprCtx()
+	for _, cons := range ds.tableInfo.Constraints {
+		if !cons.Enforced || !cons.Validated || cons.State != model.StatePublic || len(cons.ConstraintCols) != 1 {
+			continue
+		}
+		cond, err := expression.ParseSimpleExpr(exprCtx, cons.ExprString, expression.WithInputSchemaAndNames(ds.schema, ds.names, ds.tableInfo))
+		if err != nil {
+			continue
+		}
+		cols := expression.ExtractColumns(cond)
+		if len(cols) == 0 || !expression.IsImmutableFunc(cond) {
+			continue
+		}
+		col := cols[0]
+		if !mysql.HasNotNullFlag(col.RetType.GetFlag()) {
+			isNull := expression.NewFunctionInternal(exprCtx, ast.IsNull, types.NewFieldType(mysql.TypeTiny), col)
+			cond = expression.NewFunctionInternal(exprCtx, ast.LogicOr, types.NewFieldType(mysql.TypeTiny), cond, isNull)
+		}
+		if _, filterConds := ranger.DetachCondsForColumn(ds.SCtx().GetRangerCtx(), []expression.Expression{cond}, col); len(filterConds) > 0 {
+			continue
+		}
+		conds = append(conds, cond)
+	}
+	return conds
+}
+
+// contradictsCheckConstraints checks whether the predicates contradict the check constraints of the table, it means
+// no row of the table can satisfy the predicates.
+func (ds *DataSource) contradictsCheckConstraints(predicates []expression.Expression) bool {
+	if len(ds.checkConstraintConds) == 0 || len(predicates) == 0 ||
+		expression.MaybeOverOptimized4PlanCache(ds.SCtx().GetExprCtx(), predicates) {
+		return false
+	}
+	rctx := ds.SCtx().GetRangerCtx()
+	for _, cond := range ds.checkConstraintConds {
+		col := expression.ExtractColumns(cond)[0]
+		accessConds, _ := ranger.DetachCondsForColumn(rctx, predicates, col)
+		if len(accessConds) == 0 {
+			continue
+		}
+		ranges, _, _, err := ranger.BuildColumnRange(append(accessConds, cond), rctx, col.RetType, types.UnspecifiedLength, 0)
+		if err == nil && len(ranges) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// partitionPruningConds returns the conditions used to prune the partitions, the check constraint conditions are
+// included since they are satisfied by all the rows.
+func (ds *DataSource) partitionPruningConds() []expression.Expression {
+	if len(ds.checkConstraintConds) == 0 {
+		return ds.allConds
+	}
+	conds := make([]expression.Expression, 0, len(ds.allConds)+len(ds.checkConstraintConds))
+	conds = append(conds, ds.allConds...)
+	return append(conds, ds.checkConstraintConds...)
+}
+
 // PredicatePushDown implements base.LogicalPlan PredicatePushDown interface.
 func (p *LogicalTableDual) PredicatePushDown(predicates []expression.Expression, _ *optimizetrace.LogicalOptimizeOp) ([]expression.Expression, base.LogicalPlan) {
 	return predicates, p
 }
 
+// PredicatePushDown implements base.LogicalPlan PredicatePushDown interface.
+// The filters are kept in JSON_TABLE and evaluated when the rows are produced, so the rows filtered out are never materialized.
+func (p *LogicalJSONTable) PredicatePushDown(predicates []expression.Expression, _ *optimizetrace.LogicalOptimizeOp) ([]expression.Expression, base.LogicalPlan) {
+	canBePushDown, canNotBePushDown := splitSetGetVarFunc(predicates)
+	p.PushedDownConds = append(p.PushedDownConds, canBePushDown...)
+	return canNotBePushDown, p
+}
+
 // PredicatePushDown implements base.LogicalPlan PredicatePushDown interface.
 func (p *LogicalJoin) PredicatePushDown(predicates []expression.Expression, opt *optimizetrace.LogicalOptimizeOp) (ret []expression.Expression, retPlan base.LogicalPlan) {
 	simplifyOuterJoin(p, predicates)
@@ -39,6 +39,8 @@ type ScalarSubqueryEvalCtx struct {
 	is             infoschema.InfoSchema
 	evalErr        error
 	evaled         bool
+	// maxExecutionTime is the max_execution_time hint in the subquery block.
+	maxExecutionTime uint64
 
 	outputColIDs []int64
 	colsData     []types.Datum
@@ -62,7 +64,7 @@ func (ssctx *ScalarSubqueryEvalCtx) selfEval() error {
 		return ssctx.evalErr
 	}
 	ssctx.evaled = true
-	row, err := EvalSubqueryFirstRow(ssctx.ctx, ssctx.scalarSubQuery, ssctx.is, ssctx.SCtx())
+	row, err := evalSubqueryFirstRowWithin(ssctx.ctx, ssctx.scalarSubQuery, ssctx.is, ssctx.SCtx(), ssctx.maxExecutionTime)
 	if err != nil {
 		ssctx.evalErr = err
 		return err
@@ -67,6 +67,29 @@ func (p *LogicalTableDual) DeriveStats(_ []*property.StatsInfo, selfSchema *expr
 	return p.StatsInfo(), nil
 }
 
+// jsonTableDefaultRowCount is the estimated row count of JSON_TABLE, since there are no statistics of the JSON document.
+const jsonTableDefaultRowCount = 10
+
+// DeriveStats implement LogicalPlan DeriveStats interface.
+func (p *LogicalJSONTable) DeriveStats(_ []*property.StatsInfo, selfSchema *expression.Schema, _ []*expression.Schema, _ [][]*expression.Column) (*property.StatsInfo, error) {
+	if p.StatsInfo() != nil {
+		return p.StatsInfo(), nil
+	}
+	rowCount := float64(jsonTableDefaultRowCount)
+	if len(p.PushedDownConds) > 0 {
+		rowCount *= cost.SelectionFactor
+	}
+	profile := &property.StatsInfo{
+		RowCount: rowCount,
+		ColNDVs:  make(map[int64]float64, selfSchema.Len()),
+	}
+	for _, col := range selfSchema.Columns {
+		profile.ColNDVs[col.UniqueID] = rowCount
+	}
+	p.SetStats(profile)
+	return p.StatsInfo(), nil
+}
+
 // DeriveStats implement LogicalPlan DeriveStats interface.
 func (p *LogicalMemTable) DeriveStats(_ []*property.StatsInfo, selfSchema *expression.Schema, _ []*expression.Schema, _ [][]*expression.Column) (*property.StatsInfo, error) {
 	if p.StatsInfo() != nil {
@@ -272,6 +295,16 @@ func (ds *DataSource) initStats(colGroups [][]*expression.Column) {
 	for _, col := range ds.schema.Columns {
 		tableStats.ColNDVs[col.UniqueID] = cardinality.EstimateColumnNDV(ds.statisticTable, col.ID)
 	}
+	// The NDVs of the temporary table are sampled when the rows are written in the session.
+	if ds.tableInfo.TempTableType != model.TempTableNone {
+		if tempStats := getTempTableStats(ds.SCtx(), ds.tableInfo); tempStats != nil {
+			for _, col := range ds.schema.Columns {
+				if ndv, ok := tempStats.ColumnNDV(col.ID); ok {
+					tableStats.ColNDVs[col.UniqueID] = ndv
+				}
+			}
+		}
+	}
 	ds.tableStats = tableStats
 	ds.tableStats.GroupNDVs = ds.getGroupNDVs(colGroups)
 	ds.TblColHists = ds.statisticTable.ID2UniqueID(ds.TblCols)
@@ -483,6 +516,7 @@ func (ds *DataSource) DeriveStats(_ []*property.StatsInfo, _ *expression.Schema,
 			return nil, err
 		}
 	}
+	ds.pruneAccessPathsByScanTypeHint()
 	// TODO: Can we move ds.deriveStatsByFilter after pruning by heuristics? In this way some computation can be avoided
 	// when ds.possibleAccessPaths are pruned.
 	ds.SetStats(ds.deriveStatsByFilter(ds.pushedDownConds, ds.possibleAccessPaths))
@@ -490,10 +524,15 @@ func (ds *DataSource) DeriveStats(_ []*property.StatsInfo, _ *expression.Schema,
 	if err != nil {
 		return nil, err
 	}
+	ds.pruneFullRangePathsByHint()
 
 	if err := ds.generateIndexMergePath(); err != nil {
 		return nil, err
 	}
+	ds.adjustByObservedRowCount()
+	if rate, ok := ds.SampleInfo.SampleRate(); ok {
+		ds.SetStats(ds.StatsInfo().Scale(rate))
+	}
 
 	if ds.SCtx().GetSessionVars().StmtCtx.EnableOptimizerDebugTrace {
 		debugTraceAccessPaths(ds.SCtx(), ds.possibleAccessPaths)
@@ -503,6 +542,89 @@ func (ds *DataSource) DeriveStats(_ []*property.StatsInfo, _ *expression.Schema,
 	return ds.StatsInfo(), nil
 }
 
+// pruneAccessPathsByScanTypeHint keeps only the table paths for the full_scan hint and only the covering index
+// paths for the index_only hint. The hint is ignored with a warning if no path satisfies it.
+func (ds *DataSource) pruneAccessPathsByScanTypeHint() {
+	if ds.preferScanType&(h.PreferFullScan|h.PreferIndexOnly) == 0 {
+		return
+	}
+	hintName := h.HintFullScan
+	if ds.preferScanType&h.PreferIndexOnly != 0 {
+		hintName = h.HintIndexOnly
+	}
+	paths := make([]*util.AccessPath, 0, len(ds.possibleAccessPaths))
+	for _, path := range ds.possibleAccessPaths {
+		if path.IsTablePath() {
+			if hintName == h.HintFullScan {
+				paths = append(paths, path)
+			}
+		} else if hintName == h.HintIndexOnly && ds.isSingleScan(path.FullIdxCols, path.FullIdxColLens) {
+			paths = append(paths, path)
+		}
+	}
+	if len(paths) == 0 {
+		ds.setHintWarning(hintName, plannererrors.ErrInternal.FastGen(
+			"No available path for table %s.%s satisfies the hint /*+ %s */, the hint is ignored",
+			ds.DBName.O, ds.tableInfo.Name.O, hintName))
+		return
+	}
+	ds.possibleAccessPaths = paths
+}
+
+// pruneFullRangePathsByHint removes the paths which scan the full range of the table or index for the
+// no_full_scan hint. The hint is ignored with a warning if all the paths scan the full range.
+func (ds *DataSource) pruneFullRangePathsByHint() {
+	if ds.preferScanType&h.PreferNoFullScan == 0 {
+		return
+	}
+	var unsignedIntHandle bool
+	if ds.tableInfo.PKIsHandle {
+		if pkColInfo := ds.tableInfo.GetPkColInfo(); pkColInfo != nil {
+			unsignedIntHandle = mysql.HasUnsignedFlag(pkColInfo.GetFlag())
+		}
+	}
+	paths := make([]*util.AccessPath, 0, len(ds.possibleAccessPaths))
+	for _, path := range ds.possibleAccessPaths {
+		if ranger.HasFullRange(path.Ranges, path.IsIntHandlePath && unsignedIntHandle) {
+			continue
+		}
+		paths = append(paths, path)
+	}
+	if len(paths) == 0 {
+		ds.setHintWarning(h.HintNoFullScan, plannererrors.ErrInternal.FastGen(
+			"No available path for table %s.%s satisfies the hint /*+ %s */, the hint is ignored",
+			ds.DBName.O, ds.tableInfo.Name.O, h.HintNoFullScan))
+		return
+	}
+	ds.possibleAccessPaths = paths
+}
+
+// adjustByObservedRowCount scales the estimated row counts of the DataSource and its access paths up to the row
+// count observed during the execution, which is recorded before the adaptive re-optimization of the statement.
+func (ds *DataSource) adjustByObservedRowCount() {
+	sc := ds.SCtx().GetSessionVars().StmtCtx
+	asName := ds.TableInfo().Name.L
+	if ds.TableAsName != nil && ds.TableAsName.L != "" {
+		asName = ds.TableAsName.L
+	}
+	observedRows, ok := sc.Reoptimize.ObservedRowCount(stmtctx.ReoptimizeKey(ds.physicalTableID, asName))
+	if !ok || observedRows <= ds.StatsInfo().RowCount {
+		return
+	}
+	stats := ds.StatsInfo().Scale(observedRows / math.Max(ds.StatsInfo().RowCount, 1))
+	stats.RowCount = observedRows
+	ds.SetStats(stats)
+	// The observed row count is the row count after all the filters, so it's also a lower bound of
+	// the row counts of the access paths.
+	for _, path := range ds.possibleAccessPaths {
+		path.CountAfterAccess = math.Max(path.CountAfterAccess, observedRows)
+		if path.CountAfterIndex > 0 {
+			path.CountAfterIndex = math.Max(path.CountAfterIndex, observedRows)
+		}
+	}
+	sc.SetSkipPlanCache("the plan is re-optimized with the observed row count")
+}
+
 func getMinSelectivityFromPaths(paths []*util.AccessPath, totalRowCount float64) float64 {
 	minSelectivity := 1.0
 	if totalRowCount <= 0 {
@@ -579,7 +701,15 @@ func (p *LogicalSelection) DeriveStats(childStats []*property.StatsInfo, _ *expr
 	if p.StatsInfo() != nil {
 		return p.StatsInfo(), nil
 	}
-	p.SetStats(childStats[0].Scale(cost.SelectionFactor))
+	factor := cost.SelectionFactor
+	if p.sampleRate > 0 {
+		// The sampling condition keeps the rows at the sample rate, while the other conditions are estimated as usual.
+		factor = p.sampleRate
+		if len(p.Conditions) > 1 {
+			factor *= cost.SelectionFactor
+		}
+	}
+	p.SetStats(childStats[0].Scale(factor))
 	p.StatsInfo().GroupNDVs = nil
 	return p.StatsInfo(), nil
 }
@@ -809,6 +939,11 @@ func (p *LogicalJoin) DeriveStats(childStats []*property.StatsInfo, selfSchema *
 		leftJoinKeys, rightJoinKeys,
 		childSchema[0], childSchema[1],
 		nil, nil)
+	if p.SCtx().GetSessionVars().EnableFKCardinalityEstimation {
+		if fkCount, ok := p.estimateJoinRowCountByFK(leftProfile, rightProfile, leftJoinKeys, rightJoinKeys); ok {
+			p.equalCondOutCnt = math.Min(p.equalCondOutCnt, fkCount)
+		}
+	}
 	if p.JoinType == SemiJoin || p.JoinType == AntiSemiJoin {
 		p.SetStats(&property.StatsInfo{
 			RowCount: leftProfile.RowCount * cost.SelectionFactor,
@@ -852,6 +987,128 @@ func (p *LogicalJoin) DeriveStats(childStats []*property.StatsInfo, selfSchema *
 	return p.StatsInfo(), nil
 }
 
+// estimateJoinRowCountByFK bounds the row count of the equal join by the declared foreign key between the two sides.
+// If the join keys of one side are the columns of a foreign key and the join keys of the other side are the referred
+// unique columns of the parent table, every child row matches at most one parent row, so the join outputs about
+// N(child) * N(parent side) / N(parent table) rows.
+func (p *LogicalJoin) estimateJoinRowCountByFK(leftProfile, rightProfile *property.StatsInfo, leftJoinKeys, rightJoinKeys []*expression.Column) (float64, bool) {
+	if len(leftJoinKeys) == 0 {
+		return 0, false
+	}
+	if _, _, _, hasNullEQ := p.GetJoinKeys(); hasNullEQ {
+		return 0, false
+	}
+	leftDS, leftCols := findDataSourceOfJoinKeys(p.Children()[0], leftJoinKeys, true)
+	rightDS, rightCols := findDataSourceOfJoinKeys(p.Children()[1], rightJoinKeys, true)
+	if leftDS == nil || rightDS == nil {
+		return 0, false
+	}
+	// Try the left side as the child table and the right side as the parent table first.
+	if parentDS, _ := findDataSourceOfJoinKeys(p.Children()[1], rightJoinKeys, false); parentDS != nil &&
+		isJoinOnForeignKey(leftDS, leftCols, parentDS, rightCols) && parentDS.tableStats.RowCount > 0 {
+		return leftProfile.RowCount * math.Min(1, rightProfile.RowCount/parentDS.tableStats.RowCount), true
+	}
+	if parentDS, _ := findDataSourceOfJoinKeys(p.Children()[0], leftJoinKeys, false); parentDS != nil &&
+		isJoinOnForeignKey(rightDS, rightCols, parentDS, leftCols) && parentDS.tableStats.RowCount > 0 {
+		return rightProfile.RowCount * math.Min(1, leftProfile.RowCount/parentDS.tableStats.RowCount), true
+	}
+	return 0, false
+}
+
+// findDataSourceOfJoinKeys finds the DataSource which outputs all the join keys and returns the column infos of the
+// keys. Only Selections are passed through, and Joins are also passed through when throughJoin is true, since the
+// rows of the parent side must keep unique on the referred columns.
+func findDataSourceOfJoinKeys(p base.LogicalPlan, keys []*expression.Column, throughJoin bool) (*DataSource, []*model.ColumnInfo) {
+	switch x := p.(type) {
+	case *DataSource:
+		cols := make([]*model.ColumnInfo, 0, len(keys))
+		for _, key := range keys {
+			idx := x.schema.ColumnIndex(key)
+			if idx < 0 || idx >= len(x.Columns) {
+				return nil, nil
+			}
+			cols = append(cols, x.Columns[idx])
+		}
+		return x, cols
+	case *LogicalSelection:
+		return findDataSourceOfJoinKeys(x.Children()[0], keys, throughJoin)
+	case *LogicalJoin:
+		if !throughJoin {
+			return nil, nil
+		}
+		for _, child := range x.Children() {
+			if child.Schema().ColumnsIndices(keys) != nil {
+				return findDataSourceOfJoinKeys(child, keys, throughJoin)
+			}
+		}
+	}
+	return nil, nil
+}
+
+// isJoinOnForeignKey checks whether the child columns and the parent columns are joined pairwise on a foreign key of
+// the child table, and the referred columns cover a unique key of the parent table.
+func isJoinOnForeignKey(childDS *DataSource, childCols []*model.ColumnInfo, parentDS *DataSource, parentCols []*model.ColumnInfo) bool {
+	for _, fk := range childDS.tableInfo.ForeignKeys {
+		if fk.Version < 1 || fk.RefTable.L != parentDS.tableInfo.Name.L {
+			continue
+		}
+		refSchema := fk.RefSchema
+		if refSchema.L == "" {
+			refSchema = childDS.DBName
+		}
+		if refSchema.L != parentDS.DBName.L {
+			continue
+		}
+		matched := true
+		for i := range fk.Cols {
+			found := false
+			for j := range childCols {
+				if childCols[j].Name.L == fk.Cols[i].L && parentCols[j].Name.L == fk.RefCols[i].L {
+					found = true
+					break
+				}
+			}
+			if !found {
+				matched = false
+				break
+			}
+		}
+		if matched && isUniqueOnColumns(parentDS.tableInfo, fk.RefCols) {
+			return true
+		}
+	}
+	return false
+}
+
+// isUniqueOnColumns checks whether the columns cover the primary key or a unique index of the table.
+func isUniqueOnColumns(tblInfo *model.TableInfo, cols []model.CIStr) bool {
+	if pkCol := tblInfo.GetPkColInfo(); tblInfo.PKIsHandle && pkCol != nil {
+		for _, col := range cols {
+			if col.L == pkCol.Name.L {
+				return true
+			}
+		}
+	}
+	for _, idx := range tblInfo.Indices {
+		if !idx.Unique || idx.State != model.StatePublic {
+			continue
+		}
+		covered := true
+		for _, idxCol := range idx.Columns {
+			if idxCol.Length != types.UnspecifiedLength || !slices.ContainsFunc(cols, func(col model.CIStr) bool {
+				return col.L == idxCol.Name.L
+			}) {
+				covered = false
+				break
+			}
+		}
+		if covered {
+			return true
+		}
+	}
+	return false
+}
+
 // ExtractColGroups implements LogicalPlan ExtractColGroups interface.
 func (p *LogicalJoin) ExtractColGroups(colGroups [][]*expression.Column) [][]*expression.Column {
 	leftJoinKeys, rightJoinKeys, _, _ := p.GetJoinKeys()
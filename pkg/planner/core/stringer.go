@@ -234,6 +234,10 @@ func toString(in base.Plan, strs []string, idxs []int) ([]string, []int) {
 		str = fmt.Sprintf("TopN(%v,%d,%d)", x.ByItems, x.Offset, x.Count)
 	case *LogicalTableDual, *PhysicalTableDual:
 		str = "Dual"
+	case *LogicalJSONTable, *PhysicalJSONTable:
+		str = "JSONTable"
+	case *PhysicalLooseIndexScan:
+		str = fmt.Sprintf("LooseIndexScan(%s.%s)%v", x.IndexScan.Table.Name.L, x.IndexScan.Index.Name.L, x.IndexScan.Ranges)
 	case *PhysicalHashAgg:
 		str = "HashAgg"
 	case *PhysicalStreamAgg:
@@ -1565,9 +1565,19 @@ func (p *basePhysicalAgg) scale3StageForDistinctAgg() (bool, expression.Grouping
 
 // canUse3Stage4MultiDistinctAgg returns true if this agg can use 3 stage for multi distinct aggregation
 func (p *basePhysicalAgg) canUse3Stage4MultiDistinctAgg() (can bool, gss expression.GroupingSets) {
-	if !p.SCtx().GetSessionVars().Enable3StageDistinctAgg || !p.SCtx().GetSessionVars().Enable3StageMultiDistinctAgg || len(p.GroupByItems) > 0 {
+	if !p.SCtx().GetSessionVars().Enable3StageDistinctAgg || !p.SCtx().GetSessionVars().Enable3StageMultiDistinctAgg {
 		return false, nil
 	}
+	if p.noExpand {
+		// the Expand replicates every row for each distinct agg, which may explode the intermediate rows.
+		return false, nil
+	}
+	for _, item := range p.GroupByItems {
+		// the group by items are kept as they are by Expand, so they should be simple columns.
+		if _, ok := item.(*expression.Column); !ok {
+			return false, nil
+		}
+	}
 	defer func() {
 		// some clean work.
 		if !can {
@@ -1613,6 +1623,13 @@ func (p *basePhysicalAgg) canUse3Stage4MultiDistinctAgg() (can bool, gss express
 		// todo: column clone haven't implemented.
 		return false, nil
 	}
+	allSetsColIDs := groupingSets.AllSetsColIDs()
+	for _, item := range p.GroupByItems {
+		// the group by column would be filled with null in other grouping sets if it's also a distinct arg.
+		if allSetsColIDs.Has(int(item.(*expression.Column).UniqueID)) {
+			return false, nil
+		}
+	}
 	if len(groupingSets) > 1 {
 		// fill the grouping ID for normal agg.
 		for _, fun := range p.AggFuncs {
@@ -1934,6 +1951,18 @@ func (p *PhysicalHashAgg) scaleStats4GroupingSets(groupingSets expression.Groupi
 //	             +- HashAgg count(c) #4, group by a,b,groupingID                -> partial agg
 //	                 +- Expand {<a>}, {<b>}                                     -> expand
 //	                     +- TableScan foo
+//
+//	select count(distinct a), count(distinct b), count(c) from foo group by d
+//
+// will generate plan:
+//
+//	HashAgg sum(#1), sum(#2), sum(#3), group by d                                        -> final agg
+//	 +- Exchange HashPartition by d
+//	     +- HashAgg count(distinct a) #1, count(distinct b) #2, sum(#4) #3, group by d    -> middle agg
+//	         +- Exchange HashPartition by d,a,b,groupingID
+//	             +- HashAgg count(c) #4, group by d,a,b,groupingID                        -> partial agg
+//	                 +- Expand {<a>}, {<b>}                                               -> expand
+//	                     +- TableScan foo
 func (p *PhysicalHashAgg) adjust3StagePhaseAgg(partialAgg, finalAgg base.PhysicalPlan, canUse3StageAgg bool,
 	groupingSets expression.GroupingSets, mpp *MppTask) (final, mid, part, proj4Part base.PhysicalPlan, _ error) {
 	if !(partialAgg != nil && canUse3StageAgg) {
@@ -2086,6 +2115,10 @@ func (p *PhysicalHashAgg) adjust3StagePhaseAgg(partialAgg, finalAgg base.Physica
 		}
 		middleSchema.Append(col)
 	}
+	// the middle agg outputs the group by columns for the final agg, just like the partial agg does.
+	for _, item := range middleHashAgg.GroupByItems {
+		middleSchema.Append(item.(*expression.Column))
+	}
 	middleHashAgg.schema = middleSchema
 
 	// step3: adjust final agg
@@ -2118,6 +2151,28 @@ func (p *PhysicalHashAgg) adjust3StagePhaseAgg(partialAgg, finalAgg base.Physica
 	return finalHashAgg, middleHashAgg, partialHashAgg, proj4Partial, nil
 }
 
+// attachMiddleAgg2Task shuffles the output of the partial agg by its group by items, and attaches the middle agg of
+// the 3 stage aggregation to the new mpp task.
+func attachMiddleAgg2Task(partial, middle base.PhysicalPlan, mpp *MppTask) *MppTask {
+	items := partial.(*PhysicalHashAgg).GroupByItems
+	partitionCols := make([]*property.MPPPartitionColumn, 0, len(items))
+	for _, expr := range items {
+		col, ok := expr.(*expression.Column)
+		if !ok {
+			continue
+		}
+		partitionCols = append(partitionCols, &property.MPPPartitionColumn{
+			Col:       col,
+			CollateID: property.GetCollateIDByNameForPartition(col.GetType().GetCollate()),
+		})
+	}
+
+	exProp := &property.PhysicalProperty{TaskTp: property.MppTaskType, ExpectedCnt: math.MaxFloat64, MPPPartitionTp: property.HashType, MPPPartitionCols: partitionCols}
+	newMpp := mpp.enforceExchanger(exProp)
+	attachPlan2Task(middle, newMpp)
+	return newMpp
+}
+
 func (p *PhysicalHashAgg) attach2TaskForMpp(tasks ...base.Task) base.Task {
 	t := tasks[0].Copy()
 	mpp, ok := t.(*MppTask)
@@ -2136,12 +2191,24 @@ func (p *PhysicalHashAgg) attach2TaskForMpp(tasks ...base.Task) base.Task {
 		return mpp
 	case Mpp2Phase:
 		// TODO: when partition property is matched by sub-plan, we actually needn't do extra an exchange and final agg.
+		// we have to check it before the content of p has been modified
+		canUse3StageAgg, groupingSets := p.scale3StageForDistinctAgg()
 		proj := p.convertAvgForMPP()
 		partialAgg, finalAgg := p.newPartialAggregate(kv.TiFlash, true)
 		if partialAgg == nil {
 			return invalidTask
 		}
+		finalAgg, middleAgg, partialAgg, proj4Partial, err := p.adjust3StagePhaseAgg(partialAgg, finalAgg, canUse3StageAgg, groupingSets, mpp)
+		if err != nil {
+			return invalidTask
+		}
+		if proj4Partial != nil {
+			attachPlan2Task(proj4Partial, mpp)
+		}
 		attachPlan2Task(partialAgg, mpp)
+		if middleAgg != nil {
+			mpp = attachMiddleAgg2Task(partialAgg, middleAgg, mpp)
+		}
 		partitionCols := p.MppPartitionCols
 		if len(partitionCols) == 0 {
 			items := finalAgg.(*PhysicalHashAgg).GroupByItems
@@ -2207,23 +2274,7 @@ func (p *PhysicalHashAgg) attach2TaskForMpp(tasks ...base.Task) base.Task {
 		}
 
 		if middle != nil && canUse3StageAgg {
-			items := partial.(*PhysicalHashAgg).GroupByItems
-			partitionCols := make([]*property.MPPPartitionColumn, 0, len(items))
-			for _, expr := range items {
-				col, ok := expr.(*expression.Column)
-				if !ok {
-					continue
-				}
-				partitionCols = append(partitionCols, &property.MPPPartitionColumn{
-					Col:       col,
-					CollateID: property.GetCollateIDByNameForPartition(col.GetType().GetCollate()),
-				})
-			}
-
-			exProp := &property.PhysicalProperty{TaskTp: property.MppTaskType, ExpectedCnt: math.MaxFloat64, MPPPartitionTp: property.HashType, MPPPartitionCols: partitionCols}
-			newMpp := mpp.enforceExchanger(exProp)
-			attachPlan2Task(middle, newMpp)
-			mpp = newMpp
+			mpp = attachMiddleAgg2Task(partial, middle, mpp)
 		}
 
 		// prop here still be the first generated single-partition requirement.
@@ -18,6 +18,7 @@ import (
 	"context"
 	"math"
 	"math/rand"
+	"slices"
 	"sync"
 	"time"
 
@@ -29,11 +30,13 @@ import (
 	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/metrics"
 	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/planner/cascades"
 	pctx "github.com/pingcap/tidb/pkg/planner/context"
 	"github.com/pingcap/tidb/pkg/planner/core"
 	"github.com/pingcap/tidb/pkg/planner/core/base"
 	"github.com/pingcap/tidb/pkg/planner/util/debugtrace"
+	"github.com/pingcap/tidb/pkg/planner/util/fixcontrol"
 	"github.com/pingcap/tidb/pkg/privilege"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
@@ -67,6 +70,7 @@ func getPlanFromNonPreparedPlanCache(ctx context.Context, sctx sessionctx.Contex
 		stmtCtx.InPreparedPlanBuilding || // already in cached plan rebuilding phase
 		stmtCtx.EnableOptimizerCETrace || stmtCtx.EnableOptimizeTrace || // in trace
 		stmtCtx.InRestrictedSQL || // is internal SQL
+		stmtCtx.Reoptimize.Reoptimized() || // re-optimized with the observed row counts
 		isExplain || // explain external
 		!sctx.GetSessionVars().DisableTxnAutoRetry || // txn-auto-retry
 		sctx.GetSessionVars().InMultiStmts || // in multi-stmt
@@ -165,8 +169,14 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 	}
 
 	tableHints := hint.ExtractTableHintsFromStmtNode(node, sessVars.StmtCtx)
-	originStmtHints, _, warns := hint.ParseStmtHints(tableHints, setVarHintChecker, byte(kv.ReplicaReadFollower))
+	originStmtHints, _, warns := hint.ParseStmtHints(tableHints, setVarHintChecker, byte(kv.ReplicaReadFollower), byte(kv.ReplicaReadHedged))
 	sessVars.StmtCtx.StmtHints = originStmtHints
+	if len(originStmtHints.FixControl) > 0 {
+		sessVars.StmtCtx.SetForcePlanCache(fixcontrol.GetBoolWithDefault(sessVars.GetOptimizerFixControlMap(), fixcontrol.Fix49736, false))
+	}
+	if len(tableHints) > 0 {
+		sessVars.StmtCtx.OriginalHints = hint.RestoreOptimizerHints(tableHints)
+	}
 	for _, warn := range warns {
 		sessVars.StmtCtx.AppendWarning(warn)
 	}
@@ -194,11 +204,15 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 		if err != nil {
 			sessVars.StmtCtx.AppendWarning(err)
 		}
-		sessVars.StmtCtx.AddSetVarHintRestore(name, oldV)
+		// The variable may be overridden by `EXPLAIN ... WITH (...)` already, keep its original value.
+		if _, ok := sessVars.StmtCtx.SetVarHintRestore[name]; !ok {
+			sessVars.StmtCtx.AddSetVarHintRestore(name, oldV)
+		}
 	}
 	if len(sessVars.StmtCtx.StmtHints.SetVars) > 0 {
 		sessVars.StmtCtx.SetSkipPlanCache("SET_VAR is used in the SQL")
 	}
+	setResourceGroupOptimizerVars(sessVars, is)
 
 	if _, isolationReadContainTiKV := sessVars.IsolationReadEngines[kv.TiKV]; isolationReadContainTiKV {
 		var fp base.Plan
@@ -225,6 +239,11 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 	}
 
 	useBinding := enableUseBinding && isStmtNode && match
+	// The hints set for the digest are used only if there is no binding for the statement.
+	var digestHints []*ast.TableOptimizerHint
+	if isStmtNode && !useBinding {
+		digestHints, _ = bindinfo.MatchDigestHints(sctx)
+	}
 	if sessVars.StmtCtx.EnableOptimizerDebugTrace {
 		failpoint.Inject("SetBindingTimeToZero", func(val failpoint.Value) {
 			if val.(bool) && bindings != nil {
@@ -253,7 +272,8 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 	// try to get Plan from the NonPrepared Plan Cache
 	if sessVars.EnableNonPreparedPlanCache &&
 		isStmtNode &&
-		!useBinding { // TODO: support binding
+		!useBinding && // TODO: support binding
+		len(digestHints) == 0 {
 		cachedPlan, names, ok, err := getPlanFromNonPreparedPlanCache(ctx, sctx, stmtNode, is)
 		if err != nil {
 			return nil, nil, err
@@ -272,7 +292,9 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 	if useBinding {
 		minCost := math.MaxFloat64
 		var bindStmtHints hint.StmtHints
+		var bindHintUsages []hint.HintUsage
 		originHints := hint.CollectHint(stmtNode)
+		hintUsagesLen := len(sessVars.StmtCtx.HintUsages)
 		// bindings must be not nil when coming here, try to find the best binding.
 		for _, binding := range bindings {
 			if !binding.IsBindingEnabled() {
@@ -282,7 +304,7 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 				core.DebugTraceTryBinding(pctx, binding.Hint)
 			}
 			hint.BindHint(stmtNode, binding.Hint)
-			curStmtHints, _, curWarns := hint.ParseStmtHints(binding.Hint.GetFirstTableHints(), setVarHintChecker, byte(kv.ReplicaReadFollower))
+			curStmtHints, _, curWarns := hint.ParseStmtHints(binding.Hint.GetFirstTableHints(), setVarHintChecker, byte(kv.ReplicaReadFollower), byte(kv.ReplicaReadHedged))
 			sessVars.StmtCtx.StmtHints = curStmtHints
 			// update session var by hint /set_var/
 			for name, val := range sessVars.StmtCtx.StmtHints.SetVars {
@@ -293,6 +315,9 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 				sessVars.StmtCtx.AddSetVarHintRestore(name, oldV)
 			}
 			plan, curNames, cost, err := optimize(ctx, pctx, node, is)
+			// only keep the hint usages of the chosen binding.
+			curHintUsages := slices.Clone(sessVars.StmtCtx.HintUsages[hintUsagesLen:])
+			sessVars.StmtCtx.HintUsages = sessVars.StmtCtx.HintUsages[:hintUsagesLen]
 			if err != nil {
 				binding.Status = bindinfo.Invalid
 				handleInvalidBinding(ctx, pctx, scope, binding)
@@ -300,6 +325,7 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 			}
 			if cost < minCost {
 				bindStmtHints, warns, minCost, names, bestPlanFromBind, chosenBinding = curStmtHints, curWarns, cost, curNames, plan, binding
+				bindHintUsages = curHintUsages
 			}
 		}
 		if bestPlanFromBind == nil {
@@ -307,10 +333,12 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 		} else {
 			bestPlan = bestPlanFromBind
 			sessVars.StmtCtx.StmtHints = bindStmtHints
+			sessVars.StmtCtx.HintUsages = append(sessVars.StmtCtx.HintUsages, bindHintUsages...)
 			for _, warn := range warns {
 				sessVars.StmtCtx.AppendWarning(warn)
 			}
 			sessVars.StmtCtx.BindSQL = chosenBinding.BindSQL
+			sessVars.StmtCtx.BindHints = hint.RestoreOptimizerHints(chosenBinding.Hint.GetFirstTableHints())
 			sessVars.FoundInBinding = true
 			if sessVars.StmtCtx.InVerboseExplain {
 				sessVars.StmtCtx.AppendNote(errors.NewNoStackErrorf("Using the bindSQL: %v", chosenBinding.BindSQL))
@@ -328,6 +356,13 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 	if sessVars.StmtCtx.EnableOptimizerDebugTrace && bestPlanFromBind != nil {
 		core.DebugTraceBestBinding(pctx, chosenBinding.Hint)
 	}
+	if bestPlan == nil && len(digestHints) > 0 {
+		bestPlan, names, err = optimizeWithDigestHints(ctx, sctx, stmtNode, is, tableHints, digestHints)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
 	// No plan found from the bindings, or the bindings are ignored.
 	if bestPlan == nil {
 		sessVars.StmtCtx.StmtHints = originStmtHints
@@ -337,6 +372,12 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 		}
 	}
 
+	// The statement explained is optimized separately, so only collect the statement-level hints there.
+	if _, isExplain := node.(*ast.ExplainStmt); !isExplain && sessVars.ShouldCollectHintUsages() {
+		sessVars.StmtCtx.HintUsages = append(sessVars.StmtCtx.HintUsages,
+			hint.CollectStmtHintUsages(&sessVars.StmtCtx.StmtHints, setVarHintChecker)...)
+	}
+
 	// Add a baseline evolution task if:
 	// 1. the returned plan is from bindings;
 	// 2. the query is a select statement;
@@ -369,6 +410,45 @@ func Optimize(ctx context.Context, sctx sessionctx.Context, node ast.Node, is in
 	return bestPlan, names, nil
 }
 
+// optimizeWithDigestHints optimizes the statement with the hints set by `ADMIN SET HINTS FOR DIGEST`,
+// the hints are appended to the hints of the first query block of the statement.
+func optimizeWithDigestHints(ctx context.Context, sctx sessionctx.Context, stmtNode ast.StmtNode, is infoschema.InfoSchema,
+	tableHints, digestHints []*ast.TableOptimizerHint) (base.Plan, types.NameSlice, error) {
+	sessVars := sctx.GetSessionVars()
+	hints := append(slices.Clone(tableHints), digestHints...)
+	originHints := hint.CollectHint(stmtNode)
+	hint.BindHint(stmtNode, originHints.WithFirstTableHints(hints))
+	// Restore the hint to avoid changing the stmt node.
+	defer hint.BindHint(stmtNode, originHints)
+
+	stmtHints, _, warns := hint.ParseStmtHints(hints, setVarHintChecker, byte(kv.ReplicaReadFollower), byte(kv.ReplicaReadHedged))
+	for _, warn := range warns {
+		sessVars.StmtCtx.AppendWarning(warn)
+	}
+	sessVars.StmtCtx.StmtHints = stmtHints
+	for name, val := range stmtHints.SetVars {
+		oldV, err := sessVars.SetSystemVarWithOldValAsRet(name, val)
+		if err != nil {
+			sessVars.StmtCtx.AppendWarning(err)
+		}
+		// The variable may have been set by the hints of the statement itself.
+		if _, ok := sessVars.StmtCtx.SetVarHintRestore[name]; !ok {
+			sessVars.StmtCtx.AddSetVarHintRestore(name, oldV)
+		}
+	}
+	plan, names, _, err := optimize(ctx, sctx.GetPlanCtx(), stmtNode, is)
+	if err != nil {
+		return nil, nil, err
+	}
+	restoredHints := hint.RestoreOptimizerHints(digestHints)
+	if sessVars.StmtCtx.InVerboseExplain {
+		sessVars.StmtCtx.AppendNote(errors.NewNoStackErrorf("Using the hints set for the digest: %v", restoredHints))
+	} else {
+		sessVars.StmtCtx.AppendExtraNote(errors.NewNoStackErrorf("Using the hints set for the digest: %v", restoredHints))
+	}
+	return plan, names, nil
+}
+
 // OptimizeForForeignKeyCascade does optimization and creates a Plan for foreign key cascade.
 // Compare to Optimize, OptimizeForForeignKeyCascade only build plan by StmtNode,
 // doesn't consider plan cache and plan binding, also doesn't do privilege check.
@@ -463,7 +543,11 @@ func optimize(ctx context.Context, sctx pctx.PlanContext, node ast.Node, is info
 	builder := planBuilderPool.Get().(*core.PlanBuilder)
 	defer planBuilderPool.Put(builder.ResetForReuse())
 	builder.Init(sctx, is, hintProcessor)
+	hintTracesLen := len(sessVars.StmtCtx.HintMatchTraces)
 	p, err := buildLogicalPlan(ctx, sctx, node, builder)
+	if sessVars.TraceHints {
+		traceHintMatches(ctx, sctx, sessVars.StmtCtx.HintMatchTraces[hintTracesLen:])
+	}
 	if err != nil {
 		return nil, nil, 0, err
 	}
@@ -507,6 +591,22 @@ func optimize(ctx context.Context, sctx pctx.PlanContext, node ast.Node, is info
 	return finalPlan, names, cost, err
 }
 
+// traceHintMatches writes the decisions of matching the hints with the tables to the log and the optimizer debug trace.
+func traceHintMatches(ctx context.Context, sctx pctx.PlanContext, traces []string) {
+	if len(traces) == 0 {
+		return
+	}
+	sessVars := sctx.GetSessionVars()
+	_, digest := sessVars.StmtCtx.SQLDigest()
+	logutil.Logger(ctx).Info("trace the hint matching of the statement",
+		zap.Uint64("conn", sessVars.ConnectionID),
+		zap.String("digest", digest.String()),
+		zap.Strings("decisions", traces))
+	if sessVars.StmtCtx.EnableOptimizerDebugTrace {
+		debugtrace.RecordAnyValuesWithNames(sctx, "Hint matching decisions", traces)
+	}
+}
+
 // OptimizeExecStmt to handle the "execute" statement
 func OptimizeExecStmt(ctx context.Context, sctx sessionctx.Context,
 	execAst *ast.ExecuteStmt, is infoschema.InfoSchema) (base.Plan, types.NameSlice, error) {
@@ -523,6 +623,7 @@ func OptimizeExecStmt(ctx context.Context, sctx sessionctx.Context,
 	if !ok {
 		return nil, nil, errors.Errorf("invalid result plan type, should be Execute")
 	}
+	setResourceGroupOptimizerVars(sctx.GetSessionVars(), is)
 	plan, names, err := core.GetPlanFromSessionPlanCache(ctx, sctx, false, is, exec.PrepStmt, exec.Params)
 	if err != nil {
 		return nil, nil, err
@@ -573,6 +674,35 @@ func handleInvalidBinding(ctx context.Context, sctx pctx.PlanContext, level stri
 	globalHandle.AddInvalidGlobalBinding(binding)
 }
 
+// setResourceGroupOptimizerVars sets the optimizer variables overridden by the resource group of the statement,
+// the variables set by the SET_VAR hints of the statement take precedence over them.
+func setResourceGroupOptimizerVars(sessVars *variable.SessionVars, is infoschema.InfoSchema) {
+	if !variable.EnableResourceControl.Load() || sessVars.InRestrictedSQL {
+		return
+	}
+	groupName := sessVars.StmtCtx.ResourceGroupName
+	if sessVars.StmtCtx.StmtHints.HasResourceGroup {
+		groupName = sessVars.StmtCtx.StmtHints.ResourceGroup
+	}
+	group, ok := is.ResourceGroupByName(model.NewCIStr(groupName))
+	if !ok || len(group.OptimizerVars) == 0 {
+		return
+	}
+	for _, v := range group.OptimizerVars {
+		if _, ok := sessVars.StmtCtx.StmtHints.SetVars[v.Name]; ok {
+			continue
+		}
+		oldV, err := sessVars.SetSystemVarWithOldValAsRet(v.Name, v.Value)
+		if err != nil {
+			sessVars.StmtCtx.AppendWarning(err)
+			continue
+		}
+		if _, ok := sessVars.StmtCtx.SetVarHintRestore[v.Name]; !ok {
+			sessVars.StmtCtx.AddSetVarHintRestore(v.Name, oldV)
+		}
+	}
+}
+
 // setVarHintChecker checks whether the variable name in set_var hint is valid.
 func setVarHintChecker(varName, hint string) (ok bool, warning error) {
 	sysVar := variable.GetSysVar(varName)
@@ -15,6 +15,7 @@
 package fixcontrol_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/pingcap/tidb/pkg/planner/util/fixcontrol"
@@ -87,3 +88,25 @@ func TestFixControl(t *testing.T) {
 		require.Equal(t, output[i].Variable, rows)
 	}
 }
+
+func TestFixControlHint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(pk varbinary(255) primary key, a int, b varchar(50), c int, d varchar(45), index ia(a), index ib(b), index ic(c), index id(d))")
+	sql := "select %s * from t where a = 1 and (b = '2' or c = 3 or d = '4')"
+	tk.MustNotHavePlan(fmt.Sprintf(sql, ""), "IndexMerge")
+	tk.MustHavePlan(fmt.Sprintf(sql, "/*+ fix_control(52869:on) */"), "IndexMerge")
+	// the hint doesn't change the variable.
+	require.Empty(t, tk.Session().GetSessionVars().OptimizerFixControl)
+	tk.MustQuery("select @@tidb_opt_fix_control").Check(testkit.Rows(""))
+
+	// the hint overrides the variable.
+	tk.MustExec("set @@tidb_opt_fix_control = '52869:on'")
+	tk.MustHavePlan(fmt.Sprintf(sql, ""), "IndexMerge")
+	tk.MustNotHavePlan(fmt.Sprintf(sql, "/*+ fix_control(52869:off) */"), "IndexMerge")
+
+	// the last value takes effect if a fix is set more than once.
+	tk.MustHavePlan(fmt.Sprintf(sql, "/*+ fix_control(52869:off) fix_control(52869:on) */"), "IndexMerge")
+	tk.MustQuery("show warnings").Check(testkit.Rows("Warning 3126 Hint fix_control(52869:off) is ignored as conflicting/duplicated."))
+}
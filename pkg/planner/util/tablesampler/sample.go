@@ -20,6 +20,8 @@ import (
 	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/table"
+	"github.com/pingcap/tidb/pkg/types"
+	driver "github.com/pingcap/tidb/pkg/types/parser_driver"
 	"github.com/pingcap/tidb/pkg/util/size"
 )
 
@@ -28,6 +30,17 @@ type TableSampleInfo struct {
 	AstNode    *ast.TableSample
 	FullSchema *expression.Schema
 	Partitions []table.PartitionedTable
+	// Percent is the percentage of the regions to read for the SYSTEM sampling method.
+	Percent float64
+}
+
+// SampleRate returns the expected rate of the rows returned by the sampling. The returned bool is false if the rate
+// can't be known in advance, like the REGIONS sampling method which returns one row for each region.
+func (t *TableSampleInfo) SampleRate() (float64, bool) {
+	if t == nil || t.AstNode.SampleMethod != ast.SampleMethodTypeSystem {
+		return 0, false
+	}
+	return t.Percent / 100, true
 }
 
 // MemoryUsage return the memory usage of TableSampleInfo
@@ -36,7 +49,7 @@ func (t *TableSampleInfo) MemoryUsage() (sum int64) {
 		return
 	}
 
-	sum = size.SizeOfPointer*2 + size.SizeOfSlice + int64(cap(t.Partitions))*size.SizeOfInterface
+	sum = size.SizeOfPointer*2 + size.SizeOfSlice + size.SizeOfFloat64 + int64(cap(t.Partitions))*size.SizeOfInterface
 	if t.AstNode != nil {
 		sum += int64(unsafe.Sizeof(ast.TableSample{}))
 	}
@@ -58,3 +71,32 @@ func NewTableSampleInfo(node *ast.TableSample, fullSchema *expression.Schema,
 		Partitions: pt,
 	}
 }
+
+// GetSamplePercent returns the percentage to sample for the SYSTEM and BERNOULLI sampling methods. The SYSTEM method
+// keeps each region with the probability, while the BERNOULLI method keeps each row with the probability.
+func GetSamplePercent(node *ast.TableSample) (float64, error) {
+	if node.Expr == nil {
+		return 0, expression.ErrInvalidTableSample.GenWithStackByArgs("SYSTEM and BERNOULLI sampling methods require the sampling percentage")
+	}
+	if node.SampleClauseUnit == ast.SampleClauseUnitTypeRow {
+		return 0, expression.ErrInvalidTableSample.GenWithStackByArgs("Only supports PERCENT unit for SYSTEM and BERNOULLI sampling methods")
+	}
+	if node.RepeatableSeed != nil {
+		return 0, expression.ErrInvalidTableSample.GenWithStackByArgs("REPEATABLE is not supported for SYSTEM and BERNOULLI sampling methods")
+	}
+	invalidPercentErr := expression.ErrInvalidTableSample.GenWithStackByArgs("The sampling percentage must be a number in [0, 100]")
+	v, ok := node.Expr.(*driver.ValueExpr)
+	if !ok {
+		return 0, invalidPercentErr
+	}
+	switch v.Datum.Kind() {
+	case types.KindInt64, types.KindUint64, types.KindFloat32, types.KindFloat64, types.KindMysqlDecimal:
+	default:
+		return 0, invalidPercentErr
+	}
+	percent, err := v.Datum.ToFloat64(types.DefaultStmtNoWarningContext)
+	if err != nil || percent < 0 || percent > 100 {
+		return 0, invalidPercentErr
+	}
+	return percent, nil
+}
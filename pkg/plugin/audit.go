@@ -127,3 +127,12 @@ type execStartTimeCtxKeyType struct{}
 
 // ExecStartTimeCtxKey indicates stmt start execution time.
 var ExecStartTimeCtxKey = execStartTimeCtxKeyType{}
+
+type execPlanInfoCtxKeyType struct{}
+
+// ExecPlanInfoCtxKey indicates the PlanInfoFunc of the executed stmt.
+var ExecPlanInfoCtxKey = execPlanInfoCtxKeyType{}
+
+// PlanInfoFunc returns the plan digest and the optimizer hints of the executed stmt.
+// They are only generated when the function is called, because it's expensive to generate them for every stmt.
+type PlanInfoFunc func() (planDigest string, hints string)
@@ -0,0 +1,237 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/plugin"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"go.uber.org/zap"
+)
+
+const (
+	// varFilterUsers is the comma separated users whose statements are recorded, e.g. `u1,u2@%`.
+	varFilterUsers = "audit_log_filter_users"
+	// varFilterDBs is the comma separated databases whose statements are recorded.
+	varFilterDBs = "audit_log_filter_dbs"
+	// varFilterDigests is the comma separated SQL digests of the statements recorded.
+	varFilterDigests = "audit_log_filter_digests"
+	// varFilterDMLOnly indicates whether only the SELECT, INSERT, REPLACE, UPDATE and DELETE statements are recorded.
+	varFilterDMLOnly = "audit_log_filter_dml_only"
+	// varFile is the file the records are written to.
+	varFile = "audit_log_file"
+	// varSinkURL is the HTTP endpoint the records are posted to. The file is not used if it's set.
+	varSinkURL = "audit_log_sink_url"
+)
+
+// auditConfig is the configuration of the audit log, which is read from the global system variables.
+type auditConfig struct {
+	users   string
+	dbs     string
+	digests string
+	dmlOnly bool
+	file    string
+	sinkURL string
+}
+
+// auditState is built from an audit config. It's rebuilt when the config is changed.
+type auditState struct {
+	cfg    auditConfig
+	filter *auditFilter
+	// logger is nil if the sink can't be opened, and the records are dropped.
+	logger *auditLogger
+}
+
+var (
+	state   atomic.Pointer[auditState]
+	stateMu sync.Mutex
+	// shutdown is set by OnShutdown, no sink is opened after it. It's protected by stateMu.
+	shutdown bool
+)
+
+// requireSuper makes the sink variables only settable by SUPER, since they decide where the statements are sent.
+// SUPER isn't a dynamic privilege, so it can't be satisfied by the other privileges.
+func requireSuper(_ bool, _ bool) []string {
+	return []string{"SUPER"}
+}
+
+// OnInit implements TiDB plugin's OnInit SPI.
+// nolint: unused, deadcode
+func OnInit(_ context.Context, _ *plugin.Manifest) error {
+	for _, sv := range []*variable.SysVar{
+		{Scope: variable.ScopeGlobal, Name: varFilterUsers, Value: "", Type: variable.TypeStr},
+		{Scope: variable.ScopeGlobal, Name: varFilterDBs, Value: "", Type: variable.TypeStr},
+		{Scope: variable.ScopeGlobal, Name: varFilterDigests, Value: "", Type: variable.TypeStr},
+		{Scope: variable.ScopeGlobal, Name: varFilterDMLOnly, Value: variable.Off, Type: variable.TypeBool},
+		{Scope: variable.ScopeGlobal, Name: varFile, Value: "tidb-audit.log", Type: variable.TypeStr, RequireDynamicPrivileges: requireSuper},
+		{Scope: variable.ScopeGlobal, Name: varSinkURL, Value: "", Type: variable.TypeStr, RequireDynamicPrivileges: requireSuper},
+	} {
+		variable.RegisterSysVar(sv)
+	}
+	return nil
+}
+
+// OnShutdown implements TiDB plugin's OnShutdown SPI.
+// nolint: unused, deadcode
+func OnShutdown(_ context.Context, _ *plugin.Manifest) error {
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	shutdown = true
+	if st := state.Swap(nil); st != nil && st.logger != nil {
+		st.logger.close()
+	}
+	return nil
+}
+
+// OnGeneralEvent implements TiDB Audit plugin's OnGeneralEvent SPI.
+// nolint: unused, deadcode
+func OnGeneralEvent(ctx context.Context, sctx *variable.SessionVars, event plugin.GeneralEvent, cmd string) {
+	if sctx == nil || sctx.StmtCtx == nil || event != plugin.Completed {
+		return
+	}
+	cfg, err := loadAuditConfig(ctx, sctx)
+	if err != nil {
+		logutil.BgLogger().Warn("failed to load audit log config", zap.Error(err))
+		return
+	}
+	st := getAuditState(cfg)
+	if st == nil || st.logger == nil {
+		return
+	}
+	record := newAuditRecord(ctx, sctx, cmd)
+	if !st.filter.match(record) {
+		return
+	}
+	// The plan digest and the hints are expensive to generate, so they are only generated for the recorded statements.
+	if planInfo, ok := ctx.Value(plugin.ExecPlanInfoCtxKey).(plugin.PlanInfoFunc); ok && planInfo != nil {
+		record.PlanDigest, record.Hints = planInfo()
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		logutil.BgLogger().Warn("failed to encode audit log record", zap.Error(err))
+		return
+	}
+	st.logger.log(append(data, '\n'))
+}
+
+func loadAuditConfig(ctx context.Context, sctx *variable.SessionVars) (cfg auditConfig, err error) {
+	for _, item := range []struct {
+		name  string
+		value *string
+	}{
+		{varFilterUsers, &cfg.users},
+		{varFilterDBs, &cfg.dbs},
+		{varFilterDigests, &cfg.digests},
+		{varFile, &cfg.file},
+		{varSinkURL, &cfg.sinkURL},
+	} {
+		if *item.value, err = sctx.GetGlobalSystemVar(ctx, item.name); err != nil {
+			return cfg, err
+		}
+	}
+	dmlOnly, err := sctx.GetGlobalSystemVar(ctx, varFilterDMLOnly)
+	if err != nil {
+		return cfg, err
+	}
+	cfg.dmlOnly = variable.TiDBOptOn(dmlOnly)
+	return cfg, nil
+}
+
+// getAuditState returns the audit state of the config. The sink is reopened only if its config is changed.
+// It returns nil after the plugin is shut down.
+func getAuditState(cfg auditConfig) *auditState {
+	if st := state.Load(); st != nil && st.cfg == cfg {
+		return st
+	}
+	stateMu.Lock()
+	defer stateMu.Unlock()
+	if shutdown {
+		return nil
+	}
+	old := state.Load()
+	if old != nil && old.cfg == cfg {
+		return old
+	}
+	st := &auditState{
+		cfg:    cfg,
+		filter: newAuditFilter(cfg.users, cfg.dbs, cfg.digests, cfg.dmlOnly),
+	}
+	if old != nil && old.cfg.file == cfg.file && old.cfg.sinkURL == cfg.sinkURL {
+		st.logger = old.logger
+	} else {
+		st.logger = openAuditLogger(cfg)
+		if old != nil && old.logger != nil {
+			defer old.logger.close()
+		}
+	}
+	state.Store(st)
+	return st
+}
+
+func openAuditLogger(cfg auditConfig) *auditLogger {
+	if cfg.sinkURL != "" {
+		return newAuditLogger(newHTTPSink(cfg.sinkURL))
+	}
+	if cfg.file == "" {
+		return nil
+	}
+	sink, err := newFileSink(cfg.file)
+	if err != nil {
+		logutil.BgLogger().Warn("failed to open audit log file", zap.String("file", cfg.file), zap.Error(err))
+		return nil
+	}
+	return newAuditLogger(sink)
+}
+
+func newAuditRecord(ctx context.Context, sctx *variable.SessionVars, cmd string) *auditRecord {
+	sc := sctx.StmtCtx
+	startTime, ok := ctx.Value(plugin.ExecStartTimeCtxKey).(time.Time)
+	if !ok {
+		startTime = time.Now()
+	}
+	_, sqlDigest := sc.SQLDigest()
+	record := &auditRecord{
+		Time:         startTime.Format(time.RFC3339Nano),
+		ConnID:       sctx.ConnectionID,
+		CurrentDB:    sctx.CurrentDB,
+		Command:      cmd,
+		StmtType:     sc.StmtType,
+		SQL:          parser.Normalize(sc.OriginalSQL, sctx.EnableRedactLog),
+		AffectedRows: sc.AffectedRows(),
+		Status:       sctx.Status(),
+		isDML:        sc.InSelectStmt || sc.InInsertStmt || sc.InUpdateStmt || sc.InDeleteStmt,
+	}
+	if sqlDigest != nil {
+		record.SQLDigest = sqlDigest.String()
+	}
+	if sctx.User != nil {
+		record.User = sctx.User.Username
+		record.Host = sctx.User.Hostname
+	}
+	for _, tbl := range sc.Tables {
+		if !slices.Contains(record.Databases, tbl.DB) {
+			record.Databases = append(record.Databases, tbl.DB)
+		}
+	}
+	return record
+}
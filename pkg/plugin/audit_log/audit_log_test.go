@@ -0,0 +1,126 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditFilter(t *testing.T) {
+	records := []*auditRecord{
+		{User: "u1", Host: "%", CurrentDB: "test", Databases: []string{"db1"}, SQLDigest: "ABC", isDML: true},
+		{User: "u2", Host: "127.0.0.1", CurrentDB: "Test", SQLDigest: "def"},
+	}
+	cases := []struct {
+		filter  *auditFilter
+		matches []bool
+	}{
+		{newAuditFilter("", "", "", false), []bool{true, true}},
+		{newAuditFilter("", "", "", true), []bool{true, false}},
+		{newAuditFilter("u1", "", "", false), []bool{true, false}},
+		{newAuditFilter(" u3 , u2@127.0.0.1", "", "", false), []bool{false, true}},
+		{newAuditFilter("u2@%", "", "", false), []bool{false, false}},
+		{newAuditFilter("", "DB1", "", false), []bool{true, false}},
+		{newAuditFilter("", "test", "", false), []bool{false, true}},
+		{newAuditFilter("", "", "abc,xyz", false), []bool{true, false}},
+		{newAuditFilter("u2", "test", "def", true), []bool{false, false}},
+	}
+	for i, c := range cases {
+		for j, r := range records {
+			require.Equalf(t, c.matches[j], c.filter.match(r), "case %d, record %d", i, j)
+		}
+	}
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.log")
+	require.NoError(t, os.WriteFile(path, []byte("{\"old\":1}\n"), 0600))
+	sink, err := newFileSink(path)
+	require.NoError(t, err)
+	l := newAuditLogger(sink)
+	l.log([]byte("{\"a\":1}\n"))
+	l.log([]byte("{\"b\":2}\n"))
+	l.close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, "{\"old\":1}\n{\"a\":1}\n{\"b\":2}\n", string(data))
+}
+
+func TestHTTPSink(t *testing.T) {
+	var (
+		mu   sync.Mutex
+		body bytes.Buffer
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, http.MethodPost, r.Method)
+		require.Equal(t, "application/x-ndjson", r.Header.Get("Content-Type"))
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		mu.Lock()
+		body.Write(data)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	l := newAuditLogger(newHTTPSink(server.URL))
+	for i := 0; i < flushBatchSize+1; i++ {
+		l.log([]byte("{}\n"))
+	}
+	l.close()
+	mu.Lock()
+	require.Equal(t, strings.Repeat("{}\n", flushBatchSize+1), body.String())
+	mu.Unlock()
+
+	failServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer failServer.Close()
+	err := newHTTPSink(failServer.URL).write([][]byte{[]byte("{}\n")})
+	require.ErrorContains(t, err, "503")
+}
+
+func TestAuditLogShutdown(t *testing.T) {
+	cfg := auditConfig{file: filepath.Join(t.TempDir(), "audit.log")}
+	st := getAuditState(cfg)
+	require.NotNil(t, st.logger)
+	st.logger.log([]byte("{\"a\":1}\n"))
+
+	require.NoError(t, OnShutdown(context.Background(), nil))
+	defer func() {
+		stateMu.Lock()
+		shutdown = false
+		stateMu.Unlock()
+	}()
+	// The records logged before the shutdown are written, and the ones after it are dropped.
+	st.logger.log([]byte("{\"b\":2}\n"))
+	data, err := os.ReadFile(cfg.file)
+	require.NoError(t, err)
+	require.Equal(t, "{\"a\":1}\n", string(data))
+	// No sink is reopened after the shutdown.
+	require.Nil(t, getAuditState(cfg))
+	require.Nil(t, state.Load())
+}
@@ -0,0 +1,116 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"strings"
+)
+
+// auditRecord is a statement recorded by the audit log.
+type auditRecord struct {
+	Time         string   `json:"time"`
+	ConnID       uint64   `json:"conn_id"`
+	User         string   `json:"user"`
+	Host         string   `json:"host"`
+	CurrentDB    string   `json:"current_db"`
+	Databases    []string `json:"databases,omitempty"`
+	Command      string   `json:"command"`
+	StmtType     string   `json:"stmt_type"`
+	SQL          string   `json:"sql"`
+	SQLDigest    string   `json:"sql_digest"`
+	PlanDigest   string   `json:"plan_digest,omitempty"`
+	Hints        string   `json:"hints,omitempty"`
+	AffectedRows uint64   `json:"affected_rows"`
+	Status       uint16   `json:"status"`
+
+	isDML bool
+}
+
+// auditFilter decides which statements are recorded by the audit log.
+// An empty list in the filter matches all the statements.
+type auditFilter struct {
+	users   map[string]struct{}
+	dbs     map[string]struct{}
+	digests map[string]struct{}
+	dmlOnly bool
+}
+
+// newAuditFilter creates an audit filter from the comma separated lists of the users, the databases and the
+// SQL digests. A user can be written as `user` or `user@host`.
+func newAuditFilter(users, dbs, digests string, dmlOnly bool) *auditFilter {
+	return &auditFilter{
+		users:   splitFilterList(users, false),
+		dbs:     splitFilterList(dbs, true),
+		digests: splitFilterList(digests, true),
+		dmlOnly: dmlOnly,
+	}
+}
+
+func splitFilterList(s string, lower bool) map[string]struct{} {
+	var items map[string]struct{}
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if lower {
+			item = strings.ToLower(item)
+		}
+		if items == nil {
+			items = make(map[string]struct{})
+		}
+		items[item] = struct{}{}
+	}
+	return items
+}
+
+func (f *auditFilter) match(r *auditRecord) bool {
+	if f.dmlOnly && !r.isDML {
+		return false
+	}
+	if len(f.users) > 0 {
+		_, ok := f.users[r.User]
+		if !ok {
+			_, ok = f.users[r.User+"@"+r.Host]
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(f.dbs) > 0 && !f.matchDB(r) {
+		return false
+	}
+	if len(f.digests) > 0 {
+		if _, ok := f.digests[strings.ToLower(r.SQLDigest)]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// matchDB returns whether the statement accesses one of the databases in the filter.
+// The current database is used if the statement doesn't access any table.
+func (f *auditFilter) matchDB(r *auditRecord) bool {
+	if len(r.Databases) == 0 {
+		_, ok := f.dbs[strings.ToLower(r.CurrentDB)]
+		return ok
+	}
+	for _, db := range r.Databases {
+		if _, ok := f.dbs[strings.ToLower(db)]; ok {
+			return true
+		}
+	}
+	return false
+}
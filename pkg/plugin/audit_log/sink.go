@@ -0,0 +1,198 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"go.uber.org/zap"
+)
+
+const (
+	// recordBufferSize is the max number of the records waiting to be written to the sink.
+	// The records are dropped if the buffer is full, so the statements are never blocked by a slow sink.
+	recordBufferSize = 8192
+	// flushBatchSize is the max number of the records written to the sink at once.
+	flushBatchSize = 256
+	flushInterval  = time.Second
+	httpTimeout    = 5 * time.Second
+)
+
+// auditSink is where the audit records are written to.
+type auditSink interface {
+	// write writes a batch of records, every record is a JSON object ending with a newline.
+	write(records [][]byte) error
+	close() error
+}
+
+// fileSink appends the records to a local file.
+type fileSink struct {
+	f *os.File
+}
+
+func newFileSink(path string) (*fileSink, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, errors.Trace(err)
+	}
+	return &fileSink{f: f}, nil
+}
+
+func (s *fileSink) write(records [][]byte) error {
+	_, err := s.f.Write(bytes.Join(records, nil))
+	return errors.Trace(err)
+}
+
+func (s *fileSink) close() error {
+	if err := s.f.Sync(); err != nil {
+		_ = s.f.Close()
+		return errors.Trace(err)
+	}
+	return errors.Trace(s.f.Close())
+}
+
+// httpSink posts the records to an external service as newline delimited JSON.
+type httpSink struct {
+	url    string
+	client *http.Client
+}
+
+func newHTTPSink(url string) *httpSink {
+	return &httpSink{url: url, client: &http.Client{Timeout: httpTimeout}}
+}
+
+func (s *httpSink) write(records [][]byte) error {
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, s.url, bytes.NewReader(bytes.Join(records, nil)))
+	if err != nil {
+		return errors.Trace(err)
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return errors.Trace(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return errors.Errorf("audit log sink responds with status %s", resp.Status)
+	}
+	return nil
+}
+
+func (*httpSink) close() error {
+	return nil
+}
+
+// auditLogger streams the records to the sink in the background.
+type auditLogger struct {
+	sink    auditSink
+	records chan []byte
+	done    chan struct{}
+	wg      sync.WaitGroup
+	dropped atomic.Uint64
+
+	// mu makes sure no record is added after the logger is closed, so all the added records are written to the sink.
+	mu     sync.RWMutex
+	closed bool
+}
+
+func newAuditLogger(sink auditSink) *auditLogger {
+	l := &auditLogger{
+		sink:    sink,
+		records: make(chan []byte, recordBufferSize),
+		done:    make(chan struct{}),
+	}
+	l.wg.Add(1)
+	go l.run()
+	return l
+}
+
+// log adds a record to the buffer. The record is dropped if the buffer is full or the logger is closed.
+func (l *auditLogger) log(record []byte) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if l.closed {
+		return
+	}
+	select {
+	case l.records <- record:
+	default:
+		l.dropped.Add(1)
+	}
+}
+
+func (l *auditLogger) run() {
+	defer l.wg.Done()
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	batch := make([][]byte, 0, flushBatchSize)
+	for {
+		select {
+		case record := <-l.records:
+			batch = append(batch, record)
+			if len(batch) < flushBatchSize {
+				continue
+			}
+		case <-ticker.C:
+		case <-l.done:
+			for {
+				select {
+				case record := <-l.records:
+					batch = append(batch, record)
+				default:
+					l.flush(batch)
+					return
+				}
+			}
+		}
+		l.flush(batch)
+		batch = batch[:0]
+	}
+}
+
+func (l *auditLogger) flush(batch [][]byte) {
+	if dropped := l.dropped.Swap(0); dropped > 0 {
+		logutil.BgLogger().Warn("audit log records are dropped because the sink is too slow", zap.Uint64("count", dropped))
+	}
+	if len(batch) == 0 {
+		return
+	}
+	if err := l.sink.write(batch); err != nil {
+		logutil.BgLogger().Warn("failed to write audit log records", zap.Int("count", len(batch)), zap.Error(err))
+	}
+}
+
+// close writes the buffered records to the sink and closes the sink.
+func (l *auditLogger) close() {
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return
+	}
+	l.closed = true
+	l.mu.Unlock()
+	close(l.done)
+	l.wg.Wait()
+	if err := l.sink.close(); err != nil {
+		logutil.BgLogger().Warn("failed to close audit log sink", zap.Error(err))
+	}
+}
@@ -66,6 +66,10 @@ var dynamicPrivs = []string{
 	"RESTRICTED_CONNECTION_ADMIN",     // Can not be killed by PROCESS/CONNECTION_ADMIN privilege
 	"RESTRICTED_REPLICA_WRITER_ADMIN", // Can write to the sever even when tidb_restriced_read_only is turned on.
 	"RESOURCE_GROUP_ADMIN",            // Create/Drop/Alter RESOURCE GROUP
+	"BYPASS_ROW_POLICY",               // Not restricted by the row policies of tables
+	"BYPASS_DATA_MASKING",             // Read the original values of the masked columns
+	"SQL_TUNING_ADMIN",                // Create/Drop/Alter bindings and manage the hints of statements
+	"REMOTE_IMPORT_ADMIN",             // IMPORT INTO from the query result of another cluster
 }
 var dynamicPrivLock sync.Mutex
 var defaultTokenLife = 15 * time.Minute
@@ -121,6 +121,9 @@ const (
 	connStatusWaitShutdown = 3                           // Notified by server to close.
 )
 
+// appNameAttr is the connection attribute used to identify the application of a connection.
+const appNameAttr = "program_name"
+
 var (
 	statusCompression          = "Compression"
 	statusCompressionAlgorithm = "Compression_algorithm"
@@ -794,6 +797,7 @@ func (cc *clientConn) openSessionAndDoAuth(authData []byte, authPlugin string, z
 	}
 	cc.ctx.SetPort(port)
 	cc.ctx.SetCompressionLevel(zstdLevel)
+	cc.ctx.GetSessionVars().AppName = cc.attrs[appNameAttr]
 	if cc.dbname != "" {
 		_, err = cc.useDB(context.Background(), cc.dbname)
 		if err != nil {
@@ -2561,6 +2565,7 @@ func (cc *clientConn) handleResetConnection(ctx context.Context) error {
 func (cc *clientConn) handleCommonConnectionReset(ctx context.Context) error {
 	connectionInfo := cc.connectInfo()
 	cc.ctx.GetSessionVars().ConnectionInfo = connectionInfo
+	cc.ctx.GetSessionVars().AppName = cc.attrs[appNameAttr]
 
 	cc.onExtensionConnEvent(extension.ConnReset, nil)
 	err := plugin.ForeachPlugin(plugin.Audit, func(p *plugin.Plugin) error {
@@ -288,6 +288,14 @@ func (tc *TiDBContext) ExecuteStmt(ctx context.Context, stmt ast.StmtNode) (resu
 	if err = tc.checkSandBoxMode(stmt); err != nil {
 		return nil, err
 	}
+	ntStmt, isNT, err := session.NonTransactionalDMLFromHint(ctx, tc.Session, stmt)
+	if err != nil {
+		tc.Session.GetSessionVars().StmtCtx.AppendError(err)
+		return nil, err
+	}
+	if isNT {
+		stmt = ntStmt
+	}
 	if s, ok := stmt.(*ast.NonTransactionalDMLStmt); ok {
 		rs, err = session.HandleNonTransactionalDML(ctx, s, tc.Session)
 	} else {
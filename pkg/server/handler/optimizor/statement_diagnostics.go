@@ -0,0 +1,64 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizor
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/executor"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/server/handler"
+	"github.com/pingcap/tidb/pkg/session"
+)
+
+// StatementDiagnosticsHandler is the handler for collecting the diagnostics bundle of a statement.
+type StatementDiagnosticsHandler struct {
+	do *domain.Domain
+}
+
+// NewStatementDiagnosticsHandler creates a new StatementDiagnosticsHandler.
+func NewStatementDiagnosticsHandler(do *domain.Domain) *StatementDiagnosticsHandler {
+	return &StatementDiagnosticsHandler{do: do}
+}
+
+// ServeHTTP collects the diagnostics bundle of the statement given by the sql text or the sql digest.
+// The caller of the status port is not authenticated, so the plan of the statement is not collected, and the
+// sample text, the plans and the bind SQL which may contain the literal values are removed from the bundle,
+// use `ADMIN SHOW DIAGNOSTICS` to collect the full bundle with the privileges of the current user.
+func (sh StatementDiagnosticsHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	sqlText, digest, db := query.Get(handler.SQLText), query.Get(handler.SQLDigest), query.Get(handler.DBName)
+	if sqlText == "" && digest == "" {
+		handler.WriteError(w, errors.New("either sql or digest should be specified"))
+		return
+	}
+	se, err := session.CreateSession(sh.do.Store())
+	if err != nil {
+		handler.WriteError(w, err)
+		return
+	}
+	defer se.Close()
+
+	ctx := kv.WithInternalSourceType(context.Background(), kv.InternalTxnOthers)
+	bundle, err := executor.CollectStatementDiagnostics(ctx, se, sqlText, digest, db, nil)
+	if err != nil {
+		handler.WriteError(w, err)
+		return
+	}
+	handler.WriteData(w, bundle)
+}
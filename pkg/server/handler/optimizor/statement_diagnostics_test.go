@@ -0,0 +1,90 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package optimizor_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/executor"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/server/handler/optimizor"
+	"github.com/pingcap/tidb/pkg/testkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStatementDiagnosticsAPI(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	// statements are recorded in the statement summary only for authenticated users.
+	require.NoError(t, tk.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int, b int, index ia(a))")
+	tk.MustExec("insert into t values (1, 1), (2, 2)")
+	tk.MustExec("analyze table t")
+	tk.MustExec("create global binding for select * from t where a = 1 using select /*+ use_index(t, ia) */ * from t where a = 1")
+	tk.MustQuery("select * from t where a = 1").Check(testkit.Rows("1 1"))
+	_, digest := tk.Session().GetSessionVars().StmtCtx.SQLDigest()
+
+	h := optimizor.NewStatementDiagnosticsHandler(dom)
+	fetch := func(query url.Values) (*httptest.ResponseRecorder, *executor.StatementDiagnostics) {
+		req := httptest.NewRequest(http.MethodGet, "/diagnostics/statement?"+query.Encode(), nil)
+		resp := httptest.NewRecorder()
+		h.ServeHTTP(resp, req)
+		if resp.Code != http.StatusOK {
+			return resp, nil
+		}
+		bundle := &executor.StatementDiagnostics{}
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), bundle))
+		return resp, bundle
+	}
+
+	resp, _ := fetch(url.Values{})
+	require.Equal(t, http.StatusBadRequest, resp.Code)
+
+	_, bundle := fetch(url.Values{"sql": {"select * from t where a = 1"}, "db": {"test"}})
+	require.Equal(t, digest.String(), bundle.Digest)
+	require.Len(t, bundle.Bindings, 1)
+	require.Equal(t, "enabled", bundle.Bindings[0]["status"])
+	require.Len(t, bundle.TableStats, 1)
+	require.Equal(t, "t", bundle.TableStats[0]["table_name"])
+	require.Equal(t, "100", bundle.TableStats[0]["healthy"])
+	require.NotEmpty(t, bundle.ExecStats)
+	require.Equal(t, "1", bundle.ExecStats[0]["plan_in_binding"])
+	// the caller of the status port is not authenticated, so the plan is not collected,
+	// and the parts which may contain the literal values are removed.
+	require.Len(t, bundle.Errors, 1)
+	require.Contains(t, bundle.Errors[0], "plan: ")
+	require.Empty(t, bundle.Plan)
+	for _, field := range []string{"query_sample_text", "plan", "schema_name", "last_binding_sql"} {
+		require.NotContains(t, bundle.ExecStats[0], field)
+	}
+	require.NotContains(t, bundle.Bindings[0], "bind_sql")
+
+	// the statement text and the schema are taken from the statement summary to collect the bundle,
+	// but they're not returned.
+	resp, bundle = fetch(url.Values{"digest": {digest.String()}})
+	require.Empty(t, bundle.SQL)
+	require.Empty(t, bundle.DB)
+	require.Len(t, bundle.Bindings, 1)
+	require.Len(t, bundle.TableStats, 1)
+	require.NotEmpty(t, bundle.ExecStats)
+	require.NotContains(t, bundle.ExecStats[0], "query_sample_text")
+	require.Empty(t, bundle.Plan)
+	require.NotContains(t, resp.Body.String(), "a = 1")
+}
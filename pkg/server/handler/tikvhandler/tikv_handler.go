@@ -223,6 +223,10 @@ const (
 	OpTableScatter = "scatter-table"
 	// OpStopTableScatter is the operation for stopping scattering a table.
 	OpStopTableScatter = "stop-scatter-table"
+	// OpTablePrewarmRegions is the operation for prewarming the region cache of a table.
+	OpTablePrewarmRegions = "prewarm-regions"
+	// OpTableUnpinRegions is the operation for unpinning the region cache of a table.
+	OpTableUnpinRegions = "unpin-regions"
 )
 
 // MvccTxnHandler is the handler for txn debugger.
@@ -1064,6 +1068,10 @@ func (h *TableHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
 			return
 		}
 		h.handleStopScatterTableRequest(ptbl, w)
+	case OpTablePrewarmRegions:
+		h.handlePrewarmRegionsRequest(dbName, tableVal, req, w)
+	case OpTableUnpinRegions:
+		h.handleUnpinRegionsRequest(dbName, tableVal, w)
 	default:
 		handler.WriteError(w, errors.New("method not found"))
 	}
@@ -1264,6 +1272,67 @@ func (h *TableHandler) handleStopScatterTableRequest(tbl table.PhysicalTable, w
 	handler.WriteData(w, "success!")
 }
 
+// PrewarmRegionsResponse is the response of prewarming the region cache of a table.
+type PrewarmRegionsResponse struct {
+	TableName   string `json:"table_name"`
+	RegionCount int    `json:"region_count"`
+	Pinned      bool   `json:"pinned"`
+}
+
+// tableKeyRanges returns the key ranges of the table, including the records and the indices of all its partitions.
+func tableKeyRanges(tbl table.Table) []kv.KeyRange {
+	ids := []int64{tbl.Meta().ID}
+	if pi := tbl.Meta().GetPartitionInfo(); pi != nil {
+		for _, def := range pi.Definitions {
+			ids = append(ids, def.ID)
+		}
+	}
+	ranges := make([]kv.KeyRange, 0, len(ids))
+	for _, id := range ids {
+		ranges = append(ranges, kv.KeyRange{StartKey: tablecodec.EncodeTablePrefix(id), EndKey: tablecodec.EncodeTablePrefix(id + 1)})
+	}
+	return ranges
+}
+
+func pinnedRegionsName(dbName string, tbl table.Table) string {
+	return fmt.Sprintf("%s.%s-%d", strings.ToLower(dbName), tbl.Meta().Name.L, tbl.Meta().ID)
+}
+
+func (h *TableHandler) handlePrewarmRegionsRequest(dbName string, tbl table.Table, req *http.Request, w http.ResponseWriter) {
+	var pin bool
+	if pinValue := req.FormValue(handler.Pin); len(pinValue) > 0 {
+		var err error
+		pin, err = strconv.ParseBool(pinValue)
+		if err != nil {
+			handler.WriteError(w, errors.Annotate(err, "invalid pin"))
+			return
+		}
+	}
+	ranges := tableKeyRanges(tbl)
+	var (
+		cnt int
+		err error
+	)
+	if pin {
+		cnt, err = h.PinRegionCache(req.Context(), pinnedRegionsName(dbName, tbl), ranges)
+	} else {
+		cnt, err = h.PrewarmRegionCache(req.Context(), ranges)
+	}
+	if err != nil {
+		handler.WriteError(w, errors.Annotate(err, "prewarm region cache error"))
+		return
+	}
+	handler.WriteData(w, PrewarmRegionsResponse{TableName: tbl.Meta().Name.O, RegionCount: cnt, Pinned: pin})
+}
+
+func (h *TableHandler) handleUnpinRegionsRequest(dbName string, tbl table.Table, w http.ResponseWriter) {
+	if !h.UnpinRegionCache(pinnedRegionsName(dbName, tbl)) {
+		handler.WriteError(w, errors.Errorf("the region cache of table %s is not pinned", tbl.Meta().Name.O))
+		return
+	}
+	handler.WriteData(w, "success!")
+}
+
 func (h *TableHandler) handleRegionRequest(tbl table.Table, w http.ResponseWriter) {
 	pi := tbl.Meta().GetPartitionInfo()
 	if pi != nil {
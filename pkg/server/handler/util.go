@@ -62,6 +62,9 @@ const (
 	JobID        = "start_job_id"
 	Operation    = "op"
 	Seconds      = "seconds"
+	SQLText      = "sql"
+	SQLDigest    = "digest"
+	Pin          = "pin"
 )
 
 const (
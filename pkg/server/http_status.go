@@ -222,6 +222,9 @@ func (s *Server) startHTTPServer() {
 
 	router.Handle("/optimize_trace/dump/{filename}", s.newOptimizeTraceHandler()).Name("OptimizeTraceDump")
 
+	// HTTP path for collecting the diagnostics bundle of a statement.
+	router.Handle("/diagnostics/statement", s.newStatementDiagnosticsHandler()).Name("StatementDiagnostics")
+
 	tikvHandlerTool := s.NewTikvHandlerTool()
 	router.Handle("/settings", tikvhandler.NewSettingsHandler(tikvHandlerTool)).Name("Settings")
 	router.Handle("/binlog/recover", tikvhandler.BinlogRecover{}).Name("BinlogRecover")
@@ -262,6 +265,8 @@ func (s *Server) startHTTPServer() {
 		router.Handle("/tables/{db}/{table}/scatter", tikvhandler.NewTableHandler(tikvHandlerTool, tikvhandler.OpTableScatter))
 		router.Handle("/tables/{db}/{table}/stop-scatter", tikvhandler.NewTableHandler(tikvHandlerTool, tikvhandler.OpStopTableScatter))
 		router.Handle("/tables/{db}/{table}/disk-usage", tikvhandler.NewTableHandler(tikvHandlerTool, tikvhandler.OpTableDiskUsage))
+		router.Handle("/tables/{db}/{table}/prewarm-regions", tikvhandler.NewTableHandler(tikvHandlerTool, tikvhandler.OpTablePrewarmRegions))
+		router.Handle("/tables/{db}/{table}/unpin-regions", tikvhandler.NewTableHandler(tikvHandlerTool, tikvhandler.OpTableUnpinRegions))
 		router.Handle("/regions/meta", tikvhandler.NewRegionHandler(tikvHandlerTool)).Name("RegionsMeta")
 		router.Handle("/regions/hot", tikvhandler.NewRegionHandler(tikvHandlerTool)).Name("RegionHot")
 		router.Handle("/regions/{regionID}", tikvhandler.NewRegionHandler(tikvHandlerTool))
@@ -599,6 +604,19 @@ func (s *Server) newStatsHandler() *optimizor.StatsHandler {
 	return optimizor.NewStatsHandler(do)
 }
 
+func (s *Server) newStatementDiagnosticsHandler() *optimizor.StatementDiagnosticsHandler {
+	store, ok := s.driver.(*TiDBDriver)
+	if !ok {
+		panic("Illegal driver")
+	}
+
+	do, err := session.GetDomain(store.store)
+	if err != nil {
+		panic("Failed to get domain")
+	}
+	return optimizor.NewStatementDiagnosticsHandler(do)
+}
+
 func (s *Server) newStatsHistoryHandler() *optimizor.StatsHistoryHandler {
 	store, ok := s.driver.(*TiDBDriver)
 	if !ok {
@@ -490,6 +490,8 @@ const (
 		sql_digest VARCHAR(128) NOT NULL,
 		plan_digest VARCHAR(128) NOT NULL,
 		update_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP,
+		capture_limit INT UNSIGNED NOT NULL DEFAULT 0,
+		captured_count INT UNSIGNED NOT NULL DEFAULT 0,
 		PRIMARY KEY (sql_digest,plan_digest));`
 
 	// CreateStatsTableLocked stores the locked tables
@@ -690,6 +692,64 @@ const (
 		KEY (created_by),
 		KEY (status));`
 
+	// CreateDigestHintsTable stores the hints set by `ADMIN SET HINTS FOR DIGEST`.
+	CreateDigestHintsTable = `CREATE TABLE IF NOT EXISTS mysql.digest_hints (
+		digest VARCHAR(64) NOT NULL,
+		hints TEXT NOT NULL,
+		create_time TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		expire_time TIMESTAMP(6) NULL DEFAULT NULL,
+		PRIMARY KEY (digest)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;`
+
+	// CreateAppDigestHintsTable stores the hints set by `ADMIN SET HINTS FOR DIGEST ... FOR APPLICATION`.
+	CreateAppDigestHintsTable = `CREATE TABLE IF NOT EXISTS mysql.app_digest_hints (
+		app_name VARCHAR(64) NOT NULL,
+		digest VARCHAR(64) NOT NULL,
+		hints TEXT NOT NULL,
+		create_time TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		expire_time TIMESTAMP(6) NULL DEFAULT NULL,
+		PRIMARY KEY (app_name, digest)
+	) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_bin;`
+
+	// CreateImportConflictsTable stores the sample rows conflicting on primary key or unique index
+	// found by IMPORT INTO with `conflict_strategy` option.
+	CreateImportConflictsTable = `CREATE TABLE IF NOT EXISTS mysql.tidb_import_conflicts (
+		id bigint(64) NOT NULL AUTO_INCREMENT,
+		job_id bigint(64) NOT NULL,
+		table_schema VARCHAR(64) NOT NULL,
+		table_name VARCHAR(64) NOT NULL,
+		index_name VARCHAR(64) NOT NULL,
+		strategy VARCHAR(16) NOT NULL,
+		raw_key BLOB NOT NULL,
+		row_data TEXT NOT NULL,
+		create_time TIMESTAMP(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+		PRIMARY KEY (id),
+		KEY (job_id));`
+
+	// CreateTableAccessStatsTable stores the logical access counts of the tables and the indexes collected from the
+	// execution plans. The index_id is 0 for the accesses to the rows of the table.
+	CreateTableAccessStatsTable = `CREATE TABLE IF NOT EXISTS mysql.tidb_table_access_stats (
+		table_id bigint(64) NOT NULL,
+		index_id bigint(64) NOT NULL,
+		scans bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		point_gets bigint(64) UNSIGNED NOT NULL DEFAULT 0,
+		last_access_time TIMESTAMP(6) NOT NULL,
+		PRIMARY KEY (table_id, index_id));`
+
+	// CreateSQLFirewallRulesTable stores the rules of the SQL firewall. A rule matches the statements by sql_digest or
+	// by sql_pattern, which is a LIKE pattern of the normalized SQL. normalized_sql is only for reference.
+	CreateSQLFirewallRulesTable = `CREATE TABLE IF NOT EXISTS mysql.tidb_sql_firewall_rules (
+		id BIGINT(64) NOT NULL AUTO_INCREMENT,
+		sql_digest VARCHAR(64) DEFAULT NULL,
+		sql_pattern TEXT DEFAULT NULL,
+		action ENUM('ALLOW', 'DENY', 'REWRITE') NOT NULL,
+		replacement TEXT DEFAULT NULL,
+		source ENUM('MANUAL', 'LEARNED') NOT NULL DEFAULT 'MANUAL',
+		normalized_sql TEXT DEFAULT NULL,
+		create_time TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+		PRIMARY KEY (id),
+		UNIQUE KEY sql_digest (sql_digest));`
+
 	// DropMySQLIndexUsageTable removes the table `mysql.schema_index_usage`
 	DropMySQLIndexUsageTable = "DROP TABLE IF EXISTS mysql.schema_index_usage"
 
@@ -1093,11 +1153,35 @@ const (
 	// version 197
 	//   replace `mysql.tidb_mdl_view` table
 	version197 = 197
+
+	// version 198
+	//   add columns `capture_limit` and `captured_count` for `mysql.plan_replayer_task` table
+	version198 = 198
+
+	// version 199
+	//   create `mysql.digest_hints` table
+	version199 = 199
+
+	// version 200
+	//   create `mysql.app_digest_hints` table
+	version200 = 200
+
+	// version 201
+	//   create `mysql.tidb_import_conflicts` table
+	version201 = 201
+
+	// version 202
+	//   create `mysql.tidb_table_access_stats` table
+	version202 = 202
+
+	// version 203
+	//   create `mysql.tidb_sql_firewall_rules` table
+	version203 = 203
 )
 
 // currentBootstrapVersion is defined as a variable, so we can modify its value for testing.
 // please make sure this is the largest version
-var currentBootstrapVersion int64 = version197
+var currentBootstrapVersion int64 = version203
 
 // DDL owner key's expired time is ManagerSessionTTL seconds, we should wait the time and give more time to have a chance to finish it.
 var internalSQLTimeout = owner.ManagerSessionTTL + 15
@@ -1260,6 +1344,12 @@ var (
 		upgradeToVer195,
 		upgradeToVer196,
 		upgradeToVer197,
+		upgradeToVer198,
+		upgradeToVer199,
+		upgradeToVer200,
+		upgradeToVer201,
+		upgradeToVer202,
+		upgradeToVer203,
 	}
 )
 
@@ -3103,6 +3193,50 @@ func upgradeToVer197(s sessiontypes.Session, ver int64) {
 	doReentrantDDL(s, CreateMDLView)
 }
 
+func upgradeToVer198(s sessiontypes.Session, ver int64) {
+	if ver >= version198 {
+		return
+	}
+
+	doReentrantDDL(s, "ALTER TABLE mysql.plan_replayer_task ADD COLUMN `capture_limit` INT UNSIGNED NOT NULL DEFAULT 0", infoschema.ErrColumnExists)
+	doReentrantDDL(s, "ALTER TABLE mysql.plan_replayer_task ADD COLUMN `captured_count` INT UNSIGNED NOT NULL DEFAULT 0", infoschema.ErrColumnExists)
+}
+
+func upgradeToVer199(s sessiontypes.Session, ver int64) {
+	if ver >= version199 {
+		return
+	}
+	doReentrantDDL(s, CreateDigestHintsTable)
+}
+
+func upgradeToVer200(s sessiontypes.Session, ver int64) {
+	if ver >= version200 {
+		return
+	}
+	doReentrantDDL(s, CreateAppDigestHintsTable)
+}
+
+func upgradeToVer201(s sessiontypes.Session, ver int64) {
+	if ver >= version201 {
+		return
+	}
+	doReentrantDDL(s, CreateImportConflictsTable)
+}
+
+func upgradeToVer202(s sessiontypes.Session, ver int64) {
+	if ver >= version202 {
+		return
+	}
+	doReentrantDDL(s, CreateTableAccessStatsTable)
+}
+
+func upgradeToVer203(s sessiontypes.Session, ver int64) {
+	if ver >= version203 {
+		return
+	}
+	doReentrantDDL(s, CreateSQLFirewallRulesTable)
+}
+
 func writeOOMAction(s sessiontypes.Session) {
 	comment := "oom-action is `log` by default in v3.0.x, `cancel` by default in v4.0.11+"
 	mustExecute(s, `INSERT HIGH_PRIORITY INTO %n.%n VALUES (%?, %?, %?) ON DUPLICATE KEY UPDATE VARIABLE_VALUE= %?`,
@@ -3234,6 +3368,16 @@ func doDDLWorks(s sessiontypes.Session) {
 	mustExecute(s, CreateSysSchema)
 	// create `sys.schema_unused_indexes` view
 	mustExecute(s, CreateSchemaUnusedIndexesView)
+	// create digest_hints
+	mustExecute(s, CreateDigestHintsTable)
+	// create app_digest_hints
+	mustExecute(s, CreateAppDigestHintsTable)
+	// create tidb_import_conflicts
+	mustExecute(s, CreateImportConflictsTable)
+	// create tidb_table_access_stats
+	mustExecute(s, CreateTableAccessStatsTable)
+	// create tidb_sql_firewall_rules
+	mustExecute(s, CreateSQLFirewallRulesTable)
 }
 
 // doBootstrapSQLFile executes SQL commands in a file as the last stage of bootstrap.
@@ -18,12 +18,14 @@ import (
 	"context"
 	"fmt"
 	"math"
+	"slices"
 	"strings"
 
 	"github.com/pingcap/errors"
 	"github.com/pingcap/failpoint"
 	"github.com/pingcap/tidb/pkg/domain"
 	"github.com/pingcap/tidb/pkg/errno"
+	"github.com/pingcap/tidb/pkg/expression"
 	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/format"
@@ -31,6 +33,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/opcode"
 	"github.com/pingcap/tidb/pkg/planner/core"
+	plannerutil "github.com/pingcap/tidb/pkg/planner/util"
 	session_metrics "github.com/pingcap/tidb/pkg/session/metrics"
 	sessiontypes "github.com/pingcap/tidb/pkg/session/types"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
@@ -40,6 +43,8 @@ import (
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/dbterror"
+	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
+	"github.com/pingcap/tidb/pkg/util/hint"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/pingcap/tidb/pkg/util/redact"
@@ -123,6 +128,149 @@ func HandleNonTransactionalDML(ctx context.Context, stmt *ast.NonTransactionalDM
 	return buildExecuteResults(ctx, jobs, se.GetSessionVars().BatchSize.MaxChunkSize, se.GetSessionVars().EnableRedactLog)
 }
 
+// NonTransactionalDMLFromHint converts a DELETE or UPDATE statement with the `batch_dml(size = n)` hint into the
+// equivalent non-transactional DML `BATCH LIMIT n DELETE/UPDATE ...`, so the clients which can't generate the BATCH
+// syntax can still split the statement. The EXECUTE statement of such a prepared DML is converted too, with the
+// parameters replaced by their values. It returns false if the statement doesn't have the hint.
+func NonTransactionalDMLFromHint(ctx context.Context, se sessiontypes.Session, stmt ast.StmtNode) (*ast.NonTransactionalDMLStmt, bool, error) {
+	execStmt, ok := stmt.(*ast.ExecuteStmt)
+	if !ok {
+		ntStmt, ok := nonTransactionalDMLFromHint(stmt)
+		return ntStmt, ok, nil
+	}
+	prepStmt, err := core.GetPreparedStmt(execStmt, se.GetSessionVars())
+	if err != nil || !hasBatchDMLHint(prepStmt.PreparedAst.Stmt) {
+		// Leave the error to the execution of the EXECUTE statement.
+		return nil, false, nil
+	}
+	// The cached AST of the prepared statement is shared by its executions, so a new one is parsed and the parameters
+	// are replaced by their values in it.
+	stmts, _, err := se.(sqlexec.SQLParser).ParseSQL(ctx, prepStmt.StmtText, se.GetSessionVars().GetParseParams()...)
+	if err != nil {
+		return nil, false, err
+	}
+	params, err := evalExecuteParams(se, execStmt)
+	if err != nil {
+		return nil, false, err
+	}
+	dml := stmts[0]
+	collector := &paramMarkerCollector{}
+	dml.Accept(collector)
+	if len(collector.markers) != len(params) {
+		return nil, false, errors.Trace(plannererrors.ErrWrongParamCount)
+	}
+	slices.SortFunc(collector.markers, func(a, b *driver.ParamMarkerExpr) int {
+		return a.Offset - b.Offset
+	})
+	for i, marker := range collector.markers {
+		marker.Datum = params[i]
+	}
+	dml.Accept(paramMarkerReplacer{})
+	dml.SetText(nil, execStmt.Text())
+	ntStmt, ok := nonTransactionalDMLFromHint(dml)
+	return ntStmt, ok, nil
+}
+
+func hasBatchDMLHint(stmt ast.StmtNode) bool {
+	var hints []*ast.TableOptimizerHint
+	switch s := stmt.(type) {
+	case *ast.DeleteStmt:
+		hints = s.TableHints
+	case *ast.UpdateStmt:
+		hints = s.TableHints
+	}
+	return slices.ContainsFunc(hints, func(h *ast.TableOptimizerHint) bool {
+		return h.HintName.L == hint.HintBatchDML
+	})
+}
+
+// evalExecuteParams evaluates the parameters of the EXECUTE statement or the binary protocol.
+func evalExecuteParams(se sessiontypes.Session, execStmt *ast.ExecuteStmt) ([]types.Datum, error) {
+	if execStmt.BinaryArgs != nil {
+		args := execStmt.BinaryArgs.([]expression.Expression)
+		params := make([]types.Datum, 0, len(args))
+		for _, arg := range args {
+			val, err := arg.Eval(se.GetExprCtx().GetEvalCtx(), chunk.Row{})
+			if err != nil {
+				return nil, err
+			}
+			params = append(params, val)
+		}
+		return params, nil
+	}
+	params := make([]types.Datum, 0, len(execStmt.UsingVars))
+	for _, expr := range execStmt.UsingVars {
+		val, err := plannerutil.EvalAstExprWithPlanCtx(se.GetPlanCtx(), expr)
+		if err != nil {
+			return nil, err
+		}
+		params = append(params, val)
+	}
+	return params, nil
+}
+
+type paramMarkerCollector struct {
+	markers []*driver.ParamMarkerExpr
+}
+
+// Enter implements ast.Visitor interface.
+func (c *paramMarkerCollector) Enter(in ast.Node) (ast.Node, bool) {
+	if marker, ok := in.(*driver.ParamMarkerExpr); ok {
+		c.markers = append(c.markers, marker)
+	}
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (*paramMarkerCollector) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// paramMarkerReplacer replaces the parameter markers by the values set into them.
+type paramMarkerReplacer struct{}
+
+// Enter implements ast.Visitor interface.
+func (paramMarkerReplacer) Enter(in ast.Node) (ast.Node, bool) {
+	return in, false
+}
+
+// Leave implements ast.Visitor interface.
+func (paramMarkerReplacer) Leave(in ast.Node) (ast.Node, bool) {
+	if marker, ok := in.(*driver.ParamMarkerExpr); ok {
+		return &marker.ValueExpr, true
+	}
+	return in, true
+}
+
+func nonTransactionalDMLFromHint(stmt ast.StmtNode) (*ast.NonTransactionalDMLStmt, bool) {
+	var (
+		hints *[]*ast.TableOptimizerHint
+		dml   ast.ShardableDMLStmt
+	)
+	switch s := stmt.(type) {
+	case *ast.DeleteStmt:
+		hints, dml = &s.TableHints, s
+	case *ast.UpdateStmt:
+		hints, dml = &s.TableHints, s
+	default:
+		return nil, false
+	}
+	for i, h := range *hints {
+		if h.HintName.L != hint.HintBatchDML {
+			continue
+		}
+		// Remove the hint, otherwise the split statements would carry it.
+		*hints = slices.Delete(*hints, i, i+1)
+		ntStmt := &ast.NonTransactionalDMLStmt{
+			DMLStmt: dml,
+			Limit:   h.HintData.(uint64),
+		}
+		ntStmt.SetText(nil, stmt.Text())
+		return ntStmt, true
+	}
+	return nil, false
+}
+
 // we require:
 // (1) in an update statement, shard column cannot be updated
 //
@@ -366,3 +366,41 @@ func TestNonTransactionalWithCheckConstraint(t *testing.T) {
 	err = tk.ExecToErr("batch limit 1 insert into t select * from (select 1, 2) tmp")
 	require.EqualError(t, err, "Non-transactional DML, table name not found in join")
 }
+
+func TestNonTransactionalDMLHint(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	tk.MustExec("create table t(a int primary key, b int)")
+	for i := 0; i < 10; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t values (%d, %d)", i, i))
+	}
+
+	tk.MustQuery("update /*+ batch_dml(size=3) */ t set b = b + 1").Check(testkit.Rows("4 all succeeded"))
+	tk.MustQuery("select sum(b) from t").Check(testkit.Rows("55"))
+	tk.MustQuery("delete /*+ BATCH_DML(SIZE = 4) */ from t where a < 8").Check(testkit.Rows("2 all succeeded"))
+	tk.MustQuery("select a from t").Check(testkit.Rows("8", "9"))
+
+	// The hint has the same constraints as the BATCH syntax.
+	tk.MustExec("begin")
+	err := tk.ExecToErr("delete /*+ batch_dml(size=1) */ from t")
+	require.ErrorContains(t, err, "non-transactional DML can only run in auto-commit mode")
+	tk.MustExec("rollback")
+	err = tk.ExecToErr("delete /*+ batch_dml(size=1) */ from t order by a")
+	require.ErrorContains(t, err, "Non-transactional statements don't support order by")
+	tk.MustQuery("select a from t").Check(testkit.Rows("8", "9"))
+
+	// The prepared statements are split too, with the parameters replaced by their values.
+	for i := 0; i < 8; i++ {
+		tk.MustExec(fmt.Sprintf("insert into t values (%d, %d)", i, i))
+	}
+	tk.MustExec("prepare stmt from 'update /*+ batch_dml(size=3) */ t set b = b + ? where a < ?'")
+	tk.MustExec("set @a = 10, @b = 8")
+	tk.MustQuery("execute stmt using @a, @b").Check(testkit.Rows("3 all succeeded"))
+	tk.MustQuery("select sum(b) from t where a < 8").Check(testkit.Rows("108"))
+	tk.MustQuery("execute stmt using @a, @b").Check(testkit.Rows("3 all succeeded"))
+	tk.MustQuery("select sum(b) from t where a < 8").Check(testkit.Rows("188"))
+	// The statement executed by the binary protocol.
+	tk.MustQuery("delete /*+ batch_dml(size=5) */ from t where a < ?", 8).Check(testkit.Rows("2 all succeeded"))
+	tk.MustQuery("select a from t").Check(testkit.Rows("8", "9"))
+}
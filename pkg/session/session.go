@@ -103,6 +103,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/chunk"
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/dbterror"
+	"github.com/pingcap/tidb/pkg/util/dbterror/exeerrors"
 	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
 	"github.com/pingcap/tidb/pkg/util/execdetails"
 	"github.com/pingcap/tidb/pkg/util/intest"
@@ -112,10 +113,12 @@ import (
 	"github.com/pingcap/tidb/pkg/util/memory"
 	rangerctx "github.com/pingcap/tidb/pkg/util/ranger/context"
 	"github.com/pingcap/tidb/pkg/util/redact"
+	"github.com/pingcap/tidb/pkg/util/rowchange"
 	"github.com/pingcap/tidb/pkg/util/sem"
 	"github.com/pingcap/tidb/pkg/util/sli"
 	"github.com/pingcap/tidb/pkg/util/sqlescape"
 	"github.com/pingcap/tidb/pkg/util/sqlexec"
+	"github.com/pingcap/tidb/pkg/util/tableutil"
 	"github.com/pingcap/tidb/pkg/util/timeutil"
 	"github.com/pingcap/tidb/pkg/util/topsql"
 	topsqlstate "github.com/pingcap/tidb/pkg/util/topsql/state"
@@ -668,8 +671,9 @@ func (s *session) commitTxnWithTemporaryData(ctx context.Context, txn kv.Transac
 
 	sessionData := sessVars.TemporaryTableData
 	var (
-		stage           kv.StagingHandle
-		localTempTables *infoschema.SessionTables
+		stage               kv.StagingHandle
+		localTempTables     *infoschema.SessionTables
+		committedTempTables []tableutil.TempTable
 	)
 
 	if sessVars.LocalTemporaryTables != nil {
@@ -700,6 +704,7 @@ func (s *session) commitTxnWithTemporaryData(ctx context.Context, txn kv.Transac
 		if stage == kv.InvalidStagingHandle {
 			stage = sessionData.Staging()
 		}
+		committedTempTables = append(committedTempTables, tbl)
 
 		tblPrefix := tablecodec.EncodeTablePrefix(tblID)
 		endKey := tablecodec.EncodeTablePrefix(tblID + 1)
@@ -743,6 +748,10 @@ func (s *session) commitTxnWithTemporaryData(ctx context.Context, txn kv.Transac
 		sessionData.Release(stage)
 		stage = kv.InvalidStagingHandle
 	}
+	// The stats of the local temporary tables are kept across transactions once the data is committed.
+	for _, tbl := range committedTempTables {
+		sessionData.SetTableStats(tbl.GetMeta().ID, tbl.GetStats())
+	}
 
 	return nil
 }
@@ -920,6 +929,16 @@ func (s *session) CommitTxn(ctx context.Context) error {
 		s.sessionVars.StmtCtx.MergeExecDetails(nil, commitDetail)
 	}
 
+	if stmts, truncated := s.sessionVars.RowChanges.TakeTxnChanges(); err == nil && (len(stmts) > 0 || truncated) {
+		s.extensions.OnRowChangeEvent(&extension.RowChangeEvent{
+			ConnectionID: s.sessionVars.ConnectionID,
+			User:         s.sessionVars.User,
+			StartTS:      s.sessionVars.TxnCtx.StartTS,
+			Stmts:        stmts,
+			Truncated:    truncated,
+		})
+	}
+
 	// record the TTLInsertRows in the metric
 	metrics.TTLInsertRowsCount.Add(float64(s.sessionVars.TxnCtx.InsertTTLRowsCount))
 
@@ -944,6 +963,7 @@ func (s *session) RollbackTxn(ctx context.Context) {
 		s.cleanRetryInfo()
 	}
 	s.txn.changeToInvalid()
+	s.sessionVars.RowChanges.Reset()
 	s.sessionVars.TxnCtx.Cleanup()
 	s.sessionVars.CleanupTxnReadTSIfUsed()
 	s.sessionVars.SetInTxn(false)
@@ -1071,6 +1091,8 @@ func (s *session) retry(ctx context.Context, maxCnt uint) (err error) {
 			return err
 		}
 		s.sessionVars.RetryInfo.ResetOffset()
+		// the row changes are collected again when the statements are re-executed.
+		s.sessionVars.RowChanges.Reset()
 		for i, sr := range nh.history {
 			st := sr.st
 			s.sessionVars.StmtCtx = sr.stmtCtx
@@ -1443,6 +1465,7 @@ func (s *session) SetProcessInfo(sql string, t time.Time, command byte, maxExecu
 		RedactSQL:             s.sessionVars.EnableRedactLog,
 		ResourceGroupName:     s.sessionVars.StmtCtx.ResourceGroupName,
 		SessionAlias:          s.sessionVars.SessionAlias,
+		AppName:               s.sessionVars.AppName,
 	}
 	oldPi := s.ShowProcess()
 	if p == nil {
@@ -1733,6 +1756,43 @@ func (s *session) GetExtensions() *extension.SessionExtensions {
 // SetExtensions sets the `*extension.SessionExtensions` object
 func (s *session) SetExtensions(extensions *extension.SessionExtensions) {
 	s.extensions = extensions
+	if extensions.HasRowChangeListeners() {
+		s.sessionVars.RowChanges = rowchange.NewBuffer(s.subscribeRowChanges)
+	} else {
+		s.sessionVars.RowChanges = nil
+	}
+}
+
+// subscribeRowChanges returns the database name of the table and whether its row changes are subscribed by the
+// extensions.
+func (s *session) subscribeRowChanges(tblInfo *model.TableInfo) (string, bool) {
+	is := sessiontxn.GetTxnManager(s).GetTxnInfoSchema()
+	if is == nil {
+		return "", false
+	}
+	dbInfo, ok := infoschema.SchemaByTable(is, tblInfo)
+	if !ok {
+		return "", false
+	}
+	return dbInfo.Name.O, s.extensions.SubscribeRowChanges(dbInfo.Name.O, tblInfo.Name.O)
+}
+
+// newRowChangeStmt returns the metadata of the statement attached to its row changes.
+func newRowChangeStmt(sc *stmtctx.StatementContext) *rowchange.Stmt {
+	normalized, digest := sc.SQLDigest()
+	stmt := &rowchange.Stmt{
+		NormalizedSQL: normalized,
+		Hints:         sc.OriginalHints,
+		BindSQL:       sc.BindSQL,
+		BindHints:     sc.BindHints,
+	}
+	if digest != nil {
+		stmt.SQLDigest = digest.String()
+	}
+	if normalizedPlan, planDigest := executor.GetPlanDigest(sc); len(normalizedPlan) > 0 && planDigest != nil {
+		stmt.PlanDigest = planDigest.String()
+	}
+	return stmt
 }
 
 // InSandBoxMode indicates that this session is in sandbox mode
@@ -1999,6 +2059,51 @@ func (s *session) ExecuteInternalStmt(ctx context.Context, stmtNode ast.StmtNode
 }
 
 func (s *session) ExecuteStmt(ctx context.Context, stmtNode ast.StmtNode) (sqlexec.RecordSet, error) {
+	rs, err := s.executeStmt(ctx, stmtNode)
+	if err == nil || !s.shouldRetryInBulkMode(stmtNode, err) {
+		return rs, err
+	}
+	// The statement has been rolled back, so it's safe to retry it as a pipelined DML, which flushes the mutations
+	// to TiKV in chunks during execution and makes all of them visible atomically by committing the primary key.
+	logutil.Logger(ctx).Info("the auto-committed DML exceeds the transaction size limit, retry it in bulk mode",
+		zap.Uint64("conn", s.sessionVars.ConnectionID))
+	s.sessionVars.BulkDMLEnabled = true
+	defer func() {
+		s.sessionVars.BulkDMLEnabled = false
+	}()
+	rs, err = s.executeStmt(ctx, stmtNode)
+	if err == nil {
+		s.sessionVars.StmtCtx.AppendWarning(errors.New("The statement exceeds the transaction size limit and is retried in bulk mode"))
+	}
+	return rs, err
+}
+
+// shouldRetryInBulkMode returns whether the statement failed for exceeding the transaction size limit should be
+// retried in bulk mode, which is only possible for the auto-committed DML when tidb_dml_type is AUTO.
+func (s *session) shouldRetryInBulkMode(stmtNode ast.StmtNode, err error) bool {
+	vars := s.sessionVars
+	if !vars.AutoBulkDMLEnabled || vars.BulkDMLEnabled || !kv.ErrTxnTooLarge.Equal(err) {
+		return false
+	}
+	if s.isInternal() || vars.InTxn() || !vars.IsAutocommit() {
+		return false
+	}
+	if execStmt, ok := stmtNode.(*ast.ExecuteStmt); ok {
+		// The prepared statement is retried by executing it again, so check the statement it refers to.
+		prepStmt, err := plannercore.GetPreparedStmt(execStmt, vars)
+		if err != nil {
+			return false
+		}
+		stmtNode = prepStmt.PreparedAst.Stmt
+	}
+	switch stmtNode.(type) {
+	case *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+		return true
+	}
+	return false
+}
+
+func (s *session) executeStmt(ctx context.Context, stmtNode ast.StmtNode) (sqlexec.RecordSet, error) {
 	r, ctx := tracing.StartRegionEx(ctx, "session.ExecuteStmt")
 	defer r.End()
 
@@ -2050,6 +2155,17 @@ func (s *session) ExecuteStmt(ctx context.Context, stmtNode ast.StmtNode) (sqlex
 	if sessVars.StmtCtx.EnableOptimizerDebugTrace {
 		plannercore.DebugTraceReceivedCommand(s.pctx, cmdByte, stmtNode)
 	}
+	if dom := domain.GetDomain(s); dom != nil && dom.SQLFirewall() != nil && !sessVars.InRestrictedSQL &&
+		ctx.Value(sqlFirewallRewrittenKey{}) == nil {
+		rewritten, err := s.checkSQLFirewall(ctx, dom.SQLFirewall(), stmtNode, normalizedSQL, digest.String())
+		if err != nil {
+			return nil, err
+		}
+		if rewritten != nil {
+			// The rewritten statement isn't checked again, so the replacement is always executed.
+			return s.executeStmt(context.WithValue(ctx, sqlFirewallRewrittenKey{}, struct{}{}), rewritten)
+		}
+	}
 
 	if err := s.validateStatementInTxn(stmtNode); err != nil {
 		return nil, err
@@ -2180,6 +2296,46 @@ func (s *session) onTxnManagerStmtStartOrRetry(ctx context.Context, node ast.Stm
 	return sessiontxn.GetTxnManager(s).OnStmtStart(ctx, node)
 }
 
+// sqlFirewallRewrittenKey is the context key to mark the statement rewritten by the SQL firewall.
+type sqlFirewallRewrittenKey struct{}
+
+// checkSQLFirewall applies the SQL firewall to the queries and the DMLs. It returns the statement to execute instead
+// if the statement is rewritten by a REWRITE rule. The EXECUTE statements are never rewritten, since the replacement
+// can't take the parameters.
+func (s *session) checkSQLFirewall(ctx context.Context, firewall *domain.SQLFirewall, stmtNode ast.StmtNode, normalizedSQL, digest string) (ast.StmtNode, error) {
+	node := stmtNode
+	execStmt, isExecute := stmtNode.(*ast.ExecuteStmt)
+	if isExecute {
+		prepareStmt, err := plannercore.GetPreparedStmt(execStmt, s.sessionVars)
+		if err != nil || prepareStmt.PreparedAst == nil {
+			return nil, nil
+		}
+		node = prepareStmt.PreparedAst.Stmt
+	}
+	switch node.(type) {
+	case *ast.SelectStmt, *ast.SetOprStmt, *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt, *ast.LoadDataStmt:
+	default:
+		return nil, nil
+	}
+	decision := firewall.Check(normalizedSQL, digest)
+	if decision.Blocked {
+		logutil.Logger(ctx).Info("the statement is blocked by the SQL firewall", zap.Uint64("conn", s.sessionVars.ConnectionID),
+			zap.String("sqlDigest", digest), zap.Int64("ruleID", decision.RuleID))
+		return nil, exeerrors.ErrSQLFirewallBlocked.FastGenByArgs(digest)
+	}
+	if decision.Replacement == "" || isExecute {
+		return nil, nil
+	}
+	stmts, _, err := s.ParseSQL(ctx, decision.Replacement, s.sessionVars.GetParseParams()...)
+	if err != nil {
+		return nil, errors.Annotatef(err, "parse the replacement of the SQL firewall rule %d", decision.RuleID)
+	}
+	if len(stmts) != 1 {
+		return nil, errors.Errorf("the replacement of the SQL firewall rule %d must be a single statement", decision.RuleID)
+	}
+	return stmts[0], nil
+}
+
 func (s *session) validateStatementInTxn(stmtNode ast.StmtNode) error {
 	vars := s.GetSessionVars()
 	if _, ok := stmtNode.(*ast.ImportIntoStmt); ok && vars.InTxn() {
@@ -2444,6 +2600,13 @@ func (s *session) ExecutePreparedStmt(ctx context.Context, stmtID uint32, params
 		BinaryArgs: params,
 		PrepStmt:   stmt,
 	}
+	ntStmt, ok, err := NonTransactionalDMLFromHint(ctx, s, execStmt)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return HandleNonTransactionalDML(ctx, ntStmt, s)
+	}
 	return s.ExecuteStmt(ctx, execStmt)
 }
 
@@ -2579,6 +2742,7 @@ func (s *session) GetDistSQLCtx() *distsqlctx.DistSQLContext {
 
 			DistSQLConcurrency:            vars.DistSQLScanConcurrency(),
 			ReplicaReadType:               vars.GetReplicaRead(),
+			MatchStoreLabels:              sc.ReadLocalityLabels,
 			WeakConsistency:               sc.WeakConsistency,
 			RCCheckTS:                     sc.RCCheckTS,
 			NotFillCache:                  sc.NotFillCache,
@@ -2591,14 +2755,18 @@ func (s *session) GetDistSQLCtx() *distsqlctx.DistSQLContext {
 			RequestSourceType:             vars.RequestSourceType,
 			ExplicitRequestSourceType:     vars.ExplicitRequestSourceType,
 			StoreBatchSize:                vars.StoreBatchSize,
+			AdaptiveDistSQLConcurrency:    vars.EnableAdaptiveDistSQLScanConcurrency,
 			ResourceGroupName:             sc.ResourceGroupName,
 			LoadBasedReplicaReadThreshold: vars.LoadBasedReplicaReadThreshold,
 			RunawayChecker:                sc.RunawayChecker,
 			TiKVClientReadTimeout:         vars.GetTiKVClientReadTimeout(),
+			RegionErrorMaxRetries:         vars.RegionErrorMaxRetries,
+			RegionErrorRetries:            &sc.RegionErrorRetries,
 
 			ReplicaClosestReadThreshold: vars.ReplicaClosestReadThreshold,
 			ConnectionID:                vars.ConnectionID,
 			SessionAlias:                vars.SessionAlias,
+			ExplainAnalyzeTopRegions:    vars.ExplainAnalyzeTopRegions,
 
 			ExecDetails: &sc.SyncExecDetails,
 		}
@@ -2619,7 +2787,7 @@ func (s *session) GetRangerCtx() *rangerctx.RangerContext {
 			InPreparedPlanBuilding:   s.GetSessionVars().StmtCtx.InPreparedPlanBuilding,
 			RegardNULLAsPoint:        s.GetSessionVars().RegardNULLAsPoint,
 			OptPrefixIndexSingleScan: s.GetSessionVars().OptPrefixIndexSingleScan,
-			OptimizerFixControl:      s.GetSessionVars().OptimizerFixControl,
+			OptimizerFixControl:      s.GetSessionVars().GetOptimizerFixControlMap(),
 
 			PlanCacheTracker:     &s.GetSessionVars().StmtCtx.PlanCacheTracker,
 			RangeFallbackHandler: &s.GetSessionVars().StmtCtx.RangeFallbackHandler,
@@ -3941,7 +4109,8 @@ func logStmt(execStmt *executor.ExecStmt, s *session) {
 	case *ast.CreateUserStmt, *ast.DropUserStmt, *ast.AlterUserStmt, *ast.SetPwdStmt, *ast.GrantStmt,
 		*ast.RevokeStmt, *ast.AlterTableStmt, *ast.CreateDatabaseStmt, *ast.CreateTableStmt,
 		*ast.DropDatabaseStmt, *ast.DropTableStmt, *ast.RenameTableStmt, *ast.TruncateTableStmt,
-		*ast.RenameUserStmt:
+		*ast.RenameUserStmt, *ast.CreateRowPolicyStmt, *ast.DropRowPolicyStmt,
+		*ast.CreateMaskingPolicyStmt, *ast.DropMaskingPolicyStmt:
 		isCrucial = true
 	}
 
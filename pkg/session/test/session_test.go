@@ -29,7 +29,9 @@ import (
 	"github.com/pingcap/kvproto/pkg/kvrpcpb"
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/domain"
+	"github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/format"
 	"github.com/pingcap/tidb/pkg/parser/model"
@@ -402,7 +404,7 @@ func TestStmtHints(t *testing.T) {
 	val = int64(0)
 	require.Len(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings(), 1)
 	require.True(t, tk.Session().GetSessionVars().MemTracker.CheckBytesLimit(val))
-	require.EqualError(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings()[0].Err, "Setting the MEMORY_QUOTA to 0 means no memory limit")
+	require.EqualError(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings()[0].Err, "[planner:8073]Setting the MEMORY_QUOTA to 0 means no memory limit")
 
 	tk.MustExec("use test")
 	tk.MustExec("create table t1(a int);")
@@ -450,7 +452,7 @@ func TestStmtHints(t *testing.T) {
 	require.True(t, tk.Session().GetSessionVars().GetEnableCascadesPlanner())
 	tk.MustExec("select /*+ USE_CASCADES(false), USE_CASCADES(true) */ 1;")
 	require.Len(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings(), 1)
-	require.EqualError(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings()[0].Err, "USE_CASCADES() is defined more than once, only the last definition takes effect: USE_CASCADES(true)")
+	require.EqualError(t, tk.Session().GetSessionVars().StmtCtx.GetWarnings()[0].Err, "[planner:3126]USE_CASCADES() is defined more than once, only the last definition takes effect: USE_CASCADES(true)")
 	require.True(t, tk.Session().GetSessionVars().GetEnableCascadesPlanner())
 
 	// Test READ_CONSISTENT_REPLICA hint
@@ -716,6 +718,56 @@ func TestRequestSource(t *testing.T) {
 	tk.MustQueryWithContext(selectCtx, "select b from t where a in (1, 2, 3);")
 }
 
+func TestSQLFirewall(t *testing.T) {
+	store, dom := testkit.CreateMockStoreAndDomain(t)
+	tk := testkit.NewTestKit(t, store)
+	defer tk.MustExec("set global tidb_sql_firewall_mode = default")
+	firewall := dom.SQLFirewall()
+	tk.MustExec("use test")
+	tk.MustExec("create table t (a int)")
+	tk.MustExec("insert into t values (0), (1), (2)")
+
+	// The unmatched digests are learned as ALLOW rules in the learning mode.
+	tk.MustExec("set global tidb_sql_firewall_mode = 'LEARNING'")
+	tk.MustQuery("select * from t where a = 1").Check(testkit.Rows("1"))
+	require.NoError(t, firewall.FlushLearned())
+	require.NoError(t, firewall.Reload())
+	normalized, digest := parser.NormalizeDigest("select * from t where a = 1")
+	tk.MustQuery("select sql_digest, action, source, normalized_sql from mysql.tidb_sql_firewall_rules").Check(testkit.Rows(
+		fmt.Sprintf("%s ALLOW LEARNED %s", digest.String(), normalized)))
+
+	// The statements not matching any ALLOW rule are blocked in the ALLOW mode, but the other statements aren't.
+	tk.MustExec("set global tidb_sql_firewall_mode = 'ALLOW'")
+	tk.MustQuery("select * from t where a = 2").Check(testkit.Rows("2"))
+	tk.MustGetErrCode("select * from t where a > 1", errno.ErrSQLFirewallBlocked)
+	tk.MustGetErrCode("delete from t", errno.ErrSQLFirewallBlocked)
+	tk.MustQuery("show tables").Check(testkit.Rows("t"))
+
+	// The DENY rules are preferred to the ALLOW rules, and they also block the prepared statements.
+	tk.MustExec("set global tidb_sql_firewall_mode = 'DENY'")
+	tk.MustQuery("select * from t where a > 1").Check(testkit.Rows("2"))
+	tk.MustExec("insert into mysql.tidb_sql_firewall_rules (sql_pattern, action) values ('select * from `t` where `a` %', 'DENY')")
+	require.NoError(t, firewall.Reload())
+	tk.MustGetErrCode("select * from t where a = 1", errno.ErrSQLFirewallBlocked)
+	tk.MustExec("prepare stmt from 'select * from t where a > ?'")
+	tk.MustExec("set @a = 1")
+	tk.MustGetErrCode("execute stmt using @a", errno.ErrSQLFirewallBlocked)
+	tk.MustQuery("select count(*) from t").Check(testkit.Rows("3"))
+
+	// The statements matching the REWRITE rules are replaced.
+	tk.MustExec("delete from mysql.tidb_sql_firewall_rules where action = 'DENY'")
+	tk.MustExec("update mysql.tidb_sql_firewall_rules set action = 'REWRITE', replacement = 'select * from t where a = 0'")
+	require.NoError(t, firewall.Reload())
+	tk.MustQuery("select * from t where a = 1").Check(testkit.Rows("0"))
+	tk.MustQuery("select * from t where a > 1").Check(testkit.Rows("2"))
+
+	// Nothing is blocked in the learning mode.
+	tk.MustExec("set global tidb_sql_firewall_mode = 'LEARNING'")
+	tk.MustQuery("select * from t where a = 1").Check(testkit.Rows("1"))
+	tk.MustExec("set global tidb_sql_firewall_mode = 'OFF'")
+	tk.MustQuery("select * from t where a = 1").Check(testkit.Rows("1"))
+}
+
 func TestEmptyInitSQLFile(t *testing.T) {
 	// A non-existent sql file would stop the bootstrap of the tidb cluster
 	store, err := mockstore.NewMockStore(mockstore.WithStoreType(mockstore.EmbedUnistore))
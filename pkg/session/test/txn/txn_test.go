@@ -508,3 +508,39 @@ func TestInTrans(t *testing.T) {
 	tk.MustExec("rollback")
 	require.False(t, txn.Valid())
 }
+
+func TestStmtWriteConflictRetryPolicy(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+	tk1 := testkit.NewTestKit(t, store)
+	tk2 := testkit.NewTestKit(t, store)
+	// The statements summary skips the statements of the empty user.
+	require.NoError(t, tk1.Session().Auth(&auth.UserIdentity{Username: "root", Hostname: "%"}, nil, nil, nil))
+	tk1.MustExec("use test")
+	tk2.MustExec("use test")
+	tk1.MustExec("create table t (id int primary key, v int)")
+	tk1.MustExec("insert into t values (1, 1)")
+	tk1.MustExec("set tidb_pessimistic_txn_fair_locking = off")
+
+	// the statement isn't retried on the write conflict.
+	tk1.MustExec("begin pessimistic")
+	tk1.MustQuery("select * from t where id = 1").Check(testkit.Rows("1 1"))
+	tk2.MustExec("update t set v = v + 1 where id = 1")
+	tk1.MustGetErrMsg("update /*+ SET_VAR(tidb_write_conflict_max_retries=0) */ t set v = v + 10 where id = 1",
+		"pessimistic lock retry limit reached")
+	tk1.MustExec("rollback")
+
+	// the statement is retried with the backoff, and the retries are reported in the runtime stats.
+	tk1.MustExec("begin pessimistic")
+	tk1.MustQuery("select * from t where id = 1").Check(testkit.Rows("1 2"))
+	tk2.MustExec("update t set v = v + 1 where id = 1")
+	tk1.MustExec("update /*+ SET_VAR(tidb_write_conflict_max_retries=1) SET_VAR(tidb_write_conflict_retry_backoff=10) */ t set v = 100 + v where id = 1")
+	tk1.MustExec("commit")
+	tk1.MustQuery("select * from t where id = 1").Check(testkit.Rows("1 103"))
+	rows := tk1.MustQuery("select sum_exec_retry, plan from information_schema.statements_summary where query_sample_text like '%100 + v%'").Rows()
+	require.Len(t, rows, 1)
+	require.Equal(t, "1", rows[0][0])
+	require.Contains(t, rows[0][1], "retry:{write_conflict:1, region_error:0}")
+
+	// the variables are restored after the statements.
+	tk1.MustQuery("select @@tidb_write_conflict_max_retries, @@tidb_write_conflict_retry_backoff").Check(testkit.Rows("-1 0"))
+}
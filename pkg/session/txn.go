@@ -748,6 +748,14 @@ func (s *session) StmtCommit(ctx context.Context) {
 		mutation := getBinlogMutation(s, tableID)
 		mergeToMutation(mutation, delta)
 	}
+
+	if rowChanges := s.sessionVars.RowChanges; rowChanges.HasStmtRows() {
+		rowChanges.StmtCommit(newRowChangeStmt(s.sessionVars.StmtCtx))
+	}
+
+	for _, tempTable := range s.sessionVars.TxnCtx.TemporaryTables {
+		tempTable.StmtCommitStats()
+	}
 }
 
 // StmtRollback implements the sessionctx.Context interface.
@@ -758,6 +766,11 @@ func (s *session) StmtRollback(ctx context.Context, isForPessimisticRetry bool)
 		logutil.Logger(ctx).Error("txnManager failed to handle OnStmtRollback", zap.Error(err))
 	}
 	s.txn.cleanup()
+	s.sessionVars.RowChanges.StmtRollback()
+	// The stats of the temporary tables are updated by the written rows, so they are rolled back with the rows.
+	for _, tempTable := range s.sessionVars.TxnCtx.TemporaryTables {
+		tempTable.StmtRollbackStats()
+	}
 }
 
 // StmtGetMutation implements the sessionctx.Context interface.
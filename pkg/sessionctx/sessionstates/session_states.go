@@ -85,6 +85,7 @@ type SessionStates struct {
 	// Define it as string to avoid cycle import.
 	Bindings            string                                            `json:"bindings,omitempty"`
 	ResourceGroupName   string                                            `json:"rs-group,omitempty"`
+	AppName             string                                            `json:"app-name,omitempty"`
 	HypoIndexes         map[string]map[string]map[string]*model.IndexInfo `json:"hypo-indexes,omitempty"`
 	HypoTiFlashReplicas map[string]map[string]struct{}                    `json:"hypo-tiflash-replicas,omitempty"`
 }
@@ -643,6 +643,16 @@ func TestSessionCtx(t *testing.T) {
 				tk.MustQuery(`select @@tidb_request_source_type`).Check(testkit.Rows("lightning"))
 			},
 		},
+		{
+			// check AppName
+			setFunc: func(tk *testkit.TestKit) any {
+				tk.Session().GetSessionVars().AppName = "app1"
+				return nil
+			},
+			checkFunc: func(tk *testkit.TestKit, param any) {
+				require.Equal(t, "app1", tk.Session().GetSessionVars().AppName)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -26,6 +26,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/pingcap/errors"
 	distsqlctx "github.com/pingcap/tidb/pkg/distsql/context"
 	"github.com/pingcap/tidb/pkg/domain/resourcegroup"
 	"github.com/pingcap/tidb/pkg/errctx"
@@ -210,6 +211,9 @@ type StatementContext struct {
 		// extraWarnings would not be printed through SHOW WARNINGS, but we want to always output them through the slow
 		// log to help diagnostics, so we store them here separately.
 		extraWarnings []SQLWarn
+		// hintWarnings records the hint warnings by their messages, or by their codes, hints and tables if they're
+		// set for the partitions or the query blocks, it's used to aggregate the repeated hint warnings into one.
+		hintWarnings map[hintWarningKey]*repeatedHintWarning
 	}
 	execdetails.SyncExecDetails
 
@@ -249,6 +253,10 @@ type StatementContext struct {
 	// BindSQL used to construct the key for plan cache. It records the binding used by the stmt.
 	// If the binding is not used by the stmt, the value is empty
 	BindSQL string
+	// BindHints is the canonical hint set of the binding used by the stmt, it's empty if the binding is not used.
+	BindHints string
+	// OriginalHints is the restored text of the optimizer hints specified in the stmt, it's empty if no hint is specified.
+	OriginalHints string
 
 	// The several fields below are mainly for some diagnostic features, like stmt summary and slow query.
 	// We cache the values here to avoid calculating them multiple times.
@@ -305,6 +313,12 @@ type StatementContext struct {
 
 	// InVerboseExplain indicates the statement is "explain format='verbose' ...".
 	InVerboseExplain bool
+	// HintUsages records whether the hints of each query block are matched, it's only collected
+	// for the "Hints In Use" section of explain statements.
+	HintUsages []hint.HintUsage
+	// HintMatchTraces records how the hints are matched with the tables, it's only collected when
+	// `tidb_opt_trace_hints` is enabled.
+	HintMatchTraces []string
 
 	// EnableOptimizeTrace indicates whether enable optimizer trace by 'trace plan statement'
 	EnableOptimizeTrace bool
@@ -354,6 +368,11 @@ type StatementContext struct {
 	IsSQLAndPlanRegistered atomic2.Bool
 	// IsReadOnly uses to indicate whether the SQL is read-only.
 	IsReadOnly bool
+	// WriteConflictRetries is the number of times the statement is retried on the write conflicts.
+	WriteConflictRetries atomic2.Int64
+	// RegionErrorRetries is the number of times the coprocessor requests of the statement are retried on the region
+	// errors.
+	RegionErrorRetries atomic2.Int64
 	// usedStatsInfo records version of stats of each table used in the query.
 	// It's a map of table physical id -> *UsedStatsInfoForTable
 	usedStatsInfo atomic.Pointer[UsedStatsInfo]
@@ -401,6 +420,9 @@ type StatementContext struct {
 
 	// MDLRelatedTableIDs is used to store the table IDs that are related to the current MDL lock.
 	MDLRelatedTableIDs map[int64]struct{}
+
+	// Reoptimize records the states of the adaptive re-optimization of the statement.
+	Reoptimize ReoptimizeInfo
 }
 
 var defaultErrLevels = func() (l errctx.LevelMap) {
@@ -718,12 +740,100 @@ const (
 
 // SetHintWarning sets the hint warning and records the reason.
 func (sc *StatementContext) SetHintWarning(reason string) {
-	sc.AppendWarning(plannererrors.ErrInternal.FastGen(reason))
+	sc.SetHintWarningFromError(plannererrors.ErrInternal.FastGen(reason))
 }
 
-// SetHintWarningFromError sets the hint warning and records the reason.
+// SetHintWarningFromError sets the hint warning and records the reason. If the same warning has been set by the
+// statement, it's aggregated into the existing one with the number of the repetitions instead.
 func (sc *StatementContext) SetHintWarningFromError(reason error) {
-	sc.AppendWarning(reason)
+	sc.setHintWarning(reason, hintWarningKey{msg: reason.Error()}, "")
+}
+
+// SetHintWarningForScope sets the hint warning for a partition or a query block of a table. The warnings with the
+// same code of the same hint on the same table are aggregated into one warning with the number of the partitions or
+// the query blocks, e.g. "use_index hint is inapplicable for 32 partitions of t", no matter whether their messages
+// differ by the partition or query block names.
+func (sc *StatementContext) SetHintWarningForScope(reason error, scope hint.WarningScope) {
+	key := hintWarningKey{hint: scope.Hint, table: scope.Table, kind: scope.Kind}
+	if terr, ok := errors.Cause(reason).(*terror.Error); ok {
+		key.code = terr.Code()
+	} else {
+		key.msg = reason.Error()
+	}
+	sc.setHintWarning(reason, key, scope.Name)
+}
+
+func (sc *StatementContext) setHintWarning(reason error, key hintWarningKey, scopeName string) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if w, ok := sc.mu.hintWarnings[key]; ok && w.idx < len(sc.mu.warnings) && sc.mu.warnings[w.idx].Err.Error() == w.msg {
+		if key.kind != "" {
+			if _, ok := w.scopes[scopeName]; ok {
+				return
+			}
+			w.scopes[scopeName] = struct{}{}
+		}
+		w.count++
+		err := w.aggregate(key)
+		sc.mu.warnings[w.idx].Err = err
+		w.msg = err.Error()
+		return
+	}
+	if len(sc.mu.warnings) >= math.MaxUint16 {
+		return
+	}
+	if sc.mu.hintWarnings == nil {
+		sc.mu.hintWarnings = make(map[hintWarningKey]*repeatedHintWarning)
+	}
+	w := &repeatedHintWarning{origin: reason, msg: reason.Error(), idx: len(sc.mu.warnings), count: 1}
+	if key.kind != "" {
+		w.scopes = map[string]struct{}{scopeName: {}}
+	}
+	sc.mu.hintWarnings[key] = w
+	sc.mu.warnings = append(sc.mu.warnings, SQLWarn{Level: contextutil.WarnLevelWarning, Err: reason})
+}
+
+// hintWarningKey identifies the hint warnings which are aggregated into one. The warnings without scopes are
+// identified by their messages, and the warnings with scopes are identified by their codes, hints and tables.
+type hintWarningKey struct {
+	msg   string
+	code  errors.ErrCode
+	hint  string
+	table string
+	kind  string
+}
+
+// repeatedHintWarning is a hint warning which may be set repeatedly by a statement.
+type repeatedHintWarning struct {
+	origin error
+	// msg is the current message of the warning, it's used to check whether the warning is still in the warnings.
+	msg   string
+	idx   int
+	count int
+	// scopes are the names of the partitions or the query blocks which the warning has been set for.
+	scopes map[string]struct{}
+}
+
+func (w *repeatedHintWarning) aggregate(key hintWarningKey) error {
+	terr, isTErr := errors.Cause(w.origin).(*terror.Error)
+	msg := w.origin.Error()
+	if isTErr {
+		msg = terr.GetMsg()
+	}
+	if key.kind != "" {
+		on := ""
+		if key.table != "" {
+			on = " of " + key.table
+		}
+		if isTErr {
+			return terr.FastGen("%s hint is inapplicable for %d %s%s: %s", key.hint, len(w.scopes), key.kind, on, msg)
+		}
+		return fmt.Errorf("%s hint is inapplicable for %d %s%s: %s", key.hint, len(w.scopes), key.kind, on, msg)
+	}
+	if isTErr {
+		return terr.FastGen("%s (repeated %d times)", msg, w.count)
+	}
+	return fmt.Errorf("%s (repeated %d times)", msg, w.count)
 }
 
 // TableEntry presents table in db.
@@ -1385,6 +1495,104 @@ func (u *UsedStatsInfo) Values() []*UsedStatsInfoForTable {
 	return ret
 }
 
+// ReoptimizeKey returns the key to identify a data source in the adaptive re-optimization.
+func ReoptimizeKey(physicalTableID int64, tableAsName string) string {
+	return fmt.Sprintf("%d_%s", physicalTableID, tableAsName)
+}
+
+// ReoptimizeInfo records the states of the adaptive re-optimization, which re-optimizes a statement with the
+// observed row counts when the actual row count of a data source exceeds its estimation too much.
+// It's accessed by the readers concurrently, so all the states are protected by the mutex.
+type ReoptimizeInfo struct {
+	mu struct {
+		sync.Mutex
+		// enabled indicates whether the readers should check their actual row counts.
+		enabled bool
+		// requested indicates whether a reader has requested to re-optimize the statement.
+		requested bool
+		// reoptimized indicates whether the statement has been re-optimized.
+		reoptimized bool
+		// reason describes why the re-optimization is requested.
+		reason string
+		// observedRows maps the data sources to their observed row counts.
+		observedRows map[string]float64
+	}
+}
+
+// Enable enables the readers to check their actual row counts.
+func (r *ReoptimizeInfo) Enable() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.mu.enabled = !r.mu.reoptimized
+}
+
+// Disable disables the re-optimization before the first rows are returned to the client. It returns whether a
+// reader has requested to re-optimize the statement, then the statement should be re-optimized instead of returning
+// the rows, since the request may not have reached the top of the executor tree yet.
+func (r *ReoptimizeInfo) Disable() (requested bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.mu.enabled = false
+	return r.mu.requested
+}
+
+// Enabled returns whether the readers should check their actual row counts.
+func (r *ReoptimizeInfo) Enabled() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.mu.enabled
+}
+
+// Request records the observed row count of a data source and requests to re-optimize the statement.
+// It returns false if the re-optimization is disabled, then the reader should go on with the current plan.
+func (r *ReoptimizeInfo) Request(key string, observedRows float64, reason string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if !r.mu.enabled {
+		return false
+	}
+	if r.mu.observedRows == nil {
+		r.mu.observedRows = make(map[string]float64)
+	}
+	r.mu.observedRows[key] = max(r.mu.observedRows[key], observedRows)
+	if !r.mu.requested {
+		r.mu.requested = true
+		r.mu.reason = reason
+	}
+	return true
+}
+
+// Requested returns whether a reader has requested to re-optimize the statement.
+func (r *ReoptimizeInfo) Requested() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.mu.requested
+}
+
+// Start marks the statement as re-optimized and returns the reason of the re-optimization.
+// A statement is re-optimized at most once, so the readers stop checking their row counts after it.
+func (r *ReoptimizeInfo) Start() (reason string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.mu.enabled, r.mu.requested, r.mu.reoptimized = false, false, true
+	return r.mu.reason
+}
+
+// Reoptimized returns whether the statement has been re-optimized.
+func (r *ReoptimizeInfo) Reoptimized() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.mu.reoptimized
+}
+
+// ObservedRowCount returns the row count of the data source observed before the re-optimization.
+func (r *ReoptimizeInfo) ObservedRowCount(key string) (float64, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	rows, ok := r.mu.observedRows[key]
+	return rows, ok
+}
+
 // StatsLoadResult indicates result for StatsLoad
 type StatsLoadResult struct {
 	Item  model.TableItemID
@@ -464,6 +464,63 @@ func TestErrCtx(t *testing.T) {
 	require.Equal(t, errctx.NewContextWithLevels(levels, sc), sc.ErrCtx())
 }
 
+func TestSetHintWarning(t *testing.T) {
+	sc := stmtctx.NewStmtCtx()
+	for i := 0; i < 3; i++ {
+		sc.SetHintWarning("leading hint is inapplicable")
+		sc.SetHintWarningFromError(hint.ErrWarnHintInapplicable.FastGen("SAMPLE(t) is inapplicable"))
+	}
+	sc.AppendWarning(errors.New("other warning"))
+	sc.SetHintWarningFromError(hint.ErrWarnHintInapplicable.FastGen("SAMPLE(t) is inapplicable"))
+	msgs := func() []string {
+		var msgs []string
+		for _, w := range sc.GetWarnings() {
+			msgs = append(msgs, w.Err.Error())
+		}
+		return msgs
+	}
+	require.Equal(t, []string{
+		"[planner:1815]leading hint is inapplicable (repeated 3 times)",
+		"[planner:8073]SAMPLE(t) is inapplicable (repeated 4 times)",
+		"other warning",
+	}, msgs())
+	require.True(t, hint.ErrWarnHintInapplicable.Equal(sc.GetWarnings()[1].Err))
+
+	// The aggregated warning is set again if it has been truncated.
+	sc.TruncateWarnings(1)
+	sc.SetHintWarningFromError(hint.ErrWarnHintInapplicable.FastGen("SAMPLE(t) is inapplicable"))
+	sc.SetHintWarning("leading hint is inapplicable")
+	require.Equal(t, []string{
+		"[planner:1815]leading hint is inapplicable (repeated 4 times)",
+		"[planner:8073]SAMPLE(t) is inapplicable",
+	}, msgs())
+}
+
+func TestSetHintWarningForScope(t *testing.T) {
+	sc := stmtctx.NewStmtCtx()
+	for i := 0; i < 32; i++ {
+		scope := hint.WarningScope{Hint: "use_index", Table: "t", Kind: hint.WarningScopePartition, Name: fmt.Sprintf("p%d", i)}
+		sc.SetHintWarningForScope(hint.ErrWarnHintInapplicable.FastGen("use_index(t, idx) is inapplicable for the partition p%d", i), scope)
+		// The warning set again for the same partition isn't counted.
+		sc.SetHintWarningForScope(hint.ErrWarnHintInapplicable.FastGen("use_index(t, idx) is inapplicable for the partition p%d", i), scope)
+	}
+	// The warnings with another code, hint or table aren't aggregated.
+	sc.SetHintWarningForScope(hint.ErrWarnHintUnknownQueryBlock.FastGenByArgs("use_index(@sel_2 t, idx)"),
+		hint.WarningScope{Hint: "use_index", Table: "t", Kind: hint.WarningScopeQueryBlock, Name: "sel_2"})
+	sc.SetHintWarningForScope(hint.ErrWarnHintInapplicable.FastGen("use_index(t2, idx) is inapplicable for the partition p0"),
+		hint.WarningScope{Hint: "use_index", Table: "t2", Kind: hint.WarningScopePartition, Name: "p0"})
+	var msgs []string
+	for _, w := range sc.GetWarnings() {
+		msgs = append(msgs, w.Err.Error())
+	}
+	require.Equal(t, []string{
+		"[planner:8073]use_index hint is inapplicable for 32 partitions of t: use_index(t, idx) is inapplicable for the partition p0",
+		"[planner:8072]Hint use_index(@sel_2 t, idx) is ignored due to unknown query block name",
+		"[planner:8073]use_index(t2, idx) is inapplicable for the partition p0",
+	}, msgs)
+	require.True(t, hint.ErrWarnHintInapplicable.Equal(sc.GetWarnings()[0].Err))
+}
+
 func BenchmarkErrCtx(b *testing.B) {
 	sc := stmtctx.NewStmtCtx()
 
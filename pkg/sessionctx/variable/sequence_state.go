@@ -16,6 +16,8 @@ package variable
 
 import (
 	"sync"
+
+	"github.com/pingcap/tidb/pkg/parser/model"
 )
 
 // SequenceState cache all sequence's latest value accessed by lastval() builtins. It's a session scoped
@@ -24,11 +26,24 @@ type SequenceState struct {
 	mu sync.Mutex
 	// latestValueMap caches the last value obtained by nextval() for each sequence id.
 	latestValueMap map[int64]int64
+	// rangeMap caches the values taken from the instance cache for each sequence id, see tidb_sequence_session_cache_size.
+	rangeMap map[int64]SequenceRange
+}
+
+// SequenceRange is the values of a sequence taken by a session, they are Next, Next+Increment, ... until Last.
+type SequenceRange struct {
+	Next      int64
+	Last      int64
+	Increment int64
+	// Remaining is the number of values left in the range.
+	Remaining uint64
+	// Info is the sequence the values are taken from, the range is stale once the sequence is altered.
+	Info *model.SequenceInfo
 }
 
 // NewSequenceState creates a SequenceState.
 func NewSequenceState() *SequenceState {
-	return &SequenceState{mu: sync.Mutex{}, latestValueMap: make(map[int64]int64)}
+	return &SequenceState{mu: sync.Mutex{}, latestValueMap: make(map[int64]int64), rangeMap: make(map[int64]SequenceRange)}
 }
 
 // UpdateState will update the last value of specified sequenceID in a session.
@@ -70,3 +85,56 @@ func (ss *SequenceState) SetAllStates(latestValueMap map[int64]int64) {
 		ss.latestValueMap[seqID] = latestValue
 	}
 }
+
+// SetRange sets the values of the specified sequenceID taken by the session.
+func (ss *SequenceState) SetRange(sequenceID int64, r SequenceRange) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	if r.Remaining == 0 {
+		delete(ss.rangeMap, sequenceID)
+		return
+	}
+	ss.rangeMap[sequenceID] = r
+}
+
+// ClearRange drops the values of the specified sequenceID taken by the session.
+func (ss *SequenceState) ClearRange(sequenceID int64) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	delete(ss.rangeMap, sequenceID)
+}
+
+// NextInRange returns the next value of the specified sequenceID taken by the session, bool(false) indicates
+// there is no value left or the values are taken from a stale sequence.
+func (ss *SequenceState) NextInRange(sequenceID int64, info *model.SequenceInfo) (int64, bool) {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	r, ok := ss.rangeMap[sequenceID]
+	if !ok {
+		return 0, false
+	}
+	if r.Info != info {
+		delete(ss.rangeMap, sequenceID)
+		return 0, false
+	}
+	nextVal := r.Next
+	r.Remaining--
+	if r.Remaining == 0 {
+		delete(ss.rangeMap, sequenceID)
+	} else {
+		r.Next += r.Increment
+		ss.rangeMap[sequenceID] = r
+	}
+	return nextVal, true
+}
+
+// GetAllRanges returns a copied rangeMap.
+func (ss *SequenceState) GetAllRanges() map[int64]SequenceRange {
+	ss.mu.Lock()
+	defer ss.mu.Unlock()
+	rangeMap := make(map[int64]SequenceRange, len(ss.rangeMap))
+	for seqID, r := range ss.rangeMap {
+		rangeMap[seqID] = r
+	}
+	return rangeMap
+}
@@ -24,6 +24,7 @@ import (
 	"math"
 	"math/rand"
 	"net"
+	"reflect"
 	"slices"
 	"strconv"
 	"strings"
@@ -57,9 +58,11 @@ import (
 	"github.com/pingcap/tidb/pkg/util/mathutil"
 	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/pingcap/tidb/pkg/util/replayer"
+	"github.com/pingcap/tidb/pkg/util/rowchange"
 	"github.com/pingcap/tidb/pkg/util/rowcodec"
 	"github.com/pingcap/tidb/pkg/util/sqlkiller"
 	"github.com/pingcap/tidb/pkg/util/stringutil"
+	filter "github.com/pingcap/tidb/pkg/util/table-filter"
 	"github.com/pingcap/tidb/pkg/util/tableutil"
 	"github.com/pingcap/tidb/pkg/util/tiflash"
 	"github.com/pingcap/tidb/pkg/util/tiflashcompute"
@@ -251,6 +254,11 @@ type TxnCtxNoNeedToRestore struct {
 	// has occurred during execution of any statement).
 	FairLockingEffective bool
 
+	// AsyncCommitHint and OnePCHint are set by the ASYNC_COMMIT and ONE_PC hints of the DML statements in the
+	// transaction, they override tidb_enable_async_commit and tidb_enable_1pc when committing the transaction.
+	AsyncCommitHint *bool
+	OnePCHint       *bool
+
 	// CurrentStmtPessimisticLockCache is the cache for pessimistic locked keys in the current statement.
 	// It is merged into `pessimisticLockCache` after a statement finishes.
 	// Read results cannot be directly written into pessimisticLockCache because failed statement need to rollback
@@ -552,12 +560,17 @@ type TemporaryTableData interface {
 	DeleteTableKey(tblID int64, k kv.Key) error
 	// SetTableKey sets the entry for k from table
 	SetTableKey(tblID int64, k kv.Key, val []byte) error
+	// GetTableStats gets the committed in-session stats of a table (*statistics.TempTableStats)
+	GetTableStats(tblID int64) any
+	// SetTableStats sets the committed in-session stats of a table, a nil stats removes the stats of the table
+	SetTableStats(tblID int64, stats any)
 }
 
 // temporaryTableData is used for store temporary table data in session
 type temporaryTableData struct {
 	kv.MemBuffer
-	tblSize map[int64]int64
+	tblSize  map[int64]int64
+	tblStats map[int64]any
 }
 
 // NewTemporaryTableData creates a new TemporaryTableData
@@ -565,6 +578,7 @@ func NewTemporaryTableData(memBuffer kv.MemBuffer) TemporaryTableData {
 	return &temporaryTableData{
 		MemBuffer: memBuffer,
 		tblSize:   make(map[int64]int64),
+		tblStats:  make(map[int64]any),
 	}
 }
 
@@ -592,6 +606,20 @@ func (d *temporaryTableData) SetTableKey(tblID int64, k kv.Key, val []byte) erro
 	return d.MemBuffer.Set(k, val)
 }
 
+// GetTableStats gets the committed in-session stats of a table
+func (d *temporaryTableData) GetTableStats(tblID int64) any {
+	return d.tblStats[tblID]
+}
+
+// SetTableStats sets the committed in-session stats of a table
+func (d *temporaryTableData) SetTableStats(tblID int64, stats any) {
+	if stats == nil {
+		delete(d.tblStats, tblID)
+		return
+	}
+	d.tblStats[tblID] = stats
+}
+
 func (d *temporaryTableData) updateTblSize(tblID int64, beforeSize int) {
 	delta := int64(d.MemBuffer.Size() - beforeSize)
 	d.tblSize[tblID] = d.GetTableSize(tblID) + delta
@@ -675,6 +703,21 @@ type SessionVars struct {
 	Concurrency
 	MemQuota
 	BatchSize
+	// IndexLookupPrefetchTasks is the number of lookup tasks that can be prepared ahead of consumption
+	// in index double read executor and index lookup join executor.
+	IndexLookupPrefetchTasks int
+	// MemQuotaOperator defines the memory quota for a single spillable operator, 0 means no limit.
+	MemQuotaOperator int64
+	// ExplainAnalyzeTopRegions is the number of slowest regions reported for each coprocessor reader
+	// in `EXPLAIN ANALYZE`, 0 means the per-store and per-region breakdown is disabled.
+	ExplainAnalyzeTopRegions int
+	// ExplainShowHintsInUse indicates whether to append the "Hints In Use" section to the result of `EXPLAIN`.
+	ExplainShowHintsInUse bool
+	// ExplainShowCostDetails indicates whether to append the "Cost Details" and "Plan Constraints" sections to the
+	// result of `EXPLAIN FORMAT='verbose'`.
+	ExplainShowCostDetails bool
+	// TraceHints indicates whether to trace how the hints of a statement are matched with its tables.
+	TraceHints bool
 	// DMLBatchSize indicates the number of rows batch-committed for a statement.
 	// It will be used when using LOAD DATA or BatchInsert or BatchDelete is on.
 	DMLBatchSize        int
@@ -782,6 +825,10 @@ type SessionVars struct {
 	// BinlogClient is used to write binlog.
 	BinlogClient *pumpcli.PumpsClient
 
+	// RowChanges collects the row changes of the subscribed tables for the extensions, it's nil if no extension
+	// subscribes the row changes.
+	RowChanges *rowchange.Buffer
+
 	// GlobalVarsAccessor is used to set and get global variables.
 	GlobalVarsAccessor GlobalVarAccessor
 
@@ -1076,6 +1123,15 @@ type SessionVars struct {
 	// See https://github.com/pingcap/tidb/blob/7105505a78fc886c33258caa5813baf197b15247/docs/design/2023-06-30-configurable-kv-timeout.md?plain=1#L14-L15
 	TiKVClientReadTimeout uint64
 
+	// WriteConflictMaxRetries is the max number of times a statement is retried on the write conflicts, -1 means
+	// using the config.
+	WriteConflictMaxRetries int
+	// WriteConflictRetryBackoff is the backoff before the first retry of a statement on the write conflicts.
+	WriteConflictRetryBackoff time.Duration
+	// RegionErrorMaxRetries is the max number of times the coprocessor requests of a statement are retried on the
+	// region errors, -1 means no limit.
+	RegionErrorMaxRetries int
+
 	// SQLKiller is a flag to indicate that this query is killed.
 	SQLKiller sqlkiller.SQLKiller
 
@@ -1144,6 +1200,14 @@ type SessionVars struct {
 	// IsolationReadEngines is used to isolation read, tidb only read from the stores whose engine type is in the engines.
 	IsolationReadEngines map[kv.StoreType]struct{}
 
+	// ReadFromStorageFallbackPolicy is how to handle the `read_from_storage(tiflash[t])` hint when the tiflash replica
+	// of the table is not available.
+	ReadFromStorageFallbackPolicy string
+
+	// ReadFromStorageFallbackWaitTimeout is the max time to wait for the tiflash replica to become available when
+	// ReadFromStorageFallbackPolicy is WAIT.
+	ReadFromStorageFallbackWaitTimeout time.Duration
+
 	mppVersion kv.MppVersion
 
 	mppExchangeCompressionMode kv.ExchangeCompressionMode
@@ -1192,6 +1256,11 @@ type SessionVars struct {
 	// OptimizerUseInvisibleIndexes indicates whether optimizer can use invisible index
 	OptimizerUseInvisibleIndexes bool
 
+	// OptimizerIgnoredIndexes is the normalized value of tidb_opt_ignored_indexes.
+	OptimizerIgnoredIndexes string
+	// ignoredIndexes are the indexes in OptimizerIgnoredIndexes, the database of an index is empty if it's omitted.
+	ignoredIndexes map[ignoredIndex]struct{}
+
 	// SelectLimit limits the max counts of select statement's output
 	SelectLimit uint64
 
@@ -1262,10 +1331,20 @@ type SessionVars struct {
 	// Now we only support TiFlash.
 	AllowFallbackToTiKV map[kv.StoreType]struct{}
 
+	// EnableMPPFailureRetry indicates whether to retry the mpp tasks of a query when a TiFlash node fails.
+	EnableMPPFailureRetry bool
+
 	// CTEMaxRecursionDepth indicates The common table expression (CTE) maximum recursion depth.
 	// see https://dev.mysql.com/doc/refman/8.0/en/server-system-variables.html#sysvar_cte_max_recursion_depth
 	CTEMaxRecursionDepth int
 
+	// CTEMaxRecursionRows indicates the max number of rows a recursive CTE can materialize, 0 means no limit.
+	CTEMaxRecursionRows int64
+
+	// SequenceSessionCacheSize indicates the number of sequence values the session takes from the instance cache
+	// of a sequence at a time, see SequenceState.
+	SequenceSessionCacheSize int64
+
 	// The temporary table size threshold, which is different from MySQL. See https://github.com/pingcap/tidb/issues/28691.
 	TMPTableSize int64
 
@@ -1332,6 +1411,9 @@ type SessionVars struct {
 	BatchPendingTiFlashCount int
 	// RcWriteCheckTS indicates whether some special write statements don't get latest tso from PD at RC
 	RcWriteCheckTS bool
+	// RcReadTSReuseInterval is the interval within which the read-only statements of a read-committed transaction
+	// reuse the latest fetched tso. 0 means always getting a new tso.
+	RcReadTSReuseInterval time.Duration
 	// RemoveOrderbyInSubquery indicates whether to remove ORDER BY in subquery.
 	RemoveOrderbyInSubquery bool
 	// NonTransactionalIgnoreError indicates whether to ignore error in non-transactional statements.
@@ -1482,6 +1564,10 @@ type SessionVars struct {
 	// StoreBatchSize indicates the batch size limit of store batch, set this field to 0 to disable store batch.
 	StoreBatchSize int
 
+	// EnableAdaptiveDistSQLScanConcurrency indicates whether to tune the distsql scan concurrency of each statement by
+	// the regions and stores it touches and the current load of the instance.
+	EnableAdaptiveDistSQLScanConcurrency bool
+
 	// shardRand is used by TxnCtx, for the GetCurrentShard() method.
 	shardRand *rand.Rand
 
@@ -1493,6 +1579,10 @@ type SessionVars struct {
 	// is enabled.
 	PessimisticTransactionFairLocking bool
 
+	// PessimisticTransactionFairLockingTables is the filter of the tables, the pessimistic statements accessing
+	// which use fair locking. It's nil if no table is specified.
+	PessimisticTransactionFairLockingTables filter.Filter
+
 	// EnableINLJoinInnerMultiPattern indicates whether enable multi pattern for index join inner side
 	// For now it is not public to user
 	EnableINLJoinInnerMultiPattern bool
@@ -1527,11 +1617,27 @@ type SessionVars struct {
 	// 0 > value <= 1 applies that percentage as the estimate when rows are found. For example 0.1 = 10%.
 	OptOrderingIdxSelRatio float64
 
+	// EnableAdaptiveReoptimization indicates whether to re-optimize a query during its execution when the actual row
+	// count of a data source exceeds its estimation by ReoptimizationMisestimateRatio times.
+	EnableAdaptiveReoptimization bool
+
+	// ReoptimizationMisestimateRatio is the ratio of the actual row count to the estimated row count of a data source,
+	// beyond which the query is re-optimized with the observed row count.
+	ReoptimizationMisestimateRatio float64
+
 	// EnableMPPSharedCTEExecution indicates whether we enable the shared CTE execution strategy on MPP side.
 	EnableMPPSharedCTEExecution bool
 
+	// EnableFKCardinalityEstimation indicates whether to use the declared foreign keys to bound the estimated row count
+	// of the join between the child table and the parent table.
+	EnableFKCardinalityEstimation bool
+
 	// OptimizerFixControl control some details of the optimizer behavior through the tidb_opt_fix_control variable.
 	OptimizerFixControl map[uint64]string
+	// mergedFixControl caches the fix controls merged from OptimizerFixControl and the fix_control hint.
+	mergedFixControl struct {
+		session, hints, merged map[uint64]string
+	}
 
 	// FastCheckTable is used to control whether fast check table is enabled.
 	FastCheckTable bool
@@ -1567,6 +1673,11 @@ type SessionVars struct {
 	// SessionAlias is the identifier of the session
 	SessionAlias string
 
+	// AppName is the `program_name` connection attribute sent by the client, it's used to
+	// identify the application of the session in the processlist and the statements summary,
+	// and to match the hints set by `ADMIN SET HINTS FOR DIGEST ... FOR APPLICATION`.
+	AppName string
+
 	// OptObjective indicates whether the optimizer should be more stable, predictable or more aggressive.
 	// For now, the possible values and corresponding behaviors are:
 	// OptObjectiveModerate: The default value. The optimizer considers the real-time stats (real-time row count, modify count).
@@ -1592,8 +1703,26 @@ type SessionVars struct {
 }
 
 // GetOptimizerFixControlMap returns the specified value of the optimizer fix control.
+// The values set by the fix_control hint of the current statement override the ones of the variable.
 func (s *SessionVars) GetOptimizerFixControlMap() map[uint64]string {
-	return s.OptimizerFixControl
+	if s.StmtCtx == nil || len(s.StmtCtx.StmtHints.FixControl) == 0 {
+		return s.OptimizerFixControl
+	}
+	// The maps are replaced rather than modified, so the merged one is rebuilt only if either of them is replaced.
+	cache := &s.mergedFixControl
+	hints := s.StmtCtx.StmtHints.FixControl
+	if cache.merged != nil && sameFixControlMap(cache.session, s.OptimizerFixControl) && sameFixControlMap(cache.hints, hints) {
+		return cache.merged
+	}
+	fixControl := make(map[uint64]string, len(s.OptimizerFixControl)+len(hints))
+	maps.Copy(fixControl, s.OptimizerFixControl)
+	maps.Copy(fixControl, hints)
+	cache.session, cache.hints, cache.merged = s.OptimizerFixControl, hints, fixControl
+	return fixControl
+}
+
+func sameFixControlMap(a, b map[uint64]string) bool {
+	return reflect.ValueOf(a).UnsafePointer() == reflect.ValueOf(b).UnsafePointer()
 }
 
 // planReplayerSessionFinishedTaskKeyLen is used to control the max size for the finished plan replayer task key in session
@@ -2024,6 +2153,8 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		EnableIndexMergeJoin:          DefTiDBEnableIndexMergeJoin,
 		AllowFallbackToTiKV:           make(map[kv.StoreType]struct{}),
 		CTEMaxRecursionDepth:          DefCTEMaxRecursionDepth,
+		CTEMaxRecursionRows:           DefTiDBCTEMaxRecursionRows,
+		SequenceSessionCacheSize:      DefTiDBSequenceSessionCacheSize,
 		TMPTableSize:                  DefTiDBTmpTableMaxSize,
 		MPPStoreFailTTL:               DefTiDBMPPStoreFailTTL,
 		Rng:                           mathutil.NewWithTime(),
@@ -2046,6 +2177,8 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 		ResourceGroupName:             resourcegroup.DefaultResourceGroupName,
 		DefaultCollationForUTF8MB4:    mysql.DefaultCollationName,
 		GroupConcatMaxLen:             DefGroupConcatMaxLen,
+		WriteConflictMaxRetries:       DefTiDBWriteConflictMaxRetries,
+		RegionErrorMaxRetries:         DefTiDBRegionErrorMaxRetries,
 	}
 	vars.status.Store(uint32(mysql.ServerStatusAutocommit))
 	vars.StmtCtx.ResourceGroupName = resourcegroup.DefaultResourceGroupName
@@ -2090,6 +2223,7 @@ func NewSessionVars(hctx HookContext) *SessionVars {
 	vars.TiFlashMaxQueryMemoryPerNode = DefTiFlashMemQuotaQueryPerNode
 	vars.TiFlashQuerySpillRatio = DefTiFlashQuerySpillRatio
 	vars.MPPStoreFailTTL = DefTiDBMPPStoreFailTTL
+	vars.ReoptimizationMisestimateRatio = DefTiDBOptReoptimizationMisestimateRatio
 	vars.DiskTracker = disk.NewTracker(memory.LabelForSession, -1)
 	vars.MemTracker = memory.NewTracker(memory.LabelForSession, vars.MemQuotaQuery)
 	vars.MemTracker.IsRootTrackerOfSess = true
@@ -2129,6 +2263,65 @@ func (s *SessionVars) SetAllowInSubqToJoinAndAgg(val bool) {
 	s.allowInSubqToJoinAndAgg = val
 }
 
+// GetOptimizerUseInvisibleIndexes get OptimizerUseInvisibleIndexes from sql hints and SessionVars.OptimizerUseInvisibleIndexes.
+func (s *SessionVars) GetOptimizerUseInvisibleIndexes() bool {
+	return s.OptimizerUseInvisibleIndexes || s.StmtCtx.UseInvisibleIndexes
+}
+
+type ignoredIndex struct {
+	db, tbl, idx string
+}
+
+// parseIgnoredIndexes parses the value of tidb_opt_ignored_indexes, it returns the normalized value and the indexes.
+func parseIgnoredIndexes(val string) (string, map[ignoredIndex]struct{}, error) {
+	indexes := make(map[ignoredIndex]struct{})
+	names := make([]string, 0, 4)
+	for _, item := range strings.Split(val, ",") {
+		item = strings.ToLower(strings.TrimSpace(item))
+		if item == "" {
+			continue
+		}
+		parts := strings.Split(item, ".")
+		for i := range parts {
+			parts[i] = strings.TrimSpace(parts[i])
+			if parts[i] == "" {
+				return "", nil, errors.Errorf("invalid index name '%s'", item)
+			}
+		}
+		var index ignoredIndex
+		switch len(parts) {
+		case 2:
+			index = ignoredIndex{tbl: parts[0], idx: parts[1]}
+		case 3:
+			index = ignoredIndex{db: parts[0], tbl: parts[1], idx: parts[2]}
+		default:
+			return "", nil, errors.Errorf("invalid index name '%s', it should be 'db.tbl.idx' or 'tbl.idx'", item)
+		}
+		if _, ok := indexes[index]; !ok {
+			indexes[index] = struct{}{}
+			names = append(names, strings.Join(parts, "."))
+		}
+	}
+	return strings.Join(names, ","), indexes, nil
+}
+
+// IsIndexIgnored returns whether the index is listed in tidb_opt_ignored_indexes, which means the optimizer should
+// pretend it doesn't exist. The indexes without the database are regarded as the indexes of the current database.
+func (s *SessionVars) IsIndexIgnored(db, tbl, idx string) bool {
+	if len(s.ignoredIndexes) == 0 {
+		return false
+	}
+	db = strings.ToLower(db)
+	if _, ok := s.ignoredIndexes[ignoredIndex{db: db, tbl: tbl, idx: idx}]; ok {
+		return true
+	}
+	if strings.EqualFold(db, s.CurrentDB) {
+		_, ok := s.ignoredIndexes[ignoredIndex{tbl: tbl, idx: idx}]
+		return ok
+	}
+	return false
+}
+
 // GetAllowPreferRangeScan get preferRangeScan from SessionVars.preferRangeScan.
 func (s *SessionVars) GetAllowPreferRangeScan() bool {
 	return s.preferRangeScan
@@ -2199,6 +2392,16 @@ func (s *SessionVars) GetWriteStmtBufs() *WriteStmtBufs {
 	return &s.writeStmtBufs
 }
 
+// ShouldCollectHintUsages returns whether to collect the usages of the hints for the EXPLAIN statement. They're always
+// reported by EXPLAIN FORMAT='json', whose document can be extended without breaking the clients, and by the other
+// formats when tidb_explain_show_hints_in_use or tidb_explain_show_cost_details is ON.
+func (s *SessionVars) ShouldCollectHintUsages() bool {
+	if !s.StmtCtx.InExplainStmt {
+		return false
+	}
+	return s.ExplainShowHintsInUse || s.ExplainShowCostDetails || strings.ToLower(s.StmtCtx.ExplainFormat) == types.ExplainFormatJSON
+}
+
 // GetSplitRegionTimeout gets split region timeout.
 func (s *SessionVars) GetSplitRegionTimeout() time.Duration {
 	return time.Duration(s.WaitSplitRegionTimeout) * time.Second
@@ -2652,6 +2855,10 @@ func (s *SessionVars) GetTemporaryTable(tblInfo *model.TableInfo) tableutil.Temp
 		tempTable, ok := tempTables[tblInfo.ID]
 		if !ok {
 			tempTable = tableutil.TempTableFromMeta(tblInfo)
+			// The data of local temporary tables is kept across transactions, so are the stats.
+			if tblInfo.TempTableType == model.TempTableLocal && s.TemporaryTableData != nil {
+				tempTable.SetStats(s.TemporaryTableData.GetTableStats(tblInfo.ID))
+			}
 			tempTables[tblInfo.ID] = tempTable
 		}
 		return tempTable
@@ -2690,6 +2897,7 @@ func (s *SessionVars) EncodeSessionStates(_ context.Context, sessionStates *sess
 	sessionStates.FoundInPlanCache = s.PrevFoundInPlanCache
 	sessionStates.FoundInBinding = s.PrevFoundInBinding
 	sessionStates.ResourceGroupName = s.ResourceGroupName
+	sessionStates.AppName = s.AppName
 	sessionStates.HypoIndexes = s.HypoIndexes
 	sessionStates.HypoTiFlashReplicas = s.HypoTiFlashReplicas
 
@@ -2726,6 +2934,8 @@ func (s *SessionVars) DecodeSessionStates(_ context.Context, sessionStates *sess
 	s.FoundInPlanCache = sessionStates.FoundInPlanCache
 	s.FoundInBinding = sessionStates.FoundInBinding
 	s.ResourceGroupName = sessionStates.ResourceGroupName
+	// The application name is kept so that the hints set for the application still apply after migration.
+	s.AppName = sessionStates.AppName
 	s.HypoIndexes = sessionStates.HypoIndexes
 	s.HypoTiFlashReplicas = sessionStates.HypoTiFlashReplicas
 
@@ -2822,6 +3032,13 @@ type Concurrency struct {
 
 	// BulkDMLEnabled indicates whether to enable bulk DML in pipelined mode.
 	BulkDMLEnabled bool
+
+	// AutoBulkDMLEnabled indicates whether to retry an auto-committed DML in pipelined mode when it exceeds the
+	// transaction size limit in standard mode.
+	AutoBulkDMLEnabled bool
+
+	// BulkDMLBatchSize indicates the max number of mutations buffered by a bulk DML before flushing them.
+	BulkDMLBatchSize int
 }
 
 // SetIndexLookupConcurrency set the number of concurrent index lookup worker.
@@ -3030,6 +3247,8 @@ const (
 	SlowLogTimeStr = "Time"
 	// SlowLogStartPrefixStr is slow log start row prefix.
 	SlowLogStartPrefixStr = SlowLogRowPrefixStr + SlowLogTimeStr + SlowLogSpaceMarkStr
+	// SlowLogJSONStartPrefixStr is the prefix of a slow log line in the JSON format.
+	SlowLogJSONStartPrefixStr = `{"time":`
 	// SlowLogTxnStartTSStr is slow log field name.
 	SlowLogTxnStartTSStr = "Txn_start_ts"
 	// SlowLogKeyspaceName is slow log field name.
@@ -3216,6 +3435,10 @@ type SlowQueryLogItems struct {
 	RRU               float64
 	WRU               float64
 	WaitRUDuration    time.Duration
+	// AppliedHints is the canonical hint set applied to the statement.
+	AppliedHints string
+	// BindSQL is the bind SQL of the binding used by the statement.
+	BindSQL string
 }
 
 // SlowLogFormat uses for formatting slow log.
@@ -3449,6 +3672,162 @@ func writeSlowLogItem(buf *bytes.Buffer, key, value string) {
 	buf.WriteString(SlowLogRowPrefixStr + key + SlowLogSpaceMarkStr + value + "\n")
 }
 
+// SlowLogJSONItem is an entry of the JSON-lines slow log. The field names are part of
+// the format and must be kept stable for the log pipelines and the SLOW_QUERY table,
+// all the durations are in seconds.
+type SlowLogJSONItem struct {
+	TxnStartTS        uint64                  `json:"txn_start_ts"`
+	KeyspaceName      string                  `json:"keyspace_name,omitempty"`
+	KeyspaceID        uint32                  `json:"keyspace_id,omitempty"`
+	User              string                  `json:"user,omitempty"`
+	Host              string                  `json:"host,omitempty"`
+	ConnID            uint64                  `json:"conn_id,omitempty"`
+	SessionAlias      string                  `json:"session_alias,omitempty"`
+	QueryTime         float64                 `json:"query_time"`
+	Timings           SlowLogJSONTimings      `json:"timings"`
+	DB                string                  `json:"db,omitempty"`
+	IndexNames        string                  `json:"index_names,omitempty"`
+	IsInternal        bool                    `json:"is_internal"`
+	Digest            string                  `json:"digest,omitempty"`
+	PlanDigest        string                  `json:"plan_digest,omitempty"`
+	Hints             string                  `json:"hints,omitempty"`
+	Binding           string                  `json:"binding,omitempty"`
+	PlanFromCache     bool                    `json:"plan_from_cache"`
+	PlanFromBinding   bool                    `json:"plan_from_binding"`
+	Prepared          bool                    `json:"prepared"`
+	HasMoreResults    bool                    `json:"has_more_results"`
+	RequestCount      int                     `json:"request_count,omitempty"`
+	ProcessKeys       int64                   `json:"process_keys,omitempty"`
+	TotalKeys         int64                   `json:"total_keys,omitempty"`
+	NumCopTasks       int                     `json:"num_cop_tasks,omitempty"`
+	MemMax            int64                   `json:"mem_max,omitempty"`
+	DiskMax           int64                   `json:"disk_max,omitempty"`
+	ResultRows        int64                   `json:"result_rows"`
+	ExecRetryCount    uint                    `json:"exec_retry_count,omitempty"`
+	Succ              bool                    `json:"succ"`
+	IsExplicitTxn     bool                    `json:"is_explicit_txn"`
+	IsSyncStatsFailed bool                    `json:"is_sync_stats_failed"`
+	ResourceGroup     string                  `json:"resource_group,omitempty"`
+	RequestUnitRead   float64                 `json:"request_unit_read,omitempty"`
+	RequestUnitWrite  float64                 `json:"request_unit_write,omitempty"`
+	Warnings          []JSONSQLWarnForSlowLog `json:"warnings,omitempty"`
+	Plan              string                  `json:"plan,omitempty"`
+	BinaryPlan        string                  `json:"binary_plan,omitempty"`
+	PrevStmt          string                  `json:"prev_stmt,omitempty"`
+	Query             string                  `json:"query"`
+}
+
+// SlowLogJSONTimings is the time spent in each phase of a statement, in seconds.
+type SlowLogJSONTimings struct {
+	Parse                 float64 `json:"parse"`
+	Compile               float64 `json:"compile"`
+	Rewrite               float64 `json:"rewrite"`
+	PreprocSubQueries     float64 `json:"preproc_subqueries,omitempty"`
+	Optimize              float64 `json:"optimize"`
+	WaitTS                float64 `json:"wait_ts"`
+	CopProcess            float64 `json:"cop_process"`
+	CopWait               float64 `json:"cop_wait"`
+	Backoff               float64 `json:"backoff"`
+	KVTotal               float64 `json:"kv_total"`
+	PDTotal               float64 `json:"pd_total"`
+	BackoffTotal          float64 `json:"backoff_total"`
+	WriteSQLResponseTotal float64 `json:"write_sql_response_total"`
+	Prewrite              float64 `json:"prewrite,omitempty"`
+	GetCommitTS           float64 `json:"get_commit_ts,omitempty"`
+	Commit                float64 `json:"commit,omitempty"`
+	ResolveLock           float64 `json:"resolve_lock,omitempty"`
+	LocalLatchWait        float64 `json:"local_latch_wait,omitempty"`
+	ExecRetry             float64 `json:"exec_retry,omitempty"`
+	QueuedByRC            float64 `json:"queued_by_rc,omitempty"`
+}
+
+// SlowLogJSONFormat formats the slow log as a single line of JSON, it carries the same
+// information as SlowLogFormat, see SlowLogJSONItem for the field names.
+func (s *SessionVars) SlowLogJSONFormat(logItems *SlowQueryLogItems) string {
+	item := SlowLogJSONItem{
+		TxnStartTS:   logItems.TxnTS,
+		KeyspaceName: logItems.KeyspaceName,
+		KeyspaceID:   logItems.KeyspaceID,
+		ConnID:       s.ConnectionID,
+		SessionAlias: s.SessionAlias,
+		QueryTime:    logItems.TimeTotal.Seconds(),
+		Timings: SlowLogJSONTimings{
+			Parse:                 logItems.TimeParse.Seconds(),
+			Compile:               logItems.TimeCompile.Seconds(),
+			Rewrite:               logItems.RewriteInfo.DurationRewrite.Seconds(),
+			PreprocSubQueries:     logItems.RewriteInfo.DurationPreprocessSubQuery.Seconds(),
+			Optimize:              logItems.TimeOptimize.Seconds(),
+			WaitTS:                logItems.TimeWaitTS.Seconds(),
+			CopProcess:            logItems.ExecDetail.TimeDetail.ProcessTime.Seconds(),
+			CopWait:               logItems.ExecDetail.TimeDetail.WaitTime.Seconds(),
+			Backoff:               logItems.ExecDetail.BackoffTime.Seconds(),
+			KVTotal:               logItems.KVTotal.Seconds(),
+			PDTotal:               logItems.PDTotal.Seconds(),
+			BackoffTotal:          logItems.BackoffTotal.Seconds(),
+			WriteSQLResponseTotal: logItems.WriteSQLRespTotal.Seconds(),
+			ExecRetry:             logItems.ExecRetryTime.Seconds(),
+			QueuedByRC:            logItems.WaitRUDuration.Seconds(),
+		},
+		DB:                strings.ToLower(s.CurrentDB),
+		IndexNames:        logItems.IndexNames,
+		IsInternal:        s.InRestrictedSQL,
+		Digest:            logItems.Digest,
+		PlanDigest:        logItems.PlanDigest,
+		Hints:             logItems.AppliedHints,
+		Binding:           logItems.BindSQL,
+		PlanFromCache:     logItems.PlanFromCache,
+		PlanFromBinding:   logItems.PlanFromBinding,
+		Prepared:          logItems.Prepared,
+		HasMoreResults:    logItems.HasMoreResults,
+		RequestCount:      logItems.ExecDetail.RequestCount,
+		MemMax:            logItems.MemMax,
+		DiskMax:           logItems.DiskMax,
+		ResultRows:        logItems.ResultRows,
+		ExecRetryCount:    logItems.ExecRetryCount,
+		Succ:              logItems.Succ,
+		IsExplicitTxn:     logItems.IsExplicitTxn,
+		IsSyncStatsFailed: logItems.IsSyncStatsFailed,
+		ResourceGroup:     logItems.ResourceGroupName,
+		RequestUnitRead:   logItems.RRU,
+		RequestUnitWrite:  logItems.WRU,
+		Warnings:          logItems.Warnings,
+		Plan:              logItems.Plan,
+		BinaryPlan:        logItems.BinaryPlan,
+		PrevStmt:          logItems.PrevStmt,
+		Query:             logItems.SQL,
+	}
+	if s.User != nil {
+		item.User = s.User.Username
+		item.Host = s.User.Hostname
+		if s.ConnectionInfo != nil {
+			item.Host = s.ConnectionInfo.ClientIP
+		}
+	}
+	if scanDetail := logItems.ExecDetail.ScanDetail; scanDetail != nil {
+		item.ProcessKeys = scanDetail.ProcessedKeys
+		item.TotalKeys = scanDetail.TotalKeys
+	}
+	if logItems.CopTasks != nil {
+		item.NumCopTasks = logItems.CopTasks.NumCopTasks
+	}
+	if commitDetail := logItems.ExecDetail.CommitDetail; commitDetail != nil {
+		item.Timings.Prewrite = commitDetail.PrewriteTime.Seconds()
+		item.Timings.GetCommitTS = commitDetail.GetCommitTsTime.Seconds()
+		item.Timings.Commit = commitDetail.CommitTime.Seconds()
+		item.Timings.ResolveLock = time.Duration(commitDetail.ResolveLock.ResolveLockTime).Seconds()
+		item.Timings.LocalLatchWait = commitDetail.LocalLatchTime.Seconds()
+	}
+
+	var buf bytes.Buffer
+	jsonEncoder := json.NewEncoder(&buf)
+	jsonEncoder.SetEscapeHTML(false)
+	if err := jsonEncoder.Encode(item); err != nil {
+		return err.Error()
+	}
+	// Encode() appends a '\n', the line break is written by the slow log encoder.
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
 // TxnReadTS indicates the value and used situation for tx_read_ts
 type TxnReadTS struct {
 	readTS uint64
@@ -3765,8 +4144,39 @@ const (
 	OptObjectiveDeterminate = "determinate"
 )
 
+const (
+	// ReadFromStorageFallbackWarn is a possible value and the default value for TiDBReadFromStorageFallbackPolicy.
+	// The unsatisfied hint is ignored with a warning, and the table is read from TiKV.
+	ReadFromStorageFallbackWarn = "WARN"
+	// ReadFromStorageFallbackError is a possible value for TiDBReadFromStorageFallbackPolicy.
+	// The statement fails if the hint can't be satisfied.
+	ReadFromStorageFallbackError = "ERROR"
+	// ReadFromStorageFallbackWait is a possible value for TiDBReadFromStorageFallbackPolicy.
+	// The statement waits for the tiflash replica to become available, and fails with ErrTiFlashReplicaUnavailable
+	// after the timeout or at once if the replica can't become available.
+	ReadFromStorageFallbackWait = "WAIT"
+)
+
 // GetOptObjective return the session variable "tidb_opt_objective".
 // Please see comments of SessionVars.OptObjective for details.
 func (s *SessionVars) GetOptObjective() string {
 	return s.OptObjective
 }
+
+// UseFairLocking returns whether the current pessimistic statement should use fair locking, which wakes up the lock
+// waiters in FIFO order instead of waking all of them up. It's enabled by tidb_pessimistic_txn_fair_locking, or by
+// tidb_pessimistic_txn_fair_locking_tables if the statement accesses any of the matched tables.
+func (s *SessionVars) UseFairLocking() bool {
+	if s.PessimisticTransactionFairLocking {
+		return true
+	}
+	if s.PessimisticTransactionFairLockingTables == nil {
+		return false
+	}
+	for _, tbl := range s.StmtCtx.Tables {
+		if tbl.Table != "" && s.PessimisticTransactionFairLockingTables.MatchTable(tbl.DB, tbl.Table) {
+			return true
+		}
+	}
+	return false
+}
@@ -16,6 +16,7 @@ package variable_test
 
 import (
 	"context"
+	"encoding/json"
 	"strconv"
 	"sync"
 	"testing"
@@ -317,6 +318,52 @@ func TestSlowLogFormat(t *testing.T) {
 	require.False(t, seVar.CurrentDBChanged)
 }
 
+func TestSlowLogJSONFormat(t *testing.T) {
+	ctx := mock.NewContext()
+	seVar := ctx.GetSessionVars()
+	seVar.User = &auth.UserIdentity{Username: "root", Hostname: "192.168.0.1"}
+	seVar.ConnectionID = 1
+	seVar.CurrentDB = "TeST"
+	seVar.CurrentDBChanged = true
+	logItems := &variable.SlowQueryLogItems{
+		TxnTS:           406649736972468225,
+		SQL:             "select * from t where a = 1",
+		Digest:          "42a1c8aae6f133e934d4bf0147491709a8812ea05ff8819ec522780fe657b772",
+		PlanDigest:      "5d3d7a2e5d9c8d0e3b6e1e1f8e8e5f2d",
+		TimeTotal:       time.Second,
+		TimeParse:       time.Millisecond,
+		TimeCompile:     2 * time.Millisecond,
+		TimeOptimize:    3 * time.Millisecond,
+		AppliedHints:    "use_index(@`sel_1` `test`.`t` `ia`)",
+		BindSQL:         "SELECT /*+ use_index(`t` `ia`)*/ * FROM `test`.`t` WHERE `a` = ?",
+		PlanFromBinding: true,
+		Succ:            true,
+		UsedStats:       &stmtctx.UsedStatsInfo{},
+	}
+	logString := seVar.SlowLogJSONFormat(logItems)
+	require.NotContains(t, logString, "\n")
+	// The JSON format must not consume the `use` statement of the text format.
+	require.True(t, seVar.CurrentDBChanged)
+
+	var m map[string]any
+	require.NoError(t, json.Unmarshal([]byte(logString), &m))
+	require.Equal(t, float64(406649736972468225), m["txn_start_ts"])
+	require.Equal(t, "root", m["user"])
+	require.Equal(t, "test", m["db"])
+	require.Equal(t, logItems.Digest, m["digest"])
+	require.Equal(t, logItems.PlanDigest, m["plan_digest"])
+	require.Equal(t, logItems.AppliedHints, m["hints"])
+	require.Equal(t, logItems.BindSQL, m["binding"])
+	require.Equal(t, true, m["plan_from_binding"])
+	require.Equal(t, true, m["succ"])
+	require.Equal(t, float64(1), m["query_time"])
+	require.Equal(t, logItems.SQL, m["query"])
+	timings := m["timings"].(map[string]any)
+	require.Equal(t, 0.001, timings["parse"])
+	require.Equal(t, 0.002, timings["compile"])
+	require.Equal(t, 0.003, timings["optimize"])
+}
+
 func TestIsolationRead(t *testing.T) {
 	defer config.RestoreFunc()()
 	config.UpdateGlobal(func(conf *config.Config) {
@@ -331,6 +378,25 @@ func TestIsolationRead(t *testing.T) {
 	require.True(t, ok)
 }
 
+func TestGetOptimizerFixControlMap(t *testing.T) {
+	vars := variable.NewSessionVars(nil)
+	vars.StmtCtx = stmtctx.NewStmtCtx()
+	require.NoError(t, vars.SetSystemVar(variable.TiDBOptFixControl, "44262:ON,44389:ON"))
+	require.Equal(t, map[uint64]string{44262: "ON", 44389: "ON"}, vars.GetOptimizerFixControlMap())
+
+	vars.StmtCtx.StmtHints.FixControl = map[uint64]string{44389: "OFF", 45132: "10"}
+	merged := vars.GetOptimizerFixControlMap()
+	require.Equal(t, map[uint64]string{44262: "ON", 44389: "OFF", 45132: "10"}, merged)
+	// the merged map is not rebuilt on every call.
+	require.Zero(t, testing.AllocsPerRun(10, func() { vars.GetOptimizerFixControlMap() }))
+
+	// it's rebuilt once the variable or the hints are changed.
+	require.NoError(t, vars.SetSystemVar(variable.TiDBOptFixControl, "44262:OFF"))
+	require.Equal(t, map[uint64]string{44262: "OFF", 44389: "OFF", 45132: "10"}, vars.GetOptimizerFixControlMap())
+	vars.StmtCtx.StmtHints.FixControl = map[uint64]string{44262: "ON"}
+	require.Equal(t, map[uint64]string{44262: "ON"}, vars.GetOptimizerFixControlMap())
+}
+
 func TestTableDeltaClone(t *testing.T) {
 	td0 := variable.TableDelta{
 		Delta:    1,
@@ -76,6 +76,8 @@ var isHintUpdatableVerified = map[string]struct{}{
 	"tidb_enable_index_merge":                         {},
 	"tidb_enable_extended_stats":                      {},
 	"tidb_isolation_read_engines":                     {},
+	"tidb_read_from_storage_fallback_policy":          {},
+	"tidb_read_from_storage_fallback_wait_timeout":    {},
 	"tidb_executor_concurrency":                       {},
 	"tidb_partition_prune_mode":                       {},
 	"tidb_enable_index_merge_join":                    {},
@@ -92,19 +94,26 @@ var isHintUpdatableVerified = map[string]struct{}{
 	"tidb_opt_advanced_join_hint":                     {},
 	"tidb_opt_prefix_index_single_scan":               {},
 	"tidb_store_batch_size":                           {},
+	"tidb_enable_adaptive_distsql_scan_concurrency":   {},
 	"mpp_version":                                     {},
 	"tidb_enable_inl_join_inner_multi_pattern":        {},
 	"tidb_opt_enable_late_materialization":            {},
 	"tidb_opt_ordering_index_selectivity_threshold":   {},
 	"tidb_opt_ordering_index_selectivity_ratio":       {},
 	"tidb_opt_enable_mpp_shared_cte_execution":        {},
+	"tidb_opt_enable_fk_cardinality_estimation":       {},
 	"tidb_opt_fix_control":                            {},
+	"tidb_opt_enable_hash_join":                       {},
 	"tidb_runtime_filter_type":                        {},
 	"tidb_runtime_filter_mode":                        {},
 	"tidb_session_alias":                              {},
+	"tidb_pessimistic_txn_fair_locking":               {},
+	"tidb_pessimistic_txn_fair_locking_tables":        {},
 	"tidb_opt_objective":                              {},
 	"mpp_exchange_compression_mode":                   {},
+	"tidb_enable_mpp_failure_retry":                   {},
 	"tidb_allow_fallback_to_tikv":                     {},
+	"tidb_cte_max_recursion_rows":                     {},
 	"tiflash_fastscan":                                {},
 	"tiflash_fine_grained_shuffle_batch_size":         {},
 	"tiflash_fine_grained_shuffle_stream_count":       {},
@@ -360,6 +360,14 @@ var defaultSysVars = []*SysVar{
 		}
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBReadFromStorageFallbackPolicy, Value: DefTiDBReadFromStorageFallbackPolicy, Type: TypeEnum, PossibleValues: []string{ReadFromStorageFallbackWarn, ReadFromStorageFallbackError, ReadFromStorageFallbackWait}, SetSession: func(s *SessionVars, val string) error {
+		s.ReadFromStorageFallbackPolicy = val
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBReadFromStorageFallbackWaitTimeout, Value: strconv.Itoa(DefTiDBReadFromStorageFallbackWaitTimeout), Type: TypeUnsigned, MinValue: 1, MaxValue: MaxTiDBReadFromStorageFallbackWaitTimeout, SetSession: func(s *SessionVars, val string) error {
+		s.ReadFromStorageFallbackWaitTimeout = time.Duration(TidbOptInt64(val, DefTiDBReadFromStorageFallbackWaitTimeout)) * time.Second
+		return nil
+	}},
 	{Scope: ScopeSession, Name: TiDBMetricSchemaStep, Value: strconv.Itoa(DefTiDBMetricSchemaStep), Type: TypeUnsigned, skipInit: true, MinValue: 10, MaxValue: 60 * 60 * 60, SetSession: func(s *SessionVars, val string) error {
 		s.MetricSchemaStep = TidbOptInt64(val, DefTiDBMetricSchemaStep)
 		return nil
@@ -1821,6 +1829,10 @@ var defaultSysVars = []*SysVar{
 		s.distSQLScanConcurrency = tidbOptPositiveInt32(val, DefDistSQLScanConcurrency)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableAdaptiveDistSQLScanConcurrency, Value: BoolToOnOff(DefTiDBEnableAdaptiveDistSQLScanConcurrency), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableAdaptiveDistSQLScanConcurrency = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBAnalyzeDistSQLScanConcurrency, Value: strconv.Itoa(DefAnalyzeDistSQLScanConcurrency), Type: TypeUnsigned, MinValue: 1, MaxValue: math.MaxInt32, SetSession: func(s *SessionVars, val string) error {
 		s.analyzeDistSQLScanConcurrency = tidbOptPositiveInt32(val, DefAnalyzeDistSQLScanConcurrency)
 		return nil
@@ -1911,6 +1923,10 @@ var defaultSysVars = []*SysVar{
 		s.IndexLookupSize = tidbOptPositiveInt32(val, DefIndexLookupSize)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBIndexLookupPrefetchTasks, Value: strconv.Itoa(DefIndexLookupPrefetchTasks), Type: TypeUnsigned, MinValue: 0, MaxValue: MaxConfigurableConcurrency, SetSession: func(s *SessionVars, val string) error {
+		s.IndexLookupPrefetchTasks = TidbOptInt(val, DefIndexLookupPrefetchTasks)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBIndexLookupConcurrency, Value: strconv.Itoa(DefIndexLookupConcurrency), Type: TypeInt, MinValue: 1, MaxValue: MaxConfigurableConcurrency, AllowAutoValue: true, SetSession: func(s *SessionVars, val string) error {
 		s.indexLookupConcurrency = tidbOptPositiveInt32(val, ConcurrencyUnset)
 		return nil
@@ -2033,6 +2049,26 @@ var defaultSysVars = []*SysVar{
 		s.MemQuotaApplyCache = TidbOptInt64(val, DefTiDBMemQuotaApplyCache)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBMemQuotaOperator, Value: strconv.Itoa(DefTiDBMemQuotaOperator), Type: TypeUnsigned, MaxValue: math.MaxInt64, IsHintUpdatableVerified: true, SetSession: func(s *SessionVars, val string) error {
+		s.MemQuotaOperator = TidbOptInt64(val, DefTiDBMemQuotaOperator)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBExplainAnalyzeTopRegions, Value: strconv.Itoa(DefTiDBExplainAnalyzeTopRegions), Type: TypeUnsigned, MinValue: 0, MaxValue: 100, SetSession: func(s *SessionVars, val string) error {
+		s.ExplainAnalyzeTopRegions = TidbOptInt(val, DefTiDBExplainAnalyzeTopRegions)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBExplainShowHintsInUse, Value: BoolToOnOff(DefTiDBExplainShowHintsInUse), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.ExplainShowHintsInUse = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBExplainShowCostDetails, Value: BoolToOnOff(DefTiDBExplainShowCostDetails), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.ExplainShowCostDetails = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: ScopeSession, Name: TiDBOptTraceHints, Value: BoolToOnOff(DefTiDBOptTraceHints), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.TraceHints = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBBackoffLockFast, Value: strconv.Itoa(tikvstore.DefBackoffLockFast), Type: TypeUnsigned, MinValue: 1, MaxValue: math.MaxInt32, SetSession: func(s *SessionVars, val string) error {
 		s.KVVars.BackoffLockFast = tidbOptPositiveInt32(val, tikvstore.DefBackoffLockFast)
 		return nil
@@ -2135,6 +2171,10 @@ var defaultSysVars = []*SysVar{
 		}
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableMPPFailureRetry, Value: BoolToOnOff(DefTiDBEnableMPPFailureRetry), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableMPPFailureRetry = TiDBOptOn(val)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnableAutoIncrementInGenerated, Value: BoolToOnOff(DefTiDBEnableAutoIncrementInGenerated), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.EnableAutoIncrementInGenerated = TiDBOptOn(val)
 		return nil
@@ -2171,7 +2211,7 @@ var defaultSysVars = []*SysVar{
 		s.NoopFuncsMode = TiDBOptOnOffWarn(val)
 		return nil
 	}},
-	{Scope: ScopeGlobal | ScopeSession, Name: TiDBReplicaRead, Value: "leader", Type: TypeEnum, PossibleValues: []string{"leader", "prefer-leader", "follower", "leader-and-follower", "closest-replicas", "closest-adaptive", "learner"}, SetSession: func(s *SessionVars, val string) error {
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBReplicaRead, Value: "leader", Type: TypeEnum, PossibleValues: []string{"leader", "prefer-leader", "follower", "leader-and-follower", "closest-replicas", "closest-adaptive", "learner", "hedged"}, SetSession: func(s *SessionVars, val string) error {
 		if strings.EqualFold(val, "follower") {
 			s.SetReplicaRead(kv.ReplicaReadFollower)
 		} else if strings.EqualFold(val, "leader-and-follower") {
@@ -2186,6 +2226,8 @@ var defaultSysVars = []*SysVar{
 			s.SetReplicaRead(kv.ReplicaReadLearner)
 		} else if strings.EqualFold(val, "prefer-leader") {
 			s.SetReplicaRead(kv.ReplicaReadPreferLeader)
+		} else if strings.EqualFold(val, "hedged") {
+			s.SetReplicaRead(kv.ReplicaReadHedged)
 		}
 		return nil
 	}},
@@ -2214,6 +2256,14 @@ var defaultSysVars = []*SysVar{
 		s.CTEMaxRecursionDepth = TidbOptInt(val, DefCTEMaxRecursionDepth)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBCTEMaxRecursionRows, Value: strconv.Itoa(DefTiDBCTEMaxRecursionRows), Type: TypeInt, MinValue: 0, MaxValue: math.MaxInt64, SetSession: func(s *SessionVars, val string) error {
+		s.CTEMaxRecursionRows = TidbOptInt64(val, DefTiDBCTEMaxRecursionRows)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBSequenceSessionCacheSize, Value: strconv.Itoa(DefTiDBSequenceSessionCacheSize), Type: TypeInt, MinValue: 0, MaxValue: math.MaxInt32, SetSession: func(s *SessionVars, val string) error {
+		s.SequenceSessionCacheSize = TidbOptInt64(val, DefTiDBSequenceSessionCacheSize)
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBAllowAutoRandExplicitInsert, Value: BoolToOnOff(DefTiDBAllowAutoRandExplicitInsert), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.AllowAutoRandExplicitInsert = TiDBOptOn(val)
 		return nil
@@ -2412,6 +2462,10 @@ var defaultSysVars = []*SysVar{
 		s.RcWriteCheckTS = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBRCReadTSReuseInterval, Type: TypeUnsigned, Value: strconv.Itoa(DefTiDBRCReadTSReuseInterval), MinValue: 0, MaxValue: 1000, SetSession: func(s *SessionVars, val string) error {
+		s.RcReadTSReuseInterval = time.Duration(TidbOptInt64(val, DefTiDBRCReadTSReuseInterval)) * time.Millisecond
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBRemoveOrderbyInSubquery, Value: BoolToOnOff(DefTiDBRemoveOrderbyInSubquery), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.RemoveOrderbyInSubquery = TiDBOptOn(val)
 		return nil
@@ -2546,6 +2600,20 @@ var defaultSysVars = []*SysVar{
 		s.OptimizerUseInvisibleIndexes = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeSession, Name: TiDBOptIgnoredIndexes, Value: "", Type: TypeStr, Validation: func(_ *SessionVars, normalizedValue string, originalValue string, _ ScopeFlag) (string, error) {
+		normalized, _, err := parseIgnoredIndexes(normalizedValue)
+		if err != nil {
+			return "", ErrWrongValueForVar.GenWithStackByArgs(TiDBOptIgnoredIndexes, originalValue)
+		}
+		return normalized, nil
+	}, SetSession: func(s *SessionVars, val string) error {
+		normalized, indexes, err := parseIgnoredIndexes(val)
+		if err != nil {
+			return err
+		}
+		s.OptimizerIgnoredIndexes, s.ignoredIndexes = normalized, indexes
+		return nil
+	}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBAnalyzePartitionConcurrency, Value: strconv.FormatInt(DefTiDBAnalyzePartitionConcurrency, 10),
 		MinValue: 1, MaxValue: uint64(config.GetGlobalConfig().Performance.AnalyzePartitionConcurrencyQuota), SetSession: func(s *SessionVars, val string) error {
 			s.AnalyzePartitionConcurrency = int(TidbOptInt64(val, DefTiDBAnalyzePartitionConcurrency))
@@ -2755,6 +2823,19 @@ var defaultSysVars = []*SysVar{
 		s.PessimisticTransactionFairLocking = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBPessimisticTransactionFairLockingTables, Value: DefTiDBPessimisticTransactionFairLockingTables, Type: TypeStr,
+		Validation: func(_ *SessionVars, normalizedValue string, _ string, _ ScopeFlag) (string, error) {
+			_, err := parseFairLockingTables(normalizedValue)
+			return normalizedValue, err
+		},
+		SetSession: func(s *SessionVars, val string) error {
+			f, err := parseFairLockingTables(val)
+			if err != nil {
+				return err
+			}
+			s.PessimisticTransactionFairLockingTables = f
+			return nil
+		}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBEnablePlanCacheForParamLimit, Value: BoolToOnOff(DefTiDBEnablePlanCacheForParamLimit), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.EnablePlanCacheForParamLimit = TiDBOptOn(val)
 		return nil
@@ -2809,10 +2890,23 @@ var defaultSysVars = []*SysVar{
 			s.OptOrderingIdxSelRatio = tidbOptFloat64(val, DefTiDBOptOrderingIdxSelRatio)
 			return nil
 		}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptEnableAdaptiveReoptimization, Value: BoolToOnOff(DefTiDBOptEnableAdaptiveReoptimization), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableAdaptiveReoptimization = TiDBOptOn(val)
+		return nil
+	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptReoptimizationMisestimateRatio, Value: strconv.FormatFloat(DefTiDBOptReoptimizationMisestimateRatio, 'f', -1, 64), Type: TypeFloat, MinValue: 1, MaxValue: math.MaxUint32,
+		SetSession: func(s *SessionVars, val string) error {
+			s.ReoptimizationMisestimateRatio = tidbOptFloat64(val, DefTiDBOptReoptimizationMisestimateRatio)
+			return nil
+		}},
 	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptEnableMPPSharedCTEExecution, Value: BoolToOnOff(DefTiDBOptEnableMPPSharedCTEExecution), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
 		s.EnableMPPSharedCTEExecution = TiDBOptOn(val)
 		return nil
 	}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBOptEnableFKCardinalityEstimation, Value: BoolToOnOff(DefTiDBOptEnableFKCardinalityEstimation), Type: TypeBool, SetSession: func(s *SessionVars, val string) error {
+		s.EnableFKCardinalityEstimation = TiDBOptOn(val)
+		return nil
+	}},
 	{
 		Scope:                   ScopeGlobal | ScopeSession,
 		Name:                    TiDBOptFixControl,
@@ -3171,16 +3265,155 @@ var defaultSysVars = []*SysVar{
 			lowerVal := strings.ToLower(val)
 			if strings.EqualFold(lowerVal, "standard") {
 				s.BulkDMLEnabled = false
+				s.AutoBulkDMLEnabled = false
 				return nil
 			}
 			if strings.EqualFold(lowerVal, "bulk") {
 				s.BulkDMLEnabled = true
+				s.AutoBulkDMLEnabled = false
+				return nil
+			}
+			if strings.EqualFold(lowerVal, "auto") {
+				s.BulkDMLEnabled = false
+				s.AutoBulkDMLEnabled = true
 				return nil
 			}
 			return errors.Errorf("unsupport DML type: %s", val)
 		},
 		IsHintUpdatableVerified: true,
 	},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBDMLBulkBatchSize, Value: strconv.Itoa(DefTiDBDMLBulkBatchSize), Type: TypeUnsigned, MinValue: 0, MaxValue: math.MaxInt32,
+		SetSession: func(s *SessionVars, val string) error {
+			s.BulkDMLBatchSize = TidbOptInt(val, DefTiDBDMLBulkBatchSize)
+			return nil
+		},
+		IsHintUpdatableVerified: true,
+	},
+	{Scope: ScopeGlobal, Name: TiDBEnablePlanRegressionDetection, Value: BoolToOnOff(DefTiDBEnablePlanRegressionDetection), Type: TypeBool,
+		SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			EnablePlanRegressionDetection.Store(TiDBOptOn(val))
+			return nil
+		}, GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return BoolToOnOff(EnablePlanRegressionDetection.Load()), nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBPlanRegressionLatencyRatio, Value: strconv.FormatFloat(DefTiDBPlanRegressionLatencyRatio, 'f', -1, 64), Type: TypeFloat, MinValue: 1, MaxValue: math.MaxUint32,
+		SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			PlanRegressionLatencyRatio.Store(tidbOptFloat64(val, DefTiDBPlanRegressionLatencyRatio))
+			return nil
+		}, GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return strconv.FormatFloat(PlanRegressionLatencyRatio.Load(), 'f', -1, 64), nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBPlanRegressionMinExecCount, Value: strconv.Itoa(DefTiDBPlanRegressionMinExecCount), Type: TypeUnsigned, MinValue: 1, MaxValue: math.MaxInt32,
+		SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			PlanRegressionMinExecCount.Store(TidbOptInt64(val, DefTiDBPlanRegressionMinExecCount))
+			return nil
+		}, GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return strconv.FormatInt(PlanRegressionMinExecCount.Load(), 10), nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBEnablePlanRegressionShadowBinding, Value: BoolToOnOff(DefTiDBEnablePlanRegressionShadowBinding), Type: TypeBool,
+		SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			EnablePlanRegressionShadowBinding.Store(TiDBOptOn(val))
+			return nil
+		}, GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return BoolToOnOff(EnablePlanRegressionShadowBinding.Load()), nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBAutoTableCache, Value: DefTiDBAutoTableCache, Type: TypeEnum, PossibleValues: []string{Off, AutoTableCacheAdvise, On},
+		SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			AutoTableCacheMode.Store(val)
+			return nil
+		}, GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return AutoTableCacheMode.Load(), nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBAutoSplitHotRegions, Value: DefTiDBAutoSplitHotRegions, Type: TypeEnum, PossibleValues: []string{Off, AutoSplitHotRegionsAdvise, On},
+		SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			AutoSplitHotRegionsMode.Store(val)
+			return nil
+		}, GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return AutoSplitHotRegionsMode.Load(), nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBRunawayCoolingHints, Value: DefTiDBRunawayCoolingHints,
+		Validation: func(_ *SessionVars, normalizedValue string, _ string, _ ScopeFlag) (string, error) {
+			normalizedValue = strings.TrimSpace(normalizedValue)
+			if normalizedValue == "" {
+				return normalizedValue, nil
+			}
+			hints, errs := parser.ParseHint("/*+ "+normalizedValue+" */", mysql.ModeNone, parser.Pos{Line: 1})
+			if len(errs) > 0 || len(hints) == 0 {
+				return normalizedValue, ErrWrongValueForVar.GenWithStackByArgs(TiDBRunawayCoolingHints, normalizedValue)
+			}
+			return normalizedValue, nil
+		}, SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			RunawayCoolingHints.Store(val)
+			return nil
+		}, GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return RunawayCoolingHints.Load(), nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBRunawayCoolingDuration, Value: DefTiDBRunawayCoolingDuration.String(), Type: TypeDuration, MinValue: int64(time.Second), MaxValue: uint64(time.Hour * 24 * 30),
+		SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			d, err := time.ParseDuration(val)
+			if err != nil {
+				return err
+			}
+			RunawayCoolingDuration.Store(d)
+			return nil
+		}, GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return RunawayCoolingDuration.Load().String(), nil
+		}},
+	{Scope: ScopeGlobal, Name: TiDBSQLFirewallMode, Value: DefTiDBSQLFirewallMode, Type: TypeEnum, PossibleValues: []string{Off, SQLFirewallLearning, SQLFirewallDeny, SQLFirewallAllow},
+		SetGlobal: func(_ context.Context, _ *SessionVars, val string) error {
+			SQLFirewallMode.Store(val)
+			return nil
+		}, GetGlobal: func(_ context.Context, _ *SessionVars) (string, error) {
+			return SQLFirewallMode.Load(), nil
+		}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBWriteConflictMaxRetries, Value: strconv.Itoa(DefTiDBWriteConflictMaxRetries), Type: TypeInt, MinValue: -1, MaxValue: math.MaxInt32, IsHintUpdatableVerified: true,
+		SetSession: func(s *SessionVars, val string) error {
+			s.WriteConflictMaxRetries = TidbOptInt(val, DefTiDBWriteConflictMaxRetries)
+			return nil
+		}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBWriteConflictRetryBackoff, Value: strconv.Itoa(DefTiDBWriteConflictRetryBackoff), Type: TypeUnsigned, MinValue: 0, MaxValue: 60000, IsHintUpdatableVerified: true,
+		SetSession: func(s *SessionVars, val string) error {
+			s.WriteConflictRetryBackoff = time.Duration(TidbOptInt64(val, DefTiDBWriteConflictRetryBackoff)) * time.Millisecond
+			return nil
+		}},
+	{Scope: ScopeGlobal | ScopeSession, Name: TiDBRegionErrorMaxRetries, Value: strconv.Itoa(DefTiDBRegionErrorMaxRetries), Type: TypeInt, MinValue: -1, MaxValue: math.MaxInt32, IsHintUpdatableVerified: true,
+		SetSession: func(s *SessionVars, val string) error {
+			s.RegionErrorMaxRetries = TidbOptInt(val, DefTiDBRegionErrorMaxRetries)
+			return nil
+		}},
+	{Scope: ScopeGlobal | ScopeSession, Name: OptimizerSwitch, Value: DefOptimizerSwitch, IsHintUpdatableVerified: true,
+		Validation: func(vars *SessionVars, normalizedValue string, _ string, scope ScopeFlag) (string, error) {
+			// Like MySQL, the flags which are not assigned keep their current values.
+			var current string
+			var err error
+			if scope == ScopeGlobal {
+				current, err = vars.GlobalVarsAccessor.GetGlobalSysVar(OptimizerSwitch)
+			} else {
+				current, err = vars.GetSessionOrGlobalSystemVar(context.Background(), OptimizerSwitch)
+			}
+			if err != nil {
+				return normalizedValue, err
+			}
+			return mergeOptimizerSwitch(vars, current, normalizedValue)
+		}, SetSession: func(s *SessionVars, val string) error {
+			// The validation loads the current value before SET changes it. If there is no current value, the value
+			// is being loaded when the session is initialized, and the mapped TiDB variables are loaded from their own
+			// values, which must not be overwritten depending on the order of the initialization.
+			old, ok := s.systems[OptimizerSwitch]
+			if !ok {
+				return nil
+			}
+			return applyOptimizerSwitch(old, val, s.SetSystemVar)
+		}, SetGlobal: func(ctx context.Context, s *SessionVars, val string) error {
+			// SetGlobal is called before the new value is stored, so the current global value is the old one.
+			old, err := s.GlobalVarsAccessor.GetGlobalSysVar(OptimizerSwitch)
+			if err != nil {
+				return err
+			}
+			return applyOptimizerSwitch(old, val, func(name, val string) error {
+				return s.GlobalVarsAccessor.SetGlobalSysVar(ctx, name, val)
+			})
+		}},
 }
 
 // GlobalSystemVariableInitialValue gets the default value for a system variable including ones that are dynamically set (e.g. based on the store)
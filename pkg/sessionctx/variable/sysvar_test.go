@@ -31,6 +31,7 @@ import (
 	"github.com/pingcap/tidb/pkg/config"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/pkg/util/gctuner"
 	"github.com/pingcap/tidb/pkg/util/memory"
 	"github.com/pingcap/tidb/pkg/util/timeutil"
@@ -1605,3 +1606,143 @@ func TestTiDBLowResTSOUpdateInterval(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, "1000", val)
 }
+
+func TestTiDBPessimisticTxnFairLockingTables(t *testing.T) {
+	sv := GetSysVar(TiDBPessimisticTransactionFairLockingTables)
+	vars := NewSessionVars(nil)
+	require.Nil(t, vars.PessimisticTransactionFairLockingTables)
+	require.False(t, vars.UseFairLocking())
+
+	for _, val := range []string{"test", "test.t, db", "@/tmp/filter"} {
+		_, err := sv.Validate(vars, val, ScopeSession)
+		require.ErrorContains(t, err, "Variable 'tidb_pessimistic_txn_fair_locking_tables' can't be set to the value")
+	}
+
+	require.NoError(t, sv.SetSessionFromHook(vars, "test.hot_*, Db2.T"))
+	vars.StmtCtx.Tables = []stmtctx.TableEntry{{DB: "test", Table: "t"}}
+	require.False(t, vars.UseFairLocking())
+	vars.StmtCtx.Tables = []stmtctx.TableEntry{{DB: "test", Table: "t"}, {DB: "test", Table: "hot_counter"}}
+	require.True(t, vars.UseFairLocking())
+	vars.StmtCtx.Tables = []stmtctx.TableEntry{{DB: "db2", Table: "t"}}
+	require.True(t, vars.UseFairLocking())
+
+	require.NoError(t, sv.SetSessionFromHook(vars, ""))
+	require.Nil(t, vars.PessimisticTransactionFairLockingTables)
+	require.False(t, vars.UseFairLocking())
+	vars.PessimisticTransactionFairLocking = true
+	require.True(t, vars.UseFairLocking())
+}
+
+func TestOptimizerSwitch(t *testing.T) {
+	vars := NewSessionVars(nil)
+	mock := NewMockGlobalAccessor4Tests()
+	mock.SessionVars = vars
+	vars.GlobalVarsAccessor = mock
+	require.True(t, vars.GetEnableIndexMerge())
+	require.True(t, vars.GetAllowInSubqToJoinAndAgg())
+
+	// The flags which are not assigned keep their current values.
+	require.NoError(t, vars.SetSystemVar(OptimizerSwitch, "index_merge=off, DERIVED_MERGE=OFF"))
+	val, err := vars.GetSessionOrGlobalSystemVar(context.Background(), OptimizerSwitch)
+	require.NoError(t, err)
+	require.Contains(t, val, "index_merge=off,index_merge_union=on")
+	require.Contains(t, val, ",derived_merge=off,")
+	require.Contains(t, val, ",semijoin=on,")
+	require.False(t, vars.GetEnableIndexMerge())
+	val, err = vars.GetSessionOrGlobalSystemVar(context.Background(), TiDBEnableIndexMerge)
+	require.NoError(t, err)
+	require.Equal(t, Off, val)
+
+	// The TiDB variables of the unchanged flags are not overwritten.
+	require.NoError(t, vars.SetSystemVar(TiDBEnableIndexMerge, On))
+	require.NoError(t, vars.SetSystemVar(OptimizerSwitch, "hash_join=off,use_invisible_indexes=on"))
+	require.True(t, vars.GetEnableIndexMerge())
+	require.True(t, vars.DisableHashJoin)
+	require.True(t, vars.OptimizerUseInvisibleIndexes)
+	require.True(t, vars.GetAllowInSubqToJoinAndAgg())
+
+	// The flags without a TiDB equivalent are accepted with a warning if they're changed from their defaults.
+	vars.StmtCtx.SetWarnings(nil)
+	require.NoError(t, vars.SetSystemVar(OptimizerSwitch, "semijoin=off,index_merge=on,MRR=off,batched_key_access=off"))
+	warnings := vars.StmtCtx.GetWarnings()
+	require.Len(t, warnings, 2)
+	require.EqualError(t, warnings[0].Err, "[variable:8047]variable 'optimizer_switch' does not yet support value: mrr=off")
+	require.EqualError(t, warnings[1].Err, "[variable:8047]variable 'optimizer_switch' does not yet support value: semijoin=off")
+	val, err = vars.GetSessionOrGlobalSystemVar(context.Background(), OptimizerSwitch)
+	require.NoError(t, err)
+	require.Contains(t, val, ",mrr=off,")
+	require.Contains(t, val, ",semijoin=off,")
+	vars.StmtCtx.SetWarnings(nil)
+	require.NoError(t, vars.SetSystemVar(OptimizerSwitch, "semijoin=on,mrr=default,hash_set_operations=on"))
+	require.Empty(t, vars.StmtCtx.GetWarnings())
+
+	require.NoError(t, vars.SetSystemVar(OptimizerSwitch, "default"))
+	val, err = vars.GetSessionOrGlobalSystemVar(context.Background(), OptimizerSwitch)
+	require.NoError(t, err)
+	require.Equal(t, DefOptimizerSwitch, val)
+	require.False(t, vars.DisableHashJoin)
+	require.False(t, vars.OptimizerUseInvisibleIndexes)
+
+	for _, invalid := range []string{"unknown_flag=on", "index_merge=maybe", "index_merge"} {
+		err = vars.SetSystemVar(OptimizerSwitch, invalid)
+		require.ErrorContains(t, err, "Variable 'optimizer_switch' can't be set to the value")
+	}
+
+	// Loading the value when the session is initialized doesn't overwrite the mapped TiDB variables,
+	// whatever the order of the initialization is.
+	initVars := NewSessionVars(nil)
+	initVars.GlobalVarsAccessor = NewMockGlobalAccessor4Tests()
+	require.NoError(t, initVars.SetSystemVarWithoutValidation(TiDBEnableIndexMerge, On))
+	require.NoError(t, initVars.SetSystemVarWithoutValidation(OptimizerSwitch, "index_merge=off,hash_join=off"))
+	require.True(t, initVars.GetEnableIndexMerge())
+	require.False(t, initVars.DisableHashJoin)
+
+	// SET GLOBAL sets the global values of the mapped TiDB variables.
+	ctx := context.Background()
+	require.NoError(t, mock.SetGlobalSysVar(ctx, TiDBEnableIndexMerge, Off))
+	require.NoError(t, mock.SetGlobalSysVar(ctx, OptimizerSwitch, "hash_join=off"))
+	val, err = mock.GetGlobalSysVar(OptimizerSwitch)
+	require.NoError(t, err)
+	require.Contains(t, val, ",hash_join=off")
+	val, err = mock.GetGlobalSysVar(TiDBOptEnableHashJoin)
+	require.NoError(t, err)
+	require.Equal(t, Off, val)
+	val, err = mock.GetGlobalSysVar(TiDBEnableIndexMerge)
+	require.NoError(t, err)
+	require.Equal(t, Off, val)
+	require.False(t, vars.DisableHashJoin)
+	require.NoError(t, mock.SetGlobalSysVar(ctx, OptimizerSwitch, "default"))
+	val, err = mock.GetGlobalSysVar(TiDBOptEnableHashJoin)
+	require.NoError(t, err)
+	require.Equal(t, On, val)
+}
+
+func TestOptIgnoredIndexes(t *testing.T) {
+	vars := NewSessionVars(nil)
+	vars.GlobalVarsAccessor = NewMockGlobalAccessor4Tests()
+	vars.CurrentDB = "Test"
+	require.False(t, vars.IsIndexIgnored("test", "t", "a"))
+
+	require.NoError(t, vars.SetSystemVar(TiDBOptIgnoredIndexes, " T.A, db1.t2.idx ,, t.a"))
+	val, err := vars.GetSessionOrGlobalSystemVar(context.Background(), TiDBOptIgnoredIndexes)
+	require.NoError(t, err)
+	require.Equal(t, "t.a,db1.t2.idx", val)
+	require.Equal(t, "t.a,db1.t2.idx", vars.OptimizerIgnoredIndexes)
+	// the indexes without the database belong to the current database.
+	require.True(t, vars.IsIndexIgnored("test", "t", "a"))
+	require.True(t, vars.IsIndexIgnored("TEST", "t", "a"))
+	require.False(t, vars.IsIndexIgnored("db1", "t", "a"))
+	require.True(t, vars.IsIndexIgnored("db1", "t2", "idx"))
+	require.False(t, vars.IsIndexIgnored("test", "t2", "idx"))
+	require.False(t, vars.IsIndexIgnored("test", "t", "b"))
+
+	for _, invalid := range []string{"t", "t.", "db.t.a.b", "t..a"} {
+		err = vars.SetSystemVar(TiDBOptIgnoredIndexes, invalid)
+		require.ErrorContains(t, err, "Variable 'tidb_opt_ignored_indexes' can't be set to the value")
+	}
+	require.Equal(t, "t.a,db1.t2.idx", vars.OptimizerIgnoredIndexes)
+
+	require.NoError(t, vars.SetSystemVar(TiDBOptIgnoredIndexes, ""))
+	require.Equal(t, "", vars.OptimizerIgnoredIndexes)
+	require.False(t, vars.IsIndexIgnored("test", "t", "a"))
+}
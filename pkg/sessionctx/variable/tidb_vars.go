@@ -141,6 +141,27 @@ const (
 	TiDBMemQuotaQuery = "tidb_mem_quota_query" // Bytes.
 	// TiDBMemQuotaApplyCache controls the memory quota of a query.
 	TiDBMemQuotaApplyCache = "tidb_mem_quota_apply_cache"
+	// TiDBMemQuotaOperator controls the memory quota of a single spillable operator, such as Sort, HashAgg and HashJoin.
+	// When an operator exceeds it, the operator spills its data to disk first, and the statement-level action
+	// controlled by tidb_mem_quota_query is only triggered when the whole query exceeds its quota.
+	TiDBMemQuotaOperator = "tidb_mem_quota_operator" // Bytes.
+
+	// TiDBExplainAnalyzeTopRegions controls how many of the slowest regions are reported for each coprocessor
+	// reader in the result of `EXPLAIN ANALYZE`, together with the per-store breakdown. 0 disables the breakdown.
+	TiDBExplainAnalyzeTopRegions = "tidb_explain_analyze_top_regions"
+
+	// TiDBExplainShowHintsInUse controls whether to append a "Hints In Use" section to the result of `EXPLAIN`,
+	// which lists each supplied hint, where it comes from and whether it's matched.
+	TiDBExplainShowHintsInUse = "tidb_explain_show_hints_in_use"
+
+	// TiDBExplainShowCostDetails controls whether to append the "Cost Details" and "Plan Constraints" sections to the
+	// result of `EXPLAIN FORMAT='verbose'`, which break the cost of each operator into its factors and list the hints
+	// and variables restricting the alternative plans.
+	TiDBExplainShowCostDetails = "tidb_explain_show_cost_details"
+
+	// TiDBOptTraceHints controls whether to trace how the hints of a statement are matched with its tables. The
+	// matching decisions are written to the log and the optimizer debug trace.
+	TiDBOptTraceHints = "tidb_opt_trace_hints"
 
 	// TiDBGeneralLog is used to log every query in the server in info level.
 	TiDBGeneralLog = "tidb_general_log"
@@ -298,6 +319,10 @@ const (
 	// If the query has a LIMIT clause, high concurrency makes the system do much more work than needed.
 	TiDBDistSQLScanConcurrency = "tidb_distsql_scan_concurrency"
 
+	// TiDBEnableAdaptiveDistSQLScanConcurrency indicates whether to tune the distsql scan concurrency of each statement
+	// by the regions and stores it touches and the current load of the instance, instead of using tidb_distsql_scan_concurrency statically.
+	TiDBEnableAdaptiveDistSQLScanConcurrency = "tidb_enable_adaptive_distsql_scan_concurrency"
+
 	// TiDBAnalyzeDistSQLScanConcurrency is used to set the concurrency of a distsql scan task for analyze statement.
 	TiDBAnalyzeDistSQLScanConcurrency = "tidb_analyze_distsql_scan_concurrency"
 
@@ -355,6 +380,12 @@ const (
 	// Large value may do more work than needed if the query has a limit.
 	TiDBIndexLookupSize = "tidb_index_lookup_size"
 
+	// TiDBIndexLookupPrefetchTasks is used for index lookup executor and index lookup join executor.
+	// It controls how many lookup tasks can be prepared ahead of the workers consuming them, so the index scan
+	// (or outer side of index join) keeps running while the table lookups are waiting for TiKV responses.
+	// Large value hides more round-trip latency but holds more fetched handles in memory.
+	TiDBIndexLookupPrefetchTasks = "tidb_index_lookup_prefetch_tasks"
+
 	// TiDBIndexLookupConcurrency is used for index lookup executor.
 	// A lookup task may have 'tidb_index_lookup_size' of handles at maximum, the handles may be distributed
 	// in many TiKV nodes, we execute multiple concurrent index lookup tasks concurrently to reduce the time
@@ -615,6 +646,14 @@ const (
 	// Now, only support TiKV and TiFlash.
 	TiDBIsolationReadEngines = "tidb_isolation_read_engines"
 
+	// TiDBReadFromStorageFallbackPolicy indicates how to handle the `read_from_storage(tiflash[t])` hint when the tiflash
+	// replica of the table is not available. Possible values are WARN, ERROR and WAIT.
+	TiDBReadFromStorageFallbackPolicy = "tidb_read_from_storage_fallback_policy"
+
+	// TiDBReadFromStorageFallbackWaitTimeout indicates the max seconds to wait for the tiflash replica to become available
+	// when tidb_read_from_storage_fallback_policy is WAIT.
+	TiDBReadFromStorageFallbackWaitTimeout = "tidb_read_from_storage_fallback_wait_timeout"
+
 	// TiDBStoreLimit indicates the limit of sending request to a store, 0 means without limit.
 	TiDBStoreLimit = "tidb_store_limit"
 
@@ -694,6 +733,10 @@ const (
 	// Now we only support TiFlash.
 	TiDBAllowFallbackToTiKV = "tidb_allow_fallback_to_tikv"
 
+	// TiDBEnableMPPFailureRetry indicates whether to retry the mpp tasks of a query on the other TiFlash nodes when
+	// a TiFlash node fails before any result is returned to the client.
+	TiDBEnableMPPFailureRetry = "tidb_enable_mpp_failure_retry"
+
 	// TiDBEnableTopSQL indicates whether the top SQL is enabled.
 	TiDBEnableTopSQL = "tidb_enable_top_sql"
 
@@ -738,6 +781,15 @@ const (
 	// TiDBRegardNULLAsPoint indicates whether regard NULL as point when optimizing
 	TiDBRegardNULLAsPoint = "tidb_regard_null_as_point"
 
+	// TiDBCTEMaxRecursionRows indicates the max number of rows a recursive common table expression (CTE) can materialize.
+	// 0 means no limit.
+	TiDBCTEMaxRecursionRows = "tidb_cte_max_recursion_rows"
+
+	// TiDBSequenceSessionCacheSize indicates the number of sequence values a session takes from the instance cache of
+	// a sequence at a time, NEXTVAL is served from the values taken by the session until they are used up.
+	// 0 and 1 mean the session doesn't cache the values.
+	TiDBSequenceSessionCacheSize = "tidb_sequence_session_cache_size"
+
 	// TiDBTmpTableMaxSize indicates the max memory size of temporary tables.
 	TiDBTmpTableMaxSize = "tidb_tmp_table_max_size"
 
@@ -853,6 +905,10 @@ const (
 	TiDBOptAdvancedJoinHint = "tidb_opt_advanced_join_hint"
 	// TiDBOptUseInvisibleIndexes indicates whether to use invisible indexes.
 	TiDBOptUseInvisibleIndexes = "tidb_opt_use_invisible_indexes"
+
+	// TiDBOptIgnoredIndexes is a comma separated list of the indexes which the optimizer pretends don't exist, in the
+	// form of `db.tbl.idx` or `tbl.idx`. It's used to simulate dropping the indexes before actually dropping them.
+	TiDBOptIgnoredIndexes = "tidb_opt_ignored_indexes"
 	// TiDBAnalyzePartitionConcurrency indicates concurrency for save/read partitions stats in Analyze
 	TiDBAnalyzePartitionConcurrency = "tidb_analyze_partition_concurrency"
 	// TiDBMergePartitionStatsConcurrency indicates the concurrency when merge partition stats into global stats
@@ -887,6 +943,10 @@ const (
 	// is enabled.
 	TiDBPessimisticTransactionFairLocking = "tidb_pessimistic_txn_fair_locking"
 
+	// TiDBPessimisticTransactionFairLockingTables is a comma separated list of table filters, the pessimistic
+	// statements accessing the matched tables use fair locking even if tidb_pessimistic_txn_fair_locking is OFF.
+	TiDBPessimisticTransactionFairLockingTables = "tidb_pessimistic_txn_fair_locking_tables"
+
 	// TiDBEnablePlanCacheForParamLimit controls whether prepare statement with parameterized limit can be cached
 	TiDBEnablePlanCacheForParamLimit = "tidb_enable_plan_cache_for_param_limit"
 
@@ -911,8 +971,19 @@ const (
 	// via the ordering index.
 	TiDBOptOrderingIdxSelRatio = "tidb_opt_ordering_index_selectivity_ratio"
 
+	// TiDBOptEnableAdaptiveReoptimization indicates whether to re-optimize a query during its execution when the actual
+	// row count of a data source exceeds its estimation by tidb_opt_reoptimization_misestimate_ratio times.
+	// Only the read-only SELECT statements which haven't returned any row to the client are re-optimized.
+	TiDBOptEnableAdaptiveReoptimization = "tidb_opt_enable_adaptive_reoptimization"
+	// TiDBOptReoptimizationMisestimateRatio is the ratio of the actual row count to the estimated row count of a data
+	// source, beyond which the query is re-optimized with the observed row count.
+	TiDBOptReoptimizationMisestimateRatio = "tidb_opt_reoptimization_misestimate_ratio"
+
 	// TiDBOptEnableMPPSharedCTEExecution indicates whether the optimizer try to build shared CTE scan during MPP execution.
 	TiDBOptEnableMPPSharedCTEExecution = "tidb_opt_enable_mpp_shared_cte_execution"
+	// TiDBOptEnableFKCardinalityEstimation indicates whether the optimizer uses the declared foreign keys to bound the
+	// estimated row count of the join between the child table and the parent table.
+	TiDBOptEnableFKCardinalityEstimation = "tidb_opt_enable_fk_cardinality_estimation"
 	// TiDBOptFixControl makes the user able to control some details of the optimizer behavior.
 	TiDBOptFixControl = "tidb_opt_fix_control"
 
@@ -988,6 +1059,9 @@ const (
 	TiDBRCReadCheckTS = "tidb_rc_read_check_ts"
 	// TiDBRCWriteCheckTs indicates whether some special write statements don't get latest tso from PD at RC
 	TiDBRCWriteCheckTs = "tidb_rc_write_check_ts"
+	// TiDBRCReadTSReuseInterval indicates the interval in milliseconds within which the read-only statements of a
+	// read-committed transaction reuse the latest fetched tso instead of getting a new one from PD.
+	TiDBRCReadTSReuseInterval = "tidb_rc_read_ts_reuse_interval"
 	// TiDBCommitterConcurrency controls the number of running concurrent requests in the commit phase.
 	TiDBCommitterConcurrency = "tidb_committer_concurrency"
 	// TiDBEnableBatchDML enables batch dml.
@@ -1156,9 +1230,54 @@ const (
 	// TiDBLowResolutionTSOUpdateInterval defines how often to refresh low resolution timestamps.
 	TiDBLowResolutionTSOUpdateInterval = "tidb_low_resolution_tso_update_interval"
 	// TiDBDMLType indicates the execution type of DML in TiDB.
-	// The value can be STANDARD, BULK.
-	// Currently, the BULK mode only affects auto-committed DML.
+	// The value can be STANDARD, BULK, AUTO.
+	// Currently, the BULK mode only affects auto-committed DML. In AUTO mode, an auto-committed DML is executed in
+	// STANDARD mode first, and retried in BULK mode if it exceeds the transaction size limit.
 	TiDBDMLType = "tidb_dml_type"
+	// TiDBDMLBulkBatchSize indicates the max number of mutations a BULK DML buffers in TiDB before flushing them
+	// to TiKV. 0 means the mutations are flushed according to the memory usage of the transaction.
+	TiDBDMLBulkBatchSize = "tidb_dml_bulk_batch_size"
+	// TiDBEnablePlanRegressionDetection indicates whether to track the plans and latencies of the statements per
+	// digest, and raise an event when the plan of a digest changes and its latency degrades.
+	TiDBEnablePlanRegressionDetection = "tidb_enable_plan_regression_detection"
+	// TiDBPlanRegressionLatencyRatio is the ratio of the average latency of the new plan to the one of the old plan,
+	// beyond which the plan change is regarded as a regression.
+	TiDBPlanRegressionLatencyRatio = "tidb_plan_regression_latency_ratio"
+	// TiDBPlanRegressionMinExecCount is the minimum execution count of both the old and new plans to compare their
+	// latencies in the plan regression detection.
+	TiDBPlanRegressionMinExecCount = "tidb_plan_regression_min_exec_count"
+	// TiDBEnablePlanRegressionShadowBinding indicates whether to create a disabled binding for the old plan when a
+	// plan regression is detected, so that it can be enabled to pin the old plan quickly.
+	TiDBEnablePlanRegressionShadowBinding = "tidb_enable_plan_regression_shadow_binding"
+	// TiDBAutoTableCache indicates the mode of the automatic table caching, which identifies the small, hot and
+	// read-mostly tables from the statements summary. OFF disables it, ADVISE only suggests the tables to cache or
+	// evict, and ON also applies the suggestions by `ALTER TABLE ... CACHE` and `ALTER TABLE ... NOCACHE`.
+	TiDBAutoTableCache = "tidb_auto_table_cache"
+	// TiDBAutoSplitHotRegions indicates the mode of the automatic hot region splitting, which identifies the write
+	// hotspots from the hot regions of PD. OFF disables it, ADVISE only suggests the `SPLIT TABLE` statements, and ON
+	// also executes them to split and scatter the hot regions.
+	TiDBAutoSplitHotRegions = "tidb_auto_split_hot_regions"
+	// TiDBRunawayCoolingHints is the hints attached to the SQL digest of a query identified as runaway by the rules of
+	// its resource group, e.g. `MEMORY_QUOTA(1 GB) MAX_EXECUTION_TIME(10000) SET_VAR(tidb_allow_mpp=OFF)`, so that
+	// the following executions of the statement are cooled down. Empty means no hints are attached.
+	TiDBRunawayCoolingHints = "tidb_runaway_cooling_hints"
+	// TiDBRunawayCoolingDuration is how long the cooling hints are attached to the SQL digest of a runaway query.
+	TiDBRunawayCoolingDuration = "tidb_runaway_cooling_duration"
+	// TiDBSQLFirewallMode indicates the mode of the SQL firewall, which matches the statements with the rules in
+	// mysql.tidb_sql_firewall_rules by the SQL digests or the patterns of the normalized SQL. OFF disables it, LEARNING
+	// blocks nothing but records the unmatched digests as ALLOW rules, DENY blocks the statements matching the DENY
+	// rules, and ALLOW also blocks the statements not matching any ALLOW rule. The statements matching the REWRITE
+	// rules are replaced in the DENY and ALLOW modes.
+	TiDBSQLFirewallMode = "tidb_sql_firewall_mode"
+	// TiDBWriteConflictMaxRetries is the max number of times a statement in a pessimistic transaction is retried
+	// on the write conflicts. -1 means using `pessimistic-txn.max-retry-count` in the config, and 0 means never retrying.
+	TiDBWriteConflictMaxRetries = "tidb_write_conflict_max_retries"
+	// TiDBWriteConflictRetryBackoff is the backoff in milliseconds before the first retry of a statement on the write
+	// conflicts, it's doubled on each of the following retries. 0 means retrying without any backoff.
+	TiDBWriteConflictRetryBackoff = "tidb_write_conflict_retry_backoff"
+	// TiDBRegionErrorMaxRetries is the max number of times the coprocessor requests of a statement are retried on the
+	// region errors. -1 means the retries are only limited by the backoff budget, and 0 means never retrying.
+	TiDBRegionErrorMaxRetries = "tidb_region_error_max_retries"
 )
 
 // TiDB intentional limits
@@ -1178,6 +1297,7 @@ const (
 	DefIndexSerialScanConcurrency                  = 1
 	DefIndexJoinBatchSize                          = 25000
 	DefIndexLookupSize                             = 20000
+	DefIndexLookupPrefetchTasks                    = 0
 	DefDistSQLScanConcurrency                      = 15
 	DefAnalyzeDistSQLScanConcurrency               = 4
 	DefBuildStatsConcurrency                       = 2
@@ -1224,6 +1344,11 @@ const (
 	DefMaxPreparedStmtCount                        = -1
 	DefWaitTimeout                                 = 28800
 	DefTiDBMemQuotaApplyCache                      = 32 << 20 // 32MB.
+	DefTiDBMemQuotaOperator                        = 0
+	DefTiDBExplainAnalyzeTopRegions                = 0
+	DefTiDBExplainShowHintsInUse                   = false
+	DefTiDBExplainShowCostDetails                  = false
+	DefTiDBOptTraceHints                           = false
 	DefTiDBMemQuotaBindingCache                    = 64 << 20 // 64MB.
 	DefTiDBGeneralLog                              = false
 	DefTiDBPProfSQLCPU                             = 0
@@ -1316,6 +1441,8 @@ const (
 	DefTiDBEnableIndexMergeJoin                    = false
 	DefTiDBTrackAggregateMemoryUsage               = true
 	DefCTEMaxRecursionDepth                        = 1000
+	DefTiDBCTEMaxRecursionRows                     = 0
+	DefTiDBSequenceSessionCacheSize                = 0
 	DefTiDBTmpTableMaxSize                         = 64 << 20 // 64MB.
 	DefTiDBEnableLocalTxn                          = false
 	DefTiDBTSOClientBatchMaxWaitTime               = 0.0 // 0ms
@@ -1407,6 +1534,7 @@ const (
 	DefTiDBAutoBuildStatsConcurrency             = 1
 	DefTiDBSysProcScanConcurrency                = 1
 	DefTiDBRcWriteCheckTs                        = false
+	DefTiDBRCReadTSReuseInterval                 = 0
 	DefTiDBForeignKeyChecks                      = true
 	DefTiDBOptAdvancedJoinHint                   = true
 	DefTiDBAnalyzePartitionConcurrency           = 2
@@ -1456,7 +1584,10 @@ const (
 	DefTiDBOptEnableLateMaterialization               = true
 	DefTiDBOptOrderingIdxSelThresh                    = 0.0
 	DefTiDBOptOrderingIdxSelRatio                     = -1
+	DefTiDBOptEnableAdaptiveReoptimization            = false
+	DefTiDBOptReoptimizationMisestimateRatio          = 10.0
 	DefTiDBOptEnableMPPSharedCTEExecution             = false
+	DefTiDBOptEnableFKCardinalityEstimation           = false
 	DefTiDBPlanCacheInvalidationOnFreshStats          = true
 	DefTiDBEnableRowLevelChecksum                     = false
 	DefAuthenticationLDAPSASLAuthMethodName           = "SCRAM-SHA-1"
@@ -1487,8 +1618,35 @@ const (
 	DefTiDBLowResolutionTSOUpdateInterval             = 2000
 	DefDivPrecisionIncrement                          = 4
 	DefTiDBDMLType                                    = "STANDARD"
+	DefTiDBDMLBulkBatchSize                           = 0
+	DefTiDBEnablePlanRegressionDetection              = false
+	DefTiDBPlanRegressionLatencyRatio                 = 2.0
+	DefTiDBPlanRegressionMinExecCount                 = 10
+	DefTiDBEnablePlanRegressionShadowBinding          = false
+	DefTiDBAutoTableCache                             = Off
+	DefTiDBAutoSplitHotRegions                        = Off
+	DefTiDBRunawayCoolingHints                        = ""
+	DefTiDBRunawayCoolingDuration                     = 10 * time.Minute
+	DefTiDBSQLFirewallMode                            = Off
+	DefTiDBWriteConflictMaxRetries                    = -1
+	DefTiDBWriteConflictRetryBackoff                  = 0
+	DefTiDBRegionErrorMaxRetries                      = -1
+	DefOptimizerSwitch                                = "index_merge=on,index_merge_union=on,index_merge_sort_union=on,index_merge_intersection=on,engine_condition_pushdown=on,index_condition_pushdown=on,mrr=on,mrr_cost_based=on,block_nested_loop=on,batched_key_access=off,materialization=on,semijoin=on,loosescan=on,firstmatch=on,duplicateweedout=on,subquery_materialization_cost_based=on,use_index_extensions=on,condition_fanout_filter=on,derived_merge=on,use_invisible_indexes=off,skip_scan=on,hash_join=on,subquery_to_derived=off,prefer_ordering_index=on,hypergraph_optimizer=off,derived_condition_pushdown=on,hash_set_operations=on"
 	DefGroupConcatMaxLen                              = uint64(1024)
 	DefDefaultWeekFormat                              = "0"
+	// DefTiDBPessimisticTransactionFairLockingTables is the default value of TiDBPessimisticTransactionFairLockingTables.
+	DefTiDBPessimisticTransactionFairLockingTables = ""
+	// DefTiDBReadFromStorageFallbackPolicy is the default value of TiDBReadFromStorageFallbackPolicy.
+	DefTiDBReadFromStorageFallbackPolicy = ReadFromStorageFallbackWarn
+	// DefTiDBReadFromStorageFallbackWaitTimeout is the default value of TiDBReadFromStorageFallbackWaitTimeout.
+	DefTiDBReadFromStorageFallbackWaitTimeout = 10
+	// MaxTiDBReadFromStorageFallbackWaitTimeout is the max value of TiDBReadFromStorageFallbackWaitTimeout. The wait
+	// blocks the statement before it reads from tiflash, so it is kept short.
+	MaxTiDBReadFromStorageFallbackWaitTimeout = 60
+	// DefTiDBEnableAdaptiveDistSQLScanConcurrency is the default value of TiDBEnableAdaptiveDistSQLScanConcurrency.
+	DefTiDBEnableAdaptiveDistSQLScanConcurrency = false
+	// DefTiDBEnableMPPFailureRetry is the default value of TiDBEnableMPPFailureRetry.
+	DefTiDBEnableMPPFailureRetry = false
 )
 
 // Process global variables.
@@ -1596,6 +1754,16 @@ var (
 	TxnEntrySizeLimit         = atomic.NewUint64(DefTiDBTxnEntrySizeLimit)
 
 	SchemaCacheSize = atomic.NewInt64(DefTiDBSchemaCacheSize)
+
+	EnablePlanRegressionDetection     = atomic.NewBool(DefTiDBEnablePlanRegressionDetection)
+	PlanRegressionLatencyRatio        = atomic.NewFloat64(DefTiDBPlanRegressionLatencyRatio)
+	PlanRegressionMinExecCount        = atomic.NewInt64(DefTiDBPlanRegressionMinExecCount)
+	EnablePlanRegressionShadowBinding = atomic.NewBool(DefTiDBEnablePlanRegressionShadowBinding)
+	AutoTableCacheMode                = atomic.NewString(DefTiDBAutoTableCache)
+	AutoSplitHotRegionsMode           = atomic.NewString(DefTiDBAutoSplitHotRegions)
+	RunawayCoolingHints               = atomic.NewString(DefTiDBRunawayCoolingHints)
+	RunawayCoolingDuration            = atomic.NewDuration(DefTiDBRunawayCoolingDuration)
+	SQLFirewallMode                   = atomic.NewString(DefTiDBSQLFirewallMode)
 )
 
 var (
@@ -83,6 +83,21 @@ const (
 	OOMActionCancel = "CANCEL"
 	// OOMActionLog constants represents the valid action configurations for OOMAction "LOG".
 	OOMActionLog = "LOG"
+	// AutoTableCacheAdvise is a choice of variable TiDBAutoTableCache that means the tables to cache or evict are
+	// only suggested but not applied.
+	AutoTableCacheAdvise = "ADVISE"
+	// AutoSplitHotRegionsAdvise is a choice of variable TiDBAutoSplitHotRegions that means the hot regions to split
+	// are only suggested but not split.
+	AutoSplitHotRegionsAdvise = "ADVISE"
+	// SQLFirewallLearning is a choice of variable TiDBSQLFirewallMode that means no statement is blocked, and the
+	// digests not matching any rule are recorded as ALLOW rules.
+	SQLFirewallLearning = "LEARNING"
+	// SQLFirewallDeny is a choice of variable TiDBSQLFirewallMode that means the statements matching the DENY rules
+	// are blocked.
+	SQLFirewallDeny = "DENY"
+	// SQLFirewallAllow is a choice of variable TiDBSQLFirewallMode that means the statements not matching any ALLOW
+	// rule are blocked too.
+	SQLFirewallAllow = "ALLOW"
 )
 
 // Global config name list.
@@ -557,10 +572,12 @@ func (sv *SysVar) SkipInit() bool {
 // to not use a different table internally, but to do that we need to first
 // fix upgrade/downgrade so we know that older servers won't be in the cluster
 // which update only these values.
+// The SetGlobal func of optimizer_switch sets the global values of the mapped
+// TiDB variables, which must only happen once on the instance running SET GLOBAL.
 func (sv *SysVar) SkipSysvarCache() bool {
 	switch sv.Name {
 	case TiDBGCEnable, TiDBGCRunInterval, TiDBGCLifetime,
-		TiDBGCConcurrency, TiDBGCScanLockMode, TiDBExternalTS:
+		TiDBGCConcurrency, TiDBGCScanLockMode, TiDBExternalTS, OptimizerSwitch:
 		return true
 	}
 	return false
@@ -18,6 +18,7 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"maps"
 	"slices"
 	"strconv"
 	"strings"
@@ -31,6 +32,7 @@ import (
 	"github.com/pingcap/tidb/pkg/types"
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/memory"
+	filter "github.com/pingcap/tidb/pkg/util/table-filter"
 	"github.com/tikv/client-go/v2/oracle"
 )
 
@@ -523,6 +525,8 @@ var GAFunction4ExpressionIndex = map[string]struct{}{
 	ast.Reverse:    {},
 	ast.VitessHash: {},
 	ast.TiDBShard:  {},
+	// FULLTEXT index is an expression index on the tokens.
+	ast.TiDBFullTextTokens: {},
 	// JSON functions.
 	ast.JSONType:          {},
 	ast.JSONExtract:       {},
@@ -586,3 +590,138 @@ func ParseAnalyzeSkipColumnTypes(val string) map[string]struct{} {
 	}
 	return skipTypes
 }
+
+// parseFairLockingTables parses the value of tidb_pessimistic_txn_fair_locking_tables, which is a comma separated list
+// of table filters such as `db.hot_counter, db2.*`.
+func parseFairLockingTables(val string) (filter.Filter, error) {
+	var rules []string
+	for _, rule := range strings.Split(val, ",") {
+		rule = strings.TrimSpace(rule)
+		if rule == "" {
+			continue
+		}
+		// Importing the filter files is not allowed, since the value can be set by any user.
+		if strings.HasPrefix(rule, "@") {
+			return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBPessimisticTransactionFairLockingTables, val)
+		}
+		rules = append(rules, rule)
+	}
+	if len(rules) == 0 {
+		return nil, nil
+	}
+	f, err := filter.Parse(rules)
+	if err != nil {
+		return nil, ErrWrongValueForVar.GenWithStackByArgs(TiDBPessimisticTransactionFairLockingTables, val)
+	}
+	return filter.CaseInsensitive(f), nil
+}
+
+// optimizerSwitchFlags lists the flags of optimizer_switch in the order they are displayed, together with the TiDB
+// system variables which control the same optimizations. The flags without a TiDB variable are accepted for
+// compatibility with MySQL, but take no effect, and a warning is returned if they're changed from their defaults.
+var optimizerSwitchFlags = []struct {
+	name   string
+	sysVar string
+}{
+	{"index_merge", TiDBEnableIndexMerge},
+	{"index_merge_union", ""},
+	{"index_merge_sort_union", ""},
+	{"index_merge_intersection", ""},
+	{"engine_condition_pushdown", ""},
+	{"index_condition_pushdown", ""},
+	{"mrr", ""},
+	{"mrr_cost_based", ""},
+	{"block_nested_loop", ""},
+	{"batched_key_access", ""},
+	{"materialization", ""},
+	{"semijoin", ""},
+	{"loosescan", ""},
+	{"firstmatch", ""},
+	{"duplicateweedout", ""},
+	{"subquery_materialization_cost_based", ""},
+	{"use_index_extensions", ""},
+	{"condition_fanout_filter", ""},
+	{"derived_merge", ""},
+	{"use_invisible_indexes", TiDBOptUseInvisibleIndexes},
+	{"skip_scan", ""},
+	{"hash_join", TiDBOptEnableHashJoin},
+	{"subquery_to_derived", ""},
+	{"prefer_ordering_index", ""},
+	{"hypergraph_optimizer", ""},
+	{"derived_condition_pushdown", ""},
+	{"hash_set_operations", ""},
+}
+
+// parseOptimizerSwitch converts a complete value of optimizer_switch to the map form.
+func parseOptimizerSwitch(val string) map[string]string {
+	flags := make(map[string]string, len(optimizerSwitchFlags))
+	for _, item := range strings.Split(val, ",") {
+		if name, value, ok := strings.Cut(item, "="); ok {
+			flags[name] = value
+		}
+	}
+	return flags
+}
+
+// mergeOptimizerSwitch applies the assignments such as `index_merge=off,semijoin=default` to the current value of
+// optimizer_switch, and returns the new complete value. The assignment `default` resets all the flags. A warning is
+// appended for each flag without a TiDB variable which is assigned a value other than its default.
+func mergeOptimizerSwitch(vars *SessionVars, current, assignments string) (string, error) {
+	defaults := parseOptimizerSwitch(DefOptimizerSwitch)
+	flags := parseOptimizerSwitch(DefOptimizerSwitch)
+	maps.Copy(flags, parseOptimizerSwitch(current))
+	assigned := make(map[string]struct{})
+	for _, item := range strings.Split(strings.ToLower(assignments), ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if item == "default" {
+			maps.Copy(flags, defaults)
+			continue
+		}
+		name, value, ok := strings.Cut(item, "=")
+		name, value = strings.TrimSpace(name), strings.TrimSpace(value)
+		if _, known := defaults[name]; !ok || !known {
+			return assignments, ErrWrongValueForVar.GenWithStackByArgs(OptimizerSwitch, assignments)
+		}
+		switch value {
+		case "on", "off":
+			flags[name] = value
+		case "default":
+			flags[name] = defaults[name]
+		default:
+			return assignments, ErrWrongValueForVar.GenWithStackByArgs(OptimizerSwitch, assignments)
+		}
+		assigned[name] = struct{}{}
+	}
+	items := make([]string, 0, len(optimizerSwitchFlags))
+	for _, flag := range optimizerSwitchFlags {
+		item := flag.name + "=" + flags[flag.name]
+		items = append(items, item)
+		// TiDB has no equivalent of the flag, e.g. it always considers the semi joins for the subqueries and the
+		// batched reads of the index lookups, so the flag is kept only for compatibility with MySQL.
+		if _, ok := assigned[flag.name]; ok && flag.sysVar == "" && flags[flag.name] != defaults[flag.name] {
+			vars.StmtCtx.AppendWarning(ErrUnsupportedValueForVar.FastGenByArgs(OptimizerSwitch, item))
+		}
+	}
+	return strings.Join(items, ","), nil
+}
+
+// applyOptimizerSwitch sets the TiDB system variables mapped by the flags of optimizer_switch which are changed from
+// the current value oldVal to newVal by setVar. The unchanged flags are skipped, so the TiDB variables set directly
+// are not overwritten.
+func applyOptimizerSwitch(oldVal, newVal string, setVar func(name, val string) error) error {
+	oldFlags, newFlags := parseOptimizerSwitch(DefOptimizerSwitch), parseOptimizerSwitch(DefOptimizerSwitch)
+	maps.Copy(oldFlags, parseOptimizerSwitch(oldVal))
+	maps.Copy(newFlags, parseOptimizerSwitch(newVal))
+	for _, flag := range optimizerSwitchFlags {
+		if flag.sysVar == "" || oldFlags[flag.name] == newFlags[flag.name] {
+			continue
+		}
+		if err := setVar(flag.sysVar, newFlags[flag.name]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
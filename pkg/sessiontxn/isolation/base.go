@@ -474,6 +474,7 @@ func (p *baseTxnContextProvider) SetOptionsOnTxnActive(txn kv.Transaction) {
 
 	if txn.IsPipelined() {
 		txn.SetOption(kv.RequestSourceType, "p-dml")
+		txn.SetOption(kv.PipelinedFlushBatchSize, sessVars.BulkDMLBatchSize)
 	} else if tp := p.sctx.GetSessionVars().RequestSourceType; tp != "" {
 		txn.SetOption(kv.RequestSourceType, tp)
 	}
@@ -547,6 +548,14 @@ func (p *baseTxnContextProvider) SetOptionsBeforeCommit(
 	// set resource tagger again for internal tasks separated in different transactions
 	txn.SetOption(kv.ResourceGroupTagger, sessVars.StmtCtx.GetResourceGroupTagger())
 
+	// The ASYNC_COMMIT and ONE_PC hints of the statements override the session variables.
+	if sessVars.TxnCtx.AsyncCommitHint != nil {
+		txn.SetOption(kv.EnableAsyncCommit, *sessVars.TxnCtx.AsyncCommitHint)
+	}
+	if sessVars.TxnCtx.OnePCHint != nil {
+		txn.SetOption(kv.Enable1PC, *sessVars.TxnCtx.OnePCHint)
+	}
+
 	// Get the related table or partition IDs.
 	relatedPhysicalTables := sessVars.TxnCtx.TableDeltaMap
 	// Get accessed temporary tables in the transaction.
@@ -676,7 +685,7 @@ func (p *basePessimisticTxnContextProvider) OnPessimisticStmtStart(ctx context.C
 	if err := p.baseTxnContextProvider.OnPessimisticStmtStart(ctx); err != nil {
 		return err
 	}
-	if p.sctx.GetSessionVars().PessimisticTransactionFairLocking &&
+	if p.sctx.GetSessionVars().UseFairLocking() &&
 		p.txn != nil &&
 		p.sctx.GetSessionVars().ConnectionID != 0 &&
 		!p.sctx.GetSessionVars().InRestrictedSQL {
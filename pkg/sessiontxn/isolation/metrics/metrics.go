@@ -23,6 +23,7 @@ import (
 var (
 	RcReadCheckTSWriteConfilictCounter  prometheus.Counter
 	RcWriteCheckTSWriteConfilictCounter prometheus.Counter
+	RcReadTSReuseCounter                prometheus.Counter
 )
 
 func init() {
@@ -33,4 +34,5 @@ func init() {
 func InitMetricsVars() {
 	RcReadCheckTSWriteConfilictCounter = metrics.RCCheckTSWriteConfilictCounter.WithLabelValues(metrics.LblRCReadCheckTS)
 	RcWriteCheckTSWriteConfilictCounter = metrics.RCCheckTSWriteConfilictCounter.WithLabelValues(metrics.LblRCWriteCheckTS)
+	RcReadTSReuseCounter = metrics.RCReadTSReuseCounter
 }
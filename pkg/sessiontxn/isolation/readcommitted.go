@@ -55,8 +55,13 @@ type PessimisticRCTxnContextProvider struct {
 	latestOracleTS uint64
 	// latestOracleTSValid shows whether we have already fetched a ts from pd and whether the ts we fetched is still valid.
 	latestOracleTSValid bool
+	// latestOracleTSTime is the time when the latest ts is requested from pd.
+	latestOracleTSTime time.Time
 	// checkTSInWriteStmt is used to set RCCheckTS isolation for getting value when doing point-write
 	checkTSInWriteStmt bool
+	// reuseTSInReadStmt indicates whether the read-only statement can reuse the latest ts fetched within
+	// `tidb_rc_read_ts_reuse_interval`.
+	reuseTSInReadStmt bool
 }
 
 // NewPessimisticRCTxnContextProvider returns a new PessimisticRCTxnContextProvider
@@ -81,6 +86,8 @@ func NewPessimisticRCTxnContextProvider(sctx sessionctx.Context, causalConsisten
 		txn.SetOption(kv.Pessimistic, true)
 		provider.latestOracleTS = txn.StartTS()
 		provider.latestOracleTSValid = true
+		// The start ts is requested after the txn context is initialized, so the create time is an earlier bound.
+		provider.latestOracleTSTime = sctx.GetSessionVars().TxnCtx.CreateTime
 	}
 	provider.getStmtReadTSFunc = provider.getStmtTS
 	provider.getStmtForUpdateTSFunc = provider.getStmtTS
@@ -99,10 +106,21 @@ func (p *PessimisticRCTxnContextProvider) OnStmtStart(ctx context.Context, node
 		p.sctx.GetSessionVars().StmtCtx.RCCheckTS = true
 	}
 	p.checkTSInWriteStmt = false
+	p.reuseTSInReadStmt = node != nil && needReuseRCReadTS(p.sctx, node)
 
 	return p.prepareStmt(!p.isTxnPrepared)
 }
 
+// needReuseRCReadTS checks whether the statement can reuse the latest fetched ts of the transaction.
+// Only the read-only statements in an explicit transaction can reuse the ts. A reused ts may not observe the data
+// committed by other transactions within the interval, which is acceptable for these statements under read-committed.
+func needReuseRCReadTS(ctx sessionctx.Context, node ast.Node) bool {
+	sessionVars := ctx.GetSessionVars()
+	return sessionVars.RcReadTSReuseInterval > 0 && sessionVars.ConnectionID > 0 &&
+		sessionVars.InTxn() && !sessionVars.RetryInfo.Retrying &&
+		plannercore.IsReadOnly(node, sessionVars)
+}
+
 // NeedSetRCCheckTSFlag checks whether it's needed to set `RCCheckTS` flag in current stmtctx.
 func NeedSetRCCheckTSFlag(ctx sessionctx.Context, node ast.Node) bool {
 	sessionVars := ctx.GetSessionVars()
@@ -136,6 +154,7 @@ func (p *PessimisticRCTxnContextProvider) OnStmtRetry(ctx context.Context) error
 	})
 	p.latestOracleTSValid = false
 	p.checkTSInWriteStmt = false
+	p.reuseTSInReadStmt = false
 	return p.prepareStmt(false)
 }
 
@@ -150,6 +169,12 @@ func (p *PessimisticRCTxnContextProvider) prepareStmtTS() {
 		stmtTSFuture = funcFuture(p.getTxnStartTS)
 	case p.latestOracleTSValid && sessVars.StmtCtx.RCCheckTS:
 		stmtTSFuture = sessiontxn.ConstantFuture(p.latestOracleTS)
+	case p.reuseTSInReadStmt && p.latestOracleTSValid &&
+		time.Since(p.latestOracleTSTime) < sessVars.RcReadTSReuseInterval &&
+		// The for update ts may be pushed forward by the pessimistic locks, the statement should see the locked rows.
+		p.latestOracleTS >= sessVars.TxnCtx.GetForUpdateTS():
+		isolation_metrics.RcReadTSReuseCounter.Inc()
+		stmtTSFuture = sessiontxn.ConstantFuture(p.latestOracleTS)
 	default:
 		stmtTSFuture = p.getOracleFuture()
 	}
@@ -159,6 +184,7 @@ func (p *PessimisticRCTxnContextProvider) prepareStmtTS() {
 
 func (p *PessimisticRCTxnContextProvider) getOracleFuture() funcFuture {
 	txnCtx := p.sctx.GetSessionVars().TxnCtx
+	requestTime := time.Now()
 	future := newOracleFuture(p.ctx, p.sctx, txnCtx.TxnScope)
 	return func() (ts uint64, err error) {
 		if ts, err = future.Wait(); err != nil {
@@ -171,6 +197,7 @@ func (p *PessimisticRCTxnContextProvider) getOracleFuture() funcFuture {
 		ts = txnCtx.GetForUpdateTS()
 		p.latestOracleTS = ts
 		p.latestOracleTSValid = true
+		p.latestOracleTSTime = requestTime
 		return
 	}
 }
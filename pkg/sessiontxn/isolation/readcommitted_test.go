@@ -142,6 +142,82 @@ func TestPessimisticRCTxnContextProviderRCCheck(t *testing.T) {
 	require.Equal(t, sessiontxn.StmtActionNoIdea, nextAction)
 }
 
+func TestPessimisticRCTxnContextProviderReuseTS(t *testing.T) {
+	store := testkit.CreateMockStore(t)
+
+	tk := testkit.NewTestKit(t, store)
+	defer tk.MustExec("rollback")
+
+	tk.MustExec("set @@tidb_rc_read_ts_reuse_interval=1000")
+	se := tk.Session()
+	provider := initializePessimisticRCProvider(t, tk)
+
+	stmts, _, err := parser.New().Parse("select * from t", "", "")
+	require.NoError(t, err)
+	readOnlyStmt := stmts[0]
+
+	stmts, _, err = parser.New().Parse("select * from t for update", "", "")
+	require.NoError(t, err)
+	forUpdateStmt := stmts[0]
+
+	// first ts should use the txn startTS
+	require.NoError(t, executor.ResetContextOfStmt(se, readOnlyStmt))
+	require.NoError(t, provider.OnStmtStart(context.TODO(), readOnlyStmt))
+	ts, err := provider.GetStmtReadTS()
+	require.NoError(t, err)
+	require.Equal(t, se.GetSessionVars().TxnCtx.StartTS, ts)
+	reusedTS := ts
+
+	// the read-only statement within the interval should reuse the latest ts
+	require.NoError(t, executor.ResetContextOfStmt(se, readOnlyStmt))
+	require.NoError(t, provider.OnStmtStart(context.TODO(), readOnlyStmt))
+	ts, err = provider.GetStmtReadTS()
+	require.NoError(t, err)
+	require.Equal(t, reusedTS, ts)
+
+	// the locking statement should always get a new ts
+	compareTS := getOracleTS(t, se)
+	require.NoError(t, executor.ResetContextOfStmt(se, forUpdateStmt))
+	require.NoError(t, provider.OnStmtStart(context.TODO(), forUpdateStmt))
+	ts, err = provider.GetStmtReadTS()
+	require.NoError(t, err)
+	require.Greater(t, ts, compareTS)
+	reusedTS = ts
+
+	// the read-only statement should reuse the ts fetched by the locking statement
+	require.NoError(t, executor.ResetContextOfStmt(se, readOnlyStmt))
+	require.NoError(t, provider.OnStmtStart(context.TODO(), readOnlyStmt))
+	ts, err = provider.GetStmtReadTS()
+	require.NoError(t, err)
+	require.Equal(t, reusedTS, ts)
+
+	// the retried statement should get a new ts
+	compareTS = getOracleTS(t, se)
+	require.NoError(t, provider.OnStmtRetry(context.TODO()))
+	ts, err = provider.GetStmtReadTS()
+	require.NoError(t, err)
+	require.Greater(t, ts, compareTS)
+
+	// the ts should not be reused when it's fetched before the interval
+	se.GetSessionVars().RcReadTSReuseInterval = time.Millisecond
+	time.Sleep(10 * time.Millisecond)
+	compareTS = getOracleTS(t, se)
+	require.NoError(t, executor.ResetContextOfStmt(se, readOnlyStmt))
+	require.NoError(t, provider.OnStmtStart(context.TODO(), readOnlyStmt))
+	ts, err = provider.GetStmtReadTS()
+	require.NoError(t, err)
+	require.Greater(t, ts, compareTS)
+
+	// the ts should not be reused when the mode is disabled
+	se.GetSessionVars().RcReadTSReuseInterval = 0
+	compareTS = getOracleTS(t, se)
+	require.NoError(t, executor.ResetContextOfStmt(se, readOnlyStmt))
+	require.NoError(t, provider.OnStmtStart(context.TODO(), readOnlyStmt))
+	ts, err = provider.GetStmtReadTS()
+	require.NoError(t, err)
+	require.Greater(t, ts, compareTS)
+}
+
 func TestPessimisticRCTxnContextProviderRCCheckForPrepareExecute(t *testing.T) {
 	store := testkit.CreateMockStore(t)
 
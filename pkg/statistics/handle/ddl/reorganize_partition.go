@@ -15,6 +15,9 @@
 package ddl
 
 import (
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx"
+	"github.com/pingcap/tidb/pkg/statistics/handle/storage"
 	"github.com/pingcap/tidb/pkg/statistics/handle/util"
 )
 
@@ -35,6 +38,13 @@ func (h *ddlHandlerImpl) onReorganizePartitions(t *util.DDLEvent) error {
 		}
 	}
 
+	// Only SPLIT PARTITION and MERGE PARTITIONS know where the rows of the new partitions come from.
+	if t.ReorganizePartitionCarriesOverStats() {
+		if err := h.carryOverPartitionCount(addedPartInfo, droppedPartitionInfo); err != nil {
+			return err
+		}
+	}
+
 	// Reset the partition stats.
 	// It's OK to put those operations in different transactions. Because it will not affect the correctness.
 	for _, def := range droppedPartitionInfo.Definitions {
@@ -45,3 +55,36 @@ func (h *ddlHandlerImpl) onReorganizePartitions(t *util.DDLEvent) error {
 
 	return nil
 }
+
+// carryOverPartitionCount carries over the total count of the dropped partitions to the added partitions for SPLIT
+// PARTITION and MERGE PARTITIONS, since the rows of the added partitions all come from the dropped partitions. It's
+// exact when the partitions are merged into one, and it's spread evenly when a partition is split, since the real
+// count of each new partition is unknown until it's analyzed. The modify count is set to the count as well, so the
+// auto-analyze worker will analyze the new partitions in the near future.
+func (h *ddlHandlerImpl) carryOverPartitionCount(addedPartInfo, droppedPartInfo *model.PartitionInfo) error {
+	// Note: Put all the operations in a transaction.
+	return util.CallWithSCtx(h.statsHandler.SPool(), func(sctx sessionctx.Context) error {
+		count := int64(0)
+		for _, def := range droppedPartInfo.Definitions {
+			partCount, _, _, err := storage.StatsMetaCountAndModifyCount(sctx, def.ID)
+			if err != nil {
+				return err
+			}
+			count += partCount
+		}
+		if count == 0 || len(addedPartInfo.Definitions) == 0 {
+			return nil
+		}
+		numParts := int64(len(addedPartInfo.Definitions))
+		for i, def := range addedPartInfo.Definitions {
+			partCount := count / numParts
+			if int64(i) < count%numParts {
+				partCount++
+			}
+			if err := updateStatsWithCountDeltaAndModifyCountDelta(sctx, def.ID, partCount, partCount); err != nil {
+				return err
+			}
+		}
+		return nil
+	}, util.FlagWrapTxn)
+}
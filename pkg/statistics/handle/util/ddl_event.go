@@ -44,6 +44,9 @@ type DDLEvent struct {
 	// It applies when a table structure is being changed from partitioned to non-partitioned, or vice versa.
 	oldTableID int64
 	tp         model.ActionType
+	// carryOverStats is used by reorganizing partitions, it means the count of the dropped partitions is carried over
+	// to the added partitions. It's only set for SPLIT PARTITION and MERGE PARTITIONS.
+	carryOverStats bool
 }
 
 // IsMemOrSysDB checks whether the table is in the memory or system database.
@@ -233,18 +236,22 @@ func (e *DDLEvent) GetExchangePartitionInfo() (
 
 // NewReorganizePartitionEvent creates a new ddl event that reorganizes partitions.
 // We also use it for increasing or decreasing the number of hash partitions.
+// carryOverStats is true for SPLIT PARTITION and MERGE PARTITIONS, whose added partitions take over the count of the
+// dropped partitions.
 func NewReorganizePartitionEvent(
 	schemaID int64,
 	globalTableInfo *model.TableInfo,
 	addedPartInfo *model.PartitionInfo,
 	droppedPartInfo *model.PartitionInfo,
+	carryOverStats bool,
 ) *DDLEvent {
 	return &DDLEvent{
-		tp:          model.ActionReorganizePartition,
-		schemaID:    schemaID,
-		tableInfo:   globalTableInfo,
-		partInfo:    addedPartInfo,
-		oldPartInfo: droppedPartInfo,
+		tp:             model.ActionReorganizePartition,
+		schemaID:       schemaID,
+		tableInfo:      globalTableInfo,
+		partInfo:       addedPartInfo,
+		oldPartInfo:    droppedPartInfo,
+		carryOverStats: carryOverStats,
 	}
 }
 
@@ -257,6 +264,12 @@ func (e *DDLEvent) GetReorganizePartitionInfo() (
 	return e.tableInfo, e.partInfo, e.oldPartInfo
 }
 
+// ReorganizePartitionCarriesOverStats returns whether the count of the dropped partitions is carried over to the
+// added partitions when reorganizing partitions.
+func (e *DDLEvent) ReorganizePartitionCarriesOverStats() bool {
+	return e.carryOverStats
+}
+
 // NewTruncatePartitionEvent creates a new ddl event that truncates partitions.
 func NewTruncatePartitionEvent(
 	schemaID int64,
@@ -0,0 +1,95 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package statistics
+
+import (
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+// tempTableSketchSize is the maximum size of the FM sketch for each column of a temporary table. It is much smaller
+// than MaxSketchSize since the sketches are kept in the session memory and updated when rows are written.
+const tempTableSketchSize = 1000
+
+// TempTableStats is the in-session statistics of a global / local temporary table.
+// Temporary tables can't be analyzed, so the row count and the sampled column NDVs are collected when the rows are
+// written in the session.
+type TempTableStats struct {
+	// colSketches is the FM sketch of each column, which is used to estimate the NDV of the column.
+	colSketches map[int64]*FMSketch
+	// RowCount is the number of rows in the table.
+	RowCount int64
+}
+
+// NewTempTableStats creates an empty TempTableStats.
+func NewTempTableStats() *TempTableStats {
+	return &TempTableStats{
+		colSketches: make(map[int64]*FMSketch),
+	}
+}
+
+// Copy makes a copy of the TempTableStats.
+func (s *TempTableStats) Copy() *TempTableStats {
+	result := &TempTableStats{
+		colSketches: make(map[int64]*FMSketch, len(s.colSketches)),
+		RowCount:    s.RowCount,
+	}
+	for colID, sketch := range s.colSketches {
+		result.colSketches[colID] = sketch.Copy()
+	}
+	return result
+}
+
+// Update updates the stats with a written row. The delta is 1 for an inserted row, -1 for a removed row and 0 for an
+// updated row. The values of the row are sampled into the column sketches unless the row is removed, since the FM
+// sketch doesn't support deletion.
+func (s *TempTableStats) Update(sc *stmtctx.StatementContext, delta int64, cols []*model.ColumnInfo, row []types.Datum) {
+	s.RowCount = max(s.RowCount+delta, 0)
+	if delta < 0 {
+		return
+	}
+	for i, col := range cols {
+		if i >= len(row) {
+			break
+		}
+		sketch, ok := s.colSketches[col.ID]
+		if !ok {
+			sketch = NewFMSketch(tempTableSketchSize)
+			s.colSketches[col.ID] = sketch
+		}
+		// The sketch is only used for estimation, so it's fine to skip the values that can't be encoded.
+		_ = sketch.InsertValue(sc, row[i])
+	}
+}
+
+// ColumnNDV returns the estimated NDV of the column. It returns false if the column has not been sampled.
+func (s *TempTableStats) ColumnNDV(colID int64) (float64, bool) {
+	sketch, ok := s.colSketches[colID]
+	if !ok || s.RowCount == 0 {
+		return 0, false
+	}
+	return float64(min(sketch.NDV(), s.RowCount)), true
+}
+
+// StatsTable builds the statistics.Table used by the optimizer. The temporary table has no histogram, so the pseudo
+// estimation is still used for the filters, but it's based on the real row count instead of PseudoRowCount.
+func (s *TempTableStats) StatsTable(tblInfo *model.TableInfo) *Table {
+	t := PseudoTable(tblInfo, false, false)
+	if s.RowCount > 0 {
+		t.RealtimeCount = s.RowCount
+	}
+	return t
+}
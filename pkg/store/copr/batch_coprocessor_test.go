@@ -282,3 +282,26 @@ func TestTopoFetcherBackoff(t *testing.T) {
 	require.GreaterOrEqual(t, dura, 30*time.Second)
 	require.LessOrEqual(t, dura, 50*time.Second)
 }
+
+func TestFilterStoresCoveringStaleRead(t *testing.T) {
+	require.Equal(t, uint64(0), staleReadTS(false, 100))
+	require.Equal(t, uint64(100), staleReadTS(true, 100))
+
+	allStores := []uint64{1, 2, 3}
+	// not a stale read.
+	require.Equal(t, allStores, filterStoresCoveringStaleRead(allStores, nil))
+	require.Equal(t, []uint64{1, 3}, filterStoresCoveringStaleRead(allStores, map[uint64]struct{}{1: {}, 3: {}, 4: {}}))
+	// none of the stores covers the stale read.
+	require.Equal(t, allStores, filterStoresCoveringStaleRead(allStores, map[uint64]struct{}{4: {}}))
+
+	cache := &tiflashSafeTSCache{stores: make(map[uint64]tiflashSafeTS)}
+	now := time.Now()
+	_, ok := cache.get(1, now)
+	require.False(t, ok)
+	cache.set(1, 100, now)
+	ts, ok := cache.get(1, now.Add(tiflashSafeTSCacheTTL))
+	require.True(t, ok)
+	require.Equal(t, uint64(100), ts)
+	_, ok = cache.get(1, now.Add(tiflashSafeTSCacheTTL+time.Millisecond))
+	require.False(t, ok)
+}
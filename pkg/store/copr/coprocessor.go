@@ -70,8 +70,16 @@ const (
 	CopSmallTaskRow        = 32 // 32 is the initial batch size of TiKV
 	smallTaskSigma         = 0.5
 	smallConcPerCore       = 20
+	// adaptiveConcPerStore is the concurrency per store the adaptive concurrency scales up to for large scans.
+	adaptiveConcPerStore = 8
+	// adaptiveWorkersPerCore is the number of the in-flight cop workers per core, beyond which the instance is
+	// considered busy and the adaptive concurrency scales down.
+	adaptiveWorkersPerCore = 32
 )
 
+// inflightCopWorkers is the number of the running cop workers of the instance.
+var inflightCopWorkers atomic.Int64
+
 // CopClient is coprocessor client.
 type CopClient struct {
 	kv.RequestTypeSupportedChecker
@@ -161,10 +169,22 @@ func (c *CopClient) BuildCopIterator(ctx context.Context, req *kv.Request, vars
 		tasks = append(tasks, tasksFromRanges...)
 		return nil
 	}
+	// The small tasks of different partitions are batched together if store batch is enabled, so that the tasks destined
+	// for the same store are sent in fewer RPCs when there are many partitions.
+	if req.StoreBatchSize > 0 && req.KeyRanges.PartitionNum() > 1 {
+		buildOpt.batchBuilder = newBatchTaskBuilder(bo, req, buildOpt.cache, req.ReplicaRead)
+	}
 	// Here we build the task by partition, not directly by region.
 	// This is because it's possible that TiDB merge multiple small partition into one region which break some assumption.
 	// Keep it split by partition would be more safe.
 	err = req.KeyRanges.ForEachPartitionWithErr(buildTaskFunc)
+	if err == nil && buildOpt.batchBuilder != nil {
+		if req.Desc {
+			buildOpt.batchBuilder.reverse()
+		}
+		tasks = buildOpt.batchBuilder.build()
+		metrics.TxnRegionsNumHistogramWithCoprocessor.Observe(float64(buildOpt.batchBuilder.regionNum()))
+	}
 	// only batch store requests in first build.
 	req.StoreBatchSize = 0
 	reqType := "null"
@@ -202,6 +222,9 @@ func (c *CopClient) BuildCopIterator(ctx context.Context, req *kv.Request, vars
 		it.resolvedLocks.Put(req.StartTs)
 	}
 	it.tasks = tasks
+	if req.AdaptiveConcurrency {
+		it.concurrency = adaptiveConcurrency(it.concurrency, tasks, c.store.numcpu, inflightCopWorkers.Load())
+	}
 	if it.concurrency > len(tasks) {
 		it.concurrency = len(tasks)
 	}
@@ -333,6 +356,9 @@ type buildCopTaskOpt struct {
 	elapsed  *time.Duration
 	// ignoreTiKVClientReadTimeout is used to ignore tikv_client_read_timeout configuration, use default timeout instead.
 	ignoreTiKVClientReadTimeout bool
+	// batchBuilder is shared by the partitions of the request to batch their tasks together. If it's set, the tasks are
+	// collected by the builder and buildCopTasks returns nothing.
+	batchBuilder *batchStoreTaskBuilder
 }
 
 func buildCopTasks(bo *Backoffer, ranges *KeyRanges, opt *buildCopTaskOpt) ([]*copTask, error) {
@@ -371,7 +397,9 @@ func buildCopTasks(bo *Backoffer, ranges *KeyRanges, opt *buildCopTaskOpt) ([]*c
 	}
 
 	var builder taskBuilder
-	if req.StoreBatchSize > 0 && hints != nil {
+	if opt.batchBuilder != nil {
+		builder = opt.batchBuilder
+	} else if req.StoreBatchSize > 0 && hints != nil {
 		builder = newBatchTaskBuilder(bo, req, cache, req.ReplicaRead)
 	} else {
 		builder = newLegacyTaskBuilder(len(locs))
@@ -448,10 +476,13 @@ func buildCopTasks(bo *Backoffer, ranges *KeyRanges, opt *buildCopTaskOpt) ([]*c
 		}
 	}
 
-	if req.Desc {
-		builder.reverse()
+	var tasks []*copTask
+	if opt.batchBuilder == nil {
+		if req.Desc {
+			builder.reverse()
+		}
+		tasks = builder.build()
 	}
-	tasks := builder.build()
 	elapsed := time.Since(start)
 	if elapsed > time.Millisecond*500 {
 		logutil.BgLogger().Warn("buildCopTasks takes too much time",
@@ -462,7 +493,9 @@ func buildCopTasks(bo *Backoffer, ranges *KeyRanges, opt *buildCopTaskOpt) ([]*c
 	if opt.elapsed != nil {
 		*opt.elapsed = *opt.elapsed + elapsed
 	}
-	metrics.TxnRegionsNumHistogramWithCoprocessor.Observe(float64(builder.regionNum()))
+	if opt.batchBuilder == nil {
+		metrics.TxnRegionsNumHistogramWithCoprocessor.Observe(float64(builder.regionNum()))
+	}
 	return tasks, nil
 }
 
@@ -657,6 +690,25 @@ func smallTaskConcurrency(tasks []*copTask, numcpu int) (int, int) {
 	return res, extraConc
 }
 
+// adaptiveConcurrency tunes the concurrency of a request by the tasks it touches and the current load of the instance.
+// Small requests only use as many workers as their tasks, large scans use up to adaptiveConcPerStore workers for each
+// store they touch, and the concurrency scales down when the in-flight cop workers of the instance are too many.
+func adaptiveConcurrency(concurrency int, tasks []*copTask, numcpu int, inflight int64) int {
+	stores := make(map[string]struct{}, len(tasks))
+	for _, task := range tasks {
+		stores[task.storeAddr] = struct{}{}
+	}
+	conc := min(max(concurrency, len(stores)*adaptiveConcPerStore), len(tasks))
+	if numcpu <= 0 {
+		numcpu = 1
+	}
+	if idle := numcpu*adaptiveWorkersPerCore - int(inflight); conc > idle {
+		// The instance is busy, only use the idle workers.
+		conc = idle
+	}
+	return max(conc, 1)
+}
+
 // CopInfo is used to expose functions of copIterator.
 type CopInfo interface {
 	// GetConcurrency returns the concurrency and small task concurrency.
@@ -723,6 +775,8 @@ type copIteratorWorker struct {
 	finishCh <-chan struct{}
 	vars     *tikv.Variables
 	kvclient *txnsnapshot.ClientHelper
+	// hedgeClient is used to send the backup requests of the hedged read.
+	hedgeClient *txnsnapshot.ClientHelper
 
 	memTracker *memory.Tracker
 
@@ -818,6 +872,7 @@ func (worker *copIteratorWorker) run(ctx context.Context) {
 				worker.memTracker.Consume(10 * MockResponseSizeForTest)
 			}
 		})
+		inflightCopWorkers.Add(-1)
 		worker.wg.Done()
 	}()
 	for task := range worker.taskCh {
@@ -846,6 +901,7 @@ func (it *copIterator) open(ctx context.Context, enabledRateLimitAction, enableC
 	smallTaskCh := make(chan *copTask, 1)
 	it.unconsumedStats = &unconsumedCopRuntimeStats{}
 	it.wg.Add(it.concurrency + it.smallTaskConcurrency)
+	inflightCopWorkers.Add(int64(it.concurrency + it.smallTaskConcurrency))
 	// Start it.concurrency number of workers to handle cop requests.
 	for i := 0; i < it.concurrency+it.smallTaskConcurrency; i++ {
 		var ch chan *copTask
@@ -871,6 +927,9 @@ func (it *copIterator) open(ctx context.Context, enabledRateLimitAction, enableC
 			storeBatchedFallbackNum:    &it.storeBatchedFallbackNum,
 			unconsumedStats:            it.unconsumedStats,
 		}
+		if it.req.ReplicaRead == kv.ReplicaReadHedged {
+			worker.hedgeClient = txnsnapshot.NewClientHelper(it.store.store, &it.resolvedLocks, &it.committedLocks, false)
+		}
 		go worker.run(ctx)
 	}
 	taskSender := &copIteratorTaskSender{
@@ -1214,27 +1273,47 @@ func (worker *copIteratorWorker) handleTaskOnce(bo *Backoffer, task *copTask, ch
 		// But for tiflash, it check if rgName is empty to decide if resource control is enabled or not.
 		rgName = ""
 	}
-	req := tikvrpc.NewReplicaReadRequest(task.cmdType, &copReq, options.GetTiKVReplicaReadType(replicaRead), &worker.replicaReadSeed, kvrpcpb.Context{
-		IsolationLevel: isolationLevelToPB(worker.req.IsolationLevel),
-		Priority:       priorityToPB(worker.req.Priority),
-		NotFillCache:   worker.req.NotFillCache,
-		RecordTimeStat: true,
-		RecordScanStat: true,
-		TaskId:         worker.req.TaskID,
-		ResourceControlContext: &kvrpcpb.ResourceControlContext{
-			ResourceGroupName: rgName,
-		},
-		BusyThresholdMs: uint32(task.busyThreshold.Milliseconds()),
-		BucketsVersion:  task.bucketsVer,
-	})
-	req.InputRequestSource = task.requestSource.GetRequestSource()
-	if task.firstReadType != "" {
-		req.ReadType = task.firstReadType
-		req.IsRetryRequest = true
-	}
-	if worker.req.ResourceGroupTagger != nil {
-		worker.req.ResourceGroupTagger(req)
-	}
+	newReq := func(copReq *coprocessor.Request, replicaRead kv.ReplicaReadType) *tikvrpc.Request {
+		req := tikvrpc.NewReplicaReadRequest(task.cmdType, copReq, options.GetTiKVReplicaReadType(replicaRead), &worker.replicaReadSeed, kvrpcpb.Context{
+			IsolationLevel: isolationLevelToPB(worker.req.IsolationLevel),
+			Priority:       priorityToPB(worker.req.Priority),
+			NotFillCache:   worker.req.NotFillCache,
+			RecordTimeStat: true,
+			RecordScanStat: true,
+			TaskId:         worker.req.TaskID,
+			ResourceControlContext: &kvrpcpb.ResourceControlContext{
+				ResourceGroupName: rgName,
+			},
+			BusyThresholdMs: uint32(task.busyThreshold.Milliseconds()),
+			BucketsVersion:  task.bucketsVer,
+		})
+		req.InputRequestSource = task.requestSource.GetRequestSource()
+		if task.firstReadType != "" {
+			req.ReadType = task.firstReadType
+			req.IsRetryRequest = true
+		}
+		if worker.req.ResourceGroupTagger != nil {
+			worker.req.ResourceGroupTagger(req)
+		}
+		req.StoreTp = getEndPointType(task.storeType)
+		// set ReadReplicaScope and TxnScope so that req.IsStaleRead will be true when it's a global scope stale read.
+		req.ReadReplicaScope = worker.req.ReadReplicaScope
+		req.TxnScope = worker.req.TxnScope
+		if task.meetLockFallback {
+			req.DisableStaleReadMeetLock()
+		} else if worker.req.IsStaleness {
+			req.EnableStaleWithMixedReplicaRead()
+		}
+		return req
+	}
+	var backupReq *tikvrpc.Request
+	hedge := worker.canHedge(task)
+	if hedge {
+		// The backup request is sent concurrently, so it can't share the coprocessor request with the first one.
+		backupCopReq := copReq
+		backupReq = newReq(&backupCopReq, kv.ReplicaReadFollower)
+	}
+	req := newReq(&copReq, replicaRead)
 	timeout := config.GetGlobalConfig().TiKVClient.CoprReqTimeout
 	if task.tikvClientReadTimeout > 0 {
 		timeout = time.Duration(task.tikvClientReadTimeout) * time.Millisecond
@@ -1249,19 +1328,10 @@ func (worker *copIteratorWorker) handleTaskOnce(bo *Backoffer, task *copTask, ch
 			return nil, err
 		}
 	}
-	req.StoreTp = getEndPointType(task.storeType)
 	startTime := time.Now()
 	if worker.kvclient.Stats == nil {
 		worker.kvclient.Stats = tikv.NewRegionRequestRuntimeStats()
 	}
-	// set ReadReplicaScope and TxnScope so that req.IsStaleRead will be true when it's a global scope stale read.
-	req.ReadReplicaScope = worker.req.ReadReplicaScope
-	req.TxnScope = worker.req.TxnScope
-	if task.meetLockFallback {
-		req.DisableStaleReadMeetLock()
-	} else if worker.req.IsStaleness {
-		req.EnableStaleWithMixedReplicaRead()
-	}
 	staleRead := req.GetStaleRead()
 	ops := make([]tikv.StoreSelectorOption, 0, 2)
 	if len(worker.req.MatchStoreLabels) > 0 {
@@ -1272,8 +1342,18 @@ func (worker *copIteratorWorker) handleTaskOnce(bo *Backoffer, task *copTask, ch
 		req.ReplicaReadType = options.GetTiKVReplicaReadType(kv.ReplicaReadFollower)
 		ops = append(ops, tikv.WithMatchStores([]uint64{*task.redirect2Replica}))
 	}
-	resp, rpcCtx, storeAddr, err := worker.kvclient.SendReqCtx(bo.TiKVBackoffer(), req, task.region,
-		timeout, getEndPointType(task.storeType), task.storeAddr, ops...)
+	var (
+		resp      *tikvrpc.Response
+		rpcCtx    *tikv.RPCContext
+		storeAddr string
+		err       error
+	)
+	if hedge {
+		resp, rpcCtx, storeAddr, err = worker.sendReqWithHedge(bo, req, backupReq, task, timeout, ops...)
+	} else {
+		resp, rpcCtx, storeAddr, err = worker.kvclient.SendReqCtx(bo.TiKVBackoffer(), req, task.region,
+			timeout, getEndPointType(task.storeType), task.storeAddr, ops...)
+	}
 	err = derr.ToTiDBErr(err)
 	if worker.req.RunawayChecker != nil {
 		failpoint.Inject("sleepCoprAfterReq", func(v failpoint.Value) {
@@ -1417,6 +1497,19 @@ func (worker *copIteratorWorker) handleCopPagingResult(bo *Backoffer, rpcCtx *ti
 	return []*copTask{task}, nil
 }
 
+// checkRegionErrorRetry counts a retry of the request on the region error, and returns an error if the retries of the
+// statement reach the limit.
+func checkRegionErrorRetry(req *kv.Request, errStr string) error {
+	if req.RegionErrorRetries == nil {
+		return nil
+	}
+	if retries := req.RegionErrorRetries.Inc(); req.MaxRegionErrorRetries >= 0 && retries > int64(req.MaxRegionErrorRetries) {
+		req.RegionErrorRetries.Dec()
+		return errors.Errorf("region error retry limit %d reached, last error: %s", req.MaxRegionErrorRetries, errStr)
+	}
+	return nil
+}
+
 // handleCopResponse checks coprocessor Response for region split and lock,
 // returns more tasks when that happens, or handles the response if no error.
 // if we're handling coprocessor paging response, lastRange is the range of last
@@ -1433,6 +1526,9 @@ func (worker *copIteratorWorker) handleCopResponse(bo *Backoffer, rpcCtx *tikv.R
 		}
 		errStr := fmt.Sprintf("region_id:%v, region_ver:%v, store_type:%s, peer_addr:%s, error:%s",
 			task.region.GetID(), task.region.GetVer(), task.storeType.Name(), task.storeAddr, regionErr.String())
+		if err := checkRegionErrorRetry(worker.req, errStr); err != nil {
+			return nil, err
+		}
 		if err := bo.Backoff(tikv.BoRegionMiss(), errors.New(errStr)); err != nil {
 			return nil, errors.Trace(err)
 		}
@@ -1563,6 +1659,9 @@ func (worker *copIteratorWorker) handleBatchCopResponse(bo *Backoffer, rpcCtx *t
 		if regionErr := batchResp.GetRegionError(); regionErr != nil {
 			errStr := fmt.Sprintf("region_id:%v, region_ver:%v, store_type:%s, peer_addr:%s, error:%s",
 				task.region.GetID(), task.region.GetVer(), task.storeType.Name(), task.storeAddr, regionErr.String())
+			if err := checkRegionErrorRetry(worker.req, errStr); err != nil {
+				return nil, err
+			}
 			if err := bo.Backoff(tikv.BoRegionMiss(), errors.New(errStr)); err != nil {
 				return nil, errors.Trace(err)
 			}
@@ -1816,6 +1915,7 @@ func (worker *copIteratorWorker) collectCopRuntimeStats(copStats *CopRuntimeStat
 	}
 	if rpcCtx != nil {
 		copStats.CalleeAddress = rpcCtx.Addr
+		copStats.RegionID = rpcCtx.Region.GetID()
 	}
 	if resp == nil {
 		return
@@ -1861,6 +1961,8 @@ func (worker *copIteratorWorker) collectUnconsumedCopRuntimeStats(bo *Backoffer,
 type CopRuntimeStats struct {
 	execdetails.ExecDetails
 	ReqStats *tikv.RegionRequestRuntimeStats
+	// RegionID is the id of the region the task was sent to, 0 if unknown.
+	RegionID uint64
 
 	CoprCacheHit bool
 }
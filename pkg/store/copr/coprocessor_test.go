@@ -16,7 +16,9 @@ package copr
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/pingcap/kvproto/pkg/coprocessor"
 	"github.com/pingcap/tidb/pkg/kv"
@@ -26,6 +28,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"github.com/tikv/client-go/v2/testutils"
 	"github.com/tikv/client-go/v2/tikv"
+	"go.uber.org/atomic"
 )
 
 func buildTestCopTasks(bo *Backoffer, cache *RegionCache, ranges *KeyRanges, req *kv.Request, eventCb trxevents.EventCallback) ([]*copTask, error) {
@@ -865,6 +868,71 @@ func TestBuildCopTasksWithRowCountHint(t *testing.T) {
 	require.Equal(t, tasks[3].RowCountHint, 10)
 }
 
+func TestBuildBatchCopTasksAcrossPartitions(t *testing.T) {
+	// nil --- 'g' --- 'n' --- 't' --- nil
+	// <-  0  -> <- 1 -> <- 2 -> <- 3 ->
+	mockClient, cluster, pdClient, err := testutils.NewMockTiKV("", nil)
+	require.NoError(t, err)
+	defer func() {
+		pdClient.Close()
+		err = mockClient.Close()
+		require.NoError(t, err)
+	}()
+	_, _, _ = testutils.BootstrapWithMultiRegions(cluster, []byte("g"), []byte("n"), []byte("t"))
+	pdCli := tikv.NewCodecPDClient(tikv.ModeTxn, pdClient)
+	defer pdCli.Close()
+	cache := NewRegionCache(tikv.NewRegionCache(pdCli))
+	defer cache.Close()
+
+	bo := backoff.NewBackofferWithVars(context.Background(), 3000, nil)
+	req := &kv.Request{StoreBatchSize: 4}
+	partitions := []*KeyRanges{
+		buildCopRanges("a", "c"),
+		buildCopRanges("h", "i"),
+		buildCopRanges("o", "p"),
+	}
+
+	// the tasks of each partition are batched separately.
+	var tasks []*copTask
+	for _, ranges := range partitions {
+		tasksOfPartition, err := buildCopTasks(bo, ranges, &buildCopTaskOpt{
+			req:      req,
+			cache:    cache,
+			rowHints: []int{1},
+		})
+		require.NoError(t, err)
+		tasks = append(tasks, tasksOfPartition...)
+	}
+	require.Len(t, tasks, 3)
+
+	// the tasks of all partitions in the same store are batched together by the shared builder.
+	opt := &buildCopTaskOpt{
+		req:          req,
+		cache:        cache,
+		rowHints:     []int{1},
+		batchBuilder: newBatchTaskBuilder(bo, req, cache, req.ReplicaRead),
+	}
+	for _, ranges := range partitions {
+		tasksOfPartition, err := buildCopTasks(bo, ranges, opt)
+		require.NoError(t, err)
+		require.Len(t, tasksOfPartition, 0)
+	}
+	tasks = opt.batchBuilder.build()
+	require.Len(t, tasks, 1)
+	require.Len(t, tasks[0].batchTaskList, 2)
+	require.Equal(t, 3, tasks[0].RowCountHint)
+	require.Equal(t, 3, opt.batchBuilder.regionNum())
+
+	// the tasks which are not small are not batched.
+	opt.batchBuilder = newBatchTaskBuilder(bo, req, cache, req.ReplicaRead)
+	opt.rowHints = nil
+	for _, ranges := range partitions {
+		_, err := buildCopTasks(bo, ranges, opt)
+		require.NoError(t, err)
+	}
+	require.Len(t, opt.batchBuilder.build(), 3)
+}
+
 func TestSmallTaskConcurrencyLimit(t *testing.T) {
 	smallTaskCount := 1000
 	tasks := make([]*copTask, 0, smallTaskCount)
@@ -881,3 +949,72 @@ func TestSmallTaskConcurrencyLimit(t *testing.T) {
 	require.Equal(t, smallConcPerCore, conc)
 	require.Equal(t, smallTaskCount, count)
 }
+
+func TestHedgeDelayEstimator(t *testing.T) {
+	var e hedgeDelayEstimator
+	require.Equal(t, defaultHedgeDelay, e.delay())
+
+	// the delay is mean + 4 * dev.
+	e.observe(10 * time.Millisecond)
+	require.Equal(t, 30*time.Millisecond, e.delay())
+	for i := 0; i < 100; i++ {
+		e.observe(10 * time.Millisecond)
+	}
+	require.Less(t, e.delay(), 11*time.Millisecond)
+
+	// the delay is bounded.
+	for i := 0; i < 100; i++ {
+		e.observe(time.Microsecond)
+	}
+	require.Equal(t, minHedgeDelay, e.delay())
+	for i := 0; i < 100; i++ {
+		e.observe(10 * time.Second)
+	}
+	require.Equal(t, maxHedgeDelay, e.delay())
+}
+
+func TestAdaptiveConcurrency(t *testing.T) {
+	buildTasks := func(taskNum, storeNum int) []*copTask {
+		tasks := make([]*copTask, 0, taskNum)
+		for i := 0; i < taskNum; i++ {
+			tasks = append(tasks, &copTask{storeAddr: fmt.Sprintf("store%d", i%storeNum)})
+		}
+		return tasks
+	}
+	// small requests use as many workers as their tasks.
+	require.Equal(t, 3, adaptiveConcurrency(15, buildTasks(3, 3), 8, 0))
+	// large scans scale up by the number of stores.
+	require.Equal(t, 15, adaptiveConcurrency(15, buildTasks(1000, 1), 8, 0))
+	require.Equal(t, 5*adaptiveConcPerStore, adaptiveConcurrency(15, buildTasks(1000, 5), 8, 0))
+	// scale down when the instance is busy.
+	require.Equal(t, 10, adaptiveConcurrency(15, buildTasks(1000, 5), 8, int64(8*adaptiveWorkersPerCore-10)))
+	require.Equal(t, 1, adaptiveConcurrency(15, buildTasks(1000, 5), 8, int64(8*adaptiveWorkersPerCore+10)))
+}
+
+func TestCheckRegionErrorRetry(t *testing.T) {
+	// the retries aren't limited without the counter.
+	req := &kv.Request{}
+	require.NoError(t, checkRegionErrorRetry(req, "epoch not match"))
+
+	retries := atomic.NewInt64(0)
+	req = &kv.Request{MaxRegionErrorRetries: -1, RegionErrorRetries: retries}
+	for i := 0; i < 10; i++ {
+		require.NoError(t, checkRegionErrorRetry(req, "epoch not match"))
+	}
+	require.Equal(t, int64(10), retries.Load())
+
+	// the counter is shared by the requests of the statement.
+	retries.Store(0)
+	req1 := &kv.Request{MaxRegionErrorRetries: 2, RegionErrorRetries: retries}
+	req2 := &kv.Request{MaxRegionErrorRetries: 2, RegionErrorRetries: retries}
+	require.NoError(t, checkRegionErrorRetry(req1, "epoch not match"))
+	require.NoError(t, checkRegionErrorRetry(req2, "epoch not match"))
+	err := checkRegionErrorRetry(req1, "epoch not match")
+	require.ErrorContains(t, err, "region error retry limit 2 reached, last error: epoch not match")
+	require.Equal(t, int64(2), retries.Load())
+
+	retries.Store(0)
+	req = &kv.Request{MaxRegionErrorRetries: 0, RegionErrorRetries: retries}
+	require.Error(t, checkRegionErrorRetry(req, "epoch not match"))
+	require.Equal(t, int64(0), retries.Load())
+}
@@ -0,0 +1,138 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package copr
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/kv"
+	copr_metrics "github.com/pingcap/tidb/pkg/store/copr/metrics"
+	"github.com/pingcap/tidb/pkg/store/driver/backoff"
+	"github.com/tikv/client-go/v2/tikv"
+	"github.com/tikv/client-go/v2/tikvrpc"
+	"github.com/tikv/client-go/v2/txnkv/txnsnapshot"
+)
+
+const (
+	// minHedgeDelay and maxHedgeDelay bound the adaptive delay before sending the backup request.
+	minHedgeDelay = 5 * time.Millisecond
+	maxHedgeDelay = 500 * time.Millisecond
+	// defaultHedgeDelay is used before any latency is observed.
+	defaultHedgeDelay = 50 * time.Millisecond
+)
+
+// hedgeDelayEstimator estimates the delay before sending the backup request of a hedged read by the latencies of the
+// recent coprocessor requests, in the same way as the retransmission timeout of TCP, i.e. srtt + 4 * rttvar.
+type hedgeDelayEstimator struct {
+	mu       sync.Mutex
+	observed bool
+	mean     float64
+	dev      float64
+}
+
+// globalHedgeDelay is shared by all the hedged reads of the instance.
+var globalHedgeDelay hedgeDelayEstimator
+
+func (e *hedgeDelayEstimator) observe(latency time.Duration) {
+	sample := float64(latency)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.observed {
+		e.observed = true
+		e.mean, e.dev = sample, sample/2
+		return
+	}
+	diff := sample - e.mean
+	if diff < 0 {
+		diff = -diff
+	}
+	e.dev = 0.75*e.dev + 0.25*diff
+	e.mean = 0.875*e.mean + 0.125*sample
+}
+
+func (e *hedgeDelayEstimator) delay() time.Duration {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if !e.observed {
+		return defaultHedgeDelay
+	}
+	return min(max(time.Duration(e.mean+4*e.dev), minHedgeDelay), maxHedgeDelay)
+}
+
+// canHedge checks whether the task can be sent in the hedged way.
+func (worker *copIteratorWorker) canHedge(task *copTask) bool {
+	return worker.req.ReplicaRead == kv.ReplicaReadHedged && worker.hedgeClient != nil &&
+		task.storeType == kv.TiKV && len(task.batchTaskList) == 0 && task.redirect2Replica == nil &&
+		!worker.req.IsStaleness
+}
+
+type hedgedSendResult struct {
+	resp      *tikvrpc.Response
+	rpcCtx    *tikv.RPCContext
+	storeAddr string
+	err       error
+	backup    bool
+}
+
+// sendReqWithHedge sends the request to the leader, and sends the backup request to a follower if the leader doesn't
+// respond within the adaptive delay. The first successful response is used and the other request is canceled.
+func (worker *copIteratorWorker) sendReqWithHedge(bo *Backoffer, req, backupReq *tikvrpc.Request, task *copTask,
+	timeout time.Duration, ops ...tikv.StoreSelectorOption) (*tikvrpc.Response, *tikv.RPCContext, string, error) {
+	copr_metrics.CoprHedgedReadCounterTotal.Inc()
+	ctx, cancel := context.WithCancel(bo.GetCtx())
+	defer cancel()
+	results := make(chan hedgedSendResult, 2)
+	send := func(client *txnsnapshot.ClientHelper, req *tikvrpc.Request, backup bool) {
+		sendBo := backoff.NewBackofferWithVars(ctx, CopNextMaxBackoff, worker.vars)
+		resp, rpcCtx, storeAddr, err := client.SendReqCtx(sendBo.TiKVBackoffer(), req, task.region, timeout, tikvrpc.TiKV, task.storeAddr, ops...)
+		results <- hedgedSendResult{resp: resp, rpcCtx: rpcCtx, storeAddr: storeAddr, err: err, backup: backup}
+	}
+
+	start := time.Now()
+	inflight := 1
+	go send(worker.kvclient, req, false)
+	timer := time.NewTimer(globalHedgeDelay.delay())
+	defer timer.Stop()
+	var res hedgedSendResult
+	select {
+	case res = <-results:
+		inflight--
+	case <-timer.C:
+		copr_metrics.CoprHedgedReadCounterBackup.Inc()
+		inflight++
+		go send(worker.hedgeClient, backupReq, true)
+		res = <-results
+		inflight--
+		// Wait for the other request if the first one fails.
+		if res.err != nil {
+			res = <-results
+			inflight--
+		}
+		if res.err == nil && res.backup {
+			copr_metrics.CoprHedgedReadCounterBackupWon.Inc()
+		}
+	}
+	if res.err == nil {
+		globalHedgeDelay.observe(time.Since(start))
+	}
+	// The clients are not thread-safe, so wait for the canceled request to exit.
+	cancel()
+	for ; inflight > 0; inflight-- {
+		<-results
+	}
+	return res.resp, res.rpcCtx, res.storeAddr, res.err
+}
@@ -24,6 +24,10 @@ var (
 	CoprCacheCounterEvict prometheus.Counter
 	CoprCacheCounterHit   prometheus.Counter
 	CoprCacheCounterMiss  prometheus.Counter
+
+	CoprHedgedReadCounterTotal     prometheus.Counter
+	CoprHedgedReadCounterBackup    prometheus.Counter
+	CoprHedgedReadCounterBackupWon prometheus.Counter
 )
 
 func init() {
@@ -35,4 +39,8 @@ func InitMetricsVars() {
 	CoprCacheCounterEvict = metrics.DistSQLCoprCacheCounter.WithLabelValues("evict")
 	CoprCacheCounterHit = metrics.DistSQLCoprCacheCounter.WithLabelValues("hit")
 	CoprCacheCounterMiss = metrics.DistSQLCoprCacheCounter.WithLabelValues("miss")
+
+	CoprHedgedReadCounterTotal = metrics.DistSQLCoprHedgedReadCounter.WithLabelValues("total")
+	CoprHedgedReadCounterBackup = metrics.DistSQLCoprHedgedReadCounter.WithLabelValues("backup")
+	CoprHedgedReadCounterBackupWon = metrics.DistSQLCoprHedgedReadCounter.WithLabelValues("backup_won")
 }
@@ -0,0 +1,136 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package copr
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pingcap/kvproto/pkg/kvrpcpb"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/tikv/client-go/v2/tikv"
+	"github.com/tikv/client-go/v2/tikvrpc"
+	"go.uber.org/zap"
+)
+
+const (
+	// tiflashSafeTSCacheTTL is how long a fetched safe ts of a TiFlash store is reused by the stale reads.
+	tiflashSafeTSCacheTTL     = 2 * time.Second
+	fetchTiFlashSafeTSTimeout = 500 * time.Millisecond
+)
+
+// staleReadTS returns the read ts of the request if it's a stale read, otherwise 0.
+func staleReadTS(isStaleness bool, startTS uint64) uint64 {
+	if isStaleness {
+		return startTS
+	}
+	return 0
+}
+
+type tiflashSafeTS struct {
+	ts        uint64
+	updatedAt time.Time
+}
+
+// tiflashSafeTSCache caches the safe ts of the TiFlash stores. A TiFlash replica can serve a stale read locally
+// only if its safe ts is not less than the read ts, otherwise it has to wait for the read index from the leader.
+type tiflashSafeTSCache struct {
+	mu     sync.RWMutex
+	stores map[uint64]tiflashSafeTS
+}
+
+var globalTiFlashSafeTS = &tiflashSafeTSCache{stores: make(map[uint64]tiflashSafeTS)}
+
+func (c *tiflashSafeTSCache) get(storeID uint64, now time.Time) (uint64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	s, ok := c.stores[storeID]
+	if !ok || now.Sub(s.updatedAt) > tiflashSafeTSCacheTTL {
+		return 0, false
+	}
+	return s.ts, true
+}
+
+func (c *tiflashSafeTSCache) set(storeID, ts uint64, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.stores[storeID] = tiflashSafeTS{ts: ts, updatedAt: now}
+}
+
+// storesCoveringStaleRead returns the IDs of the stores whose safe ts is not less than readTS.
+func storesCoveringStaleRead(ctx context.Context, store *kvStore, stores []*tikv.Store, readTS uint64) map[uint64]struct{} {
+	covered := make(map[uint64]struct{}, len(stores))
+	var (
+		mu sync.Mutex
+		wg sync.WaitGroup
+	)
+	now := time.Now()
+	for _, s := range stores {
+		if ts, ok := globalTiFlashSafeTS.get(s.StoreID(), now); ok {
+			if ts >= readTS {
+				covered[s.StoreID()] = struct{}{}
+			}
+			continue
+		}
+		wg.Add(1)
+		go func(s *tikv.Store) {
+			defer wg.Done()
+			ts := fetchStoreSafeTS(ctx, store, s.GetAddr())
+			globalTiFlashSafeTS.set(s.StoreID(), ts, now)
+			if ts >= readTS {
+				mu.Lock()
+				covered[s.StoreID()] = struct{}{}
+				mu.Unlock()
+			}
+		}(s)
+	}
+	wg.Wait()
+	return covered
+}
+
+// fetchStoreSafeTS gets the safe ts of the store. It returns 0 if the safe ts is unknown.
+func fetchStoreSafeTS(ctx context.Context, store *kvStore, addr string) uint64 {
+	req := tikvrpc.NewRequest(tikvrpc.CmdStoreSafeTS, &kvrpcpb.StoreSafeTSRequest{
+		KeyRange: &kvrpcpb.KeyRange{StartKey: []byte(""), EndKey: []byte("")},
+	}, kvrpcpb.Context{})
+	resp, err := store.GetTiKVClient().SendRequest(ctx, addr, req, fetchTiFlashSafeTSTimeout)
+	if err != nil {
+		logutil.Logger(ctx).Debug("fetch the safe ts of the TiFlash store failed", zap.String("store", addr), zap.Error(err))
+		return 0
+	}
+	if r, ok := resp.Resp.(*kvrpcpb.StoreSafeTSResponse); ok {
+		return r.GetSafeTs()
+	}
+	return 0
+}
+
+// filterStoresCoveringStaleRead keeps the stores that can serve the stale read locally. If none of the stores can,
+// all of them are kept, and the stale read is served by the read index from the leader.
+func filterStoresCoveringStaleRead(allStores []uint64, covered map[uint64]struct{}) []uint64 {
+	if covered == nil {
+		return allStores
+	}
+	filtered := make([]uint64, 0, len(allStores))
+	for _, id := range allStores {
+		if _, ok := covered[id]; ok {
+			filtered = append(filtered, id)
+		}
+	}
+	if len(filtered) == 0 {
+		return allStores
+	}
+	return filtered
+}
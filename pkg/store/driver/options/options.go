@@ -36,6 +36,9 @@ func GetTiKVReplicaReadType(t kv.ReplicaReadType) storekv.ReplicaReadType {
 		return storekv.ReplicaReadLearner
 	case kv.ReplicaReadPreferLeader:
 		return storekv.ReplicaReadPreferLeader
+	case kv.ReplicaReadHedged:
+		// The first request of the hedged read is sent to the leader.
+		return storekv.ReplicaReadLeader
 	}
 	return 0
 }
@@ -50,6 +50,11 @@ type tikvTxn struct {
 	// columnMapsCache is a cache used for the mutation checker
 	columnMapsCache    any
 	isCommitterWorking atomic.Bool
+
+	// pipelinedFlushBatchSize bounds the number of mutations buffered in the mutable memdb of a pipelined txn.
+	pipelinedFlushBatchSize int
+	// pipelinedFlushedLen is the number of mutations that have been flushed when the last flush is triggered.
+	pipelinedFlushedLen int
 }
 
 // NewTiKVTxn returns a new Transaction.
@@ -61,7 +66,7 @@ func NewTiKVTxn(txn *tikv.KVTxn) kv.Transaction {
 	totalLimit := kv.TxnTotalSizeLimit.Load()
 	txn.GetUnionStore().SetEntrySizeLimit(entryLimit, totalLimit)
 
-	return &tikvTxn{txn, make(map[int64]*model.TableInfo), nil, nil, atomic.Bool{}}
+	return &tikvTxn{KVTxn: txn, idxNameCache: make(map[int64]*model.TableInfo)}
 }
 
 func (txn *tikvTxn) GetTableInfo(id int64) *model.TableInfo {
@@ -302,6 +307,8 @@ func (txn *tikvTxn) SetOption(opt int, val any) {
 		txn.KVTxn.GetUnionStore().SetEntrySizeLimit(limits.Entry, limits.Total)
 	case kv.SessionID:
 		txn.KVTxn.SetSessionID(val.(uint64))
+	case kv.PipelinedFlushBatchSize:
+		txn.pipelinedFlushBatchSize = val.(int)
 	}
 }
 
@@ -454,7 +461,14 @@ func (txn *tikvTxn) MayFlush() error {
 	if intest.InTest {
 		txn.isCommitterWorking.Store(true)
 	}
-	_, err := txn.KVTxn.GetMemBuffer().Flush(false)
+	memBuffer := txn.KVTxn.GetMemBuffer()
+	// Force a flush once the mutations buffered since the last flush exceed the batch size,
+	// Flush blocks until the ongoing flush is done, which keeps the memory usage bounded.
+	force := txn.pipelinedFlushBatchSize > 0 && memBuffer.Len()-txn.pipelinedFlushedLen >= txn.pipelinedFlushBatchSize
+	flushed, err := memBuffer.Flush(force)
+	if flushed {
+		txn.pipelinedFlushedLen = memBuffer.Len()
+	}
 	return txn.extractKeyErr(err)
 }
 
@@ -28,6 +28,7 @@ import (
 
 	"github.com/gorilla/mux"
 	"github.com/pingcap/log"
+	"github.com/pingcap/tidb/pkg/kv"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/store/helper"
 	"github.com/pingcap/tidb/pkg/store/mockstore"
@@ -490,3 +491,19 @@ func TestTableRange(t *testing.T) {
 	// t+(id+1)
 	require.Equal(t, "748000000000000002", endKey.String())
 }
+
+func TestPrewarmRegionCache(t *testing.T) {
+	store := createMockStore(t)
+	h := helper.NewHelper(store)
+
+	ranges := []kv.KeyRange{{StartKey: []byte("a"), EndKey: []byte("z")}}
+	cnt, err := h.PrewarmRegionCache(context.Background(), ranges)
+	require.NoError(t, err)
+	require.Equal(t, 2, cnt)
+
+	cnt, err = h.PinRegionCache(context.Background(), "test", ranges)
+	require.NoError(t, err)
+	require.Equal(t, 2, cnt)
+	require.True(t, h.UnpinRegionCache("test"))
+	require.False(t, h.UnpinRegionCache("test"))
+}
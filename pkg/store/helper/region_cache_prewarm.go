@@ -0,0 +1,131 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package helper
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/kv"
+	"github.com/pingcap/tidb/pkg/util"
+	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/tikv/client-go/v2/tikv"
+	"go.uber.org/zap"
+)
+
+const (
+	prewarmRegionCacheMaxBackoff = 20000
+	// regionCachePinRefreshInterval is the interval to reload the pinned key ranges into the region cache. It's much
+	// shorter than the TTL of the region cache, so that the pinned regions never expire.
+	regionCachePinRefreshInterval = time.Minute
+)
+
+// PrewarmRegionCache loads the regions of the key ranges into the region cache, so that the first requests to them
+// don't pay the penalties of the region cache misses. It returns the number of the loaded regions.
+func (h *Helper) PrewarmRegionCache(ctx context.Context, ranges []kv.KeyRange) (int, error) {
+	return loadRegionsInKeyRanges(ctx, h.RegionCache, ranges)
+}
+
+// PinRegionCache prewarms the region cache for the key ranges, and keeps reloading them in the background until
+// UnpinRegionCache is called with the same name. Pinning the same name again replaces the key ranges.
+func (h *Helper) PinRegionCache(ctx context.Context, name string, ranges []kv.KeyRange) (int, error) {
+	cnt, err := h.PrewarmRegionCache(ctx, ranges)
+	if err != nil {
+		return 0, err
+	}
+	globalRegionCachePinner.pin(name, h.RegionCache, ranges)
+	return cnt, nil
+}
+
+// UnpinRegionCache stops reloading the key ranges pinned by the name. It returns false if the name is not pinned.
+func (*Helper) UnpinRegionCache(name string) bool {
+	return globalRegionCachePinner.unpin(name)
+}
+
+func loadRegionsInKeyRanges(ctx context.Context, cache *tikv.RegionCache, ranges []kv.KeyRange) (int, error) {
+	bo := tikv.NewBackofferWithVars(ctx, prewarmRegionCacheMaxBackoff, nil)
+	cnt := 0
+	for _, r := range ranges {
+		regions, err := cache.LoadRegionsInKeyRange(bo, r.StartKey, r.EndKey)
+		if err != nil {
+			return cnt, errors.Trace(err)
+		}
+		cnt += len(regions)
+	}
+	return cnt, nil
+}
+
+type pinnedKeyRanges struct {
+	cache  *tikv.RegionCache
+	ranges []kv.KeyRange
+}
+
+// regionCachePinner keeps the regions of the pinned key ranges in the region cache by reloading them periodically.
+// The background goroutine only runs when there are pinned key ranges.
+type regionCachePinner struct {
+	mu     sync.Mutex
+	pinned map[string]pinnedKeyRanges
+	stopCh chan struct{}
+}
+
+var globalRegionCachePinner = &regionCachePinner{pinned: make(map[string]pinnedKeyRanges)}
+
+func (p *regionCachePinner) pin(name string, cache *tikv.RegionCache, ranges []kv.KeyRange) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.pinned[name] = pinnedKeyRanges{cache: cache, ranges: ranges}
+	if p.stopCh == nil {
+		p.stopCh = make(chan struct{})
+		stopCh := p.stopCh
+		go util.WithRecovery(func() { p.run(stopCh) }, nil)
+	}
+}
+
+func (p *regionCachePinner) unpin(name string) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if _, ok := p.pinned[name]; !ok {
+		return false
+	}
+	delete(p.pinned, name)
+	if len(p.pinned) == 0 && p.stopCh != nil {
+		close(p.stopCh)
+		p.stopCh = nil
+	}
+	return true
+}
+
+func (p *regionCachePinner) run(stopCh chan struct{}) {
+	ticker := time.NewTicker(regionCachePinRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stopCh:
+			return
+		case <-ticker.C:
+		}
+		p.mu.Lock()
+		pinned := maps.Clone(p.pinned)
+		p.mu.Unlock()
+		for name, r := range pinned {
+			if _, err := loadRegionsInKeyRanges(context.Background(), r.cache, r.ranges); err != nil {
+				logutil.BgLogger().Warn("reload the pinned regions failed", zap.String("name", name), zap.Error(err))
+			}
+		}
+	}
+}
@@ -512,10 +512,12 @@ func (b *mppExecBuilder) buildMPPAgg(agg *tipb.Aggregation) (*aggExec, error) {
 	for _, aggFunc := range agg.AggFunc {
 		ft := expression.PbTypeToFieldType(aggFunc.FieldType)
 		e.fieldTypes = append(e.fieldTypes, ft)
-		aggExpr, _, err := aggregation.NewDistAggFunc(aggFunc, chExec.getFieldTypes(), b.sctx.GetExprCtx())
+		aggExpr, aggDesc, err := aggregation.NewDistAggFunc(aggFunc, chExec.getFieldTypes(), b.sctx.GetExprCtx())
 		if err != nil {
 			return nil, errors.Trace(err)
 		}
+		// The partial aggregation of the multi distinct aggregation falling back to 2 phases keeps the DISTINCT flag.
+		aggDesc.HasDistinct = aggFunc.HasDistinct
 		e.aggExprs = append(e.aggExprs, aggExpr)
 	}
 	e.sctx = b.sctx
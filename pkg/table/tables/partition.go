@@ -34,7 +34,6 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
-	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/table"
 	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/types"
@@ -1620,8 +1619,7 @@ func partitionedTableAddRecord(ctx table.MutateContext, t *partitionedTable, r [
 		return nil, errors.WithStack(dbterror.ErrInvalidDDLState.GenWithStack("the partition is in not in public"))
 	}
 	exchangePartitionInfo := t.Meta().ExchangePartitionInfo
-	if exchangePartitionInfo != nil && exchangePartitionInfo.ExchangePartitionDefID == pid &&
-		variable.EnableCheckConstraint.Load() {
+	if exchangePartitionInfo != nil && exchangePartitionInfo.ExchangePartitionDefID == pid {
 		err = checkConstraintForExchangePartition(ctx, r, pid, exchangePartitionInfo.ExchangePartitionTableID)
 		if err != nil {
 			return nil, errors.WithStack(err)
@@ -1755,8 +1753,7 @@ func partitionedTableUpdateRecord(gctx context.Context, ctx table.MutateContext,
 		return errors.WithStack(dbterror.ErrInvalidDDLState.GenWithStack("the partition is in not in public"))
 	}
 	exchangePartitionInfo := t.Meta().ExchangePartitionInfo
-	if exchangePartitionInfo != nil && exchangePartitionInfo.ExchangePartitionDefID == to &&
-		variable.EnableCheckConstraint.Load() {
+	if exchangePartitionInfo != nil && exchangePartitionInfo.ExchangePartitionDefID == to {
 		err = checkConstraintForExchangePartition(ctx, newData, to, exchangePartitionInfo.ExchangePartitionTableID)
 		if err != nil {
 			return errors.WithStack(err)
@@ -39,6 +39,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/sessionctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/binloginfo"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
 	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/statistics"
 	"github.com/pingcap/tidb/pkg/table"
@@ -50,6 +51,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/generatedexpr"
 	"github.com/pingcap/tidb/pkg/util/logutil"
+	"github.com/pingcap/tidb/pkg/util/rowchange"
 	"github.com/pingcap/tidb/pkg/util/rowcodec"
 	"github.com/pingcap/tidb/pkg/util/stringutil"
 	"github.com/pingcap/tidb/pkg/util/tableutil"
@@ -614,6 +616,9 @@ func (t *TableCommon) UpdateRecord(ctx context.Context, sctx table.MutateContext
 			return err
 		}
 	}
+	if shouldCaptureRowChange(sessVars, t.meta) {
+		sessVars.RowChanges.AddRow(t.meta, rowchange.Update, oldData, newData)
+	}
 	colSize := make(map[int64]int64, len(t.Cols()))
 	for id, col := range t.Cols() {
 		size, err := codec.EstimateValueSize(sc.TypeCtx(), newData[id])
@@ -629,6 +634,7 @@ func (t *TableCommon) UpdateRecord(ctx context.Context, sctx table.MutateContext
 		colSize[col.ID] = int64(newLen - oldLen)
 	}
 	sessVars.TxnCtx.UpdateDeltaForTable(t.physicalTableID, 0, 1, colSize)
+	t.updateTempTableStats(sctx, 0, newData)
 	return nil
 }
 
@@ -804,6 +810,16 @@ func handleTempTableSize(t tableutil.TempTable, txnSizeBefore int, txn kv.Transa
 	t.SetSize(newSize)
 }
 
+// updateTempTableStats updates the in-session stats of the temporary table with a written row.
+func (t *TableCommon) updateTempTableStats(sctx table.MutateContext, delta int64, row []types.Datum) {
+	if t.meta.TempTableType == model.TempTableNone {
+		return
+	}
+	if tmpTable := sctx.TxnRecordTempTable(t.meta); tmpTable != nil {
+		tmpTable.UpdateStats(sctx.GetSessionVars().StmtCtx, delta, row)
+	}
+}
+
 func checkTempTableSize(ctx table.MutateContext, tmpTable tableutil.TempTable, tblInfo *model.TableInfo) error {
 	tmpTableSize := tmpTable.GetSize()
 	if tempTableData := ctx.GetSessionVars().TemporaryTableData; tempTableData != nil {
@@ -1105,6 +1121,9 @@ func (t *TableCommon) AddRecord(sctx table.MutateContext, r []types.Datum, opts
 			return nil, err
 		}
 	}
+	if shouldCaptureRowChange(sessVars, t.meta) {
+		sessVars.RowChanges.AddRow(t.meta, rowchange.Insert, nil, r)
+	}
 
 	if sessVars.TxnCtx == nil {
 		return recordID, nil
@@ -1123,6 +1142,7 @@ func (t *TableCommon) AddRecord(sctx table.MutateContext, r []types.Datum, opts
 		colSize[col.ID] = int64(size) - 1
 	}
 	sessVars.TxnCtx.UpdateDeltaForTable(t.physicalTableID, 1, 1, colSize)
+	t.updateTempTableStats(sctx, 1, r)
 	return recordID, nil
 }
 
@@ -1388,6 +1408,9 @@ func (t *TableCommon) RemoveRecord(ctx table.MutateContext, h kv.Handle, r []typ
 		}
 		err = t.addDeleteBinlog(ctx, binlogRow, colIDs)
 	}
+	if shouldCaptureRowChange(sessVars, t.meta) {
+		sessVars.RowChanges.AddRow(t.meta, rowchange.Delete, r, nil)
+	}
 	if ctx.GetSessionVars().TxnCtx == nil {
 		return nil
 	}
@@ -1400,6 +1423,7 @@ func (t *TableCommon) RemoveRecord(ctx table.MutateContext, h kv.Handle, r []typ
 		colSize[col.ID] = -int64(size - 1)
 	}
 	ctx.GetSessionVars().TxnCtx.UpdateDeltaForTable(t.physicalTableID, -1, 1, colSize)
+	t.updateTempTableStats(ctx, -1, r)
 	return err
 }
 
@@ -1707,8 +1731,8 @@ func allocHandleIDs(ctx context.Context, mctx table.MutateContext, t table.Table
 	if err != nil {
 		return 0, 0, err
 	}
-	if meta.ShardRowIDBits > 0 {
-		shardFmt := autoid.NewShardIDFormat(types.NewFieldType(mysql.TypeLonglong), meta.ShardRowIDBits, autoid.RowIDBitLength)
+	if shardRowIDBits := getShardRowIDBits(mctx, meta); shardRowIDBits > 0 {
+		shardFmt := autoid.NewShardIDFormat(types.NewFieldType(mysql.TypeLonglong), shardRowIDBits, autoid.RowIDBitLength)
 		// Use max record ShardRowIDBits to check overflow.
 		if OverflowShardBits(maxID, meta.MaxShardRowIDBits, autoid.RowIDBitLength, true) {
 			// If overflow, the rowID may be duplicated. For examples,
@@ -1727,6 +1751,18 @@ func allocHandleIDs(ctx context.Context, mctx table.MutateContext, t table.Table
 	return base, maxID, nil
 }
 
+// getShardRowIDBits returns the shard bits of the row IDs allocated by the current statement. The shard_row_id hint
+// overrides the SHARD_ROW_ID_BITS of the table, but it's limited by MaxShardRowIDBits, otherwise the allocated
+// row IDs may overflow and be duplicated.
+func getShardRowIDBits(mctx table.MutateContext, meta *model.TableInfo) uint64 {
+	if mctx != nil {
+		if stmtCtx := mctx.GetSessionVars().StmtCtx; stmtCtx != nil && stmtCtx.HasShardRowIDHint {
+			return min(stmtCtx.ShardRowIDBits, meta.MaxShardRowIDBits)
+		}
+	}
+	return meta.ShardRowIDBits
+}
+
 // OverflowShardBits checks whether the recordID overflow `1<<(typeBitsLength-shardRowIDBits-1) -1`.
 func OverflowShardBits(recordID int64, shardRowIDBits uint64, typeBitsLength uint64, reservedSignBit bool) bool {
 	var signBit uint64
@@ -1776,6 +1812,17 @@ func shouldWriteBinlog(vars *variable.SessionVars, tblInfo *model.TableInfo) boo
 	return !vars.InRestrictedSQL
 }
 
+// shouldCaptureRowChange returns whether the row changes of the table should be collected for the extensions.
+func shouldCaptureRowChange(vars *variable.SessionVars, tblInfo *model.TableInfo) bool {
+	if vars.RowChanges == nil {
+		return false
+	}
+	if tblInfo.TempTableType != model.TempTableNone {
+		return false
+	}
+	return !vars.InRestrictedSQL
+}
+
 func shouldIncreaseTTLMetricCount(tblInfo *model.TableInfo) bool {
 	return tblInfo.TTLInfo != nil
 }
@@ -2026,6 +2073,30 @@ type sequenceCommon struct {
 	mu    sync.RWMutex
 }
 
+// SequenceCacheExhaustedFunc is called when the values cached by a sequence in this instance are used up and the
+// values in (base, end] are allocated from the storage. It's called with the sequence locked, so it should return quickly.
+type SequenceCacheExhaustedFunc func(dbName, seqName string, base, end int64)
+
+var sequenceCacheExhaustedFuncs struct {
+	sync.RWMutex
+	fns []SequenceCacheExhaustedFunc
+}
+
+// RegisterSequenceCacheExhaustedFunc registers a function which is called when the instance cache of a sequence is used up.
+func RegisterSequenceCacheExhaustedFunc(fn SequenceCacheExhaustedFunc) {
+	sequenceCacheExhaustedFuncs.Lock()
+	defer sequenceCacheExhaustedFuncs.Unlock()
+	sequenceCacheExhaustedFuncs.fns = append(sequenceCacheExhaustedFuncs.fns, fn)
+}
+
+func onSequenceCacheExhausted(dbName, seqName string, base, end int64) {
+	sequenceCacheExhaustedFuncs.RLock()
+	defer sequenceCacheExhaustedFuncs.RUnlock()
+	for _, fn := range sequenceCacheExhaustedFuncs.fns {
+		fn(dbName, seqName, base, end)
+	}
+}
+
 // GetSequenceBaseEndRound is used in test.
 func (s *sequenceCommon) GetSequenceBaseEndRound() (int64, int64, int64) {
 	s.mu.RLock()
@@ -2036,12 +2107,20 @@ func (s *sequenceCommon) GetSequenceBaseEndRound() (int64, int64, int64) {
 // GetSequenceNextVal implements util.SequenceTable GetSequenceNextVal interface.
 // Caching the sequence value in table, we can easily be notified with the cache empty,
 // and write the binlogInfo in table level rather than in allocator.
+// If tidb_sequence_session_cache_size is set, the session takes a range of values from the cache at a time,
+// and the following calls are served from the range without locking the sequence.
 func (t *TableCommon) GetSequenceNextVal(ctx any, dbName, seqName string) (nextVal int64, err error) {
 	seq := t.sequence
 	if seq == nil {
 		// TODO: refine the error.
 		return 0, errors.New("sequenceCommon is nil")
 	}
+	vars := ctx.(sessionctx.Context).GetSessionVars()
+	if vars.SequenceSessionCacheSize > 1 {
+		if nextVal, ok := vars.SequenceState.NextInRange(t.tableID, seq.meta); ok {
+			return nextVal, nil
+		}
+	}
 	seq.mu.Lock()
 	defer seq.mu.Unlock()
 
@@ -2086,6 +2165,7 @@ func (t *TableCommon) GetSequenceNextVal(ctx any, dbName, seqName string) (nextV
 		seq.base = base
 		seq.end = end
 		seq.round = round
+		onSequenceCacheExhausted(dbName, seqName, base, end)
 		// write sequence binlog to the pumpClient.
 		if ctx.(sessionctx.Context).GetSessionVars().BinlogClient != nil {
 			err = writeSequenceUpdateValueBinlog(ctx.(sessionctx.Context), dbName, seqName, seq.end)
@@ -2114,6 +2194,21 @@ func (t *TableCommon) GetSequenceNextVal(ctx any, dbName, seqName string) (nextV
 		return 0, err
 	}
 	seq.base = nextVal
+	if vars.SequenceSessionCacheSize > 1 {
+		// The values taken by the session are regarded as allocated, like the values cached by other instances.
+		n := min(seq.countCachedValues(nextVal), uint64(vars.SequenceSessionCacheSize-1))
+		if n > 0 {
+			last := nextVal + int64(n)*seq.meta.Increment
+			vars.SequenceState.SetRange(t.tableID, variable.SequenceRange{
+				Next:      nextVal + seq.meta.Increment,
+				Last:      last,
+				Increment: seq.meta.Increment,
+				Remaining: n,
+				Info:      seq.meta,
+			})
+			seq.base = last
+		}
+	}
 	return nextVal, nil
 }
 
@@ -2125,6 +2220,8 @@ func (t *TableCommon) SetSequenceVal(ctx any, newVal int64, dbName, seqName stri
 		// TODO: refine the error.
 		return 0, false, errors.New("sequenceCommon is nil")
 	}
+	// The values taken by the session are dropped, so NEXTVAL after SETVAL is served from the instance cache.
+	ctx.(sessionctx.Context).GetSessionVars().SequenceState.ClearRange(t.tableID)
 	seq.mu.Lock()
 	defer seq.mu.Unlock()
 
@@ -2189,6 +2286,40 @@ func (s *sequenceCommon) getOffset() int64 {
 	return offset
 }
 
+// countCachedValues returns the number of the values after v in the cache, v must be a value of the sequence.
+// Because the distance may overflow Int64, it's computed in uint64.
+func (s *sequenceCommon) countCachedValues(v int64) uint64 {
+	if s.meta.Increment > 0 {
+		return (uint64(s.end) - uint64(v)) / uint64(s.meta.Increment)
+	}
+	return (uint64(v) - uint64(s.end)) / uint64(-s.meta.Increment)
+}
+
+// GetSequenceCacheRange implements util.SequenceTable GetSequenceCacheRange interface.
+func (t *TableCommon) GetSequenceCacheRange() (next, last int64, remaining uint64) {
+	seq := t.sequence
+	if seq == nil {
+		return 0, 0, 0
+	}
+	seq.mu.RLock()
+	defer seq.mu.RUnlock()
+	if seq.base == seq.end {
+		return 0, 0, 0
+	}
+	var ok bool
+	offset := seq.getOffset()
+	if seq.meta.Increment > 0 {
+		next, ok = autoid.SeekToFirstSequenceValue(seq.base, seq.meta.Increment, offset, seq.base, seq.end)
+	} else {
+		next, ok = autoid.SeekToFirstSequenceValue(seq.base, seq.meta.Increment, offset, seq.end, seq.base)
+	}
+	if !ok {
+		return 0, 0, 0
+	}
+	remaining = seq.countCachedValues(next) + 1
+	return next, next + int64(remaining-1)*seq.meta.Increment, remaining
+}
+
 // GetSequenceID implements util.SequenceTable GetSequenceID interface.
 func (t *TableCommon) GetSequenceID() int64 {
 	return t.tableID
@@ -2333,8 +2464,12 @@ func SetPBColumnsDefaultValue(ctx expression.BuildContext, pbColumns []*tipb.Col
 type TemporaryTable struct {
 	// Whether it's modified in this transaction.
 	modified bool
-	// The stats of this table. So far it's always pseudo stats.
-	stats *statistics.Table
+	// The in-session stats of this table, which is collected when the rows are written.
+	stats *statistics.TempTableStats
+	// The stats before the current statement, which are restored when the statement is rolled back.
+	stmtStats *statistics.TempTableStats
+	// The public columns of this table, which are used to update the stats.
+	statsCols []*model.ColumnInfo
 	// The autoID allocator of this table.
 	autoIDAllocator autoid.Allocator
 	// Table size.
@@ -2345,9 +2480,16 @@ type TemporaryTable struct {
 
 // TempTableFromMeta builds a TempTable from model.TableInfo.
 func TempTableFromMeta(tblInfo *model.TableInfo) tableutil.TempTable {
+	statsCols := make([]*model.ColumnInfo, 0, len(tblInfo.Columns))
+	for _, col := range tblInfo.Columns {
+		if col.State == model.StatePublic {
+			statsCols = append(statsCols, col)
+		}
+	}
 	return &TemporaryTable{
 		modified:        false,
-		stats:           statistics.PseudoTable(tblInfo, false, false),
+		stats:           statistics.NewTempTableStats(),
+		statsCols:       statsCols,
 		autoIDAllocator: autoid.NewAllocatorFromTempTblInfo(tblInfo),
 		meta:            tblInfo,
 	}
@@ -2373,6 +2515,34 @@ func (t *TemporaryTable) GetStats() any {
 	return t.stats
 }
 
+// SetStats is implemented from TempTable.SetStats.
+func (t *TemporaryTable) SetStats(stats any) {
+	if s, ok := stats.(*statistics.TempTableStats); ok && s != nil {
+		t.stats = s.Copy()
+	}
+}
+
+// UpdateStats is implemented from TempTable.UpdateStats.
+func (t *TemporaryTable) UpdateStats(sc *stmtctx.StatementContext, delta int64, row []types.Datum) {
+	if t.stmtStats == nil {
+		t.stmtStats = t.stats.Copy()
+	}
+	t.stats.Update(sc, delta, t.statsCols, row)
+}
+
+// StmtCommitStats is implemented from TempTable.StmtCommitStats.
+func (t *TemporaryTable) StmtCommitStats() {
+	t.stmtStats = nil
+}
+
+// StmtRollbackStats is implemented from TempTable.StmtRollbackStats.
+func (t *TemporaryTable) StmtRollbackStats() {
+	if t.stmtStats != nil {
+		t.stats = t.stmtStats
+		t.stmtStats = nil
+	}
+}
+
 // GetSize gets the table size.
 func (t *TemporaryTable) GetSize() int64 {
 	return t.size
@@ -96,6 +96,7 @@ func (d *temporaryTableDDL) clearTemporaryTableRecords(tblID int64) error {
 		return nil
 	}
 
+	sessionData.SetTableStats(tblID, nil)
 	tblPrefix := tablecodec.EncodeTablePrefix(tblID)
 	endKey := tablecodec.EncodeTablePrefix(tblID + 1)
 	iter, err := sessionData.Iter(tblPrefix, endKey)
@@ -377,7 +377,13 @@ func (tk *TestKit) ExecWithContext(ctx context.Context, sql string, args ...any)
 		var rs0 sqlexec.RecordSet
 		for i, stmt := range stmts {
 			var rs sqlexec.RecordSet
-			var err error
+			ntStmt, isNT, err := session.NonTransactionalDMLFromHint(ctx, tk.Session(), stmt)
+			if err != nil {
+				return nil, err
+			}
+			if isNT {
+				stmt = ntStmt
+			}
 			if s, ok := stmt.(*ast.NonTransactionalDMLStmt); ok {
 				rs, err = session.HandleNonTransactionalDML(ctx, s, tk.Session())
 			} else {
@@ -30,6 +30,7 @@ import (
 	"github.com/pingcap/tidb/pkg/parser/model"
 	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/parser/terror"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/table/tables"
 	"github.com/pingcap/tidb/pkg/tablecodec"
 	"github.com/pingcap/tidb/pkg/ttl/session"
@@ -38,6 +39,7 @@ import (
 	"github.com/pingcap/tidb/pkg/util/codec"
 	"github.com/pingcap/tidb/pkg/util/collate"
 	"github.com/pingcap/tidb/pkg/util/intest"
+	"github.com/pingcap/tidb/pkg/util/timeutil"
 	"github.com/tikv/client-go/v2/tikv"
 )
 
@@ -187,6 +189,33 @@ func (t *PhysicalTable) ValidateKeyPrefix(key []types.Datum) error {
 	return nil
 }
 
+// InJobWindow returns whether `now` is in the time window in which the TTL jobs of the table are allowed to run.
+// The window of the table narrows the global `tidb_ttl_job_schedule_window_start_time` and
+// `tidb_ttl_job_schedule_window_end_time`, and an unset start or end time of the table falls back to the global one.
+func InJobWindow(ttlInfo *model.TTLInfo, now time.Time) bool {
+	start, end := variable.TTLJobScheduleWindowStartTime.Load(), variable.TTLJobScheduleWindowEndTime.Load()
+	if !timeutil.WithinDayTimePeriod(start, end, now) {
+		return false
+	}
+
+	if ttlInfo == nil || (len(ttlInfo.JobWindowStart) == 0 && len(ttlInfo.JobWindowEnd) == 0) {
+		return true
+	}
+
+	// the window is checked when it's set, so an invalid one is not expected here and falls back to the global one
+	if len(ttlInfo.JobWindowStart) > 0 {
+		if t, err := time.ParseInLocation(model.TTLJobWindowTimeFormat, ttlInfo.JobWindowStart, time.UTC); err == nil {
+			start = t
+		}
+	}
+	if len(ttlInfo.JobWindowEnd) > 0 {
+		if t, err := time.ParseInLocation(model.TTLJobWindowTimeFormat, ttlInfo.JobWindowEnd, time.UTC); err == nil {
+			end = t
+		}
+	}
+	return timeutil.WithinDayTimePeriod(start, end, now)
+}
+
 var mockExpireTimeKey struct{}
 
 // SetMockExpireTime can only used in test
@@ -22,6 +22,7 @@ import (
 
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
 	"github.com/pingcap/tidb/pkg/testkit"
 	"github.com/pingcap/tidb/pkg/ttl/cache"
 	"github.com/pingcap/tidb/pkg/ttl/session"
@@ -290,3 +291,47 @@ func TestEvalTTLExpireTime(t *testing.T) {
 	require.Equal(t, "1969-12-31 22:57:00", tm.In(time.UTC).Format(time.DateTime))
 	require.Same(t, tzBerlin, tm.Location())
 }
+
+func TestInJobWindow(t *testing.T) {
+	origStart, origEnd := variable.TTLJobScheduleWindowStartTime.Load(), variable.TTLJobScheduleWindowEndTime.Load()
+	defer func() {
+		variable.TTLJobScheduleWindowStartTime.Store(origStart)
+		variable.TTLJobScheduleWindowEndTime.Store(origEnd)
+	}()
+
+	parseTime := func(s string) time.Time {
+		tm, err := time.ParseInLocation(variable.FullDayTimeFormat, s, time.UTC)
+		require.NoError(t, err)
+		return tm
+	}
+	at := func(hour, minute int) time.Time {
+		return time.Date(2024, 1, 1, hour, minute, 0, 0, time.UTC)
+	}
+
+	variable.TTLJobScheduleWindowStartTime.Store(parseTime("00:00 +0000"))
+	variable.TTLJobScheduleWindowEndTime.Store(parseTime("23:59 +0000"))
+	require.True(t, cache.InJobWindow(nil, at(12, 0)))
+	require.True(t, cache.InJobWindow(&model.TTLInfo{}, at(12, 0)))
+
+	ttlInfo := &model.TTLInfo{JobWindowStart: "01:00 +0000", JobWindowEnd: "05:00 +0000"}
+	require.True(t, cache.InJobWindow(ttlInfo, at(1, 0)))
+	require.True(t, cache.InJobWindow(ttlInfo, at(5, 0)))
+	require.False(t, cache.InJobWindow(ttlInfo, at(5, 1)))
+	require.False(t, cache.InJobWindow(ttlInfo, at(0, 59)))
+
+	// the window can cross midnight and has a time zone
+	ttlInfo = &model.TTLInfo{JobWindowStart: "22:00 +0800", JobWindowEnd: "02:00 +0800"}
+	require.True(t, cache.InJobWindow(ttlInfo, at(14, 0)))
+	require.True(t, cache.InJobWindow(ttlInfo, at(18, 0)))
+	require.False(t, cache.InJobWindow(ttlInfo, at(19, 0)))
+
+	// an unset bound falls back to the global one
+	ttlInfo = &model.TTLInfo{JobWindowStart: "10:00 +0000"}
+	require.False(t, cache.InJobWindow(ttlInfo, at(9, 0)))
+	require.True(t, cache.InJobWindow(ttlInfo, at(23, 0)))
+
+	// the window of the table can't go beyond the global one
+	variable.TTLJobScheduleWindowEndTime.Store(parseTime("12:00 +0000"))
+	require.True(t, cache.InJobWindow(ttlInfo, at(11, 0)))
+	require.False(t, cache.InJobWindow(ttlInfo, at(13, 0)))
+}
@@ -114,7 +114,9 @@ func (b *SQLBuilder) WriteSelect() error {
 	if b.state != writeBegin {
 		return errors.Errorf("invalid state: %v", b.state)
 	}
-	b.restoreCtx.WritePlain("SELECT LOW_PRIORITY SQL_NO_CACHE ")
+	b.restoreCtx.WritePlain("SELECT ")
+	b.writeResourceGroupHint()
+	b.restoreCtx.WritePlain("LOW_PRIORITY SQL_NO_CACHE ")
 	b.writeColNames(b.tbl.KeyColumns, false)
 	b.restoreCtx.WritePlain(" FROM ")
 	if err := b.writeTblName(); err != nil {
@@ -135,7 +137,9 @@ func (b *SQLBuilder) WriteDelete() error {
 	if b.state != writeBegin {
 		return errors.Errorf("invalid state: %v", b.state)
 	}
-	b.restoreCtx.WritePlain("DELETE LOW_PRIORITY FROM ")
+	b.restoreCtx.WritePlain("DELETE ")
+	b.writeResourceGroupHint()
+	b.restoreCtx.WritePlain("LOW_PRIORITY FROM ")
 	if err := b.writeTblName(); err != nil {
 		return err
 	}
@@ -167,7 +171,7 @@ func (b *SQLBuilder) WriteCommonCondition(cols []*model.ColumnInfo, op string, d
 	return b.writeDataPoint(cols, dp)
 }
 
-// WriteExpireCondition writes a condition with the time column
+// WriteExpireCondition writes a condition with the time column and the extra TTL_CONDITION of the table if it exists
 func (b *SQLBuilder) WriteExpireCondition(expire time.Time) error {
 	switch b.state {
 	case writeSelOrDel:
@@ -184,6 +188,11 @@ func (b *SQLBuilder) WriteExpireCondition(expire time.Time) error {
 	b.restoreCtx.WritePlain("FROM_UNIXTIME(")
 	b.restoreCtx.WritePlain(strconv.FormatInt(expire.Unix(), 10))
 	b.restoreCtx.WritePlain(")")
+	if ttlInfo := b.tbl.TTLInfo; ttlInfo != nil && len(ttlInfo.ConditionExprStr) > 0 {
+		b.restoreCtx.WritePlain(" AND (")
+		b.restoreCtx.WritePlain(ttlInfo.ConditionExprStr)
+		b.restoreCtx.WritePlain(")")
+	}
 	b.hasWriteExpireCond = true
 	return nil
 }
@@ -245,6 +254,15 @@ func (b *SQLBuilder) WriteLimit(n int) error {
 	return nil
 }
 
+// writeResourceGroupHint writes the hint to run the statement in the `TTL_RESOURCE_GROUP` of the table
+func (b *SQLBuilder) writeResourceGroupHint() {
+	if ttlInfo := b.tbl.TTLInfo; ttlInfo != nil && len(ttlInfo.ResourceGroup) > 0 {
+		b.restoreCtx.WritePlain("/*+ RESOURCE_GROUP(")
+		b.restoreCtx.WriteName(ttlInfo.ResourceGroup)
+		b.restoreCtx.WritePlain(") */ ")
+	}
+}
+
 func (b *SQLBuilder) writeTblName() error {
 	tn := ast.TableName{Schema: b.tbl.Schema, Name: b.tbl.Name}
 	return tn.Restore(b.restoreCtx)
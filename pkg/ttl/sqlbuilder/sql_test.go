@@ -608,6 +608,21 @@ func TestScanQueryGenerator(t *testing.T) {
 		},
 	}
 
+	t3 := &cache.PhysicalTable{
+		Schema: model.NewCIStr("test"),
+		TableInfo: &model.TableInfo{
+			Name:    model.NewCIStr("t3"),
+			TTLInfo: &model.TTLInfo{ConditionExprStr: "`status` = 2", ResourceGroup: "rg1"},
+		},
+		KeyColumns: []*model.ColumnInfo{
+			{Name: model.NewCIStr("id"), FieldType: *types.NewFieldType(mysql.TypeInt24)},
+		},
+		TimeColumn: &model.ColumnInfo{
+			Name:      model.NewCIStr("time"),
+			FieldType: *types.NewFieldType(mysql.TypeDatetime),
+		},
+	}
+
 	result := func(last []types.Datum, n int) [][]types.Datum {
 		ds := make([][]types.Datum, n)
 		ds[n-1] = last
@@ -621,6 +636,26 @@ func TestScanQueryGenerator(t *testing.T) {
 		rangeEnd   []types.Datum
 		path       [][]any
 	}{
+		{
+			tbl:        t3,
+			expire:     time.UnixMilli(0).In(time.UTC),
+			rangeStart: d(1),
+			rangeEnd:   d(100),
+			path: [][]any{
+				{
+					nil, 3,
+					"SELECT /*+ RESOURCE_GROUP(`rg1`) */ LOW_PRIORITY SQL_NO_CACHE `id` FROM `test`.`t3` WHERE `id` >= 1 AND `id` < 100 AND `time` < FROM_UNIXTIME(0) AND (`status` = 2) ORDER BY `id` ASC LIMIT 3",
+				},
+				{
+					result(d(10), 3), 5,
+					"SELECT /*+ RESOURCE_GROUP(`rg1`) */ LOW_PRIORITY SQL_NO_CACHE `id` FROM `test`.`t3` WHERE `id` > 10 AND `id` < 100 AND `time` < FROM_UNIXTIME(0) AND (`status` = 2) ORDER BY `id` ASC LIMIT 5",
+				},
+				{
+					result(d(15), 4), 5,
+					"",
+				},
+			},
+		},
 		{
 			tbl:    t1,
 			expire: time.UnixMilli(0).In(time.UTC),
@@ -891,6 +926,21 @@ func TestBuildDeleteSQL(t *testing.T) {
 		},
 	}
 
+	t3 := &cache.PhysicalTable{
+		Schema: model.NewCIStr("test"),
+		TableInfo: &model.TableInfo{
+			Name:    model.NewCIStr("t3"),
+			TTLInfo: &model.TTLInfo{ConditionExprStr: "`status` in (1, 2)", ResourceGroup: "rg1"},
+		},
+		KeyColumns: []*model.ColumnInfo{
+			{Name: model.NewCIStr("id"), FieldType: *types.NewFieldType(mysql.TypeInt24)},
+		},
+		TimeColumn: &model.ColumnInfo{
+			Name:      model.NewCIStr("time"),
+			FieldType: *types.NewFieldType(mysql.TypeDatetime),
+		},
+	}
+
 	cases := []struct {
 		tbl    *cache.PhysicalTable
 		expire time.Time
@@ -921,6 +971,12 @@ func TestBuildDeleteSQL(t *testing.T) {
 			rows:   [][]types.Datum{d(1, "a"), d(2, "b")},
 			sql:    "DELETE LOW_PRIORITY FROM `test2`.`t2` WHERE (`a`, `b`) IN ((1, 'a'), (2, 'b')) AND `time` < FROM_UNIXTIME(0) LIMIT 2",
 		},
+		{
+			tbl:    t3,
+			expire: time.UnixMilli(0).In(time.UTC),
+			rows:   [][]types.Datum{d(1), d(2)},
+			sql:    "DELETE /*+ RESOURCE_GROUP(`rg1`) */ LOW_PRIORITY FROM `test`.`t3` WHERE `id` IN (1, 2) AND `time` < FROM_UNIXTIME(0) AND (`status` in (1, 2)) LIMIT 2",
+		},
 	}
 
 	for _, c := range cases {
@@ -78,6 +78,51 @@ func (l *delRateLimiter) reset() (newLimit int64) {
 	return
 }
 
+var tableDelRateLimiters = newTableDelRateLimiter()
+
+// tableDelRateLimiter limits the DELETE statements of the tables with `TTL_DELETE_RATE_LIMIT`. The limiters are keyed
+// by the logical table ID, so all the partitions of a table share the same limit.
+type tableDelRateLimiter struct {
+	sync.Mutex
+	limiters map[int64]*rate.Limiter
+}
+
+func newTableDelRateLimiter() *tableDelRateLimiter {
+	return &tableDelRateLimiter{limiters: make(map[int64]*rate.Limiter)}
+}
+
+func (l *tableDelRateLimiter) Wait(ctx context.Context, tbl *cache.PhysicalTable) error {
+	var limit int64
+	if tbl.TTLInfo != nil {
+		limit = tbl.TTLInfo.DeleteRateLimit
+	}
+
+	limiter := l.getLimiter(tbl.TableInfo.ID, limit)
+	if limiter == nil {
+		return ctx.Err()
+	}
+
+	return limiter.Wait(ctx)
+}
+
+func (l *tableDelRateLimiter) getLimiter(tableID int64, limit int64) *rate.Limiter {
+	l.Lock()
+	defer l.Unlock()
+	if limit <= 0 {
+		delete(l.limiters, tableID)
+		return nil
+	}
+
+	limiter, ok := l.limiters[tableID]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Limit(limit), 1)
+		l.limiters[tableID] = limiter
+	} else if limiter.Limit() != rate.Limit(limit) {
+		limiter.SetLimit(rate.Limit(limit))
+	}
+	return limiter
+}
+
 type ttlDeleteTask struct {
 	tbl        *cache.PhysicalTable
 	expire     time.Time
@@ -119,6 +164,10 @@ func (t *ttlDeleteTask) doDelete(ctx context.Context, rawSe session.Session) (re
 			t.statistics.IncErrorRows(len(delBatch))
 			return
 		}
+		if err = tableDelRateLimiters.Wait(ctx, t.tbl); err != nil {
+			t.statistics.IncErrorRows(len(delBatch))
+			return
+		}
 		tracer.EnterPhase(metrics.PhaseOther)
 
 		sqlStart := time.Now()
@@ -309,6 +309,26 @@ func TestTTLDeleteRateLimiter(t *testing.T) {
 	require.EqualError(t, globalDelRateLimiter.Wait(ctx), "context canceled")
 }
 
+func TestTTLTableDeleteRateLimiter(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	limiters := newTableDelRateLimiter()
+	tbl := newMockTTLTbl(t, "t1")
+	tbl.TTLInfo.DeleteRateLimit = 100000
+	require.NoError(t, limiters.Wait(ctx, tbl))
+	require.Equal(t, rate.Limit(100000), limiters.limiters[tbl.TableInfo.ID].Limit())
+
+	tbl.TTLInfo.DeleteRateLimit = 200000
+	require.NoError(t, limiters.Wait(ctx, tbl))
+	require.Equal(t, rate.Limit(200000), limiters.limiters[tbl.TableInfo.ID].Limit())
+
+	// 0 stands for no limit
+	tbl.TTLInfo.DeleteRateLimit = 0
+	require.NoError(t, limiters.Wait(ctx, tbl))
+	require.NotContains(t, limiters.limiters, tbl.TableInfo.ID)
+}
+
 func TestTTLDeleteTaskWorker(t *testing.T) {
 	origBatchSize := variable.TTLDeleteBatchSize.Load()
 	variable.TTLDeleteBatchSize.Store(3)
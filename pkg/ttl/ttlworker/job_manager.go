@@ -406,6 +406,12 @@ func (m *JobManager) triggerTTLJob(requestID string, cmd *client.TriggerNewTTLJo
 		}
 		tableResults = append(tableResults, tblResult)
 
+		if !cache.InJobWindow(tbl.TTLInfo, se.Now().In(tz)) {
+			tblResult.ErrorMessage = "not in TTL job window of the table"
+			getJobFns = append(getJobFns, nil)
+			continue
+		}
+
 		fn, err := syncer.ManualTriggerTTLTimer(m.ctx, tbl)
 		if err != nil {
 			tblResult.ErrorMessage = err.Error()
@@ -605,6 +611,21 @@ func (m *JobManager) rescheduleJobs(se session.Session, now time.Time) {
 		job.finish(se, now, summary)
 	}
 
+	// if the time is out of the job window of the table, also cancel it
+	for _, job := range m.runningJobs {
+		if cache.InJobWindow(job.tbl.TTLInfo, now) {
+			continue
+		}
+
+		logutil.Logger(m.ctx).Info("cancel job because it's not in the TTL job window of the table", zap.String("jobID", job.id), zap.Int64("tableID", job.tbl.ID))
+		summary, err := summarizeErr(errors.New("not in TTL job window of the table"))
+		if err != nil {
+			logutil.Logger(m.ctx).Info("fail to summarize job", zap.Error(err))
+		}
+		m.removeJob(job)
+		job.finish(se, now, summary)
+	}
+
 	jobTables := m.readyForLockHBTimeoutJobTables(now)
 	// TODO: also consider to resume tables, but it's fine to left them there, as other nodes will take this job
 	// when the heart beat is not sent
@@ -644,6 +665,10 @@ tblLoop:
 			}
 		}
 
+		if !cache.InJobWindow(table.TTLInfo, now) {
+			continue
+		}
+
 		status := m.tableStatusCache.Tables[table.ID]
 		if m.couldLockJob(status, table, now, false, false) {
 			tables = append(tables, table)
@@ -1163,6 +1188,10 @@ func (a *managerJobAdapter) CanSubmitJob(tableID, physicalID int64) bool {
 		return false
 	}
 
+	if !cache.InJobWindow(ttlInfo, se.Now()) {
+		return false
+	}
+
 	if physicalID != tableID {
 		if par := tbl.GetPartitionedTable(); par == nil || par.GetPartition(physicalID) == nil {
 			return false
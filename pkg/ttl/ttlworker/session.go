@@ -259,6 +259,10 @@ func validateTTLWork(ctx context.Context, s session.Session, tbl *cache.Physical
 		return errors.New("time column name changed")
 	}
 
+	if newTblInfo.TTLInfo.ConditionExprStr != tbl.TTLInfo.ConditionExprStr {
+		return errors.New("expire condition changed")
+	}
+
 	if newTblInfo.TTLInfo.IntervalExprStr != tbl.TTLInfo.IntervalExprStr ||
 		newTblInfo.TTLInfo.IntervalTimeUnit != tbl.TTLInfo.IntervalTimeUnit {
 		newExpireTime, err := newTTLTbl.EvalExpireTime(ctx, s, s.Now())
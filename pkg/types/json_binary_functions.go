@@ -254,6 +254,12 @@ func (bj BinaryJSON) Extract(pathExprList []JSONPathExpression) (ret BinaryJSON,
 	return
 }
 
+// ExtractAll returns all the values in bj matched by pathExpr, in the document order.
+// Unlike Extract, the values are not wrapped as an array.
+func (bj BinaryJSON) ExtractAll(pathExpr JSONPathExpression) []BinaryJSON {
+	return bj.extractTo(make([]BinaryJSON, 0, 1), pathExpr, make(map[*byte]struct{}), false)
+}
+
 func (bj BinaryJSON) extractOne(pathExpr JSONPathExpression) []BinaryJSON {
 	result := make([]BinaryJSON, 0, 1)
 	return bj.extractTo(result, pathExpr, nil, true)
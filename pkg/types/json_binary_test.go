@@ -115,6 +115,31 @@ func TestBinaryJSONExtract(t *testing.T) {
 	}
 }
 
+func TestBinaryJSONExtractAll(t *testing.T) {
+	bj := mustParseBinaryFromString(t, `[{"a": 1, "b": [11, 111]}, {"a": 2, "b": [22]}, {"a": 3}]`)
+	var tests = []struct {
+		pathExpr string
+		expected []string
+	}{
+		{"$", []string{`[{"a": 1, "b": [11, 111]}, {"a": 2, "b": [22]}, {"a": 3}]`}},
+		{"$[*]", []string{`{"a": 1, "b": [11, 111]}`, `{"a": 2, "b": [22]}`, `{"a": 3}`}},
+		{"$[*].a", []string{"1", "2", "3"}},
+		{"$[*].b[*]", []string{"11", "111", "22"}},
+		{"$[0].b", []string{"[11, 111]"}},
+		{"$[2].b", nil},
+		{"$.a", nil},
+	}
+	for _, test := range tests {
+		pe, err := ParseJSONPathExpr(test.pathExpr)
+		require.NoError(t, err)
+		result := bj.ExtractAll(pe)
+		require.Len(t, result, len(test.expected), test.pathExpr)
+		for i, expected := range test.expected {
+			require.Equal(t, expected, result[i].String(), test.pathExpr)
+		}
+	}
+}
+
 func TestBinaryJSONType(t *testing.T) {
 	var tests = []struct {
 		in  string
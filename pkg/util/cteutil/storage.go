@@ -268,3 +268,60 @@ func (s *StorageRC) ActionSpillForTest() *chunk.SpillDiskAction {
 func (s *StorageRC) valid() bool {
 	return s.refCnt > 0 && s.rc != nil
 }
+
+// getChunkForRead gets the chunk by index, and returns whether the chunk is
+// newly allocated and owned by the caller, which is true when it's read from disk.
+func (s *StorageRC) getChunkForRead(chkIdx int) (chk *chunk.Chunk, owned bool, err error) {
+	owned = s.rc.AlreadySpilled()
+	chk, err = s.rc.GetChunk(chkIdx)
+	return chk, owned, err
+}
+
+// StorageIter iterates the chunks of a Storage one page at a time.
+// When the underlying storage has been spilled to disk, only the chunk
+// currently being read is loaded into memory, so readers of a large CTE
+// don't need to hold the whole result set.
+type StorageIter struct {
+	storage Storage
+	chkIdx  int
+}
+
+// NewStorageIter creates a StorageIter which reads from the first chunk of storage.
+func NewStorageIter(storage Storage) *StorageIter {
+	return &StorageIter{storage: storage}
+}
+
+// Next returns the next chunk in the storage, or nil if all chunks have been read.
+// A chunk held in memory is copied so that upper operators can't change the data in storage.
+// Rows not selected are ignored when copying, because some operators like Projection
+// doesn't support swap column if chunk.sel is not nil.
+// A chunk read from disk is already a private copy, so it's returned directly to
+// avoid holding the page twice in memory.
+func (it *StorageIter) Next() (*chunk.Chunk, error) {
+	if it.chkIdx >= it.storage.NumChunks() {
+		return nil, nil
+	}
+	var (
+		chk   *chunk.Chunk
+		owned bool
+		err   error
+	)
+	if rc, ok := it.storage.(*StorageRC); ok {
+		chk, owned, err = rc.getChunkForRead(it.chkIdx)
+	} else {
+		chk, err = it.storage.GetChunk(it.chkIdx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	it.chkIdx++
+	if owned && chk.Sel() == nil {
+		return chk, nil
+	}
+	return chk.CopyConstructSel(), nil
+}
+
+// Reset makes the iterator read from the first chunk again.
+func (it *StorageIter) Reset() {
+	it.chkIdx = 0
+}
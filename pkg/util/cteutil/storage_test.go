@@ -249,3 +249,75 @@ func TestSwapData(t *testing.T) {
 	require.Equal(t, in1, out2)
 	require.Equal(t, in2, out1)
 }
+
+func TestStorageIter(t *testing.T) {
+	fields := []*types.FieldType{types.NewFieldType(mysql.TypeLong)}
+	chkSize := 10
+	storage := NewStorageRowContainer(fields, chkSize)
+	err := storage.OpenAndRef()
+	require.NoError(t, err)
+
+	inChk := chunk.NewChunkWithCapacity(fields, chkSize)
+	for i := 0; i < chkSize; i++ {
+		inChk.AppendInt64(0, int64(i))
+	}
+	memTracker := storage.GetMemTracker()
+	memTracker.SetBytesLimit(inChk.MemoryUsage() + 1)
+	action := storage.ActionSpillForTest()
+	memTracker.FallbackOldAndSetNewAction(action)
+
+	// The second chunk triggers spill, the iterator should read all the 3 chunks from disk.
+	for i := 0; i < 3; i++ {
+		err = storage.Add(inChk)
+		require.NoError(t, err)
+	}
+	action.WaitForTest()
+	require.Greater(t, storage.GetDiskTracker().BytesConsumed(), int64(0))
+
+	iter := NewStorageIter(storage)
+	in64s := inChk.Column(0).Int64s()
+	for round := 0; round < 2; round++ {
+		numChks := 0
+		for {
+			outChk, err := iter.Next()
+			require.NoError(t, err)
+			if outChk == nil {
+				break
+			}
+			require.Equal(t, in64s, outChk.Column(0).Int64s())
+			numChks++
+		}
+		require.Equal(t, 3, numChks)
+		iter.Reset()
+	}
+}
+
+func TestStorageIterInMemory(t *testing.T) {
+	fields := []*types.FieldType{types.NewFieldType(mysql.TypeLong)}
+	chkSize := 10
+	storage := NewStorageRowContainer(fields, chkSize)
+	err := storage.OpenAndRef()
+	require.NoError(t, err)
+
+	inChk := chunk.NewChunkWithCapacity(fields, chkSize)
+	for i := 0; i < chkSize; i++ {
+		inChk.AppendInt64(0, int64(i))
+	}
+	err = storage.Add(inChk)
+	require.NoError(t, err)
+	expected := append([]int64(nil), inChk.Column(0).Int64s()...)
+
+	// The chunk held in memory is copied, so changing it doesn't affect the storage.
+	iter := NewStorageIter(storage)
+	outChk, err := iter.Next()
+	require.NoError(t, err)
+	outChk.Reset()
+	outChk, err = iter.Next()
+	require.NoError(t, err)
+	require.Nil(t, outChk)
+
+	iter.Reset()
+	outChk, err = iter.Next()
+	require.NoError(t, err)
+	require.Equal(t, expected, outChk.Column(0).Int64s())
+}
@@ -272,6 +272,8 @@ var (
 	ErrWrongObject = ClassDDL.NewStd(mysql.ErrWrongObject)
 	// ErrTableCantHandleFt returns FULLTEXT keys are not supported by table type
 	ErrTableCantHandleFt = ClassDDL.NewStd(mysql.ErrTableCantHandleFt)
+	// ErrBadFtColumn returns the column cannot be part of FULLTEXT index.
+	ErrBadFtColumn = ClassDDL.NewStd(mysql.ErrBadFtColumn)
 	// ErrFieldNotFoundPart returns an error when 'partition by columns' are not found in table columns.
 	ErrFieldNotFoundPart = ClassDDL.NewStd(mysql.ErrFieldNotFoundPart)
 	// ErrWrongTypeColumnValue returns 'Partition column values of incorrect type'
@@ -450,6 +452,26 @@ var (
 	ErrUnsupportedTTLReferencedByFK = ClassDDL.NewStd(mysql.ErrUnsupportedTTLReferencedByFK)
 	// ErrUnsupportedPrimaryKeyTypeWithTTL returns when create or alter a table with TTL options but the primary key is not supported
 	ErrUnsupportedPrimaryKeyTypeWithTTL = ClassDDL.NewStd(mysql.ErrUnsupportedPrimaryKeyTypeWithTTL)
+	// ErrUnsupportedTTLCondition returns when the TTL_CONDITION is not a deterministic predicate that can be pushed down
+	ErrUnsupportedTTLCondition = ClassDDL.NewStd(mysql.ErrUnsupportedTTLCondition)
+	// ErrRowPolicyExists returns when creating a row policy that already exists on the table
+	ErrRowPolicyExists = ClassDDL.NewStd(mysql.ErrRowPolicyExists)
+	// ErrRowPolicyNotExists returns when dropping a row policy that doesn't exist on the table
+	ErrRowPolicyNotExists = ClassDDL.NewStd(mysql.ErrRowPolicyNotExists)
+	// ErrUnsupportedRowPolicy returns when the predicate of a row policy is not a deterministic expression of the table columns
+	ErrUnsupportedRowPolicy = ClassDDL.NewStd(mysql.ErrUnsupportedRowPolicy)
+	// ErrRowPolicyColumnCannotDrop returns when dropping or renaming a column referenced by a row policy
+	ErrRowPolicyColumnCannotDrop = ClassDDL.NewStd(mysql.ErrRowPolicyColumnCannotDrop)
+	// ErrMaskingPolicyExists returns when creating a masking policy that already exists on the table
+	ErrMaskingPolicyExists = ClassDDL.NewStd(mysql.ErrMaskingPolicyExists)
+	// ErrMaskingPolicyNotExists returns when dropping a masking policy that doesn't exist on the table
+	ErrMaskingPolicyNotExists = ClassDDL.NewStd(mysql.ErrMaskingPolicyNotExists)
+	// ErrUnsupportedMaskingPolicy returns when a masking policy can't be created on the column
+	ErrUnsupportedMaskingPolicy = ClassDDL.NewStd(mysql.ErrUnsupportedMaskingPolicy)
+	// ErrMaskedColumnCannotDrop returns when dropping a column with a masking policy
+	ErrMaskedColumnCannotDrop = ClassDDL.NewStd(mysql.ErrMaskedColumnCannotDrop)
+	// ErrUnsupportedExternalTable returns when creating or altering an external table with unsupported definitions
+	ErrUnsupportedExternalTable = ClassDDL.NewStd(mysql.ErrUnsupportedExternalTable)
 
 	// ErrNotSupportedYet returns when tidb does not support this feature.
 	ErrNotSupportedYet = ClassDDL.NewStd(mysql.ErrNotSupportedYet)
@@ -42,6 +42,7 @@ var (
 	ErrAggregateInOrderNotSelect             = dbterror.ClassOptimizer.NewStd(mysql.ErrAggregateInOrderNotSelect)
 	ErrBadTable                              = dbterror.ClassOptimizer.NewStd(mysql.ErrBadTable)
 	ErrKeyDoesNotExist                       = dbterror.ClassOptimizer.NewStd(mysql.ErrKeyDoesNotExist)
+	ErrFtMatchingKeyNotFound                 = dbterror.ClassOptimizer.NewStd(mysql.ErrFtMatchingKeyNotFound)
 	ErrOperandColumns                        = dbterror.ClassOptimizer.NewStd(mysql.ErrOperandColumns)
 	ErrInvalidGroupFuncUse                   = dbterror.ClassOptimizer.NewStd(mysql.ErrInvalidGroupFuncUse)
 	ErrIllegalReference                      = dbterror.ClassOptimizer.NewStd(mysql.ErrIllegalReference)
@@ -112,6 +113,10 @@ var (
 	ErrSubqueryMoreThan1Row     = dbterror.ClassOptimizer.NewStd(mysql.ErrSubqueryNo1Row)
 	ErrKeyPart0                 = dbterror.ClassOptimizer.NewStd(mysql.ErrKeyPart0)
 	ErrGettingNoopVariable      = dbterror.ClassOptimizer.NewStd(mysql.ErrGettingNoopVariable)
+	ErrMaskedColumnInDML        = dbterror.ClassOptimizer.NewStd(mysql.ErrMaskedColumnInDML)
+	ErrUnsupportedExternalTable = dbterror.ClassOptimizer.NewStd(mysql.ErrUnsupportedExternalTable)
+	// ErrTiFlashReplicaUnavailable returns when the tiflash replica required by the read_from_storage hint is not available.
+	ErrTiFlashReplicaUnavailable = dbterror.ClassOptimizer.NewStd(mysql.ErrTiFlashReplicaUnavailable)
 
 	ErrPrepareMulti     = dbterror.ClassExecutor.NewStd(mysql.ErrPrepareMulti)
 	ErrUnsupportedPs    = dbterror.ClassExecutor.NewStd(mysql.ErrUnsupportedPs)
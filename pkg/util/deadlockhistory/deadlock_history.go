@@ -47,11 +47,18 @@ const (
 	ColKeyInfoStr = "KEY_INFO"
 	// ColTrxHoldingLockStr is the name of the TRX_HOLDING_LOCK column in INFORMATION_SCHEMA.DEADLOCKS and INFORMATION_SCHEMA.CLUSTER_DEADLOCKS table.
 	ColTrxHoldingLockStr = "TRX_HOLDING_LOCK"
+	// ColCurrentPlanDigestStr is the name of the CURRENT_PLAN_DIGEST column in INFORMATION_SCHEMA.DEADLOCKS and INFORMATION_SCHEMA.CLUSTER_DEADLOCKS table.
+	ColCurrentPlanDigestStr = "CURRENT_PLAN_DIGEST"
+	// ColCurrentBindingSQLStr is the name of the CURRENT_BINDING_SQL column in INFORMATION_SCHEMA.DEADLOCKS and INFORMATION_SCHEMA.CLUSTER_DEADLOCKS table.
+	ColCurrentBindingSQLStr = "CURRENT_BINDING_SQL"
+	// ColCurrentAppliedHintsStr is the name of the CURRENT_APPLIED_HINTS column in INFORMATION_SCHEMA.DEADLOCKS and INFORMATION_SCHEMA.CLUSTER_DEADLOCKS table.
+	ColCurrentAppliedHintsStr = "CURRENT_APPLIED_HINTS"
 )
 
 // WaitChainItem represents an entry in a deadlock's wait chain.
 type WaitChainItem struct {
 	SQLDigest      string
+	PlanDigest     string
 	Key            []byte
 	AllSQLDigests  []string
 	TryLockTxn     uint64
@@ -88,6 +95,13 @@ var columnValueGetterMap = map[string]func(rec *DeadlockRecord, waitChainIdx int
 		}
 		return types.NewDatum(digest)
 	},
+	ColCurrentPlanDigestStr: func(rec *DeadlockRecord, waitChainIdx int) types.Datum {
+		digest := rec.WaitChain[waitChainIdx].PlanDigest
+		if len(digest) == 0 {
+			return types.NewDatum(nil)
+		}
+		return types.NewDatum(digest)
+	},
 	ColKeyStr: func(rec *DeadlockRecord, waitChainIdx int) types.Datum {
 		key := rec.WaitChain[waitChainIdx].Key
 		if len(key) == 0 {
@@ -220,13 +234,14 @@ func (d *DeadlockHistory) Clear() {
 func ErrDeadlockToDeadlockRecord(dl *tikverr.ErrDeadlock) *DeadlockRecord {
 	waitChain := make([]WaitChainItem, 0, len(dl.WaitChain))
 	for _, rawItem := range dl.WaitChain {
-		sqlDigest, err := resourcegrouptag.DecodeResourceGroupTag(rawItem.ResourceGroupTag)
+		sqlDigest, planDigest, err := resourcegrouptag.DecodeResourceGroupTagDigests(rawItem.ResourceGroupTag)
 		if err != nil {
 			logutil.BgLogger().Warn("decoding resource group tag encounters error", zap.Error(err))
 		}
 		waitChain = append(waitChain, WaitChainItem{
 			TryLockTxn:     rawItem.Txn,
 			SQLDigest:      hex.EncodeToString(sqlDigest),
+			PlanDigest:     hex.EncodeToString(planDigest),
 			Key:            rawItem.Key,
 			AllSQLDigests:  nil,
 			TxnHoldingLock: rawItem.WaitForTxn,
@@ -161,6 +161,7 @@ func TestGetDatum(t *testing.T) {
 			{
 				TryLockTxn:     101,
 				SQLDigest:      "sql1",
+				PlanDigest:     "plan1",
 				Key:            []byte("k1"),
 				AllSQLDigests:  []string{"sql1", "sql2"},
 				TxnHoldingLock: 102,
@@ -205,12 +206,13 @@ func TestGetDatum(t *testing.T) {
 		{Name: model.NewCIStr(ColKeyStr)},
 		{Name: model.NewCIStr(ColKeyInfoStr)},
 		{Name: model.NewCIStr(ColTrxHoldingLockStr)},
+		{Name: model.NewCIStr(ColCurrentPlanDigestStr)},
 	}
 	res := getAllDatum(h, dummyColumnInfo)
 
 	assert.Equal(t, 4, len(res))
 	for _, row := range res {
-		assert.Equal(t, 9, len(row))
+		assert.Equal(t, 10, len(row))
 	}
 
 	toGoTime := func(d types.Datum) time.Time {
@@ -229,6 +231,7 @@ func TestGetDatum(t *testing.T) {
 	assert.Equal(t, nil, res[0][5].GetValue())         // SQL_DIGEST_TEXT
 	assert.Equal(t, "6B31", res[0][6].GetValue())      // KEY
 	assert.Equal(t, uint64(102), res[0][8].GetValue()) // TRX_HOLDING_LOCK
+	assert.Equal(t, "plan1", res[0][9].GetValue())     // PLAN_DIGEST
 
 	assert.Equal(t, uint64(1), res[1][0].GetValue())   // ID
 	assert.Equal(t, time1, toGoTime(res[1][1]))        // OCCUR_TIME
@@ -238,6 +241,7 @@ func TestGetDatum(t *testing.T) {
 	assert.Equal(t, nil, res[1][5].GetValue())         // SQL_DIGEST_TEXT
 	assert.Equal(t, nil, res[1][6].GetValue())         // KEY
 	assert.Equal(t, uint64(101), res[1][8].GetValue()) // TRX_HOLDING_LOCK
+	assert.Equal(t, nil, res[1][9].GetValue())         // PLAN_DIGEST
 
 	assert.Equal(t, uint64(2), res[2][0].GetValue())   // ID
 	assert.Equal(t, time2, toGoTime(res[2][1]))        // OCCUR_TIME
@@ -254,7 +258,8 @@ func TestGetDatum(t *testing.T) {
 
 func TestErrDeadlockToDeadlockRecord(t *testing.T) {
 	digest1, digest2 := parser.NewDigest([]byte("aabbccdd")), parser.NewDigest([]byte("ddccbbaa"))
-	tag1 := tipb.ResourceGroupTag{SqlDigest: digest1.Bytes()}
+	planDigest1 := parser.NewDigest([]byte("11223344"))
+	tag1 := tipb.ResourceGroupTag{SqlDigest: digest1.Bytes(), PlanDigest: planDigest1.Bytes()}
 	tag2 := tipb.ResourceGroupTag{SqlDigest: digest2.Bytes()}
 	tag1Data, _ := tag1.Marshal()
 	tag2Data, _ := tag2.Marshal()
@@ -287,6 +292,7 @@ func TestErrDeadlockToDeadlockRecord(t *testing.T) {
 			{
 				TryLockTxn:     100,
 				SQLDigest:      digest1.String(),
+				PlanDigest:     planDigest1.String(),
 				Key:            []byte("k2"),
 				TxnHoldingLock: 101,
 			},
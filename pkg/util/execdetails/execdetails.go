@@ -918,6 +918,12 @@ const (
 	TpFKCascadeRuntimeStats
 	// TpRURuntimeStats is the tp for RURuntimeStats
 	TpRURuntimeStats
+	// TpMPPFragmentRuntimeStats is the tp for mpp fragmentRuntimeStats
+	TpMPPFragmentRuntimeStats
+	// TpMPPExchangeCompressionRuntimeStats is the tp for mpp exchangeCompressionRuntimeStats
+	TpMPPExchangeCompressionRuntimeStats
+	// TpRetryRuntimeStats is the tp for RetryRuntimeStats
+	TpRetryRuntimeStats
 )
 
 // RuntimeStats is used to express the executor runtime information.
@@ -1162,6 +1168,10 @@ type BasicRuntimeStats struct {
 	consume atomic.Int64
 	// executor return row count.
 	rows atomic.Int64
+	// time spent in the Next() of children while the executor's Next() is running.
+	childWait atomic.Int64
+	// time spent waiting for responses from the storage layer.
+	netWait atomic.Int64
 	// executor extra infos
 	tiflashScanContext TiFlashScanContext
 }
@@ -1179,6 +1189,8 @@ func (e *BasicRuntimeStats) Clone() RuntimeStats {
 	result.loop.Store(e.loop.Load())
 	result.consume.Store(e.consume.Load())
 	result.rows.Store(e.rows.Load())
+	result.childWait.Store(e.childWait.Load())
+	result.netWait.Store(e.netWait.Load())
 	return result
 }
 
@@ -1191,6 +1203,8 @@ func (e *BasicRuntimeStats) Merge(rs RuntimeStats) {
 	e.loop.Add(tmp.loop.Load())
 	e.consume.Add(tmp.consume.Load())
 	e.rows.Add(tmp.rows.Load())
+	e.childWait.Add(tmp.childWait.Load())
+	e.netWait.Add(tmp.netWait.Load())
 	e.tiflashScanContext.Merge(tmp.tiflashScanContext)
 }
 
@@ -1246,6 +1260,26 @@ func (e *BasicRuntimeStats) Record(d time.Duration, rowNum int) {
 	e.rows.Add(int64(rowNum))
 }
 
+// RecordChildWait records the time the executor is blocked on its children.
+func (e *BasicRuntimeStats) RecordChildWait(d time.Duration) {
+	e.childWait.Add(int64(d))
+}
+
+// RecordNetworkWait records the time the executor is blocked on the responses from the storage layer.
+func (e *BasicRuntimeStats) RecordNetworkWait(d time.Duration) {
+	e.netWait.Add(int64(d))
+}
+
+// GetSelfTime returns the time spent by the executor itself, which excludes
+// the time blocked on children and network.
+func (e *BasicRuntimeStats) GetSelfTime() time.Duration {
+	self := e.consume.Load() - e.childWait.Load() - e.netWait.Load()
+	if self < 0 {
+		return 0
+	}
+	return time.Duration(self)
+}
+
 // SetRowNum sets the row num.
 func (e *BasicRuntimeStats) SetRowNum(rowNum int64) {
 	e.rows.Store(rowNum)
@@ -1264,6 +1298,37 @@ func (e *BasicRuntimeStats) String() string {
 	return str.String()
 }
 
+// WaitTimeString returns the breakdown of the executor's time into the time spent by itself
+// and the time blocked on children and network. It returns an empty string if the executor
+// never waits. The breakdown is approximate when the children run concurrently, so it's
+// only shown by EXPLAIN ANALYZE FORMAT='verbose'.
+func (e *BasicRuntimeStats) WaitTimeString() string {
+	if e == nil {
+		return ""
+	}
+	childWait, netWait := e.childWait.Load(), e.netWait.Load()
+	if childWait == 0 && netWait == 0 {
+		return ""
+	}
+	var str strings.Builder
+	str.WriteString("self:")
+	str.WriteString(FormatDuration(e.GetSelfTime()))
+	str.WriteString(", wait:{")
+	if childWait > 0 {
+		str.WriteString("child:")
+		str.WriteString(FormatDuration(time.Duration(childWait)))
+	}
+	if netWait > 0 {
+		if childWait > 0 {
+			str.WriteString(", ")
+		}
+		str.WriteString("network:")
+		str.WriteString(FormatDuration(time.Duration(netWait)))
+	}
+	str.WriteString("}")
+	return str.String()
+}
+
 // GetTime get the int64 total time
 func (e *BasicRuntimeStats) GetTime() int64 {
 	return e.consume.Load()
@@ -418,6 +418,37 @@ func TestRootRuntimeStats(t *testing.T) {
 	require.Equal(t, expect, stats.String())
 }
 
+func TestBasicRuntimeStatsWait(t *testing.T) {
+	stats := &BasicRuntimeStats{}
+	stats.Record(time.Second*3, 10)
+	require.Equal(t, "", stats.WaitTimeString())
+	require.Equal(t, time.Second*3, stats.GetSelfTime())
+
+	stats.RecordChildWait(time.Second)
+	require.Equal(t, "self:2s, wait:{child:1s}", stats.WaitTimeString())
+	stats.RecordNetworkWait(time.Millisecond * 500)
+	require.Equal(t, "self:1.5s, wait:{child:1s, network:500ms}", stats.WaitTimeString())
+	require.Equal(t, "time:3s, loops:1", stats.String())
+
+	merged := stats.Clone().(*BasicRuntimeStats)
+	merged.Merge(stats)
+	require.Equal(t, "self:3s, wait:{child:2s, network:1s}", merged.WaitTimeString())
+
+	// The waiting time of concurrent children may exceed the executor's own time.
+	stats.RecordChildWait(time.Second * 5)
+	require.Equal(t, time.Duration(0), stats.GetSelfTime())
+
+	stmtStats := NewRuntimeStatsColl(nil)
+	basic := stmtStats.GetBasicRuntimeStats(1)
+	basic.Record(time.Second, 1)
+	basic.RecordNetworkWait(time.Millisecond * 800)
+	concurrency := &RuntimeStatsWithConcurrencyInfo{}
+	concurrency.SetConcurrencyInfo(NewConcurrencyInfo("worker", 5))
+	stmtStats.RegisterStats(1, concurrency)
+	require.Equal(t, "time:1s, loops:1, worker:5", stmtStats.GetRootStats(1).String())
+	require.Equal(t, "self:200ms, wait:{network:800ms}", basic.WaitTimeString())
+}
+
 func TestFormatDurationForExplain(t *testing.T) {
 	cases := []struct {
 		t string
@@ -17,10 +17,12 @@ package hint
 import (
 	"bytes"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"strings"
 
-	"github.com/pingcap/errors"
+	"github.com/pingcap/kvproto/pkg/metapb"
 	mysql "github.com/pingcap/tidb/pkg/errno"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/format"
@@ -57,6 +59,8 @@ const (
 	HintINLHJ = "inl_hash_join"
 	// HintINLMJ is hint enforce index nested loop merge join.
 	HintINLMJ = "inl_merge_join"
+	// HintINLJPrefetch is hint enforce prefetching the inner side lookups of index join ahead.
+	HintINLJPrefetch = "inl_join_prefetch"
 	// HintNoIndexJoin is the hint to enforce the query not to use index join.
 	HintNoIndexJoin = "no_index_join"
 	// HintNoIndexHashJoin is the hint to enforce the query not to use index hash join.
@@ -91,8 +95,38 @@ const (
 	HintOrderIndex = "order_index"
 	// HintNoOrderIndex is hint enforce using some indexes and not keep the index's order.
 	HintNoOrderIndex = "no_order_index"
+	// HintLooseIndexScan is hint enforce using the index and executing the GROUP BY on its prefix by the loose index scan.
+	HintLooseIndexScan = "loose_index_scan"
+	// HintIndex is the MySQL 8.0 spelling of FORCE_INDEX.
+	HintIndex = "index"
+	// HintNoIndex is the MySQL 8.0 spelling of IGNORE_INDEX.
+	HintNoIndex = "no_index"
+	// HintGroupIndex is hint enforce using some indexes for GROUP BY, like `FORCE INDEX FOR GROUP BY`.
+	HintGroupIndex = "group_index"
+	// HintNoGroupIndex is hint enforce ignoring some indexes for GROUP BY, like `IGNORE INDEX FOR GROUP BY`.
+	HintNoGroupIndex = "no_group_index"
+	// HintJoinIndex is hint enforce using some indexes for joins, like `FORCE INDEX FOR JOIN`.
+	HintJoinIndex = "join_index"
+	// HintNoJoinIndex is hint enforce ignoring some indexes for joins, like `IGNORE INDEX FOR JOIN`.
+	HintNoJoinIndex = "no_join_index"
+	// HintFullScan is hint enforce accessing the table by table scan without any index.
+	HintFullScan = "full_scan"
+	// HintIndexOnly is hint enforce accessing the table by covering index scan without table lookup.
+	HintIndexOnly = "index_only"
+	// HintNoFullScan is hint enforce not accessing the table by scanning its full range.
+	HintNoFullScan = "no_full_scan"
+	// HintSample is hint enforce sampling a percentage of the rows of the table.
+	HintSample = "sample"
 	// HintAggToCop is hint enforce pushing aggregation to coprocessor.
 	HintAggToCop = "agg_to_cop"
+	// HintDistinctAggPushDown is hint enforce allowing the aggregation with distinct to be pushed down to coprocessor.
+	HintDistinctAggPushDown = "distinct_agg_push_down"
+	// HintNoDistinctAggPushDown is hint enforce not pushing the aggregation with distinct down to coprocessor.
+	HintNoDistinctAggPushDown = "no_distinct_agg_push_down"
+	// HintApproxCountDistinct is hint enforce rewriting COUNT(DISTINCT) to APPROX_COUNT_DISTINCT.
+	HintApproxCountDistinct = "approx_count_distinct"
+	// HintNoExpand is hint enforce not expanding the rows by Expand to compute the multiple distinct aggregations.
+	HintNoExpand = "no_expand"
 	// HintReadFromStorage is hint enforce some tables read from specific type of storage.
 	HintReadFromStorage = "read_from_storage"
 	// HintTiFlash is a label represents the tiflash storage type.
@@ -113,6 +147,14 @@ const (
 	HintSemiJoinRewrite = "semi_join_rewrite"
 	// HintNoDecorrelate indicates a LogicalApply not to be decorrelated.
 	HintNoDecorrelate = "no_decorrelate"
+	// HintMaterializeSubquery is a hint to keep the IN/EXISTS subquery as a semi join which materializes the subquery into a hash table.
+	HintMaterializeSubquery = "materialize_subquery"
+	// HintNoMaterializeSubquery is a hint to rewrite the IN/EXISTS subquery into an inner join with aggregation.
+	HintNoMaterializeSubquery = "no_materialize_subquery"
+	// HintUseFullTextIndex is a hint to enforce evaluating MATCH ... AGAINST by the FULLTEXT index.
+	HintUseFullTextIndex = "use_fulltext_index"
+	// HintNoFullTextIndex is a hint to enforce evaluating MATCH ... AGAINST by LIKE instead of the FULLTEXT index.
+	HintNoFullTextIndex = "no_fulltext_index"
 
 	// HintMemoryQuota sets the memory limit for a query
 	HintMemoryQuota = "memory_quota"
@@ -122,11 +164,38 @@ const (
 	HintNoIndexMerge = "no_index_merge"
 	// HintMaxExecutionTime specifies the max allowed execution time in milliseconds
 	HintMaxExecutionTime = "max_execution_time"
+	// HintUseInvisibleIndexes is a hint to let the optimizer consider invisible indexes
+	HintUseInvisibleIndexes = "use_invisible_indexes"
+	// HintBatchDML is a hint to execute a DELETE or UPDATE statement as a non-transactional DML in batches.
+	HintBatchDML = "batch_dml"
+	// HintShardRowID is a hint to override the shard bits of the implicit row IDs allocated by an INSERT statement.
+	HintShardRowID = "shard_row_id"
 
+	// HintReadLocality prefers reading from the replicas on the stores matching the given labels.
+	HintReadLocality = "read_locality"
+	// ReadLocalityFallbackAny reads from any replica if no store matches the labels of HintReadLocality.
+	ReadLocalityFallbackAny = "any"
+	// ReadLocalityFallbackLeader reads from the leader if no store matches the labels of HintReadLocality.
+	ReadLocalityFallbackLeader = "leader"
+	// ReadLocalityFallbackError reports an error if no store matches the labels of HintReadLocality.
+	ReadLocalityFallbackError = "error"
+
+	// HintMppExchangeCompression sets the data compression mode of the mpp exchange operators for a query.
+	HintMppExchangeCompression = "mpp_exchange_compression"
+	// mppExchangeCompressionModeVar is the system variable set by HintMppExchangeCompression, it can't refer to
+	// the variable package directly to avoid cycle import.
+	mppExchangeCompressionModeVar = "mpp_exchange_compression_mode"
+)
+
+const (
 	// HintFlagSemiJoinRewrite corresponds to HintSemiJoinRewrite.
 	HintFlagSemiJoinRewrite uint64 = 1 << iota
 	// HintFlagNoDecorrelate corresponds to HintNoDecorrelate.
 	HintFlagNoDecorrelate
+	// HintFlagMaterializeSubquery corresponds to HintMaterializeSubquery.
+	HintFlagMaterializeSubquery
+	// HintFlagNoMaterializeSubquery corresponds to HintNoMaterializeSubquery.
+	HintFlagNoMaterializeSubquery
 )
 
 const (
@@ -190,6 +259,21 @@ const (
 	PreferMPP1PhaseAgg
 	// PreferMPP2PhaseAgg indicates that the optimizer prefers to use 2-phase aggregation.
 	PreferMPP2PhaseAgg
+	// PreferDistinctAggPushDown indicates that the optimizer prefers to push the aggregation with distinct down to coprocessor.
+	PreferDistinctAggPushDown
+	// PreferNoDistinctAggPushDown indicates that the optimizer prefers not to push the aggregation with distinct down to coprocessor.
+	PreferNoDistinctAggPushDown
+	// PreferApproxCountDistinct indicates that the optimizer prefers to rewrite COUNT(DISTINCT) to APPROX_COUNT_DISTINCT.
+	PreferApproxCountDistinct
+	// PreferNoExpand indicates that the optimizer prefers not to expand the rows by Expand for the multiple distinct aggregations.
+	PreferNoExpand
+)
+
+const (
+	// PreferFullTextIndex indicates that the optimizer prefers to evaluate MATCH ... AGAINST by the FULLTEXT index.
+	PreferFullTextIndex = 1 << iota
+	// PreferNoFullTextIndex indicates that the optimizer prefers to evaluate MATCH ... AGAINST without the FULLTEXT index.
+	PreferNoFullTextIndex
 )
 
 const (
@@ -199,6 +283,19 @@ const (
 	PreferTiFlash
 )
 
+const (
+	// PreferFullScan indicates that the optimizer prefers to access the table by table scan.
+	PreferFullScan = 1 << iota
+	// PreferIndexOnly indicates that the optimizer prefers to access the table by covering index scan.
+	PreferIndexOnly
+	// PreferNoFullScan indicates that the optimizer prefers not to access the table by full range scan.
+	PreferNoFullScan
+)
+
+// maxINLJPrefetchTasks is the max number of tasks the inl_join_prefetch hint can prefetch,
+// which is the same as the max value of `tidb_index_lookup_prefetch_tasks`.
+const maxINLJPrefetchTasks = 256
+
 // StmtHints are hints that apply to the entire statement, like 'max_exec_time', 'memory_quota'.
 type StmtHints struct {
 	// Hint Information
@@ -208,12 +305,27 @@ type StmtHints struct {
 	AllowInSubqToJoinAndAgg bool
 	NoIndexMergeHint        bool
 	StraightJoinOrder       bool
+	UseInvisibleIndexes     bool
 	// EnableCascadesPlanner is use cascades planner for a single query only.
 	EnableCascadesPlanner bool
 	// ForceNthPlan indicates the PlanCounterTp number for finding physical plan.
 	// -1 for disable.
 	ForceNthPlan  int64
 	ResourceGroup string
+	// AsyncCommit and OnePC override tidb_enable_async_commit and tidb_enable_1pc for the transaction of the
+	// DML statement.
+	AsyncCommit bool
+	OnePC       bool
+	// ShardRowIDBits overrides the SHARD_ROW_ID_BITS of the tables when allocating the implicit row IDs for this
+	// statement, it's set by the shard_row_id hint.
+	ShardRowIDBits uint64
+	// GeneratedColumnSubstitute disables or forces the substitution of expressions with the generated columns
+	// for this statement, it's set by the generated_column_substitute hint.
+	GeneratedColumnSubstitute bool
+	// ReadLocalityLabels are the store labels the replicas to read from should match, and ReadLocalityFallback
+	// decides what to do if no store matches them, they're set by the read_locality hint.
+	ReadLocalityLabels   []*metapb.StoreLabel
+	ReadLocalityFallback string
 
 	// Hint flags
 	HasAllowInSubqToJoinAndAggHint bool
@@ -222,7 +334,14 @@ type StmtHints struct {
 	HasMaxExecutionTime            bool
 	HasEnableCascadesPlannerHint   bool
 	HasResourceGroup               bool
+	HasAsyncCommitHint             bool
+	HasOnePCHint                   bool
+	HasShardRowIDHint              bool
+	HasGeneratedColumnSubstitute   bool
+	HasReadLocalityHint            bool
 	SetVars                        map[string]string
+	// FixControl overrides the tidb_opt_fix_control for this statement, it's set by the fix_control hint.
+	FixControl map[uint64]string
 
 	// the original table hints
 	OriginalTableHints []*ast.TableOptimizerHint
@@ -237,6 +356,7 @@ func (sh *StmtHints) TaskMapNeedBackUp() bool {
 func (sh *StmtHints) Clone() *StmtHints {
 	var (
 		vars       map[string]string
+		fixControl map[uint64]string
 		tableHints []*ast.TableOptimizerHint
 	)
 	if len(sh.SetVars) > 0 {
@@ -245,6 +365,9 @@ func (sh *StmtHints) Clone() *StmtHints {
 			vars[k] = v
 		}
 	}
+	if len(sh.FixControl) > 0 {
+		fixControl = maps.Clone(sh.FixControl)
+	}
 	if len(sh.OriginalTableHints) > 0 {
 		tableHints = make([]*ast.TableOptimizerHint, len(sh.OriginalTableHints))
 		copy(tableHints, sh.OriginalTableHints)
@@ -256,16 +379,29 @@ func (sh *StmtHints) Clone() *StmtHints {
 		AllowInSubqToJoinAndAgg:        sh.AllowInSubqToJoinAndAgg,
 		NoIndexMergeHint:               sh.NoIndexMergeHint,
 		StraightJoinOrder:              sh.StraightJoinOrder,
+		UseInvisibleIndexes:            sh.UseInvisibleIndexes,
 		EnableCascadesPlanner:          sh.EnableCascadesPlanner,
 		ForceNthPlan:                   sh.ForceNthPlan,
 		ResourceGroup:                  sh.ResourceGroup,
+		AsyncCommit:                    sh.AsyncCommit,
+		OnePC:                          sh.OnePC,
+		ShardRowIDBits:                 sh.ShardRowIDBits,
+		GeneratedColumnSubstitute:      sh.GeneratedColumnSubstitute,
+		ReadLocalityLabels:             sh.ReadLocalityLabels,
+		ReadLocalityFallback:           sh.ReadLocalityFallback,
 		HasAllowInSubqToJoinAndAggHint: sh.HasAllowInSubqToJoinAndAggHint,
 		HasMemQuotaHint:                sh.HasMemQuotaHint,
 		HasReplicaReadHint:             sh.HasReplicaReadHint,
 		HasMaxExecutionTime:            sh.HasMaxExecutionTime,
 		HasEnableCascadesPlannerHint:   sh.HasEnableCascadesPlannerHint,
 		HasResourceGroup:               sh.HasResourceGroup,
+		HasAsyncCommitHint:             sh.HasAsyncCommitHint,
+		HasOnePCHint:                   sh.HasOnePCHint,
+		HasShardRowIDHint:              sh.HasShardRowIDHint,
+		HasGeneratedColumnSubstitute:   sh.HasGeneratedColumnSubstitute,
+		HasReadLocalityHint:            sh.HasReadLocalityHint,
 		SetVars:                        vars,
+		FixControl:                     fixControl,
 		OriginalTableHints:             tableHints,
 	}
 }
@@ -273,16 +409,18 @@ func (sh *StmtHints) Clone() *StmtHints {
 // ParseStmtHints parses statement hints.
 func ParseStmtHints(hints []*ast.TableOptimizerHint,
 	setVarHintChecker func(varName, hint string) (ok bool, warning error),
-	replicaReadFollower byte) ( // to avoid cycle import
+	replicaReadFollower, replicaReadHedged byte) ( // to avoid cycle import
 	stmtHints StmtHints, offs []int, warns []error) {
 	if len(hints) == 0 {
 		return
 	}
 	hintOffs := make(map[string]int, len(hints))
 	var forceNthPlan *ast.TableOptimizerHint
-	var memoryQuotaHintCnt, useToJAHintCnt, useCascadesHintCnt, noIndexMergeHintCnt, readReplicaHintCnt, maxExecutionTimeCnt, forceNthPlanCnt, straightJoinHintCnt, resourceGroupHintCnt int
+	var memoryQuotaHintCnt, useToJAHintCnt, useCascadesHintCnt, noIndexMergeHintCnt, readReplicaHintCnt, maxExecutionTimeCnt, forceNthPlanCnt, straightJoinHintCnt, resourceGroupHintCnt, useInvisibleIndexesHintCnt, asyncCommitHintCnt, onePCHintCnt, hedgedReadHintCnt, readLocalityHintCnt, shardRowIDHintCnt, gcSubstituteHintCnt int
 	setVars := make(map[string]string)
 	setVarsOffs := make([]int, 0, len(hints))
+	var fixControl map[uint64]string
+	fixControlOffs := make([]int, 0, len(hints))
 	for i, hint := range hints {
 		switch hint.HintName.L {
 		case "memory_quota":
@@ -303,6 +441,12 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 		case "read_consistent_replica":
 			hintOffs[hint.HintName.L] = i
 			readReplicaHintCnt++
+		case "hedged_read":
+			hintOffs[hint.HintName.L] = i
+			hedgedReadHintCnt++
+		case HintReadLocality:
+			hintOffs[hint.HintName.L] = i
+			readLocalityHintCnt++
 		case "max_execution_time":
 			hintOffs[hint.HintName.L] = i
 			maxExecutionTimeCnt++
@@ -312,6 +456,21 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 		case "straight_join":
 			hintOffs[hint.HintName.L] = i
 			straightJoinHintCnt++
+		case "use_invisible_indexes":
+			hintOffs[hint.HintName.L] = i
+			useInvisibleIndexesHintCnt++
+		case "async_commit":
+			hintOffs[hint.HintName.L] = i
+			asyncCommitHintCnt++
+		case "one_pc":
+			hintOffs[hint.HintName.L] = i
+			onePCHintCnt++
+		case "shard_row_id":
+			hintOffs[hint.HintName.L] = i
+			shardRowIDHintCnt++
+		case "generated_column_substitute":
+			hintOffs[hint.HintName.L] = i
+			gcSubstituteHintCnt++
 		case "set_var":
 			setVarHint := hint.HintData.(ast.HintSetVar)
 
@@ -332,28 +491,52 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 			}
 			setVars[setVarHint.VarName] = setVarHint.Value
 			setVarsOffs = append(setVarsOffs, i)
+		case HintMppExchangeCompression:
+			// MPP_EXCHANGE_COMPRESSION(mode) is a shortcut of SET_VAR(mpp_exchange_compression_mode=mode).
+			mode := hint.HintData.(string)
+			if _, ok := setVars[mppExchangeCompressionModeVar]; ok {
+				msg := fmt.Sprintf("%s(%s)", hint.HintName.String(), mode)
+				warns = append(warns, ErrWarnConflictingHint.FastGenByArgs(msg))
+				continue
+			}
+			setVars[mppExchangeCompressionModeVar] = mode
+			setVarsOffs = append(setVarsOffs, i)
+		case "fix_control":
+			if fixControl == nil {
+				fixControl = make(map[uint64]string)
+			}
+			// If a fix is set more than once, the last one takes effect.
+			for _, fix := range hint.HintData.([]ast.HintFixControl) {
+				if oldValue, ok := fixControl[fix.FixID]; ok && oldValue != fix.Value {
+					msg := fmt.Sprintf("%s(%d:%s)", hint.HintName.String(), fix.FixID, oldValue)
+					warns = append(warns, ErrWarnConflictingHint.FastGenByArgs(msg))
+				}
+				fixControl[fix.FixID] = fix.Value
+			}
+			fixControlOffs = append(fixControlOffs, i)
 		}
 	}
 	stmtHints.OriginalTableHints = hints
 	stmtHints.SetVars = setVars
+	stmtHints.FixControl = fixControl
 
 	// Handle MEMORY_QUOTA
 	if memoryQuotaHintCnt != 0 {
 		memoryQuotaHint := hints[hintOffs["memory_quota"]]
 		if memoryQuotaHintCnt > 1 {
-			warn := errors.NewNoStackErrorf("MEMORY_QUOTA() is defined more than once, only the last definition takes effect: MEMORY_QUOTA(%v)", memoryQuotaHint.HintData.(int64))
+			warn := ErrWarnConflictingHint.FastGen("MEMORY_QUOTA() is defined more than once, only the last definition takes effect: MEMORY_QUOTA(%v)", memoryQuotaHint.HintData.(int64))
 			warns = append(warns, warn)
 		}
 		// Executor use MemoryQuota <= 0 to indicate no memory limit, here use < 0 to handle hint syntax error.
 		if memoryQuota := memoryQuotaHint.HintData.(int64); memoryQuota < 0 {
 			delete(hintOffs, "memory_quota")
-			warn := errors.NewNoStackError("The use of MEMORY_QUOTA hint is invalid, valid usage: MEMORY_QUOTA(10 MB) or MEMORY_QUOTA(10 GB)")
+			warn := ErrWarnHintInapplicable.FastGen("The use of MEMORY_QUOTA hint is invalid, valid usage: MEMORY_QUOTA(10 MB) or MEMORY_QUOTA(10 GB)")
 			warns = append(warns, warn)
 		} else {
 			stmtHints.HasMemQuotaHint = true
 			stmtHints.MemQuotaQuery = memoryQuota
 			if memoryQuota == 0 {
-				warn := errors.NewNoStackError("Setting the MEMORY_QUOTA to 0 means no memory limit")
+				warn := ErrWarnHintInapplicable.FastGen("Setting the MEMORY_QUOTA to 0 means no memory limit")
 				warns = append(warns, warn)
 			}
 		}
@@ -362,7 +545,7 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 	if useToJAHintCnt != 0 {
 		useToJAHint := hints[hintOffs["use_toja"]]
 		if useToJAHintCnt > 1 {
-			warn := errors.NewNoStackErrorf("USE_TOJA() is defined more than once, only the last definition takes effect: USE_TOJA(%v)", useToJAHint.HintData.(bool))
+			warn := ErrWarnConflictingHint.FastGen("USE_TOJA() is defined more than once, only the last definition takes effect: USE_TOJA(%v)", useToJAHint.HintData.(bool))
 			warns = append(warns, warn)
 		}
 		stmtHints.HasAllowInSubqToJoinAndAggHint = true
@@ -372,7 +555,7 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 	if useCascadesHintCnt != 0 {
 		useCascadesHint := hints[hintOffs["use_cascades"]]
 		if useCascadesHintCnt > 1 {
-			warn := errors.NewNoStackErrorf("USE_CASCADES() is defined more than once, only the last definition takes effect: USE_CASCADES(%v)", useCascadesHint.HintData.(bool))
+			warn := ErrWarnConflictingHint.FastGen("USE_CASCADES() is defined more than once, only the last definition takes effect: USE_CASCADES(%v)", useCascadesHint.HintData.(bool))
 			warns = append(warns, warn)
 		}
 		stmtHints.HasEnableCascadesPlannerHint = true
@@ -381,7 +564,7 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 	// Handle NO_INDEX_MERGE
 	if noIndexMergeHintCnt != 0 {
 		if noIndexMergeHintCnt > 1 {
-			warn := errors.NewNoStackError("NO_INDEX_MERGE() is defined more than once, only the last definition takes effect")
+			warn := ErrWarnConflictingHint.FastGen("NO_INDEX_MERGE() is defined more than once, only the last definition takes effect")
 			warns = append(warns, warn)
 		}
 		stmtHints.NoIndexMergeHint = true
@@ -389,25 +572,67 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 	// Handle straight_join
 	if straightJoinHintCnt != 0 {
 		if straightJoinHintCnt > 1 {
-			warn := errors.NewNoStackError("STRAIGHT_JOIN() is defined more than once, only the last definition takes effect")
+			warn := ErrWarnConflictingHint.FastGen("STRAIGHT_JOIN() is defined more than once, only the last definition takes effect")
 			warns = append(warns, warn)
 		}
 		stmtHints.StraightJoinOrder = true
 	}
+	// Handle USE_INVISIBLE_INDEXES
+	if useInvisibleIndexesHintCnt != 0 {
+		if useInvisibleIndexesHintCnt > 1 {
+			warn := ErrWarnConflictingHint.FastGen("USE_INVISIBLE_INDEXES() is defined more than once, only the last definition takes effect")
+			warns = append(warns, warn)
+		}
+		stmtHints.UseInvisibleIndexes = true
+	}
 	// Handle READ_CONSISTENT_REPLICA
 	if readReplicaHintCnt != 0 {
 		if readReplicaHintCnt > 1 {
-			warn := errors.NewNoStackError("READ_CONSISTENT_REPLICA() is defined more than once, only the last definition takes effect")
+			warn := ErrWarnConflictingHint.FastGen("READ_CONSISTENT_REPLICA() is defined more than once, only the last definition takes effect")
 			warns = append(warns, warn)
 		}
 		stmtHints.HasReplicaReadHint = true
 		stmtHints.ReplicaRead = replicaReadFollower
 	}
+	// Handle HEDGED_READ
+	if hedgedReadHintCnt != 0 {
+		if hedgedReadHintCnt > 1 {
+			warn := ErrWarnConflictingHint.FastGen("HEDGED_READ() is defined more than once, only the last definition takes effect")
+			warns = append(warns, warn)
+		}
+		if readReplicaHintCnt != 0 {
+			warn := ErrWarnConflictingHint.FastGen("HEDGED_READ() is conflict with READ_CONSISTENT_REPLICA(), HEDGED_READ() is ignored")
+			warns = append(warns, warn)
+		} else {
+			stmtHints.HasReplicaReadHint = true
+			stmtHints.ReplicaRead = replicaReadHedged
+		}
+	}
+	// Handle READ_LOCALITY
+	if readLocalityHintCnt != 0 {
+		readLocalityHint := hints[hintOffs[HintReadLocality]].HintData.(ast.HintReadLocality)
+		if readLocalityHintCnt > 1 {
+			warn := ErrWarnConflictingHint.FastGen("READ_LOCALITY() is defined more than once, only the last definition takes effect: READ_LOCALITY('%s')", readLocalityHint.Labels)
+			warns = append(warns, warn)
+		}
+		labels, fallback, err := parseReadLocality(readLocalityHint)
+		if err != nil {
+			delete(hintOffs, HintReadLocality)
+			warns = append(warns, ErrWarnHintInapplicable.FastGen("The use of READ_LOCALITY hint is invalid, %s. Valid usage: READ_LOCALITY('region=eu, zone=z1', ANY | LEADER | ERROR)", err.Error()))
+		} else if stmtHints.HasReplicaReadHint && stmtHints.ReplicaRead == replicaReadHedged {
+			warn := ErrWarnConflictingHint.FastGen("READ_LOCALITY() is conflict with HEDGED_READ(), READ_LOCALITY() is ignored")
+			warns = append(warns, warn)
+		} else {
+			stmtHints.HasReadLocalityHint = true
+			stmtHints.ReadLocalityLabels = labels
+			stmtHints.ReadLocalityFallback = fallback
+		}
+	}
 	// Handle MAX_EXECUTION_TIME
 	if maxExecutionTimeCnt != 0 {
 		maxExecutionTime := hints[hintOffs["max_execution_time"]]
 		if maxExecutionTimeCnt > 1 {
-			warn := errors.NewNoStackErrorf("MAX_EXECUTION_TIME() is defined more than once, only the last definition takes effect: MAX_EXECUTION_TIME(%v)", maxExecutionTime.HintData.(uint64))
+			warn := ErrWarnConflictingHint.FastGen("MAX_EXECUTION_TIME() is defined more than once, only the last definition takes effect: MAX_EXECUTION_TIME(%v)", maxExecutionTime.HintData.(uint64))
 			warns = append(warns, warn)
 		}
 		stmtHints.HasMaxExecutionTime = true
@@ -417,22 +642,62 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 	if resourceGroupHintCnt != 0 {
 		resourceGroup := hints[hintOffs["resource_group"]]
 		if resourceGroupHintCnt > 1 {
-			warn := errors.NewNoStackErrorf("RESOURCE_GROUP() is defined more than once, only the last definition takes effect: RESOURCE_GROUP(%v)", resourceGroup.HintData.(string))
+			warn := ErrWarnConflictingHint.FastGen("RESOURCE_GROUP() is defined more than once, only the last definition takes effect: RESOURCE_GROUP(%v)", resourceGroup.HintData.(string))
 			warns = append(warns, warn)
 		}
 		stmtHints.HasResourceGroup = true
 		stmtHints.ResourceGroup = resourceGroup.HintData.(string)
 	}
+	// Handle ASYNC_COMMIT
+	if asyncCommitHintCnt != 0 {
+		asyncCommitHint := hints[hintOffs["async_commit"]]
+		if asyncCommitHintCnt > 1 {
+			warn := ErrWarnConflictingHint.FastGen("ASYNC_COMMIT() is defined more than once, only the last definition takes effect: ASYNC_COMMIT(%v)", asyncCommitHint.HintData.(bool))
+			warns = append(warns, warn)
+		}
+		stmtHints.HasAsyncCommitHint = true
+		stmtHints.AsyncCommit = asyncCommitHint.HintData.(bool)
+	}
+	// Handle ONE_PC
+	if onePCHintCnt != 0 {
+		onePCHint := hints[hintOffs["one_pc"]]
+		if onePCHintCnt > 1 {
+			warn := ErrWarnConflictingHint.FastGen("ONE_PC() is defined more than once, only the last definition takes effect: ONE_PC(%v)", onePCHint.HintData.(bool))
+			warns = append(warns, warn)
+		}
+		stmtHints.HasOnePCHint = true
+		stmtHints.OnePC = onePCHint.HintData.(bool)
+	}
+	// Handle SHARD_ROW_ID
+	if shardRowIDHintCnt != 0 {
+		shardRowIDHint := hints[hintOffs["shard_row_id"]]
+		if shardRowIDHintCnt > 1 {
+			warn := ErrWarnConflictingHint.FastGen("SHARD_ROW_ID() is defined more than once, only the last definition takes effect: SHARD_ROW_ID(%v)", shardRowIDHint.HintData.(uint64))
+			warns = append(warns, warn)
+		}
+		stmtHints.HasShardRowIDHint = true
+		stmtHints.ShardRowIDBits = shardRowIDHint.HintData.(uint64)
+	}
+	// Handle GENERATED_COLUMN_SUBSTITUTE
+	if gcSubstituteHintCnt != 0 {
+		gcSubstituteHint := hints[hintOffs["generated_column_substitute"]]
+		if gcSubstituteHintCnt > 1 {
+			warn := ErrWarnConflictingHint.FastGen("GENERATED_COLUMN_SUBSTITUTE() is defined more than once, only the last definition takes effect: GENERATED_COLUMN_SUBSTITUTE(%v)", gcSubstituteHint.HintData.(bool))
+			warns = append(warns, warn)
+		}
+		stmtHints.HasGeneratedColumnSubstitute = true
+		stmtHints.GeneratedColumnSubstitute = gcSubstituteHint.HintData.(bool)
+	}
 	// Handle NTH_PLAN
 	if forceNthPlanCnt != 0 {
 		if forceNthPlanCnt > 1 {
-			warn := errors.NewNoStackErrorf("NTH_PLAN() is defined more than once, only the last definition takes effect: NTH_PLAN(%v)", forceNthPlan.HintData.(int64))
+			warn := ErrWarnConflictingHint.FastGen("NTH_PLAN() is defined more than once, only the last definition takes effect: NTH_PLAN(%v)", forceNthPlan.HintData.(int64))
 			warns = append(warns, warn)
 		}
 		stmtHints.ForceNthPlan = forceNthPlan.HintData.(int64)
 		if stmtHints.ForceNthPlan < 1 {
 			stmtHints.ForceNthPlan = -1
-			warn := errors.NewNoStackError("the hintdata for NTH_PLAN() is too small, hint ignored")
+			warn := ErrWarnHintInapplicable.FastGen("the hintdata for NTH_PLAN() is too small, hint ignored")
 			warns = append(warns, warn)
 		}
 	} else {
@@ -442,11 +707,34 @@ func ParseStmtHints(hints []*ast.TableOptimizerHint,
 		offs = append(offs, off)
 	}
 	offs = append(offs, setVarsOffs...)
+	offs = append(offs, fixControlOffs...)
 	// let hint is always ordered, it is convenient to human compare and test.
 	sort.Ints(offs)
 	return
 }
 
+// parseReadLocality parses the labels and the fallback policy of the read_locality hint.
+func parseReadLocality(h ast.HintReadLocality) ([]*metapb.StoreLabel, string, error) {
+	labels := make([]*metapb.StoreLabel, 0, 2)
+	for _, item := range strings.Split(h.Labels, ",") {
+		key, value, ok := strings.Cut(item, "=")
+		key, value = strings.TrimSpace(key), strings.TrimSpace(value)
+		if !ok || key == "" || value == "" {
+			return nil, "", fmt.Errorf("the label '%s' is not in the form of key=value", strings.TrimSpace(item))
+		}
+		labels = append(labels, &metapb.StoreLabel{Key: key, Value: value})
+	}
+	fallback := strings.ToLower(h.Fallback)
+	switch fallback {
+	case "":
+		fallback = ReadLocalityFallbackAny
+	case ReadLocalityFallbackAny, ReadLocalityFallbackLeader, ReadLocalityFallbackError:
+	default:
+		return nil, "", fmt.Errorf("the fallback policy '%s' is unknown", h.Fallback)
+	}
+	return labels, fallback, nil
+}
+
 // IndexJoinHints stores hint information about index nested loop join.
 type IndexJoinHints struct {
 	INLJTables  []HintedTable
@@ -457,28 +745,39 @@ type IndexJoinHints struct {
 // PlanHints are hints that are used to control the optimizer plan choices like 'use_index', 'hash_join'.
 // TODO: move ignore_plan_cache, straight_join, no_decorrelate here.
 type PlanHints struct {
-	IndexJoin          IndexJoinHints // inlj_join, inlhj_join, inlmj_join
-	NoIndexJoin        IndexJoinHints // no_inlj_join, no_inlhj_join, no_inlmj_join
-	HashJoin           []HintedTable  // hash_join
-	NoHashJoin         []HintedTable  // no_hash_join
-	SortMergeJoin      []HintedTable  // merge_join
-	NoMergeJoin        []HintedTable  // no_merge_join
-	BroadcastJoin      []HintedTable  // bcj_join
-	ShuffleJoin        []HintedTable  // shuffle_join
-	IndexHintList      []HintedIndex  // use_index, ignore_index
-	IndexMergeHintList []HintedIndex  // use_index_merge
-	TiFlashTables      []HintedTable  // isolation_read_engines(xx=tiflash)
-	TiKVTables         []HintedTable  // isolation_read_engines(xx=tikv)
-	LeadingJoinOrder   []HintedTable  // leading
-	HJBuild            []HintedTable  // hash_join_build
-	HJProbe            []HintedTable  // hash_join_probe
+	IndexJoin          IndexJoinHints  // inlj_join, inlhj_join, inlmj_join
+	NoIndexJoin        IndexJoinHints  // no_inlj_join, no_inlhj_join, no_inlmj_join
+	HashJoin           []HintedTable   // hash_join
+	NoHashJoin         []HintedTable   // no_hash_join
+	SortMergeJoin      []HintedTable   // merge_join
+	NoMergeJoin        []HintedTable   // no_merge_join
+	BroadcastJoin      []HintedTable   // bcj_join
+	ShuffleJoin        []HintedTable   // shuffle_join
+	IndexHintList      []HintedIndex   // use_index, ignore_index
+	IndexMergeHintList []HintedIndex   // use_index_merge
+	TiFlashTables      []HintedTable   // isolation_read_engines(xx=tiflash)
+	TiKVTables         []HintedTable   // isolation_read_engines(xx=tikv)
+	LeadingJoinOrder   []HintedTable   // leading
+	HJBuild            []HintedTable   // hash_join_build
+	HJProbe            []HintedTable   // hash_join_probe
+	FullScanTables     []HintedTable   // full_scan
+	IndexOnlyTables    []HintedTable   // index_only
+	NoFullScanTables   []HintedTable   // no_full_scan
+	SampleTables       []SampledTable  // sample
+	INLJPrefetchTables []PrefetchTable // inl_join_prefetch
 
 	// Hints belows are not associated with any particular table.
 	PreferAggType    uint // hash_agg, merge_agg, agg_to_cop and so on
 	PreferAggToCop   bool
 	PreferLimitToCop bool // limit_to_cop
+	PreferFullText   uint // use_fulltext_index, no_fulltext_index
 	CTEMerge         bool // merge
 	TimeRangeHint    ast.HintTimeRange
+	MaxExecutionTime uint64 // max_execution_time in a subquery block
+
+	// MatchTracer records the decisions of matching the hinted tables with the tables of the query block,
+	// it's only set when `tidb_opt_trace_hints` is enabled.
+	MatchTracer func(decision string)
 }
 
 // HintedTable indicates which table this hint should take effect on.
@@ -486,15 +785,29 @@ type HintedTable struct {
 	DBName       model.CIStr   // the database name
 	TblName      model.CIStr   // the table name
 	Partitions   []model.CIStr // partition information
+	QBName       model.CIStr   // the query block name of the table specified in the hint, such as `t@sel_2`
 	SelectOffset int           // the select block offset of this hint
 	Matched      bool          // whether this hint is applied successfully
 }
 
+// SampledTable indicates which table the sample hint should take effect on.
+type SampledTable struct {
+	HintedTable
+	Percent uint64 // the percentage of the rows to sample
+}
+
+// PrefetchTable indicates which table the inl_join_prefetch hint should take effect on.
+type PrefetchTable struct {
+	HintedTable
+	Tasks uint64 // the number of lookup tasks to prefetch
+}
+
 // HintedIndex indicates which index this hint should take effect on.
 type HintedIndex struct {
 	DBName     model.CIStr    // the database name
 	TblName    model.CIStr    // the table name
 	Partitions []model.CIStr  // partition information
+	QBName     model.CIStr    // the query block name of the table specified in the hint
 	IndexHint  *ast.IndexHint // the original parser index hint structure
 	// Matched indicates whether this index hint
 	// has been successfully applied to a DataSource.
@@ -519,6 +832,12 @@ func (hint *HintedIndex) HintTypeString() string {
 		return "ignore_index"
 	case ast.HintForce:
 		return "force_index"
+	case ast.HintOrderIndex:
+		return "order_index"
+	case ast.HintNoOrderIndex:
+		return "no_order_index"
+	case ast.HintLooseIndexScan:
+		return "loose_index_scan"
 	}
 	return ""
 }
@@ -538,72 +857,118 @@ func (hint *HintedIndex) IndexString() string {
 
 // IfPreferMergeJoin checks whether the join hint is merge join.
 func (pHints *PlanHints) IfPreferMergeJoin(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.SortMergeJoin)
+	return pHints.matchTableName(HintSMJ, tableNames, pHints.SortMergeJoin)
 }
 
 // IfPreferBroadcastJoin checks whether the join hint is broadcast join.
 func (pHints *PlanHints) IfPreferBroadcastJoin(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.BroadcastJoin)
+	return pHints.matchTableName(HintBCJ, tableNames, pHints.BroadcastJoin)
 }
 
 // IfPreferShuffleJoin checks whether the join hint is shuffle join.
 func (pHints *PlanHints) IfPreferShuffleJoin(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.ShuffleJoin)
+	return pHints.matchTableName(HintShuffleJoin, tableNames, pHints.ShuffleJoin)
 }
 
 // IfPreferHashJoin checks whether the join hint is hash join.
 func (pHints *PlanHints) IfPreferHashJoin(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.HashJoin)
+	return pHints.matchTableName(HintHJ, tableNames, pHints.HashJoin)
 }
 
 // IfPreferNoHashJoin checks whether the join hint is no hash join.
 func (pHints *PlanHints) IfPreferNoHashJoin(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.NoHashJoin)
+	return pHints.matchTableName(HintNoHashJoin, tableNames, pHints.NoHashJoin)
 }
 
 // IfPreferNoMergeJoin checks whether the join hint is no merge join.
 func (pHints *PlanHints) IfPreferNoMergeJoin(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.NoMergeJoin)
+	return pHints.matchTableName(HintNoMergeJoin, tableNames, pHints.NoMergeJoin)
 }
 
 // IfPreferHJBuild checks whether the join hint is hash join build side.
 func (pHints *PlanHints) IfPreferHJBuild(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.HJBuild)
+	return pHints.matchTableName(HintHashJoinBuild, tableNames, pHints.HJBuild)
 }
 
 // IfPreferHJProbe checks whether the join hint is hash join probe side.
 func (pHints *PlanHints) IfPreferHJProbe(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.HJProbe)
+	return pHints.matchTableName(HintHashJoinProbe, tableNames, pHints.HJProbe)
 }
 
 // IfPreferINLJ checks whether the join hint is index nested loop join.
 func (pHints *PlanHints) IfPreferINLJ(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.IndexJoin.INLJTables)
+	return pHints.matchTableName(HintINLJ, tableNames, pHints.IndexJoin.INLJTables)
 }
 
 // IfPreferINLHJ checks whether the join hint is index nested loop hash join.
 func (pHints *PlanHints) IfPreferINLHJ(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.IndexJoin.INLHJTables)
+	return pHints.matchTableName(HintINLHJ, tableNames, pHints.IndexJoin.INLHJTables)
 }
 
 // IfPreferINLMJ checks whether the join hint is index nested loop merge join.
 func (pHints *PlanHints) IfPreferINLMJ(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.IndexJoin.INLMJTables)
+	return pHints.matchTableName(HintINLMJ, tableNames, pHints.IndexJoin.INLMJTables)
 }
 
 // IfPreferNoIndexJoin checks whether the join hint is no index join.
 func (pHints *PlanHints) IfPreferNoIndexJoin(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.NoIndexJoin.INLJTables)
+	return pHints.matchTableName(HintNoIndexJoin, tableNames, pHints.NoIndexJoin.INLJTables)
 }
 
 // IfPreferNoIndexHashJoin checks whether the join hint is no index hash join.
 func (pHints *PlanHints) IfPreferNoIndexHashJoin(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.NoIndexJoin.INLHJTables)
+	return pHints.matchTableName(HintNoIndexHashJoin, tableNames, pHints.NoIndexJoin.INLHJTables)
 }
 
 // IfPreferNoIndexMergeJoin checks whether the join hint is no index merge join.
 func (pHints *PlanHints) IfPreferNoIndexMergeJoin(tableNames ...*HintedTable) bool {
-	return pHints.MatchTableName(tableNames, pHints.NoIndexJoin.INLMJTables)
+	return pHints.matchTableName(HintNoIndexMergeJoin, tableNames, pHints.NoIndexJoin.INLMJTables)
+}
+
+// PreferScanType returns the scan type preferred by the full_scan, index_only and no_full_scan hints for the table.
+func (pHints *PlanHints) PreferScanType(tableName *HintedTable) (preferScanType uint) {
+	if tableName == nil {
+		return 0
+	}
+	tables := []*HintedTable{tableName}
+	if pHints.matchTableName(HintFullScan, tables, pHints.FullScanTables) {
+		preferScanType |= PreferFullScan
+	}
+	if pHints.matchTableName(HintIndexOnly, tables, pHints.IndexOnlyTables) {
+		preferScanType |= PreferIndexOnly
+	}
+	if pHints.matchTableName(HintNoFullScan, tables, pHints.NoFullScanTables) {
+		preferScanType |= PreferNoFullScan
+	}
+	return preferScanType
+}
+
+// IfSample checks whether the sample hint hit the table, and returns the matched hint if any.
+func (pHints *PlanHints) IfSample(tableName *HintedTable) *SampledTable {
+	if tableName == nil {
+		return nil
+	}
+	for i, tbl := range pHints.SampleTables {
+		if pHints.matchHintedTable(HintSample, &tbl.HintedTable, tableName, true) {
+			pHints.SampleTables[i].Matched = true
+			return &tbl
+		}
+	}
+	return nil
+}
+
+// IfINLJPrefetch checks whether the inl_join_prefetch hint hit the table, and returns the matched hint if any.
+func (pHints *PlanHints) IfINLJPrefetch(tableName *HintedTable) *PrefetchTable {
+	if tableName == nil {
+		return nil
+	}
+	for i, tbl := range pHints.INLJPrefetchTables {
+		if pHints.matchHintedTable(HintINLJPrefetch, &tbl.HintedTable, tableName, true) {
+			pHints.INLJPrefetchTables[i].Matched = true
+			return &tbl
+		}
+	}
+	return nil
 }
 
 // IfPreferTiFlash checks whether the hint hit the need of TiFlash.
@@ -616,12 +981,12 @@ func (pHints *PlanHints) IfPreferTiKV(tableName *HintedTable) *HintedTable {
 	return pHints.matchTiKVOrTiFlash(tableName, pHints.TiKVTables)
 }
 
-func (*PlanHints) matchTiKVOrTiFlash(tableName *HintedTable, hintTables []HintedTable) *HintedTable {
+func (pHints *PlanHints) matchTiKVOrTiFlash(tableName *HintedTable, hintTables []HintedTable) *HintedTable {
 	if tableName == nil {
 		return nil
 	}
 	for i, tbl := range hintTables {
-		if tableName.DBName.L == tbl.DBName.L && tableName.TblName.L == tbl.TblName.L && tbl.SelectOffset == tableName.SelectOffset {
+		if pHints.matchHintedTable(HintReadFromStorage, &tbl, tableName, false) {
 			hintTables[i].Matched = true
 			return &tbl
 		}
@@ -629,7 +994,12 @@ func (*PlanHints) matchTiKVOrTiFlash(tableName *HintedTable, hintTables []Hinted
 	return nil
 }
 
-// MatchTableName checks whether the hint hit the need.
+// IfPreferLeading checks whether the tables are specified by the leading hint.
+func (pHints *PlanHints) IfPreferLeading(tableNames ...*HintedTable) bool {
+	return pHints.matchTableName(HintLeading, tableNames, pHints.LeadingJoinOrder)
+}
+
+// matchTableName checks whether the hint hit the need.
 // Only need either side matches one on the list.
 // Even though you can put 2 tables on the list,
 // it doesn't mean optimizer will reorder to make them
@@ -637,16 +1007,14 @@ func (*PlanHints) matchTiKVOrTiFlash(tableName *HintedTable, hintTables []Hinted
 // Which it joins on with depend on sequence of traverse
 // and without reorder, user might adjust themselves.
 // This is similar to MySQL hints.
-func (*PlanHints) MatchTableName(tables []*HintedTable, hintTables []HintedTable) bool {
+func (pHints *PlanHints) matchTableName(hintName string, tables []*HintedTable, hintTables []HintedTable) bool {
 	hintMatched := false
 	for _, table := range tables {
-		for i, curEntry := range hintTables {
+		for i := range hintTables {
 			if table == nil {
 				continue
 			}
-			if (curEntry.DBName.L == table.DBName.L || curEntry.DBName.L == "*") &&
-				curEntry.TblName.L == table.TblName.L &&
-				table.SelectOffset == curEntry.SelectOffset {
+			if pHints.matchHintedTable(hintName, &hintTables[i], table, true) {
 				hintTables[i].Matched = true
 				hintMatched = true
 				break
@@ -656,11 +1024,35 @@ func (*PlanHints) MatchTableName(tables []*HintedTable, hintTables []HintedTable
 	return hintMatched
 }
 
+// matchHintedTable checks whether the hinted table refers to the table of the query block. If the MatchTracer is
+// set, the decision is recorded when the table names are the same, no matter whether they're matched or not.
+func (pHints *PlanHints) matchHintedTable(hintName string, hintTbl, table *HintedTable, allowAnyDB bool) bool {
+	if hintTbl.TblName.L != table.TblName.L {
+		return false
+	}
+	var skipReason string
+	if hintTbl.DBName.L != table.DBName.L && (!allowAnyDB || hintTbl.DBName.L != "*") {
+		skipReason = fmt.Sprintf("the database is %s", hintTbl.DBName.L)
+	} else if hintTbl.SelectOffset != table.SelectOffset {
+		skipReason = fmt.Sprintf("the hint is for the query block %d", hintTbl.SelectOffset)
+	}
+	if pHints != nil && pHints.MatchTracer != nil {
+		hint := fmt.Sprintf("%s(%s)", hintName, restore2TableHint(*hintTbl))
+		tbl := fmt.Sprintf("%s.%s in the query block %d", table.DBName.L, table.TblName.L, table.SelectOffset)
+		if skipReason == "" {
+			pHints.MatchTracer(fmt.Sprintf("%s matched %s", hint, tbl))
+		} else {
+			pHints.MatchTracer(fmt.Sprintf("%s skipped %s since %s", hint, tbl, skipReason))
+		}
+	}
+	return skipReason == ""
+}
+
 // ParsePlanHints parses *ast.TableOptimizerHint to PlanHints.
 func ParsePlanHints(hints []*ast.TableOptimizerHint,
 	currentLevel int, currentDB string,
 	hintProcessor *QBHintHandler, straightJoinOrder bool,
-	handlingExistsSubquery, notHandlingSubquery bool,
+	handlingExistsSubquery, handlingInSubquery, notHandlingSubquery bool,
 	warnHandler hintWarnHandler) (p *PlanHints, subQueryHintFlags uint64, err error) {
 	var (
 		sortMergeTables, inljTables, inlhjTables, inlmjTables, hashJoinTables, bcTables []HintedTable
@@ -673,9 +1065,14 @@ func ParsePlanHints(hints []*ast.TableOptimizerHint,
 		preferAggToCop                                                                  bool
 		timeRangeHint                                                                   ast.HintTimeRange
 		preferLimitToCop                                                                bool
+		preferFullText                                                                  uint
 		cteMerge                                                                        bool
 		leadingJoinOrder                                                                []HintedTable
 		hjBuildTables, hjProbeTables                                                    []HintedTable
+		fullScanTables, indexOnlyTables, noFullScanTables                               []HintedTable
+		sampleTables                                                                    []SampledTable
+		inljPrefetchTables                                                              []PrefetchTable
+		maxExecutionTime                                                                uint64
 		leadingHintCnt                                                                  int
 	)
 	for _, hint := range hints {
@@ -683,15 +1080,16 @@ func ParsePlanHints(hints []*ast.TableOptimizerHint,
 		switch hint.HintName.L {
 		case TiDBMergeJoin, HintSMJ, TiDBIndexNestedLoopJoin, HintINLJ, HintINLHJ, HintINLMJ,
 			HintNoHashJoin, HintNoMergeJoin, TiDBHashJoin, HintHJ, HintUseIndex, HintIgnoreIndex,
-			HintForceIndex, HintOrderIndex, HintNoOrderIndex, HintIndexMerge, HintLeading:
+			HintForceIndex, HintOrderIndex, HintNoOrderIndex, HintIndex, HintNoIndex, HintGroupIndex, HintNoGroupIndex,
+			HintJoinIndex, HintNoJoinIndex, HintLooseIndexScan, HintIndexMerge, HintLeading,
+			HintFullScan, HintIndexOnly, HintNoFullScan, HintSample, HintINLJPrefetch:
 			if len(hint.Tables) == 0 {
 				var sb strings.Builder
 				ctx := format.NewRestoreCtx(0, &sb)
 				if err := hint.Restore(ctx); err != nil {
 					return nil, 0, err
 				}
-				errMsg := fmt.Sprintf("Hint %s is inapplicable. Please specify the table names in the arguments.", sb.String())
-				warnHandler.SetHintWarning(errMsg)
+				warnHandler.SetHintWarningFromError(ErrWarnHintInapplicable.FastGen("Hint %s is inapplicable. Please specify the table names in the arguments.", sb.String()))
 				continue
 			}
 		}
@@ -729,38 +1127,88 @@ func ParsePlanHints(hints []*ast.TableOptimizerHint,
 			hjBuildTables = append(hjBuildTables, tableNames2HintTableInfo(currentDB, hint.HintName.L, hint.Tables, hintProcessor, currentLevel, warnHandler)...)
 		case HintHashJoinProbe:
 			hjProbeTables = append(hjProbeTables, tableNames2HintTableInfo(currentDB, hint.HintName.L, hint.Tables, hintProcessor, currentLevel, warnHandler)...)
+		case HintFullScan:
+			fullScanTables = append(fullScanTables, tableNames2HintTableInfo(currentDB, hint.HintName.L, hint.Tables, hintProcessor, currentLevel, warnHandler)...)
+		case HintIndexOnly:
+			indexOnlyTables = append(indexOnlyTables, tableNames2HintTableInfo(currentDB, hint.HintName.L, hint.Tables, hintProcessor, currentLevel, warnHandler)...)
+		case HintNoFullScan:
+			noFullScanTables = append(noFullScanTables, tableNames2HintTableInfo(currentDB, hint.HintName.L, hint.Tables, hintProcessor, currentLevel, warnHandler)...)
+		case HintSample:
+			percent := hint.HintData.(uint64)
+			if percent > 100 {
+				warnHandler.SetHintWarningFromError(ErrWarnHintInapplicable.FastGen("The percentage %d of the SAMPLE hint is out of range, it should be in [0, 100]", percent))
+				continue
+			}
+			for _, tbl := range tableNames2HintTableInfo(currentDB, hint.HintName.L, hint.Tables, hintProcessor, currentLevel, warnHandler) {
+				sampleTables = append(sampleTables, SampledTable{HintedTable: tbl, Percent: percent})
+			}
+		case HintINLJPrefetch:
+			tasks := hint.HintData.(uint64)
+			if tasks == 0 || tasks > maxINLJPrefetchTasks {
+				warnHandler.SetHintWarningFromError(ErrWarnHintInapplicable.FastGen("The number of tasks %d of the INL_JOIN_PREFETCH hint is out of range, it should be in [1, %d]", tasks, maxINLJPrefetchTasks))
+				continue
+			}
+			for _, tbl := range tableNames2HintTableInfo(currentDB, hint.HintName.L, hint.Tables, hintProcessor, currentLevel, warnHandler) {
+				inljPrefetchTables = append(inljPrefetchTables, PrefetchTable{HintedTable: tbl, Tasks: tasks})
+			}
 		case HintHashAgg:
 			preferAggType |= PreferHashAgg
 		case HintStreamAgg:
 			preferAggType |= PreferStreamAgg
 		case HintAggToCop:
 			preferAggToCop = true
-		case HintUseIndex, HintIgnoreIndex, HintForceIndex, HintOrderIndex, HintNoOrderIndex:
+		case HintDistinctAggPushDown:
+			preferAggType |= PreferDistinctAggPushDown
+		case HintNoDistinctAggPushDown:
+			preferAggType |= PreferNoDistinctAggPushDown
+		case HintApproxCountDistinct:
+			preferAggType |= PreferApproxCountDistinct
+		case HintNoExpand:
+			preferAggType |= PreferNoExpand
+		case HintUseIndex, HintIgnoreIndex, HintForceIndex, HintOrderIndex, HintNoOrderIndex,
+			HintIndex, HintNoIndex, HintGroupIndex, HintNoGroupIndex, HintJoinIndex, HintNoJoinIndex, HintLooseIndexScan:
 			dbName := hint.Tables[0].DBName
 			if dbName.L == "" {
 				dbName = model.NewCIStr(currentDB)
 			}
 			var hintType ast.IndexHintType
+			// Like the SQL index hints `FOR JOIN` and `FOR GROUP BY`, the hints without the scan scope are accepted but
+			// don't affect the access paths.
+			hintScope := ast.HintForScan
 			switch hint.HintName.L {
 			case HintUseIndex:
 				hintType = ast.HintUse
-			case HintIgnoreIndex:
+			case HintIgnoreIndex, HintNoIndex:
 				hintType = ast.HintIgnore
-			case HintForceIndex:
+			case HintForceIndex, HintIndex:
 				hintType = ast.HintForce
 			case HintOrderIndex:
 				hintType = ast.HintOrderIndex
 			case HintNoOrderIndex:
 				hintType = ast.HintNoOrderIndex
+			case HintLooseIndexScan:
+				hintType = ast.HintLooseIndexScan
+			case HintGroupIndex:
+				hintType, hintScope = ast.HintForce, ast.HintForGroupBy
+			case HintNoGroupIndex:
+				hintType, hintScope = ast.HintIgnore, ast.HintForGroupBy
+			case HintJoinIndex:
+				hintType, hintScope = ast.HintForce, ast.HintForJoin
+			case HintNoJoinIndex:
+				hintType, hintScope = ast.HintIgnore, ast.HintForJoin
+			}
+			if hintScope != ast.HintForScan {
+				warnHandler.SetHintWarningFromError(ErrWarnHintInapplicable.FastGen("%s() is accepted for compatibility with MySQL but doesn't affect the plan", strings.ToUpper(hint.HintName.L)))
 			}
 			indexHintList = append(indexHintList, HintedIndex{
 				DBName:     dbName,
 				TblName:    hint.Tables[0].TableName,
 				Partitions: hint.Tables[0].PartitionList,
+				QBName:     hint.Tables[0].QBName,
 				IndexHint: &ast.IndexHint{
 					IndexNames: hint.Indexes,
 					HintType:   hintType,
-					HintScope:  ast.HintForScan,
+					HintScope:  hintScope,
 				},
 			})
 		case HintReadFromStorage:
@@ -779,6 +1227,7 @@ func ParsePlanHints(hints []*ast.TableOptimizerHint,
 				DBName:     dbName,
 				TblName:    hint.Tables[0].TableName,
 				Partitions: hint.Tables[0].PartitionList,
+				QBName:     hint.Tables[0].QBName,
 				IndexHint: &ast.IndexHint{
 					IndexNames: hint.Indexes,
 					HintType:   ast.HintUse,
@@ -789,9 +1238,20 @@ func ParsePlanHints(hints []*ast.TableOptimizerHint,
 			timeRangeHint = hint.HintData.(ast.HintTimeRange)
 		case HintLimitToCop:
 			preferLimitToCop = true
+		case HintUseFullTextIndex:
+			preferFullText |= PreferFullTextIndex
+		case HintNoFullTextIndex:
+			preferFullText |= PreferNoFullTextIndex
+		case HintMaxExecutionTime:
+			// The max_execution_time hint in the top query block limits the whole statement, which is handled by
+			// ParseStmtHints, while the one written in a subquery block only limits the subquery.
+			if notHandlingSubquery || hint.QBName.L != "" {
+				continue
+			}
+			maxExecutionTime = hint.HintData.(uint64)
 		case HintMerge:
 			if hint.Tables != nil {
-				warnHandler.SetHintWarning("The MERGE hint is not used correctly, maybe it inputs a table name.")
+				warnHandler.SetHintWarningFromError(ErrWarnHintInapplicable.FastGen("The MERGE hint is not used correctly, maybe it inputs a table name."))
 				continue
 			}
 			cteMerge = true
@@ -802,27 +1262,45 @@ func ParsePlanHints(hints []*ast.TableOptimizerHint,
 			leadingHintCnt++
 		case HintSemiJoinRewrite:
 			if !handlingExistsSubquery {
-				warnHandler.SetHintWarning("The SEMI_JOIN_REWRITE hint is not used correctly, maybe it's not in a subquery or the subquery is not EXISTS clause.")
+				warnHandler.SetHintWarningFromError(ErrWarnHintInapplicable.FastGen("The SEMI_JOIN_REWRITE hint is not used correctly, maybe it's not in a subquery or the subquery is not EXISTS clause."))
 				continue
 			}
 			subQueryHintFlags |= HintFlagSemiJoinRewrite
 		case HintNoDecorrelate:
 			if notHandlingSubquery {
-				warnHandler.SetHintWarning("NO_DECORRELATE() is inapplicable because it's not in an IN subquery, an EXISTS subquery, an ANY/ALL/SOME subquery or a scalar subquery.")
+				warnHandler.SetHintWarningFromError(ErrWarnHintInapplicable.FastGen("NO_DECORRELATE() is inapplicable because it's not in an IN subquery, an EXISTS subquery, an ANY/ALL/SOME subquery or a scalar subquery."))
 				continue
 			}
 			subQueryHintFlags |= HintFlagNoDecorrelate
+		case HintMaterializeSubquery, HintNoMaterializeSubquery:
+			if !handlingExistsSubquery && !handlingInSubquery {
+				warnHandler.SetHintWarningFromError(ErrWarnHintInapplicable.FastGen("%s() is inapplicable because it's not in an IN subquery or an EXISTS subquery.", strings.ToUpper(hint.HintName.L)))
+				continue
+			}
+			if hint.HintName.L == HintMaterializeSubquery {
+				subQueryHintFlags |= HintFlagMaterializeSubquery
+			} else {
+				subQueryHintFlags |= HintFlagNoMaterializeSubquery
+			}
 		default:
 			// ignore hints that not implemented
 		}
 	}
+	if preferAggType&PreferDistinctAggPushDown > 0 && preferAggType&PreferNoDistinctAggPushDown > 0 {
+		warnHandler.SetHintWarningFromError(ErrWarnConflictingHint.FastGen("DISTINCT_AGG_PUSH_DOWN and NO_DISTINCT_AGG_PUSH_DOWN hints are conflicted, both of them will be ignored"))
+		preferAggType &^= PreferDistinctAggPushDown | PreferNoDistinctAggPushDown
+	}
+	if preferFullText&PreferFullTextIndex > 0 && preferFullText&PreferNoFullTextIndex > 0 {
+		warnHandler.SetHintWarningFromError(ErrWarnConflictingHint.FastGen("USE_FULLTEXT_INDEX and NO_FULLTEXT_INDEX hints are conflicted, both of them will be ignored"))
+		preferFullText = 0
+	}
 	if leadingHintCnt > 1 || (leadingHintCnt > 0 && straightJoinOrder) {
 		// If there are more leading hints or the straight_join hint existes, all leading hints will be invalid.
 		leadingJoinOrder = leadingJoinOrder[:0]
 		if leadingHintCnt > 1 {
-			warnHandler.SetHintWarning("We can only use one leading hint at most, when multiple leading hints are used, all leading hints will be invalid")
+			warnHandler.SetHintWarningFromError(ErrWarnConflictingHint.FastGen("We can only use one leading hint at most, when multiple leading hints are used, all leading hints will be invalid"))
 		} else if straightJoinOrder {
-			warnHandler.SetHintWarning("We can only use the straight_join hint, when we use the leading hint and straight_join hint at the same time, all leading hints will be invalid")
+			warnHandler.SetHintWarningFromError(ErrWarnConflictingHint.FastGen("We can only use the straight_join hint, when we use the leading hint and straight_join hint at the same time, all leading hints will be invalid"))
 		}
 	}
 	return &PlanHints{
@@ -841,11 +1319,18 @@ func ParsePlanHints(hints []*ast.TableOptimizerHint,
 		PreferAggType:      preferAggType,
 		IndexMergeHintList: indexMergeHintList,
 		TimeRangeHint:      timeRangeHint,
+		MaxExecutionTime:   maxExecutionTime,
 		PreferLimitToCop:   preferLimitToCop,
+		PreferFullText:     preferFullText,
 		CTEMerge:           cteMerge,
 		LeadingJoinOrder:   leadingJoinOrder,
 		HJBuild:            hjBuildTables,
 		HJProbe:            hjProbeTables,
+		FullScanTables:     fullScanTables,
+		IndexOnlyTables:    indexOnlyTables,
+		NoFullScanTables:   noFullScanTables,
+		SampleTables:       sampleTables,
+		INLJPrefetchTables: inljPrefetchTables,
 	}, subQueryHintFlags, nil
 }
 
@@ -881,6 +1366,7 @@ func tableNames2HintTableInfo(currentDB, hintName string, hintTables []ast.HintT
 			DBName:       hintTable.DBName,
 			TblName:      hintTable.TableName,
 			Partitions:   hintTable.PartitionList,
+			QBName:       hintTable.QBName,
 			SelectOffset: p.GetHintOffset(hintTable.QBName, currentOffset),
 		}
 		if tableInfo.DBName.L == "" {
@@ -896,7 +1382,7 @@ func tableNames2HintTableInfo(currentDB, hintName string, hintTables []ast.HintT
 		hintTableInfos = append(hintTableInfos, tableInfo)
 	}
 	if isInapplicable {
-		warnHandler.SetHintWarningFromError(fmt.Errorf("Optimizer Hint %s is inapplicable on specified partitions", Restore2JoinHint(hintName, hintTableInfos)))
+		warnHandler.SetHintWarningFromError(ErrWarnHintInapplicablePartition.FastGenByArgs(Restore2JoinHint(hintName, hintTableInfos)))
 		return nil
 	}
 	return hintTableInfos
@@ -906,6 +1392,12 @@ func restore2TableHint(hintTables ...HintedTable) string {
 	buffer := bytes.NewBufferString("")
 	for i, table := range hintTables {
 		buffer.WriteString(table.TblName.L)
+		// Keep the query block name, so the restored hint still refers to the same table when it's put into
+		// another query block, e.g. when it's captured into a binding.
+		if table.QBName.L != "" {
+			buffer.WriteString("@")
+			buffer.WriteString(table.QBName.L)
+		}
 		if len(table.Partitions) > 0 {
 			buffer.WriteString(" PARTITION(")
 			for j, partition := range table.Partitions {
@@ -945,6 +1437,7 @@ func Restore2IndexHint(hintType string, hintIndex HintedIndex) string {
 		DBName:     hintIndex.DBName,
 		TblName:    hintIndex.TblName,
 		Partitions: hintIndex.Partitions,
+		QBName:     hintIndex.QBName,
 	}))
 	if hintIndex.IndexHint != nil && len(hintIndex.IndexHint.IndexNames) > 0 {
 		for i, indexName := range hintIndex.IndexHint.IndexNames {
@@ -963,6 +1456,13 @@ func Restore2StorageHint(tiflashTables, tikvTables []HintedTable) string {
 	buffer := bytes.NewBufferString("/*+ ")
 	buffer.WriteString(strings.ToUpper(HintReadFromStorage))
 	buffer.WriteString("(")
+	buffer.WriteString(restore2StorageTables(tiflashTables, tikvTables))
+	buffer.WriteString(") */")
+	return buffer.String()
+}
+
+func restore2StorageTables(tiflashTables, tikvTables []HintedTable) string {
+	buffer := bytes.NewBufferString("")
 	if len(tiflashTables) > 0 {
 		buffer.WriteString("tiflash[")
 		buffer.WriteString(restore2TableHint(tiflashTables...))
@@ -976,7 +1476,6 @@ func Restore2StorageHint(tiflashTables, tikvTables []HintedTable) string {
 		buffer.WriteString(restore2TableHint(tikvTables...))
 		buffer.WriteString("]")
 	}
-	buffer.WriteString(") */")
 	return buffer.String()
 }
 
@@ -991,72 +1490,233 @@ func ExtractUnmatchedTables(hintTables []HintedTable) []string {
 	return tableNames
 }
 
+// HintUsage records whether a hint supplied in a query block has been applied.
+type HintUsage struct {
+	// Hint is the restored hint, such as `hash_join(t1, t2)`.
+	Hint string
+	// Matched indicates whether the tables of the hint are found in the query block.
+	Matched bool
+	// Warning is the warning for the unmatched hint, it's nil if the hint is matched.
+	Warning error
+	// Name is the name of the hint, such as `hash_join`.
+	Name string
+	// Tables and Index are what the hint refers to, they're used to check whether the hint takes effect in the
+	// final plan, since a matched hint may still be ignored by the optimizer.
+	Tables []HintedTable
+	Index  *HintedIndex
+}
+
+// WarningScope is the partition or the query block of a table which a hint warning is set for. The warnings with
+// the same code of the same hint on the same table only differ by their scopes, so they're aggregated into one
+// warning with the number of the scopes, see StatementContext.SetHintWarningForScope.
+type WarningScope struct {
+	// Hint is the name of the hint, such as `use_index`.
+	Hint string
+	// Table is the name of the table which the hint is specified for, it's empty if the hint has no table.
+	Table string
+	// Kind is the kind of the scope, such as WarningScopePartition.
+	Kind string
+	// Name is the name of the partition or the query block.
+	Name string
+}
+
+const (
+	// WarningScopePartition indicates that the hint warning is set for a partition of the table.
+	WarningScopePartition = "partitions"
+	// WarningScopeQueryBlock indicates that the hint warning is set for a query block.
+	WarningScopeQueryBlock = "query blocks"
+)
+
 // CollectUnmatchedHintWarnings collects warnings for unmatched hints from this TableHintInfo.
-func CollectUnmatchedHintWarnings(hintInfo *PlanHints) (warnings []string) {
-	warnings = append(warnings, collectUnmatchedIndexHintWarning(hintInfo.IndexHintList, false)...)
-	warnings = append(warnings, collectUnmatchedIndexHintWarning(hintInfo.IndexMergeHintList, true)...)
-	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintINLJ, TiDBIndexNestedLoopJoin, hintInfo.IndexJoin.INLJTables)...)
-	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintINLHJ, "", hintInfo.IndexJoin.INLHJTables)...)
-	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintINLMJ, "", hintInfo.IndexJoin.INLMJTables)...)
-	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintSMJ, TiDBMergeJoin, hintInfo.SortMergeJoin)...)
-	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintBCJ, TiDBBroadCastJoin, hintInfo.BroadcastJoin)...)
-	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintShuffleJoin, HintShuffleJoin, hintInfo.ShuffleJoin)...)
-	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintHJ, TiDBHashJoin, hintInfo.HashJoin)...)
-	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintHashJoinBuild, "", hintInfo.HJBuild)...)
-	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintHashJoinProbe, "", hintInfo.HJProbe)...)
-	warnings = append(warnings, collectUnmatchedJoinHintWarning(HintLeading, "", hintInfo.LeadingJoinOrder)...)
-	warnings = append(warnings, collectUnmatchedStorageHintWarning(hintInfo.TiFlashTables, hintInfo.TiKVTables)...)
+func CollectUnmatchedHintWarnings(hintInfo *PlanHints) (warnings []error) {
+	for _, usage := range CollectHintUsages(hintInfo) {
+		if !usage.Matched {
+			warnings = append(warnings, usage.Warning)
+		}
+	}
 	return warnings
 }
 
-func collectUnmatchedIndexHintWarning(indexHints []HintedIndex, usedForIndexMerge bool) (warnings []string) {
-	for _, hint := range indexHints {
+// CollectHintUsages collects the usages of both the matched and unmatched hints from this TableHintInfo.
+func CollectHintUsages(hintInfo *PlanHints) (usages []HintUsage) {
+	usages = append(usages, collectIndexHintUsages(hintInfo.IndexHintList, false)...)
+	usages = append(usages, collectIndexHintUsages(hintInfo.IndexMergeHintList, true)...)
+	usages = append(usages, collectJoinHintUsages(HintINLJ, TiDBIndexNestedLoopJoin, hintInfo.IndexJoin.INLJTables)...)
+	usages = append(usages, collectJoinHintUsages(HintINLHJ, "", hintInfo.IndexJoin.INLHJTables)...)
+	usages = append(usages, collectJoinHintUsages(HintINLMJ, "", hintInfo.IndexJoin.INLMJTables)...)
+	usages = append(usages, collectJoinHintUsages(HintSMJ, TiDBMergeJoin, hintInfo.SortMergeJoin)...)
+	usages = append(usages, collectJoinHintUsages(HintBCJ, TiDBBroadCastJoin, hintInfo.BroadcastJoin)...)
+	usages = append(usages, collectJoinHintUsages(HintShuffleJoin, HintShuffleJoin, hintInfo.ShuffleJoin)...)
+	usages = append(usages, collectJoinHintUsages(HintHJ, TiDBHashJoin, hintInfo.HashJoin)...)
+	usages = append(usages, collectJoinHintUsages(HintHashJoinBuild, "", hintInfo.HJBuild)...)
+	usages = append(usages, collectJoinHintUsages(HintHashJoinProbe, "", hintInfo.HJProbe)...)
+	usages = append(usages, collectJoinHintUsages(HintLeading, "", hintInfo.LeadingJoinOrder)...)
+	usages = append(usages, collectStorageHintUsages(hintInfo.TiFlashTables, hintInfo.TiKVTables)...)
+	usages = append(usages, collectJoinHintUsages(HintFullScan, "", hintInfo.FullScanTables)...)
+	usages = append(usages, collectJoinHintUsages(HintIndexOnly, "", hintInfo.IndexOnlyTables)...)
+	usages = append(usages, collectJoinHintUsages(HintNoFullScan, "", hintInfo.NoFullScanTables)...)
+	usages = append(usages, collectSampleHintUsages(hintInfo.SampleTables)...)
+	usages = append(usages, collectINLJPrefetchHintUsages(hintInfo.INLJPrefetchTables)...)
+	return usages
+}
+
+// stmtHintsOutOfStmtHints are the statement-level hints which are not parsed into StmtHints, they take effect
+// outside the planner, such as in the plan cache or the executors.
+var stmtHintsOutOfStmtHints = map[string]struct{}{
+	HintIgnorePlanCache: {},
+	HintBatchDML:        {},
+	HintTimeRange:       {},
+}
+
+// stmtHintNames are the names of the hints that take effect on the whole statement, see ParseStmtHints. They're
+// derived from the statement-level hints in the hint catalog.
+var stmtHintNames = func() map[string]struct{} {
+	names := make(map[string]struct{})
+	for _, def := range supportedHints {
+		if _, ok := stmtHintsOutOfStmtHints[def.Name]; def.Scope == ScopeStatement && !ok {
+			names[def.Name] = struct{}{}
+		}
+	}
+	return names
+}()
+
+// CollectStmtHintUsages collects the usages of the statement-level hints like `max_execution_time`, the hints
+// which are invalid or overridden by the others are unmatched.
+func CollectStmtHintUsages(stmtHints *StmtHints,
+	setVarHintChecker func(varName, hint string) (ok bool, warning error)) (usages []HintUsage) {
+	hints := stmtHints.OriginalTableHints
+	_, offs, _ := ParseStmtHints(hints, setVarHintChecker, 0, 0)
+	lastNthPlan := -1
+	for i, hint := range hints {
+		if hint.HintName.L == "nth_plan" {
+			lastNthPlan = i
+		}
+	}
+	for i, hint := range hints {
+		if _, ok := stmtHintNames[hint.HintName.L]; !ok {
+			continue
+		}
+		matched := slices.Contains(offs, i)
+		if hint.HintName.L == "nth_plan" {
+			// NTH_PLAN is not recorded in the offsets, the last one takes effect if it's valid.
+			matched = i == lastNthPlan && stmtHints.ForceNthPlan > 0
+		}
+		usages = append(usages, HintUsage{
+			Hint:    RestoreTableOptimizerHint(hint),
+			Matched: matched,
+			Name:    hint.HintName.L,
+		})
+	}
+	return usages
+}
+
+func collectIndexHintUsages(indexHints []HintedIndex, usedForIndexMerge bool) (usages []HintUsage) {
+	for i, hint := range indexHints {
+		var hintTypeString string
+		if usedForIndexMerge {
+			hintTypeString = "use_index_merge"
+		} else {
+			hintTypeString = hint.HintTypeString()
+		}
+		usage := HintUsage{
+			Hint:    fmt.Sprintf("%s(%s)", hintTypeString, hint.IndexString()),
+			Matched: hint.Matched,
+			Name:    hintTypeString,
+			Index:   &indexHints[i],
+		}
 		if !hint.Matched {
-			var hintTypeString string
-			if usedForIndexMerge {
-				hintTypeString = "use_index_merge"
-			} else {
-				hintTypeString = hint.HintTypeString()
-			}
-			errMsg := fmt.Sprintf("%s(%s) is inapplicable, check whether the table(%s.%s) exists",
-				hintTypeString,
-				hint.IndexString(),
+			usage.Warning = ErrWarnHintInapplicableIndex.FastGenByArgs(
+				fmt.Sprintf("%s(%s)", hintTypeString, hint.IndexString()),
 				hint.DBName,
 				hint.TblName,
 			)
-			warnings = append(warnings, errMsg)
 		}
+		usages = append(usages, usage)
 	}
-	return warnings
+	return usages
 }
 
-func collectUnmatchedJoinHintWarning(joinType string, joinTypeAlias string, hintTables []HintedTable) (warnings []string) {
-	unMatchedTables := ExtractUnmatchedTables(hintTables)
-	if len(unMatchedTables) == 0 {
+func collectJoinHintUsages(joinType string, joinTypeAlias string, hintTables []HintedTable) (usages []HintUsage) {
+	if len(hintTables) == 0 {
 		return
 	}
-	if len(joinTypeAlias) != 0 {
-		joinTypeAlias = fmt.Sprintf(" or %s", Restore2JoinHint(joinTypeAlias, hintTables))
+	usage := HintUsage{
+		Hint:    fmt.Sprintf("%s(%s)", joinType, restore2TableHint(hintTables...)),
+		Matched: true,
+		Name:    joinType,
+		Tables:  hintTables,
 	}
+	if unMatchedTables := ExtractUnmatchedTables(hintTables); len(unMatchedTables) > 0 {
+		if len(joinTypeAlias) != 0 {
+			joinTypeAlias = fmt.Sprintf(" or %s", Restore2JoinHint(joinTypeAlias, hintTables))
+		}
+		usage.Matched = false
+		usage.Warning = ErrWarnHintUnmatchedTable.FastGenByArgs(
+			strings.Join(unMatchedTables, ", "), Restore2JoinHint(joinType, hintTables)+joinTypeAlias)
+	}
+	return append(usages, usage)
+}
 
-	errMsg := fmt.Sprintf("There are no matching table names for (%s) in optimizer hint %s%s. Maybe you can use the table alias name",
-		strings.Join(unMatchedTables, ", "), Restore2JoinHint(joinType, hintTables), joinTypeAlias)
-	warnings = append(warnings, errMsg)
-	return warnings
+func collectSampleHintUsages(sampleTables []SampledTable) (usages []HintUsage) {
+	for _, tbl := range sampleTables {
+		usage := HintUsage{
+			Hint:    fmt.Sprintf("%s(%s, %d PERCENT)", HintSample, restore2TableHint(tbl.HintedTable), tbl.Percent),
+			Matched: tbl.Matched,
+		}
+		if !tbl.Matched {
+			usage.Warning = ErrWarnHintUnmatchedTable.FastGenByArgs(tbl.TblName.O,
+				fmt.Sprintf("/*+ %s(%s, %d PERCENT) */", strings.ToUpper(HintSample), restore2TableHint(tbl.HintedTable), tbl.Percent))
+		}
+		usages = append(usages, usage)
+	}
+	return usages
 }
 
-func collectUnmatchedStorageHintWarning(tiflashTables, tikvTables []HintedTable) (warnings []string) {
+func collectINLJPrefetchHintUsages(prefetchTables []PrefetchTable) (usages []HintUsage) {
+	for _, tbl := range prefetchTables {
+		usage := HintUsage{
+			Hint:    fmt.Sprintf("%s(%s, %d)", HintINLJPrefetch, restore2TableHint(tbl.HintedTable), tbl.Tasks),
+			Matched: tbl.Matched,
+		}
+		if !tbl.Matched {
+			usage.Warning = ErrWarnHintUnmatchedTable.FastGenByArgs(tbl.TblName.O,
+				fmt.Sprintf("/*+ %s(%s, %d) */", strings.ToUpper(HintINLJPrefetch), restore2TableHint(tbl.HintedTable), tbl.Tasks))
+		}
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+func collectStorageHintUsages(tiflashTables, tikvTables []HintedTable) (usages []HintUsage) {
+	if len(tiflashTables)+len(tikvTables) == 0 {
+		return
+	}
+	usage := HintUsage{
+		Hint:    fmt.Sprintf("%s(%s)", HintReadFromStorage, restore2StorageTables(tiflashTables, tikvTables)),
+		Matched: true,
+	}
 	unMatchedTiFlashTables := ExtractUnmatchedTables(tiflashTables)
 	unMatchedTiKVTables := ExtractUnmatchedTables(tikvTables)
-	if len(unMatchedTiFlashTables)+len(unMatchedTiKVTables) == 0 {
-		return
+	if len(unMatchedTiFlashTables)+len(unMatchedTiKVTables) > 0 {
+		usage.Matched = false
+		usage.Warning = ErrWarnHintUnmatchedTable.FastGenByArgs(
+			strings.Join(append(unMatchedTiFlashTables, unMatchedTiKVTables...), ", "),
+			Restore2StorageHint(tiflashTables, tikvTables))
 	}
-	errMsg := fmt.Sprintf("There are no matching table names for (%s) in optimizer hint %s. Maybe you can use the table alias name",
-		strings.Join(append(unMatchedTiFlashTables, unMatchedTiKVTables...), ", "),
-		Restore2StorageHint(tiflashTables, tikvTables))
-	warnings = append(warnings, errMsg)
-	return warnings
+	return append(usages, usage)
 }
 
-// ErrWarnConflictingHint is a warning error.
-var ErrWarnConflictingHint = dbterror.ClassOptimizer.NewStd(mysql.ErrWarnConflictingHint)
+var (
+	// ErrWarnConflictingHint is a warning error.
+	ErrWarnConflictingHint = dbterror.ClassOptimizer.NewStd(mysql.ErrWarnConflictingHint)
+	// ErrWarnHintUnmatchedTable is the warning for the hint whose tables are not found in the query block.
+	ErrWarnHintUnmatchedTable = dbterror.ClassOptimizer.NewStd(mysql.ErrWarnHintUnmatchedTable)
+	// ErrWarnHintInapplicableIndex is the warning for the index hint whose table is not found in the query block.
+	ErrWarnHintInapplicableIndex = dbterror.ClassOptimizer.NewStd(mysql.ErrWarnHintInapplicableIndex)
+	// ErrWarnHintInapplicablePartition is the warning for the hint which can't be applied on the specified partitions.
+	ErrWarnHintInapplicablePartition = dbterror.ClassOptimizer.NewStd(mysql.ErrWarnHintInapplicablePartition)
+	// ErrWarnHintUnknownQueryBlock is the warning for the hint which refers to an unknown query block.
+	ErrWarnHintUnknownQueryBlock = dbterror.ClassOptimizer.NewStd(mysql.ErrWarnHintUnknownQueryBlock)
+	// ErrWarnHintInapplicable is the warning for the hint which is used incorrectly or can't be applied.
+	ErrWarnHintInapplicable = dbterror.ClassOptimizer.NewStd(mysql.ErrWarnHintInapplicable)
+)
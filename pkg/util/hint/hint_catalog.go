@@ -0,0 +1,186 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hint
+
+import (
+	"slices"
+	"strings"
+)
+
+// Scope is the scope which a hint takes effect on.
+type Scope string
+
+const (
+	// ScopeStatement means the hint takes effect on the whole statement.
+	ScopeStatement Scope = "STATEMENT"
+	// ScopeQueryBlock means the hint takes effect on a query block.
+	ScopeQueryBlock Scope = "QUERY BLOCK"
+	// ScopeTable means the hint takes effect on some tables of a query block.
+	ScopeTable Scope = "TABLE"
+	// ScopeIndex means the hint takes effect on some indexes of a table.
+	ScopeIndex Scope = "INDEX"
+)
+
+const (
+	argsNone       = "[@query_block_name]"
+	argsTables     = "[@query_block_name] tbl_name [, tbl_name ...]"
+	argsIndexes    = "[@query_block_name] tbl_name [index_name [, index_name ...]]"
+	argsBoolean    = "[@query_block_name] TRUE | FALSE"
+	argsIdentifier = "name"
+)
+
+// Definition describes a hint supported by TiDB.
+type Definition struct {
+	// Name is the name of the hint in lower case.
+	Name string
+	// Arguments is the syntax of the arguments of the hint.
+	Arguments string
+	// Scope is the scope which the hint takes effect on.
+	Scope Scope
+	// AllowedInBinding indicates whether the hint takes effect when it's used in a SQL binding.
+	AllowedInBinding bool
+	// Description describes what the hint does.
+	Description string
+}
+
+// supportedHints lists all the hints which take effect in TiDB. The hints which can be parsed but are ignored by TiDB
+// are listed in ignoredHints instead.
+var supportedHints = []Definition{
+	// join hints
+	{HintSMJ, argsTables, ScopeTable, true, "Use the sort merge join for the specified tables"},
+	{TiDBMergeJoin, argsTables, ScopeTable, true, "Alias of MERGE_JOIN"},
+	{HintNoMergeJoin, argsTables, ScopeTable, true, "Do not use the sort merge join for the specified tables"},
+	{HintBCJ, argsTables, ScopeTable, true, "Use the broadcast join for the specified tables in MPP mode"},
+	{HintShuffleJoin, argsTables, ScopeTable, true, "Use the shuffle join for the specified tables in MPP mode"},
+	{HintINLJ, argsTables, ScopeTable, true, "Use the index nested loop join with the specified tables as the inner side"},
+	{TiDBIndexNestedLoopJoin, argsTables, ScopeTable, true, "Alias of INL_JOIN"},
+	{HintINLHJ, argsTables, ScopeTable, true, "Use the index nested loop hash join with the specified tables as the inner side"},
+	{HintINLMJ, argsTables, ScopeTable, true, "Use the index nested loop merge join with the specified tables as the inner side"},
+	{HintINLJPrefetch, "[@query_block_name] tbl_name, n", ScopeTable, true, "Prefetch n batches of the lookups ahead when the table is the inner side of the index nested loop join"},
+	{HintNoIndexJoin, argsTables, ScopeTable, true, "Do not use the index nested loop join for the specified tables"},
+	{HintNoIndexHashJoin, argsTables, ScopeTable, true, "Do not use the index nested loop hash join for the specified tables"},
+	{HintNoIndexMergeJoin, argsTables, ScopeTable, true, "Do not use the index nested loop merge join for the specified tables"},
+	{HintHJ, argsTables, ScopeTable, true, "Use the hash join for the specified tables"},
+	{TiDBHashJoin, argsTables, ScopeTable, true, "Alias of HASH_JOIN"},
+	{HintNoHashJoin, argsTables, ScopeTable, true, "Do not use the hash join for the specified tables"},
+	{HintHashJoinBuild, argsTables, ScopeTable, true, "Use the specified tables as the build side of the hash join"},
+	{HintHashJoinProbe, argsTables, ScopeTable, true, "Use the specified tables as the probe side of the hash join"},
+	{HintLeading, argsTables, ScopeTable, true, "Join the specified tables first in the given order"},
+	{HintStraightJoin, argsNone, ScopeStatement, true, "Join the tables in the order in which they appear in the FROM clause"},
+
+	// aggregation and push down hints
+	{HintHashAgg, argsNone, ScopeQueryBlock, true, "Use the hash aggregation"},
+	{HintStreamAgg, argsNone, ScopeQueryBlock, true, "Use the stream aggregation"},
+	{HintMPP1PhaseAgg, argsNone, ScopeQueryBlock, true, "Use the one phase aggregation in MPP mode"},
+	{HintMPP2PhaseAgg, argsNone, ScopeQueryBlock, true, "Use the two phase aggregation in MPP mode"},
+	{HintAggToCop, argsNone, ScopeQueryBlock, true, "Push the aggregation down to the coprocessor"},
+	{HintDistinctAggPushDown, argsNone, ScopeQueryBlock, true, "Allow the aggregation with DISTINCT to be pushed down to the coprocessor"},
+	{HintNoDistinctAggPushDown, argsNone, ScopeQueryBlock, true, "Do not push the aggregation with DISTINCT down to the coprocessor"},
+	{HintApproxCountDistinct, argsNone, ScopeQueryBlock, true, "Rewrite COUNT(DISTINCT) to APPROX_COUNT_DISTINCT, which estimates the result by HyperLogLog"},
+	{HintNoExpand, argsNone, ScopeQueryBlock, true, "Do not expand the rows by Expand to compute the multiple COUNT(DISTINCT) in 3 stages"},
+	{HintLimitToCop, argsNone, ScopeQueryBlock, true, "Push the Limit or TopN down to the coprocessor"},
+
+	// access path hints
+	{HintUseIndex, argsIndexes, ScopeIndex, true, "Only consider the specified indexes to access the table"},
+	{HintIgnoreIndex, argsIndexes, ScopeIndex, true, "Do not use the specified indexes to access the table"},
+	{HintForceIndex, argsIndexes, ScopeIndex, true, "Use the specified indexes even if a table scan is estimated to be cheaper"},
+	{HintOrderIndex, argsIndexes, ScopeIndex, true, "Use the specified index and keep its order"},
+	{HintNoOrderIndex, argsIndexes, ScopeIndex, true, "Use the specified index without keeping its order"},
+	{HintLooseIndexScan, argsIndexes, ScopeIndex, true, "Use the specified index and skip between the groups of GROUP BY on its prefix"},
+	{HintIndex, argsIndexes, ScopeIndex, true, "Alias of FORCE_INDEX"},
+	{HintNoIndex, argsIndexes, ScopeIndex, true, "Alias of IGNORE_INDEX"},
+	{HintGroupIndex, argsIndexes, ScopeIndex, true, "Like FORCE INDEX FOR GROUP BY, it's accepted for compatibility with MySQL but doesn't affect the plan"},
+	{HintNoGroupIndex, argsIndexes, ScopeIndex, true, "Like IGNORE INDEX FOR GROUP BY, it's accepted for compatibility with MySQL but doesn't affect the plan"},
+	{HintJoinIndex, argsIndexes, ScopeIndex, true, "Like FORCE INDEX FOR JOIN, it's accepted for compatibility with MySQL but doesn't affect the plan"},
+	{HintNoJoinIndex, argsIndexes, ScopeIndex, true, "Like IGNORE INDEX FOR JOIN, it's accepted for compatibility with MySQL but doesn't affect the plan"},
+	{HintIndexMerge, argsIndexes, ScopeIndex, true, "Access the table by the index merge of the specified indexes"},
+	{HintNoIndexMerge, argsNone, ScopeStatement, true, "Do not use the index merge"},
+	{HintUseInvisibleIndexes, argsNone, ScopeStatement, true, "Consider the invisible indexes"},
+	{HintFullScan, argsTables, ScopeTable, true, "Access the specified tables by the full table scan"},
+	{HintIndexOnly, argsTables, ScopeTable, true, "Access the specified tables by covering indexes without the table lookup"},
+	{HintNoFullScan, argsTables, ScopeTable, true, "Do not access the specified tables by scanning their full ranges"},
+	{HintReadFromStorage, "[@query_block_name] TIKV[tbl_name [, tbl_name ...]] [, TIFLASH[tbl_name [, tbl_name ...]]]", ScopeTable, true, "Read the specified tables from the specified storage engine"},
+	{HintSample, "[@query_block_name] tbl_name, n PERCENT", ScopeTable, true, "Sample a percentage of the rows of the table"},
+	{HintUseFullTextIndex, argsNone, ScopeQueryBlock, true, "Evaluate MATCH ... AGAINST by the FULLTEXT index"},
+	{HintNoFullTextIndex, argsNone, ScopeQueryBlock, true, "Evaluate MATCH ... AGAINST by LIKE instead of the FULLTEXT index"},
+
+	// subquery and CTE hints
+	{HintMerge, argsNone, ScopeQueryBlock, true, "Inline the CTE into the outer query"},
+	{HintSemiJoinRewrite, argsNone, ScopeQueryBlock, true, "Rewrite the semi join into an inner join with aggregation"},
+	{HintNoDecorrelate, argsNone, ScopeQueryBlock, true, "Do not decorrelate the correlated subquery"},
+	{HintMaterializeSubquery, argsNone, ScopeQueryBlock, true, "Keep the IN/EXISTS subquery as a semi join which materializes the subquery"},
+	{HintNoMaterializeSubquery, argsNone, ScopeQueryBlock, true, "Rewrite the IN/EXISTS subquery into an inner join with aggregation"},
+	{HintUseToja, argsBoolean, ScopeStatement, true, "Whether to rewrite the IN subquery into a join"},
+	{"qb_name", "name [, view_name ...]", ScopeQueryBlock, true, "Name the query block to be referred by other hints"},
+
+	// statement hints
+	{HintMemoryQuota, "[@query_block_name] n MB | n GB", ScopeStatement, true, "Limit the memory used by the statement"},
+	{HintMaxExecutionTime, "[@query_block_name] n", ScopeStatement, true, "Limit the execution time of the statement in milliseconds, or of the scalar subquery when it is in the subquery block, whose result is NULL on timeout"},
+	{"set_var", "var_name = value", ScopeStatement, true, "Set the value of a system variable during the statement"},
+	{"resource_group", argsIdentifier, ScopeStatement, true, "Run the statement in the specified resource group"},
+	{"fix_control", "fix_id:value [, fix_id:value ...]", ScopeStatement, true, "Set the optimizer fix controls during the statement"},
+	{HintMppExchangeCompression, "NONE | FAST | HIGH_COMPRESSION", ScopeStatement, true, "Set the data compression mode of the MPP exchange operators"},
+	{HintIgnorePlanCache, argsNone, ScopeStatement, true, "Do not put the plan of the statement into the plan cache"},
+	{"read_consistent_replica", argsNone, ScopeStatement, true, "Read from the follower replicas"},
+	{"hedged_read", argsNone, ScopeStatement, true, "Send a backup read request to another replica when the first one is slow"},
+	{HintReadLocality, "'key=value[, key=value ...]' [, ANY | LEADER | ERROR]", ScopeStatement, true, "Prefer reading from the replicas on the stores matching the labels, the fallback policy decides what to do when no store matches"},
+	{"async_commit", argsBoolean, ScopeStatement, true, "Whether to commit the transaction of the statement by async commit"},
+	{"one_pc", argsBoolean, ScopeStatement, true, "Whether to commit the transaction of the statement by one phase commit"},
+	{"use_cascades", argsBoolean, ScopeStatement, true, "Whether to use the cascades planner"},
+	{"generated_column_substitute", argsBoolean, ScopeStatement, true, "Whether to substitute the expressions with the equivalent indexed generated columns, TRUE also substitutes the unsafe ones"},
+	{HintShardRowID, "n", ScopeStatement, true, "Scatter the implicit row IDs allocated by the INSERT statement into 2^n shards, n is limited by the max SHARD_ROW_ID_BITS of the table"},
+	{HintBatchDML, "size = n", ScopeStatement, false, "Execute the DELETE or UPDATE statement as a non-transactional DML in batches of n rows"},
+	{"nth_plan", "[@query_block_name] n", ScopeStatement, false, "Use the n-th physical plan found by the optimizer, it's used for testing"},
+	{HintTimeRange, "'start_time', 'end_time'", ScopeStatement, false, "Specify the time range of the metrics, inspection, log, slow query and statements summary tables"},
+}
+
+// ignoredHints lists the hints which can be parsed but are ignored by TiDB, such as the MySQL hints `BKA` and `NO_ICP`.
+// Every hint name recognized by the parser must be either in supportedHints or in ignoredHints.
+var ignoredHints = map[string]struct{}{
+	"join_fixed_order":      {},
+	"join_order":            {},
+	"join_prefix":           {},
+	"join_suffix":           {},
+	"bka":                   {},
+	"no_bka":                {},
+	"bnl":                   {},
+	"no_bnl":                {},
+	"no_merge":              {},
+	"index_merge":           {},
+	"mrr":                   {},
+	"no_mrr":                {},
+	"no_icp":                {},
+	"no_range_optimization": {},
+	"skip_scan":             {},
+	"no_skip_scan":          {},
+	"semijoin":              {},
+	"no_semijoin":           {},
+	"index_join":            {},
+	"index_hash_join":       {},
+	"index_merge_join":      {},
+	"swap_join_inputs":      {},
+	"no_swap_join_inputs":   {},
+	"query_type":            {},
+	"use_plan_cache":        {},
+}
+
+// SupportedHints returns the definitions of all the hints which take effect in TiDB, sorted by name.
+func SupportedHints() []Definition {
+	hints := slices.Clone(supportedHints)
+	slices.SortFunc(hints, func(a, b Definition) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return hints
+}
@@ -0,0 +1,65 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hint
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHintCatalogCompleteness(t *testing.T) {
+	parsed := make(map[string]struct{})
+	for _, name := range parser.HintNames() {
+		parsed[name] = struct{}{}
+	}
+	supported := make(map[string]struct{}, len(supportedHints))
+	for _, def := range supportedHints {
+		_, ok := supported[def.Name]
+		require.False(t, ok, "hint %s is listed twice", def.Name)
+		supported[def.Name] = struct{}{}
+		_, ok = parsed[def.Name]
+		require.True(t, ok, "hint %s can't be parsed", def.Name)
+		_, ok = ignoredHints[def.Name]
+		require.False(t, ok, "hint %s is both supported and ignored", def.Name)
+	}
+	for name := range ignoredHints {
+		_, ok := parsed[name]
+		require.True(t, ok, "hint %s can't be parsed", name)
+	}
+	for name := range parsed {
+		_, isSupported := supported[name]
+		_, isIgnored := ignoredHints[name]
+		require.True(t, isSupported || isIgnored, "hint %s is neither supported nor ignored", name)
+	}
+}
+
+func TestStmtHintNames(t *testing.T) {
+	for name := range stmtHintsOutOfStmtHints {
+		_, ok := stmtHintNames[name]
+		require.False(t, ok, "hint %s is not parsed into StmtHints", name)
+		idx := slices.IndexFunc(supportedHints, func(def Definition) bool { return def.Name == name })
+		require.GreaterOrEqual(t, idx, 0, "hint %s is not in the catalog", name)
+		require.Equal(t, ScopeStatement, supportedHints[idx].Scope)
+	}
+	for _, name := range []string{"memory_quota", "max_execution_time", "set_var", "fix_control", "straight_join", "nth_plan"} {
+		_, ok := stmtHintNames[name]
+		require.True(t, ok, "hint %s is a statement-level hint", name)
+	}
+	_, ok := stmtHintNames[HintHJ]
+	require.False(t, ok)
+}
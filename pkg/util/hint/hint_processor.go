@@ -16,12 +16,15 @@ package hint
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/pingcap/errors"
 	"github.com/pingcap/tidb/pkg/parser"
 	"github.com/pingcap/tidb/pkg/parser/ast"
 	"github.com/pingcap/tidb/pkg/parser/format"
 	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
 	"github.com/pingcap/tidb/pkg/util/dbterror/plannererrors"
 	"github.com/pingcap/tidb/pkg/util/logutil"
 	"go.uber.org/zap"
@@ -47,6 +50,17 @@ func (hs *HintsSet) GetFirstTableHints() []*ast.TableOptimizerHint {
 	return nil
 }
 
+// WithFirstTableHints returns a copy of the HintsSet whose first table hints are replaced by the given hints.
+func (hs *HintsSet) WithFirstTableHints(hints []*ast.TableOptimizerHint) *HintsSet {
+	tableHints := slices.Clone(hs.tableHints)
+	if len(tableHints) > 0 {
+		tableHints[0] = hints
+	} else {
+		tableHints = append(tableHints, hints)
+	}
+	return &HintsSet{tableHints: tableHints, indexHints: hs.indexHints}
+}
+
 // ContainTableHint checks whether the table hint set contains a hint.
 func (hs *HintsSet) ContainTableHint(hint string) bool {
 	for _, tableHintsForBlock := range hs.tableHints {
@@ -104,6 +118,9 @@ func ExtractTableHintsFromStmtNode(node ast.Node, warnHandler hintWarnHandler) [
 // checkInsertStmtHintDuplicated check whether existed the duplicated hints in both insertStmt and its selectStmt.
 // If existed, it would send a warning message.
 func checkInsertStmtHintDuplicated(node ast.Node, warnHandler hintWarnHandler) {
+	if warnHandler == nil {
+		return
+	}
 	switch x := node.(type) {
 	case *ast.InsertStmt:
 		if len(x.TableHints) > 0 {
@@ -133,6 +150,23 @@ func checkInsertStmtHintDuplicated(node ast.Node, warnHandler hintWarnHandler) {
 	}
 }
 
+// ParseHintsStr parses the hints specified by a string, such as the hints of `ADMIN SET HINTS FOR DIGEST` and
+// `CREATE VIEW ... WITH HINTS`. The hints can be either wrapped by `/*+ */` or not.
+func ParseHintsStr(hintsStr string) ([]*ast.TableOptimizerHint, error) {
+	hintsStr = strings.TrimSpace(hintsStr)
+	if !strings.HasPrefix(hintsStr, "/*+") {
+		hintsStr = "/*+ " + hintsStr + " */"
+	}
+	hints, errs := parser.ParseHint(hintsStr, mysql.ModeNone, parser.Pos{Line: 1})
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	if len(hints) == 0 {
+		return nil, errors.New("no hint is specified")
+	}
+	return hints, nil
+}
+
 // RestoreOptimizerHints restores these hints.
 func RestoreOptimizerHints(hints []*ast.TableOptimizerHint) string {
 	hintsStr := make([]string, 0, len(hints))
@@ -267,44 +301,25 @@ func ParseHintsSet(p *parser.Parser, sql, charset, collation, db string) (*Hints
 	if len(stmtNodes) != 1 {
 		return nil, nil, nil, fmt.Errorf("bind_sql must be a single statement: %s", sql)
 	}
+	// The query block names are normalized, so the binding still matches when the hinted statement is formatted
+	// differently or names its query blocks by qb_name.
+	if err = NormalizeQueryBlocks(stmtNodes[0]); err != nil {
+		return nil, nil, nil, err
+	}
 	hs := CollectHint(stmtNodes[0])
 	processor := NewQBHintHandler(nil)
 	stmtNodes[0].Accept(processor)
-	topNodeType := nodeType4Stmt(stmtNodes[0])
-	for i, tblHints := range hs.tableHints {
-		newHints := make([]*ast.TableOptimizerHint, 0, len(tblHints))
-		curOffset := i + 1
-		if topNodeType == TypeDelete || topNodeType == TypeUpdate {
-			curOffset = curOffset - 1
-		}
+	for _, tblHints := range hs.tableHints {
 		for _, tblHint := range tblHints {
-			if tblHint.HintName.L == hintQBName {
-				if len(tblHint.Tables) > 0 {
-					newHints = append(newHints, tblHint)
-				}
-				continue
-			}
-			if processor.isHint4View(tblHint) {
-				newHints = append(newHints, tblHint)
+			if tblHint.HintName.L == hintQBName || processor.isHint4View(tblHint) {
 				continue
 			}
-			offset := processor.GetHintOffset(tblHint.QBName, curOffset)
-			if offset < 0 || !processor.checkTableQBName(tblHint.Tables) {
-				hintStr := RestoreTableOptimizerHint(tblHint)
-				return nil, nil, nil, fmt.Errorf("Unknown query block name in hint %s", hintStr)
-			}
-			tblHint.QBName, err = GenerateQBName(topNodeType, offset)
-			if err != nil {
-				return nil, nil, nil, err
-			}
 			for i, tbl := range tblHint.Tables {
 				if tbl.DBName.String() == "" {
 					tblHint.Tables[i].DBName = model.NewCIStr(db)
 				}
 			}
-			newHints = append(newHints, tblHint)
 		}
-		hs.tableHints[i] = newHints
 	}
 	return hs, stmtNodes[0], extractHintWarns(warns), nil
 }
@@ -405,3 +420,19 @@ func (checker *bindableChecker) Enter(in ast.Node) (out ast.Node, skipChildren b
 func (checker *bindableChecker) Leave(in ast.Node) (out ast.Node, ok bool) {
 	return in, checker.complete
 }
+
+// DiffHints returns the hints in `hints` which are not contained in `exclude`.
+// Hints are compared by their restored string format.
+func DiffHints(hints, exclude []*ast.TableOptimizerHint) []*ast.TableOptimizerHint {
+	excluded := make(map[string]struct{}, len(exclude))
+	for _, h := range exclude {
+		excluded[RestoreTableOptimizerHint(h)] = struct{}{}
+	}
+	diff := make([]*ast.TableOptimizerHint, 0, len(hints))
+	for _, h := range hints {
+		if _, ok := excluded[RestoreTableOptimizerHint(h)]; !ok {
+			diff = append(diff, h)
+		}
+	}
+	return diff
+}
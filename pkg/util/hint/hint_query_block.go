@@ -40,12 +40,13 @@ type QBHintHandler struct {
 
 	warnHandler      hintWarnHandler
 	selectStmtOffset int
+	setOprBranches   setOprBranches
 }
 
 // hintWarnHandler is used to handle the warning when parsing hints.
 type hintWarnHandler interface {
-	SetHintWarning(warn string)
 	SetHintWarningFromError(err error)
+	SetHintWarningForScope(err error, scope WarningScope)
 }
 
 // NewQBHintHandler creates a QBHintHandler.
@@ -67,9 +68,12 @@ func (p *QBHintHandler) Enter(in ast.Node) (ast.Node, bool) {
 		p.checkQueryBlockHints(node.TableHints, 0)
 	case *ast.DeleteStmt:
 		p.checkQueryBlockHints(node.TableHints, 0)
+	case *ast.SetOprSelectList:
+		p.setOprBranches.enterSetOprSelectList(node)
 	case *ast.SelectStmt:
 		p.selectStmtOffset++
 		node.QueryBlockOffset = p.selectStmtOffset
+		p.setOprBranches.enterSelect(node, p.selectStmtOffset)
 		// Handle the view hints and update the left hint.
 		node.TableHints = p.handleViewHints(node.TableHints, node.QueryBlockOffset)
 		p.checkQueryBlockHints(node.TableHints, node.QueryBlockOffset)
@@ -97,7 +101,7 @@ func (p *QBHintHandler) checkQueryBlockHints(hints []*ast.TableOptimizerHint, of
 		}
 		if qbName != "" {
 			if p.warnHandler != nil {
-				p.warnHandler.SetHintWarning(fmt.Sprintf("There are more than two query names in same query block, using the first one %s", qbName))
+				p.warnHandler.SetHintWarningFromError(ErrWarnConflictingHint.FastGen("There are more than two query names in same query block, using the first one %s", qbName))
 			}
 		} else {
 			qbName = hint.QBName.L
@@ -111,7 +115,7 @@ func (p *QBHintHandler) checkQueryBlockHints(hints []*ast.TableOptimizerHint, of
 	}
 	if _, ok := p.QBNameToSelOffset[qbName]; ok {
 		if p.warnHandler != nil {
-			p.warnHandler.SetHintWarning(fmt.Sprintf("Duplicate query block name %s, only the first one is effective", qbName))
+			p.warnHandler.SetHintWarningFromError(ErrWarnConflictingHint.FastGen("Duplicate query block name %s, only the first one is effective", qbName))
 		}
 	} else {
 		p.QBNameToSelOffset[qbName] = offset
@@ -140,7 +144,7 @@ func (p *QBHintHandler) handleViewHints(hints []*ast.TableOptimizerHint, offset
 		}
 		if _, ok := p.ViewQBNameToTable[qbName]; ok {
 			if p.warnHandler != nil {
-				p.warnHandler.SetHintWarning(fmt.Sprintf("Duplicate query block name %s for view's query block hint, only the first one is effective", qbName))
+				p.warnHandler.SetHintWarningFromError(ErrWarnConflictingHint.FastGen("Duplicate query block name %s for view's query block hint, only the first one is effective", qbName))
 			}
 		} else {
 			if offset != 1 {
@@ -177,7 +181,7 @@ func (p *QBHintHandler) handleViewHints(hints []*ast.TableOptimizerHint, offset
 					}
 				}
 				if !ok {
-					p.warnHandler.SetHintWarning("Only one query block name is allowed in a view hint, otherwise the hint will be invalid")
+					p.warnHandler.SetHintWarningFromError(ErrWarnHintInapplicable.FastGen("Only one query block name is allowed in a view hint, otherwise the hint will be invalid"))
 					usedHints[i] = true
 				}
 			}
@@ -206,7 +210,7 @@ func (p *QBHintHandler) HandleUnusedViewHints() {
 		for qbName := range p.ViewQBNameToTable {
 			_, ok := p.ViewQBNameUsed[qbName]
 			if !ok && p.warnHandler != nil {
-				p.warnHandler.SetHintWarning(fmt.Sprintf("The qb_name hint %s is unused, please check whether the table list in the qb_name hint %s is correct", qbName, qbName))
+				p.warnHandler.SetHintWarningFromError(ErrWarnHintUnknownQueryBlock.FastGen("The qb_name hint %s is unused, please check whether the table list in the qb_name hint %s is correct", qbName, qbName))
 			}
 		}
 	}
@@ -216,8 +220,41 @@ const (
 	defaultUpdateBlockName   = "upd_1"
 	defaultDeleteBlockName   = "del_1"
 	defaultSelectBlockPrefix = "sel_"
+	defaultSetOprBlockPrefix = "union_"
 )
 
+// setOprBranches numbers the SELECT branches of the UNION/EXCEPT/INTERSECT statements in the order they appear in
+// the statement, so `@union_2` refers to the second branch no matter how many subqueries the first branch contains.
+type setOprBranches struct {
+	branches map[*ast.SelectStmt]struct{}
+	offsets  []int // the select offsets of the branches
+}
+
+func (b *setOprBranches) enterSetOprSelectList(list *ast.SetOprSelectList) {
+	for _, sel := range list.Selects {
+		if selectStmt, ok := sel.(*ast.SelectStmt); ok {
+			if b.branches == nil {
+				b.branches = make(map[*ast.SelectStmt]struct{})
+			}
+			b.branches[selectStmt] = struct{}{}
+		}
+	}
+}
+
+func (b *setOprBranches) enterSelect(sel *ast.SelectStmt, offset int) {
+	if _, ok := b.branches[sel]; ok {
+		b.offsets = append(b.offsets, offset)
+	}
+}
+
+// getOffset returns the select offset of the n-th branch, n starts from 1. It returns -1 if there is no such branch.
+func (b *setOprBranches) getOffset(n int64) int {
+	if n < 1 || n > int64(len(b.offsets)) {
+		return -1
+	}
+	return b.offsets[n-1]
+}
+
 // getBlockName finds the offset of query block name. It uses 0 as offset for top level update or delete,
 // -1 for invalid block name.
 func (p *QBHintHandler) getBlockOffset(blockName model.CIStr) int {
@@ -239,6 +276,14 @@ func (p *QBHintHandler) getBlockOffset(blockName model.CIStr) int {
 		}
 		return int(level)
 	}
+	if strings.HasPrefix(blockName.L, defaultSetOprBlockPrefix) {
+		suffix := blockName.L[len(defaultSetOprBlockPrefix):]
+		n, err := strconv.ParseInt(suffix, 10, 64)
+		if err != nil {
+			return -1
+		}
+		return p.setOprBranches.getOffset(n)
+	}
 	return -1
 }
 
@@ -250,6 +295,21 @@ func (p *QBHintHandler) GetHintOffset(qbName model.CIStr, currentOffset int) int
 	return currentOffset
 }
 
+// unknownQueryBlockScope returns the scope of the warning for the hint which refers to an unknown query block, the
+// warnings of the same hint on the same table for different unknown query blocks are aggregated into one.
+func unknownQueryBlockScope(hint *ast.TableOptimizerHint) WarningScope {
+	scope := WarningScope{Hint: hint.HintName.L, Kind: WarningScopeQueryBlock, Name: hint.QBName.L}
+	for _, table := range hint.Tables {
+		if scope.Table == "" {
+			scope.Table = table.TableName.L
+		}
+		if scope.Name == "" {
+			scope.Name = table.QBName.L
+		}
+	}
+	return scope
+}
+
 func (p *QBHintHandler) checkTableQBName(tables []ast.HintTable) bool {
 	for _, table := range tables {
 		if table.QBName.L != "" && p.getBlockOffset(table.QBName) < 0 {
@@ -290,8 +350,14 @@ func (p *QBHintHandler) GetCurrentStmtHints(hints []*ast.TableOptimizerHint, cur
 		offset := p.GetHintOffset(hint.QBName, currentOffset)
 		if offset < 0 || !p.checkTableQBName(hint.Tables) {
 			if p.warnHandler != nil {
-				hintStr := RestoreTableOptimizerHint(hint)
-				p.warnHandler.SetHintWarning(fmt.Sprintf("Hint %s is ignored due to unknown query block name", hintStr))
+				p.warnHandler.SetHintWarningForScope(ErrWarnHintUnknownQueryBlock.FastGenByArgs(RestoreTableOptimizerHint(hint)),
+					unknownQueryBlockScope(hint))
+			}
+			continue
+		}
+		if hint.HintName.L == HintReadFromStorage && hint.QBName.L == "" {
+			for tblOffset, h := range p.splitStorageHint(hint, offset) {
+				p.QBOffsetToHints[tblOffset] = append(p.QBOffsetToHints[tblOffset], h)
 			}
 			continue
 		}
@@ -300,6 +366,31 @@ func (p *QBHintHandler) GetCurrentStmtHints(hints []*ast.TableOptimizerHint, cur
 	return p.QBOffsetToHints[currentOffset]
 }
 
+// splitStorageHint splits the read_from_storage hint by the query blocks of its tables, so the same table can be read
+// from different storages in different query blocks, e.g. `read_from_storage(tikv[t@sel_1], tiflash[t@sel_2])`.
+// The tables without query block names stay in the query block of the hint.
+func (p *QBHintHandler) splitStorageHint(hint *ast.TableOptimizerHint, offset int) map[int]*ast.TableOptimizerHint {
+	hints := make(map[int]*ast.TableOptimizerHint, 1)
+	for _, table := range hint.Tables {
+		tblOffset := p.GetHintOffset(table.QBName, offset)
+		h, ok := hints[tblOffset]
+		if !ok {
+			newHint := *hint
+			newHint.Tables = nil
+			h = &newHint
+			hints[tblOffset] = h
+		}
+		h.Tables = append(h.Tables, table)
+	}
+	if len(hints) == 1 {
+		// Keep the original hint if all the tables are in the same query block.
+		for tblOffset := range hints {
+			hints[tblOffset] = hint
+		}
+	}
+	return hints
+}
+
 // GenerateQBName builds QBName from offset.
 func GenerateQBName(nodeType NodeType, qbOffset int) (model.CIStr, error) {
 	if qbOffset == 0 {
@@ -313,3 +404,111 @@ func GenerateQBName(nodeType NodeType, qbOffset int) (model.CIStr, error) {
 	}
 	return model.NewCIStr(fmt.Sprintf("%s%d", defaultSelectBlockPrefix, qbOffset)), nil
 }
+
+// queryBlock is a query block collected by queryBlockCollector.
+type queryBlock struct {
+	node   ast.Node
+	offset int
+}
+
+// queryBlockCollector collects the query blocks of a statement with the same offsets as QBHintHandler.
+type queryBlockCollector struct {
+	blocks           []queryBlock
+	selectStmtOffset int
+	setOprBranches   setOprBranches
+}
+
+// Enter implements Visitor interface.
+func (c *queryBlockCollector) Enter(in ast.Node) (ast.Node, bool) {
+	switch node := in.(type) {
+	case *ast.UpdateStmt, *ast.DeleteStmt:
+		c.blocks = append(c.blocks, queryBlock{node: in, offset: 0})
+	case *ast.SetOprSelectList:
+		c.setOprBranches.enterSetOprSelectList(node)
+	case *ast.SelectStmt:
+		c.selectStmtOffset++
+		c.setOprBranches.enterSelect(node, c.selectStmtOffset)
+		c.blocks = append(c.blocks, queryBlock{node: in, offset: c.selectStmtOffset})
+	case *ast.ExplainStmt, *ast.CreateBindingStmt:
+		return in, true
+	}
+	return in, false
+}
+
+// Leave implements Visitor interface.
+func (*queryBlockCollector) Leave(in ast.Node) (ast.Node, bool) {
+	return in, true
+}
+
+// NormalizeQueryBlocks rewrites the query block names in the hints of the statement to the default names generated
+// from the block offsets, such as `sel_2`, and removes the qb_name hints which are only used to define the names.
+// The hints without a query block name are also attached to the name of the block they are in.
+// The offsets only depend on the positions of the query blocks in the AST, so the hints of the semantically identical
+// statements are normalized to the same form regardless of their formatting and the names chosen by the users.
+// The qb_name hints for views are kept, since their names can't be replaced by offsets.
+func NormalizeQueryBlocks(stmt ast.StmtNode) error {
+	collector := &queryBlockCollector{}
+	stmt.Accept(collector)
+	handler := NewQBHintHandler(nil)
+	handler.selectStmtOffset = collector.selectStmtOffset
+	handler.setOprBranches = collector.setOprBranches
+	handler.ViewQBNameToTable = make(map[string][]ast.HintTable)
+	for _, block := range collector.blocks {
+		hints := ExtractTableHintsFromStmtNode(block.node, nil)
+		handler.checkQueryBlockHints(hints, block.offset)
+		for _, hint := range hints {
+			if hint.HintName.L == hintQBName && len(hint.Tables) > 0 {
+				handler.ViewQBNameToTable[hint.QBName.L] = hint.Tables
+			}
+		}
+	}
+	isViewQBName := func(qbName model.CIStr) bool {
+		_, ok := handler.ViewQBNameToTable[qbName.L]
+		return ok
+	}
+
+	topNodeType := nodeType4Stmt(stmt)
+	for _, block := range collector.blocks {
+		hints := ExtractTableHintsFromStmtNode(block.node, nil)
+		if len(hints) == 0 {
+			continue
+		}
+		newHints := make([]*ast.TableOptimizerHint, 0, len(hints))
+		for _, hint := range hints {
+			if hint.HintName.L == hintQBName {
+				if len(hint.Tables) > 0 {
+					newHints = append(newHints, hint)
+				}
+				continue
+			}
+			if handler.isHint4View(hint) {
+				newHints = append(newHints, hint)
+				continue
+			}
+			offset := handler.GetHintOffset(hint.QBName, block.offset)
+			if offset < 0 {
+				return fmt.Errorf("Unknown query block name in hint %s", RestoreTableOptimizerHint(hint))
+			}
+			qbName, err := GenerateQBName(topNodeType, offset)
+			if err != nil {
+				return err
+			}
+			hint.QBName = qbName
+			for i, table := range hint.Tables {
+				if table.QBName.L == "" || isViewQBName(table.QBName) {
+					continue
+				}
+				offset := handler.getBlockOffset(table.QBName)
+				if offset < 0 {
+					return fmt.Errorf("Unknown query block name in hint %s", RestoreTableOptimizerHint(hint))
+				}
+				if hint.Tables[i].QBName, err = GenerateQBName(topNodeType, offset); err != nil {
+					return err
+				}
+			}
+			newHints = append(newHints, hint)
+		}
+		setTableHints4StmtNode(block.node, newHints)
+	}
+	return nil
+}
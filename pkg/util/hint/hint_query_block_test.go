@@ -0,0 +1,129 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package hint
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser"
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/parser/model"
+	_ "github.com/pingcap/tidb/pkg/types/parser_driver"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeQueryBlocks(t *testing.T) {
+	p := parser.New()
+	normalize := func(sql string) (string, error) {
+		stmt, err := p.ParseOneStmt(sql, "", "")
+		require.NoError(t, err)
+		if err := NormalizeQueryBlocks(stmt); err != nil {
+			return "", err
+		}
+		return CollectHint(stmt).Restore()
+	}
+
+	cases := []struct {
+		sqls     []string
+		expected string
+	}{
+		{
+			sqls: []string{
+				"select /*+ use_index(@qb t2 a) */ * from t1 where a in (select /*+ qb_name(qb) */ b from t2)",
+				"select /*+ use_index(@sel_2 t2 a) */ * from t1 where a in (select b from t2)",
+				"select /*+ use_index(@sel_2 t2 a) */ *\n  from t1\n  where a in (\n    /* the subquery */ select b from t2\n  )",
+				"select * from t1 where a in (select /*+ use_index(t2 a) */ b from t2)",
+			},
+			expected: "use_index(@`sel_2` `t2` `a`)",
+		},
+		{
+			sqls: []string{
+				"select /*+ hash_join(t1, t2@qb) */ * from t1, (select /*+ qb_name(qb) */ * from t2) t2",
+				"select /*+ hash_join(t1, t2@sel_2) */ * from t1, (select * from t2) t2",
+			},
+			expected: "hash_join(@`sel_1` `t1`, `t2`@`sel_2`)",
+		},
+		{
+			sqls: []string{
+				"update /*+ use_index(@qb t2 a) */ t1 set a = (select /*+ qb_name(qb) */ max(b) from t2)",
+				"update t1 set a = (select /*+ use_index(t2 a) */ max(b) from t2)",
+			},
+			expected: "use_index(@`sel_1` `t2` `a`)",
+		},
+		{
+			sqls: []string{
+				"delete /*+ use_index(t1 a) */ from t1 where a > 1",
+				"delete /*+ use_index(@del_1 t1 a) */ from t1 where a > 1",
+			},
+			expected: "use_index(@`del_1` `t1` `a`)",
+		},
+		{
+			// The hints for views are kept.
+			sqls: []string{
+				"select /*+ qb_name(qb_v, v) use_index(@qb_v t a) */ * from v",
+			},
+			expected: "qb_name(`qb_v` , `v`), use_index(@`qb_v` `t` `a`)",
+		},
+		{
+			// The branches of the set operations can be referred by `union_N`, regardless of the subqueries.
+			sqls: []string{
+				"select /*+ use_index(@union_2 t2 a) */ * from t1 where a in (select b from t3) union all select * from t2",
+				"select /*+ use_index(@sel_3 t2 a) */ * from t1 where a in (select b from t3) union all select * from t2",
+				"select * from t1 where a in (select b from t3) union all select /*+ use_index(t2 a) */ * from t2",
+			},
+			expected: "use_index(@`sel_3` `t2` `a`)",
+		},
+		{
+			sqls: []string{
+				"select /*+ hash_join(@union_3 t3, t4) */ * from t1 except (select * from t2 intersect select * from t3, t4)",
+				"select * from t1 except (select * from t2 intersect select /*+ hash_join(t3, t4) */ * from t3, t4)",
+			},
+			expected: "hash_join(@`sel_3` `t3`, `t4`)",
+		},
+	}
+	for _, c := range cases {
+		for _, sql := range c.sqls {
+			hints, err := normalize(sql)
+			require.NoError(t, err, sql)
+			require.Equal(t, c.expected, hints, sql)
+		}
+	}
+
+	_, err := normalize("select /*+ use_index(@qb t a) */ * from t")
+	require.EqualError(t, err, "Unknown query block name in hint use_index(@`qb` `t` `a`)")
+	_, err = normalize("select /*+ use_index(@sel_2 t a) */ * from t")
+	require.EqualError(t, err, "Unknown query block name in hint use_index(@`sel_2` `t` `a`)")
+	_, err = normalize("select /*+ use_index(@union_3 t a) */ * from t union select * from t")
+	require.EqualError(t, err, "Unknown query block name in hint use_index(@`union_3` `t` `a`)")
+	_, err = normalize("select /*+ use_index(@union_1 t a) */ * from t")
+	require.EqualError(t, err, "Unknown query block name in hint use_index(@`union_1` `t` `a`)")
+}
+
+func TestRestoreHintsWithQBName(t *testing.T) {
+	tables := []HintedTable{
+		{TblName: model.NewCIStr("t1")},
+		{TblName: model.NewCIStr("t2"), QBName: model.NewCIStr("sel_2")},
+	}
+	require.Equal(t, "/*+ HASH_JOIN(t1, t2@sel_2) */", Restore2JoinHint(HintHJ, tables))
+	require.Equal(t, "/*+ READ_FROM_STORAGE(tiflash[t2@sel_2], tikv[t1]) */", Restore2StorageHint(tables[1:], tables[:1]))
+
+	index := HintedIndex{
+		TblName:    model.NewCIStr("t"),
+		QBName:     model.NewCIStr("union_2"),
+		Partitions: []model.CIStr{model.NewCIStr("p0")},
+		IndexHint:  &ast.IndexHint{IndexNames: []model.CIStr{model.NewCIStr("a")}},
+	}
+	require.Equal(t, "/*+ USE_INDEX(t@union_2 PARTITION(p0) a) */", Restore2IndexHint(HintUseIndex, index))
+}
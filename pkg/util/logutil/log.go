@@ -49,6 +49,10 @@ const (
 	DefaultRecordPlanInSlowLog = 1
 	// DefaultTiDBEnableSlowLog enables TiDB to log slow queries.
 	DefaultTiDBEnableSlowLog = true
+	// SlowLogFormatText is the slow log format in which each field is written in a `# field: value` line.
+	SlowLogFormatText = "text"
+	// SlowLogFormatJSON is the slow log format in which each slow query is written as a line of JSON.
+	SlowLogFormatJSON = "json"
 )
 
 const (
@@ -83,6 +87,9 @@ type LogConfig struct {
 	// SlowQueryFile filename, default to File log config on empty.
 	SlowQueryFile string
 
+	// SlowQueryFormat is the format of the slow query log, one of text or json, default to text on empty.
+	SlowQueryFormat string
+
 	// GeneralLogFile filenanme, default to File log config on empty.
 	GeneralLogFile string
 }
@@ -17,11 +17,13 @@ package logutil
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"runtime"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pingcap/log"
@@ -223,6 +225,29 @@ func TestSlowQueryLoggerAndGeneralLoggerCreation(t *testing.T) {
 	}
 }
 
+func TestSlowLogJSONEncoder(t *testing.T) {
+	enc := &slowLogJSONEncoder{}
+	entry := zapcore.Entry{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)}
+	for _, msg := range []string{`{"query":"select 1;"}`, `{}`, "not json\nline"} {
+		entry.Message = msg
+		b, err := enc.EncodeEntry(entry, nil)
+		require.NoError(t, err)
+		line := b.String()
+		require.Equal(t, byte('\n'), line[len(line)-1])
+		var m map[string]any
+		require.NoError(t, json.Unmarshal([]byte(line), &m), line)
+		require.Equal(t, entry.Time.Format(SlowLogTimeFormat), m["time"])
+		switch msg {
+		case `{"query":"select 1;"}`:
+			require.Equal(t, "select 1;", m["query"])
+		case `{}`:
+			require.Len(t, m, 1)
+		default:
+			require.Equal(t, msg, m["message"])
+		}
+	}
+}
+
 func TestCompressedLog(t *testing.T) {
 	level := "warn"
 	fileConf := FileLogConfig{
@@ -15,7 +15,9 @@
 package logutil
 
 import (
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/pingcap/errors"
@@ -35,7 +37,11 @@ func newSlowQueryLogger(cfg *LogConfig) (*zap.Logger, *log.ZapProperties, error)
 	}
 
 	// replace 2018-12-19-unified-log-format text encoder with slow log encoder
-	newCore := log.NewTextCore(&slowLogEncoder{}, prop.Syncer, prop.Level)
+	var encoder zapcore.Encoder = &slowLogEncoder{}
+	if cfg.SlowQueryFormat == SlowLogFormatJSON {
+		encoder = &slowLogJSONEncoder{}
+	}
+	newCore := log.NewTextCore(encoder, prop.Syncer, prop.Level)
 	sqLogger = sqLogger.WithOptions(zap.WrapCore(func(zapcore.Core) zapcore.Core {
 		return newCore
 	}))
@@ -67,6 +73,35 @@ func (*slowLogEncoder) EncodeEntry(entry zapcore.Entry, _ []zapcore.Field) (*buf
 	return b, nil
 }
 
+// slowLogJSONEncoder writes each slow log as a line of JSON, the message is expected to
+// be a JSON object and the time of the entry is prepended to it as the "time" field.
+type slowLogJSONEncoder struct {
+	slowLogEncoder
+}
+
+func (*slowLogJSONEncoder) EncodeEntry(entry zapcore.Entry, _ []zapcore.Field) (*buffer.Buffer, error) {
+	b := _pool.Get()
+	msg := strings.TrimSpace(entry.Message)
+	if !strings.HasPrefix(msg, "{") {
+		// Not a JSON object, wrap it so the line is still valid JSON.
+		quoted, err := json.Marshal(msg)
+		if err != nil {
+			return nil, errors.Trace(err)
+		}
+		fmt.Fprintf(b, "{\"time\":%q,\"message\":%s}\n", entry.Time.Format(SlowLogTimeFormat), quoted)
+		return b, nil
+	}
+	fmt.Fprintf(b, "{\"time\":%q", entry.Time.Format(SlowLogTimeFormat))
+	if rest := strings.TrimSpace(msg[1:]); rest != "}" {
+		b.AppendByte(',')
+	}
+	b.AppendString(msg[1:])
+	b.AppendByte('\n')
+	return b, nil
+}
+
+func (e *slowLogJSONEncoder) Clone() zapcore.Encoder { return e }
+
 func (e *slowLogEncoder) Clone() zapcore.Encoder                        { return e }
 func (*slowLogEncoder) AddArray(string, zapcore.ArrayMarshaler) error   { return nil }
 func (*slowLogEncoder) AddObject(string, zapcore.ObjectMarshaler) error { return nil }
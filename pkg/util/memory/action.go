@@ -179,6 +179,83 @@ func (*PanicOnExceed) GetPriority() int64 {
 	return DefPanicPriority
 }
 
+// OperatorQuotaAction is bound to the tracker of a single operator when the operator has its own
+// memory quota. It triggers the operator's own action, e.g. spilling to disk, each time the operator
+// exceeds its quota, so that the statement-level action is only taken as a last resort.
+type OperatorQuotaAction struct {
+	BaseOOMAction
+	action ActionOnExceed
+	mutex  sync.Mutex // For synchronization.
+	logged bool
+}
+
+// NewOperatorQuotaAction creates an OperatorQuotaAction which triggers action.
+func NewOperatorQuotaAction(action ActionOnExceed) *OperatorQuotaAction {
+	return &OperatorQuotaAction{action: action}
+}
+
+// Action triggers the operator's action when memory usage exceeds the operator's quota.
+// If the operator can't release more memory, e.g. it has spilled all its data, the action
+// falls back to an operatorQuotaFallback which stops there, because the statement-level
+// actions, e.g. cancelling the whole query, must only be taken on the statement's quota.
+func (a *OperatorQuotaAction) Action(t *Tracker) {
+	if a.action.IsFinished() {
+		return
+	}
+	a.mutex.Lock()
+	if !a.logged {
+		a.logged = true
+		logutil.BgLogger().Info("memory exceeds operator quota",
+			zap.Int("label", t.label), zap.Int64("consumed", t.BytesConsumed()), zap.Int64("quota", t.GetBytesLimit()))
+	}
+	a.mutex.Unlock()
+	a.action.Action(t)
+}
+
+// GetPriority get the priority of the Action
+func (a *OperatorQuotaAction) GetPriority() int64 {
+	return a.action.GetPriority()
+}
+
+// operatorQuotaFallback is inserted as the fallback of an operator's action when the action is
+// also triggered by the operator's own quota. It ignores the fallback from the operator's quota,
+// and passes the fallback from the statement's quota to the original fallback actions.
+type operatorQuotaFallback struct {
+	BaseOOMAction
+	priority int64
+}
+
+// Action implements ActionOnExceed.
+func (a *operatorQuotaFallback) Action(t *Tracker) {
+	if t.operatorQuota.Load() {
+		return
+	}
+	if fallback := a.GetFallback(); fallback != nil {
+		fallback.Action(t)
+	}
+}
+
+// GetPriority get the priority of the Action
+func (a *operatorQuotaFallback) GetPriority() int64 {
+	return a.priority
+}
+
+// SetOperatorQuota sets the memory quota of an operator's tracker. When the tracker exceeds the quota,
+// action is triggered. It does nothing if quota is not positive.
+func SetOperatorQuota(t *Tracker, quota int64, action ActionOnExceed) {
+	if t == nil || quota <= 0 || action == nil {
+		return
+	}
+	if _, ok := action.GetFallback().(*operatorQuotaFallback); !ok {
+		fallback := &operatorQuotaFallback{priority: action.GetPriority()}
+		fallback.SetFallback(action.GetFallback())
+		action.SetFallback(fallback)
+	}
+	t.operatorQuota.Store(true)
+	t.SetBytesLimit(quota)
+	t.SetActionOnExceed(NewOperatorQuotaAction(action))
+}
+
 var (
 	errMemExceedThreshold = dbterror.ClassUtil.NewStd(errno.ErrMemExceedThreshold)
 )
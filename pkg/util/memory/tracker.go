@@ -94,6 +94,7 @@ type Tracker struct {
 	SessionID           atomicutil.Uint64 // SessionID indicates the sessionID the tracker is bound.
 	IsRootTrackerOfSess bool              // IsRootTrackerOfSess indicates whether this tracker is bound for session
 	isGlobal            bool              // isGlobal indicates whether this tracker is global tracker
+	operatorQuota       atomicutil.Bool   // operatorQuota indicates whether the limit of this tracker is an operator's own quota
 }
 
 type actionMu struct {
@@ -860,6 +861,8 @@ const (
 	LabelForChunkDataInDiskByChunks int = -30
 	// LabelForSortPartition represents the label of the sort partition
 	LabelForSortPartition = -31
+	// LabelForRowChanges represents the label of the row changes buffered for the extensions
+	LabelForRowChanges int = -32
 )
 
 // MetricsTypes is used to get label for metrics
@@ -244,6 +244,39 @@ func TestOOMAction(t *testing.T) {
 	require.Equal(t, action1, tracker.actionMuForHardLimit.actionOnExceed.GetFallback())
 }
 
+func TestOperatorQuotaAction(t *testing.T) {
+	stmtTracker := NewTracker(1, 1000)
+	opTracker := NewTracker(2, -1)
+	opTracker.AttachTo(stmtTracker)
+
+	cancelAction := &mockAction{priority: DefPanicPriority}
+	spillAction := &mockAction{priority: DefSpillPriority}
+	stmtTracker.SetActionOnExceed(cancelAction)
+	stmtTracker.FallbackOldAndSetNewAction(spillAction)
+
+	SetOperatorQuota(opTracker, 0, spillAction)
+	require.Equal(t, int64(-1), opTracker.GetBytesLimit())
+	SetOperatorQuota(opTracker, 100, spillAction)
+	require.Equal(t, int64(100), opTracker.GetBytesLimit())
+
+	// The operator spills first when it exceeds its own quota.
+	opTracker.Consume(150)
+	require.True(t, spillAction.called)
+	require.False(t, cancelAction.called)
+	// Exceeding the operator quota again doesn't trigger the statement-level action.
+	opTracker.Consume(150)
+	require.False(t, cancelAction.called)
+	// The operator's action is triggered again once it can release memory again.
+	spillAction.called = false
+	opTracker.Consume(10)
+	require.True(t, spillAction.called)
+	require.False(t, cancelAction.called)
+
+	// The statement-level action is the last resort.
+	opTracker.Consume(1000)
+	require.True(t, cancelAction.called)
+}
+
 type mockAction struct {
 	BaseOOMAction
 	called   bool
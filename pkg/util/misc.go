@@ -454,6 +454,9 @@ type SequenceTable interface {
 	GetSequenceID() int64
 	GetSequenceNextVal(ctx any, dbName, seqName string) (int64, error)
 	SetSequenceVal(ctx any, newVal int64, dbName, seqName string) (int64, bool, error)
+	// GetSequenceCacheRange returns the next and the last value cached in this instance and the number of them,
+	// remaining is 0 if the cache is used up.
+	GetSequenceCacheRange() (next, last int64, remaining uint64)
 }
 
 // LoadTLSCertificates loads CA/KEY/CERT for special paths.
@@ -284,7 +284,7 @@ func (c *Context) GetRangerCtx() *rangerctx.RangerContext {
 		InPreparedPlanBuilding:   c.GetSessionVars().StmtCtx.InPreparedPlanBuilding,
 		RegardNULLAsPoint:        c.GetSessionVars().RegardNULLAsPoint,
 		OptPrefixIndexSingleScan: c.GetSessionVars().OptPrefixIndexSingleScan,
-		OptimizerFixControl:      c.GetSessionVars().OptimizerFixControl,
+		OptimizerFixControl:      c.GetSessionVars().GetOptimizerFixControlMap(),
 
 		PlanCacheTracker:     &c.GetSessionVars().StmtCtx.PlanCacheTracker,
 		RangeFallbackHandler: &c.GetSessionVars().StmtCtx.RangeFallbackHandler,
@@ -0,0 +1,134 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plancodec
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pingcap/errors"
+	"github.com/pingcap/tidb/pkg/util/texttree"
+)
+
+// ExplainDigestVersion is the version of the algorithm used by ExplainDigest.
+//
+// The plan digest in the statements summary and the slow log is computed from the normalized operator info, which
+// changes whenever an operator prints a bit more or less, and the IDs of the operators, which change whenever a new
+// operator is added. So it's only stable within a TiDB version. ExplainDigest computes a digest from the result of
+// EXPLAIN instead, which only depends on the shape of the plan and is guaranteed to be the same across TiDB versions
+// as long as the version of the algorithm is the same. The algorithm of version 2 is:
+//
+//  1. Each operator of the plan, i.e. each row before the sections appended after the plan such as "Hints In Use",
+//     is normalized to a line of `depth<TAB>operator<TAB>task<TAB>access object<LF>`, where:
+//     - depth is the depth of the operator in the plan tree, which is 0 for the root operator.
+//     - operator is the name of the operator with its numeric ID removed, and its label such as "(Build)" or
+//     "(Seed Part)" kept, e.g. "HashJoin_8" and "TableReader_10(Probe)" are normalized to "HashJoin" and
+//     "TableReader(Probe)".
+//     - task is the task type in lower case, e.g. "root" and "cop[tikv]".
+//     - access object is kept as it is, e.g. "table:t, index:idx(a)".
+//  2. The estimated rows, the costs, the operator info and the runtime information are ignored.
+//  3. The digest is the hex encoded SHA-256 of "v2" followed by a LF and all the normalized lines.
+//
+// A change to the algorithm which may change the digest of any plan must increase the version. Version 1 kept the
+// IDs of the operators whose labels contain spaces, such as the parts of a recursive CTE.
+const ExplainDigestVersion = 2
+
+// ExplainDigestResult is the result of ExplainDigest.
+type ExplainDigestResult struct {
+	// Version is the version of the algorithm used to compute the digest.
+	Version int
+	// Normalized is the normalized plan which the digest is computed from.
+	Normalized string
+	// Digest is the hex encoded digest of the plan.
+	Digest string
+}
+
+var explainIDSuffix = regexp.MustCompile(`_\d+(\([^)]*\))?$`)
+
+// ExplainDigest computes the digest of a plan from the result of `EXPLAIN` or `EXPLAIN ANALYZE` in the row formats,
+// such as 'row', 'brief' and 'verbose'. columns are the names of the result columns, and rows are the result rows.
+// See ExplainDigestVersion for the algorithm and its stability guarantees.
+func ExplainDigest(columns []string, rows [][]string) (ExplainDigestResult, error) {
+	idIdx, taskIdx, accessIdx := -1, -1, -1
+	for i, col := range columns {
+		switch strings.ToLower(col) {
+		case "id":
+			idIdx = i
+		case "task":
+			taskIdx = i
+		case "access object":
+			accessIdx = i
+		}
+	}
+	if idIdx < 0 || taskIdx < 0 || accessIdx < 0 {
+		return ExplainDigestResult{}, errors.Errorf("the columns %v are not the result of EXPLAIN in the row formats", columns)
+	}
+
+	var buf bytes.Buffer
+	for i, row := range rows {
+		if len(row) != len(columns) {
+			return ExplainDigestResult{}, errors.Errorf("row %d has %d columns, but %d columns are expected", i, len(row), len(columns))
+		}
+		// The sections appended after the plan have no task type.
+		if row[taskIdx] == "" {
+			if i == 0 {
+				return ExplainDigestResult{}, errors.New("the result of EXPLAIN has no operator")
+			}
+			break
+		}
+		depth, operator := normalizeExplainID(row[idIdx])
+		buf.WriteString(strconv.Itoa(depth))
+		buf.WriteByte(separator)
+		buf.WriteString(operator)
+		buf.WriteByte(separator)
+		buf.WriteString(strings.ToLower(row[taskIdx]))
+		buf.WriteByte(separator)
+		buf.WriteString(escapeString(row[accessIdx]))
+		buf.WriteByte(lineBreaker)
+	}
+	if buf.Len() == 0 {
+		return ExplainDigestResult{}, errors.New("the result of EXPLAIN has no operator")
+	}
+
+	hasher := sha256.New()
+	hasher.Write([]byte("v" + strconv.Itoa(ExplainDigestVersion)))
+	hasher.Write([]byte{lineBreaker})
+	hasher.Write(buf.Bytes())
+	return ExplainDigestResult{
+		Version:    ExplainDigestVersion,
+		Normalized: buf.String(),
+		Digest:     hex.EncodeToString(hasher.Sum(nil)),
+	}, nil
+}
+
+// normalizeExplainID returns the depth of the operator and its name without the numeric ID from the ID column of
+// EXPLAIN, e.g. "│ └─TableReader_10(Probe)" is normalized to 2 and "TableReader(Probe)".
+func normalizeExplainID(id string) (int, string) {
+	name := strings.TrimLeftFunc(id, func(r rune) bool {
+		switch r {
+		case texttree.TreeBody, texttree.TreeMiddleNode, texttree.TreeLastNode, texttree.TreeGap, texttree.TreeNodeIdentifier:
+			return true
+		}
+		return false
+	})
+	// Every level of the tree is indented by 2 runes.
+	depth := len([]rune(id[:len(id)-len(name)])) / 2
+	name = explainIDSuffix.ReplaceAllString(name, "$1")
+	return depth, name
+}
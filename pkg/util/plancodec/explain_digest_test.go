@@ -0,0 +1,94 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plancodec
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestExplainDigest(t *testing.T) {
+	columns := []string{"id", "estRows", "task", "access object", "operator info"}
+	rows := [][]string{
+		{"HashJoin_8", "12487.50", "root", "", "inner join, equal:[eq(test.t1.a, test.t2.a)]"},
+		{"├─TableReader_15(Build)", "9990.00", "root", "", "data:Selection_14"},
+		{"│ └─Selection_14", "9990.00", "cop[tikv]", "", "not(isnull(test.t2.a))"},
+		{"│   └─TableFullScan_13", "10000.00", "cop[tikv]", "table:t2", "keep order:false, stats:pseudo"},
+		{"└─IndexReader_12(Probe)", "9990.00", "root", "", "index:IndexFullScan_11"},
+		{"  └─IndexFullScan_11", "9990.00", "cop[tikv]", "table:t1, index:a(a)", "keep order:false, stats:pseudo"},
+		{"Hints In Use:", "", "", "", ""},
+		{"hash_join(t1, t2)", "", "", "", "source:comment, matched:true, applied:true"},
+	}
+	res, err := ExplainDigest(columns, rows)
+	require.NoError(t, err)
+	require.Equal(t, ExplainDigestVersion, res.Version)
+	require.Equal(t, "0\tHashJoin\troot\t\n"+
+		"1\tTableReader(Build)\troot\t\n"+
+		"2\tSelection\tcop[tikv]\t\n"+
+		"3\tTableFullScan\tcop[tikv]\ttable:t2\n"+
+		"1\tIndexReader(Probe)\troot\t\n"+
+		"2\tIndexFullScan\tcop[tikv]\ttable:t1, index:a(a)\n", res.Normalized)
+	// The digest of version 2 must never change.
+	require.Equal(t, "735bd4bb493751bbc42af45efc5885d06870db81ce419752c9c0110a505d86db", res.Digest)
+
+	// The estimated rows, the operator info, the IDs and the extra columns don't affect the digest.
+	columns2 := []string{"id", "estRows", "estCost", "actRows", "task", "access object", "execution info", "operator info", "memory", "disk"}
+	rows2 := make([][]string, 0, 6)
+	for _, row := range rows[:6] {
+		rows2 = append(rows2, []string{row[0], "1.00", "10.00", "1", row[2], row[3], "time:1ms", "", "1 KB", "N/A"})
+	}
+	rows2[0][0] = "HashJoin_108"
+	res2, err := ExplainDigest(columns2, rows2)
+	require.NoError(t, err)
+	require.Equal(t, res, res2)
+
+	// The shape of the plan affects the digest.
+	rows2[3][5] = "table:t3"
+	res2, err = ExplainDigest(columns2, rows2)
+	require.NoError(t, err)
+	require.NotEqual(t, res.Digest, res2.Digest)
+
+	// The labels with spaces of a recursive CTE are kept, while the IDs are removed.
+	cteRows := [][]string{
+		{"CTEFullScan_17", "2.00", "root", "CTE:cte", "data:CTE_0"},
+		{"CTE_0", "2.00", "root", "", "Recursive CTE"},
+		{"├─Projection_12(Seed Part)", "1.00", "root", "", "1->Column#2"},
+		{"│ └─TableDual_13", "1.00", "root", "", "rows:1"},
+		{"└─Projection_14(Recursive Part)", "0.80", "root", "", "cast(plus(Column#3, 1), bigint BINARY)->Column#5"},
+		{"  └─Selection_15", "0.80", "root", "", "lt(Column#3, 5)"},
+		{"    └─CTETable_16", "1.00", "root", "", "Scan on CTE_0"},
+	}
+	res3, err := ExplainDigest(columns, cteRows)
+	require.NoError(t, err)
+	require.Equal(t, "0\tCTEFullScan\troot\tCTE:cte\n"+
+		"0\tCTE\troot\t\n"+
+		"1\tProjection(Seed Part)\troot\t\n"+
+		"2\tTableDual\troot\t\n"+
+		"1\tProjection(Recursive Part)\troot\t\n"+
+		"2\tSelection\troot\t\n"+
+		"3\tCTETable\troot\t\n", res3.Normalized)
+	cteRows[2][0] = "├─Projection_102(Seed Part)"
+	res4, err := ExplainDigest(columns, cteRows)
+	require.NoError(t, err)
+	require.Equal(t, res3, res4)
+
+	_, err = ExplainDigest([]string{"plan"}, [][]string{{"HashJoin"}})
+	require.ErrorContains(t, err, "not the result of EXPLAIN")
+	_, err = ExplainDigest(columns, [][]string{{"HashJoin_8"}})
+	require.ErrorContains(t, err, "row 0 has 1 columns")
+	_, err = ExplainDigest(columns, nil)
+	require.ErrorContains(t, err, "has no operator")
+}
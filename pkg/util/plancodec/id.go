@@ -139,6 +139,10 @@ const (
 	TypeSequence = "Sequence"
 	// TypeScalarSubQuery is the type of ScalarQuery
 	TypeScalarSubQuery = "ScalarSubQuery"
+	// TypeJSONTable is the type of JSON_TABLE.
+	TypeJSONTable = "JSONTable"
+	// TypeLooseIndexScan is the type of LooseIndexScan.
+	TypeLooseIndexScan = "LooseIndexScan"
 )
 
 // plan id.
@@ -204,6 +208,8 @@ const (
 	typeExpandID              int = 58
 	typeImportIntoID          int = 59
 	TypeScalarSubQueryID      int = 60
+	typeJSONTableID           int = 61
+	typeLooseIndexScanID      int = 62
 )
 
 // TypeStringToPhysicalID converts the plan type string to plan id.
@@ -329,6 +335,10 @@ func TypeStringToPhysicalID(tp string) int {
 		return typeImportIntoID
 	case TypeScalarSubQuery:
 		return TypeScalarSubQueryID
+	case TypeJSONTable:
+		return typeJSONTableID
+	case TypeLooseIndexScan:
+		return typeLooseIndexScanID
 	}
 	// Should never reach here.
 	return 0
@@ -457,6 +467,10 @@ func PhysicalIDToTypeString(id int) string {
 		return TypeImportInto
 	case TypeScalarSubQueryID:
 		return TypeScalarSubQuery
+	case typeJSONTableID:
+		return TypeJSONTable
+	case typeLooseIndexScanID:
+		return TypeLooseIndexScan
 	}
 
 	// Should never reach here.
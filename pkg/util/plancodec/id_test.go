@@ -82,6 +82,8 @@ func TestPlanIDChanged(t *testing.T) {
 		{typeShuffleID, 54},
 		{typeShuffleReceiverID, 55},
 		{typeImportIntoID, 59},
+		{typeJSONTableID, 61},
+		{typeLooseIndexScanID, 62},
 	}
 
 	for _, testcase := range testCases {
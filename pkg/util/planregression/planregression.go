@@ -0,0 +1,413 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planregression
+
+import (
+	"container/list"
+	"math/bits"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/metrics"
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/parser/mysql"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/pingcap/tidb/pkg/types"
+)
+
+const (
+	// maxDigests is the max number of SQL digests tracked by the detector, the least recently executed
+	// digest is evicted when it's exceeded.
+	maxDigests = 1024
+	// maxPlansPerDigest is the max number of plans tracked for a SQL digest.
+	maxPlansPerDigest = 8
+	// maxEvents is the max number of the recent events kept in memory.
+	maxEvents = 100
+	// latencyBuckets is the number of buckets of the latency histogram, the i-th bucket counts the latencies
+	// in [2^(i-1), 2^i) microseconds.
+	latencyBuckets = 40
+)
+
+// The status of the shadow binding of an event.
+const (
+	// ShadowBindingPending means the shadow binding is going to be created.
+	ShadowBindingPending = "pending"
+	// ShadowBindingCreated means the shadow binding has been created.
+	ShadowBindingCreated = "created"
+	// ShadowBindingSkipped means the shadow binding is not created since the statement has a binding already.
+	ShadowBindingSkipped = "skipped"
+	// ShadowBindingFailed means the shadow binding fails to be created.
+	ShadowBindingFailed = "failed"
+)
+
+// GlobalDetector is the plan regression detector of the tidb instance.
+var GlobalDetector = newDetector()
+
+// StmtExecInfo is the information of a statement execution which is used to detect the plan regressions.
+type StmtExecInfo struct {
+	SQLDigest     string
+	NormalizedSQL string
+	PlanDigest    string
+	// OriginalSQL, Schema, Charset and Collation are used to create the shadow binding for the plan.
+	OriginalSQL string
+	Schema      string
+	Charset     string
+	Collation   string
+	// PlanHintGen generates the hints to reproduce the plan, it's only called when a plan is seen for the first time.
+	PlanHintGen func() string
+	Latency     time.Duration
+	// User is the user who executes the statement, it's used to check the privilege when reading the events.
+	User string
+}
+
+// latencyStats is the latency distribution of a plan.
+type latencyStats struct {
+	count      int64
+	sum        time.Duration
+	max        time.Duration
+	histogram  [latencyBuckets]int64
+	prevPlan   string
+	regression bool
+}
+
+func (s *latencyStats) add(latency time.Duration) {
+	s.count++
+	s.sum += latency
+	if latency > s.max {
+		s.max = latency
+	}
+	s.histogram[min(bits.Len64(uint64(latency.Microseconds())), latencyBuckets-1)]++
+}
+
+func (s *latencyStats) avg() time.Duration {
+	if s.count == 0 {
+		return 0
+	}
+	return s.sum / time.Duration(s.count)
+}
+
+// p90 returns the upper bound of the bucket which the 90th percentile latency falls into.
+func (s *latencyStats) p90() time.Duration {
+	target := (s.count*9 + 9) / 10
+	var cnt int64
+	for i, c := range s.histogram {
+		cnt += c
+		if cnt >= target {
+			return min(time.Duration(uint64(1)<<i)*time.Microsecond, s.max)
+		}
+	}
+	return s.max
+}
+
+// planRecord is the tracked information of a plan of a SQL digest.
+type planRecord struct {
+	latencyStats
+	planDigest  string
+	originalSQL string
+	schema      string
+	charset     string
+	collation   string
+	planHint    string
+	// lastExecSeq is the sequence of the last execution, it's used to find the least recently executed plan.
+	lastExecSeq uint64
+}
+
+// digestRecord is the tracked plans of a SQL digest.
+type digestRecord struct {
+	sqlDigest     string
+	normalizedSQL string
+	lastPlan      string
+	plans         map[string]*planRecord
+	// authUsers is the users who have executed the statement.
+	authUsers map[string]struct{}
+}
+
+// Event is raised when the plan of a SQL digest changes and the latency degrades.
+type Event struct {
+	Time          time.Time
+	SQLDigest     string
+	NormalizedSQL string
+	Schema        string
+	OldPlanDigest string
+	NewPlanDigest string
+	OldExecCount  int64
+	NewExecCount  int64
+	OldAvgLatency time.Duration
+	NewAvgLatency time.Duration
+	OldP90Latency time.Duration
+	NewP90Latency time.Duration
+	OldMaxLatency time.Duration
+	NewMaxLatency time.Duration
+	// ShadowBinding is the status of the shadow binding for the old plan, it's empty if the shadow binding
+	// is not enabled when the event is raised.
+	ShadowBinding string
+	// AuthUsers is the users who have executed the statement when the event is raised. Like the statements
+	// summary, a user without the PROCESS privilege can only read the events of the statements executed by itself.
+	AuthUsers map[string]struct{}
+}
+
+// ShadowBindingTask is the task to create a disabled binding for the old plan of a plan regression.
+type ShadowBindingTask struct {
+	SQLDigest   string
+	OriginalSQL string
+	Schema      string
+	Charset     string
+	Collation   string
+	PlanHint    string
+
+	detector *Detector
+	event    *Event
+}
+
+// Finish updates the shadow binding status of the event.
+func (t *ShadowBindingTask) Finish(status string) {
+	t.detector.mu.Lock()
+	t.event.ShadowBinding = status
+	t.detector.mu.Unlock()
+	if status == ShadowBindingCreated {
+		metrics.PlanRegressionCounter.WithLabelValues("shadow_binding").Inc()
+	}
+}
+
+// Detector tracks the plans and their latencies per SQL digest, and raises an event when the plan of a digest
+// changes and the average latency of the new plan exceeds the one of the old plan by
+// tidb_plan_regression_latency_ratio times.
+type Detector struct {
+	mu sync.Mutex
+	// digests is ordered by the last execution time, the front is the most recently executed one.
+	digests     *list.List
+	digestIndex map[string]*list.Element
+	events      []*Event
+	tasks       []*ShadowBindingTask
+	execSeq     uint64
+}
+
+func newDetector() *Detector {
+	return &Detector{
+		digests:     list.New(),
+		digestIndex: make(map[string]*list.Element),
+	}
+}
+
+// Enabled returns whether the plan regression detection is enabled.
+func Enabled() bool {
+	return variable.EnablePlanRegressionDetection.Load()
+}
+
+// Add records a statement execution and checks whether its plan regresses.
+func (d *Detector) Add(info *StmtExecInfo) {
+	if info.SQLDigest == "" || info.PlanDigest == "" {
+		return
+	}
+	// Generate the plan hint outside the lock since it may be slow. It's only needed for the plans seen for the
+	// first time, and it doesn't matter if the plan is recorded by another session concurrently.
+	var planHint string
+	if info.PlanHintGen != nil && !d.hasPlan(info.SQLDigest, info.PlanDigest) {
+		planHint = info.PlanHintGen()
+	}
+	now := time.Now()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	var record *digestRecord
+	if elem, ok := d.digestIndex[info.SQLDigest]; ok {
+		d.digests.MoveToFront(elem)
+		record = elem.Value.(*digestRecord)
+	} else {
+		record = &digestRecord{
+			sqlDigest:     info.SQLDigest,
+			normalizedSQL: info.NormalizedSQL,
+			plans:         make(map[string]*planRecord, 1),
+			authUsers:     make(map[string]struct{}, 1),
+		}
+		d.digestIndex[info.SQLDigest] = d.digests.PushFront(record)
+		if d.digests.Len() > maxDigests {
+			oldest := d.digests.Remove(d.digests.Back()).(*digestRecord)
+			delete(d.digestIndex, oldest.sqlDigest)
+		}
+	}
+	plan, ok := record.plans[info.PlanDigest]
+	if !ok {
+		if len(record.plans) >= maxPlansPerDigest {
+			record.evictPlan()
+		}
+		plan = &planRecord{
+			planDigest:  info.PlanDigest,
+			originalSQL: info.OriginalSQL,
+			schema:      info.Schema,
+			charset:     info.Charset,
+			collation:   info.Collation,
+			planHint:    planHint,
+		}
+		if record.lastPlan != info.PlanDigest {
+			plan.prevPlan = record.lastPlan
+		}
+		record.plans[info.PlanDigest] = plan
+	}
+	record.lastPlan = info.PlanDigest
+	if info.User != "" {
+		record.authUsers[info.User] = struct{}{}
+	}
+	d.execSeq++
+	plan.lastExecSeq = d.execSeq
+	plan.add(info.Latency)
+	d.checkRegression(record, plan, now)
+}
+
+func (d *Detector) hasPlan(sqlDigest, planDigest string) bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	elem, ok := d.digestIndex[sqlDigest]
+	if !ok {
+		return false
+	}
+	_, ok = elem.Value.(*digestRecord).plans[planDigest]
+	return ok
+}
+
+// evictPlan evicts the least recently executed plan of the digest.
+func (r *digestRecord) evictPlan() {
+	var oldest *planRecord
+	for _, plan := range r.plans {
+		if oldest == nil || plan.lastExecSeq < oldest.lastExecSeq {
+			oldest = plan
+		}
+	}
+	delete(r.plans, oldest.planDigest)
+}
+
+// checkRegression raises an event if the plan is slower than the previous plan of the digest.
+// Each plan raises the event at most once.
+func (d *Detector) checkRegression(record *digestRecord, plan *planRecord, now time.Time) {
+	if plan.regression || plan.prevPlan == "" {
+		return
+	}
+	prev, ok := record.plans[plan.prevPlan]
+	if !ok {
+		return
+	}
+	minExecCount := variable.PlanRegressionMinExecCount.Load()
+	if plan.count < minExecCount || prev.count < minExecCount {
+		return
+	}
+	if float64(plan.avg()) <= float64(prev.avg())*variable.PlanRegressionLatencyRatio.Load() {
+		return
+	}
+	plan.regression = true
+	event := &Event{
+		Time:          now,
+		SQLDigest:     record.sqlDigest,
+		NormalizedSQL: record.normalizedSQL,
+		Schema:        plan.schema,
+		OldPlanDigest: prev.planDigest,
+		NewPlanDigest: plan.planDigest,
+		OldExecCount:  prev.count,
+		NewExecCount:  plan.count,
+		OldAvgLatency: prev.avg(),
+		NewAvgLatency: plan.avg(),
+		OldP90Latency: prev.p90(),
+		NewP90Latency: plan.p90(),
+		OldMaxLatency: prev.max,
+		NewMaxLatency: plan.max,
+		AuthUsers:     make(map[string]struct{}, len(record.authUsers)),
+	}
+	for user := range record.authUsers {
+		event.AuthUsers[user] = struct{}{}
+	}
+	if len(d.events) >= maxEvents {
+		d.events = d.events[1:]
+	}
+	d.events = append(d.events, event)
+	metrics.PlanRegressionCounter.WithLabelValues("event").Inc()
+
+	if variable.EnablePlanRegressionShadowBinding.Load() && prev.planHint != "" {
+		event.ShadowBinding = ShadowBindingPending
+		d.tasks = append(d.tasks, &ShadowBindingTask{
+			SQLDigest:   record.sqlDigest,
+			OriginalSQL: prev.originalSQL,
+			Schema:      prev.schema,
+			Charset:     prev.charset,
+			Collation:   prev.collation,
+			PlanHint:    prev.planHint,
+			detector:    d,
+			event:       event,
+		})
+	}
+}
+
+// TakeShadowBindingTasks returns the pending shadow binding tasks and removes them from the detector.
+func (d *Detector) TakeShadowBindingTasks() []*ShadowBindingTask {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	tasks := d.tasks
+	d.tasks = nil
+	return tasks
+}
+
+// Events returns the recent events.
+func (d *Detector) Events() []Event {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	events := make([]Event, 0, len(d.events))
+	for _, event := range d.events {
+		events = append(events, *event)
+	}
+	return events
+}
+
+// GetRows returns the rows of the recent events for the PLAN_REGRESSION_EVENTS table. If user is not nil and it
+// doesn't have the PROCESS privilege, only the events of the statements executed by the user are returned.
+func (d *Detector) GetRows(user *auth.UserIdentity, hasProcessPriv bool) [][]types.Datum {
+	events := d.Events()
+	rows := make([][]types.Datum, 0, len(events))
+	for _, event := range events {
+		if user != nil && !hasProcessPriv {
+			if _, ok := event.AuthUsers[user.Username]; !ok {
+				continue
+			}
+		}
+		var shadowBinding types.Datum
+		if event.ShadowBinding != "" {
+			shadowBinding = types.NewStringDatum(event.ShadowBinding)
+		}
+		rows = append(rows, []types.Datum{
+			types.NewTimeDatum(types.NewTime(types.FromGoTime(event.Time), mysql.TypeTimestamp, types.MaxFsp)),
+			types.NewStringDatum(event.SQLDigest),
+			types.NewStringDatum(event.NormalizedSQL),
+			types.NewStringDatum(event.Schema),
+			types.NewStringDatum(event.OldPlanDigest),
+			types.NewStringDatum(event.NewPlanDigest),
+			types.NewIntDatum(event.OldExecCount),
+			types.NewIntDatum(event.NewExecCount),
+			types.NewIntDatum(int64(event.OldAvgLatency)),
+			types.NewIntDatum(int64(event.NewAvgLatency)),
+			types.NewIntDatum(int64(event.OldP90Latency)),
+			types.NewIntDatum(int64(event.NewP90Latency)),
+			types.NewIntDatum(int64(event.OldMaxLatency)),
+			types.NewIntDatum(int64(event.NewMaxLatency)),
+			shadowBinding,
+		})
+	}
+	return rows
+}
+
+// Clear removes all the tracked statements and events.
+func (d *Detector) Clear() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.digests.Init()
+	d.digestIndex = make(map[string]*list.Element)
+	d.events = nil
+	d.tasks = nil
+}
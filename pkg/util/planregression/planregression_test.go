@@ -0,0 +1,120 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package planregression
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/auth"
+	"github.com/pingcap/tidb/pkg/sessionctx/variable"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDetectPlanRegression(t *testing.T) {
+	d := newDetector()
+	hintGenCalls := 0
+	add := func(planDigest string, latency time.Duration, times int) {
+		for i := 0; i < times; i++ {
+			d.Add(&StmtExecInfo{
+				SQLDigest:     "sql",
+				NormalizedSQL: "select * from t where a = ?",
+				PlanDigest:    planDigest,
+				OriginalSQL:   "select * from t where a = 1",
+				Schema:        "test",
+				PlanHintGen: func() string {
+					hintGenCalls++
+					return "use_index(@`sel_1` `test`.`t` `" + planDigest + "`)"
+				},
+				Latency: latency,
+				User:    "u1",
+			})
+		}
+	}
+
+	minExecCount := variable.PlanRegressionMinExecCount.Load()
+	add("p1", time.Millisecond, int(minExecCount))
+	require.Equal(t, 1, hintGenCalls)
+	// No event is raised before the new plan is executed enough times.
+	add("p2", 10*time.Millisecond, int(minExecCount)-1)
+	require.Empty(t, d.Events())
+	add("p2", 10*time.Millisecond, 1)
+	require.Equal(t, 2, hintGenCalls)
+	events := d.Events()
+	require.Len(t, events, 1)
+	require.Equal(t, "sql", events[0].SQLDigest)
+	require.Equal(t, "p1", events[0].OldPlanDigest)
+	require.Equal(t, "p2", events[0].NewPlanDigest)
+	require.Equal(t, minExecCount, events[0].OldExecCount)
+	require.Equal(t, minExecCount, events[0].NewExecCount)
+	require.Equal(t, time.Millisecond, events[0].OldAvgLatency)
+	require.Equal(t, 10*time.Millisecond, events[0].NewAvgLatency)
+	require.Equal(t, time.Millisecond, events[0].OldP90Latency)
+	require.Equal(t, 10*time.Millisecond, events[0].NewP90Latency)
+	require.Equal(t, "", events[0].ShadowBinding)
+	require.Empty(t, d.TakeShadowBindingTasks())
+
+	// The event is raised only once for a plan.
+	add("p2", 10*time.Millisecond, 1)
+	require.Len(t, d.Events(), 1)
+
+	// The plan change isn't a regression if the latency doesn't degrade beyond the ratio.
+	add("p3", 15*time.Millisecond, int(minExecCount))
+	require.Len(t, d.Events(), 1)
+
+	// A shadow binding task is created for the old plan if it's enabled.
+	variable.EnablePlanRegressionShadowBinding.Store(true)
+	defer variable.EnablePlanRegressionShadowBinding.Store(variable.DefTiDBEnablePlanRegressionShadowBinding)
+	add("p4", time.Second, int(minExecCount))
+	events = d.Events()
+	require.Len(t, events, 2)
+	require.Equal(t, "p3", events[1].OldPlanDigest)
+	require.Equal(t, "p4", events[1].NewPlanDigest)
+	require.Equal(t, ShadowBindingPending, events[1].ShadowBinding)
+	tasks := d.TakeShadowBindingTasks()
+	require.Len(t, tasks, 1)
+	require.Equal(t, "select * from t where a = 1", tasks[0].OriginalSQL)
+	require.Equal(t, "use_index(@`sel_1` `test`.`t` `p3`)", tasks[0].PlanHint)
+	require.Empty(t, d.TakeShadowBindingTasks())
+	tasks[0].Finish(ShadowBindingCreated)
+	require.Equal(t, ShadowBindingCreated, d.Events()[1].ShadowBinding)
+	require.Len(t, d.GetRows(nil, false), 2)
+	// A user without the PROCESS privilege can only read the events of its own statements.
+	require.Len(t, d.GetRows(&auth.UserIdentity{Username: "u1"}, false), 2)
+	require.Empty(t, d.GetRows(&auth.UserIdentity{Username: "u2"}, false))
+	require.Len(t, d.GetRows(&auth.UserIdentity{Username: "u2"}, true), 2)
+
+	d.Clear()
+	require.Empty(t, d.Events())
+}
+
+func TestPlanRegressionDetectorLimits(t *testing.T) {
+	d := newDetector()
+	for i := 0; i < maxDigests+10; i++ {
+		d.Add(&StmtExecInfo{SQLDigest: fmt.Sprintf("sql%d", i), PlanDigest: "p", Latency: time.Millisecond})
+	}
+	require.Equal(t, maxDigests, d.digests.Len())
+	require.Len(t, d.digestIndex, maxDigests)
+	require.False(t, d.hasPlan("sql0", "p"))
+	require.True(t, d.hasPlan(fmt.Sprintf("sql%d", maxDigests+9), "p"))
+
+	for i := 0; i < maxPlansPerDigest+2; i++ {
+		d.Add(&StmtExecInfo{SQLDigest: "sql", PlanDigest: fmt.Sprintf("p%d", i), Latency: time.Millisecond})
+	}
+	require.Len(t, d.digestIndex["sql"].Value.(*digestRecord).plans, maxPlansPerDigest)
+	require.False(t, d.hasPlan("sql", "p0"))
+	require.True(t, d.hasPlan("sql", fmt.Sprintf("p%d", maxPlansPerDigest+1)))
+}
@@ -46,15 +46,21 @@ func EncodeResourceGroupTag(sqlDigest, planDigest *parser.Digest, label tipb.Res
 
 // DecodeResourceGroupTag decodes a resource group tag and return the sql digest.
 func DecodeResourceGroupTag(data []byte) (sqlDigest []byte, err error) {
+	sqlDigest, _, err = DecodeResourceGroupTagDigests(data)
+	return sqlDigest, err
+}
+
+// DecodeResourceGroupTagDigests decodes a resource group tag and return the sql digest and the plan digest.
+func DecodeResourceGroupTagDigests(data []byte) (sqlDigest, planDigest []byte, err error) {
 	if len(data) == 0 {
-		return nil, nil
+		return nil, nil, nil
 	}
 	tag := &tipb.ResourceGroupTag{}
 	err = tag.Unmarshal(data)
 	if err != nil {
-		return nil, errors.Errorf("invalid resource group tag data %x", data)
+		return nil, nil, errors.Errorf("invalid resource group tag data %x", data)
 	}
-	return tag.SqlDigest, nil
+	return tag.SqlDigest, tag.PlanDigest, nil
 }
 
 // GetResourceGroupLabelByKey determines the tipb.ResourceGroupTagLabel of key.
@@ -57,6 +57,16 @@ func TestResourceGroupTagEncoding(t *testing.T) {
 	decodedSQLDigest, err = DecodeResourceGroupTag(tag)
 	require.NoError(t, err)
 	require.Equal(t, sqlDigest.Bytes(), decodedSQLDigest)
+
+	planDigest := parser.NewDigest(genRandHex(64))
+	tag = EncodeResourceGroupTag(sqlDigest, planDigest, tipb.ResourceGroupTagLabel_ResourceGroupTagLabelUnknown)
+	decodedSQLDigest, decodedPlanDigest, err := DecodeResourceGroupTagDigests(tag)
+	require.NoError(t, err)
+	require.Equal(t, sqlDigest.Bytes(), decodedSQLDigest)
+	require.Equal(t, planDigest.Bytes(), decodedPlanDigest)
+
+	_, _, err = DecodeResourceGroupTagDigests([]byte{0xff})
+	require.Error(t, err)
 }
 
 func TestResourceGroupTagEncodingPB(t *testing.T) {
@@ -0,0 +1,271 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowchange
+
+import (
+	"strings"
+	"unsafe"
+
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/memory"
+)
+
+const (
+	// maxTxnMemUsage is the max memory of the row changes buffered for a transaction. If it's exceeded, the row
+	// changes of the transaction are discarded and the transaction is reported as truncated.
+	maxTxnMemUsage = 128 << 20
+	rowSize        = int64(unsafe.Sizeof(Row{}))
+)
+
+// Tp is the type of the row change.
+type Tp uint8
+
+const (
+	// Insert means a row is inserted.
+	Insert Tp = iota
+	// Update means a row is updated.
+	Update
+	// Delete means a row is deleted.
+	Delete
+)
+
+// String implements the fmt.Stringer interface.
+func (tp Tp) String() string {
+	switch tp {
+	case Insert:
+		return "insert"
+	case Update:
+		return "update"
+	case Delete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// Table is the table whose row changes are subscribed.
+type Table struct {
+	ID   int64
+	DB   string
+	Name string
+	// Columns are the names of the public columns, the datums of the changed rows are in the same order.
+	Columns []string
+}
+
+// Row is a row changed by a statement.
+type Row struct {
+	Table *Table
+	Tp    Tp
+	// OldRow is the row before the change, it's nil for Insert.
+	OldRow []types.Datum
+	// NewRow is the row after the change, it's nil for Delete.
+	NewRow []types.Datum
+}
+
+// Stmt is the row changes made by a statement, with the metadata of the statement attached.
+type Stmt struct {
+	NormalizedSQL string
+	SQLDigest     string
+	PlanDigest    string
+	// Hints are the optimizer hints specified in the statement.
+	Hints string
+	// BindSQL and BindHints are the binding used by the statement, they're empty if no binding is used.
+	BindSQL   string
+	BindHints string
+	Rows      []Row
+
+	memUsage int64
+}
+
+// SubscribeFunc returns the database name of the table and whether the row changes of the table are subscribed.
+type SubscribeFunc func(tblInfo *model.TableInfo) (db string, ok bool)
+
+// Buffer collects the row changes of the subscribed tables made by a transaction. Like the binlog mutations, the
+// row changes of the running statement are staged until the statement is committed, and the staged changes are
+// discarded if the statement is rolled back.
+type Buffer struct {
+	subscribe SubscribeFunc
+	// tables caches the subscribed tables in the transaction, the value is nil if the table is not subscribed.
+	tables       map[int64]*Table
+	stmtRows     []Row
+	stmtMemUsage int64
+	stmts        []*Stmt
+	// savepoints are the numbers of the statements committed before the savepoints.
+	savepoints map[string]int
+
+	// memTracker tracks the memory of the buffered row changes, it's attached to the memory tracker of the running
+	// statement, so the row changes are counted in the memory quota of the statement.
+	memTracker  *memory.Tracker
+	maxMemUsage int64
+	// truncated indicates the row changes of the transaction are discarded because they exceed maxMemUsage.
+	truncated bool
+}
+
+// NewBuffer creates a new Buffer.
+func NewBuffer(subscribe SubscribeFunc) *Buffer {
+	return &Buffer{
+		subscribe:   subscribe,
+		tables:      make(map[int64]*Table),
+		memTracker:  memory.NewTracker(memory.LabelForRowChanges, -1),
+		maxMemUsage: maxTxnMemUsage,
+	}
+}
+
+// AttachMemTracker attaches the memory tracker of the buffer to the memory tracker of the running statement.
+func (b *Buffer) AttachMemTracker(stmtMemTracker *memory.Tracker) {
+	if b == nil {
+		return
+	}
+	b.memTracker.AttachTo(stmtMemTracker)
+}
+
+func (b *Buffer) subscribedTable(tblInfo *model.TableInfo) *Table {
+	if tbl, ok := b.tables[tblInfo.ID]; ok {
+		return tbl
+	}
+	db, ok := b.subscribe(tblInfo)
+	if !ok {
+		b.tables[tblInfo.ID] = nil
+		return nil
+	}
+	tbl := &Table{ID: tblInfo.ID, DB: db, Name: tblInfo.Name.O}
+	for _, col := range tblInfo.Cols() {
+		tbl.Columns = append(tbl.Columns, col.Name.O)
+	}
+	b.tables[tblInfo.ID] = tbl
+	return tbl
+}
+
+// AddRow stages a row change of the running statement if the table is subscribed. The rows are the datums of the
+// columns of the table ordered by the column offsets. If the buffered row changes exceed the memory limit, all the
+// row changes of the transaction are discarded, and the transaction is reported as truncated.
+func (b *Buffer) AddRow(tblInfo *model.TableInfo, tp Tp, oldRow, newRow []types.Datum) {
+	if b == nil || b.truncated {
+		return
+	}
+	tbl := b.subscribedTable(tblInfo)
+	if tbl == nil {
+		return
+	}
+	row := Row{
+		Table:  tbl,
+		Tp:     tp,
+		OldRow: cloneRow(oldRow, len(tbl.Columns)),
+		NewRow: cloneRow(newRow, len(tbl.Columns)),
+	}
+	memUsage := rowSize + types.EstimatedMemUsage(row.OldRow, 1) + types.EstimatedMemUsage(row.NewRow, 1)
+	if b.memTracker.BytesConsumed()+memUsage > b.maxMemUsage {
+		b.discard()
+		b.truncated = true
+		return
+	}
+	b.stmtRows = append(b.stmtRows, row)
+	b.stmtMemUsage += memUsage
+	b.memTracker.Consume(memUsage)
+}
+
+func cloneRow(row []types.Datum, numCols int) []types.Datum {
+	if row == nil {
+		return nil
+	}
+	// the row may contain the columns which are not public yet.
+	if len(row) > numCols {
+		row = row[:numCols]
+	}
+	return types.CloneRow(row)
+}
+
+// StmtCommit moves the staged row changes to the transaction, the metadata of the statement is attached to them.
+// The stmt is ignored if there are no staged row changes.
+func (b *Buffer) StmtCommit(stmt *Stmt) {
+	if b == nil || len(b.stmtRows) == 0 {
+		return
+	}
+	stmt.Rows = b.stmtRows
+	stmt.memUsage = b.stmtMemUsage
+	b.stmts = append(b.stmts, stmt)
+	b.stmtRows = nil
+	b.stmtMemUsage = 0
+}
+
+// HasStmtRows returns whether the running statement has staged row changes.
+func (b *Buffer) HasStmtRows() bool {
+	return b != nil && len(b.stmtRows) > 0
+}
+
+// StmtRollback discards the staged row changes.
+func (b *Buffer) StmtRollback() {
+	if b == nil {
+		return
+	}
+	b.memTracker.Consume(-b.stmtMemUsage)
+	b.stmtRows = nil
+	b.stmtMemUsage = 0
+}
+
+// TakeTxnChanges returns the row changes of the transaction and resets the buffer for the next transaction.
+// If truncated is true, the row changes of the transaction are discarded because they exceed the memory limit.
+func (b *Buffer) TakeTxnChanges() (stmts []*Stmt, truncated bool) {
+	if b == nil {
+		return nil, false
+	}
+	stmts, truncated = b.stmts, b.truncated
+	b.Reset()
+	return stmts, truncated
+}
+
+// AddSavepoint records the row changes committed before the savepoint.
+func (b *Buffer) AddSavepoint(name string) {
+	if b == nil {
+		return
+	}
+	if b.savepoints == nil {
+		b.savepoints = make(map[string]int)
+	}
+	b.savepoints[strings.ToLower(name)] = len(b.stmts)
+}
+
+// RollbackToSavepoint discards the row changes committed after the savepoint.
+func (b *Buffer) RollbackToSavepoint(name string) {
+	if b == nil {
+		return
+	}
+	if n, ok := b.savepoints[strings.ToLower(name)]; ok && n < len(b.stmts) {
+		for _, stmt := range b.stmts[n:] {
+			b.memTracker.Consume(-stmt.memUsage)
+		}
+		b.stmts = b.stmts[:n]
+	}
+}
+
+// Reset discards all the row changes of the transaction.
+func (b *Buffer) Reset() {
+	if b == nil {
+		return
+	}
+	b.discard()
+	b.truncated = false
+	if len(b.tables) > 0 {
+		b.tables = make(map[int64]*Table)
+	}
+}
+
+func (b *Buffer) discard() {
+	b.stmtRows = nil
+	b.stmtMemUsage = 0
+	b.stmts = nil
+	b.savepoints = nil
+	b.memTracker.ReplaceBytesUsed(0)
+}
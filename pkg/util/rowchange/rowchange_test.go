@@ -0,0 +1,84 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rowchange
+
+import (
+	"testing"
+
+	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/types"
+	"github.com/pingcap/tidb/pkg/util/memory"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBufferMemTracker(t *testing.T) {
+	tblInfo := &model.TableInfo{
+		ID:      1,
+		Name:    model.NewCIStr("t"),
+		Columns: []*model.ColumnInfo{{Name: model.NewCIStr("a"), State: model.StatePublic}},
+	}
+	b := NewBuffer(func(*model.TableInfo) (string, bool) { return "test", true })
+	stmtTracker := memory.NewTracker(memory.LabelForSQLText, -1)
+	b.AttachMemTracker(stmtTracker)
+	row := []types.Datum{types.NewStringDatum("aaaaaaaaaa")}
+
+	// the row changes are counted in the memory of the statement, and released when they're discarded.
+	b.AddRow(tblInfo, Insert, nil, row)
+	rowMemUsage := stmtTracker.BytesConsumed()
+	require.Positive(t, rowMemUsage)
+	b.StmtRollback()
+	require.Zero(t, stmtTracker.BytesConsumed())
+
+	b.AddRow(tblInfo, Insert, nil, row)
+	b.StmtCommit(&Stmt{})
+	b.AddSavepoint("s1")
+	b.AddRow(tblInfo, Update, row, row)
+	b.StmtCommit(&Stmt{})
+	require.Greater(t, stmtTracker.BytesConsumed(), 2*rowMemUsage)
+
+	// the buffer is attached to the tracker of the next statement.
+	nextStmtTracker := memory.NewTracker(memory.LabelForSQLText, -1)
+	b.AttachMemTracker(nextStmtTracker)
+	require.Zero(t, stmtTracker.BytesConsumed())
+	b.RollbackToSavepoint("s1")
+	require.Equal(t, rowMemUsage, nextStmtTracker.BytesConsumed())
+	stmts, truncated := b.TakeTxnChanges()
+	require.Len(t, stmts, 1)
+	require.False(t, truncated)
+	require.Zero(t, nextStmtTracker.BytesConsumed())
+
+	// the row changes of the transaction are discarded if they exceed the limit.
+	b.maxMemUsage = 3 * rowMemUsage
+	for i := 0; i < 3; i++ {
+		b.AddRow(tblInfo, Insert, nil, row)
+		b.StmtCommit(&Stmt{})
+	}
+	require.Equal(t, 3*rowMemUsage, nextStmtTracker.BytesConsumed())
+	b.AddRow(tblInfo, Insert, nil, row)
+	require.False(t, b.HasStmtRows())
+	require.Zero(t, nextStmtTracker.BytesConsumed())
+	b.AddRow(tblInfo, Insert, nil, row)
+	b.StmtCommit(&Stmt{})
+	stmts, truncated = b.TakeTxnChanges()
+	require.Empty(t, stmts)
+	require.True(t, truncated)
+
+	// the next transaction is not affected.
+	b.AddRow(tblInfo, Insert, nil, row)
+	b.StmtCommit(&Stmt{})
+	stmts, truncated = b.TakeTxnChanges()
+	require.Len(t, stmts, 1)
+	require.False(t, truncated)
+}
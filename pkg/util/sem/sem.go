@@ -62,6 +62,8 @@ const (
 	tidbGCLeaderDesc      = "tidb_gc_leader_desc"
 	restrictedPriv        = "RESTRICTED_"
 	tidbAuditRetractLog   = "tidb_audit_redact_log" // sysvar installed by a plugin
+	auditLogFile          = "audit_log_file"        // sysvar installed by the audit_log plugin
+	auditLogSinkURL       = "audit_log_sink_url"    // sysvar installed by the audit_log plugin
 )
 
 var (
@@ -158,7 +160,9 @@ func IsInvisibleSysVar(varNameInLower string) bool {
 		variable.TiDBRestrictedReadOnly,
 		variable.TiDBTopSQLMaxTimeSeriesCount,
 		variable.TiDBTopSQLMaxMetaCount,
-		tidbAuditRetractLog:
+		tidbAuditRetractLog,
+		auditLogFile,
+		auditLogSinkURL:
 		return true
 	}
 	return false
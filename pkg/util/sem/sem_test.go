@@ -104,4 +104,6 @@ func TestIsInvisibleSysVar(t *testing.T) {
 	assert.True(IsInvisibleSysVar(variable.TiDBTopSQLMaxTimeSeriesCount))
 	assert.True(IsInvisibleSysVar(variable.TiDBTopSQLMaxTimeSeriesCount))
 	assert.True(IsInvisibleSysVar(tidbAuditRetractLog))
+	assert.True(IsInvisibleSysVar(auditLogFile))
+	assert.True(IsInvisibleSysVar(auditLogSinkURL))
 }
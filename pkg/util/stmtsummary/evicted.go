@@ -385,4 +385,5 @@ func addInfo(addTo *stmtSummaryByDigestElement, addWith *stmtSummaryByDigestElem
 	addTo.StmtRUSummary.Merge(&addWith.StmtRUSummary)
 	// resourceGroupName might not be inited because when it is a evicted item.
 	addTo.resourceGroupName = addWith.resourceGroupName
+	addTo.appName = addWith.appName
 }
@@ -307,6 +307,9 @@ const (
 	PlanCacheUnqualifiedStr           = "PLAN_CACHE_UNQUALIFIED"
 	LastPlanCacheUnqualifiedStr       = "LAST_PLAN_CACHE_UNQUALIFIED_REASON"
 	PlanInBindingStr                  = "PLAN_IN_BINDING"
+	PlanBindingHitsStr                = "PLAN_BINDING_HITS"
+	LastBindingSQLStr                 = "LAST_BINDING_SQL"
+	LastAppliedHintsStr               = "LAST_APPLIED_HINTS"
 	QuerySampleTextStr                = "QUERY_SAMPLE_TEXT"
 	PrevSampleTextStr                 = "PREV_SAMPLE_TEXT"
 	PlanDigestStr                     = "PLAN_DIGEST"
@@ -322,6 +325,7 @@ const (
 	AvgQueuedRcTimeStr                = "AVG_QUEUED_RC_TIME"
 	MaxQueuedRcTimeStr                = "MAX_QUEUED_RC_TIME"
 	ResourceGroupName                 = "RESOURCE_GROUP"
+	AppNameStr                        = "APP_NAME"
 )
 
 type columnValueFactory func(reader *stmtSummaryReader, ssElement *stmtSummaryByDigestElement, ssbd *stmtSummaryByDigest) any
@@ -612,6 +616,15 @@ var columnValueFactoryMap = map[string]columnValueFactory{
 	PlanInBindingStr: func(_ *stmtSummaryReader, ssElement *stmtSummaryByDigestElement, _ *stmtSummaryByDigest) any {
 		return ssElement.planInBinding
 	},
+	PlanBindingHitsStr: func(_ *stmtSummaryReader, ssElement *stmtSummaryByDigestElement, _ *stmtSummaryByDigest) any {
+		return ssElement.planBindingHits
+	},
+	LastBindingSQLStr: func(_ *stmtSummaryReader, ssElement *stmtSummaryByDigestElement, _ *stmtSummaryByDigest) any {
+		return ssElement.lastBindingSQL
+	},
+	LastAppliedHintsStr: func(_ *stmtSummaryReader, ssElement *stmtSummaryByDigestElement, _ *stmtSummaryByDigest) any {
+		return ssElement.lastAppliedHints
+	},
 	QuerySampleTextStr: func(_ *stmtSummaryReader, ssElement *stmtSummaryByDigestElement, _ *stmtSummaryByDigest) any {
 		return ssElement.sampleSQL
 	},
@@ -662,6 +675,9 @@ var columnValueFactoryMap = map[string]columnValueFactory{
 	ResourceGroupName: func(_ *stmtSummaryReader, ssElement *stmtSummaryByDigestElement, _ *stmtSummaryByDigest) any {
 		return ssElement.resourceGroupName
 	},
+	AppNameStr: func(_ *stmtSummaryReader, ssElement *stmtSummaryByDigestElement, _ *stmtSummaryByDigest) any {
+		return ssElement.appName
+	},
 	PlanCacheUnqualifiedStr: func(_ *stmtSummaryReader, ssElement *stmtSummaryByDigestElement, _ *stmtSummaryByDigest) any {
 		return ssElement.planCacheUnqualifiedCount
 	},
@@ -18,6 +18,7 @@ import (
 	"bytes"
 	"cmp"
 	"container/list"
+	"encoding/binary"
 	"fmt"
 	"math"
 	"slices"
@@ -52,6 +53,8 @@ type stmtSummaryByDigestKey struct {
 	hash []byte
 	// `resourceGroupName` is the resource group's name of this statement is bind to.
 	resourceGroupName string
+	// `appName` is the name of the application that executes this statement.
+	appName string
 }
 
 // Hash implements SimpleLRUCache.Key.
@@ -64,7 +67,12 @@ func (key *stmtSummaryByDigestKey) Hash() []byte {
 		key.hash = append(key.hash, hack.Slice(key.schemaName)...)
 		key.hash = append(key.hash, hack.Slice(key.prevDigest)...)
 		key.hash = append(key.hash, hack.Slice(key.planDigest)...)
+		// The names are prefixed by their lengths, otherwise the group "a" with the application "bc" and the group "ab"
+		// with the application "c" would have the same hash.
+		key.hash = binary.BigEndian.AppendUint32(key.hash, uint32(len(key.resourceGroupName)))
 		key.hash = append(key.hash, hack.Slice(key.resourceGroupName)...)
+		key.hash = binary.BigEndian.AppendUint32(key.hash, uint32(len(key.appName)))
+		key.hash = append(key.hash, hack.Slice(key.appName)...)
 	}
 	return key.hash
 }
@@ -217,9 +225,16 @@ type stmtSummaryByDigestElement struct {
 	// request-units
 	resourceGroupName string
 	StmtRUSummary
+	// the `program_name` connection attribute of the sessions
+	appName string
 
 	planCacheUnqualifiedCount int64
 	lastPlanCacheUnqualified  string // the reason why this query is unqualified for the plan cache
+
+	// binding and hint provenance
+	planBindingHits  int64
+	lastBindingSQL   string // the bind SQL of the binding used by the last statement
+	lastAppliedHints string // the hints applied to the last statement, from the binding or the statement itself
 }
 
 // StmtExecInfo records execution information of each statement.
@@ -260,8 +275,14 @@ type StmtExecInfo struct {
 	KeyspaceID        uint32
 	ResourceGroupName string
 	RUDetail          *util.RUDetails
+	// AppName is the `program_name` connection attribute of the session.
+	AppName string
 
 	PlanCacheUnqualified string
+	// BindingSQL is the bind SQL of the binding used by the statement, it's empty if no binding is used.
+	BindingSQL string
+	// AppliedHints is the canonical hint set applied to the statement.
+	AppliedHints string
 }
 
 // newStmtSummaryByDigestMap creates an empty stmtSummaryByDigestMap.
@@ -317,6 +338,7 @@ func (ssMap *stmtSummaryByDigestMap) AddStatement(sei *StmtExecInfo) {
 		prevDigest:        sei.PrevSQLDigest,
 		planDigest:        sei.PlanDigest,
 		resourceGroupName: sei.ResourceGroupName,
+		appName:           sei.AppName,
 	}
 	// Calculate hash value in advance, to reduce the time holding the lock.
 	key.Hash()
@@ -686,6 +708,7 @@ func newStmtSummaryByDigestElement(sei *StmtExecInfo, beginTime int64, intervalS
 		prepared:          sei.Prepared,
 		minResultRows:     math.MaxInt64,
 		resourceGroupName: sei.ResourceGroupName,
+		appName:           sei.AppName,
 	}
 	ssElement.add(sei, intervalSeconds)
 	return ssElement
@@ -874,9 +897,13 @@ func (ssElement *stmtSummaryByDigestElement) add(sei *StmtExecInfo, intervalSeco
 	// SPM
 	if sei.PlanInBinding {
 		ssElement.planInBinding = true
+		ssElement.planBindingHits++
 	} else {
 		ssElement.planInBinding = false
 	}
+	// The binding and the hints are from the same execution, so they're always updated together.
+	ssElement.lastBindingSQL = sei.BindingSQL
+	ssElement.lastAppliedHints = sei.AppliedHints
 
 	// other
 	ssElement.sumAffectedRows += sei.StmtCtx.AffectedRows()
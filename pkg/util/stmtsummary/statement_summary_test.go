@@ -17,6 +17,7 @@ package stmtsummary
 import (
 	"container/list"
 	"fmt"
+	"slices"
 	"strings"
 	"sync"
 	"testing"
@@ -1499,3 +1500,74 @@ func TestAccessPrivilege(t *testing.T) {
 	datums = reader.GetStmtSummaryHistoryRows()
 	require.Len(t, datums, loops)
 }
+
+func TestBindingProvenance(t *testing.T) {
+	ssMap := newStmtSummaryByDigestMap()
+	ssMap.beginTimeForCurInterval = time.Now().Unix() + 60
+
+	columnNames := []string{PlanInBindingStr, PlanBindingHitsStr, LastBindingSQLStr, LastAppliedHintsStr}
+	cols := make([]*model.ColumnInfo, len(columnNames))
+	for i := range columnNames {
+		cols[i] = &model.ColumnInfo{
+			ID:     int64(i),
+			Name:   model.NewCIStr(columnNames[i]),
+			Offset: i,
+		}
+	}
+	reader := NewStmtSummaryReader(nil, true, cols, "", time.UTC)
+	reader.ssMap = ssMap
+
+	stmtExecInfo := generateAnyExecInfo()
+	stmtExecInfo.PlanInBinding = true
+	stmtExecInfo.BindingSQL = "SELECT /*+ use_index(`t` `a`)*/ * FROM `test`.`t` WHERE `a` > 1"
+	stmtExecInfo.AppliedHints = "use_index(`t` `a`)"
+	ssMap.AddStatement(stmtExecInfo)
+	datums := reader.GetStmtSummaryCurrentRows()
+	require.Len(t, datums, 1)
+	match(t, datums[0], 1, 1, stmtExecInfo.BindingSQL, stmtExecInfo.AppliedHints)
+
+	// The binding and the hints are both from the last statement.
+	stmtExecInfo = generateAnyExecInfo()
+	stmtExecInfo.AppliedHints = "hash_join(`t1`)"
+	ssMap.AddStatement(stmtExecInfo)
+	datums = reader.GetStmtSummaryCurrentRows()
+	require.Len(t, datums, 1)
+	match(t, datums[0], 0, 1, "", "hash_join(`t1`)")
+}
+
+func TestAppName(t *testing.T) {
+	ssMap := newStmtSummaryByDigestMap()
+	ssMap.beginTimeForCurInterval = time.Now().Unix() + 60
+
+	columnNames := []string{AppNameStr, ExecCountStr}
+	cols := make([]*model.ColumnInfo, len(columnNames))
+	for i := range columnNames {
+		cols[i] = &model.ColumnInfo{
+			ID:     int64(i),
+			Name:   model.NewCIStr(columnNames[i]),
+			Offset: i,
+		}
+	}
+	reader := NewStmtSummaryReader(nil, true, cols, "", time.UTC)
+	reader.ssMap = ssMap
+
+	// The statements executed by different applications are summarized separately.
+	for _, appName := range []string{"app1", "app2", "app1", ""} {
+		stmtExecInfo := generateAnyExecInfo()
+		stmtExecInfo.AppName = appName
+		ssMap.AddStatement(stmtExecInfo)
+	}
+	datums := reader.GetStmtSummaryCurrentRows()
+	require.Len(t, datums, 3)
+	rows := make([]string, 0, len(datums))
+	for _, row := range datums {
+		rows = append(rows, fmt.Sprintf("%s %d", row[0].GetString(), row[1].GetInt64()))
+	}
+	slices.Sort(rows)
+	require.Equal(t, []string{" 1", "app1 2", "app2 1"}, rows)
+
+	// The boundary between the resource group name and the application name is kept in the key.
+	key1 := &stmtSummaryByDigestKey{digest: "digest", resourceGroupName: "a", appName: "bc"}
+	key2 := &stmtSummaryByDigestKey{digest: "digest", resourceGroupName: "ab", appName: "c"}
+	require.NotEqual(t, key1.Hash(), key2.Hash())
+}
@@ -122,6 +122,9 @@ const (
 	PlanCacheUnqualifiedStr           = "PLAN_CACHE_UNQUALIFIED"
 	LastPlanCacheUnqualifiedStr       = "LAST_PLAN_CACHE_UNQUALIFIED_REASON"
 	PlanInBindingStr                  = "PLAN_IN_BINDING"
+	PlanBindingHitsStr                = "PLAN_BINDING_HITS"
+	LastBindingSQLStr                 = "LAST_BINDING_SQL"
+	LastAppliedHintsStr               = "LAST_APPLIED_HINTS"
 	QuerySampleTextStr                = "QUERY_SAMPLE_TEXT"
 	PrevSampleTextStr                 = "PREV_SAMPLE_TEXT"
 	PlanDigestStr                     = "PLAN_DIGEST"
@@ -137,6 +140,7 @@ const (
 	AvgQueuedRcTimeStr                = "AVG_QUEUED_RC_TIME"
 	MaxQueuedRcTimeStr                = "MAX_QUEUED_RC_TIME"
 	ResourceGroupName                 = "RESOURCE_GROUP"
+	AppNameStr                        = "APP_NAME"
 )
 
 type columnInfo interface {
@@ -484,12 +488,24 @@ var columnFactoryMap = map[string]columnFactory{
 	ResourceGroupName: func(_ columnInfo, record *StmtRecord) any {
 		return record.ResourceGroupName
 	},
+	AppNameStr: func(_ columnInfo, record *StmtRecord) any {
+		return record.AppName
+	},
 	PlanCacheUnqualifiedStr: func(_ columnInfo, record *StmtRecord) any {
 		return record.PlanCacheUnqualifiedCount
 	},
 	LastPlanCacheUnqualifiedStr: func(_ columnInfo, record *StmtRecord) any {
 		return record.LastPlanCacheUnqualified
 	},
+	PlanBindingHitsStr: func(_ columnInfo, record *StmtRecord) any {
+		return record.PlanBindingHits
+	},
+	LastBindingSQLStr: func(_ columnInfo, record *StmtRecord) any {
+		return record.LastBindingSQL
+	},
+	LastAppliedHintsStr: func(_ columnInfo, record *StmtRecord) any {
+		return record.LastAppliedHints
+	},
 }
 
 func makeColumnFactories(columns []*model.ColumnInfo) []columnFactory {
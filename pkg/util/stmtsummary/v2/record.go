@@ -150,9 +150,16 @@ type StmtRecord struct {
 	// request units(RU)
 	ResourceGroupName string `json:"resource_group_name"`
 	stmtsummary.StmtRUSummary
+	// the `program_name` connection attribute of the sessions
+	AppName string `json:"app_name,omitempty"`
 
 	PlanCacheUnqualifiedCount int64  `json:"plan_cache_unqualified_count"`
 	LastPlanCacheUnqualified  string `json:"last_plan_cache_unqualified"` // the reason why this query is unqualified for the plan cache
+
+	// Binding and hint provenance
+	PlanBindingHits  int64  `json:"plan_binding_hits"`
+	LastBindingSQL   string `json:"last_binding_sql"`   // the bind SQL of the binding used by the last statement
+	LastAppliedHints string `json:"last_applied_hints"` // the hints applied to the last statement, from the binding or the statement itself
 }
 
 // NewStmtRecord creates a new StmtRecord from StmtExecInfo.
@@ -221,6 +228,7 @@ func NewStmtRecord(info *stmtsummary.StmtExecInfo) *StmtRecord {
 		KeyspaceName:      info.KeyspaceName,
 		KeyspaceID:        info.KeyspaceID,
 		ResourceGroupName: info.ResourceGroupName,
+		AppName:           info.AppName,
 	}
 }
 
@@ -378,9 +386,13 @@ func (r *StmtRecord) Add(info *stmtsummary.StmtExecInfo) {
 	// SPM
 	if info.PlanInBinding {
 		r.PlanInBinding = true
+		r.PlanBindingHits++
 	} else {
 		r.PlanInBinding = false
 	}
+	// The binding and the hints are from the same execution, so they're always updated together.
+	r.LastBindingSQL = info.BindingSQL
+	r.LastAppliedHints = info.AppliedHints
 	// Other
 	r.SumAffectedRows += info.StmtCtx.AffectedRows()
 	r.SumMem += info.MemMax
@@ -553,6 +565,12 @@ func (r *StmtRecord) Merge(other *StmtRecord) {
 	if other.LastPlanCacheUnqualified != "" {
 		r.LastPlanCacheUnqualified = other.LastPlanCacheUnqualified
 	}
+	// SPM
+	r.PlanBindingHits += other.PlanBindingHits
+	if !r.LastSeen.After(other.LastSeen) {
+		r.LastBindingSQL = other.LastBindingSQL
+		r.LastAppliedHints = other.LastAppliedHints
+	}
 	// Other
 	r.SumAffectedRows += other.SumAffectedRows
 	r.SumMem += other.SumMem
@@ -16,6 +16,7 @@ package stmtsummary
 
 import (
 	"context"
+	"encoding/binary"
 	"errors"
 	"maps"
 	"math"
@@ -251,6 +252,7 @@ func (s *StmtSummary) Add(info *stmtsummary.StmtExecInfo) {
 		prevDigest:        info.PrevSQLDigest,
 		planDigest:        info.PlanDigest,
 		resourceGroupName: info.ResourceGroupName,
+		appName:           info.AppName,
 	}
 	k.Hash() // Calculate hash value in advance, to reduce the time holding the window lock.
 
@@ -458,6 +460,8 @@ type stmtKey struct {
 	planDigest string
 	// `resourceGroupName` is the resource group's name of this statement is bind to.
 	resourceGroupName string
+	// `appName` is the name of the application that executes this statement.
+	appName string
 	// `hash` is the hash value of this object.
 	hash []byte
 }
@@ -472,7 +476,12 @@ func (k *stmtKey) Hash() []byte {
 		k.hash = append(k.hash, hack.Slice(k.schemaName)...)
 		k.hash = append(k.hash, hack.Slice(k.prevDigest)...)
 		k.hash = append(k.hash, hack.Slice(k.planDigest)...)
+		// The names are prefixed by their lengths, otherwise the group "a" with the application "bc" and the group "ab"
+		// with the application "c" would have the same hash.
+		k.hash = binary.BigEndian.AppendUint32(k.hash, uint32(len(k.resourceGroupName)))
 		k.hash = append(k.hash, hack.Slice(k.resourceGroupName)...)
+		k.hash = binary.BigEndian.AppendUint32(k.hash, uint32(len(k.appName)))
+		k.hash = append(k.hash, hack.Slice(k.appName)...)
 	}
 	return k.hash
 }
@@ -680,6 +680,31 @@ func TestPerformanceSchemaforPlanCache(t *testing.T) {
 		testkit.Rows("3 1"))
 }
 
+func TestAppliedHintsWithPlanCache(t *testing.T) {
+	setupStmtSummary()
+	defer closeStmtSummary()
+
+	store := testkit.CreateMockStore(t)
+	tmp := testkit.NewTestKit(t, store)
+	tmp.MustExec("set tidb_enable_prepared_plan_cache=ON")
+	tk := newTestKitWithPlanCache(t, store)
+
+	// Clear summaries.
+	tk.MustExec("set global tidb_enable_stmt_summary = 0")
+	tk.MustExec("set global tidb_enable_stmt_summary = 1")
+	tk.MustExec("use test")
+	tk.MustExec("drop table if exists t")
+	tk.MustExec("create table t(a int, key(a))")
+	tk.MustExec("prepare stmt from 'select /*+ use_index(t, a) */ * from t where a > ?'")
+	tk.MustExec("set @a = 1")
+	tk.MustExec("execute stmt using @a")
+	tk.MustExec("execute stmt using @a")
+	tk.MustQuery("select @@last_plan_from_cache").Check(testkit.Rows("1"))
+	// the hints are kept with the cached plan, so they're still reported when the plan cache is hit.
+	tk.MustQuery("select plan_cache_hits, last_applied_hints from information_schema.statements_summary where digest_text like 'select * from `t` where `a` > ?'").Check(
+		testkit.Rows("1 use_index(`t` `a`)"))
+}
+
 func newTestKit(t *testing.T, store kv.Storage) *testkit.TestKit {
 	tk := testkit.NewTestKit(t, store)
 	tk.MustExec("use test")
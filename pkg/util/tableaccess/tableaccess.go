@@ -0,0 +1,202 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tableaccess
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
+)
+
+// flushBatchSize is the max number of the rows written by a statement when the pending accesses are flushed.
+const flushBatchSize = 256
+
+// Kind is the kind of the access to a table or an index.
+type Kind uint8
+
+const (
+	// Scan means the rows are read by a full or range scan.
+	Scan Kind = iota
+	// PointGet means the rows are read by a point get or a batch point get.
+	PointGet
+)
+
+// Key identifies the table or the index accessed. IndexID is 0 for the accesses to the rows of the table.
+type Key struct {
+	TableID int64
+	IndexID int64
+}
+
+// Access is an access to a table or an index in the plan of a statement.
+type Access struct {
+	Key
+	Kind Kind
+}
+
+// Stats is the logical access counts of a table or an index.
+type Stats struct {
+	Scans          uint64
+	PointGets      uint64
+	LastAccessTime time.Time
+}
+
+func (s *Stats) merge(other Stats) {
+	s.Scans += other.Scans
+	s.PointGets += other.PointGets
+	if other.LastAccessTime.After(s.LastAccessTime) {
+		s.LastAccessTime = other.LastAccessTime
+	}
+}
+
+// Collector accumulates the accesses of the statements executed in the instance until they're flushed into
+// mysql.tidb_table_access_stats, so the counts survive the restarts and are shared by the whole cluster.
+type Collector struct {
+	mu      sync.Mutex
+	pending map[Key]*Stats
+}
+
+// NewCollector creates a new Collector.
+func NewCollector() *Collector {
+	return &Collector{pending: make(map[Key]*Stats)}
+}
+
+// GlobalCollector is the collector of the accesses of the instance.
+var GlobalCollector = NewCollector()
+
+// Record adds the accesses of a statement.
+func (c *Collector) Record(accesses []Access, now time.Time) {
+	if len(accesses) == 0 {
+		return
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, access := range accesses {
+		stats, ok := c.pending[access.Key]
+		if !ok {
+			stats = &Stats{}
+			c.pending[access.Key] = stats
+		}
+		switch access.Kind {
+		case Scan:
+			stats.Scans++
+		case PointGet:
+			stats.PointGets++
+		}
+		stats.LastAccessTime = now
+	}
+}
+
+// Pending returns a copy of the accesses which are not flushed yet.
+func (c *Collector) Pending() map[Key]Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pending := make(map[Key]Stats, len(c.pending))
+	for key, stats := range c.pending {
+		pending[key] = *stats
+	}
+	return pending
+}
+
+func (c *Collector) take() map[Key]*Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	pending := c.pending
+	c.pending = make(map[Key]*Stats)
+	return pending
+}
+
+// restore merges the accesses failed to be flushed back, so they're flushed next time.
+func (c *Collector) restore(keys []Key, stats map[Key]*Stats) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, key := range keys {
+		if pending, ok := c.pending[key]; ok {
+			pending.merge(*stats[key])
+		} else {
+			c.pending[key] = stats[key]
+		}
+	}
+}
+
+// Flush adds the pending accesses to mysql.tidb_table_access_stats. The accesses which fail to be written are kept
+// pending.
+func (c *Collector) Flush(ctx context.Context, exec sqlexec.RestrictedSQLExecutor) error {
+	pending := c.take()
+	if len(pending) == 0 {
+		return nil
+	}
+	keys := make([]Key, 0, len(pending))
+	for key := range pending {
+		keys = append(keys, key)
+	}
+	slices.SortFunc(keys, func(a, b Key) int {
+		if a.TableID != b.TableID {
+			return cmp.Compare(a.TableID, b.TableID)
+		}
+		return cmp.Compare(a.IndexID, b.IndexID)
+	})
+	for len(keys) > 0 {
+		batch := keys[:min(len(keys), flushBatchSize)]
+		var sb strings.Builder
+		sb.WriteString("INSERT INTO mysql.tidb_table_access_stats (table_id, index_id, scans, point_gets, last_access_time) VALUES ")
+		args := make([]any, 0, len(batch)*5)
+		for i, key := range batch {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString("(%?, %?, %?, %?, %?)")
+			stats := pending[key]
+			args = append(args, key.TableID, key.IndexID, stats.Scans, stats.PointGets, stats.LastAccessTime)
+		}
+		sb.WriteString(` ON DUPLICATE KEY UPDATE scans = scans + VALUES(scans), point_gets = point_gets + VALUES(point_gets),
+			last_access_time = GREATEST(last_access_time, VALUES(last_access_time))`)
+		if _, _, err := exec.ExecRestrictedSQL(ctx, nil, sb.String(), args...); err != nil {
+			c.restore(keys, pending)
+			return err
+		}
+		keys = keys[len(batch):]
+	}
+	return nil
+}
+
+// Load returns the accesses persisted in mysql.tidb_table_access_stats with the pending accesses of the instance
+// added.
+func (c *Collector) Load(ctx context.Context, exec sqlexec.RestrictedSQLExecutor) (map[Key]Stats, error) {
+	rows, _, err := exec.ExecRestrictedSQL(ctx, nil,
+		"SELECT table_id, index_id, scans, point_gets, last_access_time FROM mysql.tidb_table_access_stats")
+	if err != nil {
+		return nil, err
+	}
+	result := c.Pending()
+	for _, row := range rows {
+		key := Key{TableID: row.GetInt64(0), IndexID: row.GetInt64(1)}
+		stats := result[key]
+		persisted := Stats{Scans: row.GetUint64(2), PointGets: row.GetUint64(3)}
+		if !row.IsNull(4) {
+			persisted.LastAccessTime, err = row.GetTime(4).GoTime(time.Local)
+			if err != nil {
+				return nil, err
+			}
+		}
+		stats.merge(persisted)
+		result[key] = stats
+	}
+	return result, nil
+}
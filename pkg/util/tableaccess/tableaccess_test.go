@@ -0,0 +1,74 @@
+// Copyright 2024 PingCAP, Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tableaccess
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/pingcap/tidb/pkg/parser/ast"
+	"github.com/pingcap/tidb/pkg/util/chunk"
+	"github.com/pingcap/tidb/pkg/util/sqlexec"
+	"github.com/stretchr/testify/require"
+)
+
+type mockExecutor struct {
+	sqlexec.RestrictedSQLExecutor
+	sqls []string
+	err  error
+}
+
+func (m *mockExecutor) ExecRestrictedSQL(_ context.Context, _ []sqlexec.OptionFuncAlias, sql string, _ ...any) ([]chunk.Row, []*ast.ResultField, error) {
+	m.sqls = append(m.sqls, sql)
+	return nil, nil, m.err
+}
+
+func TestCollectorFlush(t *testing.T) {
+	c := NewCollector()
+	t1, t2 := time.Unix(100, 0), time.Unix(200, 0)
+	c.Record([]Access{
+		{Key: Key{TableID: 1}, Kind: Scan},
+		{Key: Key{TableID: 1, IndexID: 1}, Kind: Scan},
+		{Key: Key{TableID: 1}, Kind: PointGet},
+	}, t1)
+	c.Record([]Access{{Key: Key{TableID: 1}, Kind: PointGet}}, t2)
+	require.Equal(t, map[Key]Stats{
+		{TableID: 1}:             {Scans: 1, PointGets: 2, LastAccessTime: t2},
+		{TableID: 1, IndexID: 1}: {Scans: 1, LastAccessTime: t1},
+	}, c.Pending())
+
+	// the accesses are kept pending if they fail to be flushed.
+	exec := &mockExecutor{err: errors.New("mock error")}
+	require.Error(t, c.Flush(context.Background(), exec))
+	require.Len(t, exec.sqls, 1)
+	c.Record([]Access{{Key: Key{TableID: 1, IndexID: 1}, Kind: Scan}}, t2)
+	require.Equal(t, map[Key]Stats{
+		{TableID: 1}:             {Scans: 1, PointGets: 2, LastAccessTime: t2},
+		{TableID: 1, IndexID: 1}: {Scans: 2, LastAccessTime: t2},
+	}, c.Pending())
+
+	// the accesses are written in batches.
+	for i := 0; i < flushBatchSize; i++ {
+		c.Record([]Access{{Key: Key{TableID: int64(i + 2)}, Kind: Scan}}, t1)
+	}
+	exec = &mockExecutor{}
+	require.NoError(t, c.Flush(context.Background(), exec))
+	require.Len(t, exec.sqls, 2)
+	require.Empty(t, c.Pending())
+	require.NoError(t, c.Flush(context.Background(), exec))
+	require.Len(t, exec.sqls, 2)
+}
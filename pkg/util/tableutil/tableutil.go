@@ -17,6 +17,8 @@ package tableutil
 import (
 	"github.com/pingcap/tidb/pkg/meta/autoid"
 	"github.com/pingcap/tidb/pkg/parser/model"
+	"github.com/pingcap/tidb/pkg/sessionctx/stmtctx"
+	"github.com/pingcap/tidb/pkg/types"
 )
 
 // TempTable is used to store transaction-specific or session-specific information for global / local temporary tables.
@@ -31,10 +33,23 @@ type TempTable interface {
 	// GetModified queries whether the table is modified.
 	GetModified() bool
 
-	// The stats of this table (*statistics.Table).
+	// The stats of this table (*statistics.TempTableStats).
 	// Define the return type as interface{} here to avoid cycle imports.
 	GetStats() any
 
+	// SetStats sets the stats of this table by copying the given stats (*statistics.TempTableStats).
+	SetStats(stats any)
+
+	// UpdateStats updates the stats of this table with a written row.
+	// The delta is 1 for an inserted row, -1 for a removed row and 0 for an updated row.
+	UpdateStats(sc *stmtctx.StatementContext, delta int64, row []types.Datum)
+
+	// StmtCommitStats keeps the stats updated by the current statement.
+	StmtCommitStats()
+
+	// StmtRollbackStats discards the stats updated by the current statement.
+	StmtRollbackStats()
+
 	GetSize() int64
 	SetSize(int64)
 
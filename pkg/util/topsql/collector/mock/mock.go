@@ -160,7 +160,7 @@ func (c *TopSQLCollector) RegisterSQL(sqlDigest []byte, normalizedSQL string, _
 }
 
 // RegisterPlan uses for testing.
-func (c *TopSQLCollector) RegisterPlan(planDigest []byte, normalizedPlan string, isLarge bool) {
+func (c *TopSQLCollector) RegisterPlan(planDigest []byte, normalizedPlan string, isLarge bool, _ bool) {
 	if isLarge {
 		return
 	}
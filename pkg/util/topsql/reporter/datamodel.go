@@ -600,6 +600,8 @@ type sqlMeta struct {
 type planMeta struct {
 	binaryNormalizedPlan string
 	isLarge              bool
+	// fromBinding indicates whether the plan has been generated from a SQL binding.
+	fromBinding bool
 }
 
 // normalizedSQLMap is a wrapped map used to register normalizedSQL.
@@ -680,18 +682,48 @@ func newNormalizedPlanMap() *normalizedPlanMap {
 
 // register saves the relationship between planDigest and normalizedPlan.
 // If the internal map size exceeds the limit, the relationship will be discarded.
-func (m *normalizedPlanMap) register(planDigest []byte, normalizedPlan string, isLarge bool) {
+// The plan is marked as from binding once any of its executions is generated from a binding.
+func (m *normalizedPlanMap) register(planDigest []byte, normalizedPlan string, isLarge bool, fromBinding bool) {
+	data := m.data.Load()
 	if m.length.Load() >= topsqlstate.GlobalState.MaxCollect.Load() {
+		if fromBinding {
+			// still mark the registered plan.
+			m.markFromBinding(data, planDigest)
+		}
 		reporter_metrics.IgnoreExceedPlanCounter.Inc()
 		return
 	}
-	data := m.data.Load()
-	_, loaded := data.LoadOrStore(string(planDigest), planMeta{
+	meta := planMeta{
 		binaryNormalizedPlan: normalizedPlan,
 		isLarge:              isLarge,
-	})
+		fromBinding:          fromBinding,
+	}
+	v, loaded := data.LoadOrStore(string(planDigest), meta)
 	if !loaded {
 		m.length.Add(1)
+		return
+	}
+	if fromBinding && !v.(planMeta).fromBinding {
+		m.markFromBinding(data, planDigest)
+	}
+}
+
+func (*normalizedPlanMap) markFromBinding(data *sync.Map, planDigest []byte) {
+	for {
+		v, ok := data.Load(string(planDigest))
+		if !ok {
+			return
+		}
+		meta := v.(planMeta)
+		if meta.fromBinding {
+			return
+		}
+		marked := meta
+		marked.fromBinding = true
+		// Retry if the meta is changed concurrently.
+		if data.CompareAndSwap(string(planDigest), meta, marked) {
+			return
+		}
 	}
 }
 
@@ -733,6 +765,18 @@ func (m *normalizedPlanMap) toProto(decodePlan planBinaryDecodeFunc, compressPla
 	return metas
 }
 
+// bindingPlanDigests returns the digests of the plans generated from SQL bindings.
+func (m *normalizedPlanMap) bindingPlanDigests() [][]byte {
+	var digests [][]byte
+	m.data.Load().Range(func(k, v any) bool {
+		if v.(planMeta).fromBinding {
+			digests = append(digests, hack.Slice(k.(string)))
+		}
+		return true
+	})
+	return digests
+}
+
 func encodeKey(buf *bytes.Buffer, sqlDigest, planDigest []byte) string {
 	buf.Reset()
 	buf.Write(sqlDigest)
@@ -399,9 +399,9 @@ func Test_normalizedSQLMap_toProto(t *testing.T) {
 func Test_normalizedPlanMap_register(t *testing.T) {
 	topsqlstate.GlobalState.MaxCollect.Store(2)
 	m := newNormalizedPlanMap()
-	m.register([]byte("PLAN-1"), "PLAN-1", false)
-	m.register([]byte("PLAN-2"), "PLAN-2", true)
-	m.register([]byte("PLAN-3"), "PLAN-3", false)
+	m.register([]byte("PLAN-1"), "PLAN-1", false, false)
+	m.register([]byte("PLAN-2"), "PLAN-2", true, false)
+	m.register([]byte("PLAN-3"), "PLAN-3", false, false)
 	require.Equal(t, int64(2), m.length.Load())
 	v, ok := m.data.Load().Load("PLAN-1")
 	require.True(t, ok)
@@ -422,9 +422,9 @@ func Test_normalizedPlanMap_register(t *testing.T) {
 func Test_normalizedPlanMap_take(t *testing.T) {
 	topsqlstate.GlobalState.MaxCollect.Store(999)
 	m1 := newNormalizedPlanMap()
-	m1.register([]byte("PLAN-1"), "PLAN-1", false)
-	m1.register([]byte("PLAN-2"), "PLAN-2", false)
-	m1.register([]byte("PLAN-3"), "PLAN-3", false)
+	m1.register([]byte("PLAN-1"), "PLAN-1", false, false)
+	m1.register([]byte("PLAN-2"), "PLAN-2", false, false)
+	m1.register([]byte("PLAN-3"), "PLAN-3", false, false)
 	m2 := m1.take()
 	require.Equal(t, int64(0), m1.length.Load())
 	require.Equal(t, int64(3), m2.length.Load())
@@ -447,9 +447,9 @@ func Test_normalizedPlanMap_take(t *testing.T) {
 func Test_normalizedPlanMap_toProto(t *testing.T) {
 	topsqlstate.GlobalState.MaxCollect.Store(999)
 	m := newNormalizedPlanMap()
-	m.register([]byte("PLAN-1"), "PLAN-1", false)
-	m.register([]byte("PLAN-2"), "PLAN-2", true)
-	m.register([]byte("PLAN-3"), "PLAN-3", false)
+	m.register([]byte("PLAN-1"), "PLAN-1", false, false)
+	m.register([]byte("PLAN-2"), "PLAN-2", true, false)
+	m.register([]byte("PLAN-3"), "PLAN-3", false, false)
 	pb := m.toProto(
 		func(s string) (string, error) { return "[decoded] " + s, nil },
 		func(s []byte) string { return "[encoded] " + string(s) })
@@ -472,6 +472,37 @@ func Test_normalizedPlanMap_toProto(t *testing.T) {
 	}, hash["PLAN-3"])
 }
 
+func Test_normalizedPlanMap_fromBinding(t *testing.T) {
+	topsqlstate.GlobalState.MaxCollect.Store(2)
+	m := newNormalizedPlanMap()
+	m.register([]byte("PLAN-1"), "PLAN-1", false, false)
+	m.register([]byte("PLAN-2"), "PLAN-2", true, true)
+	// the plan is marked once any execution of it is from binding, even if the map is full.
+	m.register([]byte("PLAN-1"), "PLAN-1", false, true)
+	m.register([]byte("PLAN-3"), "PLAN-3", false, true)
+	require.Equal(t, int64(2), m.length.Load())
+	pb := m.toProto(
+		func(s string) (string, error) { return "[decoded] " + s, nil },
+		func(s []byte) string { return "[encoded] " + string(s) })
+	require.Len(t, pb, 2)
+	hash := map[string]tipb.PlanMeta{}
+	for _, meta := range pb {
+		hash[string(meta.PlanDigest)] = meta
+	}
+	// the normalized plan is left as it is.
+	require.Equal(t, tipb.PlanMeta{
+		PlanDigest:     []byte("PLAN-1"),
+		NormalizedPlan: "[decoded] PLAN-1",
+	}, hash["PLAN-1"])
+	require.Equal(t, tipb.PlanMeta{
+		PlanDigest:            []byte("PLAN-2"),
+		EncodedNormalizedPlan: "[encoded] PLAN-2",
+	}, hash["PLAN-2"])
+	digests := m.bindingPlanDigests()
+	sort.Slice(digests, func(i, j int) bool { return bytes.Compare(digests[i], digests[j]) < 0 })
+	require.Equal(t, [][]byte{[]byte("PLAN-1"), []byte("PLAN-2")}, digests)
+}
+
 func Test_encodeKey(t *testing.T) {
 	buf := bytes.NewBuffer(make([]byte, 0, 64))
 	key := encodeKey(buf, []byte("S"), []byte("P"))
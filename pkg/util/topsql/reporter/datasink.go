@@ -16,6 +16,7 @@ package reporter
 
 import (
 	"context"
+	"encoding/binary"
 	"sync"
 	"time"
 
@@ -48,12 +49,88 @@ type ReportData struct {
 	DataRecords []tipb.TopSQLRecord
 	SQLMetas    []tipb.SQLMeta
 	PlanMetas   []tipb.PlanMeta
+	// BindingPlanDigests contains the digests of the PlanMetas whose plans are generated from SQL bindings.
+	BindingPlanDigests [][]byte
 }
 
 func (d *ReportData) hasData() bool {
 	return len(d.DataRecords) != 0 || len(d.SQLMetas) != 0 || len(d.PlanMetas) != 0
 }
 
+// bindingPlanDigestSet returns the set of BindingPlanDigests.
+func (d *ReportData) bindingPlanDigestSet() map[string]struct{} {
+	if len(d.BindingPlanDigests) == 0 {
+		return nil
+	}
+	digests := make(map[string]struct{}, len(d.BindingPlanDigests))
+	for _, digest := range d.BindingPlanDigests {
+		digests[string(digest)] = struct{}{}
+	}
+	return digests
+}
+
+const (
+	// planMetaFromBindingField is the number of the `from_binding` field appended to the encoded PlanMeta of the
+	// plans generated from SQL bindings. tipb doesn't define the field yet, so the consumers which don't know it
+	// skip it as an unknown field.
+	planMetaFromBindingField = 100
+	// subResponsePlanMetaField is the number of the `plan_meta` field of TopSQLSubResponse.
+	subResponsePlanMetaField = 3
+
+	protoWireVarint = 0
+	protoWireBytes  = 2
+)
+
+// planMetaFromBinding is a PlanMeta of the plan generated from a SQL binding, it's encoded with the `from_binding`
+// field.
+type planMetaFromBinding struct {
+	planMeta *tipb.PlanMeta
+}
+
+// Reset implements the proto.Message interface.
+func (m *planMetaFromBinding) Reset() { m.planMeta.Reset() }
+
+// String implements the proto.Message interface.
+func (m *planMetaFromBinding) String() string { return m.planMeta.String() }
+
+// ProtoMessage implements the proto.Message interface.
+func (*planMetaFromBinding) ProtoMessage() {}
+
+// Marshal implements the proto marshaler.
+func (m *planMetaFromBinding) Marshal() ([]byte, error) {
+	data, err := m.planMeta.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	data = binary.AppendUvarint(data, planMetaFromBindingField<<3|protoWireVarint)
+	return binary.AppendUvarint(data, 1), nil
+}
+
+// subResponseFromBinding is a TopSQLSubResponse carrying a planMetaFromBinding.
+type subResponseFromBinding struct {
+	planMeta planMetaFromBinding
+}
+
+// Reset implements the proto.Message interface.
+func (r *subResponseFromBinding) Reset() { r.planMeta.Reset() }
+
+// String implements the proto.Message interface.
+func (r *subResponseFromBinding) String() string { return r.planMeta.String() }
+
+// ProtoMessage implements the proto.Message interface.
+func (*subResponseFromBinding) ProtoMessage() {}
+
+// Marshal implements the proto marshaler.
+func (r *subResponseFromBinding) Marshal() ([]byte, error) {
+	planMeta, err := r.planMeta.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	data := binary.AppendUvarint(nil, subResponsePlanMetaField<<3|protoWireBytes)
+	data = binary.AppendUvarint(data, uint64(len(planMeta)))
+	return append(data, planMeta...), nil
+}
+
 var _ DataSinkRegisterer = &DefaultDataSinkRegisterer{}
 
 // DefaultDataSinkRegisterer implements DataSinkRegisterer.
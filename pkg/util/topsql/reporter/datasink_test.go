@@ -19,7 +19,9 @@ import (
 	"testing"
 	"time"
 
+	"github.com/pingcap/tipb/go-tipb"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestDefaultDataSinkRegisterer(t *testing.T) {
@@ -56,3 +58,23 @@ func (m *mockDataSink2) TrySend(data *ReportData, deadline time.Time) error {
 func (m *mockDataSink2) OnReporterClosing() {
 	m.closed = true
 }
+
+func TestPlanMetaFromBinding(t *testing.T) {
+	planMeta := &tipb.PlanMeta{PlanDigest: []byte("P1"), NormalizedPlan: "PLAN-1"}
+	origin, err := planMeta.Marshal()
+	require.NoError(t, err)
+	data, err := (&planMetaFromBinding{planMeta}).Marshal()
+	require.NoError(t, err)
+	// The from_binding field is appended to the encoded PlanMeta, and it's skipped by the old consumers.
+	require.Equal(t, origin, data[:len(origin)])
+	require.Equal(t, []byte{0xa0, 0x06, 0x01}, data[len(origin):])
+	decoded := &tipb.PlanMeta{}
+	require.NoError(t, decoded.Unmarshal(data))
+	require.Equal(t, planMeta, decoded)
+
+	data, err = (&subResponseFromBinding{planMeta: planMetaFromBinding{planMeta}}).Marshal()
+	require.NoError(t, err)
+	resp := &tipb.TopSQLSubResponse{}
+	require.NoError(t, resp.Unmarshal(data))
+	require.Equal(t, planMeta, resp.GetPlanMeta())
+}
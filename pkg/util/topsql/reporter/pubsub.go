@@ -159,7 +159,7 @@ func (ds *pubSubDataSink) doSend(ctx context.Context, data *ReportData) error {
 	if err := ds.sendSQLMeta(ctx, data.SQLMetas); err != nil {
 		return err
 	}
-	return ds.sendPlanMeta(ctx, data.PlanMetas)
+	return ds.sendPlanMeta(ctx, data.PlanMetas, data.bindingPlanDigestSet())
 }
 
 func (ds *pubSubDataSink) sendTopSQLRecords(ctx context.Context, records []tipb.TopSQLRecord) (err error) {
@@ -236,7 +236,7 @@ func (ds *pubSubDataSink) sendSQLMeta(ctx context.Context, sqlMetas []tipb.SQLMe
 	return
 }
 
-func (ds *pubSubDataSink) sendPlanMeta(ctx context.Context, planMetas []tipb.PlanMeta) (err error) {
+func (ds *pubSubDataSink) sendPlanMeta(ctx context.Context, planMetas []tipb.PlanMeta, bindingPlanDigests map[string]struct{}) (err error) {
 	if len(planMetas) == 0 {
 		return
 	}
@@ -257,7 +257,12 @@ func (ds *pubSubDataSink) sendPlanMeta(ctx context.Context, planMetas []tipb.Pla
 
 	for i := range planMetas {
 		planMeta.PlanMeta = &planMetas[i]
-		if err = ds.stream.Send(r); err != nil {
+		if _, ok := bindingPlanDigests[string(planMetas[i].PlanDigest)]; ok {
+			err = ds.stream.SendMsg(&subResponseFromBinding{planMeta: planMetaFromBinding{&planMetas[i]}})
+		} else {
+			err = ds.stream.Send(r)
+		}
+		if err != nil {
 			return
 		}
 		sentCount++
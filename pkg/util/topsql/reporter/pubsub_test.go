@@ -37,6 +37,8 @@ type mockPubSubDataSinkStream struct {
 	records   []*tipb.TopSQLRecord
 	sqlMetas  []*tipb.SQLMeta
 	planMetas []*tipb.PlanMeta
+	// fromBindingCnt is the number of the plan metas sent with the from_binding field.
+	fromBindingCnt int
 	sync.Mutex
 }
 
@@ -73,7 +75,18 @@ func (s *mockPubSubDataSinkStream) Context() context.Context {
 }
 
 func (s *mockPubSubDataSinkStream) SendMsg(m any) error {
-	return nil
+	data, err := m.(interface{ Marshal() ([]byte, error) }).Marshal()
+	if err != nil {
+		return err
+	}
+	resp := &tipb.TopSQLSubResponse{}
+	if err := resp.Unmarshal(data); err != nil {
+		return err
+	}
+	s.Lock()
+	s.fromBindingCnt++
+	s.Unlock()
+	return s.Send(resp)
 }
 
 func (s *mockPubSubDataSinkStream) RecvMsg(m any) error {
@@ -108,7 +121,11 @@ func TestPubSubDataSink(t *testing.T) {
 		PlanMetas: []tipb.PlanMeta{{
 			PlanDigest:     []byte("P1"),
 			NormalizedPlan: "PLAN-1",
+		}, {
+			PlanDigest:     []byte("P2"),
+			NormalizedPlan: "PLAN-2",
 		}},
+		BindingPlanDigests: [][]byte{[]byte("P2")},
 	}, time.Now().Add(10*time.Second))
 	assert.NoError(t, err)
 
@@ -117,7 +134,9 @@ func TestPubSubDataSink(t *testing.T) {
 	mockStream.Lock()
 	assert.Len(t, mockStream.records, 1)
 	assert.Len(t, mockStream.sqlMetas, 1)
-	assert.Len(t, mockStream.planMetas, 1)
+	assert.Len(t, mockStream.planMetas, 2)
+	assert.Equal(t, 1, mockStream.fromBindingCnt)
+	assert.Equal(t, "PLAN-2", mockStream.planMetas[1].NormalizedPlan)
 	mockStream.Unlock()
 
 	ds.OnReporterClosing()
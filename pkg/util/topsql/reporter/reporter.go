@@ -53,7 +53,8 @@ type TopSQLReporter interface {
 
 	// RegisterPlan like RegisterSQL, but for normalized plan strings.
 	// isLarge indicates the size of normalizedPlan is big.
-	RegisterPlan(planDigest []byte, normalizedPlan string, isLarge bool)
+	// fromBinding indicates the plan is generated from a SQL binding.
+	RegisterPlan(planDigest []byte, normalizedPlan string, isLarge bool, fromBinding bool)
 
 	// Close uses to close and release the reporter resource.
 	Close()
@@ -154,8 +155,8 @@ func (tsr *RemoteTopSQLReporter) RegisterSQL(sqlDigest []byte, normalizedSQL str
 // RegisterPlan implements TopSQLReporter.
 //
 // This function is thread-safe and efficient.
-func (tsr *RemoteTopSQLReporter) RegisterPlan(planDigest []byte, normalizedPlan string, isLarge bool) {
-	tsr.normalizedPlanMap.register(planDigest, normalizedPlan, isLarge)
+func (tsr *RemoteTopSQLReporter) RegisterPlan(planDigest []byte, normalizedPlan string, isLarge bool, fromBinding bool) {
+	tsr.normalizedPlanMap.register(planDigest, normalizedPlan, isLarge, fromBinding)
 }
 
 // Close implements TopSQLReporter.
@@ -269,9 +270,10 @@ func (tsr *RemoteTopSQLReporter) reportWorker() {
 			rs := data.collected.getReportRecords()
 			// Convert to protobuf data and do report.
 			tsr.doReport(&ReportData{
-				DataRecords: rs.toProto(),
-				SQLMetas:    data.normalizedSQLMap.toProto(),
-				PlanMetas:   data.normalizedPlanMap.toProto(tsr.decodePlan, tsr.compressPlan),
+				DataRecords:        rs.toProto(),
+				SQLMetas:           data.normalizedSQLMap.toProto(),
+				PlanMetas:          data.normalizedPlanMap.toProto(tsr.decodePlan, tsr.compressPlan),
+				BindingPlanDigests: data.normalizedPlanMap.bindingPlanDigests(),
 			})
 		case <-tsr.ctx.Done():
 			return
@@ -44,7 +44,7 @@ func populateCache(tsr *RemoteTopSQLReporter, begin, end int, timestamp uint64)
 	for i := begin; i < end; i++ {
 		key := []byte("planDigest" + strconv.Itoa(i+1))
 		value := "planNormalized" + strconv.Itoa(i+1)
-		tsr.RegisterPlan(key, value, false)
+		tsr.RegisterPlan(key, value, false, false)
 	}
 	// collect
 	var records []collector.SQLCPUTimeRecord
@@ -198,7 +198,7 @@ func newSQLCPUTimeRecord(tsr *RemoteTopSQLReporter, sqlID int, cpuTimeMs uint32)
 
 	key = []byte("planDigest" + strconv.Itoa(sqlID))
 	value = "planNormalized" + strconv.Itoa(sqlID)
-	tsr.RegisterPlan(key, value, false)
+	tsr.RegisterPlan(key, value, false, false)
 
 	return collector.SQLCPUTimeRecord{
 		SQLDigest:  []byte("sqlDigest" + strconv.Itoa(sqlID)),
@@ -321,7 +321,7 @@ func TestCollectCapacity(t *testing.T) {
 		for i := 0; i < n; i++ {
 			key := []byte("planDigest" + strconv.Itoa(i))
 			value := "planNormalized" + strconv.Itoa(i)
-			tsr.RegisterPlan(key, value, false)
+			tsr.RegisterPlan(key, value, false, false)
 		}
 	}
 	genRecord := func(n int) []collector.SQLCPUTimeRecord {
@@ -213,7 +213,7 @@ func (ds *SingleTargetDataSink) doSend(addr string, task sendTask) {
 	}()
 	go func() {
 		defer wg.Done()
-		errCh <- ds.sendBatchPlanMeta(ctx, task.data.PlanMetas)
+		errCh <- ds.sendBatchPlanMeta(ctx, task.data.PlanMetas, task.data.bindingPlanDigestSet())
 	}()
 	go func() {
 		defer wg.Done()
@@ -298,7 +298,7 @@ func (ds *SingleTargetDataSink) sendBatchSQLMeta(ctx context.Context, sqlMetas [
 }
 
 // sendBatchPlanMeta sends a batch of SQL metas by stream.
-func (ds *SingleTargetDataSink) sendBatchPlanMeta(ctx context.Context, planMetas []tipb.PlanMeta) (err error) {
+func (ds *SingleTargetDataSink) sendBatchPlanMeta(ctx context.Context, planMetas []tipb.PlanMeta, bindingPlanDigests map[string]struct{}) (err error) {
 	if len(planMetas) == 0 {
 		return nil
 	}
@@ -321,7 +321,12 @@ func (ds *SingleTargetDataSink) sendBatchPlanMeta(ctx context.Context, planMetas
 	}
 
 	for i := range planMetas {
-		if err = stream.Send(&planMetas[i]); err != nil {
+		if _, ok := bindingPlanDigests[string(planMetas[i].PlanDigest)]; ok {
+			err = stream.SendMsg(&planMetaFromBinding{&planMetas[i]})
+		} else {
+			err = stream.Send(&planMetas[i])
+		}
+		if err != nil {
 			return err
 		}
 		sentCount++
@@ -65,7 +65,11 @@ func TestSingleTargetDataSink(t *testing.T) {
 		PlanMetas: []tipb.PlanMeta{{
 			PlanDigest:     []byte("P1"),
 			NormalizedPlan: "PLAN-1",
+		}, {
+			PlanDigest:     []byte("P2"),
+			NormalizedPlan: "PLAN-2",
 		}},
+		BindingPlanDigests: [][]byte{[]byte("P2")},
 	}, time.Now().Add(10*time.Second))
 	assert.NoError(t, err)
 
@@ -80,4 +84,8 @@ func TestSingleTargetDataSink(t *testing.T) {
 	normalizedPlan, exist := server.GetPlanMetaByDigestBlocking([]byte("P1"), 5*time.Second)
 	assert.True(t, exist)
 	assert.Equal(t, normalizedPlan, "PLAN-1")
+	// The plan from a binding is sent with the from_binding field, which is skipped by the agent.
+	normalizedPlan, exist = server.GetPlanMetaByDigestBlocking([]byte("P2"), 5*time.Second)
+	assert.True(t, exist)
+	assert.Equal(t, normalizedPlan, "PLAN-2")
 }
@@ -88,10 +88,11 @@ func RegisterSQL(normalizedSQL string, sqlDigest *parser.Digest, isInternal bool
 }
 
 // RegisterPlan uses to register plan information into Top SQL.
-func RegisterPlan(normalizedPlan string, planDigest *parser.Digest) {
+// fromBinding indicates whether the plan is generated from a SQL binding.
+func RegisterPlan(normalizedPlan string, planDigest *parser.Digest, fromBinding bool) {
 	if planDigest != nil {
 		planDigestBytes := planDigest.Bytes()
-		linkPlanTextWithDigest(planDigestBytes, normalizedPlan)
+		linkPlanTextWithDigest(planDigestBytes, normalizedPlan, fromBinding)
 	}
 }
 
@@ -182,6 +183,6 @@ func linkSQLTextWithDigest(sqlDigest []byte, normalizedSQL string, isInternal bo
 	globalTopSQLReport.RegisterSQL(sqlDigest, normalizedSQL, isInternal)
 }
 
-func linkPlanTextWithDigest(planDigest []byte, normalizedBinaryPlan string) {
-	globalTopSQLReport.RegisterPlan(planDigest, normalizedBinaryPlan, len(normalizedBinaryPlan) > MaxBinaryPlanSize)
+func linkPlanTextWithDigest(planDigest []byte, normalizedBinaryPlan string, fromBinding bool) {
+	globalTopSQLReport.RegisterPlan(planDigest, normalizedBinaryPlan, len(normalizedBinaryPlan) > MaxBinaryPlanSize, fromBinding)
 }
@@ -196,7 +196,7 @@ func TestMaxSQLAndPlanTest(t *testing.T) {
 	plan := "TableReader table:t"
 	planDigest := genDigest(plan)
 	topsql.AttachSQLAndPlanInfo(ctx, sqlDigest, planDigest)
-	topsql.RegisterPlan(plan, planDigest)
+	topsql.RegisterPlan(plan, planDigest, false)
 
 	cSQL := collector.GetSQL(sqlDigest.Bytes())
 	require.Equal(t, sql, cSQL)
@@ -210,7 +210,7 @@ func TestMaxSQLAndPlanTest(t *testing.T) {
 	plan = genStr(topsql.MaxBinaryPlanSize + 10)
 	planDigest = genDigest(plan)
 	topsql.AttachSQLAndPlanInfo(ctx, sqlDigest, planDigest)
-	topsql.RegisterPlan(plan, planDigest)
+	topsql.RegisterPlan(plan, planDigest, false)
 
 	cSQL = collector.GetSQL(sqlDigest.Bytes())
 	require.Equal(t, sql[:topsql.MaxSQLTextSize], cSQL)
@@ -390,7 +390,7 @@ func mockExecuteSQL(sql, plan string) {
 	mockExecute(time.Millisecond * 100)
 	planDigest := genDigest(plan)
 	topsql.AttachSQLAndPlanInfo(ctx, sqlDigest, planDigest)
-	topsql.RegisterPlan(plan, planDigest)
+	topsql.RegisterPlan(plan, planDigest, false)
 	mockExecute(time.Millisecond * 300)
 }
 
@@ -259,6 +259,8 @@ func TestDeadlock(t *testing.T) {
 	res := tk1.MustQuery("select deadlock_id, try_lock_trx_id, trx_holding_lock, current_sql_digest, current_sql_digest_text from information_schema.deadlocks")
 	res.CheckAt([]int{1, 2, 3, 4}, testkit.RowsWithSep("/", expectedDeadlockInfo...))
 	require.Equal(t, res.Rows()[1][0], res.Rows()[0][0])
+	// The plans of the statements are recorded as well.
+	tk1.MustQuery("select count(*) from information_schema.deadlocks where current_plan_digest is null").Check(testkit.Rows("0"))
 }
 
 func TestSingleStatementRollback(t *testing.T) {
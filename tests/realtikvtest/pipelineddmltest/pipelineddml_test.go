@@ -43,6 +43,12 @@ func TestVariable(t *testing.T) {
 	require.Equal(t, tk.Session().GetSessionVars().BulkDMLEnabled, false)
 	// not supported yet.
 	tk.MustExecToErr("set session tidb_dml_type = bulk(10)")
+
+	require.Equal(t, tk.Session().GetSessionVars().BulkDMLBatchSize, 0)
+	tk.MustExec("set session tidb_dml_bulk_batch_size = 1000")
+	require.Equal(t, tk.Session().GetSessionVars().BulkDMLBatchSize, 1000)
+	tk.MustExec("set session tidb_dml_bulk_batch_size = default")
+	require.Equal(t, tk.Session().GetSessionVars().BulkDMLBatchSize, 0)
 }
 
 // We limit this feature only for cases meet all the following conditions:
@@ -266,6 +272,26 @@ func TestPipelinedDMLInsert(t *testing.T) {
 	compareTables(t, tk, "t", "_t")
 }
 
+func TestPipelinedDMLBatchSize(t *testing.T) {
+	store := realtikvtest.CreateMockStoreAndSetup(t)
+	tk := testkit.NewTestKit(t, store)
+	tk.MustExec("use test")
+	prepareData(tk)
+	tk.MustExec("set session tidb_dml_type = bulk")
+	// the mutations are flushed every 10 rows regardless of the memory usage.
+	tk.MustExec("set session tidb_dml_bulk_batch_size = 10")
+	tk.MustExec("insert into _t select * from t")
+	compareTables(t, tk, "t", "_t")
+
+	tk.MustExec("update _t set b = b + 1")
+	require.Equal(t, tk.Session().AffectedRows(), uint64(100))
+	tk.MustQuery("select count(1) from _t where b = a + 1").Check(testkit.Rows("100"))
+
+	tk.MustExec("delete from _t")
+	require.Equal(t, tk.Session().AffectedRows(), uint64(100))
+	tk.MustQuery("select count(1) from _t").Check(testkit.Rows("0"))
+}
+
 func TestPipelinedDMLInsertIgnore(t *testing.T) {
 	require.Nil(t, failpoint.Enable("tikvclient/pipelinedMemDBMinFlushKeys", `return(10)`))
 	require.Nil(t, failpoint.Enable("tikvclient/pipelinedMemDBMinFlushSize", `return(100)`))